@@ -0,0 +1,213 @@
+// Command admin runs maintenance tasks against the database configured for the
+// service, reading the same environment variables.
+//
+//	go run ./cmd/admin backup                                  # take a backup now
+//	go run ./cmd/admin list                                    # list the backups, newest first
+//	go run ./cmd/admin prune                                   # delete the backups the retention policy doesn't keep
+//	go run ./cmd/admin restore finance-20250401T033000Z.json   # restore a backup into a database without accounts
+//	go run ./cmd/admin url finance-20250401T033000Z.json       # print a link downloading a backup from S3 for an hour
+//	go run ./cmd/admin rotate-keys                             # encrypt the account numbers again with the current key
+//	go run ./cmd/admin set-tier alice@example.com unlimited     # lift the quotas of a user, or put them back on free
+//
+// Backups are read from and written to BACKUP_DIR, or to the bucket of the
+// attachments with BACKUP_STORE=s3, where url signs links to them. Restoring needs the database
+// schema to be migrated to the version shipped with this binary. The account
+// numbers are encrypted with ENCRYPTION_KEYS, after a new key is put first
+// rotate-keys moves the stored ones to it so the retired keys can be dropped.
+// The users of the free tier are held to the QUOTA_* quotas.
+package main
+
+import (
+	"context"
+	"errors"
+	"finance/domain/entities"
+	"finance/domain/finance"
+	"finance/internal/config"
+	"finance/internal/encryption"
+	"finance/internal/repository/files"
+	"finance/internal/repository/pg"
+	"finance/internal/repository/s3"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/guilhermebr/gox/postgres"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: admin backup | list | prune | restore <backup> | url <backup> | rotate-keys | set-tier <email> <tier>")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(flag.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "admin: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		flag.Usage()
+		return errors.New("missing command")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var cfg config.Config
+	if err := cfg.Load(""); err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+	if err := cfg.Validate(config.DatabaseVariables...).Err(); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	conn, err := postgres.New(ctx, "")
+	if err != nil {
+		return fmt.Errorf("connecting to postgres: %w", err)
+	}
+	defer conn.Close()
+
+	accountRepo := pg.NewAccountRepository(conn)
+	if cfg.EncryptionKeys != "" {
+		keys, err := encryption.ParseKeys(cfg.EncryptionKeys)
+		if err != nil {
+			return fmt.Errorf("invalid encryption keys: %w", err)
+		}
+		accountRepo.UseCipher(encryption.NewCipher(keys))
+	}
+
+	var backupStore finance.BackupStore = files.NewBackupStore(cfg.Backup.Dir)
+	var bucket *s3.BackupStore
+	if cfg.Backup.Store == "s3" {
+		bucket = s3.NewBackupStore(s3.Config{
+			Endpoint:        cfg.Attachments.S3Endpoint,
+			Bucket:          cfg.Attachments.S3Bucket,
+			Region:          cfg.Attachments.S3Region,
+			AccessKeyID:     cfg.Attachments.S3AccessKeyID,
+			SecretAccessKey: cfg.Attachments.S3SecretAccessKey,
+		})
+		backupStore = bucket
+	}
+
+	backups := finance.NewBackupUseCase(
+		accountRepo,
+		pg.NewCategoryRepository(conn),
+		pg.NewTransactionRepository(conn),
+		pg.NewBalanceRepository(conn),
+		pg.NewSettingsRepository(conn),
+		pg.NewUserSettingsRepository(conn),
+		backupStore,
+	)
+
+	// Accounts can be kept in the custom assets of the deployment
+	assets := finance.NewAssetUseCase(pg.NewAssetRepository(conn))
+
+	switch command := args[0]; command {
+	case "backup":
+		if err := assets.LoadCustomAssets(ctx); err != nil {
+			return err
+		}
+
+		file, err := backups.CreateBackup(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("created %s (%d bytes)\n", file.Name, file.Size)
+
+	case "list":
+		list, err := backups.ListBackups(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tCREATED\tSIZE")
+		for _, file := range list {
+			fmt.Fprintf(w, "%s\t%s\t%d\n", file.Name, file.CreatedAt.Format(time.RFC3339), file.Size)
+		}
+		return w.Flush()
+
+	case "prune":
+		pruned, err := backups.PruneBackups(ctx, entities.BackupRetention{Daily: cfg.Backup.KeepDaily, Weekly: cfg.Backup.KeepWeekly})
+		for _, file := range pruned {
+			fmt.Printf("deleted %s\n", file.Name)
+		}
+		return err
+
+	case "restore":
+		if len(args) != 2 {
+			return errors.New("usage: admin restore <backup>")
+		}
+
+		status, err := finance.NewMigrationUseCase(pg.NewMigrationRepository(conn)).GetMigrationStatus(ctx)
+		if err != nil {
+			return err
+		}
+		if !status.Current() {
+			return fmt.Errorf("database schema version %d is not current (latest %d, dirty %t), migrate it first",
+				status.SchemaVersion, status.LatestVersion, status.Dirty)
+		}
+
+		if err := assets.LoadCustomAssets(ctx); err != nil {
+			return err
+		}
+
+		restore, err := backups.RestoreBackup(ctx, args[1])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("restored %d accounts, %d transactions and %d new categories from %s\n",
+			restore.Accounts, restore.Transactions, restore.Categories, args[1])
+
+	case "url":
+		if len(args) != 2 {
+			return errors.New("usage: admin url <backup>")
+		}
+		if bucket == nil {
+			return fmt.Errorf("backups are kept in %s, only the ones in S3 have links", cfg.Backup.Dir)
+		}
+
+		link, err := bucket.SignURL(args[1], time.Hour)
+		if err != nil {
+			return err
+		}
+		fmt.Println(link)
+
+	case "rotate-keys":
+		if cfg.EncryptionKeys == "" {
+			return errors.New("ENCRYPTION_KEYS is not set")
+		}
+
+		count, err := accountRepo.ReencryptAccountNumbers(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("encrypted %d account numbers with the current key\n", count)
+
+	case "set-tier":
+		if len(args) != 3 {
+			return errors.New("usage: admin set-tier <email> <tier>")
+		}
+		tier := entities.UserTier(args[2])
+		if !slices.Contains(entities.UserTiers, tier) {
+			return fmt.Errorf("unknown tier %q, expected free or unlimited", args[2])
+		}
+
+		if err := pg.NewUserRepository(conn).SetUserTier(ctx, args[1], tier); err != nil {
+			return err
+		}
+		fmt.Printf("moved %s to the %s tier\n", args[1], tier)
+
+	default:
+		flag.Usage()
+		return fmt.Errorf("unknown command %s", command)
+	}
+
+	return nil
+}
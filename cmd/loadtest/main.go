@@ -0,0 +1,115 @@
+// Command loadtest generates a mix of reads, writes and statement imports against a
+// running API and reports latency percentiles per operation.
+//
+//	go run ./cmd/loadtest -url http://127.0.0.1:3000 -token $TOKEN -duration 1m -workers 20 -max-p99 250ms
+//
+// The token is the one /api/v1/auth/login returns, the traffic goes to that user's
+// default book.
+//
+// It creates its own accounts (and categories, when the defaults are missing) and
+// removes them, together with their transactions, when the run ends. The exit code is
+// non-zero when -max-p99 or -max-error-rate are exceeded, so it can gate a release.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+)
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.baseURL, "url", "http://127.0.0.1:3000", "API base URL")
+	flag.StringVar(&opts.token, "token", os.Getenv("LOADTEST_TOKEN"), "bearer token of the user to generate traffic as")
+	flag.DurationVar(&opts.duration, "duration", 30*time.Second, "how long to generate traffic")
+	flag.IntVar(&opts.workers, "workers", 10, "concurrent clients")
+	flag.IntVar(&opts.rate, "rate", 0, "operations per second across all workers, 0 for no limit")
+	flag.IntVar(&opts.accounts, "accounts", 5, "accounts to spread the traffic over")
+	flag.IntVar(&opts.importSize, "import-size", 50, "transactions per statement import")
+	flag.Uint64Var(&opts.seed, "seed", uint64(time.Now().UnixNano()), "random seed")
+	flag.DurationVar(&opts.maxP99, "max-p99", 0, "fail when an operation's p99 latency is above this, 0 to disable")
+	flag.Float64Var(&opts.maxErrorRate, "max-error-rate", 0.01, "fail when the ratio of failed operations is above this")
+	flag.BoolVar(&opts.keep, "keep", false, "keep the generated accounts and transactions")
+	flag.Parse()
+
+	if err := run(opts); err != nil {
+		fmt.Fprintf(os.Stderr, "loadtest: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+type options struct {
+	baseURL      string
+	token        string
+	duration     time.Duration
+	workers      int
+	rate         int
+	accounts     int
+	importSize   int
+	seed         uint64
+	maxP99       time.Duration
+	maxErrorRate float64
+	keep         bool
+}
+
+func run(opts options) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client := newClient(opts.baseURL, opts.token)
+
+	fixture, err := setup(ctx, client, opts.accounts)
+	if err != nil {
+		return fmt.Errorf("setting up fixtures: %w", err)
+	}
+	if !opts.keep {
+		defer func() {
+			// The run context may already be cancelled by an interrupt
+			if err := fixture.cleanup(context.Background(), client); err != nil {
+				slog.Error("failed to clean up fixtures", "error", err)
+			}
+		}()
+	}
+
+	slog.Info("generating traffic",
+		"url", opts.baseURL,
+		"duration", opts.duration,
+		"workers", opts.workers,
+		"rate", opts.rate,
+		"seed", opts.seed,
+	)
+
+	runCtx, cancel := context.WithTimeout(ctx, opts.duration)
+	defer cancel()
+
+	var tokens <-chan time.Time
+	if opts.rate > 0 {
+		ticker := time.NewTicker(time.Second / time.Duration(opts.rate))
+		defer ticker.Stop()
+		tokens = ticker.C
+	}
+
+	stats := newStats()
+	started := time.Now()
+
+	var wg sync.WaitGroup
+	for i := range opts.workers {
+		w := newWorker(client, fixture, stats, opts, uint64(i))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.run(runCtx, tokens)
+		}()
+	}
+	wg.Wait()
+
+	report := stats.report(time.Since(started))
+	report.print(os.Stdout)
+
+	return report.check(opts.maxP99, opts.maxErrorRate)
+}
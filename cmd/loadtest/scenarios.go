@@ -0,0 +1,296 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"finance/domain/entities"
+	v1 "finance/internal/api/v1"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// operation is one kind of request a worker can issue. Weights are relative to the
+// sum of all weights and roughly follow how the web frontend uses the API: mostly
+// dashboard and list reads, some transaction edits and the occasional statement import.
+type operation struct {
+	name   string
+	weight int
+	run    func(ctx context.Context, w *worker) error
+}
+
+var operations = []operation{
+	{name: "list_transactions", weight: 20, run: listTransactions},
+	{name: "list_accounts", weight: 10, run: listAccounts},
+	{name: "get_balance", weight: 15, run: getBalance},
+	{name: "list_balances", weight: 10, run: listBalances},
+	{name: "balance_summary", weight: 10, run: balanceSummary},
+	{name: "create_transaction", weight: 15, run: createTransaction},
+	{name: "update_transaction", weight: 8, run: updateTransaction},
+	{name: "delete_transaction", weight: 5, run: deleteTransaction},
+	{name: "refresh_balance", weight: 5, run: refreshBalance},
+	{name: "import_statement", weight: 2, run: importStatement},
+}
+
+// fixture holds the resources created for the run and the transactions the workers
+// have created so far, which the update and delete operations pick from
+type fixture struct {
+	accounts   []string
+	expense    string
+	income     string
+	categories []string
+
+	mu           sync.Mutex
+	transactions []string
+}
+
+func setup(ctx context.Context, c *client, accounts int) (*fixture, error) {
+	f := &fixture{}
+
+	var categories v1.PageResponse[v1.CategoryResponse]
+	if err := c.do(ctx, http.MethodGet, "/categories?limit=500", nil, &categories); err != nil {
+		return nil, err
+	}
+	for _, category := range categories.Items {
+		switch {
+		case category.Type == entities.CategoryTypeExpense && f.expense == "":
+			f.expense = category.ID
+		case category.Type == entities.CategoryTypeIncome && f.income == "":
+			f.income = category.ID
+		}
+	}
+
+	for categoryType, id := range map[entities.CategoryType]*string{
+		entities.CategoryTypeExpense: &f.expense,
+		entities.CategoryTypeIncome:  &f.income,
+	} {
+		if *id != "" {
+			continue
+		}
+
+		var created v1.CategoryResponse
+		err := c.do(ctx, http.MethodPost, "/categories", v1.CreateCategoryRequest{
+			Name:  fmt.Sprintf("Load Test %s", categoryType),
+			Type:  categoryType,
+			Color: "#808080",
+		}, &created)
+		if err != nil {
+			return nil, err
+		}
+		*id = created.ID
+		f.categories = append(f.categories, created.ID)
+	}
+
+	for i := range accounts {
+		var created v1.AccountResponse
+		err := c.do(ctx, http.MethodPost, "/accounts", v1.CreateAccountRequest{
+			Name:        fmt.Sprintf("Load Test %d", i+1),
+			Type:        entities.AccountTypeChecking,
+			Asset:       "USD",
+			Description: "Created by cmd/loadtest",
+		}, &created)
+		if err != nil {
+			return nil, errors.Join(err, f.cleanup(ctx, c))
+		}
+		f.accounts = append(f.accounts, created.ID)
+	}
+
+	return f, nil
+}
+
+// cleanup deletes the accounts, which cascades to their transactions, and then the
+// categories the run had to create
+func (f *fixture) cleanup(ctx context.Context, c *client) error {
+	var errs []error
+	for _, id := range f.accounts {
+		if err := c.do(ctx, http.MethodDelete, "/accounts/"+id, nil, nil); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, id := range f.categories {
+		if err := c.do(ctx, http.MethodDelete, "/categories/"+id, nil, nil); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (f *fixture) addTransaction(id string) {
+	f.mu.Lock()
+	f.transactions = append(f.transactions, id)
+	f.mu.Unlock()
+}
+
+// pickTransaction returns a random transaction created during the run, removing it
+// from the pool when take is set so two workers never delete the same one
+func (f *fixture) pickTransaction(rng *rand.Rand, take bool) (string, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.transactions) == 0 {
+		return "", false
+	}
+
+	i := rng.IntN(len(f.transactions))
+	id := f.transactions[i]
+	if take {
+		f.transactions[i] = f.transactions[len(f.transactions)-1]
+		f.transactions = f.transactions[:len(f.transactions)-1]
+	}
+	return id, true
+}
+
+type worker struct {
+	client     *client
+	fixture    *fixture
+	stats      *stats
+	rng        *rand.Rand
+	importSize int
+	weights    int
+}
+
+func newWorker(c *client, f *fixture, s *stats, opts options, id uint64) *worker {
+	w := &worker{
+		client:     c,
+		fixture:    f,
+		stats:      s,
+		rng:        rand.New(rand.NewPCG(opts.seed, id)),
+		importSize: opts.importSize,
+	}
+	for _, op := range operations {
+		w.weights += op.weight
+	}
+	return w
+}
+
+func (w *worker) run(ctx context.Context, tokens <-chan time.Time) {
+	for {
+		if tokens != nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-tokens:
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		op := w.pick()
+		started := time.Now()
+		err := op.run(ctx, w)
+
+		// Requests cut short by the end of the run aren't failures
+		if ctx.Err() != nil {
+			return
+		}
+		w.stats.record(op.name, time.Since(started), err)
+	}
+}
+
+func (w *worker) pick() operation {
+	n := w.rng.IntN(w.weights)
+	for _, op := range operations {
+		if n < op.weight {
+			return op
+		}
+		n -= op.weight
+	}
+	return operations[len(operations)-1]
+}
+
+func (w *worker) account() string {
+	return w.fixture.accounts[w.rng.IntN(len(w.fixture.accounts))]
+}
+
+// transaction returns a random transaction dated within the last 90 days, mostly
+// expenses with the occasional income
+func (w *worker) transaction(description string) v1.CreateTransactionRequest {
+	req := v1.CreateTransactionRequest{
+		AccountID:   w.account(),
+		CategoryID:  w.fixture.expense,
+		Amount:      fmt.Sprintf("-%d.%02d", 1+w.rng.IntN(300), w.rng.IntN(100)),
+		Description: description,
+		Date:        time.Now().AddDate(0, 0, -w.rng.IntN(90)).Format("2006-01-02"),
+		Status:      entities.TransactionStatusCleared,
+	}
+	if w.rng.IntN(10) == 0 {
+		req.CategoryID = w.fixture.income
+		req.Amount = fmt.Sprintf("%d.00", 1000+w.rng.IntN(5000))
+	}
+	if w.rng.IntN(5) == 0 {
+		req.Status = entities.TransactionStatusPending
+	}
+	return req
+}
+
+func listTransactions(ctx context.Context, w *worker) error {
+	return w.client.do(ctx, http.MethodGet, "/transactions?include=account,category", nil, nil)
+}
+
+func listAccounts(ctx context.Context, w *worker) error {
+	return w.client.do(ctx, http.MethodGet, "/accounts?include=balance", nil, nil)
+}
+
+func getBalance(ctx context.Context, w *worker) error {
+	return w.client.do(ctx, http.MethodGet, "/balances/"+w.account(), nil, nil)
+}
+
+func listBalances(ctx context.Context, w *worker) error {
+	return w.client.do(ctx, http.MethodGet, "/balances", nil, nil)
+}
+
+func balanceSummary(ctx context.Context, w *worker) error {
+	return w.client.do(ctx, http.MethodGet, "/balances/summary", nil, nil)
+}
+
+func refreshBalance(ctx context.Context, w *worker) error {
+	return w.client.do(ctx, http.MethodPost, "/balances/"+w.account()+"/refresh", nil, nil)
+}
+
+func createTransaction(ctx context.Context, w *worker) error {
+	var created v1.TransactionResponse
+	if err := w.client.do(ctx, http.MethodPost, "/transactions", w.transaction("Load test purchase"), &created); err != nil {
+		return err
+	}
+	w.fixture.addTransaction(created.ID)
+	return nil
+}
+
+func updateTransaction(ctx context.Context, w *worker) error {
+	id, ok := w.fixture.pickTransaction(w.rng, false)
+	if !ok {
+		return createTransaction(ctx, w)
+	}
+
+	req := w.transaction("Load test purchase (edited)")
+	return w.client.do(ctx, http.MethodPut, "/transactions/"+id, v1.UpdateTransactionRequest(req), nil)
+}
+
+func deleteTransaction(ctx context.Context, w *worker) error {
+	id, ok := w.fixture.pickTransaction(w.rng, true)
+	if !ok {
+		return createTransaction(ctx, w)
+	}
+
+	return w.client.do(ctx, http.MethodDelete, "/transactions/"+id, nil, nil)
+}
+
+// importStatement posts a burst of transactions into one account back to back, the
+// way a bank statement import does, so every insert hits the same balance row
+func importStatement(ctx context.Context, w *worker) error {
+	account := w.account()
+	for i := range w.importSize {
+		req := w.transaction(fmt.Sprintf("Imported statement line %d", i+1))
+		req.AccountID = account
+
+		var created v1.TransactionResponse
+		if err := w.client.do(ctx, http.MethodPost, "/transactions", req, &created); err != nil {
+			return err
+		}
+		w.fixture.addTransaction(created.ID)
+	}
+	return nil
+}
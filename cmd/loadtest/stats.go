@@ -0,0 +1,156 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+type series struct {
+	latencies []time.Duration
+	errors    int
+	lastError error
+}
+
+type stats struct {
+	mu     sync.Mutex
+	series map[string]*series
+}
+
+func newStats() *stats {
+	return &stats{series: map[string]*series{}}
+}
+
+func (s *stats) record(name string, latency time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ss, ok := s.series[name]
+	if !ok {
+		ss = &series{}
+		s.series[name] = ss
+	}
+
+	if err != nil {
+		ss.errors++
+		ss.lastError = err
+		return
+	}
+	ss.latencies = append(ss.latencies, latency)
+}
+
+type operationReport struct {
+	name      string
+	count     int
+	errors    int
+	lastError error
+	rps       float64
+	p50       time.Duration
+	p90       time.Duration
+	p95       time.Duration
+	p99       time.Duration
+	max       time.Duration
+}
+
+type report struct {
+	elapsed    time.Duration
+	operations []operationReport
+}
+
+func (s *stats) report(elapsed time.Duration) report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := report{elapsed: elapsed}
+	for name, ss := range s.series {
+		latencies := slices.Clone(ss.latencies)
+		slices.Sort(latencies)
+
+		op := operationReport{
+			name:      name,
+			count:     len(latencies) + ss.errors,
+			errors:    ss.errors,
+			lastError: ss.lastError,
+			rps:       float64(len(latencies)+ss.errors) / elapsed.Seconds(),
+			p50:       percentile(latencies, 50),
+			p90:       percentile(latencies, 90),
+			p95:       percentile(latencies, 95),
+			p99:       percentile(latencies, 99),
+		}
+		if len(latencies) > 0 {
+			op.max = latencies[len(latencies)-1]
+		}
+		r.operations = append(r.operations, op)
+	}
+
+	slices.SortFunc(r.operations, func(a, b operationReport) int {
+		return b.count - a.count
+	})
+	return r
+}
+
+// percentile returns the nearest-rank percentile of the sorted latencies
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+func (r report) print(out io.Writer) {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "operation\tcount\terrors\trps\tp50\tp90\tp95\tp99\tmax\t")
+
+	var total, failed int
+	for _, op := range r.operations {
+		total += op.count
+		failed += op.errors
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%.1f\t%s\t%s\t%s\t%s\t%s\t\n",
+			op.name, op.count, op.errors, op.rps,
+			round(op.p50), round(op.p90), round(op.p95), round(op.p99), round(op.max))
+	}
+	tw.Flush()
+
+	fmt.Fprintf(out, "\n%d operations in %s (%.1f/s), %d failed\n",
+		total, r.elapsed.Round(time.Millisecond), float64(total)/r.elapsed.Seconds(), failed)
+
+	for _, op := range r.operations {
+		if op.lastError != nil {
+			fmt.Fprintf(out, "last %s error: %v\n", op.name, op.lastError)
+		}
+	}
+}
+
+// check fails the run when an operation is slower than maxP99 or too many failed
+func (r report) check(maxP99 time.Duration, maxErrorRate float64) error {
+	var errs []error
+	var total, failed int
+	for _, op := range r.operations {
+		total += op.count
+		failed += op.errors
+		if maxP99 > 0 && op.p99 > maxP99 {
+			errs = append(errs, fmt.Errorf("%s p99 %s is above %s", op.name, round(op.p99), maxP99))
+		}
+	}
+
+	if total == 0 {
+		return errors.New("no operations completed")
+	}
+	if rate := float64(failed) / float64(total); rate > maxErrorRate {
+		errs = append(errs, fmt.Errorf("error rate %.2f%% is above %.2f%%", rate*100, maxErrorRate*100))
+	}
+
+	return errors.Join(errs...)
+}
+
+func round(d time.Duration) time.Duration {
+	if d < time.Millisecond {
+		return d.Round(time.Microsecond)
+	}
+	return d.Round(100 * time.Microsecond)
+}
@@ -15,25 +15,50 @@ package main
 //	@host		0.0.0.0:3000
 //	@BasePath	/api/v1
 
+//	@securityDefinitions.apikey	BearerAuth
+//	@in							header
+//	@name						Authorization
+//	@description				The token from /auth/login or /auth/register, as "Bearer <token>". Every endpoint but those two requires it
+
+//	@security	BearerAuth
+
 //	@externalDocs.description	OpenAPI
 //	@externalDocs.url			https://swagger.io/resources/open-api/
 
 import (
 	"context"
 	"errors"
+	"finance/domain/entities"
 	"finance/domain/finance"
 	"finance/internal/api"
 	v1 "finance/internal/api/v1"
+	"finance/internal/auth"
 	"finance/internal/config"
+	"finance/internal/encryption"
+	"finance/internal/exporters"
+	"finance/internal/importers"
+	"finance/internal/logging"
+	"finance/internal/metrics"
+	"finance/internal/query"
+	"finance/internal/realtime"
+	"finance/internal/repository/files"
 	"finance/internal/repository/pg"
+	"finance/internal/repository/s3"
+	"finance/internal/scripting"
+	"finance/internal/thumbnails"
+	"finance/internal/web"
+	"finance/internal/worker"
+	"finance/plugin"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"runtime"
+	"slices"
+	"strings"
 	"time"
 
-	"github.com/guilhermebr/gox/logger"
 	"github.com/guilhermebr/gox/postgres"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	_ "finance/docs"
 )
@@ -53,7 +78,7 @@ func main() {
 	}
 
 	// Logger
-	log, err := logger.NewLogger("")
+	log, logLevel, err := logging.NewLogger("")
 	if err != nil {
 		panic(fmt.Errorf("creating logger: %w", err))
 	}
@@ -66,8 +91,22 @@ func main() {
 		slog.Int("runtime_num_cpu", runtime.NumCPU()),
 	)
 
+	report := cfg.Validate(slices.Concat(config.DatabaseVariables, config.AuthVariables)...)
+	report.Log(log)
+	if err := report.Err(); err != nil {
+		log.Error("invalid configuration",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
 	// Database connection
-	conn, err := postgres.New(ctx, "")
+	var conn *pgxpool.Pool
+	if cfg.DatabaseRowLevelSecurity {
+		conn, err = pg.NewRowSecurityPool(ctx)
+	} else {
+		conn, err = postgres.New(ctx, "")
+	}
 	if err != nil {
 		log.Error("failed to setup postgres",
 			slog.String("error", err.Error()),
@@ -85,38 +124,337 @@ func main() {
 	}
 
 	// Finance repositories
+	bookRepo := pg.NewBookRepository(conn)
 	accountRepo := pg.NewAccountRepository(conn)
+	if cfg.EncryptionKeys != "" {
+		keys, err := encryption.ParseKeys(cfg.EncryptionKeys)
+		if err != nil {
+			log.Error("invalid encryption keys",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+		accountRepo.UseCipher(encryption.NewCipher(keys))
+	}
 	categoryRepo := pg.NewCategoryRepository(conn)
 	transactionRepo := pg.NewTransactionRepository(conn)
 	balanceRepo := pg.NewBalanceRepository(conn)
+	settingsRepo := pg.NewSettingsRepository(conn)
+	userSettingsRepo := pg.NewUserSettingsRepository(conn)
+	migrationRepo := pg.NewMigrationRepository(conn)
+	installmentRepo := pg.NewInstallmentRepository(conn)
+	expenseReportRepo := pg.NewExpenseReportRepository(conn)
+	invoiceRepo := pg.NewInvoiceRepository(conn)
+	projectRepo := pg.NewProjectRepository(conn)
+	tagRepo := pg.NewTagRepository(conn)
+	attachmentRepo := pg.NewAttachmentRepository(conn)
+	exportRepo := pg.NewExportRepository(conn)
+	budgetRepo := pg.NewBudgetRepository(conn)
+	budgetTemplateRepo := pg.NewBudgetTemplateRepository(conn)
+	assetRepo := pg.NewAssetRepository(conn)
+	reportSnapshotRepo := pg.NewReportSnapshotRepository(conn)
+	reportDefinitionRepo := pg.NewReportDefinitionRepository(conn)
+	restorePointRepo := pg.NewRestorePointRepository(conn)
+	transactionScriptRepo := pg.NewTransactionScriptRepository(conn)
+	userRepo := pg.NewUserRepository(conn)
+	sessionRepo := pg.NewSessionRepository(conn)
+	loginFailureRepo := pg.NewLoginFailureRepository(conn)
+	authEventRepo := pg.NewAuthEventRepository(conn)
+	idempotencyRepo := pg.NewIdempotencyRepository(conn)
+
+	// Plugins
+	// ------------------------------------------
+	// The statement sources of the importer plugins are registered before
+	// anything lists them
+	parsers := map[entities.StatementSource]finance.StatementParser{
+		entities.StatementSourceWise:    importers.NewWiseParser(),
+		entities.StatementSourceRevolut: importers.NewRevolutParser(),
+	}
+	for _, importer := range plugin.Importers() {
+		parsers[importer.Source] = importer.Parser
+		entities.RegisterStatementSource(importer.Source, importer.Institution)
+	}
+	if pluginImporters, pluginNotifiers, pluginRuleActions := plugin.Names(); len(pluginImporters)+len(pluginNotifiers)+len(pluginRuleActions) > 0 {
+		log.Info("plugins loaded",
+			slog.Any("importers", pluginImporters),
+			slog.Any("notifiers", pluginNotifiers),
+			slog.Any("rule_actions", pluginRuleActions),
+		)
+	}
 
 	// Finance use cases
-	accountUseCase := finance.NewAccountUseCase(accountRepo, balanceRepo)
+	quotaPolicy := finance.QuotaPolicy{
+		MaxAccounts:            cfg.QuotaMaxAccounts,
+		MaxMonthlyTransactions: cfg.QuotaMaxMonthlyTransactions,
+		MaxMonthlyAPICalls:     cfg.QuotaMaxMonthlyAPICalls,
+		MaxAttachmentBytes:     int64(cfg.QuotaMaxAttachmentMB) << 20,
+	}
+	quotas := finance.NewQuotaGuard(userRepo, quotaPolicy)
+	usageUseCase := finance.NewUsageUseCase(userRepo, quotaPolicy)
+	idempotencyUseCase := finance.NewIdempotencyUseCase(idempotencyRepo)
+	assetUseCase := finance.NewAssetUseCase(assetRepo)
+	bookUseCase := finance.NewBookUseCase(bookRepo, settingsRepo)
+	accountUseCase := finance.NewAccountUseCase(accountRepo, balanceRepo, quotas)
+	faturaUseCase := finance.NewFaturaUseCase(transactionRepo, accountRepo)
 	categoryUseCase := finance.NewCategoryUseCase(categoryRepo)
-	transactionUseCase := finance.NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo)
+	transactionScriptUseCase := finance.NewTransactionScriptUseCase(transactionScriptRepo, scripting.NewRunner(scripting.Limits{
+		Timeout:       cfg.Scripts.Timeout,
+		MaxSteps:      cfg.Scripts.MaxSteps,
+		MaxAllocBytes: cfg.Scripts.MaxAllocBytes,
+	}))
+	// The scripts of the users run after the plugins, having the last word
+	ruleActions := append(plugin.RuleActions(), transactionScriptUseCase)
+	transactionUseCase := finance.NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, userSettingsRepo, installmentRepo, quotas, ruleActions)
+	// The exports are kept with the attachments, and downloaded straight
+	// from the bucket through signed URLs when it's S3
+	s3Config := s3.Config{
+		Endpoint:        cfg.Attachments.S3Endpoint,
+		Bucket:          cfg.Attachments.S3Bucket,
+		Region:          cfg.Attachments.S3Region,
+		AccessKeyID:     cfg.Attachments.S3AccessKeyID,
+		SecretAccessKey: cfg.Attachments.S3SecretAccessKey,
+	}
+	var attachmentStore finance.AttachmentStore = files.NewAttachmentStore(cfg.Attachments.Dir)
+	var fileURLSigner finance.FileURLSigner
+	if cfg.Attachments.Store == "s3" {
+		bucket := s3.NewAttachmentStore(s3Config)
+		attachmentStore, fileURLSigner = bucket, bucket
+	}
+	transactionExportUseCase := finance.NewTransactionExportUseCase(map[entities.TransactionExportFormat]finance.TransactionExporter{
+		entities.TransactionExportFormatCSV:  exporters.NewTransactionsCSV(),
+		entities.TransactionExportFormatXLSX: exporters.NewTransactionsXLSX(),
+	}, transactionRepo, exportRepo, attachmentStore, fileURLSigner)
+	installmentUseCase := finance.NewInstallmentUseCase(installmentRepo, transactionRepo, balanceRepo)
+	expenseReportUseCase := finance.NewExpenseReportUseCase(map[entities.ExpenseReportFormat]finance.ExpenseReportExporter{
+		entities.ExpenseReportFormatCSV: exporters.NewExpenseReportCSV(),
+		entities.ExpenseReportFormatPDF: exporters.NewExpenseReportPDF(),
+	}, expenseReportRepo, transactionRepo)
+	invoiceUseCase := finance.NewInvoiceUseCase(invoiceRepo, transactionRepo, accountRepo)
+	projectUseCase := finance.NewProjectUseCase(projectRepo, transactionRepo, categoryRepo)
+	tagUseCase := finance.NewTagUseCase(tagRepo)
+	attachmentUseCase := finance.NewAttachmentUseCase(attachmentRepo, transactionRepo, attachmentStore, thumbnails.New(thumbnails.DefaultSize), quotas)
+	budgetUseCase := finance.NewBudgetUseCase(budgetRepo, budgetTemplateRepo, categoryRepo, transactionRepo, bookRepo)
 	balanceUseCase := finance.NewBalanceUseCase(balanceRepo, accountRepo)
+	quickCaptureUseCase := finance.NewQuickCaptureUseCase(transactionRepo, accountRepo, categoryRepo)
+	importUseCase := finance.NewImportUseCase(parsers, map[string]finance.DescriptionParser{
+		"BRL": importers.NewBrazilDescriptionParser(),
+	}, importers.NewMappedParser(), transactionRepo, accountRepo, categoryRepo, balanceRepo, restorePointRepo, quotas, ruleActions)
+	restorePointUseCase := finance.NewRestorePointUseCase(restorePointRepo, transactionRepo, accountRepo, balanceRepo)
+	settingsUseCase := finance.NewSettingsUseCase(settingsRepo)
+	lockout := finance.DefaultLockoutPolicy()
+	lockout.Threshold = cfg.AuthLockoutThreshold
+	lockout.Backoff = cfg.AuthLockoutBackoff
+	lockout.MaxBackoff = cfg.AuthLockoutMaxBackoff
+	var captcha finance.CaptchaVerifier
+	if cfg.AuthCaptchaVerifyURL != "" {
+		captcha = auth.NewSiteVerify(cfg.AuthCaptchaVerifyURL, cfg.AuthCaptchaSecret)
+	}
+	loginGuard := finance.NewLoginGuard(loginFailureRepo, authEventRepo, captcha, lockout)
+	userUseCase := finance.NewUserUseCase(userRepo, sessionRepo, bookRepo, settingsRepo, auth.NewJWT(cfg.AuthSecretKey), loginGuard, cfg.AuthTokenTTL)
+	summaryUseCase := finance.NewSummaryUseCase(balanceRepo, transactionRepo, categoryRepo)
+	reportUseCase := finance.NewReportUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, bookRepo, reportSnapshotRepo)
+	customReportUseCase := finance.NewCustomReportUseCase(reportDefinitionRepo, transactionRepo, accountRepo, categoryRepo)
+	simulationUseCase := finance.NewSimulationUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, bookRepo)
+	queryUseCase := finance.NewQueryUseCase(query.NewPatternParser(), transactionRepo, accountRepo, categoryRepo, balanceRepo)
+	userSettingsUseCase := finance.NewUserSettingsUseCase(userSettingsRepo)
+	onboardingUseCase := finance.NewOnboardingUseCase(accountRepo, categoryRepo, userSettingsRepo)
+	demoUseCase := finance.NewDemoUseCase(accountRepo, categoryRepo, transactionRepo, balanceRepo, userSettingsRepo)
+	migrationUseCase := finance.NewMigrationUseCase(migrationRepo)
+	var backupStore finance.BackupStore = files.NewBackupStore(cfg.Backup.Dir)
+	if cfg.Backup.Store == "s3" {
+		backupStore = s3.NewBackupStore(s3Config)
+	}
+	backupUseCase := finance.NewBackupUseCase(accountRepo, categoryRepo, transactionRepo, balanceRepo, settingsRepo, userSettingsRepo, backupStore)
+
+	// Amounts in the custom assets can't be read until they're loaded. While
+	// the schema is behind they're left out, and picked up on the next listing.
+	if err := assetUseCase.LoadCustomAssets(ctx); err != nil {
+		log.Warn("failed to load custom assets",
+			slog.String("error", err.Error()),
+		)
+	}
+
+	// WORKER
+	// ------------------------------------------
+	// Publish the changes made through the API and the budget alerts fired
+	// to the WebSocket clients
+	events := realtime.NewHub()
+	for name, notifier := range plugin.Notifiers() {
+		channel := worker.NotifierFunc(func(ctx context.Context, event realtime.Event) error {
+			return notifier.Notify(ctx, plugin.Notification{Topic: event.Topic, Action: event.Action, Data: event.Data})
+		})
+		go worker.NewNotifyJob(events, name, channel, log).Run(ctx)
+	}
+
+	if cfg.Worker.BalanceRefreshEnabled {
+		schedule, err := worker.ParseSchedule(cfg.Worker.BalanceRefreshSchedule)
+		if err != nil {
+			log.Error("failed to parse balance refresh schedule",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+
+		// The balances of every book are refreshed, one book after the other
+		refresher := worker.BalanceRefresherFunc(func(ctx context.Context) error {
+			return bookUseCase.InEachBook(ctx, balanceUseCase.RefreshAllBalances)
+		})
+		go worker.NewBalanceRefreshJob(refresher, schedule, log).Run(ctx)
+	}
+
+	if cfg.Worker.BackupEnabled {
+		schedule, err := worker.ParseSchedule(cfg.Worker.BackupSchedule)
+		if err != nil {
+			log.Error("failed to parse backup schedule",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+
+		retention := entities.BackupRetention{Daily: cfg.Backup.KeepDaily, Weekly: cfg.Backup.KeepWeekly}
+		go worker.NewBackupJob(backupUseCase, schedule, retention, log).Run(ctx)
+	}
+
+	if cfg.Worker.ReportSnapshotEnabled {
+		schedule, err := worker.ParseSchedule(cfg.Worker.ReportSnapshotSchedule)
+		if err != nil {
+			log.Error("failed to parse report snapshot schedule",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+
+		// Every book closes its month, one after the other
+		closer := worker.MonthCloserFunc(func(ctx context.Context) error {
+			return bookUseCase.InEachBook(ctx, reportUseCase.CloseLastMonth)
+		})
+		go worker.NewReportSnapshotJob(closer, schedule, log).Run(ctx)
+	}
+
+	if cfg.Worker.BudgetAlertsEnabled {
+		schedule, err := worker.ParseSchedule(cfg.Worker.BudgetAlertsSchedule)
+		if err != nil {
+			log.Error("failed to parse budget alerts schedule",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+
+		// The budgets of every book are evaluated, one book after the other
+		alerter := worker.BudgetAlerterFunc(func(ctx context.Context) error {
+			return bookUseCase.InEachBook(ctx, func(ctx context.Context) error {
+				alerts, err := budgetUseCase.EvaluateBudgetAlerts(ctx)
+				v1.PublishBudgetAlerts(ctx, events, alerts)
+				return err
+			})
+		})
+		go worker.NewBudgetAlertJob(alerter, schedule, log).Run(ctx)
+	}
+
+	// The API calls metered in memory are added to the database every minute
+	go worker.NewUsageFlushJob(usageUseCase, time.Minute, log).Run(ctx)
+
+	// Idempotency keys are kept for a day, the expired ones deleted hourly
+	go worker.NewIdempotencyPurgeJob(idempotencyUseCase, time.Hour, log).Run(ctx)
 
 	// API Handlers V1
 	// ------------------------------------------
+	debugLogger := api.NewDebugLogger(log, cfg.Service.DebugLogging, cfg.Service.DebugLoggingRedactDescriptions)
+	routeStats := metrics.NewRouteStats()
+	trustedProxies, err := config.ParseTrustedProxies(cfg.AuthTrustedProxies)
+	if err != nil {
+		log.Error("invalid trusted proxies",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
 	apiV1 := v1.ApiHandlers{
-		AccountUseCase:     accountUseCase,
-		CategoryUseCase:    categoryUseCase,
-		TransactionUseCase: transactionUseCase,
-		BalanceUseCase:     balanceUseCase,
+		AssetUseCase:             assetUseCase,
+		BookUseCase:              bookUseCase,
+		AccountUseCase:           v1.NewPublishingAccountUseCase(accountUseCase, events),
+		FaturaUseCase:            faturaUseCase,
+		CategoryUseCase:          categoryUseCase,
+		TransactionUseCase:       v1.NewPublishingTransactionUseCase(transactionUseCase, balanceUseCase, budgetUseCase, events),
+		TransactionExportUseCase: transactionExportUseCase,
+		InstallmentUseCase:       v1.NewPublishingInstallmentUseCase(installmentUseCase, balanceUseCase, events),
+		ExpenseReportUseCase:     expenseReportUseCase,
+		InvoiceUseCase:           invoiceUseCase,
+		ProjectUseCase:           projectUseCase,
+		TagUseCase:               tagUseCase,
+		AttachmentUseCase:        attachmentUseCase,
+		BudgetUseCase:            budgetUseCase,
+		QuickCaptureUseCase:      quickCaptureUseCase,
+		ImportUseCase:            importUseCase,
+		TransactionScriptUseCase: transactionScriptUseCase,
+		RestorePointUseCase:      restorePointUseCase,
+		UserUseCase:              userUseCase,
+		BalanceUseCase:           balanceUseCase,
+		SettingsUseCase:          settingsUseCase,
+		SummaryUseCase:           summaryUseCase,
+		ReportUseCase:            reportUseCase,
+		CustomReportUseCase:      customReportUseCase,
+		SimulationUseCase:        simulationUseCase,
+		QueryUseCase:             queryUseCase,
+		UserSettingsUseCase:      userSettingsUseCase,
+		OnboardingUseCase:        onboardingUseCase,
+		DemoUseCase:              demoUseCase,
+		MigrationUseCase:         migrationUseCase,
+		UsageUseCase:             usageUseCase,
+		IdempotencyUseCase:       idempotencyUseCase,
+		DebugLogging:             debugLogger,
+		LogLevel:                 logLevel,
+		RouteStats:               routeStats,
+		Events:                   events,
+		WebSocketEnabled:         cfg.Service.WebSocketEnabled,
+		AdminEmails:              strings.Split(cfg.AuthAdminEmails, ","),
+		TrustedProxies:           trustedProxies,
+	}
+
+	middlewares := []func(http.Handler) http.Handler{routeStats.Middleware, debugLogger.Middleware}
+	if cfg.Service.RequireCurrentSchema {
+		schemaGuard := api.NewSchemaGuard(migrationUseCase, log)
+		if err := schemaGuard.Check(ctx); err != nil {
+			log.Warn("refusing writes until the database is migrated",
+				slog.String("error", err.Error()),
+			)
+		}
+		middlewares = append(middlewares, schemaGuard.Middleware)
 	}
 
-	router := api.Router(cfg)
+	router := api.Router(cfg, middlewares...)
 	apiV1.Routes(router)
 
+	// In standalone mode the web frontend is served next to the API, calling
+	// it in process
+	var handler http.Handler = router
+	if cfg.Service.Standalone {
+		mux := http.NewServeMux()
+		for _, pattern := range []string{"/api/", "/swagger/", "/debug/vars", "/health"} {
+			mux.Handle(pattern, router)
+		}
+		webHandlers := web.NewInProcessHandlers(router)
+		if cfg.Web.CaptchaSiteKey != "" {
+			if err := webHandlers.UseCaptcha(cfg.Web.CaptchaProvider, cfg.Web.CaptchaSiteKey); err != nil {
+				log.Error("invalid captcha configuration",
+					slog.String("error", err.Error()),
+				)
+				return
+			}
+		}
+		mux.Handle("/", webHandlers.Router())
+		handler = mux
+	}
+
 	// SERVER
 	// ------------------------------------------
 	server := http.Server{
-		Handler:           router,
+		Handler:           handler,
 		Addr:              cfg.Service.Address,
 		ReadHeaderTimeout: 60 * time.Second,
 	}
 	log.Info("server started",
 		slog.String("address", server.Addr),
+		slog.Bool("standalone", cfg.Service.Standalone),
 	)
 
 	if serverErr := server.ListenAndServe(); serverErr != nil && !errors.Is(serverErr, http.ErrServerClosed) {
@@ -0,0 +1,8 @@
+package main
+
+// Plugins are linked into the service by importing their package for its
+// side effects, registering their importers, notification channels and rule
+// actions, see the plugin package.
+import (
+// _ "example.com/finance-plugins/nubank"
+)
@@ -39,11 +39,28 @@ func main() {
 		slog.Int("runtime_num_cpu", runtime.NumCPU()),
 	)
 
+	report := cfg.Validate()
+	report.Log(log)
+	if err := report.Err(); err != nil {
+		log.Error("invalid configuration",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
 	// API base URL configuration
 	apiBaseURL := cfg.Web.ApiBaseURL
 
 	// Web handlers - now only needs API base URL
 	webHandlers := web.NewHandlers(apiBaseURL)
+	if cfg.Web.CaptchaSiteKey != "" {
+		if err := webHandlers.UseCaptcha(cfg.Web.CaptchaProvider, cfg.Web.CaptchaSiteKey); err != nil {
+			log.Error("invalid captcha configuration",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+	}
 
 	// Server
 	server := http.Server{
@@ -26,7 +26,7 @@ const docTemplate = `{
     "paths": {
         "/accounts": {
             "get": {
-                "description": "Retrieve a list of all financial accounts",
+                "description": "Retrieve a page of the financial accounts with the total number of accounts and links to the next and previous pages",
                 "consumes": [
                     "application/json"
                 ],
@@ -37,14 +37,49 @@ const docTemplate = `{
                     "accounts"
                 ],
                 "summary": "Get all accounts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Related resources to embed (balance)",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to return for each account",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields (name, type, created_at, transaction_count, last_transaction_date), prefix with - for descending",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default and at most 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of accounts to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "Accounts retrieved successfully",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/v1.AccountResponse"
-                            }
+                            "$ref": "#/definitions/v1.PageResponse-v1_AccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
                     },
                     "500": {
@@ -90,6 +125,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
+                    },
+                    "402": {
+                        "description": "Quota of accounts of the free tier reached",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
                     }
                 }
             }
@@ -114,6 +161,12 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Related resources to embed (balance)",
+                        "name": "include",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -134,6 +187,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
                     }
                 }
             },
@@ -185,6 +244,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
+                    },
+                    "409": {
+                        "description": "Asset or liability type can't change once the account has transactions",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
                     }
                 }
             },
@@ -228,9 +299,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/balances": {
+        "/accounts/{id}/faturas": {
             "get": {
-                "description": "Retrieve a list of all account balances",
+                "description": "List the monthly statements (faturas) of a credit card account, newest first: the future ones holding installments, the open one and the closed ones. The account needs its statement closing and payment due days",
                 "consumes": [
                     "application/json"
                 ],
@@ -238,21 +309,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "balances"
+                    "accounts"
+                ],
+                "summary": "List credit card faturas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get all balances",
                 "responses": {
                     "200": {
-                        "description": "Balances retrieved successfully",
+                        "description": "Faturas retrieved successfully",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/v1.BalanceResponse"
+                                "$ref": "#/definitions/v1.FaturaResponse"
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Account without a billing cycle",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
@@ -260,9 +352,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/balances/summary": {
+        "/accounts/{id}/faturas/{month}": {
             "get": {
-                "description": "Retrieve a summary of all account balances including total assets, liabilities, and net worth",
+                "description": "Retrieve the fatura of a credit card account due in a month, with its transactions oldest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -270,18 +362,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "balances"
+                    "accounts"
+                ],
+                "summary": "Get credit card fatura",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month the fatura is due (YYYY-MM)",
+                        "name": "month",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get balance summary",
                 "responses": {
                     "200": {
-                        "description": "Balance summary retrieved successfully",
+                        "description": "Fatura retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/v1.BalanceSummaryResponse"
+                            "$ref": "#/definitions/v1.FaturaResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Account without a billing cycle",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
@@ -289,9 +409,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/balances/{accountId}": {
+        "/accounts/{id}/period-summary": {
             "get": {
-                "description": "Retrieve the balance information for a specific account",
+                "description": "Get the opening balance, total in, total out and closing balance of an account between from and to, counting cleared transactions. to defaults to today and from to the first day of its month",
                 "consumes": [
                     "application/json"
                 ],
@@ -299,23 +419,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "balances"
+                    "accounts"
                 ],
-                "summary": "Get balance by account ID",
+                "summary": "Get account period summary",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Account ID",
-                        "name": "accountId",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of the period (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the period (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Balance retrieved successfully",
+                        "description": "Period summary retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/v1.BalanceResponse"
+                            "$ref": "#/definitions/v1.AccountPeriodSummaryResponse"
                         }
                     },
                     "400": {
@@ -325,7 +457,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Balance not found",
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
@@ -333,9 +471,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/balances/{accountId}/refresh": {
-            "post": {
-                "description": "Recalculate and refresh the balance for a specific account",
+        "/accounts/{id}/statement": {
+            "get": {
+                "description": "List the cleared transactions of an account between from and to, oldest first, with the running balance after each one. to defaults to today and from to the first day of its month. With a project_id only the transactions of that project are listed, the balances are still the account's",
                 "consumes": [
                     "application/json"
                 ],
@@ -343,21 +481,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "balances"
+                    "accounts"
                 ],
-                "summary": "Refresh account balance",
+                "summary": "Get account statement",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Account ID",
-                        "name": "accountId",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of the statement (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the statement (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only transactions filed under this project",
+                        "name": "project_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Balance refreshed successfully"
+                    "200": {
+                        "description": "Statement retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountStatementResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad request",
@@ -370,13 +529,19 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
                     }
                 }
             }
         },
-        "/categories": {
+        "/admin/debug-logging": {
             "get": {
-                "description": "Retrieve a list of all transaction categories",
+                "description": "Report whether the API request and response bodies are logged, and whether descriptions are redacted from them on top of tokens, secrets and passwords",
                 "consumes": [
                     "application/json"
                 ],
@@ -384,29 +549,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
+                    "admin"
                 ],
-                "summary": "Get all categories",
+                "summary": "Get debug logging",
                 "responses": {
                     "200": {
-                        "description": "Categories retrieved successfully",
+                        "description": "Debug logging retrieved successfully",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/v1.CategoryResponse"
-                            }
+                            "$ref": "#/definitions/v1.DebugLoggingResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Not an operator of the deployment",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
                     }
                 }
             },
-            "post": {
-                "description": "Create a new transaction category with the provided details",
+            "put": {
+                "description": "Switch the logging of the API request and response bodies at runtime. Tokens, secrets and passwords are always redacted, descriptions only when redact_descriptions is set.",
                 "consumes": [
                     "application/json"
                 ],
@@ -414,25 +576,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
+                    "admin"
                 ],
-                "summary": "Create a new category",
+                "summary": "Update debug logging",
                 "parameters": [
                     {
-                        "description": "Category data",
-                        "name": "category",
+                        "description": "Debug logging switches",
+                        "name": "debug_logging",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.CreateCategoryRequest"
+                            "$ref": "#/definitions/v1.UpdateDebugLoggingRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Category created successfully",
+                    "200": {
+                        "description": "Debug logging updated successfully",
                         "schema": {
-                            "$ref": "#/definitions/v1.CategoryResponse"
+                            "$ref": "#/definitions/v1.DebugLoggingResponse"
                         }
                     },
                     "400": {
@@ -440,13 +602,25 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
+                    },
+                    "403": {
+                        "description": "Not an operator of the deployment",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
                     }
                 }
             }
         },
-        "/categories/{id}": {
+        "/admin/log-level": {
             "get": {
-                "description": "Retrieve a specific category by its unique identifier",
+                "description": "Report the current log level, the level the service was configured with and, when the current level is temporary, when it reverts",
                 "consumes": [
                     "application/json"
                 ],
@@ -454,33 +628,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
-                ],
-                "summary": "Get category by ID",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Category ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "admin"
                 ],
+                "summary": "Get log level",
                 "responses": {
                     "200": {
-                        "description": "Category retrieved successfully",
-                        "schema": {
-                            "$ref": "#/definitions/v1.CategoryResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
+                        "description": "Log level retrieved successfully",
                         "schema": {
-                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                            "$ref": "#/definitions/v1.LogLevelResponse"
                         }
                     },
-                    "404": {
-                        "description": "Category not found",
+                    "403": {
+                        "description": "Not an operator of the deployment",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
@@ -488,7 +647,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update an existing category with new information",
+                "description": "Change the log level (debug, info, warn or error) at runtime. With a duration, such as 30m, the level reverts to the configured one when it's up; without, it's kept until changed again or the service restarts.",
                 "consumes": [
                     "application/json"
                 ],
@@ -496,32 +655,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
+                    "admin"
                 ],
-                "summary": "Update category",
+                "summary": "Update log level",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Category ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated category data",
-                        "name": "category",
+                        "description": "Log level",
+                        "name": "log_level",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.UpdateCategoryRequest"
+                            "$ref": "#/definitions/v1.UpdateLogLevelRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Category updated successfully",
+                        "description": "Log level updated successfully",
                         "schema": {
-                            "$ref": "#/definitions/v1.CategoryResponse"
+                            "$ref": "#/definitions/v1.LogLevelResponse"
                         }
                     },
                     "400": {
@@ -530,16 +682,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
                     },
-                    "404": {
-                        "description": "Category not found",
+                    "403": {
+                        "description": "Not an operator of the deployment",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a category by its ID",
+            }
+        },
+        "/admin/migrations": {
+            "get": {
+                "description": "Compare the schema version recorded in the database with the migrations shipped with the service. While it's behind or dirty, writes are refused with 503 unless SERVICE_REQUIRE_CURRENT_SCHEMA is false.",
                 "consumes": [
                     "application/json"
                 ],
@@ -547,30 +707,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "categories"
-                ],
-                "summary": "Delete category",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Category ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "admin"
                 ],
+                "summary": "Get migration status",
                 "responses": {
-                    "204": {
-                        "description": "Category deleted successfully"
-                    },
-                    "400": {
-                        "description": "Bad request",
+                    "200": {
+                        "description": "Migration status retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.MigrationStatusResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an operator of the deployment",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
                     },
-                    "404": {
-                        "description": "Category not found",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
@@ -578,29 +732,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/health": {
+        "/admin/stats": {
             "get": {
-                "description": "Check if the service is healthy and running",
+                "description": "Request counts, client (4xx) and server (5xx) errors, error rate (share of 5xx), estimated p95 and max latency per route over the last 5 minutes, 15 minutes and hour, busiest routes first. Kept in memory, so they reset when the service restarts.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "admin"
                 ],
-                "summary": "Health check",
+                "summary": "Get route stats",
                 "responses": {
                     "200": {
-                        "description": "Service is healthy"
+                        "description": "Route stats retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.StatsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an operator of the deployment",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
                     }
                 }
             }
         },
-        "/transactions": {
+        "/assets": {
             "get": {
-                "description": "Retrieve a list of all financial transactions with pagination (limit: 50, offset: 0)",
+                "description": "List the assets accounts, books and settings can be kept in, with their symbol, precision and display name: the built-in currencies first, then the custom assets of this deployment by code",
                 "consumes": [
                     "application/json"
                 ],
@@ -608,16 +771,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "assets"
                 ],
-                "summary": "Get all transactions",
+                "summary": "List assets",
                 "responses": {
                     "200": {
-                        "description": "Transactions retrieved successfully",
+                        "description": "Assets retrieved successfully",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/v1.TransactionResponse"
+                                "$ref": "#/definitions/v1.AssetResponse"
                             }
                         }
                     },
@@ -630,7 +793,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "Create a new financial transaction with the provided details",
+                "description": "Enable a custom asset for this deployment, like loyalty points or airline miles, so accounts can be kept in it. The code is 2 to 10 letters or digits starting with a letter. Custom assets can't be disabled once enabled",
                 "consumes": [
                     "application/json"
                 ],
@@ -638,25 +801,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "assets"
                 ],
-                "summary": "Create a new transaction",
+                "summary": "Enable custom asset",
                 "parameters": [
                     {
-                        "description": "Transaction data",
-                        "name": "transaction",
+                        "description": "Asset data",
+                        "name": "asset",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.CreateTransactionRequest"
+                            "$ref": "#/definitions/v1.AssetRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Transaction created successfully",
+                        "description": "Asset enabled successfully",
                         "schema": {
-                            "$ref": "#/definitions/v1.TransactionResponse"
+                            "$ref": "#/definitions/v1.AssetResponse"
                         }
                     },
                     "400": {
@@ -664,13 +827,60 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
+                    },
+                    "403": {
+                        "description": "Not an operator of the deployment",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Asset code already taken",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
                     }
                 }
             }
         },
-        "/transactions/{id}": {
+        "/auth/events": {
             "get": {
-                "description": "Retrieve a specific transaction by its unique identifier",
+                "description": "List the latest suspicious sign ins on the account of the signed in user, the newest first: failed logins, logins refused while locked out, lockouts and wrong CAPTCHA answers",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "List auth events",
+                "responses": {
+                    "200": {
+                        "description": "Events retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.AuthEventResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Sign in with an email and password, returning the bearer token the other endpoints are called with. After repeated failures the email, or the address, is locked out for a while, and before that a CAPTCHA may be asked for: the login answers 401 with \"captcha\" as the parameter, to try again with the answer of the widget.",
                 "consumes": [
                     "application/json"
                 ],
@@ -678,23 +888,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "auth"
                 ],
-                "summary": "Get transaction by ID",
+                "summary": "Login",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Transaction ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Credentials",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.LoginRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Transaction retrieved successfully",
+                        "description": "Signed in",
                         "schema": {
-                            "$ref": "#/definitions/v1.TransactionResponse"
+                            "$ref": "#/definitions/v1.AuthResponse"
                         }
                     },
                     "400": {
@@ -703,16 +915,70 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
                     },
-                    "404": {
-                        "description": "Transaction not found",
+                    "401": {
+                        "description": "Invalid email or password, or CAPTCHA required",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "429": {
+                        "description": "Locked out after repeated failures, until the Retry-After seconds passed",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update an existing transaction with new information",
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "description": "Sign out, revoking the session of the token used",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Logout",
+                "responses": {
+                    "204": {
+                        "description": "Signed out"
+                    },
+                    "401": {
+                        "description": "Missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/me": {
+            "get": {
+                "description": "Retrieve the user the bearer token was issued to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Current user",
+                "responses": {
+                    "200": {
+                        "description": "User retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/register": {
+            "post": {
+                "description": "Create a user and sign them in. The first user takes over the books recorded before users existed, the next ones start with an empty book of their own",
                 "consumes": [
                     "application/json"
                 ],
@@ -720,32 +986,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "auth"
                 ],
-                "summary": "Update transaction",
+                "summary": "Register",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Transaction ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated transaction data",
-                        "name": "transaction",
+                        "description": "User data",
+                        "name": "user",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.UpdateTransactionRequest"
+                            "$ref": "#/definitions/v1.RegisterRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Transaction updated successfully",
+                    "201": {
+                        "description": "User registered",
                         "schema": {
-                            "$ref": "#/definitions/v1.TransactionResponse"
+                            "$ref": "#/definitions/v1.AuthResponse"
                         }
                     },
                     "400": {
@@ -754,30 +1013,85 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
                     },
-                    "404": {
-                        "description": "Transaction not found",
+                    "409": {
+                        "description": "Email already registered",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete a transaction by its ID",
-                "consumes": [
+            }
+        },
+        "/auth/sessions": {
+            "get": {
+                "description": "List the active sessions of the signed in user, the last seen first, flagging the one of the token used",
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "List sessions",
+                "responses": {
+                    "200": {
+                        "description": "Sessions retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.SessionResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Sign the user out of every session but the one of the token used",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "auth"
                 ],
-                "summary": "Delete transaction",
+                "summary": "Revoke other sessions",
+                "responses": {
+                    "200": {
+                        "description": "Sessions revoked",
+                        "schema": {
+                            "$ref": "#/definitions/v1.RevokedSessionsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sessions/{id}": {
+            "delete": {
+                "description": "Sign the user out of one of their sessions, its token is refused from then on",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Revoke session",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Transaction ID",
+                        "description": "Session ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -785,139 +1099,10410 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "204": {
-                        "description": "Transaction deleted successfully"
+                        "description": "Session revoked"
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Invalid session ID",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing or invalid token",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
                     },
                     "404": {
-                        "description": "Transaction not found",
+                        "description": "Session not found",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponseBody"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "entities.AccountType": {
-            "type": "string",
-            "enum": [
-                "checking",
-                "savings",
-                "credit",
-                "investment",
-                "cash"
-            ],
-            "x-enum-varnames": [
-                "AccountTypeChecking",
-                "AccountTypeSavings",
-                "AccountTypeCredit",
-                "AccountTypeInvestment",
-                "AccountTypeCash"
-            ]
-        },
-        "entities.CategoryType": {
-            "type": "string",
-            "enum": [
-                "income",
-                "expense"
-            ],
-            "x-enum-varnames": [
-                "CategoryTypeIncome",
-                "CategoryTypeExpense"
-            ]
-        },
-        "entities.TransactionStatus": {
-            "type": "string",
-            "enum": [
-                "pending",
-                "cleared",
-                "cancelled"
-            ],
-            "x-enum-varnames": [
-                "TransactionStatusPending",
-                "TransactionStatusCleared",
-                "TransactionStatusCancelled"
-            ]
         },
-        "v1.AccountResponse": {
-            "type": "object",
-            "properties": {
-                "asset": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "type": {
-                    "$ref": "#/definitions/entities.AccountType"
-                },
-                "updated_at": {
+        "/balances": {
+            "get": {
+                "description": "Retrieve a page of the account balances by account, including how much each changed over the last 7 and 30 days, with the total number of balances and links to the next and previous pages",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "balances"
+                ],
+                "summary": "Get all balances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Related resources to embed (account)",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to return for each balance",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default and at most 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of balances to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Balances retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PageResponse-v1_BalanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/balances/grouped": {
+            "get": {
+                "description": "Group the accounts and their balances by institution and/or type, with the net of the current balances of each group per asset (liabilities subtract). Groups are ordered by institution, accounts without one last, then by type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "balances"
+                ],
+                "summary": "Get grouped balances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to group by (institution, type), defaults to institution,type",
+                        "name": "by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Grouped balances retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.BalanceGroupResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/balances/refresh": {
+            "post": {
+                "description": "Start recalculating the balance of every account of the book in the background. Track the progress with GET /balances/refresh-status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "balances"
+                ],
+                "summary": "Refresh all balances",
+                "responses": {
+                    "202": {
+                        "description": "Balance refresh started",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BalanceRefreshStatusResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A balance refresh is already running",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/balances/refresh-status": {
+            "get": {
+                "description": "Retrieve the per account progress of the running or last refresh of all balances of the book, comparing the stored balances with the recalculated ones",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "balances"
+                ],
+                "summary": "Get balance refresh status",
+                "responses": {
+                    "200": {
+                        "description": "Balance refresh status retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BalanceRefreshStatusResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "No balance refresh has run",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/balances/summary": {
+            "get": {
+                "description": "Retrieve a summary of all account balances including total assets, liabilities, and net worth. Accounts in custom assets, like loyalty points and miles, are left out of the totals and listed in custom_assets with their net balance per asset",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "balances"
+                ],
+                "summary": "Get balance summary",
+                "responses": {
+                    "200": {
+                        "description": "Balance summary retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BalanceSummaryResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/balances/{accountId}": {
+            "get": {
+                "description": "Retrieve the balance information for a specific account, including how much it changed over the last 7 and 30 days",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "balances"
+                ],
+                "summary": "Get balance by account ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "accountId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Related resources to embed (account)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Balance retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BalanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Balance not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/balances/{accountId}/history": {
+            "get": {
+                "description": "Retrieve the current balance of an account at the end of each day, or of each month, of a period, for charting. The period defaults to the last 30 days, or the last 12 months by month. Days without a snapshot have the balance of the last one before them, and the days before the first snapshot have no point.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "balances"
+                ],
+                "summary": "Get balance history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "accountId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "day",
+                            "month"
+                        ],
+                        "type": "string",
+                        "default": "day",
+                        "description": "Distance between the points",
+                        "name": "granularity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD), today by default",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Balance history retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BalanceHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/balances/{accountId}/refresh": {
+            "post": {
+                "description": "Recalculate and refresh the balance for a specific account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "balances"
+                ],
+                "summary": "Refresh account balance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "accountId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Balance refreshed successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/books": {
+            "get": {
+                "description": "List the books ordered by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "books"
+                ],
+                "summary": "List books",
+                "responses": {
+                    "200": {
+                        "description": "Books retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.BookResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a book, a separate ledger with its own accounts and categories, reported in its base currency. Requests pick it with the X-Book-Id header",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "books"
+                ],
+                "summary": "Create book",
+                "parameters": [
+                    {
+                        "description": "Book data",
+                        "name": "book",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.BookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Book created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Book name already taken",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/books/{id}": {
+            "get": {
+                "description": "Retrieve a book by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "books"
+                ],
+                "summary": "Get book",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Book ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Book retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Book not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Rename a book or change its description and base currency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "books"
+                ],
+                "summary": "Update book",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Book ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Book data",
+                        "name": "book",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.BookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Book updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Book not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Book name already taken",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a book without accounts or categories left. The default book can't be deleted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "books"
+                ],
+                "summary": "Delete book",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Book ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Book deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Book not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Book still in use or the default book",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/budget-templates": {
+            "get": {
+                "description": "List the budget templates of the book, ordered by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "List budget templates",
+                "responses": {
+                    "200": {
+                        "description": "Budget templates retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.BudgetTemplateResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Save a named set of category limits to set up months with. A template limits a category once at most",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Create budget template",
+                "parameters": [
+                    {
+                        "description": "Budget template",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.BudgetTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Budget template created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BudgetTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Template name already taken",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/budget-templates/{id}": {
+            "get": {
+                "description": "Retrieve a budget template by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Get budget template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Budget template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Budget template retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BudgetTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Budget template not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Rename a budget template and replace its limits. The budgets set up with it are kept as they are",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Update budget template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Budget template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Budget template",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.BudgetTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Budget template updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BudgetTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Budget template or category not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Template name already taken",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a budget template, the budgets set up with it are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Delete budget template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Budget template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Budget template deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Budget template not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/budget-templates/{id}/apply": {
+            "post": {
+                "description": "Set up a month with the limits of a template, each as a budget for that month alone. The categories already budgeted in the month are skipped and keep their budget, like the ones deleted since the template was saved. All the budgets are created or none",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Apply budget template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Budget template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month (YYYY-MM), the current one by default",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Budget template applied successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BudgetCopyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid month",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Budget template not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Category already has a budget in the month",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/budgets": {
+            "get": {
+                "description": "List the budgets ordered by the type and name of their category, then by the month they start",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "List budgets",
+                "responses": {
+                    "200": {
+                        "description": "Budgets retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.BudgetResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Set the monthly limit of a category, in the currency of the accounts it's spent from. The budgets of a category can't overlap",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Create budget",
+                "parameters": [
+                    {
+                        "description": "Budget data",
+                        "name": "budget",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.BudgetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Budget created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BudgetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Category already has a budget in those months",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/budgets/alerts": {
+            "get": {
+                "description": "List the alerts the budgets fired, the latest first. A budget fires an alert the first time each month its category takes one of its thresholds, a percent of the limit. The budgets of the current month are evaluated whenever transactions change through the API, and on the schedule of the budget alerts job",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Budget alert history",
+                "responses": {
+                    "200": {
+                        "description": "Budget alerts retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.BudgetAlertResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/budgets/copy": {
+            "post": {
+                "description": "Copy the limits of the budgets applying in a month to another, each as a budget for that month alone. The categories already budgeted in the month copied to are skipped and keep their budget. All the budgets are created or none",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Copy budgets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Month to copy from (YYYY-MM)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month to copy to (YYYY-MM)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Budgets copied successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BudgetCopyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid month",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Category already has a budget in the month",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/budgets/progress": {
+            "get": {
+                "description": "Add up the cleared transactions of each budgeted category in a month, in the currency of its budget, against the limit. The budget of a category grouping others counts theirs too, with a breakdown per category. Only the budgets applying in the month are reported",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Budget progress",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Month (YYYY-MM), the current one by default",
+                        "name": "month",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Progress retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.BudgetProgressResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid month",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/budgets/{id}": {
+            "get": {
+                "description": "Retrieve a budget by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Get budget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Budget ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Budget retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BudgetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Budget not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Change the category, limit, months and alert thresholds of a budget",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Update budget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Budget ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Budget data",
+                        "name": "budget",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.BudgetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Budget updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BudgetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Budget or category not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Category already has a budget in those months",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a budget, its category is kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "budgets"
+                ],
+                "summary": "Delete budget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Budget ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Budget deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Budget not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "description": "Retrieve a page of the transaction categories with the total number of categories and links to the next and previous pages",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Get all categories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to return for each category",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields (name, type, created_at), prefix with - for descending",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default and at most 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of categories to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Categories retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PageResponse-v1_CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new transaction category with the provided details. A parent groups it under another category of the same type, one level deep",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Create a new category",
+                "parameters": [
+                    {
+                        "description": "Category data",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Category created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Parent category not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{id}": {
+            "get": {
+                "description": "Retrieve a specific category by its unique identifier",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Get category by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Category retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing category with new information. A category grouping others can't get a parent or change its type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Update category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated category data",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdateCategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Category updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a category by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Delete category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Category deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/demo": {
+            "get": {
+                "description": "Report whether the sample dataset is loaded and which accounts belong to it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "demo"
+                ],
+                "summary": "Get demo status",
+                "responses": {
+                    "200": {
+                        "description": "Demo status retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DemoStatusResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an operator of the deployment",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create sample accounts with about three months of transactions in the base currency. Only available before any account exists.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "demo"
+                ],
+                "summary": "Start demo",
+                "responses": {
+                    "201": {
+                        "description": "Demo data loaded successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DemoStatusResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an operator of the deployment",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Demo data already loaded or accounts exist",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete the sample accounts and their transactions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "demo"
+                ],
+                "summary": "Stop demo",
+                "responses": {
+                    "204": {
+                        "description": "Demo data removed successfully"
+                    },
+                    "403": {
+                        "description": "Not an operator of the deployment",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Demo data not loaded",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/expense-reports": {
+            "get": {
+                "description": "List the expense reports, the most recent period first, with their transactions and totals",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense-reports"
+                ],
+                "summary": "List expense reports",
+                "responses": {
+                    "200": {
+                        "description": "Expense reports retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.ExpenseReportResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create an empty expense report, as a draft, for the expenses of a period like a business trip",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense-reports"
+                ],
+                "summary": "Create expense report",
+                "parameters": [
+                    {
+                        "description": "Expense report data",
+                        "name": "report",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Expense report created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/expense-reports/{id}": {
+            "get": {
+                "description": "Retrieve an expense report with its transactions and totals",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense-reports"
+                ],
+                "summary": "Get expense report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Expense report retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Expense report not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Change the name, period and notes of a draft expense report. The period has to keep the transactions already on the report",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense-reports"
+                ],
+                "summary": "Update expense report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Expense report data",
+                        "name": "report",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Expense report updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Expense report not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Expense report not a draft or transactions outside the period",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an expense report. Its transactions are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense-reports"
+                ],
+                "summary": "Delete expense report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Expense report deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Expense report not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/expense-reports/{id}/approve": {
+            "post": {
+                "description": "Approve a submitted expense report, which is final",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense-reports"
+                ],
+                "summary": "Approve expense report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Expense report approved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Expense report not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Expense report not submitted",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/expense-reports/{id}/export": {
+            "get": {
+                "description": "Download an expense report as a CSV or PDF file listing its transactions and totals",
+                "produces": [
+                    "text/csv",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "expense-reports"
+                ],
+                "summary": "Export expense report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "csv",
+                        "description": "Export format, csv or pdf",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Expense report file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Expense report not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/expense-reports/{id}/reject": {
+            "post": {
+                "description": "Reject a submitted expense report, which can be reopened to be fixed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense-reports"
+                ],
+                "summary": "Reject expense report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Expense report rejected successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Expense report not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Expense report not submitted",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/expense-reports/{id}/reopen": {
+            "post": {
+                "description": "Take a submitted or rejected expense report back to draft so it can be changed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense-reports"
+                ],
+                "summary": "Reopen expense report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Expense report reopened successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Expense report not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Expense report approved or already a draft",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/expense-reports/{id}/submit": {
+            "post": {
+                "description": "Submit a draft expense report with transactions for review, after which it can't be changed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense-reports"
+                ],
+                "summary": "Submit expense report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Expense report submitted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Expense report not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Expense report not a draft or without transactions",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/expense-reports/{id}/transactions": {
+            "post": {
+                "description": "Put a pending or cleared expense dated within the period of a draft expense report on it. A transaction is on one report at most",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense-reports"
+                ],
+                "summary": "Add transaction to expense report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transaction to add",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AddExpenseReportTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transaction added successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Expense report or transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Expense report not a draft or transaction already on a report",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/expense-reports/{id}/transactions/{transaction_id}": {
+            "delete": {
+                "description": "Take a transaction off a draft expense report, the transaction is kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense-reports"
+                ],
+                "summary": "Remove transaction from expense report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Expense report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "transaction_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transaction removed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExpenseReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Expense report or transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Expense report not a draft",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/exports": {
+            "get": {
+                "description": "List the exports not expired yet, the latest first, each with a freshly signed url",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "exports"
+                ],
+                "summary": "Get exports",
+                "responses": {
+                    "200": {
+                        "description": "Exports retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.ExportResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Export every transaction matching the filters like GET /transactions/export, writing the file to the file store instead of sending it. The file is downloaded from its url, a link to the bucket signed for 15 minutes when the attachments are kept in S3, and deleted a week after it's made.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "exports"
+                ],
+                "summary": "Create export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "csv",
+                        "description": "Export format, csv or xlsx",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields (date, amount, description, status, created_at), prefix with - for descending",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only transactions filed under this project",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only transactions with this tag",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Export created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/exports/{id}": {
+            "get": {
+                "description": "Get an export with a freshly signed url",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "exports"
+                ],
+                "summary": "Get export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Export retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ExportResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Export not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an export and its file before it expires",
+                "tags": [
+                    "exports"
+                ],
+                "summary": "Delete export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Export deleted successfully"
+                    },
+                    "404": {
+                        "description": "Export not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/exports/{id}/download": {
+            "get": {
+                "description": "Download the file of an export, redirected to a signed link to the bucket when the attachments are kept in S3",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "exports"
+                ],
+                "summary": "Download export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transactions file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "302": {
+                        "description": "Redirect to the signed link"
+                    },
+                    "404": {
+                        "description": "Export not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Check if the service is healthy and running",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "Service is healthy"
+                    }
+                }
+            }
+        },
+        "/imports/{source}": {
+            "post": {
+                "description": "Import the CSV statement export of Wise or Revolut, sent as the request body. Multi-currency statements are split by currency, each going to the account picked in accounts, else to the account of the provider's institution in that currency, created as \"Wise GBP\" when there is none. Fees become transactions of their own, lines already in the account are skipped, those the transaction scripts reject are counted and left out, and the closing balance of the statement is returned next to the account balance. The rows the import changed are recorded in the restore point returned, to roll it back with",
+                "consumes": [
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Import a statement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Statement source (wise, revolut)",
+                        "name": "source",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category of the money going out",
+                        "name": "expense_category_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category of the money coming in",
+                        "name": "income_category_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category of the fees, the expense category by default",
+                        "name": "fee_category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Accounts of the currencies, like GBP:id,USD:id",
+                        "name": "accounts",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Parse the statement without importing it",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "CSV export",
+                        "name": "statement",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Statement parsed, on dry runs",
+                        "schema": {
+                            "$ref": "#/definitions/v1.StatementImportResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Statement imported",
+                        "schema": {
+                            "$ref": "#/definitions/v1.StatementImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Malformed statement or parameters",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "402": {
+                        "description": "Quota of the free tier reached",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Unknown source, category or account",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Statement too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "429": {
+                        "description": "Monthly quota reached, until the Retry-After seconds passed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/installments": {
+            "get": {
+                "description": "List the purchases paid in installments, newest first, with how many installments were paid and what's left to charge",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "installments"
+                ],
+                "summary": "List installment plans",
+                "responses": {
+                    "200": {
+                        "description": "Installment plans retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.InstallmentPlanResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/installments/commitments": {
+            "get": {
+                "description": "Add up the installments still to be charged by all the installment plans, per asset and month",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "installments"
+                ],
+                "summary": "Remaining installment commitments",
+                "responses": {
+                    "200": {
+                        "description": "Commitments retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.InstallmentCommitmentsResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/installments/{id}": {
+            "get": {
+                "description": "Retrieve a purchase paid in installments with its installments in order",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "installments"
+                ],
+                "summary": "Get installment plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Installment plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Installment plan retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InstallmentPlanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Installment plan not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a purchase paid in installments, moving all its installments to the trash",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "installments"
+                ],
+                "summary": "Delete installment plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Installment plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Installment plan deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Installment plan not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/installments/{id}/payoff": {
+            "get": {
+                "description": "Calculate what paying the installments dated after a day in advance costs. Each one is discounted at the monthly rate, in percent, for every month it's brought forward",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "installments"
+                ],
+                "summary": "Quote installment plan payoff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Installment plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payoff date (YYYY-MM-DD), defaults to today",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Monthly discount rate in percent, like 1.99",
+                        "name": "monthly_rate",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payoff calculated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InstallmentPayoffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Installment plan not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Installment plan paid off or without installments left",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Replace the installments dated after the payoff date by a single pending transaction for the discounted amount, numbered like \"TV (4-12/12)\", and mark the plan paid off",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "installments"
+                ],
+                "summary": "Pay off installment plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Installment plan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payoff date and monthly discount rate",
+                        "name": "payoff",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PayOffInstallmentPlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Installment plan paid off successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InstallmentPayoffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Installment plan not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Installment plan paid off or without installments left",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices": {
+            "get": {
+                "description": "List the invoices, the latest due first, with their status and payment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invoices"
+                ],
+                "summary": "List invoices",
+                "parameters": [
+                    {
+                        "enum": [
+                            "outstanding",
+                            "overdue",
+                            "paid"
+                        ],
+                        "type": "string",
+                        "description": "Only invoices in this status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invoices retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.InvoiceResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Record an invoice issued to a client, to be paid into an account in its currency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invoices"
+                ],
+                "summary": "Create invoice",
+                "parameters": [
+                    {
+                        "description": "Invoice data",
+                        "name": "invoice",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.InvoiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Invoice created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/receivables": {
+            "get": {
+                "description": "Add up the unpaid invoices per currency and client, next to the net balance of the accounts in each currency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invoices"
+                ],
+                "summary": "Outstanding receivables",
+                "responses": {
+                    "200": {
+                        "description": "Receivables retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.ReceivablesResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/{id}": {
+            "get": {
+                "description": "Retrieve an invoice with its status and payment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invoices"
+                ],
+                "summary": "Get invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invoice retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Invoice not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Change the details of an invoice. A paid invoice can't move to another account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invoices"
+                ],
+                "summary": "Update invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Invoice data",
+                        "name": "invoice",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.InvoiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invoice updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Invoice or account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Paid invoice moved to another account",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an invoice, the transaction that paid it is kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invoices"
+                ],
+                "summary": "Delete invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Invoice deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Invoice not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/invoices/{id}/match": {
+            "post": {
+                "description": "Record the income transaction on the invoice account that paid it. Without a transaction ID, the earliest one for the exact amount since the issue date is picked",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invoices"
+                ],
+                "summary": "Match invoice payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transaction that paid the invoice",
+                        "name": "payment",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.MatchInvoiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invoice matched successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Invoice or matching transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Invoice already paid or transaction paying another invoice",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Forget the transaction that paid an invoice, making it receivable again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "invoices"
+                ],
+                "summary": "Unmatch invoice payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Invoice unmatched successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Invoice not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Invoice not paid",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/meta/schema": {
+            "get": {
+                "description": "Describe the entities returned by the API with their fields, the values of the enums like account types and transaction statuses, and the currencies supported by this deployment, custom ones included, so tools can adapt to them instead of hardcoding them. The version is the latest migration shipped with the service.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "meta"
+                ],
+                "summary": "Get schema",
+                "responses": {
+                    "200": {
+                        "description": "Schema retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SchemaResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/onboarding/status": {
+            "get": {
+                "description": "Report which first-run steps are done (base_currency, categories, account). Onboarding is completed once the first account exists.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "onboarding"
+                ],
+                "summary": "Get onboarding status",
+                "responses": {
+                    "200": {
+                        "description": "Onboarding status retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.OnboardingStatusResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects": {
+            "get": {
+                "description": "List the projects ordered by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "List projects",
+                "responses": {
+                    "200": {
+                        "description": "Projects retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.ProjectResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a project, optionally done for a client, to file transactions under",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Create project",
+                "parameters": [
+                    {
+                        "description": "Project data",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ProjectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Project created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ProjectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Project name already taken",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/profit": {
+            "get": {
+                "description": "Add up the income and expenses of the pending and cleared transactions of every project, per currency, between from and to. Without dates the whole history is added up",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Profit per project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day of the period (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the period (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Profit retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.ProjectProfitResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}": {
+            "get": {
+                "description": "Retrieve a project by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Get project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ProjectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Change the name, client and description of a project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Update project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Project data",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ProjectRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Project updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ProjectResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Project name already taken",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a project, its transactions are kept outside of any project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Delete project",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Project deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{id}/profit": {
+            "get": {
+                "description": "Add up the income and expenses of the pending and cleared transactions of a project, per currency, between from and to. Without dates the whole history is added up",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "projects"
+                ],
+                "summary": "Project profit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of the period (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the period (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Profit retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ProjectProfitResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Project not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/query": {
+            "post": {
+                "description": "Answer a natural language question like \"how much did I spend on food in March\", \"income last month\", \"what's my net worth\" or \"Checking balance\" by translating it into a report. The question is matched against known words: spend, earn, net worth or balance, a period (today, this week, last month, March 2025, past 30 days, 2024, the current month by default) and category and account names",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Ask a question",
+                "parameters": [
+                    {
+                        "description": "Question",
+                        "name": "query",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.QueryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Answer",
+                        "schema": {
+                            "$ref": "#/definitions/v1.QueryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Question not understood",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/quick": {
+            "post": {
+                "description": "Parse a free-text note like \"coffee 12.50 food\" into the amount, description, category and account, then create the transaction. The amount is the last number in the text, and the category and account are the ones named in it or suggested by the latest transaction with the same description. Meant for iOS Shortcuts and voice assistants",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Quick capture a transaction",
+                "parameters": [
+                    {
+                        "description": "Note to capture",
+                        "name": "capture",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.QuickCaptureRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Parsed without creating, on dry runs",
+                        "schema": {
+                            "$ref": "#/definitions/v1.QuickCaptureResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Transaction created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.QuickCaptureResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Text without an amount or a category",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "402": {
+                        "description": "Quota of the free tier reached",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "429": {
+                        "description": "Monthly quota reached, until the Retry-After seconds passed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/budget-history": {
+            "get": {
+                "description": "Get what each category with a budget in the last months, the current one included, was budgeted and took in every one of them, counting the cleared transactions like the budget progress. The adherence of a category is the percent of the months it had a budget in that it kept within the limit. Months without a budget have no budgeted amount",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Budget history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of months, 1 to 24 (default 12)",
+                        "name": "months",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Budget history retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BudgetHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid number of months",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/cashflow": {
+            "get": {
+                "description": "Add up the pending and cleared income and expenses of every month of a period, and the net of each, per currency. Months without transactions are at zero. The totals are computed by the database. The period defaults to the last 12 months, the current one included. Custom assets are left out unless the report is of an account kept in one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Cash flow report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD), the first of the month 11 months before end_date by default",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD), today by default",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only the transactions of this account",
+                        "name": "account_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cash flow retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CashFlowReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/commitments": {
+            "get": {
+                "description": "Get the outflows already committed in each of the next months, starting with the current one, per currency: the credit card faturas due from today on, the installments and the pending expenses of the other accounts. Each month is set against the average monthly income of the three months before the current one, to show how much of it is already spoken for",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Cash-flow calendar of commitments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of months, 1 to 24 (default 6)",
+                        "name": "months",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Commitments retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.CommitmentReportResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/consolidated": {
+            "get": {
+                "description": "Merge the net worth and the cash flow of the pending and cleared transactions of every book into the reporting currency, with the share of each book in its base currency and converted. The books are added up in their base currency first. Rates give how much of the reporting currency one unit of each other currency held is worth",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Consolidated net worth and cash flow",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reporting currency (e.g. USD)",
+                        "name": "currency",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated exchange rates to the reporting currency (e.g. BRL:0.18,GBP:1.25)",
+                        "name": "rates",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of the cash flow period (YYYY-MM-DD), the current month by default",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the cash flow period (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Report retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ConsolidatedReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request, or a missing exchange rate",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/cube": {
+            "get": {
+                "description": "Pivot the pending and cleared transactions from from to to by one dimension down and another across (category, account, payee, month), with a measure in each cell (sum, count, avg) and the totals of each row, each column and the whole table, added up by the database with grouping sets. Each currency has its own table. Amounts are signed by the type of their category, like in custom reports",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Data cube",
+                "parameters": [
+                    {
+                        "enum": [
+                            "category",
+                            "account",
+                            "payee",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "Dimension of the rows",
+                        "name": "rows",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "category",
+                            "account",
+                            "payee",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "Dimension of the columns",
+                        "name": "cols",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "sum",
+                            "count",
+                            "avg"
+                        ],
+                        "type": "string",
+                        "description": "Measure of the cells (default sum)",
+                        "name": "measure",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of the cube (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the cube (YYYY-MM-DD), today by default",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cube retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CubeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/custom": {
+            "get": {
+                "description": "List the custom reports saved in the book, ordered by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "List custom reports",
+                "responses": {
+                    "200": {
+                        "description": "Reports retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.ReportDefinitionResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Save a report built from dimensions the transactions are grouped by (category, account, payee, month), measures added up for each group (sum, count, avg) and filters, to run it whenever needed. Only pending and cleared transactions are reported, each group split per currency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Create custom report",
+                "parameters": [
+                    {
+                        "description": "Report definition",
+                        "name": "report",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReportDefinitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Report saved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReportDefinitionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid report definition",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Report name already taken",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/custom/run": {
+            "post": {
+                "description": "Run a report definition given in the body without saving it, to try it out or for one-off reports. The name is optional",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Run report definition",
+                "parameters": [
+                    {
+                        "description": "Report definition",
+                        "name": "report",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReportDefinitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Report run successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CustomReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid report definition",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/custom/{id}": {
+            "get": {
+                "description": "Retrieve the definition of a custom report by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get custom report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Report retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReportDefinitionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the name, dimensions, measures and filter of a custom report",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Update custom report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Report definition",
+                        "name": "report",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReportDefinitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Report updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ReportDefinitionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid report definition",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Report name already taken",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a custom report",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Delete custom report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Report deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/custom/{id}/run": {
+            "get": {
+                "description": "Group the pending and cleared transactions matching the filter of a saved report by its dimensions and add them up with its measures, per currency. from and to take the place of the dates of the filter when given. Amounts are signed by the type of their category, so a report not grouped by category adds up to the cash flow",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Run custom report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of the report (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the report (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Report run successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.CustomReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/monthly/{month}": {
+            "get": {
+                "description": "Get the totals of each category, adding up the pending and cleared transactions of the month per currency, and the net worth at the end of the month, adding up the cleared transactions of the accounts holding money. Closed months are served from the snapshot taken when they were closed, so later edits and deletes don't change them until recalculated. The current month, and closed months without a snapshot, are calculated from the current transactions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Monthly report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Month (YYYY-MM)",
+                        "name": "month",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Report retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.MonthlyReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid month, or a month that hasn't started",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/monthly/{month}/close": {
+            "post": {
+                "description": "Keep the report of a closed month as a snapshot, like the monthly close does at the start of every month. A month already closed keeps its snapshot, which is returned as is. Useful to close the months before the service was running",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Close month",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Month (YYYY-MM)",
+                        "name": "month",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Month closed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.MonthlyReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid month",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Month not over yet",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/monthly/{month}/recalculate": {
+            "post": {
+                "description": "Replace the snapshot of a closed month with its report calculated from the current transactions, taking in the edits and deletes made since it was closed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Recalculate monthly report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Month (YYYY-MM)",
+                        "name": "month",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Report recalculated",
+                        "schema": {
+                            "$ref": "#/definitions/v1.MonthlyReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid month",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Month not over yet",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/net-worth": {
+            "get": {
+                "description": "Retrieve the net worth at the end of each day, or of each month, of a period, one series per currency, for charting. Like in the balance summary, liabilities subtract and the accounts in custom assets are left out. The period defaults to the last 30 days, or the last 12 months by month.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get net worth history",
+                "parameters": [
+                    {
+                        "enum": [
+                            "day",
+                            "month"
+                        ],
+                        "type": "string",
+                        "default": "day",
+                        "description": "Distance between the points",
+                        "name": "granularity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD), today by default",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Net worth history retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.NetWorthHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/spending": {
+            "get": {
+                "description": "Add up the pending and cleared expenses of a period by category, by month or by both, per currency, for pie and bar charts. The totals are computed by the database. The period defaults to the current month to date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Spending report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD), the first of the month of end_date by default",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD), today by default",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "category",
+                        "description": "Comma separated fields to group by (category, month)",
+                        "name": "group_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Spending retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SpendingReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/restore-points": {
+            "get": {
+                "description": "List the restore points of the book, newest first. One is taken before each bulk operation that changes rows, like statement imports, recording the rows it created or updated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "restore-points"
+                ],
+                "summary": "List restore points",
+                "responses": {
+                    "200": {
+                        "description": "Restore points retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.RestorePointResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/restore-points/{id}": {
+            "get": {
+                "description": "Retrieve a restore point by its ID, with the rows its operation changed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "restore-points"
+                ],
+                "summary": "Get restore point",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restore point ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restore point retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.RestorePointResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Restore point not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/restore-points/{id}/rollback": {
+            "post": {
+                "description": "Revert the operation of a restore point: the transactions it created are deleted, the ones it updated get their previous state back and the accounts it created are deleted unless they hold other transactions by now. Balances are refreshed. A restore point can only be rolled back once",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "restore-points"
+                ],
+                "summary": "Roll back restore point",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Restore point ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Operation rolled back",
+                        "schema": {
+                            "$ref": "#/definitions/v1.RestorePointResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Restore point not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Restore point already rolled back",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/scripts": {
+            "get": {
+                "description": "List the transaction scripts of the book in the order they run",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scripts"
+                ],
+                "summary": "List transaction scripts",
+                "responses": {
+                    "200": {
+                        "description": "Scripts retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.TransactionScriptResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a Starlark script run on every transaction created or imported into the book, before it's stored. The script defines process(tx), tx being a dict of account_id, category_id, amount, date, description, payee, status and tags; it can change category_id, description, payee, status and tags, or call reject(reason) to refuse the transaction. The enabled scripts run in the order of their names, each cut short past its time and memory limits",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scripts"
+                ],
+                "summary": "Create transaction script",
+                "parameters": [
+                    {
+                        "description": "Script",
+                        "name": "script",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionScriptRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Script created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionScriptResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid script",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Script name already taken",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/scripts/{id}": {
+            "get": {
+                "description": "Retrieve a transaction script by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scripts"
+                ],
+                "summary": "Get transaction script",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Script ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Script retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionScriptResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Script not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Change the name and source of a transaction script, or turn it off with enabled false",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scripts"
+                ],
+                "summary": "Update transaction script",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Script ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Script",
+                        "name": "script",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionScriptRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Script updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionScriptResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid script",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Script not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Script name already taken",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a transaction script, the transactions it ran on are kept as they are",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scripts"
+                ],
+                "summary": "Delete transaction script",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Script ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Script deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Script not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/settings": {
+            "get": {
+                "description": "Retrieve the application settings. API keys are masked, showing only their last four characters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Get settings",
+                "responses": {
+                    "200": {
+                        "description": "Settings retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SettingsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update the application settings. Masked API keys keep their stored value and empty ones are removed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Update settings",
+                "parameters": [
+                    {
+                        "description": "Updated settings",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdateSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Settings updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SettingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "403": {
+                        "description": "Not an operator of the deployment",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/simulations": {
+            "post": {
+                "description": "Project the net worth of each currency month by month over the next 1 to 24 months, starting with the next one, without any change and with the changes of each of up to 5 scenarios: a category cut or raised by a percent, its subcategories included, or an income or expense added every month, for some months or all of them. The projections carry on the average monthly amount of each category over the six months before the current one from the current net worth, drafts, cancelled transactions and custom assets left out",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "What-if scenario simulator",
+                "parameters": [
+                    {
+                        "description": "Scenarios to compare",
+                        "name": "simulation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.SimulationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Projections",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.SimulationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/summary/minimal": {
+            "get": {
+                "description": "Get the net worth, the expenses dated today and the earliest pending expense of the next 30 days, for tiny polling clients like menubar widgets. The response carries an ETag, send it back in If-None-Match to get 304 Not Modified while nothing changed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "summary"
+                ],
+                "summary": "Get the minimal summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ETag of the last response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Minimal summary",
+                        "schema": {
+                            "$ref": "#/definitions/v1.MinimalSummaryResponse"
+                        }
+                    },
+                    "304": {
+                        "description": "Not modified"
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "List the tags of the book ordered by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "List tags",
+                "responses": {
+                    "200": {
+                        "description": "Tags retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.TagResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a tag to the book. Names are lowercased, and tags given to transactions are added on their own, so creating them first is optional",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Create tag",
+                "parameters": [
+                    {
+                        "description": "Tag",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Tag created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tag",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Tag already exists",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}": {
+            "get": {
+                "description": "Retrieve a tag by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Get tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Rename a tag, on all its transactions at once",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Rename tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tag",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.TagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tag renamed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tag",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Tag already exists",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a tag, taking it off its transactions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Delete tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Tag deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions": {
+            "get": {
+                "description": "Retrieve a page of the financial transactions with the total number of matching transactions and links to the next and previous pages",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get all transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Related resources to embed (account, category)",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to return for each transaction",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields (date, amount, description, status, created_at), prefix with - for descending",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only transactions filed under this project",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only transactions with this tag",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default and at most 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of transactions to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transactions retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PageResponse-v1_TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new financial transaction with the provided details. When it leaves its category, or the group of it, over the monthly budget, budget_warnings tells which budgets and by how much; the transaction is created all the same",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Create a new transaction",
+                "parameters": [
+                    {
+                        "description": "Transaction data",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateTransactionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Related resources to embed (account, category)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Transaction created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "402": {
+                        "description": "Quota of the free tier reached",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "429": {
+                        "description": "Monthly quota reached, until the Retry-After seconds passed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/attachments/archive": {
+            "get": {
+                "description": "Start zipping the files attached to the transactions from from to to, both included, in the background, a folder for each transaction named after its date and description. Asking for the same period again returns the archive being made, or ready, until it expires a day after it's made, so the request can be repeated until the state is ready. The attachments of a period can add up to 1 GB.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Archive attachments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First day of the period (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the period (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Archive ready to be downloaded",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AttachmentArchiveResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Archive being made",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AttachmentArchiveResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid period",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/attachments/archive/{archiveId}": {
+            "get": {
+                "description": "Download the zip of the attachments of a period, once its archive is ready",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Download attachment archive",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Archive ID",
+                        "name": "archiveId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Zip of the attachments",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Archive not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Archive still being made or failed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/bulk": {
+            "post": {
+                "description": "Create transactions, change their status or delete them, up to 500 operations in a request. The operations are applied in order and all of them or none: when any would fail nothing is applied, and the error lists every operation that failed by its index. Statuses can't go back to draft, and deleted transactions go to the trash",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Bulk transaction operations",
+                "parameters": [
+                    {
+                        "description": "Operations to apply",
+                        "name": "operations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.BulkTransactionsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Related resources to embed (account, category)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Operations applied",
+                        "schema": {
+                            "$ref": "#/definitions/v1.BulkTransactionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request, with the operations that failed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "402": {
+                        "description": "Quota of the free tier reached",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "429": {
+                        "description": "Monthly quota reached, until the Retry-After seconds passed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/export": {
+            "get": {
+                "description": "Download every transaction matching the filters of the transaction list as a CSV or Excel file, with their account, category, amount, currency, status and tags",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Export transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "csv",
+                        "description": "Export format, csv or xlsx",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields (date, amount, description, status, created_at), prefix with - for descending",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only transactions filed under this project",
+                        "name": "project_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only transactions with this tag",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transactions file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/import": {
+            "post": {
+                "description": "Import the CSV export of any bank into an account, sent as the file of a multipart form. The columns holding the date, amount and description of the rows are named by their header. Money going out is filed under category_id and money coming in under income_category_id, or under the category of the latest transaction of the row's payee. Rows already in the account, with the same date, amount and description, are skipped, and those the transaction scripts reject are left out. The transactions are created all together or none at all, and recorded in the restore point returned",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Import a CSV export",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV export",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Account of the transactions",
+                        "name": "account_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category of the money going out",
+                        "name": "category_id",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Category of the money coming in, category_id by default",
+                        "name": "income_category_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Header of the date column",
+                        "name": "date_column",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Header of the signed amount column",
+                        "name": "amount_column",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Header of the description column",
+                        "name": "description_column",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Header of the payee column",
+                        "name": "payee_column",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Go layout of the dates, like 01/02/2006; ISO and day first dates are read without it",
+                        "name": "date_format",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Amounts are written like 1.234,56",
+                        "name": "decimal_comma",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Preview the import without creating the transactions",
+                        "name": "dry_run",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Import previewed, on dry runs",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionImportResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Transactions imported",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Malformed export or parameters",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "402": {
+                        "description": "Quota of the free tier reached",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Account or category not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Export too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "429": {
+                        "description": "Monthly quota reached, until the Retry-After seconds passed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/installments": {
+            "post": {
+                "description": "Spread a purchase paid in installments (parcelamento) over one transaction a month starting on its date, so each installment lands on the next credit card fatura. The total is split evenly with the leftover cents on the first installments, descriptions are numbered like \"TV (3/12)\", and installments after the first are pending until they are charged. The installments are linked to the installment plan returned",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Create an installment purchase",
+                "parameters": [
+                    {
+                        "description": "Purchase data",
+                        "name": "purchase",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateInstallmentPurchaseRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Related resources to embed (account, category)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Installment plan created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.InstallmentPlanResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "402": {
+                        "description": "Quota of the free tier reached",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Account or category not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "429": {
+                        "description": "Monthly quota reached, until the Retry-After seconds passed",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/search": {
+            "get": {
+                "description": "Retrieve a page of the transactions whose description or payee has every word searched for, matched as the start of a word and regardless of case, the best matches first: the ones matching in the description rank above the ones matching in the payee. Deleted transactions are left out",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Search transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Words to search for",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Related resources to embed (account, category)",
+                        "name": "include",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated fields to return for each transaction",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default and at most 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of transactions to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transactions found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PageResponse-v1_TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/trash": {
+            "get": {
+                "description": "Retrieve a page of the deleted transactions, the latest deleted first, with the total number of transactions in the trash",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get the trash",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default and at most 500",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of transactions to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deleted transactions retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PageResponse-v1_TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}": {
+            "get": {
+                "description": "Retrieve a specific transaction by its unique identifier",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get transaction by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Related resources to embed (account, category)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transaction retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing transaction with new information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Update transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated transaction data",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdateTransactionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Related resources to embed (account, category)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transaction updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Move a transaction to the trash, leaving it out of the balances and lists until it's restored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Delete transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Transaction deleted successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/attachments": {
+            "get": {
+                "description": "List the files attached to a transaction in the order they were added",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "List attachments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attachments retrieved successfully",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.AttachmentResponse"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Attach a receipt or another file to a transaction. JPEG, PNG, GIF and WebP pictures and PDFs of up to 10 MB are taken, the type told from the content of the file. JPEG, PNG and GIF pictures get a thumbnail. Files that would take the user over their attachment storage quota are refused, with 413 when the file alone is larger than the quota and 422 when the attachments already stored leave no room for it.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Add attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File to attach",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Attachment added successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.AttachmentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid file",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large, or larger than the whole attachment storage quota",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "422": {
+                        "description": "Attachment storage quota used up",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/attachments/{attachmentId}": {
+            "get": {
+                "description": "Download the file attached to a transaction, with the type and name it was attached with",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Download attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attached file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Attachment not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Take a file off a transaction, deleting it and its thumbnail",
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Delete attachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Attachment deleted successfully"
+                    },
+                    "404": {
+                        "description": "Attachment not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/attachments/{attachmentId}/thumbnail": {
+            "get": {
+                "description": "Download the PNG thumbnail of a picture attached to a transaction. PDFs and the pictures a thumbnail couldn't be made of have none.",
+                "produces": [
+                    "image/png"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Download attachment thumbnail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Attachment ID",
+                        "name": "attachmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Thumbnail",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Attachment or thumbnail not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/discard": {
+            "post": {
+                "description": "Delete a draft. Finalized transactions are refused, use the delete endpoint for those.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Discard draft transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Draft discarded successfully"
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Transaction is not a draft",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/duplicate": {
+            "post": {
+                "description": "Create a new transaction with the account, category, amount and description of an existing one. Without a date the copy gets the user's default date, and its status is always the user's default.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Duplicate transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Date of the copy",
+                        "name": "transaction",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.DuplicateTransactionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Related resources to embed (account, category)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Transaction duplicated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/finalize": {
+            "post": {
+                "description": "Move a draft to pending or cleared so it counts in balances and reports. Without a status the user default applies.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Finalize draft transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status to finalize the draft as",
+                        "name": "transaction",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/v1.FinalizeTransactionRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Related resources to embed (account, category)",
+                        "name": "include",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Draft finalized successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "409": {
+                        "description": "Transaction is not a draft",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}/restore": {
+            "post": {
+                "description": "Take a deleted transaction out of the trash, putting it back in the balance of its account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Restore transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Transaction restored successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/usage": {
+            "get": {
+                "description": "Count what the signed in user created, the bytes their attachments take and the API calls they made this month, with the quotas of their tier. Calls to this endpoint aren't counted",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "usage"
+                ],
+                "summary": "Get usage",
+                "responses": {
+                    "200": {
+                        "description": "Usage",
+                        "schema": {
+                            "$ref": "#/definitions/v1.UsageResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not signed in",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/user-settings": {
+            "get": {
+                "description": "Retrieve the user preferences. Known keys (base_currency, locale, timezone, first_day_of_week, dashboard_layout) are always present, falling back to their defaults",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user-settings"
+                ],
+                "summary": "Get user settings",
+                "responses": {
+                    "200": {
+                        "description": "User settings retrieved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserSettingsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update the given user preferences, leaving the others untouched. An empty value removes the preference, resetting known keys to their default",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user-settings"
+                ],
+                "summary": "Update user settings",
+                "parameters": [
+                    {
+                        "description": "Preferences to update",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdateUserSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User settings updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserSettingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "413": {
+                        "description": "Request body too large",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/ws": {
+            "get": {
+                "description": "Upgrades to a WebSocket streaming the accounts, transactions and balances changed through the API, and the budget alerts fired. Clients authenticate with their bearer token, in the Authorization: Bearer header, the token query parameter or an {\"type\": \"auth\", \"token\": \"...\"} first message, and receive the changes of their book, the one in the X-Book-Id header or book_id query parameter or their default book. They then send {\"type\": \"subscribe\", \"topics\": [\"balances\"]}. Subscribing to balances sends a snapshot of all of them first. Every message is a JSON WSClientMessage or WSServerMessage.",
+                "tags": [
+                    "realtime"
+                ],
+                "summary": "Stream changes over a WebSocket",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer token, when not sent in an auth message",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer token, for clients that can't set headers",
+                        "name": "token",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Book to stream the changes of, the user's default book when not sent",
+                        "name": "X-Book-Id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Book to stream the changes of, for clients that can't set headers",
+                        "name": "book_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "Switching protocols"
+                    },
+                    "400": {
+                        "description": "Invalid book",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "404": {
+                        "description": "Book not found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    },
+                    "503": {
+                        "description": "WebSocket API disabled",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponseBody"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "entities.AccountClassification": {
+            "type": "string",
+            "enum": [
+                "asset",
+                "liability"
+            ],
+            "x-enum-varnames": [
+                "AccountClassificationAsset",
+                "AccountClassificationLiability"
+            ]
+        },
+        "entities.AccountRefreshState": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "refreshed",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "AccountRefreshStatePending",
+                "AccountRefreshStateRefreshed",
+                "AccountRefreshStateFailed"
+            ]
+        },
+        "entities.AccountType": {
+            "type": "string",
+            "enum": [
+                "checking",
+                "savings",
+                "credit",
+                "investment",
+                "cash"
+            ],
+            "x-enum-varnames": [
+                "AccountTypeChecking",
+                "AccountTypeSavings",
+                "AccountTypeCredit",
+                "AccountTypeInvestment",
+                "AccountTypeCash"
+            ]
+        },
+        "entities.BalanceGranularity": {
+            "type": "string",
+            "enum": [
+                "day",
+                "month"
+            ],
+            "x-enum-varnames": [
+                "BalanceGranularityDay",
+                "BalanceGranularityMonth"
+            ]
+        },
+        "entities.BalanceRefreshState": {
+            "type": "string",
+            "enum": [
+                "running",
+                "completed"
+            ],
+            "x-enum-varnames": [
+                "BalanceRefreshStateRunning",
+                "BalanceRefreshStateCompleted"
+            ]
+        },
+        "entities.BulkAction": {
+            "type": "string",
+            "enum": [
+                "create",
+                "update_status",
+                "delete"
+            ],
+            "x-enum-varnames": [
+                "BulkActionCreate",
+                "BulkActionUpdateStatus",
+                "BulkActionDelete"
+            ]
+        },
+        "entities.CategoryType": {
+            "type": "string",
+            "enum": [
+                "income",
+                "expense"
+            ],
+            "x-enum-varnames": [
+                "CategoryTypeIncome",
+                "CategoryTypeExpense"
+            ]
+        },
+        "entities.CommitmentKind": {
+            "type": "string",
+            "enum": [
+                "fatura",
+                "installment",
+                "bill"
+            ],
+            "x-enum-varnames": [
+                "CommitmentKindFatura",
+                "CommitmentKindInstallment",
+                "CommitmentKindBill"
+            ]
+        },
+        "entities.ExpenseReportStatus": {
+            "type": "string",
+            "enum": [
+                "draft",
+                "submitted",
+                "approved",
+                "rejected"
+            ],
+            "x-enum-varnames": [
+                "ExpenseReportStatusDraft",
+                "ExpenseReportStatusSubmitted",
+                "ExpenseReportStatusApproved",
+                "ExpenseReportStatusRejected"
+            ]
+        },
+        "entities.FaturaStatus": {
+            "type": "string",
+            "enum": [
+                "future",
+                "open",
+                "closed"
+            ],
+            "x-enum-varnames": [
+                "FaturaStatusFuture",
+                "FaturaStatusOpen",
+                "FaturaStatusClosed"
+            ]
+        },
+        "entities.InvoiceStatus": {
+            "type": "string",
+            "enum": [
+                "outstanding",
+                "overdue",
+                "paid"
+            ],
+            "x-enum-varnames": [
+                "InvoiceStatusOutstanding",
+                "InvoiceStatusOverdue",
+                "InvoiceStatusPaid"
+            ]
+        },
+        "entities.QueryIntent": {
+            "type": "string",
+            "enum": [
+                "spending",
+                "income",
+                "net_worth",
+                "balance"
+            ],
+            "x-enum-varnames": [
+                "QueryIntentSpending",
+                "QueryIntentIncome",
+                "QueryIntentNetWorth",
+                "QueryIntentBalance"
+            ]
+        },
+        "entities.QuickCaptureSource": {
+            "type": "string",
+            "enum": [
+                "request",
+                "text",
+                "history",
+                "default"
+            ],
+            "x-enum-varnames": [
+                "QuickCaptureSourceRequest",
+                "QuickCaptureSourceText",
+                "QuickCaptureSourceHistory",
+                "QuickCaptureSourceDefault"
+            ]
+        },
+        "entities.ReportDimension": {
+            "type": "string",
+            "enum": [
+                "category",
+                "account",
+                "payee",
+                "month"
+            ],
+            "x-enum-varnames": [
+                "ReportDimensionCategory",
+                "ReportDimensionAccount",
+                "ReportDimensionPayee",
+                "ReportDimensionMonth"
+            ]
+        },
+        "entities.RestorePointAction": {
+            "type": "string",
+            "enum": [
+                "created",
+                "updated"
+            ],
+            "x-enum-varnames": [
+                "RestorePointActionCreated",
+                "RestorePointActionUpdated"
+            ]
+        },
+        "entities.RestorePointEntity": {
+            "type": "string",
+            "enum": [
+                "account",
+                "transaction"
+            ],
+            "x-enum-varnames": [
+                "RestorePointEntityAccount",
+                "RestorePointEntityTransaction"
+            ]
+        },
+        "entities.RestorePointOperation": {
+            "type": "string",
+            "enum": [
+                "statement_import",
+                "csv_import"
+            ],
+            "x-enum-varnames": [
+                "RestorePointOperationStatementImport",
+                "RestorePointOperationCSVImport"
+            ]
+        },
+        "entities.SimulationChangeType": {
+            "type": "string",
+            "enum": [
+                "category",
+                "income",
+                "expense"
+            ],
+            "x-enum-varnames": [
+                "SimulationChangeCategory",
+                "SimulationChangeIncome",
+                "SimulationChangeExpense"
+            ]
+        },
+        "entities.SpendingGroupField": {
+            "type": "string",
+            "enum": [
+                "category",
+                "month"
+            ],
+            "x-enum-varnames": [
+                "SpendingGroupFieldCategory",
+                "SpendingGroupFieldMonth"
+            ]
+        },
+        "entities.StatementSource": {
+            "type": "string",
+            "enum": [
+                "wise",
+                "revolut"
+            ],
+            "x-enum-varnames": [
+                "StatementSourceWise",
+                "StatementSourceRevolut"
+            ]
+        },
+        "entities.TransactionStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "cleared",
+                "cancelled",
+                "draft"
+            ],
+            "x-enum-varnames": [
+                "TransactionStatusPending",
+                "TransactionStatusCleared",
+                "TransactionStatusCancelled",
+                "TransactionStatusDraft"
+            ]
+        },
+        "entities.UserTier": {
+            "type": "string",
+            "enum": [
+                "free",
+                "unlimited"
+            ],
+            "x-enum-varnames": [
+                "UserTierFree",
+                "UserTierUnlimited"
+            ]
+        },
+        "v1.AccountPeriodSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "account_number_last4": {
+                    "type": "string"
+                },
+                "asset": {
+                    "type": "string"
+                },
+                "closing_balance": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "institution": {
+                    "type": "string"
+                },
+                "opening_balance": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "total_in": {
+                    "type": "string"
+                },
+                "total_out": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AccountRefreshStatusResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "changed": {
+                    "type": "boolean"
+                },
+                "current_balance": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "previous_balance": {
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/entities.AccountRefreshState"
+                }
+            }
+        },
+        "v1.AccountResponse": {
+            "type": "object",
+            "properties": {
+                "account_number_last4": {
+                    "type": "string"
+                },
+                "asset": {
+                    "type": "string"
+                },
+                "balance": {
+                    "$ref": "#/definitions/v1.BalanceResponse"
+                },
+                "classification": {
+                    "$ref": "#/definitions/entities.AccountClassification"
+                },
+                "color": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "institution": {
+                    "type": "string"
+                },
+                "last_transaction_date": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payment_due_day": {
+                    "type": "integer"
+                },
+                "statement_closing_day": {
+                    "type": "integer"
+                },
+                "transaction_count": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/entities.AccountType"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AccountStatementResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "account_number_last4": {
+                    "type": "string"
+                },
+                "asset": {
+                    "type": "string"
+                },
+                "closing_balance": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "institution": {
+                    "type": "string"
+                },
+                "opening_balance": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.StatementLineResponse"
+                    }
+                }
+            }
+        },
+        "v1.AddExpenseReportTransactionRequest": {
+            "type": "object",
+            "properties": {
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AssetRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "MILES"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Airline miles"
+                },
+                "precision": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "symbol": {
+                    "type": "string",
+                    "example": "mi"
+                }
+            }
+        },
+        "v1.AssetResponse": {
+            "type": "object",
+            "properties": {
+                "class": {
+                    "type": "string",
+                    "example": "currency"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "custom": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Brazilian Real"
+                },
+                "precision": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "symbol": {
+                    "type": "string",
+                    "example": "R$"
+                }
+            }
+        },
+        "v1.AttachmentArchiveResponse": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "download_url": {
+                    "type": "string",
+                    "example": "/api/v1/transactions/attachments/archive/9f86d081884c7d659a2feaa0c55ad015"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2025-04-01"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "9f86d081884c7d659a2feaa0c55ad015"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 3481920
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string",
+                    "enum": [
+                        "running",
+                        "ready",
+                        "failed"
+                    ],
+                    "example": "running"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-04-30"
+                }
+            }
+        },
+        "v1.AttachmentResponse": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string",
+                    "example": "image/jpeg"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string",
+                    "example": "receipt.jpg"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 48213
+                },
+                "thumbnail_url": {
+                    "type": "string",
+                    "example": "/api/v1/transactions/5f0c6a3e-8f1b-4c1d-9a2e-3b4c5d6e7f80/attachments/0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d/thumbnail"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "/api/v1/transactions/5f0c6a3e-8f1b-4c1d-9a2e-3b4c5d6e7f80/attachments/0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+                }
+            }
+        },
+        "v1.AuthEventResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip_address": {
+                    "type": "string",
+                    "example": "203.0.113.7"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "login_failed",
+                        "login_blocked",
+                        "account_locked",
+                        "address_locked",
+                        "captcha_failed"
+                    ],
+                    "example": "login_failed"
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "curl/8.5.0"
+                }
+            }
+        },
+        "v1.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/v1.UserResponse"
+                }
+            }
+        },
+        "v1.BalanceDeltaResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "days": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.BalanceGroupResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.AccountResponse"
+                    }
+                },
+                "institution": {
+                    "type": "string"
+                },
+                "subtotals": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "$ref": "#/definitions/entities.AccountType"
+                }
+            }
+        },
+        "v1.BalanceHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2025-03-01"
+                },
+                "granularity": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.BalanceGranularity"
+                        }
+                    ],
+                    "example": "day"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BalancePointResponse"
+                    }
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-03-31"
+                }
+            }
+        },
+        "v1.BalancePointResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "[BRL (R$) 1520.75]"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-31"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 1520.75
+                }
+            }
+        },
+        "v1.BalanceRefreshStatusResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.AccountRefreshStatusResponse"
+                    }
+                },
+                "changed": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "refreshed": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/entities.BalanceRefreshState"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.BalanceResponse": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "$ref": "#/definitions/v1.AccountResponse"
+                },
+                "account_id": {
+                    "type": "string"
+                },
+                "available_balance": {
+                    "type": "string"
+                },
+                "current_balance": {
+                    "type": "string"
+                },
+                "deltas": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BalanceDeltaResponse"
+                    }
+                },
+                "last_calculated": {
+                    "type": "string"
+                },
+                "pending_balance": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BalanceSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "custom_assets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[MILES (mi) 42000]"
+                    ]
+                },
+                "last_calculated": {
+                    "type": "string"
+                },
+                "net_worth": {
+                    "type": "string"
+                },
+                "total_assets": {
+                    "type": "string"
+                },
+                "total_liabilities": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BookConsolidationResponse": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "$ref": "#/definitions/v1.ConsolidatedTotalsResponse"
+                },
+                "book": {
+                    "$ref": "#/definitions/v1.BookResponse"
+                },
+                "reporting": {
+                    "$ref": "#/definitions/v1.ConsolidatedTotalsResponse"
+                }
+            }
+        },
+        "v1.BookRequest": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Side business"
+                }
+            }
+        },
+        "v1.BookResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "default": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Side business"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BudgetAlertResponse": {
+            "type": "object",
+            "properties": {
+                "budget_id": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "limit": {
+                    "type": "string",
+                    "example": "[BRL (R$) 800.00]"
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2025-04"
+                },
+                "percent": {
+                    "type": "number",
+                    "example": 81.3
+                },
+                "spent": {
+                    "type": "string",
+                    "example": "[BRL (R$) 650.00]"
+                },
+                "threshold": {
+                    "type": "integer",
+                    "example": 80
+                }
+            }
+        },
+        "v1.BudgetCategoryHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "adherence": {
+                    "type": "number",
+                    "example": 83.3
+                },
+                "category_color": {
+                    "type": "string",
+                    "example": "#4caf50"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "category_name": {
+                    "type": "string",
+                    "example": "Groceries"
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BudgetMonthResponse"
+                    }
+                }
+            }
+        },
+        "v1.BudgetCategorySpendingResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "category_name": {
+                    "type": "string",
+                    "example": "Restaurants"
+                },
+                "spent": {
+                    "type": "string",
+                    "example": "[BRL (R$) 320.00]"
+                }
+            }
+        },
+        "v1.BudgetCopyResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BudgetResponse"
+                    }
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2025-05"
+                },
+                "skipped": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.BudgetHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BudgetCategoryHistoryResponse"
+                    }
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "2025-03",
+                        "2025-04"
+                    ]
+                }
+            }
+        },
+        "v1.BudgetMonthResponse": {
+            "type": "object",
+            "properties": {
+                "actual": {
+                    "type": "string",
+                    "example": "[BRL (R$) 650.00]"
+                },
+                "budgeted": {
+                    "type": "string",
+                    "example": "[BRL (R$) 800.00]"
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2025-04"
+                },
+                "over": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "v1.BudgetProgressResponse": {
+            "type": "object",
+            "properties": {
+                "breakdown": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BudgetCategorySpendingResponse"
+                    }
+                },
+                "budget": {
+                    "$ref": "#/definitions/v1.BudgetResponse"
+                },
+                "category_color": {
+                    "type": "string",
+                    "example": "#4caf50"
+                },
+                "category_name": {
+                    "type": "string",
+                    "example": "Groceries"
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2025-04"
+                },
+                "over": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "percent": {
+                    "type": "number",
+                    "example": 62.5
+                },
+                "remaining": {
+                    "type": "string",
+                    "example": "[BRL (R$) 300.00]"
+                },
+                "spent": {
+                    "type": "string",
+                    "example": "[BRL (R$) 500.00]"
+                }
+            }
+        },
+        "v1.BudgetRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "800.00"
+                },
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "end_month": {
+                    "type": "string",
+                    "example": "2025-12"
+                },
+                "start_month": {
+                    "type": "string",
+                    "example": "2025-04"
+                },
+                "thresholds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        50,
+                        80,
+                        100
+                    ]
+                }
+            }
+        },
+        "v1.BudgetResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "end_month": {
+                    "type": "string",
+                    "example": "2025-12"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "limit": {
+                    "type": "string",
+                    "example": "[BRL (R$) 800.00]"
+                },
+                "start_month": {
+                    "type": "string",
+                    "example": "2025-04"
+                },
+                "thresholds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        50,
+                        80,
+                        100
+                    ]
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BudgetTemplateItemRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "800.00"
+                },
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "category_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BudgetTemplateItemResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "limit": {
+                    "type": "string",
+                    "example": "[BRL (R$) 800.00]"
+                }
+            }
+        },
+        "v1.BudgetTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BudgetTemplateItemRequest"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Regular month"
+                }
+            }
+        },
+        "v1.BudgetTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BudgetTemplateItemResponse"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Regular month"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.BudgetWarningResponse": {
+            "type": "object",
+            "properties": {
+                "budget_id": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "category_name": {
+                    "type": "string",
+                    "example": "Groceries"
+                },
+                "limit": {
+                    "type": "string",
+                    "example": "[BRL (R$) 800.00]"
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2025-04"
+                },
+                "overspent": {
+                    "type": "string",
+                    "example": "[BRL (R$) 100.00]"
+                },
+                "percent": {
+                    "type": "number",
+                    "example": 112.5
+                },
+                "spent": {
+                    "type": "string",
+                    "example": "[BRL (R$) 900.00]"
+                }
+            }
+        },
+        "v1.BulkFailureResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "v1.BulkOperationRequest": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.BulkAction"
+                        }
+                    ],
+                    "example": "update_status"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.TransactionStatus"
+                        }
+                    ],
+                    "example": "cleared"
+                },
+                "transaction": {
+                    "$ref": "#/definitions/v1.CreateTransactionRequest"
+                }
+            }
+        },
+        "v1.BulkResultResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.BulkAction"
+                        }
+                    ],
+                    "example": "create"
+                },
+                "transaction": {
+                    "$ref": "#/definitions/v1.TransactionResponse"
+                }
+            }
+        },
+        "v1.BulkTransactionsRequest": {
+            "type": "object",
+            "properties": {
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BulkOperationRequest"
+                    }
+                }
+            }
+        },
+        "v1.BulkTransactionsResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BulkResultResponse"
+                    }
+                }
+            }
+        },
+        "v1.CashFlowMonthResponse": {
+            "type": "object",
+            "properties": {
+                "expense": {
+                    "type": "string",
+                    "example": "[BRL (R$) 3500.00]"
+                },
+                "income": {
+                    "type": "string",
+                    "example": "[BRL (R$) 5000.00]"
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2025-04"
+                },
+                "net": {
+                    "type": "string",
+                    "example": "[BRL (R$) 1500.00]"
+                }
+            }
+        },
+        "v1.CashFlowReportResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2025-04-30"
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CashFlowSeriesResponse"
+                    }
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2024-05-01"
+                }
+            }
+        },
+        "v1.CashFlowSeriesResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "expense": {
+                    "type": "string",
+                    "example": "[BRL (R$) 42000.00]"
+                },
+                "income": {
+                    "type": "string",
+                    "example": "[BRL (R$) 60000.00]"
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CashFlowMonthResponse"
+                    }
+                },
+                "net": {
+                    "type": "string",
+                    "example": "[BRL (R$) 18000.00]"
+                }
+            }
+        },
+        "v1.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/entities.CategoryType"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CategoryTotalResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Groceries"
+                },
+                "total": {
+                    "type": "string",
+                    "example": "[BRL (R$) 850.00]"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.CategoryType"
+                        }
+                    ],
+                    "example": "expense"
+                }
+            }
+        },
+        "v1.ClientReceivablesResponse": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "type": "string",
+                    "example": "Acme"
+                },
+                "invoices": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "oldest_due_date": {
+                    "type": "string",
+                    "example": "2025-04-30"
+                },
+                "outstanding": {
+                    "type": "string",
+                    "example": "[BRL (R$) 9000.00]"
+                },
+                "overdue": {
+                    "type": "string",
+                    "example": "[BRL (R$) 4500.00]"
+                }
+            }
+        },
+        "v1.CommitmentMonthResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "string",
+                    "example": "[BRL (R$) 3000.00]"
+                },
+                "bills": {
+                    "type": "string",
+                    "example": "[BRL (R$) 1500.00]"
+                },
+                "commitments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CommitmentResponse"
+                    }
+                },
+                "committed": {
+                    "type": "string",
+                    "example": "[BRL (R$) 2000.00]"
+                },
+                "faturas": {
+                    "type": "string",
+                    "example": "[BRL (R$) 300.00]"
+                },
+                "income": {
+                    "type": "string",
+                    "example": "[BRL (R$) 5000.00]"
+                },
+                "installments": {
+                    "type": "string",
+                    "example": "[BRL (R$) 200.00]"
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2025-04"
+                },
+                "share": {
+                    "type": "number",
+                    "example": 40
+                }
+            }
+        },
+        "v1.CommitmentReportResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CommitmentMonthResponse"
+                    }
+                }
+            }
+        },
+        "v1.CommitmentResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "[BRL (R$) 300.00]"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-04-10"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Nubank fatura 2025-04"
+                },
+                "kind": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.CommitmentKind"
+                        }
+                    ],
+                    "example": "fatura"
+                },
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ConsolidatedReportResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "books": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BookConsolidationResponse"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2025-03-01"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-03-31"
+                },
+                "totals": {
+                    "$ref": "#/definitions/v1.ConsolidatedTotalsResponse"
+                }
+            }
+        },
+        "v1.ConsolidatedTotalsResponse": {
+            "type": "object",
+            "properties": {
+                "assets": {
+                    "type": "string",
+                    "example": "[USD ($) 810.00]"
+                },
+                "expenses": {
+                    "type": "string",
+                    "example": "[USD ($) 200.00]"
+                },
+                "income": {
+                    "type": "string",
+                    "example": "[USD ($) 1300.00]"
+                },
+                "liabilities": {
+                    "type": "string",
+                    "example": "[USD ($) 40.00]"
+                },
+                "net_cash_flow": {
+                    "type": "string",
+                    "example": "[USD ($) 1100.00]"
+                },
+                "net_worth": {
+                    "type": "string",
+                    "example": "[USD ($) 770.00]"
+                }
+            }
+        },
+        "v1.CreateAccountRequest": {
+            "type": "object",
+            "properties": {
+                "account_number_last4": {
+                    "type": "string"
+                },
+                "asset": {
+                    "type": "string"
+                },
+                "classification": {
+                    "$ref": "#/definitions/entities.AccountClassification"
+                },
+                "color": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "institution": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payment_due_day": {
+                    "type": "integer"
+                },
+                "statement_closing_day": {
+                    "description": "Billing cycle of credit cards, both set or both left out",
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/entities.AccountType"
+                }
+            }
+        },
+        "v1.CreateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/entities.CategoryType"
+                }
+            }
+        },
+        "v1.CreateInstallmentPurchaseRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "installments": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/entities.TransactionStatus"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "vacation2024",
+                        "reimbursable"
+                    ]
+                }
+            }
+        },
+        "v1.CreateTransactionRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/entities.TransactionStatus"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "vacation2024",
+                        "reimbursable"
+                    ]
+                }
+            }
+        },
+        "v1.CubeResponse": {
+            "type": "object",
+            "properties": {
+                "cols": {
+                    "type": "string",
+                    "example": "month"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
+                "measure": {
+                    "type": "string",
+                    "example": "sum"
+                },
+                "rows": {
+                    "type": "string",
+                    "example": "category"
+                },
+                "tables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CubeTableResponse"
+                    }
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-12-31"
+                }
+            }
+        },
+        "v1.CubeTableResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "col_totals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CubeValueResponse"
+                    }
+                },
+                "cols": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ReportKeyResponse"
+                    }
+                },
+                "row_totals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CubeValueResponse"
+                    }
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ReportKeyResponse"
+                    }
+                },
+                "total": {
+                    "$ref": "#/definitions/v1.CubeValueResponse"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/v1.CubeValueResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "v1.CubeValueResponse": {
+            "type": "object",
+            "properties": {
+                "avg": {
+                    "type": "string",
+                    "example": "[BRL (R$) -70.83]"
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "sum": {
+                    "type": "string",
+                    "example": "[BRL (R$) -850.00]"
+                }
+            }
+        },
+        "v1.CurrencyImportResponse": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "$ref": "#/definitions/v1.AccountResponse"
+                },
+                "account_balance": {
+                    "type": "string",
+                    "example": "[GBP (£) 1520.40]"
+                },
+                "account_created": {
+                    "type": "boolean"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "GBP"
+                },
+                "rejected": {
+                    "description": "Lines a transaction script rejected",
+                    "type": "integer"
+                },
+                "skipped": {
+                    "description": "Lines already in the account",
+                    "type": "integer"
+                },
+                "statement_balance": {
+                    "description": "Closing balance of the statement and balance of the account after the\nimport, to reconcile them",
+                    "type": "string",
+                    "example": "[GBP (£) 1520.40]"
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TransactionResponse"
+                    }
+                }
+            }
+        },
+        "v1.CustomReportResponse": {
+            "type": "object",
+            "properties": {
+                "dimensions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "measures": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Groceries by month"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CustomReportRowResponse"
+                    }
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-12-31"
+                }
+            }
+        },
+        "v1.CustomReportRowResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "avg": {
+                    "type": "string",
+                    "example": "[BRL (R$) -70.83]"
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ReportKeyResponse"
+                    }
+                },
+                "sum": {
+                    "type": "string",
+                    "example": "[BRL (R$) -850.00]"
+                }
+            }
+        },
+        "v1.DebugLoggingResponse": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "redact_descriptions": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.DemoStatusResponse": {
+            "type": "object",
+            "properties": {
+                "account_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "active": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.DuplicateTransactionRequest": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ErrorResponseBody": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "operations": {
+                    "description": "Operations are the operations of a bulk request that failed",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BulkFailureResponse"
+                    }
+                },
+                "parameter": {
+                    "type": "string"
+                },
+                "quota": {
+                    "description": "Quota is the quota a request would have gone over",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.QuotaResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.ExpenseReportRequest": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string",
+                    "example": "2025-03-14"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Lisbon trip"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Client visit"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2025-03-10"
+                }
+            }
+        },
+        "v1.ExpenseReportResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string",
+                    "example": "2025-03-14"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Lisbon trip"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "reviewed_at": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2025-03-10"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.ExpenseReportStatus"
+                        }
+                    ],
+                    "example": "draft"
+                },
+                "submitted_at": {
+                    "type": "string"
+                },
+                "totals": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[GBP (£) 812.40]"
+                    ]
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TransactionResponse"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ExportResponse": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string",
+                    "example": "text/csv"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string",
+                    "example": "transactions-2025-04-01.csv"
+                },
+                "format": {
+                    "type": "string",
+                    "enum": [
+                        "csv",
+                        "xlsx"
+                    ],
+                    "example": "csv"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "5f0c6a3e-8f1b-4c1d-9a2e-3b4c5d6e7f80"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 48213
+                },
+                "url": {
+                    "type": "string",
+                    "example": "/api/v1/exports/5f0c6a3e-8f1b-4c1d-9a2e-3b4c5d6e7f80/download"
+                },
+                "url_expires_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.FaturaResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "charges": {
+                    "type": "string",
+                    "example": "[BRL (R$) 1250.00]"
+                },
+                "closing_date": {
+                    "type": "string",
+                    "example": "2025-04-03"
+                },
+                "credits": {
+                    "type": "string",
+                    "example": "[BRL (R$) 0.00]"
+                },
+                "due_date": {
+                    "type": "string",
+                    "example": "2025-04-10"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2025-03-03"
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2025-04"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.FaturaStatus"
+                        }
+                    ],
+                    "example": "open"
+                },
+                "total": {
+                    "type": "string",
+                    "example": "[BRL (R$) 1250.00]"
+                },
+                "transaction_count": {
+                    "type": "integer"
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TransactionResponse"
+                    }
+                }
+            }
+        },
+        "v1.FinalizeTransactionRequest": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "$ref": "#/definitions/entities.TransactionStatus"
+                }
+            }
+        },
+        "v1.ImportedRowResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "[BRL (R$) -45.00]"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-03-14"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "payee": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.InstallmentCommitmentMonthResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "[BRL (R$) 350.00]"
+                },
+                "installments": {
+                    "type": "integer"
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2025-06"
+                }
+            }
+        },
+        "v1.InstallmentCommitmentsResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.InstallmentCommitmentMonthResponse"
+                    }
+                },
+                "plans": {
+                    "type": "integer"
+                },
+                "remaining": {
+                    "type": "string",
+                    "example": "[BRL (R$) 2350.00]"
+                }
+            }
+        },
+        "v1.InstallmentPayoffResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "[BRL (R$) 820.88]"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-06-01"
+                },
+                "discount": {
+                    "type": "string",
+                    "example": "[BRL (R$) 79.12]"
+                },
+                "installments": {
+                    "type": "integer",
+                    "example": 9
+                },
+                "monthly_rate": {
+                    "type": "number",
+                    "example": 1.99
+                },
+                "plan": {
+                    "$ref": "#/definitions/v1.InstallmentPlanResponse"
+                },
+                "remaining": {
+                    "type": "string",
+                    "example": "[BRL (R$) 900.00]"
+                },
+                "transaction": {
+                    "$ref": "#/definitions/v1.TransactionResponse"
+                }
+            }
+        },
+        "v1.InstallmentPlanResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "[BRL (R$) 1200.00]"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "TV"
+                },
+                "first_date": {
+                    "type": "string",
+                    "example": "2025-03-20"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "installment_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "installments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TransactionResponse"
+                    }
+                },
+                "next_date": {
+                    "type": "string",
+                    "example": "2025-06-20"
+                },
+                "paid": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "paid_off_on": {
+                    "type": "string"
+                },
+                "remaining": {
+                    "type": "string",
+                    "example": "[BRL (R$) 900.00]"
+                },
+                "remaining_installments": {
+                    "type": "integer",
+                    "example": 9
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.InvoiceRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "4500.00"
+                },
+                "client": {
+                    "type": "string",
+                    "example": "Acme"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "March development"
+                },
+                "due_date": {
+                    "type": "string",
+                    "example": "2025-04-30"
+                },
+                "issue_date": {
+                    "type": "string",
+                    "example": "2025-03-31"
+                },
+                "number": {
+                    "type": "string",
+                    "example": "2025-014"
+                }
+            }
+        },
+        "v1.InvoiceResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "[BRL (R$) 4500.00]"
+                },
+                "client": {
+                    "type": "string",
+                    "example": "Acme"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "days_overdue": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "description": {
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string",
+                    "example": "2025-04-30"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "issue_date": {
+                    "type": "string",
+                    "example": "2025-03-31"
+                },
+                "number": {
+                    "type": "string",
+                    "example": "2025-014"
+                },
+                "payment": {
+                    "$ref": "#/definitions/v1.TransactionResponse"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.InvoiceStatus"
+                        }
+                    ],
+                    "example": "overdue"
+                },
+                "transaction_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LogLevelResponse": {
+            "type": "object",
+            "properties": {
+                "configured": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "string"
+                },
+                "until": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LoginRequest": {
+            "type": "object",
+            "properties": {
+                "captcha": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "alice@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "correct horse battery"
+                }
+            }
+        },
+        "v1.MatchInvoiceRequest": {
+            "type": "object",
+            "properties": {
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.MigrationResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.MigrationStatusResponse": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.MigrationResponse"
+                    }
+                },
+                "current": {
+                    "type": "boolean"
+                },
+                "dirty": {
+                    "type": "boolean"
+                },
+                "latest_version": {
+                    "type": "integer"
+                },
+                "pending": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.MigrationResponse"
+                    }
+                },
+                "schema_version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.MinimalSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "last_calculated": {
+                    "type": "string"
+                },
+                "net_worth": {
+                    "type": "string",
+                    "example": "[USD ($) 12345.67]"
+                },
+                "today_spend": {
+                    "type": "string",
+                    "example": "[USD ($) 42.10]"
+                },
+                "upcoming_bill": {
+                    "$ref": "#/definitions/v1.UpcomingBillResponse"
+                }
+            }
+        },
+        "v1.MonthlyReportResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CategoryTotalResponse"
+                    }
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2025-03"
+                },
+                "net_worth": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[BRL (R$) 12345.67]"
+                    ]
+                },
+                "snapshot": {
+                    "type": "boolean"
+                },
+                "snapshot_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.NetWorthHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string",
+                    "example": "2024-04-01"
+                },
+                "granularity": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.BalanceGranularity"
+                        }
+                    ],
+                    "example": "month"
+                },
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.NetWorthSeriesResponse"
+                    }
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-03-31"
+                }
+            }
+        },
+        "v1.NetWorthSeriesResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BalancePointResponse"
+                    }
+                }
+            }
+        },
+        "v1.OnboardingStatusResponse": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "boolean"
+                },
+                "next_step": {
+                    "type": "string"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.OnboardingStepResponse"
+                    }
+                }
+            }
+        },
+        "v1.OnboardingStepResponse": {
+            "type": "object",
+            "properties": {
+                "done": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.PageResponse-v1_AccountResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.AccountResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "next": {
+                    "type": "string",
+                    "example": "/api/v1/transactions?limit=50\u0026offset=100"
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "prev": {
+                    "type": "string",
+                    "example": "/api/v1/transactions?limit=50\u0026offset=0"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "v1.PageResponse-v1_BalanceResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BalanceResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "next": {
+                    "type": "string",
+                    "example": "/api/v1/transactions?limit=50\u0026offset=100"
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "prev": {
+                    "type": "string",
+                    "example": "/api/v1/transactions?limit=50\u0026offset=0"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "v1.PageResponse-v1_CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CategoryResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "next": {
+                    "type": "string",
+                    "example": "/api/v1/transactions?limit=50\u0026offset=100"
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "prev": {
+                    "type": "string",
+                    "example": "/api/v1/transactions?limit=50\u0026offset=0"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "v1.PageResponse-v1_TransactionResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TransactionResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "next": {
+                    "type": "string",
+                    "example": "/api/v1/transactions?limit=50\u0026offset=100"
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "prev": {
+                    "type": "string",
+                    "example": "/api/v1/transactions?limit=50\u0026offset=0"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "v1.PayOffInstallmentPlanRequest": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2025-06-01"
+                },
+                "monthly_rate": {
+                    "type": "number",
+                    "example": 1.99
+                }
+            }
+        },
+        "v1.ProjectProfitResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
+                "project": {
+                    "$ref": "#/definitions/v1.ProjectResponse"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-03-31"
+                },
+                "totals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ProjectTotalResponse"
+                    }
+                },
+                "transaction_count": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "v1.ProjectRequest": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "type": "string",
+                    "example": "Acme"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Website redesign"
+                }
+            }
+        },
+        "v1.ProjectResponse": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "type": "string",
+                    "example": "Acme"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Website redesign"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ProjectTotalResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "expenses": {
+                    "type": "string",
+                    "example": "[BRL (R$) 1250.00]"
+                },
+                "income": {
+                    "type": "string",
+                    "example": "[BRL (R$) 9000.00]"
+                },
+                "profit": {
+                    "type": "string",
+                    "example": "[BRL (R$) 7750.00]"
+                }
+            }
+        },
+        "v1.QueryRequest": {
+            "type": "object",
+            "properties": {
+                "question": {
+                    "type": "string",
+                    "example": "how much did I spend on food in March"
+                }
+            }
+        },
+        "v1.QueryResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "from": {
+                    "description": "Period of the spending and income intents, both inclusive",
+                    "type": "string",
+                    "example": "2025-03-01"
+                },
+                "intent": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.QueryIntent"
+                        }
+                    ],
+                    "example": "spending"
+                },
+                "question": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-03-31"
+                },
+                "totals": {
+                    "description": "One total per asset, empty when nothing matched",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[USD ($) 412.30]"
+                    ]
+                },
+                "transaction_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.QuickCaptureRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "description": "Account to capture to, instead of the one named in the text or suggested",
+                    "type": "string"
+                },
+                "date": {
+                    "description": "Date of the transaction, YYYY-MM-DD. The user's default date when empty",
+                    "type": "string"
+                },
+                "dry_run": {
+                    "description": "Parse the text without creating the transaction",
+                    "type": "boolean"
+                },
+                "text": {
+                    "type": "string",
+                    "example": "coffee 12.50 food"
+                }
+            }
+        },
+        "v1.QuickCaptureResponse": {
+            "type": "object",
+            "properties": {
+                "account_source": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.QuickCaptureSource"
+                        }
+                    ],
+                    "example": "default"
+                },
+                "category_source": {
+                    "description": "How the category and account were picked: request, text, history or\ndefault",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.QuickCaptureSource"
+                        }
+                    ],
+                    "example": "text"
+                },
+                "created": {
+                    "type": "boolean"
+                },
+                "transaction": {
+                    "$ref": "#/definitions/v1.TransactionResponse"
+                }
+            }
+        },
+        "v1.QuotaResponse": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "name": {
+                    "type": "string",
+                    "example": "monthly_transactions"
+                },
+                "resets_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReceivablesResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "clients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ClientReceivablesResponse"
+                    }
+                },
+                "invoices": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "ledger": {
+                    "type": "string",
+                    "example": "[BRL (R$) 12000.00]"
+                },
+                "outstanding": {
+                    "type": "string",
+                    "example": "[BRL (R$) 9000.00]"
+                },
+                "overdue": {
+                    "type": "string",
+                    "example": "[BRL (R$) 4500.00]"
+                },
+                "total": {
+                    "type": "string",
+                    "example": "[BRL (R$) 21000.00]"
+                }
+            }
+        },
+        "v1.RegisterRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "alice@example.com"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Alice"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "correct horse battery"
+                }
+            }
+        },
+        "v1.ReportDefinitionRequest": {
+            "type": "object",
+            "properties": {
+                "dimensions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "enum": [
+                            "category",
+                            "account",
+                            "payee",
+                            "month"
+                        ]
+                    }
+                },
+                "filter": {
+                    "$ref": "#/definitions/v1.ReportFilterRequest"
+                },
+                "measures": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "enum": [
+                            "sum",
+                            "count",
+                            "avg"
+                        ]
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Groceries by month"
+                }
+            }
+        },
+        "v1.ReportDefinitionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "dimensions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "filter": {
+                    "$ref": "#/definitions/v1.ReportFilterRequest"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "measures": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Groceries by month"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ReportFilterRequest": {
+            "type": "object",
+            "properties": {
+                "account_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "category_type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.CategoryType"
+                        }
+                    ],
+                    "example": "expense"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
+                "payees": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2025-12-31"
+                }
+            }
+        },
+        "v1.ReportKeyResponse": {
+            "type": "object",
+            "properties": {
+                "dimension": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.ReportDimension"
+                        }
+                    ],
+                    "example": "month"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "2025-04"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "2025-04"
+                }
+            }
+        },
+        "v1.RestorePointChangeResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "action": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.RestorePointAction"
+                        }
+                    ],
+                    "example": "created"
+                },
+                "before": {
+                    "$ref": "#/definitions/v1.TransactionResponse"
+                },
+                "entity": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.RestorePointEntity"
+                        }
+                    ],
+                    "example": "transaction"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.RestorePointResponse": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.RestorePointChangeResponse"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Wise statement import"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "operation": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.RestorePointOperation"
+                        }
+                    ],
+                    "example": "statement_import"
+                },
+                "rolled_back_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.RevokedSessionsResponse": {
+            "type": "object",
+            "properties": {
+                "revoked": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "v1.RouteStatsResponse": {
+            "type": "object",
+            "properties": {
+                "client_errors": {
+                    "type": "integer"
+                },
+                "error_rate": {
+                    "type": "number"
+                },
+                "max_ms": {
+                    "type": "number"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "p95_ms": {
+                    "type": "number"
+                },
+                "requests": {
+                    "type": "integer"
+                },
+                "route": {
+                    "type": "string"
+                },
+                "server_errors": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.SchemaEntityResponse": {
+            "type": "object",
+            "properties": {
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SchemaFieldResponse"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "account"
+                }
+            }
+        },
+        "v1.SchemaEnumResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "account_type"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "checking",
+                        "savings",
+                        "credit",
+                        "investment",
+                        "cash"
+                    ]
+                }
+            }
+        },
+        "v1.SchemaFieldResponse": {
+            "type": "object",
+            "properties": {
+                "enum": {
+                    "type": "string",
+                    "example": "account_type"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "type"
+                },
+                "optional": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "string"
+                }
+            }
+        },
+        "v1.SchemaResponse": {
+            "type": "object",
+            "properties": {
+                "assets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.AssetResponse"
+                    }
+                },
+                "database_version": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SchemaEntityResponse"
+                    }
+                },
+                "enums": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SchemaEnumResponse"
+                    }
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 20
+                }
+            }
+        },
+        "v1.SessionResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "current": {
+                    "type": "boolean"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip_address": {
+                    "type": "string",
+                    "example": "192.0.2.1"
+                },
+                "last_seen_at": {
+                    "type": "string"
+                },
+                "user_agent": {
+                    "type": "string",
+                    "example": "Mozilla/5.0 (X11; Linux x86_64; rv:136.0) Gecko/20100101 Firefox/136.0"
+                }
+            }
+        },
+        "v1.SettingsResponse": {
+            "type": "object",
+            "properties": {
+                "api_keys": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "fiscal_month_start_day": {
+                    "type": "integer"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "notification_email": {
+                    "type": "string"
+                },
+                "notifications_enabled": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.SimulationChangeRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "800.00"
+                },
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "category_id": {
                     "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Car loan"
+                },
+                "months": {
+                    "type": "integer",
+                    "example": 24
+                },
+                "percent": {
+                    "type": "number",
+                    "example": -30
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.SimulationChangeType"
+                        }
+                    ],
+                    "example": "expense"
+                }
+            }
+        },
+        "v1.SimulationMonthResponse": {
+            "type": "object",
+            "properties": {
+                "expenses": {
+                    "type": "string",
+                    "example": "[BRL (R$) 2400.00]"
+                },
+                "income": {
+                    "type": "string",
+                    "example": "[BRL (R$) 5000.00]"
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2025-04"
+                },
+                "net_cash_flow": {
+                    "type": "string",
+                    "example": "[BRL (R$) 2600.00]"
+                },
+                "net_worth": {
+                    "type": "string",
+                    "example": "[BRL (R$) 10600.00]"
+                }
+            }
+        },
+        "v1.SimulationProjectionResponse": {
+            "type": "object",
+            "properties": {
+                "difference": {
+                    "type": "string",
+                    "example": "[BRL (R$) -9600.00]"
+                },
+                "end_net_worth": {
+                    "type": "string",
+                    "example": "[BRL (R$) 39200.00]"
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SimulationMonthResponse"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "baseline"
+                }
+            }
+        },
+        "v1.SimulationRequest": {
+            "type": "object",
+            "properties": {
+                "months": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "scenarios": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SimulationScenarioRequest"
+                    }
+                }
+            }
+        },
+        "v1.SimulationResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "net_worth": {
+                    "type": "string",
+                    "example": "[BRL (R$) 8000.00]"
+                },
+                "projections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SimulationProjectionResponse"
+                    }
+                }
+            }
+        },
+        "v1.SimulationScenarioRequest": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SimulationChangeRequest"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Car loan"
+                }
+            }
+        },
+        "v1.SpendingGroupResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "type": "string",
+                    "example": "BRL"
+                },
+                "category_color": {
+                    "type": "string",
+                    "example": "#4caf50"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "category_name": {
+                    "type": "string",
+                    "example": "Groceries"
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2025-04"
+                },
+                "share": {
+                    "type": "number",
+                    "example": 26.2
+                },
+                "total": {
+                    "type": "string",
+                    "example": "[BRL (R$) 850.00]"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 850
                 }
             }
         },
-        "v1.BalanceResponse": {
+        "v1.SpendingReportResponse": {
             "type": "object",
             "properties": {
-                "account": {
-                    "$ref": "#/definitions/v1.AccountResponse"
-                },
-                "account_id": {
-                    "type": "string"
+                "end_date": {
+                    "type": "string",
+                    "example": "2025-04-30"
                 },
-                "available_balance": {
-                    "type": "string"
+                "group_by": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entities.SpendingGroupField"
+                    },
+                    "example": [
+                        "category"
+                    ]
                 },
-                "current_balance": {
-                    "type": "string"
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.SpendingGroupResponse"
+                    }
                 },
-                "last_calculated": {
-                    "type": "string"
+                "start_date": {
+                    "type": "string",
+                    "example": "2025-04-01"
                 },
-                "pending_balance": {
-                    "type": "string"
+                "totals": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "[BRL (R$) 3250.00]"
+                    ]
                 }
             }
         },
-        "v1.BalanceSummaryResponse": {
+        "v1.StatementImportResponse": {
             "type": "object",
             "properties": {
-                "last_calculated": {
-                    "type": "string"
+                "currencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CurrencyImportResponse"
+                    }
                 },
-                "net_worth": {
-                    "type": "string"
+                "dry_run": {
+                    "type": "boolean"
                 },
-                "total_assets": {
+                "restore_point_id": {
+                    "description": "Restore point to roll the import back with, left out when nothing\nchanged",
                     "type": "string"
                 },
-                "total_liabilities": {
-                    "type": "string"
+                "source": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.StatementSource"
+                        }
+                    ],
+                    "example": "wise"
                 }
             }
         },
-        "v1.CategoryResponse": {
+        "v1.StatementLineResponse": {
             "type": "object",
             "properties": {
-                "color": {
+                "amount": {
                     "type": "string"
                 },
-                "created_at": {
+                "category_id": {
+                    "type": "string"
+                },
+                "date": {
                     "type": "string"
                 },
                 "description": {
@@ -926,79 +11511,108 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "name": {
+                "project_id": {
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/entities.CategoryType"
-                },
-                "updated_at": {
+                "running_balance": {
                     "type": "string"
                 }
             }
         },
-        "v1.CreateAccountRequest": {
+        "v1.StatsResponse": {
             "type": "object",
             "properties": {
-                "asset": {
-                    "type": "string"
+                "windows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.StatsWindowResponse"
+                    }
+                }
+            }
+        },
+        "v1.StatsWindowResponse": {
+            "type": "object",
+            "properties": {
+                "routes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.RouteStatsResponse"
+                    }
                 },
-                "description": {
+                "window": {
                     "type": "string"
-                },
+                }
+            }
+        },
+        "v1.TagRequest": {
+            "type": "object",
+            "properties": {
                 "name": {
-                    "type": "string"
-                },
-                "type": {
-                    "$ref": "#/definitions/entities.AccountType"
+                    "type": "string",
+                    "example": "vacation2024"
                 }
             }
         },
-        "v1.CreateCategoryRequest": {
+        "v1.TagResponse": {
             "type": "object",
             "properties": {
-                "color": {
+                "created_at": {
                     "type": "string"
                 },
-                "description": {
+                "id": {
                     "type": "string"
                 },
                 "name": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "vacation2024"
                 },
-                "type": {
-                    "$ref": "#/definitions/entities.CategoryType"
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "v1.CreateTransactionRequest": {
+        "v1.TransactionImportResponse": {
             "type": "object",
             "properties": {
                 "account_id": {
                     "type": "string"
                 },
-                "amount": {
-                    "type": "string"
+                "created": {
+                    "type": "integer",
+                    "example": 42
                 },
-                "category_id": {
-                    "type": "string"
+                "dry_run": {
+                    "type": "boolean"
                 },
-                "date": {
-                    "type": "string"
+                "rejected": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "description": {
-                    "type": "string"
+                "rejected_rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ImportedRowResponse"
+                    }
                 },
-                "status": {
-                    "$ref": "#/definitions/entities.TransactionStatus"
-                }
-            }
-        },
-        "v1.ErrorResponseBody": {
-            "type": "object",
-            "properties": {
-                "error": {
+                "restore_point_id": {
+                    "description": "Restore point to roll the import back with, left out when nothing\nchanged",
                     "type": "string"
+                },
+                "skipped": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "skipped_rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ImportedRowResponse"
+                    }
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.TransactionResponse"
+                    }
                 }
             }
         },
@@ -1014,6 +11628,19 @@ const docTemplate = `{
                 "amount": {
                     "type": "string"
                 },
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.AttachmentResponse"
+                    }
+                },
+                "budget_warnings": {
+                    "description": "BudgetWarnings are the budgets the transaction just created left over\ntheir limit in its month. They don't stop the transaction.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.BudgetWarningResponse"
+                    }
+                },
                 "category": {
                     "$ref": "#/definitions/v1.CategoryResponse"
                 },
@@ -1026,32 +11653,148 @@ const docTemplate = `{
                 "date": {
                     "type": "string"
                 },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2025-03-14T10:30:00Z"
+                },
                 "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
+                "installment_count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "installment_number": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "installment_plan_id": {
+                    "type": "string"
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
                 "status": {
                     "$ref": "#/definitions/entities.TransactionStatus"
                 },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "vacation2024",
+                        "reimbursable"
+                    ]
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TransactionScriptRequest": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "rideshare"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.TransactionScriptResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "rideshare"
+                },
+                "source": {
+                    "type": "string"
+                },
                 "updated_at": {
                     "type": "string"
                 }
             }
         },
+        "v1.UpcomingBillResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string",
+                    "example": "[USD ($) -750.00]"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-05-12"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Credit card bill"
+                },
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
         "v1.UpdateAccountRequest": {
             "type": "object",
             "properties": {
+                "account_number_last4": {
+                    "type": "string"
+                },
                 "asset": {
                     "type": "string"
                 },
+                "classification": {
+                    "$ref": "#/definitions/entities.AccountClassification"
+                },
+                "color": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
+                "icon": {
+                    "type": "string"
+                },
+                "institution": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
+                "payment_due_day": {
+                    "type": "integer"
+                },
+                "statement_closing_day": {
+                    "description": "Billing cycle of credit cards, both set or both left out",
+                    "type": "integer"
+                },
                 "type": {
                     "$ref": "#/definitions/entities.AccountType"
                 }
@@ -1069,11 +11812,65 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "parent_id": {
+                    "type": "string"
+                },
                 "type": {
                     "$ref": "#/definitions/entities.CategoryType"
                 }
             }
         },
+        "v1.UpdateDebugLoggingRequest": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "redact_descriptions": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1.UpdateLogLevelRequest": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "description": "How long to keep the level before reverting to the configured one, e.g.\n30m. Kept until changed again when empty",
+                    "type": "string",
+                    "example": "30m"
+                },
+                "level": {
+                    "type": "string",
+                    "example": "debug"
+                }
+            }
+        },
+        "v1.UpdateSettingsRequest": {
+            "type": "object",
+            "properties": {
+                "api_keys": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "fiscal_month_start_day": {
+                    "type": "integer"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "notification_email": {
+                    "type": "string"
+                },
+                "notifications_enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
         "v1.UpdateTransactionRequest": {
             "type": "object",
             "properties": {
@@ -1092,12 +11889,163 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "payee": {
+                    "type": "string"
+                },
+                "project_id": {
+                    "type": "string"
+                },
                 "status": {
                     "$ref": "#/definitions/entities.TransactionStatus"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "vacation2024",
+                        "reimbursable"
+                    ]
+                }
+            }
+        },
+        "v1.UpdateUserSettingsRequest": {
+            "type": "object",
+            "properties": {
+                "settings": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.UsageLimitsResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "attachment_bytes": {
+                    "type": "integer",
+                    "example": 1073741824
+                },
+                "monthly_api_calls": {
+                    "type": "integer",
+                    "example": 10000
+                },
+                "monthly_transactions": {
+                    "type": "integer",
+                    "example": 500
+                }
+            }
+        },
+        "v1.UsageResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "attachment_bytes": {
+                    "type": "integer",
+                    "example": 52428800
+                },
+                "books": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "budgets": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "categories": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "limits": {
+                    "$ref": "#/definitions/v1.UsageLimitsResponse"
+                },
+                "monthly_api_calls": {
+                    "type": "integer",
+                    "example": 2140
+                },
+                "monthly_transactions": {
+                    "type": "integer",
+                    "example": 87
+                },
+                "period_start": {
+                    "type": "string",
+                    "example": "2025-03-01"
+                },
+                "resets_at": {
+                    "type": "string",
+                    "example": "2025-04-01T00:00:00Z"
+                },
+                "tier": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entities.UserTier"
+                        }
+                    ],
+                    "example": "free"
+                },
+                "transactions": {
+                    "type": "integer",
+                    "example": 1250
+                }
+            }
+        },
+        "v1.UserResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "alice@example.com"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Alice"
+                },
+                "tier": {
+                    "type": "string",
+                    "example": "free"
+                }
+            }
+        },
+        "v1.UserSettingsResponse": {
+            "type": "object",
+            "properties": {
+                "settings": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 }
             }
         }
     },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "The token from /auth/login or /auth/register, as \"Bearer \u003ctoken\u003e\". Every endpoint but those two requires it",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
+    },
+    "security": [
+        {
+            "BearerAuth": []
+        }
+    ],
     "externalDocs": {
         "description": "OpenAPI",
         "url": "https://swagger.io/resources/open-api/"
@@ -0,0 +1,24 @@
+package domain
+
+import "context"
+
+// DefaultBookID is the book created along with the schema, which holds
+// everything recorded without picking a book
+const DefaultBookID = "00000000-0000-0000-0000-000000000001"
+
+type bookKey struct{}
+
+// WithBook returns a context scoped to a book, the accounts and categories
+// read and written with it are the book's
+func WithBook(ctx context.Context, bookID string) context.Context {
+	return context.WithValue(ctx, bookKey{}, bookID)
+}
+
+// BookFromContext returns the book the context is scoped to, the default book
+// when none was picked
+func BookFromContext(ctx context.Context) string {
+	if bookID, ok := ctx.Value(bookKey{}).(string); ok && bookID != "" {
+		return bookID
+	}
+	return DefaultBookID
+}
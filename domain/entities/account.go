@@ -17,13 +17,97 @@ const (
 	AccountTypeCash       AccountType = "cash"
 )
 
-// Account represents a financial account
+// AccountTypes lists every valid account type
+var AccountTypes = []AccountType{
+	AccountTypeChecking,
+	AccountTypeSavings,
+	AccountTypeCredit,
+	AccountTypeInvestment,
+	AccountTypeCash,
+}
+
+// AccountClassification tells whether an account holds money (asset) or tracks
+// money owed (liability)
+type AccountClassification string
+
+const (
+	AccountClassificationAsset     AccountClassification = "asset"
+	AccountClassificationLiability AccountClassification = "liability"
+)
+
+// AccountClassifications lists every valid account classification
+var AccountClassifications = []AccountClassification{
+	AccountClassificationAsset,
+	AccountClassificationLiability,
+}
+
+// DefaultClassification returns the classification of accounts of this type that
+// don't set one explicitly
+func (t AccountType) DefaultClassification() AccountClassification {
+	if t == AccountTypeCredit {
+		return AccountClassificationLiability
+	}
+	return AccountClassificationAsset
+}
+
+// Account represents a financial account. Classification, when set, overrides the
+// default classification of the account type.
 type Account struct {
-	ID          string         `json:"id" db:"id"`
-	Name        string         `json:"name" db:"name"`
-	Type        AccountType    `json:"type" db:"type"`
-	Asset       monetary.Asset `json:"asset" db:"asset"`
-	Description string         `json:"description" db:"description"`
-	CreatedAt   time.Time      `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at" db:"updated_at"`
+	ID             string                `json:"id" db:"id"`
+	Name           string                `json:"name" db:"name"`
+	Type           AccountType           `json:"type" db:"type"`
+	Asset          monetary.Asset        `json:"asset" db:"asset"`
+	Description    string                `json:"description" db:"description"`
+	Classification AccountClassification `json:"classification,omitempty" db:"classification"`
+	BookID         string                `json:"book_id" db:"book_id"`
+	OwnerID        string                `json:"owner_id,omitempty" db:"user_id"`
+
+	// Optional details telling apart accounts at the same bank
+	Institution        string `json:"institution,omitempty" db:"institution"`
+	AccountNumberLast4 string `json:"account_number_last4,omitempty" db:"account_number_last4"`
+	Color              string `json:"color,omitempty" db:"color"`
+	Icon               string `json:"icon,omitempty" db:"icon"`
+
+	// Billing cycle of credit cards, the days of the month the statement
+	// (fatura) closes and its payment is due. Zero when not set.
+	StatementClosingDay int `json:"statement_closing_day,omitempty" db:"statement_closing_day"`
+	PaymentDueDay       int `json:"payment_due_day,omitempty" db:"payment_due_day"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+
+	// Activity, computed from the account transactions when the account is read
+	TransactionCount    int64      `json:"transaction_count" db:"transaction_count"`
+	LastTransactionDate *time.Time `json:"last_transaction_date,omitempty" db:"last_transaction_date"`
+
+	// Relationships
+	Balance *Balance `json:"balance,omitempty"`
+}
+
+// EffectiveClassification returns the account classification, falling back to the
+// default of its type
+func (a Account) EffectiveClassification() AccountClassification {
+	if a.Classification != "" {
+		return a.Classification
+	}
+	return a.Type.DefaultClassification()
+}
+
+// HasBillingCycle reports whether the account closes monthly faturas, which
+// takes both its closing and due days
+func (a Account) HasBillingCycle() bool {
+	return a.StatementClosingDay > 0 && a.PaymentDueDay > 0
+}
+
+// IsMonetary reports whether the account holds money, instead of a custom asset
+// like loyalty points or airline miles. Only monetary accounts count towards
+// the net worth.
+func (a Account) IsMonetary() bool {
+	return a.Asset.Class != AssetClassCustom
+}
+
+// IsLiability reports whether the account tracks money owed, like a credit card,
+// instead of money held. Expenses raise the balance of a liability account.
+func (a Account) IsLiability() bool {
+	return a.EffectiveClassification() == AccountClassificationLiability
 }
@@ -0,0 +1,105 @@
+package entities
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// AssetClassCustom is the class of the assets enabled per deployment
+const AssetClassCustom = "custom"
+
+// Asset is a currency amounts can be kept in, with the name it's displayed
+// under. Custom assets, like loyalty points or airline miles, are enabled per
+// deployment next to the built-in ones.
+type Asset struct {
+	monetary.Asset
+	Name      string
+	CreatedAt time.Time
+}
+
+// Custom reports whether the asset was enabled for this deployment
+func (a Asset) Custom() bool {
+	return a.Class == AssetClassCustom
+}
+
+// SupportedAssets lists the built-in currencies accounts, books and settings
+// can be kept in: the ones known to the monetary package
+var SupportedAssets = []monetary.Asset{
+	monetary.BRL,
+	monetary.USD,
+	monetary.GBP,
+	monetary.JPY,
+	monetary.CAD,
+	monetary.BTC,
+	monetary.ETH,
+}
+
+var assetNames = map[string]string{
+	"BRL": "Brazilian Real",
+	"USD": "US Dollar",
+	"GBP": "British Pound",
+	"JPY": "Japanese Yen",
+	"CAD": "Canadian Dollar",
+	"BTC": "Bitcoin",
+	"ETH": "Ethereum",
+}
+
+// customAssets are the custom assets of this deployment, registered when the
+// service starts and as they're enabled
+var customAssets struct {
+	sync.RWMutex
+	assets []Asset
+}
+
+// RegisterCustomAssets makes the custom assets supported, replacing the ones
+// already registered with the same code
+func RegisterCustomAssets(assets ...Asset) {
+	customAssets.Lock()
+	defer customAssets.Unlock()
+
+	for _, asset := range assets {
+		asset.Class = AssetClassCustom
+		if i := slices.IndexFunc(customAssets.assets, func(a Asset) bool { return a.Asset.Asset == asset.Asset.Asset }); i >= 0 {
+			customAssets.assets[i] = asset
+			continue
+		}
+		customAssets.assets = append(customAssets.assets, asset)
+	}
+	slices.SortFunc(customAssets.assets, func(a, b Asset) int { return strings.Compare(a.Asset.Asset, b.Asset.Asset) })
+}
+
+// Assets lists the supported assets, the built-in ones first and then the
+// custom ones by code
+func Assets() []Asset {
+	customAssets.RLock()
+	defer customAssets.RUnlock()
+
+	assets := make([]Asset, 0, len(SupportedAssets)+len(customAssets.assets))
+	for _, asset := range SupportedAssets {
+		assets = append(assets, Asset{Asset: asset, Name: assetNames[asset.Asset]})
+	}
+	return append(assets, customAssets.assets...)
+}
+
+// FindSupportedAsset looks up a supported asset, built-in or custom, by its
+// code, ignoring case
+func FindSupportedAsset(code string) (monetary.Asset, bool) {
+	for _, asset := range SupportedAssets {
+		if strings.EqualFold(asset.Asset, code) {
+			return asset, true
+		}
+	}
+
+	customAssets.RLock()
+	defer customAssets.RUnlock()
+	for _, asset := range customAssets.assets {
+		if strings.EqualFold(asset.Asset.Asset, code) {
+			return asset.Asset, true
+		}
+	}
+	return monetary.Asset{}, false
+}
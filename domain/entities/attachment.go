@@ -0,0 +1,76 @@
+package entities
+
+import "time"
+
+// MaxAttachmentSize is the largest file, in bytes, that can be attached to a
+// transaction
+const MaxAttachmentSize = 10 << 20
+
+// AttachmentContentTypes are the kinds of files transactions take, the
+// pictures and PDFs receipts come as
+var AttachmentContentTypes = []string{
+	"image/jpeg",
+	"image/png",
+	"image/gif",
+	"image/webp",
+	"application/pdf",
+}
+
+// Attachment is a file, like a receipt, attached to a transaction. The file
+// is kept in the attachment store under StorageKey, and its thumbnail, when
+// it has one, under ThumbnailKey.
+type Attachment struct {
+	ID            string
+	TransactionID string
+	FileName      string
+	ContentType   string
+	Size          int64
+	StorageKey    string
+	ThumbnailKey  string
+	CreatedAt     time.Time
+}
+
+// HasThumbnail tells whether a thumbnail was made for the attachment
+func (a Attachment) HasThumbnail() bool {
+	return a.ThumbnailKey != ""
+}
+
+// MaxAttachmentArchiveSize is the most, in bytes, the attachments put in an
+// archive may add up to
+const MaxAttachmentArchiveSize = 1 << 30
+
+// AttachmentArchiveTTL is how long an archive can be downloaded once made
+const AttachmentArchiveTTL = 24 * time.Hour
+
+// AttachmentArchiveState is how far the making of an attachment archive got
+type AttachmentArchiveState string
+
+const (
+	AttachmentArchiveStateRunning AttachmentArchiveState = "running"
+	AttachmentArchiveStateReady   AttachmentArchiveState = "ready"
+	AttachmentArchiveStateFailed  AttachmentArchiveState = "failed"
+)
+
+// AttachmentArchive is a zip of the attachments of the transactions from From
+// to To, made in the background. Once ready it's kept in the attachment store
+// under StorageKey until ExpiresAt.
+type AttachmentArchive struct {
+	ID          string
+	From        time.Time
+	To          time.Time
+	State       AttachmentArchiveState
+	Attachments int
+	Size        int64
+	StorageKey  string
+	Error       string
+	BookID      string
+	OwnerID     string
+	StartedAt   time.Time
+	FinishedAt  time.Time
+	ExpiresAt   time.Time
+}
+
+// FileName is the name the archive is downloaded as
+func (a AttachmentArchive) FileName() string {
+	return "attachments-" + a.From.Format("2006-01-02") + "-" + a.To.Format("2006-01-02") + ".zip"
+}
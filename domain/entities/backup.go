@@ -0,0 +1,35 @@
+package entities
+
+import "time"
+
+// Backup is a full copy of the data, restorable into a database without
+// accounts. Balances aren't kept, they are recalculated from the transactions.
+type Backup struct {
+	CreatedAt    time.Time
+	Settings     Settings
+	UserSettings []UserSetting
+	Categories   []Category
+	Accounts     []Account
+	Transactions []Transaction
+}
+
+// BackupFile describes a stored backup
+type BackupFile struct {
+	Name      string
+	CreatedAt time.Time
+	Size      int64
+}
+
+// BackupRetention keeps the newest backup of each of the last Daily days and
+// of each of the last Weekly weeks, older backups are deleted
+type BackupRetention struct {
+	Daily  int
+	Weekly int
+}
+
+// BackupRestore counts what a restore created
+type BackupRestore struct {
+	Categories   int
+	Accounts     int
+	Transactions int
+}
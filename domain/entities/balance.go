@@ -13,15 +13,166 @@ type Balance struct {
 	PendingBalance   monetary.Monetary `json:"pending_balance" db:"pending_balance"`
 	AvailableBalance monetary.Monetary `json:"available_balance" db:"available_balance"`
 	LastCalculated   time.Time         `json:"last_calculated" db:"last_calculated"`
+	Deltas           []BalanceDelta    `json:"deltas,omitempty"`
 
 	// Relationships
 	Account *Account `json:"account,omitempty"`
 }
 
-// BalanceSummary represents a summary of all account balances
+// BalanceSummary represents a summary of all account balances. The accounts in
+// custom assets, like loyalty points and miles, are left out of the totals and
+// the net worth, CustomAssets holds their net balance per asset instead.
 type BalanceSummary struct {
-	TotalAssets      monetary.Monetary `json:"total_assets"`
-	TotalLiabilities monetary.Monetary `json:"total_liabilities"`
-	NetWorth         monetary.Monetary `json:"net_worth"`
-	LastCalculated   time.Time         `json:"last_calculated"`
+	TotalAssets      monetary.Monetary   `json:"total_assets"`
+	TotalLiabilities monetary.Monetary   `json:"total_liabilities"`
+	NetWorth         monetary.Monetary   `json:"net_worth"`
+	CustomAssets     []monetary.Monetary `json:"custom_assets"`
+	LastCalculated   time.Time           `json:"last_calculated"`
+}
+
+// BalanceGroupField is an account field balances can be grouped by
+type BalanceGroupField string
+
+const (
+	BalanceGroupFieldInstitution BalanceGroupField = "institution"
+	BalanceGroupFieldType        BalanceGroupField = "type"
+)
+
+// BalanceGroupFields lists every valid grouping field, in the default grouping order
+var BalanceGroupFields = []BalanceGroupField{
+	BalanceGroupFieldInstitution,
+	BalanceGroupFieldType,
+}
+
+// BalanceGroup is a set of accounts sharing the grouped fields, only the fields
+// grouped by are set. Subtotals hold the net of the current balances per asset,
+// liabilities subtracting, since balances of different assets can't be added up.
+type BalanceGroup struct {
+	Institution string              `json:"institution"`
+	Type        AccountType         `json:"type"`
+	Subtotals   []monetary.Monetary `json:"subtotals"`
+	Accounts    []Account           `json:"accounts"`
+}
+
+// BalanceDeltaPeriods are the periods, in days, balance deltas are reported for
+var BalanceDeltaPeriods = []int{7, 30}
+
+// BalanceDelta represents how much the current balance changed over the last Days days
+type BalanceDelta struct {
+	Days   int               `json:"days"`
+	Amount monetary.Monetary `json:"amount"`
+}
+
+// BalanceSnapshot represents the current balance of an account at the end of a day
+type BalanceSnapshot struct {
+	AccountID      string            `json:"account_id" db:"account_id"`
+	Date           time.Time         `json:"date" db:"snapshot_date"`
+	CurrentBalance monetary.Monetary `json:"current_balance" db:"current_balance"`
+}
+
+// BalanceGranularity is how far apart the points of a balance history are
+type BalanceGranularity string
+
+const (
+	BalanceGranularityDay   BalanceGranularity = "day"
+	BalanceGranularityMonth BalanceGranularity = "month"
+)
+
+// BalanceGranularities lists every valid granularity, the default first
+var BalanceGranularities = []BalanceGranularity{
+	BalanceGranularityDay,
+	BalanceGranularityMonth,
+}
+
+// BalancePoint is a balance at the end of a day
+type BalancePoint struct {
+	Date   time.Time         `json:"date"`
+	Amount monetary.Monetary `json:"amount"`
+}
+
+// BalanceHistory is the current balance of an account at the end of each day,
+// or month, from From to To, the last month ending on To. A day without a
+// snapshot has the balance of the last one before it, and the days before the
+// first snapshot have no point.
+type BalanceHistory struct {
+	AccountID   string             `json:"account_id"`
+	Granularity BalanceGranularity `json:"granularity"`
+	From        time.Time          `json:"from"`
+	To          time.Time          `json:"to"`
+	Points      []BalancePoint     `json:"points"`
+}
+
+// NetWorthHistory is the net worth at the end of each day, or month, from
+// From to To, one series per currency since balances of different assets
+// can't be added up. Like in the balance summary, liabilities subtract and
+// the accounts in custom assets are left out.
+type NetWorthHistory struct {
+	Granularity BalanceGranularity `json:"granularity"`
+	From        time.Time          `json:"from"`
+	To          time.Time          `json:"to"`
+	Series      []NetWorthSeries   `json:"series"`
+}
+
+// NetWorthSeries is the net worth in one asset over time
+type NetWorthSeries struct {
+	Asset  monetary.Asset `json:"asset"`
+	Points []BalancePoint `json:"points"`
+}
+
+// BalanceRefreshState is the state of a refresh of all balances
+type BalanceRefreshState string
+
+const (
+	BalanceRefreshStateRunning   BalanceRefreshState = "running"
+	BalanceRefreshStateCompleted BalanceRefreshState = "completed"
+)
+
+// AccountRefreshState is the state of the refresh of one account balance
+type AccountRefreshState string
+
+const (
+	AccountRefreshStatePending   AccountRefreshState = "pending"
+	AccountRefreshStateRefreshed AccountRefreshState = "refreshed"
+	AccountRefreshStateFailed    AccountRefreshState = "failed"
+)
+
+// BalanceRefreshStatus represents the progress of a refresh of all balances
+type BalanceRefreshStatus struct {
+	State      BalanceRefreshState    `json:"state"`
+	StartedAt  time.Time              `json:"started_at"`
+	FinishedAt time.Time              `json:"finished_at"`
+	Accounts   []AccountRefreshStatus `json:"accounts"`
+}
+
+// Count returns how many accounts are in the given state
+func (s BalanceRefreshStatus) Count(state AccountRefreshState) int {
+	var count int
+	for _, account := range s.Accounts {
+		if account.State == state {
+			count++
+		}
+	}
+	return count
+}
+
+// Changed returns how many refreshed balances didn't match the stored ones
+func (s BalanceRefreshStatus) Changed() int {
+	var count int
+	for _, account := range s.Accounts {
+		if account.Changed {
+			count++
+		}
+	}
+	return count
+}
+
+// AccountRefreshStatus represents the refresh of one account balance. The balance
+// stored before the refresh is kept to verify it against the recalculated one.
+type AccountRefreshStatus struct {
+	AccountID       string              `json:"account_id"`
+	State           AccountRefreshState `json:"state"`
+	PreviousBalance *monetary.Monetary  `json:"previous_balance,omitempty"`
+	CurrentBalance  *monetary.Monetary  `json:"current_balance,omitempty"`
+	Changed         bool                `json:"changed"`
+	Error           string              `json:"error,omitempty"`
 }
@@ -0,0 +1,19 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// Book is a separate ledger, like "Personal" or "Side business", with its own
+// accounts and categories, and so its own transactions and balances. Its
+// totals are reported in its base currency, Asset.
+type Book struct {
+	ID          string         `json:"id" db:"id"`
+	Name        string         `json:"name" db:"name"`
+	Description string         `json:"description" db:"description"`
+	Asset       monetary.Asset `json:"asset" db:"asset"`
+	CreatedAt   time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at" db:"updated_at"`
+}
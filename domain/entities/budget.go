@@ -0,0 +1,147 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// MaxBudgetHistoryMonths is how far back the budget history can look
+const MaxBudgetHistoryMonths = 24
+
+// MaxBudgetThreshold is the highest percent of its limit a budget can alert on
+const MaxBudgetThreshold = 1000
+
+// DefaultBudgetThresholds are the percents of its limit a budget alerts on
+// when it isn't given its own
+var DefaultBudgetThresholds = []int{50, 80, 100}
+
+// Budget caps what a category may take each month. Limit is in the asset of
+// the accounts the category is spent from, transactions in other assets don't
+// count against it. The budget applies from the month of StartMonth on,
+// through the month of EndMonth when set. Thresholds are the percents of the
+// limit, in ascending order, an alert fires at once the category reaches them.
+type Budget struct {
+	ID         string
+	CategoryID string
+	Limit      monetary.Monetary
+	StartMonth time.Time
+	EndMonth   *time.Time
+	Thresholds []int
+	BookID     string
+	OwnerID    string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Covers tells whether the budget applies in the month of date
+func (b Budget) Covers(date time.Time) bool {
+	month := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if month.Before(b.StartMonth) {
+		return false
+	}
+	return b.EndMonth == nil || !month.After(*b.EndMonth)
+}
+
+// AlertThresholds are the percents of the limit the budget alerts on, the
+// default ones when it has none
+func (b Budget) AlertThresholds() []int {
+	if len(b.Thresholds) == 0 {
+		return DefaultBudgetThresholds
+	}
+	return b.Thresholds
+}
+
+// BudgetProgress is how much of a budget its category took in a month (e.g.
+// "2025-04"), counting the cleared transactions. Remaining is negative once
+// the budget is overspent, and Percent the share of Limit spent. The budget
+// of a category grouping others counts their transactions too, Breakdown
+// telling what the group and each category in it took.
+type BudgetProgress struct {
+	Budget    Budget
+	Category  Category
+	Month     string
+	Spent     monetary.Monetary
+	Remaining monetary.Monetary
+	Percent   float64
+	Over      bool
+	Breakdown []BudgetCategorySpending
+}
+
+// BudgetAlert records a budget reaching one of its thresholds in a month
+// (e.g. "2025-04"), fired once per threshold each month. Spent and Percent
+// are what the category had taken of Limit when it fired.
+type BudgetAlert struct {
+	ID         string
+	BudgetID   string
+	CategoryID string
+	Month      string
+	Threshold  int
+	Percent    float64
+	Spent      monetary.Monetary
+	Limit      monetary.Monetary
+	BookID     string
+	OwnerID    string
+	CreatedAt  time.Time
+}
+
+// BudgetCategorySpending is what a category of a group took of the group's
+// budget in a month
+type BudgetCategorySpending struct {
+	Category Category
+	Spent    monetary.Monetary
+}
+
+// BudgetHistory is how each budgeted category kept to its budgets over the
+// last months (e.g. "2025-04"), the current one included and last
+type BudgetHistory struct {
+	Months     []string
+	Categories []BudgetCategoryHistory
+}
+
+// BudgetCategoryHistory is what a category was budgeted and took in each
+// month of a budget history. Adherence is the percent of the months it had a
+// budget in that it kept within the limit.
+type BudgetCategoryHistory struct {
+	Category  Category
+	Months    []BudgetMonth
+	Adherence float64
+}
+
+// BudgetMonth is the limit of a category in a month, nil when it had no
+// budget, and what it took counting the cleared transactions, of the
+// categories grouped in it too. Actual is in the asset of the budget, or of
+// the closest one of the category when the month had none.
+type BudgetMonth struct {
+	Month    string
+	Budgeted *monetary.Monetary
+	Actual   monetary.Monetary
+	Over     bool
+}
+
+// BudgetTemplate is a named set of category limits set up in a month at once,
+// e.g. the budgets of a regular month and the ones of a holiday month
+type BudgetTemplate struct {
+	ID        string
+	Name      string
+	Items     []BudgetTemplateItem
+	BookID    string
+	OwnerID   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// BudgetTemplateItem is the monthly limit a template sets for a category
+type BudgetTemplateItem struct {
+	CategoryID string
+	Limit      monetary.Monetary
+}
+
+// BudgetCopy is how a month was set up from other budgets or a template: the
+// budgets created for the month alone and the categories skipped, since they
+// already had a budget in it or no longer exist.
+type BudgetCopy struct {
+	Month   time.Time
+	Created []Budget
+	Skipped []string
+}
@@ -0,0 +1,44 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// CashFlowTotal is what the pending and cleared income and expenses in one
+// asset add up to in a month (e.g. "2025-04"), both by their size
+type CashFlowTotal struct {
+	Month   string
+	Income  monetary.Monetary
+	Expense monetary.Monetary
+}
+
+// CashFlowMonth is the income and expenses of a month and what's left of the
+// income once the expenses are taken away
+type CashFlowMonth struct {
+	Month   string
+	Income  monetary.Monetary
+	Expense monetary.Monetary
+	Net     monetary.Monetary
+}
+
+// CashFlowSeries is the cash flow of every month of a report in one asset,
+// with the totals of the period
+type CashFlowSeries struct {
+	Asset   monetary.Asset
+	Months  []CashFlowMonth
+	Income  monetary.Monetary
+	Expense monetary.Monetary
+	Net     monetary.Monetary
+}
+
+// CashFlowReport is the income and expenses per month from From to To, of
+// the account AccountID or of the whole book when empty, per asset since
+// amounts of different currencies can't be added up
+type CashFlowReport struct {
+	From      time.Time
+	To        time.Time
+	AccountID string
+	Series    []CashFlowSeries
+}
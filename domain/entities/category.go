@@ -12,13 +12,24 @@ const (
 	CategoryTypeExpense CategoryType = "expense"
 )
 
-// Category represents a transaction category
+// CategoryTypes lists every valid category type
+var CategoryTypes = []CategoryType{
+	CategoryTypeIncome,
+	CategoryTypeExpense,
+}
+
+// Category represents a transaction category. ParentID is the category
+// grouping it, e.g. Food over Restaurants and Groceries, empty for the
+// categories at the top. Groups are one level deep.
 type Category struct {
 	ID          string       `json:"id" db:"id"`
 	Name        string       `json:"name" db:"name"`
 	Type        CategoryType `json:"type" db:"type"`
 	Description string       `json:"description" db:"description"`
 	Color       string       `json:"color" db:"color"`
+	ParentID    string       `json:"parent_id,omitempty" db:"parent_id"`
+	BookID      string       `json:"book_id" db:"book_id"`
+	OwnerID     string       `json:"owner_id,omitempty" db:"user_id"`
 	CreatedAt   time.Time    `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time    `json:"updated_at" db:"updated_at"`
 }
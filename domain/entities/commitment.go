@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// MaxCommitmentMonths is how far ahead the commitments report can look
+const MaxCommitmentMonths = 24
+
+// CommitmentKind tells where an obligated outflow comes from
+type CommitmentKind string
+
+const (
+	// CommitmentKindFatura is a credit card fatura due in the month, holding the
+	// card purchases and their installments
+	CommitmentKindFatura CommitmentKind = "fatura"
+	// CommitmentKindInstallment is an installment of a purchase on an account
+	// without a billing cycle
+	CommitmentKindInstallment CommitmentKind = "installment"
+	// CommitmentKindBill is a pending expense, like a bill scheduled to be paid
+	CommitmentKindBill CommitmentKind = "bill"
+)
+
+// Commitment is an outflow already spoken for, dated the day it's due. Amount
+// is its size, whatever the sign of its effect on the account.
+type Commitment struct {
+	Kind          CommitmentKind
+	AccountID     string
+	TransactionID string
+	Description   string
+	Date          time.Time
+	Amount        monetary.Monetary
+}
+
+// CommitmentMonth adds up the commitments due in a month (e.g. "2025-04")
+// against the income expected in it, the average monthly income of the three
+// months before the report. Share is the percentage of Income committed, zero
+// without income.
+type CommitmentMonth struct {
+	Month        string
+	Faturas      monetary.Monetary
+	Installments monetary.Monetary
+	Bills        monetary.Monetary
+	Committed    monetary.Monetary
+	Income       monetary.Monetary
+	Available    monetary.Monetary
+	Share        float64
+	Commitments  []Commitment
+}
+
+// CommitmentReport is the calendar of the outflows committed in one asset,
+// month by month starting with the current one
+type CommitmentReport struct {
+	Asset  monetary.Asset
+	Months []CommitmentMonth
+}
@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// ExchangeRates maps currencies to how much of the reporting currency one unit
+// of them is worth
+type ExchangeRates map[string]*big.Rat
+
+// ConsolidatedTotals is the net worth of a set of accounts and the cash flow of
+// their pending and cleared transactions over a period, in a single currency.
+// Liabilities is what's owed, NetWorth the assets less the liabilities.
+type ConsolidatedTotals struct {
+	Assets      monetary.Monetary
+	Liabilities monetary.Monetary
+	NetWorth    monetary.Monetary
+	Income      monetary.Monetary
+	Expenses    monetary.Monetary
+	NetCashFlow monetary.Monetary
+}
+
+// BookConsolidation is the share of a book in a consolidated report, in the
+// book's base currency and converted to the reporting one
+type BookConsolidation struct {
+	Book      Book
+	Base      ConsolidatedTotals
+	Reporting ConsolidatedTotals
+}
+
+// ConsolidatedReport merges the net worth and cash flow of every book into the
+// reporting currency, Asset. Totals add up the converted totals of the books.
+type ConsolidatedReport struct {
+	Asset  monetary.Asset
+	From   time.Time
+	To     time.Time
+	Totals ConsolidatedTotals
+	Books  []BookConsolidation
+}
@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// CubeQuery pivots the pending and cleared transactions from From to To,
+// grouped by Rows down and by Cols across, each cell showing Measure. Without
+// From the cube starts with the first transaction of the book, without To it
+// ends today.
+type CubeQuery struct {
+	Rows    ReportDimension
+	Cols    ReportDimension
+	Measure ReportMeasure
+	From    time.Time
+	To      time.Time
+}
+
+// CubeGroup adds up the transactions in one asset of a cell of a cube, keyed
+// by its Row and Col, or of a total: AllCols adds up a whole row, AllRows a
+// whole column and both the whole cube, the keys added up being empty
+type CubeGroup struct {
+	Row     string
+	Col     string
+	AllRows bool
+	AllCols bool
+	Count   int
+	Sum     monetary.Monetary
+}
+
+// CubeTotal is the measures of a cell or a total of a cube. Average is
+// rounded to the smallest unit of the asset.
+type CubeTotal struct {
+	Count   int
+	Sum     monetary.Monetary
+	Average monetary.Monetary
+}
+
+// Cube is the pivot asked for by Query, To set to the day it ends. Amounts of
+// different currencies are never added up: each asset has its own table.
+type Cube struct {
+	Query  CubeQuery
+	Tables []CubeTable
+}
+
+// CubeTable is the pivot of the transactions in one asset. Cells holds a row
+// per key of Rows with a cell per key of Cols, nil when no transaction falls
+// in it. Amounts are signed by the type of their category, like in custom
+// reports.
+type CubeTable struct {
+	Asset     monetary.Asset
+	Rows      []ReportKey
+	Cols      []ReportKey
+	Cells     [][]*CubeTotal
+	RowTotals []CubeTotal
+	ColTotals []CubeTotal
+	Total     CubeTotal
+}
@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// ReportDimension is what the transactions of a custom report are grouped by
+type ReportDimension string
+
+const (
+	ReportDimensionCategory ReportDimension = "category"
+	ReportDimensionAccount  ReportDimension = "account"
+	ReportDimensionPayee    ReportDimension = "payee"
+	// ReportDimensionMonth groups the transactions by the calendar month of
+	// their date
+	ReportDimensionMonth ReportDimension = "month"
+)
+
+// ReportDimensions lists every valid report dimension
+var ReportDimensions = []ReportDimension{
+	ReportDimensionCategory,
+	ReportDimensionAccount,
+	ReportDimensionPayee,
+	ReportDimensionMonth,
+}
+
+// ReportMeasure is how the amounts of the transactions of a group are added up
+type ReportMeasure string
+
+const (
+	ReportMeasureSum   ReportMeasure = "sum"
+	ReportMeasureCount ReportMeasure = "count"
+	ReportMeasureAvg   ReportMeasure = "avg"
+)
+
+// ReportMeasures lists every valid report measure
+var ReportMeasures = []ReportMeasure{
+	ReportMeasureSum,
+	ReportMeasureCount,
+	ReportMeasureAvg,
+}
+
+// ReportFilter narrows down the transactions of a custom report, empty fields
+// match any transaction. Without From the report starts with the first
+// transaction of the book, without To it ends today. Payees are matched
+// regardless of case.
+type ReportFilter struct {
+	From         time.Time
+	To           time.Time
+	AccountIDs   []string
+	CategoryIDs  []string
+	CategoryType CategoryType
+	Payees       []string
+}
+
+// ReportDefinition is a report built by a user: the pending and cleared
+// transactions matching Filter, grouped by Dimensions in their order, with
+// their amounts added up by Measures. Without dimensions every transaction
+// falls in a single group.
+type ReportDefinition struct {
+	ID         string
+	Name       string
+	Dimensions []ReportDimension
+	Measures   []ReportMeasure
+	Filter     ReportFilter
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// CustomReport is a report definition run over the transactions from From to
+// To. Amounts are signed by the type of their category, income adding and
+// expenses taking away, so a report not grouped by category adds up to the
+// cash flow.
+type CustomReport struct {
+	Definition ReportDefinition
+	From       time.Time
+	To         time.Time
+	Rows       []CustomReportRow
+}
+
+// ReportKey is the value of a dimension for a group of transactions: the ID
+// of its category or account with its name as Label, its payee or its month
+// (e.g. "2025-04")
+type ReportKey struct {
+	Dimension ReportDimension
+	Value     string
+	Label     string
+}
+
+// CustomReportRow is a group of transactions of a custom report, with a key
+// per dimension of the definition. Amounts of different currencies are never
+// added up: each group has a row per asset. Every measure is calculated,
+// Average being rounded to the smallest unit of the asset.
+type CustomReportRow struct {
+	Keys    []ReportKey
+	Count   int
+	Sum     monetary.Monetary
+	Average monetary.Monetary
+}
@@ -0,0 +1,11 @@
+package entities
+
+// UserSettingDemoAccounts holds the comma separated IDs of the accounts created for
+// the demo sandbox. It is managed by the demo mode and can't be set directly.
+const UserSettingDemoAccounts UserSettingKey = "demo_accounts"
+
+// DemoStatus tells whether the sample dataset is loaded and which accounts it made
+type DemoStatus struct {
+	Active     bool
+	AccountIDs []string
+}
@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// ExpenseReportStatus tells where an expense report is in its review
+type ExpenseReportStatus string
+
+const (
+	// ExpenseReportStatusDraft is a report still being put together, the only
+	// one whose details and transactions can change
+	ExpenseReportStatusDraft ExpenseReportStatus = "draft"
+	// ExpenseReportStatusSubmitted is a report waiting to be approved or
+	// rejected
+	ExpenseReportStatusSubmitted ExpenseReportStatus = "submitted"
+	ExpenseReportStatusApproved  ExpenseReportStatus = "approved"
+	ExpenseReportStatusRejected  ExpenseReportStatus = "rejected"
+)
+
+// ExpenseReportStatuses lists every expense report status, in review order
+var ExpenseReportStatuses = []ExpenseReportStatus{
+	ExpenseReportStatusDraft,
+	ExpenseReportStatusSubmitted,
+	ExpenseReportStatusApproved,
+	ExpenseReportStatusRejected,
+}
+
+// ExpenseReportFormat names a format expense reports are exported to
+type ExpenseReportFormat string
+
+const (
+	ExpenseReportFormatCSV ExpenseReportFormat = "csv"
+	ExpenseReportFormatPDF ExpenseReportFormat = "pdf"
+)
+
+// ExpenseReport groups the work expenses of a period, like a trip, to have
+// them reimbursed. Its transactions are expenses dated from StartDate to
+// EndDate, and Totals adds up their size per asset. SubmittedAt and
+// ReviewedAt are nil until the report is submitted and approved or rejected.
+type ExpenseReport struct {
+	ID           string
+	Name         string
+	StartDate    time.Time
+	EndDate      time.Time
+	Notes        string
+	Status       ExpenseReportStatus
+	SubmittedAt  *time.Time
+	ReviewedAt   *time.Time
+	BookID       string
+	OwnerID      string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Transactions []Transaction
+	Totals       []monetary.Monetary
+}
+
+// ExpenseReportExport is an expense report rendered as a file
+type ExpenseReportExport struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// FaturaStatus tells where a fatura is in its billing cycle
+type FaturaStatus string
+
+const (
+	// FaturaStatusFuture is a fatura whose cycle hasn't started, holding only the
+	// installments of earlier purchases
+	FaturaStatusFuture FaturaStatus = "future"
+	// FaturaStatusOpen is the fatura taking the purchases made today
+	FaturaStatusOpen FaturaStatus = "open"
+	// FaturaStatusClosed is a fatura past its closing date, waiting to be paid
+	FaturaStatusClosed FaturaStatus = "closed"
+)
+
+// FaturaStatuses lists every fatura status, in billing cycle order
+var FaturaStatuses = []FaturaStatus{
+	FaturaStatusFuture,
+	FaturaStatusOpen,
+	FaturaStatusClosed,
+}
+
+// Fatura is the monthly statement of a credit card, named after the month it's
+// due (e.g. "2025-04"). It takes the pending and cleared transactions dated
+// from the previous closing date, From, up to the day before its ClosingDate:
+// purchases made on the closing day go to the next fatura, as Brazilian cards
+// do. Charges adds up what raised the balance owed, Credits what lowered it,
+// like refunds and payments, and Total is what the fatura adds to the balance
+// owed.
+type Fatura struct {
+	Account      Account
+	Month        string
+	From         time.Time
+	ClosingDate  time.Time
+	DueDate      time.Time
+	Status       FaturaStatus
+	Transactions []Transaction
+	Charges      monetary.Monetary
+	Credits      monetary.Monetary
+	Total        monetary.Monetary
+}
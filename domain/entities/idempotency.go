@@ -0,0 +1,31 @@
+package entities
+
+import "time"
+
+const (
+	// IdempotencyKeyTTL is how long the response to a request made with an
+	// idempotency key is replayed to its retries
+	IdempotencyKeyTTL = 24 * time.Hour
+	// MaxIdempotencyKeyLength is the longest idempotency key taken
+	MaxIdempotencyKeyLength = 255
+	// MaxIdempotentResponseSize is the largest response kept for the retries
+	// of a request, the larger ones aren't replayed
+	MaxIdempotentResponseSize = 1 << 20
+)
+
+// IdempotentRequest is a write made with an idempotency key, told apart from
+// another request made with the same key by its Fingerprint. Response is nil
+// while the request is still being handled.
+type IdempotentRequest struct {
+	Key         string
+	Fingerprint string
+	Response    *IdempotentResponse
+	CreatedAt   time.Time
+}
+
+// IdempotentResponse is the response to a request replayed to its retries
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
@@ -0,0 +1,73 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// MaxInstallments caps installment plans at four years of statements, longer
+// than the plans cards offer
+const MaxInstallments = 48
+
+// InstallmentPlan is a purchase paid in installments (parcelamento). Amount is
+// the total, charged as Count monthly transactions from FirstDate. PaidOffOn is
+// the day the remaining installments were paid in advance, zero while the plan
+// runs.
+type InstallmentPlan struct {
+	ID          string
+	AccountID   string
+	Description string
+	Amount      monetary.Monetary
+	Count       int
+	FirstDate   time.Time
+	PaidOffOn   time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	// Installments are the linked transactions, in order. Paid counts the ones
+	// dated up to today, RemainingCount the ones still to be charged and
+	// Remaining adds them up. NextDate is the date of the next one, zero once
+	// all were charged. Cancelled installments count in neither.
+	Installments   []Transaction
+	Paid           int
+	RemainingCount int
+	Remaining      monetary.Monetary
+	NextDate       time.Time
+}
+
+// PaidOff tells whether the remaining installments were paid in advance
+func (p InstallmentPlan) PaidOff() bool {
+	return !p.PaidOffOn.IsZero()
+}
+
+// InstallmentCommitments adds up what the installment plans in one asset
+// still have to charge, month by month
+type InstallmentCommitments struct {
+	Plans     int
+	Remaining monetary.Monetary
+	Months    []InstallmentCommitmentMonth
+}
+
+// InstallmentCommitmentMonth is what the installments dated in a month
+// (YYYY-MM) charge
+type InstallmentCommitmentMonth struct {
+	Month        string
+	Installments int
+	Amount       monetary.Monetary
+}
+
+// InstallmentPayoff settles the remaining installments of a plan in advance on
+// Date. Each one is discounted at MonthlyRate percent for every month it's
+// brought forward, so Amount is Remaining less Discount. Transaction is the
+// charge created once the plan is paid off.
+type InstallmentPayoff struct {
+	Plan         InstallmentPlan
+	Date         time.Time
+	MonthlyRate  float64
+	Installments int
+	Remaining    monetary.Monetary
+	Discount     monetary.Monetary
+	Amount       monetary.Monetary
+	Transaction  *Transaction
+}
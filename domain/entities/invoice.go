@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// InvoiceStatus tells whether an invoice was paid, computed from its payment
+// and due date
+type InvoiceStatus string
+
+const (
+	InvoiceStatusOutstanding InvoiceStatus = "outstanding"
+	// InvoiceStatusOverdue is an outstanding invoice past its due date
+	InvoiceStatusOverdue InvoiceStatus = "overdue"
+	InvoiceStatusPaid    InvoiceStatus = "paid"
+)
+
+// InvoiceStatuses lists every invoice status
+var InvoiceStatuses = []InvoiceStatus{
+	InvoiceStatusOutstanding,
+	InvoiceStatusOverdue,
+	InvoiceStatusPaid,
+}
+
+// Invoice is an invoice issued to a client, to be paid into AccountID in the
+// account's asset. TransactionID is the incoming transaction that paid it,
+// empty while it's receivable. Status, DaysOverdue and Payment are filled in
+// when the invoice is read.
+type Invoice struct {
+	ID            string
+	AccountID     string
+	Client        string
+	Number        string
+	Description   string
+	Amount        monetary.Monetary
+	IssueDate     time.Time
+	DueDate       time.Time
+	TransactionID string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	Status      InvoiceStatus
+	DaysOverdue int
+	Payment     *Transaction
+}
+
+// Receivables adds up the invoices of an asset still to be paid, next to the
+// balance of the accounts in that asset. Ledger is the net balance of the
+// accounts, assets minus liabilities, and Total adds what clients owe to it.
+type Receivables struct {
+	Asset       monetary.Asset
+	Invoices    int
+	Outstanding monetary.Monetary
+	Overdue     monetary.Monetary
+	Ledger      monetary.Monetary
+	Total       monetary.Monetary
+	Clients     []ClientReceivables
+}
+
+// ClientReceivables is what a client owes, OldestDueDate being the due date
+// of its oldest unpaid invoice
+type ClientReceivables struct {
+	Client        string
+	Invoices      int
+	Outstanding   monetary.Monetary
+	Overdue       monetary.Monetary
+	OldestDueDate time.Time
+}
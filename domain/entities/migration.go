@@ -0,0 +1,23 @@
+package entities
+
+// Migration is a database schema migration shipped with the service
+type Migration struct {
+	Version int64
+	Name    string
+}
+
+// MigrationStatus compares the migrations shipped with the service with the
+// schema version recorded in the database. Dirty means the last migration
+// failed halfway and has to be fixed by hand.
+type MigrationStatus struct {
+	SchemaVersion int64
+	LatestVersion int64
+	Dirty         bool
+	Applied       []Migration
+	Pending       []Migration
+}
+
+// Current reports whether every shipped migration is cleanly applied
+func (s MigrationStatus) Current() bool {
+	return !s.Dirty && len(s.Pending) == 0
+}
@@ -0,0 +1,184 @@
+package entities
+
+import (
+	"errors"
+	"finance/domain"
+	"fmt"
+	"math/big"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// Money is an amount in the smallest unit of a single asset. Arithmetic between
+// amounts of different assets fails with domain.ErrAssetMismatch instead of
+// silently mixing currencies.
+type Money struct {
+	Asset  monetary.Asset
+	Amount *big.Int
+}
+
+// NewMoney creates a Money of amount, in the asset's smallest unit
+func NewMoney(asset monetary.Asset, amount int64) Money {
+	return Money{Asset: asset, Amount: big.NewInt(amount)}
+}
+
+// MoneyOf copies a monetary value into a Money
+func MoneyOf(m monetary.Monetary) Money {
+	return Money{Asset: m.Asset, Amount: new(big.Int).Set(amountOf(m.Amount))}
+}
+
+// Monetary returns the value as a monetary.Monetary, as used by the entities
+func (m Money) Monetary() monetary.Monetary {
+	return monetary.Monetary{Asset: m.Asset, Amount: new(big.Int).Set(m.amount())}
+}
+
+// Add returns m + other
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.sameAsset(other); err != nil {
+		return Money{}, fmt.Errorf("cannot add %s to %s: %w", other.Asset.Asset, m.Asset.Asset, err)
+	}
+	return Money{Asset: m.Asset, Amount: new(big.Int).Add(m.amount(), other.amount())}, nil
+}
+
+// Sub returns m - other
+func (m Money) Sub(other Money) (Money, error) {
+	if err := m.sameAsset(other); err != nil {
+		return Money{}, fmt.Errorf("cannot subtract %s from %s: %w", other.Asset.Asset, m.Asset.Asset, err)
+	}
+	return Money{Asset: m.Asset, Amount: new(big.Int).Sub(m.amount(), other.amount())}, nil
+}
+
+// Neg returns -m
+func (m Money) Neg() Money {
+	return Money{Asset: m.Asset, Amount: new(big.Int).Neg(m.amount())}
+}
+
+// Abs returns |m|
+func (m Money) Abs() Money {
+	return Money{Asset: m.Asset, Amount: new(big.Int).Abs(m.amount())}
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of the amount
+func (m Money) Sign() int {
+	return m.amount().Sign()
+}
+
+// IsZero reports whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.Sign() == 0
+}
+
+// Cmp compares m and other and returns -1, 0 or 1 when m is less than, equal to
+// or greater than other
+func (m Money) Cmp(other Money) (int, error) {
+	if err := m.sameAsset(other); err != nil {
+		return 0, fmt.Errorf("cannot compare %s with %s: %w", m.Asset.Asset, other.Asset.Asset, err)
+	}
+	return m.amount().Cmp(other.amount()), nil
+}
+
+// Allocate splits m proportionally to ratios. Shares are rounded towards zero and the
+// units left over are handed out one by one, starting from the first share, so the
+// shares always add up to m.
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	if len(ratios) == 0 {
+		return nil, errors.New("at least one ratio is required")
+	}
+
+	total := new(big.Int)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, fmt.Errorf("invalid ratio: %d", ratio)
+		}
+		total.Add(total, big.NewInt(int64(ratio)))
+	}
+	if total.Sign() == 0 {
+		return nil, errors.New("ratios cannot add up to zero")
+	}
+
+	shares := make([]Money, len(ratios))
+	remainder := new(big.Int).Set(m.amount())
+	for i, ratio := range ratios {
+		share := new(big.Int).Mul(m.amount(), big.NewInt(int64(ratio)))
+		share.Quo(share, total)
+		remainder.Sub(remainder, share)
+		shares[i] = Money{Asset: m.Asset, Amount: share}
+	}
+
+	unit := big.NewInt(int64(remainder.Sign()))
+	for i := 0; remainder.Sign() != 0; i = (i + 1) % len(shares) {
+		if ratios[i] == 0 {
+			continue
+		}
+		shares[i].Amount.Add(shares[i].Amount, unit)
+		remainder.Sub(remainder, unit)
+	}
+
+	return shares, nil
+}
+
+// Split splits m into n shares as even as possible
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid number of shares: %d", n)
+	}
+
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+// SumMoney adds values up, starting from zero in asset
+func SumMoney(asset monetary.Asset, values ...Money) (Money, error) {
+	sum := NewMoney(asset, 0)
+	for _, value := range values {
+		var err error
+		if sum, err = sum.Add(value); err != nil {
+			return Money{}, err
+		}
+	}
+	return sum, nil
+}
+
+// Convert converts m into asset at rate, how much of asset one unit of m's
+// asset is worth, rounding half away from zero to the smallest unit of asset
+func (m Money) Convert(asset monetary.Asset, rate *big.Rat) Money {
+	value := new(big.Rat).SetInt(m.amount())
+	value.Mul(value, rate)
+	value.Mul(value, new(big.Rat).SetFrac(pow10(asset.Precision), pow10(m.Asset.Precision)))
+
+	quo, rem := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if new(big.Int).Abs(new(big.Int).Lsh(rem, 1)).Cmp(value.Denom()) >= 0 {
+		quo.Add(quo, big.NewInt(int64(value.Sign())))
+	}
+	return Money{Asset: asset, Amount: quo}
+}
+
+func (m Money) String() string {
+	value := m.Monetary()
+	return value.String()
+}
+
+func (m Money) amount() *big.Int {
+	return amountOf(m.Amount)
+}
+
+func (m Money) sameAsset(other Money) error {
+	if m.Asset.Asset != other.Asset.Asset {
+		return domain.ErrAssetMismatch
+	}
+	return nil
+}
+
+func pow10(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+func amountOf(amount *big.Int) *big.Int {
+	if amount == nil {
+		return new(big.Int)
+	}
+	return amount
+}
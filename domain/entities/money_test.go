@@ -0,0 +1,158 @@
+package entities
+
+import (
+	"finance/domain"
+	"math/big"
+	"testing"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoneyArithmetic(t *testing.T) {
+	a := NewMoney(monetary.BRL, 1050)
+	b := NewMoney(monetary.BRL, -300)
+
+	sum, err := a.Add(b)
+	require.NoError(t, err)
+	assert.Equal(t, int64(750), sum.Amount.Int64())
+
+	diff, err := a.Sub(b)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1350), diff.Amount.Int64())
+
+	assert.Equal(t, int64(-1050), a.Neg().Amount.Int64())
+	assert.Equal(t, int64(300), b.Abs().Amount.Int64())
+	assert.Equal(t, int64(1050), a.Amount.Int64(), "operands must not be modified")
+
+	cmp, err := a.Cmp(b)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cmp)
+
+	assert.True(t, Money{Asset: monetary.BRL}.IsZero())
+}
+
+func TestMoneyAssetMismatch(t *testing.T) {
+	brl := NewMoney(monetary.BRL, 100)
+	usd := NewMoney(monetary.USD, 100)
+
+	_, err := brl.Add(usd)
+	assert.ErrorIs(t, err, domain.ErrAssetMismatch)
+
+	_, err = brl.Sub(usd)
+	assert.ErrorIs(t, err, domain.ErrAssetMismatch)
+
+	_, err = brl.Cmp(usd)
+	assert.ErrorIs(t, err, domain.ErrAssetMismatch)
+
+	_, err = SumMoney(monetary.BRL, brl, usd)
+	assert.ErrorIs(t, err, domain.ErrAssetMismatch)
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int64
+		ratios  []int
+		want    []int64
+		wantErr string
+	}{
+		{
+			name:   "even split",
+			amount: 900,
+			ratios: []int{1, 1, 1},
+			want:   []int64{300, 300, 300},
+		},
+		{
+			name:   "remainder goes to the first shares",
+			amount: 1000,
+			ratios: []int{1, 1, 1},
+			want:   []int64{334, 333, 333},
+		},
+		{
+			name:   "negative amount",
+			amount: -1000,
+			ratios: []int{1, 1, 1},
+			want:   []int64{-334, -333, -333},
+		},
+		{
+			name:   "weighted",
+			amount: 5,
+			ratios: []int{70, 30},
+			want:   []int64{4, 1},
+		},
+		{
+			name:   "zero ratio gets nothing",
+			amount: 101,
+			ratios: []int{0, 1, 1},
+			want:   []int64{0, 51, 50},
+		},
+		{
+			name:    "no ratios",
+			amount:  100,
+			wantErr: "at least one ratio is required",
+		},
+		{
+			name:    "negative ratio",
+			amount:  100,
+			ratios:  []int{1, -1},
+			wantErr: "invalid ratio: -1",
+		},
+		{
+			name:    "zero ratios",
+			amount:  100,
+			ratios:  []int{0, 0},
+			wantErr: "ratios cannot add up to zero",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares, err := NewMoney(monetary.USD, tt.amount).Allocate(tt.ratios...)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var got []int64
+			for _, share := range shares {
+				assert.Equal(t, monetary.USD, share.Asset)
+				got = append(got, share.Amount.Int64())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoneySplit(t *testing.T) {
+	shares, err := NewMoney(monetary.BRL, 100).Split(3)
+	require.NoError(t, err)
+	require.Len(t, shares, 3)
+
+	total, err := SumMoney(monetary.BRL, shares...)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), total.Amount.Int64())
+
+	_, err = NewMoney(monetary.BRL, 100).Split(0)
+	assert.EqualError(t, err, "invalid number of shares: 0")
+}
+
+func TestMoneyConvert(t *testing.T) {
+	jpy, _ := monetary.FindAssetByName("JPY")
+
+	// R$ 100.00 at 0.18 USD per BRL
+	usd := NewMoney(monetary.BRL, 10000).Convert(monetary.USD, big.NewRat(18, 100))
+	assert.Equal(t, "USD", usd.Asset.Asset)
+	assert.Equal(t, int64(1800), usd.Amount.Int64())
+
+	// Between assets with a different number of decimals
+	assert.Equal(t, int64(15000), NewMoney(monetary.USD, 10000).Convert(jpy, big.NewRat(150, 1)).Amount.Int64())
+	assert.Equal(t, int64(667), NewMoney(jpy, 1000).Convert(monetary.USD, big.NewRat(1, 150)).Amount.Int64())
+
+	// Halves round away from zero
+	assert.Equal(t, int64(3), NewMoney(monetary.BRL, 5).Convert(monetary.USD, big.NewRat(1, 2)).Amount.Int64())
+	assert.Equal(t, int64(-3), NewMoney(monetary.BRL, -5).Convert(monetary.USD, big.NewRat(1, 2)).Amount.Int64())
+	assert.Equal(t, int64(2), NewMoney(monetary.BRL, 5).Convert(monetary.USD, big.NewRat(2, 5)).Amount.Int64())
+}
@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// CategoryTotal adds up the pending and cleared transactions of a category in
+// one currency, whether they were paid from a checking account or charged to
+// a card. Name and Type are kept as they were when the report was calculated.
+type CategoryTotal struct {
+	CategoryID string
+	Name       string
+	Type       CategoryType
+	Total      monetary.Monetary
+}
+
+// MonthlyReport is what a book went through in a calendar month: the totals of
+// its categories and its net worth at the end of the month, per currency.
+// Month is the first day of the month. Once the month is closed the report is
+// kept as a snapshot, taken at SnapshotAt, which is zero while the report is
+// calculated from the current transactions.
+type MonthlyReport struct {
+	Month      time.Time
+	Categories []CategoryTotal
+	NetWorth   []monetary.Monetary
+	SnapshotAt time.Time
+}
+
+// Snapshot reports whether the report was served from its snapshot
+func (r MonthlyReport) Snapshot() bool {
+	return !r.SnapshotAt.IsZero()
+}
@@ -0,0 +1,31 @@
+package entities
+
+// OnboardingStep is a step of the first-run setup
+type OnboardingStep string
+
+const (
+	OnboardingStepBaseCurrency OnboardingStep = "base_currency"
+	OnboardingStepCategories   OnboardingStep = "categories"
+	OnboardingStepAccount      OnboardingStep = "account"
+)
+
+// OnboardingSteps lists the setup steps in the order they are walked through
+var OnboardingSteps = []OnboardingStep{
+	OnboardingStepBaseCurrency,
+	OnboardingStepCategories,
+	OnboardingStepAccount,
+}
+
+// OnboardingStepStatus tells whether a setup step is already done
+type OnboardingStepStatus struct {
+	Step OnboardingStep
+	Done bool
+}
+
+// OnboardingStatus reports the progress of the first-run setup. Onboarding is
+// completed once the first account exists, NextStep is the first step left to do.
+type OnboardingStatus struct {
+	Completed bool
+	NextStep  OnboardingStep
+	Steps     []OnboardingStepStatus
+}
@@ -0,0 +1,15 @@
+package entities
+
+// Page is a slice of a list, Limit items after the first Offset ones, with the
+// Total number of items in the list
+type Page[T any] struct {
+	Items  []T
+	Total  int64
+	Limit  int
+	Offset int
+}
+
+// HasNext reports whether there are items after the page
+func (p Page[T]) HasNext() bool {
+	return int64(p.Offset+len(p.Items)) < p.Total
+}
@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// Project is a piece of work, optionally done for a client, that the
+// transactions of its book can be filed under to tell what it earned and cost
+type Project struct {
+	ID          string
+	Name        string
+	Client      string
+	Description string
+	BookID      string
+	OwnerID     string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ProjectProfit adds up the income and expenses of a project's pending and
+// cleared transactions dated between From and To, zero when unbounded. Totals
+// has one entry per asset.
+type ProjectProfit struct {
+	Project          Project
+	From             time.Time
+	To               time.Time
+	TransactionCount int
+	Totals           []ProjectTotal
+}
+
+// ProjectTotal is the profit of a project in one asset, Income minus Expenses
+type ProjectTotal struct {
+	Asset    monetary.Asset
+	Income   monetary.Monetary
+	Expenses monetary.Monetary
+	Profit   monetary.Monetary
+}
@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// QueryIntent is what a natural language question asks for
+type QueryIntent string
+
+const (
+	// QueryIntentSpending adds up the expenses of a period
+	QueryIntentSpending QueryIntent = "spending"
+	// QueryIntentIncome adds up the income of a period
+	QueryIntentIncome QueryIntent = "income"
+	// QueryIntentNetWorth is the net worth of all accounts
+	QueryIntentNetWorth QueryIntent = "net_worth"
+	// QueryIntentBalance is the current balance of an account
+	QueryIntentBalance QueryIntent = "balance"
+)
+
+// Query is a question translated into one of the reports
+type Query struct {
+	Intent QueryIntent
+	// From and To bound the transactions added up by the spending and income
+	// intents, both inclusive
+	From time.Time
+	To   time.Time
+	// CategoryID and AccountID narrow the transactions added up, AccountID
+	// is the account of the balance intent
+	CategoryID string
+	AccountID  string
+}
+
+// QueryVocabulary is what a question can refer to: the categories and
+// accounts by name, and periods relative to Now
+type QueryVocabulary struct {
+	Categories []Category
+	Accounts   []Account
+	Now        time.Time
+}
+
+// QueryResult answers a Query. Totals has one amount per asset, since amounts
+// of different assets can't be added up, and is empty when nothing matched.
+type QueryResult struct {
+	Query  Query
+	Totals []monetary.Monetary
+	// TransactionCount is how many transactions were added up
+	TransactionCount int
+}
@@ -0,0 +1,28 @@
+package entities
+
+// QuickCaptureSource tells how a field of a quick capture was picked
+type QuickCaptureSource string
+
+const (
+	// QuickCaptureSourceRequest fields were given alongside the text
+	QuickCaptureSourceRequest QuickCaptureSource = "request"
+	// QuickCaptureSourceText fields were named in the text
+	QuickCaptureSourceText QuickCaptureSource = "text"
+	// QuickCaptureSourceHistory fields were suggested by the latest
+	// transaction with the same description
+	QuickCaptureSourceHistory QuickCaptureSource = "history"
+	// QuickCaptureSourceDefault accounts are the most used one
+	QuickCaptureSourceDefault QuickCaptureSource = "default"
+)
+
+// QuickCaptureHistory is how many of the latest transactions are searched
+// for a suggestion
+const QuickCaptureHistory = 500
+
+// QuickCapture is a transaction parsed from a short free-text note like
+// "coffee 12.50 food", ready to be created
+type QuickCapture struct {
+	Transaction    Transaction
+	CategorySource QuickCaptureSource
+	AccountSource  QuickCaptureSource
+}
@@ -0,0 +1,66 @@
+package entities
+
+import "time"
+
+// RestorePointOperation names the bulk operation a restore point was taken for
+type RestorePointOperation string
+
+const (
+	RestorePointOperationStatementImport RestorePointOperation = "statement_import"
+	RestorePointOperationCSVImport       RestorePointOperation = "csv_import"
+)
+
+// RestorePointAction tells how a bulk operation changed a row
+type RestorePointAction string
+
+const (
+	RestorePointActionCreated RestorePointAction = "created"
+	RestorePointActionUpdated RestorePointAction = "updated"
+)
+
+// RestorePointEntity names the kind of row a change was made to
+type RestorePointEntity string
+
+const (
+	RestorePointEntityAccount     RestorePointEntity = "account"
+	RestorePointEntityTransaction RestorePointEntity = "transaction"
+)
+
+// RestorePointChange is a row changed by a bulk operation. AccountID is the
+// account of a transaction, whose balance is refreshed on rollback. Before is
+// the transaction as it was, for updated transactions.
+type RestorePointChange struct {
+	Entity    RestorePointEntity
+	Action    RestorePointAction
+	ID        string
+	AccountID string
+	Before    *Transaction
+}
+
+// RestorePoint records the rows a bulk operation changed, in the order it
+// changed them, so the operation can be rolled back when it went wrong.
+// RolledBackAt is set once it was.
+type RestorePoint struct {
+	ID           string
+	Operation    RestorePointOperation
+	Description  string
+	Changes      []RestorePointChange
+	CreatedAt    time.Time
+	RolledBackAt *time.Time
+}
+
+// Created records a row created by the operation
+func (p *RestorePoint) Created(entity RestorePointEntity, id, accountID string) {
+	p.Changes = append(p.Changes, RestorePointChange{Entity: entity, Action: RestorePointActionCreated, ID: id, AccountID: accountID})
+}
+
+// Updated records the state of a transaction before the operation updated it
+func (p *RestorePoint) Updated(before Transaction) {
+	p.Changes = append(p.Changes, RestorePointChange{
+		Entity:    RestorePointEntityTransaction,
+		Action:    RestorePointActionUpdated,
+		ID:        before.ID,
+		AccountID: before.AccountID,
+		Before:    &before,
+	})
+}
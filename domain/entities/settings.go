@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// Settings represents the application wide configuration managed from the admin page
+type Settings struct {
+	Currency             monetary.Asset    `json:"currency" db:"currency"`
+	Locale               string            `json:"locale" db:"locale"`
+	FiscalMonthStartDay  int               `json:"fiscal_month_start_day" db:"fiscal_month_start_day"`
+	NotificationsEnabled bool              `json:"notifications_enabled" db:"notifications_enabled"`
+	NotificationEmail    string            `json:"notification_email" db:"notification_email"`
+	APIKeys              map[string]string `json:"api_keys" db:"api_keys"`
+	UpdatedAt            time.Time         `json:"updated_at" db:"updated_at"`
+}
@@ -0,0 +1,78 @@
+package entities
+
+import "github.com/guilhermebr/gox/monetary"
+
+const (
+	// DefaultSimulationMonths is how many months a simulation projects when
+	// not asked for
+	DefaultSimulationMonths = 12
+	// MaxSimulationMonths is how far ahead a simulation can project
+	MaxSimulationMonths = 24
+	// MaxSimulationScenarios caps the scenarios compared in a simulation
+	MaxSimulationScenarios = 5
+)
+
+// BaselineScenario names the projection without any change, the one the
+// scenarios are compared with
+const BaselineScenario = "baseline"
+
+// SimulationChangeType tells what a hypothetical change does
+type SimulationChangeType string
+
+const (
+	// SimulationChangeCategory scales the monthly amount of a category and its
+	// subcategories by Percent, -30 cutting it by 30%
+	SimulationChangeCategory SimulationChangeType = "category"
+	// SimulationChangeIncome adds Amount of income every month
+	SimulationChangeIncome SimulationChangeType = "income"
+	// SimulationChangeExpense adds Amount of expenses every month, like a loan
+	// payment
+	SimulationChangeExpense SimulationChangeType = "expense"
+)
+
+var SimulationChangeTypes = []SimulationChangeType{SimulationChangeCategory, SimulationChangeIncome, SimulationChangeExpense}
+
+// SimulationChange is a hypothetical change to the monthly cash flow. Amount
+// is the size of an income or expense, in the base currency of the book when
+// it has no asset, and Months how many months it lasts, every month when zero.
+type SimulationChange struct {
+	Type        SimulationChangeType
+	CategoryID  string
+	Percent     float64
+	Description string
+	Amount      monetary.Monetary
+	Months      int
+}
+
+// SimulationScenario is a named set of changes projected together
+type SimulationScenario struct {
+	Name    string
+	Changes []SimulationChange
+}
+
+// SimulationMonth is the projected cash flow of a month (e.g. "2025-04") and
+// the net worth it ends with
+type SimulationMonth struct {
+	Month       string
+	Income      monetary.Monetary
+	Expenses    monetary.Monetary
+	NetCashFlow monetary.Monetary
+	NetWorth    monetary.Monetary
+}
+
+// SimulationProjection is a scenario projected month by month. Difference is
+// how much its final net worth is above the baseline's, negative when below.
+type SimulationProjection struct {
+	Name        string
+	Months      []SimulationMonth
+	EndNetWorth monetary.Monetary
+	Difference  monetary.Monetary
+}
+
+// Simulation projects the net worth in one asset from its current value, the
+// baseline first and then each scenario in the order given
+type Simulation struct {
+	Asset       monetary.Asset
+	NetWorth    monetary.Monetary
+	Projections []SimulationProjection
+}
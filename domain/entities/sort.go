@@ -0,0 +1,25 @@
+package entities
+
+import "strings"
+
+// SortField represents one key of a list ordering
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// ParseSort parses a comma separated sort expression like "-date,amount",
+// where a leading minus orders the field descending
+func ParseSort(expr string) []SortField {
+	var sort []SortField
+	for _, field := range strings.Split(expr, ",") {
+		field = strings.TrimSpace(field)
+		desc := strings.HasPrefix(field, "-")
+		field = strings.TrimPrefix(field, "-")
+		if field == "" {
+			continue
+		}
+		sort = append(sort, SortField{Field: field, Desc: desc})
+	}
+	return sort
+}
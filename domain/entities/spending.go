@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// SpendingGroupField is what a spending report can be grouped by
+type SpendingGroupField string
+
+const (
+	SpendingGroupFieldCategory SpendingGroupField = "category"
+	SpendingGroupFieldMonth    SpendingGroupField = "month"
+)
+
+// SpendingGroupFields lists every valid grouping field, the default first
+var SpendingGroupFields = []SpendingGroupField{
+	SpendingGroupFieldCategory,
+	SpendingGroupFieldMonth,
+}
+
+// SpendingTotal is what the pending and cleared expenses in one asset add up
+// to in a category, a month (e.g. "2025-04"), or both. Only the fields
+// grouped by are set.
+type SpendingTotal struct {
+	CategoryID string
+	Month      string
+	Count      int
+	Total      monetary.Monetary
+}
+
+// SpendingReport is the expenses from From to To grouped by GroupBy, per
+// asset since amounts of different currencies can't be added up. Totals hold
+// the whole spending of each asset, and Share of each group is its percent of
+// the spending in its asset.
+type SpendingReport struct {
+	From    time.Time
+	To      time.Time
+	GroupBy []SpendingGroupField
+	Totals  []monetary.Monetary
+	Groups  []SpendingGroup
+}
+
+// SpendingGroup is a total of a spending report with its category, when
+// grouped by category
+type SpendingGroup struct {
+	SpendingTotal
+	Category Category
+	Share    float64
+}
@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// AccountStatement lists the cleared transactions of an account between From and
+// To, oldest first, with the balance after each one like a bank statement
+type AccountStatement struct {
+	Account        Account
+	From           time.Time
+	To             time.Time
+	OpeningBalance monetary.Monetary
+	ClosingBalance monetary.Monetary
+	Lines          []StatementLine
+}
+
+// StatementLine is a transaction and the account balance right after it
+type StatementLine struct {
+	Transaction    Transaction
+	RunningBalance monetary.Monetary
+}
+
+// AccountPeriodSummary sums up the cleared transactions of an account between From
+// and To. TotalIn adds up the transactions raising the balance and TotalOut the
+// ones lowering it, so ClosingBalance = OpeningBalance + TotalIn - TotalOut.
+type AccountPeriodSummary struct {
+	Account        Account
+	From           time.Time
+	To             time.Time
+	OpeningBalance monetary.Monetary
+	TotalIn        monetary.Monetary
+	TotalOut       monetary.Monetary
+	ClosingBalance monetary.Monetary
+}
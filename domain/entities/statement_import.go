@@ -0,0 +1,134 @@
+package entities
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// StatementSource names a provider whose statement exports can be imported
+type StatementSource string
+
+const (
+	StatementSourceWise    StatementSource = "wise"
+	StatementSourceRevolut StatementSource = "revolut"
+)
+
+// StatementSources lists every provider statements can be imported from
+var StatementSources = []StatementSource{
+	StatementSourceWise,
+	StatementSourceRevolut,
+}
+
+// registeredSources are the statement sources of the importer plugins of this
+// deployment, with the institution of each, registered when the service starts
+var registeredSources struct {
+	sync.RWMutex
+	sources      []StatementSource
+	institutions map[StatementSource]string
+}
+
+// RegisterStatementSource makes a source importable, its accounts kept under
+// institution. Registering a source again replaces its institution.
+func RegisterStatementSource(source StatementSource, institution string) {
+	registeredSources.Lock()
+	defer registeredSources.Unlock()
+
+	if registeredSources.institutions == nil {
+		registeredSources.institutions = map[StatementSource]string{}
+	}
+	if _, ok := registeredSources.institutions[source]; !ok {
+		registeredSources.sources = append(registeredSources.sources, source)
+		slices.Sort(registeredSources.sources)
+	}
+	registeredSources.institutions[source] = institution
+}
+
+// ListStatementSources lists the built-in statement sources and then the
+// registered ones by name
+func ListStatementSources() []StatementSource {
+	registeredSources.RLock()
+	defer registeredSources.RUnlock()
+	return slices.Concat(StatementSources, registeredSources.sources)
+}
+
+// Institution returns the name the accounts of the source are kept under
+func (s StatementSource) Institution() string {
+	switch s {
+	case StatementSourceWise:
+		return "Wise"
+	case StatementSourceRevolut:
+		return "Revolut"
+	}
+
+	registeredSources.RLock()
+	defer registeredSources.RUnlock()
+	if institution, ok := registeredSources.institutions[s]; ok {
+		return institution
+	}
+	return string(s)
+}
+
+// ImportedTransaction is a line of a statement export. Amount is signed by its
+// effect on the balance of its currency. Fees charged on a line come as a line
+// of their own with Fee set, so they can be told apart from what was paid.
+// Payee is set when the export has a column for it.
+type ImportedTransaction struct {
+	Date        time.Time
+	Amount      monetary.Monetary
+	Description string
+	Payee       string
+	Fee         bool
+	Pending     bool
+}
+
+// ImportedStatement is a parsed statement export. Multi-currency accounts keep
+// one balance per currency, Balances holds the closing one of each currency
+// the export reports it for.
+type ImportedStatement struct {
+	Source       StatementSource
+	Transactions []ImportedTransaction
+	Balances     []monetary.Monetary
+}
+
+// StatementImportOptions tells how an export maps onto accounts and categories.
+// Accounts maps currencies to the account receiving their transactions, other
+// currencies go to the account of the source's institution in that currency,
+// created when there is none. FeeCategoryID defaults to ExpenseCategoryID.
+type StatementImportOptions struct {
+	Source            StatementSource
+	ExpenseCategoryID string
+	IncomeCategoryID  string
+	FeeCategoryID     string
+	Accounts          map[string]string
+	DryRun            bool
+}
+
+// StatementImportResult reports the import of an export, one entry per
+// currency sorted by currency. RestorePointID is the restore point to roll
+// the import back with, empty on dry runs and imports that changed nothing.
+type StatementImportResult struct {
+	Source         StatementSource
+	Currencies     []CurrencyImport
+	DryRun         bool
+	RestorePointID string
+}
+
+// CurrencyImport reports the transactions of a currency imported into Account.
+// Skipped counts the lines already in the account, with the same date, amount
+// and description, and Rejected those a rule action rejected.
+// StatementBalance is the closing balance of the export and
+// AccountBalance the one of the account after the import, so they can be
+// reconciled; either is nil when unknown.
+type CurrencyImport struct {
+	Currency         string
+	Account          Account
+	AccountCreated   bool
+	Transactions     []Transaction
+	Skipped          int
+	Rejected         int
+	StatementBalance *monetary.Monetary
+	AccountBalance   *monetary.Monetary
+}
@@ -0,0 +1,23 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// UpcomingBillDays is how far ahead the minimal summary looks for a bill
+const UpcomingBillDays = 30
+
+// MinimalSummary is the state of the finances at a glance, for tiny polling
+// clients like menubar widgets
+type MinimalSummary struct {
+	NetWorth monetary.Monetary
+	// TodaySpend adds up the expenses dated today in the asset of NetWorth,
+	// expenses in other assets can't be added to it and are left out
+	TodaySpend monetary.Monetary
+	// UpcomingBill is the earliest pending expense dated from today up to
+	// UpcomingBillDays ahead, nil when there is none
+	UpcomingBill   *Transaction
+	LastCalculated time.Time
+}
@@ -0,0 +1,16 @@
+package entities
+
+import "time"
+
+// MaxTagLength is the longest a tag name can be
+const MaxTagLength = 50
+
+// Tag is a free-form label of a book, like vacation2024 or reimbursable.
+// Transactions have a single category but any number of tags, referred to by
+// their names, which are lowercase and unique in the book.
+type Tag struct {
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
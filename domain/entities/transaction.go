@@ -13,21 +13,55 @@ const (
 	TransactionStatusPending   TransactionStatus = "pending"
 	TransactionStatusCleared   TransactionStatus = "cleared"
 	TransactionStatusCancelled TransactionStatus = "cancelled"
+	// TransactionStatusDraft is a transaction still being filled in. Drafts are
+	// left out of balances and reports until they are finalized.
+	TransactionStatusDraft TransactionStatus = "draft"
 )
 
-// Transaction represents a financial transaction
+// TransactionStatuses lists every valid transaction status
+var TransactionStatuses = []TransactionStatus{
+	TransactionStatusPending,
+	TransactionStatusCleared,
+	TransactionStatusCancelled,
+	TransactionStatusDraft,
+}
+
+// Transaction represents a financial transaction. Payee is who the money went
+// to or came from, empty when unknown. Installments of an installment plan link
+// back to it with their position, like 3 of 12. ProjectID is the project the
+// transaction is filed under, if any. Tags are the names of its tags in
+// alphabetical order; when updating a transaction, nil Tags leave them as they
+// are. Attachments are only loaded with the details of the transaction.
 type Transaction struct {
-	ID          string            `json:"id" db:"id"`
-	AccountID   string            `json:"account_id" db:"account_id"`
-	CategoryID  string            `json:"category_id" db:"category_id"`
-	Monetary    monetary.Monetary `json:"monetary" db:"monetary"`
-	Description string            `json:"description" db:"description"`
-	Date        time.Time         `json:"date" db:"date"`
-	Status      TransactionStatus `json:"status" db:"status"`
-	CreatedAt   time.Time         `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time         `json:"updated_at" db:"updated_at"`
+	ID                string            `json:"id" db:"id"`
+	AccountID         string            `json:"account_id" db:"account_id"`
+	CategoryID        string            `json:"category_id" db:"category_id"`
+	Monetary          monetary.Monetary `json:"monetary" db:"monetary"`
+	Description       string            `json:"description" db:"description"`
+	Payee             string            `json:"payee,omitempty" db:"payee"`
+	Date              time.Time         `json:"date" db:"date"`
+	Status            TransactionStatus `json:"status" db:"status"`
+	InstallmentPlanID string            `json:"installment_plan_id,omitempty" db:"installment_plan_id"`
+	InstallmentNumber int               `json:"installment_number,omitempty" db:"installment_number"`
+	InstallmentCount  int               `json:"installment_count,omitempty" db:"installment_count"`
+	ProjectID         string            `json:"project_id,omitempty" db:"project_id"`
+	Tags              []string          `json:"tags,omitempty" db:"-"`
+	Attachments       []Attachment      `json:"attachments,omitempty" db:"-"`
+	OwnerID           string            `json:"owner_id,omitempty" db:"user_id"`
+	CreatedAt         time.Time         `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at" db:"updated_at"`
+	// DeletedAt is when the transaction was moved to the trash
+	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
 
 	// Relationships (for JSON responses)
 	Account  *Account  `json:"account,omitempty"`
 	Category *Category `json:"category,omitempty"`
 }
+
+// TransactionFilter narrows down the transactions listed, empty fields match
+// any transaction
+type TransactionFilter struct {
+	ProjectID string
+	// Tag is the name of a tag the transactions have
+	Tag string
+}
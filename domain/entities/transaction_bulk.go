@@ -0,0 +1,33 @@
+package entities
+
+// MaxBulkOperations caps the operations of a bulk request, which are all
+// applied in a single database transaction
+const MaxBulkOperations = 500
+
+// BulkAction is what an operation of a bulk request does to a transaction
+type BulkAction string
+
+const (
+	BulkActionCreate       BulkAction = "create"
+	BulkActionUpdateStatus BulkAction = "update_status"
+	BulkActionDelete       BulkAction = "delete"
+)
+
+var BulkActions = []BulkAction{BulkActionCreate, BulkActionUpdateStatus, BulkActionDelete}
+
+// BulkOperation is an operation of a bulk request: Transaction is the
+// transaction to create, ID the one whose status changes to Status or the one
+// to delete
+type BulkOperation struct {
+	Action      BulkAction
+	ID          string
+	Status      TransactionStatus
+	Transaction Transaction
+}
+
+// BulkResult is the transaction an operation created or updated, or the one
+// it deleted as it was
+type BulkResult struct {
+	Action      BulkAction
+	Transaction Transaction
+}
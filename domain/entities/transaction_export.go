@@ -0,0 +1,51 @@
+package entities
+
+import (
+	"io"
+	"time"
+)
+
+// TransactionExportFormat is a file format the transactions can be exported as
+type TransactionExportFormat string
+
+const (
+	TransactionExportFormatCSV  TransactionExportFormat = "csv"
+	TransactionExportFormatXLSX TransactionExportFormat = "xlsx"
+)
+
+// TransactionExport is the transactions matching a filter rendered as a file.
+// Write streams the file, reading the transactions as it goes, so an export
+// of every transaction doesn't have to fit in memory.
+type TransactionExport struct {
+	Filename    string
+	ContentType string
+	Write       func(w io.Writer) error
+}
+
+const (
+	// ExportTTL is how long an export stays in the file store before it's
+	// deleted
+	ExportTTL = 7 * 24 * time.Hour
+	// ExportURLTTL is how long the signed download URL of an export works. A
+	// new one is signed each time the export is read.
+	ExportURLTTL = 15 * time.Minute
+)
+
+// Export is a transaction export written to the file store, so it's
+// downloaded from there instead of through the service until ExpiresAt
+type Export struct {
+	ID          string
+	Format      TransactionExportFormat
+	FileName    string
+	ContentType string
+	Size        int64
+	StorageKey  string
+	BookID      string
+	OwnerID     string
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	// URL downloads the export straight from the store until URLExpiresAt,
+	// empty when the store can't sign URLs
+	URL          string
+	URLExpiresAt time.Time
+}
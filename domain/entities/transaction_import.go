@@ -0,0 +1,40 @@
+package entities
+
+// CSVMapping names the columns of a CSV export holding each field of the
+// transactions, by their header. Payee is optional. DateLayout is the Go
+// layout of the dates, like "01/02/2006" for month first dates; without it
+// ISO dates and day first dates like 31/03/2025 are read. DecimalComma reads
+// amounts written like 1.234,56.
+type CSVMapping struct {
+	Date         string
+	Amount       string
+	Description  string
+	Payee        string
+	DateLayout   string
+	DecimalComma bool
+}
+
+// TransactionImportOptions tells how the rows of a CSV export are imported:
+// all of them into AccountID, money going out filed under CategoryID and money
+// coming in under IncomeCategoryID, which defaults to CategoryID.
+type TransactionImportOptions struct {
+	AccountID        string
+	CategoryID       string
+	IncomeCategoryID string
+	Mapping          CSVMapping
+	DryRun           bool
+}
+
+// TransactionImportResult reports the import of a CSV export into Account.
+// Created holds the transactions created, or that would be on dry runs, and
+// Skipped the rows already in the account, with the same date, amount and
+// description. Rejected holds the rows a rule action rejected. RestorePointID is the restore point to roll the import back
+// with, empty on dry runs and imports that created nothing.
+type TransactionImportResult struct {
+	Account        Account
+	Created        []Transaction
+	Skipped        []ImportedTransaction
+	Rejected       []ImportedTransaction
+	DryRun         bool
+	RestorePointID string
+}
@@ -0,0 +1,20 @@
+package entities
+
+import "time"
+
+// MaxTransactionScriptBytes caps the size of the source of a script
+const MaxTransactionScriptBytes = 16 << 10
+
+// TransactionScript is Starlark code run on the transactions created and
+// imported into a book, before they're stored. It defines process(tx), which
+// can change the category, payee, description, status and tags of tx, a dict,
+// or call reject(reason) to refuse it. The enabled scripts of a book run
+// in the order of their names.
+type TransactionScript struct {
+	ID        string
+	Name      string
+	Source    string
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,140 @@
+package entities
+
+import "time"
+
+// User signs in with their email and password to reach their books.
+// PasswordHash is never sent back to clients.
+type User struct {
+	ID           string    `json:"id" db:"id"`
+	Email        string    `json:"email" db:"email"`
+	Name         string    `json:"name" db:"name"`
+	PasswordHash string    `json:"-" db:"password_hash"`
+	Tier         UserTier  `json:"tier" db:"tier"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// UserTier picks the quotas a user is held to
+type UserTier string
+
+const (
+	// UserTierFree is held to the quotas of the deployment
+	UserTierFree      UserTier = "free"
+	UserTierUnlimited UserTier = "unlimited"
+)
+
+// UserTiers lists every valid user tier
+var UserTiers = []UserTier{
+	UserTierFree,
+	UserTierUnlimited,
+}
+
+// UserUsage is what a user created and how much they called the API,
+// counted against the quotas of their tier. MonthlyTransactions are the
+// transactions created since the start of the month and MonthlyAPICalls the
+// API calls made since then. AttachmentBytes adds up the size of the files
+// attached to their transactions.
+type UserUsage struct {
+	Tier                UserTier
+	Books               int
+	Accounts            int
+	Categories          int
+	Budgets             int
+	Transactions        int
+	MonthlyTransactions int
+	MonthlyAPICalls     int
+	AttachmentBytes     int64
+}
+
+// UsageLimits are the quotas a user is held to, zero for the unlimited ones
+type UsageLimits struct {
+	Accounts            int
+	MonthlyTransactions int
+	MonthlyAPICalls     int
+	AttachmentBytes     int64
+}
+
+// UsageReport is the usage of a user in the month from PeriodStart to
+// ResetsAt, when the monthly counts start over, and the limits they're held to
+type UsageReport struct {
+	Usage       UserUsage
+	Limits      UsageLimits
+	PeriodStart time.Time
+	ResetsAt    time.Time
+}
+
+// AuthToken is the bearer token a user signed in with, valid until ExpiresAt
+type AuthToken struct {
+	Token     string
+	ExpiresAt time.Time
+	User      User
+	SessionID string
+}
+
+// Session is a sign in of a user on a device, named in the token it issued so
+// the token can be revoked before it expires. LastSeenAt is refreshed at most
+// once a minute as the token is used.
+type Session struct {
+	ID         string     `json:"id" db:"id"`
+	UserID     string     `json:"user_id" db:"user_id"`
+	UserAgent  string     `json:"user_agent" db:"user_agent"`
+	IPAddress  string     `json:"ip_address" db:"ip_address"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	LastSeenAt time.Time  `json:"last_seen_at" db:"last_seen_at"`
+	ExpiresAt  time.Time  `json:"expires_at" db:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+
+	// Current flags the session of the request listing the sessions
+	Current bool `json:"current"`
+}
+
+// Device is the client a user signs in from, as told by the request
+type Device struct {
+	UserAgent string
+	IPAddress string
+	// ClientIP is the address the sign in came from as far as the service
+	// can tell, which the sign in guard counts failures by. IPAddress is the
+	// one the client claims, only shown to the user.
+	ClientIP string
+}
+
+// LoginScope is what the failed sign ins are counted by
+type LoginScope string
+
+const (
+	LoginScopeEmail LoginScope = "email"
+	LoginScopeIP    LoginScope = "ip"
+)
+
+// LoginFailures are the failed sign ins in a row of an email or address.
+// LockedUntil is set once they reach the lockout threshold.
+type LoginFailures struct {
+	Scope        LoginScope `json:"scope" db:"scope"`
+	Key          string     `json:"key" db:"key"`
+	Failures     int        `json:"failures" db:"failures"`
+	LastFailedAt time.Time  `json:"last_failed_at" db:"last_failed_at"`
+	LockedUntil  *time.Time `json:"locked_until,omitempty" db:"locked_until"`
+}
+
+type AuthEventType string
+
+const (
+	AuthEventLoginFailed AuthEventType = "login_failed"
+	// AuthEventLoginBlocked is a sign in tried while locked
+	AuthEventLoginBlocked  AuthEventType = "login_blocked"
+	AuthEventAccountLocked AuthEventType = "account_locked"
+	AuthEventAddressLocked AuthEventType = "address_locked"
+	AuthEventCaptchaFailed AuthEventType = "captcha_failed"
+)
+
+// AuthEvent audits a suspicious sign in. UserID is empty when the email isn't
+// registered.
+type AuthEvent struct {
+	ID        string        `json:"id" db:"id"`
+	UserID    string        `json:"user_id,omitempty" db:"user_id"`
+	Type      AuthEventType `json:"type" db:"type"`
+	Email     string        `json:"email" db:"email"`
+	IPAddress string        `json:"ip_address" db:"ip_address"`
+	UserAgent string        `json:"user_agent" db:"user_agent"`
+	CreatedAt time.Time     `json:"created_at" db:"created_at"`
+}
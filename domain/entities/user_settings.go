@@ -0,0 +1,52 @@
+package entities
+
+import "time"
+
+// UserSettingKey identifies a user preference
+type UserSettingKey string
+
+const (
+	UserSettingBaseCurrency    UserSettingKey = "base_currency"
+	UserSettingLocale          UserSettingKey = "locale"
+	UserSettingTimezone        UserSettingKey = "timezone"
+	UserSettingFirstDayOfWeek  UserSettingKey = "first_day_of_week"
+	UserSettingDashboardLayout UserSettingKey = "dashboard_layout"
+
+	UserSettingDefaultTransactionStatus UserSettingKey = "default_transaction_status"
+	UserSettingDefaultTransactionDate   UserSettingKey = "default_transaction_date"
+)
+
+// Values of the default transaction date preference: transactions created without a
+// date either happen today, in the user timezone, or are rejected
+const (
+	DefaultTransactionDateToday    = "today"
+	DefaultTransactionDateRequired = "required"
+)
+
+// DefaultTransactionStatusKey is the preference overriding the default transaction
+// status for accounts of the given type, e.g. default_transaction_status_credit
+func DefaultTransactionStatusKey(accountType AccountType) UserSettingKey {
+	return UserSettingDefaultTransactionStatus + UserSettingKey("_"+string(accountType))
+}
+
+// DefaultUserSettings holds the values of the known preferences that were never set
+var DefaultUserSettings = map[UserSettingKey]string{
+	UserSettingBaseCurrency:    "BRL",
+	UserSettingLocale:          "pt-BR",
+	UserSettingTimezone:        "America/Sao_Paulo",
+	UserSettingFirstDayOfWeek:  "monday",
+	UserSettingDashboardLayout: "summary,accounts,transactions",
+
+	UserSettingDefaultTransactionStatus: string(TransactionStatusCleared),
+	UserSettingDefaultTransactionDate:   DefaultTransactionDateToday,
+}
+
+// DashboardSections lists the dashboard sections that can be ordered through the dashboard layout preference
+var DashboardSections = []string{"summary", "accounts", "transactions"}
+
+// UserSetting represents a single user preference stored as a key/value pair
+type UserSetting struct {
+	Key       UserSettingKey `json:"key" db:"key"`
+	Value     string         `json:"value" db:"value"`
+	UpdatedAt time.Time      `json:"updated_at" db:"updated_at"`
+}
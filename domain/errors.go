@@ -1,11 +1,99 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
 
 var (
 	ErrConflict            = errors.New("data conflict")
 	ErrNotFound            = errors.New("not found")
 	ErrMalformedParameters = errors.New("malformed parameters")
 	ErrForbidden           = errors.New("forbidden")
+	ErrUnauthorized        = errors.New("unauthorized")
 	ErrDuplicateKey        = errors.New("duplicate key")
+	ErrAssetMismatch       = errors.New("asset mismatch")
+	ErrTooManyRequests     = errors.New("too many requests")
+	ErrQuotaExceeded       = errors.New("quota exceeded")
+	// ErrCaptchaRequired asks the client to solve a CAPTCHA before trying
+	// again
+	ErrCaptchaRequired = errors.New("captcha required")
 )
+
+// LockedError refuses the sign ins until Until, it's ErrTooManyRequests
+type LockedError struct {
+	Until time.Time
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("too many failed sign ins, locked until %s", e.Until.UTC().Format(time.RFC3339))
+}
+
+func (e *LockedError) Unwrap() error {
+	return ErrTooManyRequests
+}
+
+// QuotaError refuses what would take a user over the Limit of a quota of their
+// tier, named like "monthly_transactions". Quotas resetting at ResetsAt are ErrTooManyRequests until then, the
+// others are ErrQuotaExceeded.
+type QuotaError struct {
+	Quota    string
+	Limit    int
+	ResetsAt time.Time
+}
+
+func (e *QuotaError) Error() string {
+	quota := strings.ReplaceAll(e.Quota, "_", " ")
+	if e.ResetsAt.IsZero() {
+		return fmt.Sprintf("quota of %d %s reached", e.Limit, quota)
+	}
+	return fmt.Sprintf("quota of %d %s reached, resets at %s", e.Limit, quota, e.ResetsAt.UTC().Format(time.RFC3339))
+}
+
+func (e *QuotaError) Unwrap() error {
+	if e.ResetsAt.IsZero() {
+		return ErrQuotaExceeded
+	}
+	return ErrTooManyRequests
+}
+
+// RejectedError refuses a transaction a script rejected, it's
+// ErrMalformedParameters
+type RejectedError struct {
+	Script string
+	Reason string
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("rejected by script %s: %s", e.Script, e.Reason)
+}
+
+func (e *RejectedError) Unwrap() error {
+	return ErrMalformedParameters
+}
+
+// BulkError refuses a bulk request for the Failures of some of its
+// operations, none of them applied. It's ErrMalformedParameters.
+type BulkError struct {
+	Failures []BulkFailure
+}
+
+// BulkFailure is why the operation at Index, counting from 0, failed
+type BulkFailure struct {
+	Index int
+	Err   error
+}
+
+func (e *BulkError) Error() string {
+	first := e.Failures[0]
+	if len(e.Failures) == 1 {
+		return fmt.Sprintf("operation %d failed, none was applied: %v", first.Index, first.Err)
+	}
+	return fmt.Sprintf("%d operations failed, none was applied, the first is operation %d: %v", len(e.Failures), first.Index, first.Err)
+}
+
+func (e *BulkError) Unwrap() error {
+	return ErrMalformedParameters
+}
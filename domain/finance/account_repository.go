@@ -3,13 +3,22 @@ package finance
 import (
 	"context"
 	"finance/domain/entities"
+	"time"
 )
 
 //go:generate moq -skip-ensure -stub -pkg mocks -out mocks/account_repository.go . AccountRepository
 type AccountRepository interface {
 	CreateAccount(ctx context.Context, account entities.Account) (entities.Account, error)
 	GetAccountByID(ctx context.Context, id string) (entities.Account, error)
-	GetAllAccounts(ctx context.Context) ([]entities.Account, error)
+	GetAllAccounts(ctx context.Context, sort []entities.SortField) ([]entities.Account, error)
+	GetAccountsPage(ctx context.Context, sort []entities.SortField, limit, offset int) ([]entities.Account, error)
+	CountAccounts(ctx context.Context) (int64, error)
+	GetAccountWithBalance(ctx context.Context, id string) (entities.Account, error)
+	GetAccountsWithBalances(ctx context.Context, sort []entities.SortField) ([]entities.Account, error)
+	GetAccountsWithBalancesPage(ctx context.Context, sort []entities.SortField, limit, offset int) ([]entities.Account, error)
 	UpdateAccount(ctx context.Context, account entities.Account) (entities.Account, error)
 	DeleteAccount(ctx context.Context, id string) error
+	CountAccountTransactions(ctx context.Context, id string) (int64, error)
+	GetAccountStatement(ctx context.Context, account entities.Account, from, to time.Time) (entities.AccountStatement, error)
+	GetAccountPeriodSummary(ctx context.Context, account entities.Account, from, to time.Time) (entities.AccountPeriodSummary, error)
 }
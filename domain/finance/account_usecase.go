@@ -2,31 +2,52 @@ package finance
 
 import (
 	"context"
+	"finance/domain"
 	"finance/domain/entities"
 	"fmt"
 	"log/slog"
+	"regexp"
+	"slices"
 	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Limits of the optional account details, sized for a bank name and an emoji or
+// icon name rather than free text
+const (
+	maxInstitutionLength = 100
+	maxIconLength        = 32
+)
 
-	"github.com/guilhermebr/gox/monetary"
+var (
+	accountNumberLast4Pattern = regexp.MustCompile(`^[0-9]{4}$`)
+	accountColorPattern       = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
 )
 
 type AccountUseCase struct {
 	accountRepo AccountRepository
 	balanceRepo BalanceRepository
+	quotas      *QuotaGuard
 }
 
-func NewAccountUseCase(accountRepo AccountRepository, balanceRepo BalanceRepository) *AccountUseCase {
+func NewAccountUseCase(accountRepo AccountRepository, balanceRepo BalanceRepository, quotas *QuotaGuard) *AccountUseCase {
 	return &AccountUseCase{
 		accountRepo: accountRepo,
 		balanceRepo: balanceRepo,
+		quotas:      quotas,
 	}
 }
 
 func (uc *AccountUseCase) CreateAccount(ctx context.Context, account entities.Account) (entities.Account, error) {
 	// Validate input
+	account = normalizeAccount(account)
 	if err := uc.validateAccount(account); err != nil {
 		return entities.Account{}, err
 	}
+	if err := uc.quotas.CheckAccounts(ctx, 1); err != nil {
+		return entities.Account{}, err
+	}
 
 	// Create the account
 	createdAccount, err := uc.accountRepo.CreateAccount(ctx, account)
@@ -50,7 +71,7 @@ func (uc *AccountUseCase) GetAccountByID(ctx context.Context, id string) (entiti
 		return entities.Account{}, fmt.Errorf("account ID cannot be empty")
 	}
 
-	account, err := uc.accountRepo.GetAccountByID(ctx, id)
+	account, err := ownAccount(ctx, uc.accountRepo, id)
 	if err != nil {
 		return entities.Account{}, fmt.Errorf("failed to get account: %w", err)
 	}
@@ -58,8 +79,8 @@ func (uc *AccountUseCase) GetAccountByID(ctx context.Context, id string) (entiti
 	return account, nil
 }
 
-func (uc *AccountUseCase) GetAllAccounts(ctx context.Context) ([]entities.Account, error) {
-	accounts, err := uc.accountRepo.GetAllAccounts(ctx)
+func (uc *AccountUseCase) GetAllAccounts(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, sort)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get accounts: %w", err)
 	}
@@ -67,8 +88,154 @@ func (uc *AccountUseCase) GetAllAccounts(ctx context.Context) ([]entities.Accoun
 	return accounts, nil
 }
 
+// GetAccountWithBalance returns an account with its balance embedded
+func (uc *AccountUseCase) GetAccountWithBalance(ctx context.Context, id string) (entities.Account, error) {
+	if id == "" {
+		return entities.Account{}, fmt.Errorf("account ID cannot be empty")
+	}
+
+	account, err := uc.accountRepo.GetAccountWithBalance(ctx, id)
+	if err != nil {
+		return entities.Account{}, fmt.Errorf("failed to get account with balance: %w", err)
+	}
+	if err := checkOwner(ctx, account.OwnerID, "account"); err != nil {
+		return entities.Account{}, err
+	}
+
+	if account.Balance != nil {
+		attachBalanceDeltas(ctx, uc.balanceRepo, []*entities.Balance{account.Balance})
+	}
+
+	return account, nil
+}
+
+// GetAllAccountsWithBalances returns all accounts with their balance embedded
+func (uc *AccountUseCase) GetAllAccountsWithBalances(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+	accounts, err := uc.accountRepo.GetAccountsWithBalances(ctx, sort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts with balances: %w", err)
+	}
+
+	balances := make([]*entities.Balance, 0, len(accounts))
+	for _, account := range accounts {
+		if account.Balance != nil {
+			balances = append(balances, account.Balance)
+		}
+	}
+	attachBalanceDeltas(ctx, uc.balanceRepo, balances)
+
+	return accounts, nil
+}
+
+// GetAccountsPage returns limit accounts after the first offset ones with the
+// number of accounts in the book, their balance embedded when withBalances is
+// set
+func (uc *AccountUseCase) GetAccountsPage(ctx context.Context, sort []entities.SortField, limit, offset int, withBalances bool) (entities.Page[entities.Account], error) {
+	var accounts []entities.Account
+	var err error
+	if withBalances {
+		accounts, err = uc.accountRepo.GetAccountsWithBalancesPage(ctx, sort, limit, offset)
+	} else {
+		accounts, err = uc.accountRepo.GetAccountsPage(ctx, sort, limit, offset)
+	}
+	if err != nil {
+		return entities.Page[entities.Account]{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	total, err := uc.accountRepo.CountAccounts(ctx)
+	if err != nil {
+		return entities.Page[entities.Account]{}, fmt.Errorf("failed to count accounts: %w", err)
+	}
+
+	if withBalances {
+		balances := make([]*entities.Balance, 0, len(accounts))
+		for _, account := range accounts {
+			if account.Balance != nil {
+				balances = append(balances, account.Balance)
+			}
+		}
+		attachBalanceDeltas(ctx, uc.balanceRepo, balances)
+	}
+
+	return entities.Page[entities.Account]{Items: accounts, Total: total, Limit: limit, Offset: offset}, nil
+}
+
+// GetAccountStatement returns the cleared transactions of an account between from
+// and to with their running balance, see accountPeriod for the defaults. Only
+// the lines matching filter are kept, the balances are still the account's.
+func (uc *AccountUseCase) GetAccountStatement(ctx context.Context, id string, from, to time.Time, filter entities.TransactionFilter) (entities.AccountStatement, error) {
+	if id == "" {
+		return entities.AccountStatement{}, fmt.Errorf("account ID cannot be empty")
+	}
+
+	from, to, err := accountPeriod(from, to)
+	if err != nil {
+		return entities.AccountStatement{}, err
+	}
+
+	account, err := ownAccount(ctx, uc.accountRepo, id)
+	if err != nil {
+		return entities.AccountStatement{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	statement, err := uc.accountRepo.GetAccountStatement(ctx, account, from, to)
+	if err != nil {
+		return entities.AccountStatement{}, fmt.Errorf("failed to get account statement: %w", err)
+	}
+
+	if filter.ProjectID != "" {
+		statement.Lines = slices.DeleteFunc(statement.Lines, func(line entities.StatementLine) bool {
+			return line.Transaction.ProjectID != filter.ProjectID
+		})
+	}
+
+	return statement, nil
+}
+
+// GetAccountPeriodSummary returns the opening balance, money in, money out and
+// closing balance of an account between from and to, see accountPeriod for the
+// defaults
+func (uc *AccountUseCase) GetAccountPeriodSummary(ctx context.Context, id string, from, to time.Time) (entities.AccountPeriodSummary, error) {
+	if id == "" {
+		return entities.AccountPeriodSummary{}, fmt.Errorf("account ID cannot be empty")
+	}
+
+	from, to, err := accountPeriod(from, to)
+	if err != nil {
+		return entities.AccountPeriodSummary{}, err
+	}
+
+	account, err := ownAccount(ctx, uc.accountRepo, id)
+	if err != nil {
+		return entities.AccountPeriodSummary{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	summary, err := uc.accountRepo.GetAccountPeriodSummary(ctx, account, from, to)
+	if err != nil {
+		return entities.AccountPeriodSummary{}, fmt.Errorf("failed to get account period summary: %w", err)
+	}
+
+	return summary, nil
+}
+
+// accountPeriod fills in the period of a statement or summary: to defaults to today
+// and from to the first day of the month of to
+func accountPeriod(from, to time.Time) (time.Time, time.Time, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, to.Location())
+	}
+	if from.After(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("period start %s is after its end %s: %w", from.Format("2006-01-02"), to.Format("2006-01-02"), domain.ErrMalformedParameters)
+	}
+	return from, to, nil
+}
+
 func (uc *AccountUseCase) UpdateAccount(ctx context.Context, account entities.Account) (entities.Account, error) {
 	// Validate input
+	account = normalizeAccount(account)
 	if err := uc.validateAccount(account); err != nil {
 		return entities.Account{}, err
 	}
@@ -78,13 +245,27 @@ func (uc *AccountUseCase) UpdateAccount(ctx context.Context, account entities.Ac
 	}
 
 	// Check if account exists
-	existingAccount, err := uc.accountRepo.GetAccountByID(ctx, account.ID)
+	existingAccount, err := ownAccount(ctx, uc.accountRepo, account.ID)
 	if err != nil {
 		return entities.Account{}, fmt.Errorf("failed to get existing account: %w", err)
 	}
 
-	if existingAccount.ID == "" {
-		return entities.Account{}, fmt.Errorf("account not found")
+	// Amounts are stored in the account's asset and signed by its classification, so
+	// changing either would silently reinterpret every existing transaction
+	assetChanged := existingAccount.Asset.Asset != account.Asset.Asset
+	signChanged := existingAccount.IsLiability() != account.IsLiability()
+	if assetChanged || signChanged {
+		count, err := uc.accountRepo.CountAccountTransactions(ctx, account.ID)
+		if err != nil {
+			return entities.Account{}, fmt.Errorf("failed to count account transactions: %w", err)
+		}
+
+		if count > 0 && assetChanged {
+			return entities.Account{}, fmt.Errorf("cannot change the asset of an account with %d transactions: %w", count, domain.ErrConflict)
+		}
+		if count > 0 {
+			return entities.Account{}, fmt.Errorf("cannot change an account with %d transactions between asset and liability: %w", count, domain.ErrConflict)
+		}
 	}
 
 	updatedAccount, err := uc.accountRepo.UpdateAccount(ctx, account)
@@ -92,6 +273,10 @@ func (uc *AccountUseCase) UpdateAccount(ctx context.Context, account entities.Ac
 		return entities.Account{}, fmt.Errorf("failed to update account: %w", err)
 	}
 
+	// Updating the account doesn't touch its transactions
+	updatedAccount.TransactionCount = existingAccount.TransactionCount
+	updatedAccount.LastTransactionDate = existingAccount.LastTransactionDate
+
 	return updatedAccount, nil
 }
 
@@ -101,19 +286,14 @@ func (uc *AccountUseCase) DeleteAccount(ctx context.Context, id string) error {
 	}
 
 	// Check if account exists
-	account, err := uc.accountRepo.GetAccountByID(ctx, id)
-	if err != nil {
+	if _, err := ownAccount(ctx, uc.accountRepo, id); err != nil {
 		return fmt.Errorf("failed to get account: %w", err)
 	}
 
-	if account.ID == "" {
-		return fmt.Errorf("account not found")
-	}
-
 	// TODO: Check if account has transactions and handle accordingly
 	// For now, we'll rely on the database cascade delete
 
-	err = uc.accountRepo.DeleteAccount(ctx, id)
+	err := uc.accountRepo.DeleteAccount(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete account: %w", err)
 	}
@@ -130,24 +310,14 @@ func (uc *AccountUseCase) validateAccount(account entities.Account) error {
 		return fmt.Errorf("account type cannot be empty")
 	}
 
-	validTypes := []entities.AccountType{
-		entities.AccountTypeChecking,
-		entities.AccountTypeSavings,
-		entities.AccountTypeCredit,
-		entities.AccountTypeInvestment,
-		entities.AccountTypeCash,
+	if !slices.Contains(entities.AccountTypes, account.Type) {
+		return fmt.Errorf("invalid account type: %s", account.Type)
 	}
 
-	isValidType := false
-	for _, validType := range validTypes {
-		if account.Type == validType {
-			isValidType = true
-			break
-		}
-	}
-
-	if !isValidType {
-		return fmt.Errorf("invalid account type: %s", account.Type)
+	switch account.Classification {
+	case "", entities.AccountClassificationAsset, entities.AccountClassificationLiability:
+	default:
+		return fmt.Errorf("invalid account classification: %s", account.Classification)
 	}
 
 	// Validate asset
@@ -155,11 +325,52 @@ func (uc *AccountUseCase) validateAccount(account entities.Account) error {
 		return fmt.Errorf("account asset cannot be empty")
 	}
 
-	// Verify that the asset is valid by checking if it exists in the monetary package
-	_, ok := monetary.FindAssetByName(account.Asset.Asset)
+	_, ok := entities.FindSupportedAsset(account.Asset.Asset)
 	if !ok {
 		return fmt.Errorf("invalid asset: %s", account.Asset.Asset)
 	}
 
+	if utf8.RuneCountInString(account.Institution) > maxInstitutionLength {
+		return fmt.Errorf("account institution must be at most %d characters", maxInstitutionLength)
+	}
+
+	if account.AccountNumberLast4 != "" && !accountNumberLast4Pattern.MatchString(account.AccountNumberLast4) {
+		return fmt.Errorf("invalid account number last4: %s, expected the last 4 digits of the account number", account.AccountNumberLast4)
+	}
+
+	if account.Color != "" && !accountColorPattern.MatchString(account.Color) {
+		return fmt.Errorf("invalid account color: %s, expected a hex color like #3B82F6", account.Color)
+	}
+
+	if utf8.RuneCountInString(account.Icon) > maxIconLength {
+		return fmt.Errorf("account icon must be at most %d characters", maxIconLength)
+	}
+
+	if account.StatementClosingDay < 0 || account.StatementClosingDay > 31 {
+		return fmt.Errorf("invalid statement closing day: %d, expected a day of the month", account.StatementClosingDay)
+	}
+
+	if account.PaymentDueDay < 0 || account.PaymentDueDay > 31 {
+		return fmt.Errorf("invalid payment due day: %d, expected a day of the month", account.PaymentDueDay)
+	}
+
+	if (account.StatementClosingDay == 0) != (account.PaymentDueDay == 0) {
+		return fmt.Errorf("statement closing day and payment due day must be set together")
+	}
+
+	if account.HasBillingCycle() && !account.IsLiability() {
+		return fmt.Errorf("only liability accounts, like credit cards, have a billing cycle")
+	}
+
 	return nil
 }
+
+// normalizeAccount trims the optional account details, so blank values are stored
+// as unset
+func normalizeAccount(account entities.Account) entities.Account {
+	account.Institution = strings.TrimSpace(account.Institution)
+	account.AccountNumberLast4 = strings.TrimSpace(account.AccountNumberLast4)
+	account.Color = strings.TrimSpace(account.Color)
+	account.Icon = strings.TrimSpace(account.Icon)
+	return account
+}
@@ -0,0 +1,510 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAccount(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entities.Account
+		refreshErr  error
+		maxAccounts int
+		wantErr     string
+		wantRefresh bool
+	}{
+		{
+			name:        "success",
+			input:       entities.Account{Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL},
+			wantRefresh: true,
+		},
+		{
+			name:        "balance refresh failure is ignored",
+			input:       entities.Account{Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL},
+			refreshErr:  errors.New("connection reset"),
+			wantRefresh: true,
+		},
+		{
+			name:    "blank name",
+			input:   entities.Account{Name: "  ", Type: entities.AccountTypeChecking, Asset: monetary.BRL},
+			wantErr: "account name cannot be empty",
+		},
+		{
+			name:    "empty type",
+			input:   entities.Account{Name: "Checking", Asset: monetary.BRL},
+			wantErr: "account type cannot be empty",
+		},
+		{
+			name:    "invalid type",
+			input:   entities.Account{Name: "Checking", Type: "loan", Asset: monetary.BRL},
+			wantErr: "invalid account type: loan",
+		},
+		{
+			name:    "empty asset",
+			input:   entities.Account{Name: "Checking", Type: entities.AccountTypeChecking},
+			wantErr: "account asset cannot be empty",
+		},
+		{
+			name:    "invalid classification",
+			input:   entities.Account{Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL, Classification: "equity"},
+			wantErr: "invalid account classification: equity",
+		},
+		{
+			name:    "invalid asset",
+			input:   entities.Account{Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.Asset{Asset: "XYZ"}},
+			wantErr: "invalid asset: XYZ",
+		},
+		{
+			name:    "asset the database doesn't accept",
+			input:   entities.Account{Name: "Wallet", Type: entities.AccountTypeCash, Asset: monetary.DOGE},
+			wantErr: "invalid asset: DOGE",
+		},
+		{
+			name:        "institution details",
+			input:       entities.Account{Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL, Institution: " Nubank ", AccountNumberLast4: "1234", Color: "#8A05BE", Icon: "🏦"},
+			wantRefresh: true,
+		},
+		{
+			name:    "account number longer than 4 digits",
+			input:   entities.Account{Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL, AccountNumberLast4: "12345"},
+			wantErr: "invalid account number last4: 12345, expected the last 4 digits of the account number",
+		},
+		{
+			name:    "account number with letters",
+			input:   entities.Account{Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL, AccountNumberLast4: "12a4"},
+			wantErr: "invalid account number last4: 12a4, expected the last 4 digits of the account number",
+		},
+		{
+			name:    "invalid color",
+			input:   entities.Account{Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL, Color: "purple"},
+			wantErr: "invalid account color: purple, expected a hex color like #3B82F6",
+		},
+		{
+			name:    "institution too long",
+			input:   entities.Account{Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL, Institution: strings.Repeat("x", 101)},
+			wantErr: "account institution must be at most 100 characters",
+		},
+		{
+			name:    "icon too long",
+			input:   entities.Account{Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL, Icon: strings.Repeat("x", 33)},
+			wantErr: "account icon must be at most 32 characters",
+		},
+		{
+			name:        "billing cycle",
+			input:       entities.Account{Name: "Card", Type: entities.AccountTypeCredit, Asset: monetary.BRL, StatementClosingDay: 3, PaymentDueDay: 10},
+			wantRefresh: true,
+		},
+		{
+			name:    "closing day out of range",
+			input:   entities.Account{Name: "Card", Type: entities.AccountTypeCredit, Asset: monetary.BRL, StatementClosingDay: 32, PaymentDueDay: 10},
+			wantErr: "invalid statement closing day: 32, expected a day of the month",
+		},
+		{
+			name:    "due day without closing day",
+			input:   entities.Account{Name: "Card", Type: entities.AccountTypeCredit, Asset: monetary.BRL, PaymentDueDay: 10},
+			wantErr: "statement closing day and payment due day must be set together",
+		},
+		{
+			name:    "billing cycle on an asset account",
+			input:   entities.Account{Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL, StatementClosingDay: 3, PaymentDueDay: 10},
+			wantErr: "only liability accounts, like credit cards, have a billing cycle",
+		},
+		{
+			name:        "quota reached",
+			input:       entities.Account{Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL},
+			maxAccounts: 2,
+			wantErr:     "quota of 2 accounts reached",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountRepo := &mocks.AccountRepositoryMock{
+				CreateAccountFunc: func(ctx context.Context, account entities.Account) (entities.Account, error) {
+					account.ID = "acc-1"
+					return account, nil
+				},
+			}
+			balanceRepo := &mocks.BalanceRepositoryMock{
+				RefreshAccountBalanceFunc: func(ctx context.Context, accountID string) error {
+					return tt.refreshErr
+				},
+			}
+
+			quotas := NewQuotaGuard(&mocks.UserRepositoryMock{
+				GetUserUsageFunc: func(ctx context.Context, id string, since time.Time) (entities.UserUsage, error) {
+					return entities.UserUsage{Tier: entities.UserTierFree, Accounts: 2}, nil
+				},
+			}, QuotaPolicy{MaxAccounts: tt.maxAccounts})
+
+			uc := NewAccountUseCase(accountRepo, balanceRepo, quotas)
+			got, err := uc.CreateAccount(domain.WithUser(context.Background(), "user-1"), tt.input)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Empty(t, accountRepo.CreateAccountCalls())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "acc-1", got.ID)
+				assert.Equal(t, strings.TrimSpace(tt.input.Institution), got.Institution)
+			}
+
+			if tt.wantRefresh {
+				require.Len(t, balanceRepo.RefreshAccountBalanceCalls(), 1)
+				assert.Equal(t, "acc-1", balanceRepo.RefreshAccountBalanceCalls()[0].AccountID)
+			} else {
+				assert.Empty(t, balanceRepo.RefreshAccountBalanceCalls())
+			}
+		})
+	}
+}
+
+func TestUpdateAccount(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   entities.Account
+		mock    func(*mocks.AccountRepositoryMock)
+		wantErr string
+	}{
+		{
+			name:  "success",
+			input: entities.Account{ID: "acc-1", Name: "Main", Type: entities.AccountTypeChecking, Asset: monetary.USD},
+		},
+		{
+			name:    "empty id",
+			input:   entities.Account{Name: "Main", Type: entities.AccountTypeChecking, Asset: monetary.USD},
+			wantErr: "account ID cannot be empty",
+		},
+		{
+			name:  "not found",
+			input: entities.Account{ID: "missing", Name: "Main", Type: entities.AccountTypeChecking, Asset: monetary.USD},
+			mock: func(m *mocks.AccountRepositoryMock) {
+				m.GetAccountByIDFunc = func(ctx context.Context, id string) (entities.Account, error) {
+					return entities.Account{}, errNotFound("account")
+				}
+			},
+			wantErr: "failed to get existing account: account not found",
+		},
+		{
+			name:  "asset change without transactions",
+			input: entities.Account{ID: "acc-1", Name: "Main", Type: entities.AccountTypeChecking, Asset: monetary.GBP},
+		},
+		{
+			name:  "asset change with transactions",
+			input: entities.Account{ID: "acc-1", Name: "Main", Type: entities.AccountTypeChecking, Asset: monetary.GBP},
+			mock: func(m *mocks.AccountRepositoryMock) {
+				m.CountAccountTransactionsFunc = func(ctx context.Context, id string) (int64, error) {
+					return 3, nil
+				}
+			},
+			wantErr: "cannot change the asset of an account with 3 transactions: data conflict",
+		},
+		{
+			name:  "liability type change with transactions",
+			input: entities.Account{ID: "acc-1", Name: "Main", Type: entities.AccountTypeCredit, Asset: monetary.USD},
+			mock: func(m *mocks.AccountRepositoryMock) {
+				m.CountAccountTransactionsFunc = func(ctx context.Context, id string) (int64, error) {
+					return 3, nil
+				}
+			},
+			wantErr: "cannot change an account with 3 transactions between asset and liability: data conflict",
+		},
+		{
+			name:  "classification override with transactions",
+			input: entities.Account{ID: "acc-1", Name: "Main", Type: entities.AccountTypeChecking, Asset: monetary.USD, Classification: entities.AccountClassificationLiability},
+			mock: func(m *mocks.AccountRepositoryMock) {
+				m.CountAccountTransactionsFunc = func(ctx context.Context, id string) (int64, error) {
+					return 3, nil
+				}
+			},
+			wantErr: "cannot change an account with 3 transactions between asset and liability: data conflict",
+		},
+		{
+			name:  "credit type classified as asset with transactions",
+			input: entities.Account{ID: "acc-1", Name: "Main", Type: entities.AccountTypeCredit, Asset: monetary.USD, Classification: entities.AccountClassificationAsset},
+			mock: func(m *mocks.AccountRepositoryMock) {
+				m.CountAccountTransactionsFunc = func(ctx context.Context, id string) (int64, error) {
+					return 3, nil
+				}
+			},
+		},
+		{
+			name:  "asset type change with transactions",
+			input: entities.Account{ID: "acc-1", Name: "Main", Type: entities.AccountTypeSavings, Asset: monetary.USD},
+			mock: func(m *mocks.AccountRepositoryMock) {
+				m.CountAccountTransactionsFunc = func(ctx context.Context, id string) (int64, error) {
+					return 3, nil
+				}
+			},
+		},
+		{
+			name:  "same asset with transactions",
+			input: entities.Account{ID: "acc-1", Name: "Main", Type: entities.AccountTypeChecking, Asset: monetary.USD},
+			mock: func(m *mocks.AccountRepositoryMock) {
+				m.CountAccountTransactionsFunc = func(ctx context.Context, id string) (int64, error) {
+					return 3, nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountRepo := &mocks.AccountRepositoryMock{
+				GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+					return entities.Account{ID: id, Type: entities.AccountTypeChecking, Asset: monetary.USD}, nil
+				},
+				UpdateAccountFunc: func(ctx context.Context, account entities.Account) (entities.Account, error) {
+					return account, nil
+				},
+			}
+			if tt.mock != nil {
+				tt.mock(accountRepo)
+			}
+
+			uc := NewAccountUseCase(accountRepo, &mocks.BalanceRepositoryMock{}, nil)
+			got, err := uc.UpdateAccount(context.Background(), tt.input)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Empty(t, accountRepo.UpdateAccountCalls())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.input, got)
+		})
+	}
+}
+
+func TestUpdateAccountKeepsActivity(t *testing.T) {
+	lastTransaction := time.Date(2025, time.January, 10, 0, 0, 0, 0, time.UTC)
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+			return entities.Account{ID: id, Type: entities.AccountTypeChecking, Asset: monetary.USD, TransactionCount: 12, LastTransactionDate: &lastTransaction}, nil
+		},
+		UpdateAccountFunc: func(ctx context.Context, account entities.Account) (entities.Account, error) {
+			return account, nil
+		},
+	}
+
+	uc := NewAccountUseCase(accountRepo, &mocks.BalanceRepositoryMock{}, nil)
+	got, err := uc.UpdateAccount(context.Background(), entities.Account{ID: "acc-1", Name: "Renamed", Type: entities.AccountTypeChecking, Asset: monetary.USD})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Renamed", got.Name)
+	assert.Equal(t, int64(12), got.TransactionCount)
+	assert.Equal(t, &lastTransaction, got.LastTransactionDate)
+}
+
+func TestGetAccountsPage(t *testing.T) {
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAccountsPageFunc: func(ctx context.Context, sort []entities.SortField, limit, offset int) ([]entities.Account, error) {
+			return []entities.Account{{ID: "acc-3"}}, nil
+		},
+		GetAccountsWithBalancesPageFunc: func(ctx context.Context, sort []entities.SortField, limit, offset int) ([]entities.Account, error) {
+			return []entities.Account{{ID: "acc-3", Balance: &entities.Balance{AccountID: "acc-3"}}}, nil
+		},
+		CountAccountsFunc: func(ctx context.Context) (int64, error) {
+			return 3, nil
+		},
+	}
+
+	uc := NewAccountUseCase(accountRepo, &mocks.BalanceRepositoryMock{}, nil)
+	page, err := uc.GetAccountsPage(context.Background(), nil, 2, 2, false)
+	require.NoError(t, err)
+	assert.Equal(t, entities.Page[entities.Account]{Items: []entities.Account{{ID: "acc-3"}}, Total: 3, Limit: 2, Offset: 2}, page)
+	assert.False(t, page.HasNext())
+	assert.Len(t, accountRepo.GetAccountsWithBalancesPageCalls(), 0)
+
+	page, err = uc.GetAccountsPage(context.Background(), nil, 2, 2, true)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	assert.NotNil(t, page.Items[0].Balance)
+
+	accountRepo.CountAccountsFunc = func(ctx context.Context) (int64, error) {
+		return 0, errors.New("connection reset")
+	}
+	_, err = uc.GetAccountsPage(context.Background(), nil, 2, 0, false)
+	assert.ErrorContains(t, err, "failed to count accounts")
+}
+
+func TestGetAccountStatement(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		from     time.Time
+		to       time.Time
+		mock     func(*mocks.AccountRepositoryMock)
+		wantFrom time.Time
+		wantTo   time.Time
+		wantErr  string
+	}{
+		{
+			name:     "explicit period",
+			id:       "acc-1",
+			from:     time.Date(2025, time.January, 10, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2025, time.February, 9, 0, 0, 0, 0, time.UTC),
+			wantFrom: time.Date(2025, time.January, 10, 0, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2025, time.February, 9, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "from defaults to the start of the month",
+			id:       "acc-1",
+			to:       time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+			wantFrom: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "single day",
+			id:       "acc-1",
+			from:     time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+			wantFrom: time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "empty id",
+			wantErr: "account ID cannot be empty",
+		},
+		{
+			name:    "from after to",
+			id:      "acc-1",
+			from:    time.Date(2025, time.March, 16, 0, 0, 0, 0, time.UTC),
+			to:      time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC),
+			wantErr: "period start 2025-03-16 is after its end 2025-03-15: malformed parameters",
+		},
+		{
+			name: "not found",
+			id:   "missing",
+			mock: func(m *mocks.AccountRepositoryMock) {
+				m.GetAccountByIDFunc = func(ctx context.Context, id string) (entities.Account, error) {
+					return entities.Account{}, errNotFound("account")
+				}
+			},
+			wantErr: "failed to get account: account not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountRepo := &mocks.AccountRepositoryMock{
+				GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+					return entities.Account{ID: id, Asset: monetary.BRL}, nil
+				},
+				GetAccountStatementFunc: func(ctx context.Context, account entities.Account, from, to time.Time) (entities.AccountStatement, error) {
+					return entities.AccountStatement{Account: account, From: from, To: to}, nil
+				},
+			}
+			if tt.mock != nil {
+				tt.mock(accountRepo)
+			}
+
+			uc := NewAccountUseCase(accountRepo, &mocks.BalanceRepositoryMock{}, nil)
+			got, err := uc.GetAccountStatement(context.Background(), tt.id, tt.from, tt.to, entities.TransactionFilter{})
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Empty(t, accountRepo.GetAccountStatementCalls())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.id, got.Account.ID)
+			assert.Equal(t, tt.wantFrom, got.From)
+			assert.Equal(t, tt.wantTo, got.To)
+		})
+	}
+}
+
+func TestGetAccountPeriodSummary(t *testing.T) {
+	from := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+			return entities.Account{ID: id, Asset: monetary.BRL}, nil
+		},
+		GetAccountPeriodSummaryFunc: func(ctx context.Context, account entities.Account, from, to time.Time) (entities.AccountPeriodSummary, error) {
+			return entities.AccountPeriodSummary{Account: account, From: from, To: to}, nil
+		},
+	}
+
+	uc := NewAccountUseCase(accountRepo, &mocks.BalanceRepositoryMock{}, nil)
+	got, err := uc.GetAccountPeriodSummary(context.Background(), "acc-1", from, to)
+	require.NoError(t, err)
+	assert.Equal(t, "acc-1", got.Account.ID)
+	assert.Equal(t, from, got.From)
+	assert.Equal(t, to, got.To)
+
+	_, err = uc.GetAccountPeriodSummary(context.Background(), "acc-1", to, from)
+	assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	assert.Len(t, accountRepo.GetAccountPeriodSummaryCalls(), 1)
+}
+
+func TestDeleteAccount(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		mock    func(*mocks.AccountRepositoryMock)
+		wantErr string
+	}{
+		{
+			name: "success",
+			id:   "acc-1",
+		},
+		{
+			name:    "empty id",
+			id:      "",
+			wantErr: "account ID cannot be empty",
+		},
+		{
+			name: "not found",
+			id:   "missing",
+			mock: func(m *mocks.AccountRepositoryMock) {
+				m.GetAccountByIDFunc = func(ctx context.Context, id string) (entities.Account, error) {
+					return entities.Account{}, errNotFound("account")
+				}
+			},
+			wantErr: "failed to get account: account not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountRepo := &mocks.AccountRepositoryMock{
+				GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+					return entities.Account{ID: id}, nil
+				},
+			}
+			if tt.mock != nil {
+				tt.mock(accountRepo)
+			}
+
+			uc := NewAccountUseCase(accountRepo, &mocks.BalanceRepositoryMock{}, nil)
+			err := uc.DeleteAccount(context.Background(), tt.id)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Empty(t, accountRepo.DeleteAccountCalls())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, accountRepo.DeleteAccountCalls(), 1)
+			assert.Equal(t, tt.id, accountRepo.DeleteAccountCalls()[0].ID)
+		})
+	}
+}
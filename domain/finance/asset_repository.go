@@ -0,0 +1,12 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/asset_repository.go . AssetRepository
+type AssetRepository interface {
+	CreateCustomAsset(ctx context.Context, asset entities.Asset) (entities.Asset, error)
+	GetAllCustomAssets(ctx context.Context) ([]entities.Asset, error)
+}
@@ -0,0 +1,101 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits of the custom assets, matching the custom_assets table
+const (
+	maxAssetNameLength   = 100
+	maxAssetSymbolLength = 10
+	maxAssetPrecision    = 18
+)
+
+var assetCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+
+type AssetUseCase struct {
+	assetRepo AssetRepository
+}
+
+func NewAssetUseCase(assetRepo AssetRepository) *AssetUseCase {
+	return &AssetUseCase{
+		assetRepo: assetRepo,
+	}
+}
+
+// LoadCustomAssets registers the custom assets of the deployment, so amounts
+// in them can be read and written. It's called when the service starts.
+func (uc *AssetUseCase) LoadCustomAssets(ctx context.Context) error {
+	assets, err := uc.assetRepo.GetAllCustomAssets(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get custom assets: %w", err)
+	}
+
+	entities.RegisterCustomAssets(assets...)
+	return nil
+}
+
+// GetAssets returns the supported assets, the built-in ones first and then the
+// custom ones by code. The custom ones are loaded again, picking up the ones
+// enabled by other instances of the service.
+func (uc *AssetUseCase) GetAssets(ctx context.Context) ([]entities.Asset, error) {
+	if err := uc.LoadCustomAssets(ctx); err != nil {
+		return nil, err
+	}
+
+	return entities.Assets(), nil
+}
+
+// CreateCustomAsset enables a custom asset for the deployment. The symbol
+// defaults to the code.
+func (uc *AssetUseCase) CreateCustomAsset(ctx context.Context, asset entities.Asset) (entities.Asset, error) {
+	asset, err := validateCustomAsset(asset)
+	if err != nil {
+		return entities.Asset{}, err
+	}
+
+	if _, ok := entities.FindSupportedAsset(asset.Asset.Asset); ok {
+		return entities.Asset{}, fmt.Errorf("asset %s already exists: %w", asset.Asset.Asset, domain.ErrConflict)
+	}
+
+	created, err := uc.assetRepo.CreateCustomAsset(ctx, asset)
+	if err != nil {
+		return entities.Asset{}, fmt.Errorf("failed to create custom asset: %w", err)
+	}
+
+	entities.RegisterCustomAssets(created)
+	return created, nil
+}
+
+func validateCustomAsset(asset entities.Asset) (entities.Asset, error) {
+	asset.Asset.Asset = strings.ToUpper(strings.TrimSpace(asset.Asset.Asset))
+	asset.Name = strings.TrimSpace(asset.Name)
+	asset.Symbol = strings.TrimSpace(asset.Symbol)
+	asset.Class = entities.AssetClassCustom
+	if asset.Symbol == "" {
+		asset.Symbol = asset.Asset.Asset
+	}
+
+	if !assetCodePattern.MatchString(asset.Asset.Asset) {
+		return entities.Asset{}, fmt.Errorf("asset code must be 2 to 10 letters or digits, starting with a letter: %w", domain.ErrMalformedParameters)
+	}
+	if asset.Name == "" {
+		return entities.Asset{}, fmt.Errorf("asset name cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	if utf8.RuneCountInString(asset.Name) > maxAssetNameLength {
+		return entities.Asset{}, fmt.Errorf("asset name must be at most %d characters: %w", maxAssetNameLength, domain.ErrMalformedParameters)
+	}
+	if utf8.RuneCountInString(asset.Symbol) > maxAssetSymbolLength {
+		return entities.Asset{}, fmt.Errorf("asset symbol must be at most %d characters: %w", maxAssetSymbolLength, domain.ErrMalformedParameters)
+	}
+	if asset.Precision < 0 || asset.Precision > maxAssetPrecision {
+		return entities.Asset{}, fmt.Errorf("asset precision must be between 0 and %d: %w", maxAssetPrecision, domain.ErrMalformedParameters)
+	}
+	return asset, nil
+}
@@ -0,0 +1,79 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetUseCase_CreateCustomAsset(t *testing.T) {
+	assetRepo := &mocks.AssetRepositoryMock{
+		CreateCustomAssetFunc: func(ctx context.Context, asset entities.Asset) (entities.Asset, error) {
+			return asset, nil
+		},
+	}
+	uc := NewAssetUseCase(assetRepo)
+
+	created, err := uc.CreateCustomAsset(context.Background(), entities.Asset{
+		Asset: monetary.Asset{Asset: " miles ", Precision: 0},
+		Name:  " Airline miles ",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "MILES", created.Asset.Asset)
+	assert.Equal(t, "MILES", created.Symbol, "the symbol defaults to the code")
+	assert.Equal(t, "Airline miles", created.Name)
+	assert.True(t, created.Custom())
+
+	asset, ok := entities.FindSupportedAsset("miles")
+	assert.True(t, ok, "enabled assets are supported right away")
+	assert.Equal(t, entities.AssetClassCustom, asset.Class)
+
+	for _, invalid := range []entities.Asset{
+		{Asset: monetary.Asset{Asset: "P"}, Name: "Points"},
+		{Asset: monetary.Asset{Asset: "1PTS"}, Name: "Points"},
+		{Asset: monetary.Asset{Asset: "PTS"}},
+		{Asset: monetary.Asset{Asset: "PTS", Precision: 19}, Name: "Points"},
+		{Asset: monetary.Asset{Asset: "PTS", Symbol: "points and more"}, Name: "Points"},
+	} {
+		_, err := uc.CreateCustomAsset(context.Background(), invalid)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters, "asset %+v", invalid)
+	}
+
+	_, err = uc.CreateCustomAsset(context.Background(), entities.Asset{Asset: monetary.Asset{Asset: "brl"}, Name: "Real"})
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	assert.Len(t, assetRepo.CreateCustomAssetCalls(), 1)
+}
+
+func TestAssetUseCase_GetAssets(t *testing.T) {
+	assetRepo := &mocks.AssetRepositoryMock{
+		GetAllCustomAssetsFunc: func(ctx context.Context) ([]entities.Asset, error) {
+			return []entities.Asset{{Asset: monetary.NewAsset("PTSX", 0, "pts", entities.AssetClassCustom), Name: "Store points"}}, nil
+		},
+	}
+	uc := NewAssetUseCase(assetRepo)
+
+	assets, err := uc.GetAssets(context.Background())
+	require.NoError(t, err)
+	require.Greater(t, len(assets), len(entities.SupportedAssets))
+	assert.Equal(t, "BRL", assets[0].Asset.Asset)
+	assert.Equal(t, "Brazilian Real", assets[0].Name)
+	assert.False(t, assets[0].Custom())
+	assert.Contains(t, assets, entities.Asset{Asset: monetary.NewAsset("PTSX", 0, "pts", entities.AssetClassCustom), Name: "Store points"})
+
+	_, ok := entities.FindSupportedAsset("PTSX")
+	assert.True(t, ok, "assets enabled elsewhere are picked up when listed")
+
+	assetRepo.GetAllCustomAssetsFunc = func(ctx context.Context) ([]entities.Asset, error) {
+		return nil, errors.New("connection refused")
+	}
+	_, err = uc.GetAssets(context.Background())
+	assert.Error(t, err)
+}
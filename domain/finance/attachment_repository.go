@@ -0,0 +1,21 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+	"time"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/attachment_repository.go . AttachmentRepository
+type AttachmentRepository interface {
+	CreateAttachment(ctx context.Context, attachment entities.Attachment) (entities.Attachment, error)
+	GetAttachmentByID(ctx context.Context, id string) (entities.Attachment, error)
+	// GetAttachmentsByTransaction returns the attachments of a transaction in
+	// the order they were added
+	GetAttachmentsByTransaction(ctx context.Context, transactionID string) ([]entities.Attachment, error)
+	// GetAttachmentsByDateRange returns the attachments of the transactions
+	// from startDate to endDate, outside the trash, by the date of their
+	// transaction
+	GetAttachmentsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]entities.Attachment, error)
+	DeleteAttachment(ctx context.Context, id string) error
+}
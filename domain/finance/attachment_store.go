@@ -0,0 +1,34 @@
+package finance
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// AttachmentStore keeps the files attached to the transactions by key, on the
+// local disk or in an S3-compatible bucket. OpenFile returns
+// domain.ErrNotFound for keys it doesn't have.
+//
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/attachment_store.go . AttachmentStore
+type AttachmentStore interface {
+	SaveFile(ctx context.Context, key, contentType string, content []byte) error
+	OpenFile(ctx context.Context, key string) (io.ReadCloser, error)
+	DeleteFile(ctx context.Context, key string) error
+}
+
+// FileURLSigner signs URLs downloading the files of the attachment store
+// straight from it for ttl, as fileName, which the S3 store can do
+//
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/file_url_signer.go . FileURLSigner
+type FileURLSigner interface {
+	SignURL(key, fileName string, ttl time.Duration) (string, error)
+}
+
+// Thumbnailer shrinks the pictures attached to the transactions down to a
+// PNG thumbnail
+//
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/thumbnailer.go . Thumbnailer
+type Thumbnailer interface {
+	Thumbnail(content []byte) ([]byte, error)
+}
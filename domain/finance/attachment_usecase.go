@@ -0,0 +1,454 @@
+package finance
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// thumbnailContentTypes are the pictures the thumbnailer can decode
+var thumbnailContentTypes = []string{"image/jpeg", "image/png", "image/gif"}
+
+// AttachmentUseCase keeps the receipts and other files attached to the
+// transactions, with a thumbnail of the pictures to show next to them
+type AttachmentUseCase struct {
+	attachmentRepo  AttachmentRepository
+	transactionRepo TransactionRepository
+	store           AttachmentStore
+	thumbnailer     Thumbnailer
+	quotas          *QuotaGuard
+	now             func() time.Time
+
+	// archives holds the attachment archives being made or ready to be
+	// downloaded, by ID
+	archivesMu sync.Mutex
+	archives   map[string]*entities.AttachmentArchive
+}
+
+func NewAttachmentUseCase(attachmentRepo AttachmentRepository, transactionRepo TransactionRepository, store AttachmentStore, thumbnailer Thumbnailer, quotas *QuotaGuard) *AttachmentUseCase {
+	return &AttachmentUseCase{
+		attachmentRepo:  attachmentRepo,
+		transactionRepo: transactionRepo,
+		store:           store,
+		thumbnailer:     thumbnailer,
+		quotas:          quotas,
+		now:             time.Now,
+		archives:        map[string]*entities.AttachmentArchive{},
+	}
+}
+
+// AddAttachment stores content and attaches it to a transaction. Pictures get
+// a thumbnail, but the file is attached even when one can't be made. Files
+// that would take the user over their attachment storage quota are refused
+// with a domain.QuotaError.
+func (uc *AttachmentUseCase) AddAttachment(ctx context.Context, attachment entities.Attachment, content []byte) (entities.Attachment, error) {
+	if _, err := uc.transaction(ctx, attachment.TransactionID); err != nil {
+		return entities.Attachment{}, err
+	}
+
+	attachment.FileName = strings.TrimSpace(path.Base(strings.ReplaceAll(attachment.FileName, `\`, "/")))
+	if attachment.FileName == "" || attachment.FileName == "." || attachment.FileName == "/" {
+		return entities.Attachment{}, fmt.Errorf("attachment file name cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	if len(attachment.FileName) > 255 {
+		return entities.Attachment{}, fmt.Errorf("attachment file name cannot be longer than 255 characters: %w", domain.ErrMalformedParameters)
+	}
+	if !slices.Contains(entities.AttachmentContentTypes, attachment.ContentType) {
+		return entities.Attachment{}, fmt.Errorf("unsupported attachment type %s, expected one of %s: %w", attachment.ContentType, strings.Join(entities.AttachmentContentTypes, ", "), domain.ErrMalformedParameters)
+	}
+	if len(content) == 0 {
+		return entities.Attachment{}, fmt.Errorf("attachment cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	if len(content) > entities.MaxAttachmentSize {
+		return entities.Attachment{}, fmt.Errorf("attachment cannot be larger than %d bytes: %w", entities.MaxAttachmentSize, domain.ErrMalformedParameters)
+	}
+	attachment.Size = int64(len(content))
+	if err := uc.quotas.CheckAttachmentBytes(ctx, attachment.Size); err != nil {
+		return entities.Attachment{}, err
+	}
+
+	// The keys are random, so they can't be guessed from the transaction or
+	// the file name
+	attachment.StorageKey = attachment.TransactionID + "/" + randomKey()
+	attachment.ThumbnailKey = ""
+	if err := uc.store.SaveFile(ctx, attachment.StorageKey, attachment.ContentType, content); err != nil {
+		return entities.Attachment{}, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	if slices.Contains(thumbnailContentTypes, attachment.ContentType) {
+		uc.saveThumbnail(ctx, &attachment, content)
+	}
+
+	created, err := uc.attachmentRepo.CreateAttachment(ctx, attachment)
+	if err != nil {
+		uc.deleteFiles(ctx, attachment)
+		return entities.Attachment{}, fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	return created, nil
+}
+
+// saveThumbnail stores a thumbnail of a picture, leaving the attachment
+// without one when it can't be made
+func (uc *AttachmentUseCase) saveThumbnail(ctx context.Context, attachment *entities.Attachment, content []byte) {
+	thumbnail, err := uc.thumbnailer.Thumbnail(content)
+	if err != nil {
+		slog.Warn("failed to make attachment thumbnail", "transaction_id", attachment.TransactionID, "error", err)
+		return
+	}
+
+	key := attachment.StorageKey + "-thumbnail.png"
+	if err := uc.store.SaveFile(ctx, key, "image/png", thumbnail); err != nil {
+		slog.Warn("failed to store attachment thumbnail", "transaction_id", attachment.TransactionID, "error", err)
+		return
+	}
+	attachment.ThumbnailKey = key
+}
+
+// GetAttachments returns the attachments of a transaction in the order they
+// were added
+func (uc *AttachmentUseCase) GetAttachments(ctx context.Context, transactionID string) ([]entities.Attachment, error) {
+	if _, err := uc.transaction(ctx, transactionID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := uc.attachmentRepo.GetAttachmentsByTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// GetAttachment returns an attachment of a transaction
+func (uc *AttachmentUseCase) GetAttachment(ctx context.Context, transactionID, id string) (entities.Attachment, error) {
+	if id == "" {
+		return entities.Attachment{}, fmt.Errorf("attachment ID cannot be empty")
+	}
+	if _, err := uc.transaction(ctx, transactionID); err != nil {
+		return entities.Attachment{}, err
+	}
+
+	attachment, err := uc.attachmentRepo.GetAttachmentByID(ctx, id)
+	if err != nil {
+		return entities.Attachment{}, fmt.Errorf("failed to get attachment: %w", err)
+	}
+	// Attachments are only reachable through their own transaction
+	if attachment.TransactionID != transactionID {
+		return entities.Attachment{}, fmt.Errorf("failed to get attachment: attachment %w", domain.ErrNotFound)
+	}
+
+	return attachment, nil
+}
+
+// OpenAttachment returns an attachment of a transaction and its file, or the
+// file of its thumbnail. The caller closes the file.
+func (uc *AttachmentUseCase) OpenAttachment(ctx context.Context, transactionID, id string, thumbnail bool) (entities.Attachment, io.ReadCloser, error) {
+	attachment, err := uc.GetAttachment(ctx, transactionID, id)
+	if err != nil {
+		return entities.Attachment{}, nil, err
+	}
+
+	key := attachment.StorageKey
+	if thumbnail {
+		if !attachment.HasThumbnail() {
+			return entities.Attachment{}, nil, fmt.Errorf("attachment thumbnail %w", domain.ErrNotFound)
+		}
+		key = attachment.ThumbnailKey
+	}
+
+	file, err := uc.store.OpenFile(ctx, key)
+	if err != nil {
+		return entities.Attachment{}, nil, fmt.Errorf("failed to open attachment: %w", err)
+	}
+
+	return attachment, file, nil
+}
+
+// DeleteAttachment takes an attachment off its transaction and deletes its
+// files
+func (uc *AttachmentUseCase) DeleteAttachment(ctx context.Context, transactionID, id string) error {
+	attachment, err := uc.GetAttachment(ctx, transactionID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.attachmentRepo.DeleteAttachment(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+	uc.deleteFiles(ctx, attachment)
+
+	return nil
+}
+
+// StartAttachmentArchive starts zipping the attachments of the transactions
+// from from to to in the background, a folder for each transaction, and
+// returns the archive being made. An archive of the same period already being
+// made or ready is returned instead of making another.
+func (uc *AttachmentUseCase) StartAttachmentArchive(ctx context.Context, from, to time.Time) (entities.AttachmentArchive, error) {
+	if from.IsZero() || to.IsZero() {
+		return entities.AttachmentArchive{}, fmt.Errorf("archive period needs both from and to: %w", domain.ErrMalformedParameters)
+	}
+	if to.Before(from) {
+		return entities.AttachmentArchive{}, fmt.Errorf("archive period cannot end before it starts: %w", domain.ErrMalformedParameters)
+	}
+
+	uc.expireArchives(ctx)
+
+	ownerID, _ := domain.UserFromContext(ctx)
+	bookID := domain.BookFromContext(ctx)
+	uc.archivesMu.Lock()
+	for _, archive := range uc.archives {
+		if archive.OwnerID == ownerID && archive.BookID == bookID && archive.From.Equal(from) && archive.To.Equal(to) &&
+			archive.State != entities.AttachmentArchiveStateFailed {
+			uc.archivesMu.Unlock()
+			return *archive, nil
+		}
+	}
+	uc.archivesMu.Unlock()
+
+	attachments, err := uc.attachmentRepo.GetAttachmentsByDateRange(ctx, from, to)
+	if err != nil {
+		return entities.AttachmentArchive{}, fmt.Errorf("failed to get attachments: %w", err)
+	}
+	var size int64
+	for _, attachment := range attachments {
+		size += attachment.Size
+	}
+	if size > entities.MaxAttachmentArchiveSize {
+		return entities.AttachmentArchive{}, fmt.Errorf("attachments of the period add up to more than %d bytes, archive a shorter one: %w", entities.MaxAttachmentArchiveSize, domain.ErrMalformedParameters)
+	}
+
+	transactions, err := uc.transactionRepo.GetTransactionsByDateRange(ctx, from, to)
+	if err != nil {
+		return entities.AttachmentArchive{}, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	folders := make(map[string]string, len(transactions))
+	for _, transaction := range transactions {
+		folders[transaction.ID] = archiveFolder(transaction)
+	}
+
+	archive := &entities.AttachmentArchive{
+		ID:          randomKey(),
+		From:        from,
+		To:          to,
+		State:       entities.AttachmentArchiveStateRunning,
+		Attachments: len(attachments),
+		BookID:      bookID,
+		OwnerID:     ownerID,
+		StartedAt:   uc.now(),
+	}
+	uc.archivesMu.Lock()
+	uc.archives[archive.ID] = archive
+	started := *archive
+	uc.archivesMu.Unlock()
+
+	// The archive outlives the request that started it
+	go uc.makeArchive(context.WithoutCancel(ctx), archive, attachments, folders)
+
+	return started, nil
+}
+
+// GetAttachmentArchive returns an attachment archive of the signed in user in
+// the book
+func (uc *AttachmentUseCase) GetAttachmentArchive(ctx context.Context, id string) (entities.AttachmentArchive, error) {
+	ownerID, _ := domain.UserFromContext(ctx)
+
+	uc.archivesMu.Lock()
+	defer uc.archivesMu.Unlock()
+
+	archive, ok := uc.archives[id]
+	if !ok || archive.OwnerID != ownerID || archive.BookID != domain.BookFromContext(ctx) {
+		return entities.AttachmentArchive{}, fmt.Errorf("attachment archive %w", domain.ErrNotFound)
+	}
+	if archive.State == entities.AttachmentArchiveStateReady && !uc.now().Before(archive.ExpiresAt) {
+		return entities.AttachmentArchive{}, fmt.Errorf("attachment archive expired: %w", domain.ErrNotFound)
+	}
+
+	return *archive, nil
+}
+
+// OpenAttachmentArchive returns an attachment archive ready to be downloaded
+// and its zip. The caller closes the zip.
+func (uc *AttachmentUseCase) OpenAttachmentArchive(ctx context.Context, id string) (entities.AttachmentArchive, io.ReadCloser, error) {
+	archive, err := uc.GetAttachmentArchive(ctx, id)
+	if err != nil {
+		return entities.AttachmentArchive{}, nil, err
+	}
+	switch archive.State {
+	case entities.AttachmentArchiveStateRunning:
+		return entities.AttachmentArchive{}, nil, fmt.Errorf("attachment archive is still being made: %w", domain.ErrConflict)
+	case entities.AttachmentArchiveStateFailed:
+		return entities.AttachmentArchive{}, nil, fmt.Errorf("attachment archive failed: %s: %w", archive.Error, domain.ErrConflict)
+	}
+
+	file, err := uc.store.OpenFile(ctx, archive.StorageKey)
+	if err != nil {
+		return entities.AttachmentArchive{}, nil, fmt.Errorf("failed to open attachment archive: %w", err)
+	}
+
+	return archive, file, nil
+}
+
+// makeArchive zips the attachments into the attachment store, recording how
+// it went on the archive
+func (uc *AttachmentUseCase) makeArchive(ctx context.Context, archive *entities.AttachmentArchive, attachments []entities.Attachment, folders map[string]string) {
+	key := "archives/" + archive.ID + ".zip"
+	content, err := uc.zipAttachments(ctx, attachments, folders)
+	if err == nil {
+		err = uc.store.SaveFile(ctx, key, "application/zip", content)
+	}
+
+	uc.archivesMu.Lock()
+	defer uc.archivesMu.Unlock()
+
+	archive.FinishedAt = uc.now()
+	if err != nil {
+		slog.Error("failed to make attachment archive", "archive_id", archive.ID, "error", err)
+		archive.State = entities.AttachmentArchiveStateFailed
+		archive.Error = err.Error()
+		return
+	}
+	archive.State = entities.AttachmentArchiveStateReady
+	archive.StorageKey = key
+	archive.Size = int64(len(content))
+	archive.ExpiresAt = archive.FinishedAt.Add(entities.AttachmentArchiveTTL)
+}
+
+// zipAttachments puts each attachment in the folder of its transaction,
+// numbering the files of a folder sharing a name
+func (uc *AttachmentUseCase) zipAttachments(ctx context.Context, attachments []entities.Attachment, folders map[string]string) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	names := map[string]bool{}
+	for _, attachment := range attachments {
+		folder, ok := folders[attachment.TransactionID]
+		if !ok {
+			folder = attachment.TransactionID
+		}
+		name := folder + "/" + attachment.FileName
+		ext := path.Ext(attachment.FileName)
+		for n := 2; names[name]; n++ {
+			name = fmt.Sprintf("%s/%s (%d)%s", folder, strings.TrimSuffix(attachment.FileName, ext), n, ext)
+		}
+		names[name] = true
+
+		if err := uc.zipAttachment(ctx, zw, name, attachment); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (uc *AttachmentUseCase) zipAttachment(ctx context.Context, zw *zip.Writer, name string, attachment entities.Attachment) error {
+	file, err := uc.store.OpenFile(ctx, attachment.StorageKey)
+	if err != nil {
+		return fmt.Errorf("failed to open attachment %s: %w", attachment.ID, err)
+	}
+	defer file.Close()
+
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: attachment.CreatedAt})
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, file); err != nil {
+		return fmt.Errorf("failed to read attachment %s: %w", attachment.ID, err)
+	}
+	return nil
+}
+
+// expireArchives forgets the archives past their expiry, or failed as long
+// ago, and deletes their zips
+func (uc *AttachmentUseCase) expireArchives(ctx context.Context) {
+	now := uc.now()
+	var keys []string
+	uc.archivesMu.Lock()
+	for id, archive := range uc.archives {
+		switch {
+		case archive.State == entities.AttachmentArchiveStateReady && !now.Before(archive.ExpiresAt):
+			keys = append(keys, archive.StorageKey)
+		case archive.State == entities.AttachmentArchiveStateFailed && !now.Before(archive.FinishedAt.Add(entities.AttachmentArchiveTTL)):
+			// A failed archive left no zip behind
+		default:
+			continue
+		}
+		delete(uc.archives, id)
+	}
+	uc.archivesMu.Unlock()
+
+	for _, key := range keys {
+		if err := uc.store.DeleteFile(ctx, key); err != nil {
+			slog.Warn("failed to delete attachment archive", "key", key, "error", err)
+		}
+	}
+}
+
+// archiveFolder names the folder of the attachments of a transaction in an
+// archive after its date and description
+func archiveFolder(transaction entities.Transaction) string {
+	description := strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || unicode.IsControl(r) {
+			return '-'
+		}
+		return r
+	}, strings.TrimSpace(transaction.Description))
+	if runes := []rune(description); len(runes) > 64 {
+		description = strings.TrimSpace(string(runes[:64]))
+	}
+	if description == "" {
+		description = transaction.ID
+	}
+	return transaction.Date.Format("2006-01-02") + " " + description
+}
+
+// transaction reads a transaction of the signed in user, which can't be in
+// the trash
+func (uc *AttachmentUseCase) transaction(ctx context.Context, id string) (entities.Transaction, error) {
+	if id == "" {
+		return entities.Transaction{}, fmt.Errorf("transaction ID cannot be empty")
+	}
+
+	transaction, err := ownTransaction(ctx, uc.transactionRepo, id)
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	return transaction, nil
+}
+
+// deleteFiles deletes the files of an attachment. Once the attachment is gone
+// they can't be reached anymore, so failing to delete them is only logged.
+func (uc *AttachmentUseCase) deleteFiles(ctx context.Context, attachment entities.Attachment) {
+	for _, key := range []string{attachment.StorageKey, attachment.ThumbnailKey} {
+		if key == "" {
+			continue
+		}
+		if err := uc.store.DeleteFile(ctx, key); err != nil {
+			slog.Warn("failed to delete attachment file", "key", key, "error", err)
+		}
+	}
+}
+
+// randomKey is 128 random bits in hex
+func randomKey() string {
+	b := make([]byte, 16)
+	// crypto/rand.Read never fails
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,292 @@
+package finance
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentUseCase(t *testing.T) {
+	files := map[string][]byte{}
+	store := &mocks.AttachmentStoreMock{
+		SaveFileFunc: func(ctx context.Context, key, contentType string, content []byte) error {
+			files[key] = content
+			return nil
+		},
+		OpenFileFunc: func(ctx context.Context, key string) (io.ReadCloser, error) {
+			content, ok := files[key]
+			if !ok {
+				return nil, domain.ErrNotFound
+			}
+			return io.NopCloser(bytes.NewReader(content)), nil
+		},
+		DeleteFileFunc: func(ctx context.Context, key string) error {
+			delete(files, key)
+			return nil
+		},
+	}
+	thumbnailer := &mocks.ThumbnailerMock{
+		ThumbnailFunc: func(content []byte) ([]byte, error) {
+			if string(content) == "broken" {
+				return nil, errors.New("invalid image")
+			}
+			return []byte("thumbnail"), nil
+		},
+	}
+	attachments := map[string]entities.Attachment{}
+	attachmentRepo := &mocks.AttachmentRepositoryMock{
+		CreateAttachmentFunc: func(ctx context.Context, attachment entities.Attachment) (entities.Attachment, error) {
+			if attachment.FileName == "fails.png" {
+				return entities.Attachment{}, errors.New("database is down")
+			}
+			attachment.ID = "att-" + attachment.FileName
+			attachments[attachment.ID] = attachment
+			return attachment, nil
+		},
+		GetAttachmentByIDFunc: func(ctx context.Context, id string) (entities.Attachment, error) {
+			attachment, ok := attachments[id]
+			if !ok {
+				return entities.Attachment{}, domain.ErrNotFound
+			}
+			return attachment, nil
+		},
+		DeleteAttachmentFunc: func(ctx context.Context, id string) error {
+			delete(attachments, id)
+			return nil
+		},
+	}
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionByIDFunc: func(ctx context.Context, id string) (entities.Transaction, error) {
+			if id != "tx-1" && id != "tx-2" {
+				return entities.Transaction{}, domain.ErrNotFound
+			}
+			return entities.Transaction{ID: id, OwnerID: "user-1"}, nil
+		},
+	}
+	uc := NewAttachmentUseCase(attachmentRepo, transactionRepo, store, thumbnailer, nil)
+	ctx := context.Background()
+
+	t.Run("picture with a thumbnail", func(t *testing.T) {
+		created, err := uc.AddAttachment(ctx, entities.Attachment{TransactionID: "tx-1", FileName: `C:\Receipts\market.jpg`, ContentType: "image/jpeg"}, []byte("jpeg"))
+		require.NoError(t, err)
+		assert.Equal(t, "market.jpg", created.FileName)
+		assert.Equal(t, int64(4), created.Size)
+		assert.True(t, strings.HasPrefix(created.StorageKey, "tx-1/"))
+		assert.Equal(t, created.StorageKey+"-thumbnail.png", created.ThumbnailKey)
+
+		_, file, err := uc.OpenAttachment(ctx, "tx-1", created.ID, true)
+		require.NoError(t, err)
+		defer file.Close()
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.Equal(t, "thumbnail", string(content))
+	})
+
+	t.Run("PDFs and broken pictures have no thumbnail", func(t *testing.T) {
+		created, err := uc.AddAttachment(ctx, entities.Attachment{TransactionID: "tx-1", FileName: "invoice.pdf", ContentType: "application/pdf"}, []byte("%PDF"))
+		require.NoError(t, err)
+		assert.False(t, created.HasThumbnail())
+
+		created, err = uc.AddAttachment(ctx, entities.Attachment{TransactionID: "tx-1", FileName: "broken.png", ContentType: "image/png"}, []byte("broken"))
+		require.NoError(t, err)
+		assert.False(t, created.HasThumbnail())
+
+		_, _, err = uc.OpenAttachment(ctx, "tx-1", created.ID, true)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			attachment entities.Attachment
+			content    []byte
+			wantErr    error
+		}{
+			{"unsupported type", entities.Attachment{TransactionID: "tx-1", FileName: "notes.txt", ContentType: "text/plain"}, []byte("hi"), domain.ErrMalformedParameters},
+			{"empty", entities.Attachment{TransactionID: "tx-1", FileName: "empty.png", ContentType: "image/png"}, nil, domain.ErrMalformedParameters},
+			{"too large", entities.Attachment{TransactionID: "tx-1", FileName: "large.png", ContentType: "image/png"}, make([]byte, entities.MaxAttachmentSize+1), domain.ErrMalformedParameters},
+			{"no file name", entities.Attachment{TransactionID: "tx-1", FileName: " ", ContentType: "image/png"}, []byte("png"), domain.ErrMalformedParameters},
+			{"unknown transaction", entities.Attachment{TransactionID: "tx-9", FileName: "receipt.png", ContentType: "image/png"}, []byte("png"), domain.ErrNotFound},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := uc.AddAttachment(ctx, tt.attachment, tt.content)
+				assert.ErrorIs(t, err, tt.wantErr)
+			})
+		}
+	})
+
+	t.Run("files are deleted when the attachment isn't created", func(t *testing.T) {
+		before := len(files)
+		_, err := uc.AddAttachment(ctx, entities.Attachment{TransactionID: "tx-1", FileName: "fails.png", ContentType: "image/png"}, []byte("png"))
+		require.Error(t, err)
+		assert.Len(t, files, before)
+	})
+
+	t.Run("over the storage quota", func(t *testing.T) {
+		userRepo := &mocks.UserRepositoryMock{
+			GetUserUsageFunc: func(ctx context.Context, id string, since time.Time) (entities.UserUsage, error) {
+				return entities.UserUsage{Tier: entities.UserTierFree, AttachmentBytes: 8}, nil
+			},
+		}
+		limited := NewAttachmentUseCase(attachmentRepo, transactionRepo, store, thumbnailer, NewQuotaGuard(userRepo, QuotaPolicy{MaxAttachmentBytes: 10}))
+		userCtx := domain.WithUser(ctx, "user-1")
+
+		before := len(files)
+		_, err := limited.AddAttachment(userCtx, entities.Attachment{TransactionID: "tx-1", FileName: "large.pdf", ContentType: "application/pdf"}, []byte("%PDF-1"))
+		var quota *domain.QuotaError
+		require.ErrorAs(t, err, &quota)
+		assert.Equal(t, QuotaAttachmentBytes, quota.Quota)
+		assert.Len(t, files, before)
+
+		_, err = limited.AddAttachment(userCtx, entities.Attachment{TransactionID: "tx-1", FileName: "small.pdf", ContentType: "application/pdf"}, []byte("%P"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("only reachable through their transaction", func(t *testing.T) {
+		_, _, err := uc.OpenAttachment(ctx, "tx-2", "att-market.jpg", false)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.ErrorIs(t, uc.DeleteAttachment(ctx, "tx-2", "att-market.jpg"), domain.ErrNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		attachment := attachments["att-market.jpg"]
+		require.NoError(t, uc.DeleteAttachment(ctx, "tx-1", attachment.ID))
+		assert.NotContains(t, files, attachment.StorageKey)
+		assert.NotContains(t, files, attachment.ThumbnailKey)
+
+		_, err := uc.GetAttachment(ctx, "tx-1", attachment.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestAttachmentArchive(t *testing.T) {
+	var mu sync.Mutex
+	files := map[string][]byte{
+		"tx-1/a": []byte("receipt one"),
+		"tx-1/b": []byte("receipt two"),
+		"tx-2/c": []byte("invoice"),
+	}
+	store := &mocks.AttachmentStoreMock{
+		SaveFileFunc: func(ctx context.Context, key, contentType string, content []byte) error {
+			mu.Lock()
+			defer mu.Unlock()
+			files[key] = content
+			return nil
+		},
+		OpenFileFunc: func(ctx context.Context, key string) (io.ReadCloser, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			content, ok := files[key]
+			if !ok {
+				return nil, domain.ErrNotFound
+			}
+			return io.NopCloser(bytes.NewReader(content)), nil
+		},
+		DeleteFileFunc: func(ctx context.Context, key string) error {
+			mu.Lock()
+			defer mu.Unlock()
+			delete(files, key)
+			return nil
+		},
+	}
+	attachmentRepo := &mocks.AttachmentRepositoryMock{
+		GetAttachmentsByDateRangeFunc: func(ctx context.Context, startDate, endDate time.Time) ([]entities.Attachment, error) {
+			return []entities.Attachment{
+				{ID: "att-1", TransactionID: "tx-1", FileName: "receipt.jpg", Size: 11, StorageKey: "tx-1/a"},
+				{ID: "att-2", TransactionID: "tx-1", FileName: "receipt.jpg", Size: 11, StorageKey: "tx-1/b"},
+				{ID: "att-3", TransactionID: "tx-2", FileName: "invoice.pdf", Size: 7, StorageKey: "tx-2/c"},
+			}, nil
+		},
+	}
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionsByDateRangeFunc: func(ctx context.Context, startDate, endDate time.Time) ([]entities.Transaction, error) {
+			return []entities.Transaction{
+				{ID: "tx-1", Description: "Lunch w/ client", Date: time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)},
+				{ID: "tx-2", Description: "Hotel", Date: time.Date(2025, 4, 12, 0, 0, 0, 0, time.UTC)},
+			}, nil
+		},
+	}
+	uc := NewAttachmentUseCase(attachmentRepo, transactionRepo, store, &mocks.ThumbnailerMock{}, nil)
+	now := time.Date(2025, 5, 2, 9, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+
+	ctx := domain.WithUser(context.Background(), "user-1")
+	from := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)
+
+	t.Run("invalid period", func(t *testing.T) {
+		_, err := uc.StartAttachmentArchive(ctx, time.Time{}, to)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		_, err = uc.StartAttachmentArchive(ctx, to, from)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+
+	archive, err := uc.StartAttachmentArchive(ctx, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, 3, archive.Attachments)
+
+	require.Eventually(t, func() bool {
+		archive, err = uc.GetAttachmentArchive(ctx, archive.ID)
+		return err == nil && archive.State != entities.AttachmentArchiveStateRunning
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, entities.AttachmentArchiveStateReady, archive.State)
+	assert.Equal(t, now.Add(entities.AttachmentArchiveTTL), archive.ExpiresAt)
+
+	t.Run("a folder for each transaction", func(t *testing.T) {
+		_, file, err := uc.OpenAttachmentArchive(ctx, archive.ID)
+		require.NoError(t, err)
+		defer file.Close()
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+
+		zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+		require.NoError(t, err)
+		var names []string
+		for _, f := range zr.File {
+			names = append(names, f.Name)
+		}
+		assert.Equal(t, []string{
+			"2025-04-10 Lunch w- client/receipt.jpg",
+			"2025-04-10 Lunch w- client/receipt (2).jpg",
+			"2025-04-12 Hotel/invoice.pdf",
+		}, names)
+	})
+
+	t.Run("the same period returns the same archive", func(t *testing.T) {
+		again, err := uc.StartAttachmentArchive(ctx, from, to)
+		require.NoError(t, err)
+		assert.Equal(t, archive.ID, again.ID)
+	})
+
+	t.Run("only reachable by its user", func(t *testing.T) {
+		_, err := uc.GetAttachmentArchive(domain.WithUser(context.Background(), "user-2"), archive.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("expires", func(t *testing.T) {
+		now = now.Add(entities.AttachmentArchiveTTL)
+		_, err := uc.GetAttachmentArchive(ctx, archive.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		// Starting another archive deletes the expired ones
+		_, err = uc.StartAttachmentArchive(ctx, from, from)
+		require.NoError(t, err)
+		mu.Lock()
+		defer mu.Unlock()
+		assert.NotContains(t, files, archive.StorageKey)
+	})
+}
@@ -0,0 +1,13 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/auth_event_repository.go . AuthEventRepository
+type AuthEventRepository interface {
+	CreateAuthEvent(ctx context.Context, event entities.AuthEvent) (entities.AuthEvent, error)
+	// GetAuthEventsByUser lists the latest events of the user, the newest first
+	GetAuthEventsByUser(ctx context.Context, userID string, limit int) ([]entities.AuthEvent, error)
+}
@@ -0,0 +1,14 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/backup_store.go . BackupStore
+type BackupStore interface {
+	SaveBackup(ctx context.Context, backup entities.Backup) (entities.BackupFile, error)
+	ListBackups(ctx context.Context) ([]entities.BackupFile, error)
+	LoadBackup(ctx context.Context, name string) (entities.Backup, error)
+	DeleteBackup(ctx context.Context, name string) error
+}
@@ -0,0 +1,278 @@
+package finance
+
+import (
+	"cmp"
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// BackupUseCase takes full backups of the data, prunes them according to a
+// retention policy and restores them into a database without accounts
+type BackupUseCase struct {
+	accountRepo      AccountRepository
+	categoryRepo     CategoryRepository
+	transactionRepo  TransactionRepository
+	balanceRepo      BalanceRepository
+	settingsRepo     SettingsRepository
+	userSettingsRepo UserSettingsRepository
+	store            BackupStore
+	now              func() time.Time
+}
+
+func NewBackupUseCase(accountRepo AccountRepository, categoryRepo CategoryRepository, transactionRepo TransactionRepository, balanceRepo BalanceRepository, settingsRepo SettingsRepository, userSettingsRepo UserSettingsRepository, store BackupStore) *BackupUseCase {
+	return &BackupUseCase{
+		accountRepo:      accountRepo,
+		categoryRepo:     categoryRepo,
+		transactionRepo:  transactionRepo,
+		balanceRepo:      balanceRepo,
+		settingsRepo:     settingsRepo,
+		userSettingsRepo: userSettingsRepo,
+		store:            store,
+		now:              time.Now,
+	}
+}
+
+// CreateBackup stores a full backup of the data
+func (uc *BackupUseCase) CreateBackup(ctx context.Context) (entities.BackupFile, error) {
+	backup := entities.Backup{CreatedAt: uc.now().UTC()}
+
+	var err error
+	if backup.Settings, err = uc.settingsRepo.GetSettings(ctx); err != nil {
+		return entities.BackupFile{}, fmt.Errorf("failed to get settings: %w", err)
+	}
+	if backup.UserSettings, err = uc.userSettingsRepo.GetAllUserSettings(ctx); err != nil {
+		return entities.BackupFile{}, fmt.Errorf("failed to get user settings: %w", err)
+	}
+	if backup.Categories, err = uc.categoryRepo.GetAllCategories(ctx, nil); err != nil {
+		return entities.BackupFile{}, fmt.Errorf("failed to get categories: %w", err)
+	}
+	if backup.Accounts, err = uc.accountRepo.GetAllAccounts(ctx, nil); err != nil {
+		return entities.BackupFile{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	if backup.Transactions, err = uc.transactionRepo.GetAllTransactions(ctx); err != nil {
+		return entities.BackupFile{}, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	file, err := uc.store.SaveBackup(ctx, backup)
+	if err != nil {
+		return entities.BackupFile{}, fmt.Errorf("failed to save backup: %w", err)
+	}
+	return file, nil
+}
+
+// ListBackups returns the stored backups, newest first
+func (uc *BackupUseCase) ListBackups(ctx context.Context) ([]entities.BackupFile, error) {
+	files, err := uc.store.ListBackups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	slices.SortFunc(files, func(a, b entities.BackupFile) int {
+		return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), cmp.Compare(b.Name, a.Name))
+	})
+	return files, nil
+}
+
+// PruneBackups deletes the backups the retention policy doesn't keep,
+// returning them
+func (uc *BackupUseCase) PruneBackups(ctx context.Context, retention entities.BackupRetention) ([]entities.BackupFile, error) {
+	if retention.Daily < 1 || retention.Weekly < 0 {
+		return nil, fmt.Errorf("%w: retention must keep at least one daily backup", domain.ErrMalformedParameters)
+	}
+
+	files, err := uc.ListBackups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := []entities.BackupFile{}
+	for _, file := range expiredBackups(files, retention) {
+		if err := uc.store.DeleteBackup(ctx, file.Name); err != nil {
+			return deleted, fmt.Errorf("failed to delete backup %s: %w", file.Name, err)
+		}
+		deleted = append(deleted, file)
+	}
+	return deleted, nil
+}
+
+// expiredBackups returns the backups, sorted newest first, that are neither
+// the newest of one of the last retention.Daily days nor of one of the last
+// retention.Weekly ISO weeks
+func expiredBackups(files []entities.BackupFile, retention entities.BackupRetention) []entities.BackupFile {
+	days := map[string]bool{}
+	weeks := map[string]bool{}
+
+	var expired []entities.BackupFile
+	for _, file := range files {
+		created := file.CreatedAt.UTC()
+		day := created.Format(time.DateOnly)
+		year, week := created.ISOWeek()
+		weekKey := fmt.Sprintf("%d-W%02d", year, week)
+
+		keep := false
+		if !days[day] && len(days) < retention.Daily {
+			days[day] = true
+			keep = true
+		}
+		if !weeks[weekKey] && len(weeks) < retention.Weekly {
+			weeks[weekKey] = true
+			keep = true
+		}
+		if !keep {
+			expired = append(expired, file)
+		}
+	}
+	return expired
+}
+
+// RestoreBackup recreates the data of a backup in a database without
+// accounts. Categories are matched by name and type to the existing ones,
+// accounts and transactions get new IDs and the balances are recalculated.
+// The accounts created are removed again when the restore fails halfway.
+func (uc *BackupUseCase) RestoreBackup(ctx context.Context, name string) (entities.BackupRestore, error) {
+	existing, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return entities.BackupRestore{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	if len(existing) > 0 {
+		return entities.BackupRestore{}, fmt.Errorf("backups can only be restored into a database without accounts: %w", domain.ErrConflict)
+	}
+
+	backup, err := uc.store.LoadBackup(ctx, name)
+	if err != nil {
+		return entities.BackupRestore{}, fmt.Errorf("failed to load backup %s: %w", name, err)
+	}
+
+	var restore entities.BackupRestore
+	if _, err := uc.settingsRepo.UpdateSettings(ctx, backup.Settings); err != nil {
+		return restore, fmt.Errorf("failed to restore settings: %w", err)
+	}
+
+	categoryIDs, err := uc.restoreCategories(ctx, backup.Categories, &restore)
+	if err != nil {
+		return restore, err
+	}
+
+	accountIDs := make(map[string]string, len(backup.Accounts))
+	created := make([]string, 0, len(backup.Accounts))
+	for _, account := range backup.Accounts {
+		oldID := account.ID
+		account.ID = ""
+		account.Balance = nil
+		restored, err := uc.accountRepo.CreateAccount(ctx, account)
+		if err != nil {
+			uc.deleteAccounts(ctx, created)
+			return entities.BackupRestore{}, fmt.Errorf("failed to restore account %s: %w", account.Name, err)
+		}
+		accountIDs[oldID] = restored.ID
+		created = append(created, restored.ID)
+		restore.Accounts++
+	}
+
+	for _, transaction := range backup.Transactions {
+		accountID, ok := accountIDs[transaction.AccountID]
+		if !ok {
+			uc.deleteAccounts(ctx, created)
+			return entities.BackupRestore{}, fmt.Errorf("transaction %s references unknown account %s: %w", transaction.ID, transaction.AccountID, domain.ErrMalformedParameters)
+		}
+
+		transaction.ID = ""
+		transaction.AccountID = accountID
+		transaction.CategoryID = categoryIDs[transaction.CategoryID]
+		transaction.Account, transaction.Category = nil, nil
+		if _, err := uc.transactionRepo.CreateTransaction(ctx, transaction); err != nil {
+			uc.deleteAccounts(ctx, created)
+			return entities.BackupRestore{}, fmt.Errorf("failed to restore transaction: %w", err)
+		}
+		restore.Transactions++
+	}
+
+	for _, setting := range backup.UserSettings {
+		// The demo accounts setting lists account IDs, which changed
+		if setting.Key == entities.UserSettingDemoAccounts {
+			setting.Value = remapIDs(setting.Value, accountIDs)
+		}
+		if _, err := uc.userSettingsRepo.UpsertUserSetting(ctx, setting); err != nil {
+			return restore, fmt.Errorf("failed to restore user setting %s: %w", setting.Key, err)
+		}
+	}
+
+	for _, id := range created {
+		if err := uc.balanceRepo.RefreshAccountBalance(ctx, id); err != nil {
+			return restore, fmt.Errorf("failed to refresh balance of account %s: %w", id, err)
+		}
+	}
+
+	return restore, nil
+}
+
+// restoreCategories maps the backup category IDs to the existing category of
+// the same name and type, creating the missing ones. The groups are restored
+// before the categories in them.
+func (uc *BackupUseCase) restoreCategories(ctx context.Context, categories []entities.Category, restore *entities.BackupRestore) (map[string]string, error) {
+	existing, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	key := func(category entities.Category) string {
+		return string(category.Type) + "/" + strings.ToLower(category.Name)
+	}
+	byKey := make(map[string]string, len(existing))
+	for _, category := range existing {
+		byKey[key(category)] = category.ID
+	}
+
+	depth := func(category entities.Category) int {
+		if category.ParentID != "" {
+			return 1
+		}
+		return 0
+	}
+	categories = slices.Clone(categories)
+	slices.SortStableFunc(categories, func(a, b entities.Category) int {
+		return cmp.Compare(depth(a), depth(b))
+	})
+
+	ids := make(map[string]string, len(categories))
+	for _, category := range categories {
+		if id, ok := byKey[key(category)]; ok {
+			ids[category.ID] = id
+			continue
+		}
+
+		oldID := category.ID
+		category.ID = ""
+		category.ParentID = ids[category.ParentID]
+		created, err := uc.categoryRepo.CreateCategory(ctx, category)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore category %s: %w", category.Name, err)
+		}
+		ids[oldID] = created.ID
+		byKey[key(category)] = created.ID
+		restore.Categories++
+	}
+	return ids, nil
+}
+
+func (uc *BackupUseCase) deleteAccounts(ctx context.Context, ids []string) {
+	for _, id := range ids {
+		_ = uc.accountRepo.DeleteAccount(ctx, id)
+	}
+}
+
+// remapIDs replaces the IDs of a comma separated list, dropping the unknown ones
+func remapIDs(list string, ids map[string]string) string {
+	var remapped []string
+	for _, id := range strings.Split(list, ",") {
+		if newID, ok := ids[strings.TrimSpace(id)]; ok {
+			remapped = append(remapped, newID)
+		}
+	}
+	return strings.Join(remapped, ",")
+}
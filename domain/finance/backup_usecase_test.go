@@ -0,0 +1,193 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpiredBackups(t *testing.T) {
+	// A backup every day at 03:30 for 60 days, plus a manual one on the last day,
+	// newest first like ListBackups returns them
+	last := time.Date(2025, time.April, 30, 3, 30, 0, 0, time.UTC)
+	files := []entities.BackupFile{{Name: "manual", CreatedAt: last.Add(6 * time.Hour)}}
+	for i := 0; i < 60; i++ {
+		created := last.AddDate(0, 0, -i)
+		files = append(files, entities.BackupFile{Name: created.Format(time.DateOnly), CreatedAt: created})
+	}
+
+	expired := expiredBackups(files, entities.BackupRetention{Daily: 7, Weekly: 4})
+
+	expiredNames := map[string]bool{}
+	for _, file := range expired {
+		expiredNames[file.Name] = true
+	}
+	var kept []string
+	for _, file := range files {
+		if !expiredNames[file.Name] {
+			kept = append(kept, file.Name)
+		}
+	}
+
+	// The newest of the last 7 days, then the newest of the 3 weeks before the
+	// current one (April 30th is a Wednesday, April 27th the Sunday before)
+	assert.Equal(t, []string{
+		"manual",
+		"2025-04-29", "2025-04-28", "2025-04-27", "2025-04-26", "2025-04-25", "2025-04-24",
+		"2025-04-20", "2025-04-13",
+	}, kept)
+	assert.True(t, expiredNames["2025-04-30"], "the older backup of the last day should expire")
+}
+
+func TestPruneBackups(t *testing.T) {
+	now := time.Date(2025, time.April, 30, 3, 30, 0, 0, time.UTC)
+	var deleted []string
+	store := &mocks.BackupStoreMock{
+		ListBackupsFunc: func(ctx context.Context) ([]entities.BackupFile, error) {
+			// Out of order on purpose, the use case sorts them
+			return []entities.BackupFile{
+				{Name: "old", CreatedAt: now.AddDate(0, 0, -2)},
+				{Name: "new", CreatedAt: now},
+				{Name: "mid", CreatedAt: now.AddDate(0, 0, -1)},
+			}, nil
+		},
+		DeleteBackupFunc: func(ctx context.Context, name string) error {
+			deleted = append(deleted, name)
+			return nil
+		},
+	}
+	uc := NewBackupUseCase(nil, nil, nil, nil, nil, nil, store)
+
+	pruned, err := uc.PruneBackups(context.Background(), entities.BackupRetention{Daily: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old"}, deleted)
+	require.Len(t, pruned, 1)
+
+	_, err = uc.PruneBackups(context.Background(), entities.BackupRetention{})
+	assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+}
+
+func TestRestoreBackup(t *testing.T) {
+	backup := entities.Backup{
+		Settings:     entities.Settings{Currency: monetary.USD, Locale: "en-US", FiscalMonthStartDay: 1},
+		UserSettings: []entities.UserSetting{{Key: entities.UserSettingDemoAccounts, Value: "old-acc-1,old-acc-2"}},
+		Categories: []entities.Category{
+			{ID: "old-cat-vet", Name: "Vet", Type: entities.CategoryTypeExpense, ParentID: "old-cat-pets"},
+			{ID: "old-cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense},
+			{ID: "old-cat-pets", Name: "Pets", Type: entities.CategoryTypeExpense},
+		},
+		Accounts: []entities.Account{
+			{ID: "old-acc-1", Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.USD},
+		},
+		Transactions: []entities.Transaction{
+			{ID: "old-tx-1", AccountID: "old-acc-1", CategoryID: "old-cat-groceries", Monetary: monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(-1250)}, Status: entities.TransactionStatusCleared},
+			{ID: "old-tx-2", AccountID: "old-acc-1", CategoryID: "old-cat-pets", Monetary: monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(-800)}, Status: entities.TransactionStatusPending},
+		},
+	}
+
+	newUseCase := func(accounts []entities.Account, transactionErr error) (*BackupUseCase, *[]entities.Transaction, *[]string, *[]entities.UserSetting) {
+		var (
+			transactions []entities.Transaction
+			deleted      []string
+			settings     []entities.UserSetting
+		)
+		uc := NewBackupUseCase(
+			&mocks.AccountRepositoryMock{
+				GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+					return accounts, nil
+				},
+				CreateAccountFunc: func(ctx context.Context, account entities.Account) (entities.Account, error) {
+					assert.Empty(t, account.ID)
+					account.ID = "new-acc-1"
+					return account, nil
+				},
+				DeleteAccountFunc: func(ctx context.Context, id string) error {
+					deleted = append(deleted, id)
+					return nil
+				},
+			},
+			&mocks.CategoryRepositoryMock{
+				GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+					return []entities.Category{{ID: "cat-groceries", Name: "groceries", Type: entities.CategoryTypeExpense}}, nil
+				},
+				CreateCategoryFunc: func(ctx context.Context, category entities.Category) (entities.Category, error) {
+					if category.Name == "Vet" {
+						assert.Equal(t, "new-cat-Pets", category.ParentID, "groups are restored before their categories")
+					}
+					category.ID = "new-cat-" + category.Name
+					return category, nil
+				},
+			},
+			&mocks.TransactionRepositoryMock{
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					if transactionErr != nil {
+						return entities.Transaction{}, transactionErr
+					}
+					transactions = append(transactions, transaction)
+					return transaction, nil
+				},
+			},
+			&mocks.BalanceRepositoryMock{},
+			&mocks.SettingsRepositoryMock{
+				UpdateSettingsFunc: func(ctx context.Context, settings entities.Settings) (entities.Settings, error) {
+					return settings, nil
+				},
+			},
+			&mocks.UserSettingsRepositoryMock{
+				UpsertUserSettingFunc: func(ctx context.Context, setting entities.UserSetting) (entities.UserSetting, error) {
+					settings = append(settings, setting)
+					return setting, nil
+				},
+			},
+			&mocks.BackupStoreMock{
+				LoadBackupFunc: func(ctx context.Context, name string) (entities.Backup, error) {
+					return backup, nil
+				},
+			},
+		)
+		return uc, &transactions, &deleted, &settings
+	}
+
+	t.Run("restores with new ids", func(t *testing.T) {
+		uc, transactions, _, settings := newUseCase(nil, nil)
+
+		restore, err := uc.RestoreBackup(context.Background(), "finance-20250401T033000Z.json")
+		require.NoError(t, err)
+		assert.Equal(t, entities.BackupRestore{Categories: 2, Accounts: 1, Transactions: 2}, restore)
+
+		require.Len(t, *transactions, 2)
+		assert.Empty(t, (*transactions)[0].ID)
+		assert.Equal(t, "new-acc-1", (*transactions)[0].AccountID)
+		assert.Equal(t, "cat-groceries", (*transactions)[0].CategoryID, "existing categories are matched by name and type")
+		assert.Equal(t, "new-cat-Pets", (*transactions)[1].CategoryID)
+		assert.Equal(t, entities.TransactionStatusPending, (*transactions)[1].Status)
+
+		require.Len(t, *settings, 1)
+		assert.Equal(t, "new-acc-1", (*settings)[0].Value, "demo account ids are remapped")
+	})
+
+	t.Run("refuses a database with accounts", func(t *testing.T) {
+		uc, _, _, _ := newUseCase([]entities.Account{{ID: "acc"}}, nil)
+
+		_, err := uc.RestoreBackup(context.Background(), "finance-20250401T033000Z.json")
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("removes the accounts when failing halfway", func(t *testing.T) {
+		uc, _, deleted, _ := newUseCase(nil, errors.New("connection reset"))
+
+		_, err := uc.RestoreBackup(context.Background(), "finance-20250401T033000Z.json")
+		assert.Error(t, err)
+		assert.Equal(t, []string{"new-acc-1"}, *deleted)
+	})
+}
@@ -3,12 +3,17 @@ package finance
 import (
 	"context"
 	"finance/domain/entities"
+	"time"
 )
 
 //go:generate moq -skip-ensure -stub -pkg mocks -out mocks/balance_repository.go . BalanceRepository
 type BalanceRepository interface {
 	GetBalanceByAccountID(ctx context.Context, accountID string) (entities.Balance, error)
 	GetAllBalances(ctx context.Context) ([]entities.Balance, error)
+	GetBalancesPage(ctx context.Context, limit, offset int) ([]entities.Balance, error)
+	CountBalances(ctx context.Context) (int64, error)
 	RefreshAccountBalance(ctx context.Context, accountID string) error
 	GetBalanceSummary(ctx context.Context) (entities.BalanceSummary, error)
+	GetBalanceSnapshotsAt(ctx context.Context, date time.Time) ([]entities.BalanceSnapshot, error)
+	GetBalanceSnapshotsBetween(ctx context.Context, accountID string, from, to time.Time) ([]entities.BalanceSnapshot, error)
 }
@@ -2,19 +2,37 @@ package finance
 
 import (
 	"context"
+	"errors"
+	"finance/domain"
 	"finance/domain/entities"
 	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
 )
 
 type BalanceUseCase struct {
 	balanceRepo BalanceRepository
 	accountRepo AccountRepository
+	now         func() time.Time
+
+	// refreshes holds the status of the running or last refresh of all
+	// balances of each book, by book ID
+	refreshMu sync.Mutex
+	refreshes map[string]*entities.BalanceRefreshStatus
 }
 
 func NewBalanceUseCase(balanceRepo BalanceRepository, accountRepo AccountRepository) *BalanceUseCase {
 	return &BalanceUseCase{
 		balanceRepo: balanceRepo,
 		accountRepo: accountRepo,
+		now:         time.Now,
+		refreshes:   map[string]*entities.BalanceRefreshStatus{},
 	}
 }
 
@@ -24,35 +42,30 @@ func (uc *BalanceUseCase) GetBalanceByAccountID(ctx context.Context, accountID s
 	}
 
 	// Verify account exists
-	account, err := uc.accountRepo.GetAccountByID(ctx, accountID)
+	account, err := ownAccount(ctx, uc.accountRepo, accountID)
 	if err != nil {
 		return entities.Balance{}, fmt.Errorf("failed to get account: %w", err)
 	}
-	if account.ID == "" {
-		return entities.Balance{}, fmt.Errorf("account not found")
-	}
 
 	balance, err := uc.balanceRepo.GetBalanceByAccountID(ctx, accountID)
-	if err != nil {
-		return entities.Balance{}, fmt.Errorf("failed to get balance: %w", err)
-	}
 
 	// If no balance record exists, create one
-	if balance.AccountID == "" {
-		err = uc.balanceRepo.RefreshAccountBalance(ctx, accountID)
-		if err != nil {
+	if errors.Is(err, domain.ErrNotFound) {
+		if err := uc.balanceRepo.RefreshAccountBalance(ctx, accountID); err != nil {
 			return entities.Balance{}, fmt.Errorf("failed to refresh account balance: %w", err)
 		}
 
 		balance, err = uc.balanceRepo.GetBalanceByAccountID(ctx, accountID)
-		if err != nil {
-			return entities.Balance{}, fmt.Errorf("failed to get balance after refresh: %w", err)
-		}
+	}
+	if err != nil {
+		return entities.Balance{}, fmt.Errorf("failed to get balance: %w", err)
 	}
 
 	// Add account information to the balance
 	balance.Account = &account
 
+	attachBalanceDeltas(ctx, uc.balanceRepo, []*entities.Balance{&balance})
+
 	return balance, nil
 }
 
@@ -62,9 +75,31 @@ func (uc *BalanceUseCase) GetAllBalances(ctx context.Context) ([]entities.Balanc
 		return nil, fmt.Errorf("failed to get balances: %w", err)
 	}
 
-	// Enrich balances with account information
+	uc.enrichBalances(ctx, balances)
+	return balances, nil
+}
+
+// GetBalancesPage returns limit balances after the first offset ones with the
+// number of balances in the book
+func (uc *BalanceUseCase) GetBalancesPage(ctx context.Context, limit, offset int) (entities.Page[entities.Balance], error) {
+	balances, err := uc.balanceRepo.GetBalancesPage(ctx, limit, offset)
+	if err != nil {
+		return entities.Page[entities.Balance]{}, fmt.Errorf("failed to get balances: %w", err)
+	}
+
+	total, err := uc.balanceRepo.CountBalances(ctx)
+	if err != nil {
+		return entities.Page[entities.Balance]{}, fmt.Errorf("failed to count balances: %w", err)
+	}
+
+	uc.enrichBalances(ctx, balances)
+	return entities.Page[entities.Balance]{Items: balances, Total: total, Limit: limit, Offset: offset}, nil
+}
+
+// enrichBalances embeds the account and the deltas of each balance
+func (uc *BalanceUseCase) enrichBalances(ctx context.Context, balances []entities.Balance) {
 	for i := range balances {
-		account, err := uc.accountRepo.GetAccountByID(ctx, balances[i].AccountID)
+		account, err := ownAccount(ctx, uc.accountRepo, balances[i].AccountID)
 		if err != nil {
 			// Skip accounts that can't be found
 			continue
@@ -72,7 +107,11 @@ func (uc *BalanceUseCase) GetAllBalances(ctx context.Context) ([]entities.Balanc
 		balances[i].Account = &account
 	}
 
-	return balances, nil
+	refs := make([]*entities.Balance, len(balances))
+	for i := range balances {
+		refs[i] = &balances[i]
+	}
+	attachBalanceDeltas(ctx, uc.balanceRepo, refs)
 }
 
 func (uc *BalanceUseCase) RefreshAccountBalance(ctx context.Context, accountID string) error {
@@ -81,15 +120,11 @@ func (uc *BalanceUseCase) RefreshAccountBalance(ctx context.Context, accountID s
 	}
 
 	// Verify account exists
-	account, err := uc.accountRepo.GetAccountByID(ctx, accountID)
-	if err != nil {
+	if _, err := ownAccount(ctx, uc.accountRepo, accountID); err != nil {
 		return fmt.Errorf("failed to get account: %w", err)
 	}
-	if account.ID == "" {
-		return fmt.Errorf("account not found")
-	}
 
-	err = uc.balanceRepo.RefreshAccountBalance(ctx, accountID)
+	err := uc.balanceRepo.RefreshAccountBalance(ctx, accountID)
 	if err != nil {
 		return fmt.Errorf("failed to refresh account balance: %w", err)
 	}
@@ -97,30 +132,508 @@ func (uc *BalanceUseCase) RefreshAccountBalance(ctx context.Context, accountID s
 	return nil
 }
 
+// RefreshAllBalances recalculates the balance of every account, recording the progress
+// in the refresh status. Failures on one account don't stop the others.
 func (uc *BalanceUseCase) RefreshAllBalances(ctx context.Context) error {
-	// Get all accounts
-	accounts, err := uc.accountRepo.GetAllAccounts(ctx)
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to get accounts: %w", err)
 	}
 
-	// Refresh balance for each account
-	for _, account := range accounts {
-		err = uc.balanceRepo.RefreshAccountBalance(ctx, account.ID)
-		if err != nil {
-			// Log the error but continue with other accounts
-			fmt.Printf("Failed to refresh balance for account %s: %v\n", account.ID, err)
-		}
+	refresh, _, err := uc.beginRefresh(ctx, accounts)
+	if err != nil {
+		return err
 	}
 
+	uc.runRefresh(ctx, refresh, accounts)
+
 	return nil
 }
 
+// StartBalanceRefresh starts recalculating the balance of every account of the
+// book in the background and returns the initial refresh status
+func (uc *BalanceUseCase) StartBalanceRefresh(ctx context.Context) (entities.BalanceRefreshStatus, error) {
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return entities.BalanceRefreshStatus{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	refresh, status, err := uc.beginRefresh(ctx, accounts)
+	if err != nil {
+		return entities.BalanceRefreshStatus{}, err
+	}
+
+	// The refresh outlives the request that started it
+	go uc.runRefresh(context.WithoutCancel(ctx), refresh, accounts)
+
+	return status, nil
+}
+
+// GetBalanceRefreshStatus returns the status of the running or last refresh of
+// all balances of the book
+func (uc *BalanceUseCase) GetBalanceRefreshStatus(ctx context.Context) (entities.BalanceRefreshStatus, error) {
+	uc.refreshMu.Lock()
+	defer uc.refreshMu.Unlock()
+
+	refresh, ok := uc.refreshes[domain.BookFromContext(ctx)]
+	if !ok {
+		return entities.BalanceRefreshStatus{}, fmt.Errorf("balance refresh %w", domain.ErrNotFound)
+	}
+
+	return copyRefreshStatus(*refresh), nil
+}
+
+// beginRefresh records a refresh of the accounts of the book as running, one
+// at a time in each book
+func (uc *BalanceUseCase) beginRefresh(ctx context.Context, accounts []entities.Account) (*entities.BalanceRefreshStatus, entities.BalanceRefreshStatus, error) {
+	uc.refreshMu.Lock()
+	defer uc.refreshMu.Unlock()
+
+	bookID := domain.BookFromContext(ctx)
+	if refresh, ok := uc.refreshes[bookID]; ok && refresh.State == entities.BalanceRefreshStateRunning {
+		return nil, entities.BalanceRefreshStatus{}, fmt.Errorf("balance refresh already running: %w", domain.ErrConflict)
+	}
+
+	status := entities.BalanceRefreshStatus{
+		State:     entities.BalanceRefreshStateRunning,
+		StartedAt: time.Now(),
+		Accounts:  make([]entities.AccountRefreshStatus, len(accounts)),
+	}
+	for i, account := range accounts {
+		status.Accounts[i] = entities.AccountRefreshStatus{
+			AccountID: account.ID,
+			State:     entities.AccountRefreshStatePending,
+		}
+	}
+	uc.refreshes[bookID] = &status
+
+	return &status, copyRefreshStatus(status), nil
+}
+
+func (uc *BalanceUseCase) runRefresh(ctx context.Context, refresh *entities.BalanceRefreshStatus, accounts []entities.Account) {
+	slog.Info("balance refresh started", "accounts", len(accounts))
+
+	for i, account := range accounts {
+		result := uc.refreshAndVerify(ctx, account.ID)
+
+		uc.refreshMu.Lock()
+		refresh.Accounts[i] = result
+		uc.refreshMu.Unlock()
+	}
+
+	uc.refreshMu.Lock()
+	refresh.State = entities.BalanceRefreshStateCompleted
+	refresh.FinishedAt = time.Now()
+	status := *refresh
+	uc.refreshMu.Unlock()
+
+	slog.Info("balance refresh completed",
+		"accounts", len(accounts),
+		"failed", status.Count(entities.AccountRefreshStateFailed),
+		"changed", status.Changed(),
+		"duration", status.FinishedAt.Sub(status.StartedAt),
+	)
+}
+
+// refreshAndVerify recalculates the balance of an account and compares it with the
+// stored one. Balances are kept in sync by the transactions trigger, so a change
+// means they had drifted.
+func (uc *BalanceUseCase) refreshAndVerify(ctx context.Context, accountID string) entities.AccountRefreshStatus {
+	result := entities.AccountRefreshStatus{AccountID: accountID}
+
+	previous, err := uc.balanceRepo.GetBalanceByAccountID(ctx, accountID)
+	if err == nil {
+		result.PreviousBalance = &previous.CurrentBalance
+	} else if !errors.Is(err, domain.ErrNotFound) {
+		slog.Warn("failed to get balance before refresh", "account_id", accountID, "error", err)
+	}
+
+	if err := uc.balanceRepo.RefreshAccountBalance(ctx, accountID); err != nil {
+		slog.Error("failed to refresh account balance", "account_id", accountID, "error", err)
+		result.State = entities.AccountRefreshStateFailed
+		result.Error = err.Error()
+		return result
+	}
+	result.State = entities.AccountRefreshStateRefreshed
+
+	current, err := uc.balanceRepo.GetBalanceByAccountID(ctx, accountID)
+	if err != nil {
+		slog.Warn("failed to get balance after refresh", "account_id", accountID, "error", err)
+		return result
+	}
+	result.CurrentBalance = &current.CurrentBalance
+
+	if result.PreviousBalance != nil {
+		cmp, err := entities.MoneyOf(*result.PreviousBalance).Cmp(entities.MoneyOf(current.CurrentBalance))
+		result.Changed = err != nil || cmp != 0
+	}
+	if result.Changed {
+		slog.Warn("balance changed on refresh",
+			"account_id", accountID,
+			"previous", result.PreviousBalance.String(),
+			"current", result.CurrentBalance.String(),
+		)
+	}
+
+	return result
+}
+
+func copyRefreshStatus(status entities.BalanceRefreshStatus) entities.BalanceRefreshStatus {
+	status.Accounts = append([]entities.AccountRefreshStatus(nil), status.Accounts...)
+	return status
+}
+
+// GetBalanceSummary returns the totals and net worth of the monetary accounts,
+// with the net balance of the accounts in custom assets per asset next to them
 func (uc *BalanceUseCase) GetBalanceSummary(ctx context.Context) (entities.BalanceSummary, error) {
 	summary, err := uc.balanceRepo.GetBalanceSummary(ctx)
 	if err != nil {
 		return entities.BalanceSummary{}, fmt.Errorf("failed to get balance summary: %w", err)
 	}
 
+	accounts, err := uc.accountRepo.GetAccountsWithBalances(ctx, nil)
+	if err != nil {
+		return entities.BalanceSummary{}, fmt.Errorf("failed to get accounts with balances: %w", err)
+	}
+
+	custom := map[string]entities.Money{}
+	for _, account := range accounts {
+		if account.IsMonetary() || account.Balance == nil {
+			continue
+		}
+		balance := entities.MoneyOf(account.Balance.CurrentBalance)
+		if account.IsLiability() {
+			balance = balance.Neg()
+		}
+		total, ok := custom[balance.Asset.Asset]
+		if !ok {
+			total = entities.NewMoney(balance.Asset, 0)
+		}
+		if custom[balance.Asset.Asset], err = total.Add(balance); err != nil {
+			return entities.BalanceSummary{}, err
+		}
+	}
+
+	summary.CustomAssets = make([]monetary.Monetary, 0, len(custom))
+	for _, code := range slices.Sorted(maps.Keys(custom)) {
+		summary.CustomAssets = append(summary.CustomAssets, custom[code].Monetary())
+	}
+
 	return summary, nil
 }
+
+// maxBalanceHistoryPoints caps how many points a balance history has, about
+// three years of days
+const maxBalanceHistoryPoints = 1100
+
+// GetBalanceHistory returns the current balance of an account at the end of
+// each day or month between from and to, from the daily balance snapshots. to
+// defaults to today, from to 30 days or 12 months before it.
+func (uc *BalanceUseCase) GetBalanceHistory(ctx context.Context, accountID string, granularity entities.BalanceGranularity, from, to time.Time) (entities.BalanceHistory, error) {
+	if accountID == "" {
+		return entities.BalanceHistory{}, fmt.Errorf("account ID cannot be empty")
+	}
+	if _, err := ownAccount(ctx, uc.accountRepo, accountID); err != nil {
+		return entities.BalanceHistory{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	granularity, from, to, err := uc.historyRange(granularity, from, to)
+	if err != nil {
+		return entities.BalanceHistory{}, err
+	}
+	dates, err := historyDates(granularity, from, to)
+	if err != nil {
+		return entities.BalanceHistory{}, err
+	}
+
+	snapshots, err := uc.balanceRepo.GetBalanceSnapshotsBetween(ctx, accountID, dates[0], to)
+	if err != nil {
+		return entities.BalanceHistory{}, fmt.Errorf("failed to get balance snapshots: %w", err)
+	}
+
+	history := entities.BalanceHistory{
+		AccountID:   accountID,
+		Granularity: granularity,
+		From:        from,
+		To:          to,
+		Points:      []entities.BalancePoint{},
+	}
+	for i, balance := range balancesAt(snapshots, dates) {
+		if balance != nil {
+			history.Points = append(history.Points, entities.BalancePoint{Date: dates[i], Amount: *balance})
+		}
+	}
+
+	return history, nil
+}
+
+// GetNetWorthHistory returns the net worth of the book at the end of each day
+// or month between from and to, one series per currency, from the daily
+// balance snapshots. The dates default like in GetBalanceHistory.
+func (uc *BalanceUseCase) GetNetWorthHistory(ctx context.Context, granularity entities.BalanceGranularity, from, to time.Time) (entities.NetWorthHistory, error) {
+	granularity, from, to, err := uc.historyRange(granularity, from, to)
+	if err != nil {
+		return entities.NetWorthHistory{}, err
+	}
+	dates, err := historyDates(granularity, from, to)
+	if err != nil {
+		return entities.NetWorthHistory{}, err
+	}
+
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return entities.NetWorthHistory{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	snapshots, err := uc.balanceRepo.GetBalanceSnapshotsBetween(ctx, "", dates[0], to)
+	if err != nil {
+		return entities.NetWorthHistory{}, fmt.Errorf("failed to get balance snapshots: %w", err)
+	}
+	byAccount := map[string][]entities.BalanceSnapshot{}
+	for _, snapshot := range snapshots {
+		byAccount[snapshot.AccountID] = append(byAccount[snapshot.AccountID], snapshot)
+	}
+
+	// The net worth per asset at each date, nil until an account in the asset
+	// has a balance
+	totals := map[string][]*entities.Money{}
+	assets := map[string]monetary.Asset{}
+	for _, account := range accounts {
+		if !account.IsMonetary() || len(byAccount[account.ID]) == 0 {
+			continue
+		}
+		for i, balance := range balancesAt(byAccount[account.ID], dates) {
+			if balance == nil {
+				continue
+			}
+			amount := entities.MoneyOf(*balance)
+			if account.IsLiability() {
+				amount = amount.Neg()
+			}
+
+			code := amount.Asset.Asset
+			if totals[code] == nil {
+				totals[code] = make([]*entities.Money, len(dates))
+				assets[code] = amount.Asset
+			}
+			if total := totals[code][i]; total != nil {
+				if amount, err = total.Add(amount); err != nil {
+					return entities.NetWorthHistory{}, err
+				}
+			}
+			totals[code][i] = &amount
+		}
+	}
+
+	history := entities.NetWorthHistory{
+		Granularity: granularity,
+		From:        from,
+		To:          to,
+		Series:      make([]entities.NetWorthSeries, 0, len(totals)),
+	}
+	for _, code := range slices.Sorted(maps.Keys(totals)) {
+		series := entities.NetWorthSeries{Asset: assets[code], Points: []entities.BalancePoint{}}
+		for i, total := range totals[code] {
+			if total != nil {
+				series.Points = append(series.Points, entities.BalancePoint{Date: dates[i], Amount: total.Monetary()})
+			}
+		}
+		history.Series = append(history.Series, series)
+	}
+
+	return history, nil
+}
+
+// historyRange checks the granularity of a history, the day by default, and
+// defaults its dates: to to today, from to 30 days or 12 months before it
+func (uc *BalanceUseCase) historyRange(granularity entities.BalanceGranularity, from, to time.Time) (entities.BalanceGranularity, time.Time, time.Time, error) {
+	if granularity == "" {
+		granularity = entities.BalanceGranularityDay
+	}
+	if !slices.Contains(entities.BalanceGranularities, granularity) {
+		return "", time.Time{}, time.Time{}, fmt.Errorf("invalid balance granularity: %s: %w", granularity, domain.ErrMalformedParameters)
+	}
+
+	if to.IsZero() {
+		to = uc.now()
+	}
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	if from.IsZero() {
+		if granularity == entities.BalanceGranularityMonth {
+			from = firstOfMonth(to).AddDate(0, -11, 0)
+		} else {
+			from = to.AddDate(0, 0, -29)
+		}
+	}
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	if to.Before(from) {
+		return "", time.Time{}, time.Time{}, fmt.Errorf("balance history ends before it starts: %w", domain.ErrMalformedParameters)
+	}
+
+	return granularity, from, to, nil
+}
+
+// historyDates lists the dates a history has points for: every day from from
+// to to, or the end of every month with the last one ending on to
+func historyDates(granularity entities.BalanceGranularity, from, to time.Time) ([]time.Time, error) {
+	var dates []time.Time
+	for date := from; !date.After(to) && len(dates) <= maxBalanceHistoryPoints; date = date.AddDate(0, 0, 1) {
+		if granularity == entities.BalanceGranularityMonth {
+			date = firstOfMonth(date).AddDate(0, 1, -1)
+			if date.After(to) {
+				date = to
+			}
+		}
+		dates = append(dates, date)
+	}
+	if len(dates) > maxBalanceHistoryPoints {
+		return nil, fmt.Errorf("balance history can't have more than %d points: %w", maxBalanceHistoryPoints, domain.ErrMalformedParameters)
+	}
+
+	return dates, nil
+}
+
+// balancesAt returns the balance of an account at the end of each of dates,
+// in order, from its snapshots ordered by date: the one of the last snapshot
+// on or before the date, nil when there's none
+func balancesAt(snapshots []entities.BalanceSnapshot, dates []time.Time) []*monetary.Monetary {
+	balances := make([]*monetary.Monetary, len(dates))
+	next := 0
+	var last *monetary.Monetary
+	for i, date := range dates {
+		for next < len(snapshots) && !snapshots[next].Date.After(date) {
+			last = &snapshots[next].CurrentBalance
+			next++
+		}
+		balances[i] = last
+	}
+	return balances
+}
+
+// attachBalanceDeltas sets how much each balance changed over the delta periods, based
+// on the daily balance snapshots. Deltas are best effort: periods without a snapshot
+// are left out and failures don't fail the balance lookup.
+func attachBalanceDeltas(ctx context.Context, balanceRepo BalanceRepository, balances []*entities.Balance) {
+	today := time.Now()
+
+	for _, days := range entities.BalanceDeltaPeriods {
+		snapshots, err := balanceRepo.GetBalanceSnapshotsAt(ctx, today.AddDate(0, 0, -days))
+		if err != nil {
+			slog.Error("failed to get balance snapshots", "days", days, "error", err)
+			continue
+		}
+
+		previous := make(map[string]entities.Money, len(snapshots))
+		for _, snapshot := range snapshots {
+			previous[snapshot.AccountID] = entities.MoneyOf(snapshot.CurrentBalance)
+		}
+
+		for _, balance := range balances {
+			snapshot, ok := previous[balance.AccountID]
+			if !ok {
+				continue
+			}
+
+			// Snapshots taken before an asset change can't be compared
+			delta, err := entities.MoneyOf(balance.CurrentBalance).Sub(snapshot)
+			if err != nil {
+				continue
+			}
+
+			balance.Deltas = append(balance.Deltas, entities.BalanceDelta{
+				Days:   days,
+				Amount: delta.Monetary(),
+			})
+		}
+	}
+}
+
+// GetGroupedBalances groups the accounts and their balances by the given fields,
+// institution then type when none are given. Groups are ordered by institution,
+// accounts without one last, then by type in the order of entities.AccountTypes.
+func (uc *BalanceUseCase) GetGroupedBalances(ctx context.Context, by []entities.BalanceGroupField) ([]entities.BalanceGroup, error) {
+	if len(by) == 0 {
+		by = entities.BalanceGroupFields
+	}
+	grouped := map[entities.BalanceGroupField]bool{}
+	for _, field := range by {
+		if !slices.Contains(entities.BalanceGroupFields, field) {
+			return nil, fmt.Errorf("invalid balance group field: %s: %w", field, domain.ErrMalformedParameters)
+		}
+		grouped[field] = true
+	}
+
+	accounts, err := uc.accountRepo.GetAccountsWithBalances(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts with balances: %w", err)
+	}
+
+	var groups []entities.BalanceGroup
+	subtotals := map[int]map[string]entities.Money{}
+	for _, account := range accounts {
+		key := entities.BalanceGroup{}
+		if grouped[entities.BalanceGroupFieldInstitution] {
+			key.Institution = account.Institution
+		}
+		if grouped[entities.BalanceGroupFieldType] {
+			key.Type = account.Type
+		}
+
+		i := slices.IndexFunc(groups, func(group entities.BalanceGroup) bool {
+			return group.Institution == key.Institution && group.Type == key.Type
+		})
+		if i < 0 {
+			groups = append(groups, key)
+			i = len(groups) - 1
+			subtotals[i] = map[string]entities.Money{}
+		}
+		groups[i].Accounts = append(groups[i].Accounts, account)
+
+		if account.Balance == nil {
+			continue
+		}
+		balance := entities.MoneyOf(account.Balance.CurrentBalance)
+		if account.IsLiability() {
+			balance = balance.Neg()
+		}
+		subtotal, ok := subtotals[i][balance.Asset.Asset]
+		if !ok {
+			subtotal = entities.NewMoney(balance.Asset, 0)
+		}
+		if subtotals[i][balance.Asset.Asset], err = subtotal.Add(balance); err != nil {
+			return nil, err
+		}
+	}
+
+	refs := make([]*entities.Balance, 0, len(accounts))
+	for i := range groups {
+		for j := range groups[i].Accounts {
+			if groups[i].Accounts[j].Balance != nil {
+				refs = append(refs, groups[i].Accounts[j].Balance)
+			}
+		}
+
+		assets := slices.Sorted(maps.Keys(subtotals[i]))
+		groups[i].Subtotals = make([]monetary.Monetary, 0, len(assets))
+		for _, asset := range assets {
+			groups[i].Subtotals = append(groups[i].Subtotals, subtotals[i][asset].Monetary())
+		}
+	}
+
+	attachBalanceDeltas(ctx, uc.balanceRepo, refs)
+
+	slices.SortStableFunc(groups, func(a, b entities.BalanceGroup) int {
+		if a.Institution != b.Institution {
+			switch {
+			case a.Institution == "":
+				return 1
+			case b.Institution == "":
+				return -1
+			}
+			return strings.Compare(strings.ToLower(a.Institution), strings.ToLower(b.Institution))
+		}
+		return slices.Index(entities.AccountTypes, a.Type) - slices.Index(entities.AccountTypes, b.Type)
+	})
+
+	return groups, nil
+}
@@ -0,0 +1,603 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBalanceByAccountID(t *testing.T) {
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+			if id == "acc-1" {
+				return entities.Account{ID: id, Name: "Checking", Asset: monetary.BRL}, nil
+			}
+			return entities.Account{}, errNotFound("account")
+		},
+	}
+
+	t.Run("existing balance", func(t *testing.T) {
+		balanceRepo := &mocks.BalanceRepositoryMock{
+			GetBalanceByAccountIDFunc: func(ctx context.Context, accountID string) (entities.Balance, error) {
+				return entities.Balance{AccountID: accountID, CurrentBalance: testMonetary(t, monetary.BRL, 1000)}, nil
+			},
+		}
+
+		uc := NewBalanceUseCase(balanceRepo, accountRepo)
+		got, err := uc.GetBalanceByAccountID(context.Background(), "acc-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, "acc-1", got.AccountID)
+		require.NotNil(t, got.Account)
+		assert.Equal(t, "Checking", got.Account.Name)
+		assert.Empty(t, balanceRepo.RefreshAccountBalanceCalls())
+	})
+
+	t.Run("missing balance is refreshed", func(t *testing.T) {
+		balanceRepo := &mocks.BalanceRepositoryMock{}
+		balanceRepo.GetBalanceByAccountIDFunc = func(ctx context.Context, accountID string) (entities.Balance, error) {
+			if len(balanceRepo.RefreshAccountBalanceCalls()) == 0 {
+				return entities.Balance{}, errNotFound("balance")
+			}
+			return entities.Balance{AccountID: accountID, CurrentBalance: testMonetary(t, monetary.BRL, 0)}, nil
+		}
+
+		uc := NewBalanceUseCase(balanceRepo, accountRepo)
+		got, err := uc.GetBalanceByAccountID(context.Background(), "acc-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, "acc-1", got.AccountID)
+		require.Len(t, balanceRepo.RefreshAccountBalanceCalls(), 1)
+		assert.Len(t, balanceRepo.GetBalanceByAccountIDCalls(), 2)
+	})
+
+	t.Run("refresh failure", func(t *testing.T) {
+		balanceRepo := &mocks.BalanceRepositoryMock{
+			GetBalanceByAccountIDFunc: func(ctx context.Context, accountID string) (entities.Balance, error) {
+				return entities.Balance{}, errNotFound("balance")
+			},
+			RefreshAccountBalanceFunc: func(ctx context.Context, accountID string) error {
+				return errors.New("connection reset")
+			},
+		}
+
+		uc := NewBalanceUseCase(balanceRepo, accountRepo)
+		_, err := uc.GetBalanceByAccountID(context.Background(), "acc-1")
+		assert.EqualError(t, err, "failed to refresh account balance: connection reset")
+	})
+
+	t.Run("deltas from snapshots", func(t *testing.T) {
+		balanceRepo := &mocks.BalanceRepositoryMock{
+			GetBalanceByAccountIDFunc: func(ctx context.Context, accountID string) (entities.Balance, error) {
+				return entities.Balance{AccountID: accountID, CurrentBalance: testMonetary(t, monetary.BRL, 1000)}, nil
+			},
+			GetBalanceSnapshotsAtFunc: func(ctx context.Context, date time.Time) ([]entities.BalanceSnapshot, error) {
+				return []entities.BalanceSnapshot{
+					{AccountID: "acc-1", CurrentBalance: testMonetary(t, monetary.BRL, 400)},
+				}, nil
+			},
+		}
+
+		uc := NewBalanceUseCase(balanceRepo, accountRepo)
+		got, err := uc.GetBalanceByAccountID(context.Background(), "acc-1")
+		require.NoError(t, err)
+
+		require.Len(t, got.Deltas, len(entities.BalanceDeltaPeriods))
+		for i, delta := range got.Deltas {
+			assert.Equal(t, entities.BalanceDeltaPeriods[i], delta.Days)
+			assert.Equal(t, int64(600), delta.Amount.Amount.Int64())
+		}
+	})
+
+	t.Run("snapshot in another asset is skipped", func(t *testing.T) {
+		balanceRepo := &mocks.BalanceRepositoryMock{
+			GetBalanceByAccountIDFunc: func(ctx context.Context, accountID string) (entities.Balance, error) {
+				return entities.Balance{AccountID: accountID, CurrentBalance: testMonetary(t, monetary.BRL, 1000)}, nil
+			},
+			GetBalanceSnapshotsAtFunc: func(ctx context.Context, date time.Time) ([]entities.BalanceSnapshot, error) {
+				return []entities.BalanceSnapshot{
+					{AccountID: "acc-1", CurrentBalance: testMonetary(t, monetary.USD, 400)},
+				}, nil
+			},
+		}
+
+		uc := NewBalanceUseCase(balanceRepo, accountRepo)
+		got, err := uc.GetBalanceByAccountID(context.Background(), "acc-1")
+		require.NoError(t, err)
+		assert.Empty(t, got.Deltas)
+	})
+
+	t.Run("account not found", func(t *testing.T) {
+		balanceRepo := &mocks.BalanceRepositoryMock{}
+
+		uc := NewBalanceUseCase(balanceRepo, accountRepo)
+		_, err := uc.GetBalanceByAccountID(context.Background(), "missing")
+		assert.EqualError(t, err, "failed to get account: account not found")
+		assert.Empty(t, balanceRepo.GetBalanceByAccountIDCalls())
+	})
+
+	t.Run("empty account id", func(t *testing.T) {
+		uc := NewBalanceUseCase(&mocks.BalanceRepositoryMock{}, accountRepo)
+		_, err := uc.GetBalanceByAccountID(context.Background(), "")
+		assert.EqualError(t, err, "account ID cannot be empty")
+	})
+}
+
+func TestRefreshAllBalances(t *testing.T) {
+	tests := []struct {
+		name        string
+		accountsErr error
+		refreshErr  map[string]error
+		wantErr     string
+		want        []string
+	}{
+		{
+			name: "refreshes every account",
+			want: []string{"acc-1", "acc-2", "acc-3"},
+		},
+		{
+			name:       "continues after a failure",
+			refreshErr: map[string]error{"acc-2": errors.New("deadlock detected")},
+			want:       []string{"acc-1", "acc-2", "acc-3"},
+		},
+		{
+			name:        "accounts lookup failure",
+			accountsErr: errors.New("connection reset"),
+			wantErr:     "failed to get accounts: connection reset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountRepo := &mocks.AccountRepositoryMock{
+				GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+					if tt.accountsErr != nil {
+						return nil, tt.accountsErr
+					}
+					return []entities.Account{{ID: "acc-1"}, {ID: "acc-2"}, {ID: "acc-3"}}, nil
+				},
+			}
+			balanceRepo := &mocks.BalanceRepositoryMock{
+				RefreshAccountBalanceFunc: func(ctx context.Context, accountID string) error {
+					return tt.refreshErr[accountID]
+				},
+			}
+
+			uc := NewBalanceUseCase(balanceRepo, accountRepo)
+			err := uc.RefreshAllBalances(context.Background())
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			var refreshed []string
+			for _, call := range balanceRepo.RefreshAccountBalanceCalls() {
+				refreshed = append(refreshed, call.AccountID)
+			}
+			assert.Equal(t, tt.want, refreshed)
+
+			status, err := uc.GetBalanceRefreshStatus(context.Background())
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, domain.ErrNotFound)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, entities.BalanceRefreshStateCompleted, status.State)
+			assert.False(t, status.FinishedAt.IsZero())
+			require.Len(t, status.Accounts, len(tt.want))
+			for _, account := range status.Accounts {
+				if refreshErr := tt.refreshErr[account.AccountID]; refreshErr != nil {
+					assert.Equal(t, entities.AccountRefreshStateFailed, account.State)
+					assert.Equal(t, refreshErr.Error(), account.Error)
+				} else {
+					assert.Equal(t, entities.AccountRefreshStateRefreshed, account.State)
+				}
+			}
+		})
+	}
+}
+
+func TestRefreshAllBalancesVerification(t *testing.T) {
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+			return []entities.Account{{ID: "acc-1"}, {ID: "acc-2"}, {ID: "acc-3"}}, nil
+		},
+	}
+
+	// acc-1 is in sync, acc-2 had drifted and acc-3 had no balance yet
+	stored := map[string]int64{"acc-1": 1000, "acc-2": 900}
+	actual := map[string]int64{"acc-1": 1000, "acc-2": 1200, "acc-3": 50}
+
+	balanceRepo := &mocks.BalanceRepositoryMock{}
+	balanceRepo.GetBalanceByAccountIDFunc = func(ctx context.Context, accountID string) (entities.Balance, error) {
+		amount, ok := stored[accountID]
+		if !ok {
+			return entities.Balance{}, errNotFound("balance")
+		}
+		return entities.Balance{AccountID: accountID, CurrentBalance: testMonetary(t, monetary.BRL, amount)}, nil
+	}
+	balanceRepo.RefreshAccountBalanceFunc = func(ctx context.Context, accountID string) error {
+		stored[accountID] = actual[accountID]
+		return nil
+	}
+
+	uc := NewBalanceUseCase(balanceRepo, accountRepo)
+	require.NoError(t, uc.RefreshAllBalances(context.Background()))
+
+	status, err := uc.GetBalanceRefreshStatus(context.Background())
+	require.NoError(t, err)
+	require.Len(t, status.Accounts, 3)
+	assert.Equal(t, 1, status.Changed())
+
+	inSync, drifted, created := status.Accounts[0], status.Accounts[1], status.Accounts[2]
+	assert.False(t, inSync.Changed)
+	assert.True(t, drifted.Changed)
+	assert.Equal(t, int64(900), drifted.PreviousBalance.Amount.Int64())
+	assert.Equal(t, int64(1200), drifted.CurrentBalance.Amount.Int64())
+	assert.False(t, created.Changed)
+	assert.Nil(t, created.PreviousBalance)
+	assert.Equal(t, int64(50), created.CurrentBalance.Amount.Int64())
+}
+
+func TestStartBalanceRefresh(t *testing.T) {
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+			return []entities.Account{{ID: "acc-1"}, {ID: "acc-2"}}, nil
+		},
+	}
+
+	release := make(chan struct{})
+	balanceRepo := &mocks.BalanceRepositoryMock{
+		RefreshAccountBalanceFunc: func(ctx context.Context, accountID string) error {
+			<-release
+			return nil
+		},
+	}
+
+	uc := NewBalanceUseCase(balanceRepo, accountRepo)
+
+	_, err := uc.GetBalanceRefreshStatus(context.Background())
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	status, err := uc.StartBalanceRefresh(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, entities.BalanceRefreshStateRunning, status.State)
+	assert.Equal(t, 2, status.Count(entities.AccountRefreshStatePending))
+
+	// The refresh keeps going after the request that started it is done
+	cancel()
+
+	_, err = uc.StartBalanceRefresh(context.Background())
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	// Each book refreshes its balances on its own
+	otherBook := domain.WithBook(context.Background(), "book-2")
+	_, err = uc.GetBalanceRefreshStatus(otherBook)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = uc.StartBalanceRefresh(otherBook)
+	require.NoError(t, err)
+
+	close(release)
+	require.Eventually(t, func() bool {
+		status, err := uc.GetBalanceRefreshStatus(context.Background())
+		return err == nil && status.State == entities.BalanceRefreshStateCompleted
+	}, time.Second, 10*time.Millisecond)
+
+	status, err = uc.GetBalanceRefreshStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, status.Count(entities.AccountRefreshStateRefreshed))
+
+	// A finished refresh can be started again
+	_, err = uc.StartBalanceRefresh(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestRefreshAccountBalance(t *testing.T) {
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+			if id == "acc-1" {
+				return entities.Account{ID: id}, nil
+			}
+			return entities.Account{}, errNotFound("account")
+		},
+	}
+
+	tests := []struct {
+		name       string
+		id         string
+		refreshErr error
+		wantErr    string
+	}{
+		{
+			name: "success",
+			id:   "acc-1",
+		},
+		{
+			name:    "empty id",
+			id:      "",
+			wantErr: "account ID cannot be empty",
+		},
+		{
+			name:    "account not found",
+			id:      "missing",
+			wantErr: "failed to get account: account not found",
+		},
+		{
+			name:       "repository error",
+			id:         "acc-1",
+			refreshErr: errors.New("connection reset"),
+			wantErr:    "failed to refresh account balance: connection reset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balanceRepo := &mocks.BalanceRepositoryMock{
+				RefreshAccountBalanceFunc: func(ctx context.Context, accountID string) error {
+					return tt.refreshErr
+				},
+			}
+
+			uc := NewBalanceUseCase(balanceRepo, accountRepo)
+			err := uc.RefreshAccountBalance(context.Background(), tt.id)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetBalanceSummary(t *testing.T) {
+	miles := monetary.NewAsset("MILES", 0, "mi", entities.AssetClassCustom)
+	points := monetary.NewAsset("POINTS", 2, "pts", entities.AssetClassCustom)
+	withBalance := func(account entities.Account, amount int64) entities.Account {
+		account.Balance = &entities.Balance{AccountID: account.ID, CurrentBalance: entities.NewMoney(account.Asset, amount).Monetary()}
+		return account
+	}
+	balanceRepo := &mocks.BalanceRepositoryMock{
+		GetBalanceSummaryFunc: func(ctx context.Context) (entities.BalanceSummary, error) {
+			return entities.BalanceSummary{NetWorth: testMonetary(t, monetary.BRL, 100000)}, nil
+		},
+	}
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAccountsWithBalancesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+			return []entities.Account{
+				withBalance(entities.Account{ID: "checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL}, 100000),
+				withBalance(entities.Account{ID: "airline", Type: entities.AccountTypeInvestment, Asset: miles}, 40000),
+				withBalance(entities.Account{ID: "hotel", Type: entities.AccountTypeInvestment, Asset: miles}, 2000),
+				withBalance(entities.Account{ID: "store", Type: entities.AccountTypeInvestment, Asset: points}, 1550),
+				{ID: "new", Type: entities.AccountTypeInvestment, Asset: points},
+			}, nil
+		},
+	}
+	uc := NewBalanceUseCase(balanceRepo, accountRepo)
+
+	summary, err := uc.GetBalanceSummary(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(100000), summary.NetWorth.Amount.Int64())
+	require.Len(t, summary.CustomAssets, 2)
+	assert.Equal(t, entities.NewMoney(miles, 42000).String(), entities.MoneyOf(summary.CustomAssets[0]).String())
+	assert.Equal(t, entities.NewMoney(points, 1550).String(), entities.MoneyOf(summary.CustomAssets[1]).String())
+}
+
+func TestGetGroupedBalances(t *testing.T) {
+	withBalance := func(account entities.Account, cents int64) entities.Account {
+		account.Balance = &entities.Balance{AccountID: account.ID, CurrentBalance: entities.NewMoney(account.Asset, cents).Monetary()}
+		return account
+	}
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAccountsWithBalancesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+			return []entities.Account{
+				withBalance(entities.Account{ID: "wallet", Type: entities.AccountTypeCash, Asset: monetary.BRL}, 5000),
+				withBalance(entities.Account{ID: "nu-checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL, Institution: "Nubank"}, 100000),
+				withBalance(entities.Account{ID: "nu-card", Type: entities.AccountTypeCredit, Asset: monetary.BRL, Institution: "Nubank"}, 30000),
+				withBalance(entities.Account{ID: "nu-usd", Type: entities.AccountTypeChecking, Asset: monetary.USD, Institution: "Nubank"}, 2000),
+				withBalance(entities.Account{ID: "itau-savings", Type: entities.AccountTypeSavings, Asset: monetary.BRL, Institution: "Itaú"}, 70000),
+				{ID: "nu-new", Type: entities.AccountTypeChecking, Asset: monetary.BRL, Institution: "Nubank"},
+			}, nil
+		},
+	}
+	uc := NewBalanceUseCase(&mocks.BalanceRepositoryMock{}, accountRepo)
+
+	ids := func(accounts []entities.Account) []string {
+		result := make([]string, len(accounts))
+		for i, account := range accounts {
+			result[i] = account.ID
+		}
+		return result
+	}
+	subtotals := func(values []monetary.Monetary) []string {
+		result := make([]string, len(values))
+		for i, value := range values {
+			result[i] = entities.MoneyOf(value).String()
+		}
+		return result
+	}
+
+	t.Run("by institution and type", func(t *testing.T) {
+		groups, err := uc.GetGroupedBalances(context.Background(), nil)
+		require.NoError(t, err)
+		require.Len(t, groups, 4)
+
+		assert.Equal(t, "Itaú", groups[0].Institution)
+		assert.Equal(t, entities.AccountTypeSavings, groups[0].Type)
+		assert.Equal(t, "Nubank", groups[1].Institution)
+		assert.Equal(t, entities.AccountTypeChecking, groups[1].Type)
+		assert.Equal(t, []string{"nu-checking", "nu-usd", "nu-new"}, ids(groups[1].Accounts))
+		assert.Equal(t, []string{entities.NewMoney(monetary.BRL, 100000).String(), entities.NewMoney(monetary.USD, 2000).String()}, subtotals(groups[1].Subtotals))
+		assert.Equal(t, entities.AccountTypeCredit, groups[2].Type)
+		assert.Equal(t, []string{entities.NewMoney(monetary.BRL, -30000).String()}, subtotals(groups[2].Subtotals))
+		assert.Empty(t, groups[3].Institution)
+		assert.Equal(t, []string{"wallet"}, ids(groups[3].Accounts))
+	})
+
+	t.Run("by institution nets liabilities", func(t *testing.T) {
+		groups, err := uc.GetGroupedBalances(context.Background(), []entities.BalanceGroupField{entities.BalanceGroupFieldInstitution})
+		require.NoError(t, err)
+		require.Len(t, groups, 3)
+
+		assert.Equal(t, "Nubank", groups[1].Institution)
+		assert.Empty(t, groups[1].Type)
+		assert.Len(t, groups[1].Accounts, 4)
+		assert.Equal(t, []string{entities.NewMoney(monetary.BRL, 70000).String(), entities.NewMoney(monetary.USD, 2000).String()}, subtotals(groups[1].Subtotals))
+	})
+
+	t.Run("by type", func(t *testing.T) {
+		groups, err := uc.GetGroupedBalances(context.Background(), []entities.BalanceGroupField{entities.BalanceGroupFieldType})
+		require.NoError(t, err)
+		require.Len(t, groups, 4)
+
+		assert.Equal(t, entities.AccountTypeChecking, groups[0].Type)
+		assert.Equal(t, []string{"nu-checking", "nu-usd", "nu-new"}, ids(groups[0].Accounts))
+		assert.Equal(t, entities.AccountTypeCash, groups[3].Type)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := uc.GetGroupedBalances(context.Background(), []entities.BalanceGroupField{"asset"})
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+}
+
+// testBalanceHistoryUseCase serves a BRL checking account, a BRL credit card,
+// a USD savings account and a miles account with their daily snapshots. Today
+// is 2025-04-15.
+func testBalanceHistoryUseCase(t *testing.T) *BalanceUseCase {
+	t.Helper()
+
+	miles := monetary.NewAsset("MILES", 0, "mi", entities.AssetClassCustom)
+	accounts := []entities.Account{
+		{ID: "acc-checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL},
+		{ID: "acc-card", Type: entities.AccountTypeCredit, Asset: monetary.BRL},
+		{ID: "acc-savings", Type: entities.AccountTypeSavings, Asset: monetary.USD},
+		{ID: "acc-miles", Type: entities.AccountTypeSavings, Asset: miles},
+	}
+	snapshot := func(accountID string, month time.Month, day int, amount monetary.Monetary) entities.BalanceSnapshot {
+		return entities.BalanceSnapshot{AccountID: accountID, Date: time.Date(2025, month, day, 0, 0, 0, 0, time.UTC), CurrentBalance: amount}
+	}
+	snapshots := []entities.BalanceSnapshot{
+		snapshot("acc-card", time.April, 12, testMonetary(t, monetary.BRL, 30000)),
+		snapshot("acc-checking", time.March, 1, testMonetary(t, monetary.BRL, 100000)),
+		snapshot("acc-checking", time.April, 10, testMonetary(t, monetary.BRL, 150000)),
+		snapshot("acc-checking", time.April, 14, testMonetary(t, monetary.BRL, 120000)),
+		snapshot("acc-miles", time.April, 1, testMonetary(t, miles, 1000)),
+		snapshot("acc-savings", time.April, 13, testMonetary(t, monetary.USD, 50000)),
+	}
+
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+			for _, account := range accounts {
+				if account.ID == id {
+					return account, nil
+				}
+			}
+			return entities.Account{}, errNotFound("account")
+		},
+		GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+			return accounts, nil
+		},
+	}
+	balanceRepo := &mocks.BalanceRepositoryMock{
+		GetBalanceSnapshotsBetweenFunc: func(ctx context.Context, accountID string, from, to time.Time) ([]entities.BalanceSnapshot, error) {
+			var result []entities.BalanceSnapshot
+			for _, snapshot := range snapshots {
+				if (accountID == "" || snapshot.AccountID == accountID) && !snapshot.Date.After(to) {
+					result = append(result, snapshot)
+				}
+			}
+			return result, nil
+		},
+	}
+
+	uc := NewBalanceUseCase(balanceRepo, accountRepo)
+	uc.now = func() time.Time { return time.Date(2025, time.April, 15, 14, 0, 0, 0, time.UTC) }
+	return uc
+}
+
+func TestGetBalanceHistory(t *testing.T) {
+	uc := testBalanceHistoryUseCase(t)
+	day := func(month time.Month, day int) time.Time {
+		return time.Date(2025, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("last 30 days", func(t *testing.T) {
+		history, err := uc.GetBalanceHistory(context.Background(), "acc-checking", "", time.Time{}, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, entities.BalanceGranularityDay, history.Granularity)
+		assert.Equal(t, day(time.March, 17), history.From)
+		assert.Equal(t, day(time.April, 15), history.To)
+		require.Len(t, history.Points, 30)
+
+		// Days without a snapshot keep the balance of the last one
+		assert.Equal(t, "[BRL (R$) 1000.00]", history.Points[23].Amount.String())
+		assert.Equal(t, day(time.April, 10), history.Points[24].Date)
+		assert.Equal(t, "[BRL (R$) 1500.00]", history.Points[24].Amount.String())
+		assert.Equal(t, "[BRL (R$) 1200.00]", history.Points[29].Amount.String())
+	})
+
+	t.Run("by month", func(t *testing.T) {
+		history, err := uc.GetBalanceHistory(context.Background(), "acc-checking", entities.BalanceGranularityMonth, day(time.January, 10), time.Time{})
+		require.NoError(t, err)
+
+		// No point before the first snapshot, the last month ends today
+		assert.Equal(t, []entities.BalancePoint{
+			{Date: day(time.March, 31), Amount: testMonetary(t, monetary.BRL, 100000)},
+			{Date: day(time.April, 15), Amount: testMonetary(t, monetary.BRL, 120000)},
+		}, history.Points)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, args := range map[string]struct {
+			granularity entities.BalanceGranularity
+			from, to    time.Time
+		}{
+			"granularity":     {granularity: "week"},
+			"ends too soon":   {from: day(time.April, 10), to: day(time.April, 1)},
+			"too many points": {from: time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		} {
+			_, err := uc.GetBalanceHistory(context.Background(), "acc-checking", args.granularity, args.from, args.to)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters, name)
+		}
+	})
+
+	t.Run("account not found", func(t *testing.T) {
+		_, err := uc.GetBalanceHistory(context.Background(), "acc-missing", "", time.Time{}, time.Time{})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestGetNetWorthHistory(t *testing.T) {
+	uc := testBalanceHistoryUseCase(t)
+	day := func(month time.Month, day int) time.Time {
+		return time.Date(2025, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	history, err := uc.GetNetWorthHistory(context.Background(), entities.BalanceGranularityMonth, day(time.March, 1), time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, day(time.March, 1), history.From)
+
+	// The card subtracts once it has a balance, the miles are left out
+	assert.Equal(t, []entities.NetWorthSeries{
+		{Asset: monetary.BRL, Points: []entities.BalancePoint{
+			{Date: day(time.March, 31), Amount: testMonetary(t, monetary.BRL, 100000)},
+			{Date: day(time.April, 15), Amount: testMonetary(t, monetary.BRL, 90000)},
+		}},
+		{Asset: monetary.USD, Points: []entities.BalancePoint{
+			{Date: day(time.April, 15), Amount: testMonetary(t, monetary.USD, 50000)},
+		}},
+	}, history.Series)
+}
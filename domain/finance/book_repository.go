@@ -0,0 +1,17 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/book_repository.go . BookRepository
+type BookRepository interface {
+	CreateBook(ctx context.Context, book entities.Book) (entities.Book, error)
+	GetBookByID(ctx context.Context, id string) (entities.Book, error)
+	GetAllBooks(ctx context.Context) ([]entities.Book, error)
+	GetFirstBook(ctx context.Context) (entities.Book, error)
+	ClaimBooks(ctx context.Context, userID string) (int64, error)
+	UpdateBook(ctx context.Context, book entities.Book) (entities.Book, error)
+	DeleteBook(ctx context.Context, id string) error
+}
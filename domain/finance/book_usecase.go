@@ -0,0 +1,166 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"strings"
+)
+
+type BookUseCase struct {
+	bookRepo     BookRepository
+	settingsRepo SettingsRepository
+}
+
+func NewBookUseCase(bookRepo BookRepository, settingsRepo SettingsRepository) *BookUseCase {
+	return &BookUseCase{
+		bookRepo:     bookRepo,
+		settingsRepo: settingsRepo,
+	}
+}
+
+// CreateBook creates a book, in the currency of the settings unless another
+// base currency is given
+func (uc *BookUseCase) CreateBook(ctx context.Context, book entities.Book) (entities.Book, error) {
+	if book.Asset.Asset == "" {
+		settings, err := uc.settingsRepo.GetSettings(ctx)
+		if err != nil {
+			return entities.Book{}, fmt.Errorf("failed to get settings: %w", err)
+		}
+		book.Asset = settings.Currency
+	}
+
+	book, err := validateBook(book)
+	if err != nil {
+		return entities.Book{}, err
+	}
+
+	created, err := uc.bookRepo.CreateBook(ctx, book)
+	if err != nil {
+		return entities.Book{}, fmt.Errorf("failed to create book: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetBooks returns the books ordered by name, only the user's when one
+// signed in
+func (uc *BookUseCase) GetBooks(ctx context.Context) ([]entities.Book, error) {
+	books, err := uc.bookRepo.GetAllBooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get books: %w", err)
+	}
+
+	return books, nil
+}
+
+func (uc *BookUseCase) GetBook(ctx context.Context, id string) (entities.Book, error) {
+	if id == "" {
+		return entities.Book{}, fmt.Errorf("book ID cannot be empty")
+	}
+
+	book, err := uc.bookRepo.GetBookByID(ctx, id)
+	if err != nil {
+		return entities.Book{}, fmt.Errorf("failed to get book: %w", err)
+	}
+
+	return book, nil
+}
+
+// DefaultBook returns the book the requests that don't pick one go to: the
+// oldest book of the signed in user, else the default book
+func (uc *BookUseCase) DefaultBook(ctx context.Context) (entities.Book, error) {
+	if _, ok := domain.UserFromContext(ctx); !ok {
+		return uc.GetBook(ctx, domain.DefaultBookID)
+	}
+
+	book, err := uc.bookRepo.GetFirstBook(ctx)
+	if err != nil {
+		return entities.Book{}, fmt.Errorf("failed to get default book: %w", err)
+	}
+
+	return book, nil
+}
+
+// UpdateBook renames a book or changes its description and base currency,
+// which is kept when none is given
+func (uc *BookUseCase) UpdateBook(ctx context.Context, book entities.Book) (entities.Book, error) {
+	if book.ID == "" {
+		return entities.Book{}, fmt.Errorf("book ID cannot be empty")
+	}
+
+	if book.Asset.Asset == "" {
+		current, err := uc.GetBook(ctx, book.ID)
+		if err != nil {
+			return entities.Book{}, err
+		}
+		book.Asset = current.Asset
+	}
+
+	book, err := validateBook(book)
+	if err != nil {
+		return entities.Book{}, err
+	}
+
+	updated, err := uc.bookRepo.UpdateBook(ctx, book)
+	if err != nil {
+		return entities.Book{}, fmt.Errorf("failed to update book: %w", err)
+	}
+
+	return updated, nil
+}
+
+// DeleteBook deletes a book once its accounts and categories are gone. The
+// default book is kept, it's where requests without a book go.
+func (uc *BookUseCase) DeleteBook(ctx context.Context, id string) error {
+	book, err := uc.GetBook(ctx, id)
+	if err != nil {
+		return err
+	}
+	defaultBook, err := uc.DefaultBook(ctx)
+	if err != nil {
+		return err
+	}
+	if book.ID == defaultBook.ID {
+		return fmt.Errorf("the default book cannot be deleted: %w", domain.ErrConflict)
+	}
+
+	if err := uc.bookRepo.DeleteBook(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete book: %w", err)
+	}
+
+	return nil
+}
+
+// InEachBook runs fn with the context scoped to each book in turn, for the
+// jobs that go over every book. The books after a failing one still run, and
+// their errors are joined.
+func (uc *BookUseCase) InEachBook(ctx context.Context, fn func(ctx context.Context) error) error {
+	books, err := uc.GetBooks(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, book := range books {
+		if err := fn(domain.WithBook(ctx, book.ID)); err != nil {
+			errs = append(errs, fmt.Errorf("book %s: %w", book.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateBook(book entities.Book) (entities.Book, error) {
+	book.Name = strings.TrimSpace(book.Name)
+	if book.Name == "" {
+		return entities.Book{}, fmt.Errorf("book name cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	asset, ok := entities.FindSupportedAsset(book.Asset.Asset)
+	if !ok {
+		return entities.Book{}, fmt.Errorf("unknown currency %q: %w", book.Asset.Asset, domain.ErrMalformedParameters)
+	}
+	book.Asset = asset
+	return book, nil
+}
@@ -0,0 +1,108 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testBookUseCase serves the default book and a side business one
+func testBookUseCase(t *testing.T) (*BookUseCase, *mocks.BookRepositoryMock) {
+	t.Helper()
+
+	books := []entities.Book{
+		{ID: domain.DefaultBookID, Name: "Personal"},
+		{ID: "book-side", Name: "Side business"},
+	}
+	bookRepo := &mocks.BookRepositoryMock{
+		GetAllBooksFunc: func(ctx context.Context) ([]entities.Book, error) {
+			return books, nil
+		},
+		GetBookByIDFunc: func(ctx context.Context, id string) (entities.Book, error) {
+			for _, book := range books {
+				if book.ID == id {
+					return book, nil
+				}
+			}
+			return entities.Book{}, errNotFound("book")
+		},
+		CreateBookFunc: func(ctx context.Context, book entities.Book) (entities.Book, error) {
+			book.ID = "book-new"
+			return book, nil
+		},
+	}
+
+	settingsRepo := &mocks.SettingsRepositoryMock{
+		GetSettingsFunc: func(ctx context.Context) (entities.Settings, error) {
+			return entities.Settings{Currency: monetary.BRL}, nil
+		},
+	}
+
+	return NewBookUseCase(bookRepo, settingsRepo), bookRepo
+}
+
+func TestBookUseCase_CreateBook(t *testing.T) {
+	uc, bookRepo := testBookUseCase(t)
+
+	created, err := uc.CreateBook(context.Background(), entities.Book{Name: "  Rental  "})
+	require.NoError(t, err)
+	assert.Equal(t, "book-new", created.ID)
+	assert.Equal(t, "Rental", created.Name)
+	assert.Equal(t, "BRL", created.Asset.Asset, "books default to the settings currency")
+
+	created, err = uc.CreateBook(context.Background(), entities.Book{Name: "Side business", Asset: monetary.Asset{Asset: "USD"}})
+	require.NoError(t, err)
+	assert.Equal(t, monetary.USD, created.Asset)
+
+	_, err = uc.CreateBook(context.Background(), entities.Book{Name: "  "})
+	assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	_, err = uc.CreateBook(context.Background(), entities.Book{Name: "Crypto", Asset: monetary.Asset{Asset: "XYZ"}})
+	assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	assert.Len(t, bookRepo.CreateBookCalls(), 2)
+}
+
+func TestBookUseCase_DeleteBook(t *testing.T) {
+	uc, bookRepo := testBookUseCase(t)
+
+	assert.ErrorIs(t, uc.DeleteBook(context.Background(), "book-missing"), domain.ErrNotFound)
+	assert.ErrorIs(t, uc.DeleteBook(context.Background(), domain.DefaultBookID), domain.ErrConflict)
+	assert.Empty(t, bookRepo.DeleteBookCalls())
+
+	require.NoError(t, uc.DeleteBook(context.Background(), "book-side"))
+	assert.Len(t, bookRepo.DeleteBookCalls(), 1)
+
+	t.Run("the oldest book of the user is their default", func(t *testing.T) {
+		uc, bookRepo := testBookUseCase(t)
+		bookRepo.GetFirstBookFunc = func(ctx context.Context) (entities.Book, error) {
+			return entities.Book{ID: "book-side", Name: "Side business"}, nil
+		}
+		ctx := domain.WithUser(context.Background(), "user-1")
+
+		assert.ErrorIs(t, uc.DeleteBook(ctx, "book-side"), domain.ErrConflict)
+		require.NoError(t, uc.DeleteBook(ctx, domain.DefaultBookID))
+		assert.Len(t, bookRepo.DeleteBookCalls(), 1)
+	})
+}
+
+func TestBookUseCase_InEachBook(t *testing.T) {
+	uc, _ := testBookUseCase(t)
+
+	var scoped []string
+	err := uc.InEachBook(context.Background(), func(ctx context.Context) error {
+		scoped = append(scoped, domain.BookFromContext(ctx))
+		if domain.BookFromContext(ctx) == domain.DefaultBookID {
+			return errors.New("refresh failed")
+		}
+		return nil
+	})
+	assert.ErrorContains(t, err, "book Personal: refresh failed")
+	assert.Equal(t, []string{domain.DefaultBookID, "book-side"}, scoped)
+}
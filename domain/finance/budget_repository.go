@@ -0,0 +1,18 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/budget_repository.go . BudgetRepository
+type BudgetRepository interface {
+	CreateBudget(ctx context.Context, budget entities.Budget) (entities.Budget, error)
+	CreateBudgets(ctx context.Context, budgets []entities.Budget) ([]entities.Budget, error)
+	GetBudgetByID(ctx context.Context, id string) (entities.Budget, error)
+	GetAllBudgets(ctx context.Context) ([]entities.Budget, error)
+	UpdateBudget(ctx context.Context, budget entities.Budget) (entities.Budget, error)
+	DeleteBudget(ctx context.Context, id string) error
+	RecordBudgetAlert(ctx context.Context, alert entities.BudgetAlert) (entities.BudgetAlert, bool, error)
+	GetAllBudgetAlerts(ctx context.Context) ([]entities.BudgetAlert, error)
+}
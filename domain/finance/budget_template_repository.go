@@ -0,0 +1,15 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/budget_template_repository.go . BudgetTemplateRepository
+type BudgetTemplateRepository interface {
+	CreateBudgetTemplate(ctx context.Context, template entities.BudgetTemplate) (entities.BudgetTemplate, error)
+	GetBudgetTemplateByID(ctx context.Context, id string) (entities.BudgetTemplate, error)
+	GetAllBudgetTemplates(ctx context.Context) ([]entities.BudgetTemplate, error)
+	UpdateBudgetTemplate(ctx context.Context, template entities.BudgetTemplate) (entities.BudgetTemplate, error)
+	DeleteBudgetTemplate(ctx context.Context, id string) error
+}
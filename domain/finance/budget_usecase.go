@@ -0,0 +1,691 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+type BudgetUseCase struct {
+	budgetRepo      BudgetRepository
+	templateRepo    BudgetTemplateRepository
+	categoryRepo    CategoryRepository
+	transactionRepo TransactionRepository
+	bookRepo        BookRepository
+	now             func() time.Time
+}
+
+func NewBudgetUseCase(budgetRepo BudgetRepository, templateRepo BudgetTemplateRepository, categoryRepo CategoryRepository, transactionRepo TransactionRepository, bookRepo BookRepository) *BudgetUseCase {
+	return &BudgetUseCase{
+		budgetRepo:      budgetRepo,
+		templateRepo:    templateRepo,
+		categoryRepo:    categoryRepo,
+		transactionRepo: transactionRepo,
+		bookRepo:        bookRepo,
+		now:             time.Now,
+	}
+}
+
+// CreateBudget sets the monthly limit of a category. Without an asset the
+// limit is taken in the base currency of the book, and without a start month
+// the budget applies from the current one.
+func (uc *BudgetUseCase) CreateBudget(ctx context.Context, budget entities.Budget) (entities.Budget, error) {
+	budget, err := uc.validateBudget(ctx, budget)
+	if err != nil {
+		return entities.Budget{}, err
+	}
+
+	created, err := uc.budgetRepo.CreateBudget(ctx, budget)
+	if err != nil {
+		return entities.Budget{}, fmt.Errorf("failed to create budget: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetBudgets returns the budgets ordered by the type and name of their
+// category, then by the month they start
+func (uc *BudgetUseCase) GetBudgets(ctx context.Context) ([]entities.Budget, error) {
+	budgets, err := uc.budgetRepo.GetAllBudgets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budgets: %w", err)
+	}
+
+	return budgets, nil
+}
+
+func (uc *BudgetUseCase) GetBudget(ctx context.Context, id string) (entities.Budget, error) {
+	if id == "" {
+		return entities.Budget{}, fmt.Errorf("budget ID cannot be empty")
+	}
+
+	budget, err := uc.budgetRepo.GetBudgetByID(ctx, id)
+	if err != nil {
+		return entities.Budget{}, fmt.Errorf("failed to get budget: %w", err)
+	}
+	if err := checkOwner(ctx, budget.OwnerID, "budget"); err != nil {
+		return entities.Budget{}, err
+	}
+
+	return budget, nil
+}
+
+func (uc *BudgetUseCase) UpdateBudget(ctx context.Context, budget entities.Budget) (entities.Budget, error) {
+	if _, err := uc.GetBudget(ctx, budget.ID); err != nil {
+		return entities.Budget{}, err
+	}
+
+	budget, err := uc.validateBudget(ctx, budget)
+	if err != nil {
+		return entities.Budget{}, err
+	}
+
+	updated, err := uc.budgetRepo.UpdateBudget(ctx, budget)
+	if err != nil {
+		return entities.Budget{}, fmt.Errorf("failed to update budget: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *BudgetUseCase) DeleteBudget(ctx context.Context, id string) error {
+	if _, err := uc.GetBudget(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.budgetRepo.DeleteBudget(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete budget: %w", err)
+	}
+
+	return nil
+}
+
+// CopyBudgets sets up the month of to with the limits of the budgets applying
+// in the month of from, each copied as a budget for that month alone. The
+// categories already budgeted in to are skipped and keep their budget.
+func (uc *BudgetUseCase) CopyBudgets(ctx context.Context, from, to time.Time) (entities.BudgetCopy, error) {
+	if from.IsZero() || to.IsZero() {
+		return entities.BudgetCopy{}, fmt.Errorf("months to copy budgets from and to are required: %w", domain.ErrMalformedParameters)
+	}
+	from, to = firstOfMonth(from), firstOfMonth(to)
+	if from.Equal(to) {
+		return entities.BudgetCopy{}, fmt.Errorf("budgets can't be copied to the month they're from: %w", domain.ErrMalformedParameters)
+	}
+
+	budgets, err := uc.GetBudgets(ctx)
+	if err != nil {
+		return entities.BudgetCopy{}, err
+	}
+
+	var limits []entities.BudgetTemplateItem
+	for _, budget := range budgets {
+		if budget.Covers(from) {
+			limits = append(limits, entities.BudgetTemplateItem{CategoryID: budget.CategoryID, Limit: budget.Limit})
+		}
+	}
+
+	return uc.setUpMonth(ctx, to, budgets, limits)
+}
+
+// GetBudgetProgress reports how much of each budget applying in the month of
+// date its category took, the current month when date is zero. Only cleared
+// transactions in the asset of the budget are added up, by their size since
+// amounts are signed by their effect on the account. The budget of a group
+// adds up the transactions of the group and of the categories in it, broken
+// down by category.
+func (uc *BudgetUseCase) GetBudgetProgress(ctx context.Context, date time.Time) ([]entities.BudgetProgress, error) {
+	if date.IsZero() {
+		date = uc.now()
+	}
+	month := firstOfMonth(date)
+
+	budgets, err := uc.GetBudgets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	byID := make(map[string]entities.Category, len(categories))
+	children := make(map[string][]entities.Category)
+	for _, category := range categories {
+		byID[category.ID] = category
+		if category.ParentID != "" {
+			children[category.ParentID] = append(children[category.ParentID], category)
+		}
+	}
+
+	transactions, err := uc.transactionRepo.GetTransactionsByDateRange(ctx, month, month.AddDate(0, 1, -1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	progress := []entities.BudgetProgress{}
+	for _, budget := range budgets {
+		if !budget.Covers(month) {
+			continue
+		}
+
+		spent, breakdown, err := budgetSpending(transactions, byID[budget.CategoryID], children[budget.CategoryID], budget.Limit.Asset)
+		if err != nil {
+			return nil, err
+		}
+
+		remaining, err := entities.MoneyOf(budget.Limit).Sub(spent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to subtract spending: %w", err)
+		}
+
+		var percent float64
+		if limit := budget.Limit.Amount.Int64(); limit > 0 {
+			percent = math.Round(float64(spent.Monetary().Amount.Int64())/float64(limit)*1000) / 10
+		}
+
+		progress = append(progress, entities.BudgetProgress{
+			Budget:    budget,
+			Category:  byID[budget.CategoryID],
+			Month:     month.Format(faturaMonthLayout),
+			Spent:     spent.Monetary(),
+			Remaining: remaining.Monetary(),
+			Percent:   percent,
+			Over:      remaining.Sign() < 0,
+			Breakdown: breakdown,
+		})
+	}
+
+	return progress, nil
+}
+
+// EvaluateBudgetAlerts fires an alert for each threshold the budgets of the
+// current month reached, once a month each, returning the ones fired now. A
+// threshold is reached once the category took that percent of the limit,
+// counting the transactions like the budget progress.
+func (uc *BudgetUseCase) EvaluateBudgetAlerts(ctx context.Context) ([]entities.BudgetAlert, error) {
+	progress, err := uc.GetBudgetProgress(ctx, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	fired := []entities.BudgetAlert{}
+	for _, p := range progress {
+		for _, threshold := range p.Budget.AlertThresholds() {
+			if !thresholdReached(p.Spent, p.Budget.Limit, threshold) {
+				continue
+			}
+
+			alert, recorded, err := uc.budgetRepo.RecordBudgetAlert(ctx, entities.BudgetAlert{
+				BudgetID:   p.Budget.ID,
+				CategoryID: p.Budget.CategoryID,
+				Month:      p.Month,
+				Threshold:  threshold,
+				Percent:    p.Percent,
+				Spent:      p.Spent,
+				Limit:      p.Budget.Limit,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to record budget alert: %w", err)
+			}
+			// Fired earlier in the month
+			if !recorded {
+				continue
+			}
+			fired = append(fired, alert)
+		}
+	}
+
+	return fired, nil
+}
+
+// OverBudget returns the progress of the budgets transaction leaves over
+// their limit in its month: the one of its category and the one of the group
+// its category is in. Only the transactions counting against budgets, cleared
+// ones in the asset of the budget, leave one over.
+func (uc *BudgetUseCase) OverBudget(ctx context.Context, transaction entities.Transaction) ([]entities.BudgetProgress, error) {
+	over := []entities.BudgetProgress{}
+	if transaction.CategoryID == "" || transaction.Status != entities.TransactionStatusCleared {
+		return over, nil
+	}
+
+	progress, err := uc.GetBudgetProgress(ctx, transaction.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range progress {
+		if !p.Over || p.Budget.Limit.Asset.Asset != transaction.Monetary.Asset.Asset {
+			continue
+		}
+		inGroup := slices.ContainsFunc(p.Breakdown, func(spending entities.BudgetCategorySpending) bool {
+			return spending.Category.ID == transaction.CategoryID
+		})
+		if p.Budget.CategoryID == transaction.CategoryID || inGroup {
+			over = append(over, p)
+		}
+	}
+
+	return over, nil
+}
+
+// GetBudgetAlerts returns the alerts the budgets fired, the latest first
+func (uc *BudgetUseCase) GetBudgetAlerts(ctx context.Context) ([]entities.BudgetAlert, error) {
+	alerts, err := uc.budgetRepo.GetAllBudgetAlerts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budget alerts: %w", err)
+	}
+
+	return alerts, nil
+}
+
+// thresholdReached tells whether spent is at least threshold percent of
+// limit, compared exactly rather than by the rounded percent
+func thresholdReached(spent, limit monetary.Monetary, threshold int) bool {
+	scaledSpent := new(big.Int).Mul(spent.Amount, big.NewInt(100))
+	scaledLimit := new(big.Int).Mul(limit.Amount, big.NewInt(int64(threshold)))
+	return scaledSpent.Cmp(scaledLimit) >= 0
+}
+
+// GetBudgetHistory reports what each category with a budget in the last
+// months, the current one included, was budgeted and took in every one of
+// them, and how often it kept within its limit. Categories are ordered like
+// their budgets.
+func (uc *BudgetUseCase) GetBudgetHistory(ctx context.Context, months int) (entities.BudgetHistory, error) {
+	if months < 1 || months > entities.MaxBudgetHistoryMonths {
+		return entities.BudgetHistory{}, fmt.Errorf("months must be between 1 and %d, got %d: %w", entities.MaxBudgetHistoryMonths, months, domain.ErrMalformedParameters)
+	}
+	end := firstOfMonth(uc.now())
+	start := end.AddDate(0, 1-months, 0)
+
+	budgets, err := uc.GetBudgets(ctx)
+	if err != nil {
+		return entities.BudgetHistory{}, err
+	}
+
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return entities.BudgetHistory{}, fmt.Errorf("failed to get categories: %w", err)
+	}
+	byID := make(map[string]entities.Category, len(categories))
+	children := make(map[string][]entities.Category)
+	for _, category := range categories {
+		byID[category.ID] = category
+		if category.ParentID != "" {
+			children[category.ParentID] = append(children[category.ParentID], category)
+		}
+	}
+
+	transactions, err := uc.transactionRepo.GetTransactionsByDateRange(ctx, start, end.AddDate(0, 1, -1))
+	if err != nil {
+		return entities.BudgetHistory{}, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	byMonth := make(map[string][]entities.Transaction)
+	for _, transaction := range transactions {
+		month := transaction.Date.Format(faturaMonthLayout)
+		byMonth[month] = append(byMonth[month], transaction)
+	}
+
+	history := entities.BudgetHistory{Months: make([]string, months), Categories: []entities.BudgetCategoryHistory{}}
+	for i := range history.Months {
+		history.Months[i] = start.AddDate(0, i, 0).Format(faturaMonthLayout)
+	}
+
+	// The budgets of a category don't overlap, so each month has one at most
+	var categoryIDs []string
+	monthBudgets := make(map[string][]*entities.Budget)
+	for _, budget := range budgets {
+		for i := range history.Months {
+			if !budget.Covers(start.AddDate(0, i, 0)) {
+				continue
+			}
+			if monthBudgets[budget.CategoryID] == nil {
+				categoryIDs = append(categoryIDs, budget.CategoryID)
+				monthBudgets[budget.CategoryID] = make([]*entities.Budget, months)
+			}
+			monthBudgets[budget.CategoryID][i] = &budget
+		}
+	}
+
+	for _, categoryID := range categoryIDs {
+		category := entities.BudgetCategoryHistory{Category: byID[categoryID], Months: make([]entities.BudgetMonth, months)}
+		budgeted, kept := 0, 0
+		for i, month := range history.Months {
+			budget := closestBudget(monthBudgets[categoryID], i)
+			spent, _, err := budgetSpending(byMonth[month], byID[categoryID], children[categoryID], budget.Limit.Asset)
+			if err != nil {
+				return entities.BudgetHistory{}, err
+			}
+			category.Months[i] = entities.BudgetMonth{Month: month, Actual: spent.Monetary()}
+
+			if monthBudgets[categoryID][i] == nil {
+				continue
+			}
+			limit := budget.Limit
+			category.Months[i].Budgeted = &limit
+			category.Months[i].Over = spent.Monetary().Amount.Cmp(limit.Amount) > 0
+			budgeted++
+			if !category.Months[i].Over {
+				kept++
+			}
+		}
+		category.Adherence = math.Round(float64(kept)/float64(budgeted)*1000) / 10
+		history.Categories = append(history.Categories, category)
+	}
+
+	return history, nil
+}
+
+// closestBudget returns the budget of the month at i, or the one of the
+// closest month having one. At least one of budgets is set.
+func closestBudget(budgets []*entities.Budget, i int) *entities.Budget {
+	for distance := 0; ; distance++ {
+		if i-distance >= 0 && budgets[i-distance] != nil {
+			return budgets[i-distance]
+		}
+		if i+distance < len(budgets) && budgets[i+distance] != nil {
+			return budgets[i+distance]
+		}
+	}
+}
+
+// budgetSpending adds up the size of the cleared transactions in asset of
+// category and of the categories grouped in it, broken down by category when
+// it's a group
+func budgetSpending(transactions []entities.Transaction, category entities.Category, group []entities.Category, asset monetary.Asset) (entities.Money, []entities.BudgetCategorySpending, error) {
+	spent, err := categorySpending(transactions, category.ID, asset)
+	if err != nil {
+		return entities.Money{}, nil, err
+	}
+	if len(group) == 0 {
+		return spent, nil, nil
+	}
+
+	breakdown := []entities.BudgetCategorySpending{{Category: category, Spent: spent.Monetary()}}
+	for _, child := range group {
+		childSpent, err := categorySpending(transactions, child.ID, asset)
+		if err != nil {
+			return entities.Money{}, nil, err
+		}
+		if spent, err = spent.Add(childSpent); err != nil {
+			return entities.Money{}, nil, fmt.Errorf("failed to add up transactions: %w", err)
+		}
+		breakdown = append(breakdown, entities.BudgetCategorySpending{Category: child, Spent: childSpent.Monetary()})
+	}
+	return spent, breakdown, nil
+}
+
+// categorySpending adds up the size of the cleared transactions of a category
+// in asset
+func categorySpending(transactions []entities.Transaction, categoryID string, asset monetary.Asset) (entities.Money, error) {
+	spent := entities.NewMoney(asset, 0)
+	for _, transaction := range transactions {
+		if transaction.CategoryID != categoryID || transaction.Status != entities.TransactionStatusCleared ||
+			transaction.Monetary.Asset.Asset != asset.Asset {
+			continue
+		}
+		var err error
+		if spent, err = spent.Add(entities.MoneyOf(transaction.Monetary).Abs()); err != nil {
+			return entities.Money{}, fmt.Errorf("failed to add up transactions: %w", err)
+		}
+	}
+	return spent, nil
+}
+
+// validateBudget checks the category is the signed in user's and takes the
+// limit in the asset of the budget, the book's by default. The period is
+// rounded to whole months, and the thresholds sorted, the default ones when
+// none are given.
+func (uc *BudgetUseCase) validateBudget(ctx context.Context, budget entities.Budget) (entities.Budget, error) {
+	if budget.CategoryID == "" {
+		return entities.Budget{}, fmt.Errorf("budget category ID cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	if budget.Limit.Amount == nil || budget.Limit.Amount.Sign() <= 0 {
+		return entities.Budget{}, fmt.Errorf("budget limit must be positive: %w", domain.ErrMalformedParameters)
+	}
+
+	if budget.StartMonth.IsZero() {
+		budget.StartMonth = uc.now()
+	}
+	budget.StartMonth = firstOfMonth(budget.StartMonth)
+	if budget.EndMonth != nil {
+		end := firstOfMonth(*budget.EndMonth)
+		if end.Before(budget.StartMonth) {
+			return entities.Budget{}, fmt.Errorf("budget ends before it starts: %w", domain.ErrMalformedParameters)
+		}
+		budget.EndMonth = &end
+	}
+
+	thresholds, err := budgetThresholds(budget.AlertThresholds())
+	if err != nil {
+		return entities.Budget{}, err
+	}
+	budget.Thresholds = thresholds
+
+	if _, err := ownCategory(ctx, uc.categoryRepo, budget.CategoryID); err != nil {
+		return entities.Budget{}, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	limit, err := uc.budgetLimit(ctx, budget.Limit)
+	if err != nil {
+		return entities.Budget{}, err
+	}
+	budget.Limit = limit
+
+	return budget, nil
+}
+
+// budgetThresholds sorts the percents a budget alerts on, each from 1 up to
+// entities.MaxBudgetThreshold, dropping the repeated ones
+func budgetThresholds(thresholds []int) ([]int, error) {
+	for _, threshold := range thresholds {
+		if threshold < 1 || threshold > entities.MaxBudgetThreshold {
+			return nil, fmt.Errorf("budget thresholds must be between 1 and %d, got %d: %w", entities.MaxBudgetThreshold, threshold, domain.ErrMalformedParameters)
+		}
+	}
+	sorted := slices.Clone(thresholds)
+	slices.Sort(sorted)
+	return slices.Compact(sorted), nil
+}
+
+// budgetLimit takes a limit in its asset, the base currency of the book when
+// it has none
+func (uc *BudgetUseCase) budgetLimit(ctx context.Context, limit monetary.Monetary) (monetary.Monetary, error) {
+	asset := limit.Asset
+	if asset.Asset == "" {
+		book, err := uc.bookRepo.GetBookByID(ctx, domain.BookFromContext(ctx))
+		if err != nil {
+			return monetary.Monetary{}, fmt.Errorf("failed to get book: %w", err)
+		}
+		asset = book.Asset
+	}
+	taken, err := monetary.NewMonetary(asset, limit.Amount)
+	if err != nil {
+		return monetary.Monetary{}, fmt.Errorf("invalid budget limit: %w", err)
+	}
+	return *taken, nil
+}
+
+func (uc *BudgetUseCase) CreateBudgetTemplate(ctx context.Context, template entities.BudgetTemplate) (entities.BudgetTemplate, error) {
+	template, err := uc.validateBudgetTemplate(ctx, template)
+	if err != nil {
+		return entities.BudgetTemplate{}, err
+	}
+
+	created, err := uc.templateRepo.CreateBudgetTemplate(ctx, template)
+	if err != nil {
+		return entities.BudgetTemplate{}, fmt.Errorf("failed to create budget template: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetBudgetTemplates returns the budget templates ordered by name
+func (uc *BudgetUseCase) GetBudgetTemplates(ctx context.Context) ([]entities.BudgetTemplate, error) {
+	templates, err := uc.templateRepo.GetAllBudgetTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get budget templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+func (uc *BudgetUseCase) GetBudgetTemplate(ctx context.Context, id string) (entities.BudgetTemplate, error) {
+	if id == "" {
+		return entities.BudgetTemplate{}, fmt.Errorf("budget template ID cannot be empty")
+	}
+
+	template, err := uc.templateRepo.GetBudgetTemplateByID(ctx, id)
+	if err != nil {
+		return entities.BudgetTemplate{}, fmt.Errorf("failed to get budget template: %w", err)
+	}
+	if err := checkOwner(ctx, template.OwnerID, "budget template"); err != nil {
+		return entities.BudgetTemplate{}, err
+	}
+
+	return template, nil
+}
+
+func (uc *BudgetUseCase) UpdateBudgetTemplate(ctx context.Context, template entities.BudgetTemplate) (entities.BudgetTemplate, error) {
+	if _, err := uc.GetBudgetTemplate(ctx, template.ID); err != nil {
+		return entities.BudgetTemplate{}, err
+	}
+
+	template, err := uc.validateBudgetTemplate(ctx, template)
+	if err != nil {
+		return entities.BudgetTemplate{}, err
+	}
+
+	updated, err := uc.templateRepo.UpdateBudgetTemplate(ctx, template)
+	if err != nil {
+		return entities.BudgetTemplate{}, fmt.Errorf("failed to update budget template: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *BudgetUseCase) DeleteBudgetTemplate(ctx context.Context, id string) error {
+	if _, err := uc.GetBudgetTemplate(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.templateRepo.DeleteBudgetTemplate(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete budget template: %w", err)
+	}
+
+	return nil
+}
+
+// ApplyBudgetTemplate sets up the month of date, the current one when zero,
+// with the limits of a template, each as a budget for that month alone. The
+// categories already budgeted in the month are skipped and keep their budget,
+// like the ones deleted since the template was saved.
+func (uc *BudgetUseCase) ApplyBudgetTemplate(ctx context.Context, id string, date time.Time) (entities.BudgetCopy, error) {
+	template, err := uc.GetBudgetTemplate(ctx, id)
+	if err != nil {
+		return entities.BudgetCopy{}, err
+	}
+	if date.IsZero() {
+		date = uc.now()
+	}
+
+	budgets, err := uc.GetBudgets(ctx)
+	if err != nil {
+		return entities.BudgetCopy{}, err
+	}
+
+	return uc.setUpMonth(ctx, firstOfMonth(date), budgets, template.Items)
+}
+
+// setUpMonth creates a budget for month alone out of each of limits, all or
+// none of them, skipping the categories budgeted in month already and the
+// ones that no longer exist. The budgets alert on the default thresholds.
+func (uc *BudgetUseCase) setUpMonth(ctx context.Context, month time.Time, budgets []entities.Budget, limits []entities.BudgetTemplateItem) (entities.BudgetCopy, error) {
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return entities.BudgetCopy{}, fmt.Errorf("failed to get categories: %w", err)
+	}
+	existing := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		existing[category.ID] = true
+	}
+
+	budgeted := make(map[string]bool)
+	for _, budget := range budgets {
+		if budget.Covers(month) {
+			budgeted[budget.CategoryID] = true
+		}
+	}
+
+	result := entities.BudgetCopy{Month: month, Created: []entities.Budget{}, Skipped: []string{}}
+	var pending []entities.Budget
+	for _, limit := range limits {
+		if budgeted[limit.CategoryID] || !existing[limit.CategoryID] {
+			result.Skipped = append(result.Skipped, limit.CategoryID)
+			continue
+		}
+		end := month
+		pending = append(pending, entities.Budget{
+			CategoryID: limit.CategoryID,
+			Limit:      limit.Limit,
+			StartMonth: month,
+			EndMonth:   &end,
+		})
+	}
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	if result.Created, err = uc.budgetRepo.CreateBudgets(ctx, pending); err != nil {
+		return entities.BudgetCopy{}, fmt.Errorf("failed to create budgets: %w", err)
+	}
+
+	return result, nil
+}
+
+// validateBudgetTemplate checks the template is named and limits the
+// signed in user's categories once each, taking the limits without an asset
+// in the base currency of the book
+func (uc *BudgetUseCase) validateBudgetTemplate(ctx context.Context, template entities.BudgetTemplate) (entities.BudgetTemplate, error) {
+	template.Name = strings.TrimSpace(template.Name)
+	if template.Name == "" {
+		return entities.BudgetTemplate{}, fmt.Errorf("budget template name cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	if len(template.Items) == 0 {
+		return entities.BudgetTemplate{}, fmt.Errorf("budget template must limit a category: %w", domain.ErrMalformedParameters)
+	}
+
+	seen := make(map[string]bool, len(template.Items))
+	items := make([]entities.BudgetTemplateItem, len(template.Items))
+	for i, item := range template.Items {
+		if item.CategoryID == "" {
+			return entities.BudgetTemplate{}, fmt.Errorf("budget template category ID cannot be empty: %w", domain.ErrMalformedParameters)
+		}
+		if seen[item.CategoryID] {
+			return entities.BudgetTemplate{}, fmt.Errorf("budget template limits category %s twice: %w", item.CategoryID, domain.ErrMalformedParameters)
+		}
+		seen[item.CategoryID] = true
+		if item.Limit.Amount == nil || item.Limit.Amount.Sign() <= 0 {
+			return entities.BudgetTemplate{}, fmt.Errorf("budget limit must be positive: %w", domain.ErrMalformedParameters)
+		}
+
+		if _, err := ownCategory(ctx, uc.categoryRepo, item.CategoryID); err != nil {
+			return entities.BudgetTemplate{}, fmt.Errorf("failed to get category: %w", err)
+		}
+		limit, err := uc.budgetLimit(ctx, item.Limit)
+		if err != nil {
+			return entities.BudgetTemplate{}, err
+		}
+		items[i] = entities.BudgetTemplateItem{CategoryID: item.CategoryID, Limit: limit}
+	}
+	template.Items = items
+
+	return template, nil
+}
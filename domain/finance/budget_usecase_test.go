@@ -0,0 +1,574 @@
+package finance
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testBudgetUseCase serves a BRL book with a groceries budget of R$ 800.00
+// since March 2025 and a dining one of R$ 200.00 for March only, the
+// transactions of March and April and a template limiting both categories and
+// a deleted one. Today is 2025-04-15.
+func testBudgetUseCase(t *testing.T) (*BudgetUseCase, *mocks.BudgetRepositoryMock) {
+	t.Helper()
+
+	groceries := entities.Category{ID: "cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense}
+	dining := entities.Category{ID: "cat-dining", Name: "Dining", Type: entities.CategoryTypeExpense}
+	categories := map[string]entities.Category{groceries.ID: groceries, dining.ID: dining}
+
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	budgets := []entities.Budget{
+		{ID: "bud-groceries", CategoryID: groceries.ID, Limit: testMonetary(t, monetary.BRL, 80000), StartMonth: march},
+		{ID: "bud-dining", CategoryID: dining.ID, Limit: testMonetary(t, monetary.BRL, 20000), StartMonth: march, EndMonth: &march},
+	}
+
+	transaction := func(id string, category entities.Category, amount monetary.Monetary, month time.Month, day int, status entities.TransactionStatus) entities.Transaction {
+		return entities.Transaction{
+			ID:         id,
+			CategoryID: category.ID,
+			Monetary:   amount,
+			Date:       time.Date(2025, month, day, 0, 0, 0, 0, time.UTC),
+			Status:     status,
+		}
+	}
+	transactions := []entities.Transaction{
+		transaction("tx-market", groceries, testMonetary(t, monetary.BRL, -45000), time.March, 5, entities.TransactionStatusCleared),
+		transaction("tx-dinner", dining, testMonetary(t, monetary.BRL, -25000), time.March, 8, entities.TransactionStatusCleared),
+		transaction("tx-bakery", groceries, testMonetary(t, monetary.BRL, -30000), time.April, 2, entities.TransactionStatusCleared),
+		transaction("tx-card", groceries, testMonetary(t, monetary.BRL, 20000), time.April, 9, entities.TransactionStatusCleared),
+		transaction("tx-pending", groceries, testMonetary(t, monetary.BRL, -10000), time.April, 10, entities.TransactionStatusPending),
+		transaction("tx-abroad", groceries, testMonetary(t, monetary.USD, -5000), time.April, 11, entities.TransactionStatusCleared),
+	}
+
+	budgetRepo := &mocks.BudgetRepositoryMock{
+		CreateBudgetFunc: func(ctx context.Context, budget entities.Budget) (entities.Budget, error) {
+			budget.ID = "bud-new"
+			return budget, nil
+		},
+		CreateBudgetsFunc: func(ctx context.Context, budgets []entities.Budget) ([]entities.Budget, error) {
+			created := make([]entities.Budget, len(budgets))
+			for i, budget := range budgets {
+				budget.ID = "bud-" + budget.CategoryID
+				created[i] = budget
+			}
+			return created, nil
+		},
+		GetBudgetByIDFunc: func(ctx context.Context, id string) (entities.Budget, error) {
+			for _, budget := range budgets {
+				if budget.ID == id {
+					return budget, nil
+				}
+			}
+			return entities.Budget{}, errNotFound("budget")
+		},
+		GetAllBudgetsFunc: func(ctx context.Context) ([]entities.Budget, error) {
+			return budgets, nil
+		},
+		UpdateBudgetFunc: func(ctx context.Context, budget entities.Budget) (entities.Budget, error) {
+			return budget, nil
+		},
+	}
+	template := entities.BudgetTemplate{
+		ID:   "tpl-regular",
+		Name: "Regular month",
+		Items: []entities.BudgetTemplateItem{
+			{CategoryID: groceries.ID, Limit: testMonetary(t, monetary.BRL, 90000)},
+			{CategoryID: dining.ID, Limit: testMonetary(t, monetary.BRL, 25000)},
+			{CategoryID: "cat-deleted", Limit: testMonetary(t, monetary.BRL, 10000)},
+		},
+	}
+	templateRepo := &mocks.BudgetTemplateRepositoryMock{
+		CreateBudgetTemplateFunc: func(ctx context.Context, template entities.BudgetTemplate) (entities.BudgetTemplate, error) {
+			template.ID = "tpl-new"
+			return template, nil
+		},
+		GetBudgetTemplateByIDFunc: func(ctx context.Context, id string) (entities.BudgetTemplate, error) {
+			if id != template.ID {
+				return entities.BudgetTemplate{}, errNotFound("budget template")
+			}
+			return template, nil
+		},
+	}
+	categoryRepo := &mocks.CategoryRepositoryMock{
+		GetCategoryByIDFunc: func(ctx context.Context, id string) (entities.Category, error) {
+			category, ok := categories[id]
+			if !ok {
+				return entities.Category{}, errNotFound("category")
+			}
+			return category, nil
+		},
+		GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+			return []entities.Category{dining, groceries}, nil
+		},
+	}
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionsByDateRangeFunc: func(ctx context.Context, startDate, endDate time.Time) ([]entities.Transaction, error) {
+			var result []entities.Transaction
+			for _, transaction := range transactions {
+				if !transaction.Date.Before(startDate) && !transaction.Date.After(endDate) {
+					result = append(result, transaction)
+				}
+			}
+			return result, nil
+		},
+	}
+	bookRepo := &mocks.BookRepositoryMock{
+		GetBookByIDFunc: func(ctx context.Context, id string) (entities.Book, error) {
+			return entities.Book{ID: id, Name: "Personal", Asset: monetary.BRL}, nil
+		},
+	}
+
+	uc := NewBudgetUseCase(budgetRepo, templateRepo, categoryRepo, transactionRepo, bookRepo)
+	uc.now = func() time.Time { return time.Date(2025, time.April, 15, 14, 0, 0, 0, time.UTC) }
+	return uc, budgetRepo
+}
+
+func TestCreateBudget(t *testing.T) {
+	uc, _ := testBudgetUseCase(t)
+	limit := monetary.Monetary{Amount: big.NewInt(50000)}
+
+	t.Run("takes the book asset and starts this month", func(t *testing.T) {
+		end := time.Date(2025, time.December, 20, 0, 0, 0, 0, time.UTC)
+		budget, err := uc.CreateBudget(context.Background(), entities.Budget{CategoryID: "cat-dining", Limit: limit, EndMonth: &end})
+		require.NoError(t, err)
+		assert.Equal(t, monetary.BRL, budget.Limit.Asset)
+		assert.Equal(t, int64(50000), budget.Limit.Amount.Int64())
+		assert.Equal(t, time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC), budget.StartMonth)
+		require.NotNil(t, budget.EndMonth)
+		assert.Equal(t, time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC), *budget.EndMonth)
+		assert.Equal(t, []int{50, 80, 100}, budget.Thresholds)
+	})
+
+	t.Run("sorts the thresholds", func(t *testing.T) {
+		budget, err := uc.CreateBudget(context.Background(), entities.Budget{CategoryID: "cat-dining", Limit: limit, Thresholds: []int{120, 75, 120}})
+		require.NoError(t, err)
+		assert.Equal(t, []int{75, 120}, budget.Thresholds)
+	})
+
+	t.Run("keeps the asset given", func(t *testing.T) {
+		budget, err := uc.CreateBudget(context.Background(), entities.Budget{CategoryID: "cat-dining", Limit: testMonetary(t, monetary.USD, 10000)})
+		require.NoError(t, err)
+		assert.Equal(t, monetary.USD, budget.Limit.Asset)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		march := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+		for name, budget := range map[string]entities.Budget{
+			"no category":    {Limit: limit},
+			"no limit":       {CategoryID: "cat-dining"},
+			"negative limit": {CategoryID: "cat-dining", Limit: monetary.Monetary{Amount: big.NewInt(-100)}},
+			"ends too soon":  {CategoryID: "cat-dining", Limit: limit, EndMonth: &march},
+			"zero threshold": {CategoryID: "cat-dining", Limit: limit, Thresholds: []int{0, 50}},
+			"huge threshold": {CategoryID: "cat-dining", Limit: limit, Thresholds: []int{1001}},
+		} {
+			_, err := uc.CreateBudget(context.Background(), budget)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters, name)
+		}
+	})
+
+	t.Run("category not found", func(t *testing.T) {
+		_, err := uc.CreateBudget(context.Background(), entities.Budget{CategoryID: "cat-missing", Limit: limit})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestDeleteBudget(t *testing.T) {
+	uc, budgetRepo := testBudgetUseCase(t)
+
+	require.NoError(t, uc.DeleteBudget(context.Background(), "bud-dining"))
+	assert.Len(t, budgetRepo.DeleteBudgetCalls(), 1)
+
+	err := uc.DeleteBudget(context.Background(), "bud-missing")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestGetBudgetProgress(t *testing.T) {
+	uc, _ := testBudgetUseCase(t)
+
+	t.Run("current month", func(t *testing.T) {
+		progress, err := uc.GetBudgetProgress(context.Background(), time.Time{})
+		require.NoError(t, err)
+		require.Len(t, progress, 1)
+
+		groceries := progress[0]
+		assert.Equal(t, "2025-04", groceries.Month)
+		assert.Equal(t, "Groceries", groceries.Category.Name)
+		// Cleared BRL transactions only, by their size
+		assert.Equal(t, int64(50000), groceries.Spent.Amount.Int64())
+		assert.Equal(t, int64(30000), groceries.Remaining.Amount.Int64())
+		assert.Equal(t, 62.5, groceries.Percent)
+		assert.False(t, groceries.Over)
+	})
+
+	t.Run("overspent", func(t *testing.T) {
+		progress, err := uc.GetBudgetProgress(context.Background(), time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, progress, 2)
+
+		dining := progress[1]
+		assert.Equal(t, "Dining", dining.Category.Name)
+		assert.Equal(t, int64(25000), dining.Spent.Amount.Int64())
+		assert.Equal(t, int64(-5000), dining.Remaining.Amount.Int64())
+		assert.Equal(t, 125.0, dining.Percent)
+		assert.True(t, dining.Over)
+	})
+
+	t.Run("before the budgets start", func(t *testing.T) {
+		progress, err := uc.GetBudgetProgress(context.Background(), time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Empty(t, progress)
+	})
+}
+
+func TestOverBudget(t *testing.T) {
+	uc, _ := testBudgetUseCase(t)
+	march := time.Date(2025, time.March, 8, 0, 0, 0, 0, time.UTC)
+
+	t.Run("leaves its category over", func(t *testing.T) {
+		over, err := uc.OverBudget(context.Background(), entities.Transaction{
+			CategoryID: "cat-dining",
+			Monetary:   testMonetary(t, monetary.BRL, -25000),
+			Date:       march,
+			Status:     entities.TransactionStatusCleared,
+		})
+		require.NoError(t, err)
+		require.Len(t, over, 1)
+		assert.Equal(t, "bud-dining", over[0].Budget.ID)
+		assert.Equal(t, int64(-5000), over[0].Remaining.Amount.Int64())
+	})
+
+	t.Run("within the budget", func(t *testing.T) {
+		over, err := uc.OverBudget(context.Background(), entities.Transaction{
+			CategoryID: "cat-groceries",
+			Monetary:   testMonetary(t, monetary.BRL, -30000),
+			Date:       time.Date(2025, time.April, 2, 0, 0, 0, 0, time.UTC),
+			Status:     entities.TransactionStatusCleared,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, over)
+	})
+
+	t.Run("not counted against the budget", func(t *testing.T) {
+		for name, transaction := range map[string]entities.Transaction{
+			"pending":        {CategoryID: "cat-dining", Monetary: testMonetary(t, monetary.BRL, -100), Date: march, Status: entities.TransactionStatusPending},
+			"other currency": {CategoryID: "cat-dining", Monetary: testMonetary(t, monetary.USD, -100), Date: march, Status: entities.TransactionStatusCleared},
+		} {
+			over, err := uc.OverBudget(context.Background(), transaction)
+			require.NoError(t, err, name)
+			assert.Empty(t, over, name)
+		}
+	})
+}
+
+func TestEvaluateBudgetAlerts(t *testing.T) {
+	uc, budgetRepo := testBudgetUseCase(t)
+	recorded := map[string]bool{}
+	budgetRepo.RecordBudgetAlertFunc = func(ctx context.Context, alert entities.BudgetAlert) (entities.BudgetAlert, bool, error) {
+		key := fmt.Sprintf("%s/%s/%d", alert.BudgetID, alert.Month, alert.Threshold)
+		if recorded[key] {
+			return entities.BudgetAlert{}, false, nil
+		}
+		recorded[key] = true
+		alert.ID = "alert-" + key
+		return alert, true, nil
+	}
+
+	t.Run("fires the thresholds reached", func(t *testing.T) {
+		// Groceries took R$ 500.00 of R$ 800.00 in April
+		fired, err := uc.EvaluateBudgetAlerts(context.Background())
+		require.NoError(t, err)
+		require.Len(t, fired, 1)
+		assert.Equal(t, "bud-groceries", fired[0].BudgetID)
+		assert.Equal(t, "2025-04", fired[0].Month)
+		assert.Equal(t, 50, fired[0].Threshold)
+		assert.Equal(t, 62.5, fired[0].Percent)
+		assert.Equal(t, int64(50000), fired[0].Spent.Amount.Int64())
+	})
+
+	t.Run("once a month each", func(t *testing.T) {
+		fired, err := uc.EvaluateBudgetAlerts(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, fired)
+		assert.Len(t, budgetRepo.RecordBudgetAlertCalls(), 2)
+	})
+
+	t.Run("compares the exact share spent", func(t *testing.T) {
+		budgets, err := uc.GetBudgets(context.Background())
+		require.NoError(t, err)
+		budgets[0].Thresholds = []int{60, 62, 63}
+		budgetRepo.GetAllBudgetsFunc = func(ctx context.Context) ([]entities.Budget, error) {
+			return budgets, nil
+		}
+
+		fired, err := uc.EvaluateBudgetAlerts(context.Background())
+		require.NoError(t, err)
+		require.Len(t, fired, 2)
+		assert.Equal(t, 60, fired[0].Threshold)
+		assert.Equal(t, 62, fired[1].Threshold)
+	})
+}
+
+func TestGetBudgetProgressOfGroup(t *testing.T) {
+	food := entities.Category{ID: "cat-food", Name: "Food", Type: entities.CategoryTypeExpense}
+	groceries := entities.Category{ID: "cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense, ParentID: food.ID}
+	restaurants := entities.Category{ID: "cat-restaurants", Name: "Restaurants", Type: entities.CategoryTypeExpense, ParentID: food.ID}
+	april := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	transaction := func(category entities.Category, cents int64) entities.Transaction {
+		return entities.Transaction{
+			CategoryID: category.ID,
+			Monetary:   testMonetary(t, monetary.BRL, cents),
+			Date:       april.AddDate(0, 0, 3),
+			Status:     entities.TransactionStatusCleared,
+		}
+	}
+	budgetRepo := &mocks.BudgetRepositoryMock{
+		GetAllBudgetsFunc: func(ctx context.Context) ([]entities.Budget, error) {
+			return []entities.Budget{
+				{ID: "bud-food", CategoryID: food.ID, Limit: testMonetary(t, monetary.BRL, 100000), StartMonth: april},
+				{ID: "bud-restaurants", CategoryID: restaurants.ID, Limit: testMonetary(t, monetary.BRL, 30000), StartMonth: april},
+			}, nil
+		},
+	}
+	categoryRepo := &mocks.CategoryRepositoryMock{
+		GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+			return []entities.Category{food, groceries, restaurants}, nil
+		},
+	}
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionsByDateRangeFunc: func(ctx context.Context, startDate, endDate time.Time) ([]entities.Transaction, error) {
+			return []entities.Transaction{
+				transaction(food, -5000),
+				transaction(groceries, -60000),
+				transaction(restaurants, -32000),
+			}, nil
+		},
+	}
+	uc := NewBudgetUseCase(budgetRepo, nil, categoryRepo, transactionRepo, nil)
+
+	progress, err := uc.GetBudgetProgress(context.Background(), april)
+	require.NoError(t, err)
+	require.Len(t, progress, 2)
+
+	// The group adds up its own transactions and the ones of its categories
+	group := progress[0]
+	assert.Equal(t, int64(97000), group.Spent.Amount.Int64())
+	assert.Equal(t, int64(3000), group.Remaining.Amount.Int64())
+	assert.False(t, group.Over)
+	require.Len(t, group.Breakdown, 3)
+	for i, want := range []struct {
+		name  string
+		spent int64
+	}{{"Food", 5000}, {"Groceries", 60000}, {"Restaurants", 32000}} {
+		assert.Equal(t, want.name, group.Breakdown[i].Category.Name)
+		assert.Equal(t, want.spent, group.Breakdown[i].Spent.Amount.Int64())
+	}
+
+	// A category in the group keeps its own budget
+	restaurantsProgress := progress[1]
+	assert.Equal(t, int64(32000), restaurantsProgress.Spent.Amount.Int64())
+	assert.True(t, restaurantsProgress.Over)
+	assert.Empty(t, restaurantsProgress.Breakdown)
+}
+
+func TestGetBudgetHistory(t *testing.T) {
+	food := entities.Category{ID: "cat-food", Name: "Food", Type: entities.CategoryTypeExpense}
+	groceries := entities.Category{ID: "cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense, ParentID: food.ID}
+	rent := entities.Category{ID: "cat-rent", Name: "Rent", Type: entities.CategoryTypeExpense}
+	month := func(m time.Month) time.Time {
+		return time.Date(2025, m, 1, 0, 0, 0, 0, time.UTC)
+	}
+	january, february := month(time.January), month(time.February)
+
+	transaction := func(category entities.Category, cents int64, date time.Time, status entities.TransactionStatus) entities.Transaction {
+		return entities.Transaction{CategoryID: category.ID, Monetary: testMonetary(t, monetary.BRL, cents), Date: date, Status: status}
+	}
+	var gotStart, gotEnd time.Time
+	budgetRepo := &mocks.BudgetRepositoryMock{
+		GetAllBudgetsFunc: func(ctx context.Context) ([]entities.Budget, error) {
+			return []entities.Budget{
+				{ID: "bud-food", CategoryID: food.ID, Limit: testMonetary(t, monetary.BRL, 100000), StartMonth: january, EndMonth: &february},
+				{ID: "bud-food-later", CategoryID: food.ID, Limit: testMonetary(t, monetary.BRL, 80000), StartMonth: month(time.March)},
+				{ID: "bud-rent", CategoryID: rent.ID, Limit: testMonetary(t, monetary.BRL, 200000), StartMonth: february, EndMonth: &february},
+				// Ended before the history starts
+				{ID: "bud-old", CategoryID: groceries.ID, Limit: testMonetary(t, monetary.BRL, 1000), StartMonth: january, EndMonth: &january},
+			}, nil
+		},
+	}
+	categoryRepo := &mocks.CategoryRepositoryMock{
+		GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+			return []entities.Category{food, groceries, rent}, nil
+		},
+	}
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionsByDateRangeFunc: func(ctx context.Context, startDate, endDate time.Time) ([]entities.Transaction, error) {
+			gotStart, gotEnd = startDate, endDate
+			return []entities.Transaction{
+				transaction(groceries, -120000, february.AddDate(0, 0, 3), entities.TransactionStatusCleared),
+				transaction(food, -50000, month(time.March).AddDate(0, 0, 3), entities.TransactionStatusCleared),
+				transaction(groceries, -40000, month(time.March).AddDate(0, 0, 9), entities.TransactionStatusCleared),
+				transaction(groceries, -70000, month(time.April).AddDate(0, 0, 2), entities.TransactionStatusCleared),
+				// Only cleared transactions count, like in the budget progress
+				transaction(groceries, -90000, month(time.April).AddDate(0, 0, 5), entities.TransactionStatusPending),
+				transaction(rent, -200000, february.AddDate(0, 0, 5), entities.TransactionStatusCleared),
+			}, nil
+		},
+	}
+	uc := NewBudgetUseCase(budgetRepo, nil, categoryRepo, transactionRepo, nil)
+	uc.now = func() time.Time { return time.Date(2025, time.April, 20, 18, 0, 0, 0, time.UTC) }
+
+	history, err := uc.GetBudgetHistory(context.Background(), 3)
+	require.NoError(t, err)
+	assert.Equal(t, february, gotStart)
+	assert.Equal(t, time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC), gotEnd)
+	assert.Equal(t, []string{"2025-02", "2025-03", "2025-04"}, history.Months)
+	require.Len(t, history.Categories, 2)
+
+	type historyMonth struct {
+		budgeted int64
+		actual   int64
+		over     bool
+	}
+	months := func(category entities.BudgetCategoryHistory) []historyMonth {
+		got := make([]historyMonth, len(category.Months))
+		for i, m := range category.Months {
+			got[i] = historyMonth{-1, m.Actual.Amount.Int64(), m.Over}
+			if m.Budgeted != nil {
+				got[i].budgeted = m.Budgeted.Amount.Int64()
+			}
+		}
+		return got
+	}
+
+	// The group adds up its categories, with the limit of each month
+	assert.Equal(t, "Food", history.Categories[0].Category.Name)
+	assert.Equal(t, []historyMonth{{100000, 120000, true}, {80000, 90000, true}, {80000, 70000, false}}, months(history.Categories[0]))
+	assert.Equal(t, 33.3, history.Categories[0].Adherence)
+
+	// Months without a budget only count what was spent
+	assert.Equal(t, "Rent", history.Categories[1].Category.Name)
+	assert.Equal(t, []historyMonth{{200000, 200000, false}, {-1, 0, false}, {-1, 0, false}}, months(history.Categories[1]))
+	assert.Equal(t, 100.0, history.Categories[1].Adherence)
+
+	t.Run("invalid months", func(t *testing.T) {
+		for _, months := range []int{0, entities.MaxBudgetHistoryMonths + 1} {
+			_, err := uc.GetBudgetHistory(context.Background(), months)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		}
+	})
+}
+
+func TestCopyBudgets(t *testing.T) {
+	may := time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("copies the budgets of a month", func(t *testing.T) {
+		uc, _ := testBudgetUseCase(t)
+
+		copied, err := uc.CopyBudgets(context.Background(), time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC), may)
+		require.NoError(t, err)
+		assert.Equal(t, may, copied.Month)
+		// Groceries has a budget in May already
+		assert.Equal(t, []string{"cat-groceries"}, copied.Skipped)
+		require.Len(t, copied.Created, 1)
+
+		dining := copied.Created[0]
+		assert.Equal(t, "cat-dining", dining.CategoryID)
+		assert.Equal(t, int64(20000), dining.Limit.Amount.Int64())
+		assert.Equal(t, may, dining.StartMonth)
+		require.NotNil(t, dining.EndMonth)
+		assert.Equal(t, may, *dining.EndMonth)
+	})
+
+	t.Run("nothing to copy", func(t *testing.T) {
+		uc, budgetRepo := testBudgetUseCase(t)
+
+		copied, err := uc.CopyBudgets(context.Background(), time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC), may)
+		require.NoError(t, err)
+		assert.Empty(t, copied.Created)
+		assert.Empty(t, copied.Skipped)
+		assert.Empty(t, budgetRepo.CreateBudgetsCalls())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		uc, _ := testBudgetUseCase(t)
+
+		_, err := uc.CopyBudgets(context.Background(), may, may.AddDate(0, 0, 14))
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		_, err = uc.CopyBudgets(context.Background(), time.Time{}, may)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+}
+
+func TestCreateBudgetTemplate(t *testing.T) {
+	uc, _ := testBudgetUseCase(t)
+	limit := monetary.Monetary{Amount: big.NewInt(50000)}
+
+	t.Run("takes the book asset", func(t *testing.T) {
+		template, err := uc.CreateBudgetTemplate(context.Background(), entities.BudgetTemplate{
+			Name:  " Holidays ",
+			Items: []entities.BudgetTemplateItem{{CategoryID: "cat-dining", Limit: limit}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Holidays", template.Name)
+		require.Len(t, template.Items, 1)
+		assert.Equal(t, monetary.BRL, template.Items[0].Limit.Asset)
+		assert.Equal(t, int64(50000), template.Items[0].Limit.Amount.Int64())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, template := range map[string]entities.BudgetTemplate{
+			"no name":  {Items: []entities.BudgetTemplateItem{{CategoryID: "cat-dining", Limit: limit}}},
+			"no items": {Name: "Holidays"},
+			"no limit": {Name: "Holidays", Items: []entities.BudgetTemplateItem{{CategoryID: "cat-dining"}}},
+			"twice": {Name: "Holidays", Items: []entities.BudgetTemplateItem{
+				{CategoryID: "cat-dining", Limit: limit},
+				{CategoryID: "cat-dining", Limit: limit},
+			}},
+		} {
+			_, err := uc.CreateBudgetTemplate(context.Background(), template)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters, name)
+		}
+	})
+
+	t.Run("category not found", func(t *testing.T) {
+		_, err := uc.CreateBudgetTemplate(context.Background(), entities.BudgetTemplate{
+			Name:  "Holidays",
+			Items: []entities.BudgetTemplateItem{{CategoryID: "cat-missing", Limit: limit}},
+		})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestApplyBudgetTemplate(t *testing.T) {
+	uc, _ := testBudgetUseCase(t)
+
+	t.Run("current month", func(t *testing.T) {
+		applied, err := uc.ApplyBudgetTemplate(context.Background(), "tpl-regular", time.Time{})
+		require.NoError(t, err)
+
+		april := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, april, applied.Month)
+		assert.Equal(t, []string{"cat-groceries", "cat-deleted"}, applied.Skipped)
+		require.Len(t, applied.Created, 1)
+		assert.Equal(t, "cat-dining", applied.Created[0].CategoryID)
+		assert.Equal(t, int64(25000), applied.Created[0].Limit.Amount.Int64())
+		assert.Equal(t, april, applied.Created[0].StartMonth)
+		assert.Equal(t, april, *applied.Created[0].EndMonth)
+	})
+
+	t.Run("template not found", func(t *testing.T) {
+		_, err := uc.ApplyBudgetTemplate(context.Background(), "tpl-missing", time.Time{})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
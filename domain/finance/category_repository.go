@@ -9,7 +9,9 @@ import (
 type CategoryRepository interface {
 	CreateCategory(ctx context.Context, category entities.Category) (entities.Category, error)
 	GetCategoryByID(ctx context.Context, id string) (entities.Category, error)
-	GetAllCategories(ctx context.Context) ([]entities.Category, error)
+	GetAllCategories(ctx context.Context, sort []entities.SortField) ([]entities.Category, error)
+	GetCategoriesPage(ctx context.Context, sort []entities.SortField, limit, offset int) ([]entities.Category, error)
+	CountCategories(ctx context.Context) (int64, error)
 	GetCategoriesByType(ctx context.Context, categoryType entities.CategoryType) ([]entities.Category, error)
 	UpdateCategory(ctx context.Context, category entities.Category) (entities.Category, error)
 	DeleteCategory(ctx context.Context, id string) error
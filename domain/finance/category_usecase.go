@@ -2,8 +2,10 @@ package finance
 
 import (
 	"context"
+	"finance/domain"
 	"finance/domain/entities"
 	"fmt"
+	"slices"
 	"strings"
 )
 
@@ -22,6 +24,9 @@ func (uc *CategoryUseCase) CreateCategory(ctx context.Context, category entities
 	if err := uc.validateCategory(category); err != nil {
 		return entities.Category{}, err
 	}
+	if err := uc.validateParent(ctx, category); err != nil {
+		return entities.Category{}, err
+	}
 
 	// Set default color if not provided
 	if category.Color == "" {
@@ -41,7 +46,7 @@ func (uc *CategoryUseCase) GetCategoryByID(ctx context.Context, id string) (enti
 		return entities.Category{}, fmt.Errorf("category ID cannot be empty")
 	}
 
-	category, err := uc.categoryRepo.GetCategoryByID(ctx, id)
+	category, err := ownCategory(ctx, uc.categoryRepo, id)
 	if err != nil {
 		return entities.Category{}, fmt.Errorf("failed to get category: %w", err)
 	}
@@ -49,8 +54,8 @@ func (uc *CategoryUseCase) GetCategoryByID(ctx context.Context, id string) (enti
 	return category, nil
 }
 
-func (uc *CategoryUseCase) GetAllCategories(ctx context.Context) ([]entities.Category, error) {
-	categories, err := uc.categoryRepo.GetAllCategories(ctx)
+func (uc *CategoryUseCase) GetAllCategories(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, sort)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get categories: %w", err)
 	}
@@ -58,6 +63,22 @@ func (uc *CategoryUseCase) GetAllCategories(ctx context.Context) ([]entities.Cat
 	return categories, nil
 }
 
+// GetCategoriesPage returns limit categories after the first offset ones with
+// the number of categories in the book
+func (uc *CategoryUseCase) GetCategoriesPage(ctx context.Context, sort []entities.SortField, limit, offset int) (entities.Page[entities.Category], error) {
+	categories, err := uc.categoryRepo.GetCategoriesPage(ctx, sort, limit, offset)
+	if err != nil {
+		return entities.Page[entities.Category]{}, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	total, err := uc.categoryRepo.CountCategories(ctx)
+	if err != nil {
+		return entities.Page[entities.Category]{}, fmt.Errorf("failed to count categories: %w", err)
+	}
+
+	return entities.Page[entities.Category]{Items: categories, Total: total, Limit: limit, Offset: offset}, nil
+}
+
 func (uc *CategoryUseCase) GetCategoriesByType(ctx context.Context, categoryType entities.CategoryType) ([]entities.Category, error) {
 	if categoryType == "" {
 		return nil, fmt.Errorf("category type cannot be empty")
@@ -82,13 +103,11 @@ func (uc *CategoryUseCase) UpdateCategory(ctx context.Context, category entities
 	}
 
 	// Check if category exists
-	existingCategory, err := uc.categoryRepo.GetCategoryByID(ctx, category.ID)
-	if err != nil {
+	if _, err := ownCategory(ctx, uc.categoryRepo, category.ID); err != nil {
 		return entities.Category{}, fmt.Errorf("failed to get existing category: %w", err)
 	}
-
-	if existingCategory.ID == "" {
-		return entities.Category{}, fmt.Errorf("category not found")
+	if err := uc.validateParent(ctx, category); err != nil {
+		return entities.Category{}, err
 	}
 
 	// Set default color if not provided
@@ -110,19 +129,14 @@ func (uc *CategoryUseCase) DeleteCategory(ctx context.Context, id string) error
 	}
 
 	// Check if category exists
-	category, err := uc.categoryRepo.GetCategoryByID(ctx, id)
-	if err != nil {
+	if _, err := ownCategory(ctx, uc.categoryRepo, id); err != nil {
 		return fmt.Errorf("failed to get category: %w", err)
 	}
 
-	if category.ID == "" {
-		return fmt.Errorf("category not found")
-	}
-
 	// TODO: Check if category is being used by transactions
 	// For now, we'll rely on the database constraint to prevent deletion
 
-	err = uc.categoryRepo.DeleteCategory(ctx, id)
+	err := uc.categoryRepo.DeleteCategory(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete category: %w", err)
 	}
@@ -139,21 +153,54 @@ func (uc *CategoryUseCase) validateCategory(category entities.Category) error {
 		return fmt.Errorf("category type cannot be empty")
 	}
 
-	validTypes := []entities.CategoryType{
-		entities.CategoryTypeIncome,
-		entities.CategoryTypeExpense,
+	if !slices.Contains(entities.CategoryTypes, category.Type) {
+		return fmt.Errorf("invalid category type: %s", category.Type)
 	}
 
-	isValidType := false
-	for _, validType := range validTypes {
-		if category.Type == validType {
-			isValidType = true
-			break
+	return nil
+}
+
+// validateParent checks a category is grouped under a category of the same
+// type at the top, and that a group keeps the type of its categories and
+// isn't put in another group, since groups are one level deep
+func (uc *CategoryUseCase) validateParent(ctx context.Context, category entities.Category) error {
+	var children []entities.Category
+	if category.ID != "" {
+		categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get categories: %w", err)
+		}
+		for _, child := range categories {
+			if child.ParentID == category.ID {
+				children = append(children, child)
+			}
+		}
+	}
+	for _, child := range children {
+		if child.Type != category.Type {
+			return fmt.Errorf("category type must stay %s while it groups %s: %w", child.Type, child.Name, domain.ErrMalformedParameters)
 		}
 	}
 
-	if !isValidType {
-		return fmt.Errorf("invalid category type: %s", category.Type)
+	if category.ParentID == "" {
+		return nil
+	}
+	if category.ParentID == category.ID {
+		return fmt.Errorf("category can't be its own parent: %w", domain.ErrMalformedParameters)
+	}
+	if len(children) > 0 {
+		return fmt.Errorf("category grouping other categories can't have a parent: %w", domain.ErrMalformedParameters)
+	}
+
+	parent, err := ownCategory(ctx, uc.categoryRepo, category.ParentID)
+	if err != nil {
+		return fmt.Errorf("failed to get parent category: %w", err)
+	}
+	if parent.ParentID != "" {
+		return fmt.Errorf("parent category %s is in a group itself: %w", parent.Name, domain.ErrMalformedParameters)
+	}
+	if parent.Type != category.Type {
+		return fmt.Errorf("parent category must be an %s category: %w", category.Type, domain.ErrMalformedParameters)
 	}
 
 	return nil
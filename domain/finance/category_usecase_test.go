@@ -0,0 +1,86 @@
+package finance
+
+import (
+	"context"
+	"testing"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryGroups(t *testing.T) {
+	food := entities.Category{ID: "cat-food", Name: "Food", Type: entities.CategoryTypeExpense}
+	groceries := entities.Category{ID: "cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense, ParentID: food.ID}
+	salary := entities.Category{ID: "cat-salary", Name: "Salary", Type: entities.CategoryTypeIncome}
+	categories := []entities.Category{food, groceries, salary}
+
+	categoryRepo := &mocks.CategoryRepositoryMock{
+		CreateCategoryFunc: func(ctx context.Context, category entities.Category) (entities.Category, error) {
+			category.ID = "cat-new"
+			return category, nil
+		},
+		GetCategoryByIDFunc: func(ctx context.Context, id string) (entities.Category, error) {
+			for _, category := range categories {
+				if category.ID == id {
+					return category, nil
+				}
+			}
+			return entities.Category{}, errNotFound("category")
+		},
+		GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+			return categories, nil
+		},
+		UpdateCategoryFunc: func(ctx context.Context, category entities.Category) (entities.Category, error) {
+			return category, nil
+		},
+	}
+	uc := NewCategoryUseCase(categoryRepo)
+	ctx := context.Background()
+
+	t.Run("create in a group", func(t *testing.T) {
+		created, err := uc.CreateCategory(ctx, entities.Category{Name: "Restaurants", Type: entities.CategoryTypeExpense, ParentID: food.ID})
+		require.NoError(t, err)
+		assert.Equal(t, food.ID, created.ParentID)
+	})
+
+	t.Run("invalid groups", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			category entities.Category
+		}{
+			{"parent of another type", entities.Category{Name: "Bonus", Type: entities.CategoryTypeIncome, ParentID: food.ID}},
+			{"parent in a group", entities.Category{Name: "Bakery", Type: entities.CategoryTypeExpense, ParentID: groceries.ID}},
+			{"its own parent", entities.Category{ID: salary.ID, Name: "Salary", Type: entities.CategoryTypeIncome, ParentID: salary.ID}},
+			{"group in a group", entities.Category{ID: food.ID, Name: "Food", Type: entities.CategoryTypeExpense, ParentID: salary.ID}},
+			{"group changing type", entities.Category{ID: food.ID, Name: "Food", Type: entities.CategoryTypeIncome}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var err error
+				if tt.category.ID == "" {
+					_, err = uc.CreateCategory(ctx, tt.category)
+				} else {
+					_, err = uc.UpdateCategory(ctx, tt.category)
+				}
+				assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+			})
+		}
+	})
+
+	t.Run("parent not found", func(t *testing.T) {
+		_, err := uc.CreateCategory(ctx, entities.Category{Name: "Pets", Type: entities.CategoryTypeExpense, ParentID: "cat-missing"})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("leave a group", func(t *testing.T) {
+		groceries := groceries
+		groceries.ParentID = ""
+		updated, err := uc.UpdateCategory(ctx, groceries)
+		require.NoError(t, err)
+		assert.Empty(t, updated.ParentID)
+	})
+}
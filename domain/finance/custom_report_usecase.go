@@ -0,0 +1,475 @@
+package finance
+
+import (
+	"cmp"
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CustomReportUseCase manages the reports the users build themselves and runs
+// them over the transactions of the book
+type CustomReportUseCase struct {
+	definitionRepo  ReportDefinitionRepository
+	transactionRepo TransactionRepository
+	accountRepo     AccountRepository
+	categoryRepo    CategoryRepository
+	now             func() time.Time
+}
+
+func NewCustomReportUseCase(definitionRepo ReportDefinitionRepository, transactionRepo TransactionRepository, accountRepo AccountRepository, categoryRepo CategoryRepository) *CustomReportUseCase {
+	return &CustomReportUseCase{
+		definitionRepo:  definitionRepo,
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		categoryRepo:    categoryRepo,
+		now:             time.Now,
+	}
+}
+
+func (uc *CustomReportUseCase) CreateReportDefinition(ctx context.Context, definition entities.ReportDefinition) (entities.ReportDefinition, error) {
+	definition, err := validateReportDefinition(definition)
+	if err != nil {
+		return entities.ReportDefinition{}, err
+	}
+
+	created, err := uc.definitionRepo.CreateReportDefinition(ctx, definition)
+	if err != nil {
+		return entities.ReportDefinition{}, fmt.Errorf("failed to create report definition: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetReportDefinitions returns the report definitions of the book ordered by
+// name
+func (uc *CustomReportUseCase) GetReportDefinitions(ctx context.Context) ([]entities.ReportDefinition, error) {
+	definitions, err := uc.definitionRepo.GetAllReportDefinitions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report definitions: %w", err)
+	}
+
+	return definitions, nil
+}
+
+func (uc *CustomReportUseCase) GetReportDefinition(ctx context.Context, id string) (entities.ReportDefinition, error) {
+	if id == "" {
+		return entities.ReportDefinition{}, fmt.Errorf("report definition ID cannot be empty")
+	}
+
+	definition, err := uc.definitionRepo.GetReportDefinitionByID(ctx, id)
+	if err != nil {
+		return entities.ReportDefinition{}, fmt.Errorf("failed to get report definition: %w", err)
+	}
+
+	return definition, nil
+}
+
+func (uc *CustomReportUseCase) UpdateReportDefinition(ctx context.Context, definition entities.ReportDefinition) (entities.ReportDefinition, error) {
+	if definition.ID == "" {
+		return entities.ReportDefinition{}, fmt.Errorf("report definition ID cannot be empty")
+	}
+
+	definition, err := validateReportDefinition(definition)
+	if err != nil {
+		return entities.ReportDefinition{}, err
+	}
+
+	updated, err := uc.definitionRepo.UpdateReportDefinition(ctx, definition)
+	if err != nil {
+		return entities.ReportDefinition{}, fmt.Errorf("failed to update report definition: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *CustomReportUseCase) DeleteReportDefinition(ctx context.Context, id string) error {
+	if _, err := uc.GetReportDefinition(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.definitionRepo.DeleteReportDefinition(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete report definition: %w", err)
+	}
+
+	return nil
+}
+
+// RunReport runs a saved report definition. from and to, when set, take the
+// place of the dates of its filter.
+func (uc *CustomReportUseCase) RunReport(ctx context.Context, id string, from, to time.Time) (entities.CustomReport, error) {
+	definition, err := uc.GetReportDefinition(ctx, id)
+	if err != nil {
+		return entities.CustomReport{}, err
+	}
+
+	if !from.IsZero() {
+		definition.Filter.From = from
+	}
+	if !to.IsZero() {
+		definition.Filter.To = to
+	}
+	return uc.RunReportDefinition(ctx, definition)
+}
+
+// RunReportDefinition groups the pending and cleared transactions matching
+// the filter of definition by its dimensions and adds them up, without
+// saving it. Drafts and cancelled transactions are left out, like in the
+// other reports.
+func (uc *CustomReportUseCase) RunReportDefinition(ctx context.Context, definition entities.ReportDefinition) (entities.CustomReport, error) {
+	definition, err := normalizeReportDefinition(definition)
+	if err != nil {
+		return entities.CustomReport{}, err
+	}
+
+	from, to := definition.Filter.From, definition.Filter.To
+	if to.IsZero() {
+		now := uc.now()
+		to = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	if to.Before(from) {
+		return entities.CustomReport{}, fmt.Errorf("report ends before it starts: %w", domain.ErrMalformedParameters)
+	}
+
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return entities.CustomReport{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	accountByID := make(map[string]entities.Account, len(accounts))
+	for _, account := range accounts {
+		accountByID[account.ID] = account
+	}
+
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return entities.CustomReport{}, fmt.Errorf("failed to get categories: %w", err)
+	}
+	categoryByID := make(map[string]entities.Category, len(categories))
+	for _, category := range categories {
+		categoryByID[category.ID] = category
+	}
+
+	transactions, err := uc.transactionRepo.GetTransactionsByDateRange(ctx, from, to)
+	if err != nil {
+		return entities.CustomReport{}, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	type group struct {
+		keys  []entities.ReportKey
+		count int
+		sum   entities.Money
+	}
+	groups := map[string]*group{}
+	var order []string
+	for _, transaction := range transactions {
+		category := categoryByID[transaction.CategoryID]
+		if !inFatura(transaction) || !matchesReportFilter(definition.Filter, transaction, category) {
+			continue
+		}
+
+		// Amounts are signed by their effect on the account, so take their
+		// size and sign it by the type of the category
+		amount := entities.MoneyOf(transaction.Monetary).Abs()
+		if category.Type == entities.CategoryTypeExpense {
+			amount = amount.Neg()
+		}
+
+		keys := make([]entities.ReportKey, len(definition.Dimensions))
+		id := make([]string, 0, len(keys)+1)
+		for i, dimension := range definition.Dimensions {
+			keys[i] = reportKey(dimension, transaction, accountByID, category)
+			id = append(id, keys[i].Value)
+		}
+		id = append(id, amount.Asset.Asset)
+
+		key := strings.Join(id, "\x00")
+		g, ok := groups[key]
+		if !ok {
+			g = &group{keys: keys, sum: entities.NewMoney(amount.Asset, 0)}
+			groups[key] = g
+			order = append(order, key)
+		}
+		g.count++
+		if g.sum, err = g.sum.Add(amount); err != nil {
+			return entities.CustomReport{}, fmt.Errorf("failed to add up transactions: %w", err)
+		}
+	}
+
+	report := entities.CustomReport{
+		Definition: definition,
+		From:       from,
+		To:         to,
+		Rows:       make([]entities.CustomReportRow, len(order)),
+	}
+	for i, key := range order {
+		g := groups[key]
+		sum := g.sum.Monetary()
+		average := math.Round(float64(sum.Amount.Int64()) / float64(g.count))
+		report.Rows[i] = entities.CustomReportRow{
+			Keys:    g.keys,
+			Count:   g.count,
+			Sum:     sum,
+			Average: entities.NewMoney(sum.Asset, int64(average)).Monetary(),
+		}
+	}
+	slices.SortStableFunc(report.Rows, compareReportRows)
+
+	return report, nil
+}
+
+// RunCube pivots the pending and cleared transactions of the book by the
+// dimensions of query, with a table per asset in the order of their codes.
+// The rows and columns are ordered like the rows of custom reports, and the
+// measure defaults to the sum.
+func (uc *CustomReportUseCase) RunCube(ctx context.Context, query entities.CubeQuery) (entities.Cube, error) {
+	if !slices.Contains(entities.ReportDimensions, query.Rows) {
+		return entities.Cube{}, fmt.Errorf("invalid cube rows: %s: %w", query.Rows, domain.ErrMalformedParameters)
+	}
+	if !slices.Contains(entities.ReportDimensions, query.Cols) {
+		return entities.Cube{}, fmt.Errorf("invalid cube columns: %s: %w", query.Cols, domain.ErrMalformedParameters)
+	}
+	if query.Rows == query.Cols {
+		return entities.Cube{}, fmt.Errorf("cube rows and columns must be different dimensions: %w", domain.ErrMalformedParameters)
+	}
+	if query.Measure == "" {
+		query.Measure = entities.ReportMeasureSum
+	}
+	if !slices.Contains(entities.ReportMeasures, query.Measure) {
+		return entities.Cube{}, fmt.Errorf("invalid cube measure: %s: %w", query.Measure, domain.ErrMalformedParameters)
+	}
+	if query.To.IsZero() {
+		now := uc.now()
+		query.To = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	if query.To.Before(query.From) {
+		return entities.Cube{}, fmt.Errorf("cube ends before it starts: %w", domain.ErrMalformedParameters)
+	}
+
+	rowLabels, err := uc.cubeLabels(ctx, query.Rows)
+	if err != nil {
+		return entities.Cube{}, err
+	}
+	colLabels, err := uc.cubeLabels(ctx, query.Cols)
+	if err != nil {
+		return entities.Cube{}, err
+	}
+
+	groups, err := uc.transactionRepo.GetTransactionCube(ctx, query.Rows, query.Cols, query.From, query.To)
+	if err != nil {
+		return entities.Cube{}, fmt.Errorf("failed to get cube: %w", err)
+	}
+
+	type axisEntry struct {
+		key   entities.ReportKey
+		total entities.CubeTotal
+	}
+	type table struct {
+		cube       entities.CubeTable
+		rows, cols []axisEntry
+		cells      []entities.CubeGroup
+	}
+	tables := map[string]*table{}
+	for _, group := range groups {
+		t, ok := tables[group.Sum.Asset.Asset]
+		if !ok {
+			t = &table{cube: entities.CubeTable{Asset: group.Sum.Asset}}
+			tables[group.Sum.Asset.Asset] = t
+		}
+		switch {
+		case group.AllRows && group.AllCols:
+			t.cube.Total = cubeTotal(group)
+		case group.AllCols:
+			t.rows = append(t.rows, axisEntry{cubeKey(query.Rows, group.Row, rowLabels), cubeTotal(group)})
+		case group.AllRows:
+			t.cols = append(t.cols, axisEntry{cubeKey(query.Cols, group.Col, colLabels), cubeTotal(group)})
+		default:
+			t.cells = append(t.cells, group)
+		}
+	}
+
+	// The keys are ordered like the rows of custom reports: months in
+	// calendar order and the others by name
+	compareEntries := func(a, b axisEntry) int {
+		return cmp.Or(cmp.Compare(a.key.Label, b.key.Label), cmp.Compare(a.key.Value, b.key.Value))
+	}
+
+	cube := entities.Cube{Query: query, Tables: make([]entities.CubeTable, 0, len(tables))}
+	for _, code := range slices.Sorted(maps.Keys(tables)) {
+		t := tables[code]
+		slices.SortFunc(t.rows, compareEntries)
+		slices.SortFunc(t.cols, compareEntries)
+
+		rowIndex := make(map[string]int, len(t.rows))
+		for i, entry := range t.rows {
+			t.cube.Rows = append(t.cube.Rows, entry.key)
+			t.cube.RowTotals = append(t.cube.RowTotals, entry.total)
+			rowIndex[entry.key.Value] = i
+		}
+		colIndex := make(map[string]int, len(t.cols))
+		for i, entry := range t.cols {
+			t.cube.Cols = append(t.cube.Cols, entry.key)
+			t.cube.ColTotals = append(t.cube.ColTotals, entry.total)
+			colIndex[entry.key.Value] = i
+		}
+
+		t.cube.Cells = make([][]*entities.CubeTotal, len(t.rows))
+		for i := range t.cube.Cells {
+			t.cube.Cells[i] = make([]*entities.CubeTotal, len(t.cols))
+		}
+		for _, group := range t.cells {
+			total := cubeTotal(group)
+			t.cube.Cells[rowIndex[group.Row]][colIndex[group.Col]] = &total
+		}
+
+		cube.Tables = append(cube.Tables, t.cube)
+	}
+
+	return cube, nil
+}
+
+// cubeLabels maps the IDs of the categories or accounts to their names when
+// a cube is pivoted by them. The other dimensions are labeled by their value.
+func (uc *CustomReportUseCase) cubeLabels(ctx context.Context, dimension entities.ReportDimension) (map[string]string, error) {
+	labels := map[string]string{}
+	switch dimension {
+	case entities.ReportDimensionCategory:
+		categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get categories: %w", err)
+		}
+		for _, category := range categories {
+			labels[category.ID] = category.Name
+		}
+	case entities.ReportDimensionAccount:
+		accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get accounts: %w", err)
+		}
+		for _, account := range accounts {
+			labels[account.ID] = account.Name
+		}
+	}
+	return labels, nil
+}
+
+func cubeKey(dimension entities.ReportDimension, value string, labels map[string]string) entities.ReportKey {
+	label, ok := labels[value]
+	if !ok {
+		label = value
+	}
+	return entities.ReportKey{Dimension: dimension, Value: value, Label: label}
+}
+
+func cubeTotal(group entities.CubeGroup) entities.CubeTotal {
+	average := math.Round(float64(group.Sum.Amount.Int64()) / float64(group.Count))
+	return entities.CubeTotal{
+		Count:   group.Count,
+		Sum:     group.Sum,
+		Average: entities.NewMoney(group.Sum.Asset, int64(average)).Monetary(),
+	}
+}
+
+// matchesReportFilter tells whether a transaction, in category, is one of
+// those filter narrows the report down to
+func matchesReportFilter(filter entities.ReportFilter, transaction entities.Transaction, category entities.Category) bool {
+	if len(filter.AccountIDs) > 0 && !slices.Contains(filter.AccountIDs, transaction.AccountID) {
+		return false
+	}
+	if len(filter.CategoryIDs) > 0 && !slices.Contains(filter.CategoryIDs, transaction.CategoryID) {
+		return false
+	}
+	if filter.CategoryType != "" && category.Type != filter.CategoryType {
+		return false
+	}
+	if len(filter.Payees) > 0 && !slices.ContainsFunc(filter.Payees, func(payee string) bool {
+		return strings.EqualFold(payee, transaction.Payee)
+	}) {
+		return false
+	}
+	return true
+}
+
+// reportKey is the value of dimension for a transaction in category
+func reportKey(dimension entities.ReportDimension, transaction entities.Transaction, accountByID map[string]entities.Account, category entities.Category) entities.ReportKey {
+	key := entities.ReportKey{Dimension: dimension}
+	switch dimension {
+	case entities.ReportDimensionCategory:
+		key.Value, key.Label = transaction.CategoryID, category.Name
+	case entities.ReportDimensionAccount:
+		key.Value, key.Label = transaction.AccountID, accountByID[transaction.AccountID].Name
+	case entities.ReportDimensionPayee:
+		key.Value, key.Label = transaction.Payee, transaction.Payee
+	case entities.ReportDimensionMonth:
+		key.Value = transaction.Date.Format(faturaMonthLayout)
+		key.Label = key.Value
+	}
+	return key
+}
+
+// compareReportRows orders the rows by their keys, months in calendar order
+// and the others by name, then by asset
+func compareReportRows(a, b entities.CustomReportRow) int {
+	for i := range a.Keys {
+		if c := cmp.Or(cmp.Compare(a.Keys[i].Label, b.Keys[i].Label), cmp.Compare(a.Keys[i].Value, b.Keys[i].Value)); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(a.Sum.Asset.Asset, b.Sum.Asset.Asset)
+}
+
+func validateReportDefinition(definition entities.ReportDefinition) (entities.ReportDefinition, error) {
+	definition.Name = strings.TrimSpace(definition.Name)
+	if definition.Name == "" {
+		return entities.ReportDefinition{}, fmt.Errorf("report name cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	return normalizeReportDefinition(definition)
+}
+
+// normalizeReportDefinition checks the dimensions, measures and filter of a
+// definition, trimming the payees it filters on
+func normalizeReportDefinition(definition entities.ReportDefinition) (entities.ReportDefinition, error) {
+	for i, dimension := range definition.Dimensions {
+		if !slices.Contains(entities.ReportDimensions, dimension) {
+			return entities.ReportDefinition{}, fmt.Errorf("invalid report dimension: %s: %w", dimension, domain.ErrMalformedParameters)
+		}
+		if slices.Contains(definition.Dimensions[:i], dimension) {
+			return entities.ReportDefinition{}, fmt.Errorf("report dimension %s is repeated: %w", dimension, domain.ErrMalformedParameters)
+		}
+	}
+
+	if len(definition.Measures) == 0 {
+		return entities.ReportDefinition{}, fmt.Errorf("report needs at least one measure: %w", domain.ErrMalformedParameters)
+	}
+	for i, measure := range definition.Measures {
+		if !slices.Contains(entities.ReportMeasures, measure) {
+			return entities.ReportDefinition{}, fmt.Errorf("invalid report measure: %s: %w", measure, domain.ErrMalformedParameters)
+		}
+		if slices.Contains(definition.Measures[:i], measure) {
+			return entities.ReportDefinition{}, fmt.Errorf("report measure %s is repeated: %w", measure, domain.ErrMalformedParameters)
+		}
+	}
+
+	filter := definition.Filter
+	if filter.CategoryType != "" && !slices.Contains(entities.CategoryTypes, filter.CategoryType) {
+		return entities.ReportDefinition{}, fmt.Errorf("invalid category type: %s: %w", filter.CategoryType, domain.ErrMalformedParameters)
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		return entities.ReportDefinition{}, fmt.Errorf("report ends before it starts: %w", domain.ErrMalformedParameters)
+	}
+
+	var payees []string
+	for _, payee := range filter.Payees {
+		if payee = strings.TrimSpace(payee); payee != "" {
+			payees = append(payees, payee)
+		}
+	}
+	definition.Filter.Payees = payees
+
+	return definition, nil
+}
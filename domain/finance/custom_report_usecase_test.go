@@ -0,0 +1,309 @@
+package finance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunReportDefinition(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2025, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	transaction := func(accountID, categoryID, payee string, amount int64, date time.Time, status entities.TransactionStatus) entities.Transaction {
+		asset := monetary.BRL
+		if accountID == "acc-usd" {
+			asset = monetary.USD
+		}
+		return entities.Transaction{
+			AccountID:  accountID,
+			CategoryID: categoryID,
+			Payee:      payee,
+			Monetary:   testMonetary(t, asset, amount),
+			Date:       date,
+			Status:     status,
+		}
+	}
+	transactions := []entities.Transaction{
+		transaction("acc-1", "cat-salary", "ACME", 500000, date(time.March, 5), entities.TransactionStatusCleared),
+		transaction("acc-1", "cat-groceries", "Market", -10000, date(time.March, 10), entities.TransactionStatusCleared),
+		transaction("acc-1", "cat-groceries", "market", -5001, date(time.March, 20), entities.TransactionStatusPending),
+		// Card purchases are positive, they're still expenses
+		transaction("acc-card", "cat-groceries", "Market", 3000, date(time.April, 2), entities.TransactionStatusCleared),
+		transaction("acc-usd", "cat-groceries", "Market", -2000, date(time.April, 3), entities.TransactionStatusCleared),
+		// Drafts and cancelled transactions are left out
+		transaction("acc-1", "cat-groceries", "Market", -99900, date(time.April, 4), entities.TransactionStatusDraft),
+		transaction("acc-1", "cat-groceries", "Market", -99900, date(time.April, 5), entities.TransactionStatusCancelled),
+	}
+
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionsByDateRangeFunc: func(ctx context.Context, startDate, endDate time.Time) ([]entities.Transaction, error) {
+			var result []entities.Transaction
+			for _, transaction := range transactions {
+				if !transaction.Date.Before(startDate) && !transaction.Date.After(endDate) {
+					result = append(result, transaction)
+				}
+			}
+			return result, nil
+		},
+	}
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+			return []entities.Account{
+				{ID: "acc-1", Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL},
+				{ID: "acc-card", Name: "Nubank", Type: entities.AccountTypeCredit, Asset: monetary.BRL},
+				{ID: "acc-usd", Name: "Wise", Type: entities.AccountTypeChecking, Asset: monetary.USD},
+			}, nil
+		},
+	}
+	categoryRepo := &mocks.CategoryRepositoryMock{
+		GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+			return []entities.Category{
+				{ID: "cat-salary", Name: "Salary", Type: entities.CategoryTypeIncome},
+				{ID: "cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense},
+			}, nil
+		},
+	}
+	definitionRepo := &mocks.ReportDefinitionRepositoryMock{
+		GetReportDefinitionByIDFunc: func(ctx context.Context, id string) (entities.ReportDefinition, error) {
+			return entities.ReportDefinition{
+				ID:         id,
+				Name:       "Groceries by month",
+				Dimensions: []entities.ReportDimension{entities.ReportDimensionMonth},
+				Measures:   []entities.ReportMeasure{entities.ReportMeasureSum},
+				Filter:     entities.ReportFilter{CategoryIDs: []string{"cat-groceries"}, From: date(time.January, 1)},
+			}, nil
+		},
+	}
+	uc := NewCustomReportUseCase(definitionRepo, transactionRepo, accountRepo, categoryRepo)
+	uc.now = func() time.Time { return time.Date(2025, time.April, 30, 15, 0, 0, 0, time.UTC) }
+	ctx := context.Background()
+
+	type row struct {
+		keys    []string
+		count   int
+		sum     string
+		average string
+	}
+	rows := func(report entities.CustomReport) []row {
+		result := make([]row, len(report.Rows))
+		for i, r := range report.Rows {
+			for _, key := range r.Keys {
+				result[i].keys = append(result[i].keys, key.Label)
+			}
+			result[i].count = r.Count
+			result[i].sum = r.Sum.String()
+			result[i].average = r.Average.String()
+		}
+		return result
+	}
+	brl := func(amount int64) string { return testMonetary(t, monetary.BRL, amount).String() }
+	usd := func(amount int64) string { return testMonetary(t, monetary.USD, amount).String() }
+
+	t.Run("grouped by category and month", func(t *testing.T) {
+		report, err := uc.RunReportDefinition(ctx, entities.ReportDefinition{
+			Dimensions: []entities.ReportDimension{entities.ReportDimensionCategory, entities.ReportDimensionMonth},
+			Measures:   []entities.ReportMeasure{entities.ReportMeasureSum, entities.ReportMeasureCount, entities.ReportMeasureAvg},
+		})
+		require.NoError(t, err)
+		assert.True(t, report.From.IsZero())
+		assert.Equal(t, date(time.April, 30), report.To)
+		assert.Equal(t, []row{
+			{[]string{"Groceries", "2025-03"}, 2, brl(-15001), brl(-7501)},
+			{[]string{"Groceries", "2025-04"}, 1, brl(-3000), brl(-3000)},
+			{[]string{"Groceries", "2025-04"}, 1, usd(-2000), usd(-2000)},
+			{[]string{"Salary", "2025-03"}, 1, brl(500000), brl(500000)},
+		}, rows(report))
+	})
+
+	t.Run("without dimensions it's the cash flow", func(t *testing.T) {
+		report, err := uc.RunReportDefinition(ctx, entities.ReportDefinition{
+			Measures: []entities.ReportMeasure{entities.ReportMeasureSum},
+			Filter:   entities.ReportFilter{AccountIDs: []string{"acc-1", "acc-card"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []row{{nil, 4, brl(481999), brl(120500)}}, rows(report))
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		report, err := uc.RunReportDefinition(ctx, entities.ReportDefinition{
+			Dimensions: []entities.ReportDimension{entities.ReportDimensionAccount},
+			Measures:   []entities.ReportMeasure{entities.ReportMeasureCount},
+			Filter: entities.ReportFilter{
+				From:         date(time.March, 15),
+				CategoryType: entities.CategoryTypeExpense,
+				Payees:       []string{" MARKET "},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []row{
+			{[]string{"Checking"}, 1, brl(-5001), brl(-5001)},
+			{[]string{"Nubank"}, 1, brl(-3000), brl(-3000)},
+			{[]string{"Wise"}, 1, usd(-2000), usd(-2000)},
+		}, rows(report))
+		assert.Equal(t, []string{"MARKET"}, report.Definition.Filter.Payees)
+	})
+
+	t.Run("saved definition with other dates", func(t *testing.T) {
+		report, err := uc.RunReport(ctx, "rep-1", time.Time{}, date(time.March, 31))
+		require.NoError(t, err)
+		assert.Equal(t, "Groceries by month", report.Definition.Name)
+		assert.Equal(t, date(time.January, 1), report.From)
+		assert.Equal(t, []row{{[]string{"2025-03"}, 2, brl(-15001), brl(-7501)}}, rows(report))
+	})
+
+	t.Run("ends before it starts", func(t *testing.T) {
+		_, err := uc.RunReportDefinition(ctx, entities.ReportDefinition{
+			Measures: []entities.ReportMeasure{entities.ReportMeasureSum},
+			Filter:   entities.ReportFilter{From: date(time.May, 1)},
+		})
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+}
+
+func TestReportDefinitionValidation(t *testing.T) {
+	definitionRepo := &mocks.ReportDefinitionRepositoryMock{
+		CreateReportDefinitionFunc: func(ctx context.Context, definition entities.ReportDefinition) (entities.ReportDefinition, error) {
+			definition.ID = "rep-new"
+			return definition, nil
+		},
+	}
+	uc := NewCustomReportUseCase(definitionRepo, nil, nil, nil)
+	ctx := context.Background()
+
+	sum := []entities.ReportMeasure{entities.ReportMeasureSum}
+	created, err := uc.CreateReportDefinition(ctx, entities.ReportDefinition{Name: " Payees ", Dimensions: []entities.ReportDimension{entities.ReportDimensionPayee}, Measures: sum})
+	require.NoError(t, err)
+	assert.Equal(t, "Payees", created.Name)
+
+	tests := []struct {
+		name       string
+		definition entities.ReportDefinition
+		wantErr    string
+	}{
+		{"no name", entities.ReportDefinition{Measures: sum}, "report name cannot be empty"},
+		{"no measure", entities.ReportDefinition{Name: "r"}, "report needs at least one measure"},
+		{"invalid measure", entities.ReportDefinition{Name: "r", Measures: []entities.ReportMeasure{"max"}}, "invalid report measure: max"},
+		{"repeated measure", entities.ReportDefinition{Name: "r", Measures: []entities.ReportMeasure{"sum", "sum"}}, "report measure sum is repeated"},
+		{"invalid dimension", entities.ReportDefinition{Name: "r", Measures: sum, Dimensions: []entities.ReportDimension{"week"}}, "invalid report dimension: week"},
+		{"repeated dimension", entities.ReportDefinition{Name: "r", Measures: sum, Dimensions: []entities.ReportDimension{"month", "payee", "month"}}, "report dimension month is repeated"},
+		{"invalid category type", entities.ReportDefinition{Name: "r", Measures: sum, Filter: entities.ReportFilter{CategoryType: "transfer"}}, "invalid category type: transfer"},
+		{"ends before it starts", entities.ReportDefinition{Name: "r", Measures: sum, Filter: entities.ReportFilter{
+			From: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC),
+			To:   time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC),
+		}}, "report ends before it starts"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.CreateReportDefinition(ctx, tt.definition)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+	assert.Len(t, definitionRepo.CreateReportDefinitionCalls(), 1)
+}
+
+func TestRunCube(t *testing.T) {
+	brl := func(amount int64) monetary.Monetary { return testMonetary(t, monetary.BRL, amount) }
+	usd := func(amount int64) monetary.Monetary { return testMonetary(t, monetary.USD, amount) }
+
+	var gotFrom, gotTo time.Time
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionCubeFunc: func(ctx context.Context, rows, cols entities.ReportDimension, startDate, endDate time.Time) ([]entities.CubeGroup, error) {
+			gotFrom, gotTo = startDate, endDate
+			// Groceries and Salary down, March and April across
+			return []entities.CubeGroup{
+				{Row: "cat-salary", Col: "2025-03", Count: 1, Sum: brl(500000)},
+				{Row: "cat-groceries", Col: "2025-03", Count: 2, Sum: brl(-15001)},
+				{Row: "cat-groceries", Col: "2025-04", Count: 1, Sum: brl(-3000)},
+				{Row: "cat-salary", AllCols: true, Count: 1, Sum: brl(500000)},
+				{Row: "cat-groceries", AllCols: true, Count: 3, Sum: brl(-18001)},
+				{Col: "2025-04", AllRows: true, Count: 1, Sum: brl(-3000)},
+				{Col: "2025-03", AllRows: true, Count: 3, Sum: brl(484999)},
+				{AllRows: true, AllCols: true, Count: 4, Sum: brl(481999)},
+				{Row: "cat-groceries", Col: "2025-04", Count: 1, Sum: usd(-2000)},
+				{Row: "cat-groceries", AllCols: true, Count: 1, Sum: usd(-2000)},
+				{Col: "2025-04", AllRows: true, Count: 1, Sum: usd(-2000)},
+				{AllRows: true, AllCols: true, Count: 1, Sum: usd(-2000)},
+			}, nil
+		},
+	}
+	categoryRepo := &mocks.CategoryRepositoryMock{
+		GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+			return []entities.Category{
+				{ID: "cat-salary", Name: "Salary", Type: entities.CategoryTypeIncome},
+				{ID: "cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense},
+			}, nil
+		},
+	}
+	uc := NewCustomReportUseCase(nil, transactionRepo, nil, categoryRepo)
+	uc.now = func() time.Time { return time.Date(2025, time.April, 30, 15, 0, 0, 0, time.UTC) }
+	ctx := context.Background()
+
+	from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	cube, err := uc.RunCube(ctx, entities.CubeQuery{Rows: entities.ReportDimensionCategory, Cols: entities.ReportDimensionMonth, From: from})
+	require.NoError(t, err)
+	assert.Equal(t, from, gotFrom)
+	assert.Equal(t, time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC), gotTo)
+	assert.Equal(t, entities.ReportMeasureSum, cube.Query.Measure)
+	require.Len(t, cube.Tables, 2)
+
+	table := cube.Tables[0]
+	assert.Equal(t, monetary.BRL, table.Asset)
+	assert.Equal(t, []entities.ReportKey{
+		{Dimension: entities.ReportDimensionCategory, Value: "cat-groceries", Label: "Groceries"},
+		{Dimension: entities.ReportDimensionCategory, Value: "cat-salary", Label: "Salary"},
+	}, table.Rows)
+	assert.Equal(t, []entities.ReportKey{
+		{Dimension: entities.ReportDimensionMonth, Value: "2025-03", Label: "2025-03"},
+		{Dimension: entities.ReportDimensionMonth, Value: "2025-04", Label: "2025-04"},
+	}, table.Cols)
+	assert.Equal(t, [][]*entities.CubeTotal{
+		{{Count: 2, Sum: brl(-15001), Average: brl(-7501)}, {Count: 1, Sum: brl(-3000), Average: brl(-3000)}},
+		{{Count: 1, Sum: brl(500000), Average: brl(500000)}, nil},
+	}, table.Cells)
+	assert.Equal(t, []entities.CubeTotal{
+		{Count: 3, Sum: brl(-18001), Average: brl(-6000)},
+		{Count: 1, Sum: brl(500000), Average: brl(500000)},
+	}, table.RowTotals)
+	assert.Equal(t, []entities.CubeTotal{
+		{Count: 3, Sum: brl(484999), Average: brl(161666)},
+		{Count: 1, Sum: brl(-3000), Average: brl(-3000)},
+	}, table.ColTotals)
+	assert.Equal(t, entities.CubeTotal{Count: 4, Sum: brl(481999), Average: brl(120500)}, table.Total)
+
+	table = cube.Tables[1]
+	assert.Equal(t, monetary.USD, table.Asset)
+	assert.Len(t, table.Rows, 1)
+	assert.Equal(t, [][]*entities.CubeTotal{{{Count: 1, Sum: usd(-2000), Average: usd(-2000)}}}, table.Cells)
+
+	t.Run("validation", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			query   entities.CubeQuery
+			wantErr string
+		}{
+			{"invalid rows", entities.CubeQuery{Rows: "week", Cols: "month"}, "invalid cube rows: week"},
+			{"invalid columns", entities.CubeQuery{Rows: "category"}, "invalid cube columns"},
+			{"same dimension", entities.CubeQuery{Rows: "payee", Cols: "payee"}, "must be different dimensions"},
+			{"invalid measure", entities.CubeQuery{Rows: "category", Cols: "month", Measure: "max"}, "invalid cube measure: max"},
+			{"ends before it starts", entities.CubeQuery{Rows: "category", Cols: "month", From: time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)}, "cube ends before it starts"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := uc.RunCube(ctx, tt.query)
+				assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+				assert.ErrorContains(t, err, tt.wantErr)
+			})
+		}
+		assert.Len(t, transactionRepo.GetTransactionCubeCalls(), 1)
+	})
+}
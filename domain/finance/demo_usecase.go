@@ -0,0 +1,274 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"math/big"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// demoSeed keeps the sample dataset the same on every run
+const demoSeed = 2024
+
+// demoDays is how far back the sample transactions go
+const demoDays = 90
+
+var demoAccounts = []entities.Account{
+	{Name: "Demo Checking", Type: entities.AccountTypeChecking, Description: "Sample salary account"},
+	{Name: "Demo Savings", Type: entities.AccountTypeSavings, Description: "Sample savings account"},
+	{Name: "Demo Credit Card", Type: entities.AccountTypeCredit, Description: "Sample credit card"},
+}
+
+// demoEntry is a recurring sample transaction, booked every `every` days starting
+// `offset` days into the period on demoAccounts[account], for an amount in cents
+// picked between min and max
+type demoEntry struct {
+	account      int
+	category     string
+	categoryType entities.CategoryType
+	description  string
+	every        int
+	offset       int
+	min, max     int64
+}
+
+// demoEntries use the categories seeded by the first migration, falling back to
+// any category of the same type when one was removed
+var demoEntries = []demoEntry{
+	{account: 0, category: "Salary", categoryType: entities.CategoryTypeIncome, description: "Monthly salary", every: 30, offset: 4, min: 650000, max: 650000},
+	{account: 0, category: "Housing", categoryType: entities.CategoryTypeExpense, description: "Rent", every: 30, offset: 6, min: 180000, max: 180000},
+	{account: 0, category: "Groceries", categoryType: entities.CategoryTypeExpense, description: "Supermarket", every: 7, offset: 1, min: 15000, max: 45000},
+	{account: 0, category: "Credit Card", categoryType: entities.CategoryTypeExpense, description: "Credit card bill", every: 30, offset: 12, min: 60000, max: 90000},
+	{account: 1, category: "Investment", categoryType: entities.CategoryTypeIncome, description: "Savings yield", every: 30, offset: 28, min: 4000, max: 9000},
+	{account: 2, category: "Dining Out", categoryType: entities.CategoryTypeExpense, description: "Restaurant", every: 5, offset: 2, min: 4000, max: 15000},
+	{account: 2, category: "Transportation", categoryType: entities.CategoryTypeExpense, description: "Ride share", every: 4, offset: 0, min: 1500, max: 6000},
+	{account: 2, category: "Entertainment", categoryType: entities.CategoryTypeExpense, description: "Streaming and movies", every: 15, offset: 3, min: 3000, max: 9000},
+}
+
+// DemoUseCase loads a sample dataset so new users can explore the app before
+// entering their own finances, and removes it again
+type DemoUseCase struct {
+	accountRepo      AccountRepository
+	categoryRepo     CategoryRepository
+	transactionRepo  TransactionRepository
+	balanceRepo      BalanceRepository
+	userSettingsRepo UserSettingsRepository
+	now              func() time.Time
+}
+
+func NewDemoUseCase(accountRepo AccountRepository, categoryRepo CategoryRepository, transactionRepo TransactionRepository, balanceRepo BalanceRepository, userSettingsRepo UserSettingsRepository) *DemoUseCase {
+	return &DemoUseCase{
+		accountRepo:      accountRepo,
+		categoryRepo:     categoryRepo,
+		transactionRepo:  transactionRepo,
+		balanceRepo:      balanceRepo,
+		userSettingsRepo: userSettingsRepo,
+		now:              time.Now,
+	}
+}
+
+// GetDemoStatus tells whether the sample dataset is loaded
+func (uc *DemoUseCase) GetDemoStatus(ctx context.Context) (entities.DemoStatus, error) {
+	settings, err := uc.userSettingsRepo.GetAllUserSettings(ctx)
+	if err != nil {
+		return entities.DemoStatus{}, fmt.Errorf("failed to get user settings: %w", err)
+	}
+
+	for _, setting := range settings {
+		if setting.Key == entities.UserSettingDemoAccounts && setting.Value != "" {
+			return entities.DemoStatus{Active: true, AccountIDs: strings.Split(setting.Value, ",")}, nil
+		}
+	}
+
+	return entities.DemoStatus{}, nil
+}
+
+// StartDemo creates the sample accounts and about three months of transactions in
+// the base currency. It is only offered before any account exists, so the sample
+// data never mixes with real finances.
+func (uc *DemoUseCase) StartDemo(ctx context.Context) (entities.DemoStatus, error) {
+	status, err := uc.GetDemoStatus(ctx)
+	if err != nil {
+		return entities.DemoStatus{}, err
+	}
+	if status.Active {
+		return entities.DemoStatus{}, fmt.Errorf("demo data is already loaded: %w", domain.ErrConflict)
+	}
+
+	existing, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return entities.DemoStatus{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	if len(existing) > 0 {
+		return entities.DemoStatus{}, fmt.Errorf("demo data can only be loaded before any account exists: %w", domain.ErrConflict)
+	}
+
+	asset, err := uc.baseCurrency(ctx)
+	if err != nil {
+		return entities.DemoStatus{}, err
+	}
+
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return entities.DemoStatus{}, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	accounts := make([]entities.Account, 0, len(demoAccounts))
+	accountIDs := make([]string, 0, len(demoAccounts))
+	for _, account := range demoAccounts {
+		account.Asset = asset
+		created, err := uc.accountRepo.CreateAccount(ctx, account)
+		if err != nil {
+			uc.deleteAccounts(ctx, accountIDs)
+			return entities.DemoStatus{}, fmt.Errorf("failed to create demo account: %w", err)
+		}
+		accounts = append(accounts, created)
+		accountIDs = append(accountIDs, created.ID)
+	}
+
+	for _, transaction := range demoTransactions(accounts, categories, uc.now()) {
+		if _, err := uc.transactionRepo.CreateTransaction(ctx, transaction); err != nil {
+			uc.deleteAccounts(ctx, accountIDs)
+			return entities.DemoStatus{}, fmt.Errorf("failed to create demo transaction: %w", err)
+		}
+	}
+
+	for _, id := range accountIDs {
+		_ = uc.balanceRepo.RefreshAccountBalance(ctx, id)
+	}
+
+	if _, err := uc.userSettingsRepo.UpsertUserSetting(ctx, entities.UserSetting{
+		Key:   entities.UserSettingDemoAccounts,
+		Value: strings.Join(accountIDs, ","),
+	}); err != nil {
+		uc.deleteAccounts(ctx, accountIDs)
+		return entities.DemoStatus{}, fmt.Errorf("failed to store demo accounts: %w", err)
+	}
+
+	return entities.DemoStatus{Active: true, AccountIDs: accountIDs}, nil
+}
+
+// StopDemo removes the sample accounts, their transactions going with them
+func (uc *DemoUseCase) StopDemo(ctx context.Context) error {
+	status, err := uc.GetDemoStatus(ctx)
+	if err != nil {
+		return err
+	}
+	if !status.Active {
+		return fmt.Errorf("demo data is not loaded: %w", domain.ErrConflict)
+	}
+
+	for _, id := range status.AccountIDs {
+		// Accounts already deleted by hand are fine, the goal is for them to be gone
+		if err := uc.accountRepo.DeleteAccount(ctx, id); err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("failed to delete demo account: %w", err)
+		}
+	}
+
+	if err := uc.userSettingsRepo.DeleteUserSetting(ctx, entities.UserSettingDemoAccounts); err != nil {
+		return fmt.Errorf("failed to clear demo accounts: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *DemoUseCase) baseCurrency(ctx context.Context) (monetary.Asset, error) {
+	settings, err := uc.userSettingsRepo.GetAllUserSettings(ctx)
+	if err != nil {
+		return monetary.Asset{}, fmt.Errorf("failed to get user settings: %w", err)
+	}
+
+	currency := entities.DefaultUserSettings[entities.UserSettingBaseCurrency]
+	for _, setting := range settings {
+		if setting.Key == entities.UserSettingBaseCurrency {
+			currency = setting.Value
+		}
+	}
+
+	asset, ok := entities.FindSupportedAsset(currency)
+	if !ok {
+		return monetary.Asset{}, fmt.Errorf("invalid base currency: %s", currency)
+	}
+	return asset, nil
+}
+
+// deleteAccounts cleans up after a failed start, errors are ignored as there is
+// nothing better to do with them
+func (uc *DemoUseCase) deleteAccounts(ctx context.Context, ids []string) {
+	for _, id := range ids {
+		_ = uc.accountRepo.DeleteAccount(ctx, id)
+	}
+}
+
+// demoTransactions generates the sample transactions of the demoDays before now.
+// The last week stays pending, like card purchases that haven't cleared yet.
+func demoTransactions(accounts []entities.Account, categories []entities.Category, now time.Time) []entities.Transaction {
+	rng := rand.New(rand.NewPCG(demoSeed, demoSeed))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	start := today.AddDate(0, 0, -demoDays+1)
+
+	var transactions []entities.Transaction
+	for _, entry := range demoEntries {
+		category, ok := demoCategory(categories, entry)
+		if !ok {
+			continue
+		}
+		account := accounts[entry.account]
+
+		for day := entry.offset; day < demoDays; day += entry.every {
+			cents := entry.min
+			if entry.max > entry.min {
+				cents += rng.Int64N(entry.max - entry.min + 1)
+			}
+
+			// Same signs as TransactionUseCase.adjustTransactionAmount: expenses lower
+			// asset balances and raise the balance owed on liabilities
+			if (entry.categoryType == entities.CategoryTypeExpense) != account.IsLiability() {
+				cents = -cents
+			}
+
+			date := start.AddDate(0, 0, day)
+			status := entities.TransactionStatusCleared
+			if today.Sub(date) < 7*24*time.Hour {
+				status = entities.TransactionStatusPending
+			}
+
+			transactions = append(transactions, entities.Transaction{
+				AccountID:   account.ID,
+				CategoryID:  category.ID,
+				Monetary:    monetary.Monetary{Asset: account.Asset, Amount: big.NewInt(cents)},
+				Description: entry.description,
+				Date:        date,
+				Status:      status,
+			})
+		}
+	}
+
+	return transactions
+}
+
+func demoCategory(categories []entities.Category, entry demoEntry) (entities.Category, bool) {
+	var fallback *entities.Category
+	for i, category := range categories {
+		if category.Type != entry.categoryType {
+			continue
+		}
+		if category.Name == entry.category {
+			return category, true
+		}
+		if fallback == nil {
+			fallback = &categories[i]
+		}
+	}
+	if fallback == nil {
+		return entities.Category{}, false
+	}
+	return *fallback, true
+}
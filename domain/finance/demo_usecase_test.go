@@ -0,0 +1,246 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartDemo(t *testing.T) {
+	var (
+		accounts     []entities.Account
+		transactions []entities.Transaction
+		refreshed    []string
+		stored       entities.UserSetting
+	)
+
+	uc := NewDemoUseCase(
+		&mocks.AccountRepositoryMock{
+			GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+				return nil, nil
+			},
+			CreateAccountFunc: func(ctx context.Context, account entities.Account) (entities.Account, error) {
+				account.ID = account.Name
+				accounts = append(accounts, account)
+				return account, nil
+			},
+		},
+		&mocks.CategoryRepositoryMock{
+			GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+				return []entities.Category{
+					{ID: "cat-salary", Name: "Salary", Type: entities.CategoryTypeIncome},
+					{ID: "cat-other", Name: "Other Expense", Type: entities.CategoryTypeExpense},
+					{ID: "cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense},
+				}, nil
+			},
+		},
+		&mocks.TransactionRepositoryMock{
+			CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+				transactions = append(transactions, transaction)
+				return transaction, nil
+			},
+		},
+		&mocks.BalanceRepositoryMock{
+			RefreshAccountBalanceFunc: func(ctx context.Context, accountID string) error {
+				refreshed = append(refreshed, accountID)
+				return nil
+			},
+		},
+		&mocks.UserSettingsRepositoryMock{
+			GetAllUserSettingsFunc: func(ctx context.Context) ([]entities.UserSetting, error) {
+				return []entities.UserSetting{{Key: entities.UserSettingBaseCurrency, Value: "GBP"}}, nil
+			},
+			UpsertUserSettingFunc: func(ctx context.Context, setting entities.UserSetting) (entities.UserSetting, error) {
+				stored = setting
+				return setting, nil
+			},
+		},
+	)
+	uc.now = func() time.Time { return time.Date(2025, time.June, 30, 15, 0, 0, 0, time.UTC) }
+
+	status, err := uc.StartDemo(context.Background())
+	require.NoError(t, err)
+
+	assert.True(t, status.Active)
+	assert.Equal(t, []string{"Demo Checking", "Demo Savings", "Demo Credit Card"}, status.AccountIDs)
+	assert.Equal(t, status.AccountIDs, refreshed)
+	assert.Equal(t, entities.UserSettingDemoAccounts, stored.Key)
+	assert.Equal(t, "Demo Checking,Demo Savings,Demo Credit Card", stored.Value)
+	for _, account := range accounts {
+		assert.Equal(t, "GBP", account.Asset.Asset)
+	}
+
+	require.NotEmpty(t, transactions)
+	first, last := transactions[0].Date, transactions[0].Date
+	for _, transaction := range transactions {
+		if transaction.Date.Before(first) {
+			first = transaction.Date
+		}
+		if transaction.Date.After(last) {
+			last = transaction.Date
+		}
+
+		switch {
+		case transaction.Description == "Supermarket":
+			assert.Equal(t, "cat-groceries", transaction.CategoryID)
+			assert.Negative(t, transaction.Monetary.Amount.Sign())
+		case transaction.Description == "Restaurant":
+			// Dining Out is missing, so the first expense category is used; on the
+			// credit card an expense raises the balance owed
+			assert.Equal(t, "cat-other", transaction.CategoryID)
+			assert.Equal(t, "Demo Credit Card", transaction.AccountID)
+			assert.Positive(t, transaction.Monetary.Amount.Sign())
+		case transaction.Description == "Monthly salary":
+			assert.Equal(t, int64(650000), transaction.Monetary.Amount.Int64())
+		}
+
+		if time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC).Sub(transaction.Date) < 7*24*time.Hour {
+			assert.Equal(t, entities.TransactionStatusPending, transaction.Status)
+		} else {
+			assert.Equal(t, entities.TransactionStatusCleared, transaction.Status)
+		}
+	}
+	assert.Equal(t, time.Date(2025, time.April, 2, 0, 0, 0, 0, time.UTC), first)
+	assert.False(t, last.After(time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC)))
+
+	// The same day gives the same dataset
+	again := demoTransactions(accounts, []entities.Category{
+		{ID: "cat-salary", Name: "Salary", Type: entities.CategoryTypeIncome},
+		{ID: "cat-other", Name: "Other Expense", Type: entities.CategoryTypeExpense},
+		{ID: "cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense},
+	}, uc.now())
+	require.Len(t, again, len(transactions))
+	for i := range again {
+		assert.Equal(t, transactions[i].Monetary.Amount.Int64(), again[i].Monetary.Amount.Int64())
+	}
+}
+
+func TestStartDemoConflict(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings []entities.UserSetting
+		accounts []entities.Account
+	}{
+		{
+			name:     "already loaded",
+			settings: []entities.UserSetting{{Key: entities.UserSettingDemoAccounts, Value: "acc-1"}},
+			accounts: []entities.Account{{ID: "acc-1"}},
+		},
+		{
+			name:     "real accounts exist",
+			accounts: []entities.Account{{ID: "acc-1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountRepo := &mocks.AccountRepositoryMock{
+				GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+					return tt.accounts, nil
+				},
+			}
+			uc := NewDemoUseCase(
+				accountRepo,
+				&mocks.CategoryRepositoryMock{},
+				&mocks.TransactionRepositoryMock{},
+				&mocks.BalanceRepositoryMock{},
+				&mocks.UserSettingsRepositoryMock{
+					GetAllUserSettingsFunc: func(ctx context.Context) ([]entities.UserSetting, error) {
+						return tt.settings, nil
+					},
+				},
+			)
+
+			_, err := uc.StartDemo(context.Background())
+			assert.ErrorIs(t, err, domain.ErrConflict)
+			assert.Empty(t, accountRepo.CreateAccountCalls())
+		})
+	}
+}
+
+func TestStartDemoCleansUpOnFailure(t *testing.T) {
+	var deleted []string
+	created := 0
+
+	uc := NewDemoUseCase(
+		&mocks.AccountRepositoryMock{
+			CreateAccountFunc: func(ctx context.Context, account entities.Account) (entities.Account, error) {
+				created++
+				account.ID = account.Name
+				return account, nil
+			},
+			DeleteAccountFunc: func(ctx context.Context, id string) error {
+				deleted = append(deleted, id)
+				return nil
+			},
+		},
+		&mocks.CategoryRepositoryMock{
+			GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+				return []entities.Category{{ID: "cat-expense", Type: entities.CategoryTypeExpense}}, nil
+			},
+		},
+		&mocks.TransactionRepositoryMock{
+			CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+				return entities.Transaction{}, errors.New("connection reset")
+			},
+		},
+		&mocks.BalanceRepositoryMock{},
+		&mocks.UserSettingsRepositoryMock{},
+	)
+
+	_, err := uc.StartDemo(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, 3, created)
+	assert.Equal(t, []string{"Demo Checking", "Demo Savings", "Demo Credit Card"}, deleted)
+}
+
+func TestGetDemoStatus(t *testing.T) {
+	uc := NewDemoUseCase(nil, nil, nil, nil, &mocks.UserSettingsRepositoryMock{})
+
+	status, err := uc.GetDemoStatus(context.Background())
+	require.NoError(t, err)
+	assert.False(t, status.Active)
+	assert.Empty(t, status.AccountIDs)
+}
+
+func TestStopDemo(t *testing.T) {
+	t.Run("removes the demo accounts", func(t *testing.T) {
+		var deleted []string
+		settingsRepo := &mocks.UserSettingsRepositoryMock{
+			GetAllUserSettingsFunc: func(ctx context.Context) ([]entities.UserSetting, error) {
+				return []entities.UserSetting{{Key: entities.UserSettingDemoAccounts, Value: "acc-1,acc-2"}}, nil
+			},
+		}
+		uc := NewDemoUseCase(
+			&mocks.AccountRepositoryMock{
+				DeleteAccountFunc: func(ctx context.Context, id string) error {
+					deleted = append(deleted, id)
+					if id == "acc-2" {
+						return domain.ErrNotFound
+					}
+					return nil
+				},
+			},
+			nil, nil, nil,
+			settingsRepo,
+		)
+
+		require.NoError(t, uc.StopDemo(context.Background()))
+		assert.Equal(t, []string{"acc-1", "acc-2"}, deleted)
+		require.Len(t, settingsRepo.DeleteUserSettingCalls(), 1)
+		assert.Equal(t, entities.UserSettingDemoAccounts, settingsRepo.DeleteUserSettingCalls()[0].Key)
+	})
+
+	t.Run("not loaded", func(t *testing.T) {
+		uc := NewDemoUseCase(nil, nil, nil, nil, &mocks.UserSettingsRepositoryMock{})
+		assert.ErrorIs(t, uc.StopDemo(context.Background()), domain.ErrConflict)
+	})
+}
@@ -0,0 +1,18 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/expense_report_repository.go . ExpenseReportRepository
+type ExpenseReportRepository interface {
+	CreateExpenseReport(ctx context.Context, report entities.ExpenseReport) (entities.ExpenseReport, error)
+	GetExpenseReportByID(ctx context.Context, id string) (entities.ExpenseReport, error)
+	GetAllExpenseReports(ctx context.Context) ([]entities.ExpenseReport, error)
+	UpdateExpenseReport(ctx context.Context, report entities.ExpenseReport) (entities.ExpenseReport, error)
+	DeleteExpenseReport(ctx context.Context, id string) error
+	AddExpenseReportTransaction(ctx context.Context, reportID, transactionID string) error
+	RemoveExpenseReportTransaction(ctx context.Context, reportID, transactionID string) error
+	GetExpenseReportTransactions(ctx context.Context, reportID string) ([]entities.Transaction, error)
+}
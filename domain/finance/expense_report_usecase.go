@@ -0,0 +1,335 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ExpenseReportExporter renders an expense report to a file format
+//
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/expense_report_exporter.go . ExpenseReportExporter
+type ExpenseReportExporter interface {
+	ExportExpenseReport(report entities.ExpenseReport) ([]byte, error)
+}
+
+// expenseReportTransitions lists the statuses a report can move to from each
+// status. Approved reports are final.
+var expenseReportTransitions = map[entities.ExpenseReportStatus][]entities.ExpenseReportStatus{
+	entities.ExpenseReportStatusDraft:     {entities.ExpenseReportStatusSubmitted},
+	entities.ExpenseReportStatusSubmitted: {entities.ExpenseReportStatusApproved, entities.ExpenseReportStatusRejected, entities.ExpenseReportStatusDraft},
+	entities.ExpenseReportStatusRejected:  {entities.ExpenseReportStatusDraft},
+}
+
+// expenseReportContentTypes are the media types of the export formats
+var expenseReportContentTypes = map[entities.ExpenseReportFormat]string{
+	entities.ExpenseReportFormatCSV: "text/csv",
+	entities.ExpenseReportFormatPDF: "application/pdf",
+}
+
+type ExpenseReportUseCase struct {
+	expenseReportRepo ExpenseReportRepository
+	transactionRepo   TransactionRepository
+	exporters         map[entities.ExpenseReportFormat]ExpenseReportExporter
+	now               func() time.Time
+}
+
+func NewExpenseReportUseCase(exporters map[entities.ExpenseReportFormat]ExpenseReportExporter, expenseReportRepo ExpenseReportRepository, transactionRepo TransactionRepository) *ExpenseReportUseCase {
+	return &ExpenseReportUseCase{
+		expenseReportRepo: expenseReportRepo,
+		transactionRepo:   transactionRepo,
+		exporters:         exporters,
+		now:               time.Now,
+	}
+}
+
+// CreateExpenseReport creates an empty report, as a draft
+func (uc *ExpenseReportUseCase) CreateExpenseReport(ctx context.Context, report entities.ExpenseReport) (entities.ExpenseReport, error) {
+	if err := validateExpenseReport(report); err != nil {
+		return entities.ExpenseReport{}, err
+	}
+
+	created, err := uc.expenseReportRepo.CreateExpenseReport(ctx, report)
+	if err != nil {
+		return entities.ExpenseReport{}, fmt.Errorf("failed to create expense report: %w", err)
+	}
+
+	return withExpenseReportTotals(created), nil
+}
+
+// GetExpenseReports returns the reports, the most recent period first, with
+// their transactions and totals
+func (uc *ExpenseReportUseCase) GetExpenseReports(ctx context.Context) ([]entities.ExpenseReport, error) {
+	reports, err := uc.expenseReportRepo.GetAllExpenseReports(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expense reports: %w", err)
+	}
+
+	for i, report := range reports {
+		if reports[i], err = uc.withTransactions(ctx, report); err != nil {
+			return nil, err
+		}
+	}
+
+	return reports, nil
+}
+
+func (uc *ExpenseReportUseCase) GetExpenseReport(ctx context.Context, id string) (entities.ExpenseReport, error) {
+	if id == "" {
+		return entities.ExpenseReport{}, fmt.Errorf("expense report ID cannot be empty")
+	}
+
+	report, err := ownExpenseReport(ctx, uc.expenseReportRepo, id)
+	if err != nil {
+		return entities.ExpenseReport{}, fmt.Errorf("failed to get expense report: %w", err)
+	}
+
+	return uc.withTransactions(ctx, report)
+}
+
+// UpdateExpenseReport changes the name, period and notes of a draft. The
+// period has to keep the transactions already in the report.
+func (uc *ExpenseReportUseCase) UpdateExpenseReport(ctx context.Context, report entities.ExpenseReport) (entities.ExpenseReport, error) {
+	if err := validateExpenseReport(report); err != nil {
+		return entities.ExpenseReport{}, err
+	}
+
+	current, err := uc.draft(ctx, report.ID)
+	if err != nil {
+		return entities.ExpenseReport{}, err
+	}
+
+	for _, transaction := range current.Transactions {
+		if transaction.Date.Before(report.StartDate) || transaction.Date.After(report.EndDate) {
+			return entities.ExpenseReport{}, fmt.Errorf("transaction %s dated %s would be left out of the report: %w", transaction.ID, transaction.Date.Format("2006-01-02"), domain.ErrConflict)
+		}
+	}
+
+	current.Name = report.Name
+	current.StartDate = report.StartDate
+	current.EndDate = report.EndDate
+	current.Notes = report.Notes
+
+	updated, err := uc.expenseReportRepo.UpdateExpenseReport(ctx, current)
+	if err != nil {
+		return entities.ExpenseReport{}, fmt.Errorf("failed to update expense report: %w", err)
+	}
+	updated.Transactions = current.Transactions
+
+	return withExpenseReportTotals(updated), nil
+}
+
+// DeleteExpenseReport deletes a report, its transactions are kept
+func (uc *ExpenseReportUseCase) DeleteExpenseReport(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("expense report ID cannot be empty")
+	}
+
+	if _, err := ownExpenseReport(ctx, uc.expenseReportRepo, id); err != nil {
+		return fmt.Errorf("failed to get expense report: %w", err)
+	}
+
+	if err := uc.expenseReportRepo.DeleteExpenseReport(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete expense report: %w", err)
+	}
+
+	return nil
+}
+
+// AddExpenseReportTransaction puts an expense dated within the period of a
+// draft on it. A transaction is on one report at most.
+func (uc *ExpenseReportUseCase) AddExpenseReportTransaction(ctx context.Context, reportID, transactionID string) (entities.ExpenseReport, error) {
+	if transactionID == "" {
+		return entities.ExpenseReport{}, fmt.Errorf("transaction ID cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+
+	report, err := uc.draft(ctx, reportID)
+	if err != nil {
+		return entities.ExpenseReport{}, err
+	}
+
+	transaction, err := uc.transactionRepo.GetTransactionWithDetails(ctx, transactionID)
+	if err != nil {
+		return entities.ExpenseReport{}, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	if transaction.Category == nil || transaction.Category.Type != entities.CategoryTypeExpense {
+		return entities.ExpenseReport{}, fmt.Errorf("transaction %s is not an expense: %w", transactionID, domain.ErrMalformedParameters)
+	}
+	if !inFatura(transaction) {
+		return entities.ExpenseReport{}, fmt.Errorf("transaction %s is %s, only pending and cleared transactions are reimbursed: %w", transactionID, transaction.Status, domain.ErrMalformedParameters)
+	}
+	if transaction.Date.Before(report.StartDate) || transaction.Date.After(report.EndDate) {
+		return entities.ExpenseReport{}, fmt.Errorf("transaction %s dated %s is outside the report period: %w", transactionID, transaction.Date.Format("2006-01-02"), domain.ErrMalformedParameters)
+	}
+
+	if err := uc.expenseReportRepo.AddExpenseReportTransaction(ctx, reportID, transactionID); err != nil {
+		return entities.ExpenseReport{}, fmt.Errorf("failed to add transaction to expense report: %w", err)
+	}
+
+	return uc.GetExpenseReport(ctx, reportID)
+}
+
+// RemoveExpenseReportTransaction takes a transaction off a draft
+func (uc *ExpenseReportUseCase) RemoveExpenseReportTransaction(ctx context.Context, reportID, transactionID string) (entities.ExpenseReport, error) {
+	if _, err := uc.draft(ctx, reportID); err != nil {
+		return entities.ExpenseReport{}, err
+	}
+
+	if err := uc.expenseReportRepo.RemoveExpenseReportTransaction(ctx, reportID, transactionID); err != nil {
+		return entities.ExpenseReport{}, fmt.Errorf("failed to remove transaction from expense report: %w", err)
+	}
+
+	return uc.GetExpenseReport(ctx, reportID)
+}
+
+// ChangeExpenseReportStatus moves a report along its review: drafts are
+// submitted, submitted reports approved, rejected or taken back to draft, and
+// rejected reports reopened as drafts to be fixed. Only reports with
+// transactions can be submitted.
+func (uc *ExpenseReportUseCase) ChangeExpenseReportStatus(ctx context.Context, id string, status entities.ExpenseReportStatus) (entities.ExpenseReport, error) {
+	report, err := uc.GetExpenseReport(ctx, id)
+	if err != nil {
+		return entities.ExpenseReport{}, err
+	}
+
+	if !slices.Contains(expenseReportTransitions[report.Status], status) {
+		return entities.ExpenseReport{}, fmt.Errorf("expense report is %s, it can't be %s: %w", report.Status, status, domain.ErrConflict)
+	}
+
+	now := uc.now()
+	switch status {
+	case entities.ExpenseReportStatusSubmitted:
+		if len(report.Transactions) == 0 {
+			return entities.ExpenseReport{}, fmt.Errorf("expense report has no transactions to submit: %w", domain.ErrConflict)
+		}
+		report.SubmittedAt = &now
+		report.ReviewedAt = nil
+	case entities.ExpenseReportStatusApproved, entities.ExpenseReportStatusRejected:
+		report.ReviewedAt = &now
+	}
+	report.Status = status
+
+	updated, err := uc.expenseReportRepo.UpdateExpenseReport(ctx, report)
+	if err != nil {
+		return entities.ExpenseReport{}, fmt.Errorf("failed to update expense report: %w", err)
+	}
+	updated.Transactions = report.Transactions
+
+	return withExpenseReportTotals(updated), nil
+}
+
+// ExportExpenseReport renders a report to a file in format, named after the
+// report
+func (uc *ExpenseReportUseCase) ExportExpenseReport(ctx context.Context, id string, format entities.ExpenseReportFormat) (entities.ExpenseReportExport, error) {
+	exporter, ok := uc.exporters[format]
+	if !ok {
+		formats := make([]string, 0, len(uc.exporters))
+		for _, format := range slices.Sorted(maps.Keys(uc.exporters)) {
+			formats = append(formats, string(format))
+		}
+		return entities.ExpenseReportExport{}, fmt.Errorf("unknown export format %q, expected one of %s: %w", format, strings.Join(formats, ", "), domain.ErrMalformedParameters)
+	}
+
+	report, err := uc.GetExpenseReport(ctx, id)
+	if err != nil {
+		return entities.ExpenseReportExport{}, err
+	}
+
+	data, err := exporter.ExportExpenseReport(report)
+	if err != nil {
+		return entities.ExpenseReportExport{}, fmt.Errorf("failed to export expense report: %w", err)
+	}
+
+	return entities.ExpenseReportExport{
+		Filename:    fmt.Sprintf("%s.%s", expenseReportFilename(report), format),
+		ContentType: expenseReportContentTypes[format],
+		Data:        data,
+	}, nil
+}
+
+// draft returns a report with its transactions, as long as it's a draft
+func (uc *ExpenseReportUseCase) draft(ctx context.Context, id string) (entities.ExpenseReport, error) {
+	report, err := uc.GetExpenseReport(ctx, id)
+	if err != nil {
+		return entities.ExpenseReport{}, err
+	}
+
+	if report.Status != entities.ExpenseReportStatusDraft {
+		return entities.ExpenseReport{}, fmt.Errorf("expense report is %s, only drafts can be changed: %w", report.Status, domain.ErrConflict)
+	}
+
+	return report, nil
+}
+
+// withTransactions loads the transactions of a report and its totals
+func (uc *ExpenseReportUseCase) withTransactions(ctx context.Context, report entities.ExpenseReport) (entities.ExpenseReport, error) {
+	transactions, err := uc.expenseReportRepo.GetExpenseReportTransactions(ctx, report.ID)
+	if err != nil {
+		return entities.ExpenseReport{}, fmt.Errorf("failed to get expense report transactions: %w", err)
+	}
+	report.Transactions = transactions
+
+	return withExpenseReportTotals(report), nil
+}
+
+func validateExpenseReport(report entities.ExpenseReport) error {
+	if strings.TrimSpace(report.Name) == "" {
+		return fmt.Errorf("expense report name cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	if report.StartDate.IsZero() || report.EndDate.IsZero() {
+		return fmt.Errorf("expense report start and end dates are required: %w", domain.ErrMalformedParameters)
+	}
+	if report.EndDate.Before(report.StartDate) {
+		return fmt.Errorf("expense report ends before it starts: %w", domain.ErrMalformedParameters)
+	}
+	return nil
+}
+
+// withExpenseReportTotals adds up the size of the transactions of a report per
+// asset. Expenses are signed by their effect on the account, so a card
+// purchase counts the same as a payment from a checking account.
+func withExpenseReportTotals(report entities.ExpenseReport) entities.ExpenseReport {
+	totals := map[string]entities.Money{}
+	for _, transaction := range report.Transactions {
+		asset := transaction.Monetary.Asset
+		total, ok := totals[asset.Asset]
+		if !ok {
+			total = entities.NewMoney(asset, 0)
+		}
+		totals[asset.Asset], _ = total.Add(entities.MoneyOf(transaction.Monetary).Abs())
+	}
+
+	report.Totals = nil
+	for _, asset := range slices.Sorted(maps.Keys(totals)) {
+		report.Totals = append(report.Totals, totals[asset].Monetary())
+	}
+	return report
+}
+
+// expenseReportFilename names the exports of a report after it, keeping
+// letters and digits, like "lisbon-trip-2025-03"
+func expenseReportFilename(report entities.ExpenseReport) string {
+	var name strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(report.Name) {
+		if r < 0x80 && (r >= 'a' && r <= 'z' || r >= '0' && r <= '9') {
+			if dash && name.Len() > 0 {
+				name.WriteByte('-')
+			}
+			name.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	if name.Len() == 0 {
+		return "expense-report-" + report.StartDate.Format("2006-01-02")
+	}
+	return name.String()
+}
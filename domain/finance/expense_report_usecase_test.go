@@ -0,0 +1,268 @@
+package finance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testExpenseReportUseCase serves a draft report for a trip from 2025-03-10 to
+// 2025-03-14 with a hotel stay on it, and the transactions around it
+func testExpenseReportUseCase(t *testing.T) (*ExpenseReportUseCase, *mocks.ExpenseReportRepositoryMock) {
+	t.Helper()
+
+	expense := &entities.Category{ID: "cat-travel", Name: "Travel", Type: entities.CategoryTypeExpense}
+	income := &entities.Category{ID: "cat-salary", Name: "Salary", Type: entities.CategoryTypeIncome}
+	transaction := func(id string, category *entities.Category, amount int64, day int, status entities.TransactionStatus) entities.Transaction {
+		return entities.Transaction{
+			ID:         id,
+			AccountID:  "acc-card",
+			CategoryID: category.ID,
+			Monetary:   testMonetary(t, monetary.GBP, amount),
+			Date:       time.Date(2025, time.March, day, 0, 0, 0, 0, time.UTC),
+			Status:     status,
+			Category:   category,
+		}
+	}
+	transactions := map[string]entities.Transaction{
+		"tx-hotel":  transaction("tx-hotel", expense, -48000, 11, entities.TransactionStatusCleared),
+		"tx-taxi":   transaction("tx-taxi", expense, -3240, 12, entities.TransactionStatusPending),
+		"tx-salary": transaction("tx-salary", income, 500000, 12, entities.TransactionStatusCleared),
+		"tx-draft":  transaction("tx-draft", expense, -1000, 12, entities.TransactionStatusDraft),
+		"tx-later":  transaction("tx-later", expense, -2500, 20, entities.TransactionStatusCleared),
+	}
+
+	report := entities.ExpenseReport{
+		ID:        "report-1",
+		Name:      "Lisbon trip",
+		StartDate: time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC),
+		Status:    entities.ExpenseReportStatusDraft,
+		OwnerID:   "user-1",
+	}
+	attached := []string{"tx-hotel"}
+
+	expenseReportRepo := &mocks.ExpenseReportRepositoryMock{
+		CreateExpenseReportFunc: func(ctx context.Context, report entities.ExpenseReport) (entities.ExpenseReport, error) {
+			report.ID = "report-2"
+			report.Status = entities.ExpenseReportStatusDraft
+			return report, nil
+		},
+		GetExpenseReportByIDFunc: func(ctx context.Context, id string) (entities.ExpenseReport, error) {
+			if id != report.ID {
+				return entities.ExpenseReport{}, errNotFound("expense report")
+			}
+			return report, nil
+		},
+		UpdateExpenseReportFunc: func(ctx context.Context, updated entities.ExpenseReport) (entities.ExpenseReport, error) {
+			updated.Transactions, updated.Totals = nil, nil
+			report = updated
+			return report, nil
+		},
+		AddExpenseReportTransactionFunc: func(ctx context.Context, reportID, transactionID string) error {
+			attached = append(attached, transactionID)
+			return nil
+		},
+		GetExpenseReportTransactionsFunc: func(ctx context.Context, reportID string) ([]entities.Transaction, error) {
+			var result []entities.Transaction
+			for _, id := range attached {
+				result = append(result, transactions[id])
+			}
+			return result, nil
+		},
+	}
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionWithDetailsFunc: func(ctx context.Context, id string) (entities.Transaction, error) {
+			transaction, ok := transactions[id]
+			if !ok {
+				return entities.Transaction{}, errNotFound("transaction")
+			}
+			return transaction, nil
+		},
+	}
+
+	exporter := &mocks.ExpenseReportExporterMock{
+		ExportExpenseReportFunc: func(report entities.ExpenseReport) ([]byte, error) {
+			return []byte(fmt.Sprintf("%s: %d transactions", report.Name, len(report.Transactions))), nil
+		},
+	}
+
+	uc := NewExpenseReportUseCase(map[entities.ExpenseReportFormat]ExpenseReportExporter{
+		entities.ExpenseReportFormatCSV: exporter,
+	}, expenseReportRepo, transactionRepo)
+	uc.now = func() time.Time { return time.Date(2025, time.March, 17, 9, 30, 0, 0, time.UTC) }
+	return uc, expenseReportRepo
+}
+
+func TestCreateExpenseReport(t *testing.T) {
+	uc, _ := testExpenseReportUseCase(t)
+	start := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("creates a draft", func(t *testing.T) {
+		report, err := uc.CreateExpenseReport(context.Background(), entities.ExpenseReport{Name: "Porto", StartDate: start, EndDate: start.AddDate(0, 0, 2)})
+		require.NoError(t, err)
+		assert.Equal(t, entities.ExpenseReportStatusDraft, report.Status)
+		assert.Empty(t, report.Totals)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, report := range map[string]entities.ExpenseReport{
+			"no name":       {Name: " ", StartDate: start, EndDate: start},
+			"no dates":      {Name: "Porto"},
+			"ends too soon": {Name: "Porto", StartDate: start, EndDate: start.AddDate(0, 0, -1)},
+		} {
+			_, err := uc.CreateExpenseReport(context.Background(), report)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters, name)
+		}
+	})
+}
+
+func TestGetExpenseReport(t *testing.T) {
+	uc, expenseReportRepo := testExpenseReportUseCase(t)
+
+	t.Run("adds up the transactions", func(t *testing.T) {
+		report, err := uc.GetExpenseReport(context.Background(), "report-1")
+		require.NoError(t, err)
+		require.Len(t, report.Transactions, 1)
+		require.Len(t, report.Totals, 1)
+		assert.Equal(t, int64(48000), report.Totals[0].Amount.Int64())
+		assert.Equal(t, monetary.GBP, report.Totals[0].Asset)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := uc.GetExpenseReport(context.Background(), "report-3")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("report of another user", func(t *testing.T) {
+		ctx := domain.WithUser(context.Background(), "user-2")
+		_, err := uc.GetExpenseReport(ctx, "report-1")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		assert.ErrorIs(t, uc.DeleteExpenseReport(ctx, "report-1"), domain.ErrNotFound)
+		assert.Empty(t, expenseReportRepo.DeleteExpenseReportCalls())
+	})
+}
+
+func TestAddExpenseReportTransaction(t *testing.T) {
+	uc, expenseReportRepo := testExpenseReportUseCase(t)
+
+	t.Run("adds an expense in the period", func(t *testing.T) {
+		report, err := uc.AddExpenseReportTransaction(context.Background(), "report-1", "tx-taxi")
+		require.NoError(t, err)
+		assert.Len(t, report.Transactions, 2)
+		assert.Equal(t, int64(51240), report.Totals[0].Amount.Int64())
+	})
+
+	t.Run("rejects what isn't reimbursable", func(t *testing.T) {
+		for _, id := range []string{"tx-salary", "tx-draft", "tx-later"} {
+			_, err := uc.AddExpenseReportTransaction(context.Background(), "report-1", id)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters, id)
+		}
+		assert.Len(t, expenseReportRepo.AddExpenseReportTransactionCalls(), 1)
+	})
+
+	t.Run("transaction not found", func(t *testing.T) {
+		_, err := uc.AddExpenseReportTransaction(context.Background(), "report-1", "tx-missing")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestUpdateExpenseReport(t *testing.T) {
+	uc, _ := testExpenseReportUseCase(t)
+	report := entities.ExpenseReport{
+		ID:        "report-1",
+		Name:      "Lisbon and Porto trip",
+		StartDate: time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, time.March, 16, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("extends the period", func(t *testing.T) {
+		updated, err := uc.UpdateExpenseReport(context.Background(), report)
+		require.NoError(t, err)
+		assert.Equal(t, "Lisbon and Porto trip", updated.Name)
+		assert.Equal(t, entities.ExpenseReportStatusDraft, updated.Status)
+		assert.Len(t, updated.Transactions, 1)
+	})
+
+	t.Run("keeps the transactions in the period", func(t *testing.T) {
+		report.StartDate = time.Date(2025, time.March, 12, 0, 0, 0, 0, time.UTC)
+		_, err := uc.UpdateExpenseReport(context.Background(), report)
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+}
+
+func TestChangeExpenseReportStatus(t *testing.T) {
+	uc, _ := testExpenseReportUseCase(t)
+	ctx := context.Background()
+
+	report, err := uc.ChangeExpenseReportStatus(ctx, "report-1", entities.ExpenseReportStatusSubmitted)
+	require.NoError(t, err)
+	assert.Equal(t, entities.ExpenseReportStatusSubmitted, report.Status)
+	require.NotNil(t, report.SubmittedAt)
+	assert.Nil(t, report.ReviewedAt)
+
+	t.Run("submitted reports can't change", func(t *testing.T) {
+		_, err := uc.AddExpenseReportTransaction(ctx, "report-1", "tx-taxi")
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	report, err = uc.ChangeExpenseReportStatus(ctx, "report-1", entities.ExpenseReportStatusRejected)
+	require.NoError(t, err)
+	require.NotNil(t, report.ReviewedAt)
+
+	report, err = uc.ChangeExpenseReportStatus(ctx, "report-1", entities.ExpenseReportStatusDraft)
+	require.NoError(t, err)
+	assert.Equal(t, entities.ExpenseReportStatusDraft, report.Status)
+
+	_, err = uc.ChangeExpenseReportStatus(ctx, "report-1", entities.ExpenseReportStatusSubmitted)
+	require.NoError(t, err)
+	report, err = uc.ChangeExpenseReportStatus(ctx, "report-1", entities.ExpenseReportStatusApproved)
+	require.NoError(t, err)
+	assert.Equal(t, entities.ExpenseReportStatusApproved, report.Status)
+	assert.Len(t, report.Transactions, 1)
+
+	t.Run("approved reports are final", func(t *testing.T) {
+		for _, status := range []entities.ExpenseReportStatus{entities.ExpenseReportStatusDraft, entities.ExpenseReportStatusRejected} {
+			_, err := uc.ChangeExpenseReportStatus(ctx, "report-1", status)
+			assert.ErrorIs(t, err, domain.ErrConflict, status)
+		}
+	})
+}
+
+func TestSubmitEmptyExpenseReport(t *testing.T) {
+	uc, expenseReportRepo := testExpenseReportUseCase(t)
+	expenseReportRepo.GetExpenseReportTransactionsFunc = func(ctx context.Context, reportID string) ([]entities.Transaction, error) {
+		return nil, nil
+	}
+
+	_, err := uc.ChangeExpenseReportStatus(context.Background(), "report-1", entities.ExpenseReportStatusSubmitted)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	assert.Empty(t, expenseReportRepo.UpdateExpenseReportCalls())
+}
+
+func TestExportExpenseReport(t *testing.T) {
+	uc, _ := testExpenseReportUseCase(t)
+
+	t.Run("names the file after the report", func(t *testing.T) {
+		export, err := uc.ExportExpenseReport(context.Background(), "report-1", entities.ExpenseReportFormatCSV)
+		require.NoError(t, err)
+		assert.Equal(t, "lisbon-trip.csv", export.Filename)
+		assert.Equal(t, "text/csv", export.ContentType)
+		assert.Equal(t, "Lisbon trip: 1 transactions", string(export.Data))
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, err := uc.ExportExpenseReport(context.Background(), "report-1", "xlsx")
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+}
@@ -0,0 +1,16 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+	"time"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/export_repository.go . ExportRepository
+type ExportRepository interface {
+	CreateExport(ctx context.Context, export entities.Export) (entities.Export, error)
+	GetExportByID(ctx context.Context, id string) (entities.Export, error)
+	GetExports(ctx context.Context, now time.Time) ([]entities.Export, error)
+	DeleteExpiredExports(ctx context.Context, now time.Time) ([]entities.Export, error)
+	DeleteExport(ctx context.Context, id string) error
+}
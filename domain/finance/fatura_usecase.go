@@ -0,0 +1,197 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+// faturaMonthLayout names a fatura after the month it's due
+const faturaMonthLayout = "2006-01"
+
+type FaturaUseCase struct {
+	transactionRepo TransactionRepository
+	accountRepo     AccountRepository
+	now             func() time.Time
+}
+
+func NewFaturaUseCase(transactionRepo TransactionRepository, accountRepo AccountRepository) *FaturaUseCase {
+	return &FaturaUseCase{
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		now:             time.Now,
+	}
+}
+
+// GetFaturas returns the faturas of a credit card, newest first: the future
+// ones holding installments, the open one, even when empty, and the closed
+// ones with transactions
+func (uc *FaturaUseCase) GetFaturas(ctx context.Context, accountID string) ([]entities.Fatura, error) {
+	account, err := uc.billingAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := uc.transactionRepo.GetTransactionsByAccount(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	byMonth := map[time.Time][]entities.Transaction{
+		faturaMonth(account, uc.today()): nil,
+	}
+	for _, transaction := range transactions {
+		if !inFatura(transaction) {
+			continue
+		}
+		month := faturaMonth(account, transaction.Date)
+		byMonth[month] = append(byMonth[month], transaction)
+	}
+
+	months := slices.SortedFunc(maps.Keys(byMonth), func(a, b time.Time) int { return b.Compare(a) })
+	faturas := make([]entities.Fatura, len(months))
+	for i, month := range months {
+		faturas[i] = uc.fatura(account, month, byMonth[month])
+	}
+
+	return faturas, nil
+}
+
+// GetFatura returns the fatura of a credit card due in the month of month
+func (uc *FaturaUseCase) GetFatura(ctx context.Context, accountID string, month time.Time) (entities.Fatura, error) {
+	account, err := uc.billingAccount(ctx, accountID)
+	if err != nil {
+		return entities.Fatura{}, err
+	}
+
+	month = time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	from, closing, _ := faturaCycle(account, month)
+	transactions, err := uc.transactionRepo.GetTransactionsByAccountAndDateRange(ctx, accountID, from, closing.AddDate(0, 0, -1))
+	if err != nil {
+		return entities.Fatura{}, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	return uc.fatura(account, month, slices.DeleteFunc(transactions, func(transaction entities.Transaction) bool {
+		return !inFatura(transaction)
+	})), nil
+}
+
+// billingAccount returns the account of a credit card with a billing cycle
+func (uc *FaturaUseCase) billingAccount(ctx context.Context, accountID string) (entities.Account, error) {
+	if accountID == "" {
+		return entities.Account{}, fmt.Errorf("account ID cannot be empty")
+	}
+
+	account, err := ownAccount(ctx, uc.accountRepo, accountID)
+	if err != nil {
+		return entities.Account{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	if !account.HasBillingCycle() {
+		return entities.Account{}, fmt.Errorf("account %s has no statement closing and payment due days: %w", accountID, domain.ErrConflict)
+	}
+
+	return account, nil
+}
+
+// fatura builds the fatura due in month out of its transactions
+func (uc *FaturaUseCase) fatura(account entities.Account, month time.Time, transactions []entities.Transaction) entities.Fatura {
+	from, closing, due := faturaCycle(account, month)
+	fatura := entities.Fatura{
+		Account:      account,
+		Month:        month.Format(faturaMonthLayout),
+		From:         from,
+		ClosingDate:  closing,
+		DueDate:      due,
+		Status:       entities.FaturaStatusOpen,
+		Transactions: transactions,
+	}
+
+	switch today := uc.today(); {
+	case !today.Before(closing):
+		fatura.Status = entities.FaturaStatusClosed
+	case today.Before(from):
+		fatura.Status = entities.FaturaStatusFuture
+	}
+
+	slices.SortStableFunc(fatura.Transactions, func(a, b entities.Transaction) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	charges := entities.NewMoney(account.Asset, 0)
+	credits := entities.NewMoney(account.Asset, 0)
+	for _, transaction := range fatura.Transactions {
+		amount := entities.MoneyOf(transaction.Monetary)
+		// Transactions are stored in the account asset
+		if amount.Sign() > 0 {
+			charges, _ = charges.Add(amount)
+		} else {
+			credits, _ = credits.Add(amount.Abs())
+		}
+	}
+	total, _ := charges.Sub(credits)
+
+	fatura.Charges = charges.Monetary()
+	fatura.Credits = credits.Monetary()
+	fatura.Total = total.Monetary()
+	return fatura
+}
+
+// today is the current date, in the UTC midnight transactions are dated with
+func (uc *FaturaUseCase) today() time.Time {
+	now := uc.now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// inFatura reports whether a transaction is billed, drafts and cancelled
+// transactions aren't
+func inFatura(transaction entities.Transaction) bool {
+	return transaction.Status == entities.TransactionStatusPending || transaction.Status == entities.TransactionStatusCleared
+}
+
+// faturaMonth returns the first day of the month the fatura taking a
+// transaction dated date is due
+func faturaMonth(account entities.Account, date time.Time) time.Time {
+	month := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if date.Day() >= dayOfMonth(month, account.StatementClosingDay).Day() {
+		month = month.AddDate(0, 1, 0)
+	}
+	// Cards due before they close are due the month after closing
+	if account.PaymentDueDay <= account.StatementClosingDay {
+		month = month.AddDate(0, 1, 0)
+	}
+	return month
+}
+
+// faturaCycle returns the first day, closing date and due date of the fatura
+// due in month
+func faturaCycle(account entities.Account, month time.Time) (time.Time, time.Time, time.Time) {
+	closingMonth := month
+	if account.PaymentDueDay <= account.StatementClosingDay {
+		closingMonth = month.AddDate(0, -1, 0)
+	}
+	from := dayOfMonth(closingMonth.AddDate(0, -1, 0), account.StatementClosingDay)
+	closing := dayOfMonth(closingMonth, account.StatementClosingDay)
+	due := dayOfMonth(month, account.PaymentDueDay)
+	return from, closing, due
+}
+
+// dayOfMonth returns the day of the month of month, the last day of the month
+// for days it doesn't have, like the 31st of April
+func dayOfMonth(month time.Time, day int) time.Time {
+	last := time.Date(month.Year(), month.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	return time.Date(month.Year(), month.Month(), min(day, last), 0, 0, 0, 0, time.UTC)
+}
+
+// addMonths moves date by months, keeping its day of the month when the target
+// month has it and falling back to its last day otherwise, so a purchase on
+// January 31st is followed by one on February 28th
+func addMonths(date time.Time, months int) time.Time {
+	month := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, date.Location())
+	day := dayOfMonth(month, date.Day())
+	return time.Date(day.Year(), day.Month(), day.Day(), date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+}
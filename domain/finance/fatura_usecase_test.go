@@ -0,0 +1,166 @@
+package finance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaturaCycle(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2025, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name        string
+		closingDay  int
+		dueDay      int
+		date        time.Time
+		wantMonth   time.Time
+		wantFrom    time.Time
+		wantClosing time.Time
+		wantDue     time.Time
+	}{
+		{
+			name:        "due after closing",
+			closingDay:  3,
+			dueDay:      10,
+			date:        date(time.March, 2),
+			wantMonth:   date(time.March, 1),
+			wantFrom:    date(time.February, 3),
+			wantClosing: date(time.March, 3),
+			wantDue:     date(time.March, 10),
+		},
+		{
+			name:        "purchase on the closing day goes to the next fatura",
+			closingDay:  3,
+			dueDay:      10,
+			date:        date(time.March, 3),
+			wantMonth:   date(time.April, 1),
+			wantFrom:    date(time.March, 3),
+			wantClosing: date(time.April, 3),
+			wantDue:     date(time.April, 10),
+		},
+		{
+			name:        "due the month after closing",
+			closingDay:  28,
+			dueDay:      5,
+			date:        date(time.March, 27),
+			wantMonth:   date(time.April, 1),
+			wantFrom:    date(time.February, 28),
+			wantClosing: date(time.March, 28),
+			wantDue:     date(time.April, 5),
+		},
+		{
+			name:        "closing day past the end of the month",
+			closingDay:  31,
+			dueDay:      8,
+			date:        date(time.February, 28),
+			wantMonth:   date(time.April, 1),
+			wantFrom:    date(time.February, 28),
+			wantClosing: date(time.March, 31),
+			wantDue:     date(time.April, 8),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := entities.Account{StatementClosingDay: tt.closingDay, PaymentDueDay: tt.dueDay}
+
+			month := faturaMonth(account, tt.date)
+			assert.Equal(t, tt.wantMonth, month)
+
+			from, closing, due := faturaCycle(account, month)
+			assert.Equal(t, tt.wantFrom, from)
+			assert.Equal(t, tt.wantClosing, closing)
+			assert.Equal(t, tt.wantDue, due)
+		})
+	}
+}
+
+func TestGetFaturas(t *testing.T) {
+	march := func(day int) time.Time {
+		return time.Date(2025, time.March, day, 0, 0, 0, 0, time.UTC)
+	}
+	card := entities.Account{ID: "acc-card", Type: entities.AccountTypeCredit, Asset: monetary.BRL, StatementClosingDay: 3, PaymentDueDay: 10}
+
+	transactions := []entities.Transaction{
+		{ID: "tx-market", Monetary: testMonetary(t, monetary.BRL, 25000), Date: march(2), Status: entities.TransactionStatusCleared},
+		{ID: "tx-payment", Monetary: testMonetary(t, monetary.BRL, -80000), Date: march(10), Status: entities.TransactionStatusCleared},
+		{ID: "tx-tv-1", Monetary: testMonetary(t, monetary.BRL, 50000), Date: march(20), Status: entities.TransactionStatusCleared},
+		{ID: "tx-draft", Monetary: testMonetary(t, monetary.BRL, 1000), Date: march(21), Status: entities.TransactionStatusDraft},
+		{ID: "tx-tv-2", Monetary: testMonetary(t, monetary.BRL, 50000), Date: march(20).AddDate(0, 1, 0), Status: entities.TransactionStatusPending},
+	}
+
+	setup := func() *FaturaUseCase {
+		uc := NewFaturaUseCase(
+			&mocks.TransactionRepositoryMock{
+				GetTransactionsByAccountFunc: func(ctx context.Context, accountID string) ([]entities.Transaction, error) {
+					return transactions, nil
+				},
+				GetTransactionsByAccountAndDateRangeFunc: func(ctx context.Context, accountID string, startDate, endDate time.Time) ([]entities.Transaction, error) {
+					var found []entities.Transaction
+					for _, transaction := range transactions {
+						if !transaction.Date.Before(startDate) && !transaction.Date.After(endDate) {
+							found = append(found, transaction)
+						}
+					}
+					return found, nil
+				},
+			},
+			&mocks.AccountRepositoryMock{
+				GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+					if id == "acc-checking" {
+						return entities.Account{ID: id, Type: entities.AccountTypeChecking, Asset: monetary.BRL}, nil
+					}
+					return card, nil
+				},
+			},
+		)
+		uc.now = func() time.Time { return time.Date(2025, time.March, 25, 15, 0, 0, 0, time.UTC) }
+		return uc
+	}
+
+	t.Run("lists the faturas newest first", func(t *testing.T) {
+		faturas, err := setup().GetFaturas(context.Background(), "acc-card")
+		require.NoError(t, err)
+		require.Len(t, faturas, 3)
+
+		assert.Equal(t, "2025-05", faturas[0].Month)
+		assert.Equal(t, entities.FaturaStatusFuture, faturas[0].Status)
+
+		open := faturas[1]
+		assert.Equal(t, "2025-04", open.Month)
+		assert.Equal(t, entities.FaturaStatusOpen, open.Status)
+		require.Len(t, open.Transactions, 2)
+		assert.Equal(t, "tx-payment", open.Transactions[0].ID)
+		assert.Equal(t, "[BRL (R$) 500.00]", open.Charges.String())
+		assert.Equal(t, "[BRL (R$) 800.00]", open.Credits.String())
+		assert.Equal(t, "[BRL (R$) -300.00]", open.Total.String())
+
+		assert.Equal(t, "2025-03", faturas[2].Month)
+		assert.Equal(t, entities.FaturaStatusClosed, faturas[2].Status)
+		assert.Equal(t, time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC), faturas[2].DueDate)
+	})
+
+	t.Run("gets a fatura by month", func(t *testing.T) {
+		fatura, err := setup().GetFatura(context.Background(), "acc-card", time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.Len(t, fatura.Transactions, 1)
+		assert.Equal(t, "tx-market", fatura.Transactions[0].ID)
+		assert.Equal(t, "[BRL (R$) 250.00]", fatura.Total.String())
+	})
+
+	t.Run("account without billing cycle", func(t *testing.T) {
+		_, err := setup().GetFaturas(context.Background(), "acc-checking")
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+}
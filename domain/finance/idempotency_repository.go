@@ -0,0 +1,20 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+	"time"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/idempotency_repository.go . IdempotencyRepository
+type IdempotencyRepository interface {
+	// ClaimIdempotencyKey records the request for the user of the context,
+	// unless its key was already used. It returns the request recorded with
+	// the key and whether it's the one just recorded.
+	ClaimIdempotencyKey(ctx context.Context, request entities.IdempotentRequest) (entities.IdempotentRequest, bool, error)
+	SaveIdempotentResponse(ctx context.Context, key string, response entities.IdempotentResponse) error
+	DeleteIdempotencyKey(ctx context.Context, key string) error
+	// DeleteIdempotencyKeysBefore deletes the keys of every user used before
+	// a time, returning how many were deleted
+	DeleteIdempotencyKeysBefore(ctx context.Context, before time.Time) (int64, error)
+}
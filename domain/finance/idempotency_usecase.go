@@ -0,0 +1,112 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"time"
+)
+
+// IdempotencyUseCase keeps the response to the writes made with an
+// idempotency key, so the retries of a request that timed out get the result
+// of the first one instead of making the change again.
+//
+// A key belongs to the user who used it, and is claimed by the first request
+// made with it before the request is handled, so concurrent retries don't
+// both go through. Keys are forgotten after entities.IdempotencyKeyTTL.
+type IdempotencyUseCase struct {
+	repo IdempotencyRepository
+	now  func() time.Time
+}
+
+func NewIdempotencyUseCase(repo IdempotencyRepository) *IdempotencyUseCase {
+	return &IdempotencyUseCase{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// Begin claims key for the request with fingerprint. It returns nil when the
+// request is the first made with the key and should be handled, or the
+// response to the first one when it's a retry. A key used for another
+// request is domain.ErrMalformedParameters, and a key whose request is still
+// being handled domain.ErrConflict.
+func (uc *IdempotencyUseCase) Begin(ctx context.Context, key, fingerprint string) (*entities.IdempotentResponse, error) {
+	if err := validateIdempotencyKey(key); err != nil {
+		return nil, err
+	}
+
+	request := entities.IdempotentRequest{Key: key, Fingerprint: fingerprint}
+	stored, claimed, err := uc.repo.ClaimIdempotencyKey(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+	// An expired key is free to be used again
+	if !claimed && stored.CreatedAt.Before(uc.now().Add(-entities.IdempotencyKeyTTL)) {
+		if err := uc.repo.DeleteIdempotencyKey(ctx, key); err != nil {
+			return nil, fmt.Errorf("failed to delete expired idempotency key: %w", err)
+		}
+		stored, claimed, err = uc.repo.ClaimIdempotencyKey(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim idempotency key: %w", err)
+		}
+	}
+	if claimed {
+		return nil, nil
+	}
+
+	if stored.Fingerprint != fingerprint {
+		return nil, fmt.Errorf("idempotency key %q was used for another request: %w", key, domain.ErrMalformedParameters)
+	}
+	if stored.Response == nil {
+		return nil, fmt.Errorf("the request with idempotency key %q is still being handled: %w", key, domain.ErrConflict)
+	}
+	return stored.Response, nil
+}
+
+// Complete keeps the response to the request that claimed key, to be
+// replayed to its retries. Responses larger than
+// entities.MaxIdempotentResponseSize aren't kept, releasing the key instead.
+func (uc *IdempotencyUseCase) Complete(ctx context.Context, key string, response entities.IdempotentResponse) error {
+	if len(response.Body) > entities.MaxIdempotentResponseSize {
+		return uc.Release(ctx, key)
+	}
+
+	if err := uc.repo.SaveIdempotentResponse(ctx, key, response); err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+	return nil
+}
+
+// Release frees key when its request failed, so a retry is handled again
+func (uc *IdempotencyUseCase) Release(ctx context.Context, key string) error {
+	if err := uc.repo.DeleteIdempotencyKey(ctx, key); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// PurgeExpired deletes the keys of every user older than
+// entities.IdempotencyKeyTTL
+func (uc *IdempotencyUseCase) PurgeExpired(ctx context.Context) (int64, error) {
+	deleted, err := uc.repo.DeleteIdempotencyKeysBefore(ctx, uc.now().Add(-entities.IdempotencyKeyTTL))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	return deleted, nil
+}
+
+// validateIdempotencyKey takes keys of up to entities.MaxIdempotencyKeyLength
+// printable ASCII characters, like the UUIDs clients usually send
+func validateIdempotencyKey(key string) error {
+	if key == "" || len(key) > entities.MaxIdempotencyKeyLength {
+		return fmt.Errorf("idempotency key must have 1 to %d characters: %w", entities.MaxIdempotencyKeyLength, domain.ErrMalformedParameters)
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < ' ' || key[i] > '~' {
+			return fmt.Errorf("idempotency key must be printable ASCII: %w", domain.ErrMalformedParameters)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package finance
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyUseCase(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, time.March, 17, 9, 30, 0, 0, time.UTC)
+
+	// The keys recorded so far
+	stored := map[string]entities.IdempotentRequest{}
+	repo := &mocks.IdempotencyRepositoryMock{
+		ClaimIdempotencyKeyFunc: func(ctx context.Context, request entities.IdempotentRequest) (entities.IdempotentRequest, bool, error) {
+			if existing, ok := stored[request.Key]; ok {
+				return existing, false, nil
+			}
+			request.CreatedAt = now
+			stored[request.Key] = request
+			return request, true, nil
+		},
+		SaveIdempotentResponseFunc: func(ctx context.Context, key string, response entities.IdempotentResponse) error {
+			request := stored[key]
+			request.Response = &response
+			stored[key] = request
+			return nil
+		},
+		DeleteIdempotencyKeyFunc: func(ctx context.Context, key string) error {
+			delete(stored, key)
+			return nil
+		},
+	}
+	uc := NewIdempotencyUseCase(repo)
+	uc.now = func() time.Time { return now }
+
+	created := entities.IdempotentResponse{StatusCode: 201, ContentType: "application/json", Body: []byte(`{"id":"tx-1"}`)}
+
+	t.Run("the first request is handled", func(t *testing.T) {
+		replay, err := uc.Begin(ctx, "key-1", "create")
+		require.NoError(t, err)
+		assert.Nil(t, replay)
+	})
+
+	t.Run("a retry while it's handled conflicts", func(t *testing.T) {
+		_, err := uc.Begin(ctx, "key-1", "create")
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("retries get the response", func(t *testing.T) {
+		require.NoError(t, uc.Complete(ctx, "key-1", created))
+
+		replay, err := uc.Begin(ctx, "key-1", "create")
+		require.NoError(t, err)
+		assert.Equal(t, &created, replay)
+	})
+
+	t.Run("the key can't be used for another request", func(t *testing.T) {
+		_, err := uc.Begin(ctx, "key-1", "update")
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+
+	t.Run("a released key is handled again", func(t *testing.T) {
+		_, err := uc.Begin(ctx, "key-2", "create")
+		require.NoError(t, err)
+		require.NoError(t, uc.Release(ctx, "key-2"))
+
+		replay, err := uc.Begin(ctx, "key-2", "create")
+		require.NoError(t, err)
+		assert.Nil(t, replay)
+	})
+
+	t.Run("large responses aren't kept", func(t *testing.T) {
+		_, err := uc.Begin(ctx, "key-3", "import")
+		require.NoError(t, err)
+		require.NoError(t, uc.Complete(ctx, "key-3", entities.IdempotentResponse{StatusCode: 200, Body: make([]byte, entities.MaxIdempotentResponseSize+1)}))
+		assert.NotContains(t, stored, "key-3")
+	})
+
+	t.Run("expired keys are used again", func(t *testing.T) {
+		later := uc.now().Add(entities.IdempotencyKeyTTL + time.Minute)
+		uc.now = func() time.Time { return later }
+		defer func() { uc.now = func() time.Time { return now } }()
+
+		replay, err := uc.Begin(ctx, "key-1", "update")
+		require.NoError(t, err)
+		assert.Nil(t, replay)
+		assert.Equal(t, "update", stored["key-1"].Fingerprint)
+	})
+
+	t.Run("invalid keys", func(t *testing.T) {
+		for _, key := range []string{"", strings.Repeat("k", entities.MaxIdempotencyKeyLength+1), "key\n1", "chave-ção"} {
+			_, err := uc.Begin(ctx, key, "create")
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters, key)
+		}
+	})
+
+	t.Run("purges the expired keys", func(t *testing.T) {
+		var before time.Time
+		repo.DeleteIdempotencyKeysBeforeFunc = func(ctx context.Context, t time.Time) (int64, error) {
+			before = t
+			return 3, nil
+		}
+
+		deleted, err := uc.PurgeExpired(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), deleted)
+		assert.Equal(t, now.Add(-entities.IdempotencyKeyTTL), before)
+	})
+}
@@ -0,0 +1,508 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// StatementParser reads the statement export of a provider
+//
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/statement_parser.go . StatementParser
+type StatementParser interface {
+	ParseStatement(r io.Reader) (entities.ImportedStatement, error)
+}
+
+// CSVParser reads the CSV export of any bank through a column mapping, the
+// rows in the asset of the account they're imported into
+//
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/csv_parser.go . CSVParser
+type CSVParser interface {
+	ParseCSV(r io.Reader, mapping entities.CSVMapping, asset monetary.Asset) ([]entities.ImportedTransaction, error)
+}
+
+// DescriptionParser reads the payee out of the descriptions banks of a country
+// write, for statements without a payee column
+//
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/description_parser.go . DescriptionParser
+type DescriptionParser interface {
+	ParsePayee(description string) (string, bool)
+}
+
+type ImportUseCase struct {
+	parsers map[entities.StatementSource]StatementParser
+	// descriptionParsers are picked by the currency of the line
+	descriptionParsers map[string]DescriptionParser
+	csvParser          CSVParser
+	transactionRepo    TransactionRepository
+	accountRepo        AccountRepository
+	categoryRepo       CategoryRepository
+	balanceRepo        BalanceRepository
+	restorePointRepo   RestorePointRepository
+	quotas             *QuotaGuard
+	ruleActions        []RuleAction
+}
+
+func NewImportUseCase(parsers map[entities.StatementSource]StatementParser, descriptionParsers map[string]DescriptionParser, csvParser CSVParser, transactionRepo TransactionRepository, accountRepo AccountRepository, categoryRepo CategoryRepository, balanceRepo BalanceRepository, restorePointRepo RestorePointRepository, quotas *QuotaGuard, ruleActions []RuleAction) *ImportUseCase {
+	return &ImportUseCase{
+		parsers:            parsers,
+		descriptionParsers: descriptionParsers,
+		csvParser:          csvParser,
+		transactionRepo:    transactionRepo,
+		accountRepo:        accountRepo,
+		categoryRepo:       categoryRepo,
+		balanceRepo:        balanceRepo,
+		restorePointRepo:   restorePointRepo,
+		quotas:             quotas,
+		ruleActions:        ruleActions,
+	}
+}
+
+// ImportStatement creates the transactions of a statement export, each
+// currency into its own account. Lines without a payee get the one the
+// description parser of their currency reads from the description. Lines are
+// filed under the category of the latest transaction with the same payee,
+// falling back to the expense category for money going out and the income one
+// for money coming in. Fees go under the fee category. The rule actions are
+// applied to the lines to create, those rejected are counted and left out,
+// dry runs included. Lines already in the
+// account are skipped, so an export can be imported again after a failure or
+// to pick up its newer lines; a pending transaction whose line has since
+// completed is cleared. The rows the import creates or clears are recorded in
+// a restore point, so it can be rolled back; the point is saved even when the
+// import fails halfway.
+func (uc *ImportUseCase) ImportStatement(ctx context.Context, r io.Reader, options entities.StatementImportOptions) (entities.StatementImportResult, error) {
+	parser, ok := uc.parsers[options.Source]
+	if !ok {
+		return entities.StatementImportResult{}, fmt.Errorf("statement source %q: %w", options.Source, domain.ErrNotFound)
+	}
+
+	if options.ExpenseCategoryID == "" || options.IncomeCategoryID == "" {
+		return entities.StatementImportResult{}, fmt.Errorf("expense and income categories are required: %w", domain.ErrMalformedParameters)
+	}
+	if options.FeeCategoryID == "" {
+		options.FeeCategoryID = options.ExpenseCategoryID
+	}
+	for _, id := range []string{options.ExpenseCategoryID, options.IncomeCategoryID, options.FeeCategoryID} {
+		if _, err := ownCategory(ctx, uc.categoryRepo, id); err != nil {
+			return entities.StatementImportResult{}, fmt.Errorf("failed to get category %s: %w", id, err)
+		}
+	}
+
+	statement, err := parser.ParseStatement(r)
+	if err != nil {
+		return entities.StatementImportResult{}, err
+	}
+
+	lines := map[string][]entities.ImportedTransaction{}
+	withPayee := false
+	for _, line := range statement.Transactions {
+		currency := line.Amount.Asset.Asset
+		if parser, ok := uc.descriptionParsers[currency]; ok && line.Payee == "" && !line.Fee {
+			line.Payee, _ = parser.ParsePayee(line.Description)
+		}
+		withPayee = withPayee || line.Payee != ""
+		lines[currency] = append(lines[currency], line)
+	}
+
+	var payeeCategories map[string]string
+	if withPayee {
+		if payeeCategories, err = uc.payeeCategories(ctx); err != nil {
+			return entities.StatementImportResult{}, err
+		}
+	}
+
+	balances := map[string]monetary.Monetary{}
+	for _, balance := range statement.Balances {
+		balances[balance.Asset.Asset] = balance
+	}
+
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return entities.StatementImportResult{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	point := entities.RestorePoint{
+		Operation:   entities.RestorePointOperationStatementImport,
+		Description: options.Source.Institution() + " statement import",
+	}
+	result := entities.StatementImportResult{Source: options.Source, DryRun: options.DryRun}
+	for _, currency := range slices.Sorted(maps.Keys(lines)) {
+		imported, err := uc.importCurrency(ctx, currency, lines[currency], accounts, payeeCategories, &point, options)
+		if err != nil {
+			// Keep what was imported before the failure revertible
+			if _, saveErr := uc.saveRestorePoint(ctx, point); saveErr != nil {
+				slog.Error("failed to save restore point", "error", saveErr)
+			}
+			return entities.StatementImportResult{}, err
+		}
+		if balance, ok := balances[currency]; ok {
+			imported.StatementBalance = &balance
+		}
+		result.Currencies = append(result.Currencies, imported)
+	}
+
+	if result.RestorePointID, err = uc.saveRestorePoint(ctx, point); err != nil {
+		return entities.StatementImportResult{}, err
+	}
+
+	return result, nil
+}
+
+// ImportTransactions creates the transactions of the CSV export of any bank
+// into one account, reading the rows through the column mapping of the
+// options. Rows are filed like the lines of statement exports: under the
+// category of the latest transaction of their payee, else by whether the money
+// goes out or comes in. Rows already in the account, with the same date,
+// amount and description, are skipped, so an export can be imported again to
+// pick up its newer rows. The transactions are created all together or none
+// at all, and recorded in a restore point.
+func (uc *ImportUseCase) ImportTransactions(ctx context.Context, r io.Reader, options entities.TransactionImportOptions) (entities.TransactionImportResult, error) {
+	if options.AccountID == "" || options.CategoryID == "" {
+		return entities.TransactionImportResult{}, fmt.Errorf("account and category are required: %w", domain.ErrMalformedParameters)
+	}
+	if options.IncomeCategoryID == "" {
+		options.IncomeCategoryID = options.CategoryID
+	}
+
+	account, err := ownAccount(ctx, uc.accountRepo, options.AccountID)
+	if err != nil {
+		return entities.TransactionImportResult{}, fmt.Errorf("failed to get account: %w", err)
+	}
+	for _, id := range []string{options.CategoryID, options.IncomeCategoryID} {
+		if _, err := ownCategory(ctx, uc.categoryRepo, id); err != nil {
+			return entities.TransactionImportResult{}, fmt.Errorf("failed to get category %s: %w", id, err)
+		}
+	}
+
+	lines, err := uc.csvParser.ParseCSV(r, options.Mapping, account.Asset)
+	if err != nil {
+		return entities.TransactionImportResult{}, err
+	}
+
+	withPayee := false
+	for i, line := range lines {
+		if parser, ok := uc.descriptionParsers[account.Asset.Asset]; ok && line.Payee == "" {
+			lines[i].Payee, _ = parser.ParsePayee(line.Description)
+		}
+		withPayee = withPayee || lines[i].Payee != ""
+	}
+
+	var payeeCategories map[string]string
+	if withPayee {
+		if payeeCategories, err = uc.payeeCategories(ctx); err != nil {
+			return entities.TransactionImportResult{}, err
+		}
+	}
+
+	transactions, err := uc.transactionRepo.GetTransactionsByAccount(ctx, account.ID)
+	if err != nil {
+		return entities.TransactionImportResult{}, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	existing := map[string]int{}
+	for _, transaction := range transactions {
+		existing[importKey(transaction.Date.Format("2006-01-02"), transaction.Monetary, transaction.Description)]++
+	}
+
+	result := entities.TransactionImportResult{Account: account, DryRun: options.DryRun}
+	checked := map[string]bool{options.CategoryID: true, options.IncomeCategoryID: true}
+	for _, line := range lines {
+		key := importKey(line.Date.Format("2006-01-02"), line.Amount, line.Description)
+		if existing[key] > 0 {
+			existing[key]--
+			result.Skipped = append(result.Skipped, line)
+			continue
+		}
+
+		transaction := entities.Transaction{
+			AccountID:   account.ID,
+			CategoryID:  options.CategoryID,
+			Monetary:    line.Amount,
+			Description: line.Description,
+			Payee:       line.Payee,
+			Date:        line.Date,
+			Status:      entities.TransactionStatusCleared,
+		}
+		switch {
+		case payeeCategories[strings.ToLower(line.Payee)] != "":
+			transaction.CategoryID = payeeCategories[strings.ToLower(line.Payee)]
+		case entities.MoneyOf(line.Amount).Sign() > 0:
+			transaction.CategoryID = options.IncomeCategoryID
+		}
+
+		transaction, err = uc.applyRuleActions(ctx, transaction, checked)
+		var rejected *domain.RejectedError
+		if errors.As(err, &rejected) {
+			result.Rejected = append(result.Rejected, line)
+			continue
+		}
+		if err != nil {
+			return entities.TransactionImportResult{}, err
+		}
+		result.Created = append(result.Created, transaction)
+	}
+
+	if options.DryRun || len(result.Created) == 0 {
+		return result, nil
+	}
+	if err := uc.quotas.CheckTransactions(ctx, len(result.Created)); err != nil {
+		return entities.TransactionImportResult{}, err
+	}
+
+	if result.Created, err = uc.transactionRepo.CreateTransactions(ctx, result.Created); err != nil {
+		return entities.TransactionImportResult{}, fmt.Errorf("failed to create transactions: %w", err)
+	}
+
+	point := entities.RestorePoint{
+		Operation:   entities.RestorePointOperationCSVImport,
+		Description: "CSV import into " + account.Name,
+	}
+	for _, transaction := range result.Created {
+		point.Created(entities.RestorePointEntityTransaction, transaction.ID, account.ID)
+	}
+	if result.RestorePointID, err = uc.saveRestorePoint(ctx, point); err != nil {
+		return entities.TransactionImportResult{}, err
+	}
+
+	if err := uc.balanceRepo.RefreshAccountBalance(ctx, account.ID); err != nil {
+		return entities.TransactionImportResult{}, fmt.Errorf("failed to refresh balance: %w", err)
+	}
+
+	return result, nil
+}
+
+// newLines counts the lines that aren't in the account yet, those the import
+// creates
+func newLines(lines []entities.ImportedTransaction, existing map[string][]entities.Transaction) int {
+	matched := map[string]int{}
+	count := 0
+	for _, line := range lines {
+		key := importKey(line.Date.Format("2006-01-02"), line.Amount, line.Description)
+		if matched[key] < len(existing[key]) {
+			matched[key]++
+			continue
+		}
+		count++
+	}
+	return count
+}
+
+// saveRestorePoint saves the restore point of an import that changed rows,
+// returning its ID
+func (uc *ImportUseCase) saveRestorePoint(ctx context.Context, point entities.RestorePoint) (string, error) {
+	if len(point.Changes) == 0 {
+		return "", nil
+	}
+
+	saved, err := uc.restorePointRepo.CreateRestorePoint(ctx, point)
+	if err != nil {
+		return "", fmt.Errorf("failed to save restore point: %w", err)
+	}
+	return saved.ID, nil
+}
+
+// importCurrency imports the lines of a currency into its account
+func (uc *ImportUseCase) importCurrency(ctx context.Context, currency string, lines []entities.ImportedTransaction, accounts []entities.Account, payeeCategories map[string]string, point *entities.RestorePoint, options entities.StatementImportOptions) (entities.CurrencyImport, error) {
+	imported := entities.CurrencyImport{Currency: currency}
+
+	account, err := uc.currencyAccount(ctx, currency, accounts, options)
+	if err != nil {
+		return entities.CurrencyImport{}, err
+	}
+
+	// The transactions already in the account, by line
+	existing := map[string][]entities.Transaction{}
+	if account.ID != "" {
+		transactions, err := uc.transactionRepo.GetTransactionsByAccount(ctx, account.ID)
+		if err != nil {
+			return entities.CurrencyImport{}, fmt.Errorf("failed to get transactions: %w", err)
+		}
+		for _, transaction := range transactions {
+			key := importKey(transaction.Date.Format("2006-01-02"), transaction.Monetary, transaction.Description)
+			existing[key] = append(existing[key], transaction)
+		}
+	}
+
+	if !options.DryRun {
+		if err := uc.quotas.CheckTransactions(ctx, newLines(lines, existing)); err != nil {
+			return entities.CurrencyImport{}, err
+		}
+	}
+
+	if account.ID == "" {
+		if !options.DryRun {
+			if err := uc.quotas.CheckAccounts(ctx, 1); err != nil {
+				return entities.CurrencyImport{}, err
+			}
+			account, err = uc.accountRepo.CreateAccount(ctx, account)
+			if err != nil {
+				return entities.CurrencyImport{}, fmt.Errorf("failed to create %s account: %w", currency, err)
+			}
+			point.Created(entities.RestorePointEntityAccount, account.ID, "")
+		}
+		imported.AccountCreated = true
+	}
+	imported.Account = account
+
+	checked := map[string]bool{}
+	for _, line := range lines {
+		status := entities.TransactionStatusCleared
+		if line.Pending {
+			status = entities.TransactionStatusPending
+		}
+
+		key := importKey(line.Date.Format("2006-01-02"), line.Amount, line.Description)
+		if matches := existing[key]; len(matches) > 0 {
+			existing[key] = matches[1:]
+			imported.Skipped++
+			if matches[0].Status == entities.TransactionStatusPending && status == entities.TransactionStatusCleared && !options.DryRun {
+				if _, err := uc.transactionRepo.UpdateTransactionStatus(ctx, matches[0].ID, status); err != nil {
+					return entities.CurrencyImport{}, fmt.Errorf("failed to clear transaction %s: %w", matches[0].ID, err)
+				}
+				point.Updated(matches[0])
+			}
+			continue
+		}
+
+		transaction := entities.Transaction{
+			AccountID:   account.ID,
+			CategoryID:  options.ExpenseCategoryID,
+			Monetary:    line.Amount,
+			Description: line.Description,
+			Payee:       line.Payee,
+			Date:        line.Date,
+			Status:      status,
+		}
+		switch {
+		case line.Fee:
+			transaction.CategoryID = options.FeeCategoryID
+		case payeeCategories[strings.ToLower(line.Payee)] != "":
+			transaction.CategoryID = payeeCategories[strings.ToLower(line.Payee)]
+		case entities.MoneyOf(line.Amount).Sign() > 0:
+			transaction.CategoryID = options.IncomeCategoryID
+		}
+
+		transaction, err = uc.applyRuleActions(ctx, transaction, checked)
+		var rejected *domain.RejectedError
+		if errors.As(err, &rejected) {
+			imported.Rejected++
+			continue
+		}
+		if err != nil {
+			return entities.CurrencyImport{}, err
+		}
+
+		if !options.DryRun {
+			transaction, err = uc.transactionRepo.CreateTransaction(ctx, transaction)
+			if err != nil {
+				return entities.CurrencyImport{}, fmt.Errorf("failed to create transaction: %w", err)
+			}
+			point.Created(entities.RestorePointEntityTransaction, transaction.ID, account.ID)
+		}
+		imported.Transactions = append(imported.Transactions, transaction)
+	}
+
+	if !options.DryRun {
+		if err := uc.balanceRepo.RefreshAccountBalance(ctx, account.ID); err != nil {
+			return entities.CurrencyImport{}, fmt.Errorf("failed to refresh balance: %w", err)
+		}
+		balance, err := uc.balanceRepo.GetBalanceByAccountID(ctx, account.ID)
+		if err != nil {
+			return entities.CurrencyImport{}, fmt.Errorf("failed to get balance: %w", err)
+		}
+		imported.AccountBalance = &balance.CurrentBalance
+	}
+
+	return imported, nil
+}
+
+// applyRuleActions applies the rule actions to an imported transaction,
+// keeping its account, amount and date. The categories the actions pick are
+// checked to be the user's, once per import through checked, and their tags
+// normalized like those of new transactions.
+func (uc *ImportUseCase) applyRuleActions(ctx context.Context, transaction entities.Transaction, checked map[string]bool) (entities.Transaction, error) {
+	applied, err := applyRuleActions(ctx, uc.ruleActions, transaction)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+	applied.AccountID, applied.Monetary, applied.Date = transaction.AccountID, transaction.Monetary, transaction.Date
+	if applied.Tags, err = normalizeTags(applied.Tags); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	if applied.CategoryID != transaction.CategoryID && !checked[applied.CategoryID] {
+		if _, err := ownCategory(ctx, uc.categoryRepo, applied.CategoryID); err != nil {
+			return entities.Transaction{}, fmt.Errorf("failed to get category %s: %w", applied.CategoryID, err)
+		}
+		checked[applied.CategoryID] = true
+	}
+
+	return applied, nil
+}
+
+// currencyAccount finds the account of a currency: the one picked in the
+// options, else the first of the source's institution in the currency. When
+// there is none, the account to create is returned, without an ID.
+func (uc *ImportUseCase) currencyAccount(ctx context.Context, currency string, accounts []entities.Account, options entities.StatementImportOptions) (entities.Account, error) {
+	if id, ok := options.Accounts[currency]; ok {
+		account, err := ownAccount(ctx, uc.accountRepo, id)
+		if err != nil {
+			return entities.Account{}, fmt.Errorf("failed to get %s account: %w", currency, err)
+		}
+		if account.Asset.Asset != currency {
+			return entities.Account{}, fmt.Errorf("account %s holds %s, not %s: %w", id, account.Asset.Asset, currency, domain.ErrMalformedParameters)
+		}
+		return account, nil
+	}
+
+	institution := options.Source.Institution()
+	for _, account := range accounts {
+		if strings.EqualFold(account.Institution, institution) && account.Asset.Asset == currency {
+			return account, nil
+		}
+	}
+
+	asset, ok := entities.FindSupportedAsset(currency)
+	if !ok {
+		return entities.Account{}, fmt.Errorf("unknown currency %s: %w", currency, domain.ErrMalformedParameters)
+	}
+	return entities.Account{
+		Name:        institution + " " + currency,
+		Type:        entities.AccountTypeChecking,
+		Asset:       asset,
+		Institution: institution,
+	}, nil
+}
+
+// payeeCategories maps the payees seen before, lowercased, to the category of
+// their latest transaction
+func (uc *ImportUseCase) payeeCategories(ctx context.Context) (map[string]string, error) {
+	transactions, err := uc.transactionRepo.GetAllTransactions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	// Newest first, so the first one of each payee wins
+	categories := map[string]string{}
+	for _, transaction := range transactions {
+		payee := strings.ToLower(transaction.Payee)
+		if _, ok := categories[payee]; payee != "" && !ok {
+			categories[payee] = transaction.CategoryID
+		}
+	}
+	return categories, nil
+}
+
+// importKey identifies a line by what a statement shows of it
+func importKey(date string, amount monetary.Monetary, description string) string {
+	return date + "|" + entities.MoneyOf(amount).Amount.String() + "|" + strings.TrimSpace(description)
+}
@@ -0,0 +1,471 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportStatement(t *testing.T) {
+	money := func(asset monetary.Asset, cents int64) monetary.Monetary {
+		m := &monetary.Monetary{Asset: asset, Amount: big.NewInt(cents)}
+		return *m
+	}
+	march := func(day int) time.Time {
+		return time.Date(2025, time.March, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	statement := entities.ImportedStatement{
+		Source: entities.StatementSourceWise,
+		Transactions: []entities.ImportedTransaction{
+			{Date: march(14), Amount: money(monetary.GBP, -2500), Description: "Bakery"},
+			{Date: march(14), Amount: money(monetary.GBP, -80), Description: "Wise fee: Bakery", Fee: true},
+			{Date: march(10), Amount: money(monetary.GBP, 100000), Description: "Rent from John"},
+			{Date: march(2), Amount: money(monetary.USD, -19880), Description: "Converted to GBP"},
+		},
+		Balances: []monetary.Monetary{money(monetary.GBP, 97420), money(monetary.USD, 30000)},
+	}
+	parser := &mocks.StatementParserMock{
+		ParseStatementFunc: func(r io.Reader) (entities.ImportedStatement, error) {
+			return statement, nil
+		},
+	}
+
+	// The restore point repository of the last setup
+	var restorePointRepo *mocks.RestorePointRepositoryMock
+	setup := func() (*ImportUseCase, *mocks.TransactionRepositoryMock, *mocks.AccountRepositoryMock) {
+		transactionRepo := &mocks.TransactionRepositoryMock{
+			GetTransactionsByAccountFunc: func(ctx context.Context, accountID string) ([]entities.Transaction, error) {
+				// Rent was imported before, while pending
+				return []entities.Transaction{
+					{ID: "tx-rent", AccountID: accountID, Monetary: money(monetary.GBP, 100000), Description: "Rent from John", Date: march(10), Status: entities.TransactionStatusPending},
+				}, nil
+			},
+			CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+				transaction.ID = "tx-" + transaction.Description
+				return transaction, nil
+			},
+			GetAllTransactionsFunc: func(ctx context.Context) ([]entities.Transaction, error) {
+				return []entities.Transaction{
+					{ID: "tx-3", CategoryID: "cat-rent", Payee: "MARIA DE SOUZA"},
+					{ID: "tx-2", CategoryID: "cat-old", Payee: "Maria de Souza"},
+					{ID: "tx-1", CategoryID: "cat-groceries", Payee: "Mercado"},
+				}, nil
+			},
+		}
+		accountRepo := &mocks.AccountRepositoryMock{
+			GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+				return []entities.Account{
+					{ID: "acc-checking", Name: "Checking", Asset: monetary.GBP},
+					{ID: "acc-wise-eur", Name: "Wise Euro", Asset: monetary.GBP, Institution: "wise"},
+				}, nil
+			},
+			GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+				return entities.Account{ID: id, Asset: monetary.GBP}, nil
+			},
+			CreateAccountFunc: func(ctx context.Context, account entities.Account) (entities.Account, error) {
+				account.ID = "acc-new"
+				return account, nil
+			},
+		}
+		restorePointRepo = &mocks.RestorePointRepositoryMock{
+			CreateRestorePointFunc: func(ctx context.Context, point entities.RestorePoint) (entities.RestorePoint, error) {
+				point.ID = "rp-1"
+				return point, nil
+			},
+		}
+		uc := NewImportUseCase(
+			map[entities.StatementSource]StatementParser{entities.StatementSourceWise: parser},
+			map[string]DescriptionParser{"BRL": &mocks.DescriptionParserMock{
+				ParsePayeeFunc: func(description string) (string, bool) {
+					name, ok := strings.CutPrefix(description, "PIX TRANSF ")
+					return name, ok
+				},
+			}},
+			nil,
+			transactionRepo,
+			accountRepo,
+			&mocks.CategoryRepositoryMock{
+				GetCategoryByIDFunc: func(ctx context.Context, id string) (entities.Category, error) {
+					if id == "cat-missing" {
+						return entities.Category{}, domain.ErrNotFound
+					}
+					return entities.Category{ID: id}, nil
+				},
+			},
+			&mocks.BalanceRepositoryMock{
+				GetBalanceByAccountIDFunc: func(ctx context.Context, accountID string) (entities.Balance, error) {
+					return entities.Balance{AccountID: accountID, CurrentBalance: money(monetary.GBP, 97420)}, nil
+				},
+			},
+			restorePointRepo,
+			nil,
+			nil,
+		)
+		return uc, transactionRepo, accountRepo
+	}
+	options := entities.StatementImportOptions{
+		Source:            entities.StatementSourceWise,
+		ExpenseCategoryID: "cat-expense",
+		IncomeCategoryID:  "cat-income",
+	}
+
+	t.Run("imports each currency into its account", func(t *testing.T) {
+		uc, transactionRepo, accountRepo := setup()
+		result, err := uc.ImportStatement(context.Background(), strings.NewReader(""), options)
+		require.NoError(t, err)
+		require.Len(t, result.Currencies, 2)
+
+		eur := result.Currencies[0]
+		assert.Equal(t, "GBP", eur.Currency)
+		assert.Equal(t, "acc-wise-eur", eur.Account.ID)
+		assert.False(t, eur.AccountCreated)
+		assert.Equal(t, 1, eur.Skipped)
+		require.Len(t, eur.Transactions, 2)
+		assert.Equal(t, "cat-expense", eur.Transactions[0].CategoryID)
+		// Fees default to the expense category
+		assert.Equal(t, "cat-expense", eur.Transactions[1].CategoryID)
+		assert.Equal(t, entities.TransactionStatusCleared, eur.Transactions[0].Status)
+		assert.Equal(t, "[GBP (£) 974.20]", eur.StatementBalance.String())
+		assert.Equal(t, "[GBP (£) 974.20]", eur.AccountBalance.String())
+
+		// The pending rent cleared since the last import
+		require.Len(t, transactionRepo.UpdateTransactionStatusCalls(), 1)
+		assert.Equal(t, "tx-rent", transactionRepo.UpdateTransactionStatusCalls()[0].ID)
+
+		usd := result.Currencies[1]
+		assert.Equal(t, "USD", usd.Currency)
+		assert.True(t, usd.AccountCreated)
+		require.Len(t, accountRepo.CreateAccountCalls(), 1)
+		created := accountRepo.CreateAccountCalls()[0].Account
+		assert.Equal(t, "Wise USD", created.Name)
+		assert.Equal(t, "Wise", created.Institution)
+		assert.Equal(t, monetary.USD, created.Asset)
+		require.Len(t, usd.Transactions, 1)
+		assert.Equal(t, "acc-new", usd.Transactions[0].AccountID)
+
+		// Everything the import changed can be rolled back
+		assert.Equal(t, "rp-1", result.RestorePointID)
+		require.Len(t, restorePointRepo.CreateRestorePointCalls(), 1)
+		point := restorePointRepo.CreateRestorePointCalls()[0].Point
+		assert.Equal(t, entities.RestorePointOperationStatementImport, point.Operation)
+		assert.Equal(t, "Wise statement import", point.Description)
+		require.Len(t, point.Changes, 5)
+		assert.Equal(t, entities.RestorePointChange{Entity: entities.RestorePointEntityTransaction, Action: entities.RestorePointActionCreated, ID: "tx-Bakery", AccountID: "acc-wise-eur"}, point.Changes[0])
+		assert.Equal(t, entities.RestorePointActionUpdated, point.Changes[2].Action)
+		assert.Equal(t, "tx-rent", point.Changes[2].ID)
+		require.NotNil(t, point.Changes[2].Before)
+		assert.Equal(t, entities.TransactionStatusPending, point.Changes[2].Before.Status)
+		assert.Equal(t, entities.RestorePointChange{Entity: entities.RestorePointEntityAccount, Action: entities.RestorePointActionCreated, ID: "acc-new"}, point.Changes[3])
+		assert.Equal(t, "acc-new", point.Changes[4].AccountID)
+	})
+
+	t.Run("failed import keeps its restore point", func(t *testing.T) {
+		uc, transactionRepo, _ := setup()
+		transactionRepo.CreateTransactionFunc = func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+			if transaction.Monetary.Asset == monetary.USD {
+				return entities.Transaction{}, errors.New("connection reset")
+			}
+			transaction.ID = "tx-" + transaction.Description
+			return transaction, nil
+		}
+
+		_, err := uc.ImportStatement(context.Background(), strings.NewReader(""), options)
+		require.Error(t, err)
+		require.Len(t, restorePointRepo.CreateRestorePointCalls(), 1)
+		// The GBP lines and the USD account made it in
+		assert.Len(t, restorePointRepo.CreateRestorePointCalls()[0].Point.Changes, 4)
+	})
+
+	t.Run("quota reached", func(t *testing.T) {
+		uc, transactionRepo, accountRepo := setup()
+		uc.quotas = NewQuotaGuard(&mocks.UserRepositoryMock{
+			GetUserUsageFunc: func(ctx context.Context, id string, since time.Time) (entities.UserUsage, error) {
+				return entities.UserUsage{Tier: entities.UserTierFree, MonthlyTransactions: 98 + len(transactionRepo.CreateTransactionCalls())}, nil
+			},
+		}, QuotaPolicy{MaxMonthlyTransactions: 100})
+		uc.categoryRepo = &mocks.CategoryRepositoryMock{
+			GetCategoryByIDFunc: func(ctx context.Context, id string) (entities.Category, error) {
+				return entities.Category{ID: id, OwnerID: "user-1"}, nil
+			},
+		}
+
+		_, err := uc.ImportStatement(domain.WithUser(context.Background(), "user-1"), strings.NewReader(""), options)
+		assert.ErrorIs(t, err, domain.ErrTooManyRequests)
+		// The GBP lines not imported before fit, the USD one doesn't
+		assert.Len(t, transactionRepo.CreateTransactionCalls(), 2)
+		assert.Empty(t, accountRepo.CreateAccountCalls())
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		uc, transactionRepo, accountRepo := setup()
+		dryRun := options
+		dryRun.DryRun = true
+		dryRun.FeeCategoryID = "cat-fees"
+		result, err := uc.ImportStatement(context.Background(), strings.NewReader(""), dryRun)
+		require.NoError(t, err)
+
+		assert.True(t, result.DryRun)
+		assert.Equal(t, "cat-fees", result.Currencies[0].Transactions[1].CategoryID)
+		assert.True(t, result.Currencies[1].AccountCreated)
+		assert.Nil(t, result.Currencies[0].AccountBalance)
+		assert.Empty(t, transactionRepo.CreateTransactionCalls())
+		assert.Empty(t, transactionRepo.UpdateTransactionStatusCalls())
+		assert.Empty(t, accountRepo.CreateAccountCalls())
+		assert.Empty(t, result.RestorePointID)
+		assert.Empty(t, restorePointRepo.CreateRestorePointCalls())
+	})
+
+	t.Run("picked accounts", func(t *testing.T) {
+		uc, _, _ := setup()
+		picked := options
+		picked.Accounts = map[string]string{"GBP": "acc-checking"}
+		result, err := uc.ImportStatement(context.Background(), strings.NewReader(""), picked)
+		require.NoError(t, err)
+		assert.Equal(t, "acc-checking", result.Currencies[0].Account.ID)
+
+		picked.Accounts = map[string]string{"USD": "acc-checking"}
+		_, err = uc.ImportStatement(context.Background(), strings.NewReader(""), picked)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+
+	t.Run("payees", func(t *testing.T) {
+		uc, transactionRepo, _ := setup()
+		wise := statement
+		defer func() { statement = wise }()
+		statement = entities.ImportedStatement{
+			Source: entities.StatementSourceWise,
+			Transactions: []entities.ImportedTransaction{
+				{Date: march(5), Amount: money(monetary.BRL, -150000), Description: "PIX TRANSF Maria de Souza"},
+				{Date: march(5), Amount: money(monetary.BRL, -500), Description: "Tarifa PIX", Fee: true},
+				{Date: march(6), Amount: money(monetary.BRL, -4500), Description: "Card purchase", Payee: "mercado"},
+				{Date: march(7), Amount: money(monetary.BRL, 300000), Description: "PIX TRANSF Acme"},
+				{Date: march(8), Amount: money(monetary.GBP, -1000), Description: "PIX TRANSF Nobody"},
+			},
+		}
+
+		result, err := uc.ImportStatement(context.Background(), strings.NewReader(""), options)
+		require.NoError(t, err)
+		require.Len(t, result.Currencies, 2)
+
+		brl := result.Currencies[0].Transactions
+		require.Len(t, brl, 4)
+		assert.Equal(t, "Maria de Souza", brl[0].Payee)
+		// The category of the latest transaction of the payee
+		assert.Equal(t, "cat-rent", brl[0].CategoryID)
+		assert.Equal(t, "", brl[1].Payee)
+		assert.Equal(t, "cat-expense", brl[1].CategoryID)
+		assert.Equal(t, "cat-groceries", brl[2].CategoryID)
+		assert.Equal(t, "Acme", brl[3].Payee)
+		assert.Equal(t, "cat-income", brl[3].CategoryID)
+
+		// Only BRL descriptions are parsed
+		assert.Equal(t, "", result.Currencies[1].Transactions[0].Payee)
+		assert.Len(t, transactionRepo.GetAllTransactionsCalls(), 1)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		uc, _, _ := setup()
+
+		unknown := options
+		unknown.Source = "paypal"
+		_, err := uc.ImportStatement(context.Background(), strings.NewReader(""), unknown)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		missing := options
+		missing.IncomeCategoryID = ""
+		_, err = uc.ImportStatement(context.Background(), strings.NewReader(""), missing)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+
+		missing = options
+		missing.FeeCategoryID = "cat-missing"
+		_, err = uc.ImportStatement(context.Background(), strings.NewReader(""), missing)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestImportTransactions(t *testing.T) {
+	money := func(cents int64) monetary.Monetary {
+		return monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(cents)}
+	}
+	march := func(day int) time.Time {
+		return time.Date(2025, time.March, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	rows := []entities.ImportedTransaction{
+		{Date: march(3), Amount: money(-4500), Description: "PADARIA"},
+		{Date: march(3), Amount: money(-4500), Description: "PADARIA"},
+		{Date: march(5), Amount: money(-12000), Description: "PIX TRANSF MARIA"},
+		{Date: march(5), Amount: money(850000), Description: "SALARIO"},
+	}
+	mapping := entities.CSVMapping{Date: "Data", Amount: "Valor", Description: "Histórico"}
+
+	setup := func(ruleActions ...RuleAction) (*ImportUseCase, *mocks.TransactionRepositoryMock, *mocks.CSVParserMock) {
+		parser := &mocks.CSVParserMock{
+			ParseCSVFunc: func(r io.Reader, mapping entities.CSVMapping, asset monetary.Asset) ([]entities.ImportedTransaction, error) {
+				return append([]entities.ImportedTransaction(nil), rows...), nil
+			},
+		}
+		transactionRepo := &mocks.TransactionRepositoryMock{
+			GetTransactionsByAccountFunc: func(ctx context.Context, accountID string) ([]entities.Transaction, error) {
+				// One of the bakery rows was imported before
+				return []entities.Transaction{
+					{ID: "tx-bakery", AccountID: accountID, Monetary: money(-4500), Description: "PADARIA", Date: march(3)},
+				}, nil
+			},
+			GetAllTransactionsFunc: func(ctx context.Context) ([]entities.Transaction, error) {
+				return []entities.Transaction{{ID: "tx-1", CategoryID: "cat-family", Payee: "Maria"}}, nil
+			},
+			CreateTransactionsFunc: func(ctx context.Context, transactions []entities.Transaction) ([]entities.Transaction, error) {
+				for i := range transactions {
+					transactions[i].ID = "tx-" + transactions[i].Description
+				}
+				return transactions, nil
+			},
+		}
+		uc := NewImportUseCase(
+			nil,
+			map[string]DescriptionParser{"BRL": &mocks.DescriptionParserMock{
+				ParsePayeeFunc: func(description string) (string, bool) {
+					name, ok := strings.CutPrefix(description, "PIX TRANSF ")
+					return name, ok
+				},
+			}},
+			parser,
+			transactionRepo,
+			&mocks.AccountRepositoryMock{
+				GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+					if id == "acc-missing" {
+						return entities.Account{}, domain.ErrNotFound
+					}
+					return entities.Account{ID: id, Name: "Checking", Asset: monetary.BRL}, nil
+				},
+			},
+			&mocks.CategoryRepositoryMock{
+				GetCategoryByIDFunc: func(ctx context.Context, id string) (entities.Category, error) {
+					return entities.Category{ID: id}, nil
+				},
+			},
+			&mocks.BalanceRepositoryMock{},
+			&mocks.RestorePointRepositoryMock{
+				CreateRestorePointFunc: func(ctx context.Context, point entities.RestorePoint) (entities.RestorePoint, error) {
+					point.ID = "rp-1"
+					return point, nil
+				},
+			},
+			nil,
+			ruleActions,
+		)
+		return uc, transactionRepo, parser
+	}
+	options := entities.TransactionImportOptions{
+		AccountID:        "acc-checking",
+		CategoryID:       "cat-expense",
+		IncomeCategoryID: "cat-income",
+		Mapping:          mapping,
+	}
+
+	t.Run("creates the new rows together", func(t *testing.T) {
+		uc, transactionRepo, parser := setup()
+		result, err := uc.ImportTransactions(context.Background(), strings.NewReader(""), options)
+		require.NoError(t, err)
+
+		require.Len(t, parser.ParseCSVCalls(), 1)
+		assert.Equal(t, mapping, parser.ParseCSVCalls()[0].Mapping)
+		assert.Equal(t, monetary.BRL, parser.ParseCSVCalls()[0].Asset)
+
+		require.Len(t, result.Skipped, 1)
+		assert.Equal(t, "PADARIA", result.Skipped[0].Description)
+		require.Len(t, result.Created, 3)
+		assert.Equal(t, "tx-PADARIA", result.Created[0].ID)
+		assert.Equal(t, "cat-expense", result.Created[0].CategoryID)
+		// Filed under the category of the payee read from the description
+		assert.Equal(t, "MARIA", result.Created[1].Payee)
+		assert.Equal(t, "cat-family", result.Created[1].CategoryID)
+		assert.Equal(t, "cat-income", result.Created[2].CategoryID)
+		assert.Equal(t, "rp-1", result.RestorePointID)
+		assert.Len(t, transactionRepo.CreateTransactionsCalls(), 1)
+		assert.Empty(t, transactionRepo.CreateTransactionCalls())
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		uc, transactionRepo, _ := setup()
+		dryRun := options
+		dryRun.DryRun = true
+		result, err := uc.ImportTransactions(context.Background(), strings.NewReader(""), dryRun)
+		require.NoError(t, err)
+		assert.True(t, result.DryRun)
+		assert.Len(t, result.Created, 3)
+		assert.Empty(t, result.RestorePointID)
+		assert.Empty(t, transactionRepo.CreateTransactionsCalls())
+	})
+
+	t.Run("rule actions", func(t *testing.T) {
+		uc, transactionRepo, _ := setup(&mocks.RuleActionMock{
+			ApplyFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+				switch transaction.Description {
+				case "PADARIA":
+					transaction.CategoryID = "cat-bakery"
+					// Imports keep the amount of the row
+					transaction.Monetary = money(-1)
+				case "SALARIO":
+					return entities.Transaction{}, &domain.RejectedError{Script: "payroll", Reason: "imported from the payslips"}
+				}
+				return transaction, nil
+			},
+		})
+		result, err := uc.ImportTransactions(context.Background(), strings.NewReader(""), options)
+		require.NoError(t, err)
+
+		require.Len(t, result.Rejected, 1)
+		assert.Equal(t, "SALARIO", result.Rejected[0].Description)
+		require.Len(t, result.Created, 2)
+		assert.Equal(t, "cat-bakery", result.Created[0].CategoryID)
+		assert.Equal(t, money(-4500), result.Created[0].Monetary)
+		require.Len(t, transactionRepo.CreateTransactionsCalls(), 1)
+		assert.Len(t, transactionRepo.CreateTransactionsCalls()[0].Transactions, 2)
+
+		failing, _, _ := setup(&mocks.RuleActionMock{
+			ApplyFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+				return entities.Transaction{}, errors.New("script payroll failed: time limit exceeded")
+			},
+		})
+		_, err = failing.ImportTransactions(context.Background(), strings.NewReader(""), options)
+		assert.EqualError(t, err, "failed to apply rule action: script payroll failed: time limit exceeded")
+	})
+
+	t.Run("failed insert", func(t *testing.T) {
+		uc, transactionRepo, _ := setup()
+		transactionRepo.CreateTransactionsFunc = func(ctx context.Context, transactions []entities.Transaction) ([]entities.Transaction, error) {
+			return nil, errors.New("connection reset")
+		}
+		_, err := uc.ImportTransactions(context.Background(), strings.NewReader(""), options)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		uc, _, _ := setup()
+
+		missing := options
+		missing.CategoryID = ""
+		_, err := uc.ImportTransactions(context.Background(), strings.NewReader(""), missing)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+
+		missing = options
+		missing.AccountID = "acc-missing"
+		_, err = uc.ImportTransactions(context.Background(), strings.NewReader(""), missing)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,16 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+	"time"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/installment_repository.go . InstallmentRepository
+type InstallmentRepository interface {
+	CreateInstallmentPlan(ctx context.Context, plan entities.InstallmentPlan) (entities.InstallmentPlan, error)
+	GetInstallmentPlanByID(ctx context.Context, id string) (entities.InstallmentPlan, error)
+	GetAllInstallmentPlans(ctx context.Context) ([]entities.InstallmentPlan, error)
+	MarkInstallmentPlanPaidOff(ctx context.Context, id string, date time.Time) (entities.InstallmentPlan, error)
+	DeleteInstallmentPlan(ctx context.Context, id string) error
+}
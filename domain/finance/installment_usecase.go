@@ -0,0 +1,324 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"time"
+)
+
+// maxPayoffRate caps the monthly rate, in percent, the remaining installments
+// are discounted at when paid in advance
+const maxPayoffRate = 20
+
+type InstallmentUseCase struct {
+	installmentRepo InstallmentRepository
+	transactionRepo TransactionRepository
+	balanceRepo     BalanceRepository
+	now             func() time.Time
+}
+
+func NewInstallmentUseCase(installmentRepo InstallmentRepository, transactionRepo TransactionRepository, balanceRepo BalanceRepository) *InstallmentUseCase {
+	return &InstallmentUseCase{
+		installmentRepo: installmentRepo,
+		transactionRepo: transactionRepo,
+		balanceRepo:     balanceRepo,
+		now:             time.Now,
+	}
+}
+
+// GetInstallmentPlans returns the installment plans, newest first, with their
+// installments and how many were paid
+func (uc *InstallmentUseCase) GetInstallmentPlans(ctx context.Context) ([]entities.InstallmentPlan, error) {
+	plans, err := uc.installmentRepo.GetAllInstallmentPlans(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installment plans: %w", err)
+	}
+
+	for i, plan := range plans {
+		if plans[i], err = uc.withInstallments(ctx, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	return plans, nil
+}
+
+func (uc *InstallmentUseCase) GetInstallmentPlan(ctx context.Context, id string) (entities.InstallmentPlan, error) {
+	if id == "" {
+		return entities.InstallmentPlan{}, fmt.Errorf("installment plan ID cannot be empty")
+	}
+
+	plan, err := uc.installmentRepo.GetInstallmentPlanByID(ctx, id)
+	if err != nil {
+		return entities.InstallmentPlan{}, fmt.Errorf("failed to get installment plan: %w", err)
+	}
+
+	return uc.withInstallments(ctx, plan)
+}
+
+// GetInstallmentCommitments adds up the installments the plans still have to
+// charge, one entry per asset with the months ahead in order
+func (uc *InstallmentUseCase) GetInstallmentCommitments(ctx context.Context) ([]entities.InstallmentCommitments, error) {
+	plans, err := uc.GetInstallmentPlans(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	today := uc.today()
+	byAsset := map[string]*entities.InstallmentCommitments{}
+	months := map[string]map[string]*entities.InstallmentCommitmentMonth{}
+	for _, plan := range plans {
+		remaining := remainingInstallments(plan, today)
+		if len(remaining) == 0 {
+			continue
+		}
+
+		asset := plan.Amount.Asset
+		commitments, ok := byAsset[asset.Asset]
+		if !ok {
+			commitments = &entities.InstallmentCommitments{Remaining: entities.NewMoney(asset, 0).Monetary()}
+			byAsset[asset.Asset] = commitments
+			months[asset.Asset] = map[string]*entities.InstallmentCommitmentMonth{}
+		}
+		commitments.Plans++
+
+		for _, installment := range remaining {
+			total, err := entities.MoneyOf(commitments.Remaining).Add(entities.MoneyOf(installment.Monetary))
+			if err != nil {
+				return nil, fmt.Errorf("failed to add up installment plan %s: %w", plan.ID, err)
+			}
+			commitments.Remaining = total.Monetary()
+
+			key := installment.Date.Format(faturaMonthLayout)
+			month, ok := months[asset.Asset][key]
+			if !ok {
+				month = &entities.InstallmentCommitmentMonth{Month: key, Amount: entities.NewMoney(asset, 0).Monetary()}
+				months[asset.Asset][key] = month
+			}
+			amount, err := entities.MoneyOf(month.Amount).Add(entities.MoneyOf(installment.Monetary))
+			if err != nil {
+				return nil, fmt.Errorf("failed to add up installment plan %s: %w", plan.ID, err)
+			}
+			month.Amount = amount.Monetary()
+			month.Installments++
+		}
+	}
+
+	result := make([]entities.InstallmentCommitments, 0, len(byAsset))
+	for _, asset := range slices.Sorted(maps.Keys(byAsset)) {
+		commitments := byAsset[asset]
+		for _, key := range slices.Sorted(maps.Keys(months[asset])) {
+			commitments.Months = append(commitments.Months, *months[asset][key])
+		}
+		result = append(result, *commitments)
+	}
+
+	return result, nil
+}
+
+// QuoteInstallmentPayoff calculates what paying the installments of a plan
+// dated after date in advance costs, discounting each at monthlyRate percent
+// for every month it's brought forward. A zero date is today.
+func (uc *InstallmentUseCase) QuoteInstallmentPayoff(ctx context.Context, id string, date time.Time, monthlyRate float64) (entities.InstallmentPayoff, error) {
+	plan, err := uc.GetInstallmentPlan(ctx, id)
+	if err != nil {
+		return entities.InstallmentPayoff{}, err
+	}
+
+	payoff, _, err := uc.quote(plan, date, monthlyRate)
+	return payoff, err
+}
+
+// PayOffInstallmentPlan pays the remaining installments of a plan in advance:
+// they are replaced by a single pending transaction for the quoted amount,
+// dated date and numbered like "TV (4-12/12)", and the plan is marked paid off
+func (uc *InstallmentUseCase) PayOffInstallmentPlan(ctx context.Context, id string, date time.Time, monthlyRate float64) (entities.InstallmentPayoff, error) {
+	plan, err := uc.GetInstallmentPlan(ctx, id)
+	if err != nil {
+		return entities.InstallmentPayoff{}, err
+	}
+
+	payoff, remaining, err := uc.quote(plan, date, monthlyRate)
+	if err != nil {
+		return entities.InstallmentPayoff{}, err
+	}
+
+	first, last := remaining[0], remaining[len(remaining)-1]
+	numbers := fmt.Sprintf("%d-%d", first.InstallmentNumber, last.InstallmentNumber)
+	if first.InstallmentNumber == last.InstallmentNumber {
+		numbers = fmt.Sprint(first.InstallmentNumber)
+	}
+
+	transaction, err := uc.transactionRepo.CreateTransaction(ctx, entities.Transaction{
+		AccountID:         plan.AccountID,
+		CategoryID:        first.CategoryID,
+		Monetary:          payoff.Amount,
+		Description:       fmt.Sprintf("%s (%s/%d)", plan.Description, numbers, plan.Count),
+		Payee:             first.Payee,
+		Date:              payoff.Date,
+		Status:            entities.TransactionStatusPending,
+		InstallmentPlanID: plan.ID,
+		InstallmentNumber: first.InstallmentNumber,
+		InstallmentCount:  plan.Count,
+	})
+	if err != nil {
+		return entities.InstallmentPayoff{}, fmt.Errorf("failed to create payoff transaction: %w", err)
+	}
+
+	for _, installment := range remaining {
+		if err := uc.transactionRepo.DeleteTransaction(ctx, installment.ID); err != nil {
+			_ = uc.balanceRepo.RefreshAccountBalance(ctx, plan.AccountID)
+			return entities.InstallmentPayoff{}, fmt.Errorf("failed to delete installment %d/%d: %w", installment.InstallmentNumber, plan.Count, err)
+		}
+	}
+
+	if _, err := uc.installmentRepo.MarkInstallmentPlanPaidOff(ctx, plan.ID, payoff.Date); err != nil {
+		return entities.InstallmentPayoff{}, fmt.Errorf("failed to mark installment plan paid off: %w", err)
+	}
+
+	_ = uc.balanceRepo.RefreshAccountBalance(ctx, plan.AccountID)
+
+	if payoff.Plan, err = uc.GetInstallmentPlan(ctx, plan.ID); err != nil {
+		return entities.InstallmentPayoff{}, err
+	}
+	payoff.Transaction = &transaction
+
+	return payoff, nil
+}
+
+// DeleteInstallmentPlan deletes a plan, moving its installments to the trash
+func (uc *InstallmentUseCase) DeleteInstallmentPlan(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("installment plan ID cannot be empty")
+	}
+
+	plan, err := uc.installmentRepo.GetInstallmentPlanByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get installment plan: %w", err)
+	}
+
+	if err := uc.installmentRepo.DeleteInstallmentPlan(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete installment plan: %w", err)
+	}
+
+	_ = uc.balanceRepo.RefreshAccountBalance(ctx, plan.AccountID)
+
+	return nil
+}
+
+// quote prices the payoff of plan on date, returning the installments it
+// settles along with it
+func (uc *InstallmentUseCase) quote(plan entities.InstallmentPlan, date time.Time, monthlyRate float64) (entities.InstallmentPayoff, []entities.Transaction, error) {
+	if monthlyRate < 0 || monthlyRate > maxPayoffRate || math.IsNaN(monthlyRate) {
+		return entities.InstallmentPayoff{}, nil, fmt.Errorf("monthly rate must be between 0 and %d percent, got %v: %w", maxPayoffRate, monthlyRate, domain.ErrMalformedParameters)
+	}
+
+	if plan.PaidOff() {
+		return entities.InstallmentPayoff{}, nil, fmt.Errorf("installment plan was paid off on %s: %w", plan.PaidOffOn.Format("2006-01-02"), domain.ErrConflict)
+	}
+
+	if date.IsZero() {
+		date = uc.today()
+	}
+
+	remaining := remainingInstallments(plan, date)
+	if len(remaining) == 0 {
+		return entities.InstallmentPayoff{}, nil, fmt.Errorf("installment plan has no installments left after %s: %w", date.Format("2006-01-02"), domain.ErrConflict)
+	}
+
+	asset := plan.Amount.Asset
+	payoff := entities.InstallmentPayoff{
+		Plan:         plan,
+		Date:         date,
+		MonthlyRate:  monthlyRate,
+		Installments: len(remaining),
+	}
+
+	var total, discount int64
+	for _, installment := range remaining {
+		amount := installment.Monetary.Amount.Int64()
+		// Amounts carry the sign of their effect on the account, discount
+		// their size and sign it back
+		size := math.Abs(float64(amount))
+		months := monthsBetween(date, installment.Date)
+		presentValue := int64(math.Round(size / math.Pow(1+monthlyRate/100, float64(months))))
+		if amount < 0 {
+			presentValue = -presentValue
+		}
+
+		total += amount
+		discount += amount - presentValue
+	}
+
+	payoff.Remaining = entities.NewMoney(asset, total).Monetary()
+	payoff.Discount = entities.NewMoney(asset, discount).Monetary()
+	payoff.Amount = entities.NewMoney(asset, total-discount).Monetary()
+
+	return payoff, remaining, nil
+}
+
+// withInstallments loads the installments of a plan and its progress
+func (uc *InstallmentUseCase) withInstallments(ctx context.Context, plan entities.InstallmentPlan) (entities.InstallmentPlan, error) {
+	installments, err := uc.transactionRepo.GetTransactionsByInstallmentPlan(ctx, plan.ID)
+	if err != nil {
+		return entities.InstallmentPlan{}, fmt.Errorf("failed to get installments: %w", err)
+	}
+	plan.Installments = installments
+
+	return withInstallmentProgress(plan, uc.now()), nil
+}
+
+// today is the current date, in the UTC midnight transactions are dated with
+func (uc *InstallmentUseCase) today() time.Time {
+	now := uc.now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// withInstallmentProgress counts the installments of plan paid by now and adds
+// up the remaining ones
+func withInstallmentProgress(plan entities.InstallmentPlan, now time.Time) entities.InstallmentPlan {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	var remaining int64
+	plan.Paid, plan.RemainingCount, plan.NextDate = 0, 0, time.Time{}
+	for _, installment := range plan.Installments {
+		if !inFatura(installment) {
+			continue
+		}
+		if !installment.Date.After(today) {
+			plan.Paid++
+			continue
+		}
+		if plan.RemainingCount == 0 {
+			plan.NextDate = installment.Date
+		}
+		plan.RemainingCount++
+		remaining += installment.Monetary.Amount.Int64()
+	}
+	plan.Remaining = entities.NewMoney(plan.Amount.Asset, remaining).Monetary()
+
+	return plan
+}
+
+// remainingInstallments returns the installments of plan still to be charged
+// after date, cancelled ones aside
+func remainingInstallments(plan entities.InstallmentPlan, date time.Time) []entities.Transaction {
+	var remaining []entities.Transaction
+	for _, installment := range plan.Installments {
+		if inFatura(installment) && installment.Date.After(date) {
+			remaining = append(remaining, installment)
+		}
+	}
+	return remaining
+}
+
+// monthsBetween counts the calendar months from from to to, zero when to is in
+// the same month or earlier
+func monthsBetween(from, to time.Time) int {
+	return max(0, (to.Year()-from.Year())*12+int(to.Month())-int(from.Month()))
+}
@@ -0,0 +1,185 @@
+package finance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testInstallmentUseCase serves a TV bought on 2025-01-15 in 12 installments
+// of R$ 100.00, on 2025-03-20
+func testInstallmentUseCase(t *testing.T) (*InstallmentUseCase, *mocks.InstallmentRepositoryMock, *mocks.TransactionRepositoryMock) {
+	t.Helper()
+
+	plan := entities.InstallmentPlan{
+		ID:          "plan-1",
+		AccountID:   "acc-credit",
+		Description: "TV",
+		Amount:      testMonetary(t, monetary.BRL, 120000),
+		Count:       12,
+		FirstDate:   time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC),
+	}
+	installments := make([]entities.Transaction, 12)
+	for i := range installments {
+		status := entities.TransactionStatusPending
+		if i == 0 {
+			status = entities.TransactionStatusCleared
+		}
+		installments[i] = entities.Transaction{
+			ID:                fmt.Sprintf("tx-%d", i+1),
+			AccountID:         plan.AccountID,
+			CategoryID:        "cat-expense",
+			Monetary:          testMonetary(t, monetary.BRL, 10000),
+			Description:       "TV",
+			Date:              plan.FirstDate.AddDate(0, i, 0),
+			Status:            status,
+			InstallmentPlanID: plan.ID,
+			InstallmentNumber: i + 1,
+			InstallmentCount:  12,
+		}
+	}
+
+	installmentRepo := &mocks.InstallmentRepositoryMock{
+		GetInstallmentPlanByIDFunc: func(ctx context.Context, id string) (entities.InstallmentPlan, error) {
+			if id != plan.ID {
+				return entities.InstallmentPlan{}, errNotFound("installment plan")
+			}
+			return plan, nil
+		},
+		GetAllInstallmentPlansFunc: func(ctx context.Context) ([]entities.InstallmentPlan, error) {
+			return []entities.InstallmentPlan{plan}, nil
+		},
+		MarkInstallmentPlanPaidOffFunc: func(ctx context.Context, id string, date time.Time) (entities.InstallmentPlan, error) {
+			plan.PaidOffOn = date
+			return plan, nil
+		},
+	}
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionsByInstallmentPlanFunc: func(ctx context.Context, planID string) ([]entities.Transaction, error) {
+			return installments, nil
+		},
+		CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+			transaction.ID = "tx-payoff"
+			installments = append(installments, transaction)
+			return transaction, nil
+		},
+		DeleteTransactionFunc: func(ctx context.Context, id string) error {
+			for i, installment := range installments {
+				if installment.ID == id {
+					installments = append(installments[:i:i], installments[i+1:]...)
+					break
+				}
+			}
+			return nil
+		},
+	}
+
+	uc := NewInstallmentUseCase(installmentRepo, transactionRepo, &mocks.BalanceRepositoryMock{})
+	uc.now = func() time.Time { return time.Date(2025, time.March, 20, 9, 30, 0, 0, time.UTC) }
+	return uc, installmentRepo, transactionRepo
+}
+
+func TestGetInstallmentPlan(t *testing.T) {
+	uc, _, _ := testInstallmentUseCase(t)
+
+	t.Run("counts the installments paid", func(t *testing.T) {
+		plan, err := uc.GetInstallmentPlan(context.Background(), "plan-1")
+		require.NoError(t, err)
+
+		assert.Len(t, plan.Installments, 12)
+		assert.Equal(t, 3, plan.Paid)
+		assert.Equal(t, 9, plan.RemainingCount)
+		assert.Equal(t, int64(90000), plan.Remaining.Amount.Int64())
+		assert.Equal(t, time.Date(2025, time.April, 15, 0, 0, 0, 0, time.UTC), plan.NextDate)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := uc.GetInstallmentPlan(context.Background(), "plan-2")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestGetInstallmentCommitments(t *testing.T) {
+	uc, _, _ := testInstallmentUseCase(t)
+
+	commitments, err := uc.GetInstallmentCommitments(context.Background())
+	require.NoError(t, err)
+	require.Len(t, commitments, 1)
+
+	assert.Equal(t, 1, commitments[0].Plans)
+	assert.Equal(t, int64(90000), commitments[0].Remaining.Amount.Int64())
+	require.Len(t, commitments[0].Months, 9)
+	assert.Equal(t, "2025-04", commitments[0].Months[0].Month)
+	assert.Equal(t, "2025-12", commitments[0].Months[8].Month)
+	assert.Equal(t, 1, commitments[0].Months[0].Installments)
+	assert.Equal(t, int64(10000), commitments[0].Months[0].Amount.Amount.Int64())
+}
+
+func TestQuoteInstallmentPayoff(t *testing.T) {
+	uc, _, _ := testInstallmentUseCase(t)
+
+	t.Run("without a discount", func(t *testing.T) {
+		payoff, err := uc.QuoteInstallmentPayoff(context.Background(), "plan-1", time.Time{}, 0)
+		require.NoError(t, err)
+
+		assert.Equal(t, time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC), payoff.Date)
+		assert.Equal(t, 9, payoff.Installments)
+		assert.Equal(t, int64(90000), payoff.Amount.Amount.Int64())
+		assert.Equal(t, int64(0), payoff.Discount.Amount.Int64())
+	})
+
+	t.Run("discounts each month brought forward", func(t *testing.T) {
+		payoff, err := uc.QuoteInstallmentPayoff(context.Background(), "plan-1", time.Time{}, 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(90000), payoff.Remaining.Amount.Int64())
+		assert.Equal(t, int64(4340), payoff.Discount.Amount.Int64())
+		assert.Equal(t, int64(85660), payoff.Amount.Amount.Int64())
+	})
+
+	t.Run("invalid rate", func(t *testing.T) {
+		for _, rate := range []float64{-1, 21} {
+			_, err := uc.QuoteInstallmentPayoff(context.Background(), "plan-1", time.Time{}, rate)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		}
+	})
+
+	t.Run("no installments left", func(t *testing.T) {
+		_, err := uc.QuoteInstallmentPayoff(context.Background(), "plan-1", time.Date(2025, time.December, 15, 0, 0, 0, 0, time.UTC), 0)
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+}
+
+func TestPayOffInstallmentPlan(t *testing.T) {
+	uc, installmentRepo, transactionRepo := testInstallmentUseCase(t)
+
+	payoff, err := uc.PayOffInstallmentPlan(context.Background(), "plan-1", time.Time{}, 1)
+	require.NoError(t, err)
+
+	require.NotNil(t, payoff.Transaction)
+	assert.Equal(t, "TV (4-12/12)", payoff.Transaction.Description)
+	assert.Equal(t, int64(85660), payoff.Transaction.Monetary.Amount.Int64())
+	assert.Equal(t, entities.TransactionStatusPending, payoff.Transaction.Status)
+	assert.Equal(t, 4, payoff.Transaction.InstallmentNumber)
+	assert.Len(t, transactionRepo.DeleteTransactionCalls(), 9)
+	require.Len(t, installmentRepo.MarkInstallmentPlanPaidOffCalls(), 1)
+
+	// The payoff is dated today, it's counted paid along with the first three
+	assert.True(t, payoff.Plan.PaidOff())
+	assert.Len(t, payoff.Plan.Installments, 4)
+	assert.Equal(t, 0, payoff.Plan.RemainingCount)
+
+	t.Run("already paid off", func(t *testing.T) {
+		_, err := uc.PayOffInstallmentPlan(context.Background(), "plan-1", time.Time{}, 0)
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+}
@@ -0,0 +1,16 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/invoice_repository.go . InvoiceRepository
+type InvoiceRepository interface {
+	CreateInvoice(ctx context.Context, invoice entities.Invoice) (entities.Invoice, error)
+	GetInvoiceByID(ctx context.Context, id string) (entities.Invoice, error)
+	GetAllInvoices(ctx context.Context) ([]entities.Invoice, error)
+	UpdateInvoice(ctx context.Context, invoice entities.Invoice) (entities.Invoice, error)
+	SetInvoiceTransaction(ctx context.Context, id, transactionID string) (entities.Invoice, error)
+	DeleteInvoice(ctx context.Context, id string) error
+}
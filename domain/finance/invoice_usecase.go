@@ -0,0 +1,399 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+type InvoiceUseCase struct {
+	invoiceRepo     InvoiceRepository
+	transactionRepo TransactionRepository
+	accountRepo     AccountRepository
+	now             func() time.Time
+}
+
+func NewInvoiceUseCase(invoiceRepo InvoiceRepository, transactionRepo TransactionRepository, accountRepo AccountRepository) *InvoiceUseCase {
+	return &InvoiceUseCase{
+		invoiceRepo:     invoiceRepo,
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		now:             time.Now,
+	}
+}
+
+// CreateInvoice records an invoice issued to a client. The amount is taken in
+// the asset of the account it's paid into.
+func (uc *InvoiceUseCase) CreateInvoice(ctx context.Context, invoice entities.Invoice) (entities.Invoice, error) {
+	invoice, err := uc.validateInvoice(ctx, invoice)
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+
+	created, err := uc.invoiceRepo.CreateInvoice(ctx, invoice)
+	if err != nil {
+		return entities.Invoice{}, fmt.Errorf("failed to create invoice: %w", err)
+	}
+
+	return uc.withStatus(created), nil
+}
+
+// GetInvoices returns the invoices, the latest due first, keeping the ones in
+// status when it's given
+func (uc *InvoiceUseCase) GetInvoices(ctx context.Context, status entities.InvoiceStatus) ([]entities.Invoice, error) {
+	switch status {
+	case "", entities.InvoiceStatusOutstanding, entities.InvoiceStatusOverdue, entities.InvoiceStatusPaid:
+	default:
+		return nil, fmt.Errorf("invalid invoice status %q: %w", status, domain.ErrMalformedParameters)
+	}
+
+	invoices, err := uc.invoiceRepo.GetAllInvoices(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invoices: %w", err)
+	}
+
+	var result []entities.Invoice
+	for _, invoice := range invoices {
+		invoice = uc.withStatus(invoice)
+		if status != "" && invoice.Status != status {
+			continue
+		}
+		if invoice, err = uc.withPayment(ctx, invoice); err != nil {
+			return nil, err
+		}
+		result = append(result, invoice)
+	}
+
+	return result, nil
+}
+
+func (uc *InvoiceUseCase) GetInvoice(ctx context.Context, id string) (entities.Invoice, error) {
+	if id == "" {
+		return entities.Invoice{}, fmt.Errorf("invoice ID cannot be empty")
+	}
+
+	invoice, err := uc.invoiceRepo.GetInvoiceByID(ctx, id)
+	if err != nil {
+		return entities.Invoice{}, fmt.Errorf("failed to get invoice: %w", err)
+	}
+
+	return uc.withPayment(ctx, uc.withStatus(invoice))
+}
+
+// UpdateInvoice changes the details of an invoice. A paid invoice stays on the
+// account of its payment.
+func (uc *InvoiceUseCase) UpdateInvoice(ctx context.Context, invoice entities.Invoice) (entities.Invoice, error) {
+	current, err := uc.GetInvoice(ctx, invoice.ID)
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+
+	if current.TransactionID != "" && invoice.AccountID != current.AccountID {
+		return entities.Invoice{}, fmt.Errorf("invoice is paid into account %s, unmatch its payment to move it: %w", current.AccountID, domain.ErrConflict)
+	}
+
+	if invoice, err = uc.validateInvoice(ctx, invoice); err != nil {
+		return entities.Invoice{}, err
+	}
+
+	updated, err := uc.invoiceRepo.UpdateInvoice(ctx, invoice)
+	if err != nil {
+		return entities.Invoice{}, fmt.Errorf("failed to update invoice: %w", err)
+	}
+
+	return uc.withPayment(ctx, uc.withStatus(updated))
+}
+
+// DeleteInvoice deletes an invoice, its payment is kept
+func (uc *InvoiceUseCase) DeleteInvoice(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("invoice ID cannot be empty")
+	}
+
+	if _, err := uc.invoiceRepo.GetInvoiceByID(ctx, id); err != nil {
+		return fmt.Errorf("failed to get invoice: %w", err)
+	}
+
+	if err := uc.invoiceRepo.DeleteInvoice(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete invoice: %w", err)
+	}
+
+	return nil
+}
+
+// MatchInvoice records the incoming transaction that paid an invoice. Without
+// a transaction ID, the earliest pending or cleared transaction on the
+// invoice account for the exact amount, from the issue date on and not paying
+// another invoice, is picked. The payment may differ from the amount, like
+// when the bank takes a fee.
+func (uc *InvoiceUseCase) MatchInvoice(ctx context.Context, id, transactionID string) (entities.Invoice, error) {
+	invoice, err := uc.GetInvoice(ctx, id)
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+
+	if invoice.TransactionID != "" {
+		return entities.Invoice{}, fmt.Errorf("invoice is already paid by transaction %s: %w", invoice.TransactionID, domain.ErrConflict)
+	}
+
+	var transaction entities.Transaction
+	if transactionID == "" {
+		transaction, err = uc.findPayment(ctx, invoice)
+	} else {
+		transaction, err = uc.transactionRepo.GetTransactionWithDetails(ctx, transactionID)
+		if err == nil {
+			err = validatePayment(invoice, transaction)
+		}
+	}
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+
+	matched, err := uc.invoiceRepo.SetInvoiceTransaction(ctx, id, transaction.ID)
+	if err != nil {
+		return entities.Invoice{}, fmt.Errorf("failed to match invoice: %w", err)
+	}
+
+	return uc.withPayment(ctx, uc.withStatus(matched))
+}
+
+// UnmatchInvoice forgets the payment of an invoice, making it receivable
+// again
+func (uc *InvoiceUseCase) UnmatchInvoice(ctx context.Context, id string) (entities.Invoice, error) {
+	invoice, err := uc.GetInvoice(ctx, id)
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+
+	if invoice.TransactionID == "" {
+		return entities.Invoice{}, fmt.Errorf("invoice is not paid: %w", domain.ErrConflict)
+	}
+
+	unmatched, err := uc.invoiceRepo.SetInvoiceTransaction(ctx, id, "")
+	if err != nil {
+		return entities.Invoice{}, fmt.Errorf("failed to unmatch invoice: %w", err)
+	}
+
+	return uc.withStatus(unmatched), nil
+}
+
+// GetReceivables adds up the unpaid invoices per asset and client, next to the
+// net balance of the accounts in each asset. Assets without unpaid invoices
+// are left out.
+func (uc *InvoiceUseCase) GetReceivables(ctx context.Context) ([]entities.Receivables, error) {
+	invoices, err := uc.invoiceRepo.GetAllInvoices(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invoices: %w", err)
+	}
+
+	receivables := map[string]*entities.Receivables{}
+	clients := map[string]map[string]*entities.ClientReceivables{}
+	for _, invoice := range invoices {
+		invoice = uc.withStatus(invoice)
+		if invoice.Status == entities.InvoiceStatusPaid {
+			continue
+		}
+
+		asset := invoice.Amount.Asset
+		zero := entities.NewMoney(asset, 0).Monetary()
+		receivable, ok := receivables[asset.Asset]
+		if !ok {
+			receivable = &entities.Receivables{Asset: asset, Outstanding: zero, Overdue: zero, Ledger: zero, Total: zero}
+			receivables[asset.Asset] = receivable
+			clients[asset.Asset] = map[string]*entities.ClientReceivables{}
+		}
+		client, ok := clients[asset.Asset][invoice.Client]
+		if !ok {
+			client = &entities.ClientReceivables{Client: invoice.Client, Outstanding: zero, Overdue: zero, OldestDueDate: invoice.DueDate}
+			clients[asset.Asset][invoice.Client] = client
+		}
+
+		receivable.Invoices++
+		client.Invoices++
+		receivable.Outstanding = addMonetary(receivable.Outstanding, invoice.Amount)
+		client.Outstanding = addMonetary(client.Outstanding, invoice.Amount)
+		if invoice.Status == entities.InvoiceStatusOverdue {
+			receivable.Overdue = addMonetary(receivable.Overdue, invoice.Amount)
+			client.Overdue = addMonetary(client.Overdue, invoice.Amount)
+		}
+		if invoice.DueDate.Before(client.OldestDueDate) {
+			client.OldestDueDate = invoice.DueDate
+		}
+	}
+
+	if len(receivables) == 0 {
+		return []entities.Receivables{}, nil
+	}
+
+	accounts, err := uc.accountRepo.GetAccountsWithBalances(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	for _, account := range accounts {
+		receivable, ok := receivables[account.Asset.Asset]
+		if !ok || account.Balance == nil {
+			continue
+		}
+		balance := account.Balance.CurrentBalance
+		if account.IsLiability() {
+			balance = entities.MoneyOf(balance).Neg().Monetary()
+		}
+		receivable.Ledger = addMonetary(receivable.Ledger, balance)
+	}
+
+	result := make([]entities.Receivables, 0, len(receivables))
+	for _, asset := range slices.Sorted(maps.Keys(receivables)) {
+		receivable := receivables[asset]
+		receivable.Total = addMonetary(receivable.Ledger, receivable.Outstanding)
+		for _, name := range slices.Sorted(maps.Keys(clients[asset])) {
+			receivable.Clients = append(receivable.Clients, *clients[asset][name])
+		}
+		// The clients owing the most come first
+		slices.SortStableFunc(receivable.Clients, func(a, b entities.ClientReceivables) int {
+			return b.Outstanding.Amount.Cmp(a.Outstanding.Amount)
+		})
+		result = append(result, *receivable)
+	}
+
+	return result, nil
+}
+
+// validateInvoice checks the details of an invoice and takes its amount in the
+// asset of its account
+func (uc *InvoiceUseCase) validateInvoice(ctx context.Context, invoice entities.Invoice) (entities.Invoice, error) {
+	invoice.Client = strings.TrimSpace(invoice.Client)
+	if invoice.Client == "" {
+		return entities.Invoice{}, fmt.Errorf("invoice client cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	if invoice.AccountID == "" {
+		return entities.Invoice{}, fmt.Errorf("invoice account ID cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	if invoice.Amount.Amount == nil || invoice.Amount.Amount.Sign() <= 0 {
+		return entities.Invoice{}, fmt.Errorf("invoice amount must be positive: %w", domain.ErrMalformedParameters)
+	}
+	if invoice.IssueDate.IsZero() {
+		invoice.IssueDate = uc.today()
+	}
+	if invoice.DueDate.IsZero() {
+		return entities.Invoice{}, fmt.Errorf("invoice due date is required: %w", domain.ErrMalformedParameters)
+	}
+	if invoice.DueDate.Before(invoice.IssueDate) {
+		return entities.Invoice{}, fmt.Errorf("invoice is due before it's issued: %w", domain.ErrMalformedParameters)
+	}
+
+	account, err := ownAccount(ctx, uc.accountRepo, invoice.AccountID)
+	if err != nil {
+		return entities.Invoice{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	amount, err := monetary.NewMonetary(account.Asset, invoice.Amount.Amount)
+	if err != nil {
+		return entities.Invoice{}, fmt.Errorf("invalid invoice amount: %w", err)
+	}
+	invoice.Amount = *amount
+
+	return invoice, nil
+}
+
+// findPayment picks the transaction paying an invoice, see MatchInvoice
+func (uc *InvoiceUseCase) findPayment(ctx context.Context, invoice entities.Invoice) (entities.Transaction, error) {
+	transactions, err := uc.transactionRepo.GetTransactionsByAccountAndDateRange(ctx, invoice.AccountID, invoice.IssueDate, uc.today())
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	invoices, err := uc.invoiceRepo.GetAllInvoices(ctx)
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to get invoices: %w", err)
+	}
+	paying := map[string]bool{}
+	for _, other := range invoices {
+		paying[other.TransactionID] = true
+	}
+
+	slices.SortStableFunc(transactions, func(a, b entities.Transaction) int {
+		return a.Date.Compare(b.Date)
+	})
+	for _, candidate := range transactions {
+		if paying[candidate.ID] || !inFatura(candidate) || candidate.Monetary.Amount.Cmp(invoice.Amount.Amount) != 0 {
+			continue
+		}
+
+		transaction, err := uc.transactionRepo.GetTransactionWithDetails(ctx, candidate.ID)
+		if err != nil {
+			return entities.Transaction{}, fmt.Errorf("failed to get transaction: %w", err)
+		}
+		if err := validatePayment(invoice, transaction); errors.Is(err, domain.ErrMalformedParameters) {
+			continue
+		}
+		return transaction, nil
+	}
+
+	return entities.Transaction{}, fmt.Errorf("no incoming transaction of %s on the invoice account since %s: %w", invoice.Amount.String(), invoice.IssueDate.Format("2006-01-02"), domain.ErrNotFound)
+}
+
+// validatePayment checks a transaction can pay an invoice: an income on the
+// invoice account that isn't a draft or cancelled
+func validatePayment(invoice entities.Invoice, transaction entities.Transaction) error {
+	if transaction.AccountID != invoice.AccountID {
+		return fmt.Errorf("transaction %s is not on the invoice account: %w", transaction.ID, domain.ErrMalformedParameters)
+	}
+	if transaction.Category == nil || transaction.Category.Type != entities.CategoryTypeIncome {
+		return fmt.Errorf("transaction %s is not an income: %w", transaction.ID, domain.ErrMalformedParameters)
+	}
+	if !inFatura(transaction) {
+		return fmt.Errorf("transaction %s is %s, only pending and cleared transactions pay invoices: %w", transaction.ID, transaction.Status, domain.ErrMalformedParameters)
+	}
+	return nil
+}
+
+// withStatus tells whether the invoice is paid, outstanding or overdue and
+// for how long
+func (uc *InvoiceUseCase) withStatus(invoice entities.Invoice) entities.Invoice {
+	today := uc.today()
+	invoice.DaysOverdue = 0
+	switch {
+	case invoice.TransactionID != "":
+		invoice.Status = entities.InvoiceStatusPaid
+	case invoice.DueDate.Before(today):
+		invoice.Status = entities.InvoiceStatusOverdue
+		invoice.DaysOverdue = int(today.Sub(invoice.DueDate).Hours() / 24)
+	default:
+		invoice.Status = entities.InvoiceStatusOutstanding
+	}
+	return invoice
+}
+
+// withPayment loads the transaction that paid the invoice
+func (uc *InvoiceUseCase) withPayment(ctx context.Context, invoice entities.Invoice) (entities.Invoice, error) {
+	if invoice.TransactionID == "" {
+		return invoice, nil
+	}
+
+	payment, err := ownTransaction(ctx, uc.transactionRepo, invoice.TransactionID)
+	if err != nil {
+		return entities.Invoice{}, fmt.Errorf("failed to get invoice payment: %w", err)
+	}
+	invoice.Payment = &payment
+
+	return invoice, nil
+}
+
+func (uc *InvoiceUseCase) today() time.Time {
+	now := uc.now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// addMonetary adds two amounts known to be of the same asset
+func addMonetary(a, b monetary.Monetary) monetary.Monetary {
+	sum, _ := entities.MoneyOf(a).Add(entities.MoneyOf(b))
+	return sum.Monetary()
+}
@@ -0,0 +1,323 @@
+package finance
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testInvoiceUseCase serves two invoices to Acme paid into a BRL checking
+// account, one due 2025-04-30 and another overdue since 2025-04-10, and the
+// transactions on the account. Today is 2025-04-15.
+func testInvoiceUseCase(t *testing.T) (*InvoiceUseCase, *mocks.InvoiceRepositoryMock) {
+	t.Helper()
+
+	checking := entities.Account{ID: "acc-checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL}
+	card := entities.Account{ID: "acc-card", Type: entities.AccountTypeCredit, Asset: monetary.BRL}
+	accounts := map[string]entities.Account{checking.ID: checking, card.ID: card}
+
+	income := &entities.Category{ID: "cat-freelance", Name: "Freelance", Type: entities.CategoryTypeIncome}
+	expense := &entities.Category{ID: "cat-rent", Name: "Rent", Type: entities.CategoryTypeExpense}
+	transaction := func(id, accountID string, category *entities.Category, amount int64, day int, status entities.TransactionStatus) entities.Transaction {
+		return entities.Transaction{
+			ID:         id,
+			AccountID:  accountID,
+			CategoryID: category.ID,
+			Monetary:   testMonetary(t, monetary.BRL, amount),
+			Date:       time.Date(2025, time.April, day, 0, 0, 0, 0, time.UTC),
+			Status:     status,
+			Category:   category,
+		}
+	}
+	transactions := map[string]entities.Transaction{
+		"tx-draft":   transaction("tx-draft", checking.ID, income, 450000, 2, entities.TransactionStatusDraft),
+		"tx-rent":    transaction("tx-rent", checking.ID, expense, 450000, 3, entities.TransactionStatusCleared),
+		"tx-acme":    transaction("tx-acme", checking.ID, income, 450000, 8, entities.TransactionStatusCleared),
+		"tx-again":   transaction("tx-again", checking.ID, income, 450000, 12, entities.TransactionStatusPending),
+		"tx-partial": transaction("tx-partial", checking.ID, income, 100000, 9, entities.TransactionStatusCleared),
+		"tx-card":    transaction("tx-card", card.ID, income, 450000, 9, entities.TransactionStatusCleared),
+	}
+
+	invoices := []entities.Invoice{
+		{
+			ID:        "inv-1",
+			AccountID: checking.ID,
+			Client:    "Acme",
+			Amount:    testMonetary(t, monetary.BRL, 450000),
+			IssueDate: time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC),
+			DueDate:   time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:        "inv-2",
+			AccountID: checking.ID,
+			Client:    "Acme",
+			Amount:    testMonetary(t, monetary.BRL, 200000),
+			IssueDate: time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+			DueDate:   time.Date(2025, time.April, 10, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	find := func(id string) int {
+		for i, invoice := range invoices {
+			if invoice.ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+
+	invoiceRepo := &mocks.InvoiceRepositoryMock{
+		CreateInvoiceFunc: func(ctx context.Context, invoice entities.Invoice) (entities.Invoice, error) {
+			invoice.ID = "inv-3"
+			invoices = append(invoices, invoice)
+			return invoice, nil
+		},
+		GetInvoiceByIDFunc: func(ctx context.Context, id string) (entities.Invoice, error) {
+			i := find(id)
+			if i < 0 {
+				return entities.Invoice{}, errNotFound("invoice")
+			}
+			return invoices[i], nil
+		},
+		GetAllInvoicesFunc: func(ctx context.Context) ([]entities.Invoice, error) {
+			return invoices, nil
+		},
+		UpdateInvoiceFunc: func(ctx context.Context, invoice entities.Invoice) (entities.Invoice, error) {
+			i := find(invoice.ID)
+			invoice.TransactionID = invoices[i].TransactionID
+			invoices[i] = invoice
+			return invoice, nil
+		},
+		SetInvoiceTransactionFunc: func(ctx context.Context, id, transactionID string) (entities.Invoice, error) {
+			i := find(id)
+			invoices[i].TransactionID = transactionID
+			return invoices[i], nil
+		},
+	}
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionByIDFunc: func(ctx context.Context, id string) (entities.Transaction, error) {
+			return transactions[id], nil
+		},
+		GetTransactionWithDetailsFunc: func(ctx context.Context, id string) (entities.Transaction, error) {
+			transaction, ok := transactions[id]
+			if !ok {
+				return entities.Transaction{}, errNotFound("transaction")
+			}
+			return transaction, nil
+		},
+		GetTransactionsByAccountAndDateRangeFunc: func(ctx context.Context, accountID string, startDate, endDate time.Time) ([]entities.Transaction, error) {
+			var result []entities.Transaction
+			for _, transaction := range transactions {
+				if transaction.AccountID == accountID && !transaction.Date.Before(startDate) && !transaction.Date.After(endDate) {
+					result = append(result, transaction)
+				}
+			}
+			return result, nil
+		},
+	}
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+			account, ok := accounts[id]
+			if !ok {
+				return entities.Account{}, errNotFound("account")
+			}
+			return account, nil
+		},
+		GetAccountsWithBalancesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+			checking.Balance = &entities.Balance{CurrentBalance: testMonetary(t, monetary.BRL, 1000000)}
+			card.Balance = &entities.Balance{CurrentBalance: testMonetary(t, monetary.BRL, 300000)}
+			return []entities.Account{checking, card}, nil
+		},
+	}
+
+	uc := NewInvoiceUseCase(invoiceRepo, transactionRepo, accountRepo)
+	uc.now = func() time.Time { return time.Date(2025, time.April, 15, 14, 0, 0, 0, time.UTC) }
+	return uc, invoiceRepo
+}
+
+func TestCreateInvoice(t *testing.T) {
+	uc, _ := testInvoiceUseCase(t)
+	due := time.Date(2025, time.May, 15, 0, 0, 0, 0, time.UTC)
+	amount := testMonetary(t, monetary.USD, 120000)
+
+	t.Run("takes the account asset and issues it today", func(t *testing.T) {
+		invoice, err := uc.CreateInvoice(context.Background(), entities.Invoice{AccountID: "acc-checking", Client: " Globex ", Amount: amount, DueDate: due})
+		require.NoError(t, err)
+		assert.Equal(t, "Globex", invoice.Client)
+		assert.Equal(t, monetary.BRL, invoice.Amount.Asset)
+		assert.Equal(t, int64(120000), invoice.Amount.Amount.Int64())
+		assert.Equal(t, time.Date(2025, time.April, 15, 0, 0, 0, 0, time.UTC), invoice.IssueDate)
+		assert.Equal(t, entities.InvoiceStatusOutstanding, invoice.Status)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, invoice := range map[string]entities.Invoice{
+			"no client":       {AccountID: "acc-checking", Amount: amount, DueDate: due},
+			"no account":      {Client: "Globex", Amount: amount, DueDate: due},
+			"no amount":       {AccountID: "acc-checking", Client: "Globex", DueDate: due},
+			"negative amount": {AccountID: "acc-checking", Client: "Globex", Amount: monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(-100)}, DueDate: due},
+			"no due date":     {AccountID: "acc-checking", Client: "Globex", Amount: amount},
+			"due too soon":    {AccountID: "acc-checking", Client: "Globex", Amount: amount, IssueDate: due, DueDate: due.AddDate(0, 0, -1)},
+		} {
+			_, err := uc.CreateInvoice(context.Background(), invoice)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters, name)
+		}
+	})
+
+	t.Run("account not found", func(t *testing.T) {
+		_, err := uc.CreateInvoice(context.Background(), entities.Invoice{AccountID: "acc-missing", Client: "Globex", Amount: amount, DueDate: due})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestGetInvoices(t *testing.T) {
+	uc, _ := testInvoiceUseCase(t)
+
+	t.Run("tells overdue invoices apart", func(t *testing.T) {
+		invoices, err := uc.GetInvoices(context.Background(), "")
+		require.NoError(t, err)
+		require.Len(t, invoices, 2)
+		assert.Equal(t, entities.InvoiceStatusOutstanding, invoices[0].Status)
+		assert.Equal(t, entities.InvoiceStatusOverdue, invoices[1].Status)
+		assert.Equal(t, 5, invoices[1].DaysOverdue)
+	})
+
+	t.Run("by status", func(t *testing.T) {
+		invoices, err := uc.GetInvoices(context.Background(), entities.InvoiceStatusOverdue)
+		require.NoError(t, err)
+		require.Len(t, invoices, 1)
+		assert.Equal(t, "inv-2", invoices[0].ID)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		_, err := uc.GetInvoices(context.Background(), "late")
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+}
+
+func TestMatchInvoice(t *testing.T) {
+	t.Run("finds the earliest payment for the amount", func(t *testing.T) {
+		uc, _ := testInvoiceUseCase(t)
+
+		invoice, err := uc.MatchInvoice(context.Background(), "inv-1", "")
+		require.NoError(t, err)
+		assert.Equal(t, entities.InvoiceStatusPaid, invoice.Status)
+		assert.Equal(t, "tx-acme", invoice.TransactionID)
+		require.NotNil(t, invoice.Payment)
+		assert.Equal(t, "tx-acme", invoice.Payment.ID)
+
+		_, err = uc.MatchInvoice(context.Background(), "inv-1", "tx-again")
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("takes a partial payment", func(t *testing.T) {
+		uc, _ := testInvoiceUseCase(t)
+
+		invoice, err := uc.MatchInvoice(context.Background(), "inv-2", "tx-partial")
+		require.NoError(t, err)
+		assert.Equal(t, "tx-partial", invoice.TransactionID)
+	})
+
+	t.Run("rejects what isn't an incoming payment", func(t *testing.T) {
+		uc, invoiceRepo := testInvoiceUseCase(t)
+
+		for _, id := range []string{"tx-draft", "tx-rent", "tx-card"} {
+			_, err := uc.MatchInvoice(context.Background(), "inv-1", id)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters, id)
+		}
+		assert.Empty(t, invoiceRepo.SetInvoiceTransactionCalls())
+	})
+
+	t.Run("no payment found", func(t *testing.T) {
+		uc, _ := testInvoiceUseCase(t)
+
+		_, err := uc.MatchInvoice(context.Background(), "inv-2", "")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestUnmatchInvoice(t *testing.T) {
+	uc, _ := testInvoiceUseCase(t)
+	ctx := context.Background()
+
+	_, err := uc.UnmatchInvoice(ctx, "inv-1")
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	_, err = uc.MatchInvoice(ctx, "inv-1", "tx-acme")
+	require.NoError(t, err)
+
+	invoice, err := uc.UnmatchInvoice(ctx, "inv-1")
+	require.NoError(t, err)
+	assert.Equal(t, entities.InvoiceStatusOutstanding, invoice.Status)
+	assert.Empty(t, invoice.TransactionID)
+}
+
+func TestUpdateInvoice(t *testing.T) {
+	uc, _ := testInvoiceUseCase(t)
+	ctx := context.Background()
+
+	_, err := uc.MatchInvoice(ctx, "inv-1", "tx-acme")
+	require.NoError(t, err)
+
+	invoice := entities.Invoice{
+		ID:        "inv-1",
+		AccountID: "acc-checking",
+		Client:    "Acme",
+		Number:    "2025-014",
+		Amount:    testMonetary(t, monetary.USD, 450000),
+		IssueDate: time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC),
+		DueDate:   time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("keeps the payment", func(t *testing.T) {
+		updated, err := uc.UpdateInvoice(ctx, invoice)
+		require.NoError(t, err)
+		assert.Equal(t, "2025-014", updated.Number)
+		assert.Equal(t, entities.InvoiceStatusPaid, updated.Status)
+	})
+
+	t.Run("paid invoices stay on their account", func(t *testing.T) {
+		invoice.AccountID = "acc-card"
+		_, err := uc.UpdateInvoice(ctx, invoice)
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+}
+
+func TestGetReceivables(t *testing.T) {
+	uc, _ := testInvoiceUseCase(t)
+	ctx := context.Background()
+
+	receivables, err := uc.GetReceivables(ctx)
+	require.NoError(t, err)
+	require.Len(t, receivables, 1)
+
+	receivable := receivables[0]
+	assert.Equal(t, monetary.BRL, receivable.Asset)
+	assert.Equal(t, 2, receivable.Invoices)
+	assert.Equal(t, int64(650000), receivable.Outstanding.Amount.Int64())
+	assert.Equal(t, int64(200000), receivable.Overdue.Amount.Int64())
+	// The card balance is owed
+	assert.Equal(t, int64(700000), receivable.Ledger.Amount.Int64())
+	assert.Equal(t, int64(1350000), receivable.Total.Amount.Int64())
+	require.Len(t, receivable.Clients, 1)
+	assert.Equal(t, time.Date(2025, time.April, 10, 0, 0, 0, 0, time.UTC), receivable.Clients[0].OldestDueDate)
+
+	t.Run("paid invoices are left out", func(t *testing.T) {
+		_, err := uc.MatchInvoice(ctx, "inv-1", "tx-acme")
+		require.NoError(t, err)
+		_, err = uc.MatchInvoice(ctx, "inv-2", "tx-partial")
+		require.NoError(t, err)
+
+		receivables, err := uc.GetReceivables(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, receivables)
+	})
+}
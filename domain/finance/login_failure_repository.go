@@ -0,0 +1,18 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+	"time"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/login_failure_repository.go . LoginFailureRepository
+type LoginFailureRepository interface {
+	// GetLoginFailures returns no failures when there are none recorded
+	GetLoginFailures(ctx context.Context, scope entities.LoginScope, key string) (entities.LoginFailures, error)
+	// RecordLoginFailure counts a failure, starting over when the last one was
+	// before since
+	RecordLoginFailure(ctx context.Context, scope entities.LoginScope, key string, since time.Time) (entities.LoginFailures, error)
+	LockLogin(ctx context.Context, scope entities.LoginScope, key string, until time.Time) error
+	ClearLoginFailures(ctx context.Context, scope entities.LoginScope, key string) error
+}
@@ -0,0 +1,215 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// CaptchaVerifier checks the CAPTCHA answers of the clients asked to solve one
+//
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/captcha_verifier.go . CaptchaVerifier
+type CaptchaVerifier interface {
+	// VerifyCaptcha returns domain.ErrUnauthorized when the answer is wrong
+	VerifyCaptcha(ctx context.Context, answer, ipAddress string) error
+}
+
+// LockoutPolicy throttles the failed sign ins. Once an email failed Threshold
+// times in a row, or an address IPThreshold times, its sign ins are refused
+// for Backoff, doubled with each failure after up to MaxBackoff. Failures more
+// than Window apart start counting over. From CaptchaThreshold failures on, a
+// CAPTCHA is asked for when there's a verifier.
+type LockoutPolicy struct {
+	Threshold        int
+	IPThreshold      int
+	CaptchaThreshold int
+	Backoff          time.Duration
+	MaxBackoff       time.Duration
+	Window           time.Duration
+}
+
+func DefaultLockoutPolicy() LockoutPolicy {
+	return LockoutPolicy{
+		Threshold:        5,
+		IPThreshold:      20,
+		CaptchaThreshold: 3,
+		Backoff:          time.Minute,
+		MaxBackoff:       time.Hour,
+		Window:           24 * time.Hour,
+	}
+}
+
+// lockout returns how long a failure locks the sign ins for, none below the
+// threshold
+func (p LockoutPolicy) lockout(failures, threshold int) time.Duration {
+	if threshold <= 0 || failures < threshold {
+		return 0
+	}
+	backoff := p.Backoff
+	for i := threshold; i < failures && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, p.MaxBackoff)
+}
+
+// loginAttempt is a sign in as the guard sees it, cut to the lengths the
+// database keeps. UserID is empty when the email isn't registered.
+type loginAttempt struct {
+	email  string
+	userID string
+	device entities.Device
+}
+
+// LoginGuard protects the sign ins from brute force. It locks the emails and
+// addresses failing too many times in a row, asks for a CAPTCHA before that,
+// and audits the suspicious activity.
+type LoginGuard struct {
+	failureRepo LoginFailureRepository
+	eventRepo   AuthEventRepository
+	captcha     CaptchaVerifier
+	policy      LockoutPolicy
+	now         func() time.Time
+}
+
+// NewLoginGuard returns a guard enforcing the policy. The captcha verifier is
+// optional, no CAPTCHA is asked for without one.
+func NewLoginGuard(failureRepo LoginFailureRepository, eventRepo AuthEventRepository, captcha CaptchaVerifier, policy LockoutPolicy) *LoginGuard {
+	return &LoginGuard{
+		failureRepo: failureRepo,
+		eventRepo:   eventRepo,
+		captcha:     captcha,
+		policy:      policy,
+		now:         time.Now,
+	}
+}
+
+// check refuses the sign in while its email or address is locked, with a
+// domain.LockedError, or while a CAPTCHA is asked for and not solved
+func (g *LoginGuard) check(ctx context.Context, attempt loginAttempt, captcha string) error {
+	now := g.now()
+	emailFailures, err := g.failures(ctx, entities.LoginScopeEmail, attempt.email, now)
+	if err != nil {
+		return err
+	}
+	ipFailures, err := g.failures(ctx, entities.LoginScopeIP, attempt.device.ClientIP, now)
+	if err != nil {
+		return err
+	}
+
+	var until time.Time
+	for _, failures := range []entities.LoginFailures{emailFailures, ipFailures} {
+		if failures.LockedUntil != nil && failures.LockedUntil.After(now) && failures.LockedUntil.After(until) {
+			until = *failures.LockedUntil
+		}
+	}
+	if !until.IsZero() {
+		g.audit(ctx, entities.AuthEventLoginBlocked, attempt)
+		return &domain.LockedError{Until: until}
+	}
+
+	if g.captcha == nil || max(emailFailures.Failures, ipFailures.Failures) < g.policy.CaptchaThreshold {
+		return nil
+	}
+	if captcha == "" {
+		return fmt.Errorf("solve the captcha to sign in: %w", domain.ErrCaptchaRequired)
+	}
+	if err := g.captcha.VerifyCaptcha(ctx, captcha, attempt.device.ClientIP); err != nil {
+		if !errors.Is(err, domain.ErrUnauthorized) {
+			return fmt.Errorf("failed to verify captcha: %w", err)
+		}
+		g.audit(ctx, entities.AuthEventCaptchaFailed, attempt)
+		return fmt.Errorf("captcha not solved: %w", domain.ErrCaptchaRequired)
+	}
+	return nil
+}
+
+// failed counts the failed sign in against its email and address, locking
+// them once past their threshold
+func (g *LoginGuard) failed(ctx context.Context, attempt loginAttempt) error {
+	g.audit(ctx, entities.AuthEventLoginFailed, attempt)
+
+	now := g.now()
+	scopes := []struct {
+		scope     entities.LoginScope
+		key       string
+		threshold int
+		event     entities.AuthEventType
+	}{
+		{entities.LoginScopeEmail, attempt.email, g.policy.Threshold, entities.AuthEventAccountLocked},
+		{entities.LoginScopeIP, attempt.device.ClientIP, g.policy.IPThreshold, entities.AuthEventAddressLocked},
+	}
+	for _, s := range scopes {
+		if s.key == "" {
+			continue
+		}
+		failures, err := g.failureRepo.RecordLoginFailure(ctx, s.scope, s.key, now.Add(-g.policy.Window))
+		if err != nil {
+			return fmt.Errorf("failed to record login failure: %w", err)
+		}
+
+		lockout := g.policy.lockout(failures.Failures, s.threshold)
+		if lockout == 0 {
+			continue
+		}
+		until := now.Add(lockout)
+		if err := g.failureRepo.LockLogin(ctx, s.scope, s.key, until); err != nil {
+			return fmt.Errorf("failed to lock login: %w", err)
+		}
+		slog.Warn("sign ins locked after repeated failures",
+			"scope", s.scope,
+			"key", s.key,
+			"failures", failures.Failures,
+			"locked_until", until)
+		g.audit(ctx, s.event, attempt)
+	}
+	return nil
+}
+
+// succeeded forgets the failures of the email. The ones of the address are
+// kept, or signing in to an account of their own would let an attacker go on
+// trying others.
+func (g *LoginGuard) succeeded(ctx context.Context, attempt loginAttempt) error {
+	if err := g.failureRepo.ClearLoginFailures(ctx, entities.LoginScopeEmail, attempt.email); err != nil {
+		return fmt.Errorf("failed to clear login failures: %w", err)
+	}
+	return nil
+}
+
+// events lists the latest events of the user
+func (g *LoginGuard) events(ctx context.Context, userID string) ([]entities.AuthEvent, error) {
+	return g.eventRepo.GetAuthEventsByUser(ctx, userID, maxAuthEvents)
+}
+
+// failures returns the failures still counting of the email or address
+func (g *LoginGuard) failures(ctx context.Context, scope entities.LoginScope, key string, now time.Time) (entities.LoginFailures, error) {
+	if key == "" {
+		return entities.LoginFailures{}, nil
+	}
+	failures, err := g.failureRepo.GetLoginFailures(ctx, scope, key)
+	if err != nil {
+		return entities.LoginFailures{}, fmt.Errorf("failed to get login failures: %w", err)
+	}
+	if failures.LastFailedAt.Before(now.Add(-g.policy.Window)) {
+		failures.Failures = 0
+	}
+	return failures, nil
+}
+
+// audit records an event of the attempt. Failing to doesn't fail the sign in,
+// it's logged instead.
+func (g *LoginGuard) audit(ctx context.Context, eventType entities.AuthEventType, attempt loginAttempt) {
+	_, err := g.eventRepo.CreateAuthEvent(ctx, entities.AuthEvent{
+		UserID:    attempt.userID,
+		Type:      eventType,
+		Email:     attempt.email,
+		IPAddress: attempt.device.IPAddress,
+		UserAgent: attempt.device.UserAgent,
+	})
+	if err != nil {
+		slog.Error("failed to record auth event", "type", eventType, "error", err)
+	}
+}
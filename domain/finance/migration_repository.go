@@ -0,0 +1,12 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/migration_repository.go . MigrationRepository
+type MigrationRepository interface {
+	ListMigrations() ([]entities.Migration, error)
+	GetSchemaVersion(ctx context.Context) (version int64, dirty bool, err error)
+}
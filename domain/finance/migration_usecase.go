@@ -0,0 +1,48 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+	"fmt"
+)
+
+type MigrationUseCase struct {
+	migrationRepo MigrationRepository
+}
+
+func NewMigrationUseCase(migrationRepo MigrationRepository) *MigrationUseCase {
+	return &MigrationUseCase{
+		migrationRepo: migrationRepo,
+	}
+}
+
+// GetMigrationStatus splits the shipped migrations into the applied and the
+// pending ones according to the schema version of the database
+func (uc *MigrationUseCase) GetMigrationStatus(ctx context.Context) (entities.MigrationStatus, error) {
+	migrations, err := uc.migrationRepo.ListMigrations()
+	if err != nil {
+		return entities.MigrationStatus{}, fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	version, dirty, err := uc.migrationRepo.GetSchemaVersion(ctx)
+	if err != nil {
+		return entities.MigrationStatus{}, fmt.Errorf("failed to get schema version: %w", err)
+	}
+
+	status := entities.MigrationStatus{
+		SchemaVersion: version,
+		Dirty:         dirty,
+		Applied:       []entities.Migration{},
+		Pending:       []entities.Migration{},
+	}
+	for _, migration := range migrations {
+		status.LatestVersion = max(status.LatestVersion, migration.Version)
+		if migration.Version <= version {
+			status.Applied = append(status.Applied, migration)
+		} else {
+			status.Pending = append(status.Pending, migration)
+		}
+	}
+
+	return status, nil
+}
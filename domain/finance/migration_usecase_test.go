@@ -0,0 +1,72 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMigrationStatus(t *testing.T) {
+	shipped := []entities.Migration{
+		{Version: 1, Name: "consolidated_schema"},
+		{Version: 2, Name: "settings"},
+		{Version: 3, Name: "user_settings"},
+	}
+
+	tests := []struct {
+		name        string
+		version     int64
+		dirty       bool
+		wantApplied int
+		wantPending int
+		wantCurrent bool
+	}{
+		{name: "never migrated", version: 0, wantApplied: 0, wantPending: 3},
+		{name: "behind", version: 2, wantApplied: 2, wantPending: 1},
+		{name: "current", version: 3, wantApplied: 3, wantPending: 0, wantCurrent: true},
+		{name: "dirty", version: 3, dirty: true, wantApplied: 3, wantPending: 0},
+		{name: "ahead of the service", version: 4, wantApplied: 3, wantPending: 0, wantCurrent: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewMigrationUseCase(&mocks.MigrationRepositoryMock{
+				ListMigrationsFunc: func() ([]entities.Migration, error) {
+					return shipped, nil
+				},
+				GetSchemaVersionFunc: func(ctx context.Context) (int64, bool, error) {
+					return tt.version, tt.dirty, nil
+				},
+			})
+
+			status, err := uc.GetMigrationStatus(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.version, status.SchemaVersion)
+			assert.Equal(t, int64(3), status.LatestVersion)
+			assert.Equal(t, tt.dirty, status.Dirty)
+			assert.Len(t, status.Applied, tt.wantApplied)
+			assert.Len(t, status.Pending, tt.wantPending)
+			assert.Equal(t, tt.wantCurrent, status.Current())
+		})
+	}
+
+	t.Run("schema version error", func(t *testing.T) {
+		uc := NewMigrationUseCase(&mocks.MigrationRepositoryMock{
+			ListMigrationsFunc: func() ([]entities.Migration, error) {
+				return shipped, nil
+			},
+			GetSchemaVersionFunc: func(ctx context.Context) (int64, bool, error) {
+				return 0, false, errors.New("connection refused")
+			},
+		})
+
+		_, err := uc.GetMigrationStatus(context.Background())
+		assert.Error(t, err)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"finance/domain/entities"
 	"sync"
+	"time"
 )
 
 // AccountRepositoryMock is a mock implementation of finance.AccountRepository.
@@ -15,6 +16,12 @@ import (
 //
 //		// make and configure a mocked finance.AccountRepository
 //		mockedAccountRepository := &AccountRepositoryMock{
+//			CountAccountTransactionsFunc: func(ctx context.Context, id string) (int64, error) {
+//				panic("mock out the CountAccountTransactions method")
+//			},
+//			CountAccountsFunc: func(ctx context.Context) (int64, error) {
+//				panic("mock out the CountAccounts method")
+//			},
 //			CreateAccountFunc: func(ctx context.Context, account entities.Account) (entities.Account, error) {
 //				panic("mock out the CreateAccount method")
 //			},
@@ -24,7 +31,25 @@ import (
 //			GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
 //				panic("mock out the GetAccountByID method")
 //			},
-//			GetAllAccountsFunc: func(ctx context.Context) ([]entities.Account, error) {
+//			GetAccountPeriodSummaryFunc: func(ctx context.Context, account entities.Account, from time.Time, to time.Time) (entities.AccountPeriodSummary, error) {
+//				panic("mock out the GetAccountPeriodSummary method")
+//			},
+//			GetAccountStatementFunc: func(ctx context.Context, account entities.Account, from time.Time, to time.Time) (entities.AccountStatement, error) {
+//				panic("mock out the GetAccountStatement method")
+//			},
+//			GetAccountWithBalanceFunc: func(ctx context.Context, id string) (entities.Account, error) {
+//				panic("mock out the GetAccountWithBalance method")
+//			},
+//			GetAccountsPageFunc: func(ctx context.Context, sort []entities.SortField, limit int, offset int) ([]entities.Account, error) {
+//				panic("mock out the GetAccountsPage method")
+//			},
+//			GetAccountsWithBalancesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+//				panic("mock out the GetAccountsWithBalances method")
+//			},
+//			GetAccountsWithBalancesPageFunc: func(ctx context.Context, sort []entities.SortField, limit int, offset int) ([]entities.Account, error) {
+//				panic("mock out the GetAccountsWithBalancesPage method")
+//			},
+//			GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
 //				panic("mock out the GetAllAccounts method")
 //			},
 //			UpdateAccountFunc: func(ctx context.Context, account entities.Account) (entities.Account, error) {
@@ -37,6 +62,12 @@ import (
 //
 //	}
 type AccountRepositoryMock struct {
+	// CountAccountTransactionsFunc mocks the CountAccountTransactions method.
+	CountAccountTransactionsFunc func(ctx context.Context, id string) (int64, error)
+
+	// CountAccountsFunc mocks the CountAccounts method.
+	CountAccountsFunc func(ctx context.Context) (int64, error)
+
 	// CreateAccountFunc mocks the CreateAccount method.
 	CreateAccountFunc func(ctx context.Context, account entities.Account) (entities.Account, error)
 
@@ -46,14 +77,44 @@ type AccountRepositoryMock struct {
 	// GetAccountByIDFunc mocks the GetAccountByID method.
 	GetAccountByIDFunc func(ctx context.Context, id string) (entities.Account, error)
 
+	// GetAccountPeriodSummaryFunc mocks the GetAccountPeriodSummary method.
+	GetAccountPeriodSummaryFunc func(ctx context.Context, account entities.Account, from time.Time, to time.Time) (entities.AccountPeriodSummary, error)
+
+	// GetAccountStatementFunc mocks the GetAccountStatement method.
+	GetAccountStatementFunc func(ctx context.Context, account entities.Account, from time.Time, to time.Time) (entities.AccountStatement, error)
+
+	// GetAccountWithBalanceFunc mocks the GetAccountWithBalance method.
+	GetAccountWithBalanceFunc func(ctx context.Context, id string) (entities.Account, error)
+
+	// GetAccountsPageFunc mocks the GetAccountsPage method.
+	GetAccountsPageFunc func(ctx context.Context, sort []entities.SortField, limit int, offset int) ([]entities.Account, error)
+
+	// GetAccountsWithBalancesFunc mocks the GetAccountsWithBalances method.
+	GetAccountsWithBalancesFunc func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error)
+
+	// GetAccountsWithBalancesPageFunc mocks the GetAccountsWithBalancesPage method.
+	GetAccountsWithBalancesPageFunc func(ctx context.Context, sort []entities.SortField, limit int, offset int) ([]entities.Account, error)
+
 	// GetAllAccountsFunc mocks the GetAllAccounts method.
-	GetAllAccountsFunc func(ctx context.Context) ([]entities.Account, error)
+	GetAllAccountsFunc func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error)
 
 	// UpdateAccountFunc mocks the UpdateAccount method.
 	UpdateAccountFunc func(ctx context.Context, account entities.Account) (entities.Account, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// CountAccountTransactions holds details about calls to the CountAccountTransactions method.
+		CountAccountTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// CountAccounts holds details about calls to the CountAccounts method.
+		CountAccounts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// CreateAccount holds details about calls to the CreateAccount method.
 		CreateAccount []struct {
 			// Ctx is the ctx argument value.
@@ -75,10 +136,70 @@ type AccountRepositoryMock struct {
 			// ID is the id argument value.
 			ID string
 		}
+		// GetAccountPeriodSummary holds details about calls to the GetAccountPeriodSummary method.
+		GetAccountPeriodSummary []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Account is the account argument value.
+			Account entities.Account
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+		// GetAccountStatement holds details about calls to the GetAccountStatement method.
+		GetAccountStatement []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Account is the account argument value.
+			Account entities.Account
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+		// GetAccountWithBalance holds details about calls to the GetAccountWithBalance method.
+		GetAccountWithBalance []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetAccountsPage holds details about calls to the GetAccountsPage method.
+		GetAccountsPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Sort is the sort argument value.
+			Sort []entities.SortField
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
+		// GetAccountsWithBalances holds details about calls to the GetAccountsWithBalances method.
+		GetAccountsWithBalances []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Sort is the sort argument value.
+			Sort []entities.SortField
+		}
+		// GetAccountsWithBalancesPage holds details about calls to the GetAccountsWithBalancesPage method.
+		GetAccountsWithBalancesPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Sort is the sort argument value.
+			Sort []entities.SortField
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
 		// GetAllAccounts holds details about calls to the GetAllAccounts method.
 		GetAllAccounts []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// Sort is the sort argument value.
+			Sort []entities.SortField
 		}
 		// UpdateAccount holds details about calls to the UpdateAccount method.
 		UpdateAccount []struct {
@@ -88,11 +209,95 @@ type AccountRepositoryMock struct {
 			Account entities.Account
 		}
 	}
-	lockCreateAccount  sync.RWMutex
-	lockDeleteAccount  sync.RWMutex
-	lockGetAccountByID sync.RWMutex
-	lockGetAllAccounts sync.RWMutex
-	lockUpdateAccount  sync.RWMutex
+	lockCountAccountTransactions    sync.RWMutex
+	lockCountAccounts               sync.RWMutex
+	lockCreateAccount               sync.RWMutex
+	lockDeleteAccount               sync.RWMutex
+	lockGetAccountByID              sync.RWMutex
+	lockGetAccountPeriodSummary     sync.RWMutex
+	lockGetAccountStatement         sync.RWMutex
+	lockGetAccountWithBalance       sync.RWMutex
+	lockGetAccountsPage             sync.RWMutex
+	lockGetAccountsWithBalances     sync.RWMutex
+	lockGetAccountsWithBalancesPage sync.RWMutex
+	lockGetAllAccounts              sync.RWMutex
+	lockUpdateAccount               sync.RWMutex
+}
+
+// CountAccountTransactions calls CountAccountTransactionsFunc.
+func (mock *AccountRepositoryMock) CountAccountTransactions(ctx context.Context, id string) (int64, error) {
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockCountAccountTransactions.Lock()
+	mock.calls.CountAccountTransactions = append(mock.calls.CountAccountTransactions, callInfo)
+	mock.lockCountAccountTransactions.Unlock()
+	if mock.CountAccountTransactionsFunc == nil {
+		var (
+			nOut   int64
+			errOut error
+		)
+		return nOut, errOut
+	}
+	return mock.CountAccountTransactionsFunc(ctx, id)
+}
+
+// CountAccountTransactionsCalls gets all the calls that were made to CountAccountTransactions.
+// Check the length with:
+//
+//	len(mockedAccountRepository.CountAccountTransactionsCalls())
+func (mock *AccountRepositoryMock) CountAccountTransactionsCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockCountAccountTransactions.RLock()
+	calls = mock.calls.CountAccountTransactions
+	mock.lockCountAccountTransactions.RUnlock()
+	return calls
+}
+
+// CountAccounts calls CountAccountsFunc.
+func (mock *AccountRepositoryMock) CountAccounts(ctx context.Context) (int64, error) {
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockCountAccounts.Lock()
+	mock.calls.CountAccounts = append(mock.calls.CountAccounts, callInfo)
+	mock.lockCountAccounts.Unlock()
+	if mock.CountAccountsFunc == nil {
+		var (
+			nOut   int64
+			errOut error
+		)
+		return nOut, errOut
+	}
+	return mock.CountAccountsFunc(ctx)
+}
+
+// CountAccountsCalls gets all the calls that were made to CountAccounts.
+// Check the length with:
+//
+//	len(mockedAccountRepository.CountAccountsCalls())
+func (mock *AccountRepositoryMock) CountAccountsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockCountAccounts.RLock()
+	calls = mock.calls.CountAccounts
+	mock.lockCountAccounts.RUnlock()
+	return calls
 }
 
 // CreateAccount calls CreateAccountFunc.
@@ -214,12 +419,286 @@ func (mock *AccountRepositoryMock) GetAccountByIDCalls() []struct {
 	return calls
 }
 
-// GetAllAccounts calls GetAllAccountsFunc.
-func (mock *AccountRepositoryMock) GetAllAccounts(ctx context.Context) ([]entities.Account, error) {
+// GetAccountPeriodSummary calls GetAccountPeriodSummaryFunc.
+func (mock *AccountRepositoryMock) GetAccountPeriodSummary(ctx context.Context, account entities.Account, from time.Time, to time.Time) (entities.AccountPeriodSummary, error) {
+	callInfo := struct {
+		Ctx     context.Context
+		Account entities.Account
+		From    time.Time
+		To      time.Time
+	}{
+		Ctx:     ctx,
+		Account: account,
+		From:    from,
+		To:      to,
+	}
+	mock.lockGetAccountPeriodSummary.Lock()
+	mock.calls.GetAccountPeriodSummary = append(mock.calls.GetAccountPeriodSummary, callInfo)
+	mock.lockGetAccountPeriodSummary.Unlock()
+	if mock.GetAccountPeriodSummaryFunc == nil {
+		var (
+			accountPeriodSummaryOut entities.AccountPeriodSummary
+			errOut                  error
+		)
+		return accountPeriodSummaryOut, errOut
+	}
+	return mock.GetAccountPeriodSummaryFunc(ctx, account, from, to)
+}
+
+// GetAccountPeriodSummaryCalls gets all the calls that were made to GetAccountPeriodSummary.
+// Check the length with:
+//
+//	len(mockedAccountRepository.GetAccountPeriodSummaryCalls())
+func (mock *AccountRepositoryMock) GetAccountPeriodSummaryCalls() []struct {
+	Ctx     context.Context
+	Account entities.Account
+	From    time.Time
+	To      time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Account entities.Account
+		From    time.Time
+		To      time.Time
+	}
+	mock.lockGetAccountPeriodSummary.RLock()
+	calls = mock.calls.GetAccountPeriodSummary
+	mock.lockGetAccountPeriodSummary.RUnlock()
+	return calls
+}
+
+// GetAccountStatement calls GetAccountStatementFunc.
+func (mock *AccountRepositoryMock) GetAccountStatement(ctx context.Context, account entities.Account, from time.Time, to time.Time) (entities.AccountStatement, error) {
+	callInfo := struct {
+		Ctx     context.Context
+		Account entities.Account
+		From    time.Time
+		To      time.Time
+	}{
+		Ctx:     ctx,
+		Account: account,
+		From:    from,
+		To:      to,
+	}
+	mock.lockGetAccountStatement.Lock()
+	mock.calls.GetAccountStatement = append(mock.calls.GetAccountStatement, callInfo)
+	mock.lockGetAccountStatement.Unlock()
+	if mock.GetAccountStatementFunc == nil {
+		var (
+			accountStatementOut entities.AccountStatement
+			errOut              error
+		)
+		return accountStatementOut, errOut
+	}
+	return mock.GetAccountStatementFunc(ctx, account, from, to)
+}
+
+// GetAccountStatementCalls gets all the calls that were made to GetAccountStatement.
+// Check the length with:
+//
+//	len(mockedAccountRepository.GetAccountStatementCalls())
+func (mock *AccountRepositoryMock) GetAccountStatementCalls() []struct {
+	Ctx     context.Context
+	Account entities.Account
+	From    time.Time
+	To      time.Time
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Account entities.Account
+		From    time.Time
+		To      time.Time
+	}
+	mock.lockGetAccountStatement.RLock()
+	calls = mock.calls.GetAccountStatement
+	mock.lockGetAccountStatement.RUnlock()
+	return calls
+}
+
+// GetAccountWithBalance calls GetAccountWithBalanceFunc.
+func (mock *AccountRepositoryMock) GetAccountWithBalance(ctx context.Context, id string) (entities.Account, error) {
 	callInfo := struct {
 		Ctx context.Context
+		ID  string
 	}{
 		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockGetAccountWithBalance.Lock()
+	mock.calls.GetAccountWithBalance = append(mock.calls.GetAccountWithBalance, callInfo)
+	mock.lockGetAccountWithBalance.Unlock()
+	if mock.GetAccountWithBalanceFunc == nil {
+		var (
+			accountOut entities.Account
+			errOut     error
+		)
+		return accountOut, errOut
+	}
+	return mock.GetAccountWithBalanceFunc(ctx, id)
+}
+
+// GetAccountWithBalanceCalls gets all the calls that were made to GetAccountWithBalance.
+// Check the length with:
+//
+//	len(mockedAccountRepository.GetAccountWithBalanceCalls())
+func (mock *AccountRepositoryMock) GetAccountWithBalanceCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetAccountWithBalance.RLock()
+	calls = mock.calls.GetAccountWithBalance
+	mock.lockGetAccountWithBalance.RUnlock()
+	return calls
+}
+
+// GetAccountsPage calls GetAccountsPageFunc.
+func (mock *AccountRepositoryMock) GetAccountsPage(ctx context.Context, sort []entities.SortField, limit int, offset int) ([]entities.Account, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Sort   []entities.SortField
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Sort:   sort,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockGetAccountsPage.Lock()
+	mock.calls.GetAccountsPage = append(mock.calls.GetAccountsPage, callInfo)
+	mock.lockGetAccountsPage.Unlock()
+	if mock.GetAccountsPageFunc == nil {
+		var (
+			accountsOut []entities.Account
+			errOut      error
+		)
+		return accountsOut, errOut
+	}
+	return mock.GetAccountsPageFunc(ctx, sort, limit, offset)
+}
+
+// GetAccountsPageCalls gets all the calls that were made to GetAccountsPage.
+// Check the length with:
+//
+//	len(mockedAccountRepository.GetAccountsPageCalls())
+func (mock *AccountRepositoryMock) GetAccountsPageCalls() []struct {
+	Ctx    context.Context
+	Sort   []entities.SortField
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Sort   []entities.SortField
+		Limit  int
+		Offset int
+	}
+	mock.lockGetAccountsPage.RLock()
+	calls = mock.calls.GetAccountsPage
+	mock.lockGetAccountsPage.RUnlock()
+	return calls
+}
+
+// GetAccountsWithBalances calls GetAccountsWithBalancesFunc.
+func (mock *AccountRepositoryMock) GetAccountsWithBalances(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		Sort []entities.SortField
+	}{
+		Ctx:  ctx,
+		Sort: sort,
+	}
+	mock.lockGetAccountsWithBalances.Lock()
+	mock.calls.GetAccountsWithBalances = append(mock.calls.GetAccountsWithBalances, callInfo)
+	mock.lockGetAccountsWithBalances.Unlock()
+	if mock.GetAccountsWithBalancesFunc == nil {
+		var (
+			accountsOut []entities.Account
+			errOut      error
+		)
+		return accountsOut, errOut
+	}
+	return mock.GetAccountsWithBalancesFunc(ctx, sort)
+}
+
+// GetAccountsWithBalancesCalls gets all the calls that were made to GetAccountsWithBalances.
+// Check the length with:
+//
+//	len(mockedAccountRepository.GetAccountsWithBalancesCalls())
+func (mock *AccountRepositoryMock) GetAccountsWithBalancesCalls() []struct {
+	Ctx  context.Context
+	Sort []entities.SortField
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Sort []entities.SortField
+	}
+	mock.lockGetAccountsWithBalances.RLock()
+	calls = mock.calls.GetAccountsWithBalances
+	mock.lockGetAccountsWithBalances.RUnlock()
+	return calls
+}
+
+// GetAccountsWithBalancesPage calls GetAccountsWithBalancesPageFunc.
+func (mock *AccountRepositoryMock) GetAccountsWithBalancesPage(ctx context.Context, sort []entities.SortField, limit int, offset int) ([]entities.Account, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Sort   []entities.SortField
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Sort:   sort,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockGetAccountsWithBalancesPage.Lock()
+	mock.calls.GetAccountsWithBalancesPage = append(mock.calls.GetAccountsWithBalancesPage, callInfo)
+	mock.lockGetAccountsWithBalancesPage.Unlock()
+	if mock.GetAccountsWithBalancesPageFunc == nil {
+		var (
+			accountsOut []entities.Account
+			errOut      error
+		)
+		return accountsOut, errOut
+	}
+	return mock.GetAccountsWithBalancesPageFunc(ctx, sort, limit, offset)
+}
+
+// GetAccountsWithBalancesPageCalls gets all the calls that were made to GetAccountsWithBalancesPage.
+// Check the length with:
+//
+//	len(mockedAccountRepository.GetAccountsWithBalancesPageCalls())
+func (mock *AccountRepositoryMock) GetAccountsWithBalancesPageCalls() []struct {
+	Ctx    context.Context
+	Sort   []entities.SortField
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Sort   []entities.SortField
+		Limit  int
+		Offset int
+	}
+	mock.lockGetAccountsWithBalancesPage.RLock()
+	calls = mock.calls.GetAccountsWithBalancesPage
+	mock.lockGetAccountsWithBalancesPage.RUnlock()
+	return calls
+}
+
+// GetAllAccounts calls GetAllAccountsFunc.
+func (mock *AccountRepositoryMock) GetAllAccounts(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		Sort []entities.SortField
+	}{
+		Ctx:  ctx,
+		Sort: sort,
 	}
 	mock.lockGetAllAccounts.Lock()
 	mock.calls.GetAllAccounts = append(mock.calls.GetAllAccounts, callInfo)
@@ -231,7 +710,7 @@ func (mock *AccountRepositoryMock) GetAllAccounts(ctx context.Context) ([]entiti
 		)
 		return accountsOut, errOut
 	}
-	return mock.GetAllAccountsFunc(ctx)
+	return mock.GetAllAccountsFunc(ctx, sort)
 }
 
 // GetAllAccountsCalls gets all the calls that were made to GetAllAccounts.
@@ -239,10 +718,12 @@ func (mock *AccountRepositoryMock) GetAllAccounts(ctx context.Context) ([]entiti
 //
 //	len(mockedAccountRepository.GetAllAccountsCalls())
 func (mock *AccountRepositoryMock) GetAllAccountsCalls() []struct {
-	Ctx context.Context
+	Ctx  context.Context
+	Sort []entities.SortField
 } {
 	var calls []struct {
-		Ctx context.Context
+		Ctx  context.Context
+		Sort []entities.SortField
 	}
 	mock.lockGetAllAccounts.RLock()
 	calls = mock.calls.GetAllAccounts
@@ -7,6 +7,7 @@ import (
 	"context"
 	"finance/domain/entities"
 	"sync"
+	"time"
 )
 
 // BalanceRepositoryMock is a mock implementation of finance.BalanceRepository.
@@ -15,15 +16,27 @@ import (
 //
 //		// make and configure a mocked finance.BalanceRepository
 //		mockedBalanceRepository := &BalanceRepositoryMock{
+//			CountBalancesFunc: func(ctx context.Context) (int64, error) {
+//				panic("mock out the CountBalances method")
+//			},
 //			GetAllBalancesFunc: func(ctx context.Context) ([]entities.Balance, error) {
 //				panic("mock out the GetAllBalances method")
 //			},
 //			GetBalanceByAccountIDFunc: func(ctx context.Context, accountID string) (entities.Balance, error) {
 //				panic("mock out the GetBalanceByAccountID method")
 //			},
+//			GetBalanceSnapshotsAtFunc: func(ctx context.Context, date time.Time) ([]entities.BalanceSnapshot, error) {
+//				panic("mock out the GetBalanceSnapshotsAt method")
+//			},
+//			GetBalanceSnapshotsBetweenFunc: func(ctx context.Context, accountID string, from time.Time, to time.Time) ([]entities.BalanceSnapshot, error) {
+//				panic("mock out the GetBalanceSnapshotsBetween method")
+//			},
 //			GetBalanceSummaryFunc: func(ctx context.Context) (entities.BalanceSummary, error) {
 //				panic("mock out the GetBalanceSummary method")
 //			},
+//			GetBalancesPageFunc: func(ctx context.Context, limit int, offset int) ([]entities.Balance, error) {
+//				panic("mock out the GetBalancesPage method")
+//			},
 //			RefreshAccountBalanceFunc: func(ctx context.Context, accountID string) error {
 //				panic("mock out the RefreshAccountBalance method")
 //			},
@@ -34,20 +47,37 @@ import (
 //
 //	}
 type BalanceRepositoryMock struct {
+	// CountBalancesFunc mocks the CountBalances method.
+	CountBalancesFunc func(ctx context.Context) (int64, error)
+
 	// GetAllBalancesFunc mocks the GetAllBalances method.
 	GetAllBalancesFunc func(ctx context.Context) ([]entities.Balance, error)
 
 	// GetBalanceByAccountIDFunc mocks the GetBalanceByAccountID method.
 	GetBalanceByAccountIDFunc func(ctx context.Context, accountID string) (entities.Balance, error)
 
+	// GetBalanceSnapshotsAtFunc mocks the GetBalanceSnapshotsAt method.
+	GetBalanceSnapshotsAtFunc func(ctx context.Context, date time.Time) ([]entities.BalanceSnapshot, error)
+
+	// GetBalanceSnapshotsBetweenFunc mocks the GetBalanceSnapshotsBetween method.
+	GetBalanceSnapshotsBetweenFunc func(ctx context.Context, accountID string, from time.Time, to time.Time) ([]entities.BalanceSnapshot, error)
+
 	// GetBalanceSummaryFunc mocks the GetBalanceSummary method.
 	GetBalanceSummaryFunc func(ctx context.Context) (entities.BalanceSummary, error)
 
+	// GetBalancesPageFunc mocks the GetBalancesPage method.
+	GetBalancesPageFunc func(ctx context.Context, limit int, offset int) ([]entities.Balance, error)
+
 	// RefreshAccountBalanceFunc mocks the RefreshAccountBalance method.
 	RefreshAccountBalanceFunc func(ctx context.Context, accountID string) error
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// CountBalances holds details about calls to the CountBalances method.
+		CountBalances []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// GetAllBalances holds details about calls to the GetAllBalances method.
 		GetAllBalances []struct {
 			// Ctx is the ctx argument value.
@@ -60,11 +90,38 @@ type BalanceRepositoryMock struct {
 			// AccountID is the accountID argument value.
 			AccountID string
 		}
+		// GetBalanceSnapshotsAt holds details about calls to the GetBalanceSnapshotsAt method.
+		GetBalanceSnapshotsAt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Date is the date argument value.
+			Date time.Time
+		}
+		// GetBalanceSnapshotsBetween holds details about calls to the GetBalanceSnapshotsBetween method.
+		GetBalanceSnapshotsBetween []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountID is the accountID argument value.
+			AccountID string
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
 		// GetBalanceSummary holds details about calls to the GetBalanceSummary method.
 		GetBalanceSummary []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// GetBalancesPage holds details about calls to the GetBalancesPage method.
+		GetBalancesPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
 		// RefreshAccountBalance holds details about calls to the RefreshAccountBalance method.
 		RefreshAccountBalance []struct {
 			// Ctx is the ctx argument value.
@@ -73,10 +130,50 @@ type BalanceRepositoryMock struct {
 			AccountID string
 		}
 	}
-	lockGetAllBalances        sync.RWMutex
-	lockGetBalanceByAccountID sync.RWMutex
-	lockGetBalanceSummary     sync.RWMutex
-	lockRefreshAccountBalance sync.RWMutex
+	lockCountBalances              sync.RWMutex
+	lockGetAllBalances             sync.RWMutex
+	lockGetBalanceByAccountID      sync.RWMutex
+	lockGetBalanceSnapshotsAt      sync.RWMutex
+	lockGetBalanceSnapshotsBetween sync.RWMutex
+	lockGetBalanceSummary          sync.RWMutex
+	lockGetBalancesPage            sync.RWMutex
+	lockRefreshAccountBalance      sync.RWMutex
+}
+
+// CountBalances calls CountBalancesFunc.
+func (mock *BalanceRepositoryMock) CountBalances(ctx context.Context) (int64, error) {
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockCountBalances.Lock()
+	mock.calls.CountBalances = append(mock.calls.CountBalances, callInfo)
+	mock.lockCountBalances.Unlock()
+	if mock.CountBalancesFunc == nil {
+		var (
+			nOut   int64
+			errOut error
+		)
+		return nOut, errOut
+	}
+	return mock.CountBalancesFunc(ctx)
+}
+
+// CountBalancesCalls gets all the calls that were made to CountBalances.
+// Check the length with:
+//
+//	len(mockedBalanceRepository.CountBalancesCalls())
+func (mock *BalanceRepositoryMock) CountBalancesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockCountBalances.RLock()
+	calls = mock.calls.CountBalances
+	mock.lockCountBalances.RUnlock()
+	return calls
 }
 
 // GetAllBalances calls GetAllBalancesFunc.
@@ -155,6 +252,94 @@ func (mock *BalanceRepositoryMock) GetBalanceByAccountIDCalls() []struct {
 	return calls
 }
 
+// GetBalanceSnapshotsAt calls GetBalanceSnapshotsAtFunc.
+func (mock *BalanceRepositoryMock) GetBalanceSnapshotsAt(ctx context.Context, date time.Time) ([]entities.BalanceSnapshot, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		Date time.Time
+	}{
+		Ctx:  ctx,
+		Date: date,
+	}
+	mock.lockGetBalanceSnapshotsAt.Lock()
+	mock.calls.GetBalanceSnapshotsAt = append(mock.calls.GetBalanceSnapshotsAt, callInfo)
+	mock.lockGetBalanceSnapshotsAt.Unlock()
+	if mock.GetBalanceSnapshotsAtFunc == nil {
+		var (
+			balanceSnapshotsOut []entities.BalanceSnapshot
+			errOut              error
+		)
+		return balanceSnapshotsOut, errOut
+	}
+	return mock.GetBalanceSnapshotsAtFunc(ctx, date)
+}
+
+// GetBalanceSnapshotsAtCalls gets all the calls that were made to GetBalanceSnapshotsAt.
+// Check the length with:
+//
+//	len(mockedBalanceRepository.GetBalanceSnapshotsAtCalls())
+func (mock *BalanceRepositoryMock) GetBalanceSnapshotsAtCalls() []struct {
+	Ctx  context.Context
+	Date time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Date time.Time
+	}
+	mock.lockGetBalanceSnapshotsAt.RLock()
+	calls = mock.calls.GetBalanceSnapshotsAt
+	mock.lockGetBalanceSnapshotsAt.RUnlock()
+	return calls
+}
+
+// GetBalanceSnapshotsBetween calls GetBalanceSnapshotsBetweenFunc.
+func (mock *BalanceRepositoryMock) GetBalanceSnapshotsBetween(ctx context.Context, accountID string, from time.Time, to time.Time) ([]entities.BalanceSnapshot, error) {
+	callInfo := struct {
+		Ctx       context.Context
+		AccountID string
+		From      time.Time
+		To        time.Time
+	}{
+		Ctx:       ctx,
+		AccountID: accountID,
+		From:      from,
+		To:        to,
+	}
+	mock.lockGetBalanceSnapshotsBetween.Lock()
+	mock.calls.GetBalanceSnapshotsBetween = append(mock.calls.GetBalanceSnapshotsBetween, callInfo)
+	mock.lockGetBalanceSnapshotsBetween.Unlock()
+	if mock.GetBalanceSnapshotsBetweenFunc == nil {
+		var (
+			balanceSnapshotsOut []entities.BalanceSnapshot
+			errOut              error
+		)
+		return balanceSnapshotsOut, errOut
+	}
+	return mock.GetBalanceSnapshotsBetweenFunc(ctx, accountID, from, to)
+}
+
+// GetBalanceSnapshotsBetweenCalls gets all the calls that were made to GetBalanceSnapshotsBetween.
+// Check the length with:
+//
+//	len(mockedBalanceRepository.GetBalanceSnapshotsBetweenCalls())
+func (mock *BalanceRepositoryMock) GetBalanceSnapshotsBetweenCalls() []struct {
+	Ctx       context.Context
+	AccountID string
+	From      time.Time
+	To        time.Time
+} {
+	var calls []struct {
+		Ctx       context.Context
+		AccountID string
+		From      time.Time
+		To        time.Time
+	}
+	mock.lockGetBalanceSnapshotsBetween.RLock()
+	calls = mock.calls.GetBalanceSnapshotsBetween
+	mock.lockGetBalanceSnapshotsBetween.RUnlock()
+	return calls
+}
+
 // GetBalanceSummary calls GetBalanceSummaryFunc.
 func (mock *BalanceRepositoryMock) GetBalanceSummary(ctx context.Context) (entities.BalanceSummary, error) {
 	callInfo := struct {
@@ -191,6 +376,50 @@ func (mock *BalanceRepositoryMock) GetBalanceSummaryCalls() []struct {
 	return calls
 }
 
+// GetBalancesPage calls GetBalancesPageFunc.
+func (mock *BalanceRepositoryMock) GetBalancesPage(ctx context.Context, limit int, offset int) ([]entities.Balance, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockGetBalancesPage.Lock()
+	mock.calls.GetBalancesPage = append(mock.calls.GetBalancesPage, callInfo)
+	mock.lockGetBalancesPage.Unlock()
+	if mock.GetBalancesPageFunc == nil {
+		var (
+			balancesOut []entities.Balance
+			errOut      error
+		)
+		return balancesOut, errOut
+	}
+	return mock.GetBalancesPageFunc(ctx, limit, offset)
+}
+
+// GetBalancesPageCalls gets all the calls that were made to GetBalancesPage.
+// Check the length with:
+//
+//	len(mockedBalanceRepository.GetBalancesPageCalls())
+func (mock *BalanceRepositoryMock) GetBalancesPageCalls() []struct {
+	Ctx    context.Context
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Limit  int
+		Offset int
+	}
+	mock.lockGetBalancesPage.RLock()
+	calls = mock.calls.GetBalancesPage
+	mock.lockGetBalancesPage.RUnlock()
+	return calls
+}
+
 // RefreshAccountBalance calls RefreshAccountBalanceFunc.
 func (mock *BalanceRepositoryMock) RefreshAccountBalance(ctx context.Context, accountID string) error {
 	callInfo := struct {
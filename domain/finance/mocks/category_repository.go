@@ -15,18 +15,24 @@ import (
 //
 //		// make and configure a mocked finance.CategoryRepository
 //		mockedCategoryRepository := &CategoryRepositoryMock{
+//			CountCategoriesFunc: func(ctx context.Context) (int64, error) {
+//				panic("mock out the CountCategories method")
+//			},
 //			CreateCategoryFunc: func(ctx context.Context, category entities.Category) (entities.Category, error) {
 //				panic("mock out the CreateCategory method")
 //			},
 //			DeleteCategoryFunc: func(ctx context.Context, id string) error {
 //				panic("mock out the DeleteCategory method")
 //			},
-//			GetAllCategoriesFunc: func(ctx context.Context) ([]entities.Category, error) {
+//			GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
 //				panic("mock out the GetAllCategories method")
 //			},
 //			GetCategoriesByTypeFunc: func(ctx context.Context, categoryType entities.CategoryType) ([]entities.Category, error) {
 //				panic("mock out the GetCategoriesByType method")
 //			},
+//			GetCategoriesPageFunc: func(ctx context.Context, sort []entities.SortField, limit int, offset int) ([]entities.Category, error) {
+//				panic("mock out the GetCategoriesPage method")
+//			},
 //			GetCategoryByIDFunc: func(ctx context.Context, id string) (entities.Category, error) {
 //				panic("mock out the GetCategoryByID method")
 //			},
@@ -40,6 +46,9 @@ import (
 //
 //	}
 type CategoryRepositoryMock struct {
+	// CountCategoriesFunc mocks the CountCategories method.
+	CountCategoriesFunc func(ctx context.Context) (int64, error)
+
 	// CreateCategoryFunc mocks the CreateCategory method.
 	CreateCategoryFunc func(ctx context.Context, category entities.Category) (entities.Category, error)
 
@@ -47,11 +56,14 @@ type CategoryRepositoryMock struct {
 	DeleteCategoryFunc func(ctx context.Context, id string) error
 
 	// GetAllCategoriesFunc mocks the GetAllCategories method.
-	GetAllCategoriesFunc func(ctx context.Context) ([]entities.Category, error)
+	GetAllCategoriesFunc func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error)
 
 	// GetCategoriesByTypeFunc mocks the GetCategoriesByType method.
 	GetCategoriesByTypeFunc func(ctx context.Context, categoryType entities.CategoryType) ([]entities.Category, error)
 
+	// GetCategoriesPageFunc mocks the GetCategoriesPage method.
+	GetCategoriesPageFunc func(ctx context.Context, sort []entities.SortField, limit int, offset int) ([]entities.Category, error)
+
 	// GetCategoryByIDFunc mocks the GetCategoryByID method.
 	GetCategoryByIDFunc func(ctx context.Context, id string) (entities.Category, error)
 
@@ -60,6 +72,11 @@ type CategoryRepositoryMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// CountCategories holds details about calls to the CountCategories method.
+		CountCategories []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// CreateCategory holds details about calls to the CreateCategory method.
 		CreateCategory []struct {
 			// Ctx is the ctx argument value.
@@ -78,6 +95,8 @@ type CategoryRepositoryMock struct {
 		GetAllCategories []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// Sort is the sort argument value.
+			Sort []entities.SortField
 		}
 		// GetCategoriesByType holds details about calls to the GetCategoriesByType method.
 		GetCategoriesByType []struct {
@@ -86,6 +105,17 @@ type CategoryRepositoryMock struct {
 			// CategoryType is the categoryType argument value.
 			CategoryType entities.CategoryType
 		}
+		// GetCategoriesPage holds details about calls to the GetCategoriesPage method.
+		GetCategoriesPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Sort is the sort argument value.
+			Sort []entities.SortField
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
 		// GetCategoryByID holds details about calls to the GetCategoryByID method.
 		GetCategoryByID []struct {
 			// Ctx is the ctx argument value.
@@ -101,14 +131,52 @@ type CategoryRepositoryMock struct {
 			Category entities.Category
 		}
 	}
+	lockCountCategories     sync.RWMutex
 	lockCreateCategory      sync.RWMutex
 	lockDeleteCategory      sync.RWMutex
 	lockGetAllCategories    sync.RWMutex
 	lockGetCategoriesByType sync.RWMutex
+	lockGetCategoriesPage   sync.RWMutex
 	lockGetCategoryByID     sync.RWMutex
 	lockUpdateCategory      sync.RWMutex
 }
 
+// CountCategories calls CountCategoriesFunc.
+func (mock *CategoryRepositoryMock) CountCategories(ctx context.Context) (int64, error) {
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockCountCategories.Lock()
+	mock.calls.CountCategories = append(mock.calls.CountCategories, callInfo)
+	mock.lockCountCategories.Unlock()
+	if mock.CountCategoriesFunc == nil {
+		var (
+			nOut   int64
+			errOut error
+		)
+		return nOut, errOut
+	}
+	return mock.CountCategoriesFunc(ctx)
+}
+
+// CountCategoriesCalls gets all the calls that were made to CountCategories.
+// Check the length with:
+//
+//	len(mockedCategoryRepository.CountCategoriesCalls())
+func (mock *CategoryRepositoryMock) CountCategoriesCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockCountCategories.RLock()
+	calls = mock.calls.CountCategories
+	mock.lockCountCategories.RUnlock()
+	return calls
+}
+
 // CreateCategory calls CreateCategoryFunc.
 func (mock *CategoryRepositoryMock) CreateCategory(ctx context.Context, category entities.Category) (entities.Category, error) {
 	callInfo := struct {
@@ -189,11 +257,13 @@ func (mock *CategoryRepositoryMock) DeleteCategoryCalls() []struct {
 }
 
 // GetAllCategories calls GetAllCategoriesFunc.
-func (mock *CategoryRepositoryMock) GetAllCategories(ctx context.Context) ([]entities.Category, error) {
+func (mock *CategoryRepositoryMock) GetAllCategories(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
 	callInfo := struct {
-		Ctx context.Context
+		Ctx  context.Context
+		Sort []entities.SortField
 	}{
-		Ctx: ctx,
+		Ctx:  ctx,
+		Sort: sort,
 	}
 	mock.lockGetAllCategories.Lock()
 	mock.calls.GetAllCategories = append(mock.calls.GetAllCategories, callInfo)
@@ -205,7 +275,7 @@ func (mock *CategoryRepositoryMock) GetAllCategories(ctx context.Context) ([]ent
 		)
 		return categorysOut, errOut
 	}
-	return mock.GetAllCategoriesFunc(ctx)
+	return mock.GetAllCategoriesFunc(ctx, sort)
 }
 
 // GetAllCategoriesCalls gets all the calls that were made to GetAllCategories.
@@ -213,10 +283,12 @@ func (mock *CategoryRepositoryMock) GetAllCategories(ctx context.Context) ([]ent
 //
 //	len(mockedCategoryRepository.GetAllCategoriesCalls())
 func (mock *CategoryRepositoryMock) GetAllCategoriesCalls() []struct {
-	Ctx context.Context
+	Ctx  context.Context
+	Sort []entities.SortField
 } {
 	var calls []struct {
-		Ctx context.Context
+		Ctx  context.Context
+		Sort []entities.SortField
 	}
 	mock.lockGetAllCategories.RLock()
 	calls = mock.calls.GetAllCategories
@@ -264,6 +336,54 @@ func (mock *CategoryRepositoryMock) GetCategoriesByTypeCalls() []struct {
 	return calls
 }
 
+// GetCategoriesPage calls GetCategoriesPageFunc.
+func (mock *CategoryRepositoryMock) GetCategoriesPage(ctx context.Context, sort []entities.SortField, limit int, offset int) ([]entities.Category, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Sort   []entities.SortField
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Sort:   sort,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockGetCategoriesPage.Lock()
+	mock.calls.GetCategoriesPage = append(mock.calls.GetCategoriesPage, callInfo)
+	mock.lockGetCategoriesPage.Unlock()
+	if mock.GetCategoriesPageFunc == nil {
+		var (
+			categorysOut []entities.Category
+			errOut       error
+		)
+		return categorysOut, errOut
+	}
+	return mock.GetCategoriesPageFunc(ctx, sort, limit, offset)
+}
+
+// GetCategoriesPageCalls gets all the calls that were made to GetCategoriesPage.
+// Check the length with:
+//
+//	len(mockedCategoryRepository.GetCategoriesPageCalls())
+func (mock *CategoryRepositoryMock) GetCategoriesPageCalls() []struct {
+	Ctx    context.Context
+	Sort   []entities.SortField
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Sort   []entities.SortField
+		Limit  int
+		Offset int
+	}
+	mock.lockGetCategoriesPage.RLock()
+	calls = mock.calls.GetCategoriesPage
+	mock.lockGetCategoriesPage.RUnlock()
+	return calls
+}
+
 // GetCategoryByID calls GetCategoryByIDFunc.
 func (mock *CategoryRepositoryMock) GetCategoryByID(ctx context.Context, id string) (entities.Category, error) {
 	callInfo := struct {
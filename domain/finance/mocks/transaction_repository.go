@@ -16,18 +16,45 @@ import (
 //
 //		// make and configure a mocked finance.TransactionRepository
 //		mockedTransactionRepository := &TransactionRepositoryMock{
+//			ApplyBulkOperationsFunc: func(ctx context.Context, operations []entities.BulkOperation) ([]entities.Transaction, error) {
+//				panic("mock out the ApplyBulkOperations method")
+//			},
+//			CountDeletedTransactionsFunc: func(ctx context.Context) (int64, error) {
+//				panic("mock out the CountDeletedTransactions method")
+//			},
+//			CountSearchTransactionsFunc: func(ctx context.Context, query string) (int64, error) {
+//				panic("mock out the CountSearchTransactions method")
+//			},
+//			CountTransactionsFunc: func(ctx context.Context, filter entities.TransactionFilter) (int64, error) {
+//				panic("mock out the CountTransactions method")
+//			},
 //			CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
 //				panic("mock out the CreateTransaction method")
 //			},
+//			CreateTransactionsFunc: func(ctx context.Context, transactions []entities.Transaction) ([]entities.Transaction, error) {
+//				panic("mock out the CreateTransactions method")
+//			},
 //			DeleteTransactionFunc: func(ctx context.Context, id string) error {
 //				panic("mock out the DeleteTransaction method")
 //			},
 //			GetAllTransactionsFunc: func(ctx context.Context) ([]entities.Transaction, error) {
 //				panic("mock out the GetAllTransactions method")
 //			},
+//			GetCashFlowFunc: func(ctx context.Context, accountID string, startDate time.Time, endDate time.Time) ([]entities.CashFlowTotal, error) {
+//				panic("mock out the GetCashFlow method")
+//			},
+//			GetDeletedTransactionsFunc: func(ctx context.Context, limit int, offset int) ([]entities.Transaction, error) {
+//				panic("mock out the GetDeletedTransactions method")
+//			},
+//			GetSpendingFunc: func(ctx context.Context, byCategory bool, byMonth bool, startDate time.Time, endDate time.Time) ([]entities.SpendingTotal, error) {
+//				panic("mock out the GetSpending method")
+//			},
 //			GetTransactionByIDFunc: func(ctx context.Context, id string) (entities.Transaction, error) {
 //				panic("mock out the GetTransactionByID method")
 //			},
+//			GetTransactionCubeFunc: func(ctx context.Context, rows entities.ReportDimension, cols entities.ReportDimension, startDate time.Time, endDate time.Time) ([]entities.CubeGroup, error) {
+//				panic("mock out the GetTransactionCube method")
+//			},
 //			GetTransactionWithDetailsFunc: func(ctx context.Context, id string) (entities.Transaction, error) {
 //				panic("mock out the GetTransactionWithDetails method")
 //			},
@@ -43,9 +70,21 @@ import (
 //			GetTransactionsByDateRangeFunc: func(ctx context.Context, startDate time.Time, endDate time.Time) ([]entities.Transaction, error) {
 //				panic("mock out the GetTransactionsByDateRange method")
 //			},
-//			GetTransactionsWithDetailsFunc: func(ctx context.Context, limit int, offset int) ([]entities.Transaction, error) {
+//			GetTransactionsByInstallmentPlanFunc: func(ctx context.Context, planID string) ([]entities.Transaction, error) {
+//				panic("mock out the GetTransactionsByInstallmentPlan method")
+//			},
+//			GetTransactionsByProjectFunc: func(ctx context.Context, projectID string) ([]entities.Transaction, error) {
+//				panic("mock out the GetTransactionsByProject method")
+//			},
+//			GetTransactionsWithDetailsFunc: func(ctx context.Context, filter entities.TransactionFilter, limit int, offset int, sort []entities.SortField) ([]entities.Transaction, error) {
 //				panic("mock out the GetTransactionsWithDetails method")
 //			},
+//			RestoreTransactionFunc: func(ctx context.Context, id string) (entities.Transaction, error) {
+//				panic("mock out the RestoreTransaction method")
+//			},
+//			SearchTransactionsFunc: func(ctx context.Context, query string, limit int, offset int) ([]entities.Transaction, error) {
+//				panic("mock out the SearchTransactions method")
+//			},
 //			UpdateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
 //				panic("mock out the UpdateTransaction method")
 //			},
@@ -59,18 +98,45 @@ import (
 //
 //	}
 type TransactionRepositoryMock struct {
+	// ApplyBulkOperationsFunc mocks the ApplyBulkOperations method.
+	ApplyBulkOperationsFunc func(ctx context.Context, operations []entities.BulkOperation) ([]entities.Transaction, error)
+
+	// CountDeletedTransactionsFunc mocks the CountDeletedTransactions method.
+	CountDeletedTransactionsFunc func(ctx context.Context) (int64, error)
+
+	// CountSearchTransactionsFunc mocks the CountSearchTransactions method.
+	CountSearchTransactionsFunc func(ctx context.Context, query string) (int64, error)
+
+	// CountTransactionsFunc mocks the CountTransactions method.
+	CountTransactionsFunc func(ctx context.Context, filter entities.TransactionFilter) (int64, error)
+
 	// CreateTransactionFunc mocks the CreateTransaction method.
 	CreateTransactionFunc func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error)
 
+	// CreateTransactionsFunc mocks the CreateTransactions method.
+	CreateTransactionsFunc func(ctx context.Context, transactions []entities.Transaction) ([]entities.Transaction, error)
+
 	// DeleteTransactionFunc mocks the DeleteTransaction method.
 	DeleteTransactionFunc func(ctx context.Context, id string) error
 
 	// GetAllTransactionsFunc mocks the GetAllTransactions method.
 	GetAllTransactionsFunc func(ctx context.Context) ([]entities.Transaction, error)
 
+	// GetCashFlowFunc mocks the GetCashFlow method.
+	GetCashFlowFunc func(ctx context.Context, accountID string, startDate time.Time, endDate time.Time) ([]entities.CashFlowTotal, error)
+
+	// GetDeletedTransactionsFunc mocks the GetDeletedTransactions method.
+	GetDeletedTransactionsFunc func(ctx context.Context, limit int, offset int) ([]entities.Transaction, error)
+
+	// GetSpendingFunc mocks the GetSpending method.
+	GetSpendingFunc func(ctx context.Context, byCategory bool, byMonth bool, startDate time.Time, endDate time.Time) ([]entities.SpendingTotal, error)
+
 	// GetTransactionByIDFunc mocks the GetTransactionByID method.
 	GetTransactionByIDFunc func(ctx context.Context, id string) (entities.Transaction, error)
 
+	// GetTransactionCubeFunc mocks the GetTransactionCube method.
+	GetTransactionCubeFunc func(ctx context.Context, rows entities.ReportDimension, cols entities.ReportDimension, startDate time.Time, endDate time.Time) ([]entities.CubeGroup, error)
+
 	// GetTransactionWithDetailsFunc mocks the GetTransactionWithDetails method.
 	GetTransactionWithDetailsFunc func(ctx context.Context, id string) (entities.Transaction, error)
 
@@ -86,8 +152,20 @@ type TransactionRepositoryMock struct {
 	// GetTransactionsByDateRangeFunc mocks the GetTransactionsByDateRange method.
 	GetTransactionsByDateRangeFunc func(ctx context.Context, startDate time.Time, endDate time.Time) ([]entities.Transaction, error)
 
+	// GetTransactionsByInstallmentPlanFunc mocks the GetTransactionsByInstallmentPlan method.
+	GetTransactionsByInstallmentPlanFunc func(ctx context.Context, planID string) ([]entities.Transaction, error)
+
+	// GetTransactionsByProjectFunc mocks the GetTransactionsByProject method.
+	GetTransactionsByProjectFunc func(ctx context.Context, projectID string) ([]entities.Transaction, error)
+
 	// GetTransactionsWithDetailsFunc mocks the GetTransactionsWithDetails method.
-	GetTransactionsWithDetailsFunc func(ctx context.Context, limit int, offset int) ([]entities.Transaction, error)
+	GetTransactionsWithDetailsFunc func(ctx context.Context, filter entities.TransactionFilter, limit int, offset int, sort []entities.SortField) ([]entities.Transaction, error)
+
+	// RestoreTransactionFunc mocks the RestoreTransaction method.
+	RestoreTransactionFunc func(ctx context.Context, id string) (entities.Transaction, error)
+
+	// SearchTransactionsFunc mocks the SearchTransactions method.
+	SearchTransactionsFunc func(ctx context.Context, query string, limit int, offset int) ([]entities.Transaction, error)
 
 	// UpdateTransactionFunc mocks the UpdateTransaction method.
 	UpdateTransactionFunc func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error)
@@ -97,6 +175,32 @@ type TransactionRepositoryMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// ApplyBulkOperations holds details about calls to the ApplyBulkOperations method.
+		ApplyBulkOperations []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Operations is the operations argument value.
+			Operations []entities.BulkOperation
+		}
+		// CountDeletedTransactions holds details about calls to the CountDeletedTransactions method.
+		CountDeletedTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// CountSearchTransactions holds details about calls to the CountSearchTransactions method.
+		CountSearchTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+		}
+		// CountTransactions holds details about calls to the CountTransactions method.
+		CountTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter entities.TransactionFilter
+		}
 		// CreateTransaction holds details about calls to the CreateTransaction method.
 		CreateTransaction []struct {
 			// Ctx is the ctx argument value.
@@ -104,6 +208,13 @@ type TransactionRepositoryMock struct {
 			// Transaction is the transaction argument value.
 			Transaction entities.Transaction
 		}
+		// CreateTransactions holds details about calls to the CreateTransactions method.
+		CreateTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Transactions is the transactions argument value.
+			Transactions []entities.Transaction
+		}
 		// DeleteTransaction holds details about calls to the DeleteTransaction method.
 		DeleteTransaction []struct {
 			// Ctx is the ctx argument value.
@@ -116,6 +227,39 @@ type TransactionRepositoryMock struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// GetCashFlow holds details about calls to the GetCashFlow method.
+		GetCashFlow []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountID is the accountID argument value.
+			AccountID string
+			// StartDate is the startDate argument value.
+			StartDate time.Time
+			// EndDate is the endDate argument value.
+			EndDate time.Time
+		}
+		// GetDeletedTransactions holds details about calls to the GetDeletedTransactions method.
+		GetDeletedTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
+		// GetSpending holds details about calls to the GetSpending method.
+		GetSpending []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ByCategory is the byCategory argument value.
+			ByCategory bool
+			// ByMonth is the byMonth argument value.
+			ByMonth bool
+			// StartDate is the startDate argument value.
+			StartDate time.Time
+			// EndDate is the endDate argument value.
+			EndDate time.Time
+		}
 		// GetTransactionByID holds details about calls to the GetTransactionByID method.
 		GetTransactionByID []struct {
 			// Ctx is the ctx argument value.
@@ -123,6 +267,19 @@ type TransactionRepositoryMock struct {
 			// ID is the id argument value.
 			ID string
 		}
+		// GetTransactionCube holds details about calls to the GetTransactionCube method.
+		GetTransactionCube []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Rows is the rows argument value.
+			Rows entities.ReportDimension
+			// Cols is the cols argument value.
+			Cols entities.ReportDimension
+			// StartDate is the startDate argument value.
+			StartDate time.Time
+			// EndDate is the endDate argument value.
+			EndDate time.Time
+		}
 		// GetTransactionWithDetails holds details about calls to the GetTransactionWithDetails method.
 		GetTransactionWithDetails []struct {
 			// Ctx is the ctx argument value.
@@ -164,10 +321,46 @@ type TransactionRepositoryMock struct {
 			// EndDate is the endDate argument value.
 			EndDate time.Time
 		}
+		// GetTransactionsByInstallmentPlan holds details about calls to the GetTransactionsByInstallmentPlan method.
+		GetTransactionsByInstallmentPlan []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PlanID is the planID argument value.
+			PlanID string
+		}
+		// GetTransactionsByProject holds details about calls to the GetTransactionsByProject method.
+		GetTransactionsByProject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProjectID is the projectID argument value.
+			ProjectID string
+		}
 		// GetTransactionsWithDetails holds details about calls to the GetTransactionsWithDetails method.
 		GetTransactionsWithDetails []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter entities.TransactionFilter
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+			// Sort is the sort argument value.
+			Sort []entities.SortField
+		}
+		// RestoreTransaction holds details about calls to the RestoreTransaction method.
+		RestoreTransaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// SearchTransactions holds details about calls to the SearchTransactions method.
+		SearchTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
 			// Limit is the limit argument value.
 			Limit int
 			// Offset is the offset argument value.
@@ -190,20 +383,189 @@ type TransactionRepositoryMock struct {
 			Status entities.TransactionStatus
 		}
 	}
+	lockApplyBulkOperations                  sync.RWMutex
+	lockCountDeletedTransactions             sync.RWMutex
+	lockCountSearchTransactions              sync.RWMutex
+	lockCountTransactions                    sync.RWMutex
 	lockCreateTransaction                    sync.RWMutex
+	lockCreateTransactions                   sync.RWMutex
 	lockDeleteTransaction                    sync.RWMutex
 	lockGetAllTransactions                   sync.RWMutex
+	lockGetCashFlow                          sync.RWMutex
+	lockGetDeletedTransactions               sync.RWMutex
+	lockGetSpending                          sync.RWMutex
 	lockGetTransactionByID                   sync.RWMutex
+	lockGetTransactionCube                   sync.RWMutex
 	lockGetTransactionWithDetails            sync.RWMutex
 	lockGetTransactionsByAccount             sync.RWMutex
 	lockGetTransactionsByAccountAndDateRange sync.RWMutex
 	lockGetTransactionsByCategory            sync.RWMutex
 	lockGetTransactionsByDateRange           sync.RWMutex
+	lockGetTransactionsByInstallmentPlan     sync.RWMutex
+	lockGetTransactionsByProject             sync.RWMutex
 	lockGetTransactionsWithDetails           sync.RWMutex
+	lockRestoreTransaction                   sync.RWMutex
+	lockSearchTransactions                   sync.RWMutex
 	lockUpdateTransaction                    sync.RWMutex
 	lockUpdateTransactionStatus              sync.RWMutex
 }
 
+// ApplyBulkOperations calls ApplyBulkOperationsFunc.
+func (mock *TransactionRepositoryMock) ApplyBulkOperations(ctx context.Context, operations []entities.BulkOperation) ([]entities.Transaction, error) {
+	callInfo := struct {
+		Ctx        context.Context
+		Operations []entities.BulkOperation
+	}{
+		Ctx:        ctx,
+		Operations: operations,
+	}
+	mock.lockApplyBulkOperations.Lock()
+	mock.calls.ApplyBulkOperations = append(mock.calls.ApplyBulkOperations, callInfo)
+	mock.lockApplyBulkOperations.Unlock()
+	if mock.ApplyBulkOperationsFunc == nil {
+		var (
+			transactionsOut []entities.Transaction
+			errOut          error
+		)
+		return transactionsOut, errOut
+	}
+	return mock.ApplyBulkOperationsFunc(ctx, operations)
+}
+
+// ApplyBulkOperationsCalls gets all the calls that were made to ApplyBulkOperations.
+// Check the length with:
+//
+//	len(mockedTransactionRepository.ApplyBulkOperationsCalls())
+func (mock *TransactionRepositoryMock) ApplyBulkOperationsCalls() []struct {
+	Ctx        context.Context
+	Operations []entities.BulkOperation
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Operations []entities.BulkOperation
+	}
+	mock.lockApplyBulkOperations.RLock()
+	calls = mock.calls.ApplyBulkOperations
+	mock.lockApplyBulkOperations.RUnlock()
+	return calls
+}
+
+// CountDeletedTransactions calls CountDeletedTransactionsFunc.
+func (mock *TransactionRepositoryMock) CountDeletedTransactions(ctx context.Context) (int64, error) {
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockCountDeletedTransactions.Lock()
+	mock.calls.CountDeletedTransactions = append(mock.calls.CountDeletedTransactions, callInfo)
+	mock.lockCountDeletedTransactions.Unlock()
+	if mock.CountDeletedTransactionsFunc == nil {
+		var (
+			nOut   int64
+			errOut error
+		)
+		return nOut, errOut
+	}
+	return mock.CountDeletedTransactionsFunc(ctx)
+}
+
+// CountDeletedTransactionsCalls gets all the calls that were made to CountDeletedTransactions.
+// Check the length with:
+//
+//	len(mockedTransactionRepository.CountDeletedTransactionsCalls())
+func (mock *TransactionRepositoryMock) CountDeletedTransactionsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockCountDeletedTransactions.RLock()
+	calls = mock.calls.CountDeletedTransactions
+	mock.lockCountDeletedTransactions.RUnlock()
+	return calls
+}
+
+// CountSearchTransactions calls CountSearchTransactionsFunc.
+func (mock *TransactionRepositoryMock) CountSearchTransactions(ctx context.Context, query string) (int64, error) {
+	callInfo := struct {
+		Ctx   context.Context
+		Query string
+	}{
+		Ctx:   ctx,
+		Query: query,
+	}
+	mock.lockCountSearchTransactions.Lock()
+	mock.calls.CountSearchTransactions = append(mock.calls.CountSearchTransactions, callInfo)
+	mock.lockCountSearchTransactions.Unlock()
+	if mock.CountSearchTransactionsFunc == nil {
+		var (
+			nOut   int64
+			errOut error
+		)
+		return nOut, errOut
+	}
+	return mock.CountSearchTransactionsFunc(ctx, query)
+}
+
+// CountSearchTransactionsCalls gets all the calls that were made to CountSearchTransactions.
+// Check the length with:
+//
+//	len(mockedTransactionRepository.CountSearchTransactionsCalls())
+func (mock *TransactionRepositoryMock) CountSearchTransactionsCalls() []struct {
+	Ctx   context.Context
+	Query string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Query string
+	}
+	mock.lockCountSearchTransactions.RLock()
+	calls = mock.calls.CountSearchTransactions
+	mock.lockCountSearchTransactions.RUnlock()
+	return calls
+}
+
+// CountTransactions calls CountTransactionsFunc.
+func (mock *TransactionRepositoryMock) CountTransactions(ctx context.Context, filter entities.TransactionFilter) (int64, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Filter entities.TransactionFilter
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+	}
+	mock.lockCountTransactions.Lock()
+	mock.calls.CountTransactions = append(mock.calls.CountTransactions, callInfo)
+	mock.lockCountTransactions.Unlock()
+	if mock.CountTransactionsFunc == nil {
+		var (
+			nOut   int64
+			errOut error
+		)
+		return nOut, errOut
+	}
+	return mock.CountTransactionsFunc(ctx, filter)
+}
+
+// CountTransactionsCalls gets all the calls that were made to CountTransactions.
+// Check the length with:
+//
+//	len(mockedTransactionRepository.CountTransactionsCalls())
+func (mock *TransactionRepositoryMock) CountTransactionsCalls() []struct {
+	Ctx    context.Context
+	Filter entities.TransactionFilter
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter entities.TransactionFilter
+	}
+	mock.lockCountTransactions.RLock()
+	calls = mock.calls.CountTransactions
+	mock.lockCountTransactions.RUnlock()
+	return calls
+}
+
 // CreateTransaction calls CreateTransactionFunc.
 func (mock *TransactionRepositoryMock) CreateTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
 	callInfo := struct {
@@ -244,6 +606,46 @@ func (mock *TransactionRepositoryMock) CreateTransactionCalls() []struct {
 	return calls
 }
 
+// CreateTransactions calls CreateTransactionsFunc.
+func (mock *TransactionRepositoryMock) CreateTransactions(ctx context.Context, transactions []entities.Transaction) ([]entities.Transaction, error) {
+	callInfo := struct {
+		Ctx          context.Context
+		Transactions []entities.Transaction
+	}{
+		Ctx:          ctx,
+		Transactions: transactions,
+	}
+	mock.lockCreateTransactions.Lock()
+	mock.calls.CreateTransactions = append(mock.calls.CreateTransactions, callInfo)
+	mock.lockCreateTransactions.Unlock()
+	if mock.CreateTransactionsFunc == nil {
+		var (
+			transactionsOut []entities.Transaction
+			errOut          error
+		)
+		return transactionsOut, errOut
+	}
+	return mock.CreateTransactionsFunc(ctx, transactions)
+}
+
+// CreateTransactionsCalls gets all the calls that were made to CreateTransactions.
+// Check the length with:
+//
+//	len(mockedTransactionRepository.CreateTransactionsCalls())
+func (mock *TransactionRepositoryMock) CreateTransactionsCalls() []struct {
+	Ctx          context.Context
+	Transactions []entities.Transaction
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Transactions []entities.Transaction
+	}
+	mock.lockCreateTransactions.RLock()
+	calls = mock.calls.CreateTransactions
+	mock.lockCreateTransactions.RUnlock()
+	return calls
+}
+
 // DeleteTransaction calls DeleteTransactionFunc.
 func (mock *TransactionRepositoryMock) DeleteTransaction(ctx context.Context, id string) error {
 	callInfo := struct {
@@ -319,6 +721,150 @@ func (mock *TransactionRepositoryMock) GetAllTransactionsCalls() []struct {
 	return calls
 }
 
+// GetCashFlow calls GetCashFlowFunc.
+func (mock *TransactionRepositoryMock) GetCashFlow(ctx context.Context, accountID string, startDate time.Time, endDate time.Time) ([]entities.CashFlowTotal, error) {
+	callInfo := struct {
+		Ctx       context.Context
+		AccountID string
+		StartDate time.Time
+		EndDate   time.Time
+	}{
+		Ctx:       ctx,
+		AccountID: accountID,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+	mock.lockGetCashFlow.Lock()
+	mock.calls.GetCashFlow = append(mock.calls.GetCashFlow, callInfo)
+	mock.lockGetCashFlow.Unlock()
+	if mock.GetCashFlowFunc == nil {
+		var (
+			cashFlowTotalsOut []entities.CashFlowTotal
+			errOut            error
+		)
+		return cashFlowTotalsOut, errOut
+	}
+	return mock.GetCashFlowFunc(ctx, accountID, startDate, endDate)
+}
+
+// GetCashFlowCalls gets all the calls that were made to GetCashFlow.
+// Check the length with:
+//
+//	len(mockedTransactionRepository.GetCashFlowCalls())
+func (mock *TransactionRepositoryMock) GetCashFlowCalls() []struct {
+	Ctx       context.Context
+	AccountID string
+	StartDate time.Time
+	EndDate   time.Time
+} {
+	var calls []struct {
+		Ctx       context.Context
+		AccountID string
+		StartDate time.Time
+		EndDate   time.Time
+	}
+	mock.lockGetCashFlow.RLock()
+	calls = mock.calls.GetCashFlow
+	mock.lockGetCashFlow.RUnlock()
+	return calls
+}
+
+// GetDeletedTransactions calls GetDeletedTransactionsFunc.
+func (mock *TransactionRepositoryMock) GetDeletedTransactions(ctx context.Context, limit int, offset int) ([]entities.Transaction, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockGetDeletedTransactions.Lock()
+	mock.calls.GetDeletedTransactions = append(mock.calls.GetDeletedTransactions, callInfo)
+	mock.lockGetDeletedTransactions.Unlock()
+	if mock.GetDeletedTransactionsFunc == nil {
+		var (
+			transactionsOut []entities.Transaction
+			errOut          error
+		)
+		return transactionsOut, errOut
+	}
+	return mock.GetDeletedTransactionsFunc(ctx, limit, offset)
+}
+
+// GetDeletedTransactionsCalls gets all the calls that were made to GetDeletedTransactions.
+// Check the length with:
+//
+//	len(mockedTransactionRepository.GetDeletedTransactionsCalls())
+func (mock *TransactionRepositoryMock) GetDeletedTransactionsCalls() []struct {
+	Ctx    context.Context
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Limit  int
+		Offset int
+	}
+	mock.lockGetDeletedTransactions.RLock()
+	calls = mock.calls.GetDeletedTransactions
+	mock.lockGetDeletedTransactions.RUnlock()
+	return calls
+}
+
+// GetSpending calls GetSpendingFunc.
+func (mock *TransactionRepositoryMock) GetSpending(ctx context.Context, byCategory bool, byMonth bool, startDate time.Time, endDate time.Time) ([]entities.SpendingTotal, error) {
+	callInfo := struct {
+		Ctx        context.Context
+		ByCategory bool
+		ByMonth    bool
+		StartDate  time.Time
+		EndDate    time.Time
+	}{
+		Ctx:        ctx,
+		ByCategory: byCategory,
+		ByMonth:    byMonth,
+		StartDate:  startDate,
+		EndDate:    endDate,
+	}
+	mock.lockGetSpending.Lock()
+	mock.calls.GetSpending = append(mock.calls.GetSpending, callInfo)
+	mock.lockGetSpending.Unlock()
+	if mock.GetSpendingFunc == nil {
+		var (
+			spendingTotalsOut []entities.SpendingTotal
+			errOut            error
+		)
+		return spendingTotalsOut, errOut
+	}
+	return mock.GetSpendingFunc(ctx, byCategory, byMonth, startDate, endDate)
+}
+
+// GetSpendingCalls gets all the calls that were made to GetSpending.
+// Check the length with:
+//
+//	len(mockedTransactionRepository.GetSpendingCalls())
+func (mock *TransactionRepositoryMock) GetSpendingCalls() []struct {
+	Ctx        context.Context
+	ByCategory bool
+	ByMonth    bool
+	StartDate  time.Time
+	EndDate    time.Time
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ByCategory bool
+		ByMonth    bool
+		StartDate  time.Time
+		EndDate    time.Time
+	}
+	mock.lockGetSpending.RLock()
+	calls = mock.calls.GetSpending
+	mock.lockGetSpending.RUnlock()
+	return calls
+}
+
 // GetTransactionByID calls GetTransactionByIDFunc.
 func (mock *TransactionRepositoryMock) GetTransactionByID(ctx context.Context, id string) (entities.Transaction, error) {
 	callInfo := struct {
@@ -359,6 +905,58 @@ func (mock *TransactionRepositoryMock) GetTransactionByIDCalls() []struct {
 	return calls
 }
 
+// GetTransactionCube calls GetTransactionCubeFunc.
+func (mock *TransactionRepositoryMock) GetTransactionCube(ctx context.Context, rows entities.ReportDimension, cols entities.ReportDimension, startDate time.Time, endDate time.Time) ([]entities.CubeGroup, error) {
+	callInfo := struct {
+		Ctx       context.Context
+		Rows      entities.ReportDimension
+		Cols      entities.ReportDimension
+		StartDate time.Time
+		EndDate   time.Time
+	}{
+		Ctx:       ctx,
+		Rows:      rows,
+		Cols:      cols,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+	mock.lockGetTransactionCube.Lock()
+	mock.calls.GetTransactionCube = append(mock.calls.GetTransactionCube, callInfo)
+	mock.lockGetTransactionCube.Unlock()
+	if mock.GetTransactionCubeFunc == nil {
+		var (
+			cubeGroupsOut []entities.CubeGroup
+			errOut        error
+		)
+		return cubeGroupsOut, errOut
+	}
+	return mock.GetTransactionCubeFunc(ctx, rows, cols, startDate, endDate)
+}
+
+// GetTransactionCubeCalls gets all the calls that were made to GetTransactionCube.
+// Check the length with:
+//
+//	len(mockedTransactionRepository.GetTransactionCubeCalls())
+func (mock *TransactionRepositoryMock) GetTransactionCubeCalls() []struct {
+	Ctx       context.Context
+	Rows      entities.ReportDimension
+	Cols      entities.ReportDimension
+	StartDate time.Time
+	EndDate   time.Time
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Rows      entities.ReportDimension
+		Cols      entities.ReportDimension
+		StartDate time.Time
+		EndDate   time.Time
+	}
+	mock.lockGetTransactionCube.RLock()
+	calls = mock.calls.GetTransactionCube
+	mock.lockGetTransactionCube.RUnlock()
+	return calls
+}
+
 // GetTransactionWithDetails calls GetTransactionWithDetailsFunc.
 func (mock *TransactionRepositoryMock) GetTransactionWithDetails(ctx context.Context, id string) (entities.Transaction, error) {
 	callInfo := struct {
@@ -571,16 +1169,100 @@ func (mock *TransactionRepositoryMock) GetTransactionsByDateRangeCalls() []struc
 	return calls
 }
 
+// GetTransactionsByInstallmentPlan calls GetTransactionsByInstallmentPlanFunc.
+func (mock *TransactionRepositoryMock) GetTransactionsByInstallmentPlan(ctx context.Context, planID string) ([]entities.Transaction, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		PlanID string
+	}{
+		Ctx:    ctx,
+		PlanID: planID,
+	}
+	mock.lockGetTransactionsByInstallmentPlan.Lock()
+	mock.calls.GetTransactionsByInstallmentPlan = append(mock.calls.GetTransactionsByInstallmentPlan, callInfo)
+	mock.lockGetTransactionsByInstallmentPlan.Unlock()
+	if mock.GetTransactionsByInstallmentPlanFunc == nil {
+		var (
+			transactionsOut []entities.Transaction
+			errOut          error
+		)
+		return transactionsOut, errOut
+	}
+	return mock.GetTransactionsByInstallmentPlanFunc(ctx, planID)
+}
+
+// GetTransactionsByInstallmentPlanCalls gets all the calls that were made to GetTransactionsByInstallmentPlan.
+// Check the length with:
+//
+//	len(mockedTransactionRepository.GetTransactionsByInstallmentPlanCalls())
+func (mock *TransactionRepositoryMock) GetTransactionsByInstallmentPlanCalls() []struct {
+	Ctx    context.Context
+	PlanID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		PlanID string
+	}
+	mock.lockGetTransactionsByInstallmentPlan.RLock()
+	calls = mock.calls.GetTransactionsByInstallmentPlan
+	mock.lockGetTransactionsByInstallmentPlan.RUnlock()
+	return calls
+}
+
+// GetTransactionsByProject calls GetTransactionsByProjectFunc.
+func (mock *TransactionRepositoryMock) GetTransactionsByProject(ctx context.Context, projectID string) ([]entities.Transaction, error) {
+	callInfo := struct {
+		Ctx       context.Context
+		ProjectID string
+	}{
+		Ctx:       ctx,
+		ProjectID: projectID,
+	}
+	mock.lockGetTransactionsByProject.Lock()
+	mock.calls.GetTransactionsByProject = append(mock.calls.GetTransactionsByProject, callInfo)
+	mock.lockGetTransactionsByProject.Unlock()
+	if mock.GetTransactionsByProjectFunc == nil {
+		var (
+			transactionsOut []entities.Transaction
+			errOut          error
+		)
+		return transactionsOut, errOut
+	}
+	return mock.GetTransactionsByProjectFunc(ctx, projectID)
+}
+
+// GetTransactionsByProjectCalls gets all the calls that were made to GetTransactionsByProject.
+// Check the length with:
+//
+//	len(mockedTransactionRepository.GetTransactionsByProjectCalls())
+func (mock *TransactionRepositoryMock) GetTransactionsByProjectCalls() []struct {
+	Ctx       context.Context
+	ProjectID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		ProjectID string
+	}
+	mock.lockGetTransactionsByProject.RLock()
+	calls = mock.calls.GetTransactionsByProject
+	mock.lockGetTransactionsByProject.RUnlock()
+	return calls
+}
+
 // GetTransactionsWithDetails calls GetTransactionsWithDetailsFunc.
-func (mock *TransactionRepositoryMock) GetTransactionsWithDetails(ctx context.Context, limit int, offset int) ([]entities.Transaction, error) {
+func (mock *TransactionRepositoryMock) GetTransactionsWithDetails(ctx context.Context, filter entities.TransactionFilter, limit int, offset int, sort []entities.SortField) ([]entities.Transaction, error) {
 	callInfo := struct {
 		Ctx    context.Context
+		Filter entities.TransactionFilter
 		Limit  int
 		Offset int
+		Sort   []entities.SortField
 	}{
 		Ctx:    ctx,
+		Filter: filter,
 		Limit:  limit,
 		Offset: offset,
+		Sort:   sort,
 	}
 	mock.lockGetTransactionsWithDetails.Lock()
 	mock.calls.GetTransactionsWithDetails = append(mock.calls.GetTransactionsWithDetails, callInfo)
@@ -592,7 +1274,7 @@ func (mock *TransactionRepositoryMock) GetTransactionsWithDetails(ctx context.Co
 		)
 		return transactionsOut, errOut
 	}
-	return mock.GetTransactionsWithDetailsFunc(ctx, limit, offset)
+	return mock.GetTransactionsWithDetailsFunc(ctx, filter, limit, offset, sort)
 }
 
 // GetTransactionsWithDetailsCalls gets all the calls that were made to GetTransactionsWithDetails.
@@ -601,13 +1283,17 @@ func (mock *TransactionRepositoryMock) GetTransactionsWithDetails(ctx context.Co
 //	len(mockedTransactionRepository.GetTransactionsWithDetailsCalls())
 func (mock *TransactionRepositoryMock) GetTransactionsWithDetailsCalls() []struct {
 	Ctx    context.Context
+	Filter entities.TransactionFilter
 	Limit  int
 	Offset int
+	Sort   []entities.SortField
 } {
 	var calls []struct {
 		Ctx    context.Context
+		Filter entities.TransactionFilter
 		Limit  int
 		Offset int
+		Sort   []entities.SortField
 	}
 	mock.lockGetTransactionsWithDetails.RLock()
 	calls = mock.calls.GetTransactionsWithDetails
@@ -615,6 +1301,94 @@ func (mock *TransactionRepositoryMock) GetTransactionsWithDetailsCalls() []struc
 	return calls
 }
 
+// RestoreTransaction calls RestoreTransactionFunc.
+func (mock *TransactionRepositoryMock) RestoreTransaction(ctx context.Context, id string) (entities.Transaction, error) {
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRestoreTransaction.Lock()
+	mock.calls.RestoreTransaction = append(mock.calls.RestoreTransaction, callInfo)
+	mock.lockRestoreTransaction.Unlock()
+	if mock.RestoreTransactionFunc == nil {
+		var (
+			transactionOut entities.Transaction
+			errOut         error
+		)
+		return transactionOut, errOut
+	}
+	return mock.RestoreTransactionFunc(ctx, id)
+}
+
+// RestoreTransactionCalls gets all the calls that were made to RestoreTransaction.
+// Check the length with:
+//
+//	len(mockedTransactionRepository.RestoreTransactionCalls())
+func (mock *TransactionRepositoryMock) RestoreTransactionCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockRestoreTransaction.RLock()
+	calls = mock.calls.RestoreTransaction
+	mock.lockRestoreTransaction.RUnlock()
+	return calls
+}
+
+// SearchTransactions calls SearchTransactionsFunc.
+func (mock *TransactionRepositoryMock) SearchTransactions(ctx context.Context, query string, limit int, offset int) ([]entities.Transaction, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Query  string
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Query:  query,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockSearchTransactions.Lock()
+	mock.calls.SearchTransactions = append(mock.calls.SearchTransactions, callInfo)
+	mock.lockSearchTransactions.Unlock()
+	if mock.SearchTransactionsFunc == nil {
+		var (
+			transactionsOut []entities.Transaction
+			errOut          error
+		)
+		return transactionsOut, errOut
+	}
+	return mock.SearchTransactionsFunc(ctx, query, limit, offset)
+}
+
+// SearchTransactionsCalls gets all the calls that were made to SearchTransactions.
+// Check the length with:
+//
+//	len(mockedTransactionRepository.SearchTransactionsCalls())
+func (mock *TransactionRepositoryMock) SearchTransactionsCalls() []struct {
+	Ctx    context.Context
+	Query  string
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Query  string
+		Limit  int
+		Offset int
+	}
+	mock.lockSearchTransactions.RLock()
+	calls = mock.calls.SearchTransactions
+	mock.lockSearchTransactions.RUnlock()
+	return calls
+}
+
 // UpdateTransaction calls UpdateTransactionFunc.
 func (mock *TransactionRepositoryMock) UpdateTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
 	callInfo := struct {
@@ -0,0 +1,68 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+	"fmt"
+)
+
+type OnboardingUseCase struct {
+	accountRepo      AccountRepository
+	categoryRepo     CategoryRepository
+	userSettingsRepo UserSettingsRepository
+}
+
+func NewOnboardingUseCase(accountRepo AccountRepository, categoryRepo CategoryRepository, userSettingsRepo UserSettingsRepository) *OnboardingUseCase {
+	return &OnboardingUseCase{
+		accountRepo:      accountRepo,
+		categoryRepo:     categoryRepo,
+		userSettingsRepo: userSettingsRepo,
+	}
+}
+
+// GetOnboardingStatus checks which first-run steps are done: the base currency was
+// picked rather than left to its default, there is an income and an expense
+// category to file transactions under, and the first account exists
+func (uc *OnboardingUseCase) GetOnboardingStatus(ctx context.Context) (entities.OnboardingStatus, error) {
+	settings, err := uc.userSettingsRepo.GetAllUserSettings(ctx)
+	if err != nil {
+		return entities.OnboardingStatus{}, fmt.Errorf("failed to get user settings: %w", err)
+	}
+
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return entities.OnboardingStatus{}, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return entities.OnboardingStatus{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	done := map[entities.OnboardingStep]bool{
+		entities.OnboardingStepAccount: len(accounts) > 0,
+	}
+	for _, setting := range settings {
+		if setting.Key == entities.UserSettingBaseCurrency {
+			done[entities.OnboardingStepBaseCurrency] = true
+		}
+	}
+	categoryTypes := map[entities.CategoryType]bool{}
+	for _, category := range categories {
+		categoryTypes[category.Type] = true
+	}
+	done[entities.OnboardingStepCategories] = categoryTypes[entities.CategoryTypeIncome] && categoryTypes[entities.CategoryTypeExpense]
+
+	status := entities.OnboardingStatus{
+		Completed: done[entities.OnboardingStepAccount],
+		Steps:     make([]entities.OnboardingStepStatus, 0, len(entities.OnboardingSteps)),
+	}
+	for _, step := range entities.OnboardingSteps {
+		status.Steps = append(status.Steps, entities.OnboardingStepStatus{Step: step, Done: done[step]})
+		if !status.Completed && !done[step] && status.NextStep == "" {
+			status.NextStep = step
+		}
+	}
+
+	return status, nil
+}
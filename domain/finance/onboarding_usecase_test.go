@@ -0,0 +1,106 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOnboardingStatus(t *testing.T) {
+	seeded := []entities.Category{
+		{ID: "cat-income", Type: entities.CategoryTypeIncome},
+		{ID: "cat-expense", Type: entities.CategoryTypeExpense},
+	}
+
+	tests := []struct {
+		name          string
+		settings      []entities.UserSetting
+		categories    []entities.Category
+		accounts      []entities.Account
+		wantCompleted bool
+		wantNextStep  entities.OnboardingStep
+		wantDone      []bool
+	}{
+		{
+			name:         "first run",
+			categories:   seeded,
+			wantNextStep: entities.OnboardingStepBaseCurrency,
+			wantDone:     []bool{false, true, false},
+		},
+		{
+			name:         "currency picked",
+			settings:     []entities.UserSetting{{Key: entities.UserSettingBaseCurrency, Value: "GBP"}},
+			categories:   seeded,
+			wantNextStep: entities.OnboardingStepAccount,
+			wantDone:     []bool{true, true, false},
+		},
+		{
+			name:         "missing an income category",
+			settings:     []entities.UserSetting{{Key: entities.UserSettingBaseCurrency, Value: "GBP"}},
+			categories:   seeded[1:],
+			wantNextStep: entities.OnboardingStepCategories,
+			wantDone:     []bool{true, false, false},
+		},
+		{
+			name:          "completed once an account exists",
+			categories:    seeded,
+			accounts:      []entities.Account{{ID: "acc-1"}},
+			wantCompleted: true,
+			wantDone:      []bool{false, true, true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewOnboardingUseCase(
+				&mocks.AccountRepositoryMock{
+					GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+						return tt.accounts, nil
+					},
+				},
+				&mocks.CategoryRepositoryMock{
+					GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+						return tt.categories, nil
+					},
+				},
+				&mocks.UserSettingsRepositoryMock{
+					GetAllUserSettingsFunc: func(ctx context.Context) ([]entities.UserSetting, error) {
+						return tt.settings, nil
+					},
+				},
+			)
+
+			status, err := uc.GetOnboardingStatus(context.Background())
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantCompleted, status.Completed)
+			assert.Equal(t, tt.wantNextStep, status.NextStep)
+			require.Len(t, status.Steps, len(entities.OnboardingSteps))
+			for i, step := range status.Steps {
+				assert.Equal(t, entities.OnboardingSteps[i], step.Step)
+				assert.Equal(t, tt.wantDone[i], step.Done, step.Step)
+			}
+		})
+	}
+}
+
+func TestGetOnboardingStatusError(t *testing.T) {
+	uc := NewOnboardingUseCase(
+		&mocks.AccountRepositoryMock{},
+		&mocks.CategoryRepositoryMock{},
+		&mocks.UserSettingsRepositoryMock{
+			GetAllUserSettingsFunc: func(ctx context.Context) ([]entities.UserSetting, error) {
+				return nil, errors.New("connection refused")
+			},
+		},
+	)
+
+	_, err := uc.GetOnboardingStatus(context.Background())
+	assert.EqualError(t, err, "failed to get user settings: connection refused")
+}
@@ -0,0 +1,79 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+)
+
+// checkOwner rejects the resources of other users than the signed in one as
+// not found, so their IDs can't be probed. Without a signed in user, like in
+// the jobs going over every book, every resource is reachable.
+func checkOwner(ctx context.Context, ownerID, resource string) error {
+	userID, ok := domain.UserFromContext(ctx)
+	if ok && ownerID != userID {
+		return fmt.Errorf("%s %w", resource, domain.ErrNotFound)
+	}
+	return nil
+}
+
+// ownAccount reads an account of the signed in user
+func ownAccount(ctx context.Context, repo AccountRepository, id string) (entities.Account, error) {
+	account, err := repo.GetAccountByID(ctx, id)
+	if err != nil {
+		return entities.Account{}, err
+	}
+	if err := checkOwner(ctx, account.OwnerID, "account"); err != nil {
+		return entities.Account{}, err
+	}
+	return account, nil
+}
+
+// ownCategory reads a category of the signed in user
+func ownCategory(ctx context.Context, repo CategoryRepository, id string) (entities.Category, error) {
+	category, err := repo.GetCategoryByID(ctx, id)
+	if err != nil {
+		return entities.Category{}, err
+	}
+	if err := checkOwner(ctx, category.OwnerID, "category"); err != nil {
+		return entities.Category{}, err
+	}
+	return category, nil
+}
+
+// ownTransaction reads a transaction of the signed in user
+func ownTransaction(ctx context.Context, repo TransactionRepository, id string) (entities.Transaction, error) {
+	transaction, err := repo.GetTransactionByID(ctx, id)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+	if err := checkOwner(ctx, transaction.OwnerID, "transaction"); err != nil {
+		return entities.Transaction{}, err
+	}
+	return transaction, nil
+}
+
+// ownProject reads a project of the signed in user
+func ownProject(ctx context.Context, repo ProjectRepository, id string) (entities.Project, error) {
+	project, err := repo.GetProjectByID(ctx, id)
+	if err != nil {
+		return entities.Project{}, err
+	}
+	if err := checkOwner(ctx, project.OwnerID, "project"); err != nil {
+		return entities.Project{}, err
+	}
+	return project, nil
+}
+
+// ownExpenseReport reads an expense report of the signed in user
+func ownExpenseReport(ctx context.Context, repo ExpenseReportRepository, id string) (entities.ExpenseReport, error) {
+	report, err := repo.GetExpenseReportByID(ctx, id)
+	if err != nil {
+		return entities.ExpenseReport{}, err
+	}
+	if err := checkOwner(ctx, report.OwnerID, "expense report"); err != nil {
+		return entities.ExpenseReport{}, err
+	}
+	return report, nil
+}
@@ -0,0 +1,15 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/project_repository.go . ProjectRepository
+type ProjectRepository interface {
+	CreateProject(ctx context.Context, project entities.Project) (entities.Project, error)
+	GetProjectByID(ctx context.Context, id string) (entities.Project, error)
+	GetAllProjects(ctx context.Context) ([]entities.Project, error)
+	UpdateProject(ctx context.Context, project entities.Project) (entities.Project, error)
+	DeleteProject(ctx context.Context, id string) error
+}
@@ -0,0 +1,231 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+type ProjectUseCase struct {
+	projectRepo     ProjectRepository
+	transactionRepo TransactionRepository
+	categoryRepo    CategoryRepository
+}
+
+func NewProjectUseCase(projectRepo ProjectRepository, transactionRepo TransactionRepository, categoryRepo CategoryRepository) *ProjectUseCase {
+	return &ProjectUseCase{
+		projectRepo:     projectRepo,
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+	}
+}
+
+func (uc *ProjectUseCase) CreateProject(ctx context.Context, project entities.Project) (entities.Project, error) {
+	project, err := validateProject(project)
+	if err != nil {
+		return entities.Project{}, err
+	}
+
+	created, err := uc.projectRepo.CreateProject(ctx, project)
+	if err != nil {
+		return entities.Project{}, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetProjects returns the projects ordered by name
+func (uc *ProjectUseCase) GetProjects(ctx context.Context) ([]entities.Project, error) {
+	projects, err := uc.projectRepo.GetAllProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	return projects, nil
+}
+
+func (uc *ProjectUseCase) GetProject(ctx context.Context, id string) (entities.Project, error) {
+	if id == "" {
+		return entities.Project{}, fmt.Errorf("project ID cannot be empty")
+	}
+
+	project, err := ownProject(ctx, uc.projectRepo, id)
+	if err != nil {
+		return entities.Project{}, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	return project, nil
+}
+
+func (uc *ProjectUseCase) UpdateProject(ctx context.Context, project entities.Project) (entities.Project, error) {
+	if project.ID == "" {
+		return entities.Project{}, fmt.Errorf("project ID cannot be empty")
+	}
+
+	project, err := validateProject(project)
+	if err != nil {
+		return entities.Project{}, err
+	}
+
+	if _, err := uc.GetProject(ctx, project.ID); err != nil {
+		return entities.Project{}, err
+	}
+
+	updated, err := uc.projectRepo.UpdateProject(ctx, project)
+	if err != nil {
+		return entities.Project{}, fmt.Errorf("failed to update project: %w", err)
+	}
+
+	return updated, nil
+}
+
+// DeleteProject deletes a project, its transactions are kept outside of any
+// project
+func (uc *ProjectUseCase) DeleteProject(ctx context.Context, id string) error {
+	if _, err := uc.GetProject(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.projectRepo.DeleteProject(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+
+	return nil
+}
+
+// GetProjectProfit adds up the income and expenses of a project between from
+// and to, either of which can be zero to leave the period open
+func (uc *ProjectUseCase) GetProjectProfit(ctx context.Context, id string, from, to time.Time) (entities.ProjectProfit, error) {
+	if err := validateProfitPeriod(from, to); err != nil {
+		return entities.ProjectProfit{}, err
+	}
+
+	project, err := uc.GetProject(ctx, id)
+	if err != nil {
+		return entities.ProjectProfit{}, err
+	}
+
+	categories, err := uc.categoryTypes(ctx)
+	if err != nil {
+		return entities.ProjectProfit{}, err
+	}
+
+	return uc.projectProfit(ctx, project, from, to, categories)
+}
+
+// GetProjectsProfit adds up the income and expenses of every project between
+// from and to, see GetProjectProfit
+func (uc *ProjectUseCase) GetProjectsProfit(ctx context.Context, from, to time.Time) ([]entities.ProjectProfit, error) {
+	if err := validateProfitPeriod(from, to); err != nil {
+		return nil, err
+	}
+
+	projects, err := uc.GetProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := uc.categoryTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profits := make([]entities.ProjectProfit, len(projects))
+	for i, project := range projects {
+		if profits[i], err = uc.projectProfit(ctx, project, from, to, categories); err != nil {
+			return nil, err
+		}
+	}
+
+	return profits, nil
+}
+
+// projectProfit adds up the pending and cleared transactions of a project,
+// income and expenses by the type of their category. Amounts are signed by
+// their effect on the account, so their size is added up whether the account
+// is an asset or a liability.
+func (uc *ProjectUseCase) projectProfit(ctx context.Context, project entities.Project, from, to time.Time, categories map[string]entities.CategoryType) (entities.ProjectProfit, error) {
+	transactions, err := uc.transactionRepo.GetTransactionsByProject(ctx, project.ID)
+	if err != nil {
+		return entities.ProjectProfit{}, fmt.Errorf("failed to get project transactions: %w", err)
+	}
+
+	profit := entities.ProjectProfit{Project: project, From: from, To: to, Totals: []entities.ProjectTotal{}}
+	income := map[string]entities.Money{}
+	expenses := map[string]entities.Money{}
+	for _, transaction := range transactions {
+		if !inFatura(transaction) || (!from.IsZero() && transaction.Date.Before(from)) || (!to.IsZero() && transaction.Date.After(to)) {
+			continue
+		}
+
+		asset := transaction.Monetary.Asset
+		if _, ok := income[asset.Asset]; !ok {
+			income[asset.Asset] = entities.NewMoney(asset, 0)
+			expenses[asset.Asset] = entities.NewMoney(asset, 0)
+		}
+
+		amount := entities.MoneyOf(transaction.Monetary).Abs()
+		switch categories[transaction.CategoryID] {
+		case entities.CategoryTypeIncome:
+			income[asset.Asset], err = income[asset.Asset].Add(amount)
+		case entities.CategoryTypeExpense:
+			expenses[asset.Asset], err = expenses[asset.Asset].Add(amount)
+		default:
+			continue
+		}
+		if err != nil {
+			return entities.ProjectProfit{}, fmt.Errorf("failed to add up transactions: %w", err)
+		}
+		profit.TransactionCount++
+	}
+
+	for _, asset := range slices.Sorted(maps.Keys(income)) {
+		net, err := income[asset].Sub(expenses[asset])
+		if err != nil {
+			return entities.ProjectProfit{}, fmt.Errorf("failed to add up transactions: %w", err)
+		}
+		profit.Totals = append(profit.Totals, entities.ProjectTotal{
+			Asset:    income[asset].Asset,
+			Income:   income[asset].Monetary(),
+			Expenses: expenses[asset].Monetary(),
+			Profit:   net.Monetary(),
+		})
+	}
+
+	return profit, nil
+}
+
+// categoryTypes maps the category IDs to their type
+func (uc *ProjectUseCase) categoryTypes(ctx context.Context) (map[string]entities.CategoryType, error) {
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+
+	types := make(map[string]entities.CategoryType, len(categories))
+	for _, category := range categories {
+		types[category.ID] = category.Type
+	}
+	return types, nil
+}
+
+func validateProject(project entities.Project) (entities.Project, error) {
+	project.Name = strings.TrimSpace(project.Name)
+	project.Client = strings.TrimSpace(project.Client)
+	if project.Name == "" {
+		return entities.Project{}, fmt.Errorf("project name cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	return project, nil
+}
+
+func validateProfitPeriod(from, to time.Time) error {
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		return fmt.Errorf("profit period starts after it ends: %w", domain.ErrMalformedParameters)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package finance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testProjectUseCase serves a website project for Acme whose transactions are
+// spread over March and April 2025, in BRL and USD
+func testProjectUseCase(t *testing.T) (*ProjectUseCase, *mocks.ProjectRepositoryMock) {
+	t.Helper()
+
+	website := entities.Project{ID: "prj-website", Name: "Website", Client: "Acme", OwnerID: "user-1"}
+	empty := entities.Project{ID: "prj-empty", Name: "Empty"}
+
+	income := entities.Category{ID: "cat-freelance", Name: "Freelance", Type: entities.CategoryTypeIncome}
+	expense := entities.Category{ID: "cat-hosting", Name: "Hosting", Type: entities.CategoryTypeExpense}
+	transaction := func(category entities.Category, asset monetary.Asset, amount int64, month time.Month, status entities.TransactionStatus) entities.Transaction {
+		return entities.Transaction{
+			ProjectID:  website.ID,
+			CategoryID: category.ID,
+			Monetary:   testMonetary(t, asset, amount),
+			Date:       time.Date(2025, month, 10, 0, 0, 0, 0, time.UTC),
+			Status:     status,
+		}
+	}
+	transactions := []entities.Transaction{
+		transaction(income, monetary.BRL, 900000, time.March, entities.TransactionStatusCleared),
+		transaction(expense, monetary.BRL, -50000, time.March, entities.TransactionStatusCleared),
+		// Charged on a credit card, so the amount is positive
+		transaction(expense, monetary.BRL, 75000, time.April, entities.TransactionStatusPending),
+		transaction(income, monetary.BRL, 300000, time.April, entities.TransactionStatusDraft),
+		transaction(income, monetary.USD, 20000, time.April, entities.TransactionStatusCleared),
+	}
+
+	projectRepo := &mocks.ProjectRepositoryMock{
+		GetAllProjectsFunc: func(ctx context.Context) ([]entities.Project, error) {
+			return []entities.Project{empty, website}, nil
+		},
+		GetProjectByIDFunc: func(ctx context.Context, id string) (entities.Project, error) {
+			for _, project := range []entities.Project{empty, website} {
+				if project.ID == id {
+					return project, nil
+				}
+			}
+			return entities.Project{}, errNotFound("project")
+		},
+		CreateProjectFunc: func(ctx context.Context, project entities.Project) (entities.Project, error) {
+			project.ID = "prj-new"
+			return project, nil
+		},
+	}
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionsByProjectFunc: func(ctx context.Context, projectID string) ([]entities.Transaction, error) {
+			if projectID != website.ID {
+				return nil, nil
+			}
+			return transactions, nil
+		},
+	}
+	categoryRepo := &mocks.CategoryRepositoryMock{
+		GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+			return []entities.Category{income, expense}, nil
+		},
+	}
+
+	return NewProjectUseCase(projectRepo, transactionRepo, categoryRepo), projectRepo
+}
+
+func TestProjectUseCase_CreateProject(t *testing.T) {
+	uc, projectRepo := testProjectUseCase(t)
+
+	created, err := uc.CreateProject(context.Background(), entities.Project{Name: "  Website  ", Client: " Acme "})
+	require.NoError(t, err)
+	assert.Equal(t, "prj-new", created.ID)
+	assert.Equal(t, "Website", created.Name)
+	assert.Equal(t, "Acme", created.Client)
+
+	_, err = uc.CreateProject(context.Background(), entities.Project{Name: "  "})
+	assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	assert.Len(t, projectRepo.CreateProjectCalls(), 1)
+}
+
+func TestProjectUseCase_DeleteProject(t *testing.T) {
+	uc, projectRepo := testProjectUseCase(t)
+
+	assert.ErrorIs(t, uc.DeleteProject(context.Background(), "prj-missing"), domain.ErrNotFound)
+	assert.Empty(t, projectRepo.DeleteProjectCalls())
+
+	require.NoError(t, uc.DeleteProject(context.Background(), "prj-website"))
+	assert.Len(t, projectRepo.DeleteProjectCalls(), 1)
+}
+
+func TestProjectUseCase_ProjectsOfOtherUsers(t *testing.T) {
+	uc, projectRepo := testProjectUseCase(t)
+	ctx := domain.WithUser(context.Background(), "user-2")
+
+	_, err := uc.GetProject(ctx, "prj-website")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	_, err = uc.UpdateProject(ctx, entities.Project{ID: "prj-website", Name: "Taken"})
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	assert.Empty(t, projectRepo.UpdateProjectCalls())
+
+	assert.ErrorIs(t, uc.DeleteProject(ctx, "prj-website"), domain.ErrNotFound)
+	assert.Empty(t, projectRepo.DeleteProjectCalls())
+}
+
+func TestProjectUseCase_GetProjectProfit(t *testing.T) {
+	uc, _ := testProjectUseCase(t)
+
+	t.Run("whole history", func(t *testing.T) {
+		profit, err := uc.GetProjectProfit(context.Background(), "prj-website", time.Time{}, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, "Website", profit.Project.Name)
+		assert.Equal(t, 4, profit.TransactionCount)
+		require.Len(t, profit.Totals, 2)
+
+		brl := profit.Totals[0]
+		assert.Equal(t, monetary.BRL, brl.Asset)
+		assert.Equal(t, int64(900000), brl.Income.Amount.Int64())
+		assert.Equal(t, int64(125000), brl.Expenses.Amount.Int64())
+		assert.Equal(t, int64(775000), brl.Profit.Amount.Int64())
+
+		usd := profit.Totals[1]
+		assert.Equal(t, monetary.USD, usd.Asset)
+		assert.Equal(t, int64(20000), usd.Profit.Amount.Int64())
+	})
+
+	t.Run("period", func(t *testing.T) {
+		from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+		profit, err := uc.GetProjectProfit(context.Background(), "prj-website", from, to)
+		require.NoError(t, err)
+		assert.Equal(t, 2, profit.TransactionCount)
+		require.Len(t, profit.Totals, 1)
+		assert.Equal(t, int64(850000), profit.Totals[0].Profit.Amount.Int64())
+	})
+
+	t.Run("inverted period", func(t *testing.T) {
+		from := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+		_, err := uc.GetProjectProfit(context.Background(), "prj-website", from, from.AddDate(0, 0, -1))
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := uc.GetProjectProfit(context.Background(), "prj-missing", time.Time{}, time.Time{})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestProjectUseCase_GetProjectsProfit(t *testing.T) {
+	uc, _ := testProjectUseCase(t)
+
+	profits, err := uc.GetProjectsProfit(context.Background(), time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, profits, 2)
+	assert.Equal(t, "Empty", profits[0].Project.Name)
+	assert.Zero(t, profits[0].TransactionCount)
+	assert.Empty(t, profits[0].Totals)
+	assert.Equal(t, "Website", profits[1].Project.Name)
+	assert.Equal(t, 4, profits[1].TransactionCount)
+}
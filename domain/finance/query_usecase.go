@@ -0,0 +1,149 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// QueryParser translates a natural language question into a query. The
+// pattern based parser understands a fixed set of phrasings, another provider
+// such as an LLM can take its place.
+//
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/query_parser.go . QueryParser
+type QueryParser interface {
+	ParseQuery(ctx context.Context, question string, vocabulary entities.QueryVocabulary) (entities.Query, error)
+}
+
+type QueryUseCase struct {
+	parser          QueryParser
+	transactionRepo TransactionRepository
+	accountRepo     AccountRepository
+	categoryRepo    CategoryRepository
+	balanceRepo     BalanceRepository
+	now             func() time.Time
+}
+
+func NewQueryUseCase(parser QueryParser, transactionRepo TransactionRepository, accountRepo AccountRepository, categoryRepo CategoryRepository, balanceRepo BalanceRepository) *QueryUseCase {
+	return &QueryUseCase{
+		parser:          parser,
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		categoryRepo:    categoryRepo,
+		balanceRepo:     balanceRepo,
+		now:             time.Now,
+	}
+}
+
+// AnswerQuestion parses the question and runs the report it asks for
+func (uc *QueryUseCase) AnswerQuestion(ctx context.Context, question string) (entities.QueryResult, error) {
+	if strings.TrimSpace(question) == "" {
+		return entities.QueryResult{}, fmt.Errorf("question cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return entities.QueryResult{}, fmt.Errorf("failed to get categories: %w", err)
+	}
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return entities.QueryResult{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	query, err := uc.parser.ParseQuery(ctx, question, entities.QueryVocabulary{
+		Categories: categories,
+		Accounts:   accounts,
+		Now:        uc.now(),
+	})
+	if err != nil {
+		return entities.QueryResult{}, err
+	}
+
+	return uc.runQuery(ctx, query, categories)
+}
+
+// runQuery runs the report of a parsed query. Drafts and cancelled
+// transactions are left out of the totals.
+func (uc *QueryUseCase) runQuery(ctx context.Context, query entities.Query, categories []entities.Category) (entities.QueryResult, error) {
+	result := entities.QueryResult{Query: query}
+
+	switch query.Intent {
+	case entities.QueryIntentNetWorth:
+		summary, err := uc.balanceRepo.GetBalanceSummary(ctx)
+		if err != nil {
+			return entities.QueryResult{}, fmt.Errorf("failed to get balance summary: %w", err)
+		}
+		result.Totals = []monetary.Monetary{summary.NetWorth}
+
+	case entities.QueryIntentBalance:
+		if query.AccountID == "" {
+			return entities.QueryResult{}, fmt.Errorf("balance query needs an account: %w", domain.ErrMalformedParameters)
+		}
+		balance, err := uc.balanceRepo.GetBalanceByAccountID(ctx, query.AccountID)
+		if err != nil {
+			return entities.QueryResult{}, fmt.Errorf("failed to get balance: %w", err)
+		}
+		result.Totals = []monetary.Monetary{balance.CurrentBalance}
+
+	case entities.QueryIntentSpending, entities.QueryIntentIncome:
+		if query.From.IsZero() || query.To.IsZero() || query.From.After(query.To) {
+			return entities.QueryResult{}, fmt.Errorf("query period is invalid: %w", domain.ErrMalformedParameters)
+		}
+
+		categoryType := entities.CategoryTypeExpense
+		if query.Intent == entities.QueryIntentIncome {
+			categoryType = entities.CategoryTypeIncome
+		}
+		matches := map[string]bool{}
+		for _, category := range categories {
+			if query.CategoryID != "" {
+				matches[category.ID] = category.ID == query.CategoryID
+			} else {
+				matches[category.ID] = category.Type == categoryType
+			}
+		}
+
+		transactions, err := uc.transactionRepo.GetTransactionsByDateRange(ctx, query.From, query.To)
+		if err != nil {
+			return entities.QueryResult{}, fmt.Errorf("failed to get transactions: %w", err)
+		}
+
+		totals := map[string]entities.Money{}
+		for _, transaction := range transactions {
+			if !matches[transaction.CategoryID] || (query.AccountID != "" && transaction.AccountID != query.AccountID) {
+				continue
+			}
+			if transaction.Status == entities.TransactionStatusDraft || transaction.Status == entities.TransactionStatusCancelled {
+				continue
+			}
+
+			asset := transaction.Monetary.Asset
+			total, ok := totals[asset.Asset]
+			if !ok {
+				total = entities.NewMoney(asset, 0)
+			}
+			// Amounts are signed by their effect on the account, so add up
+			// their size whether the account is an asset or a liability
+			if totals[asset.Asset], err = total.Add(entities.MoneyOf(transaction.Monetary).Abs()); err != nil {
+				return entities.QueryResult{}, fmt.Errorf("failed to add up transactions: %w", err)
+			}
+			result.TransactionCount++
+		}
+
+		for _, asset := range slices.Sorted(maps.Keys(totals)) {
+			result.Totals = append(result.Totals, totals[asset].Monetary())
+		}
+
+	default:
+		return entities.QueryResult{}, fmt.Errorf("unknown query intent %q: %w", query.Intent, domain.ErrMalformedParameters)
+	}
+
+	return result, nil
+}
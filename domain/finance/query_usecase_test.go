@@ -0,0 +1,124 @@
+package finance
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnswerQuestion(t *testing.T) {
+	money := func(asset monetary.Asset, cents int64) monetary.Monetary {
+		m := &monetary.Monetary{Asset: asset, Amount: big.NewInt(cents)}
+		return *m
+	}
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	endOfMarch := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+
+	var parsed entities.Query
+	parser := &mocks.QueryParserMock{
+		ParseQueryFunc: func(ctx context.Context, question string, vocabulary entities.QueryVocabulary) (entities.Query, error) {
+			assert.Len(t, vocabulary.Categories, 3)
+			assert.Len(t, vocabulary.Accounts, 1)
+			return parsed, nil
+		},
+	}
+	uc := NewQueryUseCase(
+		parser,
+		&mocks.TransactionRepositoryMock{
+			GetTransactionsByDateRangeFunc: func(ctx context.Context, startDate, endDate time.Time) ([]entities.Transaction, error) {
+				assert.Equal(t, march, startDate)
+				assert.Equal(t, endOfMarch, endDate)
+				return []entities.Transaction{
+					{CategoryID: "cat-food", AccountID: "acc-1", Monetary: money(monetary.USD, -1250), Status: entities.TransactionStatusCleared},
+					{CategoryID: "cat-food", AccountID: "acc-2", Monetary: money(monetary.USD, 3000), Status: entities.TransactionStatusPending},
+					{CategoryID: "cat-rent", AccountID: "acc-1", Monetary: money(monetary.GBP, -90000), Status: entities.TransactionStatusCleared},
+					{CategoryID: "cat-food", AccountID: "acc-1", Monetary: money(monetary.USD, -500), Status: entities.TransactionStatusDraft},
+					{CategoryID: "cat-salary", AccountID: "acc-1", Monetary: money(monetary.USD, 500000), Status: entities.TransactionStatusCleared},
+				}, nil
+			},
+		},
+		&mocks.AccountRepositoryMock{
+			GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+				return []entities.Account{{ID: "acc-1", Name: "Checking"}}, nil
+			},
+		},
+		&mocks.CategoryRepositoryMock{
+			GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+				return []entities.Category{
+					{ID: "cat-food", Name: "Food", Type: entities.CategoryTypeExpense},
+					{ID: "cat-rent", Name: "Rent", Type: entities.CategoryTypeExpense},
+					{ID: "cat-salary", Name: "Salary", Type: entities.CategoryTypeIncome},
+				}, nil
+			},
+		},
+		&mocks.BalanceRepositoryMock{
+			GetBalanceSummaryFunc: func(ctx context.Context) (entities.BalanceSummary, error) {
+				return entities.BalanceSummary{NetWorth: money(monetary.USD, 1234567)}, nil
+			},
+			GetBalanceByAccountIDFunc: func(ctx context.Context, accountID string) (entities.Balance, error) {
+				return entities.Balance{AccountID: accountID, CurrentBalance: money(monetary.USD, 4200)}, nil
+			},
+		},
+	)
+
+	totals := func(result entities.QueryResult) []string {
+		var values []string
+		for _, total := range result.Totals {
+			values = append(values, total.String())
+		}
+		return values
+	}
+
+	t.Run("spending per asset", func(t *testing.T) {
+		parsed = entities.Query{Intent: entities.QueryIntentSpending, From: march, To: endOfMarch}
+		result, err := uc.AnswerQuestion(context.Background(), "spending in march")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"[GBP (£) 900.00]", "[USD ($) 42.50]"}, totals(result))
+		assert.Equal(t, 3, result.TransactionCount)
+	})
+
+	t.Run("spending of a category on an account", func(t *testing.T) {
+		parsed = entities.Query{Intent: entities.QueryIntentSpending, CategoryID: "cat-food", AccountID: "acc-1", From: march, To: endOfMarch}
+		result, err := uc.AnswerQuestion(context.Background(), "food on checking in march")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"[USD ($) 12.50]"}, totals(result))
+		assert.Equal(t, 1, result.TransactionCount)
+	})
+
+	t.Run("income", func(t *testing.T) {
+		parsed = entities.Query{Intent: entities.QueryIntentIncome, From: march, To: endOfMarch}
+		result, err := uc.AnswerQuestion(context.Background(), "income in march")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"[USD ($) 5000.00]"}, totals(result))
+	})
+
+	t.Run("net worth and balance", func(t *testing.T) {
+		parsed = entities.Query{Intent: entities.QueryIntentNetWorth}
+		result, err := uc.AnswerQuestion(context.Background(), "net worth")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"[USD ($) 12345.67]"}, totals(result))
+
+		parsed = entities.Query{Intent: entities.QueryIntentBalance, AccountID: "acc-1"}
+		result, err = uc.AnswerQuestion(context.Background(), "checking balance")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"[USD ($) 42.00]"}, totals(result))
+	})
+
+	t.Run("invalid questions", func(t *testing.T) {
+		_, err := uc.AnswerQuestion(context.Background(), " ")
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+
+		parsed = entities.Query{Intent: entities.QueryIntentSpending, From: endOfMarch, To: march}
+		_, err = uc.AnswerQuestion(context.Background(), "spending")
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+}
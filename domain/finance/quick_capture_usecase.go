@@ -0,0 +1,259 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+type QuickCaptureUseCase struct {
+	transactionRepo TransactionRepository
+	accountRepo     AccountRepository
+	categoryRepo    CategoryRepository
+}
+
+func NewQuickCaptureUseCase(transactionRepo TransactionRepository, accountRepo AccountRepository, categoryRepo CategoryRepository) *QuickCaptureUseCase {
+	return &QuickCaptureUseCase{
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		categoryRepo:    categoryRepo,
+	}
+}
+
+// ParseQuickCapture turns a note like "coffee 12.50 food" into a transaction:
+//   - the amount is the last number in the text, "12.50", "12,50" and
+//     "R$1.234,50" are all understood
+//   - the category and the account are the ones named in the text, matched
+//     case insensitively and removed from the description
+//   - the rest of the text is the description, the category name when empty
+//
+// accountID, when given, picks the account instead. A category or account not
+// named in the text is suggested by the latest transaction with the same
+// description, the account then falls back to the most used one. The date and
+// status are left for CreateTransaction to fill in from the user preferences.
+func (uc *QuickCaptureUseCase) ParseQuickCapture(ctx context.Context, text, accountID string) (entities.QuickCapture, error) {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return entities.QuickCapture{}, fmt.Errorf("text cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+
+	amountAt := -1
+	var amount *big.Rat
+	for i := len(words) - 1; i >= 0; i-- {
+		if value, ok := parseQuickAmount(words[i]); ok {
+			amountAt, amount = i, value
+			break
+		}
+	}
+	if amount == nil {
+		return entities.QuickCapture{}, fmt.Errorf("no amount found in %q: %w", text, domain.ErrMalformedParameters)
+	}
+	words = append(words[:amountAt:amountAt], words[amountAt+1:]...)
+
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return entities.QuickCapture{}, fmt.Errorf("failed to get categories: %w", err)
+	}
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return entities.QuickCapture{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+
+	var capture entities.QuickCapture
+	var category *entities.Category
+	var account *entities.Account
+
+	if accountID != "" {
+		for i := range accounts {
+			if accounts[i].ID == accountID {
+				account, capture.AccountSource = &accounts[i], entities.QuickCaptureSourceRequest
+			}
+		}
+		if account == nil {
+			return entities.QuickCapture{}, fmt.Errorf("account %s: %w", accountID, domain.ErrNotFound)
+		}
+	}
+
+	categoryNames := make([]string, len(categories))
+	for i, c := range categories {
+		categoryNames[i] = c.Name
+	}
+	if i, rest, ok := matchName(words, categoryNames); ok {
+		category, capture.CategorySource, words = &categories[i], entities.QuickCaptureSourceText, rest
+	}
+
+	if account == nil {
+		accountNames := make([]string, len(accounts))
+		for i, a := range accounts {
+			accountNames[i] = a.Name
+		}
+		if i, rest, ok := matchName(words, accountNames); ok {
+			account, capture.AccountSource, words = &accounts[i], entities.QuickCaptureSourceText, rest
+		}
+	}
+
+	description := strings.Join(words, " ")
+	if (category == nil || account == nil) && description != "" {
+		previous, err := uc.previousTransaction(ctx, description)
+		if err != nil {
+			return entities.QuickCapture{}, err
+		}
+		if previous != nil {
+			for i := range categories {
+				if category == nil && categories[i].ID == previous.CategoryID {
+					category, capture.CategorySource = &categories[i], entities.QuickCaptureSourceHistory
+				}
+			}
+			for i := range accounts {
+				if account == nil && accounts[i].ID == previous.AccountID {
+					account, capture.AccountSource = &accounts[i], entities.QuickCaptureSourceHistory
+				}
+			}
+		}
+	}
+
+	if category == nil {
+		return entities.QuickCapture{}, fmt.Errorf("no category named in %q or used before for %q: %w", text, description, domain.ErrMalformedParameters)
+	}
+	if account == nil {
+		for i := range accounts {
+			if account == nil || accounts[i].TransactionCount > account.TransactionCount {
+				account, capture.AccountSource = &accounts[i], entities.QuickCaptureSourceDefault
+			}
+		}
+		if account == nil {
+			return entities.QuickCapture{}, fmt.Errorf("no account to capture to, create one first: %w", domain.ErrMalformedParameters)
+		}
+	}
+	if description == "" {
+		description = category.Name
+	}
+
+	minorUnits, err := quickAmountIn(amount, account.Asset)
+	if err != nil {
+		return entities.QuickCapture{}, err
+	}
+
+	capture.Transaction = entities.Transaction{
+		AccountID:   account.ID,
+		CategoryID:  category.ID,
+		Monetary:    minorUnits,
+		Description: description,
+		Account:     account,
+		Category:    category,
+	}
+	return capture, nil
+}
+
+// previousTransaction finds the latest transaction with the description, nil
+// when there is none among the last entities.QuickCaptureHistory
+func (uc *QuickCaptureUseCase) previousTransaction(ctx context.Context, description string) (*entities.Transaction, error) {
+	transactions, err := uc.transactionRepo.GetTransactionsWithDetails(ctx, entities.TransactionFilter{}, entities.QuickCaptureHistory, 0, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	for i, transaction := range transactions {
+		if strings.EqualFold(transaction.Description, description) {
+			return &transactions[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// matchName finds the longest of names in words, the last one when it appears
+// more than once, returning its index and the words without it
+func matchName(words []string, names []string) (int, []string, bool) {
+	best, bestAt, bestLen := -1, 0, 0
+	for i, name := range names {
+		nameWords := strings.Fields(name)
+		if len(nameWords) == 0 || len(nameWords) < bestLen {
+			continue
+		}
+
+		for at := len(words) - len(nameWords); at >= 0; at-- {
+			if !wordsEqualFold(words[at:at+len(nameWords)], nameWords) {
+				continue
+			}
+			if len(nameWords) > bestLen || at > bestAt {
+				best, bestAt, bestLen = i, at, len(nameWords)
+			}
+			break
+		}
+	}
+	if best < 0 {
+		return 0, words, false
+	}
+
+	rest := append(words[:bestAt:bestAt], words[bestAt+bestLen:]...)
+	return best, rest, true
+}
+
+func wordsEqualFold(a, b []string) bool {
+	for i := range a {
+		if !strings.EqualFold(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+var quickAmountPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+// parseQuickAmount parses a positive amount written with either decimal
+// separator and an optional currency symbol, e.g. 12.50, 12,50, $1,234.50 or
+// R$1.234,50. With both separators the last one is the decimal one, a lone
+// comma is decimal when followed by at most two digits.
+func parseQuickAmount(word string) (*big.Rat, bool) {
+	word = strings.TrimLeft(word, "R$€£")
+	word = strings.TrimRight(word, "$€£")
+
+	lastComma, lastDot := strings.LastIndex(word, ","), strings.LastIndex(word, ".")
+	switch {
+	case lastComma >= 0 && lastDot >= 0:
+		if lastComma > lastDot {
+			word = strings.ReplaceAll(word, ".", "")
+			word = strings.Replace(word, ",", ".", 1)
+		} else {
+			word = strings.ReplaceAll(word, ",", "")
+		}
+	case lastComma >= 0:
+		if strings.Count(word, ",") == 1 && len(word)-lastComma-1 <= 2 {
+			word = strings.Replace(word, ",", ".", 1)
+		} else {
+			word = strings.ReplaceAll(word, ",", "")
+		}
+	case strings.Count(word, ".") > 1:
+		word = strings.ReplaceAll(word, ".", "")
+	}
+
+	if !quickAmountPattern.MatchString(word) {
+		return nil, false
+	}
+	amount, ok := new(big.Rat).SetString(word)
+	if !ok || amount.Sign() <= 0 {
+		return nil, false
+	}
+	return amount, true
+}
+
+// quickAmountIn converts the amount to the minor units of asset, refusing
+// more decimals than the asset has
+func quickAmountIn(amount *big.Rat, asset monetary.Asset) (monetary.Monetary, error) {
+	scaled := new(big.Rat).Mul(amount, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(asset.Precision)), nil)))
+	if !scaled.IsInt() {
+		return monetary.Monetary{}, fmt.Errorf("amount has more than %d decimals for %s: %w", asset.Precision, asset.Asset, domain.ErrMalformedParameters)
+	}
+
+	value, err := monetary.NewMonetary(asset, scaled.Num())
+	if err != nil {
+		return monetary.Monetary{}, fmt.Errorf("invalid amount: %w", err)
+	}
+	return *value, nil
+}
@@ -0,0 +1,150 @@
+package finance
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuickCapture(t *testing.T) {
+	usd := func(cents int64) monetary.Monetary {
+		m := &monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(cents)}
+		return *m
+	}
+
+	uc := NewQuickCaptureUseCase(
+		&mocks.TransactionRepositoryMock{
+			GetTransactionsWithDetailsFunc: func(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) ([]entities.Transaction, error) {
+				assert.Equal(t, entities.QuickCaptureHistory, limit)
+				return []entities.Transaction{
+					{Description: "Uber", CategoryID: "cat-transport", AccountID: "acc-card"},
+					{Description: "uber", CategoryID: "cat-food", AccountID: "acc-checking"},
+				}, nil
+			},
+		},
+		&mocks.AccountRepositoryMock{
+			GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+				return []entities.Account{
+					{ID: "acc-card", Name: "Credit Card", Asset: monetary.USD, TransactionCount: 3},
+					{ID: "acc-checking", Name: "Checking", Asset: monetary.USD, TransactionCount: 10},
+					{ID: "acc-yen", Name: "Wallet", Asset: monetary.Asset{Asset: "JPY", Precision: 0}},
+				}, nil
+			},
+		},
+		&mocks.CategoryRepositoryMock{
+			GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+				return []entities.Category{
+					{ID: "cat-food", Name: "Food"},
+					{ID: "cat-eating-out", Name: "Eating Out"},
+					{ID: "cat-transport", Name: "Transport"},
+				}, nil
+			},
+		},
+	)
+
+	tests := []struct {
+		name            string
+		text            string
+		accountID       string
+		wantAmount      monetary.Monetary
+		wantDescription string
+		wantCategoryID  string
+		wantAccountID   string
+		wantCategory    entities.QuickCaptureSource
+		wantAccount     entities.QuickCaptureSource
+	}{
+		{
+			name:            "category named in the text",
+			text:            "coffee 12.50 food",
+			wantAmount:      usd(1250),
+			wantDescription: "coffee",
+			wantCategoryID:  "cat-food",
+			wantAccountID:   "acc-checking",
+			wantCategory:    entities.QuickCaptureSourceText,
+			wantAccount:     entities.QuickCaptureSourceDefault,
+		},
+		{
+			name:            "longest name and account named in the text",
+			text:            "pizza with friends R$1.234,50 eating out credit card",
+			wantAmount:      usd(123450),
+			wantDescription: "pizza with friends",
+			wantCategoryID:  "cat-eating-out",
+			wantAccountID:   "acc-card",
+			wantCategory:    entities.QuickCaptureSourceText,
+			wantAccount:     entities.QuickCaptureSourceText,
+		},
+		{
+			name:            "suggested by the latest transaction",
+			text:            "uber 23,4",
+			wantAmount:      usd(2340),
+			wantDescription: "uber",
+			wantCategoryID:  "cat-transport",
+			wantAccountID:   "acc-card",
+			wantCategory:    entities.QuickCaptureSourceHistory,
+			wantAccount:     entities.QuickCaptureSourceHistory,
+		},
+		{
+			name:            "account given with the request",
+			text:            "2 coffees 9 food",
+			accountID:       "acc-card",
+			wantAmount:      usd(900),
+			wantDescription: "2 coffees",
+			wantCategoryID:  "cat-food",
+			wantAccountID:   "acc-card",
+			wantCategory:    entities.QuickCaptureSourceText,
+			wantAccount:     entities.QuickCaptureSourceRequest,
+		},
+		{
+			name:            "description defaults to the category",
+			text:            "Transport $1,050.00",
+			wantAmount:      usd(105000),
+			wantDescription: "Transport",
+			wantCategoryID:  "cat-transport",
+			wantAccountID:   "acc-checking",
+			wantCategory:    entities.QuickCaptureSourceText,
+			wantAccount:     entities.QuickCaptureSourceDefault,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			capture, err := uc.ParseQuickCapture(context.Background(), tt.text, tt.accountID)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantAmount.String(), capture.Transaction.Monetary.String())
+			assert.Equal(t, tt.wantDescription, capture.Transaction.Description)
+			assert.Equal(t, tt.wantCategoryID, capture.Transaction.CategoryID)
+			assert.Equal(t, tt.wantAccountID, capture.Transaction.AccountID)
+			assert.Equal(t, tt.wantCategory, capture.CategorySource)
+			assert.Equal(t, tt.wantAccount, capture.AccountSource)
+		})
+	}
+
+	errorTests := []struct {
+		name      string
+		text      string
+		accountID string
+		wantErr   error
+	}{
+		{name: "empty text", text: "  ", wantErr: domain.ErrMalformedParameters},
+		{name: "no amount", text: "coffee food", wantErr: domain.ErrMalformedParameters},
+		{name: "no category", text: "coffee 12.50", wantErr: domain.ErrMalformedParameters},
+		{name: "unknown account", text: "coffee 12.50 food", accountID: "acc-missing", wantErr: domain.ErrNotFound},
+		{name: "too many decimals", text: "sushi 12.50 food", accountID: "acc-yen", wantErr: domain.ErrMalformedParameters},
+	}
+
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.ParseQuickCapture(context.Background(), tt.text, tt.accountID)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
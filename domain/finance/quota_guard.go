@@ -0,0 +1,122 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"time"
+)
+
+// Names of the quotas, as told in domain.QuotaError
+const (
+	QuotaAccounts            = "accounts"
+	QuotaMonthlyTransactions = "monthly_transactions"
+	QuotaMonthlyAPICalls     = "monthly_api_calls"
+	QuotaAttachmentBytes     = "attachment_bytes"
+)
+
+// QuotaPolicy caps what the users of the free tier can create and how much
+// they call the API, for the deployments hosting the app for others.
+// MaxMonthlyTransactions counts the transactions created since the start of
+// the month, in UTC, and MaxMonthlyAPICalls the API calls, metered by the
+// UsageUseCase. MaxAttachmentBytes caps the size of the files attached to the
+// transactions of a user. Zero leaves a quota unlimited.
+type QuotaPolicy struct {
+	MaxAccounts            int
+	MaxMonthlyTransactions int
+	MaxMonthlyAPICalls     int
+	MaxAttachmentBytes     int64
+}
+
+// QuotaGuard holds the user of the context to the quotas of their tier.
+// Requests without a user, as in single user deployments, and the users of
+// the unlimited tier aren't limited, and neither is anything with a nil guard.
+type QuotaGuard struct {
+	userRepo UserRepository
+	policy   QuotaPolicy
+	now      func() time.Time
+}
+
+func NewQuotaGuard(userRepo UserRepository, policy QuotaPolicy) *QuotaGuard {
+	return &QuotaGuard{
+		userRepo: userRepo,
+		policy:   policy,
+		now:      time.Now,
+	}
+}
+
+// CheckAccounts returns a domain.QuotaError when creating count more accounts
+// would take the user over their quota
+func (g *QuotaGuard) CheckAccounts(ctx context.Context, count int) error {
+	if g == nil || g.policy.MaxAccounts <= 0 {
+		return nil
+	}
+	usage, ok, err := g.usage(ctx)
+	if err != nil || !ok {
+		return err
+	}
+	if usage.Accounts+count > g.policy.MaxAccounts {
+		return &domain.QuotaError{Quota: QuotaAccounts, Limit: g.policy.MaxAccounts}
+	}
+	return nil
+}
+
+// CheckTransactions returns a domain.QuotaError, resetting at the start of
+// the next month, when creating count more transactions would take the user
+// over their quota
+func (g *QuotaGuard) CheckTransactions(ctx context.Context, count int) error {
+	if g == nil || g.policy.MaxMonthlyTransactions <= 0 {
+		return nil
+	}
+	usage, ok, err := g.usage(ctx)
+	if err != nil || !ok {
+		return err
+	}
+	if usage.MonthlyTransactions+count > g.policy.MaxMonthlyTransactions {
+		return &domain.QuotaError{
+			Quota:    QuotaMonthlyTransactions,
+			Limit:    g.policy.MaxMonthlyTransactions,
+			ResetsAt: monthStart(g.now()).AddDate(0, 1, 0),
+		}
+	}
+	return nil
+}
+
+// CheckAttachmentBytes returns a domain.QuotaError when attaching a file of
+// size bytes would take the user over their attachment storage quota
+func (g *QuotaGuard) CheckAttachmentBytes(ctx context.Context, size int64) error {
+	if g == nil || g.policy.MaxAttachmentBytes <= 0 {
+		return nil
+	}
+	usage, ok, err := g.usage(ctx)
+	if err != nil || !ok {
+		return err
+	}
+	if usage.AttachmentBytes+size > g.policy.MaxAttachmentBytes {
+		return &domain.QuotaError{Quota: QuotaAttachmentBytes, Limit: int(g.policy.MaxAttachmentBytes)}
+	}
+	return nil
+}
+
+// usage counts what the user of the context created, false when there's no
+// user or they aren't limited
+func (g *QuotaGuard) usage(ctx context.Context) (entities.UserUsage, bool, error) {
+	userID, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return entities.UserUsage{}, false, nil
+	}
+
+	usage, err := g.userRepo.GetUserUsage(ctx, userID, monthStart(g.now()))
+	if err != nil {
+		return entities.UserUsage{}, false, fmt.Errorf("failed to get usage: %w", err)
+	}
+	return usage, usage.Tier != entities.UserTierUnlimited, nil
+}
+
+// monthStart is the start of the month of now in UTC, when the monthly quotas
+// start over
+func monthStart(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,73 @@
+package finance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaGuard(t *testing.T) {
+	ctx := domain.WithUser(context.Background(), "user-1")
+	usage := entities.UserUsage{Tier: entities.UserTierFree, Accounts: 3, MonthlyTransactions: 99, AttachmentBytes: 900}
+	userRepo := &mocks.UserRepositoryMock{
+		GetUserUsageFunc: func(ctx context.Context, id string, since time.Time) (entities.UserUsage, error) {
+			return usage, nil
+		},
+	}
+	guard := NewQuotaGuard(userRepo, QuotaPolicy{MaxAccounts: 3, MaxMonthlyTransactions: 100, MaxAttachmentBytes: 1000})
+	guard.now = func() time.Time { return time.Date(2025, time.March, 17, 9, 30, 0, 0, time.UTC) }
+
+	t.Run("accounts", func(t *testing.T) {
+		err := guard.CheckAccounts(ctx, 1)
+		var quota *domain.QuotaError
+		require.ErrorAs(t, err, &quota)
+		assert.Equal(t, &domain.QuotaError{Quota: QuotaAccounts, Limit: 3}, quota)
+		assert.ErrorIs(t, err, domain.ErrQuotaExceeded)
+		assert.EqualError(t, err, "quota of 3 accounts reached")
+	})
+
+	t.Run("monthly transactions", func(t *testing.T) {
+		assert.NoError(t, guard.CheckTransactions(ctx, 1))
+
+		err := guard.CheckTransactions(ctx, 2)
+		var quota *domain.QuotaError
+		require.ErrorAs(t, err, &quota)
+		assert.Equal(t, time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC), quota.ResetsAt)
+		assert.ErrorIs(t, err, domain.ErrTooManyRequests)
+		assert.EqualError(t, err, "quota of 100 monthly transactions reached, resets at 2025-04-01T00:00:00Z")
+
+		calls := userRepo.GetUserUsageCalls()
+		assert.Equal(t, "user-1", calls[len(calls)-1].ID)
+		assert.Equal(t, time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), calls[len(calls)-1].Since)
+	})
+
+	t.Run("attachment bytes", func(t *testing.T) {
+		assert.NoError(t, guard.CheckAttachmentBytes(ctx, 100))
+
+		err := guard.CheckAttachmentBytes(ctx, 101)
+		var quota *domain.QuotaError
+		require.ErrorAs(t, err, &quota)
+		assert.Equal(t, &domain.QuotaError{Quota: QuotaAttachmentBytes, Limit: 1000}, quota)
+		assert.ErrorIs(t, err, domain.ErrQuotaExceeded)
+		assert.EqualError(t, err, "quota of 1000 attachment bytes reached")
+	})
+
+	t.Run("not limited", func(t *testing.T) {
+		calls := len(userRepo.GetUserUsageCalls())
+		assert.NoError(t, guard.CheckAccounts(context.Background(), 1), "without a user")
+		assert.NoError(t, NewQuotaGuard(userRepo, QuotaPolicy{}).CheckAccounts(ctx, 1), "without quotas")
+		var none *QuotaGuard
+		assert.NoError(t, none.CheckTransactions(ctx, 1), "without a guard")
+		assert.Len(t, userRepo.GetUserUsageCalls(), calls)
+
+		usage.Tier = entities.UserTierUnlimited
+		assert.NoError(t, guard.CheckAccounts(ctx, 1), "unlimited tier")
+	})
+}
@@ -0,0 +1,15 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/report_definition_repository.go . ReportDefinitionRepository
+type ReportDefinitionRepository interface {
+	CreateReportDefinition(ctx context.Context, definition entities.ReportDefinition) (entities.ReportDefinition, error)
+	GetReportDefinitionByID(ctx context.Context, id string) (entities.ReportDefinition, error)
+	GetAllReportDefinitions(ctx context.Context) ([]entities.ReportDefinition, error)
+	UpdateReportDefinition(ctx context.Context, definition entities.ReportDefinition) (entities.ReportDefinition, error)
+	DeleteReportDefinition(ctx context.Context, id string) error
+}
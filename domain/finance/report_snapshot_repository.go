@@ -0,0 +1,13 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+	"time"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/report_snapshot_repository.go . ReportSnapshotRepository
+type ReportSnapshotRepository interface {
+	GetReportSnapshot(ctx context.Context, month time.Time) (entities.MonthlyReport, error)
+	SaveReportSnapshot(ctx context.Context, report entities.MonthlyReport) (entities.MonthlyReport, error)
+}
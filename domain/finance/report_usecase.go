@@ -0,0 +1,810 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// incomeMonths is how many months before a report its expected monthly
+// income is averaged over
+const incomeMonths = 3
+
+// cashFlowMonths is how many months a cash flow report covers by default,
+// the current one included
+const cashFlowMonths = 12
+
+type ReportUseCase struct {
+	transactionRepo TransactionRepository
+	accountRepo     AccountRepository
+	categoryRepo    CategoryRepository
+	balanceRepo     BalanceRepository
+	bookRepo        BookRepository
+	snapshotRepo    ReportSnapshotRepository
+	now             func() time.Time
+}
+
+func NewReportUseCase(transactionRepo TransactionRepository, accountRepo AccountRepository, categoryRepo CategoryRepository, balanceRepo BalanceRepository, bookRepo BookRepository, snapshotRepo ReportSnapshotRepository) *ReportUseCase {
+	return &ReportUseCase{
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		categoryRepo:    categoryRepo,
+		balanceRepo:     balanceRepo,
+		bookRepo:        bookRepo,
+		snapshotRepo:    snapshotRepo,
+		now:             time.Now,
+	}
+}
+
+// GetCommitments returns the outflows already committed in each of the next
+// months, starting with the current one, one report per asset: the faturas
+// of credit cards due from today on, and the pending expenses of the other
+// accounts, installments apart. Each month is set against the average monthly
+// income of the three months before the current one.
+func (uc *ReportUseCase) GetCommitments(ctx context.Context, months int) ([]entities.CommitmentReport, error) {
+	if months < 1 || months > entities.MaxCommitmentMonths {
+		return nil, fmt.Errorf("months must be between 1 and %d, got %d: %w", entities.MaxCommitmentMonths, months, domain.ErrMalformedParameters)
+	}
+
+	today := uc.today()
+	start := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, months, 0)
+
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	byID := map[string]entities.Account{}
+	for _, account := range accounts {
+		byID[account.ID] = account
+	}
+
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	categoryTypes := map[string]entities.CategoryType{}
+	for _, category := range categories {
+		categoryTypes[category.ID] = category.Type
+	}
+
+	var commitments []entities.Commitment
+
+	// Card purchases are paid with their fatura, whatever their own date
+	faturas := FaturaUseCase{transactionRepo: uc.transactionRepo, accountRepo: uc.accountRepo, now: uc.now}
+	for _, account := range accounts {
+		if !account.HasBillingCycle() {
+			continue
+		}
+		cardFaturas, err := faturas.GetFaturas(ctx, account.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, fatura := range cardFaturas {
+			if fatura.DueDate.Before(today) || !fatura.DueDate.Before(end) || entities.MoneyOf(fatura.Total).Sign() <= 0 {
+				continue
+			}
+			commitments = append(commitments, entities.Commitment{
+				Kind:        entities.CommitmentKindFatura,
+				AccountID:   account.ID,
+				Description: fmt.Sprintf("%s fatura %s", account.Name, fatura.Month),
+				Date:        fatura.DueDate,
+				Amount:      fatura.Total,
+			})
+		}
+	}
+
+	upcoming, err := uc.transactionRepo.GetTransactionsByDateRange(ctx, today, end.AddDate(0, 0, -1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+	for _, transaction := range upcoming {
+		account, ok := byID[transaction.AccountID]
+		if !ok || account.HasBillingCycle() || transaction.Status != entities.TransactionStatusPending || categoryTypes[transaction.CategoryID] != entities.CategoryTypeExpense {
+			continue
+		}
+		kind := entities.CommitmentKindBill
+		if transaction.InstallmentPlanID != "" {
+			kind = entities.CommitmentKindInstallment
+		}
+		commitments = append(commitments, entities.Commitment{
+			Kind:          kind,
+			AccountID:     transaction.AccountID,
+			TransactionID: transaction.ID,
+			Description:   transaction.Description,
+			Date:          transaction.Date,
+			// Expenses are signed by their effect on the account, so take
+			// their size whether the account is an asset or a liability
+			Amount: entities.MoneyOf(transaction.Monetary).Abs().Monetary(),
+		})
+	}
+
+	income, err := uc.expectedIncome(ctx, start, categoryTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := map[string]monetary.Asset{}
+	for _, commitment := range commitments {
+		assets[commitment.Amount.Asset.Asset] = commitment.Amount.Asset
+	}
+	for _, amount := range income {
+		assets[amount.Asset.Asset] = amount.Asset
+	}
+
+	slices.SortStableFunc(commitments, func(a, b entities.Commitment) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	reports := make([]entities.CommitmentReport, 0, len(assets))
+	for _, code := range slices.Sorted(maps.Keys(assets)) {
+		asset := assets[code]
+		expected, ok := income[code]
+		if !ok {
+			expected = entities.NewMoney(asset, 0).Monetary()
+		}
+
+		report := entities.CommitmentReport{Asset: asset, Months: make([]entities.CommitmentMonth, months)}
+		for i := range report.Months {
+			month := start.AddDate(0, i, 0)
+			due := slices.DeleteFunc(slices.Clone(commitments), func(commitment entities.Commitment) bool {
+				return commitment.Amount.Asset.Asset != code || commitment.Date.Year() != month.Year() || commitment.Date.Month() != month.Month()
+			})
+			if report.Months[i], err = commitmentMonth(month, asset, expected, due); err != nil {
+				return nil, err
+			}
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// expectedIncome averages the income of the months before start, per asset.
+// Drafts and cancelled transactions are left out.
+func (uc *ReportUseCase) expectedIncome(ctx context.Context, start time.Time, categoryTypes map[string]entities.CategoryType) (map[string]monetary.Monetary, error) {
+	transactions, err := uc.transactionRepo.GetTransactionsByDateRange(ctx, start.AddDate(0, -incomeMonths, 0), start.AddDate(0, 0, -1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	totals := map[string]entities.Money{}
+	for _, transaction := range transactions {
+		if !inFatura(transaction) || categoryTypes[transaction.CategoryID] != entities.CategoryTypeIncome {
+			continue
+		}
+		asset := transaction.Monetary.Asset
+		total, ok := totals[asset.Asset]
+		if !ok {
+			total = entities.NewMoney(asset, 0)
+		}
+		if totals[asset.Asset], err = total.Add(entities.MoneyOf(transaction.Monetary).Abs()); err != nil {
+			return nil, fmt.Errorf("failed to add up income: %w", err)
+		}
+	}
+
+	income := make(map[string]monetary.Monetary, len(totals))
+	for code, total := range totals {
+		average := math.Round(float64(total.Monetary().Amount.Int64()) / incomeMonths)
+		income[code] = entities.NewMoney(total.Monetary().Asset, int64(average)).Monetary()
+	}
+	return income, nil
+}
+
+// GetConsolidatedReport merges the net worth of every book and the cash flow
+// of its pending and cleared transactions between from and to, the current
+// month when both are zero. Each book is added up in its base currency, then
+// converted to asset at rates, which must hold every other currency met. The
+// accounts in custom assets are left out, they hold no money.
+func (uc *ReportUseCase) GetConsolidatedReport(ctx context.Context, asset monetary.Asset, rates entities.ExchangeRates, from, to time.Time) (entities.ConsolidatedReport, error) {
+	if from.IsZero() && to.IsZero() {
+		to = uc.today()
+		from = time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	if from.IsZero() || to.IsZero() || from.After(to) {
+		return entities.ConsolidatedReport{}, fmt.Errorf("report period needs a start before its end: %w", domain.ErrMalformedParameters)
+	}
+
+	// rate is how much of asset one unit of code is worth
+	rate := func(code string) (*big.Rat, error) {
+		if code == asset.Asset {
+			return big.NewRat(1, 1), nil
+		}
+		if value, ok := rates[code]; ok && value.Sign() > 0 {
+			return value, nil
+		}
+		return nil, fmt.Errorf("no exchange rate from %s to %s: %w", code, asset.Asset, domain.ErrMalformedParameters)
+	}
+
+	books, err := uc.bookRepo.GetAllBooks(ctx)
+	if err != nil {
+		return entities.ConsolidatedReport{}, fmt.Errorf("failed to get books: %w", err)
+	}
+
+	report := entities.ConsolidatedReport{Asset: asset, From: from, To: to, Books: make([]entities.BookConsolidation, 0, len(books))}
+	var total consolidation
+	for _, book := range books {
+		base, err := uc.bookConsolidation(domain.WithBook(ctx, book.ID), from, to)
+		if err != nil {
+			return entities.ConsolidatedReport{}, fmt.Errorf("book %s: %w", book.Name, err)
+		}
+
+		consolidated := entities.BookConsolidation{Book: book}
+		if consolidated.Base, err = base.totals(book.Asset, rate); err != nil {
+			return entities.ConsolidatedReport{}, fmt.Errorf("book %s: %w", book.Name, err)
+		}
+		var reporting consolidation
+		reporting.add(consolidated.Base)
+		if consolidated.Reporting, err = reporting.totals(asset, rate); err != nil {
+			return entities.ConsolidatedReport{}, fmt.Errorf("book %s: %w", book.Name, err)
+		}
+		total.add(consolidated.Reporting)
+		report.Books = append(report.Books, consolidated)
+	}
+
+	if report.Totals, err = total.totals(asset, rate); err != nil {
+		return entities.ConsolidatedReport{}, err
+	}
+	return report, nil
+}
+
+// bookConsolidation adds up the balances and cash flow of the book ctx is
+// scoped to, per currency
+func (uc *ReportUseCase) bookConsolidation(ctx context.Context, from, to time.Time) (consolidation, error) {
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return consolidation{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	byID := make(map[string]entities.Account, len(accounts))
+	for _, account := range accounts {
+		byID[account.ID] = account
+	}
+
+	balances, err := uc.balanceRepo.GetAllBalances(ctx)
+	if err != nil {
+		return consolidation{}, fmt.Errorf("failed to get balances: %w", err)
+	}
+
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return consolidation{}, fmt.Errorf("failed to get categories: %w", err)
+	}
+	categoryTypes := make(map[string]entities.CategoryType, len(categories))
+	for _, category := range categories {
+		categoryTypes[category.ID] = category.Type
+	}
+
+	transactions, err := uc.transactionRepo.GetTransactionsByDateRange(ctx, from, to)
+	if err != nil {
+		return consolidation{}, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	var book consolidation
+	for _, balance := range balances {
+		// Custom assets like loyalty points aren't money, so they're left out
+		// like in the balance summary
+		if !byID[balance.AccountID].IsMonetary() {
+			continue
+		}
+		// Liability balances are what's owed, like in the balance summary
+		if byID[balance.AccountID].IsLiability() {
+			book.liabilities = append(book.liabilities, entities.MoneyOf(balance.CurrentBalance))
+		} else {
+			book.assets = append(book.assets, entities.MoneyOf(balance.CurrentBalance))
+		}
+	}
+	for _, transaction := range transactions {
+		if !inFatura(transaction) || !byID[transaction.AccountID].IsMonetary() {
+			continue
+		}
+		// Amounts are signed by their effect on the account, so take their
+		// size whether the account is an asset or a liability
+		amount := entities.MoneyOf(transaction.Monetary).Abs()
+		switch categoryTypes[transaction.CategoryID] {
+		case entities.CategoryTypeIncome:
+			book.income = append(book.income, amount)
+		case entities.CategoryTypeExpense:
+			book.expenses = append(book.expenses, amount)
+		}
+	}
+	return book, nil
+}
+
+// GetSpending adds up the pending and cleared expenses from from to to by
+// category, by month or by both, in the database, per asset. The period ends
+// today and starts with the month it ends in by default, and the spending is
+// grouped by category unless told otherwise.
+func (uc *ReportUseCase) GetSpending(ctx context.Context, by []entities.SpendingGroupField, from, to time.Time) (entities.SpendingReport, error) {
+	if len(by) == 0 {
+		by = []entities.SpendingGroupField{entities.SpendingGroupFieldCategory}
+	}
+	grouped := map[entities.SpendingGroupField]bool{}
+	for _, field := range by {
+		if !slices.Contains(entities.SpendingGroupFields, field) {
+			return entities.SpendingReport{}, fmt.Errorf("invalid spending group field: %s: %w", field, domain.ErrMalformedParameters)
+		}
+		if grouped[field] {
+			return entities.SpendingReport{}, fmt.Errorf("spending grouped by %s twice: %w", field, domain.ErrMalformedParameters)
+		}
+		grouped[field] = true
+	}
+
+	if to.IsZero() {
+		to = uc.today()
+	}
+	if from.IsZero() {
+		from = firstOfMonth(to)
+	}
+	if to.Before(from) {
+		return entities.SpendingReport{}, fmt.Errorf("spending report ends before it starts: %w", domain.ErrMalformedParameters)
+	}
+
+	totals, err := uc.transactionRepo.GetSpending(ctx, grouped[entities.SpendingGroupFieldCategory], grouped[entities.SpendingGroupFieldMonth], from, to)
+	if err != nil {
+		return entities.SpendingReport{}, fmt.Errorf("failed to get spending: %w", err)
+	}
+
+	categories := map[string]entities.Category{}
+	if grouped[entities.SpendingGroupFieldCategory] {
+		all, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+		if err != nil {
+			return entities.SpendingReport{}, fmt.Errorf("failed to get categories: %w", err)
+		}
+		for _, category := range all {
+			categories[category.ID] = category
+		}
+	}
+
+	// The totals come ordered by asset
+	report := entities.SpendingReport{From: from, To: to, GroupBy: by, Totals: []monetary.Monetary{}, Groups: []entities.SpendingGroup{}}
+	assetTotals := map[string]entities.Money{}
+	for _, total := range totals {
+		code := total.Total.Asset.Asset
+		assetTotal, ok := assetTotals[code]
+		if !ok {
+			assetTotal = entities.NewMoney(total.Total.Asset, 0)
+		}
+		if assetTotals[code], err = assetTotal.Add(entities.MoneyOf(total.Total)); err != nil {
+			return entities.SpendingReport{}, fmt.Errorf("failed to add up spending: %w", err)
+		}
+	}
+	for _, total := range totals {
+		code := total.Total.Asset.Asset
+		if len(report.Totals) == 0 || report.Totals[len(report.Totals)-1].Asset.Asset != code {
+			report.Totals = append(report.Totals, assetTotals[code].Monetary())
+		}
+
+		var share float64
+		if whole := assetTotals[code].Monetary().Amount.Int64(); whole > 0 {
+			share = math.Round(float64(total.Total.Amount.Int64())/float64(whole)*1000) / 10
+		}
+		report.Groups = append(report.Groups, entities.SpendingGroup{
+			SpendingTotal: total,
+			Category:      categories[total.CategoryID],
+			Share:         share,
+		})
+	}
+
+	return report, nil
+}
+
+// GetCashFlow adds up the pending and cleared income and expenses from from
+// to to per month, in the database, of the account accountID or of the whole
+// book when empty. Each asset gets its own series with every month of the
+// period, the ones without transactions at zero. The period ends today and
+// starts cashFlowMonths months before by default. Custom assets aren't money
+// and are left out unless the report is of an account kept in one.
+func (uc *ReportUseCase) GetCashFlow(ctx context.Context, accountID string, from, to time.Time) (entities.CashFlowReport, error) {
+	if to.IsZero() {
+		to = uc.today()
+	}
+	if from.IsZero() {
+		from = firstOfMonth(to).AddDate(0, 1-cashFlowMonths, 0)
+	}
+	if to.Before(from) {
+		return entities.CashFlowReport{}, fmt.Errorf("cash flow report ends before it starts: %w", domain.ErrMalformedParameters)
+	}
+	if accountID != "" {
+		if _, err := uc.accountRepo.GetAccountByID(ctx, accountID); err != nil {
+			return entities.CashFlowReport{}, fmt.Errorf("failed to get account: %w", err)
+		}
+	}
+
+	totals, err := uc.transactionRepo.GetCashFlow(ctx, accountID, from, to)
+	if err != nil {
+		return entities.CashFlowReport{}, fmt.Errorf("failed to get cash flow: %w", err)
+	}
+
+	var months []string
+	for month := firstOfMonth(from); !month.After(to); month = month.AddDate(0, 1, 0) {
+		months = append(months, month.Format("2006-01"))
+	}
+
+	// The totals come ordered by asset and month
+	report := entities.CashFlowReport{From: from, To: to, AccountID: accountID, Series: []entities.CashFlowSeries{}}
+	for start := 0; start < len(totals); {
+		asset := totals[start].Income.Asset
+		end := start
+		for end < len(totals) && totals[end].Income.Asset.Asset == asset.Asset {
+			end++
+		}
+		byMonth := make(map[string]entities.CashFlowTotal, end-start)
+		for _, total := range totals[start:end] {
+			byMonth[total.Month] = total
+		}
+		start = end
+
+		if asset.Class == entities.AssetClassCustom && accountID == "" {
+			continue
+		}
+		series, err := cashFlowSeries(asset, months, byMonth)
+		if err != nil {
+			return entities.CashFlowReport{}, err
+		}
+		report.Series = append(report.Series, series)
+	}
+
+	return report, nil
+}
+
+// cashFlowSeries lays the totals of an asset out over months, working out
+// what's left of the income of each month and of the whole period
+func cashFlowSeries(asset monetary.Asset, months []string, totals map[string]entities.CashFlowTotal) (entities.CashFlowSeries, error) {
+	income, expense := entities.NewMoney(asset, 0), entities.NewMoney(asset, 0)
+	series := entities.CashFlowSeries{Asset: asset, Months: make([]entities.CashFlowMonth, len(months))}
+	for i, month := range months {
+		monthIncome, monthExpense := entities.NewMoney(asset, 0), entities.NewMoney(asset, 0)
+		if total, ok := totals[month]; ok {
+			monthIncome, monthExpense = entities.MoneyOf(total.Income), entities.MoneyOf(total.Expense)
+		}
+		net, err := monthIncome.Sub(monthExpense)
+		if err != nil {
+			return entities.CashFlowSeries{}, fmt.Errorf("failed to work out the cash flow: %w", err)
+		}
+		series.Months[i] = entities.CashFlowMonth{
+			Month:   month,
+			Income:  monthIncome.Monetary(),
+			Expense: monthExpense.Monetary(),
+			Net:     net.Monetary(),
+		}
+
+		if income, err = income.Add(monthIncome); err != nil {
+			return entities.CashFlowSeries{}, fmt.Errorf("failed to add up the cash flow: %w", err)
+		}
+		if expense, err = expense.Add(monthExpense); err != nil {
+			return entities.CashFlowSeries{}, fmt.Errorf("failed to add up the cash flow: %w", err)
+		}
+	}
+
+	net, err := income.Sub(expense)
+	if err != nil {
+		return entities.CashFlowSeries{}, fmt.Errorf("failed to work out the cash flow: %w", err)
+	}
+	series.Income, series.Expense, series.Net = income.Monetary(), expense.Monetary(), net.Monetary()
+	return series, nil
+}
+
+// GetMonthlyReport returns the report of the month holding month. Closed
+// months are served from their snapshot when they have one, so later changes
+// to their transactions don't rewrite them. The current month, and the closed
+// ones never snapshotted, are calculated from the current transactions.
+func (uc *ReportUseCase) GetMonthlyReport(ctx context.Context, month time.Time) (entities.MonthlyReport, error) {
+	month = firstOfMonth(month)
+	current := firstOfMonth(uc.today())
+	if month.After(current) {
+		return entities.MonthlyReport{}, fmt.Errorf("month %s hasn't started yet: %w", month.Format("2006-01"), domain.ErrMalformedParameters)
+	}
+
+	if month.Before(current) {
+		snapshot, err := uc.snapshotRepo.GetReportSnapshot(ctx, month)
+		if err == nil {
+			return snapshot, nil
+		}
+		if !errors.Is(err, domain.ErrNotFound) {
+			return entities.MonthlyReport{}, fmt.Errorf("failed to get report snapshot: %w", err)
+		}
+	}
+
+	return uc.monthlyReport(ctx, month)
+}
+
+// CloseMonth snapshots the report of a closed month. A month already closed
+// keeps its snapshot, which is returned as is.
+func (uc *ReportUseCase) CloseMonth(ctx context.Context, month time.Time) (entities.MonthlyReport, error) {
+	month = firstOfMonth(month)
+	if err := uc.checkClosed(month); err != nil {
+		return entities.MonthlyReport{}, err
+	}
+
+	snapshot, err := uc.snapshotRepo.GetReportSnapshot(ctx, month)
+	if err == nil {
+		return snapshot, nil
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		return entities.MonthlyReport{}, fmt.Errorf("failed to get report snapshot: %w", err)
+	}
+
+	return uc.snapshotMonth(ctx, month)
+}
+
+// CloseLastMonth closes the month before the current one, the report snapshot
+// job runs it at the start of every month
+func (uc *ReportUseCase) CloseLastMonth(ctx context.Context) error {
+	_, err := uc.CloseMonth(ctx, firstOfMonth(uc.today()).AddDate(0, -1, 0))
+	return err
+}
+
+// RecalculateMonthlyReport replaces the snapshot of a closed month with its
+// report calculated from the current transactions, taking in the changes
+// made since it was closed
+func (uc *ReportUseCase) RecalculateMonthlyReport(ctx context.Context, month time.Time) (entities.MonthlyReport, error) {
+	month = firstOfMonth(month)
+	if err := uc.checkClosed(month); err != nil {
+		return entities.MonthlyReport{}, err
+	}
+
+	return uc.snapshotMonth(ctx, month)
+}
+
+// checkClosed fails unless month, its first day, is over
+func (uc *ReportUseCase) checkClosed(month time.Time) error {
+	if !month.Before(firstOfMonth(uc.today())) {
+		return fmt.Errorf("month %s isn't closed yet: %w", month.Format("2006-01"), domain.ErrConflict)
+	}
+	return nil
+}
+
+// snapshotMonth calculates the report of month and saves it as its snapshot
+func (uc *ReportUseCase) snapshotMonth(ctx context.Context, month time.Time) (entities.MonthlyReport, error) {
+	report, err := uc.monthlyReport(ctx, month)
+	if err != nil {
+		return entities.MonthlyReport{}, err
+	}
+
+	snapshot, err := uc.snapshotRepo.SaveReportSnapshot(ctx, report)
+	if err != nil {
+		return entities.MonthlyReport{}, fmt.Errorf("failed to save report snapshot: %w", err)
+	}
+	return snapshot, nil
+}
+
+// monthlyReport calculates the report of month, its first day, from the
+// current transactions. Category totals add up the pending and cleared
+// transactions of the month, ordered by category type, name and currency.
+// The net worth adds up the cleared transactions until the end of the month,
+// like the balances do, of the accounts holding money.
+func (uc *ReportUseCase) monthlyReport(ctx context.Context, month time.Time) (entities.MonthlyReport, error) {
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return entities.MonthlyReport{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	byID := make(map[string]entities.Account, len(accounts))
+	for _, account := range accounts {
+		byID[account.ID] = account
+	}
+
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return entities.MonthlyReport{}, fmt.Errorf("failed to get categories: %w", err)
+	}
+	categoryByID := make(map[string]entities.Category, len(categories))
+	for _, category := range categories {
+		categoryByID[category.ID] = category
+	}
+
+	// The transactions before the month only count towards the net worth
+	transactions, err := uc.transactionRepo.GetTransactionsByDateRange(ctx, time.Time{}, month.AddDate(0, 1, -1))
+	if err != nil {
+		return entities.MonthlyReport{}, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	type categoryAsset struct{ categoryID, asset string }
+	totals := map[categoryAsset]entities.Money{}
+	netWorth := map[string]entities.Money{}
+	for _, transaction := range transactions {
+		account := byID[transaction.AccountID]
+		if transaction.Status == entities.TransactionStatusCleared && account.IsMonetary() {
+			// Liability balances are what's owed, like in the balance summary
+			amount := entities.MoneyOf(transaction.Monetary)
+			if account.IsLiability() {
+				amount = amount.Neg()
+			}
+			balance, ok := netWorth[amount.Asset.Asset]
+			if !ok {
+				balance = entities.NewMoney(amount.Asset, 0)
+			}
+			if netWorth[amount.Asset.Asset], err = balance.Add(amount); err != nil {
+				return entities.MonthlyReport{}, err
+			}
+		}
+
+		if transaction.Date.Before(month) || !inFatura(transaction) {
+			continue
+		}
+		// Amounts are signed by their effect on the account, so take their
+		// size whether the account is an asset or a liability
+		amount := entities.MoneyOf(transaction.Monetary).Abs()
+		key := categoryAsset{transaction.CategoryID, amount.Asset.Asset}
+		total, ok := totals[key]
+		if !ok {
+			total = entities.NewMoney(amount.Asset, 0)
+		}
+		if totals[key], err = total.Add(amount); err != nil {
+			return entities.MonthlyReport{}, err
+		}
+	}
+
+	report := entities.MonthlyReport{
+		Month:      month,
+		Categories: make([]entities.CategoryTotal, 0, len(totals)),
+		NetWorth:   make([]monetary.Monetary, 0, len(netWorth)),
+	}
+	for key, total := range totals {
+		category := categoryByID[key.categoryID]
+		report.Categories = append(report.Categories, entities.CategoryTotal{
+			CategoryID: key.categoryID,
+			Name:       category.Name,
+			Type:       category.Type,
+			Total:      total.Monetary(),
+		})
+	}
+	slices.SortFunc(report.Categories, func(a, b entities.CategoryTotal) int {
+		if c := slices.Index(entities.CategoryTypes, a.Type) - slices.Index(entities.CategoryTypes, b.Type); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Total.Asset.Asset, b.Total.Asset.Asset)
+	})
+	for _, code := range slices.Sorted(maps.Keys(netWorth)) {
+		report.NetWorth = append(report.NetWorth, netWorth[code].Monetary())
+	}
+	return report, nil
+}
+
+// firstOfMonth returns the first day of the month holding date, in the UTC
+// midnight transactions are dated with
+func firstOfMonth(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// today is the current date, in the UTC midnight transactions are dated with
+func (uc *ReportUseCase) today() time.Time {
+	now := uc.now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// consolidation holds the amounts making up consolidated totals, in any
+// currency
+type consolidation struct {
+	assets      []entities.Money
+	liabilities []entities.Money
+	income      []entities.Money
+	expenses    []entities.Money
+}
+
+// add adds the totals of another consolidation
+func (c *consolidation) add(totals entities.ConsolidatedTotals) {
+	c.assets = append(c.assets, entities.MoneyOf(totals.Assets))
+	c.liabilities = append(c.liabilities, entities.MoneyOf(totals.Liabilities))
+	c.income = append(c.income, entities.MoneyOf(totals.Income))
+	c.expenses = append(c.expenses, entities.MoneyOf(totals.Expenses))
+}
+
+// totals adds the amounts up in asset, each currency being added up before it
+// is converted. rate is how much of a common reporting currency one unit of a
+// currency is worth.
+func (c consolidation) totals(asset monetary.Asset, rate func(code string) (*big.Rat, error)) (entities.ConsolidatedTotals, error) {
+	sum := func(amounts []entities.Money) (entities.Money, error) {
+		byAsset := map[string]entities.Money{}
+		for _, amount := range amounts {
+			total, ok := byAsset[amount.Asset.Asset]
+			if !ok {
+				total = entities.NewMoney(amount.Asset, 0)
+			}
+			var err error
+			if byAsset[amount.Asset.Asset], err = total.Add(amount); err != nil {
+				return entities.Money{}, err
+			}
+		}
+
+		converted := make([]entities.Money, 0, len(byAsset))
+		for _, code := range slices.Sorted(maps.Keys(byAsset)) {
+			if code == asset.Asset {
+				converted = append(converted, byAsset[code])
+				continue
+			}
+			from, err := rate(code)
+			if err != nil {
+				return entities.Money{}, err
+			}
+			to, err := rate(asset.Asset)
+			if err != nil {
+				return entities.Money{}, err
+			}
+			converted = append(converted, byAsset[code].Convert(asset, new(big.Rat).Quo(from, to)))
+		}
+		return entities.SumMoney(asset, converted...)
+	}
+
+	var values [4]entities.Money
+	for i, amounts := range [][]entities.Money{c.assets, c.liabilities, c.income, c.expenses} {
+		var err error
+		if values[i], err = sum(amounts); err != nil {
+			return entities.ConsolidatedTotals{}, err
+		}
+	}
+	assets, liabilities, income, expenses := values[0], values[1], values[2], values[3]
+
+	netWorth, err := assets.Sub(liabilities)
+	if err != nil {
+		return entities.ConsolidatedTotals{}, err
+	}
+	netCashFlow, err := income.Sub(expenses)
+	if err != nil {
+		return entities.ConsolidatedTotals{}, err
+	}
+
+	return entities.ConsolidatedTotals{
+		Assets:      assets.Monetary(),
+		Liabilities: liabilities.Monetary(),
+		NetWorth:    netWorth.Monetary(),
+		Income:      income.Monetary(),
+		Expenses:    expenses.Monetary(),
+		NetCashFlow: netCashFlow.Monetary(),
+	}, nil
+}
+
+// commitmentMonth adds up the commitments due in month by kind and sets them
+// against the expected income
+func commitmentMonth(month time.Time, asset monetary.Asset, income monetary.Monetary, commitments []entities.Commitment) (entities.CommitmentMonth, error) {
+	totals := map[entities.CommitmentKind]entities.Money{
+		entities.CommitmentKindFatura:      entities.NewMoney(asset, 0),
+		entities.CommitmentKindInstallment: entities.NewMoney(asset, 0),
+		entities.CommitmentKindBill:        entities.NewMoney(asset, 0),
+	}
+	committed := entities.NewMoney(asset, 0)
+	for _, commitment := range commitments {
+		var err error
+		amount := entities.MoneyOf(commitment.Amount)
+		if totals[commitment.Kind], err = totals[commitment.Kind].Add(amount); err != nil {
+			return entities.CommitmentMonth{}, fmt.Errorf("failed to add up commitments: %w", err)
+		}
+		if committed, err = committed.Add(amount); err != nil {
+			return entities.CommitmentMonth{}, fmt.Errorf("failed to add up commitments: %w", err)
+		}
+	}
+
+	available, err := entities.MoneyOf(income).Sub(committed)
+	if err != nil {
+		return entities.CommitmentMonth{}, fmt.Errorf("failed to subtract commitments: %w", err)
+	}
+
+	var share float64
+	if incomeAmount := income.Amount.Int64(); incomeAmount > 0 {
+		share = math.Round(float64(committed.Monetary().Amount.Int64())/float64(incomeAmount)*1000) / 10
+	}
+
+	return entities.CommitmentMonth{
+		Month:        month.Format(faturaMonthLayout),
+		Faturas:      totals[entities.CommitmentKindFatura].Monetary(),
+		Installments: totals[entities.CommitmentKindInstallment].Monetary(),
+		Bills:        totals[entities.CommitmentKindBill].Monetary(),
+		Committed:    committed.Monetary(),
+		Income:       income,
+		Available:    available.Monetary(),
+		Share:        share,
+		Commitments:  commitments,
+	}, nil
+}
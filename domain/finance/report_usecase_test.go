@@ -0,0 +1,579 @@
+package finance
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCommitments(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	transaction := func(id, accountID, categoryID string, amount int64, date time.Time, status entities.TransactionStatus) entities.Transaction {
+		return entities.Transaction{
+			ID:          id,
+			AccountID:   accountID,
+			CategoryID:  categoryID,
+			Monetary:    testMonetary(t, monetary.BRL, amount),
+			Description: id,
+			Date:        date,
+			Status:      status,
+		}
+	}
+
+	installment := transaction("tv-2", "acc-1", "cat-expense", -20000, date(2025, time.April, 15), entities.TransactionStatusPending)
+	installment.InstallmentPlanID = "plan-1"
+	transactions := []entities.Transaction{
+		// Three months of income, the draft is left out
+		transaction("salary-dec", "acc-1", "cat-income", 500000, date(2024, time.December, 5), entities.TransactionStatusCleared),
+		transaction("salary-jan", "acc-1", "cat-income", 500000, date(2025, time.January, 5), entities.TransactionStatusCleared),
+		transaction("salary-feb", "acc-1", "cat-income", 500000, date(2025, time.February, 5), entities.TransactionStatusCleared),
+		transaction("bonus", "acc-1", "cat-income", 100000, date(2025, time.February, 10), entities.TransactionStatusDraft),
+		transaction("rent", "acc-1", "cat-expense", -150000, date(2025, time.April, 5), entities.TransactionStatusPending),
+		installment,
+		transaction("groceries", "acc-1", "cat-expense", -8000, date(2025, time.March, 25), entities.TransactionStatusCleared),
+		// The March fatura was due before today
+		transaction("books", "acc-card", "cat-expense", 10000, date(2025, time.February, 20), entities.TransactionStatusCleared),
+		transaction("dinner", "acc-card", "cat-expense", 30000, date(2025, time.March, 10), entities.TransactionStatusCleared),
+		transaction("phone-2", "acc-card", "cat-expense", 5000, date(2025, time.April, 20), entities.TransactionStatusPending),
+	}
+
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionsByDateRangeFunc: func(ctx context.Context, startDate, endDate time.Time) ([]entities.Transaction, error) {
+			var result []entities.Transaction
+			for _, transaction := range transactions {
+				if !transaction.Date.Before(startDate) && !transaction.Date.After(endDate) {
+					result = append(result, transaction)
+				}
+			}
+			return result, nil
+		},
+		GetTransactionsByAccountFunc: func(ctx context.Context, accountID string) ([]entities.Transaction, error) {
+			var result []entities.Transaction
+			for _, transaction := range transactions {
+				if transaction.AccountID == accountID {
+					result = append(result, transaction)
+				}
+			}
+			return result, nil
+		},
+	}
+	card := entities.Account{ID: "acc-card", Name: "Nubank", Type: entities.AccountTypeCredit, Asset: monetary.BRL, StatementClosingDay: 3, PaymentDueDay: 10}
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+			return []entities.Account{
+				{ID: "acc-1", Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL},
+				card,
+			}, nil
+		},
+		GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+			return card, nil
+		},
+	}
+	categoryRepo := &mocks.CategoryRepositoryMock{
+		GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+			return []entities.Category{
+				{ID: "cat-expense", Type: entities.CategoryTypeExpense},
+				{ID: "cat-income", Type: entities.CategoryTypeIncome},
+			}, nil
+		},
+	}
+	uc := NewReportUseCase(transactionRepo, accountRepo, categoryRepo, &mocks.BalanceRepositoryMock{}, &mocks.BookRepositoryMock{}, &mocks.ReportSnapshotRepositoryMock{})
+	uc.now = func() time.Time { return time.Date(2025, time.March, 20, 18, 0, 0, 0, time.UTC) }
+
+	t.Run("adds up the commitments of each month", func(t *testing.T) {
+		reports, err := uc.GetCommitments(context.Background(), 3)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		assert.Equal(t, "BRL", reports[0].Asset.Asset)
+
+		months := reports[0].Months
+		require.Len(t, months, 3)
+		assert.Equal(t, []string{"2025-03", "2025-04", "2025-05"}, []string{months[0].Month, months[1].Month, months[2].Month})
+
+		// Nothing left to pay in March
+		assert.Empty(t, months[0].Commitments)
+		assert.Equal(t, int64(500000), months[0].Available.Amount.Int64())
+
+		april := months[1]
+		require.Len(t, april.Commitments, 3)
+		assert.Equal(t, entities.CommitmentKindBill, april.Commitments[0].Kind)
+		assert.Equal(t, "rent", april.Commitments[0].TransactionID)
+		assert.Equal(t, entities.CommitmentKindFatura, april.Commitments[1].Kind)
+		assert.Equal(t, "Nubank fatura 2025-04", april.Commitments[1].Description)
+		assert.Equal(t, date(2025, time.April, 10), april.Commitments[1].Date)
+		assert.Equal(t, int64(30000), april.Faturas.Amount.Int64())
+		assert.Equal(t, int64(20000), april.Installments.Amount.Int64())
+		assert.Equal(t, int64(150000), april.Bills.Amount.Int64())
+		assert.Equal(t, int64(200000), april.Committed.Amount.Int64())
+		assert.Equal(t, int64(500000), april.Income.Amount.Int64())
+		assert.Equal(t, int64(300000), april.Available.Amount.Int64())
+		assert.Equal(t, 40.0, april.Share)
+
+		may := months[2]
+		require.Len(t, may.Commitments, 1)
+		assert.Equal(t, int64(5000), may.Faturas.Amount.Int64())
+		assert.Equal(t, 1.0, may.Share)
+	})
+
+	t.Run("invalid number of months", func(t *testing.T) {
+		for _, months := range []int{0, 25} {
+			_, err := uc.GetCommitments(context.Background(), months)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		}
+	})
+}
+
+func TestGetConsolidatedReport(t *testing.T) {
+	const side = "book-side"
+	eur, _ := monetary.FindAssetByName("GBP")
+	miles := monetary.NewAsset("MILES", 0, "mi", entities.AssetClassCustom)
+
+	books := []entities.Book{
+		{ID: domain.DefaultBookID, Name: "Personal", Asset: monetary.BRL},
+		{ID: side, Name: "Side business", Asset: monetary.USD},
+	}
+	accounts := map[string][]entities.Account{
+		domain.DefaultBookID: {
+			{ID: "acc-checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL},
+			{ID: "acc-card", Type: entities.AccountTypeCredit, Asset: monetary.BRL},
+			{ID: "acc-euro", Type: entities.AccountTypeSavings, Asset: eur},
+			{ID: "acc-miles", Type: entities.AccountTypeInvestment, Asset: miles},
+		},
+		side: {{ID: "acc-business", Type: entities.AccountTypeChecking, Asset: monetary.USD}},
+	}
+	balances := map[string][]entities.Balance{
+		domain.DefaultBookID: {
+			{AccountID: "acc-checking", CurrentBalance: testMonetary(t, monetary.BRL, 100000)},
+			// R$ 200.00 owed on the card
+			{AccountID: "acc-card", CurrentBalance: testMonetary(t, monetary.BRL, 20000)},
+			{AccountID: "acc-euro", CurrentBalance: testMonetary(t, eur, 10000)},
+			// Miles have no exchange rate and are left out
+			{AccountID: "acc-miles", CurrentBalance: testMonetary(t, miles, 42000)},
+		},
+		side: {{AccountID: "acc-business", CurrentBalance: testMonetary(t, monetary.USD, 50000)}},
+	}
+	transaction := func(categoryID string, asset monetary.Asset, amount int64, status entities.TransactionStatus) entities.Transaction {
+		return entities.Transaction{CategoryID: categoryID, Monetary: testMonetary(t, asset, amount), Status: status}
+	}
+	transactions := map[string][]entities.Transaction{
+		domain.DefaultBookID: {
+			transaction("cat-salary", monetary.BRL, 500000, entities.TransactionStatusCleared),
+			transaction("cat-rent", monetary.BRL, -100000, entities.TransactionStatusCleared),
+			transaction("cat-rent", monetary.BRL, -300000, entities.TransactionStatusDraft),
+			{AccountID: "acc-miles", CategoryID: "cat-salary", Monetary: testMonetary(t, miles, 2000), Status: entities.TransactionStatusCleared},
+		},
+		side: {transaction("cat-sales", monetary.USD, 30000, entities.TransactionStatusPending)},
+	}
+
+	var from, to time.Time
+	uc := NewReportUseCase(
+		&mocks.TransactionRepositoryMock{
+			GetTransactionsByDateRangeFunc: func(ctx context.Context, startDate, endDate time.Time) ([]entities.Transaction, error) {
+				from, to = startDate, endDate
+				return transactions[domain.BookFromContext(ctx)], nil
+			},
+		},
+		&mocks.AccountRepositoryMock{
+			GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+				return accounts[domain.BookFromContext(ctx)], nil
+			},
+		},
+		&mocks.CategoryRepositoryMock{
+			GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+				if domain.BookFromContext(ctx) == side {
+					return []entities.Category{{ID: "cat-sales", Type: entities.CategoryTypeIncome}}, nil
+				}
+				return []entities.Category{
+					{ID: "cat-salary", Type: entities.CategoryTypeIncome},
+					{ID: "cat-rent", Type: entities.CategoryTypeExpense},
+				}, nil
+			},
+		},
+		&mocks.BalanceRepositoryMock{
+			GetAllBalancesFunc: func(ctx context.Context) ([]entities.Balance, error) {
+				return balances[domain.BookFromContext(ctx)], nil
+			},
+		},
+		&mocks.BookRepositoryMock{
+			GetAllBooksFunc: func(ctx context.Context) ([]entities.Book, error) {
+				return books, nil
+			},
+		},
+		&mocks.ReportSnapshotRepositoryMock{},
+	)
+	uc.now = func() time.Time { return time.Date(2025, time.March, 20, 18, 0, 0, 0, time.UTC) }
+	rates := entities.ExchangeRates{"BRL": big.NewRat(1, 5), "GBP": big.NewRat(11, 10)}
+
+	t.Run("merges the books in the reporting currency", func(t *testing.T) {
+		report, err := uc.GetConsolidatedReport(context.Background(), monetary.USD, rates, time.Time{}, time.Time{})
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), from)
+		assert.Equal(t, time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC), to)
+		require.Len(t, report.Books, 2)
+
+		// The euros are worth 5.5 reais
+		personal := report.Books[0]
+		assert.Equal(t, "BRL", personal.Base.Assets.Asset.Asset)
+		assert.Equal(t, int64(155000), personal.Base.Assets.Amount.Int64())
+		assert.Equal(t, int64(20000), personal.Base.Liabilities.Amount.Int64())
+		assert.Equal(t, int64(135000), personal.Base.NetWorth.Amount.Int64())
+		assert.Equal(t, int64(500000), personal.Base.Income.Amount.Int64())
+		assert.Equal(t, int64(100000), personal.Base.Expenses.Amount.Int64())
+		assert.Equal(t, int64(400000), personal.Base.NetCashFlow.Amount.Int64())
+		assert.Equal(t, "USD", personal.Reporting.NetWorth.Asset.Asset)
+		assert.Equal(t, int64(27000), personal.Reporting.NetWorth.Amount.Int64())
+		assert.Equal(t, int64(80000), personal.Reporting.NetCashFlow.Amount.Int64())
+
+		business := report.Books[1]
+		assert.Equal(t, int64(50000), business.Base.NetWorth.Amount.Int64())
+		assert.Equal(t, business.Base, business.Reporting)
+
+		assert.Equal(t, int64(81000), report.Totals.Assets.Amount.Int64())
+		assert.Equal(t, int64(4000), report.Totals.Liabilities.Amount.Int64())
+		assert.Equal(t, int64(77000), report.Totals.NetWorth.Amount.Int64())
+		assert.Equal(t, int64(130000), report.Totals.Income.Amount.Int64())
+		assert.Equal(t, int64(20000), report.Totals.Expenses.Amount.Int64())
+		assert.Equal(t, int64(110000), report.Totals.NetCashFlow.Amount.Int64())
+	})
+
+	t.Run("missing exchange rate", func(t *testing.T) {
+		_, err := uc.GetConsolidatedReport(context.Background(), monetary.USD, entities.ExchangeRates{"BRL": big.NewRat(1, 5)}, time.Time{}, time.Time{})
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		assert.ErrorContains(t, err, "no exchange rate from GBP to USD")
+	})
+
+	t.Run("inverted period", func(t *testing.T) {
+		start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+		_, err := uc.GetConsolidatedReport(context.Background(), monetary.USD, rates, start, start.AddDate(0, 0, -1))
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+}
+
+func TestGetMonthlyReport(t *testing.T) {
+	miles := monetary.NewAsset("MILES", 0, "mi", entities.AssetClassCustom)
+	accounts := []entities.Account{
+		{ID: "acc-checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL},
+		{ID: "acc-card", Type: entities.AccountTypeCredit, Asset: monetary.BRL},
+		{ID: "acc-usd", Type: entities.AccountTypeChecking, Asset: monetary.USD},
+		{ID: "acc-miles", Type: entities.AccountTypeInvestment, Asset: miles},
+	}
+	categories := []entities.Category{
+		{ID: "cat-salary", Name: "Salary", Type: entities.CategoryTypeIncome},
+		{ID: "cat-rent", Name: "Rent", Type: entities.CategoryTypeExpense},
+		{ID: "cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense},
+	}
+	transaction := func(accountID, categoryID string, asset monetary.Asset, amount int64, date time.Time, status entities.TransactionStatus) entities.Transaction {
+		return entities.Transaction{AccountID: accountID, CategoryID: categoryID, Monetary: testMonetary(t, asset, amount), Date: date, Status: status}
+	}
+	february := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	transactions := []entities.Transaction{
+		transaction("acc-checking", "cat-salary", monetary.BRL, 500000, february.AddDate(0, -1, 5), entities.TransactionStatusCleared),
+		transaction("acc-checking", "cat-salary", monetary.BRL, 500000, february.AddDate(0, 0, 5), entities.TransactionStatusCleared),
+		transaction("acc-checking", "cat-rent", monetary.BRL, -200000, february.AddDate(0, 0, 10), entities.TransactionStatusCleared),
+		// Owed on the card, left out of the net worth until cleared
+		transaction("acc-card", "cat-groceries", monetary.BRL, 30000, february.AddDate(0, 0, 12), entities.TransactionStatusCleared),
+		transaction("acc-card", "cat-groceries", monetary.BRL, 5000, february.AddDate(0, 0, 20), entities.TransactionStatusPending),
+		transaction("acc-card", "cat-groceries", monetary.BRL, 9000, february.AddDate(0, 0, 21), entities.TransactionStatusDraft),
+		transaction("acc-usd", "cat-groceries", monetary.USD, -1500, february.AddDate(0, 0, 14), entities.TransactionStatusCleared),
+		transaction("acc-miles", "cat-salary", miles, 4000, february.AddDate(0, 0, 3), entities.TransactionStatusCleared),
+	}
+
+	var saved []entities.MonthlyReport
+	snapshots := map[time.Time]entities.MonthlyReport{}
+	var end time.Time
+	snapshotRepo := &mocks.ReportSnapshotRepositoryMock{
+		GetReportSnapshotFunc: func(ctx context.Context, month time.Time) (entities.MonthlyReport, error) {
+			snapshot, ok := snapshots[month]
+			if !ok {
+				return entities.MonthlyReport{}, fmt.Errorf("report snapshot %w", domain.ErrNotFound)
+			}
+			return snapshot, nil
+		},
+		SaveReportSnapshotFunc: func(ctx context.Context, report entities.MonthlyReport) (entities.MonthlyReport, error) {
+			report.SnapshotAt = time.Date(2025, time.March, 1, 2, 0, 0, 0, time.UTC)
+			saved = append(saved, report)
+			snapshots[report.Month] = report
+			return report, nil
+		},
+	}
+	uc := NewReportUseCase(
+		&mocks.TransactionRepositoryMock{
+			GetTransactionsByDateRangeFunc: func(ctx context.Context, startDate, endDate time.Time) ([]entities.Transaction, error) {
+				end = endDate
+				var result []entities.Transaction
+				for _, transaction := range transactions {
+					if !transaction.Date.After(endDate) {
+						result = append(result, transaction)
+					}
+				}
+				return result, nil
+			},
+		},
+		&mocks.AccountRepositoryMock{
+			GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+				return accounts, nil
+			},
+		},
+		&mocks.CategoryRepositoryMock{
+			GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+				return categories, nil
+			},
+		},
+		&mocks.BalanceRepositoryMock{},
+		&mocks.BookRepositoryMock{},
+		snapshotRepo,
+	)
+	uc.now = func() time.Time { return time.Date(2025, time.March, 20, 18, 0, 0, 0, time.UTC) }
+
+	totals := func(report entities.MonthlyReport) []string {
+		result := make([]string, len(report.Categories))
+		for i, category := range report.Categories {
+			result[i] = category.Name + " " + entities.MoneyOf(category.Total).String()
+		}
+		return result
+	}
+	amounts := func(values []monetary.Monetary) []string {
+		result := make([]string, len(values))
+		for i, value := range values {
+			result[i] = entities.MoneyOf(value).String()
+		}
+		return result
+	}
+
+	t.Run("closed month without snapshot is calculated", func(t *testing.T) {
+		report, err := uc.GetMonthlyReport(context.Background(), february.AddDate(0, 0, 9))
+		require.NoError(t, err)
+		assert.Equal(t, february, report.Month)
+		assert.Equal(t, time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC), end)
+		assert.False(t, report.Snapshot())
+		assert.Equal(t, []string{
+			"Salary " + entities.NewMoney(monetary.BRL, 500000).String(),
+			"Salary " + entities.NewMoney(miles, 4000).String(),
+			"Groceries " + entities.NewMoney(monetary.BRL, 35000).String(),
+			"Groceries " + entities.NewMoney(monetary.USD, 1500).String(),
+			"Rent " + entities.NewMoney(monetary.BRL, 200000).String(),
+		}, totals(report))
+		assert.Equal(t, []string{
+			entities.NewMoney(monetary.BRL, 770000).String(),
+			entities.NewMoney(monetary.USD, -1500).String(),
+		}, amounts(report.NetWorth))
+		assert.Empty(t, saved)
+	})
+
+	t.Run("closing keeps the month as it was", func(t *testing.T) {
+		closed, err := uc.CloseMonth(context.Background(), february)
+		require.NoError(t, err)
+		require.Len(t, saved, 1)
+		assert.True(t, closed.Snapshot())
+
+		// A later edit doesn't rewrite the closed month
+		transactions = append(transactions, transaction("acc-checking", "cat-rent", monetary.BRL, -100000, february.AddDate(0, 0, 25), entities.TransactionStatusCleared))
+		report, err := uc.GetMonthlyReport(context.Background(), february)
+		require.NoError(t, err)
+		assert.Equal(t, closed, report)
+
+		_, err = uc.CloseMonth(context.Background(), february)
+		require.NoError(t, err)
+		assert.Len(t, saved, 1, "closing again keeps the snapshot")
+	})
+
+	t.Run("recalculating takes in the edits", func(t *testing.T) {
+		report, err := uc.RecalculateMonthlyReport(context.Background(), february)
+		require.NoError(t, err)
+		require.Len(t, saved, 2)
+		assert.Contains(t, totals(report), "Rent "+entities.NewMoney(monetary.BRL, 300000).String())
+		assert.Equal(t, entities.NewMoney(monetary.BRL, 670000).String(), entities.MoneyOf(report.NetWorth[0]).String())
+	})
+
+	t.Run("last month is closed", func(t *testing.T) {
+		delete(snapshots, february)
+		require.NoError(t, uc.CloseLastMonth(context.Background()))
+		require.Len(t, saved, 3)
+		assert.Equal(t, february, saved[2].Month)
+	})
+
+	t.Run("current month is calculated and can't be closed", func(t *testing.T) {
+		report, err := uc.GetMonthlyReport(context.Background(), time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.False(t, report.Snapshot())
+
+		_, err = uc.CloseMonth(context.Background(), time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC))
+		assert.ErrorIs(t, err, domain.ErrConflict)
+		_, err = uc.RecalculateMonthlyReport(context.Background(), time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC))
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("future month", func(t *testing.T) {
+		_, err := uc.GetMonthlyReport(context.Background(), time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC))
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+}
+
+func TestGetSpending(t *testing.T) {
+	var gotByCategory, gotByMonth bool
+	var gotFrom, gotTo time.Time
+	categoriesFetched := 0
+	uc := NewReportUseCase(
+		&mocks.TransactionRepositoryMock{
+			GetSpendingFunc: func(ctx context.Context, byCategory, byMonth bool, startDate, endDate time.Time) ([]entities.SpendingTotal, error) {
+				gotByCategory, gotByMonth, gotFrom, gotTo = byCategory, byMonth, startDate, endDate
+				return []entities.SpendingTotal{
+					{CategoryID: "cat-rent", Count: 1, Total: testMonetary(t, monetary.BRL, 150000)},
+					{CategoryID: "cat-groceries", Count: 4, Total: testMonetary(t, monetary.BRL, 50000)},
+					{CategoryID: "cat-groceries", Count: 1, Total: testMonetary(t, monetary.USD, 2500)},
+				}, nil
+			},
+		},
+		&mocks.AccountRepositoryMock{},
+		&mocks.CategoryRepositoryMock{
+			GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+				categoriesFetched++
+				return []entities.Category{
+					{ID: "cat-rent", Name: "Rent", Type: entities.CategoryTypeExpense},
+					{ID: "cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense},
+				}, nil
+			},
+		},
+		&mocks.BalanceRepositoryMock{},
+		&mocks.BookRepositoryMock{},
+		&mocks.ReportSnapshotRepositoryMock{},
+	)
+	uc.now = func() time.Time { return time.Date(2025, time.April, 20, 18, 0, 0, 0, time.UTC) }
+
+	t.Run("by category this month by default", func(t *testing.T) {
+		report, err := uc.GetSpending(context.Background(), nil, time.Time{}, time.Time{})
+		require.NoError(t, err)
+
+		assert.True(t, gotByCategory)
+		assert.False(t, gotByMonth)
+		assert.Equal(t, time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC), gotFrom)
+		assert.Equal(t, time.Date(2025, time.April, 20, 0, 0, 0, 0, time.UTC), gotTo)
+		assert.Equal(t, []entities.SpendingGroupField{entities.SpendingGroupFieldCategory}, report.GroupBy)
+
+		require.Len(t, report.Totals, 2)
+		assert.Equal(t, "[BRL (R$) 2000.00]", report.Totals[0].String())
+		assert.Equal(t, "USD", report.Totals[1].Asset.Asset)
+
+		require.Len(t, report.Groups, 3)
+		assert.Equal(t, "Rent", report.Groups[0].Category.Name)
+		assert.Equal(t, 75.0, report.Groups[0].Share)
+		assert.Equal(t, 25.0, report.Groups[1].Share)
+		assert.Equal(t, 100.0, report.Groups[2].Share)
+	})
+
+	t.Run("by month leaves the categories out", func(t *testing.T) {
+		fetched := categoriesFetched
+		from := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+		_, err := uc.GetSpending(context.Background(), []entities.SpendingGroupField{entities.SpendingGroupFieldMonth}, from, to)
+		require.NoError(t, err)
+
+		assert.False(t, gotByCategory)
+		assert.True(t, gotByMonth)
+		assert.Equal(t, from, gotFrom)
+		assert.Equal(t, to, gotTo)
+		assert.Equal(t, fetched, categoriesFetched)
+	})
+
+	t.Run("rejects invalid parameters", func(t *testing.T) {
+		for name, by := range map[string][]entities.SpendingGroupField{
+			"unknown field":  {"payee"},
+			"repeated field": {entities.SpendingGroupFieldMonth, entities.SpendingGroupFieldMonth},
+		} {
+			_, err := uc.GetSpending(context.Background(), by, time.Time{}, time.Time{})
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters, name)
+		}
+
+		_, err := uc.GetSpending(context.Background(), nil, time.Date(2025, time.April, 10, 0, 0, 0, 0, time.UTC), time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC))
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+}
+
+func TestGetCashFlow(t *testing.T) {
+	miles := monetary.NewAsset("MILES", 0, "mi", entities.AssetClassCustom)
+	var gotAccountID string
+	var gotFrom, gotTo time.Time
+	uc := NewReportUseCase(
+		&mocks.TransactionRepositoryMock{
+			GetCashFlowFunc: func(ctx context.Context, accountID string, startDate, endDate time.Time) ([]entities.CashFlowTotal, error) {
+				gotAccountID, gotFrom, gotTo = accountID, startDate, endDate
+				return []entities.CashFlowTotal{
+					{Month: "2025-02", Income: testMonetary(t, monetary.BRL, 500000), Expense: testMonetary(t, monetary.BRL, 350000)},
+					{Month: "2025-04", Income: testMonetary(t, monetary.BRL, 0), Expense: testMonetary(t, monetary.BRL, 120000)},
+					{Month: "2025-03", Income: testMonetary(t, miles, 4000), Expense: testMonetary(t, miles, 0)},
+				}, nil
+			},
+		},
+		&mocks.AccountRepositoryMock{
+			GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+				if id != "acc-miles" {
+					return entities.Account{}, errNotFound("account")
+				}
+				return entities.Account{ID: id, Asset: miles}, nil
+			},
+		},
+		&mocks.CategoryRepositoryMock{},
+		&mocks.BalanceRepositoryMock{},
+		&mocks.BookRepositoryMock{},
+		&mocks.ReportSnapshotRepositoryMock{},
+	)
+	uc.now = func() time.Time { return time.Date(2025, time.April, 20, 18, 0, 0, 0, time.UTC) }
+
+	t.Run("the last 12 months by default", func(t *testing.T) {
+		report, err := uc.GetCashFlow(context.Background(), "", time.Time{}, time.Time{})
+		require.NoError(t, err)
+
+		assert.Equal(t, "", gotAccountID)
+		assert.Equal(t, time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC), gotFrom)
+		assert.Equal(t, time.Date(2025, time.April, 20, 0, 0, 0, 0, time.UTC), gotTo)
+
+		// Miles aren't money
+		require.Len(t, report.Series, 1)
+		series := report.Series[0]
+		assert.Equal(t, "BRL", series.Asset.Asset)
+		require.Len(t, series.Months, 12)
+		assert.Equal(t, "2024-05", series.Months[0].Month)
+		assert.Equal(t, int64(0), series.Months[0].Net.Amount.Int64())
+		assert.Equal(t, "2025-02", series.Months[9].Month)
+		assert.Equal(t, int64(150000), series.Months[9].Net.Amount.Int64())
+		assert.Equal(t, int64(-120000), series.Months[11].Net.Amount.Int64())
+		assert.Equal(t, int64(500000), series.Income.Amount.Int64())
+		assert.Equal(t, int64(470000), series.Expense.Amount.Int64())
+		assert.Equal(t, int64(30000), series.Net.Amount.Int64())
+	})
+
+	t.Run("of an account", func(t *testing.T) {
+		from := time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, time.April, 10, 0, 0, 0, 0, time.UTC)
+		report, err := uc.GetCashFlow(context.Background(), "acc-miles", from, to)
+		require.NoError(t, err)
+
+		assert.Equal(t, "acc-miles", gotAccountID)
+		assert.Equal(t, from, gotFrom)
+		assert.Equal(t, to, gotTo)
+		require.Len(t, report.Series, 2)
+		assert.Equal(t, "MILES", report.Series[1].Asset.Asset)
+		require.Len(t, report.Series[1].Months, 2)
+		assert.Equal(t, int64(4000), report.Series[1].Net.Amount.Int64())
+
+		_, err = uc.GetCashFlow(context.Background(), "acc-missing", from, to)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("ends before it starts", func(t *testing.T) {
+		_, err := uc.GetCashFlow(context.Background(), "", time.Date(2025, time.April, 10, 0, 0, 0, 0, time.UTC), time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC))
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+}
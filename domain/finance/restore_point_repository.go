@@ -0,0 +1,14 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/restore_point_repository.go . RestorePointRepository
+type RestorePointRepository interface {
+	CreateRestorePoint(ctx context.Context, point entities.RestorePoint) (entities.RestorePoint, error)
+	GetRestorePointByID(ctx context.Context, id string) (entities.RestorePoint, error)
+	GetAllRestorePoints(ctx context.Context) ([]entities.RestorePoint, error)
+	MarkRestorePointRolledBack(ctx context.Context, id string) (entities.RestorePoint, error)
+}
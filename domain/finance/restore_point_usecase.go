@@ -0,0 +1,112 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+type RestorePointUseCase struct {
+	restorePointRepo RestorePointRepository
+	transactionRepo  TransactionRepository
+	accountRepo      AccountRepository
+	balanceRepo      BalanceRepository
+}
+
+func NewRestorePointUseCase(restorePointRepo RestorePointRepository, transactionRepo TransactionRepository, accountRepo AccountRepository, balanceRepo BalanceRepository) *RestorePointUseCase {
+	return &RestorePointUseCase{
+		restorePointRepo: restorePointRepo,
+		transactionRepo:  transactionRepo,
+		accountRepo:      accountRepo,
+		balanceRepo:      balanceRepo,
+	}
+}
+
+// GetRestorePoints returns the restore points of the book, newest first
+func (uc *RestorePointUseCase) GetRestorePoints(ctx context.Context) ([]entities.RestorePoint, error) {
+	points, err := uc.restorePointRepo.GetAllRestorePoints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get restore points: %w", err)
+	}
+
+	return points, nil
+}
+
+func (uc *RestorePointUseCase) GetRestorePoint(ctx context.Context, id string) (entities.RestorePoint, error) {
+	if id == "" {
+		return entities.RestorePoint{}, fmt.Errorf("restore point ID cannot be empty")
+	}
+
+	point, err := uc.restorePointRepo.GetRestorePointByID(ctx, id)
+	if err != nil {
+		return entities.RestorePoint{}, fmt.Errorf("failed to get restore point: %w", err)
+	}
+
+	return point, nil
+}
+
+// Rollback reverts the operation of a restore point, newest change first. The
+// transactions it created are deleted and the ones it updated get their
+// previous state back, skipping the ones deleted since. The accounts it
+// created are deleted unless they hold other transactions by now. The
+// balances of the accounts left are refreshed. A restore point can only be
+// rolled back once.
+func (uc *RestorePointUseCase) Rollback(ctx context.Context, id string) (entities.RestorePoint, error) {
+	point, err := uc.GetRestorePoint(ctx, id)
+	if err != nil {
+		return entities.RestorePoint{}, err
+	}
+	if point.RolledBackAt != nil {
+		return entities.RestorePoint{}, fmt.Errorf("restore point %s was already rolled back: %w", id, domain.ErrConflict)
+	}
+
+	accounts := map[string]bool{}
+	for _, change := range slices.Backward(point.Changes) {
+		switch {
+		case change.Entity == entities.RestorePointEntityAccount:
+			count, err := uc.accountRepo.CountAccountTransactions(ctx, change.ID)
+			if err != nil {
+				return entities.RestorePoint{}, fmt.Errorf("failed to count transactions of account %s: %w", change.ID, err)
+			}
+			if count > 0 {
+				continue
+			}
+			if err := uc.accountRepo.DeleteAccount(ctx, change.ID); err != nil {
+				return entities.RestorePoint{}, fmt.Errorf("failed to delete account %s: %w", change.ID, err)
+			}
+			delete(accounts, change.ID)
+
+		case change.Action == entities.RestorePointActionCreated:
+			if err := uc.transactionRepo.DeleteTransaction(ctx, change.ID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+				return entities.RestorePoint{}, fmt.Errorf("failed to delete transaction %s: %w", change.ID, err)
+			}
+			accounts[change.AccountID] = true
+
+		case change.Before != nil:
+			if _, err := uc.transactionRepo.UpdateTransaction(ctx, *change.Before); err != nil {
+				if errors.Is(err, domain.ErrNotFound) {
+					continue
+				}
+				return entities.RestorePoint{}, fmt.Errorf("failed to restore transaction %s: %w", change.ID, err)
+			}
+			accounts[change.AccountID] = true
+		}
+	}
+
+	for _, accountID := range slices.Sorted(maps.Keys(accounts)) {
+		if err := uc.balanceRepo.RefreshAccountBalance(ctx, accountID); err != nil {
+			return entities.RestorePoint{}, fmt.Errorf("failed to refresh balance of account %s: %w", accountID, err)
+		}
+	}
+
+	point, err = uc.restorePointRepo.MarkRestorePointRolledBack(ctx, id)
+	if err != nil {
+		return entities.RestorePoint{}, fmt.Errorf("failed to mark restore point rolled back: %w", err)
+	}
+
+	return point, nil
+}
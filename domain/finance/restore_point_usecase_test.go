@@ -0,0 +1,112 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollback(t *testing.T) {
+	rent := entities.Transaction{
+		ID:          "tx-rent",
+		AccountID:   "acc-wise",
+		CategoryID:  "cat-income",
+		Monetary:    monetary.Monetary{Asset: monetary.GBP, Amount: big.NewInt(100000)},
+		Description: "Rent from John",
+		Status:      entities.TransactionStatusPending,
+	}
+	point := entities.RestorePoint{ID: "rp-1", Operation: entities.RestorePointOperationStatementImport}
+	point.Created(entities.RestorePointEntityTransaction, "tx-bakery", "acc-wise")
+	point.Updated(rent)
+	point.Created(entities.RestorePointEntityAccount, "acc-new", "")
+	point.Created(entities.RestorePointEntityTransaction, "tx-usd", "acc-new")
+	point.Created(entities.RestorePointEntityAccount, "acc-used", "")
+	point.Created(entities.RestorePointEntityTransaction, "tx-deleted", "acc-used")
+
+	setup := func(point entities.RestorePoint) (*RestorePointUseCase, *mocks.TransactionRepositoryMock, *mocks.AccountRepositoryMock, *mocks.BalanceRepositoryMock, *mocks.RestorePointRepositoryMock) {
+		restorePointRepo := &mocks.RestorePointRepositoryMock{
+			GetRestorePointByIDFunc: func(ctx context.Context, id string) (entities.RestorePoint, error) {
+				if id != point.ID {
+					return entities.RestorePoint{}, domain.ErrNotFound
+				}
+				return point, nil
+			},
+			MarkRestorePointRolledBackFunc: func(ctx context.Context, id string) (entities.RestorePoint, error) {
+				now := time.Now()
+				point.RolledBackAt = &now
+				return point, nil
+			},
+		}
+		transactionRepo := &mocks.TransactionRepositoryMock{
+			DeleteTransactionFunc: func(ctx context.Context, id string) error {
+				if id == "tx-deleted" {
+					return domain.ErrNotFound
+				}
+				return nil
+			},
+		}
+		accountRepo := &mocks.AccountRepositoryMock{
+			CountAccountTransactionsFunc: func(ctx context.Context, accountID string) (int64, error) {
+				// Transactions were added to it since the import
+				if accountID == "acc-used" {
+					return 2, nil
+				}
+				return 0, nil
+			},
+		}
+		balanceRepo := &mocks.BalanceRepositoryMock{}
+		uc := NewRestorePointUseCase(restorePointRepo, transactionRepo, accountRepo, balanceRepo)
+		return uc, transactionRepo, accountRepo, balanceRepo, restorePointRepo
+	}
+
+	t.Run("reverts the changes newest first", func(t *testing.T) {
+		uc, transactionRepo, accountRepo, balanceRepo, restorePointRepo := setup(point)
+		rolledBack, err := uc.Rollback(context.Background(), "rp-1")
+		require.NoError(t, err)
+		assert.NotNil(t, rolledBack.RolledBackAt)
+		assert.Len(t, restorePointRepo.MarkRestorePointRolledBackCalls(), 1)
+
+		deleted := transactionRepo.DeleteTransactionCalls()
+		require.Len(t, deleted, 3)
+		assert.Equal(t, "tx-deleted", deleted[0].ID)
+		assert.Equal(t, "tx-usd", deleted[1].ID)
+		assert.Equal(t, "tx-bakery", deleted[2].ID)
+
+		require.Len(t, transactionRepo.UpdateTransactionCalls(), 1)
+		assert.Equal(t, rent, transactionRepo.UpdateTransactionCalls()[0].Transaction)
+
+		// The account holding other transactions is kept
+		require.Len(t, accountRepo.DeleteAccountCalls(), 1)
+		assert.Equal(t, "acc-new", accountRepo.DeleteAccountCalls()[0].ID)
+
+		refreshed := balanceRepo.RefreshAccountBalanceCalls()
+		require.Len(t, refreshed, 2)
+		assert.Equal(t, "acc-used", refreshed[0].AccountID)
+		assert.Equal(t, "acc-wise", refreshed[1].AccountID)
+	})
+
+	t.Run("already rolled back", func(t *testing.T) {
+		rolledBack := point
+		now := time.Now()
+		rolledBack.RolledBackAt = &now
+		uc, transactionRepo, _, _, _ := setup(rolledBack)
+
+		_, err := uc.Rollback(context.Background(), "rp-1")
+		assert.ErrorIs(t, err, domain.ErrConflict)
+		assert.Empty(t, transactionRepo.DeleteTransactionCalls())
+	})
+
+	t.Run("missing restore point", func(t *testing.T) {
+		uc, _, _, _, _ := setup(point)
+		_, err := uc.Rollback(context.Background(), "rp-2")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,20 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/session_repository.go . SessionRepository
+type SessionRepository interface {
+	CreateSession(ctx context.Context, session entities.Session) (entities.Session, error)
+	GetSessionByID(ctx context.Context, id string) (entities.Session, error)
+	// GetActiveSessions lists the sessions of the user neither revoked nor
+	// expired, the last seen first
+	GetActiveSessions(ctx context.Context, userID string) ([]entities.Session, error)
+	TouchSession(ctx context.Context, id string) error
+	// RevokeSession returns domain.ErrNotFound when the user has no such active
+	// session
+	RevokeSession(ctx context.Context, id, userID string) error
+	RevokeOtherSessions(ctx context.Context, userID, keepID string) (int64, error)
+}
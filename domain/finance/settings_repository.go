@@ -0,0 +1,12 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/settings_repository.go . SettingsRepository
+type SettingsRepository interface {
+	GetSettings(ctx context.Context) (entities.Settings, error)
+	UpdateSettings(ctx context.Context, settings entities.Settings) (entities.Settings, error)
+}
@@ -0,0 +1,104 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// maskedKeyPrefix marks API keys that were masked by the API before being
+// returned to clients
+const maskedKeyPrefix = "****"
+
+type SettingsUseCase struct {
+	settingsRepo SettingsRepository
+}
+
+func NewSettingsUseCase(settingsRepo SettingsRepository) *SettingsUseCase {
+	return &SettingsUseCase{
+		settingsRepo: settingsRepo,
+	}
+}
+
+func (uc *SettingsUseCase) GetSettings(ctx context.Context) (entities.Settings, error) {
+	settings, err := uc.settingsRepo.GetSettings(ctx)
+	if err != nil {
+		return entities.Settings{}, fmt.Errorf("failed to get settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+func (uc *SettingsUseCase) UpdateSettings(ctx context.Context, settings entities.Settings) (entities.Settings, error) {
+	// Validate input
+	if err := uc.validateSettings(settings); err != nil {
+		return entities.Settings{}, err
+	}
+
+	existingSettings, err := uc.settingsRepo.GetSettings(ctx)
+	if err != nil {
+		return entities.Settings{}, fmt.Errorf("failed to get existing settings: %w", err)
+	}
+
+	// Keys are only ever returned masked, so a masked value means "keep the
+	// stored key" and an empty value removes it
+	apiKeys := make(map[string]string, len(settings.APIKeys))
+	for provider, key := range settings.APIKeys {
+		switch {
+		case key == "":
+			continue
+		case strings.HasPrefix(key, maskedKeyPrefix):
+			if existingKey, ok := existingSettings.APIKeys[provider]; ok {
+				apiKeys[provider] = existingKey
+			}
+		default:
+			apiKeys[provider] = key
+		}
+	}
+	settings.APIKeys = apiKeys
+
+	updatedSettings, err := uc.settingsRepo.UpdateSettings(ctx, settings)
+	if err != nil {
+		return entities.Settings{}, fmt.Errorf("failed to update settings: %w", err)
+	}
+
+	return updatedSettings, nil
+}
+
+func (uc *SettingsUseCase) validateSettings(settings entities.Settings) error {
+	if settings.Currency.Asset == "" {
+		return fmt.Errorf("settings currency cannot be empty")
+	}
+
+	if _, ok := entities.FindSupportedAsset(settings.Currency.Asset); !ok {
+		return fmt.Errorf("invalid currency: %s", settings.Currency.Asset)
+	}
+
+	if strings.TrimSpace(settings.Locale) == "" {
+		return fmt.Errorf("settings locale cannot be empty")
+	}
+
+	if settings.FiscalMonthStartDay < 1 || settings.FiscalMonthStartDay > 28 {
+		return fmt.Errorf("fiscal month start day must be between 1 and 28")
+	}
+
+	if settings.NotificationsEnabled && settings.NotificationEmail == "" {
+		return fmt.Errorf("notification email is required when notifications are enabled")
+	}
+
+	if settings.NotificationEmail != "" {
+		if _, err := mail.ParseAddress(settings.NotificationEmail); err != nil {
+			return fmt.Errorf("invalid notification email: %s", settings.NotificationEmail)
+		}
+	}
+
+	for provider := range settings.APIKeys {
+		if strings.TrimSpace(provider) == "" {
+			return fmt.Errorf("api key provider cannot be empty")
+		}
+	}
+
+	return nil
+}
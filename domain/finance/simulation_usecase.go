@@ -0,0 +1,324 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// simulationHistoryMonths is how many months before a simulation the monthly
+// amount of each category is averaged over
+const simulationHistoryMonths = 6
+
+type SimulationUseCase struct {
+	transactionRepo TransactionRepository
+	accountRepo     AccountRepository
+	categoryRepo    CategoryRepository
+	balanceRepo     BalanceRepository
+	bookRepo        BookRepository
+	now             func() time.Time
+}
+
+func NewSimulationUseCase(transactionRepo TransactionRepository, accountRepo AccountRepository, categoryRepo CategoryRepository, balanceRepo BalanceRepository, bookRepo BookRepository) *SimulationUseCase {
+	return &SimulationUseCase{
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		categoryRepo:    categoryRepo,
+		balanceRepo:     balanceRepo,
+		bookRepo:        bookRepo,
+		now:             time.Now,
+	}
+}
+
+// simulationBase is what the projections of an asset start from: its current
+// net worth and the average monthly amount of each category
+type simulationBase struct {
+	netWorth   entities.Money
+	categories map[string]int64
+}
+
+// Simulate projects the net worth of each asset over the next months,
+// starting with the one after the current, without any change and with the
+// changes of each scenario. The projections carry on the average monthly
+// income and expenses of each category over the six months before the
+// current one, from the current net worth. Drafts, cancelled transactions
+// and the accounts in custom assets are left out.
+func (uc *SimulationUseCase) Simulate(ctx context.Context, months int, scenarios []entities.SimulationScenario) ([]entities.Simulation, error) {
+	if months < 1 || months > entities.MaxSimulationMonths {
+		return nil, fmt.Errorf("months must be between 1 and %d, got %d: %w", entities.MaxSimulationMonths, months, domain.ErrMalformedParameters)
+	}
+	if len(scenarios) == 0 || len(scenarios) > entities.MaxSimulationScenarios {
+		return nil, fmt.Errorf("a simulation compares 1 to %d scenarios, got %d: %w", entities.MaxSimulationScenarios, len(scenarios), domain.ErrMalformedParameters)
+	}
+
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	byID := make(map[string]entities.Category, len(categories))
+	for _, category := range categories {
+		byID[category.ID] = category
+	}
+
+	scenarios, err = uc.validateScenarios(ctx, scenarios, byID)
+	if err != nil {
+		return nil, err
+	}
+
+	bases, err := uc.simulationBases(ctx, byID)
+	if err != nil {
+		return nil, err
+	}
+	// The assets of the changes are projected too, even without accounts
+	assets := map[string]monetary.Asset{}
+	for _, base := range bases {
+		assets[base.netWorth.Asset.Asset] = base.netWorth.Asset
+	}
+	for _, scenario := range scenarios {
+		for _, change := range scenario.Changes {
+			if change.Type != entities.SimulationChangeCategory {
+				assets[change.Amount.Asset.Asset] = change.Amount.Asset
+			}
+		}
+	}
+
+	now := uc.now()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, 1, 0)
+	baseline := entities.SimulationScenario{Name: entities.BaselineScenario}
+
+	simulations := make([]entities.Simulation, 0, len(assets))
+	for _, code := range slices.Sorted(maps.Keys(assets)) {
+		base, ok := bases[code]
+		if !ok {
+			base = simulationBase{netWorth: entities.NewMoney(assets[code], 0)}
+		}
+
+		simulation := entities.Simulation{Asset: assets[code], NetWorth: base.netWorth.Monetary()}
+		for _, scenario := range append([]entities.SimulationScenario{baseline}, scenarios...) {
+			projection, err := projectScenario(base, scenario, byID, start, months)
+			if err != nil {
+				return nil, err
+			}
+			simulation.Projections = append(simulation.Projections, projection)
+		}
+
+		baselineEnd := entities.MoneyOf(simulation.Projections[0].EndNetWorth)
+		for i := range simulation.Projections {
+			difference, err := entities.MoneyOf(simulation.Projections[i].EndNetWorth).Sub(baselineEnd)
+			if err != nil {
+				return nil, fmt.Errorf("failed to compare scenarios: %w", err)
+			}
+			simulation.Projections[i].Difference = difference.Monetary()
+		}
+		simulations = append(simulations, simulation)
+	}
+
+	return simulations, nil
+}
+
+// validateScenarios checks the scenarios, returning them with the income and
+// expenses lacking an asset taken in the base currency of the book
+func (uc *SimulationUseCase) validateScenarios(ctx context.Context, scenarios []entities.SimulationScenario, categories map[string]entities.Category) ([]entities.SimulationScenario, error) {
+	var bookAsset *monetary.Asset
+	names := map[string]bool{entities.BaselineScenario: true}
+	validated := make([]entities.SimulationScenario, len(scenarios))
+	for i, scenario := range scenarios {
+		scenario.Name = strings.TrimSpace(scenario.Name)
+		if scenario.Name == "" {
+			return nil, fmt.Errorf("scenario %d needs a name: %w", i, domain.ErrMalformedParameters)
+		}
+		if names[strings.ToLower(scenario.Name)] {
+			return nil, fmt.Errorf("scenario name %q is taken: %w", scenario.Name, domain.ErrMalformedParameters)
+		}
+		names[strings.ToLower(scenario.Name)] = true
+
+		scenario.Changes = slices.Clone(scenario.Changes)
+		for j, change := range scenario.Changes {
+			if change.Months < 0 {
+				return nil, fmt.Errorf("scenario %q: change %d can't last %d months: %w", scenario.Name, j, change.Months, domain.ErrMalformedParameters)
+			}
+
+			switch change.Type {
+			case entities.SimulationChangeCategory:
+				if _, ok := categories[change.CategoryID]; !ok {
+					return nil, fmt.Errorf("scenario %q: change %d: category %q %w", scenario.Name, j, change.CategoryID, domain.ErrNotFound)
+				}
+				if math.IsNaN(change.Percent) || change.Percent < -100 || change.Percent > 1000 {
+					return nil, fmt.Errorf("scenario %q: change %d: percent must be between -100 and 1000: %w", scenario.Name, j, domain.ErrMalformedParameters)
+				}
+			case entities.SimulationChangeIncome, entities.SimulationChangeExpense:
+				if change.Amount.Amount == nil || change.Amount.Amount.Sign() <= 0 {
+					return nil, fmt.Errorf("scenario %q: change %d: amount must be positive: %w", scenario.Name, j, domain.ErrMalformedParameters)
+				}
+				if change.Amount.Asset.Asset == "" {
+					if bookAsset == nil {
+						book, err := uc.bookRepo.GetBookByID(ctx, domain.BookFromContext(ctx))
+						if err != nil {
+							return nil, fmt.Errorf("failed to get book: %w", err)
+						}
+						bookAsset = &book.Asset
+					}
+					scenario.Changes[j].Amount.Asset = *bookAsset
+				}
+			default:
+				return nil, fmt.Errorf("scenario %q: invalid change type %q: %w", scenario.Name, change.Type, domain.ErrMalformedParameters)
+			}
+		}
+		validated[i] = scenario
+	}
+	return validated, nil
+}
+
+// simulationBases reads the current net worth and the average monthly amount
+// of each category, per asset
+func (uc *SimulationUseCase) simulationBases(ctx context.Context, categories map[string]entities.Category) (map[string]simulationBase, error) {
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	byID := make(map[string]entities.Account, len(accounts))
+	for _, account := range accounts {
+		byID[account.ID] = account
+	}
+
+	balances, err := uc.balanceRepo.GetAllBalances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balances: %w", err)
+	}
+
+	now := uc.now()
+	end := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	transactions, err := uc.transactionRepo.GetTransactionsByDateRange(ctx, end.AddDate(0, -simulationHistoryMonths, 0), end.AddDate(0, 0, -1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	bases := map[string]simulationBase{}
+	base := func(asset monetary.Asset) simulationBase {
+		if _, ok := bases[asset.Asset]; !ok {
+			bases[asset.Asset] = simulationBase{netWorth: entities.NewMoney(asset, 0), categories: map[string]int64{}}
+		}
+		return bases[asset.Asset]
+	}
+
+	for _, balance := range balances {
+		// Custom assets like loyalty points aren't money, and liability
+		// balances are what's owed, like in the balance summary
+		account := byID[balance.AccountID]
+		if !account.IsMonetary() {
+			continue
+		}
+		amount := entities.MoneyOf(balance.CurrentBalance)
+		if account.IsLiability() {
+			amount = amount.Neg()
+		}
+		asset := base(amount.Asset)
+		if asset.netWorth, err = asset.netWorth.Add(amount); err != nil {
+			return nil, fmt.Errorf("failed to add up net worth: %w", err)
+		}
+		bases[amount.Asset.Asset] = asset
+	}
+
+	totals := map[string]map[string]int64{}
+	for _, transaction := range transactions {
+		if !inFatura(transaction) || !byID[transaction.AccountID].IsMonetary() {
+			continue
+		}
+		if _, ok := categories[transaction.CategoryID]; !ok {
+			continue
+		}
+		asset := transaction.Monetary.Asset
+		base(asset)
+		if totals[asset.Asset] == nil {
+			totals[asset.Asset] = map[string]int64{}
+		}
+		// Amounts are signed by their effect on the account, so take their
+		// size whether the account is an asset or a liability
+		totals[asset.Asset][transaction.CategoryID] += entities.MoneyOf(transaction.Monetary).Abs().Monetary().Amount.Int64()
+	}
+	for code, categoryTotals := range totals {
+		for categoryID, total := range categoryTotals {
+			bases[code].categories[categoryID] = int64(math.Round(float64(total) / simulationHistoryMonths))
+		}
+	}
+
+	return bases, nil
+}
+
+// projectScenario carries the base on month by month from start with the
+// changes of the scenario in its asset
+func projectScenario(base simulationBase, scenario entities.SimulationScenario, categories map[string]entities.Category, start time.Time, months int) (entities.SimulationProjection, error) {
+	asset := base.netWorth.Asset
+
+	// The monthly amount of each category once scaled by the changes to it
+	// and to the categories above it
+	var income, expenses int64
+	for categoryID, amount := range base.categories {
+		scale := 1.0
+		for _, change := range scenario.Changes {
+			if change.Type == entities.SimulationChangeCategory && withinCategory(categories, categoryID, change.CategoryID) {
+				scale *= 1 + change.Percent/100
+			}
+		}
+		scaled := int64(math.Round(float64(amount) * scale))
+		switch categories[categoryID].Type {
+		case entities.CategoryTypeIncome:
+			income += scaled
+		case entities.CategoryTypeExpense:
+			expenses += scaled
+		}
+	}
+
+	projection := entities.SimulationProjection{Name: scenario.Name, Months: make([]entities.SimulationMonth, months)}
+	netWorth := base.netWorth
+	for i := range projection.Months {
+		monthIncome, monthExpenses := income, expenses
+		for _, change := range scenario.Changes {
+			if change.Type == entities.SimulationChangeCategory || change.Amount.Asset.Asset != asset.Asset || (change.Months > 0 && i >= change.Months) {
+				continue
+			}
+			if change.Type == entities.SimulationChangeIncome {
+				monthIncome += change.Amount.Amount.Int64()
+			} else {
+				monthExpenses += change.Amount.Amount.Int64()
+			}
+		}
+
+		net := entities.NewMoney(asset, monthIncome-monthExpenses)
+		var err error
+		if netWorth, err = netWorth.Add(net); err != nil {
+			return entities.SimulationProjection{}, fmt.Errorf("failed to project net worth: %w", err)
+		}
+		projection.Months[i] = entities.SimulationMonth{
+			Month:       start.AddDate(0, i, 0).Format(faturaMonthLayout),
+			Income:      entities.NewMoney(asset, monthIncome).Monetary(),
+			Expenses:    entities.NewMoney(asset, monthExpenses).Monetary(),
+			NetCashFlow: net.Monetary(),
+			NetWorth:    netWorth.Monetary(),
+		}
+	}
+	projection.EndNetWorth = netWorth.Monetary()
+
+	return projection, nil
+}
+
+// withinCategory reports whether the category is ancestorID or one of its
+// subcategories
+func withinCategory(categories map[string]entities.Category, categoryID, ancestorID string) bool {
+	// The depth guards against a cycle of parents
+	for depth := 0; categoryID != "" && depth <= len(categories); depth++ {
+		if categoryID == ancestorID {
+			return true
+		}
+		categoryID = categories[categoryID].ParentID
+	}
+	return false
+}
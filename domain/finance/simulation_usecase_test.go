@@ -0,0 +1,161 @@
+package finance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, time.March, 17, 9, 30, 0, 0, time.UTC)
+
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAllAccountsFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+			return []entities.Account{
+				{ID: "acc-checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL},
+				{ID: "acc-card", Type: entities.AccountTypeCredit, Asset: monetary.BRL},
+				{ID: "acc-miles", Type: entities.AccountTypeChecking, Asset: monetary.Asset{Asset: "MILES", Class: entities.AssetClassCustom}},
+			}, nil
+		},
+	}
+	balanceRepo := &mocks.BalanceRepositoryMock{
+		GetAllBalancesFunc: func(ctx context.Context) ([]entities.Balance, error) {
+			return []entities.Balance{
+				{AccountID: "acc-checking", CurrentBalance: testMonetary(t, monetary.BRL, 1000000)},
+				{AccountID: "acc-card", CurrentBalance: testMonetary(t, monetary.BRL, 200000)},
+				{AccountID: "acc-miles", CurrentBalance: monetary.Monetary{Asset: monetary.Asset{Asset: "MILES", Class: entities.AssetClassCustom}, Amount: testMonetary(t, monetary.BRL, 50000).Amount}},
+			}, nil
+		},
+	}
+	categoryRepo := &mocks.CategoryRepositoryMock{
+		GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+			return []entities.Category{
+				{ID: "cat-salary", Type: entities.CategoryTypeIncome},
+				{ID: "cat-food", Type: entities.CategoryTypeExpense},
+				{ID: "cat-dining", Type: entities.CategoryTypeExpense, ParentID: "cat-food"},
+				{ID: "cat-rent", Type: entities.CategoryTypeExpense},
+			}, nil
+		},
+	}
+	var gotFrom, gotTo time.Time
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionsByDateRangeFunc: func(ctx context.Context, from, to time.Time) ([]entities.Transaction, error) {
+			gotFrom, gotTo = from, to
+			return []entities.Transaction{
+				// Six months of R$ 5.000,00 salary, R$ 1.800,00 rent and R$ 600,00 dining
+				{AccountID: "acc-checking", CategoryID: "cat-salary", Monetary: testMonetary(t, monetary.BRL, 3000000), Status: entities.TransactionStatusCleared},
+				{AccountID: "acc-checking", CategoryID: "cat-rent", Monetary: testMonetary(t, monetary.BRL, -1080000), Status: entities.TransactionStatusCleared},
+				{AccountID: "acc-card", CategoryID: "cat-dining", Monetary: testMonetary(t, monetary.BRL, 360000), Status: entities.TransactionStatusPending},
+				// Left out
+				{AccountID: "acc-checking", CategoryID: "cat-rent", Monetary: testMonetary(t, monetary.BRL, -90000), Status: entities.TransactionStatusDraft},
+				{AccountID: "acc-miles", CategoryID: "cat-salary", Monetary: testMonetary(t, monetary.BRL, 90000), Status: entities.TransactionStatusCleared},
+			}, nil
+		},
+	}
+	bookRepo := &mocks.BookRepositoryMock{
+		GetBookByIDFunc: func(ctx context.Context, id string) (entities.Book, error) {
+			return entities.Book{ID: id, Asset: monetary.BRL}, nil
+		},
+	}
+	uc := NewSimulationUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, bookRepo)
+	uc.now = func() time.Time { return now }
+
+	t.Run("compares the scenarios with the baseline", func(t *testing.T) {
+		simulations, err := uc.Simulate(ctx, 12, []entities.SimulationScenario{
+			{Name: "Less dining out", Changes: []entities.SimulationChange{
+				{Type: entities.SimulationChangeCategory, CategoryID: "cat-food", Percent: -30},
+			}},
+			{Name: "Car loan", Changes: []entities.SimulationChange{
+				{Type: entities.SimulationChangeExpense, Description: "Car loan", Amount: monetary.Monetary{Amount: testMonetary(t, monetary.BRL, 80000).Amount}, Months: 10},
+			}},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC), gotFrom)
+		assert.Equal(t, time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC), gotTo)
+
+		require.Len(t, simulations, 1)
+		simulation := simulations[0]
+		assert.Equal(t, "BRL", simulation.Asset.Asset)
+		assert.Equal(t, int64(800000), simulation.NetWorth.Amount.Int64())
+		require.Len(t, simulation.Projections, 3)
+
+		baseline := simulation.Projections[0]
+		assert.Equal(t, entities.BaselineScenario, baseline.Name)
+		require.Len(t, baseline.Months, 12)
+		assert.Equal(t, "2025-04", baseline.Months[0].Month)
+		assert.Equal(t, "2026-03", baseline.Months[11].Month)
+		assert.Equal(t, int64(500000), baseline.Months[0].Income.Amount.Int64())
+		assert.Equal(t, int64(240000), baseline.Months[0].Expenses.Amount.Int64())
+		assert.Equal(t, int64(1060000), baseline.Months[0].NetWorth.Amount.Int64())
+		assert.Equal(t, int64(800000+12*260000), baseline.EndNetWorth.Amount.Int64())
+		assert.Zero(t, baseline.Difference.Amount.Int64())
+
+		// Dining is under food, so it's cut by 30%
+		dining := simulation.Projections[1]
+		assert.Equal(t, "Less dining out", dining.Name)
+		assert.Equal(t, int64(222000), dining.Months[0].Expenses.Amount.Int64())
+		assert.Equal(t, int64(12*18000), dining.Difference.Amount.Int64())
+
+		// The loan is paid for 10 months, in the base currency of the book
+		loan := simulation.Projections[2]
+		assert.Equal(t, int64(320000), loan.Months[9].Expenses.Amount.Int64())
+		assert.Equal(t, int64(240000), loan.Months[10].Expenses.Amount.Int64())
+		assert.Equal(t, int64(-10*80000), loan.Difference.Amount.Int64())
+	})
+
+	t.Run("changes in another asset", func(t *testing.T) {
+		simulations, err := uc.Simulate(ctx, 2, []entities.SimulationScenario{
+			{Name: "Freelance", Changes: []entities.SimulationChange{
+				{Type: entities.SimulationChangeIncome, Amount: testMonetary(t, monetary.USD, 100000)},
+			}},
+		})
+		require.NoError(t, err)
+		require.Len(t, simulations, 2)
+		assert.Equal(t, "USD", simulations[1].Asset.Asset)
+		assert.Zero(t, simulations[1].Projections[0].EndNetWorth.Amount.Int64())
+		assert.Equal(t, int64(200000), simulations[1].Projections[1].EndNetWorth.Amount.Int64())
+		assert.Zero(t, simulations[0].Projections[1].Difference.Amount.Int64())
+	})
+
+	t.Run("invalid simulations", func(t *testing.T) {
+		scenario := func(changes ...entities.SimulationChange) []entities.SimulationScenario {
+			return []entities.SimulationScenario{{Name: "Scenario", Changes: changes}}
+		}
+		for name, tt := range map[string]struct {
+			months    int
+			scenarios []entities.SimulationScenario
+			err       error
+		}{
+			"no months":          {months: 0, scenarios: scenario(), err: domain.ErrMalformedParameters},
+			"too many months":    {months: entities.MaxSimulationMonths + 1, scenarios: scenario(), err: domain.ErrMalformedParameters},
+			"no scenario":        {months: 12, err: domain.ErrMalformedParameters},
+			"too many scenarios": {months: 12, scenarios: make([]entities.SimulationScenario, entities.MaxSimulationScenarios+1), err: domain.ErrMalformedParameters},
+			"no name":            {months: 12, scenarios: []entities.SimulationScenario{{Name: " "}}, err: domain.ErrMalformedParameters},
+			"baseline name":      {months: 12, scenarios: []entities.SimulationScenario{{Name: "Baseline"}}, err: domain.ErrMalformedParameters},
+			"same names":         {months: 12, scenarios: []entities.SimulationScenario{{Name: "Loan"}, {Name: "loan"}}, err: domain.ErrMalformedParameters},
+			"unknown category": {months: 12, scenarios: scenario(entities.SimulationChange{Type: entities.SimulationChangeCategory, CategoryID: "cat-gone", Percent: -10}),
+				err: domain.ErrNotFound},
+			"percent below -100": {months: 12, scenarios: scenario(entities.SimulationChange{Type: entities.SimulationChangeCategory, CategoryID: "cat-food", Percent: -110}),
+				err: domain.ErrMalformedParameters},
+			"no amount": {months: 12, scenarios: scenario(entities.SimulationChange{Type: entities.SimulationChangeExpense}),
+				err: domain.ErrMalformedParameters},
+			"negative months": {months: 12, scenarios: scenario(entities.SimulationChange{Type: entities.SimulationChangeIncome, Amount: testMonetary(t, monetary.BRL, 100), Months: -1}),
+				err: domain.ErrMalformedParameters},
+			"unknown type": {months: 12, scenarios: scenario(entities.SimulationChange{Type: "bonus"}),
+				err: domain.ErrMalformedParameters},
+		} {
+			_, err := uc.Simulate(ctx, tt.months, tt.scenarios)
+			assert.ErrorIs(t, err, tt.err, name)
+		}
+	})
+}
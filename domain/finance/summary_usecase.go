@@ -0,0 +1,90 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+	"fmt"
+	"time"
+)
+
+type SummaryUseCase struct {
+	balanceRepo     BalanceRepository
+	transactionRepo TransactionRepository
+	categoryRepo    CategoryRepository
+	now             func() time.Time
+}
+
+func NewSummaryUseCase(balanceRepo BalanceRepository, transactionRepo TransactionRepository, categoryRepo CategoryRepository) *SummaryUseCase {
+	return &SummaryUseCase{
+		balanceRepo:     balanceRepo,
+		transactionRepo: transactionRepo,
+		categoryRepo:    categoryRepo,
+		now:             time.Now,
+	}
+}
+
+// GetMinimalSummary returns the net worth, what was spent today and the next
+// bill. Drafts and cancelled transactions are left out.
+func (uc *SummaryUseCase) GetMinimalSummary(ctx context.Context) (entities.MinimalSummary, error) {
+	balanceSummary, err := uc.balanceRepo.GetBalanceSummary(ctx)
+	if err != nil {
+		return entities.MinimalSummary{}, fmt.Errorf("failed to get balance summary: %w", err)
+	}
+
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return entities.MinimalSummary{}, fmt.Errorf("failed to get categories: %w", err)
+	}
+	expense := map[string]bool{}
+	for _, category := range categories {
+		expense[category.ID] = category.Type == entities.CategoryTypeExpense
+	}
+
+	now := uc.now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	transactions, err := uc.transactionRepo.GetTransactionsByDateRange(ctx, today, today.AddDate(0, 0, entities.UpcomingBillDays))
+	if err != nil {
+		return entities.MinimalSummary{}, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	asset := balanceSummary.NetWorth.Asset
+	todaySpend := entities.NewMoney(asset, 0)
+	var upcomingBill *entities.Transaction
+	for i, transaction := range transactions {
+		if !expense[transaction.CategoryID] {
+			continue
+		}
+
+		switch transaction.Status {
+		case entities.TransactionStatusDraft, entities.TransactionStatusCancelled:
+			continue
+		case entities.TransactionStatusPending:
+			if upcomingBill == nil || transaction.Date.Before(upcomingBill.Date) {
+				upcomingBill = &transactions[i]
+			}
+		}
+
+		if !sameDay(transaction.Date, today) || transaction.Monetary.Asset.Asset != asset.Asset {
+			continue
+		}
+		// Expenses are signed by their effect on the account, so take their
+		// size whether the account is an asset or a liability
+		todaySpend, err = todaySpend.Add(entities.MoneyOf(transaction.Monetary).Abs())
+		if err != nil {
+			return entities.MinimalSummary{}, fmt.Errorf("failed to add up today's spend: %w", err)
+		}
+	}
+
+	return entities.MinimalSummary{
+		NetWorth:       balanceSummary.NetWorth,
+		TodaySpend:     todaySpend.Monetary(),
+		UpcomingBill:   upcomingBill,
+		LastCalculated: balanceSummary.LastCalculated,
+	}, nil
+}
+
+// sameDay reports whether a and b fall on the same calendar date, transaction
+// dates carry no time zone
+func sameDay(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month() && a.Day() == b.Day()
+}
@@ -0,0 +1,74 @@
+package finance
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMinimalSummary(t *testing.T) {
+	now := time.Date(2025, time.May, 10, 18, 30, 0, 0, time.UTC)
+	today := time.Date(2025, time.May, 10, 0, 0, 0, 0, time.UTC)
+	usd := func(cents int64) monetary.Monetary {
+		m := &monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(cents)}
+		return *m
+	}
+	eur := &monetary.Monetary{Asset: monetary.GBP, Amount: big.NewInt(-9999)}
+
+	transactions := []entities.Transaction{
+		{ID: "coffee", CategoryID: "cat-food", Monetary: usd(-450), Date: today, Status: entities.TransactionStatusCleared},
+		// Liability accounts sign expenses positive
+		{ID: "card", CategoryID: "cat-food", Monetary: usd(2000), Date: today, Status: entities.TransactionStatusCleared},
+		{ID: "salary", CategoryID: "cat-salary", Monetary: usd(500000), Date: today, Status: entities.TransactionStatusCleared},
+		{ID: "draft", CategoryID: "cat-food", Monetary: usd(-100), Date: today, Status: entities.TransactionStatusDraft},
+		{ID: "cancelled", CategoryID: "cat-food", Monetary: usd(-100), Date: today, Status: entities.TransactionStatusCancelled},
+		{ID: "abroad", CategoryID: "cat-food", Monetary: *eur, Date: today, Status: entities.TransactionStatusCleared},
+		{ID: "rent", CategoryID: "cat-housing", Monetary: usd(-150000), Date: today.AddDate(0, 0, 20), Status: entities.TransactionStatusPending},
+		{ID: "phone", CategoryID: "cat-housing", Monetary: usd(-5000), Date: today.AddDate(0, 0, 3), Status: entities.TransactionStatusPending},
+		{ID: "refund", CategoryID: "cat-salary", Monetary: usd(1000), Date: today.AddDate(0, 0, 1), Status: entities.TransactionStatusPending},
+	}
+
+	var from, to time.Time
+	uc := NewSummaryUseCase(
+		&mocks.BalanceRepositoryMock{
+			GetBalanceSummaryFunc: func(ctx context.Context) (entities.BalanceSummary, error) {
+				return entities.BalanceSummary{NetWorth: usd(1234567), LastCalculated: now}, nil
+			},
+		},
+		&mocks.TransactionRepositoryMock{
+			GetTransactionsByDateRangeFunc: func(ctx context.Context, startDate, endDate time.Time) ([]entities.Transaction, error) {
+				from, to = startDate, endDate
+				return transactions, nil
+			},
+		},
+		&mocks.CategoryRepositoryMock{
+			GetAllCategoriesFunc: func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+				return []entities.Category{
+					{ID: "cat-food", Type: entities.CategoryTypeExpense},
+					{ID: "cat-housing", Type: entities.CategoryTypeExpense},
+					{ID: "cat-salary", Type: entities.CategoryTypeIncome},
+				}, nil
+			},
+		},
+	)
+	uc.now = func() time.Time { return now }
+
+	summary, err := uc.GetMinimalSummary(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, today, from)
+	assert.Equal(t, today.AddDate(0, 0, entities.UpcomingBillDays), to)
+	assert.Equal(t, "[USD ($) 12345.67]", summary.NetWorth.String())
+	assert.Equal(t, "[USD ($) 24.50]", summary.TodaySpend.String(), "only today's USD expenses")
+	require.NotNil(t, summary.UpcomingBill)
+	assert.Equal(t, "phone", summary.UpcomingBill.ID)
+	assert.Equal(t, now, summary.LastCalculated)
+}
@@ -0,0 +1,16 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/tag_repository.go . TagRepository
+type TagRepository interface {
+	CreateTag(ctx context.Context, tag entities.Tag) (entities.Tag, error)
+	GetTagByID(ctx context.Context, id string) (entities.Tag, error)
+	GetAllTags(ctx context.Context) ([]entities.Tag, error)
+	UpdateTag(ctx context.Context, tag entities.Tag) (entities.Tag, error)
+	// DeleteTag deletes the tag, taking it off its transactions
+	DeleteTag(ctx context.Context, id string) error
+}
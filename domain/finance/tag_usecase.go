@@ -0,0 +1,125 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type TagUseCase struct {
+	tagRepo TagRepository
+}
+
+func NewTagUseCase(tagRepo TagRepository) *TagUseCase {
+	return &TagUseCase{
+		tagRepo: tagRepo,
+	}
+}
+
+func (uc *TagUseCase) CreateTag(ctx context.Context, tag entities.Tag) (entities.Tag, error) {
+	name, err := normalizeTag(tag.Name)
+	if err != nil {
+		return entities.Tag{}, err
+	}
+	tag.Name = name
+
+	created, err := uc.tagRepo.CreateTag(ctx, tag)
+	if err != nil {
+		return entities.Tag{}, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetTags returns the tags of the book ordered by name
+func (uc *TagUseCase) GetTags(ctx context.Context) ([]entities.Tag, error) {
+	tags, err := uc.tagRepo.GetAllTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (uc *TagUseCase) GetTag(ctx context.Context, id string) (entities.Tag, error) {
+	if id == "" {
+		return entities.Tag{}, fmt.Errorf("tag ID cannot be empty")
+	}
+
+	tag, err := uc.tagRepo.GetTagByID(ctx, id)
+	if err != nil {
+		return entities.Tag{}, fmt.Errorf("failed to get tag: %w", err)
+	}
+
+	return tag, nil
+}
+
+// UpdateTag renames a tag, on all its transactions at once
+func (uc *TagUseCase) UpdateTag(ctx context.Context, tag entities.Tag) (entities.Tag, error) {
+	if tag.ID == "" {
+		return entities.Tag{}, fmt.Errorf("tag ID cannot be empty")
+	}
+
+	name, err := normalizeTag(tag.Name)
+	if err != nil {
+		return entities.Tag{}, err
+	}
+	tag.Name = name
+
+	updated, err := uc.tagRepo.UpdateTag(ctx, tag)
+	if err != nil {
+		return entities.Tag{}, fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *TagUseCase) DeleteTag(ctx context.Context, id string) error {
+	if _, err := uc.GetTag(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.tagRepo.DeleteTag(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	return nil
+}
+
+// normalizeTag trims and lowercases a tag name, so Vacation2024 and
+// vacation2024 are the same tag
+func normalizeTag(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return "", fmt.Errorf("tag name cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	if len([]rune(name)) > entities.MaxTagLength {
+		return "", fmt.Errorf("tag %q is longer than %d characters: %w", name, entities.MaxTagLength, domain.ErrMalformedParameters)
+	}
+	if strings.Contains(name, ",") {
+		return "", fmt.Errorf("tag %q cannot contain commas: %w", name, domain.ErrMalformedParameters)
+	}
+	return name, nil
+}
+
+// normalizeTags normalizes the tags of a transaction, sorted and without
+// repeats. nil stays nil, so updates still leave the tags as they are.
+func normalizeTags(tags []string) ([]string, error) {
+	if tags == nil {
+		return nil, nil
+	}
+
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		name, err := normalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, name)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
@@ -0,0 +1,58 @@
+package finance
+
+import (
+	"context"
+	"testing"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagUseCase(t *testing.T) {
+	tagRepo := &mocks.TagRepositoryMock{
+		CreateTagFunc: func(ctx context.Context, tag entities.Tag) (entities.Tag, error) {
+			tag.ID = "tag-new"
+			return tag, nil
+		},
+		UpdateTagFunc: func(ctx context.Context, tag entities.Tag) (entities.Tag, error) {
+			return tag, nil
+		},
+	}
+	uc := NewTagUseCase(tagRepo)
+	ctx := context.Background()
+
+	created, err := uc.CreateTag(ctx, entities.Tag{Name: "  Vacation2024 "})
+	require.NoError(t, err)
+	assert.Equal(t, "vacation2024", created.Name)
+
+	renamed, err := uc.UpdateTag(ctx, entities.Tag{ID: "tag-new", Name: "Reimbursable"})
+	require.NoError(t, err)
+	assert.Equal(t, "reimbursable", renamed.Name)
+
+	for _, name := range []string{" ", "a, b", "this-tag-is-way-too-long-to-be-a-useful-label-anymore"} {
+		_, err := uc.CreateTag(ctx, entities.Tag{Name: name})
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters, name)
+	}
+	assert.Len(t, tagRepo.CreateTagCalls(), 1)
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tags, err := normalizeTags([]string{"Work", "reimbursable", " work "})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reimbursable", "work"}, tags)
+
+	tags, err = normalizeTags(nil)
+	require.NoError(t, err)
+	assert.Nil(t, tags, "nil keeps the tags of updated transactions")
+
+	tags, err = normalizeTags([]string{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{}, tags)
+
+	_, err = normalizeTags([]string{"work", ""})
+	assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+}
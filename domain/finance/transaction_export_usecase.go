@@ -0,0 +1,246 @@
+package finance
+
+import (
+	"bytes"
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"io"
+	"iter"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// TransactionExporter renders transactions to a file format, writing them as
+// they're read
+//
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/transaction_exporter.go . TransactionExporter
+type TransactionExporter interface {
+	ExportTransactions(w io.Writer, transactions iter.Seq2[entities.Transaction, error]) error
+}
+
+// transactionExportBatch is how many transactions an export reads at a time
+const transactionExportBatch = 500
+
+var transactionExportContentTypes = map[entities.TransactionExportFormat]string{
+	entities.TransactionExportFormatCSV:  "text/csv",
+	entities.TransactionExportFormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+type TransactionExportUseCase struct {
+	transactionRepo TransactionRepository
+	exportRepo      ExportRepository
+	exporters       map[entities.TransactionExportFormat]TransactionExporter
+	store           AttachmentStore
+	// signer is nil for the stores that can't sign URLs, whose exports are
+	// downloaded through the service
+	signer FileURLSigner
+	now    func() time.Time
+}
+
+func NewTransactionExportUseCase(exporters map[entities.TransactionExportFormat]TransactionExporter, transactionRepo TransactionRepository, exportRepo ExportRepository, store AttachmentStore, signer FileURLSigner) *TransactionExportUseCase {
+	return &TransactionExportUseCase{
+		transactionRepo: transactionRepo,
+		exportRepo:      exportRepo,
+		exporters:       exporters,
+		store:           store,
+		signer:          signer,
+		now:             time.Now,
+	}
+}
+
+// ExportTransactions exports every transaction matching filter, in the order
+// of sort like the transaction list. The first batch is read right away, so a
+// bad filter or sort fails before anything is written; the rest is read in
+// batches while the export is written.
+func (uc *TransactionExportUseCase) ExportTransactions(ctx context.Context, format entities.TransactionExportFormat, filter entities.TransactionFilter, sort []entities.SortField) (entities.TransactionExport, error) {
+	exporter, ok := uc.exporters[format]
+	if !ok {
+		formats := make([]string, 0, len(uc.exporters))
+		for _, format := range slices.Sorted(maps.Keys(uc.exporters)) {
+			formats = append(formats, string(format))
+		}
+		return entities.TransactionExport{}, fmt.Errorf("unknown export format %q, expected one of %s: %w", format, strings.Join(formats, ", "), domain.ErrMalformedParameters)
+	}
+
+	first, err := uc.transactionRepo.GetTransactionsWithDetails(ctx, filter, transactionExportBatch, 0, sort)
+	if err != nil {
+		return entities.TransactionExport{}, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	transactions := func(yield func(entities.Transaction, error) bool) {
+		batch := first
+		for offset := 0; ; offset += transactionExportBatch {
+			if offset > 0 {
+				var err error
+				batch, err = uc.transactionRepo.GetTransactionsWithDetails(ctx, filter, transactionExportBatch, offset, sort)
+				if err != nil {
+					yield(entities.Transaction{}, fmt.Errorf("failed to get transactions: %w", err))
+					return
+				}
+			}
+			for _, transaction := range batch {
+				if !yield(transaction, nil) {
+					return
+				}
+			}
+			if len(batch) < transactionExportBatch {
+				return
+			}
+		}
+	}
+
+	return entities.TransactionExport{
+		Filename:    fmt.Sprintf("transactions-%s.%s", uc.now().Format("2006-01-02"), format),
+		ContentType: transactionExportContentTypes[format],
+		Write: func(w io.Writer) error {
+			if err := exporter.ExportTransactions(w, transactions); err != nil {
+				return fmt.Errorf("failed to export transactions: %w", err)
+			}
+			return nil
+		},
+	}, nil
+}
+
+// CreateExport exports the transactions like ExportTransactions into the file
+// store, so the file is downloaded from there until it expires after
+// entities.ExportTTL
+func (uc *TransactionExportUseCase) CreateExport(ctx context.Context, format entities.TransactionExportFormat, filter entities.TransactionFilter, sort []entities.SortField) (entities.Export, error) {
+	uc.deleteExpiredExports(ctx)
+
+	export, err := uc.ExportTransactions(ctx, format, filter, sort)
+	if err != nil {
+		return entities.Export{}, err
+	}
+	var file bytes.Buffer
+	if err := export.Write(&file); err != nil {
+		return entities.Export{}, err
+	}
+
+	key := "exports/" + randomKey() + "." + string(format)
+	if err := uc.store.SaveFile(ctx, key, export.ContentType, file.Bytes()); err != nil {
+		return entities.Export{}, fmt.Errorf("failed to save export: %w", err)
+	}
+
+	created, err := uc.exportRepo.CreateExport(ctx, entities.Export{
+		Format:      format,
+		FileName:    export.Filename,
+		ContentType: export.ContentType,
+		Size:        int64(file.Len()),
+		StorageKey:  key,
+		ExpiresAt:   uc.now().Add(entities.ExportTTL),
+	})
+	if err != nil {
+		uc.deleteFile(ctx, key)
+		return entities.Export{}, fmt.Errorf("failed to create export: %w", err)
+	}
+
+	return uc.signExport(created)
+}
+
+// GetExports returns the exports not expired yet, the latest first, each
+// with a fresh signed URL
+func (uc *TransactionExportUseCase) GetExports(ctx context.Context) ([]entities.Export, error) {
+	uc.deleteExpiredExports(ctx)
+
+	exports, err := uc.exportRepo.GetExports(ctx, uc.now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exports: %w", err)
+	}
+	for i := range exports {
+		if exports[i], err = uc.signExport(exports[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return exports, nil
+}
+
+// GetExport returns an export with a fresh signed URL, domain.ErrNotFound
+// once it expired
+func (uc *TransactionExportUseCase) GetExport(ctx context.Context, id string) (entities.Export, error) {
+	export, err := uc.exportRepo.GetExportByID(ctx, id)
+	if err != nil {
+		return entities.Export{}, err
+	}
+	if !uc.now().Before(export.ExpiresAt) {
+		return entities.Export{}, fmt.Errorf("export %w", domain.ErrNotFound)
+	}
+
+	return uc.signExport(export)
+}
+
+// OpenExport returns the file of an export, for the stores that can't sign
+// URLs
+func (uc *TransactionExportUseCase) OpenExport(ctx context.Context, id string) (entities.Export, io.ReadCloser, error) {
+	export, err := uc.GetExport(ctx, id)
+	if err != nil {
+		return entities.Export{}, nil, err
+	}
+
+	file, err := uc.store.OpenFile(ctx, export.StorageKey)
+	if err != nil {
+		return entities.Export{}, nil, fmt.Errorf("failed to open export: %w", err)
+	}
+
+	return export, file, nil
+}
+
+// DeleteExport deletes an export and its file before it expires
+func (uc *TransactionExportUseCase) DeleteExport(ctx context.Context, id string) error {
+	export, err := uc.exportRepo.GetExportByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.exportRepo.DeleteExport(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete export: %w", err)
+	}
+
+	uc.deleteFile(ctx, export.StorageKey)
+	return nil
+}
+
+// signExport adds a URL downloading the export from the store for
+// entities.ExportURLTTL, or until the export expires if that's sooner
+func (uc *TransactionExportUseCase) signExport(export entities.Export) (entities.Export, error) {
+	if uc.signer == nil {
+		return export, nil
+	}
+
+	now := uc.now()
+	expires := min(export.ExpiresAt.Sub(now), entities.ExportURLTTL).Truncate(time.Second)
+	if expires < time.Second {
+		return export, nil
+	}
+	url, err := uc.signer.SignURL(export.StorageKey, export.FileName, expires)
+	if err != nil {
+		return entities.Export{}, fmt.Errorf("failed to sign export URL: %w", err)
+	}
+
+	export.URL = url
+	export.URLExpiresAt = now.Add(expires)
+	return export, nil
+}
+
+// deleteExpiredExports forgets the expired exports and deletes their files.
+// It runs as the exports are created and listed, failures only being logged.
+func (uc *TransactionExportUseCase) deleteExpiredExports(ctx context.Context) {
+	expired, err := uc.exportRepo.DeleteExpiredExports(ctx, uc.now())
+	if err != nil {
+		slog.Warn("failed to delete expired exports", "error", err)
+		return
+	}
+	for _, export := range expired {
+		uc.deleteFile(ctx, export.StorageKey)
+	}
+}
+
+func (uc *TransactionExportUseCase) deleteFile(ctx context.Context, key string) {
+	if err := uc.store.DeleteFile(ctx, key); err != nil {
+		slog.Warn("failed to delete export file", "key", key, "error", err)
+	}
+}
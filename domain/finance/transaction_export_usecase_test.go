@@ -0,0 +1,226 @@
+package finance
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"iter"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionExportUseCase(t *testing.T) {
+	ctx := context.Background()
+	filter := entities.TransactionFilter{ProjectID: "project-1"}
+	sort := []entities.SortField{{Field: "date", Desc: true}}
+
+	// 1200 transactions take three batches, and the batches from failFrom on
+	// fail
+	failFrom := -1
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionsWithDetailsFunc: func(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) ([]entities.Transaction, error) {
+			if failFrom >= 0 && offset >= failFrom {
+				return nil, errors.New("connection lost")
+			}
+			batch := make([]entities.Transaction, max(0, min(limit, 1200-offset)))
+			for i := range batch {
+				batch[i].Description = "transaction"
+			}
+			return batch, nil
+		},
+	}
+	// The exporter writes a line per transaction
+	exporter := &mocks.TransactionExporterMock{
+		ExportTransactionsFunc: func(w io.Writer, transactions iter.Seq2[entities.Transaction, error]) error {
+			for transaction, err := range transactions {
+				if err != nil {
+					return err
+				}
+				io.WriteString(w, transaction.Description+"\n")
+			}
+			return nil
+		},
+	}
+	uc := NewTransactionExportUseCase(map[entities.TransactionExportFormat]TransactionExporter{entities.TransactionExportFormatCSV: exporter}, transactionRepo, nil, nil, nil)
+	uc.now = func() time.Time { return time.Date(2025, time.March, 17, 9, 30, 0, 0, time.UTC) }
+
+	t.Run("every transaction in batches", func(t *testing.T) {
+		export, err := uc.ExportTransactions(ctx, entities.TransactionExportFormatCSV, filter, sort)
+		require.NoError(t, err)
+		assert.Equal(t, "transactions-2025-03-17.csv", export.Filename)
+		assert.Equal(t, "text/csv", export.ContentType)
+
+		var buf bytes.Buffer
+		require.NoError(t, export.Write(&buf))
+		assert.Equal(t, 1200, bytes.Count(buf.Bytes(), []byte("\n")))
+
+		calls := transactionRepo.GetTransactionsWithDetailsCalls()
+		require.Len(t, calls, 3)
+		assert.Equal(t, []int{0, 500, 1000}, []int{calls[0].Offset, calls[1].Offset, calls[2].Offset})
+		assert.Equal(t, filter, calls[2].Filter)
+		assert.Equal(t, sort, calls[2].Sort)
+	})
+
+	t.Run("a failing batch", func(t *testing.T) {
+		failFrom = 500
+		defer func() { failFrom = -1 }()
+
+		export, err := uc.ExportTransactions(ctx, entities.TransactionExportFormatCSV, filter, sort)
+		require.NoError(t, err)
+		assert.ErrorContains(t, export.Write(io.Discard), "connection lost")
+	})
+
+	t.Run("fails before writing", func(t *testing.T) {
+		failFrom = 0
+		defer func() { failFrom = -1 }()
+
+		_, err := uc.ExportTransactions(ctx, entities.TransactionExportFormatCSV, filter, sort)
+		assert.ErrorContains(t, err, "connection lost")
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, err := uc.ExportTransactions(ctx, "ods", filter, sort)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		assert.ErrorContains(t, err, "expected one of csv")
+	})
+}
+
+func TestTransactionExportUseCaseExports(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, time.March, 17, 9, 30, 0, 0, time.UTC)
+
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		GetTransactionsWithDetailsFunc: func(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) ([]entities.Transaction, error) {
+			return []entities.Transaction{{Description: "Market"}}, nil
+		},
+	}
+	exporter := &mocks.TransactionExporterMock{
+		ExportTransactionsFunc: func(w io.Writer, transactions iter.Seq2[entities.Transaction, error]) error {
+			for transaction := range transactions {
+				io.WriteString(w, transaction.Description+"\n")
+			}
+			return nil
+		},
+	}
+	files := map[string][]byte{}
+	store := &mocks.AttachmentStoreMock{
+		SaveFileFunc: func(ctx context.Context, key, contentType string, content []byte) error {
+			files[key] = content
+			return nil
+		},
+		OpenFileFunc: func(ctx context.Context, key string) (io.ReadCloser, error) {
+			content, ok := files[key]
+			if !ok {
+				return nil, domain.ErrNotFound
+			}
+			return io.NopCloser(bytes.NewReader(content)), nil
+		},
+		DeleteFileFunc: func(ctx context.Context, key string) error {
+			delete(files, key)
+			return nil
+		},
+	}
+	exports := map[string]entities.Export{}
+	exportRepo := &mocks.ExportRepositoryMock{
+		CreateExportFunc: func(ctx context.Context, export entities.Export) (entities.Export, error) {
+			export.ID = "export-1"
+			export.CreatedAt = now
+			exports[export.ID] = export
+			return export, nil
+		},
+		GetExportByIDFunc: func(ctx context.Context, id string) (entities.Export, error) {
+			export, ok := exports[id]
+			if !ok {
+				return entities.Export{}, domain.ErrNotFound
+			}
+			return export, nil
+		},
+		GetExportsFunc: func(ctx context.Context, now time.Time) ([]entities.Export, error) {
+			var list []entities.Export
+			for _, export := range exports {
+				if now.Before(export.ExpiresAt) {
+					list = append(list, export)
+				}
+			}
+			return list, nil
+		},
+		DeleteExpiredExportsFunc: func(ctx context.Context, now time.Time) ([]entities.Export, error) {
+			var expired []entities.Export
+			for id, export := range exports {
+				if !now.Before(export.ExpiresAt) {
+					expired = append(expired, export)
+					delete(exports, id)
+				}
+			}
+			return expired, nil
+		},
+		DeleteExportFunc: func(ctx context.Context, id string) error {
+			delete(exports, id)
+			return nil
+		},
+	}
+	signer := &mocks.FileURLSignerMock{
+		SignURLFunc: func(key, fileName string, ttl time.Duration) (string, error) {
+			return "https://bucket.example.com/" + key + "?expires=" + ttl.String(), nil
+		},
+	}
+
+	uc := NewTransactionExportUseCase(map[entities.TransactionExportFormat]TransactionExporter{entities.TransactionExportFormatCSV: exporter}, transactionRepo, exportRepo, store, signer)
+	uc.now = func() time.Time { return now }
+
+	export, err := uc.CreateExport(ctx, entities.TransactionExportFormatCSV, entities.TransactionFilter{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "transactions-2025-03-17.csv", export.FileName)
+	assert.Equal(t, int64(len("Market\n")), export.Size)
+	assert.Equal(t, now.Add(entities.ExportTTL), export.ExpiresAt)
+	assert.Equal(t, "Market\n", string(files[export.StorageKey]))
+	assert.Equal(t, "https://bucket.example.com/"+export.StorageKey+"?expires=15m0s", export.URL)
+	assert.Equal(t, now.Add(entities.ExportURLTTL), export.URLExpiresAt)
+
+	t.Run("list with fresh URLs", func(t *testing.T) {
+		now = now.Add(time.Hour)
+		list, err := uc.GetExports(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, now.Add(entities.ExportURLTTL), list[0].URLExpiresAt)
+	})
+
+	t.Run("the URL doesn't outlive the export", func(t *testing.T) {
+		now = export.ExpiresAt.Add(-time.Minute)
+		got, err := uc.GetExport(ctx, export.ID)
+		require.NoError(t, err)
+		assert.Equal(t, export.ExpiresAt, got.URLExpiresAt)
+	})
+
+	t.Run("without a signer the file is opened", func(t *testing.T) {
+		local := NewTransactionExportUseCase(uc.exporters, transactionRepo, exportRepo, store, nil)
+		local.now = uc.now
+
+		got, file, err := local.OpenExport(ctx, export.ID)
+		require.NoError(t, err)
+		defer file.Close()
+		assert.Empty(t, got.URL)
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.Equal(t, "Market\n", string(content))
+	})
+
+	t.Run("expired exports are deleted with their files", func(t *testing.T) {
+		now = export.ExpiresAt
+		_, err := uc.GetExport(ctx, export.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		list, err := uc.GetExports(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+		assert.Empty(t, files)
+	})
+}
@@ -9,15 +9,45 @@ import (
 //go:generate moq -skip-ensure -stub -pkg mocks -out mocks/transaction_repository.go . TransactionRepository
 type TransactionRepository interface {
 	CreateTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error)
+	// CreateTransactions creates all the transactions or, when one fails, none
+	CreateTransactions(ctx context.Context, transactions []entities.Transaction) ([]entities.Transaction, error)
+	// ApplyBulkOperations applies all the operations in order or, when one
+	// fails, none, returning a *domain.BulkError naming it. Deleted
+	// transactions are returned with their ID only.
+	ApplyBulkOperations(ctx context.Context, operations []entities.BulkOperation) ([]entities.Transaction, error)
 	GetTransactionByID(ctx context.Context, id string) (entities.Transaction, error)
 	GetAllTransactions(ctx context.Context) ([]entities.Transaction, error)
 	GetTransactionsByAccount(ctx context.Context, accountID string) ([]entities.Transaction, error)
 	GetTransactionsByCategory(ctx context.Context, categoryID string) ([]entities.Transaction, error)
 	GetTransactionsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]entities.Transaction, error)
 	GetTransactionsByAccountAndDateRange(ctx context.Context, accountID string, startDate, endDate time.Time) ([]entities.Transaction, error)
+	GetTransactionsByInstallmentPlan(ctx context.Context, planID string) ([]entities.Transaction, error)
+	GetTransactionsByProject(ctx context.Context, projectID string) ([]entities.Transaction, error)
 	UpdateTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error)
 	UpdateTransactionStatus(ctx context.Context, id string, status entities.TransactionStatus) (entities.Transaction, error)
+	// DeleteTransaction moves the transaction to the trash
 	DeleteTransaction(ctx context.Context, id string) error
+	GetDeletedTransactions(ctx context.Context, limit, offset int) ([]entities.Transaction, error)
+	CountDeletedTransactions(ctx context.Context) (int64, error)
+	// SearchTransactions lists the transactions whose description or payee has
+	// every word of query, the best matches first
+	SearchTransactions(ctx context.Context, query string, limit, offset int) ([]entities.Transaction, error)
+	CountSearchTransactions(ctx context.Context, query string) (int64, error)
+	// RestoreTransaction takes the transaction out of the trash
+	RestoreTransaction(ctx context.Context, id string) (entities.Transaction, error)
 	GetTransactionWithDetails(ctx context.Context, id string) (entities.Transaction, error)
-	GetTransactionsWithDetails(ctx context.Context, limit, offset int) ([]entities.Transaction, error)
+	GetTransactionsWithDetails(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) ([]entities.Transaction, error)
+	CountTransactions(ctx context.Context, filter entities.TransactionFilter) (int64, error)
+	// GetTransactionCube adds up the pending and cleared transactions from
+	// startDate to endDate by rows and cols, with the totals of each row, each
+	// column and the whole cube, per asset
+	GetTransactionCube(ctx context.Context, rows, cols entities.ReportDimension, startDate, endDate time.Time) ([]entities.CubeGroup, error)
+	// GetSpending adds up the pending and cleared expenses from startDate to
+	// endDate per asset, by category and by month when asked, ordered by
+	// asset, month and the largest total first
+	GetSpending(ctx context.Context, byCategory, byMonth bool, startDate, endDate time.Time) ([]entities.SpendingTotal, error)
+	// GetCashFlow adds up the pending and cleared income and expenses from
+	// startDate to endDate per asset and month, of the account accountID or
+	// of every account when empty, ordered by asset and month
+	GetCashFlow(ctx context.Context, accountID string, startDate, endDate time.Time) ([]entities.CashFlowTotal, error)
 }
@@ -0,0 +1,15 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/transaction_script_repository.go . TransactionScriptRepository
+type TransactionScriptRepository interface {
+	CreateTransactionScript(ctx context.Context, script entities.TransactionScript) (entities.TransactionScript, error)
+	GetTransactionScriptByID(ctx context.Context, id string) (entities.TransactionScript, error)
+	GetAllTransactionScripts(ctx context.Context) ([]entities.TransactionScript, error)
+	UpdateTransactionScript(ctx context.Context, script entities.TransactionScript) (entities.TransactionScript, error)
+	DeleteTransactionScript(ctx context.Context, id string) error
+}
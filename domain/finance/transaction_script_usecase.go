@@ -0,0 +1,149 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"strings"
+)
+
+// ScriptRunner runs the transaction scripts in a sandbox, bounded in time and
+// memory
+//
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/script_runner.go . ScriptRunner
+type ScriptRunner interface {
+	// Check compiles a script, refusing it when it doesn't define process
+	Check(script entities.TransactionScript) error
+	// Run runs a script on a transaction, returning the transaction as the
+	// script left it. A script rejecting the transaction fails with a
+	// *domain.RejectedError.
+	Run(ctx context.Context, script entities.TransactionScript, transaction entities.Transaction) (entities.Transaction, error)
+}
+
+// TransactionScriptUseCase manages the scripts of the book and, as a
+// RuleAction, runs them on the transactions created and imported
+type TransactionScriptUseCase struct {
+	scriptRepo TransactionScriptRepository
+	runner     ScriptRunner
+}
+
+func NewTransactionScriptUseCase(scriptRepo TransactionScriptRepository, runner ScriptRunner) *TransactionScriptUseCase {
+	return &TransactionScriptUseCase{
+		scriptRepo: scriptRepo,
+		runner:     runner,
+	}
+}
+
+func (uc *TransactionScriptUseCase) CreateTransactionScript(ctx context.Context, script entities.TransactionScript) (entities.TransactionScript, error) {
+	script, err := uc.validateTransactionScript(script)
+	if err != nil {
+		return entities.TransactionScript{}, err
+	}
+
+	created, err := uc.scriptRepo.CreateTransactionScript(ctx, script)
+	if err != nil {
+		return entities.TransactionScript{}, fmt.Errorf("failed to create script: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetTransactionScripts returns the scripts of the book in the order they run
+func (uc *TransactionScriptUseCase) GetTransactionScripts(ctx context.Context) ([]entities.TransactionScript, error) {
+	scripts, err := uc.scriptRepo.GetAllTransactionScripts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scripts: %w", err)
+	}
+
+	return scripts, nil
+}
+
+func (uc *TransactionScriptUseCase) GetTransactionScript(ctx context.Context, id string) (entities.TransactionScript, error) {
+	if id == "" {
+		return entities.TransactionScript{}, fmt.Errorf("script ID cannot be empty")
+	}
+
+	script, err := uc.scriptRepo.GetTransactionScriptByID(ctx, id)
+	if err != nil {
+		return entities.TransactionScript{}, fmt.Errorf("failed to get script: %w", err)
+	}
+
+	return script, nil
+}
+
+func (uc *TransactionScriptUseCase) UpdateTransactionScript(ctx context.Context, script entities.TransactionScript) (entities.TransactionScript, error) {
+	if script.ID == "" {
+		return entities.TransactionScript{}, fmt.Errorf("script ID cannot be empty")
+	}
+
+	script, err := uc.validateTransactionScript(script)
+	if err != nil {
+		return entities.TransactionScript{}, err
+	}
+
+	updated, err := uc.scriptRepo.UpdateTransactionScript(ctx, script)
+	if err != nil {
+		return entities.TransactionScript{}, fmt.Errorf("failed to update script: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *TransactionScriptUseCase) DeleteTransactionScript(ctx context.Context, id string) error {
+	if _, err := uc.GetTransactionScript(ctx, id); err != nil {
+		return err
+	}
+
+	if err := uc.scriptRepo.DeleteTransactionScript(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete script: %w", err)
+	}
+
+	return nil
+}
+
+// Apply runs the enabled scripts of the book on a transaction, each on what
+// the one before left. A script failing, like one running out of time, refuses
+// the transaction as much as one rejecting it, so nothing is stored without
+// going through the scripts.
+func (uc *TransactionScriptUseCase) Apply(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+	scripts, err := uc.GetTransactionScripts(ctx)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+
+	for _, script := range scripts {
+		if !script.Enabled {
+			continue
+		}
+
+		transaction, err = uc.runner.Run(ctx, script, transaction)
+		var rejected *domain.RejectedError
+		switch {
+		case errors.As(err, &rejected):
+			return entities.Transaction{}, err
+		case err != nil:
+			return entities.Transaction{}, fmt.Errorf("script %s failed: %v: %w", script.Name, err, domain.ErrMalformedParameters)
+		}
+	}
+
+	return transaction, nil
+}
+
+func (uc *TransactionScriptUseCase) validateTransactionScript(script entities.TransactionScript) (entities.TransactionScript, error) {
+	script.Name = strings.TrimSpace(script.Name)
+	if script.Name == "" {
+		return entities.TransactionScript{}, fmt.Errorf("script name cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	if strings.TrimSpace(script.Source) == "" {
+		return entities.TransactionScript{}, fmt.Errorf("script source cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+	if len(script.Source) > entities.MaxTransactionScriptBytes {
+		return entities.TransactionScript{}, fmt.Errorf("script source is longer than %d bytes: %w", entities.MaxTransactionScriptBytes, domain.ErrMalformedParameters)
+	}
+	if err := uc.runner.Check(script); err != nil {
+		return entities.TransactionScript{}, fmt.Errorf("invalid script: %v: %w", err, domain.ErrMalformedParameters)
+	}
+	return script, nil
+}
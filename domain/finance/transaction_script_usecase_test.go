@@ -0,0 +1,112 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionScriptUseCaseValidation(t *testing.T) {
+	runner := &mocks.ScriptRunnerMock{
+		CheckFunc: func(script entities.TransactionScript) error {
+			if !strings.Contains(script.Source, "def process") {
+				return errors.New("the script must define process(tx)")
+			}
+			return nil
+		},
+	}
+	scriptRepo := &mocks.TransactionScriptRepositoryMock{
+		CreateTransactionScriptFunc: func(ctx context.Context, script entities.TransactionScript) (entities.TransactionScript, error) {
+			script.ID = "scr-new"
+			return script, nil
+		},
+	}
+	uc := NewTransactionScriptUseCase(scriptRepo, runner)
+	ctx := context.Background()
+
+	created, err := uc.CreateTransactionScript(ctx, entities.TransactionScript{Name: "  rides ", Source: "def process(tx):\n    pass\n", Enabled: true})
+	require.NoError(t, err)
+	assert.Equal(t, "rides", created.Name)
+
+	tests := []struct {
+		name    string
+		script  entities.TransactionScript
+		wantErr string
+	}{
+		{"no name", entities.TransactionScript{Source: "def process(tx):\n    pass\n"}, "script name cannot be empty"},
+		{"no source", entities.TransactionScript{Name: "rides", Source: "\n"}, "script source cannot be empty"},
+		{"too long", entities.TransactionScript{Name: "rides", Source: "def process(tx):\n" + strings.Repeat("    pass\n", 2000)}, "script source is longer than 16384 bytes"},
+		{"no process", entities.TransactionScript{Name: "rides", Source: "x = 1\n"}, "invalid script: the script must define process(tx)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.CreateTransactionScript(ctx, tt.script)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+	assert.Len(t, scriptRepo.CreateTransactionScriptCalls(), 1)
+}
+
+func TestTransactionScriptUseCaseApply(t *testing.T) {
+	scripts := []entities.TransactionScript{
+		{ID: "scr-1", Name: "1-rides", Enabled: true},
+		{ID: "scr-2", Name: "2-off", Enabled: false},
+		{ID: "scr-3", Name: "3-describe", Enabled: true},
+	}
+	scriptRepo := &mocks.TransactionScriptRepositoryMock{
+		GetAllTransactionScriptsFunc: func(ctx context.Context) ([]entities.TransactionScript, error) {
+			return scripts, nil
+		},
+	}
+	runner := &mocks.ScriptRunnerMock{
+		RunFunc: func(ctx context.Context, script entities.TransactionScript, transaction entities.Transaction) (entities.Transaction, error) {
+			switch script.Name {
+			case "1-rides":
+				if transaction.Payee == "Blocked" {
+					return entities.Transaction{}, &domain.RejectedError{Script: script.Name, Reason: "blocked payee"}
+				}
+				if transaction.Payee == "Loop" {
+					return entities.Transaction{}, errors.New("Starlark computation cancelled: too many steps")
+				}
+				transaction.CategoryID = "cat-transport"
+			case "3-describe":
+				transaction.Description = transaction.CategoryID + " ride"
+			default:
+				t.Errorf("disabled script %s ran", script.Name)
+			}
+			return transaction, nil
+		},
+	}
+	uc := NewTransactionScriptUseCase(scriptRepo, runner)
+	ctx := context.Background()
+
+	t.Run("runs the enabled scripts in order", func(t *testing.T) {
+		got, err := uc.Apply(ctx, entities.Transaction{Payee: "Uber"})
+		require.NoError(t, err)
+		assert.Equal(t, "cat-transport", got.CategoryID)
+		assert.Equal(t, "cat-transport ride", got.Description)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		_, err := uc.Apply(ctx, entities.Transaction{Payee: "Blocked"})
+		var rejected *domain.RejectedError
+		require.ErrorAs(t, err, &rejected)
+		assert.Equal(t, "1-rides", rejected.Script)
+		assert.EqualError(t, err, "rejected by script 1-rides: blocked payee")
+	})
+
+	t.Run("failed script refuses the transaction", func(t *testing.T) {
+		_, err := uc.Apply(ctx, entities.Transaction{Payee: "Loop"})
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		assert.EqualError(t, err, "script 1-rides failed: Starlark computation cancelled: too many steps: malformed parameters")
+	})
+}
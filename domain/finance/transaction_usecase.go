@@ -2,9 +2,11 @@ package finance
 
 import (
 	"context"
+	"finance/domain"
 	"finance/domain/entities"
 	"fmt"
 	"math/big"
+	"slices"
 	"strings"
 	"time"
 
@@ -12,34 +14,99 @@ import (
 )
 
 type TransactionUseCase struct {
-	transactionRepo TransactionRepository
-	accountRepo     AccountRepository
-	categoryRepo    CategoryRepository
-	balanceRepo     BalanceRepository
+	transactionRepo  TransactionRepository
+	accountRepo      AccountRepository
+	categoryRepo     CategoryRepository
+	balanceRepo      BalanceRepository
+	userSettingsRepo UserSettingsRepository
+	installmentRepo  InstallmentRepository
+	quotas           *QuotaGuard
+	ruleActions      []RuleAction
+	now              func() time.Time
 }
 
-func NewTransactionUseCase(transactionRepo TransactionRepository, accountRepo AccountRepository, categoryRepo CategoryRepository, balanceRepo BalanceRepository) *TransactionUseCase {
+// RuleAction changes a transaction before it's created or imported, like
+// filing it under a category by its payee. Returning an error refuses the
+// transaction, a *domain.RejectedError leaves the line out of imports.
+//
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/rule_action.go . RuleAction
+type RuleAction interface {
+	Apply(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error)
+}
+
+// applyRuleActions applies the actions to a transaction, in order
+func applyRuleActions(ctx context.Context, actions []RuleAction, transaction entities.Transaction) (entities.Transaction, error) {
+	for _, action := range actions {
+		var err error
+		if transaction, err = action.Apply(ctx, transaction); err != nil {
+			return entities.Transaction{}, fmt.Errorf("failed to apply rule action: %w", err)
+		}
+	}
+	return transaction, nil
+}
+
+func NewTransactionUseCase(transactionRepo TransactionRepository, accountRepo AccountRepository, categoryRepo CategoryRepository, balanceRepo BalanceRepository, userSettingsRepo UserSettingsRepository, installmentRepo InstallmentRepository, quotas *QuotaGuard, ruleActions []RuleAction) *TransactionUseCase {
 	return &TransactionUseCase{
-		transactionRepo: transactionRepo,
-		accountRepo:     accountRepo,
-		categoryRepo:    categoryRepo,
-		balanceRepo:     balanceRepo,
+		transactionRepo:  transactionRepo,
+		accountRepo:      accountRepo,
+		categoryRepo:     categoryRepo,
+		balanceRepo:      balanceRepo,
+		userSettingsRepo: userSettingsRepo,
+		installmentRepo:  installmentRepo,
+		quotas:           quotas,
+		ruleActions:      ruleActions,
+		now:              time.Now,
 	}
 }
 
+// CreateTransaction creates a transaction once the rule actions are applied
+// to it, in order, so what they change is validated like the rest
 func (uc *TransactionUseCase) CreateTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+	transaction, account, category, err := uc.prepareTransaction(ctx, transaction)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+	if err := uc.quotas.CheckTransactions(ctx, 1); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	createdTransaction, err := uc.transactionRepo.CreateTransaction(ctx, transaction)
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	// The database trigger will automatically update the balance
+	// But we can also refresh it manually to ensure consistency
+	_ = uc.balanceRepo.RefreshAccountBalance(ctx, transaction.AccountID)
+
+	// Attach the already loaded relations so callers don't need another lookup
+	createdTransaction.Account = &account
+	createdTransaction.Category = &category
+
+	return createdTransaction, nil
+}
+
+// prepareTransaction applies the rule actions to a new transaction and
+// validates it, signing its amount in the asset of its account and filling in
+// the defaults. It returns the account and category of the transaction.
+func (uc *TransactionUseCase) prepareTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, entities.Account, entities.Category, error) {
+	transaction, err := applyRuleActions(ctx, uc.ruleActions, transaction)
+	if err != nil {
+		return entities.Transaction{}, entities.Account{}, entities.Category{}, err
+	}
+
 	// Validate input
 	if err := uc.validateTransaction(transaction); err != nil {
-		return entities.Transaction{}, err
+		return entities.Transaction{}, entities.Account{}, entities.Category{}, err
+	}
+	if transaction.Tags, err = normalizeTags(transaction.Tags); err != nil {
+		return entities.Transaction{}, entities.Account{}, entities.Category{}, err
 	}
 
 	// Verify account exists
-	account, err := uc.accountRepo.GetAccountByID(ctx, transaction.AccountID)
+	account, err := ownAccount(ctx, uc.accountRepo, transaction.AccountID)
 	if err != nil {
-		return entities.Transaction{}, fmt.Errorf("failed to get account: %w", err)
-	}
-	if account.ID == "" {
-		return entities.Transaction{}, fmt.Errorf("account not found")
+		return entities.Transaction{}, entities.Account{}, entities.Category{}, fmt.Errorf("failed to get account: %w", err)
 	}
 
 	// Convert the transaction amount to the correct asset based on the account
@@ -47,34 +114,69 @@ func (uc *TransactionUseCase) CreateTransaction(ctx context.Context, transaction
 	transaction = uc.convertTransactionToAccountAsset(transaction, account)
 
 	// Verify category exists
-	category, err := uc.categoryRepo.GetCategoryByID(ctx, transaction.CategoryID)
+	category, err := ownCategory(ctx, uc.categoryRepo, transaction.CategoryID)
 	if err != nil {
-		return entities.Transaction{}, fmt.Errorf("failed to get category: %w", err)
-	}
-	if category.ID == "" {
-		return entities.Transaction{}, fmt.Errorf("category not found")
+		return entities.Transaction{}, entities.Account{}, entities.Category{}, fmt.Errorf("failed to get category: %w", err)
 	}
 
-	// Set default status if not provided
-	if transaction.Status == "" {
-		transaction.Status = entities.TransactionStatusCleared
+	// Business logic for transaction amounts based on category and account type
+	transaction = uc.adjustTransactionAmount(transaction, account, category)
+
+	transaction, err = uc.applyTransactionDefaults(ctx, transaction, account)
+	if err != nil {
+		return entities.Transaction{}, entities.Account{}, entities.Category{}, err
 	}
+	return transaction, account, category, nil
+}
 
-	// Set default date if not provided
-	if transaction.Date.IsZero() {
-		transaction.Date = time.Now()
+// applyTransactionDefaults fills in the status and date left empty with the user
+// preferences. The status can be set per account type, e.g. to keep credit card
+// entries pending until the statement closes, and the date defaults to today in the
+// user timezone rather than the server one.
+func (uc *TransactionUseCase) applyTransactionDefaults(ctx context.Context, transaction entities.Transaction, account entities.Account) (entities.Transaction, error) {
+	if transaction.Status != "" && !transaction.Date.IsZero() {
+		return transaction, nil
 	}
 
-	createdTransaction, err := uc.transactionRepo.CreateTransaction(ctx, transaction)
+	stored, err := uc.userSettingsRepo.GetAllUserSettings(ctx)
 	if err != nil {
-		return entities.Transaction{}, fmt.Errorf("failed to create transaction: %w", err)
+		return entities.Transaction{}, fmt.Errorf("failed to get user settings: %w", err)
 	}
 
-	// The database trigger will automatically update the balance
-	// But we can also refresh it manually to ensure consistency
-	_ = uc.balanceRepo.RefreshAccountBalance(ctx, transaction.AccountID)
+	settings := make(map[entities.UserSettingKey]string, len(stored))
+	for _, setting := range stored {
+		settings[setting.Key] = setting.Value
+	}
+	setting := func(keys ...entities.UserSettingKey) string {
+		for _, key := range keys {
+			if value, ok := settings[key]; ok {
+				return value
+			}
+		}
+		return entities.DefaultUserSettings[keys[len(keys)-1]]
+	}
 
-	return createdTransaction, nil
+	if transaction.Status == "" {
+		transaction.Status = entities.TransactionStatus(setting(
+			entities.DefaultTransactionStatusKey(account.Type),
+			entities.UserSettingDefaultTransactionStatus,
+		))
+	}
+
+	if transaction.Date.IsZero() {
+		if setting(entities.UserSettingDefaultTransactionDate) == entities.DefaultTransactionDateRequired {
+			return entities.Transaction{}, fmt.Errorf("transaction date is required")
+		}
+
+		location, err := time.LoadLocation(setting(entities.UserSettingTimezone))
+		if err != nil {
+			location = time.Local
+		}
+		now := uc.now().In(location)
+		transaction.Date = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	return transaction, nil
 }
 
 func (uc *TransactionUseCase) GetTransactionByID(ctx context.Context, id string) (entities.Transaction, error) {
@@ -82,7 +184,7 @@ func (uc *TransactionUseCase) GetTransactionByID(ctx context.Context, id string)
 		return entities.Transaction{}, fmt.Errorf("transaction ID cannot be empty")
 	}
 
-	transaction, err := uc.transactionRepo.GetTransactionByID(ctx, id)
+	transaction, err := ownTransaction(ctx, uc.transactionRepo, id)
 	if err != nil {
 		return entities.Transaction{}, fmt.Errorf("failed to get transaction: %w", err)
 	}
@@ -99,6 +201,9 @@ func (uc *TransactionUseCase) GetTransactionWithDetails(ctx context.Context, id
 	if err != nil {
 		return entities.Transaction{}, fmt.Errorf("failed to get transaction with details: %w", err)
 	}
+	if err := checkOwner(ctx, transaction.OwnerID, "transaction"); err != nil {
+		return entities.Transaction{}, err
+	}
 
 	return transaction, nil
 }
@@ -112,7 +217,9 @@ func (uc *TransactionUseCase) GetAllTransactions(ctx context.Context) ([]entitie
 	return transactions, nil
 }
 
-func (uc *TransactionUseCase) GetTransactionsWithDetails(ctx context.Context, limit, offset int) ([]entities.Transaction, error) {
+// GetTransactionsWithDetails lists the transactions matching filter with their
+// account and category
+func (uc *TransactionUseCase) GetTransactionsWithDetails(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) ([]entities.Transaction, error) {
 	if limit <= 0 {
 		limit = 50 // Default limit
 	}
@@ -120,7 +227,7 @@ func (uc *TransactionUseCase) GetTransactionsWithDetails(ctx context.Context, li
 		offset = 0
 	}
 
-	transactions, err := uc.transactionRepo.GetTransactionsWithDetails(ctx, limit, offset)
+	transactions, err := uc.transactionRepo.GetTransactionsWithDetails(ctx, filter, limit, offset, sort)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get transactions with details: %w", err)
 	}
@@ -128,6 +235,23 @@ func (uc *TransactionUseCase) GetTransactionsWithDetails(ctx context.Context, li
 	return transactions, nil
 }
 
+// GetTransactionsPage returns limit transactions matching filter after the
+// first offset ones, with their account and category, and the number of
+// transactions matching it
+func (uc *TransactionUseCase) GetTransactionsPage(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error) {
+	transactions, err := uc.transactionRepo.GetTransactionsWithDetails(ctx, filter, limit, offset, sort)
+	if err != nil {
+		return entities.Page[entities.Transaction]{}, fmt.Errorf("failed to get transactions with details: %w", err)
+	}
+
+	total, err := uc.transactionRepo.CountTransactions(ctx, filter)
+	if err != nil {
+		return entities.Page[entities.Transaction]{}, fmt.Errorf("failed to count transactions: %w", err)
+	}
+
+	return entities.Page[entities.Transaction]{Items: transactions, Total: total, Limit: limit, Offset: offset}, nil
+}
+
 func (uc *TransactionUseCase) GetTransactionsByAccount(ctx context.Context, accountID string) ([]entities.Transaction, error) {
 	if accountID == "" {
 		return nil, fmt.Errorf("account ID cannot be empty")
@@ -168,39 +292,41 @@ func (uc *TransactionUseCase) UpdateTransaction(ctx context.Context, transaction
 		return entities.Transaction{}, fmt.Errorf("transaction ID cannot be empty")
 	}
 
+	tags, err := normalizeTags(transaction.Tags)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+	transaction.Tags = tags
+
 	// Get the existing transaction to compare account changes
-	existingTransaction, err := uc.transactionRepo.GetTransactionByID(ctx, transaction.ID)
+	existingTransaction, err := ownTransaction(ctx, uc.transactionRepo, transaction.ID)
 	if err != nil {
 		return entities.Transaction{}, fmt.Errorf("failed to get existing transaction: %w", err)
 	}
 
-	if existingTransaction.ID == "" {
-		return entities.Transaction{}, fmt.Errorf("transaction not found")
+	// Finalized transactions may already be in balances and statements, going back
+	// to a draft would silently drop them from there
+	if transaction.Status == entities.TransactionStatusDraft && existingTransaction.Status != entities.TransactionStatusDraft {
+		return entities.Transaction{}, fmt.Errorf("cannot turn a %s transaction back into a draft: %w", existingTransaction.Status, domain.ErrConflict)
 	}
 
 	// Verify account exists
-	account, err := uc.accountRepo.GetAccountByID(ctx, transaction.AccountID)
+	account, err := ownAccount(ctx, uc.accountRepo, transaction.AccountID)
 	if err != nil {
 		return entities.Transaction{}, fmt.Errorf("failed to get account: %w", err)
 	}
-	if account.ID == "" {
-		return entities.Transaction{}, fmt.Errorf("account not found")
-	}
 
 	// Convert the transaction amount to the correct asset based on the account
 	transaction = uc.convertTransactionToAccountAsset(transaction, account)
 
 	// Verify category exists
-	category, err := uc.categoryRepo.GetCategoryByID(ctx, transaction.CategoryID)
+	category, err := ownCategory(ctx, uc.categoryRepo, transaction.CategoryID)
 	if err != nil {
 		return entities.Transaction{}, fmt.Errorf("failed to get category: %w", err)
 	}
-	if category.ID == "" {
-		return entities.Transaction{}, fmt.Errorf("category not found")
-	}
 
-	// Business logic for transaction amounts based on category type
-	transaction = uc.adjustTransactionAmount(transaction, category)
+	// Business logic for transaction amounts based on category and account type
+	transaction = uc.adjustTransactionAmount(transaction, account, category)
 
 	updatedTransaction, err := uc.transactionRepo.UpdateTransaction(ctx, transaction)
 	if err != nil {
@@ -213,24 +339,26 @@ func (uc *TransactionUseCase) UpdateTransaction(ctx context.Context, transaction
 		_ = uc.balanceRepo.RefreshAccountBalance(ctx, existingTransaction.AccountID)
 	}
 
+	// Attach the already loaded relations so callers don't need another lookup
+	updatedTransaction.Account = &account
+	updatedTransaction.Category = &category
+
 	return updatedTransaction, nil
 }
 
+// DeleteTransaction moves a transaction to the trash, taking it out of the
+// balance of its account until it's restored
 func (uc *TransactionUseCase) DeleteTransaction(ctx context.Context, id string) error {
 	if id == "" {
 		return fmt.Errorf("transaction ID cannot be empty")
 	}
 
 	// Get the transaction to know which account balance to refresh
-	transaction, err := uc.transactionRepo.GetTransactionByID(ctx, id)
+	transaction, err := ownTransaction(ctx, uc.transactionRepo, id)
 	if err != nil {
 		return fmt.Errorf("failed to get transaction: %w", err)
 	}
 
-	if transaction.ID == "" {
-		return fmt.Errorf("transaction not found")
-	}
-
 	err = uc.transactionRepo.DeleteTransaction(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete transaction: %w", err)
@@ -242,6 +370,385 @@ func (uc *TransactionUseCase) DeleteTransaction(ctx context.Context, id string)
 	return nil
 }
 
+// GetTrashPage returns limit transactions of the trash after the first offset
+// ones, the latest deleted first, and the number of transactions in it
+func (uc *TransactionUseCase) GetTrashPage(ctx context.Context, limit, offset int) (entities.Page[entities.Transaction], error) {
+	transactions, err := uc.transactionRepo.GetDeletedTransactions(ctx, limit, offset)
+	if err != nil {
+		return entities.Page[entities.Transaction]{}, fmt.Errorf("failed to get deleted transactions: %w", err)
+	}
+
+	total, err := uc.transactionRepo.CountDeletedTransactions(ctx)
+	if err != nil {
+		return entities.Page[entities.Transaction]{}, fmt.Errorf("failed to count deleted transactions: %w", err)
+	}
+
+	return entities.Page[entities.Transaction]{Items: transactions, Total: total, Limit: limit, Offset: offset}, nil
+}
+
+// SearchTransactionsPage returns limit transactions matching query after the
+// first offset, the best matches first, with their account and category.
+// Every word of query has to start a word of the description or the payee of
+// a transaction.
+func (uc *TransactionUseCase) SearchTransactionsPage(ctx context.Context, query string, limit, offset int) (entities.Page[entities.Transaction], error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return entities.Page[entities.Transaction]{}, fmt.Errorf("search query cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+
+	transactions, err := uc.transactionRepo.SearchTransactions(ctx, query, limit, offset)
+	if err != nil {
+		return entities.Page[entities.Transaction]{}, fmt.Errorf("failed to search transactions: %w", err)
+	}
+
+	total, err := uc.transactionRepo.CountSearchTransactions(ctx, query)
+	if err != nil {
+		return entities.Page[entities.Transaction]{}, fmt.Errorf("failed to count transactions: %w", err)
+	}
+
+	// Results are listed like the transactions, with their account and
+	// category
+	accounts, err := uc.accountRepo.GetAllAccounts(ctx, nil)
+	if err != nil {
+		return entities.Page[entities.Transaction]{}, fmt.Errorf("failed to get accounts: %w", err)
+	}
+	categories, err := uc.categoryRepo.GetAllCategories(ctx, nil)
+	if err != nil {
+		return entities.Page[entities.Transaction]{}, fmt.Errorf("failed to get categories: %w", err)
+	}
+	for i, transaction := range transactions {
+		if j := slices.IndexFunc(accounts, func(a entities.Account) bool { return a.ID == transaction.AccountID }); j >= 0 {
+			transactions[i].Account = &accounts[j]
+		}
+		if j := slices.IndexFunc(categories, func(c entities.Category) bool { return c.ID == transaction.CategoryID }); j >= 0 {
+			transactions[i].Category = &categories[j]
+		}
+	}
+
+	return entities.Page[entities.Transaction]{Items: transactions, Total: total, Limit: limit, Offset: offset}, nil
+}
+
+// RestoreTransaction takes a transaction out of the trash, putting it back in
+// the balance of its account
+func (uc *TransactionUseCase) RestoreTransaction(ctx context.Context, id string) (entities.Transaction, error) {
+	if id == "" {
+		return entities.Transaction{}, fmt.Errorf("transaction ID cannot be empty")
+	}
+
+	restored, err := uc.transactionRepo.RestoreTransaction(ctx, id)
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to restore transaction: %w", err)
+	}
+
+	_ = uc.balanceRepo.RefreshAccountBalance(ctx, restored.AccountID)
+
+	return restored, nil
+}
+
+// DuplicateTransaction creates a copy of a transaction on the given date, for
+// expenses that repeat now and then. The copy goes through the same rules as a new
+// transaction, so a zero date and the status come from the user preferences.
+func (uc *TransactionUseCase) DuplicateTransaction(ctx context.Context, id string, date time.Time) (entities.Transaction, error) {
+	if id == "" {
+		return entities.Transaction{}, fmt.Errorf("transaction ID cannot be empty")
+	}
+
+	original, err := ownTransaction(ctx, uc.transactionRepo, id)
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	return uc.CreateTransaction(ctx, entities.Transaction{
+		AccountID:   original.AccountID,
+		CategoryID:  original.CategoryID,
+		Monetary:    original.Monetary,
+		Description: original.Description,
+		Payee:       original.Payee,
+		Date:        date,
+		ProjectID:   original.ProjectID,
+		Tags:        original.Tags,
+	})
+}
+
+// CreateInstallmentPurchase spreads a purchase paid in installments
+// (parcelamento) over one transaction a month starting on the purchase date, so
+// each installment lands on the next card statement. The amount is the total of
+// the purchase, split evenly with the leftover cents on the first installments.
+// The installments are linked to an installment plan with their position, and
+// descriptions are numbered like "TV (3/12)". Installments after the first are
+// pending until they are charged.
+func (uc *TransactionUseCase) CreateInstallmentPurchase(ctx context.Context, transaction entities.Transaction, installments int) (entities.InstallmentPlan, error) {
+	if installments < 2 || installments > entities.MaxInstallments {
+		return entities.InstallmentPlan{}, fmt.Errorf("installments must be between 2 and %d, got %d: %w", entities.MaxInstallments, installments, domain.ErrMalformedParameters)
+	}
+
+	if err := uc.validateTransaction(transaction); err != nil {
+		return entities.InstallmentPlan{}, err
+	}
+	tags, err := normalizeTags(transaction.Tags)
+	if err != nil {
+		return entities.InstallmentPlan{}, err
+	}
+	transaction.Tags = tags
+
+	account, err := ownAccount(ctx, uc.accountRepo, transaction.AccountID)
+	if err != nil {
+		return entities.InstallmentPlan{}, fmt.Errorf("failed to get account: %w", err)
+	}
+	transaction = uc.convertTransactionToAccountAsset(transaction, account)
+
+	category, err := ownCategory(ctx, uc.categoryRepo, transaction.CategoryID)
+	if err != nil {
+		return entities.InstallmentPlan{}, fmt.Errorf("failed to get category: %w", err)
+	}
+	transaction = uc.adjustTransactionAmount(transaction, account, category)
+
+	transaction, err = uc.applyTransactionDefaults(ctx, transaction, account)
+	if err != nil {
+		return entities.InstallmentPlan{}, err
+	}
+	if err := uc.quotas.CheckTransactions(ctx, installments); err != nil {
+		return entities.InstallmentPlan{}, err
+	}
+
+	amounts, err := entities.MoneyOf(transaction.Monetary).Split(installments)
+	if err != nil {
+		return entities.InstallmentPlan{}, err
+	}
+
+	plan, err := uc.installmentRepo.CreateInstallmentPlan(ctx, entities.InstallmentPlan{
+		AccountID:   transaction.AccountID,
+		Description: transaction.Description,
+		Amount:      transaction.Monetary,
+		Count:       installments,
+		FirstDate:   transaction.Date,
+	})
+	if err != nil {
+		return entities.InstallmentPlan{}, fmt.Errorf("failed to create installment plan: %w", err)
+	}
+
+	plan.Installments = make([]entities.Transaction, 0, installments)
+	for i, amount := range amounts {
+		installment := transaction
+		installment.Monetary = amount.Monetary()
+		installment.Description = fmt.Sprintf("%s (%d/%d)", transaction.Description, i+1, installments)
+		installment.Date = addMonths(transaction.Date, i)
+		installment.InstallmentPlanID = plan.ID
+		installment.InstallmentNumber = i + 1
+		installment.InstallmentCount = installments
+		if i > 0 && transaction.Status == entities.TransactionStatusCleared {
+			installment.Status = entities.TransactionStatusPending
+		}
+
+		installment, err = uc.transactionRepo.CreateTransaction(ctx, installment)
+		if err != nil {
+			// Don't leave part of the purchase behind, deleting the plan
+			// moves the installments already created to the trash
+			_ = uc.installmentRepo.DeleteInstallmentPlan(ctx, plan.ID)
+			_ = uc.balanceRepo.RefreshAccountBalance(ctx, transaction.AccountID)
+			return entities.InstallmentPlan{}, fmt.Errorf("failed to create installment %d/%d: %w", i+1, installments, err)
+		}
+		installment.Account = &account
+		installment.Category = &category
+		plan.Installments = append(plan.Installments, installment)
+	}
+
+	_ = uc.balanceRepo.RefreshAccountBalance(ctx, transaction.AccountID)
+
+	return withInstallmentProgress(plan, uc.now()), nil
+}
+
+// FinalizeTransaction moves a draft to the given status so it starts counting in
+// balances and reports. An empty status falls back to the user preferences, like
+// a new transaction would.
+func (uc *TransactionUseCase) FinalizeTransaction(ctx context.Context, id string, status entities.TransactionStatus) (entities.Transaction, error) {
+	if id == "" {
+		return entities.Transaction{}, fmt.Errorf("transaction ID cannot be empty")
+	}
+
+	switch status {
+	case "", entities.TransactionStatusPending, entities.TransactionStatusCleared:
+	default:
+		return entities.Transaction{}, fmt.Errorf("a draft can only be finalized as pending or cleared, got %s: %w", status, domain.ErrMalformedParameters)
+	}
+
+	draft, err := ownTransaction(ctx, uc.transactionRepo, id)
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	if draft.Status != entities.TransactionStatusDraft {
+		return entities.Transaction{}, fmt.Errorf("transaction is %s, only drafts can be finalized: %w", draft.Status, domain.ErrConflict)
+	}
+
+	account, err := ownAccount(ctx, uc.accountRepo, draft.AccountID)
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	draft.Status = status
+	draft, err = uc.applyTransactionDefaults(ctx, draft, account)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+
+	finalized, err := uc.transactionRepo.UpdateTransactionStatus(ctx, id, draft.Status)
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to finalize transaction: %w", err)
+	}
+
+	_ = uc.balanceRepo.RefreshAccountBalance(ctx, finalized.AccountID)
+
+	// Attach the relations like create and update do, the category lookup is only
+	// needed for the response
+	finalized.Account = &account
+	if category, err := ownCategory(ctx, uc.categoryRepo, finalized.CategoryID); err == nil {
+		finalized.Category = &category
+	}
+
+	return finalized, nil
+}
+
+// DiscardDraft deletes a draft. It refuses finalized transactions so a stale
+// quick-add screen can't remove one by mistake.
+func (uc *TransactionUseCase) DiscardDraft(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("transaction ID cannot be empty")
+	}
+
+	draft, err := ownTransaction(ctx, uc.transactionRepo, id)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	if draft.Status != entities.TransactionStatusDraft {
+		return fmt.Errorf("transaction is %s, only drafts can be discarded: %w", draft.Status, domain.ErrConflict)
+	}
+
+	// Drafts are not in the balance, so there is nothing to refresh
+	if err := uc.transactionRepo.DeleteTransaction(ctx, id); err != nil {
+		return fmt.Errorf("failed to discard draft: %w", err)
+	}
+
+	return nil
+}
+
+// BulkTransactions applies the operations in order, all of them or none.
+// Every operation is checked before any is applied, and the ones that would
+// fail are returned together in a *domain.BulkError. Creates are checked like
+// CreateTransaction, statuses can't go back to draft like UpdateTransaction,
+// and deletes move the transactions to the trash.
+func (uc *TransactionUseCase) BulkTransactions(ctx context.Context, operations []entities.BulkOperation) ([]entities.BulkResult, error) {
+	if len(operations) == 0 || len(operations) > entities.MaxBulkOperations {
+		return nil, fmt.Errorf("a bulk request takes 1 to %d operations, got %d: %w", entities.MaxBulkOperations, len(operations), domain.ErrMalformedParameters)
+	}
+
+	// The prepared transactions are created in place of the ones given
+	operations = slices.Clone(operations)
+	accounts := map[string]entities.Account{}
+	categories := map[string]entities.Category{}
+	// The transaction each operation creates, or changes as it was
+	transactions := make([]entities.Transaction, len(operations))
+	var failures []domain.BulkFailure
+	creates := 0
+	for i, operation := range operations {
+		transaction, err := uc.checkBulkOperation(ctx, operation, accounts, categories)
+		if err != nil {
+			failures = append(failures, domain.BulkFailure{Index: i, Err: err})
+			continue
+		}
+		transactions[i] = transaction
+		if operation.Action == entities.BulkActionCreate {
+			operations[i].Transaction = transaction
+			creates++
+		}
+	}
+	if len(failures) > 0 {
+		return nil, &domain.BulkError{Failures: failures}
+	}
+	if err := uc.quotas.CheckTransactions(ctx, creates); err != nil {
+		return nil, err
+	}
+
+	applied, err := uc.transactionRepo.ApplyBulkOperations(ctx, operations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply bulk operations: %w", err)
+	}
+
+	results := make([]entities.BulkResult, len(operations))
+	refreshed := map[string]bool{}
+	for i, operation := range operations {
+		transaction := applied[i]
+		// Deleted transactions are returned as they were
+		if operation.Action == entities.BulkActionDelete {
+			transaction = transactions[i]
+		}
+		if account, ok := accounts[transaction.AccountID]; ok {
+			transaction.Account = &account
+		}
+		if category, ok := categories[transaction.CategoryID]; ok {
+			transaction.Category = &category
+		}
+		results[i] = entities.BulkResult{Action: operation.Action, Transaction: transaction}
+
+		if !refreshed[transaction.AccountID] {
+			refreshed[transaction.AccountID] = true
+			_ = uc.balanceRepo.RefreshAccountBalance(ctx, transaction.AccountID)
+		}
+	}
+
+	return results, nil
+}
+
+// checkBulkOperation checks an operation of a bulk request, returning the
+// transaction it creates ready to be created, or the one it changes. The
+// accounts and categories of the transactions are kept in the maps.
+func (uc *TransactionUseCase) checkBulkOperation(ctx context.Context, operation entities.BulkOperation, accounts map[string]entities.Account, categories map[string]entities.Category) (entities.Transaction, error) {
+	if operation.Action == entities.BulkActionCreate {
+		transaction, account, category, err := uc.prepareTransaction(ctx, operation.Transaction)
+		if err != nil {
+			return entities.Transaction{}, err
+		}
+		accounts[account.ID] = account
+		categories[category.ID] = category
+		return transaction, nil
+	}
+
+	if !slices.Contains(entities.BulkActions, operation.Action) {
+		return entities.Transaction{}, fmt.Errorf("invalid bulk action %q: %w", operation.Action, domain.ErrMalformedParameters)
+	}
+	if operation.ID == "" {
+		return entities.Transaction{}, fmt.Errorf("transaction ID cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+
+	transaction, err := ownTransaction(ctx, uc.transactionRepo, operation.ID)
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	if operation.Action == entities.BulkActionUpdateStatus {
+		if !slices.Contains(entities.TransactionStatuses, operation.Status) {
+			return entities.Transaction{}, fmt.Errorf("invalid transaction status: %q: %w", operation.Status, domain.ErrMalformedParameters)
+		}
+		// Like updates, finalized transactions don't go back to drafts
+		if operation.Status == entities.TransactionStatusDraft && transaction.Status != entities.TransactionStatusDraft {
+			return entities.Transaction{}, fmt.Errorf("cannot turn a %s transaction back into a draft: %w", transaction.Status, domain.ErrConflict)
+		}
+	}
+
+	if _, ok := accounts[transaction.AccountID]; !ok {
+		if account, err := ownAccount(ctx, uc.accountRepo, transaction.AccountID); err == nil {
+			accounts[account.ID] = account
+		}
+	}
+	if _, ok := categories[transaction.CategoryID]; !ok {
+		if category, err := ownCategory(ctx, uc.categoryRepo, transaction.CategoryID); err == nil {
+			categories[category.ID] = category
+		}
+	}
+	return transaction, nil
+}
+
 func (uc *TransactionUseCase) validateTransaction(transaction entities.Transaction) error {
 	if transaction.AccountID == "" {
 		return fmt.Errorf("account ID cannot be empty")
@@ -260,21 +767,7 @@ func (uc *TransactionUseCase) validateTransaction(transaction entities.Transacti
 	}
 
 	if transaction.Status != "" {
-		validStatuses := []entities.TransactionStatus{
-			entities.TransactionStatusPending,
-			entities.TransactionStatusCleared,
-			entities.TransactionStatusCancelled,
-		}
-
-		isValidStatus := false
-		for _, validStatus := range validStatuses {
-			if transaction.Status == validStatus {
-				isValidStatus = true
-				break
-			}
-		}
-
-		if !isValidStatus {
+		if !slices.Contains(entities.TransactionStatuses, transaction.Status) {
 			return fmt.Errorf("invalid transaction status: %s", transaction.Status)
 		}
 	}
@@ -282,23 +775,27 @@ func (uc *TransactionUseCase) validateTransaction(transaction entities.Transacti
 	return nil
 }
 
-func (uc *TransactionUseCase) adjustTransactionAmount(transaction entities.Transaction, category entities.Category) entities.Transaction {
-	// For expense categories, ensure amount is negative
-	if category.Type == entities.CategoryTypeExpense && transaction.Monetary.Amount.Sign() > 0 {
-		negatedAmount := new(big.Int).Neg(transaction.Monetary.Amount)
-		convertedMonetary, err := monetary.NewMonetary(transaction.Monetary.Asset, negatedAmount)
-		if err == nil {
-			transaction.Monetary = *convertedMonetary
-		}
+// adjustTransactionAmount signs the amount by its effect on the account balance:
+// expenses lower the balance of asset accounts and raise the balance owed on
+// liability accounts, income does the opposite
+func (uc *TransactionUseCase) adjustTransactionAmount(transaction entities.Transaction, account entities.Account, category entities.Category) entities.Transaction {
+	var sign int
+	switch category.Type {
+	case entities.CategoryTypeExpense:
+		sign = -1
+	case entities.CategoryTypeIncome:
+		sign = 1
+	default:
+		return transaction
 	}
 
-	// For income categories, ensure amount is positive
-	if category.Type == entities.CategoryTypeIncome && transaction.Monetary.Amount.Sign() < 0 {
-		positiveAmount := new(big.Int).Neg(transaction.Monetary.Amount)
-		convertedMonetary, err := monetary.NewMonetary(transaction.Monetary.Asset, positiveAmount)
-		if err == nil {
-			transaction.Monetary = *convertedMonetary
-		}
+	if account.IsLiability() {
+		sign = -sign
+	}
+
+	amount := entities.MoneyOf(transaction.Monetary)
+	if amount.Sign() == -sign {
+		transaction.Monetary = amount.Neg().Monetary()
 	}
 
 	return transaction
@@ -313,12 +810,6 @@ func (uc *TransactionUseCase) convertTransactionToAccountAsset(transaction entit
 	// Convert the amount to the account's asset
 	// For simplicity, we'll keep the same numeric value but change the asset
 	// In a real-world scenario, you would need currency conversion rates
-	convertedMonetary, err := monetary.NewMonetary(account.Asset, transaction.Monetary.Amount)
-	if err != nil {
-		// If conversion fails, return the original transaction
-		return transaction
-	}
-
-	transaction.Monetary = *convertedMonetary
+	transaction.Monetary = monetary.Monetary{Asset: account.Asset, Amount: new(big.Int).Set(transaction.Monetary.Amount)}
 	return transaction
 }
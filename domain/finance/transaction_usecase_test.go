@@ -0,0 +1,1204 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMonetary(t *testing.T, asset monetary.Asset, amount int64) monetary.Monetary {
+	t.Helper()
+
+	return monetary.Monetary{Asset: asset, Amount: big.NewInt(amount)}
+}
+
+func errNotFound(resource string) error {
+	return fmt.Errorf("%s %w", resource, domain.ErrNotFound)
+}
+
+func testTransactionRepos() (*mocks.TransactionRepositoryMock, *mocks.AccountRepositoryMock, *mocks.CategoryRepositoryMock, *mocks.BalanceRepositoryMock) {
+	transactionRepo := &mocks.TransactionRepositoryMock{
+		CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+			transaction.ID = "tx-1"
+			return transaction, nil
+		},
+		UpdateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+			return transaction, nil
+		},
+		GetTransactionByIDFunc: func(ctx context.Context, id string) (entities.Transaction, error) {
+			return entities.Transaction{ID: id, AccountID: "acc-1", CategoryID: "cat-expense"}, nil
+		},
+	}
+	accountRepo := &mocks.AccountRepositoryMock{
+		GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+			switch id {
+			case "acc-1":
+				return entities.Account{ID: id, Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL}, nil
+			case "acc-2":
+				return entities.Account{ID: id, Name: "Savings", Type: entities.AccountTypeSavings, Asset: monetary.BRL}, nil
+			case "acc-credit":
+				return entities.Account{ID: id, Name: "Credit Card", Type: entities.AccountTypeCredit, Asset: monetary.BRL}, nil
+			}
+			return entities.Account{}, errNotFound("account")
+		},
+	}
+	categoryRepo := &mocks.CategoryRepositoryMock{
+		GetCategoryByIDFunc: func(ctx context.Context, id string) (entities.Category, error) {
+			switch id {
+			case "cat-expense":
+				return entities.Category{ID: id, Name: "Groceries", Type: entities.CategoryTypeExpense}, nil
+			case "cat-income":
+				return entities.Category{ID: id, Name: "Salary", Type: entities.CategoryTypeIncome}, nil
+			}
+			return entities.Category{}, errNotFound("category")
+		},
+	}
+	return transactionRepo, accountRepo, categoryRepo, &mocks.BalanceRepositoryMock{}
+}
+
+func TestCreateTransaction(t *testing.T) {
+	valid := func(t *testing.T) entities.Transaction {
+		return entities.Transaction{
+			AccountID:   "acc-1",
+			CategoryID:  "cat-expense",
+			Monetary:    testMonetary(t, monetary.USD, -1500),
+			Description: "Market",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		input   func(*testing.T) entities.Transaction
+		mock    func(*mocks.TransactionRepositoryMock)
+		wantErr string
+	}{
+		{
+			name:  "success",
+			input: valid,
+		},
+		{
+			name: "empty account id",
+			input: func(t *testing.T) entities.Transaction {
+				tx := valid(t)
+				tx.AccountID = ""
+				return tx
+			},
+			wantErr: "account ID cannot be empty",
+		},
+		{
+			name: "empty category id",
+			input: func(t *testing.T) entities.Transaction {
+				tx := valid(t)
+				tx.CategoryID = ""
+				return tx
+			},
+			wantErr: "category ID cannot be empty",
+		},
+		{
+			name: "zero amount",
+			input: func(t *testing.T) entities.Transaction {
+				tx := valid(t)
+				tx.Monetary = testMonetary(t, monetary.USD, 0)
+				return tx
+			},
+			wantErr: "transaction amount cannot be zero",
+		},
+		{
+			name: "blank description",
+			input: func(t *testing.T) entities.Transaction {
+				tx := valid(t)
+				tx.Description = "   "
+				return tx
+			},
+			wantErr: "transaction description cannot be empty",
+		},
+		{
+			name: "invalid status",
+			input: func(t *testing.T) entities.Transaction {
+				tx := valid(t)
+				tx.Status = "refunded"
+				return tx
+			},
+			wantErr: "invalid transaction status: refunded",
+		},
+		{
+			name: "account not found",
+			input: func(t *testing.T) entities.Transaction {
+				tx := valid(t)
+				tx.AccountID = "missing"
+				return tx
+			},
+			wantErr: "failed to get account: account not found",
+		},
+		{
+			name: "category not found",
+			input: func(t *testing.T) entities.Transaction {
+				tx := valid(t)
+				tx.CategoryID = "missing"
+				return tx
+			},
+			wantErr: "failed to get category: category not found",
+		},
+		{
+			name:  "repository error",
+			input: valid,
+			mock: func(m *mocks.TransactionRepositoryMock) {
+				m.CreateTransactionFunc = func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					return entities.Transaction{}, errors.New("connection reset")
+				}
+			},
+			wantErr: "failed to create transaction: connection reset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+			if tt.mock != nil {
+				tt.mock(transactionRepo)
+			}
+
+			uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+			got, err := uc.CreateTransaction(context.Background(), tt.input(t))
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Empty(t, got.ID)
+				assert.Empty(t, balanceRepo.RefreshAccountBalanceCalls())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "tx-1", got.ID)
+			require.NotNil(t, got.Account)
+			require.NotNil(t, got.Category)
+			assert.Equal(t, "Checking", got.Account.Name)
+			assert.Equal(t, "Groceries", got.Category.Name)
+
+			require.Len(t, balanceRepo.RefreshAccountBalanceCalls(), 1)
+			assert.Equal(t, "acc-1", balanceRepo.RefreshAccountBalanceCalls()[0].AccountID)
+		})
+	}
+}
+
+func TestCreateTransactionDefaults(t *testing.T) {
+	transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+	uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+	uc.now = func() time.Time { return time.Date(2025, time.March, 10, 1, 30, 0, 0, time.UTC) }
+
+	_, err := uc.CreateTransaction(context.Background(), entities.Transaction{
+		AccountID:   "acc-1",
+		CategoryID:  "cat-expense",
+		Monetary:    testMonetary(t, monetary.USD, -1500),
+		Description: "Market",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, transactionRepo.CreateTransactionCalls(), 1)
+	stored := transactionRepo.CreateTransactionCalls()[0].Transaction
+
+	// The amount is stored in the account's asset
+	assert.Equal(t, "BRL", stored.Monetary.Asset.Asset)
+	assert.Equal(t, int64(-1500), stored.Monetary.Amount.Int64())
+
+	assert.Equal(t, entities.TransactionStatusCleared, stored.Status)
+	// Still the 9th in the default timezone, America/Sao_Paulo
+	assert.Equal(t, time.Date(2025, time.March, 9, 0, 0, 0, 0, time.UTC), stored.Date)
+}
+
+func TestCreateTransactionRuleActions(t *testing.T) {
+	categorize := &mocks.RuleActionMock{
+		ApplyFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+			if transaction.Payee == "Market" {
+				transaction.CategoryID = "cat-expense"
+			}
+			return transaction, nil
+		},
+	}
+	describe := &mocks.RuleActionMock{
+		ApplyFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+			transaction.Description = transaction.Payee + " purchase"
+			return transaction, nil
+		},
+	}
+	input := entities.Transaction{
+		AccountID: "acc-1",
+		Monetary:  testMonetary(t, monetary.USD, -1500),
+		Payee:     "Market",
+		Status:    entities.TransactionStatusCleared,
+		Date:      time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("applied in order before validation", func(t *testing.T) {
+		transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+		uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, []RuleAction{categorize, describe})
+
+		_, err := uc.CreateTransaction(context.Background(), input)
+		require.NoError(t, err)
+
+		require.Len(t, transactionRepo.CreateTransactionCalls(), 1)
+		stored := transactionRepo.CreateTransactionCalls()[0].Transaction
+		assert.Equal(t, "cat-expense", stored.CategoryID)
+		assert.Equal(t, "Market purchase", stored.Description)
+	})
+
+	t.Run("refusing the transaction", func(t *testing.T) {
+		transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+		refuse := &mocks.RuleActionMock{
+			ApplyFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+				return entities.Transaction{}, errors.New("payee is blocked")
+			},
+		}
+		uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, []RuleAction{refuse, describe})
+
+		_, err := uc.CreateTransaction(context.Background(), input)
+		assert.EqualError(t, err, "failed to apply rule action: payee is blocked")
+		assert.Empty(t, transactionRepo.CreateTransactionCalls())
+	})
+}
+
+func TestCreateTransactionUserDefaults(t *testing.T) {
+	tests := []struct {
+		name       string
+		accountID  string
+		settings   []entities.UserSetting
+		wantStatus entities.TransactionStatus
+		wantDate   time.Time
+		wantErr    string
+	}{
+		{
+			name:      "default status",
+			accountID: "acc-1",
+			settings: []entities.UserSetting{
+				{Key: entities.UserSettingDefaultTransactionStatus, Value: "pending"},
+			},
+			wantStatus: entities.TransactionStatusPending,
+			wantDate:   time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "account type status",
+			accountID: "acc-credit",
+			settings: []entities.UserSetting{
+				{Key: entities.DefaultTransactionStatusKey(entities.AccountTypeCredit), Value: "pending"},
+			},
+			wantStatus: entities.TransactionStatusPending,
+			wantDate:   time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "account type status only applies to its type",
+			accountID: "acc-1",
+			settings: []entities.UserSetting{
+				{Key: entities.DefaultTransactionStatusKey(entities.AccountTypeCredit), Value: "pending"},
+			},
+			wantStatus: entities.TransactionStatusCleared,
+			wantDate:   time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "today in the user timezone",
+			accountID: "acc-1",
+			settings: []entities.UserSetting{
+				{Key: entities.UserSettingTimezone, Value: "Asia/Tokyo"},
+			},
+			wantStatus: entities.TransactionStatusCleared,
+			wantDate:   time.Date(2025, time.March, 11, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "date required",
+			accountID: "acc-1",
+			settings: []entities.UserSetting{
+				{Key: entities.UserSettingDefaultTransactionDate, Value: entities.DefaultTransactionDateRequired},
+			},
+			wantErr: "transaction date is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+			userSettingsRepo := &mocks.UserSettingsRepositoryMock{
+				GetAllUserSettingsFunc: func(ctx context.Context) ([]entities.UserSetting, error) {
+					return tt.settings, nil
+				},
+			}
+
+			uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, userSettingsRepo, &mocks.InstallmentRepositoryMock{}, nil, nil)
+			uc.now = func() time.Time { return time.Date(2025, time.March, 10, 18, 0, 0, 0, time.UTC) }
+
+			_, err := uc.CreateTransaction(context.Background(), entities.Transaction{
+				AccountID:   tt.accountID,
+				CategoryID:  "cat-expense",
+				Monetary:    testMonetary(t, monetary.USD, -1500),
+				Description: "Market",
+			})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Empty(t, transactionRepo.CreateTransactionCalls())
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, transactionRepo.CreateTransactionCalls(), 1)
+			stored := transactionRepo.CreateTransactionCalls()[0].Transaction
+			assert.Equal(t, tt.wantStatus, stored.Status)
+			assert.Equal(t, tt.wantDate, stored.Date)
+		})
+	}
+}
+
+func TestCreateTransactionExplicitValuesSkipDefaults(t *testing.T) {
+	transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+	userSettingsRepo := &mocks.UserSettingsRepositoryMock{}
+	uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, userSettingsRepo, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+	date := time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC)
+	_, err := uc.CreateTransaction(context.Background(), entities.Transaction{
+		AccountID:   "acc-1",
+		CategoryID:  "cat-expense",
+		Monetary:    testMonetary(t, monetary.USD, -1500),
+		Description: "Market",
+		Date:        date,
+		Status:      entities.TransactionStatusPending,
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, userSettingsRepo.GetAllUserSettingsCalls())
+	stored := transactionRepo.CreateTransactionCalls()[0].Transaction
+	assert.Equal(t, entities.TransactionStatusPending, stored.Status)
+	assert.Equal(t, date, stored.Date)
+}
+
+func TestCreateTransactionOnLiabilityAccount(t *testing.T) {
+	transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+	uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+	got, err := uc.CreateTransaction(context.Background(), entities.Transaction{
+		AccountID:   "acc-credit",
+		CategoryID:  "cat-expense",
+		Monetary:    testMonetary(t, monetary.USD, -1500),
+		Description: "Market",
+	})
+	require.NoError(t, err)
+
+	// Spending on a credit card raises what's owed on it
+	assert.Equal(t, int64(1500), got.Monetary.Amount.Int64())
+}
+
+func TestAdjustTransactionAmount(t *testing.T) {
+	expense := entities.Category{Type: entities.CategoryTypeExpense}
+	income := entities.Category{Type: entities.CategoryTypeIncome}
+
+	tests := []struct {
+		account  entities.Account
+		category entities.Category
+		want     int64
+	}{
+		{entities.Account{Type: entities.AccountTypeChecking}, expense, -2000},
+		{entities.Account{Type: entities.AccountTypeChecking}, income, 2000},
+		{entities.Account{Type: entities.AccountTypeSavings}, expense, -2000},
+		{entities.Account{Type: entities.AccountTypeSavings}, income, 2000},
+		{entities.Account{Type: entities.AccountTypeInvestment}, expense, -2000},
+		{entities.Account{Type: entities.AccountTypeInvestment}, income, 2000},
+		{entities.Account{Type: entities.AccountTypeCash}, expense, -2000},
+		{entities.Account{Type: entities.AccountTypeCash}, income, 2000},
+		{entities.Account{Type: entities.AccountTypeCredit}, expense, 2000},
+		{entities.Account{Type: entities.AccountTypeCredit}, income, -2000},
+		{entities.Account{Type: entities.AccountTypeInvestment, Classification: entities.AccountClassificationLiability}, expense, 2000},
+		{entities.Account{Type: entities.AccountTypeCredit, Classification: entities.AccountClassificationAsset}, expense, -2000},
+	}
+
+	uc := &TransactionUseCase{}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %s/%s", tt.account.EffectiveClassification(), tt.account.Type, tt.category.Type), func(t *testing.T) {
+			// The sign is enforced whichever sign the amount came with
+			for _, amount := range []int64{2000, -2000} {
+				got := uc.adjustTransactionAmount(entities.Transaction{Monetary: testMonetary(t, monetary.BRL, amount)}, tt.account, tt.category)
+				assert.Equal(t, tt.want, got.Monetary.Amount.Int64(), "amount %d", amount)
+			}
+		})
+	}
+}
+
+func TestConvertTransactionToAccountAsset(t *testing.T) {
+	uc := &TransactionUseCase{}
+	account := entities.Account{Asset: monetary.BRL}
+
+	// The amount keeps its sign in the account's asset
+	for _, amount := range []int64{1500, -1500} {
+		transaction := entities.Transaction{Monetary: testMonetary(t, monetary.USD, amount)}
+		got := uc.convertTransactionToAccountAsset(transaction, account)
+		assert.Equal(t, "BRL", got.Monetary.Asset.Asset)
+		assert.Equal(t, amount, got.Monetary.Amount.Int64())
+		assert.Equal(t, "USD", transaction.Monetary.Asset.Asset)
+	}
+}
+
+func TestUpdateTransaction(t *testing.T) {
+	tests := []struct {
+		name       string
+		categoryID string
+		amount     int64
+		wantAmount int64
+	}{
+		{
+			name:       "expense made negative",
+			categoryID: "cat-expense",
+			amount:     2000,
+			wantAmount: -2000,
+		},
+		{
+			name:       "expense already negative",
+			categoryID: "cat-expense",
+			amount:     -2000,
+			wantAmount: -2000,
+		},
+		{
+			name:       "income made positive",
+			categoryID: "cat-income",
+			amount:     -2000,
+			wantAmount: 2000,
+		},
+		{
+			name:       "income already positive",
+			categoryID: "cat-income",
+			amount:     2000,
+			wantAmount: 2000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+			uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+			got, err := uc.UpdateTransaction(context.Background(), entities.Transaction{
+				ID:          "tx-1",
+				AccountID:   "acc-1",
+				CategoryID:  tt.categoryID,
+				Monetary:    testMonetary(t, monetary.USD, tt.amount),
+				Description: "Adjusted",
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantAmount, got.Monetary.Amount.Int64())
+			assert.Equal(t, "BRL", got.Monetary.Asset.Asset)
+			require.NotNil(t, got.Account)
+			require.NotNil(t, got.Category)
+		})
+	}
+}
+
+func TestUpdateTransactionRefreshesBalances(t *testing.T) {
+	tests := []struct {
+		name      string
+		accountID string
+		want      []string
+	}{
+		{
+			name:      "same account",
+			accountID: "acc-1",
+			want:      []string{"acc-1"},
+		},
+		{
+			name:      "moved to another account",
+			accountID: "acc-2",
+			want:      []string{"acc-2", "acc-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+			uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+			_, err := uc.UpdateTransaction(context.Background(), entities.Transaction{
+				ID:          "tx-1",
+				AccountID:   tt.accountID,
+				CategoryID:  "cat-expense",
+				Monetary:    testMonetary(t, monetary.BRL, -500),
+				Description: "Moved",
+			})
+			require.NoError(t, err)
+
+			var refreshed []string
+			for _, call := range balanceRepo.RefreshAccountBalanceCalls() {
+				refreshed = append(refreshed, call.AccountID)
+			}
+			assert.Equal(t, tt.want, refreshed)
+		})
+	}
+}
+
+func TestUpdateTransactionErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		mock    func(*mocks.TransactionRepositoryMock)
+		wantErr string
+	}{
+		{
+			name:    "empty id",
+			id:      "",
+			wantErr: "transaction ID cannot be empty",
+		},
+		{
+			name: "not found",
+			id:   "missing",
+			mock: func(m *mocks.TransactionRepositoryMock) {
+				m.GetTransactionByIDFunc = func(ctx context.Context, id string) (entities.Transaction, error) {
+					return entities.Transaction{}, errNotFound("transaction")
+				}
+			},
+			wantErr: "failed to get existing transaction: transaction not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+			if tt.mock != nil {
+				tt.mock(transactionRepo)
+			}
+			uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+			_, err := uc.UpdateTransaction(context.Background(), entities.Transaction{
+				ID:          tt.id,
+				AccountID:   "acc-1",
+				CategoryID:  "cat-expense",
+				Monetary:    testMonetary(t, monetary.BRL, -500),
+				Description: "Update",
+			})
+			assert.EqualError(t, err, tt.wantErr)
+			assert.Empty(t, transactionRepo.UpdateTransactionCalls())
+			assert.Empty(t, balanceRepo.RefreshAccountBalanceCalls())
+		})
+	}
+}
+
+func TestDeleteTransaction(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		mock    func(*mocks.TransactionRepositoryMock)
+		wantErr string
+	}{
+		{
+			name: "success",
+			id:   "tx-1",
+		},
+		{
+			name:    "empty id",
+			id:      "",
+			wantErr: "transaction ID cannot be empty",
+		},
+		{
+			name: "not found",
+			id:   "missing",
+			mock: func(m *mocks.TransactionRepositoryMock) {
+				m.GetTransactionByIDFunc = func(ctx context.Context, id string) (entities.Transaction, error) {
+					return entities.Transaction{}, errNotFound("transaction")
+				}
+			},
+			wantErr: "failed to get transaction: transaction not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+			if tt.mock != nil {
+				tt.mock(transactionRepo)
+			}
+			uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+			err := uc.DeleteTransaction(context.Background(), tt.id)
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Empty(t, transactionRepo.DeleteTransactionCalls())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, transactionRepo.DeleteTransactionCalls(), 1)
+			require.Len(t, balanceRepo.RefreshAccountBalanceCalls(), 1)
+			assert.Equal(t, "acc-1", balanceRepo.RefreshAccountBalanceCalls()[0].AccountID)
+		})
+	}
+}
+
+func TestRestoreTransaction(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+		transactionRepo.RestoreTransactionFunc = func(ctx context.Context, id string) (entities.Transaction, error) {
+			return entities.Transaction{ID: id, AccountID: "acc-1"}, nil
+		}
+		uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+		restored, err := uc.RestoreTransaction(context.Background(), "tx-1")
+		require.NoError(t, err)
+		assert.Equal(t, "tx-1", restored.ID)
+		require.Len(t, balanceRepo.RefreshAccountBalanceCalls(), 1)
+		assert.Equal(t, "acc-1", balanceRepo.RefreshAccountBalanceCalls()[0].AccountID)
+	})
+
+	t.Run("not in the trash", func(t *testing.T) {
+		transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+		transactionRepo.RestoreTransactionFunc = func(ctx context.Context, id string) (entities.Transaction, error) {
+			return entities.Transaction{}, errNotFound("deleted transaction")
+		}
+		uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+		_, err := uc.RestoreTransaction(context.Background(), "tx-1")
+		assert.EqualError(t, err, "failed to restore transaction: deleted transaction not found")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.Empty(t, balanceRepo.RefreshAccountBalanceCalls())
+	})
+}
+
+func TestSearchTransactionsPage(t *testing.T) {
+	transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+	var gotQuery string
+	transactionRepo.SearchTransactionsFunc = func(ctx context.Context, query string, limit, offset int) ([]entities.Transaction, error) {
+		gotQuery = query
+		return []entities.Transaction{
+			{ID: "tx-1", AccountID: "acc-1", CategoryID: "cat-expense", Description: "Supermarket"},
+			{ID: "tx-2", AccountID: "acc-gone", CategoryID: "cat-expense", Payee: "Super Foods"},
+		}, nil
+	}
+	transactionRepo.CountSearchTransactionsFunc = func(ctx context.Context, query string) (int64, error) {
+		return 7, nil
+	}
+	accountRepo.GetAllAccountsFunc = func(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+		return []entities.Account{{ID: "acc-1", Name: "Checking"}}, nil
+	}
+	categoryRepo.GetAllCategoriesFunc = func(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+		return []entities.Category{{ID: "cat-expense", Name: "Groceries"}}, nil
+	}
+	uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+	t.Run("with the account and category", func(t *testing.T) {
+		page, err := uc.SearchTransactionsPage(context.Background(), "  super ", 2, 4)
+		require.NoError(t, err)
+		assert.Equal(t, "super", gotQuery)
+		assert.Equal(t, int64(7), page.Total)
+		assert.Equal(t, 2, page.Limit)
+		assert.Equal(t, 4, page.Offset)
+		require.Len(t, page.Items, 2)
+		assert.Equal(t, "Checking", page.Items[0].Account.Name)
+		assert.Equal(t, "Groceries", page.Items[0].Category.Name)
+		assert.Nil(t, page.Items[1].Account)
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		_, err := uc.SearchTransactionsPage(context.Background(), "   ", 50, 0)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+}
+
+func TestTransactionsOfOtherUsers(t *testing.T) {
+	ctx := domain.WithUser(context.Background(), "user-1")
+
+	newUseCase := func() (*TransactionUseCase, *mocks.TransactionRepositoryMock) {
+		transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+		owned := func(id string) string {
+			if id == "acc-2" || id == "cat-income" || id == "tx-2" {
+				return "user-2"
+			}
+			return "user-1"
+		}
+		transactionRepo.GetTransactionByIDFunc = func(ctx context.Context, id string) (entities.Transaction, error) {
+			return entities.Transaction{ID: id, AccountID: "acc-1", CategoryID: "cat-expense", OwnerID: owned(id)}, nil
+		}
+		getAccount := accountRepo.GetAccountByIDFunc
+		accountRepo.GetAccountByIDFunc = func(ctx context.Context, id string) (entities.Account, error) {
+			account, err := getAccount(ctx, id)
+			account.OwnerID = owned(id)
+			return account, err
+		}
+		getCategory := categoryRepo.GetCategoryByIDFunc
+		categoryRepo.GetCategoryByIDFunc = func(ctx context.Context, id string) (entities.Category, error) {
+			category, err := getCategory(ctx, id)
+			category.OwnerID = owned(id)
+			return category, err
+		}
+		return NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil), transactionRepo
+	}
+
+	t.Run("own transaction", func(t *testing.T) {
+		uc, _ := newUseCase()
+		_, err := uc.GetTransactionByID(ctx, "tx-1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("transaction of another user", func(t *testing.T) {
+		uc, transactionRepo := newUseCase()
+		_, err := uc.GetTransactionByID(ctx, "tx-2")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		err = uc.DeleteTransaction(ctx, "tx-2")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.Empty(t, transactionRepo.DeleteTransactionCalls())
+	})
+
+	for _, tt := range []struct {
+		name      string
+		accountID string
+		category  string
+	}{
+		{name: "in the account of another user", accountID: "acc-2", category: "cat-expense"},
+		{name: "in the category of another user", accountID: "acc-1", category: "cat-income"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, transactionRepo := newUseCase()
+			_, err := uc.CreateTransaction(ctx, entities.Transaction{
+				AccountID:   tt.accountID,
+				CategoryID:  tt.category,
+				Monetary:    testMonetary(t, monetary.BRL, -500),
+				Description: "Market",
+			})
+			assert.ErrorIs(t, err, domain.ErrNotFound)
+			assert.Empty(t, transactionRepo.CreateTransactionCalls())
+		})
+	}
+
+	t.Run("without a signed in user", func(t *testing.T) {
+		uc, _ := newUseCase()
+		_, err := uc.GetTransactionByID(context.Background(), "tx-2")
+		assert.NoError(t, err)
+	})
+}
+
+func TestUpdateTransactionBackToDraft(t *testing.T) {
+	transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+	transactionRepo.GetTransactionByIDFunc = func(ctx context.Context, id string) (entities.Transaction, error) {
+		return entities.Transaction{ID: id, AccountID: "acc-1", CategoryID: "cat-expense", Status: entities.TransactionStatusCleared}, nil
+	}
+	uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+	_, err := uc.UpdateTransaction(context.Background(), entities.Transaction{
+		ID:          "tx-1",
+		AccountID:   "acc-1",
+		CategoryID:  "cat-expense",
+		Monetary:    testMonetary(t, monetary.BRL, -500),
+		Description: "Update",
+		Status:      entities.TransactionStatusDraft,
+	})
+	assert.ErrorIs(t, err, domain.ErrConflict)
+	assert.Empty(t, transactionRepo.UpdateTransactionCalls())
+}
+
+func TestDuplicateTransaction(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		date     time.Time
+		wantDate time.Time
+		wantErr  error
+	}{
+		{
+			name:     "on a new date",
+			id:       "tx-1",
+			date:     time.Date(2025, time.April, 2, 0, 0, 0, 0, time.UTC),
+			wantDate: time.Date(2025, time.April, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "defaults to today",
+			id:       "tx-1",
+			wantDate: time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "not found",
+			id:      "missing",
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+			transactionRepo.GetTransactionByIDFunc = func(ctx context.Context, id string) (entities.Transaction, error) {
+				if id == "missing" {
+					return entities.Transaction{}, errNotFound("transaction")
+				}
+				return entities.Transaction{
+					ID:          id,
+					AccountID:   "acc-1",
+					CategoryID:  "cat-expense",
+					Monetary:    testMonetary(t, monetary.BRL, -1500),
+					Description: "Haircut",
+					Date:        time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC),
+					Status:      entities.TransactionStatusPending,
+				}, nil
+			}
+			uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+			uc.now = func() time.Time { return time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC) }
+
+			duplicate, err := uc.DuplicateTransaction(context.Background(), tt.id, tt.date)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, transactionRepo.CreateTransactionCalls())
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, transactionRepo.CreateTransactionCalls(), 1)
+			stored := transactionRepo.CreateTransactionCalls()[0].Transaction
+			assert.Empty(t, stored.ID)
+			assert.Equal(t, "acc-1", stored.AccountID)
+			assert.Equal(t, "cat-expense", stored.CategoryID)
+			assert.Equal(t, "Haircut", stored.Description)
+			assert.Equal(t, int64(-1500), stored.Monetary.Amount.Int64())
+			assert.Equal(t, "BRL", stored.Monetary.Asset.Asset)
+			assert.Equal(t, tt.wantDate, stored.Date)
+			assert.Equal(t, entities.TransactionStatusCleared, stored.Status)
+			assert.Equal(t, "tx-1", duplicate.ID)
+			require.NotNil(t, duplicate.Account)
+		})
+	}
+}
+
+func TestCreateInstallmentPurchase(t *testing.T) {
+	purchase := entities.Transaction{
+		AccountID:   "acc-credit",
+		CategoryID:  "cat-expense",
+		Monetary:    testMonetary(t, monetary.BRL, 100000),
+		Description: "TV",
+		Date:        time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC),
+		Status:      entities.TransactionStatusCleared,
+	}
+
+	installmentRepo := func() *mocks.InstallmentRepositoryMock {
+		return &mocks.InstallmentRepositoryMock{
+			CreateInstallmentPlanFunc: func(ctx context.Context, plan entities.InstallmentPlan) (entities.InstallmentPlan, error) {
+				plan.ID = "plan-1"
+				return plan, nil
+			},
+		}
+	}
+
+	t.Run("spreads the purchase over monthly installments", func(t *testing.T) {
+		transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+		uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, installmentRepo(), nil, nil)
+		uc.now = func() time.Time { return time.Date(2025, time.February, 10, 12, 0, 0, 0, time.UTC) }
+
+		plan, err := uc.CreateInstallmentPurchase(context.Background(), purchase, 3)
+		require.NoError(t, err)
+		installments := plan.Installments
+		require.Len(t, installments, 3)
+
+		assert.Equal(t, "plan-1", plan.ID)
+		assert.Equal(t, 3, plan.Count)
+		assert.Equal(t, "TV", plan.Description)
+		assert.Equal(t, 1, plan.Paid)
+		assert.Equal(t, 2, plan.RemainingCount)
+		assert.Equal(t, int64(66666), plan.Remaining.Amount.Int64())
+		assert.Equal(t, "plan-1", installments[1].InstallmentPlanID)
+		assert.Equal(t, 2, installments[1].InstallmentNumber)
+		assert.Equal(t, 3, installments[1].InstallmentCount)
+
+		// The leftover cent goes on the first installment
+		assert.Equal(t, int64(33334), installments[0].Monetary.Amount.Int64())
+		assert.Equal(t, int64(33333), installments[2].Monetary.Amount.Int64())
+		assert.Equal(t, "TV (1/3)", installments[0].Description)
+		assert.Equal(t, "TV (3/3)", installments[2].Description)
+		assert.Equal(t, time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC), installments[1].Date)
+		assert.Equal(t, time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC), installments[2].Date)
+		assert.Equal(t, entities.TransactionStatusCleared, installments[0].Status)
+		assert.Equal(t, entities.TransactionStatusPending, installments[1].Status)
+		assert.Len(t, balanceRepo.RefreshAccountBalanceCalls(), 1)
+	})
+
+	t.Run("removes the installments created before a failure", func(t *testing.T) {
+		transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+		transactionRepo.CreateTransactionFunc = func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+			if transaction.Description == "TV (3/3)" {
+				return entities.Transaction{}, errors.New("connection reset")
+			}
+			transaction.ID = "tx-" + transaction.Description
+			return transaction, nil
+		}
+		plans := installmentRepo()
+		uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, plans, nil, nil)
+
+		_, err := uc.CreateInstallmentPurchase(context.Background(), purchase, 3)
+		assert.EqualError(t, err, "failed to create installment 3/3: connection reset")
+		// Deleting the plan trashes the installments already created
+		require.Len(t, plans.DeleteInstallmentPlanCalls(), 1)
+		assert.Equal(t, "plan-1", plans.DeleteInstallmentPlanCalls()[0].ID)
+	})
+
+	t.Run("invalid number of installments", func(t *testing.T) {
+		transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+		uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+		for _, installments := range []int{1, 49} {
+			_, err := uc.CreateInstallmentPurchase(context.Background(), purchase, installments)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		}
+		assert.Empty(t, transactionRepo.CreateTransactionCalls())
+	})
+}
+
+func TestFinalizeTransaction(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		status     entities.TransactionStatus
+		current    entities.TransactionStatus
+		settings   []entities.UserSetting
+		wantStatus entities.TransactionStatus
+		wantErr    error
+	}{
+		{
+			name:       "explicit status",
+			id:         "tx-1",
+			status:     entities.TransactionStatusPending,
+			current:    entities.TransactionStatusDraft,
+			wantStatus: entities.TransactionStatusPending,
+		},
+		{
+			name:       "status from user preferences",
+			id:         "tx-1",
+			current:    entities.TransactionStatusDraft,
+			settings:   []entities.UserSetting{{Key: entities.UserSettingDefaultTransactionStatus, Value: "pending"}},
+			wantStatus: entities.TransactionStatusPending,
+		},
+		{
+			name:       "default status",
+			id:         "tx-1",
+			current:    entities.TransactionStatusDraft,
+			wantStatus: entities.TransactionStatusCleared,
+		},
+		{
+			name:    "not a draft",
+			id:      "tx-1",
+			current: entities.TransactionStatusCleared,
+			wantErr: domain.ErrConflict,
+		},
+		{
+			name:    "cannot finalize as cancelled",
+			id:      "tx-1",
+			status:  entities.TransactionStatusCancelled,
+			current: entities.TransactionStatusDraft,
+			wantErr: domain.ErrMalformedParameters,
+		},
+		{
+			name:    "not found",
+			id:      "missing",
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+			transactionRepo.GetTransactionByIDFunc = func(ctx context.Context, id string) (entities.Transaction, error) {
+				if id == "missing" {
+					return entities.Transaction{}, errNotFound("transaction")
+				}
+				return entities.Transaction{ID: id, AccountID: "acc-1", CategoryID: "cat-expense", Date: time.Now(), Status: tt.current}, nil
+			}
+			transactionRepo.UpdateTransactionStatusFunc = func(ctx context.Context, id string, status entities.TransactionStatus) (entities.Transaction, error) {
+				return entities.Transaction{ID: id, AccountID: "acc-1", CategoryID: "cat-expense", Status: status}, nil
+			}
+			userSettingsRepo := &mocks.UserSettingsRepositoryMock{
+				GetAllUserSettingsFunc: func(ctx context.Context) ([]entities.UserSetting, error) {
+					return tt.settings, nil
+				},
+			}
+			uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, userSettingsRepo, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+			finalized, err := uc.FinalizeTransaction(context.Background(), tt.id, tt.status)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, transactionRepo.UpdateTransactionStatusCalls())
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, transactionRepo.UpdateTransactionStatusCalls(), 1)
+			assert.Equal(t, tt.wantStatus, transactionRepo.UpdateTransactionStatusCalls()[0].Status)
+			assert.Equal(t, tt.wantStatus, finalized.Status)
+			require.NotNil(t, finalized.Account)
+			require.NotNil(t, finalized.Category)
+			require.Len(t, balanceRepo.RefreshAccountBalanceCalls(), 1)
+		})
+	}
+}
+
+func TestDiscardDraft(t *testing.T) {
+	tests := []struct {
+		name    string
+		current entities.TransactionStatus
+		wantErr error
+	}{
+		{
+			name:    "draft",
+			current: entities.TransactionStatusDraft,
+		},
+		{
+			name:    "not a draft",
+			current: entities.TransactionStatusPending,
+			wantErr: domain.ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+			transactionRepo.GetTransactionByIDFunc = func(ctx context.Context, id string) (entities.Transaction, error) {
+				return entities.Transaction{ID: id, AccountID: "acc-1", Status: tt.current}, nil
+			}
+			uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+
+			err := uc.DiscardDraft(context.Background(), "tx-1")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, transactionRepo.DeleteTransactionCalls())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, transactionRepo.DeleteTransactionCalls(), 1)
+			assert.Empty(t, balanceRepo.RefreshAccountBalanceCalls())
+		})
+	}
+}
+
+func TestBulkTransactions(t *testing.T) {
+	newUseCase := func() (*TransactionUseCase, *mocks.TransactionRepositoryMock, *mocks.BalanceRepositoryMock) {
+		transactionRepo, accountRepo, categoryRepo, balanceRepo := testTransactionRepos()
+		transactionRepo.GetTransactionByIDFunc = func(ctx context.Context, id string) (entities.Transaction, error) {
+			switch id {
+			case "tx-cleared":
+				return entities.Transaction{ID: id, AccountID: "acc-1", CategoryID: "cat-expense", Status: entities.TransactionStatusCleared}, nil
+			case "tx-savings":
+				return entities.Transaction{ID: id, AccountID: "acc-2", CategoryID: "cat-income", Status: entities.TransactionStatusPending}, nil
+			}
+			return entities.Transaction{}, errNotFound("transaction")
+		}
+		transactionRepo.ApplyBulkOperationsFunc = func(ctx context.Context, operations []entities.BulkOperation) ([]entities.Transaction, error) {
+			applied := make([]entities.Transaction, len(operations))
+			for i, operation := range operations {
+				switch operation.Action {
+				case entities.BulkActionCreate:
+					applied[i] = operation.Transaction
+					applied[i].ID = fmt.Sprintf("tx-new-%d", i)
+				case entities.BulkActionUpdateStatus:
+					applied[i] = entities.Transaction{ID: operation.ID, AccountID: "acc-1", CategoryID: "cat-expense", Status: operation.Status}
+				case entities.BulkActionDelete:
+					applied[i] = entities.Transaction{ID: operation.ID}
+				}
+			}
+			return applied, nil
+		}
+		uc := NewTransactionUseCase(transactionRepo, accountRepo, categoryRepo, balanceRepo, &mocks.UserSettingsRepositoryMock{}, &mocks.InstallmentRepositoryMock{}, nil, nil)
+		return uc, transactionRepo, balanceRepo
+	}
+	create := entities.Transaction{
+		AccountID:   "acc-1",
+		CategoryID:  "cat-expense",
+		Monetary:    testMonetary(t, monetary.USD, 4590),
+		Description: "Market",
+		Date:        time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("applies the operations in order", func(t *testing.T) {
+		uc, transactionRepo, balanceRepo := newUseCase()
+
+		results, err := uc.BulkTransactions(context.Background(), []entities.BulkOperation{
+			{Action: entities.BulkActionCreate, Transaction: create},
+			{Action: entities.BulkActionUpdateStatus, ID: "tx-cleared", Status: entities.TransactionStatusCancelled},
+			{Action: entities.BulkActionDelete, ID: "tx-savings"},
+		})
+		require.NoError(t, err)
+
+		require.Len(t, transactionRepo.ApplyBulkOperationsCalls(), 1)
+		applied := transactionRepo.ApplyBulkOperationsCalls()[0].Operations
+		// The transaction created is the one prepared, in the account's asset
+		assert.Equal(t, monetary.BRL, applied[0].Transaction.Monetary.Asset)
+		assert.Equal(t, entities.TransactionStatusCleared, applied[0].Transaction.Status)
+
+		require.Len(t, results, 3)
+		assert.Equal(t, entities.BulkActionCreate, results[0].Action)
+		assert.Equal(t, "tx-new-0", results[0].Transaction.ID)
+		assert.Equal(t, "Checking", results[0].Transaction.Account.Name)
+		assert.Equal(t, entities.TransactionStatusCancelled, results[1].Transaction.Status)
+		assert.Equal(t, "Groceries", results[1].Transaction.Category.Name)
+		// The transaction deleted is returned as it was
+		assert.Equal(t, "acc-2", results[2].Transaction.AccountID)
+		assert.Equal(t, "Salary", results[2].Transaction.Category.Name)
+
+		// Each account's balance is refreshed once
+		require.Len(t, balanceRepo.RefreshAccountBalanceCalls(), 2)
+		assert.Equal(t, "acc-1", balanceRepo.RefreshAccountBalanceCalls()[0].AccountID)
+		assert.Equal(t, "acc-2", balanceRepo.RefreshAccountBalanceCalls()[1].AccountID)
+	})
+
+	t.Run("reports every operation that fails and applies none", func(t *testing.T) {
+		uc, transactionRepo, balanceRepo := newUseCase()
+		missingAccount := create
+		missingAccount.AccountID = "acc-gone"
+
+		_, err := uc.BulkTransactions(context.Background(), []entities.BulkOperation{
+			{Action: entities.BulkActionCreate, Transaction: create},
+			{Action: entities.BulkActionCreate, Transaction: missingAccount},
+			{Action: entities.BulkActionDelete, ID: "missing"},
+			{Action: entities.BulkActionUpdateStatus, ID: "tx-cleared", Status: entities.TransactionStatusDraft},
+			{Action: entities.BulkActionUpdateStatus, ID: "tx-cleared", Status: "lost"},
+			{Action: "archive", ID: "tx-cleared"},
+			{Action: entities.BulkActionDelete},
+		})
+
+		var bulk *domain.BulkError
+		require.ErrorAs(t, err, &bulk)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		indexes := make([]int, len(bulk.Failures))
+		for i, failure := range bulk.Failures {
+			indexes[i] = failure.Index
+		}
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, indexes)
+		assert.ErrorIs(t, bulk.Failures[1].Err, domain.ErrNotFound)
+		assert.ErrorIs(t, bulk.Failures[2].Err, domain.ErrConflict)
+		assert.EqualError(t, err, "6 operations failed, none was applied, the first is operation 1: failed to get account: account not found")
+		assert.Empty(t, transactionRepo.ApplyBulkOperationsCalls())
+		assert.Empty(t, balanceRepo.RefreshAccountBalanceCalls())
+	})
+
+	t.Run("the database refuses an operation", func(t *testing.T) {
+		uc, transactionRepo, balanceRepo := newUseCase()
+		transactionRepo.ApplyBulkOperationsFunc = func(ctx context.Context, operations []entities.BulkOperation) ([]entities.Transaction, error) {
+			return nil, &domain.BulkError{Failures: []domain.BulkFailure{{Index: 1, Err: errNotFound("transaction")}}}
+		}
+
+		_, err := uc.BulkTransactions(context.Background(), []entities.BulkOperation{
+			{Action: entities.BulkActionDelete, ID: "tx-cleared"},
+			{Action: entities.BulkActionDelete, ID: "tx-cleared"},
+		})
+		var bulk *domain.BulkError
+		require.ErrorAs(t, err, &bulk)
+		assert.Equal(t, 1, bulk.Failures[0].Index)
+		assert.Empty(t, balanceRepo.RefreshAccountBalanceCalls())
+	})
+
+	t.Run("number of operations", func(t *testing.T) {
+		uc, _, _ := newUseCase()
+
+		_, err := uc.BulkTransactions(context.Background(), nil)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+
+		_, err = uc.BulkTransactions(context.Background(), make([]entities.BulkOperation, entities.MaxBulkOperations+1))
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+}
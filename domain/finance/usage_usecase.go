@@ -0,0 +1,183 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// UsageUseCase meters the API calls of each user and reports what they
+// created and called against the quotas of their tier.
+//
+// Calls are counted in memory and added to the database by Flush, so the
+// count of a user is loaded once and then kept up to date without a query per
+// call. Counts are loaded again after each flush, picking up tier changes and
+// the calls counted by other instances of the service.
+type UsageUseCase struct {
+	userRepo UserRepository
+	policy   QuotaPolicy
+	now      func() time.Time
+
+	mu     sync.Mutex
+	meters map[apiMeterKey]*apiMeter
+}
+
+type apiMeterKey struct {
+	userID string
+	month  time.Time
+}
+
+// apiMeter is the API calls of a user in a month. Calls counts them all,
+// pending the ones not flushed yet.
+type apiMeter struct {
+	tier    entities.UserTier
+	calls   int
+	pending int
+}
+
+func NewUsageUseCase(userRepo UserRepository, policy QuotaPolicy) *UsageUseCase {
+	return &UsageUseCase{
+		userRepo: userRepo,
+		policy:   policy,
+		now:      time.Now,
+		meters:   map[apiMeterKey]*apiMeter{},
+	}
+}
+
+// RecordAPICall counts an API call of the user of the context, or returns a
+// domain.QuotaError, resetting at the start of the next month, when the user
+// already made as many calls as their quota allows. Calls without a user
+// aren't metered.
+func (uc *UsageUseCase) RecordAPICall(ctx context.Context) error {
+	userID, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	key := apiMeterKey{userID: userID, month: monthStart(uc.now())}
+	if err := uc.loadMeter(ctx, key); err != nil {
+		return err
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	meter := uc.meters[key]
+	if limit := uc.limits(meter.tier).MonthlyAPICalls; limit > 0 && meter.calls >= limit {
+		return &domain.QuotaError{
+			Quota:    QuotaMonthlyAPICalls,
+			Limit:    limit,
+			ResetsAt: key.month.AddDate(0, 1, 0),
+		}
+	}
+	meter.calls++
+	meter.pending++
+	return nil
+}
+
+// loadMeter loads the calls the user made in the month, unless they're
+// already counted
+func (uc *UsageUseCase) loadMeter(ctx context.Context, key apiMeterKey) error {
+	uc.mu.Lock()
+	_, ok := uc.meters[key]
+	uc.mu.Unlock()
+	if ok {
+		return nil
+	}
+
+	usage, err := uc.userRepo.GetUserUsage(ctx, key.userID, key.month)
+	if err != nil {
+		return fmt.Errorf("failed to get usage: %w", err)
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	// Another call may have loaded it meanwhile
+	if _, ok := uc.meters[key]; !ok {
+		uc.meters[key] = &apiMeter{tier: usage.Tier, calls: usage.MonthlyAPICalls}
+	}
+	return nil
+}
+
+// Flush adds the calls counted since the last flush to the database. Calls
+// that couldn't be added are kept for the next flush.
+func (uc *UsageUseCase) Flush(ctx context.Context) error {
+	uc.mu.Lock()
+	pending := map[apiMeterKey]int{}
+	for key, meter := range uc.meters {
+		if meter.pending > 0 {
+			pending[key] = meter.pending
+			meter.pending = 0
+		}
+	}
+	uc.mu.Unlock()
+
+	var errs []error
+	failed := map[apiMeterKey]int{}
+	for key, calls := range pending {
+		if err := uc.userRepo.AddAPICalls(ctx, key.userID, key.month, calls); err != nil {
+			errs = append(errs, err)
+			failed[key] = calls
+		}
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	for key, meter := range uc.meters {
+		meter.pending += failed[key]
+		// The meters with every call in the database are loaded again
+		if meter.pending == 0 {
+			delete(uc.meters, key)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("failed to add API calls: %w", err)
+	}
+	return nil
+}
+
+// GetUsage reports the usage of the user of the context in the current month,
+// counting the API calls not flushed yet
+func (uc *UsageUseCase) GetUsage(ctx context.Context) (entities.UsageReport, error) {
+	userID, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return entities.UsageReport{}, fmt.Errorf("usage is metered per user, sign in to see it: %w", domain.ErrUnauthorized)
+	}
+
+	month := monthStart(uc.now())
+	usage, err := uc.userRepo.GetUserUsage(ctx, userID, month)
+	if err != nil {
+		return entities.UsageReport{}, fmt.Errorf("failed to get usage: %w", err)
+	}
+
+	uc.mu.Lock()
+	if meter, ok := uc.meters[apiMeterKey{userID: userID, month: month}]; ok {
+		usage.MonthlyAPICalls += meter.pending
+	}
+	uc.mu.Unlock()
+
+	return entities.UsageReport{
+		Usage:       usage,
+		Limits:      uc.limits(usage.Tier),
+		PeriodStart: month,
+		ResetsAt:    month.AddDate(0, 1, 0),
+	}, nil
+}
+
+// limits are the quotas of the tier, none for the unlimited tier
+func (uc *UsageUseCase) limits(tier entities.UserTier) entities.UsageLimits {
+	if tier == entities.UserTierUnlimited {
+		return entities.UsageLimits{}
+	}
+	return entities.UsageLimits{
+		Accounts:            uc.policy.MaxAccounts,
+		MonthlyTransactions: uc.policy.MaxMonthlyTransactions,
+		MonthlyAPICalls:     uc.policy.MaxMonthlyAPICalls,
+		AttachmentBytes:     uc.policy.MaxAttachmentBytes,
+	}
+}
@@ -0,0 +1,88 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageUseCase(t *testing.T) {
+	ctx := domain.WithUser(context.Background(), "user-1")
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	// The database holds the calls flushed so far
+	flushed := 8
+	tier := entities.UserTierFree
+	var addErr error
+	userRepo := &mocks.UserRepositoryMock{
+		GetUserUsageFunc: func(ctx context.Context, id string, since time.Time) (entities.UserUsage, error) {
+			return entities.UserUsage{Tier: tier, Accounts: 2, MonthlyAPICalls: flushed}, nil
+		},
+		AddAPICallsFunc: func(ctx context.Context, id string, month time.Time, calls int) error {
+			if addErr != nil {
+				return addErr
+			}
+			flushed += calls
+			return nil
+		},
+	}
+	uc := NewUsageUseCase(userRepo, QuotaPolicy{MaxAccounts: 3, MaxMonthlyAPICalls: 10})
+	uc.now = func() time.Time { return time.Date(2025, time.March, 17, 9, 30, 0, 0, time.UTC) }
+
+	t.Run("counts the calls up to the quota", func(t *testing.T) {
+		require.NoError(t, uc.RecordAPICall(ctx))
+		require.NoError(t, uc.RecordAPICall(ctx))
+		assert.Len(t, userRepo.GetUserUsageCalls(), 1, "loaded once")
+
+		err := uc.RecordAPICall(ctx)
+		var quota *domain.QuotaError
+		require.ErrorAs(t, err, &quota)
+		assert.Equal(t, &domain.QuotaError{Quota: QuotaMonthlyAPICalls, Limit: 10, ResetsAt: march.AddDate(0, 1, 0)}, quota)
+		assert.ErrorIs(t, err, domain.ErrTooManyRequests)
+
+		assert.NoError(t, uc.RecordAPICall(context.Background()), "without a user")
+	})
+
+	t.Run("reports the pending calls", func(t *testing.T) {
+		report, err := uc.GetUsage(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, entities.UsageReport{
+			Usage:       entities.UserUsage{Tier: entities.UserTierFree, Accounts: 2, MonthlyAPICalls: 10},
+			Limits:      entities.UsageLimits{Accounts: 3, MonthlyAPICalls: 10},
+			PeriodStart: march,
+			ResetsAt:    march.AddDate(0, 1, 0),
+		}, report)
+
+		_, err = uc.GetUsage(context.Background())
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("flushes the pending calls", func(t *testing.T) {
+		addErr = errors.New("connection reset")
+		assert.ErrorContains(t, uc.Flush(ctx), "connection reset")
+		assert.Equal(t, 8, flushed)
+
+		// The calls that failed are flushed on the next run
+		addErr = nil
+		require.NoError(t, uc.Flush(ctx))
+		assert.Equal(t, 10, flushed)
+		calls := userRepo.AddAPICallsCalls()
+		assert.Equal(t, "user-1", calls[len(calls)-1].ID)
+		assert.Equal(t, march, calls[len(calls)-1].Month)
+
+		// Nothing is left to flush, and the meter is loaded again
+		require.NoError(t, uc.Flush(ctx))
+		assert.Len(t, userRepo.AddAPICallsCalls(), 2)
+		tier = entities.UserTierUnlimited
+		assert.NoError(t, uc.RecordAPICall(ctx), "unlimited tier")
+		assert.Len(t, userRepo.GetUserUsageCalls(), 3)
+	})
+}
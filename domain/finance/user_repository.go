@@ -0,0 +1,20 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+	"time"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/user_repository.go . UserRepository
+type UserRepository interface {
+	CreateUser(ctx context.Context, user entities.User) (entities.User, error)
+	GetUserByID(ctx context.Context, id string) (entities.User, error)
+	GetUserByEmail(ctx context.Context, email string) (entities.User, error)
+	// GetUserUsage counts the transactions created and the API calls made
+	// since the given time, the start of a month
+	GetUserUsage(ctx context.Context, id string, since time.Time) (entities.UserUsage, error)
+	SetUserTier(ctx context.Context, email string, tier entities.UserTier) error
+	// AddAPICalls adds calls to the API calls the user made in the month
+	AddAPICalls(ctx context.Context, id string, month time.Time, calls int) error
+}
@@ -0,0 +1,13 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/user_settings_repository.go . UserSettingsRepository
+type UserSettingsRepository interface {
+	GetAllUserSettings(ctx context.Context) ([]entities.UserSetting, error)
+	UpsertUserSetting(ctx context.Context, setting entities.UserSetting) (entities.UserSetting, error)
+	DeleteUserSetting(ctx context.Context, key entities.UserSettingKey) error
+}
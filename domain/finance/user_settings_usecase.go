@@ -0,0 +1,136 @@
+package finance
+
+import (
+	"context"
+	"finance/domain/entities"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// userSettingKeyPattern matches the keys accepted for custom preferences
+var userSettingKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+type UserSettingsUseCase struct {
+	userSettingsRepo UserSettingsRepository
+}
+
+func NewUserSettingsUseCase(userSettingsRepo UserSettingsRepository) *UserSettingsUseCase {
+	return &UserSettingsUseCase{
+		userSettingsRepo: userSettingsRepo,
+	}
+}
+
+// GetUserSettings returns the stored preferences merged over the defaults of the known keys
+func (uc *UserSettingsUseCase) GetUserSettings(ctx context.Context) (map[entities.UserSettingKey]string, error) {
+	stored, err := uc.userSettingsRepo.GetAllUserSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user settings: %w", err)
+	}
+
+	settings := make(map[entities.UserSettingKey]string, len(entities.DefaultUserSettings)+len(stored))
+	for key, value := range entities.DefaultUserSettings {
+		settings[key] = value
+	}
+	for _, setting := range stored {
+		settings[setting.Key] = setting.Value
+	}
+
+	return settings, nil
+}
+
+// UpdateUserSettings stores the given preferences, leaving the ones not present untouched.
+// An empty value removes the preference, resetting known keys to their default.
+func (uc *UserSettingsUseCase) UpdateUserSettings(ctx context.Context, settings map[entities.UserSettingKey]string) (map[entities.UserSettingKey]string, error) {
+	// Validate everything first so a bad value doesn't leave a partial update behind
+	for key, value := range settings {
+		if err := uc.validateUserSetting(key, value); err != nil {
+			return nil, err
+		}
+	}
+
+	for key, value := range settings {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			if err := uc.userSettingsRepo.DeleteUserSetting(ctx, key); err != nil {
+				return nil, fmt.Errorf("failed to delete user setting %s: %w", key, err)
+			}
+			continue
+		}
+
+		if _, err := uc.userSettingsRepo.UpsertUserSetting(ctx, entities.UserSetting{Key: key, Value: value}); err != nil {
+			return nil, fmt.Errorf("failed to update user setting %s: %w", key, err)
+		}
+	}
+
+	return uc.GetUserSettings(ctx)
+}
+
+func (uc *UserSettingsUseCase) validateUserSetting(key entities.UserSettingKey, value string) error {
+	if !userSettingKeyPattern.MatchString(string(key)) {
+		return fmt.Errorf("invalid user setting key: %s", key)
+	}
+
+	if key == entities.UserSettingDemoAccounts {
+		return fmt.Errorf("user setting %s is managed by the demo mode", key)
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+
+	// Per account type overrides of the default transaction status
+	if prefix := string(entities.UserSettingDefaultTransactionStatus) + "_"; strings.HasPrefix(string(key), prefix) {
+		accountType := entities.AccountType(strings.TrimPrefix(string(key), prefix))
+		if !slices.Contains(entities.AccountTypes, accountType) {
+			return fmt.Errorf("invalid account type in user setting %s: %s", key, accountType)
+		}
+		return validateDefaultTransactionStatus(value)
+	}
+
+	switch key {
+	case entities.UserSettingBaseCurrency:
+		if _, ok := entities.FindSupportedAsset(value); !ok {
+			return fmt.Errorf("invalid base currency: %s", value)
+		}
+	case entities.UserSettingTimezone:
+		if _, err := time.LoadLocation(value); err != nil {
+			return fmt.Errorf("invalid timezone: %s", value)
+		}
+	case entities.UserSettingFirstDayOfWeek:
+		if value != "sunday" && value != "monday" {
+			return fmt.Errorf("first day of week must be sunday or monday")
+		}
+	case entities.UserSettingDefaultTransactionStatus:
+		return validateDefaultTransactionStatus(value)
+	case entities.UserSettingDefaultTransactionDate:
+		if value != entities.DefaultTransactionDateToday && value != entities.DefaultTransactionDateRequired {
+			return fmt.Errorf("default transaction date must be %s or %s", entities.DefaultTransactionDateToday, entities.DefaultTransactionDateRequired)
+		}
+	case entities.UserSettingDashboardLayout:
+		sections := strings.Split(value, ",")
+		for i, section := range sections {
+			if !slices.Contains(entities.DashboardSections, section) {
+				return fmt.Errorf("invalid dashboard layout section: %s", section)
+			}
+			if slices.Contains(sections[:i], section) {
+				return fmt.Errorf("duplicate dashboard layout section: %s", section)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateDefaultTransactionStatus only accepts the statuses a new transaction can
+// start in, a cancelled transaction has to be cancelled explicitly
+func validateDefaultTransactionStatus(value string) error {
+	status := entities.TransactionStatus(value)
+	if status != entities.TransactionStatusPending && status != entities.TransactionStatusCleared {
+		return fmt.Errorf("default transaction status must be %s or %s", entities.TransactionStatusPending, entities.TransactionStatusCleared)
+	}
+	return nil
+}
@@ -0,0 +1,361 @@
+package finance
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// TokenIssuer signs the bearer tokens users authenticate with
+//
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/token_issuer.go . TokenIssuer
+type TokenIssuer interface {
+	IssueToken(userID, sessionID string, expiresAt time.Time) (string, error)
+	// VerifyToken returns the user and session the token was issued for,
+	// domain.ErrUnauthorized when it's invalid or expired
+	VerifyToken(token string) (string, string, error)
+}
+
+// Limits of the users, matching the users table
+const (
+	maxUserEmailLength = 254
+	maxUserNameLength  = 100
+	minPasswordLength  = 8
+	// bcrypt only reads the first 72 bytes of a password
+	maxPasswordBytes = 72
+)
+
+// Limits of the devices kept with the sessions, matching the sessions table
+const (
+	maxSessionUserAgentLength = 500
+	maxSessionIPAddressLength = 45
+)
+
+// maxAuthEvents is how many of the latest auth events of a user are listed
+const maxAuthEvents = 100
+
+// firstBookName is the name of the book created for the users registering
+// once the books recorded before users existed were taken over
+const firstBookName = "Personal"
+
+var (
+	errInvalidCredentials = fmt.Errorf("invalid email or password: %w", domain.ErrUnauthorized)
+	errNoSession          = fmt.Errorf("no signed in session: %w", domain.ErrUnauthorized)
+)
+
+// dummyPasswordHash is compared against when the email isn't registered, so
+// logins take as long whether it is or not
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("not a password"), bcrypt.DefaultCost)
+	return hash
+})
+
+type UserUseCase struct {
+	userRepo     UserRepository
+	sessionRepo  SessionRepository
+	bookRepo     BookRepository
+	settingsRepo SettingsRepository
+	tokens       TokenIssuer
+	// guard protects the sign ins from brute force, when set
+	guard *LoginGuard
+	// sessionTTL is how long a sign in lasts
+	sessionTTL time.Duration
+	now        func() time.Time
+}
+
+func NewUserUseCase(userRepo UserRepository, sessionRepo SessionRepository, bookRepo BookRepository, settingsRepo SettingsRepository, tokens TokenIssuer, guard *LoginGuard, sessionTTL time.Duration) *UserUseCase {
+	return &UserUseCase{
+		userRepo:     userRepo,
+		sessionRepo:  sessionRepo,
+		bookRepo:     bookRepo,
+		settingsRepo: settingsRepo,
+		tokens:       tokens,
+		guard:        guard,
+		sessionTTL:   sessionTTL,
+		now:          time.Now,
+	}
+}
+
+// Register creates a user and signs them in. The first user takes over the
+// books recorded before users existed, the next ones start with an empty book
+// of their own in the settings currency.
+func (uc *UserUseCase) Register(ctx context.Context, user entities.User, password string, device entities.Device) (entities.AuthToken, error) {
+	user, err := validateUser(user)
+	if err != nil {
+		return entities.AuthToken{}, err
+	}
+	if utf8.RuneCountInString(password) < minPasswordLength {
+		return entities.AuthToken{}, fmt.Errorf("password must be at least %d characters: %w", minPasswordLength, domain.ErrMalformedParameters)
+	}
+	if len(password) > maxPasswordBytes {
+		return entities.AuthToken{}, fmt.Errorf("password must be at most %d bytes: %w", maxPasswordBytes, domain.ErrMalformedParameters)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return entities.AuthToken{}, fmt.Errorf("failed to hash password: %w", err)
+	}
+	user.PasswordHash = string(hash)
+
+	created, err := uc.userRepo.CreateUser(ctx, user)
+	if err != nil {
+		return entities.AuthToken{}, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	ctx = domain.WithUser(ctx, created.ID)
+	claimed, err := uc.bookRepo.ClaimBooks(ctx, created.ID)
+	if err != nil {
+		return entities.AuthToken{}, fmt.Errorf("failed to claim books: %w", err)
+	}
+	if claimed == 0 {
+		settings, err := uc.settingsRepo.GetSettings(ctx)
+		if err != nil {
+			return entities.AuthToken{}, fmt.Errorf("failed to get settings: %w", err)
+		}
+		if _, err := uc.bookRepo.CreateBook(ctx, entities.Book{Name: firstBookName, Asset: settings.Currency}); err != nil {
+			return entities.AuthToken{}, fmt.Errorf("failed to create book: %w", err)
+		}
+	}
+
+	return uc.signIn(ctx, created, device)
+}
+
+// Login signs a user in with their email and password. With a guard, the
+// emails and addresses failing too often are locked out for a while, and
+// asked to solve a CAPTCHA before that.
+func (uc *UserUseCase) Login(ctx context.Context, email, password, captcha string, device entities.Device) (entities.AuthToken, error) {
+	email = normalizeEmail(email)
+	user, err := uc.userRepo.GetUserByEmail(ctx, email)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return entities.AuthToken{}, fmt.Errorf("failed to get user: %w", err)
+	}
+	registered := err == nil
+
+	attempt := loginAttempt{
+		email:  truncate(email, maxUserEmailLength),
+		userID: user.ID,
+		device: entities.Device{
+			UserAgent: truncate(device.UserAgent, maxSessionUserAgentLength),
+			IPAddress: truncate(device.IPAddress, maxSessionIPAddressLength),
+			ClientIP:  truncate(device.ClientIP, maxSessionIPAddressLength),
+		},
+	}
+	if uc.guard != nil {
+		if err := uc.guard.check(ctx, attempt, captcha); err != nil {
+			return entities.AuthToken{}, err
+		}
+	}
+
+	if !registered {
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(password))
+		return uc.loginFailed(ctx, attempt)
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return uc.loginFailed(ctx, attempt)
+	}
+
+	if uc.guard != nil {
+		if err := uc.guard.succeeded(ctx, attempt); err != nil {
+			return entities.AuthToken{}, err
+		}
+	}
+
+	return uc.signIn(ctx, user, device)
+}
+
+func (uc *UserUseCase) loginFailed(ctx context.Context, attempt loginAttempt) (entities.AuthToken, error) {
+	if uc.guard != nil {
+		if err := uc.guard.failed(ctx, attempt); err != nil {
+			return entities.AuthToken{}, err
+		}
+	}
+	return entities.AuthToken{}, errInvalidCredentials
+}
+
+// Authenticate returns the session a bearer token was issued for, as long as
+// it wasn't revoked, and marks it as seen
+func (uc *UserUseCase) Authenticate(ctx context.Context, token string) (entities.Session, error) {
+	userID, sessionID, err := uc.tokens.VerifyToken(token)
+	if err != nil {
+		return entities.Session{}, err
+	}
+
+	session, err := uc.sessionRepo.GetSessionByID(ctx, sessionID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return entities.Session{}, fmt.Errorf("session of the token no longer exists: %w", domain.ErrUnauthorized)
+	}
+	if err != nil {
+		return entities.Session{}, fmt.Errorf("failed to get session: %w", err)
+	}
+	if session.UserID != userID {
+		return entities.Session{}, fmt.Errorf("session of the token belongs to another user: %w", domain.ErrUnauthorized)
+	}
+	if session.RevokedAt != nil {
+		return entities.Session{}, fmt.Errorf("session was revoked: %w", domain.ErrUnauthorized)
+	}
+	if !uc.now().Before(session.ExpiresAt) {
+		return entities.Session{}, fmt.Errorf("session expired: %w", domain.ErrUnauthorized)
+	}
+
+	// Last seen is informative, failing to record it doesn't fail the request
+	_ = uc.sessionRepo.TouchSession(ctx, session.ID)
+
+	return session, nil
+}
+
+// ListSessions returns the active sessions of the signed in user, flagging the
+// one of the request
+func (uc *UserUseCase) ListSessions(ctx context.Context) ([]entities.Session, error) {
+	userID, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return nil, errNoSession
+	}
+
+	sessions, err := uc.sessionRepo.GetActiveSessions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	current, _ := domain.SessionFromContext(ctx)
+	for i := range sessions {
+		sessions[i].Current = sessions[i].ID == current
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession signs the signed in user out of one of their sessions
+func (uc *UserUseCase) RevokeSession(ctx context.Context, id string) error {
+	userID, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return errNoSession
+	}
+	if id == "" {
+		return fmt.Errorf("session ID cannot be empty: %w", domain.ErrMalformedParameters)
+	}
+
+	if err := uc.sessionRepo.RevokeSession(ctx, id, userID); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeOtherSessions signs the signed in user out of every session but the
+// one of the request, returning how many were revoked
+func (uc *UserUseCase) RevokeOtherSessions(ctx context.Context) (int64, error) {
+	userID, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return 0, errNoSession
+	}
+	current, ok := domain.SessionFromContext(ctx)
+	if !ok {
+		return 0, errNoSession
+	}
+
+	revoked, err := uc.sessionRepo.RevokeOtherSessions(ctx, userID, current)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return revoked, nil
+}
+
+// ListAuthEvents returns the latest suspicious sign ins on the account of the
+// signed in user, the newest first
+func (uc *UserUseCase) ListAuthEvents(ctx context.Context) ([]entities.AuthEvent, error) {
+	userID, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return nil, errNoSession
+	}
+	if uc.guard == nil {
+		return []entities.AuthEvent{}, nil
+	}
+
+	events, err := uc.guard.events(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auth events: %w", err)
+	}
+
+	return events, nil
+}
+
+// Logout revokes the session of the request
+func (uc *UserUseCase) Logout(ctx context.Context) error {
+	current, ok := domain.SessionFromContext(ctx)
+	if !ok {
+		return errNoSession
+	}
+
+	return uc.RevokeSession(ctx, current)
+}
+
+func (uc *UserUseCase) GetUser(ctx context.Context, id string) (entities.User, error) {
+	if id == "" {
+		return entities.User{}, fmt.Errorf("user ID cannot be empty")
+	}
+
+	user, err := uc.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		return entities.User{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
+
+// signIn starts a session of the user on the device and issues its token
+func (uc *UserUseCase) signIn(ctx context.Context, user entities.User, device entities.Device) (entities.AuthToken, error) {
+	session, err := uc.sessionRepo.CreateSession(ctx, entities.Session{
+		UserID:    user.ID,
+		UserAgent: truncate(device.UserAgent, maxSessionUserAgentLength),
+		IPAddress: truncate(device.IPAddress, maxSessionIPAddressLength),
+		ExpiresAt: uc.now().Add(uc.sessionTTL).Truncate(time.Second).UTC(),
+	})
+	if err != nil {
+		return entities.AuthToken{}, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	token, err := uc.tokens.IssueToken(user.ID, session.ID, session.ExpiresAt)
+	if err != nil {
+		return entities.AuthToken{}, fmt.Errorf("failed to issue token: %w", err)
+	}
+
+	return entities.AuthToken{Token: token, ExpiresAt: session.ExpiresAt, User: user, SessionID: session.ID}, nil
+}
+
+// truncate cuts s to at most n runes
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func validateUser(user entities.User) (entities.User, error) {
+	user.Email = normalizeEmail(user.Email)
+	user.Name = strings.TrimSpace(user.Name)
+
+	if address, err := mail.ParseAddress(user.Email); err != nil || address.Address != user.Email {
+		return entities.User{}, fmt.Errorf("invalid email %q: %w", user.Email, domain.ErrMalformedParameters)
+	}
+	if len(user.Email) > maxUserEmailLength {
+		return entities.User{}, fmt.Errorf("email must be at most %d characters: %w", maxUserEmailLength, domain.ErrMalformedParameters)
+	}
+	if utf8.RuneCountInString(user.Name) > maxUserNameLength {
+		return entities.User{}, fmt.Errorf("name must be at most %d characters: %w", maxUserNameLength, domain.ErrMalformedParameters)
+	}
+	return user, nil
+}
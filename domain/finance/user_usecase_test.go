@@ -0,0 +1,419 @@
+package finance
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUserUseCase(t *testing.T) {
+	now := time.Date(2025, time.March, 15, 9, 0, 0, 0, time.UTC)
+	expiresAt := now.Add(24 * time.Hour)
+	device := entities.Device{UserAgent: "Firefox", IPAddress: "192.0.2.1"}
+	setup := func(claimed int64) (*UserUseCase, *mocks.UserRepositoryMock, *mocks.BookRepositoryMock) {
+		users := map[string]entities.User{}
+		userRepo := &mocks.UserRepositoryMock{
+			CreateUserFunc: func(ctx context.Context, user entities.User) (entities.User, error) {
+				if _, ok := users[user.Email]; ok {
+					return entities.User{}, domain.ErrConflict
+				}
+				user.ID = "user-" + user.Email
+				users[user.Email] = user
+				return user, nil
+			},
+			GetUserByEmailFunc: func(ctx context.Context, email string) (entities.User, error) {
+				user, ok := users[email]
+				if !ok {
+					return entities.User{}, domain.ErrNotFound
+				}
+				return user, nil
+			},
+			GetUserByIDFunc: func(ctx context.Context, id string) (entities.User, error) {
+				for _, user := range users {
+					if user.ID == id {
+						return user, nil
+					}
+				}
+				return entities.User{}, domain.ErrNotFound
+			},
+		}
+		bookRepo := &mocks.BookRepositoryMock{
+			ClaimBooksFunc: func(ctx context.Context, userID string) (int64, error) {
+				return claimed, nil
+			},
+		}
+		settingsRepo := &mocks.SettingsRepositoryMock{
+			GetSettingsFunc: func(ctx context.Context) (entities.Settings, error) {
+				return entities.Settings{Currency: monetary.GBP}, nil
+			},
+		}
+		sessions := map[string]entities.Session{}
+		sessionRepo := &mocks.SessionRepositoryMock{
+			CreateSessionFunc: func(ctx context.Context, session entities.Session) (entities.Session, error) {
+				session.ID = fmt.Sprintf("session-%d", len(sessions)+1)
+				session.CreatedAt = now
+				session.LastSeenAt = now
+				sessions[session.ID] = session
+				return session, nil
+			},
+			GetSessionByIDFunc: func(ctx context.Context, id string) (entities.Session, error) {
+				session, ok := sessions[id]
+				if !ok {
+					return entities.Session{}, domain.ErrNotFound
+				}
+				return session, nil
+			},
+			GetActiveSessionsFunc: func(ctx context.Context, userID string) ([]entities.Session, error) {
+				var active []entities.Session
+				for _, session := range sessions {
+					if session.UserID == userID && session.RevokedAt == nil {
+						active = append(active, session)
+					}
+				}
+				sort.Slice(active, func(i, j int) bool { return active[i].ID < active[j].ID })
+				return active, nil
+			},
+			RevokeSessionFunc: func(ctx context.Context, id, userID string) error {
+				session, ok := sessions[id]
+				if !ok || session.UserID != userID || session.RevokedAt != nil {
+					return domain.ErrNotFound
+				}
+				session.RevokedAt = &now
+				sessions[id] = session
+				return nil
+			},
+			RevokeOtherSessionsFunc: func(ctx context.Context, userID, keepID string) (int64, error) {
+				var revoked int64
+				for id, session := range sessions {
+					if session.UserID == userID && id != keepID && session.RevokedAt == nil {
+						session.RevokedAt = &now
+						sessions[id] = session
+						revoked++
+					}
+				}
+				return revoked, nil
+			},
+		}
+		tokens := &mocks.TokenIssuerMock{
+			IssueTokenFunc: func(userID, sessionID string, expiresAt time.Time) (string, error) {
+				return "token-" + sessionID + "-" + userID, nil
+			},
+			VerifyTokenFunc: func(token string) (string, string, error) {
+				rest, ok := strings.CutPrefix(token, "token-")
+				if !ok {
+					return "", "", domain.ErrUnauthorized
+				}
+				sessionID, userID, _ := strings.Cut(strings.TrimPrefix(rest, "session-"), "-")
+				return userID, "session-" + sessionID, nil
+			},
+		}
+		uc := NewUserUseCase(userRepo, sessionRepo, bookRepo, settingsRepo, tokens, nil, 24*time.Hour)
+		uc.now = func() time.Time { return now }
+		return uc, userRepo, bookRepo
+	}
+
+	t.Run("the first user takes over the books", func(t *testing.T) {
+		uc, userRepo, bookRepo := setup(2)
+		token, err := uc.Register(context.Background(), entities.User{Email: " Alice@Example.com ", Name: "Alice"}, "correct horse", device)
+		require.NoError(t, err)
+		assert.Equal(t, "token-session-1-user-alice@example.com", token.Token)
+		assert.Equal(t, "session-1", token.SessionID)
+		assert.Equal(t, expiresAt, token.ExpiresAt)
+		assert.Equal(t, "alice@example.com", token.User.Email)
+
+		created := userRepo.CreateUserCalls()[0].User
+		assert.NotEqual(t, "correct horse", created.PasswordHash)
+		require.Len(t, bookRepo.ClaimBooksCalls(), 1)
+		assert.Equal(t, "user-alice@example.com", bookRepo.ClaimBooksCalls()[0].UserID)
+		assert.Empty(t, bookRepo.CreateBookCalls())
+	})
+
+	t.Run("the next users get a book of their own", func(t *testing.T) {
+		uc, _, bookRepo := setup(0)
+		_, err := uc.Register(context.Background(), entities.User{Email: "bob@example.com"}, "correct horse", device)
+		require.NoError(t, err)
+
+		require.Len(t, bookRepo.CreateBookCalls(), 1)
+		call := bookRepo.CreateBookCalls()[0]
+		assert.Equal(t, entities.Book{Name: "Personal", Asset: monetary.GBP}, call.Book)
+		userID, _ := domain.UserFromContext(call.Ctx)
+		assert.Equal(t, "user-bob@example.com", userID)
+	})
+
+	t.Run("register validates", func(t *testing.T) {
+		uc, _, _ := setup(0)
+		ctx := context.Background()
+		_, err := uc.Register(ctx, entities.User{Email: "not-an-email"}, "correct horse", device)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		_, err = uc.Register(ctx, entities.User{Email: "Bob <bob@example.com>"}, "correct horse", device)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		_, err = uc.Register(ctx, entities.User{Email: "bob@example.com"}, "short", device)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		_, err = uc.Register(ctx, entities.User{Email: "bob@example.com"}, strings.Repeat("a", 73), device)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+
+		_, err = uc.Register(ctx, entities.User{Email: "bob@example.com"}, "correct horse", device)
+		require.NoError(t, err)
+		_, err = uc.Register(ctx, entities.User{Email: "BOB@example.com"}, "correct horse", device)
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("login", func(t *testing.T) {
+		uc, _, _ := setup(0)
+		ctx := context.Background()
+		_, err := uc.Register(ctx, entities.User{Email: "alice@example.com"}, "correct horse", device)
+		require.NoError(t, err)
+
+		token, err := uc.Login(ctx, "ALICE@example.com", "correct horse", "", device)
+		require.NoError(t, err)
+		assert.Equal(t, "token-session-2-user-alice@example.com", token.Token)
+
+		_, err = uc.Login(ctx, "alice@example.com", "wrong horse", "", device)
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		_, err = uc.Login(ctx, "bob@example.com", "correct horse", "", device)
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("authenticate", func(t *testing.T) {
+		uc, _, _ := setup(0)
+		ctx := context.Background()
+		token, err := uc.Register(ctx, entities.User{Email: "alice@example.com"}, "correct horse", device)
+		require.NoError(t, err)
+
+		session, err := uc.Authenticate(ctx, token.Token)
+		require.NoError(t, err)
+		assert.Equal(t, "user-alice@example.com", session.UserID)
+		assert.Equal(t, "Firefox", session.UserAgent)
+
+		_, err = uc.Authenticate(ctx, "forged")
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		// The session was deleted with its user since the token was issued
+		_, err = uc.Authenticate(ctx, "token-session-9-user-bob@example.com")
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		_, err = uc.Authenticate(ctx, "token-session-1-user-bob@example.com")
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+
+		uc.now = func() time.Time { return expiresAt }
+		_, err = uc.Authenticate(ctx, token.Token)
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("sessions", func(t *testing.T) {
+		uc, _, _ := setup(0)
+		ctx := context.Background()
+		laptop, err := uc.Register(ctx, entities.User{Email: "alice@example.com"}, "correct horse", device)
+		require.NoError(t, err)
+		phone, err := uc.Login(ctx, "alice@example.com", "correct horse", "", entities.Device{UserAgent: strings.Repeat("a", 600)})
+		require.NoError(t, err)
+		_, err = uc.Login(ctx, "alice@example.com", "correct horse", "", device)
+		require.NoError(t, err)
+		_, err = uc.Register(ctx, entities.User{Email: "bob@example.com"}, "correct horse", device)
+		require.NoError(t, err)
+
+		ctx = domain.WithSession(domain.WithUser(ctx, laptop.User.ID), laptop.SessionID)
+		sessions, err := uc.ListSessions(ctx)
+		require.NoError(t, err)
+		require.Len(t, sessions, 3)
+		assert.True(t, sessions[0].Current)
+		assert.False(t, sessions[1].Current)
+		assert.Len(t, sessions[1].UserAgent, 500)
+
+		require.NoError(t, uc.RevokeSession(ctx, phone.SessionID))
+		_, err = uc.Authenticate(ctx, phone.Token)
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		// Bob's session isn't Alice's to revoke
+		assert.ErrorIs(t, uc.RevokeSession(ctx, "session-4"), domain.ErrNotFound)
+
+		revoked, err := uc.RevokeOtherSessions(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), revoked)
+		sessions, err = uc.ListSessions(ctx)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, laptop.SessionID, sessions[0].ID)
+
+		require.NoError(t, uc.Logout(ctx))
+		_, err = uc.Authenticate(ctx, laptop.Token)
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+
+		_, err = uc.ListSessions(context.Background())
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+}
+
+func TestLoginLockout(t *testing.T) {
+	now := time.Date(2025, time.March, 15, 9, 0, 0, 0, time.UTC)
+	attacker := entities.Device{UserAgent: "curl", IPAddress: "203.0.113.7", ClientIP: "203.0.113.7"}
+	laptop := entities.Device{UserAgent: "Firefox", IPAddress: "198.51.100.1", ClientIP: "198.51.100.1"}
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+	alice := entities.User{ID: "user-alice", Email: "alice@example.com", PasswordHash: string(hash)}
+
+	setup := func(captcha CaptchaVerifier) (*UserUseCase, *mocks.AuthEventRepositoryMock) {
+		failures := map[string]entities.LoginFailures{}
+		failureRepo := &mocks.LoginFailureRepositoryMock{
+			GetLoginFailuresFunc: func(ctx context.Context, scope entities.LoginScope, key string) (entities.LoginFailures, error) {
+				return failures[string(scope)+key], nil
+			},
+			RecordLoginFailureFunc: func(ctx context.Context, scope entities.LoginScope, key string, since time.Time) (entities.LoginFailures, error) {
+				f := failures[string(scope)+key]
+				if f.LastFailedAt.Before(since) {
+					f = entities.LoginFailures{Scope: scope, Key: key}
+				}
+				f.Failures++
+				f.LastFailedAt = now
+				failures[string(scope)+key] = f
+				return f, nil
+			},
+			LockLoginFunc: func(ctx context.Context, scope entities.LoginScope, key string, until time.Time) error {
+				f := failures[string(scope)+key]
+				f.LockedUntil = &until
+				failures[string(scope)+key] = f
+				return nil
+			},
+			ClearLoginFailuresFunc: func(ctx context.Context, scope entities.LoginScope, key string) error {
+				delete(failures, string(scope)+key)
+				return nil
+			},
+		}
+		eventRepo := &mocks.AuthEventRepositoryMock{}
+		userRepo := &mocks.UserRepositoryMock{
+			GetUserByEmailFunc: func(ctx context.Context, email string) (entities.User, error) {
+				if email != alice.Email {
+					return entities.User{}, domain.ErrNotFound
+				}
+				return alice, nil
+			},
+		}
+		sessionRepo := &mocks.SessionRepositoryMock{
+			CreateSessionFunc: func(ctx context.Context, session entities.Session) (entities.Session, error) {
+				session.ID = "session-1"
+				return session, nil
+			},
+		}
+		tokens := &mocks.TokenIssuerMock{
+			IssueTokenFunc: func(userID, sessionID string, expiresAt time.Time) (string, error) {
+				return "token", nil
+			},
+		}
+
+		guard := NewLoginGuard(failureRepo, eventRepo, captcha, DefaultLockoutPolicy())
+		guard.now = func() time.Time { return now }
+		uc := NewUserUseCase(userRepo, sessionRepo, &mocks.BookRepositoryMock{}, &mocks.SettingsRepositoryMock{}, tokens, guard, 24*time.Hour)
+		uc.now = guard.now
+		return uc, eventRepo
+	}
+	events := func(eventRepo *mocks.AuthEventRepositoryMock) []entities.AuthEventType {
+		var types []entities.AuthEventType
+		for _, call := range eventRepo.CreateAuthEventCalls() {
+			types = append(types, call.Event.Type)
+		}
+		return types
+	}
+
+	t.Run("locks the email with a growing backoff", func(t *testing.T) {
+		uc, eventRepo := setup(nil)
+		ctx := context.Background()
+		for range 5 {
+			_, err := uc.Login(ctx, "alice@example.com", "wrong horse", "", attacker)
+			assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		}
+		assert.Contains(t, events(eventRepo), entities.AuthEventAccountLocked)
+		assert.Equal(t, "user-alice", eventRepo.CreateAuthEventCalls()[0].Event.UserID)
+
+		// Even the right password is refused while locked
+		_, err := uc.Login(ctx, "alice@example.com", "correct horse", "", laptop)
+		var locked *domain.LockedError
+		require.ErrorAs(t, err, &locked)
+		assert.ErrorIs(t, err, domain.ErrTooManyRequests)
+		assert.Equal(t, now.Add(time.Minute), locked.Until)
+		assert.Equal(t, entities.AuthEventLoginBlocked, events(eventRepo)[len(events(eventRepo))-1])
+
+		// Each failure after the lock doubles it
+		now = now.Add(time.Minute)
+		_, err = uc.Login(ctx, "alice@example.com", "wrong horse", "", attacker)
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		_, err = uc.Login(ctx, "alice@example.com", "correct horse", "", laptop)
+		require.ErrorAs(t, err, &locked)
+		assert.Equal(t, now.Add(2*time.Minute), locked.Until)
+
+		// Signing in once the lock is over starts counting over
+		now = now.Add(2 * time.Minute)
+		_, err = uc.Login(ctx, "alice@example.com", "correct horse", "", laptop)
+		require.NoError(t, err)
+		_, err = uc.Login(ctx, "alice@example.com", "wrong horse", "", laptop)
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		_, err = uc.Login(ctx, "alice@example.com", "correct horse", "", laptop)
+		require.NoError(t, err)
+	})
+
+	t.Run("locks the address trying many emails", func(t *testing.T) {
+		uc, eventRepo := setup(nil)
+		ctx := context.Background()
+		for i := range 20 {
+			_, err := uc.Login(ctx, fmt.Sprintf("user%d@example.com", i), "password", "", attacker)
+			assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		}
+		assert.Contains(t, events(eventRepo), entities.AuthEventAddressLocked)
+
+		_, err := uc.Login(ctx, "alice@example.com", "correct horse", "", attacker)
+		assert.ErrorIs(t, err, domain.ErrTooManyRequests)
+		_, err = uc.Login(ctx, "alice@example.com", "correct horse", "", laptop)
+		require.NoError(t, err)
+	})
+
+	t.Run("counts the address the sign ins come from, not the one they claim", func(t *testing.T) {
+		uc, eventRepo := setup(nil)
+		ctx := context.Background()
+		for i := range 20 {
+			spoofed := attacker
+			spoofed.IPAddress = fmt.Sprintf("198.51.100.%d", i)
+			_, err := uc.Login(ctx, fmt.Sprintf("user%d@example.com", i), "password", "", spoofed)
+			assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		}
+		assert.Contains(t, events(eventRepo), entities.AuthEventAddressLocked)
+
+		spoofed := attacker
+		spoofed.IPAddress = laptop.IPAddress
+		_, err := uc.Login(ctx, "alice@example.com", "correct horse", "", spoofed)
+		assert.ErrorIs(t, err, domain.ErrTooManyRequests)
+	})
+
+	t.Run("asks for a captcha before locking", func(t *testing.T) {
+		captcha := &mocks.CaptchaVerifierMock{
+			VerifyCaptchaFunc: func(ctx context.Context, answer, ipAddress string) error {
+				if answer != "solved" {
+					return domain.ErrUnauthorized
+				}
+				return nil
+			},
+		}
+		uc, eventRepo := setup(captcha)
+		ctx := context.Background()
+		for range 3 {
+			_, err := uc.Login(ctx, "alice@example.com", "wrong horse", "", attacker)
+			assert.ErrorIs(t, err, domain.ErrUnauthorized)
+		}
+
+		_, err := uc.Login(ctx, "alice@example.com", "correct horse", "", laptop)
+		assert.ErrorIs(t, err, domain.ErrCaptchaRequired)
+		_, err = uc.Login(ctx, "alice@example.com", "correct horse", "guessed", laptop)
+		assert.ErrorIs(t, err, domain.ErrCaptchaRequired)
+		assert.Contains(t, events(eventRepo), entities.AuthEventCaptchaFailed)
+
+		_, err = uc.Login(ctx, "alice@example.com", "correct horse", "solved", laptop)
+		require.NoError(t, err)
+		assert.Equal(t, "198.51.100.1", captcha.VerifyCaptchaCalls()[1].IpAddress)
+	})
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "context"
+
+type userKey struct{}
+
+// WithUser returns a context scoped to a signed in user, the books read and
+// written with it are the user's
+func WithUser(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userKey{}, userID)
+}
+
+// UserFromContext returns the user the context is scoped to, false when no
+// user signed in, like in the jobs going over every book or while
+// authentication is off
+func UserFromContext(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(userKey{}).(string)
+	return userID, ok && userID != ""
+}
+
+type sessionKey struct{}
+
+// WithSession returns a context scoped to the session the user signed in
+// with
+func WithSession(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionKey{}, sessionID)
+}
+
+// SessionFromContext returns the session the context is scoped to, false
+// when no user signed in
+func SessionFromContext(ctx context.Context) (string, bool) {
+	sessionID, ok := ctx.Value(sessionKey{}).(string)
+	return sessionID, ok && sessionID != ""
+}
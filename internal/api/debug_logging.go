@@ -0,0 +1,166 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// maxLoggedBodyBytes caps how much of each body the debug logging keeps, larger
+// bodies are logged by size only
+const maxLoggedBodyBytes = 64 << 10
+
+const redacted = "[REDACTED]"
+
+// sensitiveFields are the JSON field name fragments always redacted from the
+// logged bodies
+var sensitiveFields = []string{"token", "secret", "password", "authorization", "api_key"}
+
+// DebugLogger logs the request and response bodies of the API for
+// troubleshooting, redacting sensitive fields. It's meant to be switched on
+// briefly, so it can be toggled at runtime through the admin API.
+type DebugLogger struct {
+	log                *slog.Logger
+	enabled            atomic.Bool
+	redactDescriptions atomic.Bool
+}
+
+func NewDebugLogger(log *slog.Logger, enabled, redactDescriptions bool) *DebugLogger {
+	d := &DebugLogger{log: log}
+	d.Configure(enabled, redactDescriptions)
+	return d
+}
+
+// Enabled reports whether the bodies are being logged
+func (d *DebugLogger) Enabled() bool {
+	return d.enabled.Load()
+}
+
+// RedactDescriptions reports whether description fields are redacted too
+func (d *DebugLogger) RedactDescriptions() bool {
+	return d.redactDescriptions.Load()
+}
+
+// Configure switches the body logging and the redaction of descriptions
+func (d *DebugLogger) Configure(enabled, redactDescriptions bool) {
+	d.enabled.Store(enabled)
+	d.redactDescriptions.Store(redactDescriptions)
+}
+
+// Middleware logs the request and response bodies while the logging is enabled
+func (d *DebugLogger) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !d.Enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Only keep the head of the request body, the handler still reads all
+		// of it. A read error is left for the handler to hit again
+		requestBody, _ := io.ReadAll(io.LimitReader(r.Body, maxLoggedBodyBytes+1))
+		r.Body = readCloser{io.MultiReader(bytes.NewReader(requestBody), r.Body), r.Body}
+
+		responseBody := &cappedBuffer{max: maxLoggedBodyBytes}
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		ww.Tee(responseBody)
+
+		start := time.Now()
+		next.ServeHTTP(ww, r)
+
+		redactDescriptions := d.RedactDescriptions()
+		d.log.Info("api request",
+			slog.String("request_id", middleware.GetReqID(r.Context())),
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.Int("status", ww.Status()),
+			slog.Duration("duration", time.Since(start)),
+			slog.String("request_body", redactBody(requestBody, len(requestBody) > maxLoggedBodyBytes, redactDescriptions)),
+			slog.String("response_body", redactBody(responseBody.Bytes(), responseBody.truncated, redactDescriptions)),
+		)
+	})
+}
+
+// redactBody returns the JSON body with its sensitive fields replaced. Bodies
+// that can't be parsed, including truncated ones, are logged by size only so
+// nothing slips through unredacted
+func redactBody(body []byte, truncated, redactDescriptions bool) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+	if truncated {
+		return fmt.Sprintf("<more than %d bytes>", maxLoggedBodyBytes)
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return fmt.Sprintf("<%d bytes of non JSON body>", len(body))
+	}
+
+	data, err := json.Marshal(redactValue(value, redactDescriptions))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes>", len(body))
+	}
+	return string(data)
+}
+
+func redactValue(value any, redactDescriptions bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if isSensitiveField(key, redactDescriptions) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(field, redactDescriptions)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item, redactDescriptions)
+		}
+	}
+	return value
+}
+
+func isSensitiveField(key string, redactDescriptions bool) bool {
+	key = strings.ToLower(key)
+	if redactDescriptions && key == "description" {
+		return true
+	}
+	for _, fragment := range sensitiveFields {
+		if strings.Contains(key, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// cappedBuffer keeps the first max bytes written to it, flagging whether
+// anything was left out
+type cappedBuffer struct {
+	bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if room := b.max - b.Len(); len(p) > room {
+		b.truncated = true
+		b.Buffer.Write(p[:max(room, 0)])
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
+
+// readCloser reads from the replayed body while closing the original one
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"finance/internal/config"
+	"finance/internal/tracing"
 	"fmt"
 	"net/http"
 
@@ -11,21 +12,26 @@ import (
 	httpSwagger "github.com/swaggo/http-swagger/v2"
 )
 
-func Router(cfg config.Config) *chi.Mux {
+// Router sets up the routes shared by every API version, with middlewares
+// running after the standard ones on every request
+func Router(cfg config.Config, middlewares ...func(http.Handler) http.Handler) *chi.Mux {
 	r := chi.NewRouter()
 	r.Use(
+		// Before the logger, so it prints the request ID forwarded by the web
+		tracing.Middleware,
 		middleware.RedirectSlashes,
 		cors.Handler(cors.Options{
 			AllowedOrigins:   []string{"*"},
 			AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
-			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-			ExposedHeaders:   []string{"Link"},
+			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "Idempotency-Key", "If-None-Match", "X-Book-Id", "X-CSRF-Token", tracing.RequestIDHeader, tracing.TraceparentHeader},
+			ExposedHeaders:   []string{"ETag", "Idempotent-Replayed", "Link", tracing.RequestIDHeader, tracing.TraceIDHeader},
 			AllowCredentials: false,
 			MaxAge:           300,
 		}),
 		middleware.Logger,
 		middleware.Recoverer,
 	)
+	r.Use(middlewares...)
 
 	// Swagger documentation routes
 	r.Get("/swagger/*", httpSwagger.Handler(
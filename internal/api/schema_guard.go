@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"finance/domain/entities"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-chi/render"
+)
+
+type MigrationStatusGetter interface {
+	GetMigrationStatus(ctx context.Context) (entities.MigrationStatus, error)
+}
+
+// SchemaGuard refuses writes while the database schema is behind the
+// migrations shipped with the service, which would otherwise fail halfway on
+// missing columns or constraints. Reads keep working.
+type SchemaGuard struct {
+	migrations MigrationStatusGetter
+	log        *slog.Logger
+	current    atomic.Bool
+}
+
+func NewSchemaGuard(migrations MigrationStatusGetter, log *slog.Logger) *SchemaGuard {
+	return &SchemaGuard{
+		migrations: migrations,
+		log:        log,
+	}
+}
+
+// Check reads the migration status again, returning an error describing why
+// writes are refused. Once the schema is current it isn't checked anymore
+func (g *SchemaGuard) Check(ctx context.Context) error {
+	if g.current.Load() {
+		return nil
+	}
+
+	status, err := g.migrations.GetMigrationStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check the database schema: %w", err)
+	}
+
+	switch {
+	case status.Dirty:
+		return fmt.Errorf("database schema version %d is dirty, fix it and migrate again", status.SchemaVersion)
+	case len(status.Pending) > 0:
+		return fmt.Errorf("database schema version %d is behind version %d, %d migrations pending",
+			status.SchemaVersion, status.LatestVersion, len(status.Pending))
+	}
+
+	g.current.Store(true)
+	g.log.Info("database schema is current", slog.Int64("schema_version", status.SchemaVersion))
+	return nil
+}
+
+// Middleware answers 503 to the writes while the schema is behind, letting
+// reads and the admin API through
+func (g *SchemaGuard) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet, r.Method == http.MethodHead, r.Method == http.MethodOptions,
+			strings.HasPrefix(r.URL.Path, "/api/v1/admin/"):
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if err := g.Check(r.Context()); err != nil {
+			render.Status(r, http.StatusServiceUnavailable)
+			render.JSON(w, r, map[string]string{"error": err.Error()})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
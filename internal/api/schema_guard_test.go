@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"finance/domain/entities"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type migrationStatusFunc func(ctx context.Context) (entities.MigrationStatus, error)
+
+func (f migrationStatusFunc) GetMigrationStatus(ctx context.Context) (entities.MigrationStatus, error) {
+	return f(ctx)
+}
+
+func TestSchemaGuard(t *testing.T) {
+	status := entities.MigrationStatus{
+		SchemaVersion: 9,
+		LatestVersion: 10,
+		Pending:       []entities.Migration{{Version: 10, Name: "account_institution"}},
+	}
+	checks := 0
+	guard := NewSchemaGuard(migrationStatusFunc(func(ctx context.Context) (entities.MigrationStatus, error) {
+		checks++
+		return status, nil
+	}), slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	handler := guard.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	serve := func(method, path string) int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		return w.Code
+	}
+
+	if code := serve(http.MethodGet, "/api/v1/accounts"); code != http.StatusNoContent {
+		t.Errorf("expected reads to pass while behind, got %d", code)
+	}
+	if code := serve(http.MethodPost, "/api/v1/accounts"); code != http.StatusServiceUnavailable {
+		t.Errorf("expected writes to be refused while behind, got %d", code)
+	}
+	if code := serve(http.MethodPut, "/api/v1/admin/debug-logging"); code != http.StatusNoContent {
+		t.Errorf("expected the admin API to pass while behind, got %d", code)
+	}
+
+	// Migrating lets the writes through without restarting
+	status = entities.MigrationStatus{SchemaVersion: 10, LatestVersion: 10}
+	if code := serve(http.MethodDelete, "/api/v1/accounts/1"); code != http.StatusNoContent {
+		t.Errorf("expected writes to pass once migrated, got %d", code)
+	}
+
+	checksWhenCurrent := checks
+	serve(http.MethodPost, "/api/v1/accounts")
+	if checks != checksWhenCurrent {
+		t.Error("expected the schema not to be checked again once current")
+	}
+}
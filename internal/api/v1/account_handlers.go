@@ -2,47 +2,114 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 	"finance/domain/entities"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
-	"github.com/guilhermebr/gox/monetary"
 )
 
 // Account request/response types
 type CreateAccountRequest struct {
-	Name        string               `json:"name"`
-	Type        entities.AccountType `json:"type"`
-	Asset       string               `json:"asset"`
-	Description string               `json:"description"`
+	Name               string                         `json:"name"`
+	Type               entities.AccountType           `json:"type"`
+	Asset              string                         `json:"asset"`
+	Description        string                         `json:"description"`
+	Classification     entities.AccountClassification `json:"classification,omitempty"`
+	Institution        string                         `json:"institution,omitempty"`
+	AccountNumberLast4 string                         `json:"account_number_last4,omitempty"`
+	Color              string                         `json:"color,omitempty"`
+	Icon               string                         `json:"icon,omitempty"`
+	// Billing cycle of credit cards, both set or both left out
+	StatementClosingDay int `json:"statement_closing_day,omitempty"`
+	PaymentDueDay       int `json:"payment_due_day,omitempty"`
 }
 
 type UpdateAccountRequest struct {
-	Name        string               `json:"name"`
-	Type        entities.AccountType `json:"type"`
-	Asset       string               `json:"asset"`
-	Description string               `json:"description"`
+	Name               string                         `json:"name"`
+	Type               entities.AccountType           `json:"type"`
+	Asset              string                         `json:"asset"`
+	Description        string                         `json:"description"`
+	Classification     entities.AccountClassification `json:"classification,omitempty"`
+	Institution        string                         `json:"institution,omitempty"`
+	AccountNumberLast4 string                         `json:"account_number_last4,omitempty"`
+	Color              string                         `json:"color,omitempty"`
+	Icon               string                         `json:"icon,omitempty"`
+	// Billing cycle of credit cards, both set or both left out
+	StatementClosingDay int `json:"statement_closing_day,omitempty"`
+	PaymentDueDay       int `json:"payment_due_day,omitempty"`
 }
 
 type AccountResponse struct {
-	ID          string               `json:"id"`
-	Name        string               `json:"name"`
-	Type        entities.AccountType `json:"type"`
-	Asset       string               `json:"asset"`
-	Description string               `json:"description"`
-	CreatedAt   string               `json:"created_at"`
-	UpdatedAt   string               `json:"updated_at"`
+	ID                  string                         `json:"id"`
+	Name                string                         `json:"name"`
+	Type                entities.AccountType           `json:"type"`
+	Asset               string                         `json:"asset"`
+	Description         string                         `json:"description"`
+	Classification      entities.AccountClassification `json:"classification,omitempty"`
+	Institution         string                         `json:"institution,omitempty"`
+	AccountNumberLast4  string                         `json:"account_number_last4,omitempty"`
+	Color               string                         `json:"color,omitempty"`
+	Icon                string                         `json:"icon,omitempty"`
+	StatementClosingDay int                            `json:"statement_closing_day,omitempty"`
+	PaymentDueDay       int                            `json:"payment_due_day,omitempty"`
+	CreatedAt           string                         `json:"created_at"`
+	UpdatedAt           string                         `json:"updated_at"`
+	TransactionCount    int64                          `json:"transaction_count"`
+	LastTransactionDate *string                        `json:"last_transaction_date,omitempty"`
+	Balance             *BalanceResponse               `json:"balance,omitempty"`
+}
+
+// AccountStatementResponse lists the cleared transactions of an account over a
+// period, oldest first, with the balance after each one
+type AccountStatementResponse struct {
+	AccountID          string                  `json:"account_id"`
+	Institution        string                  `json:"institution,omitempty"`
+	AccountNumberLast4 string                  `json:"account_number_last4,omitempty"`
+	Asset              string                  `json:"asset"`
+	From               string                  `json:"from"`
+	To                 string                  `json:"to"`
+	OpeningBalance     string                  `json:"opening_balance"`
+	ClosingBalance     string                  `json:"closing_balance"`
+	Transactions       []StatementLineResponse `json:"transactions"`
+}
+
+type StatementLineResponse struct {
+	ID             string `json:"id"`
+	CategoryID     string `json:"category_id"`
+	Date           string `json:"date"`
+	Description    string `json:"description"`
+	Amount         string `json:"amount"`
+	RunningBalance string `json:"running_balance"`
+	ProjectID      string `json:"project_id,omitempty"`
+}
+
+// AccountPeriodSummaryResponse is the balance of an account at the start and end of
+// a period and the money that came in and went out in between
+type AccountPeriodSummaryResponse struct {
+	AccountID          string `json:"account_id"`
+	Institution        string `json:"institution,omitempty"`
+	AccountNumberLast4 string `json:"account_number_last4,omitempty"`
+	Asset              string `json:"asset"`
+	From               string `json:"from"`
+	To                 string `json:"to"`
+	OpeningBalance     string `json:"opening_balance"`
+	TotalIn            string `json:"total_in"`
+	TotalOut           string `json:"total_out"`
+	ClosingBalance     string `json:"closing_balance"`
 }
 
 //go:generate moq -skip-ensure -stub -pkg mocks -out mocks/account_uc.go . AccountUseCase
 type AccountUseCase interface {
 	CreateAccount(ctx context.Context, account entities.Account) (entities.Account, error)
 	GetAccountByID(ctx context.Context, id string) (entities.Account, error)
-	GetAllAccounts(ctx context.Context) ([]entities.Account, error)
+	GetAccountWithBalance(ctx context.Context, id string) (entities.Account, error)
+	GetAccountsPage(ctx context.Context, sort []entities.SortField, limit, offset int, withBalances bool) (entities.Page[entities.Account], error)
 	UpdateAccount(ctx context.Context, account entities.Account) (entities.Account, error)
 	DeleteAccount(ctx context.Context, id string) error
+	GetAccountStatement(ctx context.Context, id string, from, to time.Time, filter entities.TransactionFilter) (entities.AccountStatement, error)
+	GetAccountPeriodSummary(ctx context.Context, id string, from, to time.Time) (entities.AccountPeriodSummary, error)
 }
 
 // Account handlers
@@ -57,42 +124,60 @@ type AccountUseCase interface {
 //	@Param			account	body		CreateAccountRequest	true	"Account data"
 //	@Success		201		{object}	AccountResponse			"Account created successfully"
 //	@Failure		400		{object}	ErrorResponseBody		"Bad request"
+//	@Failure		402		{object}	ErrorResponseBody		"Quota of accounts of the free tier reached"
+//	@Failure		413		{object}	ErrorResponseBody		"Request body too large"
 //	@Router			/accounts [post]
 func (h *ApiHandlers) CreateAccount(w http.ResponseWriter, r *http.Request) {
 	var req CreateAccountRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		errorResponse(w, r, http.StatusBadRequest, err)
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
 		return
 	}
 
 	// Validate and get asset
-	asset, ok := monetary.FindAssetByName(req.Asset)
+	asset, ok := entities.FindSupportedAsset(req.Asset)
 	if !ok {
 		errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("asset", req.Asset))
 		return
 	}
 
 	account := entities.Account{
-		Name:        req.Name,
-		Type:        req.Type,
-		Asset:       asset,
-		Description: req.Description,
+		Name:                req.Name,
+		Type:                req.Type,
+		Asset:               asset,
+		Description:         req.Description,
+		Classification:      req.Classification,
+		Institution:         req.Institution,
+		AccountNumberLast4:  req.AccountNumberLast4,
+		Color:               req.Color,
+		Icon:                req.Icon,
+		StatementClosingDay: req.StatementClosingDay,
+		PaymentDueDay:       req.PaymentDueDay,
 	}
 
 	createdAccount, err := h.AccountUseCase.CreateAccount(r.Context(), account)
 	if err != nil {
-		errorResponse(w, r, http.StatusBadRequest, err)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
 		return
 	}
 
 	response := AccountResponse{
-		ID:          createdAccount.ID,
-		Name:        createdAccount.Name,
-		Type:        createdAccount.Type,
-		Asset:       createdAccount.Asset.Asset,
-		Description: createdAccount.Description,
-		CreatedAt:   createdAccount.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   createdAccount.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:                  createdAccount.ID,
+		Name:                createdAccount.Name,
+		Type:                createdAccount.Type,
+		Asset:               createdAccount.Asset.Asset,
+		Description:         createdAccount.Description,
+		Classification:      createdAccount.EffectiveClassification(),
+		Institution:         createdAccount.Institution,
+		AccountNumberLast4:  createdAccount.AccountNumberLast4,
+		Color:               createdAccount.Color,
+		Icon:                createdAccount.Icon,
+		StatementClosingDay: createdAccount.StatementClosingDay,
+		PaymentDueDay:       createdAccount.PaymentDueDay,
+		CreatedAt:           createdAccount.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:           createdAccount.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		TransactionCount:    createdAccount.TransactionCount,
+		LastTransactionDate: formatDate(createdAccount.LastTransactionDate),
 	}
 
 	render.Status(r, http.StatusCreated)
@@ -106,10 +191,12 @@ func (h *ApiHandlers) CreateAccount(w http.ResponseWriter, r *http.Request) {
 //	@Tags			accounts
 //	@Accept			json
 //	@Produce		json
-//	@Param			id	path		string			true	"Account ID"
-//	@Success		200	{object}	AccountResponse	"Account retrieved successfully"
-//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
-//	@Failure		404	{object}	ErrorResponseBody	"Account not found"
+//	@Param			id		path		string				true	"Account ID"
+//	@Param			include	query		string				false	"Related resources to embed (balance)"
+//	@Success		200		{object}	AccountResponse		"Account retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody	"Account not found"
+//	@Failure		500		{object}	ErrorResponseBody	"Internal server error"
 //	@Router			/accounts/{id} [get]
 func (h *ApiHandlers) GetAccountByID(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
@@ -118,25 +205,52 @@ func (h *ApiHandlers) GetAccountByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	account, err := h.AccountUseCase.GetAccountByID(r.Context(), id)
+	include, err := parseInclude(r, "balance")
 	if err != nil {
-		errorResponse(w, r, http.StatusNotFound, err)
+		errorResponse(w, r, http.StatusBadRequest, err)
 		return
 	}
 
-	if account.ID == "" {
-		errorResponse(w, r, http.StatusNotFound, errNotFound("account"))
+	var account entities.Account
+	if include["balance"] {
+		account, err = h.AccountUseCase.GetAccountWithBalance(r.Context(), id)
+	} else {
+		account, err = h.AccountUseCase.GetAccountByID(r.Context(), id)
+	}
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
 		return
 	}
 
 	response := AccountResponse{
-		ID:          account.ID,
-		Name:        account.Name,
-		Type:        account.Type,
-		Asset:       account.Asset.Asset,
-		Description: account.Description,
-		CreatedAt:   account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   account.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:                  account.ID,
+		Name:                account.Name,
+		Type:                account.Type,
+		Asset:               account.Asset.Asset,
+		Description:         account.Description,
+		Classification:      account.EffectiveClassification(),
+		Institution:         account.Institution,
+		AccountNumberLast4:  account.AccountNumberLast4,
+		Color:               account.Color,
+		Icon:                account.Icon,
+		StatementClosingDay: account.StatementClosingDay,
+		PaymentDueDay:       account.PaymentDueDay,
+		CreatedAt:           account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:           account.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		TransactionCount:    account.TransactionCount,
+		LastTransactionDate: formatDate(account.LastTransactionDate),
+	}
+
+	// Add balance information if requested
+	if account.Balance != nil {
+		response.Balance = &BalanceResponse{
+			AccountID:        account.Balance.AccountID,
+			CurrentBalance:   account.Balance.CurrentBalance.String(),
+			PendingBalance:   account.Balance.PendingBalance.String(),
+			AvailableBalance: account.Balance.AvailableBalance.String(),
+			LastCalculated:   account.Balance.LastCalculated.Format("2006-01-02T15:04:05Z07:00"),
+			Deltas:           toBalanceDeltaResponses(account.Balance.Deltas),
+		}
 	}
 
 	render.JSON(w, r, response)
@@ -145,34 +259,211 @@ func (h *ApiHandlers) GetAccountByID(w http.ResponseWriter, r *http.Request) {
 // GetAllAccounts retrieves all accounts
 //
 //	@Summary		Get all accounts
-//	@Description	Retrieve a list of all financial accounts
+//	@Description	Retrieve a page of the financial accounts with the total number of accounts and links to the next and previous pages
 //	@Tags			accounts
 //	@Accept			json
 //	@Produce		json
-//	@Success		200	{array}		AccountResponse		"Accounts retrieved successfully"
-//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Param			include	query		string				false	"Related resources to embed (balance)"
+//	@Param			fields	query		string				false	"Comma separated fields to return for each account"
+//	@Param			sort	query		string				false	"Comma separated sort fields (name, type, created_at, transaction_count, last_transaction_date), prefix with - for descending"
+//	@Param			limit	query		int					false	"Page size, 50 by default and at most 500"
+//	@Param			offset	query		int					false	"Number of accounts to skip"
+//	@Success		200		{object}	PageResponse[AccountResponse]	"Accounts retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		500		{object}	ErrorResponseBody	"Internal server error"
 //	@Router			/accounts [get]
 func (h *ApiHandlers) GetAllAccounts(w http.ResponseWriter, r *http.Request) {
-	accounts, err := h.AccountUseCase.GetAllAccounts(r.Context())
+	include, err := parseInclude(r, "balance")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	fields, err := parseFields(r, AccountResponse{})
 	if err != nil {
-		errorResponse(w, r, http.StatusInternalServerError, err)
+		errorResponse(w, r, http.StatusBadRequest, err)
 		return
 	}
 
-	responses := make([]AccountResponse, len(accounts))
-	for i, account := range accounts {
+	limit, offset, err := parsePage(r)
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	sort := entities.ParseSort(r.URL.Query().Get("sort"))
+
+	page, err := h.AccountUseCase.GetAccountsPage(r.Context(), sort, limit, offset, include["balance"])
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	responses := make([]AccountResponse, len(page.Items))
+	for i, account := range page.Items {
 		responses[i] = AccountResponse{
-			ID:          account.ID,
-			Name:        account.Name,
-			Type:        account.Type,
-			Asset:       account.Asset.Asset,
-			Description: account.Description,
-			CreatedAt:   account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt:   account.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			ID:                  account.ID,
+			Name:                account.Name,
+			Type:                account.Type,
+			Asset:               account.Asset.Asset,
+			Description:         account.Description,
+			Classification:      account.EffectiveClassification(),
+			Institution:         account.Institution,
+			AccountNumberLast4:  account.AccountNumberLast4,
+			Color:               account.Color,
+			Icon:                account.Icon,
+			StatementClosingDay: account.StatementClosingDay,
+			PaymentDueDay:       account.PaymentDueDay,
+			CreatedAt:           account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			UpdatedAt:           account.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			TransactionCount:    account.TransactionCount,
+			LastTransactionDate: formatDate(account.LastTransactionDate),
+		}
+
+		// Add balance information if requested
+		if account.Balance != nil {
+			responses[i].Balance = &BalanceResponse{
+				AccountID:        account.Balance.AccountID,
+				CurrentBalance:   account.Balance.CurrentBalance.String(),
+				PendingBalance:   account.Balance.PendingBalance.String(),
+				AvailableBalance: account.Balance.AvailableBalance.String(),
+				LastCalculated:   account.Balance.LastCalculated.Format("2006-01-02T15:04:05Z07:00"),
+				Deltas:           toBalanceDeltaResponses(account.Balance.Deltas),
+			}
+		}
+	}
+
+	renderPage(w, r, page, responses, fields)
+}
+
+// GetAccountStatement retrieves the statement of an account
+//
+//	@Summary		Get account statement
+//	@Description	List the cleared transactions of an account between from and to, oldest first, with the running balance after each one. to defaults to today and from to the first day of its month. With a project_id only the transactions of that project are listed, the balances are still the account's
+//	@Tags			accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string						true	"Account ID"
+//	@Param			from		query		string						false	"First day of the statement (YYYY-MM-DD)"
+//	@Param			to			query		string						false	"Last day of the statement (YYYY-MM-DD)"
+//	@Param			project_id	query		string						false	"Only transactions filed under this project"
+//	@Success		200			{object}	AccountStatementResponse	"Statement retrieved successfully"
+//	@Failure		400			{object}	ErrorResponseBody			"Bad request"
+//	@Failure		404			{object}	ErrorResponseBody			"Account not found"
+//	@Failure		500			{object}	ErrorResponseBody			"Internal server error"
+//	@Router			/accounts/{id}/statement [get]
+func (h *ApiHandlers) GetAccountStatement(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("id"))
+		return
+	}
+
+	from, err := parseDateParam(r, "from")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	to, err := parseDateParam(r, "to")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	projectID, err := parseUUIDParam(r, "project_id")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	statement, err := h.AccountUseCase.GetAccountStatement(r.Context(), id, from, to, entities.TransactionFilter{ProjectID: projectID})
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := AccountStatementResponse{
+		AccountID:          statement.Account.ID,
+		Institution:        statement.Account.Institution,
+		AccountNumberLast4: statement.Account.AccountNumberLast4,
+		Asset:              statement.Account.Asset.Asset,
+		From:               statement.From.Format("2006-01-02"),
+		To:                 statement.To.Format("2006-01-02"),
+		OpeningBalance:     statement.OpeningBalance.String(),
+		ClosingBalance:     statement.ClosingBalance.String(),
+		Transactions:       make([]StatementLineResponse, len(statement.Lines)),
+	}
+
+	for i, line := range statement.Lines {
+		response.Transactions[i] = StatementLineResponse{
+			ID:             line.Transaction.ID,
+			CategoryID:     line.Transaction.CategoryID,
+			Date:           line.Transaction.Date.Format("2006-01-02"),
+			Description:    line.Transaction.Description,
+			Amount:         line.Transaction.Monetary.String(),
+			RunningBalance: line.RunningBalance.String(),
+			ProjectID:      line.Transaction.ProjectID,
 		}
 	}
 
-	render.JSON(w, r, responses)
+	render.JSON(w, r, response)
+}
+
+// GetAccountPeriodSummary retrieves the balances and totals of an account over a period
+//
+//	@Summary		Get account period summary
+//	@Description	Get the opening balance, total in, total out and closing balance of an account between from and to, counting cleared transactions. to defaults to today and from to the first day of its month
+//	@Tags			accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Account ID"
+//	@Param			from	query		string							false	"First day of the period (YYYY-MM-DD)"
+//	@Param			to		query		string							false	"Last day of the period (YYYY-MM-DD)"
+//	@Success		200		{object}	AccountPeriodSummaryResponse	"Period summary retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody				"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody				"Account not found"
+//	@Failure		500		{object}	ErrorResponseBody				"Internal server error"
+//	@Router			/accounts/{id}/period-summary [get]
+func (h *ApiHandlers) GetAccountPeriodSummary(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("id"))
+		return
+	}
+
+	from, err := parseDateParam(r, "from")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	to, err := parseDateParam(r, "to")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	summary, err := h.AccountUseCase.GetAccountPeriodSummary(r.Context(), id, from, to)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := AccountPeriodSummaryResponse{
+		AccountID:          summary.Account.ID,
+		Institution:        summary.Account.Institution,
+		AccountNumberLast4: summary.Account.AccountNumberLast4,
+		Asset:              summary.Account.Asset.Asset,
+		From:               summary.From.Format("2006-01-02"),
+		To:                 summary.To.Format("2006-01-02"),
+		OpeningBalance:     summary.OpeningBalance.String(),
+		TotalIn:            summary.TotalIn.String(),
+		TotalOut:           summary.TotalOut.String(),
+		ClosingBalance:     summary.ClosingBalance.String(),
+	}
+
+	render.JSON(w, r, response)
 }
 
 // UpdateAccount updates an existing account
@@ -186,7 +477,9 @@ func (h *ApiHandlers) GetAllAccounts(w http.ResponseWriter, r *http.Request) {
 //	@Param			account	body		UpdateAccountRequest	true	"Updated account data"
 //	@Success		200		{object}	AccountResponse		"Account updated successfully"
 //	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		413		{object}	ErrorResponseBody	"Request body too large"
 //	@Failure		404		{object}	ErrorResponseBody	"Account not found"
+//	@Failure		409		{object}	ErrorResponseBody	"Asset or liability type can't change once the account has transactions"
 //	@Router			/accounts/{id} [put]
 func (h *ApiHandlers) UpdateAccount(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
@@ -196,40 +489,56 @@ func (h *ApiHandlers) UpdateAccount(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req UpdateAccountRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		errorResponse(w, r, http.StatusBadRequest, err)
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
 		return
 	}
 
 	// Validate and get asset
-	asset, ok := monetary.FindAssetByName(req.Asset)
+	asset, ok := entities.FindSupportedAsset(req.Asset)
 	if !ok {
 		errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("asset", req.Asset))
 		return
 	}
 
 	account := entities.Account{
-		ID:          id,
-		Name:        req.Name,
-		Type:        req.Type,
-		Asset:       asset,
-		Description: req.Description,
+		ID:                  id,
+		Name:                req.Name,
+		Type:                req.Type,
+		Asset:               asset,
+		Description:         req.Description,
+		Classification:      req.Classification,
+		Institution:         req.Institution,
+		AccountNumberLast4:  req.AccountNumberLast4,
+		Color:               req.Color,
+		Icon:                req.Icon,
+		StatementClosingDay: req.StatementClosingDay,
+		PaymentDueDay:       req.PaymentDueDay,
 	}
 
 	updatedAccount, err := h.AccountUseCase.UpdateAccount(r.Context(), account)
 	if err != nil {
-		errorResponse(w, r, http.StatusBadRequest, err)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
 		return
 	}
 
 	response := AccountResponse{
-		ID:          updatedAccount.ID,
-		Name:        updatedAccount.Name,
-		Type:        updatedAccount.Type,
-		Asset:       updatedAccount.Asset.Asset,
-		Description: updatedAccount.Description,
-		CreatedAt:   updatedAccount.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   updatedAccount.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:                  updatedAccount.ID,
+		Name:                updatedAccount.Name,
+		Type:                updatedAccount.Type,
+		Asset:               updatedAccount.Asset.Asset,
+		Description:         updatedAccount.Description,
+		Classification:      updatedAccount.EffectiveClassification(),
+		Institution:         updatedAccount.Institution,
+		AccountNumberLast4:  updatedAccount.AccountNumberLast4,
+		Color:               updatedAccount.Color,
+		Icon:                updatedAccount.Icon,
+		StatementClosingDay: updatedAccount.StatementClosingDay,
+		PaymentDueDay:       updatedAccount.PaymentDueDay,
+		CreatedAt:           updatedAccount.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:           updatedAccount.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		TransactionCount:    updatedAccount.TransactionCount,
+		LastTransactionDate: formatDate(updatedAccount.LastTransactionDate),
 	}
 
 	render.JSON(w, r, response)
@@ -256,9 +565,18 @@ func (h *ApiHandlers) DeleteAccount(w http.ResponseWriter, r *http.Request) {
 
 	err := h.AccountUseCase.DeleteAccount(r.Context(), id)
 	if err != nil {
-		errorResponse(w, r, http.StatusBadRequest, err)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// formatDate formats an optional date, nil stays nil so it's omitted from the response
+func formatDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	formatted := date.Format("2006-01-02")
+	return &formatted
+}
@@ -0,0 +1,299 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"finance/internal/logging"
+	"finance/internal/metrics"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+)
+
+// Admin request/response types
+type UpdateDebugLoggingRequest struct {
+	Enabled            bool `json:"enabled"`
+	RedactDescriptions bool `json:"redact_descriptions"`
+}
+
+type DebugLoggingResponse struct {
+	Enabled            bool `json:"enabled"`
+	RedactDescriptions bool `json:"redact_descriptions"`
+}
+
+type UpdateLogLevelRequest struct {
+	Level string `json:"level" example:"debug"`
+	// How long to keep the level before reverting to the configured one, e.g.
+	// 30m. Kept until changed again when empty
+	Duration string `json:"duration,omitempty" example:"30m"`
+}
+
+type LogLevelResponse struct {
+	Level      string     `json:"level"`
+	Configured string     `json:"configured"`
+	Until      *time.Time `json:"until,omitempty"`
+}
+
+type RouteStatsResponse struct {
+	Method       string  `json:"method"`
+	Route        string  `json:"route"`
+	Requests     int64   `json:"requests"`
+	ClientErrors int64   `json:"client_errors"`
+	ServerErrors int64   `json:"server_errors"`
+	ErrorRate    float64 `json:"error_rate"`
+	P95Ms        float64 `json:"p95_ms"`
+	MaxMs        float64 `json:"max_ms"`
+}
+
+type StatsWindowResponse struct {
+	Window string               `json:"window"`
+	Routes []RouteStatsResponse `json:"routes"`
+}
+
+type StatsResponse struct {
+	Windows []StatsWindowResponse `json:"windows"`
+}
+
+type MigrationResponse struct {
+	Version int64  `json:"version"`
+	Name    string `json:"name"`
+}
+
+type MigrationStatusResponse struct {
+	SchemaVersion int64               `json:"schema_version"`
+	LatestVersion int64               `json:"latest_version"`
+	Dirty         bool                `json:"dirty"`
+	Current       bool                `json:"current"`
+	Applied       []MigrationResponse `json:"applied"`
+	Pending       []MigrationResponse `json:"pending"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/migration_uc.go . MigrationUseCase
+type MigrationUseCase interface {
+	GetMigrationStatus(ctx context.Context) (entities.MigrationStatus, error)
+}
+
+// DebugLogging switches the logging of the API request and response bodies
+type DebugLogging interface {
+	Enabled() bool
+	RedactDescriptions() bool
+	Configure(enabled, redactDescriptions bool)
+}
+
+// LogLevel changes the service log level at runtime
+type LogLevel interface {
+	Level() slog.Level
+	Configured() slog.Level
+	Until() time.Time
+	Set(level slog.Level, duration time.Duration)
+}
+
+// RouteStats sums up the requests served by each route over recent windows
+type RouteStats interface {
+	Summary(window time.Duration) []metrics.RouteSummary
+}
+
+// statsWindows are the windows reported by the stats endpoint
+var statsWindows = []struct {
+	label    string
+	duration time.Duration
+}{
+	{"5m", 5 * time.Minute},
+	{"15m", 15 * time.Minute},
+	{"1h", time.Hour},
+}
+
+// Admin handlers
+
+// GetDebugLogging reports whether the request and response bodies are logged
+//
+//	@Summary		Get debug logging
+//	@Description	Report whether the API request and response bodies are logged, and whether descriptions are redacted from them on top of tokens, secrets and passwords
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	DebugLoggingResponse	"Debug logging retrieved successfully"
+//	@Failure		403	{object}	ErrorResponseBody	"Not an operator of the deployment"
+//	@Router			/admin/debug-logging [get]
+func (h *ApiHandlers) GetDebugLogging(w http.ResponseWriter, r *http.Request) {
+	render.JSON(w, r, h.debugLoggingResponse())
+}
+
+// UpdateDebugLogging switches the logging of the request and response bodies
+//
+//	@Summary		Update debug logging
+//	@Description	Switch the logging of the API request and response bodies at runtime. Tokens, secrets and passwords are always redacted, descriptions only when redact_descriptions is set.
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			debug_logging	body		UpdateDebugLoggingRequest	true	"Debug logging switches"
+//	@Success		200				{object}	DebugLoggingResponse		"Debug logging updated successfully"
+//	@Failure		400				{object}	ErrorResponseBody			"Bad request"
+//	@Failure		403				{object}	ErrorResponseBody			"Not an operator of the deployment"
+//	@Failure		413				{object}	ErrorResponseBody			"Request body too large"
+//	@Router			/admin/debug-logging [put]
+func (h *ApiHandlers) UpdateDebugLogging(w http.ResponseWriter, r *http.Request) {
+	var req UpdateDebugLoggingRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	h.DebugLogging.Configure(req.Enabled, req.RedactDescriptions)
+	slog.Info("debug logging updated", "enabled", req.Enabled, "redact_descriptions", req.RedactDescriptions)
+
+	render.JSON(w, r, h.debugLoggingResponse())
+}
+
+func (h *ApiHandlers) debugLoggingResponse() DebugLoggingResponse {
+	return DebugLoggingResponse{
+		Enabled:            h.DebugLogging.Enabled(),
+		RedactDescriptions: h.DebugLogging.RedactDescriptions(),
+	}
+}
+
+// GetLogLevel reports the current log level
+//
+//	@Summary		Get log level
+//	@Description	Report the current log level, the level the service was configured with and, when the current level is temporary, when it reverts
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	LogLevelResponse	"Log level retrieved successfully"
+//	@Failure		403	{object}	ErrorResponseBody	"Not an operator of the deployment"
+//	@Router			/admin/log-level [get]
+func (h *ApiHandlers) GetLogLevel(w http.ResponseWriter, r *http.Request) {
+	render.JSON(w, r, h.logLevelResponse())
+}
+
+// UpdateLogLevel changes the log level without restarting the service
+//
+//	@Summary		Update log level
+//	@Description	Change the log level (debug, info, warn or error) at runtime. With a duration, such as 30m, the level reverts to the configured one when it's up; without, it's kept until changed again or the service restarts.
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			log_level	body		UpdateLogLevelRequest	true	"Log level"
+//	@Success		200			{object}	LogLevelResponse		"Log level updated successfully"
+//	@Failure		400			{object}	ErrorResponseBody		"Bad request"
+//	@Failure		403			{object}	ErrorResponseBody		"Not an operator of the deployment"
+//	@Failure		413			{object}	ErrorResponseBody		"Request body too large"
+//	@Router			/admin/log-level [put]
+func (h *ApiHandlers) UpdateLogLevel(w http.ResponseWriter, r *http.Request) {
+	var req UpdateLogLevelRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	level, err := logging.ParseLevel(req.Level)
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	var duration time.Duration
+	if req.Duration != "" {
+		duration, err = time.ParseDuration(req.Duration)
+		if err != nil || duration <= 0 {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("duration", req.Duration))
+			return
+		}
+	}
+
+	h.LogLevel.Set(level, duration)
+	slog.Warn("log level updated", "level", level.String(), "duration", duration.String())
+
+	render.JSON(w, r, h.logLevelResponse())
+}
+
+func (h *ApiHandlers) logLevelResponse() LogLevelResponse {
+	response := LogLevelResponse{
+		Level:      strings.ToLower(h.LogLevel.Level().String()),
+		Configured: strings.ToLower(h.LogLevel.Configured().String()),
+	}
+	if until := h.LogLevel.Until(); !until.IsZero() {
+		response.Until = &until
+	}
+	return response
+}
+
+// GetStats sums up the requests served by each route
+//
+//	@Summary		Get route stats
+//	@Description	Request counts, client (4xx) and server (5xx) errors, error rate (share of 5xx), estimated p95 and max latency per route over the last 5 minutes, 15 minutes and hour, busiest routes first. Kept in memory, so they reset when the service restarts.
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	StatsResponse	"Route stats retrieved successfully"
+//	@Failure		403	{object}	ErrorResponseBody	"Not an operator of the deployment"
+//	@Router			/admin/stats [get]
+func (h *ApiHandlers) GetStats(w http.ResponseWriter, r *http.Request) {
+	response := StatsResponse{Windows: make([]StatsWindowResponse, 0, len(statsWindows))}
+	for _, window := range statsWindows {
+		summaries := h.RouteStats.Summary(window.duration)
+		routes := make([]RouteStatsResponse, 0, len(summaries))
+		for _, summary := range summaries {
+			routes = append(routes, RouteStatsResponse{
+				Method:       summary.Method,
+				Route:        summary.Route,
+				Requests:     summary.Requests,
+				ClientErrors: summary.ClientErrors,
+				ServerErrors: summary.ServerErrors,
+				ErrorRate:    summary.ErrorRate(),
+				P95Ms:        float64(summary.P95) / float64(time.Millisecond),
+				MaxMs:        float64(summary.Max) / float64(time.Millisecond),
+			})
+		}
+
+		response.Windows = append(response.Windows, StatsWindowResponse{
+			Window: window.label,
+			Routes: routes,
+		})
+	}
+
+	render.JSON(w, r, response)
+}
+
+// GetMigrationStatus reports the applied and pending database migrations
+//
+//	@Summary		Get migration status
+//	@Description	Compare the schema version recorded in the database with the migrations shipped with the service. While it's behind or dirty, writes are refused with 503 unless SERVICE_REQUIRE_CURRENT_SCHEMA is false.
+//	@Tags			admin
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	MigrationStatusResponse	"Migration status retrieved successfully"
+//	@Failure		403	{object}	ErrorResponseBody	"Not an operator of the deployment"
+//	@Failure		500	{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/admin/migrations [get]
+func (h *ApiHandlers) GetMigrationStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := h.MigrationUseCase.GetMigrationStatus(r.Context())
+	if err != nil {
+		slog.Error("failed to get migration status", "error", err)
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	render.JSON(w, r, MigrationStatusResponse{
+		SchemaVersion: status.SchemaVersion,
+		LatestVersion: status.LatestVersion,
+		Dirty:         status.Dirty,
+		Current:       status.Current(),
+		Applied:       migrationResponses(status.Applied),
+		Pending:       migrationResponses(status.Pending),
+	})
+}
+
+func migrationResponses(migrations []entities.Migration) []MigrationResponse {
+	responses := make([]MigrationResponse, 0, len(migrations))
+	for _, migration := range migrations {
+		responses = append(responses, MigrationResponse{
+			Version: migration.Version,
+			Name:    migration.Name,
+		})
+	}
+	return responses
+}
@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// AssetRequest is a custom asset to enable, like loyalty points or airline
+// miles. Precision is the number of decimal places of its amounts and the
+// symbol defaults to the code.
+type AssetRequest struct {
+	Code      string `json:"code" example:"MILES"`
+	Name      string `json:"name" example:"Airline miles"`
+	Symbol    string `json:"symbol" example:"mi"`
+	Precision int    `json:"precision" example:"0"`
+}
+
+// AssetResponse is a currency amounts can be kept in. Precision is the number
+// of decimal places of its amounts, the exponent of its minor unit, and Class
+// tells currencies, cryptocurrencies and custom assets apart.
+type AssetResponse struct {
+	Code      string `json:"code" example:"BRL"`
+	Name      string `json:"name" example:"Brazilian Real"`
+	Symbol    string `json:"symbol" example:"R$"`
+	Precision int    `json:"precision" example:"2"`
+	Class     string `json:"class" example:"currency"`
+	Custom    bool   `json:"custom"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/asset_uc.go . AssetUseCase
+type AssetUseCase interface {
+	GetAssets(ctx context.Context) ([]entities.Asset, error)
+	CreateCustomAsset(ctx context.Context, asset entities.Asset) (entities.Asset, error)
+}
+
+// Asset handlers
+
+// GetAssets lists the supported assets
+//
+//	@Summary		List assets
+//	@Description	List the assets accounts, books and settings can be kept in, with their symbol, precision and display name: the built-in currencies first, then the custom assets of this deployment by code
+//	@Tags			assets
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		AssetResponse		"Assets retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/assets [get]
+func (h *ApiHandlers) GetAssets(w http.ResponseWriter, r *http.Request) {
+	assets, err := h.AssetUseCase.GetAssets(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	render.JSON(w, r, assetResponses(assets))
+}
+
+// CreateCustomAsset enables a custom asset
+//
+//	@Summary		Enable custom asset
+//	@Description	Enable a custom asset for this deployment, like loyalty points or airline miles, so accounts can be kept in it. The code is 2 to 10 letters or digits starting with a letter. Custom assets can't be disabled once enabled
+//	@Tags			assets
+//	@Accept			json
+//	@Produce		json
+//	@Param			asset	body		AssetRequest		true	"Asset data"
+//	@Success		201		{object}	AssetResponse		"Asset enabled successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		403		{object}	ErrorResponseBody	"Not an operator of the deployment"
+//	@Failure		409		{object}	ErrorResponseBody	"Asset code already taken"
+//	@Failure		413		{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/assets [post]
+func (h *ApiHandlers) CreateCustomAsset(w http.ResponseWriter, r *http.Request) {
+	var req AssetRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	asset, err := h.AssetUseCase.CreateCustomAsset(r.Context(), entities.Asset{
+		Asset: monetary.Asset{Asset: req.Code, Symbol: req.Symbol, Precision: req.Precision},
+		Name:  req.Name,
+	})
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, assetResponse(asset))
+}
+
+func assetResponse(asset entities.Asset) AssetResponse {
+	response := AssetResponse{
+		Code:      asset.Asset.Asset,
+		Name:      asset.Name,
+		Symbol:    asset.Symbol,
+		Precision: asset.Precision,
+		Class:     asset.Class,
+		Custom:    asset.Custom(),
+	}
+	if !asset.CreatedAt.IsZero() {
+		response.CreatedAt = asset.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return response
+}
+
+func assetResponses(assets []entities.Asset) []AssetResponse {
+	responses := make([]AssetResponse, len(assets))
+	for i, asset := range assets {
+		responses[i] = assetResponse(asset)
+	}
+	return responses
+}
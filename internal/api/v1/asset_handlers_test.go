@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestAssetHandlers(t *testing.T) {
+	miles := entities.Asset{
+		Asset:     monetary.NewAsset("MILES", 0, "mi", entities.AssetClassCustom),
+		Name:      "Airline miles",
+		CreatedAt: time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC),
+	}
+
+	var gotAsset entities.Asset
+	mockUC := &mocks.AssetUseCaseMock{
+		GetAssetsFunc: func(ctx context.Context) ([]entities.Asset, error) {
+			return []entities.Asset{{Asset: monetary.BRL, Name: "Brazilian Real"}, miles}, nil
+		},
+		CreateCustomAssetFunc: func(ctx context.Context, asset entities.Asset) (entities.Asset, error) {
+			gotAsset = asset
+			if asset.Asset.Asset == "BRL" {
+				return entities.Asset{}, fmt.Errorf("asset BRL already exists: %w", domain.ErrConflict)
+			}
+			if asset.Name == "" {
+				return entities.Asset{}, fmt.Errorf("asset name cannot be empty: %w", domain.ErrMalformedParameters)
+			}
+			return miles, nil
+		},
+	}
+	h := &ApiHandlers{AssetUseCase: mockUC}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(method, path, bytes.NewBufferString(body)))
+		return rec
+	}
+
+	t.Run("lists the assets", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/assets", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+
+		var response []AssetResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response) != 2 || response[0].Code != "BRL" || response[0].Custom || response[0].CreatedAt != "" {
+			t.Fatalf("unexpected assets: %+v", response)
+		}
+		if response[1] != (AssetResponse{Code: "MILES", Name: "Airline miles", Symbol: "mi", Precision: 0, Class: "custom", Custom: true, CreatedAt: "2025-03-10T12:00:00Z"}) {
+			t.Errorf("unexpected custom asset: %+v", response[1])
+		}
+	})
+
+	t.Run("enables a custom asset", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/assets", `{"code":"MILES","name":"Airline miles","symbol":"mi","precision":0}`)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotAsset.Asset.Asset != "MILES" || gotAsset.Name != "Airline miles" || gotAsset.Symbol != "mi" {
+			t.Errorf("unexpected asset passed to the use case: %+v", gotAsset)
+		}
+	})
+
+	t.Run("invalid asset", func(t *testing.T) {
+		if rec := serve(http.MethodPost, "/api/v1/assets", `{"code":"PTS"}`); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("code already taken", func(t *testing.T) {
+		if rec := serve(http.MethodPost, "/api/v1/assets", `{"code":"BRL","name":"Real"}`); rec.Code != http.StatusConflict {
+			t.Errorf("expected status 409, got %d", rec.Code)
+		}
+	})
+}
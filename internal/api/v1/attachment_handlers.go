@@ -0,0 +1,328 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// maxAttachmentBodyBytes leaves room for the multipart headers around the
+// largest attachment
+const maxAttachmentBodyBytes = entities.MaxAttachmentSize + 1<<20
+
+type AttachmentResponse struct {
+	ID           string `json:"id"`
+	FileName     string `json:"file_name" example:"receipt.jpg"`
+	ContentType  string `json:"content_type" example:"image/jpeg"`
+	Size         int64  `json:"size" example:"48213"`
+	URL          string `json:"url" example:"/api/v1/transactions/5f0c6a3e-8f1b-4c1d-9a2e-3b4c5d6e7f80/attachments/0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty" example:"/api/v1/transactions/5f0c6a3e-8f1b-4c1d-9a2e-3b4c5d6e7f80/attachments/0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d/thumbnail"`
+	CreatedAt    string `json:"created_at"`
+}
+
+// AttachmentArchiveResponse is a zip of the attachments of a period, made in
+// the background. Downloading it before its state is ready returns 409.
+type AttachmentArchiveResponse struct {
+	ID          string `json:"id" example:"9f86d081884c7d659a2feaa0c55ad015"`
+	From        string `json:"from" example:"2025-04-01"`
+	To          string `json:"to" example:"2025-04-30"`
+	State       string `json:"state" example:"running" enums:"running,ready,failed"`
+	Attachments int    `json:"attachments" example:"12"`
+	Size        int64  `json:"size,omitempty" example:"3481920"`
+	DownloadURL string `json:"download_url" example:"/api/v1/transactions/attachments/archive/9f86d081884c7d659a2feaa0c55ad015"`
+	Error       string `json:"error,omitempty"`
+	StartedAt   string `json:"started_at"`
+	FinishedAt  string `json:"finished_at,omitempty"`
+	ExpiresAt   string `json:"expires_at,omitempty"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/attachment_uc.go . AttachmentUseCase
+type AttachmentUseCase interface {
+	AddAttachment(ctx context.Context, attachment entities.Attachment, content []byte) (entities.Attachment, error)
+	GetAttachments(ctx context.Context, transactionID string) ([]entities.Attachment, error)
+	OpenAttachment(ctx context.Context, transactionID, id string, thumbnail bool) (entities.Attachment, io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, transactionID, id string) error
+	StartAttachmentArchive(ctx context.Context, from, to time.Time) (entities.AttachmentArchive, error)
+	OpenAttachmentArchive(ctx context.Context, id string) (entities.AttachmentArchive, io.ReadCloser, error)
+}
+
+// Attachment handlers
+
+// AddAttachment attaches a file to a transaction
+//
+//	@Summary		Add attachment
+//	@Description	Attach a receipt or another file to a transaction. JPEG, PNG, GIF and WebP pictures and PDFs of up to 10 MB are taken, the type told from the content of the file. JPEG, PNG and GIF pictures get a thumbnail. Files that would take the user over their attachment storage quota are refused, with 413 when the file alone is larger than the quota and 422 when the attachments already stored leave no room for it.
+//	@Tags			attachments
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			id		path		string				true	"Transaction ID"
+//	@Param			file	formData	file				true	"File to attach"
+//	@Success		201		{object}	AttachmentResponse	"Attachment added successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Invalid file"
+//	@Failure		404		{object}	ErrorResponseBody	"Transaction not found"
+//	@Failure		413		{object}	ErrorResponseBody	"File too large, or larger than the whole attachment storage quota"
+//	@Failure		422		{object}	ErrorResponseBody	"Attachment storage quota used up"
+//	@Router			/transactions/{id}/attachments [post]
+func (h *ApiHandlers) AddAttachment(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxAttachmentBodyBytes)
+	if err := r.ParseMultipartForm(maxAttachmentBodyBytes); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			errorResponse(w, r, http.StatusRequestEntityTooLarge, fmt.Errorf("attachment must not be larger than %d bytes", entities.MaxAttachmentSize))
+			return
+		}
+		errorResponse(w, r, http.StatusBadRequest, fmt.Errorf("invalid multipart form: %w", err))
+		return
+	}
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("file"))
+		return
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if len(content) > entities.MaxAttachmentSize {
+		errorResponse(w, r, http.StatusRequestEntityTooLarge, fmt.Errorf("attachment must not be larger than %d bytes", entities.MaxAttachmentSize))
+		return
+	}
+
+	// The type the client claims isn't trusted, the file is served with the
+	// one its content has
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(content))
+
+	attachment, err := h.AttachmentUseCase.AddAttachment(r.Context(), entities.Attachment{
+		TransactionID: chi.URLParam(r, "id"),
+		FileName:      header.Filename,
+		ContentType:   contentType,
+	}, content)
+	if err != nil {
+		errorResponse(w, r, attachmentErrorStatus(err, len(content)), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, attachmentResponse(attachment))
+}
+
+// GetAttachments lists the attachments of a transaction
+//
+//	@Summary		List attachments
+//	@Description	List the files attached to a transaction in the order they were added
+//	@Tags			attachments
+//	@Produce		json
+//	@Param			id	path		string					true	"Transaction ID"
+//	@Success		200	{array}		AttachmentResponse		"Attachments retrieved successfully"
+//	@Failure		404	{object}	ErrorResponseBody		"Transaction not found"
+//	@Router			/transactions/{id}/attachments [get]
+func (h *ApiHandlers) GetAttachments(w http.ResponseWriter, r *http.Request) {
+	attachments, err := h.AttachmentUseCase.GetAttachments(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, attachmentResponses(attachments))
+}
+
+// DownloadAttachment sends the file of an attachment
+//
+//	@Summary		Download attachment
+//	@Description	Download the file attached to a transaction, with the type and name it was attached with
+//	@Tags			attachments
+//	@Produce		octet-stream
+//	@Param			id				path		string				true	"Transaction ID"
+//	@Param			attachmentId	path		string				true	"Attachment ID"
+//	@Success		200				{file}		file				"Attached file"
+//	@Failure		404				{object}	ErrorResponseBody	"Attachment not found"
+//	@Router			/transactions/{id}/attachments/{attachmentId} [get]
+func (h *ApiHandlers) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	h.sendAttachment(w, r, false)
+}
+
+// DownloadAttachmentThumbnail sends the thumbnail of an attachment
+//
+//	@Summary		Download attachment thumbnail
+//	@Description	Download the PNG thumbnail of a picture attached to a transaction. PDFs and the pictures a thumbnail couldn't be made of have none.
+//	@Tags			attachments
+//	@Produce		png
+//	@Param			id				path		string				true	"Transaction ID"
+//	@Param			attachmentId	path		string				true	"Attachment ID"
+//	@Success		200				{file}		file				"Thumbnail"
+//	@Failure		404				{object}	ErrorResponseBody	"Attachment or thumbnail not found"
+//	@Router			/transactions/{id}/attachments/{attachmentId}/thumbnail [get]
+func (h *ApiHandlers) DownloadAttachmentThumbnail(w http.ResponseWriter, r *http.Request) {
+	h.sendAttachment(w, r, true)
+}
+
+func (h *ApiHandlers) sendAttachment(w http.ResponseWriter, r *http.Request, thumbnail bool) {
+	attachment, file, err := h.AttachmentUseCase.OpenAttachment(r.Context(), chi.URLParam(r, "id"), chi.URLParam(r, "attachmentId"), thumbnail)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+	defer file.Close()
+
+	if thumbnail {
+		w.Header().Set("Content-Type", "image/png")
+	} else {
+		w.Header().Set("Content-Type", attachment.ContentType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": attachment.FileName}))
+	}
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	// The files of an attachment never change
+	w.Header().Set("Cache-Control", "private, max-age=86400")
+	if _, err := io.Copy(w, file); err != nil {
+		slog.Error("failed to send attachment", "error", err, "attachment_id", attachment.ID)
+	}
+}
+
+// DeleteAttachment deletes an attachment
+//
+//	@Summary		Delete attachment
+//	@Description	Take a file off a transaction, deleting it and its thumbnail
+//	@Tags			attachments
+//	@Param			id				path	string	true	"Transaction ID"
+//	@Param			attachmentId	path	string	true	"Attachment ID"
+//	@Success		204				"Attachment deleted successfully"
+//	@Failure		404				{object}	ErrorResponseBody	"Attachment not found"
+//	@Router			/transactions/{id}/attachments/{attachmentId} [delete]
+func (h *ApiHandlers) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	if err := h.AttachmentUseCase.DeleteAttachment(r.Context(), chi.URLParam(r, "id"), chi.URLParam(r, "attachmentId")); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ArchiveAttachments starts zipping the attachments of a period
+//
+//	@Summary		Archive attachments
+//	@Description	Start zipping the files attached to the transactions from from to to, both included, in the background, a folder for each transaction named after its date and description. Asking for the same period again returns the archive being made, or ready, until it expires a day after it's made, so the request can be repeated until the state is ready. The attachments of a period can add up to 1 GB.
+//	@Tags			attachments
+//	@Produce		json
+//	@Param			from	query		string						true	"First day of the period (YYYY-MM-DD)"
+//	@Param			to		query		string						true	"Last day of the period (YYYY-MM-DD)"
+//	@Success		200		{object}	AttachmentArchiveResponse	"Archive ready to be downloaded"
+//	@Success		202		{object}	AttachmentArchiveResponse	"Archive being made"
+//	@Failure		400		{object}	ErrorResponseBody			"Invalid period"
+//	@Router			/transactions/attachments/archive [get]
+func (h *ApiHandlers) ArchiveAttachments(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := parsePeriod(w, r)
+	if !ok {
+		return
+	}
+
+	archive, err := h.AttachmentUseCase.StartAttachmentArchive(r.Context(), from, to)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	if archive.State == entities.AttachmentArchiveStateRunning {
+		render.Status(r, http.StatusAccepted)
+	}
+	render.JSON(w, r, attachmentArchiveResponse(archive))
+}
+
+// DownloadAttachmentArchive sends the zip of an attachment archive
+//
+//	@Summary		Download attachment archive
+//	@Description	Download the zip of the attachments of a period, once its archive is ready
+//	@Tags			attachments
+//	@Produce		application/zip
+//	@Param			archiveId	path		string				true	"Archive ID"
+//	@Success		200			{file}		file				"Zip of the attachments"
+//	@Failure		404			{object}	ErrorResponseBody	"Archive not found or expired"
+//	@Failure		409			{object}	ErrorResponseBody	"Archive still being made or failed"
+//	@Router			/transactions/attachments/archive/{archiveId} [get]
+func (h *ApiHandlers) DownloadAttachmentArchive(w http.ResponseWriter, r *http.Request) {
+	archive, file, err := h.AttachmentUseCase.OpenAttachmentArchive(r.Context(), chi.URLParam(r, "archiveId"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+	defer file.Close()
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": archive.FileName()}))
+	w.Header().Set("Content-Length", strconv.FormatInt(archive.Size, 10))
+	if _, err := io.Copy(w, file); err != nil {
+		slog.Error("failed to send attachment archive", "error", err, "archive_id", archive.ID)
+	}
+}
+
+// attachmentErrorStatus tells a file that can never fit in the attachment
+// storage quota, 413, from one the attachments already stored leave no room
+// for, 422
+func attachmentErrorStatus(err error, size int) int {
+	var quota *domain.QuotaError
+	if errors.As(err, &quota) {
+		if size > quota.Limit {
+			return http.StatusRequestEntityTooLarge
+		}
+		return http.StatusUnprocessableEntity
+	}
+	return errorStatus(err, http.StatusInternalServerError)
+}
+
+func attachmentResponse(attachment entities.Attachment) AttachmentResponse {
+	url := "/api/v1/transactions/" + attachment.TransactionID + "/attachments/" + attachment.ID
+	response := AttachmentResponse{
+		ID:          attachment.ID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		URL:         url,
+		CreatedAt:   attachment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if attachment.HasThumbnail() {
+		response.ThumbnailURL = url + "/thumbnail"
+	}
+	return response
+}
+
+func attachmentResponses(attachments []entities.Attachment) []AttachmentResponse {
+	responses := make([]AttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		responses[i] = attachmentResponse(attachment)
+	}
+	return responses
+}
+
+func attachmentArchiveResponse(archive entities.AttachmentArchive) AttachmentArchiveResponse {
+	response := AttachmentArchiveResponse{
+		ID:          archive.ID,
+		From:        archive.From.Format("2006-01-02"),
+		To:          archive.To.Format("2006-01-02"),
+		State:       string(archive.State),
+		Attachments: archive.Attachments,
+		Size:        archive.Size,
+		DownloadURL: "/api/v1/transactions/attachments/archive/" + archive.ID,
+		Error:       archive.Error,
+		StartedAt:   archive.StartedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if !archive.FinishedAt.IsZero() {
+		response.FinishedAt = archive.FinishedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if !archive.ExpiresAt.IsZero() {
+		response.ExpiresAt = archive.ExpiresAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return response
+}
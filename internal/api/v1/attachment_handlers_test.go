@@ -0,0 +1,224 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestAttachmentHandlers(t *testing.T) {
+	const transactionID = "5f0c6a3e-8f1b-4c1d-9a2e-3b4c5d6e7f80"
+	const attachmentID = "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+	const missingID = "7a8b9c0d-1e2f-4a3b-8c4d-5e6f7a8b9c0d"
+	pdf := []byte("%PDF-1.4\n%âãÏÓ\n")
+
+	var got entities.Attachment
+	mockUC := &mocks.AttachmentUseCaseMock{
+		AddAttachmentFunc: func(ctx context.Context, attachment entities.Attachment, content []byte) (entities.Attachment, error) {
+			if attachment.ContentType == "text/plain" {
+				return entities.Attachment{}, fmt.Errorf("unsupported attachment type: %w", domain.ErrMalformedParameters)
+			}
+			if strings.HasPrefix(attachment.FileName, "over quota") {
+				return entities.Attachment{}, &domain.QuotaError{Quota: "attachment_bytes", Limit: 20}
+			}
+			got = attachment
+			attachment.ID = attachmentID
+			attachment.Size = int64(len(content))
+			return attachment, nil
+		},
+		OpenAttachmentFunc: func(ctx context.Context, transactionID, id string, thumbnail bool) (entities.Attachment, io.ReadCloser, error) {
+			if id != attachmentID || thumbnail {
+				return entities.Attachment{}, nil, fmt.Errorf("attachment %w", domain.ErrNotFound)
+			}
+			attachment := entities.Attachment{ID: id, TransactionID: transactionID, FileName: "nota fiscal.pdf", ContentType: "application/pdf"}
+			return attachment, io.NopCloser(bytes.NewReader(pdf)), nil
+		},
+	}
+	h := &ApiHandlers{AttachmentUseCase: mockUC}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	upload := func(name string, content []byte) *httptest.ResponseRecorder {
+		var buf bytes.Buffer
+		form := multipart.NewWriter(&buf)
+		file, _ := form.CreateFormFile("file", name)
+		file.Write(content)
+		form.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/transactions/"+transactionID+"/attachments", &buf)
+		req.Header.Set("Content-Type", form.FormDataContentType())
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("upload", func(t *testing.T) {
+		rec := upload("nota fiscal.pdf", pdf)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		if got.TransactionID != transactionID || got.FileName != "nota fiscal.pdf" || got.ContentType != "application/pdf" {
+			t.Errorf("unexpected attachment passed to the use case: %+v", got)
+		}
+
+		var response AttachmentResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		wantURL := "/api/v1/transactions/" + transactionID + "/attachments/" + attachmentID
+		if response.URL != wantURL || response.ThumbnailURL != "" || response.Size != int64(len(pdf)) {
+			t.Errorf("unexpected response: %+v", response)
+		}
+	})
+
+	t.Run("the type comes from the content", func(t *testing.T) {
+		if rec := upload("receipt.pdf", []byte("not a receipt")); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		if rec := upload("large.pdf", make([]byte, maxAttachmentBodyBytes)); rec.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("expected status 413, got %d", rec.Code)
+		}
+	})
+
+	t.Run("over the storage quota", func(t *testing.T) {
+		if rec := upload("over quota.pdf", pdf); rec.Code != http.StatusUnprocessableEntity {
+			t.Errorf("expected status 422 for a file the stored ones leave no room for, got %d", rec.Code)
+		}
+		large := append([]byte("%PDF-1.4\n"), make([]byte, 20)...)
+		rec := upload("over quota large.pdf", large)
+		if rec.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("expected status 413 for a file larger than the quota, got %d", rec.Code)
+		}
+		if !strings.Contains(rec.Body.String(), `"name":"attachment_bytes"`) {
+			t.Errorf("expected the quota in the body, got %s", rec.Body)
+		}
+	})
+
+	t.Run("download", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/transactions/"+transactionID+"/attachments/"+attachmentID, nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if rec.Header().Get("Content-Type") != "application/pdf" {
+			t.Errorf("unexpected content type %q", rec.Header().Get("Content-Type"))
+		}
+		if disposition := rec.Header().Get("Content-Disposition"); !strings.HasPrefix(disposition, "inline;") || !strings.Contains(disposition, "nota fiscal.pdf") {
+			t.Errorf("unexpected content disposition %q", disposition)
+		}
+		if !bytes.Equal(rec.Body.Bytes(), pdf) {
+			t.Errorf("unexpected file %q", rec.Body)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		for path, want := range map[string]int{
+			"/attachments/" + missingID:                   http.StatusNotFound,
+			"/attachments/" + attachmentID + "/thumbnail": http.StatusNotFound,
+			"/attachments/not-a-uuid":                     http.StatusBadRequest,
+		} {
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/transactions/"+transactionID+path, nil))
+			if rec.Code != want {
+				t.Errorf("expected status %d for %s, got %d", want, path, rec.Code)
+			}
+		}
+	})
+}
+
+func TestAttachmentArchiveHandlers(t *testing.T) {
+	state := entities.AttachmentArchiveStateRunning
+	var gotFrom, gotTo time.Time
+	mockUC := &mocks.AttachmentUseCaseMock{
+		StartAttachmentArchiveFunc: func(ctx context.Context, from, to time.Time) (entities.AttachmentArchive, error) {
+			if from.IsZero() || to.IsZero() {
+				return entities.AttachmentArchive{}, fmt.Errorf("archive period needs both from and to: %w", domain.ErrMalformedParameters)
+			}
+			gotFrom, gotTo = from, to
+			return entities.AttachmentArchive{ID: "archive-1", From: from, To: to, State: state, Attachments: 2}, nil
+		},
+		OpenAttachmentArchiveFunc: func(ctx context.Context, id string) (entities.AttachmentArchive, io.ReadCloser, error) {
+			if id != "archive-1" {
+				return entities.AttachmentArchive{}, nil, fmt.Errorf("attachment archive %w", domain.ErrNotFound)
+			}
+			if state != entities.AttachmentArchiveStateReady {
+				return entities.AttachmentArchive{}, nil, fmt.Errorf("attachment archive is still being made: %w", domain.ErrConflict)
+			}
+			archive := entities.AttachmentArchive{ID: id, From: gotFrom, To: gotTo, State: state, Size: 3}
+			return archive, io.NopCloser(strings.NewReader("zip")), nil
+		},
+	}
+	h := &ApiHandlers{AttachmentUseCase: mockUC}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	t.Run("started", func(t *testing.T) {
+		rec := get("/api/v1/transactions/attachments/archive?from=2025-04-01&to=2025-04-30")
+		if rec.Code != http.StatusAccepted {
+			t.Fatalf("expected status 202, got %d: %s", rec.Code, rec.Body)
+		}
+		var response AttachmentArchiveResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.State != "running" || response.From != "2025-04-01" || response.DownloadURL != "/api/v1/transactions/attachments/archive/archive-1" {
+			t.Errorf("unexpected response: %+v", response)
+		}
+
+		if rec := get(response.DownloadURL); rec.Code != http.StatusConflict {
+			t.Errorf("expected status 409 downloading an archive being made, got %d", rec.Code)
+		}
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		for _, query := range []string{"?from=2025-04-01", "?from=April&to=2025-04-30"} {
+			if rec := get("/api/v1/transactions/attachments/archive" + query); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", query, rec.Code)
+			}
+		}
+	})
+
+	t.Run("download", func(t *testing.T) {
+		state = entities.AttachmentArchiveStateReady
+		if rec := get("/api/v1/transactions/attachments/archive?from=2025-04-01&to=2025-04-30"); rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200 for a ready archive, got %d", rec.Code)
+		}
+
+		rec := get("/api/v1/transactions/attachments/archive/archive-1")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if rec.Header().Get("Content-Type") != "application/zip" {
+			t.Errorf("unexpected content type %q", rec.Header().Get("Content-Type"))
+		}
+		if disposition := rec.Header().Get("Content-Disposition"); !strings.Contains(disposition, "attachments-2025-04-01-2025-04-30.zip") {
+			t.Errorf("unexpected content disposition %q", disposition)
+		}
+
+		if rec := get("/api/v1/transactions/attachments/archive/archive-2"); rec.Code != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", rec.Code)
+		}
+	})
+}
@@ -0,0 +1,454 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// RegisterRequest creates a user. The password must be 8 to 72 bytes long.
+type RegisterRequest struct {
+	Email    string `json:"email" example:"alice@example.com"`
+	Name     string `json:"name" example:"Alice"`
+	Password string `json:"password" example:"correct horse battery"`
+}
+
+// LoginRequest signs a user in. Captcha is the answer of the CAPTCHA widget,
+// only needed once the login was refused asking for it.
+type LoginRequest struct {
+	Email    string `json:"email" example:"alice@example.com"`
+	Password string `json:"password" example:"correct horse battery"`
+	Captcha  string `json:"captcha,omitempty"`
+}
+
+// AuthResponse is the bearer token to send as Authorization: Bearer <token>
+// until ExpiresAt
+type AuthResponse struct {
+	Token     string       `json:"token"`
+	ExpiresAt string       `json:"expires_at"`
+	User      UserResponse `json:"user"`
+}
+
+// SessionResponse is a device the user is signed in on. Current flags the
+// session of the token listing them.
+type SessionResponse struct {
+	ID         string `json:"id"`
+	UserAgent  string `json:"user_agent" example:"Mozilla/5.0 (X11; Linux x86_64; rv:136.0) Gecko/20100101 Firefox/136.0"`
+	IPAddress  string `json:"ip_address" example:"192.0.2.1"`
+	CreatedAt  string `json:"created_at"`
+	LastSeenAt string `json:"last_seen_at"`
+	ExpiresAt  string `json:"expires_at"`
+	Current    bool   `json:"current"`
+}
+
+// RevokedSessionsResponse is how many sessions were signed out
+type RevokedSessionsResponse struct {
+	Revoked int64 `json:"revoked" example:"2"`
+}
+
+// AuthEventResponse is a suspicious sign in on the user's account
+type AuthEventResponse struct {
+	ID        string `json:"id"`
+	Type      string `json:"type" example:"login_failed" enums:"login_failed,login_blocked,account_locked,address_locked,captcha_failed"`
+	IPAddress string `json:"ip_address" example:"203.0.113.7"`
+	UserAgent string `json:"user_agent" example:"curl/8.5.0"`
+	CreatedAt string `json:"created_at"`
+}
+
+type UserResponse struct {
+	ID        string `json:"id"`
+	Email     string `json:"email" example:"alice@example.com"`
+	Name      string `json:"name" example:"Alice"`
+	Tier      string `json:"tier" example:"free"`
+	CreatedAt string `json:"created_at"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/user_uc.go . UserUseCase
+type UserUseCase interface {
+	Register(ctx context.Context, user entities.User, password string, device entities.Device) (entities.AuthToken, error)
+	Login(ctx context.Context, email, password, captcha string, device entities.Device) (entities.AuthToken, error)
+	Authenticate(ctx context.Context, token string) (entities.Session, error)
+	GetUser(ctx context.Context, id string) (entities.User, error)
+	ListSessions(ctx context.Context) ([]entities.Session, error)
+	RevokeSession(ctx context.Context, id string) error
+	RevokeOtherSessions(ctx context.Context) (int64, error)
+	Logout(ctx context.Context) error
+	ListAuthEvents(ctx context.Context) ([]entities.AuthEvent, error)
+}
+
+var (
+	errMissingBearerToken = errors.New("missing bearer token in the Authorization header")
+	errAdminOnly          = fmt.Errorf("only the operators of the deployment can do this: %w", domain.ErrForbidden)
+)
+
+// Auth handlers
+
+// Register creates a user
+//
+//	@Summary		Register
+//	@Description	Create a user and sign them in. The first user takes over the books recorded before users existed, the next ones start with an empty book of their own
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			user	body		RegisterRequest		true	"User data"
+//	@Success		201		{object}	AuthResponse		"User registered"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		409		{object}	ErrorResponseBody	"Email already registered"
+//	@Failure		413		{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/auth/register [post]
+func (h *ApiHandlers) Register(w http.ResponseWriter, r *http.Request) {
+	var req RegisterRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	token, err := h.UserUseCase.Register(r.Context(), entities.User{Email: req.Email, Name: req.Name}, req.Password, h.device(r))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, authResponse(token))
+}
+
+// Login signs a user in
+//
+//	@Summary		Login
+//	@Description	Sign in with an email and password, returning the bearer token the other endpoints are called with. After repeated failures the email, or the address, is locked out for a while, and before that a CAPTCHA may be asked for: the login answers 401 with "captcha" as the parameter, to try again with the answer of the widget.
+//	@Tags			auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			credentials	body		LoginRequest		true	"Credentials"
+//	@Success		200			{object}	AuthResponse		"Signed in"
+//	@Failure		400			{object}	ErrorResponseBody	"Bad request"
+//	@Failure		401			{object}	ErrorResponseBody	"Invalid email or password, or CAPTCHA required"
+//	@Failure		429			{object}	ErrorResponseBody	"Locked out after repeated failures, until the Retry-After seconds passed"
+//	@Router			/auth/login [post]
+func (h *ApiHandlers) Login(w http.ResponseWriter, r *http.Request) {
+	var req LoginRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	token, err := h.UserUseCase.Login(r.Context(), req.Email, req.Password, req.Captcha, h.device(r))
+	var locked *domain.LockedError
+	switch {
+	case errors.As(err, &locked):
+		retryAfter := max(int(math.Ceil(time.Until(locked.Until).Seconds())), 1)
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		errorResponse(w, r, http.StatusTooManyRequests, err)
+		return
+	case errors.Is(err, domain.ErrCaptchaRequired):
+		render.Status(r, http.StatusUnauthorized)
+		render.JSON(w, r, ErrorResponseBody{Error: err.Error(), Parameter: "captcha"})
+		return
+	case err != nil:
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, authResponse(token))
+}
+
+// GetCurrentUser returns the signed in user
+//
+//	@Summary		Current user
+//	@Description	Retrieve the user the bearer token was issued to
+//	@Tags			auth
+//	@Produce		json
+//	@Success		200	{object}	UserResponse		"User retrieved successfully"
+//	@Failure		401	{object}	ErrorResponseBody	"Missing or invalid token"
+//	@Router			/auth/me [get]
+func (h *ApiHandlers) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+	userID, ok := domain.UserFromContext(r.Context())
+	if !ok {
+		unauthorizedResponse(w, r, errMissingBearerToken)
+		return
+	}
+
+	user, err := h.UserUseCase.GetUser(r.Context(), userID)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, userResponse(user))
+}
+
+// GetSessions lists the devices the user is signed in on
+//
+//	@Summary		List sessions
+//	@Description	List the active sessions of the signed in user, the last seen first, flagging the one of the token used
+//	@Tags			auth
+//	@Produce		json
+//	@Success		200	{array}		SessionResponse		"Sessions retrieved successfully"
+//	@Failure		401	{object}	ErrorResponseBody	"Missing or invalid token"
+//	@Router			/auth/sessions [get]
+func (h *ApiHandlers) GetSessions(w http.ResponseWriter, r *http.Request) {
+	sessions, err := h.UserUseCase.ListSessions(r.Context())
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := make([]SessionResponse, len(sessions))
+	for i, session := range sessions {
+		response[i] = sessionResponse(session)
+	}
+
+	render.JSON(w, r, response)
+}
+
+// RevokeSession signs the user out of a device
+//
+//	@Summary		Revoke session
+//	@Description	Sign the user out of one of their sessions, its token is refused from then on
+//	@Tags			auth
+//	@Param			id	path	string	true	"Session ID"
+//	@Success		204	"Session revoked"
+//	@Failure		400	{object}	ErrorResponseBody	"Invalid session ID"
+//	@Failure		401	{object}	ErrorResponseBody	"Missing or invalid token"
+//	@Failure		404	{object}	ErrorResponseBody	"Session not found"
+//	@Router			/auth/sessions/{id} [delete]
+func (h *ApiHandlers) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	if err := h.UserUseCase.RevokeSession(r.Context(), chi.URLParam(r, "id")); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RevokeOtherSessions signs the user out of every other device
+//
+//	@Summary		Revoke other sessions
+//	@Description	Sign the user out of every session but the one of the token used
+//	@Tags			auth
+//	@Produce		json
+//	@Success		200	{object}	RevokedSessionsResponse	"Sessions revoked"
+//	@Failure		401	{object}	ErrorResponseBody		"Missing or invalid token"
+//	@Router			/auth/sessions [delete]
+func (h *ApiHandlers) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	revoked, err := h.UserUseCase.RevokeOtherSessions(r.Context())
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, RevokedSessionsResponse{Revoked: revoked})
+}
+
+// GetAuthEvents lists the suspicious sign ins on the user's account
+//
+//	@Summary		List auth events
+//	@Description	List the latest suspicious sign ins on the account of the signed in user, the newest first: failed logins, logins refused while locked out, lockouts and wrong CAPTCHA answers
+//	@Tags			auth
+//	@Produce		json
+//	@Success		200	{array}		AuthEventResponse	"Events retrieved successfully"
+//	@Failure		401	{object}	ErrorResponseBody	"Missing or invalid token"
+//	@Router			/auth/events [get]
+func (h *ApiHandlers) GetAuthEvents(w http.ResponseWriter, r *http.Request) {
+	events, err := h.UserUseCase.ListAuthEvents(r.Context())
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := make([]AuthEventResponse, len(events))
+	for i, event := range events {
+		response[i] = AuthEventResponse{
+			ID:        event.ID,
+			Type:      string(event.Type),
+			IPAddress: event.IPAddress,
+			UserAgent: event.UserAgent,
+			CreatedAt: event.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	render.JSON(w, r, response)
+}
+
+// Logout revokes the session of the token used
+//
+//	@Summary		Logout
+//	@Description	Sign out, revoking the session of the token used
+//	@Tags			auth
+//	@Success		204	"Signed out"
+//	@Failure		401	{object}	ErrorResponseBody	"Missing or invalid token"
+//	@Router			/auth/logout [post]
+func (h *ApiHandlers) Logout(w http.ResponseWriter, r *http.Request) {
+	if err := h.UserUseCase.Logout(r.Context()); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// authenticate requires the bearer token of a user and scopes the request
+// context to them. Without a UserUseCase, as in tests, requests go through
+// unauthenticated.
+func (h *ApiHandlers) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.UserUseCase == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			unauthorizedResponse(w, r, errMissingBearerToken)
+			return
+		}
+
+		session, err := h.UserUseCase.Authenticate(r.Context(), token)
+		if err != nil {
+			if errors.Is(err, domain.ErrUnauthorized) {
+				unauthorizedResponse(w, r, err)
+				return
+			}
+			errorResponse(w, r, http.StatusInternalServerError, err)
+			return
+		}
+
+		ctx := domain.WithSession(domain.WithUser(r.Context(), session.UserID), session.ID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// requireAdmin lets through only the operators of the deployment, the users
+// whose email is in AdminEmails, answering 403 to the others. It goes after
+// authenticate, and like it lets requests through without a UserUseCase.
+func (h *ApiHandlers) requireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.UserUseCase == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		userID, ok := domain.UserFromContext(r.Context())
+		if !ok {
+			unauthorizedResponse(w, r, errMissingBearerToken)
+			return
+		}
+		user, err := h.UserUseCase.GetUser(r.Context(), userID)
+		if err != nil {
+			errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+			return
+		}
+		if !slices.ContainsFunc(h.AdminEmails, func(email string) bool {
+			return strings.EqualFold(strings.TrimSpace(email), user.Email)
+		}) {
+			errorResponse(w, r, http.StatusForbidden, errAdminOnly)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// inProcessAddress is the peer address of the requests the web app makes in
+// process when SERVICE_STANDALONE is on
+const inProcessAddress = "in-process"
+
+// device describes the client of a sign in. The address shown is the first
+// of X-Forwarded-For, set by the web app and proxies, falling back to the
+// peer. Anyone can set that header, so the sign in guard goes by clientIP.
+func (h *ApiHandlers) device(r *http.Request) entities.Device {
+	peer := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(peer); err == nil {
+		peer = host
+	}
+
+	ip, _, _ := strings.Cut(r.Header.Get("X-Forwarded-For"), ",")
+	ip = strings.TrimSpace(ip)
+	if ip == "" {
+		ip = peer
+	}
+	return entities.Device{UserAgent: r.UserAgent(), IPAddress: ip, ClientIP: h.clientIP(peer, r.Header.Get("X-Forwarded-For"))}
+}
+
+// clientIP returns the address a request came from: the peer, unless it's a
+// trusted proxy. Proxies append the address they got the request from to
+// X-Forwarded-For, so going from its end the first address that isn't a
+// trusted proxy is the client, whatever the client put before it.
+func (h *ApiHandlers) clientIP(peer, forwardedFor string) string {
+	ip := peer
+	forwarded := strings.Split(forwardedFor, ",")
+	for i := len(forwarded) - 1; i >= 0 && h.trustedProxy(ip); i-- {
+		if next := strings.TrimSpace(forwarded[i]); next != "" {
+			ip = next
+		}
+	}
+	return ip
+}
+
+// trustedProxy tells whether ip is the web app in process, a loopback
+// address or in TrustedProxies
+func (h *ApiHandlers) trustedProxy(ip string) bool {
+	if ip == inProcessAddress {
+		return true
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	if addr.IsLoopback() {
+		return true
+	}
+	return slices.ContainsFunc(h.TrustedProxies, func(proxy netip.Prefix) bool {
+		return proxy.Contains(addr)
+	})
+}
+
+func unauthorizedResponse(w http.ResponseWriter, r *http.Request, err error) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="finance"`)
+	errorResponse(w, r, http.StatusUnauthorized, err)
+}
+
+func authResponse(token entities.AuthToken) AuthResponse {
+	return AuthResponse{
+		Token:     token.Token,
+		ExpiresAt: token.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		User:      userResponse(token.User),
+	}
+}
+
+func sessionResponse(session entities.Session) SessionResponse {
+	return SessionResponse{
+		ID:         session.ID,
+		UserAgent:  session.UserAgent,
+		IPAddress:  session.IPAddress,
+		CreatedAt:  session.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		LastSeenAt: session.LastSeenAt.Format("2006-01-02T15:04:05Z07:00"),
+		ExpiresAt:  session.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		Current:    session.Current,
+	}
+}
+
+func userResponse(user entities.User) UserResponse {
+	return UserResponse{
+		ID:        user.ID,
+		Email:     user.Email,
+		Name:      user.Name,
+		Tier:      string(user.Tier),
+		CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
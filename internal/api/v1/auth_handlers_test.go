@@ -0,0 +1,382 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestAuthHandlers(t *testing.T) {
+	const (
+		aliceBookID     = "5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b"
+		bobBookID       = "6f7a8b9c-0d1e-4f2a-9b3c-4d5e6f7a8b9c"
+		laptopSessionID = "7a8b9c0d-1e2f-4a3b-8c4d-5e6f7a8b9c0d"
+		phoneSessionID  = "8b9c0d1e-2f3a-4b4c-9d5e-6f7a8b9c0d1e"
+	)
+	alice := entities.User{ID: "user-alice", Email: "alice@example.com", Name: "Alice"}
+	token := entities.AuthToken{Token: "token-alice", ExpiresAt: time.Date(2025, time.March, 16, 9, 0, 0, 0, time.UTC), User: alice}
+
+	var (
+		gotScope   string
+		gotDevice  entities.Device
+		gotSession string
+		revoked    []string
+	)
+	h := &ApiHandlers{
+		UserUseCase: &mocks.UserUseCaseMock{
+			RegisterFunc: func(ctx context.Context, user entities.User, password string, device entities.Device) (entities.AuthToken, error) {
+				if user.Email == alice.Email {
+					return entities.AuthToken{}, fmt.Errorf("email %q is already registered: %w", user.Email, domain.ErrConflict)
+				}
+				user.ID = "user-bob"
+				return entities.AuthToken{Token: "token-bob", User: user}, nil
+			},
+			LoginFunc: func(ctx context.Context, email, password, captcha string, device entities.Device) (entities.AuthToken, error) {
+				switch {
+				case email == "mallory@example.com":
+					return entities.AuthToken{}, &domain.LockedError{Until: time.Now().Add(90 * time.Second)}
+				case email == "bob@example.com" && captcha == "":
+					return entities.AuthToken{}, fmt.Errorf("solve the captcha to sign in: %w", domain.ErrCaptchaRequired)
+				}
+				if email != alice.Email || password != "correct horse" {
+					return entities.AuthToken{}, fmt.Errorf("invalid email or password: %w", domain.ErrUnauthorized)
+				}
+				gotDevice = device
+				return token, nil
+			},
+			AuthenticateFunc: func(ctx context.Context, token string) (entities.Session, error) {
+				if token != "token-alice" {
+					return entities.Session{}, fmt.Errorf("invalid token: %w", domain.ErrUnauthorized)
+				}
+				return entities.Session{ID: laptopSessionID, UserID: alice.ID}, nil
+			},
+			ListSessionsFunc: func(ctx context.Context) ([]entities.Session, error) {
+				gotSession, _ = domain.SessionFromContext(ctx)
+				return []entities.Session{
+					{ID: laptopSessionID, UserAgent: "Firefox", Current: true},
+					{ID: phoneSessionID, UserAgent: "Safari"},
+				}, nil
+			},
+			RevokeSessionFunc: func(ctx context.Context, id string) error {
+				if id != phoneSessionID {
+					return fmt.Errorf("session %w", domain.ErrNotFound)
+				}
+				revoked = append(revoked, id)
+				return nil
+			},
+			RevokeOtherSessionsFunc: func(ctx context.Context) (int64, error) {
+				return 1, nil
+			},
+			ListAuthEventsFunc: func(ctx context.Context) ([]entities.AuthEvent, error) {
+				return []entities.AuthEvent{{ID: "event-1", Type: entities.AuthEventAccountLocked, IPAddress: "203.0.113.7"}}, nil
+			},
+			LogoutFunc: func(ctx context.Context) error {
+				current, _ := domain.SessionFromContext(ctx)
+				revoked = append(revoked, current)
+				return nil
+			},
+			GetUserFunc: func(ctx context.Context, id string) (entities.User, error) {
+				return alice, nil
+			},
+		},
+		BookUseCase: &mocks.BookUseCaseMock{
+			DefaultBookFunc: func(ctx context.Context) (entities.Book, error) {
+				return entities.Book{ID: aliceBookID}, nil
+			},
+			GetBookFunc: func(ctx context.Context, id string) (entities.Book, error) {
+				if id != aliceBookID {
+					return entities.Book{}, fmt.Errorf("book %w", domain.ErrNotFound)
+				}
+				return entities.Book{ID: id}, nil
+			},
+		},
+		CategoryUseCase: &mocks.CategoryUseCaseMock{
+			GetCategoriesPageFunc: func(ctx context.Context, sort []entities.SortField, limit, offset int) (entities.Page[entities.Category], error) {
+				gotScope = domain.BookFromContext(ctx)
+				return entities.Page[entities.Category]{}, nil
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	serve := func(method, path, body, bearer, book string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		req.Header.Set("User-Agent", "Firefox")
+		if bearer != "" {
+			req.Header.Set("Authorization", "Bearer "+bearer)
+		}
+		if book != "" {
+			req.Header.Set(BookHeader, book)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("registers", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/auth/register", `{"email":"bob@example.com","name":"Bob","password":"correct horse"}`, "", "")
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		var response AuthResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Token != "token-bob" || response.User.Email != "bob@example.com" {
+			t.Errorf("unexpected response: %+v", response)
+		}
+
+		if rec := serve(http.MethodPost, "/api/v1/auth/register", `{"email":"alice@example.com","password":"correct horse"}`, "", ""); rec.Code != http.StatusConflict {
+			t.Errorf("expected status 409, got %d", rec.Code)
+		}
+	})
+
+	t.Run("logs in", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/auth/login", `{"email":"alice@example.com","password":"correct horse"}`, "", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		var response AuthResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Token != "token-alice" || response.ExpiresAt != "2025-03-16T09:00:00Z" {
+			t.Errorf("unexpected response: %+v", response)
+		}
+		// httptest requests come from 192.0.2.1:1234
+		if gotDevice != (entities.Device{UserAgent: "Firefox", IPAddress: "192.0.2.1", ClientIP: "192.0.2.1"}) {
+			t.Errorf("unexpected device: %+v", gotDevice)
+		}
+
+		if rec := serve(http.MethodPost, "/api/v1/auth/login", `{"email":"alice@example.com","password":"wrong"}`, "", ""); rec.Code != http.StatusUnauthorized {
+			t.Errorf("expected status 401, got %d", rec.Code)
+		}
+	})
+
+	t.Run("current user", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/auth/me", "", "token-alice", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		var response UserResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.ID != alice.ID {
+			t.Errorf("unexpected user: %+v", response)
+		}
+	})
+
+	t.Run("locked out", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/auth/login", `{"email":"mallory@example.com","password":"guess"}`, "", "")
+		if rec.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected status 429, got %d: %s", rec.Code, rec.Body)
+		}
+		if retryAfter := rec.Header().Get("Retry-After"); retryAfter != "90" && retryAfter != "89" {
+			t.Errorf("expected to retry after 90 seconds, got %q", retryAfter)
+		}
+	})
+
+	t.Run("asks for a captcha", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/auth/login", `{"email":"bob@example.com","password":"guess"}`, "", "")
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("expected status 401, got %d: %s", rec.Code, rec.Body)
+		}
+		var response ErrorResponseBody
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Parameter != "captcha" {
+			t.Errorf("expected the captcha parameter, got %+v", response)
+		}
+	})
+
+	t.Run("lists auth events", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/auth/events", "", "token-alice", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		var response []AuthEventResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response) != 1 || response[0].Type != "account_locked" {
+			t.Errorf("unexpected events: %+v", response)
+		}
+	})
+
+	t.Run("lists sessions", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/auth/sessions", "", "token-alice", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		var response []SessionResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response) != 2 || !response[0].Current || response[1].Current {
+			t.Errorf("unexpected sessions: %+v", response)
+		}
+		if gotSession != laptopSessionID {
+			t.Errorf("expected the request scoped to session %s, got %q", laptopSessionID, gotSession)
+		}
+
+		if rec := serve(http.MethodGet, "/api/v1/auth/sessions", "", "", ""); rec.Code != http.StatusUnauthorized {
+			t.Errorf("expected status 401, got %d", rec.Code)
+		}
+	})
+
+	t.Run("revokes sessions", func(t *testing.T) {
+		revoked = nil
+		if rec := serve(http.MethodDelete, "/api/v1/auth/sessions/"+phoneSessionID, "", "token-alice", ""); rec.Code != http.StatusNoContent {
+			t.Errorf("expected status 204, got %d: %s", rec.Code, rec.Body)
+		}
+		if rec := serve(http.MethodDelete, "/api/v1/auth/sessions/"+bobBookID, "", "token-alice", ""); rec.Code != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", rec.Code)
+		}
+		if rec := serve(http.MethodDelete, "/api/v1/auth/sessions/not-a-uuid", "", "token-alice", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+
+		rec := serve(http.MethodDelete, "/api/v1/auth/sessions", "", "token-alice", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		var response RevokedSessionsResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Revoked != 1 {
+			t.Errorf("expected 1 revoked session, got %d", response.Revoked)
+		}
+
+		if rec := serve(http.MethodPost, "/api/v1/auth/logout", "", "token-alice", ""); rec.Code != http.StatusNoContent {
+			t.Errorf("expected status 204, got %d: %s", rec.Code, rec.Body)
+		}
+		if len(revoked) != 2 || revoked[0] != phoneSessionID || revoked[1] != laptopSessionID {
+			t.Errorf("unexpected revoked sessions: %v", revoked)
+		}
+	})
+
+	t.Run("requires a token", func(t *testing.T) {
+		for _, bearer := range []string{"", "forged"} {
+			rec := serve(http.MethodGet, "/api/v1/categories", "", bearer, "")
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("token %q: expected status 401, got %d", bearer, rec.Code)
+			}
+			if rec := serve(http.MethodGet, "/debug/vars", "", bearer, ""); rec.Code != http.StatusUnauthorized {
+				t.Errorf("token %q: expected status 401 on /debug/vars, got %d", bearer, rec.Code)
+			}
+			if rec.Header().Get("WWW-Authenticate") == "" {
+				t.Errorf("token %q: missing WWW-Authenticate header", bearer)
+			}
+		}
+	})
+
+	t.Run("scopes to the user's default book", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/categories", "", "token-alice", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotScope != aliceBookID {
+			t.Errorf("expected the request scoped to %s, got %s", aliceBookID, gotScope)
+		}
+	})
+
+	t.Run("admin routes are for the operators", func(t *testing.T) {
+		for _, route := range []struct{ method, path string }{
+			{http.MethodGet, "/api/v1/admin/stats"},
+			{http.MethodPut, "/api/v1/settings"},
+			{http.MethodPost, "/api/v1/assets"},
+			{http.MethodPost, "/api/v1/demo"},
+			{http.MethodGet, "/debug/vars"},
+		} {
+			if rec := serve(route.method, route.path, "", "token-alice", ""); rec.Code != http.StatusForbidden {
+				t.Errorf("%s %s: expected status 403, got %d", route.method, route.path, rec.Code)
+			}
+		}
+
+		h.AdminEmails = []string{"bob@example.com", " Alice@Example.com"}
+		defer func() { h.AdminEmails = nil }()
+		// Past requireAdmin, the empty body is refused by the handler
+		if rec := serve(http.MethodPut, "/api/v1/settings", "", "token-alice", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d: %s", rec.Code, rec.Body)
+		}
+		if rec := serve(http.MethodGet, "/debug/vars", "", "token-alice", ""); rec.Code != http.StatusOK {
+			t.Errorf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+	})
+
+	t.Run("other users' books are not found", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/categories", "", "token-alice", bobBookID); rec.Code != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", rec.Code)
+		}
+	})
+}
+
+func TestDevice(t *testing.T) {
+	h := &ApiHandlers{TrustedProxies: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}}
+
+	tests := []struct {
+		name         string
+		peer         string
+		forwardedFor string
+		want         entities.Device
+	}{
+		{
+			name: "peer",
+			peer: "203.0.113.7:1234",
+			want: entities.Device{IPAddress: "203.0.113.7", ClientIP: "203.0.113.7"},
+		},
+		{
+			// A client can't pass for another address, or reset the failed
+			// sign ins counted against its own, by forging the header
+			name:         "forwarded by an untrusted peer",
+			peer:         "203.0.113.7:1234",
+			forwardedFor: "198.51.100.1",
+			want:         entities.Device{IPAddress: "198.51.100.1", ClientIP: "203.0.113.7"},
+		},
+		{
+			name:         "forwarded by a trusted proxy",
+			peer:         "10.0.0.2:1234",
+			forwardedFor: "198.51.100.1, 203.0.113.7",
+			want:         entities.Device{IPAddress: "198.51.100.1", ClientIP: "203.0.113.7"},
+		},
+		{
+			name:         "forwarded by the web app through a trusted proxy",
+			peer:         "in-process",
+			forwardedFor: "203.0.113.7, 10.0.0.3",
+			want:         entities.Device{IPAddress: "203.0.113.7", ClientIP: "203.0.113.7"},
+		},
+		{
+			name:         "loopback",
+			peer:         "127.0.0.1:1234",
+			forwardedFor: "203.0.113.7",
+			want:         entities.Device{IPAddress: "203.0.113.7", ClientIP: "203.0.113.7"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
+			req.RemoteAddr = tt.peer
+			if tt.forwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			}
+			if got := h.device(req); got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
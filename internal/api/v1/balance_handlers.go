@@ -3,36 +3,123 @@ package v1
 import (
 	"context"
 	"finance/domain/entities"
+	"math/big"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
+	"github.com/guilhermebr/gox/monetary"
 )
 
 // Balance response types
 type BalanceResponse struct {
-	AccountID        string           `json:"account_id"`
-	CurrentBalance   string           `json:"current_balance"`
-	PendingBalance   string           `json:"pending_balance"`
-	AvailableBalance string           `json:"available_balance"`
-	LastCalculated   string           `json:"last_calculated"`
-	Account          *AccountResponse `json:"account,omitempty"`
+	AccountID        string                 `json:"account_id"`
+	CurrentBalance   string                 `json:"current_balance"`
+	PendingBalance   string                 `json:"pending_balance"`
+	AvailableBalance string                 `json:"available_balance"`
+	LastCalculated   string                 `json:"last_calculated"`
+	Deltas           []BalanceDeltaResponse `json:"deltas,omitempty"`
+	Account          *AccountResponse       `json:"account,omitempty"`
 }
 
+// BalanceDeltaResponse is the change of the current balance over the last Days days
+type BalanceDeltaResponse struct {
+	Days   int    `json:"days"`
+	Amount string `json:"amount"`
+}
+
+// BalanceSummaryResponse is the totals and net worth of the monetary accounts.
+// CustomAssets is the net balance of the accounts in custom assets, like
+// loyalty points and miles, per asset, left out of the net worth.
 type BalanceSummaryResponse struct {
-	TotalAssets      string `json:"total_assets"`
-	TotalLiabilities string `json:"total_liabilities"`
-	NetWorth         string `json:"net_worth"`
-	LastCalculated   string `json:"last_calculated"`
+	TotalAssets      string   `json:"total_assets"`
+	TotalLiabilities string   `json:"total_liabilities"`
+	NetWorth         string   `json:"net_worth"`
+	CustomAssets     []string `json:"custom_assets" example:"[MILES (mi) 42000]"`
+	LastCalculated   string   `json:"last_calculated"`
+}
+
+// BalanceGroupResponse is a group of accounts with the net of their current
+// balances per asset. Only the fields grouped by are set.
+type BalanceGroupResponse struct {
+	Institution string               `json:"institution,omitempty"`
+	Type        entities.AccountType `json:"type,omitempty"`
+	Subtotals   []string             `json:"subtotals"`
+	Accounts    []AccountResponse    `json:"accounts"`
+}
+
+// BalanceRefreshStatusResponse is the progress of a refresh of all balances
+type BalanceRefreshStatusResponse struct {
+	State      entities.BalanceRefreshState   `json:"state"`
+	StartedAt  string                         `json:"started_at"`
+	FinishedAt string                         `json:"finished_at,omitempty"`
+	Total      int                            `json:"total"`
+	Refreshed  int                            `json:"refreshed"`
+	Failed     int                            `json:"failed"`
+	Changed    int                            `json:"changed"`
+	Accounts   []AccountRefreshStatusResponse `json:"accounts"`
+}
+
+// AccountRefreshStatusResponse is the refresh of one account balance, with the
+// balance stored before the refresh and the recalculated one
+type AccountRefreshStatusResponse struct {
+	AccountID       string                       `json:"account_id"`
+	State           entities.AccountRefreshState `json:"state"`
+	PreviousBalance string                       `json:"previous_balance,omitempty"`
+	CurrentBalance  string                       `json:"current_balance,omitempty"`
+	Changed         bool                         `json:"changed"`
+	Error           string                       `json:"error,omitempty"`
+}
+
+// BalancePointResponse is a balance at the end of a day. Value is the amount
+// as a number in units of the asset, for charting.
+type BalancePointResponse struct {
+	Date   string  `json:"date" example:"2025-03-31"`
+	Amount string  `json:"amount" example:"[BRL (R$) 1520.75]"`
+	Value  float64 `json:"value" example:"1520.75"`
+}
+
+// BalanceHistoryResponse is the balance of an account at the end of each day,
+// or month, of a period
+type BalanceHistoryResponse struct {
+	AccountID   string                      `json:"account_id"`
+	Granularity entities.BalanceGranularity `json:"granularity" example:"day"`
+	From        string                      `json:"from" example:"2025-03-01"`
+	To          string                      `json:"to" example:"2025-03-31"`
+	Points      []BalancePointResponse      `json:"points"`
+}
+
+// NetWorthHistoryResponse is the net worth at the end of each day, or month,
+// of a period, one series per currency
+type NetWorthHistoryResponse struct {
+	Granularity entities.BalanceGranularity `json:"granularity" example:"month"`
+	From        string                      `json:"from" example:"2024-04-01"`
+	To          string                      `json:"to" example:"2025-03-31"`
+	Series      []NetWorthSeriesResponse    `json:"series"`
+}
+
+// NetWorthSeriesResponse is the net worth in one currency over time
+type NetWorthSeriesResponse struct {
+	Asset  string                 `json:"asset" example:"BRL"`
+	Points []BalancePointResponse `json:"points"`
 }
 
 //go:generate moq -skip-ensure -stub -pkg mocks -out mocks/balance_uc.go . BalanceUseCase
 type BalanceUseCase interface {
 	GetBalanceByAccountID(ctx context.Context, accountID string) (entities.Balance, error)
 	GetAllBalances(ctx context.Context) ([]entities.Balance, error)
+	GetBalancesPage(ctx context.Context, limit, offset int) (entities.Page[entities.Balance], error)
 	RefreshAccountBalance(ctx context.Context, accountID string) error
 	RefreshAllBalances(ctx context.Context) error
+	StartBalanceRefresh(ctx context.Context) (entities.BalanceRefreshStatus, error)
+	GetBalanceRefreshStatus(ctx context.Context) (entities.BalanceRefreshStatus, error)
 	GetBalanceSummary(ctx context.Context) (entities.BalanceSummary, error)
+	GetGroupedBalances(ctx context.Context, by []entities.BalanceGroupField) ([]entities.BalanceGroup, error)
+	GetBalanceHistory(ctx context.Context, accountID string, granularity entities.BalanceGranularity, from, to time.Time) (entities.BalanceHistory, error)
+	GetNetWorthHistory(ctx context.Context, granularity entities.BalanceGranularity, from, to time.Time) (entities.NetWorthHistory, error)
 }
 
 // Balance handlers
@@ -40,14 +127,16 @@ type BalanceUseCase interface {
 // GetBalanceByAccountID retrieves the balance for a specific account
 //
 //	@Summary		Get balance by account ID
-//	@Description	Retrieve the balance information for a specific account
+//	@Description	Retrieve the balance information for a specific account, including how much it changed over the last 7 and 30 days
 //	@Tags			balances
 //	@Accept			json
 //	@Produce		json
-//	@Param			accountId	path		string			true	"Account ID"
-//	@Success		200			{object}	BalanceResponse	"Balance retrieved successfully"
+//	@Param			accountId	path		string				true	"Account ID"
+//	@Param			include		query		string				false	"Related resources to embed (account)"
+//	@Success		200			{object}	BalanceResponse		"Balance retrieved successfully"
 //	@Failure		400			{object}	ErrorResponseBody	"Bad request"
 //	@Failure		404			{object}	ErrorResponseBody	"Balance not found"
+//	@Failure		500			{object}	ErrorResponseBody	"Internal server error"
 //	@Router			/balances/{accountId} [get]
 func (h *ApiHandlers) GetBalanceByAccountID(w http.ResponseWriter, r *http.Request) {
 	accountID := chi.URLParam(r, "accountId")
@@ -56,9 +145,15 @@ func (h *ApiHandlers) GetBalanceByAccountID(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	include, err := parseInclude(r, "account")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
 	balance, err := h.BalanceUseCase.GetBalanceByAccountID(r.Context(), accountID)
 	if err != nil {
-		errorResponse(w, r, http.StatusNotFound, err)
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
 		return
 	}
 
@@ -68,17 +163,19 @@ func (h *ApiHandlers) GetBalanceByAccountID(w http.ResponseWriter, r *http.Reque
 		PendingBalance:   balance.PendingBalance.String(),
 		AvailableBalance: balance.AvailableBalance.String(),
 		LastCalculated:   balance.LastCalculated.Format("2006-01-02T15:04:05Z07:00"),
+		Deltas:           toBalanceDeltaResponses(balance.Deltas),
 	}
 
-	// Add account information if available
-	if balance.Account != nil {
+	// Add account information if requested
+	if include["account"] && balance.Account != nil {
 		response.Account = &AccountResponse{
-			ID:          balance.Account.ID,
-			Name:        balance.Account.Name,
-			Type:        balance.Account.Type,
-			Description: balance.Account.Description,
-			CreatedAt:   balance.Account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt:   balance.Account.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			ID:             balance.Account.ID,
+			Name:           balance.Account.Name,
+			Type:           balance.Account.Type,
+			Description:    balance.Account.Description,
+			Classification: balance.Account.EffectiveClassification(),
+			CreatedAt:      balance.Account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			UpdatedAt:      balance.Account.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
 	}
 
@@ -88,50 +185,75 @@ func (h *ApiHandlers) GetBalanceByAccountID(w http.ResponseWriter, r *http.Reque
 // GetAllBalances retrieves all account balances
 //
 //	@Summary		Get all balances
-//	@Description	Retrieve a list of all account balances
+//	@Description	Retrieve a page of the account balances by account, including how much each changed over the last 7 and 30 days, with the total number of balances and links to the next and previous pages
 //	@Tags			balances
 //	@Accept			json
 //	@Produce		json
-//	@Success		200	{array}		BalanceResponse		"Balances retrieved successfully"
-//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Param			include	query		string				false	"Related resources to embed (account)"
+//	@Param			fields	query		string				false	"Comma separated fields to return for each balance"
+//	@Param			limit	query		int					false	"Page size, 50 by default and at most 500"
+//	@Param			offset	query		int					false	"Number of balances to skip"
+//	@Success		200		{object}	PageResponse[BalanceResponse]	"Balances retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		500		{object}	ErrorResponseBody	"Internal server error"
 //	@Router			/balances [get]
 func (h *ApiHandlers) GetAllBalances(w http.ResponseWriter, r *http.Request) {
-	balances, err := h.BalanceUseCase.GetAllBalances(r.Context())
+	include, err := parseInclude(r, "account")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	fields, err := parseFields(r, BalanceResponse{})
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	limit, offset, err := parsePage(r)
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	page, err := h.BalanceUseCase.GetBalancesPage(r.Context(), limit, offset)
 	if err != nil {
 		errorResponse(w, r, http.StatusInternalServerError, err)
 		return
 	}
 
-	responses := make([]BalanceResponse, len(balances))
-	for i, balance := range balances {
+	responses := make([]BalanceResponse, len(page.Items))
+	for i, balance := range page.Items {
 		responses[i] = BalanceResponse{
 			AccountID:        balance.AccountID,
 			CurrentBalance:   balance.CurrentBalance.String(),
 			PendingBalance:   balance.PendingBalance.String(),
 			AvailableBalance: balance.AvailableBalance.String(),
 			LastCalculated:   balance.LastCalculated.Format("2006-01-02T15:04:05Z07:00"),
+			Deltas:           toBalanceDeltaResponses(balance.Deltas),
 		}
 
-		// Add account information if available
-		if balance.Account != nil {
+		// Add account information if requested
+		if include["account"] && balance.Account != nil {
 			responses[i].Account = &AccountResponse{
-				ID:          balance.Account.ID,
-				Name:        balance.Account.Name,
-				Type:        balance.Account.Type,
-				Description: balance.Account.Description,
-				CreatedAt:   balance.Account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-				UpdatedAt:   balance.Account.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+				ID:             balance.Account.ID,
+				Name:           balance.Account.Name,
+				Type:           balance.Account.Type,
+				Description:    balance.Account.Description,
+				Classification: balance.Account.EffectiveClassification(),
+				CreatedAt:      balance.Account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+				UpdatedAt:      balance.Account.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 			}
 		}
 	}
 
-	render.JSON(w, r, responses)
+	renderPage(w, r, page, responses, fields)
 }
 
 // GetBalanceSummary retrieves the overall balance summary
 //
 //	@Summary		Get balance summary
-//	@Description	Retrieve a summary of all account balances including total assets, liabilities, and net worth
+//	@Description	Retrieve a summary of all account balances including total assets, liabilities, and net worth. Accounts in custom assets, like loyalty points and miles, are left out of the totals and listed in custom_assets with their net balance per asset
 //	@Tags			balances
 //	@Accept			json
 //	@Produce		json
@@ -149,12 +271,96 @@ func (h *ApiHandlers) GetBalanceSummary(w http.ResponseWriter, r *http.Request)
 		TotalAssets:      summary.TotalAssets.String(),
 		TotalLiabilities: summary.TotalLiabilities.String(),
 		NetWorth:         summary.NetWorth.String(),
+		CustomAssets:     make([]string, len(summary.CustomAssets)),
 		LastCalculated:   summary.LastCalculated.Format("2006-01-02T15:04:05Z07:00"),
 	}
+	for i, balance := range summary.CustomAssets {
+		response.CustomAssets[i] = balance.String()
+	}
 
 	render.JSON(w, r, response)
 }
 
+// GetGroupedBalances retrieves the account balances grouped by institution and type
+//
+//	@Summary		Get grouped balances
+//	@Description	Group the accounts and their balances by institution and/or type, with the net of the current balances of each group per asset (liabilities subtract). Groups are ordered by institution, accounts without one last, then by type
+//	@Tags			balances
+//	@Accept			json
+//	@Produce		json
+//	@Param			by	query		string					false	"Comma separated fields to group by (institution, type), defaults to institution,type"
+//	@Success		200	{array}		BalanceGroupResponse	"Grouped balances retrieved successfully"
+//	@Failure		400	{object}	ErrorResponseBody		"Bad request"
+//	@Failure		500	{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/balances/grouped [get]
+func (h *ApiHandlers) GetGroupedBalances(w http.ResponseWriter, r *http.Request) {
+	var by []entities.BalanceGroupField
+	for _, field := range strings.Split(r.URL.Query().Get("by"), ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if !slices.Contains(entities.BalanceGroupFields, entities.BalanceGroupField(field)) {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("by", field))
+			return
+		}
+		by = append(by, entities.BalanceGroupField(field))
+	}
+
+	groups, err := h.BalanceUseCase.GetGroupedBalances(r.Context(), by)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	responses := make([]BalanceGroupResponse, len(groups))
+	for i, group := range groups {
+		responses[i] = BalanceGroupResponse{
+			Institution: group.Institution,
+			Type:        group.Type,
+			Subtotals:   make([]string, len(group.Subtotals)),
+			Accounts:    make([]AccountResponse, len(group.Accounts)),
+		}
+		for j, subtotal := range group.Subtotals {
+			responses[i].Subtotals[j] = subtotal.String()
+		}
+
+		for j, account := range group.Accounts {
+			responses[i].Accounts[j] = AccountResponse{
+				ID:                  account.ID,
+				Name:                account.Name,
+				Type:                account.Type,
+				Asset:               account.Asset.Asset,
+				Description:         account.Description,
+				Classification:      account.EffectiveClassification(),
+				Institution:         account.Institution,
+				AccountNumberLast4:  account.AccountNumberLast4,
+				Color:               account.Color,
+				Icon:                account.Icon,
+				StatementClosingDay: account.StatementClosingDay,
+				PaymentDueDay:       account.PaymentDueDay,
+				CreatedAt:           account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+				UpdatedAt:           account.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+				TransactionCount:    account.TransactionCount,
+				LastTransactionDate: formatDate(account.LastTransactionDate),
+			}
+
+			if account.Balance != nil {
+				responses[i].Accounts[j].Balance = &BalanceResponse{
+					AccountID:        account.Balance.AccountID,
+					CurrentBalance:   account.Balance.CurrentBalance.String(),
+					PendingBalance:   account.Balance.PendingBalance.String(),
+					AvailableBalance: account.Balance.AvailableBalance.String(),
+					LastCalculated:   account.Balance.LastCalculated.Format("2006-01-02T15:04:05Z07:00"),
+					Deltas:           toBalanceDeltaResponses(account.Balance.Deltas),
+				}
+			}
+		}
+	}
+
+	render.JSON(w, r, responses)
+}
+
 // RefreshAccountBalance refreshes the balance for a specific account
 //
 //	@Summary		Refresh account balance
@@ -176,9 +382,202 @@ func (h *ApiHandlers) RefreshAccountBalance(w http.ResponseWriter, r *http.Reque
 
 	err := h.BalanceUseCase.RefreshAccountBalance(r.Context(), accountID)
 	if err != nil {
-		errorResponse(w, r, http.StatusBadRequest, err)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// RefreshAllBalances starts recalculating every account balance
+//
+//	@Summary		Refresh all balances
+//	@Description	Start recalculating the balance of every account of the book in the background. Track the progress with GET /balances/refresh-status
+//	@Tags			balances
+//	@Accept			json
+//	@Produce		json
+//	@Success		202	{object}	BalanceRefreshStatusResponse	"Balance refresh started"
+//	@Failure		409	{object}	ErrorResponseBody				"A balance refresh is already running"
+//	@Failure		500	{object}	ErrorResponseBody				"Internal server error"
+//	@Router			/balances/refresh [post]
+func (h *ApiHandlers) RefreshAllBalances(w http.ResponseWriter, r *http.Request) {
+	status, err := h.BalanceUseCase.StartBalanceRefresh(r.Context())
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusAccepted)
+	render.JSON(w, r, toBalanceRefreshStatusResponse(status))
+}
+
+// GetBalanceRefreshStatus retrieves the progress of the running or last balance refresh
+//
+//	@Summary		Get balance refresh status
+//	@Description	Retrieve the per account progress of the running or last refresh of all balances of the book, comparing the stored balances with the recalculated ones
+//	@Tags			balances
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	BalanceRefreshStatusResponse	"Balance refresh status retrieved successfully"
+//	@Failure		404	{object}	ErrorResponseBody				"No balance refresh has run"
+//	@Failure		500	{object}	ErrorResponseBody				"Internal server error"
+//	@Router			/balances/refresh-status [get]
+func (h *ApiHandlers) GetBalanceRefreshStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := h.BalanceUseCase.GetBalanceRefreshStatus(r.Context())
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, toBalanceRefreshStatusResponse(status))
+}
+
+// GetBalanceHistory retrieves the balance of an account over time
+//
+//	@Summary		Get balance history
+//	@Description	Retrieve the current balance of an account at the end of each day, or of each month, of a period, for charting. The period defaults to the last 30 days, or the last 12 months by month. Days without a snapshot have the balance of the last one before them, and the days before the first snapshot have no point.
+//	@Tags			balances
+//	@Accept			json
+//	@Produce		json
+//	@Param			accountId	path		string					true	"Account ID"
+//	@Param			granularity	query		string					false	"Distance between the points"	Enums(day, month)	default(day)
+//	@Param			from		query		string					false	"First day (YYYY-MM-DD)"
+//	@Param			to			query		string					false	"Last day (YYYY-MM-DD), today by default"
+//	@Success		200			{object}	BalanceHistoryResponse	"Balance history retrieved successfully"
+//	@Failure		400			{object}	ErrorResponseBody		"Invalid parameters"
+//	@Failure		404			{object}	ErrorResponseBody		"Account not found"
+//	@Router			/balances/{accountId}/history [get]
+func (h *ApiHandlers) GetBalanceHistory(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := parsePeriod(w, r)
+	if !ok {
+		return
+	}
+	granularity := entities.BalanceGranularity(r.URL.Query().Get("granularity"))
+
+	history, err := h.BalanceUseCase.GetBalanceHistory(r.Context(), chi.URLParam(r, "accountId"), granularity, from, to)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, BalanceHistoryResponse{
+		AccountID:   history.AccountID,
+		Granularity: history.Granularity,
+		From:        history.From.Format("2006-01-02"),
+		To:          history.To.Format("2006-01-02"),
+		Points:      balancePointResponses(history.Points),
+	})
+}
+
+// GetNetWorthHistory retrieves the net worth over time
+//
+//	@Summary		Get net worth history
+//	@Description	Retrieve the net worth at the end of each day, or of each month, of a period, one series per currency, for charting. Like in the balance summary, liabilities subtract and the accounts in custom assets are left out. The period defaults to the last 30 days, or the last 12 months by month.
+//	@Tags			reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			granularity	query		string					false	"Distance between the points"	Enums(day, month)	default(day)
+//	@Param			from		query		string					false	"First day (YYYY-MM-DD)"
+//	@Param			to			query		string					false	"Last day (YYYY-MM-DD), today by default"
+//	@Success		200			{object}	NetWorthHistoryResponse	"Net worth history retrieved successfully"
+//	@Failure		400			{object}	ErrorResponseBody		"Invalid parameters"
+//	@Failure		500			{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/reports/net-worth [get]
+func (h *ApiHandlers) GetNetWorthHistory(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := parsePeriod(w, r)
+	if !ok {
+		return
+	}
+	granularity := entities.BalanceGranularity(r.URL.Query().Get("granularity"))
+
+	history, err := h.BalanceUseCase.GetNetWorthHistory(r.Context(), granularity, from, to)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := NetWorthHistoryResponse{
+		Granularity: history.Granularity,
+		From:        history.From.Format("2006-01-02"),
+		To:          history.To.Format("2006-01-02"),
+		Series:      make([]NetWorthSeriesResponse, len(history.Series)),
+	}
+	for i, series := range history.Series {
+		response.Series[i] = NetWorthSeriesResponse{
+			Asset:  series.Asset.Asset,
+			Points: balancePointResponses(series.Points),
+		}
+	}
+
+	render.JSON(w, r, response)
+}
+
+func balancePointResponses(points []entities.BalancePoint) []BalancePointResponse {
+	responses := make([]BalancePointResponse, len(points))
+	for i, point := range points {
+		responses[i] = BalancePointResponse{
+			Date:   point.Date.Format("2006-01-02"),
+			Amount: point.Amount.String(),
+			Value:  monetaryValue(point.Amount),
+		}
+	}
+	return responses
+}
+
+// monetaryValue is an amount in units of its asset, as a float for charts
+func monetaryValue(amount monetary.Monetary) float64 {
+	if amount.Amount == nil {
+		return 0
+	}
+	units := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(amount.Asset.Precision)), nil)
+	value, _ := new(big.Rat).SetFrac(amount.Amount, units).Float64()
+	return value
+}
+
+func toBalanceRefreshStatusResponse(status entities.BalanceRefreshStatus) BalanceRefreshStatusResponse {
+	response := BalanceRefreshStatusResponse{
+		State:     status.State,
+		StartedAt: status.StartedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Total:     len(status.Accounts),
+		Refreshed: status.Count(entities.AccountRefreshStateRefreshed),
+		Failed:    status.Count(entities.AccountRefreshStateFailed),
+		Changed:   status.Changed(),
+		Accounts:  make([]AccountRefreshStatusResponse, len(status.Accounts)),
+	}
+	if !status.FinishedAt.IsZero() {
+		response.FinishedAt = status.FinishedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	for i, account := range status.Accounts {
+		response.Accounts[i] = AccountRefreshStatusResponse{
+			AccountID: account.AccountID,
+			State:     account.State,
+			Changed:   account.Changed,
+			Error:     account.Error,
+		}
+		if account.PreviousBalance != nil {
+			response.Accounts[i].PreviousBalance = account.PreviousBalance.String()
+		}
+		if account.CurrentBalance != nil {
+			response.Accounts[i].CurrentBalance = account.CurrentBalance.String()
+		}
+	}
+
+	return response
+}
+
+func toBalanceDeltaResponses(deltas []entities.BalanceDelta) []BalanceDeltaResponse {
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	responses := make([]BalanceDeltaResponse, len(deltas))
+	for i, delta := range deltas {
+		responses[i] = BalanceDeltaResponse{
+			Days:   delta.Days,
+			Amount: delta.Amount.String(),
+		}
+	}
+
+	return responses
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestBalanceHistoryHandlers(t *testing.T) {
+	const accountID = "3f2b1c4e-8a6d-4e1f-9b7a-2c5d8e0f1a3b"
+	brl := func(cents int64) monetary.Monetary {
+		return monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(cents)}
+	}
+	march := func(day int) time.Time {
+		return time.Date(2025, 3, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	var gotGranularity entities.BalanceGranularity
+	var gotFrom, gotTo time.Time
+	mockUC := &mocks.BalanceUseCaseMock{
+		GetBalanceHistoryFunc: func(ctx context.Context, id string, granularity entities.BalanceGranularity, from, to time.Time) (entities.BalanceHistory, error) {
+			if granularity == "week" {
+				return entities.BalanceHistory{}, fmt.Errorf("invalid balance granularity: %s: %w", granularity, domain.ErrMalformedParameters)
+			}
+			gotGranularity, gotFrom, gotTo = granularity, from, to
+			return entities.BalanceHistory{
+				AccountID:   id,
+				Granularity: entities.BalanceGranularityDay,
+				From:        march(1),
+				To:          march(2),
+				Points: []entities.BalancePoint{
+					{Date: march(1), Amount: brl(152075)},
+					{Date: march(2), Amount: brl(-4590)},
+				},
+			}, nil
+		},
+		GetNetWorthHistoryFunc: func(ctx context.Context, granularity entities.BalanceGranularity, from, to time.Time) (entities.NetWorthHistory, error) {
+			return entities.NetWorthHistory{
+				Granularity: granularity,
+				From:        march(1),
+				To:          march(31),
+				Series: []entities.NetWorthSeries{{
+					Asset:  monetary.BRL,
+					Points: []entities.BalancePoint{{Date: march(31), Amount: brl(80000)}},
+				}},
+			}, nil
+		},
+	}
+	h := &ApiHandlers{BalanceUseCase: mockUC}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	t.Run("account history", func(t *testing.T) {
+		rec := get("/api/v1/balances/" + accountID + "/history?from=2025-03-01&to=2025-03-02")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotGranularity != "" || !gotFrom.Equal(march(1)) || !gotTo.Equal(march(2)) {
+			t.Errorf("unexpected parameters passed to the use case: %q %v %v", gotGranularity, gotFrom, gotTo)
+		}
+
+		var response BalanceHistoryResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		want := []BalancePointResponse{
+			{Date: "2025-03-01", Amount: brl(152075).String(), Value: 1520.75},
+			{Date: "2025-03-02", Amount: brl(-4590).String(), Value: -45.9},
+		}
+		if response.AccountID != accountID || response.From != "2025-03-01" || response.To != "2025-03-02" || len(response.Points) != len(want) {
+			t.Fatalf("unexpected response: %+v", response)
+		}
+		for i, point := range response.Points {
+			if point != want[i] {
+				t.Errorf("expected point %+v, got %+v", want[i], point)
+			}
+		}
+	})
+
+	t.Run("net worth", func(t *testing.T) {
+		rec := get("/api/v1/reports/net-worth?granularity=month")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+
+		var response NetWorthHistoryResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Granularity != entities.BalanceGranularityMonth || len(response.Series) != 1 {
+			t.Fatalf("unexpected response: %+v", response)
+		}
+		if series := response.Series[0]; series.Asset != "BRL" || len(series.Points) != 1 || series.Points[0].Value != 800 {
+			t.Errorf("unexpected series: %+v", series)
+		}
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		for _, path := range []string{
+			"/api/v1/balances/" + accountID + "/history?from=March",
+			"/api/v1/balances/" + accountID + "/history?granularity=week",
+			"/api/v1/balances/not-a-uuid/history",
+			"/api/v1/reports/net-worth?to=2025-13-01",
+		} {
+			if rec := get(path); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", path, rec.Code)
+			}
+		}
+	})
+}
@@ -0,0 +1,278 @@
+package v1
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/gofrs/uuid/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// BookHeader picks the book a request reads and writes, the default book is
+// used when it's not sent
+const BookHeader = "X-Book-Id"
+
+// BookRequest is a book, a separate ledger with its own accounts and
+// categories. Asset is the base currency it's reported in, the settings
+// currency when created without one and kept when updated without one.
+type BookRequest struct {
+	Name        string `json:"name" example:"Side business"`
+	Description string `json:"description"`
+	Asset       string `json:"asset" example:"USD"`
+}
+
+type BookResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name" example:"Side business"`
+	Description string `json:"description,omitempty"`
+	Asset       string `json:"asset" example:"USD"`
+	Default     bool   `json:"default"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/book_uc.go . BookUseCase
+type BookUseCase interface {
+	CreateBook(ctx context.Context, book entities.Book) (entities.Book, error)
+	GetBooks(ctx context.Context) ([]entities.Book, error)
+	GetBook(ctx context.Context, id string) (entities.Book, error)
+	UpdateBook(ctx context.Context, book entities.Book) (entities.Book, error)
+	DeleteBook(ctx context.Context, id string) error
+	DefaultBook(ctx context.Context) (entities.Book, error)
+}
+
+// Book handlers
+
+// CreateBook creates a book
+//
+//	@Summary		Create book
+//	@Description	Create a book, a separate ledger with its own accounts and categories, reported in its base currency. Requests pick it with the X-Book-Id header
+//	@Tags			books
+//	@Accept			json
+//	@Produce		json
+//	@Param			book	body		BookRequest			true	"Book data"
+//	@Success		201		{object}	BookResponse		"Book created successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		409		{object}	ErrorResponseBody	"Book name already taken"
+//	@Failure		413		{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/books [post]
+func (h *ApiHandlers) CreateBook(w http.ResponseWriter, r *http.Request) {
+	var req BookRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	book, err := h.BookUseCase.CreateBook(r.Context(), entities.Book{
+		Name:        req.Name,
+		Description: req.Description,
+		Asset:       monetary.Asset{Asset: strings.ToUpper(req.Asset)},
+	})
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	defaultBookID, err := h.defaultBookID(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, bookResponse(book, defaultBookID))
+}
+
+// GetBooks lists the books
+//
+//	@Summary		List books
+//	@Description	List the books ordered by name
+//	@Tags			books
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		BookResponse		"Books retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/books [get]
+func (h *ApiHandlers) GetBooks(w http.ResponseWriter, r *http.Request) {
+	books, err := h.BookUseCase.GetBooks(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	defaultBookID, err := h.defaultBookID(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	responses := make([]BookResponse, len(books))
+	for i, book := range books {
+		responses[i] = bookResponse(book, defaultBookID)
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetBook retrieves a book
+//
+//	@Summary		Get book
+//	@Description	Retrieve a book by its ID
+//	@Tags			books
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string				true	"Book ID"
+//	@Success		200	{object}	BookResponse		"Book retrieved successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Book not found"
+//	@Router			/books/{id} [get]
+func (h *ApiHandlers) GetBook(w http.ResponseWriter, r *http.Request) {
+	book, err := h.BookUseCase.GetBook(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	defaultBookID, err := h.defaultBookID(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	render.JSON(w, r, bookResponse(book, defaultBookID))
+}
+
+// UpdateBook updates a book
+//
+//	@Summary		Update book
+//	@Description	Rename a book or change its description and base currency
+//	@Tags			books
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Book ID"
+//	@Param			book	body		BookRequest			true	"Book data"
+//	@Success		200		{object}	BookResponse		"Book updated successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody	"Book not found"
+//	@Failure		409		{object}	ErrorResponseBody	"Book name already taken"
+//	@Failure		413		{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/books/{id} [put]
+func (h *ApiHandlers) UpdateBook(w http.ResponseWriter, r *http.Request) {
+	var req BookRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	book, err := h.BookUseCase.UpdateBook(r.Context(), entities.Book{
+		ID:          chi.URLParam(r, "id"),
+		Name:        req.Name,
+		Description: req.Description,
+		Asset:       monetary.Asset{Asset: strings.ToUpper(req.Asset)},
+	})
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	defaultBookID, err := h.defaultBookID(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	render.JSON(w, r, bookResponse(book, defaultBookID))
+}
+
+// DeleteBook deletes a book
+//
+//	@Summary		Delete book
+//	@Description	Delete a book without accounts or categories left. The default book can't be deleted
+//	@Tags			books
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	string	true	"Book ID"
+//	@Success		204	"Book deleted successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Book not found"
+//	@Failure		409	{object}	ErrorResponseBody	"Book still in use or the default book"
+//	@Router			/books/{id} [delete]
+func (h *ApiHandlers) DeleteBook(w http.ResponseWriter, r *http.Request) {
+	if err := h.BookUseCase.DeleteBook(r.Context(), chi.URLParam(r, "id")); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// bookScope scopes the request context to the book in the BookHeader, so the
+// accounts and categories read and written are the book's. Signed in users
+// can only pick their own books, and get their default book when they don't.
+func (h *ApiHandlers) bookScope(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		_, signedIn := domain.UserFromContext(ctx)
+
+		value := r.Header.Get(BookHeader)
+		if value == "" {
+			if signedIn {
+				book, err := h.BookUseCase.DefaultBook(ctx)
+				if err != nil {
+					errorResponse(w, r, http.StatusInternalServerError, err)
+					return
+				}
+				ctx = domain.WithBook(ctx, book.ID)
+			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+
+		id, err := uuid.FromString(value)
+		if err != nil {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, ErrorResponseBody{
+				Error:     errInvalidParameter(BookHeader, "must be a valid UUID").Error(),
+				Parameter: BookHeader,
+			})
+			return
+		}
+		if signedIn {
+			if _, err := h.BookUseCase.GetBook(ctx, id.String()); err != nil {
+				errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+				return
+			}
+		}
+		next.ServeHTTP(w, r.WithContext(domain.WithBook(ctx, id.String())))
+	})
+}
+
+// defaultBookID returns the book the requests that don't pick one go to
+func (h *ApiHandlers) defaultBookID(ctx context.Context) (string, error) {
+	if _, ok := domain.UserFromContext(ctx); !ok {
+		return domain.DefaultBookID, nil
+	}
+
+	book, err := h.BookUseCase.DefaultBook(ctx)
+	if err != nil {
+		return "", err
+	}
+	return book.ID, nil
+}
+
+func bookResponse(book entities.Book, defaultBookID string) BookResponse {
+	return BookResponse{
+		ID:          book.ID,
+		Name:        book.Name,
+		Description: book.Description,
+		Asset:       book.Asset.Asset,
+		Default:     book.ID == defaultBookID,
+		CreatedAt:   book.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   book.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestBookHandlers(t *testing.T) {
+	const bookID = "5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b"
+
+	books := []entities.Book{
+		{ID: domain.DefaultBookID, Name: "Personal"},
+		{ID: bookID, Name: "Side business"},
+	}
+
+	var gotBook entities.Book
+	var gotScope string
+	mockUC := &mocks.BookUseCaseMock{
+		CreateBookFunc: func(ctx context.Context, book entities.Book) (entities.Book, error) {
+			gotBook = book
+			book.ID = bookID
+			return book, nil
+		},
+		GetBooksFunc: func(ctx context.Context) ([]entities.Book, error) {
+			gotScope = domain.BookFromContext(ctx)
+			return books, nil
+		},
+		GetBookFunc: func(ctx context.Context, id string) (entities.Book, error) {
+			for _, book := range books {
+				if book.ID == id {
+					return book, nil
+				}
+			}
+			return entities.Book{}, fmt.Errorf("book %w", domain.ErrNotFound)
+		},
+		DeleteBookFunc: func(ctx context.Context, id string) error {
+			if id == domain.DefaultBookID {
+				return fmt.Errorf("the default book cannot be deleted: %w", domain.ErrConflict)
+			}
+			return nil
+		},
+	}
+	h := &ApiHandlers{BookUseCase: mockUC}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	serve := func(method, path, body, book string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		if book != "" {
+			req.Header.Set(BookHeader, book)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("creates a book", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/books", `{"name":"Side business"}`, "")
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotBook.Name != "Side business" {
+			t.Errorf("unexpected book passed to the use case: %+v", gotBook)
+		}
+	})
+
+	t.Run("lists books", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/books", "", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+
+		var response []BookResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response) != 2 || !response[0].Default || response[1].Default {
+			t.Errorf("unexpected books: %+v", response)
+		}
+		if gotScope != domain.DefaultBookID {
+			t.Errorf("expected the default book without a header, got %q", gotScope)
+		}
+	})
+
+	t.Run("scopes the request to the header's book", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/books", "", "5E6F7A8B-9C0D-4E1F-8A2B-3C4D5E6F7A8B")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotScope != bookID {
+			t.Errorf("expected book %s, got %q", bookID, gotScope)
+		}
+	})
+
+	t.Run("invalid book header", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/books", "", "personal")
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+
+		var response ErrorResponseBody
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Parameter != BookHeader {
+			t.Errorf("expected parameter %s, got %q", BookHeader, response.Parameter)
+		}
+	})
+
+	t.Run("default book can't be deleted", func(t *testing.T) {
+		if rec := serve(http.MethodDelete, "/api/v1/books/"+domain.DefaultBookID, "", ""); rec.Code != http.StatusConflict {
+			t.Errorf("expected status 409, got %d", rec.Code)
+		}
+		if rec := serve(http.MethodDelete, "/api/v1/books/"+bookID, "", ""); rec.Code != http.StatusNoContent {
+			t.Errorf("expected status 204, got %d", rec.Code)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/books/8e9f0a1b-2c3d-4e5f-9a6b-7c8d9e0f1a2b", "", ""); rec.Code != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", rec.Code)
+		}
+	})
+}
@@ -0,0 +1,598 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// defaultBudgetHistoryMonths is how many months the budget history covers
+// when not asked for
+const defaultBudgetHistoryMonths = 12
+
+// BudgetRequest is the monthly limit of a category. The asset defaults to the
+// base currency of the book and the start month to the current one, the
+// budget is open ended without an end month. Thresholds are the percents of
+// the limit to alert on, 50, 80 and 100 by default.
+type BudgetRequest struct {
+	CategoryID string `json:"category_id"`
+	Amount     string `json:"amount" example:"800.00"`
+	Asset      string `json:"asset" example:"BRL"`
+	StartMonth string `json:"start_month" example:"2025-04"`
+	EndMonth   string `json:"end_month" example:"2025-12"`
+	Thresholds []int  `json:"thresholds,omitempty" example:"50,80,100"`
+}
+
+type BudgetResponse struct {
+	ID         string `json:"id"`
+	CategoryID string `json:"category_id"`
+	Limit      string `json:"limit" example:"[BRL (R$) 800.00]"`
+	Asset      string `json:"asset" example:"BRL"`
+	StartMonth string `json:"start_month" example:"2025-04"`
+	EndMonth   string `json:"end_month,omitempty" example:"2025-12"`
+	Thresholds []int  `json:"thresholds" example:"50,80,100"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+// BudgetWarningResponse is a budget a transaction left over its limit in the
+// month, overspent by how much the category took past it
+type BudgetWarningResponse struct {
+	BudgetID     string  `json:"budget_id"`
+	CategoryID   string  `json:"category_id"`
+	CategoryName string  `json:"category_name" example:"Groceries"`
+	Month        string  `json:"month" example:"2025-04"`
+	Limit        string  `json:"limit" example:"[BRL (R$) 800.00]"`
+	Spent        string  `json:"spent" example:"[BRL (R$) 900.00]"`
+	Overspent    string  `json:"overspent" example:"[BRL (R$) 100.00]"`
+	Percent      float64 `json:"percent" example:"112.5"`
+}
+
+// BudgetAlertResponse is a budget reaching one of its thresholds in a month,
+// with what its category had taken of the limit then
+type BudgetAlertResponse struct {
+	ID         string  `json:"id"`
+	BudgetID   string  `json:"budget_id"`
+	CategoryID string  `json:"category_id"`
+	Month      string  `json:"month" example:"2025-04"`
+	Threshold  int     `json:"threshold" example:"80"`
+	Percent    float64 `json:"percent" example:"81.3"`
+	Spent      string  `json:"spent" example:"[BRL (R$) 650.00]"`
+	Limit      string  `json:"limit" example:"[BRL (R$) 800.00]"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+// BudgetProgressResponse is how much of a budget its category took in a month
+// with the cleared transactions. Remaining is negative and over true once the
+// budget is overspent, percent is the share of the limit spent. The budget of
+// a group counts the categories in it too, breaking the spending down by
+// category.
+type BudgetProgressResponse struct {
+	Budget        BudgetResponse                   `json:"budget"`
+	CategoryName  string                           `json:"category_name" example:"Groceries"`
+	CategoryColor string                           `json:"category_color,omitempty" example:"#4caf50"`
+	Month         string                           `json:"month" example:"2025-04"`
+	Spent         string                           `json:"spent" example:"[BRL (R$) 500.00]"`
+	Remaining     string                           `json:"remaining" example:"[BRL (R$) 300.00]"`
+	Percent       float64                          `json:"percent" example:"62.5"`
+	Over          bool                             `json:"over" example:"false"`
+	Breakdown     []BudgetCategorySpendingResponse `json:"breakdown,omitempty"`
+}
+
+// BudgetCategorySpendingResponse is what a category of a group took of the
+// group's budget in the month
+type BudgetCategorySpendingResponse struct {
+	CategoryID   string `json:"category_id"`
+	CategoryName string `json:"category_name" example:"Restaurants"`
+	Spent        string `json:"spent" example:"[BRL (R$) 320.00]"`
+}
+
+// BudgetCopyResponse is how a month was set up: the budgets created for the
+// month alone and the IDs of the categories skipped, since they already had a
+// budget in it or no longer exist
+type BudgetCopyResponse struct {
+	Month   string           `json:"month" example:"2025-05"`
+	Created []BudgetResponse `json:"created"`
+	Skipped []string         `json:"skipped"`
+}
+
+// BudgetHistoryResponse is how each budgeted category kept to its budgets in
+// each of the last months, the current one last
+type BudgetHistoryResponse struct {
+	Months     []string                        `json:"months" example:"2025-03,2025-04"`
+	Categories []BudgetCategoryHistoryResponse `json:"categories"`
+}
+
+// BudgetCategoryHistoryResponse is what a category was budgeted and took in
+// each month. Adherence is the percent of the months it had a budget in that
+// it kept within the limit.
+type BudgetCategoryHistoryResponse struct {
+	CategoryID    string                `json:"category_id"`
+	CategoryName  string                `json:"category_name" example:"Groceries"`
+	CategoryColor string                `json:"category_color,omitempty" example:"#4caf50"`
+	Adherence     float64               `json:"adherence" example:"83.3"`
+	Months        []BudgetMonthResponse `json:"months"`
+}
+
+// BudgetMonthResponse is the limit of a category in a month, left out when it
+// had no budget, and what it took with the cleared transactions
+type BudgetMonthResponse struct {
+	Month    string `json:"month" example:"2025-04"`
+	Budgeted string `json:"budgeted,omitempty" example:"[BRL (R$) 800.00]"`
+	Actual   string `json:"actual" example:"[BRL (R$) 650.00]"`
+	Over     bool   `json:"over" example:"false"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/budget_uc.go . BudgetUseCase
+type BudgetUseCase interface {
+	CreateBudget(ctx context.Context, budget entities.Budget) (entities.Budget, error)
+	GetBudgets(ctx context.Context) ([]entities.Budget, error)
+	GetBudget(ctx context.Context, id string) (entities.Budget, error)
+	UpdateBudget(ctx context.Context, budget entities.Budget) (entities.Budget, error)
+	DeleteBudget(ctx context.Context, id string) error
+	GetBudgetProgress(ctx context.Context, month time.Time) ([]entities.BudgetProgress, error)
+	CopyBudgets(ctx context.Context, from, to time.Time) (entities.BudgetCopy, error)
+	GetBudgetHistory(ctx context.Context, months int) (entities.BudgetHistory, error)
+	CreateBudgetTemplate(ctx context.Context, template entities.BudgetTemplate) (entities.BudgetTemplate, error)
+	GetBudgetTemplates(ctx context.Context) ([]entities.BudgetTemplate, error)
+	GetBudgetTemplate(ctx context.Context, id string) (entities.BudgetTemplate, error)
+	UpdateBudgetTemplate(ctx context.Context, template entities.BudgetTemplate) (entities.BudgetTemplate, error)
+	DeleteBudgetTemplate(ctx context.Context, id string) error
+	ApplyBudgetTemplate(ctx context.Context, id string, month time.Time) (entities.BudgetCopy, error)
+	OverBudget(ctx context.Context, transaction entities.Transaction) ([]entities.BudgetProgress, error)
+	EvaluateBudgetAlerts(ctx context.Context) ([]entities.BudgetAlert, error)
+	GetBudgetAlerts(ctx context.Context) ([]entities.BudgetAlert, error)
+}
+
+// Budget handlers
+
+// CreateBudget sets the monthly limit of a category
+//
+//	@Summary		Create budget
+//	@Description	Set the monthly limit of a category, in the currency of the accounts it's spent from. The budgets of a category can't overlap
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Param			budget	body		BudgetRequest		true	"Budget data"
+//	@Success		201		{object}	BudgetResponse		"Budget created successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody	"Category not found"
+//	@Failure		409		{object}	ErrorResponseBody	"Category already has a budget in those months"
+//	@Failure		413		{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/budgets [post]
+func (h *ApiHandlers) CreateBudget(w http.ResponseWriter, r *http.Request) {
+	budget, ok := decodeBudget(w, r)
+	if !ok {
+		return
+	}
+
+	created, err := h.BudgetUseCase.CreateBudget(r.Context(), budget)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, budgetResponse(created))
+}
+
+// GetBudgets lists the budgets
+//
+//	@Summary		List budgets
+//	@Description	List the budgets ordered by the type and name of their category, then by the month they start
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		BudgetResponse		"Budgets retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/budgets [get]
+func (h *ApiHandlers) GetBudgets(w http.ResponseWriter, r *http.Request) {
+	budgets, err := h.BudgetUseCase.GetBudgets(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	responses := make([]BudgetResponse, len(budgets))
+	for i, budget := range budgets {
+		responses[i] = budgetResponse(budget)
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetBudgetProgress reports the progress of the budgets in a month
+//
+//	@Summary		Budget progress
+//	@Description	Add up the cleared transactions of each budgeted category in a month, in the currency of its budget, against the limit. The budget of a category grouping others counts theirs too, with a breakdown per category. Only the budgets applying in the month are reported
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Param			month	query		string					false	"Month (YYYY-MM), the current one by default"
+//	@Success		200		{array}		BudgetProgressResponse	"Progress retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody		"Invalid month"
+//	@Failure		500		{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/budgets/progress [get]
+func (h *ApiHandlers) GetBudgetProgress(w http.ResponseWriter, r *http.Request) {
+	var month time.Time
+	if value := r.URL.Query().Get("month"); value != "" {
+		var err error
+		if month, err = time.Parse("2006-01", value); err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("month", "must be in format YYYY-MM"))
+			return
+		}
+	}
+
+	progress, err := h.BudgetUseCase.GetBudgetProgress(r.Context(), month)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	responses := make([]BudgetProgressResponse, len(progress))
+	for i, p := range progress {
+		responses[i] = BudgetProgressResponse{
+			Budget:        budgetResponse(p.Budget),
+			CategoryName:  p.Category.Name,
+			CategoryColor: p.Category.Color,
+			Month:         p.Month,
+			Spent:         p.Spent.String(),
+			Remaining:     p.Remaining.String(),
+			Percent:       p.Percent,
+			Over:          p.Over,
+		}
+		for _, spending := range p.Breakdown {
+			responses[i].Breakdown = append(responses[i].Breakdown, BudgetCategorySpendingResponse{
+				CategoryID:   spending.Category.ID,
+				CategoryName: spending.Category.Name,
+				Spent:        spending.Spent.String(),
+			})
+		}
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetBudgetAlerts lists the alerts the budgets fired
+//
+//	@Summary		Budget alert history
+//	@Description	List the alerts the budgets fired, the latest first. A budget fires an alert the first time each month its category takes one of its thresholds, a percent of the limit. The budgets of the current month are evaluated whenever transactions change through the API, and on the schedule of the budget alerts job
+//	@Tags			budgets
+//	@Produce		json
+//	@Success		200	{array}		BudgetAlertResponse	"Budget alerts retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/budgets/alerts [get]
+func (h *ApiHandlers) GetBudgetAlerts(w http.ResponseWriter, r *http.Request) {
+	alerts, err := h.BudgetUseCase.GetBudgetAlerts(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	responses := make([]BudgetAlertResponse, len(alerts))
+	for i, alert := range alerts {
+		responses[i] = budgetAlertResponse(alert)
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetBudgetHistory returns how the budgets were kept in the last months
+//
+//	@Summary		Budget history
+//	@Description	Get what each category with a budget in the last months, the current one included, was budgeted and took in every one of them, counting the cleared transactions like the budget progress. The adherence of a category is the percent of the months it had a budget in that it kept within the limit. Months without a budget have no budgeted amount
+//	@Tags			reports
+//	@Produce		json
+//	@Param			months	query		int						false	"Number of months, 1 to 24 (default 12)"
+//	@Success		200		{object}	BudgetHistoryResponse	"Budget history retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody		"Invalid number of months"
+//	@Failure		500		{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/reports/budget-history [get]
+func (h *ApiHandlers) GetBudgetHistory(w http.ResponseWriter, r *http.Request) {
+	months := defaultBudgetHistoryMonths
+	if value := r.URL.Query().Get("months"); value != "" {
+		var err error
+		if months, err = strconv.Atoi(value); err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("months", value))
+			return
+		}
+	}
+
+	history, err := h.BudgetUseCase.GetBudgetHistory(r.Context(), months)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := BudgetHistoryResponse{
+		Months:     history.Months,
+		Categories: make([]BudgetCategoryHistoryResponse, len(history.Categories)),
+	}
+	for i, category := range history.Categories {
+		response.Categories[i] = BudgetCategoryHistoryResponse{
+			CategoryID:    category.Category.ID,
+			CategoryName:  category.Category.Name,
+			CategoryColor: category.Category.Color,
+			Adherence:     category.Adherence,
+			Months:        make([]BudgetMonthResponse, len(category.Months)),
+		}
+		for j, month := range category.Months {
+			response.Categories[i].Months[j] = BudgetMonthResponse{
+				Month:  month.Month,
+				Actual: month.Actual.String(),
+				Over:   month.Over,
+			}
+			if month.Budgeted != nil {
+				response.Categories[i].Months[j].Budgeted = month.Budgeted.String()
+			}
+		}
+	}
+
+	render.JSON(w, r, response)
+}
+
+// CopyBudgets sets up a month with the budgets of another
+//
+//	@Summary		Copy budgets
+//	@Description	Copy the limits of the budgets applying in a month to another, each as a budget for that month alone. The categories already budgeted in the month copied to are skipped and keep their budget. All the budgets are created or none
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Param			from	query		string				true	"Month to copy from (YYYY-MM)"
+//	@Param			to		query		string				true	"Month to copy to (YYYY-MM)"
+//	@Success		201		{object}	BudgetCopyResponse	"Budgets copied successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Invalid month"
+//	@Failure		409		{object}	ErrorResponseBody	"Category already has a budget in the month"
+//	@Router			/budgets/copy [post]
+func (h *ApiHandlers) CopyBudgets(w http.ResponseWriter, r *http.Request) {
+	from, ok := parseBudgetMonth(w, r, "from")
+	if !ok {
+		return
+	}
+	to, ok := parseBudgetMonth(w, r, "to")
+	if !ok {
+		return
+	}
+
+	copied, err := h.BudgetUseCase.CopyBudgets(r.Context(), from, to)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, budgetCopyResponse(copied))
+}
+
+// GetBudget retrieves a budget
+//
+//	@Summary		Get budget
+//	@Description	Retrieve a budget by its ID
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string				true	"Budget ID"
+//	@Success		200	{object}	BudgetResponse		"Budget retrieved successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Budget not found"
+//	@Router			/budgets/{id} [get]
+func (h *ApiHandlers) GetBudget(w http.ResponseWriter, r *http.Request) {
+	budget, err := h.BudgetUseCase.GetBudget(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, budgetResponse(budget))
+}
+
+// UpdateBudget updates a budget
+//
+//	@Summary		Update budget
+//	@Description	Change the category, limit, months and alert thresholds of a budget
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Budget ID"
+//	@Param			budget	body		BudgetRequest		true	"Budget data"
+//	@Success		200		{object}	BudgetResponse		"Budget updated successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody	"Budget or category not found"
+//	@Failure		409		{object}	ErrorResponseBody	"Category already has a budget in those months"
+//	@Failure		413		{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/budgets/{id} [put]
+func (h *ApiHandlers) UpdateBudget(w http.ResponseWriter, r *http.Request) {
+	budget, ok := decodeBudget(w, r)
+	if !ok {
+		return
+	}
+	budget.ID = chi.URLParam(r, "id")
+
+	updated, err := h.BudgetUseCase.UpdateBudget(r.Context(), budget)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, budgetResponse(updated))
+}
+
+// DeleteBudget deletes a budget
+//
+//	@Summary		Delete budget
+//	@Description	Delete a budget, its category is kept
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	string	true	"Budget ID"
+//	@Success		204	"Budget deleted successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Budget not found"
+//	@Router			/budgets/{id} [delete]
+func (h *ApiHandlers) DeleteBudget(w http.ResponseWriter, r *http.Request) {
+	if err := h.BudgetUseCase.DeleteBudget(r.Context(), chi.URLParam(r, "id")); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseBudgetMonth reads the required month query parameter param, answering
+// the request itself when it's missing or invalid
+func parseBudgetMonth(w http.ResponseWriter, r *http.Request, param string) (time.Time, bool) {
+	value := r.URL.Query().Get(param)
+	if value == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter(param))
+		return time.Time{}, false
+	}
+	month, err := time.Parse("2006-01", value)
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, errInvalidParameter(param, "must be in format YYYY-MM"))
+		return time.Time{}, false
+	}
+	return month, true
+}
+
+// decodeBudget reads a budget from the request body, answering the request
+// itself when it's invalid
+func decodeBudget(w http.ResponseWriter, r *http.Request) (entities.Budget, bool) {
+	var req BudgetRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return entities.Budget{}, false
+	}
+
+	limit, err := parseBudgetLimit(req.Amount, req.Asset, "")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return entities.Budget{}, false
+	}
+
+	budget := entities.Budget{
+		CategoryID: req.CategoryID,
+		Limit:      limit,
+		Thresholds: req.Thresholds,
+	}
+
+	// The use case defaults the start month to the current one
+	if req.StartMonth != "" {
+		if budget.StartMonth, err = time.Parse("2006-01", req.StartMonth); err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("start_month", "must be in format YYYY-MM"))
+			return entities.Budget{}, false
+		}
+	}
+	if req.EndMonth != "" {
+		endMonth, err := time.Parse("2006-01", req.EndMonth)
+		if err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("end_month", "must be in format YYYY-MM"))
+			return entities.Budget{}, false
+		}
+		budget.EndMonth = &endMonth
+	}
+
+	return budget, true
+}
+
+// parseBudgetLimit reads the amount and asset of a limit, naming the fields
+// after prefix in the errors. Without an asset the use case takes the limit
+// in the base currency of the book.
+func parseBudgetLimit(amount, asset, prefix string) (monetary.Monetary, error) {
+	var limit monetary.Monetary
+	if asset != "" {
+		var ok bool
+		if limit.Asset, ok = entities.FindSupportedAsset(asset); !ok {
+			return monetary.Monetary{}, errInvalidParameter(prefix+"asset", "unsupported asset")
+		}
+	}
+
+	amountFloat, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return monetary.Monetary{}, errInvalidParameter(prefix+"amount", "must be a valid decimal number")
+	}
+	limit.Amount = big.NewInt(int64(amountFloat * 100))
+
+	return limit, nil
+}
+
+func budgetResponse(budget entities.Budget) BudgetResponse {
+	response := BudgetResponse{
+		ID:         budget.ID,
+		CategoryID: budget.CategoryID,
+		Limit:      budget.Limit.String(),
+		Asset:      budget.Limit.Asset.Asset,
+		StartMonth: budget.StartMonth.Format("2006-01"),
+		Thresholds: budget.AlertThresholds(),
+		CreatedAt:  budget.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:  budget.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if budget.EndMonth != nil {
+		response.EndMonth = budget.EndMonth.Format("2006-01")
+	}
+	return response
+}
+
+// budgetWarnings tells which budgets a created transaction left over their
+// limit. The transaction went through, failing to check its budgets only
+// loses the warnings.
+func (h *ApiHandlers) budgetWarnings(ctx context.Context, transaction entities.Transaction) []BudgetWarningResponse {
+	if h.BudgetUseCase == nil {
+		return nil
+	}
+
+	over, err := h.BudgetUseCase.OverBudget(ctx, transaction)
+	if err != nil {
+		slog.Warn("failed to check the budgets of the transaction", "transaction_id", transaction.ID, "error", err)
+		return nil
+	}
+
+	var warnings []BudgetWarningResponse
+	for _, p := range over {
+		overspent := monetary.Monetary{Asset: p.Remaining.Asset, Amount: new(big.Int).Neg(p.Remaining.Amount)}
+		warnings = append(warnings, BudgetWarningResponse{
+			BudgetID:     p.Budget.ID,
+			CategoryID:   p.Budget.CategoryID,
+			CategoryName: p.Category.Name,
+			Month:        p.Month,
+			Limit:        p.Budget.Limit.String(),
+			Spent:        p.Spent.String(),
+			Overspent:    overspent.String(),
+			Percent:      p.Percent,
+		})
+	}
+	return warnings
+}
+
+func budgetAlertResponse(alert entities.BudgetAlert) BudgetAlertResponse {
+	return BudgetAlertResponse{
+		ID:         alert.ID,
+		BudgetID:   alert.BudgetID,
+		CategoryID: alert.CategoryID,
+		Month:      alert.Month,
+		Threshold:  alert.Threshold,
+		Percent:    alert.Percent,
+		Spent:      alert.Spent.String(),
+		Limit:      alert.Limit.String(),
+		CreatedAt:  alert.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func budgetCopyResponse(copied entities.BudgetCopy) BudgetCopyResponse {
+	response := BudgetCopyResponse{
+		Month:   copied.Month.Format("2006-01"),
+		Created: make([]BudgetResponse, len(copied.Created)),
+		Skipped: copied.Skipped,
+	}
+	for i, budget := range copied.Created {
+		response.Created[i] = budgetResponse(budget)
+	}
+	return response
+}
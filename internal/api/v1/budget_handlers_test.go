@@ -0,0 +1,297 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestBudgetHandlers(t *testing.T) {
+	const budgetID = "6e7f8a9b-0c1d-4e2f-8a3b-4c5d6e7f8a9b"
+	const missingID = "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+
+	limit, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(80000))
+	spent, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(90000))
+	remaining := monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(-10000)}
+	budget := entities.Budget{ID: budgetID, CategoryID: "cat-groceries", Limit: *limit, StartMonth: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)}
+
+	var gotBudget entities.Budget
+	var gotMonth, gotFrom, gotTo time.Time
+	var gotMonths int
+	mockUC := &mocks.BudgetUseCaseMock{
+		CopyBudgetsFunc: func(ctx context.Context, from, to time.Time) (entities.BudgetCopy, error) {
+			gotFrom, gotTo = from, to
+			copied := budget
+			copied.StartMonth = to
+			copied.EndMonth = &to
+			return entities.BudgetCopy{Month: to, Created: []entities.Budget{copied}, Skipped: []string{"cat-dining"}}, nil
+		},
+		CreateBudgetFunc: func(ctx context.Context, created entities.Budget) (entities.Budget, error) {
+			if created.CategoryID == "" {
+				return entities.Budget{}, fmt.Errorf("budget category ID cannot be empty: %w", domain.ErrMalformedParameters)
+			}
+			gotBudget = created
+			created.ID = budgetID
+			return created, nil
+		},
+		GetBudgetAlertsFunc: func(ctx context.Context) ([]entities.BudgetAlert, error) {
+			return []entities.BudgetAlert{
+				{ID: "alert-2", BudgetID: budgetID, CategoryID: "cat-groceries", Month: "2025-04", Threshold: 100, Percent: 112.5, Spent: *spent, Limit: *limit},
+				{ID: "alert-1", BudgetID: budgetID, CategoryID: "cat-groceries", Month: "2025-04", Threshold: 80, Percent: 112.5, Spent: *spent, Limit: *limit},
+			}, nil
+		},
+		GetBudgetFunc: func(ctx context.Context, id string) (entities.Budget, error) {
+			if id != budgetID {
+				return entities.Budget{}, fmt.Errorf("budget %w", domain.ErrNotFound)
+			}
+			return budget, nil
+		},
+		GetBudgetHistoryFunc: func(ctx context.Context, months int) (entities.BudgetHistory, error) {
+			gotMonths = months
+			if months > entities.MaxBudgetHistoryMonths {
+				return entities.BudgetHistory{}, fmt.Errorf("months must be between 1 and 24, got %d: %w", months, domain.ErrMalformedParameters)
+			}
+			return entities.BudgetHistory{
+				Months: []string{"2025-03", "2025-04"},
+				Categories: []entities.BudgetCategoryHistory{{
+					Category: entities.Category{ID: "cat-groceries", Name: "Groceries"},
+					Months: []entities.BudgetMonth{
+						{Month: "2025-03", Actual: *spent},
+						{Month: "2025-04", Budgeted: limit, Actual: *spent, Over: true},
+					},
+				}},
+			}, nil
+		},
+		GetBudgetProgressFunc: func(ctx context.Context, month time.Time) ([]entities.BudgetProgress, error) {
+			gotMonth = month
+			return []entities.BudgetProgress{{
+				Budget:    budget,
+				Category:  entities.Category{ID: "cat-groceries", Name: "Groceries"},
+				Month:     "2025-04",
+				Spent:     *spent,
+				Remaining: remaining,
+				Percent:   112.5,
+				Over:      true,
+				Breakdown: []entities.BudgetCategorySpending{
+					{Category: entities.Category{ID: "cat-groceries", Name: "Groceries"}, Spent: remaining},
+					{Category: entities.Category{ID: "cat-bakery", Name: "Bakery", ParentID: "cat-groceries"}, Spent: *spent},
+				},
+			}}, nil
+		},
+	}
+	h := &ApiHandlers{BudgetUseCase: mockUC}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(method, path, bytes.NewBufferString(body)))
+		return rec
+	}
+
+	t.Run("creates a budget", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/budgets", `{"category_id":"cat-groceries","amount":"800.00","asset":"USD","start_month":"2025-04","end_month":"2025-12"}`)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotBudget.Limit.Asset != monetary.USD || gotBudget.Limit.Amount.Int64() != 80000 {
+			t.Errorf("unexpected limit passed to the use case: %s", gotBudget.Limit.String())
+		}
+		if gotBudget.StartMonth.Format("2006-01") != "2025-04" || gotBudget.EndMonth == nil || gotBudget.EndMonth.Format("2006-01") != "2025-12" {
+			t.Errorf("unexpected months passed to the use case: %+v", gotBudget)
+		}
+		if gotBudget.Thresholds != nil {
+			t.Errorf("expected the thresholds left to the use case, got %v", gotBudget.Thresholds)
+		}
+
+		var response BudgetResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if fmt.Sprint(response.Thresholds) != "[50 80 100]" {
+			t.Errorf("expected the default thresholds, got %v", response.Thresholds)
+		}
+	})
+
+	t.Run("creates a budget with its thresholds", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/budgets", `{"category_id":"cat-groceries","amount":"800.00","thresholds":[75,100]}`)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		if fmt.Sprint(gotBudget.Thresholds) != "[75 100]" {
+			t.Errorf("unexpected thresholds passed to the use case: %v", gotBudget.Thresholds)
+		}
+	})
+
+	t.Run("leaves the asset to the use case", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/budgets", `{"category_id":"cat-groceries","amount":"800"}`)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotBudget.Limit.Asset.Asset != "" || !gotBudget.StartMonth.IsZero() || gotBudget.EndMonth != nil {
+			t.Errorf("unexpected budget passed to the use case: %+v", gotBudget)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, body := range []string{
+			`{"amount":"800.00"}`,
+			`{"category_id":"cat-groceries","amount":"lots"}`,
+			`{"category_id":"cat-groceries","amount":"800.00","asset":"XYZ"}`,
+			`{"category_id":"cat-groceries","amount":"800.00","start_month":"April"}`,
+		} {
+			if rec := serve(http.MethodPost, "/api/v1/budgets", body); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", body, rec.Code)
+			}
+		}
+	})
+
+	t.Run("reports the progress of a month", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/budgets/progress?month=2025-04", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotMonth.Format("2006-01") != "2025-04" {
+			t.Errorf("unexpected month passed to the use case: %s", gotMonth)
+		}
+
+		var response []BudgetProgressResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response) != 1 || response[0].CategoryName != "Groceries" || !response[0].Over || response[0].Budget.Asset != "BRL" {
+			t.Fatalf("unexpected progress: %+v", response)
+		}
+		if breakdown := response[0].Breakdown; len(breakdown) != 2 || breakdown[1].CategoryID != "cat-bakery" || breakdown[1].Spent != spent.String() {
+			t.Errorf("unexpected breakdown: %+v", breakdown)
+		}
+	})
+
+	t.Run("progress of the current month", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/budgets/progress", ""); rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if !gotMonth.IsZero() {
+			t.Errorf("expected no month, got %s", gotMonth)
+		}
+	})
+
+	t.Run("invalid month", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/budgets/progress?month=April", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("lists the alerts fired", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/budgets/alerts", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+
+		var response []BudgetAlertResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response) != 2 || response[0].Threshold != 100 || response[1].Threshold != 80 {
+			t.Fatalf("unexpected alerts: %+v", response)
+		}
+		if response[0].Month != "2025-04" || response[0].Spent != spent.String() || response[0].Limit != limit.String() {
+			t.Errorf("unexpected alert: %+v", response[0])
+		}
+	})
+
+	t.Run("reports the history of the last months", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/reports/budget-history?months=2", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotMonths != 2 {
+			t.Errorf("expected 2 months, got %d", gotMonths)
+		}
+
+		var response BudgetHistoryResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response.Months) != 2 || len(response.Categories) != 1 || response.Categories[0].CategoryName != "Groceries" {
+			t.Fatalf("unexpected history: %+v", response)
+		}
+		want := []BudgetMonthResponse{
+			{Month: "2025-03", Actual: "[BRL (R$) 900.00]"},
+			{Month: "2025-04", Budgeted: "[BRL (R$) 800.00]", Actual: "[BRL (R$) 900.00]", Over: true},
+		}
+		for i, month := range response.Categories[0].Months {
+			if month != want[i] {
+				t.Errorf("expected month %+v, got %+v", want[i], month)
+			}
+		}
+	})
+
+	t.Run("history of 12 months by default", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/reports/budget-history", ""); rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if gotMonths != defaultBudgetHistoryMonths {
+			t.Errorf("expected %d months, got %d", defaultBudgetHistoryMonths, gotMonths)
+		}
+	})
+
+	t.Run("invalid history months", func(t *testing.T) {
+		for _, query := range []string{"?months=many", "?months=25"} {
+			if rec := serve(http.MethodGet, "/api/v1/reports/budget-history"+query, ""); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", query, rec.Code)
+			}
+		}
+	})
+
+	t.Run("copies the budgets of a month", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/budgets/copy?from=2024-05&to=2024-06", "")
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotFrom.Format("2006-01") != "2024-05" || gotTo.Format("2006-01") != "2024-06" {
+			t.Errorf("unexpected months passed to the use case: %s, %s", gotFrom, gotTo)
+		}
+
+		var response BudgetCopyResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Month != "2024-06" || len(response.Created) != 1 || response.Created[0].EndMonth != "2024-06" ||
+			len(response.Skipped) != 1 || response.Skipped[0] != "cat-dining" {
+			t.Errorf("unexpected copy: %+v", response)
+		}
+	})
+
+	t.Run("invalid copy", func(t *testing.T) {
+		for _, query := range []string{"?from=2024-05", "?to=2024-06", "?from=May&to=2024-06"} {
+			if rec := serve(http.MethodPost, "/api/v1/budgets/copy"+query, ""); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", query, rec.Code)
+			}
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/budgets/"+missingID, ""); rec.Code != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", rec.Code)
+		}
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		if rec := serve(http.MethodDelete, "/api/v1/budgets/not-a-uuid", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
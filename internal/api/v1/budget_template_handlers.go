@@ -0,0 +1,246 @@
+package v1
+
+import (
+	"finance/domain/entities"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// BudgetTemplateRequest is a named set of category limits set up in a month
+// at once. The asset of a limit defaults to the base currency of the book.
+type BudgetTemplateRequest struct {
+	Name  string                      `json:"name" example:"Regular month"`
+	Items []BudgetTemplateItemRequest `json:"items"`
+}
+
+type BudgetTemplateItemRequest struct {
+	CategoryID string `json:"category_id"`
+	Amount     string `json:"amount" example:"800.00"`
+	Asset      string `json:"asset" example:"BRL"`
+}
+
+type BudgetTemplateResponse struct {
+	ID        string                       `json:"id"`
+	Name      string                       `json:"name" example:"Regular month"`
+	Items     []BudgetTemplateItemResponse `json:"items"`
+	CreatedAt string                       `json:"created_at"`
+	UpdatedAt string                       `json:"updated_at"`
+}
+
+type BudgetTemplateItemResponse struct {
+	CategoryID string `json:"category_id"`
+	Limit      string `json:"limit" example:"[BRL (R$) 800.00]"`
+	Asset      string `json:"asset" example:"BRL"`
+}
+
+// Budget template handlers
+
+// CreateBudgetTemplate saves a budget template
+//
+//	@Summary		Create budget template
+//	@Description	Save a named set of category limits to set up months with. A template limits a category once at most
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Param			template	body		BudgetTemplateRequest	true	"Budget template"
+//	@Success		201			{object}	BudgetTemplateResponse	"Budget template created successfully"
+//	@Failure		400			{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404			{object}	ErrorResponseBody		"Category not found"
+//	@Failure		409			{object}	ErrorResponseBody		"Template name already taken"
+//	@Failure		413			{object}	ErrorResponseBody		"Request body too large"
+//	@Router			/budget-templates [post]
+func (h *ApiHandlers) CreateBudgetTemplate(w http.ResponseWriter, r *http.Request) {
+	template, ok := decodeBudgetTemplate(w, r)
+	if !ok {
+		return
+	}
+
+	created, err := h.BudgetUseCase.CreateBudgetTemplate(r.Context(), template)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, budgetTemplateResponse(created))
+}
+
+// GetBudgetTemplates lists the budget templates
+//
+//	@Summary		List budget templates
+//	@Description	List the budget templates of the book, ordered by name
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		BudgetTemplateResponse	"Budget templates retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/budget-templates [get]
+func (h *ApiHandlers) GetBudgetTemplates(w http.ResponseWriter, r *http.Request) {
+	templates, err := h.BudgetUseCase.GetBudgetTemplates(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	responses := make([]BudgetTemplateResponse, len(templates))
+	for i, template := range templates {
+		responses[i] = budgetTemplateResponse(template)
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetBudgetTemplate retrieves a budget template
+//
+//	@Summary		Get budget template
+//	@Description	Retrieve a budget template by its ID
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string					true	"Budget template ID"
+//	@Success		200	{object}	BudgetTemplateResponse	"Budget template retrieved successfully"
+//	@Failure		400	{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody		"Budget template not found"
+//	@Router			/budget-templates/{id} [get]
+func (h *ApiHandlers) GetBudgetTemplate(w http.ResponseWriter, r *http.Request) {
+	template, err := h.BudgetUseCase.GetBudgetTemplate(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, budgetTemplateResponse(template))
+}
+
+// UpdateBudgetTemplate updates a budget template
+//
+//	@Summary		Update budget template
+//	@Description	Rename a budget template and replace its limits. The budgets set up with it are kept as they are
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string					true	"Budget template ID"
+//	@Param			template	body		BudgetTemplateRequest	true	"Budget template"
+//	@Success		200			{object}	BudgetTemplateResponse	"Budget template updated successfully"
+//	@Failure		400			{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404			{object}	ErrorResponseBody		"Budget template or category not found"
+//	@Failure		409			{object}	ErrorResponseBody		"Template name already taken"
+//	@Failure		413			{object}	ErrorResponseBody		"Request body too large"
+//	@Router			/budget-templates/{id} [put]
+func (h *ApiHandlers) UpdateBudgetTemplate(w http.ResponseWriter, r *http.Request) {
+	template, ok := decodeBudgetTemplate(w, r)
+	if !ok {
+		return
+	}
+	template.ID = chi.URLParam(r, "id")
+
+	updated, err := h.BudgetUseCase.UpdateBudgetTemplate(r.Context(), template)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, budgetTemplateResponse(updated))
+}
+
+// DeleteBudgetTemplate deletes a budget template
+//
+//	@Summary		Delete budget template
+//	@Description	Delete a budget template, the budgets set up with it are kept
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	string	true	"Budget template ID"
+//	@Success		204	"Budget template deleted successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Budget template not found"
+//	@Router			/budget-templates/{id} [delete]
+func (h *ApiHandlers) DeleteBudgetTemplate(w http.ResponseWriter, r *http.Request) {
+	if err := h.BudgetUseCase.DeleteBudgetTemplate(r.Context(), chi.URLParam(r, "id")); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ApplyBudgetTemplate sets up a month with a budget template
+//
+//	@Summary		Apply budget template
+//	@Description	Set up a month with the limits of a template, each as a budget for that month alone. The categories already budgeted in the month are skipped and keep their budget, like the ones deleted since the template was saved. All the budgets are created or none
+//	@Tags			budgets
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Budget template ID"
+//	@Param			month	query		string				false	"Month (YYYY-MM), the current one by default"
+//	@Success		201		{object}	BudgetCopyResponse	"Budget template applied successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Invalid month"
+//	@Failure		404		{object}	ErrorResponseBody	"Budget template not found"
+//	@Failure		409		{object}	ErrorResponseBody	"Category already has a budget in the month"
+//	@Router			/budget-templates/{id}/apply [post]
+func (h *ApiHandlers) ApplyBudgetTemplate(w http.ResponseWriter, r *http.Request) {
+	var month time.Time
+	if value := r.URL.Query().Get("month"); value != "" {
+		var err error
+		if month, err = time.Parse("2006-01", value); err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("month", "must be in format YYYY-MM"))
+			return
+		}
+	}
+
+	applied, err := h.BudgetUseCase.ApplyBudgetTemplate(r.Context(), chi.URLParam(r, "id"), month)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, budgetCopyResponse(applied))
+}
+
+// decodeBudgetTemplate reads a budget template from the request body,
+// answering the request itself when it's invalid
+func decodeBudgetTemplate(w http.ResponseWriter, r *http.Request) (entities.BudgetTemplate, bool) {
+	var req BudgetTemplateRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return entities.BudgetTemplate{}, false
+	}
+
+	template := entities.BudgetTemplate{
+		Name:  req.Name,
+		Items: make([]entities.BudgetTemplateItem, len(req.Items)),
+	}
+	for i, item := range req.Items {
+		limit, err := parseBudgetLimit(item.Amount, item.Asset, fmt.Sprintf("items[%d].", i))
+		if err != nil {
+			errorResponse(w, r, http.StatusBadRequest, err)
+			return entities.BudgetTemplate{}, false
+		}
+		template.Items[i] = entities.BudgetTemplateItem{CategoryID: item.CategoryID, Limit: limit}
+	}
+
+	return template, true
+}
+
+func budgetTemplateResponse(template entities.BudgetTemplate) BudgetTemplateResponse {
+	response := BudgetTemplateResponse{
+		ID:        template.ID,
+		Name:      template.Name,
+		Items:     make([]BudgetTemplateItemResponse, len(template.Items)),
+		CreatedAt: template.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: template.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	for i, item := range template.Items {
+		response.Items[i] = BudgetTemplateItemResponse{
+			CategoryID: item.CategoryID,
+			Limit:      item.Limit.String(),
+			Asset:      item.Limit.Asset.Asset,
+		}
+	}
+	return response
+}
@@ -0,0 +1,116 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestBudgetTemplateHandlers(t *testing.T) {
+	const templateID = "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"
+	const missingID = "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+
+	limit, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(80000))
+
+	var gotTemplate entities.BudgetTemplate
+	var gotMonth time.Time
+	mockUC := &mocks.BudgetUseCaseMock{
+		CreateBudgetTemplateFunc: func(ctx context.Context, template entities.BudgetTemplate) (entities.BudgetTemplate, error) {
+			if template.Name == "" {
+				return entities.BudgetTemplate{}, fmt.Errorf("budget template name cannot be empty: %w", domain.ErrMalformedParameters)
+			}
+			gotTemplate = template
+			template.ID = templateID
+			template.Items = []entities.BudgetTemplateItem{{CategoryID: "cat-groceries", Limit: *limit}}
+			return template, nil
+		},
+		ApplyBudgetTemplateFunc: func(ctx context.Context, id string, month time.Time) (entities.BudgetCopy, error) {
+			if id != templateID {
+				return entities.BudgetCopy{}, fmt.Errorf("budget template %w", domain.ErrNotFound)
+			}
+			gotMonth = month
+			return entities.BudgetCopy{Month: month, Created: []entities.Budget{}, Skipped: []string{"cat-groceries"}}, nil
+		},
+	}
+	h := &ApiHandlers{BudgetUseCase: mockUC}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(method, path, bytes.NewBufferString(body)))
+		return rec
+	}
+
+	t.Run("creates a template", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/budget-templates", `{"name":"Regular month","items":[{"category_id":"cat-groceries","amount":"800.00"}]}`)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		if len(gotTemplate.Items) != 1 || gotTemplate.Items[0].Limit.Amount.Int64() != 80000 || gotTemplate.Items[0].Limit.Asset.Asset != "" {
+			t.Errorf("unexpected template passed to the use case: %+v", gotTemplate)
+		}
+
+		var response BudgetTemplateResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.ID != templateID || len(response.Items) != 1 || response.Items[0].Asset != "BRL" {
+			t.Errorf("unexpected response: %+v", response)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, body := range []string{
+			`{"items":[{"category_id":"cat-groceries","amount":"800.00"}]}`,
+			`{"name":"Regular month","items":[{"category_id":"cat-groceries","amount":"lots"}]}`,
+			`{"name":"Regular month","items":[{"category_id":"cat-groceries","amount":"800.00","asset":"XYZ"}]}`,
+		} {
+			if rec := serve(http.MethodPost, "/api/v1/budget-templates", body); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", body, rec.Code)
+			}
+		}
+	})
+
+	t.Run("applies a template", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/budget-templates/"+templateID+"/apply?month=2024-06", "")
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotMonth.Format("2006-01") != "2024-06" {
+			t.Errorf("unexpected month passed to the use case: %s", gotMonth)
+		}
+
+		var response BudgetCopyResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Month != "2024-06" || len(response.Created) != 0 || len(response.Skipped) != 1 {
+			t.Errorf("unexpected response: %+v", response)
+		}
+	})
+
+	t.Run("apply errors", func(t *testing.T) {
+		for path, want := range map[string]int{
+			"/" + templateID + "/apply?month=June": http.StatusBadRequest,
+			"/" + missingID + "/apply":             http.StatusNotFound,
+			"/not-a-uuid/apply":                    http.StatusBadRequest,
+		} {
+			if rec := serve(http.MethodPost, "/api/v1/budget-templates"+path, ""); rec.Code != want {
+				t.Errorf("expected status %d for %s, got %d", want, path, rec.Code)
+			}
+		}
+	})
+}
@@ -2,7 +2,6 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
 	"finance/domain/entities"
 	"net/http"
 
@@ -10,12 +9,14 @@ import (
 	"github.com/go-chi/render"
 )
 
-// Category request/response types
+// Category request/response types. A category with a parent is grouped
+// under it, e.g. Restaurants under Food.
 type CreateCategoryRequest struct {
 	Name        string                `json:"name"`
 	Type        entities.CategoryType `json:"type"`
 	Description string                `json:"description"`
 	Color       string                `json:"color"`
+	ParentID    string                `json:"parent_id"`
 }
 
 type UpdateCategoryRequest struct {
@@ -23,6 +24,7 @@ type UpdateCategoryRequest struct {
 	Type        entities.CategoryType `json:"type"`
 	Description string                `json:"description"`
 	Color       string                `json:"color"`
+	ParentID    string                `json:"parent_id"`
 }
 
 type CategoryResponse struct {
@@ -31,6 +33,7 @@ type CategoryResponse struct {
 	Type        entities.CategoryType `json:"type"`
 	Description string                `json:"description"`
 	Color       string                `json:"color"`
+	ParentID    string                `json:"parent_id,omitempty"`
 	CreatedAt   string                `json:"created_at"`
 	UpdatedAt   string                `json:"updated_at"`
 }
@@ -39,7 +42,7 @@ type CategoryResponse struct {
 type CategoryUseCase interface {
 	CreateCategory(ctx context.Context, category entities.Category) (entities.Category, error)
 	GetCategoryByID(ctx context.Context, id string) (entities.Category, error)
-	GetAllCategories(ctx context.Context) ([]entities.Category, error)
+	GetCategoriesPage(ctx context.Context, sort []entities.SortField, limit, offset int) (entities.Page[entities.Category], error)
 	UpdateCategory(ctx context.Context, category entities.Category) (entities.Category, error)
 	DeleteCategory(ctx context.Context, id string) error
 }
@@ -49,18 +52,20 @@ type CategoryUseCase interface {
 // CreateCategory creates a new category
 //
 //	@Summary		Create a new category
-//	@Description	Create a new transaction category with the provided details
+//	@Description	Create a new transaction category with the provided details. A parent groups it under another category of the same type, one level deep
 //	@Tags			categories
 //	@Accept			json
 //	@Produce		json
 //	@Param			category	body		CreateCategoryRequest	true	"Category data"
 //	@Success		201			{object}	CategoryResponse		"Category created successfully"
 //	@Failure		400			{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404			{object}	ErrorResponseBody		"Parent category not found"
+//	@Failure		413			{object}	ErrorResponseBody		"Request body too large"
 //	@Router			/categories [post]
 func (h *ApiHandlers) CreateCategory(w http.ResponseWriter, r *http.Request) {
 	var req CreateCategoryRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		errorResponse(w, r, http.StatusBadRequest, err)
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
 		return
 	}
 
@@ -69,26 +74,17 @@ func (h *ApiHandlers) CreateCategory(w http.ResponseWriter, r *http.Request) {
 		Type:        req.Type,
 		Description: req.Description,
 		Color:       req.Color,
+		ParentID:    req.ParentID,
 	}
 
 	createdCategory, err := h.CategoryUseCase.CreateCategory(r.Context(), category)
 	if err != nil {
-		errorResponse(w, r, http.StatusBadRequest, err)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
 		return
 	}
 
-	response := CategoryResponse{
-		ID:          createdCategory.ID,
-		Name:        createdCategory.Name,
-		Type:        createdCategory.Type,
-		Description: createdCategory.Description,
-		Color:       createdCategory.Color,
-		CreatedAt:   createdCategory.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   createdCategory.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}
-
 	render.Status(r, http.StatusCreated)
-	render.JSON(w, r, response)
+	render.JSON(w, r, categoryResponse(createdCategory))
 }
 
 // GetCategoryByID retrieves a category by its ID
@@ -102,6 +98,7 @@ func (h *ApiHandlers) CreateCategory(w http.ResponseWriter, r *http.Request) {
 //	@Success		200	{object}	CategoryResponse	"Category retrieved successfully"
 //	@Failure		400	{object}	ErrorResponseBody	"Bad request"
 //	@Failure		404	{object}	ErrorResponseBody	"Category not found"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
 //	@Router			/categories/{id} [get]
 func (h *ApiHandlers) GetCategoryByID(w http.ResponseWriter, r *http.Request) {
 	id := chi.URLParam(r, "id")
@@ -112,65 +109,61 @@ func (h *ApiHandlers) GetCategoryByID(w http.ResponseWriter, r *http.Request) {
 
 	category, err := h.CategoryUseCase.GetCategoryByID(r.Context(), id)
 	if err != nil {
-		errorResponse(w, r, http.StatusNotFound, err)
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
 		return
 	}
 
-	if category.ID == "" {
-		errorResponse(w, r, http.StatusNotFound, errNotFound("category"))
-		return
-	}
-
-	response := CategoryResponse{
-		ID:          category.ID,
-		Name:        category.Name,
-		Type:        category.Type,
-		Description: category.Description,
-		Color:       category.Color,
-		CreatedAt:   category.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   category.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}
-
-	render.JSON(w, r, response)
+	render.JSON(w, r, categoryResponse(category))
 }
 
 // GetAllCategories retrieves all categories
 //
 //	@Summary		Get all categories
-//	@Description	Retrieve a list of all transaction categories
+//	@Description	Retrieve a page of the transaction categories with the total number of categories and links to the next and previous pages
 //	@Tags			categories
 //	@Accept			json
 //	@Produce		json
-//	@Success		200	{array}		CategoryResponse	"Categories retrieved successfully"
-//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Param			fields	query		string				false	"Comma separated fields to return for each category"
+//	@Param			sort	query		string				false	"Comma separated sort fields (name, type, created_at), prefix with - for descending"
+//	@Param			limit	query		int					false	"Page size, 50 by default and at most 500"
+//	@Param			offset	query		int					false	"Number of categories to skip"
+//	@Success		200		{object}	PageResponse[CategoryResponse]	"Categories retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		500		{object}	ErrorResponseBody	"Internal server error"
 //	@Router			/categories [get]
 func (h *ApiHandlers) GetAllCategories(w http.ResponseWriter, r *http.Request) {
-	categories, err := h.CategoryUseCase.GetAllCategories(r.Context())
+	fields, err := parseFields(r, CategoryResponse{})
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	limit, offset, err := parsePage(r)
 	if err != nil {
-		errorResponse(w, r, http.StatusInternalServerError, err)
+		errorResponse(w, r, http.StatusBadRequest, err)
 		return
 	}
 
-	responses := make([]CategoryResponse, len(categories))
-	for i, category := range categories {
-		responses[i] = CategoryResponse{
-			ID:          category.ID,
-			Name:        category.Name,
-			Type:        category.Type,
-			Description: category.Description,
-			Color:       category.Color,
-			CreatedAt:   category.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt:   category.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		}
+	sort := entities.ParseSort(r.URL.Query().Get("sort"))
+
+	page, err := h.CategoryUseCase.GetCategoriesPage(r.Context(), sort, limit, offset)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
 	}
 
-	render.JSON(w, r, responses)
+	responses := make([]CategoryResponse, len(page.Items))
+	for i, category := range page.Items {
+		responses[i] = categoryResponse(category)
+	}
+
+	renderPage(w, r, page, responses, fields)
 }
 
 // UpdateCategory updates an existing category
 //
 //	@Summary		Update category
-//	@Description	Update an existing category with new information
+//	@Description	Update an existing category with new information. A category grouping others can't get a parent or change its type
 //	@Tags			categories
 //	@Accept			json
 //	@Produce		json
@@ -178,6 +171,7 @@ func (h *ApiHandlers) GetAllCategories(w http.ResponseWriter, r *http.Request) {
 //	@Param			category	body		UpdateCategoryRequest	true	"Updated category data"
 //	@Success		200			{object}	CategoryResponse		"Category updated successfully"
 //	@Failure		400			{object}	ErrorResponseBody		"Bad request"
+//	@Failure		413			{object}	ErrorResponseBody		"Request body too large"
 //	@Failure		404			{object}	ErrorResponseBody		"Category not found"
 //	@Router			/categories/{id} [put]
 func (h *ApiHandlers) UpdateCategory(w http.ResponseWriter, r *http.Request) {
@@ -188,8 +182,8 @@ func (h *ApiHandlers) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req UpdateCategoryRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		errorResponse(w, r, http.StatusBadRequest, err)
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
 		return
 	}
 
@@ -199,25 +193,16 @@ func (h *ApiHandlers) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 		Type:        req.Type,
 		Description: req.Description,
 		Color:       req.Color,
+		ParentID:    req.ParentID,
 	}
 
 	updatedCategory, err := h.CategoryUseCase.UpdateCategory(r.Context(), category)
 	if err != nil {
-		errorResponse(w, r, http.StatusBadRequest, err)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
 		return
 	}
 
-	response := CategoryResponse{
-		ID:          updatedCategory.ID,
-		Name:        updatedCategory.Name,
-		Type:        updatedCategory.Type,
-		Description: updatedCategory.Description,
-		Color:       updatedCategory.Color,
-		CreatedAt:   updatedCategory.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   updatedCategory.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}
-
-	render.JSON(w, r, response)
+	render.JSON(w, r, categoryResponse(updatedCategory))
 }
 
 // DeleteCategory deletes a category
@@ -241,9 +226,22 @@ func (h *ApiHandlers) DeleteCategory(w http.ResponseWriter, r *http.Request) {
 
 	err := h.CategoryUseCase.DeleteCategory(r.Context(), id)
 	if err != nil {
-		errorResponse(w, r, http.StatusBadRequest, err)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func categoryResponse(category entities.Category) CategoryResponse {
+	return CategoryResponse{
+		ID:          category.ID,
+		Name:        category.Name,
+		Type:        category.Type,
+		Description: category.Description,
+		Color:       category.Color,
+		ParentID:    category.ParentID,
+		CreatedAt:   category.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   category.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,537 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// ReportDefinitionRequest is a report built by the user: the pending and
+// cleared transactions matching the filter, grouped by the dimensions in
+// their order and added up by the measures
+type ReportDefinitionRequest struct {
+	Name       string              `json:"name" example:"Groceries by month"`
+	Dimensions []string            `json:"dimensions" enums:"category,account,payee,month"`
+	Measures   []string            `json:"measures" enums:"sum,count,avg"`
+	Filter     ReportFilterRequest `json:"filter"`
+}
+
+// ReportFilterRequest narrows down the transactions of a report, empty fields
+// match any transaction. Without from the report starts with the first
+// transaction, without to it ends today. Payees are matched regardless of
+// case.
+type ReportFilterRequest struct {
+	From         string                `json:"from,omitempty" example:"2025-01-01"`
+	To           string                `json:"to,omitempty" example:"2025-12-31"`
+	AccountIDs   []string              `json:"account_ids,omitempty"`
+	CategoryIDs  []string              `json:"category_ids,omitempty"`
+	CategoryType entities.CategoryType `json:"category_type,omitempty" example:"expense"`
+	Payees       []string              `json:"payees,omitempty"`
+}
+
+type ReportDefinitionResponse struct {
+	ID         string              `json:"id"`
+	Name       string              `json:"name" example:"Groceries by month"`
+	Dimensions []string            `json:"dimensions"`
+	Measures   []string            `json:"measures"`
+	Filter     ReportFilterRequest `json:"filter"`
+	CreatedAt  string              `json:"created_at"`
+	UpdatedAt  string              `json:"updated_at"`
+}
+
+// CustomReportResponse is a report definition run from from to to. Amounts
+// are signed by the type of their category, income adding and expenses
+// taking away.
+type CustomReportResponse struct {
+	ID         string                    `json:"id,omitempty"`
+	Name       string                    `json:"name,omitempty" example:"Groceries by month"`
+	Dimensions []string                  `json:"dimensions"`
+	Measures   []string                  `json:"measures"`
+	From       string                    `json:"from,omitempty" example:"2025-01-01"`
+	To         string                    `json:"to" example:"2025-12-31"`
+	Rows       []CustomReportRowResponse `json:"rows"`
+}
+
+// CustomReportRowResponse is a group of transactions in one asset, with a key
+// per dimension and the measures asked for
+type CustomReportRowResponse struct {
+	Keys    []ReportKeyResponse `json:"keys"`
+	Asset   string              `json:"asset" example:"BRL"`
+	Sum     string              `json:"sum,omitempty" example:"[BRL (R$) -850.00]"`
+	Count   *int                `json:"count,omitempty" example:"12"`
+	Average string              `json:"avg,omitempty" example:"[BRL (R$) -70.83]"`
+}
+
+// ReportKeyResponse is the value of a dimension for a row: the ID of the
+// category or account with its name as label, the payee or the month
+type ReportKeyResponse struct {
+	Dimension entities.ReportDimension `json:"dimension" example:"month"`
+	Value     string                   `json:"value" example:"2025-04"`
+	Label     string                   `json:"label" example:"2025-04"`
+}
+
+// CubeResponse pivots the pending and cleared transactions by two
+// dimensions, with a table per currency
+type CubeResponse struct {
+	Rows    string              `json:"rows" example:"category"`
+	Cols    string              `json:"cols" example:"month"`
+	Measure string              `json:"measure" example:"sum"`
+	From    string              `json:"from,omitempty" example:"2025-01-01"`
+	To      string              `json:"to" example:"2025-12-31"`
+	Tables  []CubeTableResponse `json:"tables"`
+}
+
+// CubeTableResponse is the pivot of the transactions in one asset. Values
+// holds a row per key of rows with a cell per key of cols, null when no
+// transaction falls in it, and the totals of each row, each column and the
+// whole table follow.
+type CubeTableResponse struct {
+	Asset     string                 `json:"asset" example:"BRL"`
+	Rows      []ReportKeyResponse    `json:"rows"`
+	Cols      []ReportKeyResponse    `json:"cols"`
+	Values    [][]*CubeValueResponse `json:"values"`
+	RowTotals []CubeValueResponse    `json:"row_totals"`
+	ColTotals []CubeValueResponse    `json:"col_totals"`
+	Total     CubeValueResponse      `json:"total"`
+}
+
+// CubeValueResponse is the measure asked for of a cell or a total
+type CubeValueResponse struct {
+	Sum     string `json:"sum,omitempty" example:"[BRL (R$) -850.00]"`
+	Count   *int   `json:"count,omitempty" example:"12"`
+	Average string `json:"avg,omitempty" example:"[BRL (R$) -70.83]"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/custom_report_uc.go . CustomReportUseCase
+type CustomReportUseCase interface {
+	CreateReportDefinition(ctx context.Context, definition entities.ReportDefinition) (entities.ReportDefinition, error)
+	GetReportDefinitions(ctx context.Context) ([]entities.ReportDefinition, error)
+	GetReportDefinition(ctx context.Context, id string) (entities.ReportDefinition, error)
+	UpdateReportDefinition(ctx context.Context, definition entities.ReportDefinition) (entities.ReportDefinition, error)
+	DeleteReportDefinition(ctx context.Context, id string) error
+	RunReport(ctx context.Context, id string, from, to time.Time) (entities.CustomReport, error)
+	RunReportDefinition(ctx context.Context, definition entities.ReportDefinition) (entities.CustomReport, error)
+	RunCube(ctx context.Context, query entities.CubeQuery) (entities.Cube, error)
+}
+
+// Custom report handlers
+
+// CreateReportDefinition saves a custom report
+//
+//	@Summary		Create custom report
+//	@Description	Save a report built from dimensions the transactions are grouped by (category, account, payee, month), measures added up for each group (sum, count, avg) and filters, to run it whenever needed. Only pending and cleared transactions are reported, each group split per currency
+//	@Tags			reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			report	body		ReportDefinitionRequest		true	"Report definition"
+//	@Success		201		{object}	ReportDefinitionResponse	"Report saved successfully"
+//	@Failure		400		{object}	ErrorResponseBody			"Invalid report definition"
+//	@Failure		409		{object}	ErrorResponseBody			"Report name already taken"
+//	@Failure		413		{object}	ErrorResponseBody			"Request body too large"
+//	@Router			/reports/custom [post]
+func (h *ApiHandlers) CreateReportDefinition(w http.ResponseWriter, r *http.Request) {
+	definition, ok := parseReportDefinitionRequest(w, r)
+	if !ok {
+		return
+	}
+
+	created, err := h.CustomReportUseCase.CreateReportDefinition(r.Context(), definition)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, reportDefinitionResponse(created))
+}
+
+// GetReportDefinitions lists the custom reports
+//
+//	@Summary		List custom reports
+//	@Description	List the custom reports saved in the book, ordered by name
+//	@Tags			reports
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		ReportDefinitionResponse	"Reports retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody			"Internal server error"
+//	@Router			/reports/custom [get]
+func (h *ApiHandlers) GetReportDefinitions(w http.ResponseWriter, r *http.Request) {
+	definitions, err := h.CustomReportUseCase.GetReportDefinitions(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	responses := make([]ReportDefinitionResponse, len(definitions))
+	for i, definition := range definitions {
+		responses[i] = reportDefinitionResponse(definition)
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetReportDefinition retrieves a custom report
+//
+//	@Summary		Get custom report
+//	@Description	Retrieve the definition of a custom report by its ID
+//	@Tags			reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string						true	"Report ID"
+//	@Success		200	{object}	ReportDefinitionResponse	"Report retrieved successfully"
+//	@Failure		400	{object}	ErrorResponseBody			"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody			"Report not found"
+//	@Router			/reports/custom/{id} [get]
+func (h *ApiHandlers) GetReportDefinition(w http.ResponseWriter, r *http.Request) {
+	definition, err := h.CustomReportUseCase.GetReportDefinition(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, reportDefinitionResponse(definition))
+}
+
+// UpdateReportDefinition updates a custom report
+//
+//	@Summary		Update custom report
+//	@Description	Replace the name, dimensions, measures and filter of a custom report
+//	@Tags			reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Report ID"
+//	@Param			report	body		ReportDefinitionRequest		true	"Report definition"
+//	@Success		200		{object}	ReportDefinitionResponse	"Report updated successfully"
+//	@Failure		400		{object}	ErrorResponseBody			"Invalid report definition"
+//	@Failure		404		{object}	ErrorResponseBody			"Report not found"
+//	@Failure		409		{object}	ErrorResponseBody			"Report name already taken"
+//	@Failure		413		{object}	ErrorResponseBody			"Request body too large"
+//	@Router			/reports/custom/{id} [put]
+func (h *ApiHandlers) UpdateReportDefinition(w http.ResponseWriter, r *http.Request) {
+	definition, ok := parseReportDefinitionRequest(w, r)
+	if !ok {
+		return
+	}
+	definition.ID = chi.URLParam(r, "id")
+
+	updated, err := h.CustomReportUseCase.UpdateReportDefinition(r.Context(), definition)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, reportDefinitionResponse(updated))
+}
+
+// DeleteReportDefinition deletes a custom report
+//
+//	@Summary		Delete custom report
+//	@Description	Delete a custom report
+//	@Tags			reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	string	true	"Report ID"
+//	@Success		204	"Report deleted successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Report not found"
+//	@Router			/reports/custom/{id} [delete]
+func (h *ApiHandlers) DeleteReportDefinition(w http.ResponseWriter, r *http.Request) {
+	if err := h.CustomReportUseCase.DeleteReportDefinition(r.Context(), chi.URLParam(r, "id")); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RunReport runs a saved custom report
+//
+//	@Summary		Run custom report
+//	@Description	Group the pending and cleared transactions matching the filter of a saved report by its dimensions and add them up with its measures, per currency. from and to take the place of the dates of the filter when given. Amounts are signed by the type of their category, so a report not grouped by category adds up to the cash flow
+//	@Tags			reports
+//	@Produce		json
+//	@Param			id		path		string					true	"Report ID"
+//	@Param			from	query		string					false	"First day of the report (YYYY-MM-DD)"
+//	@Param			to		query		string					false	"Last day of the report (YYYY-MM-DD)"
+//	@Success		200		{object}	CustomReportResponse	"Report run successfully"
+//	@Failure		400		{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody		"Report not found"
+//	@Router			/reports/custom/{id}/run [get]
+func (h *ApiHandlers) RunReport(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := parsePeriod(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := h.CustomReportUseCase.RunReport(r.Context(), chi.URLParam(r, "id"), from, to)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, customReportResponse(report))
+}
+
+// RunReportDefinition runs a custom report without saving it
+//
+//	@Summary		Run report definition
+//	@Description	Run a report definition given in the body without saving it, to try it out or for one-off reports. The name is optional
+//	@Tags			reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			report	body		ReportDefinitionRequest	true	"Report definition"
+//	@Success		200		{object}	CustomReportResponse	"Report run successfully"
+//	@Failure		400		{object}	ErrorResponseBody		"Invalid report definition"
+//	@Failure		413		{object}	ErrorResponseBody		"Request body too large"
+//	@Failure		500		{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/reports/custom/run [post]
+func (h *ApiHandlers) RunReportDefinition(w http.ResponseWriter, r *http.Request) {
+	definition, ok := parseReportDefinitionRequest(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := h.CustomReportUseCase.RunReportDefinition(r.Context(), definition)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, customReportResponse(report))
+}
+
+// GetCube pivots the transactions by two dimensions
+//
+//	@Summary		Data cube
+//	@Description	Pivot the pending and cleared transactions from from to to by one dimension down and another across (category, account, payee, month), with a measure in each cell (sum, count, avg) and the totals of each row, each column and the whole table, added up by the database with grouping sets. Each currency has its own table. Amounts are signed by the type of their category, like in custom reports
+//	@Tags			reports
+//	@Produce		json
+//	@Param			rows	query		string				true	"Dimension of the rows"		Enums(category, account, payee, month)
+//	@Param			cols	query		string				true	"Dimension of the columns"	Enums(category, account, payee, month)
+//	@Param			measure	query		string				false	"Measure of the cells (default sum)"	Enums(sum, count, avg)
+//	@Param			from	query		string				false	"First day of the cube (YYYY-MM-DD)"
+//	@Param			to		query		string				false	"Last day of the cube (YYYY-MM-DD), today by default"
+//	@Success		200		{object}	CubeResponse		"Cube retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		500		{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/reports/cube [get]
+func (h *ApiHandlers) GetCube(w http.ResponseWriter, r *http.Request) {
+	query := entities.CubeQuery{
+		Rows:    entities.ReportDimension(r.URL.Query().Get("rows")),
+		Cols:    entities.ReportDimension(r.URL.Query().Get("cols")),
+		Measure: entities.ReportMeasure(r.URL.Query().Get("measure")),
+	}
+	if query.Rows == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("rows"))
+		return
+	}
+	if query.Cols == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("cols"))
+		return
+	}
+
+	var ok bool
+	if query.From, query.To, ok = parsePeriod(w, r); !ok {
+		return
+	}
+
+	cube, err := h.CustomReportUseCase.RunCube(r.Context(), query)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, cubeResponse(cube))
+}
+
+// parseReportDefinitionRequest decodes the definition of the body, leaving
+// its dimensions and measures to be checked by the use case
+func parseReportDefinitionRequest(w http.ResponseWriter, r *http.Request) (entities.ReportDefinition, bool) {
+	var req ReportDefinitionRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return entities.ReportDefinition{}, false
+	}
+
+	definition := entities.ReportDefinition{
+		Name:       req.Name,
+		Dimensions: make([]entities.ReportDimension, len(req.Dimensions)),
+		Measures:   make([]entities.ReportMeasure, len(req.Measures)),
+		Filter: entities.ReportFilter{
+			AccountIDs:   req.Filter.AccountIDs,
+			CategoryIDs:  req.Filter.CategoryIDs,
+			CategoryType: req.Filter.CategoryType,
+			Payees:       req.Filter.Payees,
+		},
+	}
+	for i, dimension := range req.Dimensions {
+		definition.Dimensions[i] = entities.ReportDimension(dimension)
+	}
+	for i, measure := range req.Measures {
+		definition.Measures[i] = entities.ReportMeasure(measure)
+	}
+
+	var err error
+	if req.Filter.From != "" {
+		if definition.Filter.From, err = time.Parse("2006-01-02", req.Filter.From); err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("filter.from", "must be in format YYYY-MM-DD"))
+			return entities.ReportDefinition{}, false
+		}
+	}
+	if req.Filter.To != "" {
+		if definition.Filter.To, err = time.Parse("2006-01-02", req.Filter.To); err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("filter.to", "must be in format YYYY-MM-DD"))
+			return entities.ReportDefinition{}, false
+		}
+	}
+
+	return definition, true
+}
+
+func reportDefinitionResponse(definition entities.ReportDefinition) ReportDefinitionResponse {
+	return ReportDefinitionResponse{
+		ID:         definition.ID,
+		Name:       definition.Name,
+		Dimensions: reportDimensionNames(definition.Dimensions),
+		Measures:   reportMeasureNames(definition.Measures),
+		Filter:     reportFilterResponse(definition.Filter),
+		CreatedAt:  definition.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:  definition.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func reportFilterResponse(filter entities.ReportFilter) ReportFilterRequest {
+	response := ReportFilterRequest{
+		AccountIDs:   filter.AccountIDs,
+		CategoryIDs:  filter.CategoryIDs,
+		CategoryType: filter.CategoryType,
+		Payees:       filter.Payees,
+	}
+	if !filter.From.IsZero() {
+		response.From = filter.From.Format("2006-01-02")
+	}
+	if !filter.To.IsZero() {
+		response.To = filter.To.Format("2006-01-02")
+	}
+	return response
+}
+
+// customReportResponse renders the rows of a report with the measures its
+// definition asks for
+func customReportResponse(report entities.CustomReport) CustomReportResponse {
+	measures := report.Definition.Measures
+	response := CustomReportResponse{
+		ID:         report.Definition.ID,
+		Name:       report.Definition.Name,
+		Dimensions: reportDimensionNames(report.Definition.Dimensions),
+		Measures:   reportMeasureNames(measures),
+		To:         report.To.Format("2006-01-02"),
+		Rows:       make([]CustomReportRowResponse, len(report.Rows)),
+	}
+	if !report.From.IsZero() {
+		response.From = report.From.Format("2006-01-02")
+	}
+	for i, row := range report.Rows {
+		rowResponse := CustomReportRowResponse{
+			Keys:  make([]ReportKeyResponse, len(row.Keys)),
+			Asset: row.Sum.Asset.Asset,
+		}
+		for j, key := range row.Keys {
+			rowResponse.Keys[j] = ReportKeyResponse{Dimension: key.Dimension, Value: key.Value, Label: key.Label}
+		}
+		if slices.Contains(measures, entities.ReportMeasureSum) {
+			rowResponse.Sum = row.Sum.String()
+		}
+		if slices.Contains(measures, entities.ReportMeasureCount) {
+			rowResponse.Count = &row.Count
+		}
+		if slices.Contains(measures, entities.ReportMeasureAvg) {
+			rowResponse.Average = row.Average.String()
+		}
+		response.Rows[i] = rowResponse
+	}
+	return response
+}
+
+func reportDimensionNames(dimensions []entities.ReportDimension) []string {
+	names := make([]string, len(dimensions))
+	for i, dimension := range dimensions {
+		names[i] = string(dimension)
+	}
+	return names
+}
+
+func reportMeasureNames(measures []entities.ReportMeasure) []string {
+	names := make([]string, len(measures))
+	for i, measure := range measures {
+		names[i] = string(measure)
+	}
+	return names
+}
+
+// cubeResponse renders the tables of a cube with the measure it was asked for
+func cubeResponse(cube entities.Cube) CubeResponse {
+	measure := cube.Query.Measure
+	value := func(total entities.CubeTotal) CubeValueResponse {
+		switch measure {
+		case entities.ReportMeasureCount:
+			return CubeValueResponse{Count: &total.Count}
+		case entities.ReportMeasureAvg:
+			return CubeValueResponse{Average: total.Average.String()}
+		default:
+			return CubeValueResponse{Sum: total.Sum.String()}
+		}
+	}
+	values := func(totals []entities.CubeTotal) []CubeValueResponse {
+		responses := make([]CubeValueResponse, len(totals))
+		for i, total := range totals {
+			responses[i] = value(total)
+		}
+		return responses
+	}
+	keys := func(keys []entities.ReportKey) []ReportKeyResponse {
+		responses := make([]ReportKeyResponse, len(keys))
+		for i, key := range keys {
+			responses[i] = ReportKeyResponse{Dimension: key.Dimension, Value: key.Value, Label: key.Label}
+		}
+		return responses
+	}
+
+	response := CubeResponse{
+		Rows:    string(cube.Query.Rows),
+		Cols:    string(cube.Query.Cols),
+		Measure: string(measure),
+		To:      cube.Query.To.Format("2006-01-02"),
+		Tables:  make([]CubeTableResponse, len(cube.Tables)),
+	}
+	if !cube.Query.From.IsZero() {
+		response.From = cube.Query.From.Format("2006-01-02")
+	}
+	for i, table := range cube.Tables {
+		tableResponse := CubeTableResponse{
+			Asset:     table.Asset.Asset,
+			Rows:      keys(table.Rows),
+			Cols:      keys(table.Cols),
+			Values:    make([][]*CubeValueResponse, len(table.Cells)),
+			RowTotals: values(table.RowTotals),
+			ColTotals: values(table.ColTotals),
+			Total:     value(table.Total),
+		}
+		for j, row := range table.Cells {
+			tableResponse.Values[j] = make([]*CubeValueResponse, len(row))
+			for k, cell := range row {
+				if cell != nil {
+					cellResponse := value(*cell)
+					tableResponse.Values[j][k] = &cellResponse
+				}
+			}
+		}
+		response.Tables[i] = tableResponse
+	}
+	return response
+}
@@ -0,0 +1,267 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestCustomReportHandlers(t *testing.T) {
+	const reportID = "7a8b9c0d-1e2f-4a3b-8c4d-5e6f7a8b9c0d"
+	const missingID = "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+
+	sum := monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(-15001)}
+	average := monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(-7501)}
+	definition := entities.ReportDefinition{
+		ID:         reportID,
+		Name:       "Groceries by month",
+		Dimensions: []entities.ReportDimension{entities.ReportDimensionMonth},
+		Measures:   []entities.ReportMeasure{entities.ReportMeasureSum, entities.ReportMeasureCount},
+	}
+	report := entities.CustomReport{
+		Definition: definition,
+		To:         time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC),
+		Rows: []entities.CustomReportRow{{
+			Keys:    []entities.ReportKey{{Dimension: entities.ReportDimensionMonth, Value: "2025-03", Label: "2025-03"}},
+			Count:   2,
+			Sum:     sum,
+			Average: average,
+		}},
+	}
+
+	var gotDefinition entities.ReportDefinition
+	var gotFrom, gotTo time.Time
+	mockUC := &mocks.CustomReportUseCaseMock{
+		CreateReportDefinitionFunc: func(ctx context.Context, created entities.ReportDefinition) (entities.ReportDefinition, error) {
+			if len(created.Measures) == 0 {
+				return entities.ReportDefinition{}, fmt.Errorf("report needs at least one measure: %w", domain.ErrMalformedParameters)
+			}
+			gotDefinition = created
+			created.ID = reportID
+			return created, nil
+		},
+		RunReportFunc: func(ctx context.Context, id string, from, to time.Time) (entities.CustomReport, error) {
+			if id != reportID {
+				return entities.CustomReport{}, fmt.Errorf("report definition %w", domain.ErrNotFound)
+			}
+			gotFrom, gotTo = from, to
+			return report, nil
+		},
+		RunReportDefinitionFunc: func(ctx context.Context, definition entities.ReportDefinition) (entities.CustomReport, error) {
+			gotDefinition = definition
+			return entities.CustomReport{Definition: definition, To: report.To, Rows: report.Rows}, nil
+		},
+	}
+	h := &ApiHandlers{CustomReportUseCase: mockUC}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(method, path, bytes.NewBufferString(body)))
+		return rec
+	}
+
+	t.Run("creates a report", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/reports/custom", `{"name":"Groceries by month","dimensions":["month"],"measures":["sum","count"],"filter":{"from":"2025-01-01","category_type":"expense","payees":["Market"]}}`)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		want := entities.ReportDefinition{
+			Name:       "Groceries by month",
+			Dimensions: []entities.ReportDimension{entities.ReportDimensionMonth},
+			Measures:   []entities.ReportMeasure{entities.ReportMeasureSum, entities.ReportMeasureCount},
+			Filter: entities.ReportFilter{
+				From:         time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+				CategoryType: entities.CategoryTypeExpense,
+				Payees:       []string{"Market"},
+			},
+		}
+		if !reflect.DeepEqual(gotDefinition, want) {
+			t.Errorf("unexpected definition passed to the use case: %+v", gotDefinition)
+		}
+
+		var response ReportDefinitionResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.ID != reportID || response.Filter.From != "2025-01-01" || response.Filter.To != "" {
+			t.Errorf("unexpected response: %+v", response)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, body := range []string{
+			`{"name":"r","dimensions":["month"]}`,
+			`{"name":"r","measures":["sum"],"filter":{"from":"January"}}`,
+			`{"name":"r","measures":"sum"}`,
+		} {
+			if rec := serve(http.MethodPost, "/api/v1/reports/custom", body); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", body, rec.Code)
+			}
+		}
+	})
+
+	t.Run("runs a saved report", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/reports/custom/"+reportID+"/run?from=2025-03-01", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotFrom.Format("2006-01-02") != "2025-03-01" || !gotTo.IsZero() {
+			t.Errorf("unexpected period passed to the use case: %s to %s", gotFrom, gotTo)
+		}
+
+		var response CustomReportResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		count := 2
+		want := CustomReportResponse{
+			ID:         reportID,
+			Name:       "Groceries by month",
+			Dimensions: []string{"month"},
+			Measures:   []string{"sum", "count"},
+			To:         "2025-03-31",
+			Rows: []CustomReportRowResponse{{
+				Keys:  []ReportKeyResponse{{Dimension: entities.ReportDimensionMonth, Value: "2025-03", Label: "2025-03"}},
+				Asset: "BRL",
+				Sum:   sum.String(),
+				Count: &count,
+			}},
+		}
+		if !reflect.DeepEqual(response, want) {
+			t.Errorf("unexpected report:\n got %+v\nwant %+v", response, want)
+		}
+	})
+
+	t.Run("runs a definition without saving it", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/reports/custom/run", `{"measures":["avg"]}`)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if !reflect.DeepEqual(gotDefinition.Measures, []entities.ReportMeasure{entities.ReportMeasureAvg}) {
+			t.Errorf("unexpected definition passed to the use case: %+v", gotDefinition)
+		}
+
+		var response CustomReportResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response.Rows) != 1 || response.Rows[0].Average != average.String() || response.Rows[0].Sum != "" || response.Rows[0].Count != nil {
+			t.Errorf("unexpected rows: %+v", response.Rows)
+		}
+	})
+
+	t.Run("unknown report", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/reports/custom/"+missingID+"/run", ""); rec.Code != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", rec.Code)
+		}
+		if rec := serve(http.MethodGet, "/api/v1/reports/custom/not-a-uuid/run", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
+
+func TestGetCube(t *testing.T) {
+	brl := func(amount int64) monetary.Monetary {
+		return monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(amount)}
+	}
+	total := func(count int, amount int64) entities.CubeTotal {
+		return entities.CubeTotal{Count: count, Sum: brl(amount), Average: brl(amount / int64(count))}
+	}
+	cell := total(2, -15000)
+
+	var got entities.CubeQuery
+	mockUC := &mocks.CustomReportUseCaseMock{
+		RunCubeFunc: func(ctx context.Context, query entities.CubeQuery) (entities.Cube, error) {
+			if query.Rows == "week" {
+				return entities.Cube{}, fmt.Errorf("invalid cube rows: week: %w", domain.ErrMalformedParameters)
+			}
+			got = query
+			if query.Measure == "" {
+				query.Measure = entities.ReportMeasureSum
+			}
+			query.To = time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC)
+			return entities.Cube{Query: query, Tables: []entities.CubeTable{{
+				Asset: monetary.BRL,
+				Rows:  []entities.ReportKey{{Dimension: entities.ReportDimensionCategory, Value: "cat-groceries", Label: "Groceries"}},
+				Cols: []entities.ReportKey{
+					{Dimension: entities.ReportDimensionMonth, Value: "2025-03", Label: "2025-03"},
+					{Dimension: entities.ReportDimensionMonth, Value: "2025-04", Label: "2025-04"},
+				},
+				Cells:     [][]*entities.CubeTotal{{&cell, nil}},
+				RowTotals: []entities.CubeTotal{total(2, -15000)},
+				ColTotals: []entities.CubeTotal{total(2, -15000), {}},
+				Total:     total(2, -15000),
+			}}}, nil
+		},
+	}
+	h := &ApiHandlers{CustomReportUseCase: mockUC}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	get := func(query string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/reports/cube?"+query, nil))
+		return rec
+	}
+
+	rec := get("rows=category&cols=month&measure=count&from=2025-03-01")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+	}
+	want := entities.CubeQuery{Rows: entities.ReportDimensionCategory, Cols: entities.ReportDimensionMonth, Measure: entities.ReportMeasureCount, From: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)}
+	if got != want {
+		t.Errorf("unexpected query %+v", got)
+	}
+
+	var response CubeResponse
+	if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Measure != "count" || response.From != "2025-03-01" || response.To != "2025-04-30" || len(response.Tables) != 1 {
+		t.Fatalf("unexpected response %+v", response)
+	}
+	table := response.Tables[0]
+	if table.Asset != "BRL" || len(table.Rows) != 1 || table.Rows[0].Label != "Groceries" || len(table.Cols) != 2 {
+		t.Errorf("unexpected keys %+v", table)
+	}
+	if len(table.Values) != 1 || table.Values[0][0] == nil || table.Values[0][0].Count == nil || *table.Values[0][0].Count != 2 || table.Values[0][0].Sum != "" || table.Values[0][1] != nil {
+		t.Errorf("unexpected values %+v", table.Values)
+	}
+	if table.Total.Count == nil || *table.Total.Count != 2 || len(table.ColTotals) != 2 {
+		t.Errorf("unexpected totals %+v", table)
+	}
+
+	t.Run("sum by default", func(t *testing.T) {
+		rec := get("rows=category&cols=month")
+		var response CubeResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Measure != "sum" || response.Tables[0].Total.Sum != brl(-15000).String() || response.Tables[0].Total.Count != nil {
+			t.Errorf("unexpected response %+v", response)
+		}
+	})
+
+	t.Run("bad requests", func(t *testing.T) {
+		for _, query := range []string{"cols=month", "rows=category", "rows=week&cols=month", "rows=category&cols=month&from=2025-13-01"} {
+			if rec := get(query); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", query, rec.Code)
+			}
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/render"
+)
+
+// Demo response types
+type DemoStatusResponse struct {
+	Active     bool     `json:"active"`
+	AccountIDs []string `json:"account_ids"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/demo_uc.go . DemoUseCase
+type DemoUseCase interface {
+	GetDemoStatus(ctx context.Context) (entities.DemoStatus, error)
+	StartDemo(ctx context.Context) (entities.DemoStatus, error)
+	StopDemo(ctx context.Context) error
+}
+
+// Demo handlers
+
+// GetDemoStatus reports whether the sample dataset is loaded
+//
+//	@Summary		Get demo status
+//	@Description	Report whether the sample dataset is loaded and which accounts belong to it
+//	@Tags			demo
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	DemoStatusResponse	"Demo status retrieved successfully"
+//	@Failure		403	{object}	ErrorResponseBody	"Not an operator of the deployment"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/demo [get]
+func (h *ApiHandlers) GetDemoStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := h.DemoUseCase.GetDemoStatus(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	render.JSON(w, r, demoStatusResponse(status))
+}
+
+// StartDemo loads the sample dataset
+//
+//	@Summary		Start demo
+//	@Description	Create sample accounts with about three months of transactions in the base currency. Only available before any account exists.
+//	@Tags			demo
+//	@Accept			json
+//	@Produce		json
+//	@Success		201	{object}	DemoStatusResponse	"Demo data loaded successfully"
+//	@Failure		403	{object}	ErrorResponseBody	"Not an operator of the deployment"
+//	@Failure		409	{object}	ErrorResponseBody	"Demo data already loaded or accounts exist"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/demo [post]
+func (h *ApiHandlers) StartDemo(w http.ResponseWriter, r *http.Request) {
+	status, err := h.DemoUseCase.StartDemo(r.Context())
+	if err != nil {
+		slog.Error("failed to start demo", "error", err)
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, demoStatusResponse(status))
+}
+
+// StopDemo removes the sample dataset
+//
+//	@Summary		Stop demo
+//	@Description	Delete the sample accounts and their transactions
+//	@Tags			demo
+//	@Accept			json
+//	@Produce		json
+//	@Success		204	"Demo data removed successfully"
+//	@Failure		403	{object}	ErrorResponseBody	"Not an operator of the deployment"
+//	@Failure		409	{object}	ErrorResponseBody	"Demo data not loaded"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/demo [delete]
+func (h *ApiHandlers) StopDemo(w http.ResponseWriter, r *http.Request) {
+	if err := h.DemoUseCase.StopDemo(r.Context()); err != nil {
+		slog.Error("failed to stop demo", "error", err)
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func demoStatusResponse(status entities.DemoStatus) DemoStatusResponse {
+	accountIDs := status.AccountIDs
+	if accountIDs == nil {
+		accountIDs = []string{}
+	}
+	return DemoStatusResponse{Active: status.Active, AccountIDs: accountIDs}
+}
@@ -0,0 +1,367 @@
+package v1
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/realtime"
+	"log/slog"
+	"time"
+)
+
+// EventHub fans out the changes made through the API to the WebSocket clients
+type EventHub interface {
+	Active() bool
+	Publish(event realtime.Event)
+	Subscribe(buffer int) *realtime.Subscription
+}
+
+// newEvent builds an event of a change made for the user and book of ctx, so
+// it only reaches the clients of that user and book
+func newEvent(ctx context.Context, topic, action string, data any) realtime.Event {
+	userID, _ := domain.UserFromContext(ctx)
+	return realtime.Event{
+		Topic:  topic,
+		Action: action,
+		Data:   data,
+		UserID: userID,
+		BookID: domain.BookFromContext(ctx),
+	}
+}
+
+// DeletedResponse identifies a deleted resource in the events
+type DeletedResponse struct {
+	ID string `json:"id"`
+}
+
+// publishingAccountUseCase publishes the account changes, the reads go
+// straight to the wrapped use case
+type publishingAccountUseCase struct {
+	AccountUseCase
+	events EventHub
+}
+
+// NewPublishingAccountUseCase publishes the accounts created, updated and
+// deleted through uc to events
+func NewPublishingAccountUseCase(uc AccountUseCase, events EventHub) AccountUseCase {
+	return publishingAccountUseCase{AccountUseCase: uc, events: events}
+}
+
+func (uc publishingAccountUseCase) CreateAccount(ctx context.Context, account entities.Account) (entities.Account, error) {
+	created, err := uc.AccountUseCase.CreateAccount(ctx, account)
+	if err == nil {
+		uc.events.Publish(newEvent(ctx, realtime.TopicAccounts, realtime.ActionCreated, accountEventData(created)))
+	}
+	return created, err
+}
+
+func (uc publishingAccountUseCase) UpdateAccount(ctx context.Context, account entities.Account) (entities.Account, error) {
+	updated, err := uc.AccountUseCase.UpdateAccount(ctx, account)
+	if err == nil {
+		uc.events.Publish(newEvent(ctx, realtime.TopicAccounts, realtime.ActionUpdated, accountEventData(updated)))
+	}
+	return updated, err
+}
+
+func (uc publishingAccountUseCase) DeleteAccount(ctx context.Context, id string) error {
+	err := uc.AccountUseCase.DeleteAccount(ctx, id)
+	if err == nil {
+		uc.events.Publish(newEvent(ctx, realtime.TopicAccounts, realtime.ActionDeleted, DeletedResponse{ID: id}))
+	}
+	return err
+}
+
+// BudgetAlerter fires the alerts of the budgets reaching their thresholds
+type BudgetAlerter interface {
+	EvaluateBudgetAlerts(ctx context.Context) ([]entities.BudgetAlert, error)
+}
+
+// publishingTransactionUseCase publishes the transaction changes and the new
+// balance of the accounts they touched, and evaluates the budget alerts after
+// them. The reads go straight to the wrapped use case.
+type publishingTransactionUseCase struct {
+	TransactionUseCase
+	balances BalanceUseCase
+	budgets  BudgetAlerter
+	events   EventHub
+}
+
+// NewPublishingTransactionUseCase publishes the transactions created, updated
+// and deleted through uc to events, followed by the balances they changed and
+// the budget alerts they fired
+func NewPublishingTransactionUseCase(uc TransactionUseCase, balances BalanceUseCase, budgets BudgetAlerter, events EventHub) TransactionUseCase {
+	return publishingTransactionUseCase{TransactionUseCase: uc, balances: balances, budgets: budgets, events: events}
+}
+
+func (uc publishingTransactionUseCase) CreateTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+	created, err := uc.TransactionUseCase.CreateTransaction(ctx, transaction)
+	if err == nil {
+		uc.published(ctx, realtime.ActionCreated, created)
+	}
+	return created, err
+}
+
+func (uc publishingTransactionUseCase) UpdateTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+	before := uc.before(ctx, transaction.ID)
+	updated, err := uc.TransactionUseCase.UpdateTransaction(ctx, transaction)
+	if err == nil {
+		uc.published(ctx, realtime.ActionUpdated, updated, before.AccountID)
+	}
+	return updated, err
+}
+
+func (uc publishingTransactionUseCase) DeleteTransaction(ctx context.Context, id string) error {
+	before := uc.before(ctx, id)
+	err := uc.TransactionUseCase.DeleteTransaction(ctx, id)
+	if err == nil {
+		uc.deleted(ctx, id, before.AccountID)
+	}
+	return err
+}
+
+func (uc publishingTransactionUseCase) DuplicateTransaction(ctx context.Context, id string, date time.Time) (entities.Transaction, error) {
+	created, err := uc.TransactionUseCase.DuplicateTransaction(ctx, id, date)
+	if err == nil {
+		uc.published(ctx, realtime.ActionCreated, created)
+	}
+	return created, err
+}
+
+func (uc publishingTransactionUseCase) CreateInstallmentPurchase(ctx context.Context, transaction entities.Transaction, installments int) (entities.InstallmentPlan, error) {
+	plan, err := uc.TransactionUseCase.CreateInstallmentPurchase(ctx, transaction, installments)
+	if err == nil {
+		defer uc.alert(ctx)
+	}
+	if err == nil && uc.events.Active() {
+		for _, installment := range plan.Installments {
+			uc.events.Publish(newEvent(ctx, realtime.TopicTransactions, realtime.ActionCreated, transactionEventData(installment)))
+		}
+		// The installments share the account, its balance is published once
+		publishBalances(ctx, uc.balances, uc.events, plan.AccountID)
+	}
+	return plan, err
+}
+
+func (uc publishingTransactionUseCase) FinalizeTransaction(ctx context.Context, id string, status entities.TransactionStatus) (entities.Transaction, error) {
+	updated, err := uc.TransactionUseCase.FinalizeTransaction(ctx, id, status)
+	if err == nil {
+		uc.published(ctx, realtime.ActionUpdated, updated)
+	}
+	return updated, err
+}
+
+func (uc publishingTransactionUseCase) DiscardDraft(ctx context.Context, id string) error {
+	before := uc.before(ctx, id)
+	err := uc.TransactionUseCase.DiscardDraft(ctx, id)
+	if err == nil {
+		uc.deleted(ctx, id, before.AccountID)
+	}
+	return err
+}
+
+func (uc publishingTransactionUseCase) RestoreTransaction(ctx context.Context, id string) (entities.Transaction, error) {
+	restored, err := uc.TransactionUseCase.RestoreTransaction(ctx, id)
+	if err == nil {
+		uc.published(ctx, realtime.ActionCreated, restored)
+	}
+	return restored, err
+}
+
+func (uc publishingTransactionUseCase) BulkTransactions(ctx context.Context, operations []entities.BulkOperation) ([]entities.BulkResult, error) {
+	results, err := uc.TransactionUseCase.BulkTransactions(ctx, operations)
+	if err == nil {
+		defer uc.alert(ctx)
+	}
+	if err == nil && uc.events.Active() {
+		accountIDs := make([]string, len(results))
+		for i, result := range results {
+			accountIDs[i] = result.Transaction.AccountID
+			switch result.Action {
+			case entities.BulkActionCreate:
+				uc.events.Publish(newEvent(ctx, realtime.TopicTransactions, realtime.ActionCreated, transactionEventData(result.Transaction)))
+			case entities.BulkActionUpdateStatus:
+				uc.events.Publish(newEvent(ctx, realtime.TopicTransactions, realtime.ActionUpdated, transactionEventData(result.Transaction)))
+			case entities.BulkActionDelete:
+				uc.events.Publish(newEvent(ctx, realtime.TopicTransactions, realtime.ActionDeleted, DeletedResponse{ID: result.Transaction.ID}))
+			}
+		}
+		// Each account touched has its balance published once
+		publishBalances(ctx, uc.balances, uc.events, accountIDs...)
+	}
+	return results, err
+}
+
+// before reads the transaction about to change, to know which account it
+// leaves. It's skipped while nobody listens.
+func (uc publishingTransactionUseCase) before(ctx context.Context, id string) entities.Transaction {
+	if !uc.events.Active() {
+		return entities.Transaction{}
+	}
+	transaction, err := uc.TransactionUseCase.GetTransactionWithDetails(ctx, id)
+	if err != nil {
+		return entities.Transaction{}
+	}
+	return transaction
+}
+
+func (uc publishingTransactionUseCase) published(ctx context.Context, action string, transaction entities.Transaction, previousAccountIDs ...string) {
+	defer uc.alert(ctx)
+	if !uc.events.Active() {
+		return
+	}
+	uc.events.Publish(newEvent(ctx, realtime.TopicTransactions, action, transactionEventData(transaction)))
+	publishBalances(ctx, uc.balances, uc.events, append(previousAccountIDs, transaction.AccountID)...)
+}
+
+func (uc publishingTransactionUseCase) deleted(ctx context.Context, id, accountID string) {
+	defer uc.alert(ctx)
+	if !uc.events.Active() {
+		return
+	}
+	uc.events.Publish(newEvent(ctx, realtime.TopicTransactions, realtime.ActionDeleted, DeletedResponse{ID: id}))
+	publishBalances(ctx, uc.balances, uc.events, accountID)
+}
+
+// alert evaluates the budgets once the transactions changed, publishing the
+// alerts fired after the changes themselves. It runs while nobody listens
+// too, for the alerts to be recorded.
+func (uc publishingTransactionUseCase) alert(ctx context.Context) {
+	alerts, err := uc.budgets.EvaluateBudgetAlerts(ctx)
+	if err != nil {
+		// The change went through, its alerts fire on the next evaluation
+		slog.Warn("failed to evaluate the budget alerts", "error", err)
+		return
+	}
+	PublishBudgetAlerts(ctx, uc.events, alerts)
+}
+
+// PublishBudgetAlerts publishes the budget alerts fired to the clients of
+// the user and book of ctx. Without a user, as on a schedule, each goes to
+// the owner of its budget.
+func PublishBudgetAlerts(ctx context.Context, events EventHub, alerts []entities.BudgetAlert) {
+	_, authenticated := domain.UserFromContext(ctx)
+	for _, alert := range alerts {
+		alertCtx := ctx
+		if !authenticated && alert.OwnerID != "" {
+			alertCtx = domain.WithUser(ctx, alert.OwnerID)
+		}
+		events.Publish(newEvent(alertCtx, realtime.TopicBudgetAlerts, realtime.ActionCreated, budgetAlertResponse(alert)))
+	}
+}
+
+// publishBalances publishes the current balance of each account once
+func publishBalances(ctx context.Context, balances BalanceUseCase, events EventHub, accountIDs ...string) {
+	seen := map[string]bool{}
+	for _, accountID := range accountIDs {
+		if accountID == "" || seen[accountID] {
+			continue
+		}
+		seen[accountID] = true
+
+		balance, err := balances.GetBalanceByAccountID(ctx, accountID)
+		if err != nil {
+			// The change went through, only its event is lost
+			slog.Warn("failed to get the balance to publish", "account_id", accountID, "error", err)
+			continue
+		}
+		events.Publish(newEvent(ctx, realtime.TopicBalances, realtime.ActionUpdated, balanceEventData(balance)))
+	}
+}
+
+// publishingInstallmentUseCase publishes the installments paid off or
+// deleted with their plan, the reads go straight to the wrapped use case
+type publishingInstallmentUseCase struct {
+	InstallmentUseCase
+	balances BalanceUseCase
+	events   EventHub
+}
+
+// NewPublishingInstallmentUseCase publishes the transactions created and
+// deleted by paying off or deleting installment plans through uc to events,
+// followed by the balance they changed
+func NewPublishingInstallmentUseCase(uc InstallmentUseCase, balances BalanceUseCase, events EventHub) InstallmentUseCase {
+	return publishingInstallmentUseCase{InstallmentUseCase: uc, balances: balances, events: events}
+}
+
+func (uc publishingInstallmentUseCase) PayOffInstallmentPlan(ctx context.Context, id string, date time.Time, monthlyRate float64) (entities.InstallmentPayoff, error) {
+	before := uc.before(ctx, id)
+	payoff, err := uc.InstallmentUseCase.PayOffInstallmentPlan(ctx, id, date, monthlyRate)
+	if err == nil && uc.events.Active() {
+		// The installments left in the plan are the ones kept
+		kept := map[string]bool{}
+		for _, installment := range payoff.Plan.Installments {
+			kept[installment.ID] = true
+		}
+		for _, installment := range before.Installments {
+			if !kept[installment.ID] {
+				uc.events.Publish(newEvent(ctx, realtime.TopicTransactions, realtime.ActionDeleted, DeletedResponse{ID: installment.ID}))
+			}
+		}
+		if payoff.Transaction != nil {
+			uc.events.Publish(newEvent(ctx, realtime.TopicTransactions, realtime.ActionCreated, transactionEventData(*payoff.Transaction)))
+		}
+		publishBalances(ctx, uc.balances, uc.events, payoff.Plan.AccountID)
+	}
+	return payoff, err
+}
+
+func (uc publishingInstallmentUseCase) DeleteInstallmentPlan(ctx context.Context, id string) error {
+	before := uc.before(ctx, id)
+	err := uc.InstallmentUseCase.DeleteInstallmentPlan(ctx, id)
+	if err == nil && uc.events.Active() {
+		for _, installment := range before.Installments {
+			uc.events.Publish(newEvent(ctx, realtime.TopicTransactions, realtime.ActionDeleted, DeletedResponse{ID: installment.ID}))
+		}
+		publishBalances(ctx, uc.balances, uc.events, before.AccountID)
+	}
+	return err
+}
+
+// before reads the plan about to change with its installments. It's skipped
+// while nobody listens.
+func (uc publishingInstallmentUseCase) before(ctx context.Context, id string) entities.InstallmentPlan {
+	if !uc.events.Active() {
+		return entities.InstallmentPlan{}
+	}
+	plan, err := uc.InstallmentUseCase.GetInstallmentPlan(ctx, id)
+	if err != nil {
+		return entities.InstallmentPlan{}
+	}
+	return plan
+}
+
+func accountEventData(account entities.Account) AccountResponse {
+	return AccountResponse{
+		ID:                  account.ID,
+		Name:                account.Name,
+		Type:                account.Type,
+		Asset:               account.Asset.Asset,
+		Description:         account.Description,
+		Classification:      account.EffectiveClassification(),
+		Institution:         account.Institution,
+		AccountNumberLast4:  account.AccountNumberLast4,
+		Color:               account.Color,
+		Icon:                account.Icon,
+		StatementClosingDay: account.StatementClosingDay,
+		PaymentDueDay:       account.PaymentDueDay,
+		CreatedAt:           account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:           account.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		TransactionCount:    account.TransactionCount,
+		LastTransactionDate: formatDate(account.LastTransactionDate),
+	}
+}
+
+func transactionEventData(transaction entities.Transaction) TransactionResponse {
+	return transactionResponse(transaction)
+}
+
+func balanceEventData(balance entities.Balance) BalanceResponse {
+	return BalanceResponse{
+		AccountID:        balance.AccountID,
+		CurrentBalance:   balance.CurrentBalance.String(),
+		PendingBalance:   balance.PendingBalance.String(),
+		AvailableBalance: balance.AvailableBalance.String(),
+		LastCalculated:   balance.LastCalculated.Format("2006-01-02T15:04:05Z07:00"),
+		Deltas:           toBalanceDeltaResponses(balance.Deltas),
+	}
+}
@@ -0,0 +1,433 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// ExpenseReportRequest names an expense report and the period its expenses are
+// dated in
+type ExpenseReportRequest struct {
+	Name      string `json:"name" example:"Lisbon trip"`
+	StartDate string `json:"start_date" example:"2025-03-10"`
+	EndDate   string `json:"end_date" example:"2025-03-14"`
+	Notes     string `json:"notes" example:"Client visit"`
+}
+
+type AddExpenseReportTransactionRequest struct {
+	TransactionID string `json:"transaction_id"`
+}
+
+// ExpenseReportResponse is a group of work expenses to have reimbursed.
+// Totals adds up the transactions per currency.
+type ExpenseReportResponse struct {
+	ID           string                       `json:"id"`
+	Name         string                       `json:"name" example:"Lisbon trip"`
+	StartDate    string                       `json:"start_date" example:"2025-03-10"`
+	EndDate      string                       `json:"end_date" example:"2025-03-14"`
+	Notes        string                       `json:"notes,omitempty"`
+	Status       entities.ExpenseReportStatus `json:"status" example:"draft"`
+	SubmittedAt  *string                      `json:"submitted_at,omitempty"`
+	ReviewedAt   *string                      `json:"reviewed_at,omitempty"`
+	Totals       []string                     `json:"totals" example:"[GBP (£) 812.40]"`
+	Transactions []TransactionResponse        `json:"transactions"`
+	CreatedAt    string                       `json:"created_at"`
+	UpdatedAt    string                       `json:"updated_at"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/expense_report_uc.go . ExpenseReportUseCase
+type ExpenseReportUseCase interface {
+	CreateExpenseReport(ctx context.Context, report entities.ExpenseReport) (entities.ExpenseReport, error)
+	GetExpenseReports(ctx context.Context) ([]entities.ExpenseReport, error)
+	GetExpenseReport(ctx context.Context, id string) (entities.ExpenseReport, error)
+	UpdateExpenseReport(ctx context.Context, report entities.ExpenseReport) (entities.ExpenseReport, error)
+	DeleteExpenseReport(ctx context.Context, id string) error
+	AddExpenseReportTransaction(ctx context.Context, reportID, transactionID string) (entities.ExpenseReport, error)
+	RemoveExpenseReportTransaction(ctx context.Context, reportID, transactionID string) (entities.ExpenseReport, error)
+	ChangeExpenseReportStatus(ctx context.Context, id string, status entities.ExpenseReportStatus) (entities.ExpenseReport, error)
+	ExportExpenseReport(ctx context.Context, id string, format entities.ExpenseReportFormat) (entities.ExpenseReportExport, error)
+}
+
+// Expense report handlers
+
+// CreateExpenseReport creates an expense report
+//
+//	@Summary		Create expense report
+//	@Description	Create an empty expense report, as a draft, for the expenses of a period like a business trip
+//	@Tags			expense-reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			report	body		ExpenseReportRequest	true	"Expense report data"
+//	@Success		201		{object}	ExpenseReportResponse	"Expense report created successfully"
+//	@Failure		400		{object}	ErrorResponseBody		"Bad request"
+//	@Failure		413		{object}	ErrorResponseBody		"Request body too large"
+//	@Router			/expense-reports [post]
+func (h *ApiHandlers) CreateExpenseReport(w http.ResponseWriter, r *http.Request) {
+	report, ok := decodeExpenseReport(w, r)
+	if !ok {
+		return
+	}
+
+	created, err := h.ExpenseReportUseCase.CreateExpenseReport(r.Context(), report)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, expenseReportResponse(created))
+}
+
+// GetExpenseReports lists the expense reports
+//
+//	@Summary		List expense reports
+//	@Description	List the expense reports, the most recent period first, with their transactions and totals
+//	@Tags			expense-reports
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		ExpenseReportResponse	"Expense reports retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/expense-reports [get]
+func (h *ApiHandlers) GetExpenseReports(w http.ResponseWriter, r *http.Request) {
+	reports, err := h.ExpenseReportUseCase.GetExpenseReports(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	responses := make([]ExpenseReportResponse, len(reports))
+	for i, report := range reports {
+		responses[i] = expenseReportResponse(report)
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetExpenseReport retrieves an expense report
+//
+//	@Summary		Get expense report
+//	@Description	Retrieve an expense report with its transactions and totals
+//	@Tags			expense-reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string					true	"Expense report ID"
+//	@Success		200	{object}	ExpenseReportResponse	"Expense report retrieved successfully"
+//	@Failure		400	{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody		"Expense report not found"
+//	@Router			/expense-reports/{id} [get]
+func (h *ApiHandlers) GetExpenseReport(w http.ResponseWriter, r *http.Request) {
+	report, err := h.ExpenseReportUseCase.GetExpenseReport(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, expenseReportResponse(report))
+}
+
+// UpdateExpenseReport updates an expense report
+//
+//	@Summary		Update expense report
+//	@Description	Change the name, period and notes of a draft expense report. The period has to keep the transactions already on the report
+//	@Tags			expense-reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"Expense report ID"
+//	@Param			report	body		ExpenseReportRequest	true	"Expense report data"
+//	@Success		200		{object}	ExpenseReportResponse	"Expense report updated successfully"
+//	@Failure		400		{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody		"Expense report not found"
+//	@Failure		409		{object}	ErrorResponseBody		"Expense report not a draft or transactions outside the period"
+//	@Failure		413		{object}	ErrorResponseBody		"Request body too large"
+//	@Router			/expense-reports/{id} [put]
+func (h *ApiHandlers) UpdateExpenseReport(w http.ResponseWriter, r *http.Request) {
+	report, ok := decodeExpenseReport(w, r)
+	if !ok {
+		return
+	}
+	report.ID = chi.URLParam(r, "id")
+
+	updated, err := h.ExpenseReportUseCase.UpdateExpenseReport(r.Context(), report)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, expenseReportResponse(updated))
+}
+
+// DeleteExpenseReport deletes an expense report
+//
+//	@Summary		Delete expense report
+//	@Description	Delete an expense report. Its transactions are kept
+//	@Tags			expense-reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	string	true	"Expense report ID"
+//	@Success		204	"Expense report deleted successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Expense report not found"
+//	@Router			/expense-reports/{id} [delete]
+func (h *ApiHandlers) DeleteExpenseReport(w http.ResponseWriter, r *http.Request) {
+	if err := h.ExpenseReportUseCase.DeleteExpenseReport(r.Context(), chi.URLParam(r, "id")); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddExpenseReportTransaction puts a transaction on an expense report
+//
+//	@Summary		Add transaction to expense report
+//	@Description	Put a pending or cleared expense dated within the period of a draft expense report on it. A transaction is on one report at most
+//	@Tags			expense-reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string								true	"Expense report ID"
+//	@Param			transaction	body		AddExpenseReportTransactionRequest	true	"Transaction to add"
+//	@Success		200			{object}	ExpenseReportResponse				"Transaction added successfully"
+//	@Failure		400			{object}	ErrorResponseBody					"Bad request"
+//	@Failure		404			{object}	ErrorResponseBody					"Expense report or transaction not found"
+//	@Failure		409			{object}	ErrorResponseBody					"Expense report not a draft or transaction already on a report"
+//	@Failure		413			{object}	ErrorResponseBody					"Request body too large"
+//	@Router			/expense-reports/{id}/transactions [post]
+func (h *ApiHandlers) AddExpenseReportTransaction(w http.ResponseWriter, r *http.Request) {
+	var req AddExpenseReportTransactionRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+	if req.TransactionID == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("transaction_id"))
+		return
+	}
+
+	report, err := h.ExpenseReportUseCase.AddExpenseReportTransaction(r.Context(), chi.URLParam(r, "id"), req.TransactionID)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, expenseReportResponse(report))
+}
+
+// RemoveExpenseReportTransaction takes a transaction off an expense report
+//
+//	@Summary		Remove transaction from expense report
+//	@Description	Take a transaction off a draft expense report, the transaction is kept
+//	@Tags			expense-reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string					true	"Expense report ID"
+//	@Param			transaction_id	path		string					true	"Transaction ID"
+//	@Success		200				{object}	ExpenseReportResponse	"Transaction removed successfully"
+//	@Failure		400				{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404				{object}	ErrorResponseBody		"Expense report or transaction not found"
+//	@Failure		409				{object}	ErrorResponseBody		"Expense report not a draft"
+//	@Router			/expense-reports/{id}/transactions/{transaction_id} [delete]
+func (h *ApiHandlers) RemoveExpenseReportTransaction(w http.ResponseWriter, r *http.Request) {
+	report, err := h.ExpenseReportUseCase.RemoveExpenseReportTransaction(r.Context(), chi.URLParam(r, "id"), chi.URLParam(r, "transaction_id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, expenseReportResponse(report))
+}
+
+// SubmitExpenseReport submits an expense report for review
+//
+//	@Summary		Submit expense report
+//	@Description	Submit a draft expense report with transactions for review, after which it can't be changed
+//	@Tags			expense-reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string					true	"Expense report ID"
+//	@Success		200	{object}	ExpenseReportResponse	"Expense report submitted successfully"
+//	@Failure		400	{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody		"Expense report not found"
+//	@Failure		409	{object}	ErrorResponseBody		"Expense report not a draft or without transactions"
+//	@Router			/expense-reports/{id}/submit [post]
+func (h *ApiHandlers) SubmitExpenseReport(w http.ResponseWriter, r *http.Request) {
+	h.changeExpenseReportStatus(w, r, entities.ExpenseReportStatusSubmitted)
+}
+
+// ApproveExpenseReport approves a submitted expense report
+//
+//	@Summary		Approve expense report
+//	@Description	Approve a submitted expense report, which is final
+//	@Tags			expense-reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string					true	"Expense report ID"
+//	@Success		200	{object}	ExpenseReportResponse	"Expense report approved successfully"
+//	@Failure		400	{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody		"Expense report not found"
+//	@Failure		409	{object}	ErrorResponseBody		"Expense report not submitted"
+//	@Router			/expense-reports/{id}/approve [post]
+func (h *ApiHandlers) ApproveExpenseReport(w http.ResponseWriter, r *http.Request) {
+	h.changeExpenseReportStatus(w, r, entities.ExpenseReportStatusApproved)
+}
+
+// RejectExpenseReport rejects a submitted expense report
+//
+//	@Summary		Reject expense report
+//	@Description	Reject a submitted expense report, which can be reopened to be fixed
+//	@Tags			expense-reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string					true	"Expense report ID"
+//	@Success		200	{object}	ExpenseReportResponse	"Expense report rejected successfully"
+//	@Failure		400	{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody		"Expense report not found"
+//	@Failure		409	{object}	ErrorResponseBody		"Expense report not submitted"
+//	@Router			/expense-reports/{id}/reject [post]
+func (h *ApiHandlers) RejectExpenseReport(w http.ResponseWriter, r *http.Request) {
+	h.changeExpenseReportStatus(w, r, entities.ExpenseReportStatusRejected)
+}
+
+// ReopenExpenseReport takes an expense report back to draft
+//
+//	@Summary		Reopen expense report
+//	@Description	Take a submitted or rejected expense report back to draft so it can be changed
+//	@Tags			expense-reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string					true	"Expense report ID"
+//	@Success		200	{object}	ExpenseReportResponse	"Expense report reopened successfully"
+//	@Failure		400	{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody		"Expense report not found"
+//	@Failure		409	{object}	ErrorResponseBody		"Expense report approved or already a draft"
+//	@Router			/expense-reports/{id}/reopen [post]
+func (h *ApiHandlers) ReopenExpenseReport(w http.ResponseWriter, r *http.Request) {
+	h.changeExpenseReportStatus(w, r, entities.ExpenseReportStatusDraft)
+}
+
+// ExportExpenseReport downloads an expense report as a file
+//
+//	@Summary		Export expense report
+//	@Description	Download an expense report as a CSV or PDF file listing its transactions and totals
+//	@Tags			expense-reports
+//	@Produce		text/csv
+//	@Produce		application/pdf
+//	@Param			id		path		string				true	"Expense report ID"
+//	@Param			format	query		string				false	"Export format, csv or pdf"	default(csv)
+//	@Success		200		{file}		file				"Expense report file"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody	"Expense report not found"
+//	@Router			/expense-reports/{id}/export [get]
+func (h *ApiHandlers) ExportExpenseReport(w http.ResponseWriter, r *http.Request) {
+	format := entities.ExpenseReportFormat(r.URL.Query().Get("format"))
+	if format == "" {
+		format = entities.ExpenseReportFormatCSV
+	}
+
+	export, err := h.ExpenseReportUseCase.ExportExpenseReport(r.Context(), chi.URLParam(r, "id"), format)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	w.Header().Set("Content-Type", export.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.Filename))
+	w.Write(export.Data)
+}
+
+func (h *ApiHandlers) changeExpenseReportStatus(w http.ResponseWriter, r *http.Request, status entities.ExpenseReportStatus) {
+	report, err := h.ExpenseReportUseCase.ChangeExpenseReportStatus(r.Context(), chi.URLParam(r, "id"), status)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, expenseReportResponse(report))
+}
+
+// decodeExpenseReport reads an ExpenseReportRequest, answering the request
+// itself when it's invalid
+func decodeExpenseReport(w http.ResponseWriter, r *http.Request) (entities.ExpenseReport, bool) {
+	var req ExpenseReportRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return entities.ExpenseReport{}, false
+	}
+
+	report := entities.ExpenseReport{Name: req.Name, Notes: req.Notes}
+	for _, date := range []struct {
+		param string
+		value string
+		dst   *time.Time
+	}{
+		{"start_date", req.StartDate, &report.StartDate},
+		{"end_date", req.EndDate, &report.EndDate},
+	} {
+		if date.value == "" {
+			errorResponse(w, r, http.StatusBadRequest, errMissingParameter(date.param))
+			return entities.ExpenseReport{}, false
+		}
+		parsed, err := time.Parse("2006-01-02", date.value)
+		if err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter(date.param, "must be in format YYYY-MM-DD"))
+			return entities.ExpenseReport{}, false
+		}
+		*date.dst = parsed
+	}
+
+	return report, true
+}
+
+func expenseReportResponse(report entities.ExpenseReport) ExpenseReportResponse {
+	response := ExpenseReportResponse{
+		ID:           report.ID,
+		Name:         report.Name,
+		StartDate:    report.StartDate.Format("2006-01-02"),
+		EndDate:      report.EndDate.Format("2006-01-02"),
+		Notes:        report.Notes,
+		Status:       report.Status,
+		SubmittedAt:  formatTimestamp(report.SubmittedAt),
+		ReviewedAt:   formatTimestamp(report.ReviewedAt),
+		Totals:       make([]string, len(report.Totals)),
+		Transactions: make([]TransactionResponse, len(report.Transactions)),
+		CreatedAt:    report.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:    report.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	for i, total := range report.Totals {
+		response.Totals[i] = total.String()
+	}
+	for i, transaction := range report.Transactions {
+		response.Transactions[i] = transactionEventData(transaction)
+		if transaction.Account != nil {
+			response.Transactions[i].Account = &AccountResponse{
+				ID:   transaction.Account.ID,
+				Name: transaction.Account.Name,
+				Type: transaction.Account.Type,
+			}
+		}
+		if transaction.Category != nil {
+			response.Transactions[i].Category = &CategoryResponse{
+				ID:    transaction.Category.ID,
+				Name:  transaction.Category.Name,
+				Type:  transaction.Category.Type,
+				Color: transaction.Category.Color,
+			}
+		}
+	}
+	return response
+}
+
+// formatTimestamp formats an optional timestamp, nil stays nil so it's omitted
+// from the response
+func formatTimestamp(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format("2006-01-02T15:04:05Z07:00")
+	return &formatted
+}
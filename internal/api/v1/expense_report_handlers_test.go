@@ -0,0 +1,168 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestExpenseReportHandlers(t *testing.T) {
+	const reportID = "5d2e8f1a-3b4c-4d5e-8f6a-7b8c9d0e1f2a"
+	const approvedID = "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	const transactionID = "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e"
+
+	hotel := &monetary.Monetary{Asset: monetary.GBP, Amount: big.NewInt(-48000)}
+	total, _ := monetary.NewMonetary(monetary.GBP, big.NewInt(48000))
+	report := entities.ExpenseReport{
+		ID:        reportID,
+		Name:      "Lisbon trip",
+		StartDate: time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC),
+		Status:    entities.ExpenseReportStatusDraft,
+		Transactions: []entities.Transaction{
+			{ID: transactionID, Monetary: *hotel, Description: "Hotel", Date: time.Date(2025, time.March, 11, 0, 0, 0, 0, time.UTC), Status: entities.TransactionStatusCleared, Category: &entities.Category{Name: "Travel"}},
+		},
+		Totals: []monetary.Monetary{*total},
+	}
+	get := func(ctx context.Context, id string) (entities.ExpenseReport, error) {
+		if id != reportID {
+			return entities.ExpenseReport{}, fmt.Errorf("expense report %w", domain.ErrNotFound)
+		}
+		return report, nil
+	}
+
+	var gotReport entities.ExpenseReport
+	var gotStatus entities.ExpenseReportStatus
+	mockUC := &mocks.ExpenseReportUseCaseMock{
+		CreateExpenseReportFunc: func(ctx context.Context, created entities.ExpenseReport) (entities.ExpenseReport, error) {
+			gotReport = created
+			created.ID = reportID
+			created.Status = entities.ExpenseReportStatusDraft
+			return created, nil
+		},
+		GetExpenseReportFunc: get,
+		AddExpenseReportTransactionFunc: func(ctx context.Context, id, transactionID string) (entities.ExpenseReport, error) {
+			return get(ctx, id)
+		},
+		ChangeExpenseReportStatusFunc: func(ctx context.Context, id string, status entities.ExpenseReportStatus) (entities.ExpenseReport, error) {
+			if id == approvedID {
+				return entities.ExpenseReport{}, fmt.Errorf("expense report is approved, it can't be %s: %w", status, domain.ErrConflict)
+			}
+			gotStatus = status
+			changed, err := get(ctx, id)
+			changed.Status = status
+			return changed, err
+		},
+		ExportExpenseReportFunc: func(ctx context.Context, id string, format entities.ExpenseReportFormat) (entities.ExpenseReportExport, error) {
+			if format != entities.ExpenseReportFormatCSV && format != entities.ExpenseReportFormatPDF {
+				return entities.ExpenseReportExport{}, fmt.Errorf("unknown export format %q: %w", format, domain.ErrMalformedParameters)
+			}
+			return entities.ExpenseReportExport{Filename: "lisbon-trip." + string(format), ContentType: "text/csv", Data: []byte("Date\n")}, nil
+		},
+	}
+	h := &ApiHandlers{ExpenseReportUseCase: mockUC}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(method, path, bytes.NewBufferString(body)))
+		return rec
+	}
+
+	t.Run("creates a report", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/expense-reports", `{"name":"Lisbon trip","start_date":"2025-03-10","end_date":"2025-03-14"}`)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotReport.Name != "Lisbon trip" || !gotReport.EndDate.Equal(report.EndDate) {
+			t.Errorf("unexpected report: %+v", gotReport)
+		}
+	})
+
+	t.Run("requires the period", func(t *testing.T) {
+		for _, body := range []string{`{"name":"Lisbon trip","start_date":"2025-03-10"}`, `{"name":"Lisbon trip","start_date":"10/03/2025","end_date":"2025-03-14"}`} {
+			if rec := serve(http.MethodPost, "/api/v1/expense-reports", body); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", body, rec.Code)
+			}
+		}
+	})
+
+	t.Run("gets a report", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/expense-reports/"+reportID, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		var response ExpenseReportResponse
+		json.NewDecoder(rec.Body).Decode(&response)
+		if len(response.Totals) != 1 || response.Totals[0] != "[GBP (£) 480.00]" {
+			t.Errorf("unexpected totals: %v", response.Totals)
+		}
+		if len(response.Transactions) != 1 || response.Transactions[0].Category == nil || response.Transactions[0].Category.Name != "Travel" {
+			t.Errorf("unexpected transactions: %+v", response.Transactions)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/expense-reports/"+approvedID, ""); rec.Code != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", rec.Code)
+		}
+	})
+
+	t.Run("adds a transaction", func(t *testing.T) {
+		if rec := serve(http.MethodPost, "/api/v1/expense-reports/"+reportID+"/transactions", `{"transaction_id":"`+transactionID+`"}`); rec.Code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", rec.Code)
+		}
+		if rec := serve(http.MethodPost, "/api/v1/expense-reports/"+reportID+"/transactions", `{}`); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400 without a transaction, got %d", rec.Code)
+		}
+	})
+
+	t.Run("validates the transaction ID", func(t *testing.T) {
+		if rec := serve(http.MethodDelete, "/api/v1/expense-reports/"+reportID+"/transactions/hotel", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("moves through the review", func(t *testing.T) {
+		for action, status := range map[string]entities.ExpenseReportStatus{
+			"submit":  entities.ExpenseReportStatusSubmitted,
+			"approve": entities.ExpenseReportStatusApproved,
+			"reject":  entities.ExpenseReportStatusRejected,
+			"reopen":  entities.ExpenseReportStatusDraft,
+		} {
+			rec := serve(http.MethodPost, "/api/v1/expense-reports/"+reportID+"/"+action, "")
+			if rec.Code != http.StatusOK || gotStatus != status {
+				t.Errorf("expected status 200 and %s for %s, got %d and %s", status, action, rec.Code, gotStatus)
+			}
+		}
+		if rec := serve(http.MethodPost, "/api/v1/expense-reports/"+approvedID+"/reopen", ""); rec.Code != http.StatusConflict {
+			t.Errorf("expected status 409, got %d", rec.Code)
+		}
+	})
+
+	t.Run("exports a report", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/expense-reports/"+reportID+"/export", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if got := rec.Header().Get("Content-Disposition"); got != `attachment; filename="lisbon-trip.csv"` {
+			t.Errorf("unexpected Content-Disposition: %s", got)
+		}
+		if rec := serve(http.MethodGet, "/api/v1/expense-reports/"+reportID+"/export?format=xlsx", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400 for xlsx, got %d", rec.Code)
+		}
+	})
+}
@@ -0,0 +1,183 @@
+package v1
+
+import (
+	"finance/domain/entities"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// ExportResponse is a transaction export kept in the file store. url
+// downloads it, from the store itself with a link signed until
+// url_expires_at when the store signs them, through the service otherwise.
+type ExportResponse struct {
+	ID           string `json:"id" example:"5f0c6a3e-8f1b-4c1d-9a2e-3b4c5d6e7f80"`
+	Format       string `json:"format" example:"csv" enums:"csv,xlsx"`
+	FileName     string `json:"file_name" example:"transactions-2025-04-01.csv"`
+	ContentType  string `json:"content_type" example:"text/csv"`
+	Size         int64  `json:"size" example:"48213"`
+	URL          string `json:"url" example:"/api/v1/exports/5f0c6a3e-8f1b-4c1d-9a2e-3b4c5d6e7f80/download"`
+	URLExpiresAt string `json:"url_expires_at,omitempty"`
+	CreatedAt    string `json:"created_at"`
+	ExpiresAt    string `json:"expires_at"`
+}
+
+// CreateExport writes the transactions to a file kept for download
+//
+//	@Summary		Create export
+//	@Description	Export every transaction matching the filters like GET /transactions/export, writing the file to the file store instead of sending it. The file is downloaded from its url, a link to the bucket signed for 15 minutes when the attachments are kept in S3, and deleted a week after it's made.
+//	@Tags			exports
+//	@Produce		json
+//	@Param			format		query		string				false	"Export format, csv or xlsx"	default(csv)
+//	@Param			sort		query		string				false	"Comma separated sort fields (date, amount, description, status, created_at), prefix with - for descending"
+//	@Param			project_id	query		string				false	"Only transactions filed under this project"
+//	@Param			tag			query		string				false	"Only transactions with this tag"
+//	@Success		201			{object}	ExportResponse		"Export created"
+//	@Failure		400			{object}	ErrorResponseBody	"Bad request"
+//	@Failure		500			{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/exports [post]
+func (h *ApiHandlers) CreateExport(w http.ResponseWriter, r *http.Request) {
+	format := entities.TransactionExportFormat(r.URL.Query().Get("format"))
+	if format == "" {
+		format = entities.TransactionExportFormatCSV
+	}
+
+	projectID, err := parseUUIDParam(r, "project_id")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	sort := entities.ParseSort(r.URL.Query().Get("sort"))
+
+	export, err := h.TransactionExportUseCase.CreateExport(r.Context(), format, entities.TransactionFilter{ProjectID: projectID, Tag: r.URL.Query().Get("tag")}, sort)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, exportResponse(export))
+}
+
+// GetExports lists the exports
+//
+//	@Summary		Get exports
+//	@Description	List the exports not expired yet, the latest first, each with a freshly signed url
+//	@Tags			exports
+//	@Produce		json
+//	@Success		200	{array}		ExportResponse		"Exports retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/exports [get]
+func (h *ApiHandlers) GetExports(w http.ResponseWriter, r *http.Request) {
+	exports, err := h.TransactionExportUseCase.GetExports(r.Context())
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := make([]ExportResponse, len(exports))
+	for i, export := range exports {
+		response[i] = exportResponse(export)
+	}
+	render.JSON(w, r, response)
+}
+
+// GetExport retrieves an export
+//
+//	@Summary		Get export
+//	@Description	Get an export with a freshly signed url
+//	@Tags			exports
+//	@Produce		json
+//	@Param			id	path		string				true	"Export ID"
+//	@Success		200	{object}	ExportResponse		"Export retrieved successfully"
+//	@Failure		404	{object}	ErrorResponseBody	"Export not found or expired"
+//	@Router			/exports/{id} [get]
+func (h *ApiHandlers) GetExport(w http.ResponseWriter, r *http.Request) {
+	export, err := h.TransactionExportUseCase.GetExport(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, exportResponse(export))
+}
+
+// DownloadExport sends the file of an export
+//
+//	@Summary		Download export
+//	@Description	Download the file of an export, redirected to a signed link to the bucket when the attachments are kept in S3
+//	@Tags			exports
+//	@Produce		text/csv
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Param			id	path		string				true	"Export ID"
+//	@Success		200	{file}		file				"Transactions file"
+//	@Success		302	"Redirect to the signed link"
+//	@Failure		404	{object}	ErrorResponseBody	"Export not found or expired"
+//	@Router			/exports/{id}/download [get]
+func (h *ApiHandlers) DownloadExport(w http.ResponseWriter, r *http.Request) {
+	export, err := h.TransactionExportUseCase.GetExport(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+	if export.URL != "" {
+		http.Redirect(w, r, export.URL, http.StatusFound)
+		return
+	}
+
+	export, file, err := h.TransactionExportUseCase.OpenExport(r.Context(), export.ID)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+	defer file.Close()
+
+	w.Header().Set("Content-Type", export.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": export.FileName}))
+	w.Header().Set("Content-Length", strconv.FormatInt(export.Size, 10))
+	if _, err := io.Copy(w, file); err != nil {
+		slog.Error("failed to send export", "error", err, "export_id", export.ID)
+	}
+}
+
+// DeleteExport deletes an export
+//
+//	@Summary		Delete export
+//	@Description	Delete an export and its file before it expires
+//	@Tags			exports
+//	@Param			id	path	string	true	"Export ID"
+//	@Success		204	"Export deleted successfully"
+//	@Failure		404	{object}	ErrorResponseBody	"Export not found"
+//	@Router			/exports/{id} [delete]
+func (h *ApiHandlers) DeleteExport(w http.ResponseWriter, r *http.Request) {
+	if err := h.TransactionExportUseCase.DeleteExport(r.Context(), chi.URLParam(r, "id")); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func exportResponse(export entities.Export) ExportResponse {
+	response := ExportResponse{
+		ID:          export.ID,
+		Format:      string(export.Format),
+		FileName:    export.FileName,
+		ContentType: export.ContentType,
+		Size:        export.Size,
+		URL:         "/api/v1/exports/" + export.ID + "/download",
+		CreatedAt:   export.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ExpiresAt:   export.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if export.URL != "" {
+		response.URL = export.URL
+		response.URLExpiresAt = export.URLExpiresAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return response
+}
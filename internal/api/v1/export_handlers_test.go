@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestExportHandlers(t *testing.T) {
+	const exportID = "5f0c6a3e-8f1b-4c1d-9a2e-3b4c5d6e7f80"
+	created := time.Date(2025, time.April, 1, 9, 30, 0, 0, time.UTC)
+	export := entities.Export{
+		ID:          exportID,
+		Format:      entities.TransactionExportFormatCSV,
+		FileName:    "transactions-2025-04-01.csv",
+		ContentType: "text/csv",
+		Size:        5,
+		CreatedAt:   created,
+		ExpiresAt:   created.Add(entities.ExportTTL),
+	}
+	// signed is the URL of the store, empty for the local one
+	signed := ""
+	withURL := func(export entities.Export) entities.Export {
+		if signed != "" {
+			export.URL = signed
+			export.URLExpiresAt = created.Add(entities.ExportURLTTL)
+		}
+		return export
+	}
+	mockUC := &mocks.TransactionExportUseCaseMock{
+		CreateExportFunc: func(ctx context.Context, format entities.TransactionExportFormat, filter entities.TransactionFilter, sort []entities.SortField) (entities.Export, error) {
+			if format != entities.TransactionExportFormatCSV {
+				return entities.Export{}, fmt.Errorf("unknown export format %q: %w", format, domain.ErrMalformedParameters)
+			}
+			return withURL(export), nil
+		},
+		GetExportsFunc: func(ctx context.Context) ([]entities.Export, error) {
+			return []entities.Export{withURL(export)}, nil
+		},
+		GetExportFunc: func(ctx context.Context, id string) (entities.Export, error) {
+			if id != exportID {
+				return entities.Export{}, fmt.Errorf("export %w", domain.ErrNotFound)
+			}
+			return withURL(export), nil
+		},
+		OpenExportFunc: func(ctx context.Context, id string) (entities.Export, io.ReadCloser, error) {
+			return export, io.NopCloser(strings.NewReader("Date\n")), nil
+		},
+	}
+	r := chi.NewRouter()
+	(&ApiHandlers{TransactionExportUseCase: mockUC}).Routes(r)
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		return w
+	}
+
+	t.Run("create", func(t *testing.T) {
+		w := serve(http.MethodPost, "/api/v1/exports?tag=travel&sort=-date")
+		if w.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", w.Code, w.Body)
+		}
+		var response ExportResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.URL != "/api/v1/exports/"+exportID+"/download" || response.URLExpiresAt != "" || response.ExpiresAt != "2025-04-08T09:30:00Z" {
+			t.Errorf("unexpected response: %+v", response)
+		}
+		if call := mockUC.CreateExportCalls()[0]; call.Format != entities.TransactionExportFormatCSV || call.Filter.Tag != "travel" {
+			t.Errorf("unexpected export: %+v", call)
+		}
+
+		if w := serve(http.MethodPost, "/api/v1/exports?format=ods"); w.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400 for an unknown format, got %d", w.Code)
+		}
+	})
+
+	t.Run("download through the service", func(t *testing.T) {
+		w := serve(http.MethodGet, "/api/v1/exports/"+exportID+"/download")
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body)
+		}
+		if got := w.Header().Get("Content-Disposition"); got != `attachment; filename=transactions-2025-04-01.csv` {
+			t.Errorf("unexpected Content-Disposition: %s", got)
+		}
+		if w.Body.String() != "Date\n" {
+			t.Errorf("unexpected body: %q", w.Body)
+		}
+	})
+
+	t.Run("signed URLs", func(t *testing.T) {
+		signed = "https://bucket.example.com/exports/1.csv?X-Amz-Signature=abc"
+		defer func() { signed = "" }()
+
+		w := serve(http.MethodGet, "/api/v1/exports")
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body)
+		}
+		var response []ExportResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response) != 1 || response[0].URL != signed || response[0].URLExpiresAt != "2025-04-01T09:45:00Z" {
+			t.Errorf("unexpected response: %+v", response)
+		}
+
+		w = serve(http.MethodGet, "/api/v1/exports/"+exportID+"/download")
+		if w.Code != http.StatusFound || w.Header().Get("Location") != signed {
+			t.Errorf("expected a redirect to the signed URL, got %d %s", w.Code, w.Header().Get("Location"))
+		}
+		if len(mockUC.OpenExportCalls()) != 1 {
+			t.Errorf("expected the file not to be opened when the URL is signed")
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		if w := serve(http.MethodGet, "/api/v1/exports/3f2a7d4e-8b1c-4e5f-9a6d-2c7b8e9f0a1b"); w.Code != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", w.Code)
+		}
+		if w := serve(http.MethodDelete, "/api/v1/exports/not-a-uuid"); w.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", w.Code)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if w := serve(http.MethodDelete, "/api/v1/exports/"+exportID); w.Code != http.StatusNoContent {
+			t.Errorf("expected status 204, got %d", w.Code)
+		}
+		if calls := mockUC.DeleteExportCalls(); len(calls) != 1 || calls[0].ID != exportID {
+			t.Errorf("unexpected deletes: %+v", calls)
+		}
+	})
+}
@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// FaturaResponse is a monthly credit card statement, named after the month it's
+// due. Purchases from its closing date on go to the next fatura. Transactions
+// are only listed when a single fatura is retrieved.
+type FaturaResponse struct {
+	AccountID        string                `json:"account_id"`
+	Month            string                `json:"month" example:"2025-04"`
+	From             string                `json:"from" example:"2025-03-03"`
+	ClosingDate      string                `json:"closing_date" example:"2025-04-03"`
+	DueDate          string                `json:"due_date" example:"2025-04-10"`
+	Status           entities.FaturaStatus `json:"status" example:"open"`
+	Charges          string                `json:"charges" example:"[BRL (R$) 1250.00]"`
+	Credits          string                `json:"credits" example:"[BRL (R$) 0.00]"`
+	Total            string                `json:"total" example:"[BRL (R$) 1250.00]"`
+	TransactionCount int                   `json:"transaction_count"`
+	Transactions     []TransactionResponse `json:"transactions,omitempty"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/fatura_uc.go . FaturaUseCase
+type FaturaUseCase interface {
+	GetFaturas(ctx context.Context, accountID string) ([]entities.Fatura, error)
+	GetFatura(ctx context.Context, accountID string, month time.Time) (entities.Fatura, error)
+}
+
+// Fatura handlers
+
+// GetFaturas lists the faturas of a credit card
+//
+//	@Summary		List credit card faturas
+//	@Description	List the monthly statements (faturas) of a credit card account, newest first: the future ones holding installments, the open one and the closed ones. The account needs its statement closing and payment due days
+//	@Tags			accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string				true	"Account ID"
+//	@Success		200	{array}		FaturaResponse		"Faturas retrieved successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Account not found"
+//	@Failure		409	{object}	ErrorResponseBody	"Account without a billing cycle"
+//	@Router			/accounts/{id}/faturas [get]
+func (h *ApiHandlers) GetFaturas(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("id"))
+		return
+	}
+
+	faturas, err := h.FaturaUseCase.GetFaturas(r.Context(), id)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	responses := make([]FaturaResponse, len(faturas))
+	for i, fatura := range faturas {
+		responses[i] = faturaResponse(fatura)
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetFatura retrieves a fatura of a credit card
+//
+//	@Summary		Get credit card fatura
+//	@Description	Retrieve the fatura of a credit card account due in a month, with its transactions oldest first
+//	@Tags			accounts
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Account ID"
+//	@Param			month	path		string				true	"Month the fatura is due (YYYY-MM)"
+//	@Success		200		{object}	FaturaResponse		"Fatura retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody	"Account not found"
+//	@Failure		409		{object}	ErrorResponseBody	"Account without a billing cycle"
+//	@Router			/accounts/{id}/faturas/{month} [get]
+func (h *ApiHandlers) GetFatura(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("id"))
+		return
+	}
+
+	month, err := time.Parse("2006-01", chi.URLParam(r, "month"))
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("month", "must be in format YYYY-MM"))
+		return
+	}
+
+	fatura, err := h.FaturaUseCase.GetFatura(r.Context(), id, month)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := faturaResponse(fatura)
+	response.Transactions = make([]TransactionResponse, len(fatura.Transactions))
+	for i, transaction := range fatura.Transactions {
+		response.Transactions[i] = TransactionResponse{
+			ID:                transaction.ID,
+			AccountID:         transaction.AccountID,
+			CategoryID:        transaction.CategoryID,
+			Amount:            transaction.Monetary.String(),
+			Description:       transaction.Description,
+			Payee:             transaction.Payee,
+			Date:              transaction.Date.Format("2006-01-02"),
+			Status:            transaction.Status,
+			InstallmentPlanID: transaction.InstallmentPlanID,
+			InstallmentNumber: transaction.InstallmentNumber,
+			InstallmentCount:  transaction.InstallmentCount,
+			ProjectID:         transaction.ProjectID,
+			CreatedAt:         transaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			UpdatedAt:         transaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+
+	render.JSON(w, r, response)
+}
+
+func faturaResponse(fatura entities.Fatura) FaturaResponse {
+	return FaturaResponse{
+		AccountID:        fatura.Account.ID,
+		Month:            fatura.Month,
+		From:             fatura.From.Format("2006-01-02"),
+		ClosingDate:      fatura.ClosingDate.Format("2006-01-02"),
+		DueDate:          fatura.DueDate.Format("2006-01-02"),
+		Status:           fatura.Status,
+		Charges:          fatura.Charges.String(),
+		Credits:          fatura.Credits.String(),
+		Total:            fatura.Total.String(),
+		TransactionCount: len(fatura.Transactions),
+	}
+}
@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestFaturaHandlers(t *testing.T) {
+	const cardID = "6f1c2a3e-8b4d-4e5f-9a6b-7c8d9e0f1a2b"
+	const checkingID = "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+
+	amount, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(50000))
+	zero, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(0))
+	fatura := entities.Fatura{
+		Account:     entities.Account{ID: cardID},
+		Month:       "2025-04",
+		From:        time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC),
+		ClosingDate: time.Date(2025, time.April, 3, 0, 0, 0, 0, time.UTC),
+		DueDate:     time.Date(2025, time.April, 10, 0, 0, 0, 0, time.UTC),
+		Status:      entities.FaturaStatusOpen,
+		Transactions: []entities.Transaction{
+			{ID: "txn-1", AccountID: cardID, Monetary: *amount, Description: "TV (1/12)", Date: time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC), Status: entities.TransactionStatusCleared},
+		},
+		Charges: *amount,
+		Credits: *zero,
+		Total:   *amount,
+	}
+
+	var gotMonth time.Time
+	h := &ApiHandlers{
+		FaturaUseCase: &mocks.FaturaUseCaseMock{
+			GetFaturasFunc: func(ctx context.Context, accountID string) ([]entities.Fatura, error) {
+				if accountID == checkingID {
+					return nil, fmt.Errorf("account %s has no statement closing and payment due days: %w", accountID, domain.ErrConflict)
+				}
+				return []entities.Fatura{fatura}, nil
+			},
+			GetFaturaFunc: func(ctx context.Context, accountID string, month time.Time) (entities.Fatura, error) {
+				gotMonth = month
+				return fatura, nil
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	t.Run("lists the faturas", func(t *testing.T) {
+		rec := get("/api/v1/accounts/" + cardID + "/faturas")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		var response []FaturaResponse
+		json.NewDecoder(rec.Body).Decode(&response)
+		if len(response) != 1 || response[0].Month != "2025-04" || response[0].ClosingDate != "2025-04-03" || response[0].DueDate != "2025-04-10" {
+			t.Fatalf("unexpected response: %+v", response)
+		}
+		if response[0].TransactionCount != 1 || response[0].Transactions != nil || response[0].Total != "[BRL (R$) 500.00]" {
+			t.Errorf("unexpected fatura: %+v", response[0])
+		}
+	})
+
+	t.Run("gets a fatura", func(t *testing.T) {
+		rec := get("/api/v1/accounts/" + cardID + "/faturas/2025-04")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if !gotMonth.Equal(time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("unexpected month: %s", gotMonth)
+		}
+		var response FaturaResponse
+		json.NewDecoder(rec.Body).Decode(&response)
+		if len(response.Transactions) != 1 || response.Transactions[0].Description != "TV (1/12)" || response.Transactions[0].Date != "2025-03-20" {
+			t.Errorf("unexpected transactions: %+v", response.Transactions)
+		}
+	})
+
+	t.Run("rejects a malformed month", func(t *testing.T) {
+		if rec := get("/api/v1/accounts/" + cardID + "/faturas/april"); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("account without billing cycle", func(t *testing.T) {
+		if rec := get("/api/v1/accounts/" + checkingID + "/faturas"); rec.Code != http.StatusConflict {
+			t.Errorf("expected status 409, got %d", rec.Code)
+		}
+	})
+}
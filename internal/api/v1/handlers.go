@@ -1,57 +1,419 @@
 package v1
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"finance/domain"
+	"finance/domain/entities"
 	"fmt"
+	"io"
+	"math"
 	"net/http"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
+	"github.com/gofrs/uuid/v5"
 )
 
 type ApiHandlers struct {
-	AccountUseCase     AccountUseCase
-	CategoryUseCase    CategoryUseCase
-	TransactionUseCase TransactionUseCase
-	BalanceUseCase     BalanceUseCase
+	AssetUseCase             AssetUseCase
+	BookUseCase              BookUseCase
+	AccountUseCase           AccountUseCase
+	FaturaUseCase            FaturaUseCase
+	CategoryUseCase          CategoryUseCase
+	TransactionUseCase       TransactionUseCase
+	TransactionExportUseCase TransactionExportUseCase
+	InstallmentUseCase       InstallmentUseCase
+	ExpenseReportUseCase     ExpenseReportUseCase
+	InvoiceUseCase           InvoiceUseCase
+	ProjectUseCase           ProjectUseCase
+	TagUseCase               TagUseCase
+	AttachmentUseCase        AttachmentUseCase
+	BudgetUseCase            BudgetUseCase
+	QuickCaptureUseCase      QuickCaptureUseCase
+	ImportUseCase            ImportUseCase
+	TransactionScriptUseCase TransactionScriptUseCase
+	RestorePointUseCase      RestorePointUseCase
+	UserUseCase              UserUseCase
+	BalanceUseCase           BalanceUseCase
+	SettingsUseCase          SettingsUseCase
+	SummaryUseCase           SummaryUseCase
+	ReportUseCase            ReportUseCase
+	CustomReportUseCase      CustomReportUseCase
+	SimulationUseCase        SimulationUseCase
+	QueryUseCase             QueryUseCase
+	UserSettingsUseCase      UserSettingsUseCase
+	OnboardingUseCase        OnboardingUseCase
+	DemoUseCase              DemoUseCase
+	MigrationUseCase         MigrationUseCase
+	UsageUseCase             UsageUseCase
+	IdempotencyUseCase       IdempotencyUseCase
+	DebugLogging             DebugLogging
+	LogLevel                 LogLevel
+	RouteStats               RouteStats
+	// Events feeds the WebSocket API, which is off unless WebSocketEnabled
+	Events           EventHub
+	WebSocketEnabled bool
+	// AdminEmails are the emails of the operators of the deployment, the
+	// only users requireAdmin lets through
+	AdminEmails []string
+	// TrustedProxies are the proxies whose X-Forwarded-For tells the address
+	// of the client, on top of the loopback addresses and the web app in
+	// process
+	TrustedProxies []netip.Prefix
 }
 
 func (h *ApiHandlers) Routes(r chi.Router) {
 	r.Get("/health", h.Health)
+
+	// Runtime and worker metrics, for the operators only
+	r.With(h.authenticate, h.requireAdmin).Get("/debug/vars", expvar.Handler().ServeHTTP)
+
+	// Auth routes, the only ones reachable without a token
+	r.Route("/api/v1/auth", func(r chi.Router) {
+		r.Post("/register", h.Register)
+		r.Post("/login", h.Login)
+		r.Group(func(r chi.Router) {
+			r.Use(h.authenticate)
+			r.Get("/me", h.GetCurrentUser)
+			r.Post("/logout", h.Logout)
+			r.Get("/events", h.GetAuthEvents)
+			r.Get("/sessions", h.GetSessions)
+			r.Delete("/sessions", h.RevokeOtherSessions)
+			r.With(validateUUIDParams("id")).Delete("/sessions/{id}", h.RevokeSession)
+		})
+	})
+
+	// Realtime routes, authenticating the user's token on the connection
+	// since browsers can't set headers on WebSockets
+	r.Get("/api/v1/ws", h.WebSocket)
+
 	r.Route("/api/v1", func(r chi.Router) {
+		r.Use(h.authenticate, h.meterUsage, h.bookScope, h.idempotent)
+
+		// Asset routes
+		r.Route("/assets", func(r chi.Router) {
+			r.Get("/", h.GetAssets)
+			r.With(h.requireAdmin).Post("/", h.CreateCustomAsset)
+		})
+
+		// Book routes
+		r.Route("/books", func(r chi.Router) {
+			r.Post("/", h.CreateBook)
+			r.Get("/", h.GetBooks)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetBook)
+				r.Put("/", h.UpdateBook)
+				r.Delete("/", h.DeleteBook)
+			})
+		})
 
 		// Account routes
 		r.Route("/accounts", func(r chi.Router) {
 			r.Post("/", h.CreateAccount)
 			r.Get("/", h.GetAllAccounts)
-			r.Get("/{id}", h.GetAccountByID)
-			r.Put("/{id}", h.UpdateAccount)
-			r.Delete("/{id}", h.DeleteAccount)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetAccountByID)
+				r.Put("/", h.UpdateAccount)
+				r.Delete("/", h.DeleteAccount)
+				r.Get("/statement", h.GetAccountStatement)
+				r.Get("/period-summary", h.GetAccountPeriodSummary)
+				r.Get("/faturas", h.GetFaturas)
+				r.Get("/faturas/{month}", h.GetFatura)
+			})
 		})
 
 		// Category routes
 		r.Route("/categories", func(r chi.Router) {
 			r.Post("/", h.CreateCategory)
 			r.Get("/", h.GetAllCategories)
-			r.Get("/{id}", h.GetCategoryByID)
-			r.Put("/{id}", h.UpdateCategory)
-			r.Delete("/{id}", h.DeleteCategory)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetCategoryByID)
+				r.Put("/", h.UpdateCategory)
+				r.Delete("/", h.DeleteCategory)
+			})
 		})
 
 		// Transaction routes
 		r.Route("/transactions", func(r chi.Router) {
 			r.Post("/", h.CreateTransaction)
 			r.Get("/", h.GetAllTransactions)
-			r.Get("/{id}", h.GetTransactionByID)
-			r.Put("/{id}", h.UpdateTransaction)
-			r.Delete("/{id}", h.DeleteTransaction)
+			r.Post("/installments", h.CreateInstallmentPurchase)
+			r.Post("/import", h.ImportTransactions)
+			r.Get("/export", h.ExportTransactions)
+			r.Get("/trash", h.GetTrash)
+			r.Get("/search", h.SearchTransactions)
+			r.Post("/bulk", h.BulkTransactions)
+			r.Get("/attachments/archive", h.ArchiveAttachments)
+			r.Get("/attachments/archive/{archiveId}", h.DownloadAttachmentArchive)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetTransactionByID)
+				r.Put("/", h.UpdateTransaction)
+				r.Delete("/", h.DeleteTransaction)
+				r.Post("/duplicate", h.DuplicateTransaction)
+				r.Post("/finalize", h.FinalizeTransaction)
+				r.Post("/discard", h.DiscardDraft)
+				r.Post("/restore", h.RestoreTransaction)
+				r.Route("/attachments", func(r chi.Router) {
+					r.Post("/", h.AddAttachment)
+					r.Get("/", h.GetAttachments)
+					r.Route("/{attachmentId}", func(r chi.Router) {
+						r.Use(validateUUIDParams("attachmentId"))
+						r.Get("/", h.DownloadAttachment)
+						r.Get("/thumbnail", h.DownloadAttachmentThumbnail)
+						r.Delete("/", h.DeleteAttachment)
+					})
+				})
+			})
+		})
+
+		// Export routes
+		r.Route("/exports", func(r chi.Router) {
+			r.Post("/", h.CreateExport)
+			r.Get("/", h.GetExports)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetExport)
+				r.Delete("/", h.DeleteExport)
+				r.Get("/download", h.DownloadExport)
+			})
+		})
+
+		// Installment plan routes
+		r.Route("/installments", func(r chi.Router) {
+			r.Get("/", h.GetInstallmentPlans)
+			r.Get("/commitments", h.GetInstallmentCommitments)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetInstallmentPlan)
+				r.Delete("/", h.DeleteInstallmentPlan)
+				r.Get("/payoff", h.QuoteInstallmentPayoff)
+				r.Post("/payoff", h.PayOffInstallmentPlan)
+			})
+		})
+
+		// Expense report routes
+		r.Route("/expense-reports", func(r chi.Router) {
+			r.Post("/", h.CreateExpenseReport)
+			r.Get("/", h.GetExpenseReports)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetExpenseReport)
+				r.Put("/", h.UpdateExpenseReport)
+				r.Delete("/", h.DeleteExpenseReport)
+				r.Post("/transactions", h.AddExpenseReportTransaction)
+				r.With(validateUUIDParams("transaction_id")).Delete("/transactions/{transaction_id}", h.RemoveExpenseReportTransaction)
+				r.Post("/submit", h.SubmitExpenseReport)
+				r.Post("/approve", h.ApproveExpenseReport)
+				r.Post("/reject", h.RejectExpenseReport)
+				r.Post("/reopen", h.ReopenExpenseReport)
+				r.Get("/export", h.ExportExpenseReport)
+			})
+		})
+
+		// Invoice routes
+		r.Route("/invoices", func(r chi.Router) {
+			r.Post("/", h.CreateInvoice)
+			r.Get("/", h.GetInvoices)
+			r.Get("/receivables", h.GetReceivables)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetInvoice)
+				r.Put("/", h.UpdateInvoice)
+				r.Delete("/", h.DeleteInvoice)
+				r.Post("/match", h.MatchInvoice)
+				r.Delete("/match", h.UnmatchInvoice)
+			})
+		})
+
+		// Project routes
+		r.Route("/projects", func(r chi.Router) {
+			r.Post("/", h.CreateProject)
+			r.Get("/", h.GetProjects)
+			r.Get("/profit", h.GetProjectsProfit)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetProject)
+				r.Put("/", h.UpdateProject)
+				r.Delete("/", h.DeleteProject)
+				r.Get("/profit", h.GetProjectProfit)
+			})
+		})
+
+		// Tag routes
+		r.Route("/tags", func(r chi.Router) {
+			r.Post("/", h.CreateTag)
+			r.Get("/", h.GetTags)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetTag)
+				r.Put("/", h.UpdateTag)
+				r.Delete("/", h.DeleteTag)
+			})
+		})
+
+		// Transaction script routes
+		r.Route("/scripts", func(r chi.Router) {
+			r.Post("/", h.CreateTransactionScript)
+			r.Get("/", h.GetTransactionScripts)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetTransactionScript)
+				r.Put("/", h.UpdateTransactionScript)
+				r.Delete("/", h.DeleteTransactionScript)
+			})
+		})
+
+		// Budget routes
+		r.Route("/budgets", func(r chi.Router) {
+			r.Post("/", h.CreateBudget)
+			r.Get("/", h.GetBudgets)
+			r.Get("/progress", h.GetBudgetProgress)
+			r.Get("/alerts", h.GetBudgetAlerts)
+			r.Post("/copy", h.CopyBudgets)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetBudget)
+				r.Put("/", h.UpdateBudget)
+				r.Delete("/", h.DeleteBudget)
+			})
+		})
+		r.Route("/budget-templates", func(r chi.Router) {
+			r.Post("/", h.CreateBudgetTemplate)
+			r.Get("/", h.GetBudgetTemplates)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetBudgetTemplate)
+				r.Put("/", h.UpdateBudgetTemplate)
+				r.Delete("/", h.DeleteBudgetTemplate)
+				r.Post("/apply", h.ApplyBudgetTemplate)
+			})
+		})
+
+		// Usage routes
+		r.Get("/usage", h.GetUsage)
+
+		// Quick capture routes
+		r.Post("/quick", h.QuickCapture)
+
+		// Statement import routes
+		r.Post("/imports/{source}", h.ImportStatement)
+
+		// Restore point routes
+		r.Route("/restore-points", func(r chi.Router) {
+			r.Get("/", h.GetRestorePoints)
+			r.Route("/{id}", func(r chi.Router) {
+				r.Use(validateUUIDParams("id"))
+				r.Get("/", h.GetRestorePoint)
+				r.Post("/rollback", h.RollbackRestorePoint)
+			})
 		})
 
 		// Balance routes
 		r.Route("/balances", func(r chi.Router) {
 			r.Get("/", h.GetAllBalances)
 			r.Get("/summary", h.GetBalanceSummary)
-			r.Get("/{accountId}", h.GetBalanceByAccountID)
-			r.Post("/{accountId}/refresh", h.RefreshAccountBalance)
+			r.Get("/grouped", h.GetGroupedBalances)
+			r.Post("/refresh", h.RefreshAllBalances)
+			r.Get("/refresh-status", h.GetBalanceRefreshStatus)
+			r.Route("/{accountId}", func(r chi.Router) {
+				r.Use(validateUUIDParams("accountId"))
+				r.Get("/", h.GetBalanceByAccountID)
+				r.Post("/refresh", h.RefreshAccountBalance)
+				r.Get("/history", h.GetBalanceHistory)
+			})
+		})
+
+		// Summary routes
+		r.Route("/summary", func(r chi.Router) {
+			r.Get("/minimal", h.GetMinimalSummary)
+		})
+
+		// Report routes
+		r.Route("/reports", func(r chi.Router) {
+			r.Get("/budget-history", h.GetBudgetHistory)
+			r.Get("/cashflow", h.GetCashFlow)
+			r.Get("/commitments", h.GetCommitments)
+			r.Get("/consolidated", h.GetConsolidatedReport)
+			r.Get("/cube", h.GetCube)
+			r.Get("/net-worth", h.GetNetWorthHistory)
+			r.Get("/spending", h.GetSpending)
+			r.Get("/monthly/{month}", h.GetMonthlyReport)
+			r.Post("/monthly/{month}/close", h.CloseMonth)
+			r.Post("/monthly/{month}/recalculate", h.RecalculateMonthlyReport)
+			r.Route("/custom", func(r chi.Router) {
+				r.Post("/", h.CreateReportDefinition)
+				r.Get("/", h.GetReportDefinitions)
+				r.Post("/run", h.RunReportDefinition)
+				r.Route("/{id}", func(r chi.Router) {
+					r.Use(validateUUIDParams("id"))
+					r.Get("/", h.GetReportDefinition)
+					r.Put("/", h.UpdateReportDefinition)
+					r.Delete("/", h.DeleteReportDefinition)
+					r.Get("/run", h.RunReport)
+				})
+			})
+		})
+
+		// Query routes
+		r.Post("/query", h.Query)
+
+		// Simulation routes
+		r.Post("/simulations", h.Simulate)
+
+		// Settings routes
+		r.Route("/settings", func(r chi.Router) {
+			r.Get("/", h.GetSettings)
+			r.With(h.requireAdmin).Put("/", h.UpdateSettings)
+		})
+
+		// User settings routes
+		r.Route("/user-settings", func(r chi.Router) {
+			r.Get("/", h.GetUserSettings)
+			r.Put("/", h.UpdateUserSettings)
+		})
+
+		// Onboarding routes
+		r.Route("/onboarding", func(r chi.Router) {
+			r.Get("/status", h.GetOnboardingStatus)
+		})
+
+		// Demo routes
+		r.Route("/demo", func(r chi.Router) {
+			r.Use(h.requireAdmin)
+			r.Get("/", h.GetDemoStatus)
+			r.Post("/", h.StartDemo)
+			r.Delete("/", h.StopDemo)
+		})
+
+		// Admin routes
+		r.Route("/admin", func(r chi.Router) {
+			r.Use(h.requireAdmin)
+			r.Get("/debug-logging", h.GetDebugLogging)
+			r.Put("/debug-logging", h.UpdateDebugLogging)
+			r.Get("/log-level", h.GetLogLevel)
+			r.Put("/log-level", h.UpdateLogLevel)
+			r.Get("/stats", h.GetStats)
+			r.Get("/migrations", h.GetMigrationStatus)
+		})
+
+		// Meta routes
+		r.Route("/meta", func(r chi.Router) {
+			r.Get("/schema", h.GetSchema)
 		})
 	})
 }
@@ -70,14 +432,68 @@ func (h *ApiHandlers) Health(w http.ResponseWriter, r *http.Request) {
 }
 
 type ErrorResponseBody struct {
-	Error string `json:"error"`
+	Error     string `json:"error"`
+	Parameter string `json:"parameter,omitempty"`
+	// Quota is the quota a request would have gone over
+	Quota *QuotaResponse `json:"quota,omitempty"`
+	// Operations are the operations of a bulk request that failed
+	Operations []BulkFailureResponse `json:"operations,omitempty"`
+}
+
+// QuotaResponse tells which quota of the user's tier was reached, and when it
+// resets for the monthly ones
+type QuotaResponse struct {
+	Name     string     `json:"name" example:"monthly_transactions"`
+	Limit    int        `json:"limit" example:"500"`
+	ResetsAt *time.Time `json:"resets_at,omitempty"`
 }
 
 func errorResponse(w http.ResponseWriter, r *http.Request, code int, err error) {
-	render.Status(r, code)
-	render.JSON(w, r, ErrorResponseBody{
+	body := ErrorResponseBody{
 		Error: err.Error(),
-	})
+	}
+	var quota *domain.QuotaError
+	if errors.As(err, &quota) {
+		body.Quota = &QuotaResponse{Name: quota.Quota, Limit: quota.Limit}
+		if !quota.ResetsAt.IsZero() {
+			body.Quota.ResetsAt = &quota.ResetsAt
+			retryAfter := max(int(math.Ceil(time.Until(quota.ResetsAt).Seconds())), 1)
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		}
+	}
+	var bulk *domain.BulkError
+	if errors.As(err, &bulk) {
+		body.Operations = make([]BulkFailureResponse, len(bulk.Failures))
+		for i, failure := range bulk.Failures {
+			body.Operations[i] = BulkFailureResponse{Index: failure.Index, Error: failure.Err.Error()}
+		}
+	}
+
+	render.Status(r, code)
+	render.JSON(w, r, body)
+}
+
+// errorStatus maps the domain errors to their status code, using fallback for
+// anything else
+func errorStatus(err error, fallback int) int {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrMalformedParameters):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrUnauthorized), errors.Is(err, domain.ErrCaptchaRequired):
+		return http.StatusUnauthorized
+	case errors.Is(err, domain.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, domain.ErrTooManyRequests):
+		return http.StatusTooManyRequests
+	case errors.Is(err, domain.ErrQuotaExceeded):
+		return http.StatusPaymentRequired
+	default:
+		return fallback
+	}
 }
 
 func unknownErrorResponse(w http.ResponseWriter, r *http.Request) {
@@ -90,10 +506,343 @@ func errMissingParameter(param string) error {
 	return fmt.Errorf("missing required parameter: %s", param)
 }
 
-func errNotFound(resource string) error {
-	return fmt.Errorf("%s not found", resource)
-}
-
 func errInvalidParameter(param, value string) error {
 	return fmt.Errorf("invalid parameter %s: %s", param, value)
 }
+
+const (
+	// maxRequestBodyBytes caps the size of the JSON request bodies, far above
+	// anything the API legitimately accepts
+	maxRequestBodyBytes = 1 << 20
+	// maxRequestBodyDepth caps how deeply the JSON request bodies may nest
+	maxRequestBodyDepth = 32
+)
+
+var (
+	errRequestBodyTooLarge = fmt.Errorf("request body must not be larger than %d bytes", maxRequestBodyBytes)
+	errRequestBodyTooDeep  = fmt.Errorf("request body must not be nested more than %d levels deep", maxRequestBodyDepth)
+	errRequestBodyTrailing = errors.New("request body must contain a single JSON value")
+)
+
+// decodeJSON decodes the request body into dst, rejecting bodies that are too
+// large or too deeply nested, fields dst doesn't know and trailing data. An
+// empty body returns io.EOF, so endpoints with an optional body can accept it
+func decodeJSON(w http.ResponseWriter, r *http.Request, dst any) error {
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBodyBytes))
+	if err != nil {
+		return err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return io.EOF
+	}
+	if jsonDepthExceeds(data, maxRequestBodyDepth) {
+		return errRequestBodyTooDeep
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(dst); err != nil {
+		return err
+	}
+	if decoder.More() {
+		return errRequestBodyTrailing
+	}
+	return nil
+}
+
+// jsonDepthExceeds reports whether the objects and arrays in data nest deeper
+// than max, skipping over brackets inside strings
+func jsonDepthExceeds(data []byte, max int) bool {
+	depth := 0
+	inString, escaped := false, false
+	for _, c := range data {
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '{', '[':
+			depth++
+			if depth > max {
+				return true
+			}
+		case '}', ']':
+			depth--
+		}
+	}
+	return false
+}
+
+// bodyErrorResponse renders the error returned by decodeJSON, answering 413
+// for oversized bodies and 400 otherwise, naming the offending field when
+// there is one
+func bodyErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
+	var (
+		maxBytesErr *http.MaxBytesError
+		syntaxErr   *json.SyntaxError
+		typeErr     *json.UnmarshalTypeError
+		status      = http.StatusBadRequest
+		parameter   string
+	)
+	switch {
+	case errors.As(err, &maxBytesErr):
+		status, err = http.StatusRequestEntityTooLarge, errRequestBodyTooLarge
+	case errors.Is(err, io.EOF):
+		err = errors.New("request body must not be empty")
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		err = errors.New("request body contains malformed JSON")
+	case errors.As(err, &syntaxErr):
+		err = fmt.Errorf("request body contains malformed JSON at offset %d", syntaxErr.Offset)
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		parameter = typeErr.Field
+		err = errInvalidParameter(parameter, "must be a "+typeErr.Type.String())
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		// encoding/json has no typed error for unknown fields
+		parameter = strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		err = errInvalidParameter(parameter, "unknown field")
+	}
+
+	render.Status(r, status)
+	render.JSON(w, r, ErrorResponseBody{
+		Error:     err.Error(),
+		Parameter: parameter,
+	})
+}
+
+// validateUUIDParams rejects requests whose URL parameters aren't canonical UUIDs
+// before they reach the handlers, naming the offending parameter in the response
+func validateUUIDParams(params ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, param := range params {
+				value := chi.URLParam(r, param)
+				id, err := uuid.FromString(value)
+				if err != nil || id.String() != strings.ToLower(value) {
+					render.Status(r, http.StatusBadRequest)
+					render.JSON(w, r, ErrorResponseBody{
+						Error:     errInvalidParameter(param, "must be a valid UUID").Error(),
+						Parameter: param,
+					})
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// parseInclude reads the comma separated include query parameter, rejecting
+// relations the endpoint doesn't know how to embed
+func parseInclude(r *http.Request, allowed ...string) (map[string]bool, error) {
+	include := map[string]bool{}
+	for _, relation := range strings.Split(r.URL.Query().Get("include"), ",") {
+		relation = strings.TrimSpace(relation)
+		if relation == "" {
+			continue
+		}
+		if !slices.Contains(allowed, relation) {
+			return nil, errInvalidParameter("include", relation)
+		}
+		include[relation] = true
+	}
+	return include, nil
+}
+
+// parseDateParam parses an optional YYYY-MM-DD query parameter, returning the zero
+// time when it's absent
+func parseDateParam(r *http.Request, param string) (time.Time, error) {
+	value := r.URL.Query().Get(param)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, errInvalidParameter(param, value)
+	}
+	return date, nil
+}
+
+// parseUUIDParam reads an optional UUID query parameter, empty when it's not
+// given
+func parseUUIDParam(r *http.Request, param string) (string, error) {
+	value := r.URL.Query().Get(param)
+	if value == "" {
+		return "", nil
+	}
+
+	id, err := uuid.FromString(value)
+	if err != nil {
+		return "", errInvalidParameter(param, "must be a valid UUID")
+	}
+	return id.String(), nil
+}
+
+// parseFields reads the comma separated fields query parameter, rejecting
+// names that aren't JSON fields of the response type
+func parseFields(r *http.Request, response any) ([]string, error) {
+	known := map[string]bool{}
+	t := reflect.TypeOf(response)
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			known[name] = true
+		}
+	}
+
+	var fields []string
+	for _, field := range strings.Split(r.URL.Query().Get("fields"), ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if !known[field] {
+			return nil, errInvalidParameter("fields", field)
+		}
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
+const (
+	// defaultPageLimit is the page size of the list endpoints without a limit
+	defaultPageLimit = 50
+	// maxPageLimit caps the page size of the list endpoints
+	maxPageLimit = 500
+)
+
+// PageResponse is a page of a list endpoint, Limit items after the first
+// Offset ones out of Total. Next and Prev link the following and preceding
+// pages keeping the other query parameters, and are left out at the ends of
+// the list.
+type PageResponse[T any] struct {
+	Items  []T    `json:"items"`
+	Total  int64  `json:"total" example:"120"`
+	Limit  int    `json:"limit" example:"50"`
+	Offset int    `json:"offset" example:"50"`
+	Next   string `json:"next,omitempty" example:"/api/v1/transactions?limit=50&offset=100"`
+	Prev   string `json:"prev,omitempty" example:"/api/v1/transactions?limit=50&offset=0"`
+}
+
+// parsePage reads the limit and offset query parameters of the list
+// endpoints, the limit defaulting to defaultPageLimit
+func parsePage(r *http.Request) (int, int, error) {
+	limit, offset := defaultPageLimit, 0
+
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			return 0, 0, errInvalidParameter("limit", fmt.Sprintf("must be between 1 and %d", maxPageLimit))
+		}
+		limit = parsed
+	}
+
+	if value := r.URL.Query().Get("offset"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return 0, 0, errInvalidParameter("offset", "must be a non-negative integer")
+		}
+		offset = parsed
+	}
+
+	return limit, offset, nil
+}
+
+// pageLink links the page starting at offset, keeping the other query
+// parameters of the request
+func pageLink(r *http.Request, limit, offset int) string {
+	query := r.URL.Query()
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("offset", strconv.Itoa(offset))
+	return r.URL.Path + "?" + query.Encode()
+}
+
+// renderPage renders the items of a page in the paginated envelope, keeping
+// only the requested fields of each item when fields were requested
+func renderPage[T, E any](w http.ResponseWriter, r *http.Request, page entities.Page[E], items []T, fields []string) {
+	var next, prev string
+	if page.HasNext() {
+		next = pageLink(r, page.Limit, page.Offset+page.Limit)
+	}
+	if page.Offset > 0 {
+		prev = pageLink(r, page.Limit, max(page.Offset-page.Limit, 0))
+	}
+
+	if len(fields) == 0 {
+		render.JSON(w, r, PageResponse[T]{Items: items, Total: page.Total, Limit: page.Limit, Offset: page.Offset, Next: next, Prev: prev})
+		return
+	}
+
+	sparse := make([]map[string]json.RawMessage, len(items))
+	for i, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			errorResponse(w, r, http.StatusInternalServerError, err)
+			return
+		}
+
+		var all map[string]json.RawMessage
+		if err := json.Unmarshal(data, &all); err != nil {
+			errorResponse(w, r, http.StatusInternalServerError, err)
+			return
+		}
+
+		sparse[i] = make(map[string]json.RawMessage, len(fields))
+		for _, field := range fields {
+			if value, ok := all[field]; ok {
+				sparse[i][field] = value
+			}
+		}
+	}
+
+	render.JSON(w, r, PageResponse[map[string]json.RawMessage]{Items: sparse, Total: page.Total, Limit: page.Limit, Offset: page.Offset, Next: next, Prev: prev})
+}
+
+// renderWithETag renders v as JSON tagged with a hash of the body, answering
+// 304 Not Modified without the body when If-None-Match already has it, so
+// polling clients only download what changed
+func renderWithETag(w http.ResponseWriter, r *http.Request, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	sum := sha256.Sum256(data)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	// Caches may keep it but must check it's still current before using it
+	w.Header().Set("Cache-Control", "no-cache")
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// etagMatches reports whether the If-None-Match header lists etag, comparing
+// weakly as RFC 9110 asks for If-None-Match
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,213 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestValidateUUIDParams(t *testing.T) {
+	const validID = "3f2b1c4e-8a6d-4e1f-9b7a-2c5d8e0f1a3b"
+
+	h := &ApiHandlers{
+		AccountUseCase: &mocks.AccountUseCaseMock{
+			GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
+				return entities.Account{ID: id}, nil
+			},
+		},
+		TransactionUseCase: &mocks.TransactionUseCaseMock{},
+		BalanceUseCase: &mocks.BalanceUseCaseMock{
+			GetBalanceSummaryFunc: func(ctx context.Context) (entities.BalanceSummary, error) {
+				return entities.BalanceSummary{}, errors.New("unavailable")
+			},
+			GetBalanceRefreshStatusFunc: func(ctx context.Context) (entities.BalanceRefreshStatus, error) {
+				return entities.BalanceRefreshStatus{}, fmt.Errorf("balance refresh %w", domain.ErrNotFound)
+			},
+		},
+	}
+
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	tests := []struct {
+		name      string
+		method    string
+		path      string
+		wantCode  int
+		wantParam string
+	}{
+		{
+			name:     "valid account id",
+			method:   http.MethodGet,
+			path:     "/api/v1/accounts/" + validID,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:      "invalid account id",
+			method:    http.MethodGet,
+			path:      "/api/v1/accounts/not-a-uuid",
+			wantCode:  http.StatusBadRequest,
+			wantParam: "id",
+		},
+		{
+			name:      "non canonical transaction id",
+			method:    http.MethodDelete,
+			path:      "/api/v1/transactions/urn:uuid:" + validID,
+			wantCode:  http.StatusBadRequest,
+			wantParam: "id",
+		},
+		{
+			name:      "invalid balance account id",
+			method:    http.MethodPost,
+			path:      "/api/v1/balances/123/refresh",
+			wantCode:  http.StatusBadRequest,
+			wantParam: "accountId",
+		},
+		{
+			name:     "static route next to a parameter",
+			method:   http.MethodGet,
+			path:     "/api/v1/balances/summary",
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:     "refresh status next to a parameter",
+			method:   http.MethodGet,
+			path:     "/api/v1/balances/refresh-status",
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantCode, w.Code, w.Body.String())
+			}
+
+			if tt.wantParam == "" {
+				return
+			}
+
+			var response ErrorResponseBody
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if response.Parameter != tt.wantParam {
+				t.Errorf("expected parameter %q, got %q", tt.wantParam, response.Parameter)
+			}
+			if response.Error == "" {
+				t.Error("expected an error message")
+			}
+		})
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Name string   `json:"name"`
+			Tags []string `json:"tags"`
+		}
+		if err := decodeJSON(w, r, &req); err != nil {
+			bodyErrorResponse(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name      string
+		body      string
+		wantCode  int
+		wantParam string
+	}{
+		{
+			name:     "valid body",
+			body:     `{"name":"groceries","tags":["food"]}`,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "brackets inside strings",
+			body:     `{"name":"` + strings.Repeat("[", 100) + `"}`,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "empty body",
+			body:     "",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:      "unknown field",
+			body:      `{"name":"groceries","owner":"someone"}`,
+			wantCode:  http.StatusBadRequest,
+			wantParam: "owner",
+		},
+		{
+			name:      "wrong field type",
+			body:      `{"name":42}`,
+			wantCode:  http.StatusBadRequest,
+			wantParam: "name",
+		},
+		{
+			name:     "malformed JSON",
+			body:     `{"name":`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "trailing data",
+			body:     `{"name":"groceries"}{"name":"rent"}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "too deeply nested",
+			body:     `{"tags":` + strings.Repeat("[", maxRequestBodyDepth+1) + strings.Repeat("]", maxRequestBodyDepth+1) + `}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "too large",
+			body:     `{"name":"` + strings.Repeat("a", maxRequestBodyBytes) + `"}`,
+			wantCode: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantCode, w.Code, w.Body.String())
+			}
+			if tt.wantCode == http.StatusOK {
+				return
+			}
+
+			var response ErrorResponseBody
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if response.Parameter != tt.wantParam {
+				t.Errorf("expected parameter %q, got %q", tt.wantParam, response.Parameter)
+			}
+			if response.Error == "" {
+				t.Error("expected an error message")
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"finance/domain/entities"
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+const (
+	// IdempotencyKeyHeader makes a write safe to retry, its retries getting
+	// the response to the first request made with the key
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks the responses replayed to a retry
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/idempotency_uc.go . IdempotencyUseCase
+type IdempotencyUseCase interface {
+	Begin(ctx context.Context, key, fingerprint string) (*entities.IdempotentResponse, error)
+	Complete(ctx context.Context, key string, response entities.IdempotentResponse) error
+	Release(ctx context.Context, key string) error
+}
+
+// idempotent handles the writes made with an Idempotency-Key header once,
+// replaying the response to the first request to its retries. The response is
+// kept even when the client gave up waiting for it, and server errors aren't
+// kept so the retries are handled again. Bodies are limited to
+// maxRequestBodyBytes.
+func (h *ApiHandlers) idempotent(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if h.IdempotencyUseCase == nil || key == "" || !isWrite(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// The body is held in memory to fingerprint it, so it's capped
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBodyBytes))
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			errorResponse(w, r, http.StatusRequestEntityTooLarge, errRequestBodyTooLarge)
+			return
+		} else if err != nil {
+			errorResponse(w, r, http.StatusBadRequest, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		replay, err := h.IdempotencyUseCase.Begin(r.Context(), key, requestFingerprint(r, body))
+		if err != nil {
+			errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+			return
+		}
+		if replay != nil {
+			if replay.ContentType != "" {
+				w.Header().Set("Content-Type", replay.ContentType)
+			}
+			w.Header().Set(IdempotentReplayedHeader, "true")
+			w.WriteHeader(replay.StatusCode)
+			w.Write(replay.Body)
+			return
+		}
+
+		// The request is over for the client, not for its retries
+		ctx := context.WithoutCancel(r.Context())
+		recorder := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		defer func() {
+			if p := recover(); p != nil {
+				h.releaseIdempotencyKey(ctx, key)
+				panic(p)
+			}
+		}()
+		next.ServeHTTP(recorder, r)
+
+		if recorder.status >= http.StatusInternalServerError {
+			h.releaseIdempotencyKey(ctx, key)
+			return
+		}
+		response := entities.IdempotentResponse{
+			StatusCode:  recorder.status,
+			ContentType: w.Header().Get("Content-Type"),
+			Body:        recorder.body.Bytes(),
+		}
+		if err := h.IdempotencyUseCase.Complete(ctx, key, response); err != nil {
+			slog.Error("failed to save idempotent response", "error", err)
+		}
+	})
+}
+
+func (h *ApiHandlers) releaseIdempotencyKey(ctx context.Context, key string) {
+	if err := h.IdempotencyUseCase.Release(ctx, key); err != nil {
+		slog.Error("failed to release idempotency key", "error", err)
+	}
+}
+
+func isWrite(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// requestFingerprint tells apart the requests made with the same key, by
+// what they change and in which book
+func requestFingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	io.WriteString(hash, r.Method+" "+r.URL.RequestURI()+"\n"+r.Header.Get(BookHeader)+"\n")
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// responseRecorder keeps a copy of the response it writes through, up to
+// the largest one replayed
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (rec *responseRecorder) WriteHeader(status int) {
+	if !rec.wroteHeader {
+		rec.status = status
+		rec.wroteHeader = true
+	}
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *responseRecorder) Write(b []byte) (int, error) {
+	rec.wroteHeader = true
+	if rec.body.Len() <= entities.MaxIdempotentResponseSize {
+		rec.body.Write(b)
+	}
+	return rec.ResponseWriter.Write(b)
+}
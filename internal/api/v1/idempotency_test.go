@@ -0,0 +1,158 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestIdempotentWrites(t *testing.T) {
+	created := 0
+	transactionUC := &mocks.TransactionUseCaseMock{
+		CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+			created++
+			amount, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(4590))
+			transaction.ID = fmt.Sprintf("tx-%d", created)
+			transaction.Monetary = *amount
+			return transaction, nil
+		},
+	}
+
+	// The keys recorded so far, with the fingerprint of their request
+	type stored struct {
+		fingerprint string
+		response    *entities.IdempotentResponse
+	}
+	keys := map[string]*stored{}
+	idempotencyUC := &mocks.IdempotencyUseCaseMock{
+		BeginFunc: func(ctx context.Context, key, fingerprint string) (*entities.IdempotentResponse, error) {
+			request, ok := keys[key]
+			if !ok {
+				keys[key] = &stored{fingerprint: fingerprint}
+				return nil, nil
+			}
+			if request.fingerprint != fingerprint {
+				return nil, fmt.Errorf("idempotency key %q was used for another request: %w", key, domain.ErrMalformedParameters)
+			}
+			return request.response, nil
+		},
+		CompleteFunc: func(ctx context.Context, key string, response entities.IdempotentResponse) error {
+			keys[key].response = &response
+			return nil
+		},
+		ReleaseFunc: func(ctx context.Context, key string) error {
+			delete(keys, key)
+			return nil
+		},
+	}
+	h := &ApiHandlers{TransactionUseCase: transactionUC, IdempotencyUseCase: idempotencyUC}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	create := func(key, description string) *httptest.ResponseRecorder {
+		body := `{"account_id": "acc-1", "category_id": "cat-1", "amount": "45.90", "description": "` + description + `", "date": "2025-03-10"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/transactions", strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("retries get the first response", func(t *testing.T) {
+		first := create("key-1", "Market")
+		if first.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", first.Code, first.Body)
+		}
+		retry := create("key-1", "Market")
+		if retry.Code != http.StatusCreated || retry.Body.String() != first.Body.String() {
+			t.Errorf("expected the first response, got %d: %s", retry.Code, retry.Body)
+		}
+		if retry.Header().Get(IdempotentReplayedHeader) != "true" || retry.Header().Get("Content-Type") != first.Header().Get("Content-Type") {
+			t.Errorf("unexpected headers of the replay: %v", retry.Header())
+		}
+		if created != 1 {
+			t.Errorf("expected 1 transaction created, got %d", created)
+		}
+	})
+
+	t.Run("another request with the key", func(t *testing.T) {
+		if rec := create("key-1", "Pharmacy"); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("server errors are handled again", func(t *testing.T) {
+		failing := h.idempotent(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			errorResponse(w, r, http.StatusInternalServerError, errors.New("connection reset"))
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/transactions", strings.NewReader(`{}`))
+		req.Header.Set(IdempotencyKeyHeader, "key-2")
+		rec := httptest.NewRecorder()
+		failing.ServeHTTP(rec, req)
+		if rec.Code != http.StatusInternalServerError {
+			t.Fatalf("expected status 500, got %d", rec.Code)
+		}
+		if _, ok := keys["key-2"]; ok {
+			t.Error("expected the key to be released")
+		}
+	})
+
+	t.Run("body too large", func(t *testing.T) {
+		rec := create("key-3", strings.Repeat("a", maxRequestBodyBytes))
+		if rec.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("expected status 413, got %d", rec.Code)
+		}
+		if _, ok := keys["key-3"]; ok {
+			t.Error("expected the key not to be taken")
+		}
+	})
+
+	t.Run("writes without a key", func(t *testing.T) {
+		create("", "Market")
+		create("", "Market")
+		if created != 3 {
+			t.Errorf("expected 3 transactions created, got %d", created)
+		}
+		if len(idempotencyUC.BeginCalls()) != 4 {
+			t.Errorf("expected only the requests with a key to begin, got %d", len(idempotencyUC.BeginCalls()))
+		}
+	})
+}
+
+func TestRequestFingerprint(t *testing.T) {
+	fingerprint := func(method, target, book, body string) string {
+		req := httptest.NewRequest(method, target, nil)
+		if book != "" {
+			req.Header.Set(BookHeader, book)
+		}
+		return requestFingerprint(req, []byte(body))
+	}
+
+	base := fingerprint(http.MethodPost, "/api/v1/transactions", "", `{"amount": "45.90"}`)
+	if base != fingerprint(http.MethodPost, "/api/v1/transactions", "", `{"amount": "45.90"}`) {
+		t.Error("expected the same request to have the same fingerprint")
+	}
+	for name, other := range map[string]string{
+		"method": fingerprint(http.MethodPut, "/api/v1/transactions", "", `{"amount": "45.90"}`),
+		"path":   fingerprint(http.MethodPost, "/api/v1/transactions?include=account", "", `{"amount": "45.90"}`),
+		"book":   fingerprint(http.MethodPost, "/api/v1/transactions", "3f2b1c4e-8a6d-4e1f-9b7a-2c5d8e0f1a3b", `{"amount": "45.90"}`),
+		"body":   fingerprint(http.MethodPost, "/api/v1/transactions", "", `{"amount": "45.91"}`),
+	} {
+		if other == base {
+			t.Errorf("expected another %s to change the fingerprint", name)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"finance/domain/entities"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// maxImportBodyBytes caps the size of the statement exports, years of
+// transactions fit well under it
+const maxImportBodyBytes = 10 << 20
+
+// Statement import response types
+type StatementImportResponse struct {
+	Source     entities.StatementSource `json:"source" example:"wise"`
+	DryRun     bool                     `json:"dry_run"`
+	Currencies []CurrencyImportResponse `json:"currencies"`
+	// Restore point to roll the import back with, left out when nothing
+	// changed
+	RestorePointID string `json:"restore_point_id,omitempty"`
+}
+
+type CurrencyImportResponse struct {
+	Currency       string                `json:"currency" example:"GBP"`
+	Account        AccountResponse       `json:"account"`
+	AccountCreated bool                  `json:"account_created"`
+	Transactions   []TransactionResponse `json:"transactions"`
+	// Lines already in the account
+	Skipped int `json:"skipped"`
+	// Lines a transaction script rejected
+	Rejected int `json:"rejected"`
+	// Closing balance of the statement and balance of the account after the
+	// import, to reconcile them
+	StatementBalance string `json:"statement_balance,omitempty" example:"[GBP (£) 1520.40]"`
+	AccountBalance   string `json:"account_balance,omitempty" example:"[GBP (£) 1520.40]"`
+}
+
+// TransactionImportResponse summarizes the import of a CSV export into an
+// account, the transactions created, or that would be on dry runs, and the
+// rows skipped as already in the account or rejected by a transaction script
+type TransactionImportResponse struct {
+	DryRun       bool                  `json:"dry_run"`
+	AccountID    string                `json:"account_id"`
+	Created      int                   `json:"created" example:"42"`
+	Skipped      int                   `json:"skipped" example:"3"`
+	Rejected     int                   `json:"rejected" example:"1"`
+	Transactions []TransactionResponse `json:"transactions"`
+	SkippedRows  []ImportedRowResponse `json:"skipped_rows"`
+	RejectedRows []ImportedRowResponse `json:"rejected_rows"`
+	// Restore point to roll the import back with, left out when nothing
+	// changed
+	RestorePointID string `json:"restore_point_id,omitempty"`
+}
+
+type ImportedRowResponse struct {
+	Date        string `json:"date" example:"2025-03-14"`
+	Amount      string `json:"amount" example:"[BRL (R$) -45.00]"`
+	Description string `json:"description"`
+	Payee       string `json:"payee,omitempty"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/import_uc.go . ImportUseCase
+type ImportUseCase interface {
+	ImportStatement(ctx context.Context, r io.Reader, options entities.StatementImportOptions) (entities.StatementImportResult, error)
+	ImportTransactions(ctx context.Context, r io.Reader, options entities.TransactionImportOptions) (entities.TransactionImportResult, error)
+}
+
+// Import handlers
+
+// ImportStatement imports a statement export
+//
+//	@Summary		Import a statement
+//	@Description	Import the CSV statement export of Wise or Revolut, sent as the request body. Multi-currency statements are split by currency, each going to the account picked in accounts, else to the account of the provider's institution in that currency, created as "Wise GBP" when there is none. Fees become transactions of their own, lines already in the account are skipped, those the transaction scripts reject are counted and left out, and the closing balance of the statement is returned next to the account balance. The rows the import changed are recorded in the restore point returned, to roll it back with
+//	@Tags			transactions
+//	@Accept			text/csv
+//	@Produce		json
+//	@Param			source				path		string					true	"Statement source (wise, revolut)"
+//	@Param			expense_category_id	query		string					true	"Category of the money going out"
+//	@Param			income_category_id	query		string					true	"Category of the money coming in"
+//	@Param			fee_category_id		query		string					false	"Category of the fees, the expense category by default"
+//	@Param			accounts			query		string					false	"Accounts of the currencies, like GBP:id,USD:id"
+//	@Param			dry_run				query		bool					false	"Parse the statement without importing it"
+//	@Param			statement			body		string					true	"CSV export"
+//	@Success		201					{object}	StatementImportResponse	"Statement imported"
+//	@Success		200					{object}	StatementImportResponse	"Statement parsed, on dry runs"
+//	@Failure		400					{object}	ErrorResponseBody		"Malformed statement or parameters"
+//	@Failure		402					{object}	ErrorResponseBody		"Quota of the free tier reached"
+//	@Failure		404					{object}	ErrorResponseBody		"Unknown source, category or account"
+//	@Failure		413					{object}	ErrorResponseBody		"Statement too large"
+//	@Failure		429					{object}	ErrorResponseBody		"Monthly quota reached, until the Retry-After seconds passed"
+//	@Router			/imports/{source} [post]
+func (h *ApiHandlers) ImportStatement(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	options := entities.StatementImportOptions{
+		Source:            entities.StatementSource(chi.URLParam(r, "source")),
+		ExpenseCategoryID: query.Get("expense_category_id"),
+		IncomeCategoryID:  query.Get("income_category_id"),
+		FeeCategoryID:     query.Get("fee_category_id"),
+		Accounts:          map[string]string{},
+	}
+	if value := query.Get("dry_run"); value != "" {
+		dryRun, err := strconv.ParseBool(value)
+		if err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("dry_run", value))
+			return
+		}
+		options.DryRun = dryRun
+	}
+	for _, pair := range strings.Split(query.Get("accounts"), ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		currency, id, ok := strings.Cut(pair, ":")
+		if !ok || strings.TrimSpace(currency) == "" || strings.TrimSpace(id) == "" {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("accounts", pair))
+			return
+		}
+		options.Accounts[strings.ToUpper(strings.TrimSpace(currency))] = strings.TrimSpace(id)
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxImportBodyBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			errorResponse(w, r, http.StatusRequestEntityTooLarge, fmt.Errorf("statement must not be larger than %d bytes", maxImportBodyBytes))
+			return
+		}
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := h.ImportUseCase.ImportStatement(r.Context(), bytes.NewReader(data), options)
+	if err != nil {
+		slog.Error("failed to import statement", "error", err, "source", options.Source)
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := StatementImportResponse{
+		Source:         result.Source,
+		DryRun:         result.DryRun,
+		Currencies:     make([]CurrencyImportResponse, len(result.Currencies)),
+		RestorePointID: result.RestorePointID,
+	}
+	for i, imported := range result.Currencies {
+		account := imported.Account
+		currency := CurrencyImportResponse{
+			Currency: imported.Currency,
+			Account: AccountResponse{
+				ID:             account.ID,
+				Name:           account.Name,
+				Type:           account.Type,
+				Asset:          account.Asset.Asset,
+				Description:    account.Description,
+				Classification: account.EffectiveClassification(),
+				Institution:    account.Institution,
+			},
+			AccountCreated: imported.AccountCreated,
+			Transactions:   make([]TransactionResponse, len(imported.Transactions)),
+			Skipped:        imported.Skipped,
+			Rejected:       imported.Rejected,
+		}
+		if account.ID != "" {
+			currency.Account.CreatedAt = account.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+			currency.Account.UpdatedAt = account.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+		}
+		for j, transaction := range imported.Transactions {
+			currency.Transactions[j] = TransactionResponse{
+				ID:          transaction.ID,
+				AccountID:   transaction.AccountID,
+				CategoryID:  transaction.CategoryID,
+				Amount:      transaction.Monetary.String(),
+				Description: transaction.Description,
+				Payee:       transaction.Payee,
+				Date:        transaction.Date.Format("2006-01-02"),
+				Status:      transaction.Status,
+			}
+			if !result.DryRun {
+				currency.Transactions[j].CreatedAt = transaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+				currency.Transactions[j].UpdatedAt = transaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+			}
+		}
+		if imported.StatementBalance != nil {
+			currency.StatementBalance = imported.StatementBalance.String()
+		}
+		if imported.AccountBalance != nil {
+			currency.AccountBalance = imported.AccountBalance.String()
+		}
+		response.Currencies[i] = currency
+	}
+
+	if !result.DryRun {
+		render.Status(r, http.StatusCreated)
+	}
+	render.JSON(w, r, response)
+}
+
+// ImportTransactions imports the CSV export of any bank into an account
+//
+//	@Summary		Import a CSV export
+//	@Description	Import the CSV export of any bank into an account, sent as the file of a multipart form. The columns holding the date, amount and description of the rows are named by their header. Money going out is filed under category_id and money coming in under income_category_id, or under the category of the latest transaction of the row's payee. Rows already in the account, with the same date, amount and description, are skipped, and those the transaction scripts reject are left out. The transactions are created all together or none at all, and recorded in the restore point returned
+//	@Tags			transactions
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			file				formData	file						true	"CSV export"
+//	@Param			account_id			formData	string						true	"Account of the transactions"
+//	@Param			category_id			formData	string						true	"Category of the money going out"
+//	@Param			income_category_id	formData	string						false	"Category of the money coming in, category_id by default"
+//	@Param			date_column			formData	string						true	"Header of the date column"
+//	@Param			amount_column		formData	string						true	"Header of the signed amount column"
+//	@Param			description_column	formData	string						true	"Header of the description column"
+//	@Param			payee_column		formData	string						false	"Header of the payee column"
+//	@Param			date_format			formData	string						false	"Go layout of the dates, like 01/02/2006; ISO and day first dates are read without it"
+//	@Param			decimal_comma		formData	bool						false	"Amounts are written like 1.234,56"
+//	@Param			dry_run				formData	bool						false	"Preview the import without creating the transactions"
+//	@Success		201					{object}	TransactionImportResponse	"Transactions imported"
+//	@Success		200					{object}	TransactionImportResponse	"Import previewed, on dry runs"
+//	@Failure		400					{object}	ErrorResponseBody			"Malformed export or parameters"
+//	@Failure		402					{object}	ErrorResponseBody			"Quota of the free tier reached"
+//	@Failure		404					{object}	ErrorResponseBody			"Account or category not found"
+//	@Failure		413					{object}	ErrorResponseBody			"Export too large"
+//	@Failure		429					{object}	ErrorResponseBody			"Monthly quota reached, until the Retry-After seconds passed"
+//	@Router			/transactions/import [post]
+func (h *ApiHandlers) ImportTransactions(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportBodyBytes)
+	if err := r.ParseMultipartForm(maxImportBodyBytes); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			errorResponse(w, r, http.StatusRequestEntityTooLarge, fmt.Errorf("export must not be larger than %d bytes", maxImportBodyBytes))
+			return
+		}
+		errorResponse(w, r, http.StatusBadRequest, fmt.Errorf("invalid multipart form: %w", err))
+		return
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("file"))
+		return
+	}
+	defer file.Close()
+
+	options := entities.TransactionImportOptions{
+		AccountID:        r.FormValue("account_id"),
+		CategoryID:       r.FormValue("category_id"),
+		IncomeCategoryID: r.FormValue("income_category_id"),
+		Mapping: entities.CSVMapping{
+			Date:        r.FormValue("date_column"),
+			Amount:      r.FormValue("amount_column"),
+			Description: r.FormValue("description_column"),
+			Payee:       r.FormValue("payee_column"),
+			DateLayout:  r.FormValue("date_format"),
+		},
+	}
+	for name, target := range map[string]*bool{"decimal_comma": &options.Mapping.DecimalComma, "dry_run": &options.DryRun} {
+		value := r.FormValue(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter(name, value))
+			return
+		}
+		*target = parsed
+	}
+
+	result, err := h.ImportUseCase.ImportTransactions(r.Context(), file, options)
+	if err != nil {
+		slog.Error("failed to import transactions", "error", err, "account_id", options.AccountID)
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := TransactionImportResponse{
+		DryRun:         result.DryRun,
+		AccountID:      result.Account.ID,
+		Created:        len(result.Created),
+		Skipped:        len(result.Skipped),
+		Rejected:       len(result.Rejected),
+		Transactions:   make([]TransactionResponse, len(result.Created)),
+		SkippedRows:    importedRowResponses(result.Skipped),
+		RejectedRows:   importedRowResponses(result.Rejected),
+		RestorePointID: result.RestorePointID,
+	}
+	for i, transaction := range result.Created {
+		response.Transactions[i] = TransactionResponse{
+			ID:          transaction.ID,
+			AccountID:   transaction.AccountID,
+			CategoryID:  transaction.CategoryID,
+			Amount:      transaction.Monetary.String(),
+			Description: transaction.Description,
+			Payee:       transaction.Payee,
+			Date:        transaction.Date.Format("2006-01-02"),
+			Status:      transaction.Status,
+		}
+		if !result.DryRun {
+			response.Transactions[i].CreatedAt = transaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+			response.Transactions[i].UpdatedAt = transaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+		}
+	}
+
+	if !result.DryRun {
+		render.Status(r, http.StatusCreated)
+	}
+	render.JSON(w, r, response)
+}
+
+func importedRowResponses(rows []entities.ImportedTransaction) []ImportedRowResponse {
+	responses := make([]ImportedRowResponse, len(rows))
+	for i, row := range rows {
+		responses[i] = ImportedRowResponse{
+			Date:        row.Date.Format("2006-01-02"),
+			Amount:      row.Amount.String(),
+			Description: row.Description,
+			Payee:       row.Payee,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,213 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"io"
+	"math/big"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestImportStatement(t *testing.T) {
+	amount := &monetary.Monetary{Asset: monetary.GBP, Amount: big.NewInt(-2500)}
+	balance, _ := monetary.NewMonetary(monetary.GBP, big.NewInt(97420))
+
+	var got entities.StatementImportOptions
+	var body string
+	h := &ApiHandlers{
+		ImportUseCase: &mocks.ImportUseCaseMock{
+			ImportStatementFunc: func(ctx context.Context, r io.Reader, options entities.StatementImportOptions) (entities.StatementImportResult, error) {
+				if options.Source != entities.StatementSourceWise {
+					return entities.StatementImportResult{}, fmt.Errorf("statement source %q: %w", options.Source, domain.ErrNotFound)
+				}
+				data, _ := io.ReadAll(r)
+				got, body = options, string(data)
+				return entities.StatementImportResult{
+					Source: options.Source,
+					DryRun: options.DryRun,
+					Currencies: []entities.CurrencyImport{{
+						Currency: "GBP",
+						Account:  entities.Account{ID: "acc-1", Name: "Wise GBP", Type: entities.AccountTypeChecking, Asset: monetary.GBP, Institution: "Wise"},
+						Transactions: []entities.Transaction{
+							{ID: "txn-1", AccountID: "acc-1", CategoryID: "cat-1", Monetary: *amount, Description: "Bakery", Date: time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC), Status: entities.TransactionStatusCleared},
+						},
+						Skipped:          2,
+						StatementBalance: balance,
+						AccountBalance:   balance,
+					}},
+				}, nil
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	post := func(path string) (*httptest.ResponseRecorder, StatementImportResponse) {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, strings.NewReader("Date,Amount\n")))
+		var response StatementImportResponse
+		json.NewDecoder(rec.Body).Decode(&response)
+		return rec, response
+	}
+
+	t.Run("imports the statement", func(t *testing.T) {
+		rec, response := post("/api/v1/imports/wise?expense_category_id=cat-1&income_category_id=cat-2&accounts=gbp:acc-1,USD:acc-2")
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d", rec.Code)
+		}
+		if body != "Date,Amount\n" || got.ExpenseCategoryID != "cat-1" || got.IncomeCategoryID != "cat-2" || got.DryRun {
+			t.Errorf("unexpected options: %+v", got)
+		}
+		if got.Accounts["GBP"] != "acc-1" || got.Accounts["USD"] != "acc-2" {
+			t.Errorf("unexpected accounts: %+v", got.Accounts)
+		}
+		if len(response.Currencies) != 1 || response.Currencies[0].Skipped != 2 || response.Currencies[0].StatementBalance != "[GBP (£) 974.20]" {
+			t.Fatalf("unexpected response: %+v", response)
+		}
+		if transactions := response.Currencies[0].Transactions; len(transactions) != 1 || transactions[0].Date != "2025-03-14" || transactions[0].Amount != "[GBP (£) -25.00]" {
+			t.Errorf("unexpected transactions: %+v", transactions)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		rec, response := post("/api/v1/imports/wise?expense_category_id=cat-1&income_category_id=cat-2&dry_run=true")
+		if rec.Code != http.StatusOK || !response.DryRun {
+			t.Errorf("expected status 200 on a dry run, got %d", rec.Code)
+		}
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		for path, status := range map[string]int{
+			"/api/v1/imports/wise?dry_run=maybe": http.StatusBadRequest,
+			"/api/v1/imports/wise?accounts=GBP":  http.StatusBadRequest,
+			"/api/v1/imports/paypal":             http.StatusNotFound,
+		} {
+			if rec, _ := post(path); rec.Code != status {
+				t.Errorf("%s: expected status %d, got %d", path, status, rec.Code)
+			}
+		}
+	})
+}
+
+func TestImportTransactions(t *testing.T) {
+	amount := monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(-4500)}
+	march3 := time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC)
+
+	var got entities.TransactionImportOptions
+	var body string
+	h := &ApiHandlers{
+		ImportUseCase: &mocks.ImportUseCaseMock{
+			ImportTransactionsFunc: func(ctx context.Context, r io.Reader, options entities.TransactionImportOptions) (entities.TransactionImportResult, error) {
+				if options.AccountID == "acc-missing" {
+					return entities.TransactionImportResult{}, fmt.Errorf("failed to get account: account %w", domain.ErrNotFound)
+				}
+				data, _ := io.ReadAll(r)
+				got, body = options, string(data)
+				return entities.TransactionImportResult{
+					Account: entities.Account{ID: options.AccountID},
+					DryRun:  options.DryRun,
+					Created: []entities.Transaction{
+						{ID: "txn-1", AccountID: options.AccountID, CategoryID: "cat-1", Monetary: amount, Description: "PADARIA", Date: march3, Status: entities.TransactionStatusCleared},
+					},
+					Skipped: []entities.ImportedTransaction{{Date: march3, Amount: amount, Description: "PADARIA"}},
+				}, nil
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	post := func(fields map[string]string, withFile bool) (*httptest.ResponseRecorder, TransactionImportResponse) {
+		var buf bytes.Buffer
+		form := multipart.NewWriter(&buf)
+		for name, value := range fields {
+			form.WriteField(name, value)
+		}
+		if withFile {
+			file, _ := form.CreateFormFile("file", "extrato.csv")
+			file.Write([]byte("Data,Valor,Histórico\n"))
+		}
+		form.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/transactions/import", &buf)
+		req.Header.Set("Content-Type", form.FormDataContentType())
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		var response TransactionImportResponse
+		json.NewDecoder(rec.Body).Decode(&response)
+		return rec, response
+	}
+	fields := map[string]string{
+		"account_id":         "acc-1",
+		"category_id":        "cat-1",
+		"date_column":        "Data",
+		"amount_column":      "Valor",
+		"description_column": "Histórico",
+		"date_format":        "02/01/2006",
+		"decimal_comma":      "true",
+	}
+
+	t.Run("imports the export", func(t *testing.T) {
+		rec, response := post(fields, true)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d", rec.Code)
+		}
+		if body != "Data,Valor,Histórico\n" || got.AccountID != "acc-1" || got.CategoryID != "cat-1" || got.DryRun {
+			t.Errorf("unexpected options: %+v", got)
+		}
+		if got.Mapping != (entities.CSVMapping{Date: "Data", Amount: "Valor", Description: "Histórico", DateLayout: "02/01/2006", DecimalComma: true}) {
+			t.Errorf("unexpected mapping: %+v", got.Mapping)
+		}
+		if response.Created != 1 || response.Skipped != 1 || response.Transactions[0].Amount != "[BRL (R$) -45.00]" || response.SkippedRows[0].Date != "2025-03-03" {
+			t.Errorf("unexpected response: %+v", response)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		dryRun := map[string]string{"dry_run": "true"}
+		for name, value := range fields {
+			dryRun[name] = value
+		}
+		rec, response := post(dryRun, true)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if !got.DryRun || !response.DryRun {
+			t.Errorf("expected a dry run, got %+v", response)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		if rec, _ := post(fields, false); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400 without a file, got %d", rec.Code)
+		}
+		invalid := map[string]string{"dry_run": "maybe"}
+		if rec, _ := post(invalid, true); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400 for an invalid dry_run, got %d", rec.Code)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/transactions/import", strings.NewReader("Data,Valor\n")))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400 for a body that isn't a form, got %d", rec.Code)
+		}
+	})
+
+	t.Run("account not found", func(t *testing.T) {
+		if rec, _ := post(map[string]string{"account_id": "acc-missing"}, true); rec.Code != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", rec.Code)
+		}
+	})
+}
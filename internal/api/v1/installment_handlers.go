@@ -0,0 +1,357 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// InstallmentPlanResponse is a purchase paid in installments. Paid counts the
+// installments charged so far, remaining_installments and remaining the ones
+// still ahead. Installments are only listed when a single plan is retrieved.
+type InstallmentPlanResponse struct {
+	ID                    string                `json:"id"`
+	AccountID             string                `json:"account_id"`
+	Description           string                `json:"description" example:"TV"`
+	Amount                string                `json:"amount" example:"[BRL (R$) 1200.00]"`
+	InstallmentCount      int                   `json:"installment_count" example:"12"`
+	FirstDate             string                `json:"first_date" example:"2025-03-20"`
+	PaidOffOn             string                `json:"paid_off_on,omitempty"`
+	Paid                  int                   `json:"paid" example:"3"`
+	RemainingInstallments int                   `json:"remaining_installments" example:"9"`
+	Remaining             string                `json:"remaining" example:"[BRL (R$) 900.00]"`
+	NextDate              string                `json:"next_date,omitempty" example:"2025-06-20"`
+	CreatedAt             string                `json:"created_at"`
+	UpdatedAt             string                `json:"updated_at"`
+	Installments          []TransactionResponse `json:"installments,omitempty"`
+}
+
+// InstallmentCommitmentsResponse adds up what the installment plans in one
+// asset still have to charge, month by month
+type InstallmentCommitmentsResponse struct {
+	Asset     string                               `json:"asset" example:"BRL"`
+	Plans     int                                  `json:"plans"`
+	Remaining string                               `json:"remaining" example:"[BRL (R$) 2350.00]"`
+	Months    []InstallmentCommitmentMonthResponse `json:"months"`
+}
+
+type InstallmentCommitmentMonthResponse struct {
+	Month        string `json:"month" example:"2025-06"`
+	Installments int    `json:"installments"`
+	Amount       string `json:"amount" example:"[BRL (R$) 350.00]"`
+}
+
+// PayOffInstallmentPlanRequest picks the day the remaining installments are
+// paid, today when empty, and the monthly rate in percent they are discounted
+// at for each month brought forward
+type PayOffInstallmentPlanRequest struct {
+	Date        string  `json:"date" example:"2025-06-01"`
+	MonthlyRate float64 `json:"monthly_rate" example:"1.99"`
+}
+
+// InstallmentPayoffResponse is what paying the remaining installments of a
+// plan in advance costs. Transaction is the charge created once paid off.
+type InstallmentPayoffResponse struct {
+	Plan         InstallmentPlanResponse `json:"plan"`
+	Date         string                  `json:"date" example:"2025-06-01"`
+	MonthlyRate  float64                 `json:"monthly_rate" example:"1.99"`
+	Installments int                     `json:"installments" example:"9"`
+	Remaining    string                  `json:"remaining" example:"[BRL (R$) 900.00]"`
+	Discount     string                  `json:"discount" example:"[BRL (R$) 79.12]"`
+	Amount       string                  `json:"amount" example:"[BRL (R$) 820.88]"`
+	Transaction  *TransactionResponse    `json:"transaction,omitempty"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/installment_uc.go . InstallmentUseCase
+type InstallmentUseCase interface {
+	GetInstallmentPlans(ctx context.Context) ([]entities.InstallmentPlan, error)
+	GetInstallmentPlan(ctx context.Context, id string) (entities.InstallmentPlan, error)
+	GetInstallmentCommitments(ctx context.Context) ([]entities.InstallmentCommitments, error)
+	QuoteInstallmentPayoff(ctx context.Context, id string, date time.Time, monthlyRate float64) (entities.InstallmentPayoff, error)
+	PayOffInstallmentPlan(ctx context.Context, id string, date time.Time, monthlyRate float64) (entities.InstallmentPayoff, error)
+	DeleteInstallmentPlan(ctx context.Context, id string) error
+}
+
+// Installment handlers
+
+// GetInstallmentPlans lists the installment plans
+//
+//	@Summary		List installment plans
+//	@Description	List the purchases paid in installments, newest first, with how many installments were paid and what's left to charge
+//	@Tags			installments
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		InstallmentPlanResponse	"Installment plans retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/installments [get]
+func (h *ApiHandlers) GetInstallmentPlans(w http.ResponseWriter, r *http.Request) {
+	plans, err := h.InstallmentUseCase.GetInstallmentPlans(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	responses := make([]InstallmentPlanResponse, len(plans))
+	for i, plan := range plans {
+		responses[i] = installmentPlanResponse(plan)
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetInstallmentCommitments adds up the remaining installments
+//
+//	@Summary		Remaining installment commitments
+//	@Description	Add up the installments still to be charged by all the installment plans, per asset and month
+//	@Tags			installments
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		InstallmentCommitmentsResponse	"Commitments retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody				"Internal server error"
+//	@Router			/installments/commitments [get]
+func (h *ApiHandlers) GetInstallmentCommitments(w http.ResponseWriter, r *http.Request) {
+	commitments, err := h.InstallmentUseCase.GetInstallmentCommitments(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	responses := make([]InstallmentCommitmentsResponse, len(commitments))
+	for i, commitment := range commitments {
+		responses[i] = InstallmentCommitmentsResponse{
+			Asset:     commitment.Remaining.Asset.Asset,
+			Plans:     commitment.Plans,
+			Remaining: commitment.Remaining.String(),
+			Months:    make([]InstallmentCommitmentMonthResponse, len(commitment.Months)),
+		}
+		for j, month := range commitment.Months {
+			responses[i].Months[j] = InstallmentCommitmentMonthResponse{
+				Month:        month.Month,
+				Installments: month.Installments,
+				Amount:       month.Amount.String(),
+			}
+		}
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetInstallmentPlan retrieves an installment plan with its installments
+//
+//	@Summary		Get installment plan
+//	@Description	Retrieve a purchase paid in installments with its installments in order
+//	@Tags			installments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string					true	"Installment plan ID"
+//	@Success		200	{object}	InstallmentPlanResponse	"Installment plan retrieved successfully"
+//	@Failure		400	{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody		"Installment plan not found"
+//	@Router			/installments/{id} [get]
+func (h *ApiHandlers) GetInstallmentPlan(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("id"))
+		return
+	}
+
+	plan, err := h.InstallmentUseCase.GetInstallmentPlan(r.Context(), id)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	response := installmentPlanResponse(plan)
+	response.Installments = installmentResponses(plan.Installments)
+
+	render.JSON(w, r, response)
+}
+
+// QuoteInstallmentPayoff calculates the early payoff of an installment plan
+//
+//	@Summary		Quote installment plan payoff
+//	@Description	Calculate what paying the installments dated after a day in advance costs. Each one is discounted at the monthly rate, in percent, for every month it's brought forward
+//	@Tags			installments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string						true	"Installment plan ID"
+//	@Param			date			query		string						false	"Payoff date (YYYY-MM-DD), defaults to today"
+//	@Param			monthly_rate	query		number						false	"Monthly discount rate in percent, like 1.99"
+//	@Success		200				{object}	InstallmentPayoffResponse	"Payoff calculated successfully"
+//	@Failure		400				{object}	ErrorResponseBody			"Bad request"
+//	@Failure		404				{object}	ErrorResponseBody			"Installment plan not found"
+//	@Failure		409				{object}	ErrorResponseBody			"Installment plan paid off or without installments left"
+//	@Router			/installments/{id}/payoff [get]
+func (h *ApiHandlers) QuoteInstallmentPayoff(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("id"))
+		return
+	}
+
+	date, err := parseDateParam(r, "date")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	var monthlyRate float64
+	if value := r.URL.Query().Get("monthly_rate"); value != "" {
+		if monthlyRate, err = strconv.ParseFloat(value, 64); err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("monthly_rate", value))
+			return
+		}
+	}
+
+	payoff, err := h.InstallmentUseCase.QuoteInstallmentPayoff(r.Context(), id, date, monthlyRate)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, installmentPayoffResponse(payoff))
+}
+
+// PayOffInstallmentPlan pays the remaining installments of a plan in advance
+//
+//	@Summary		Pay off installment plan
+//	@Description	Replace the installments dated after the payoff date by a single pending transaction for the discounted amount, numbered like "TV (4-12/12)", and mark the plan paid off
+//	@Tags			installments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string							true	"Installment plan ID"
+//	@Param			payoff	body		PayOffInstallmentPlanRequest	false	"Payoff date and monthly discount rate"
+//	@Success		200		{object}	InstallmentPayoffResponse		"Installment plan paid off successfully"
+//	@Failure		400		{object}	ErrorResponseBody				"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody				"Installment plan not found"
+//	@Failure		409		{object}	ErrorResponseBody				"Installment plan paid off or without installments left"
+//	@Failure		413		{object}	ErrorResponseBody				"Request body too large"
+//	@Router			/installments/{id}/payoff [post]
+func (h *ApiHandlers) PayOffInstallmentPlan(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("id"))
+		return
+	}
+
+	// The body is optional, an empty one pays off today without a discount
+	var req PayOffInstallmentPlanRequest
+	if r.ContentLength != 0 {
+		if err := decodeJSON(w, r, &req); err != nil {
+			bodyErrorResponse(w, r, err)
+			return
+		}
+	}
+
+	var date time.Time
+	if req.Date != "" {
+		var err error
+		if date, err = time.Parse("2006-01-02", req.Date); err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("date", "must be in format YYYY-MM-DD"))
+			return
+		}
+	}
+
+	payoff, err := h.InstallmentUseCase.PayOffInstallmentPlan(r.Context(), id, date, req.MonthlyRate)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, installmentPayoffResponse(payoff))
+}
+
+// DeleteInstallmentPlan deletes an installment plan
+//
+//	@Summary		Delete installment plan
+//	@Description	Delete a purchase paid in installments, moving all its installments to the trash
+//	@Tags			installments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	string	true	"Installment plan ID"
+//	@Success		204	"Installment plan deleted successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Installment plan not found"
+//	@Router			/installments/{id} [delete]
+func (h *ApiHandlers) DeleteInstallmentPlan(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("id"))
+		return
+	}
+
+	if err := h.InstallmentUseCase.DeleteInstallmentPlan(r.Context(), id); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func installmentPlanResponse(plan entities.InstallmentPlan) InstallmentPlanResponse {
+	response := InstallmentPlanResponse{
+		ID:                    plan.ID,
+		AccountID:             plan.AccountID,
+		Description:           plan.Description,
+		Amount:                plan.Amount.String(),
+		InstallmentCount:      plan.Count,
+		FirstDate:             plan.FirstDate.Format("2006-01-02"),
+		Paid:                  plan.Paid,
+		RemainingInstallments: plan.RemainingCount,
+		Remaining:             plan.Remaining.String(),
+		CreatedAt:             plan.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:             plan.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if plan.PaidOff() {
+		response.PaidOffOn = plan.PaidOffOn.Format("2006-01-02")
+	}
+	if !plan.NextDate.IsZero() {
+		response.NextDate = plan.NextDate.Format("2006-01-02")
+	}
+	return response
+}
+
+func installmentResponses(installments []entities.Transaction) []TransactionResponse {
+	responses := make([]TransactionResponse, len(installments))
+	for i, installment := range installments {
+		responses[i] = TransactionResponse{
+			ID:                installment.ID,
+			AccountID:         installment.AccountID,
+			CategoryID:        installment.CategoryID,
+			Amount:            installment.Monetary.String(),
+			Description:       installment.Description,
+			Payee:             installment.Payee,
+			Date:              installment.Date.Format("2006-01-02"),
+			Status:            installment.Status,
+			InstallmentPlanID: installment.InstallmentPlanID,
+			InstallmentNumber: installment.InstallmentNumber,
+			InstallmentCount:  installment.InstallmentCount,
+			ProjectID:         installment.ProjectID,
+			Tags:              installment.Tags,
+			CreatedAt:         installment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			UpdatedAt:         installment.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		}
+	}
+	return responses
+}
+
+func installmentPayoffResponse(payoff entities.InstallmentPayoff) InstallmentPayoffResponse {
+	response := InstallmentPayoffResponse{
+		Plan:         installmentPlanResponse(payoff.Plan),
+		Date:         payoff.Date.Format("2006-01-02"),
+		MonthlyRate:  payoff.MonthlyRate,
+		Installments: payoff.Installments,
+		Remaining:    payoff.Remaining.String(),
+		Discount:     payoff.Discount.String(),
+		Amount:       payoff.Amount.String(),
+	}
+	if payoff.Transaction != nil {
+		response.Transaction = &installmentResponses([]entities.Transaction{*payoff.Transaction})[0]
+	}
+	return response
+}
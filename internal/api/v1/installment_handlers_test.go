@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestInstallmentHandlers(t *testing.T) {
+	const planID = "3c9a1f2e-5b7d-4c8e-9f0a-1b2c3d4e5f6a"
+	const paidOffID = "8e7d6c5b-4a39-4281-9f0e-d1c2b3a4f5e6"
+
+	installment, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(10000))
+	total, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(120000))
+	remaining, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(90000))
+	discount, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(4340))
+	amount, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(85660))
+	plan := entities.InstallmentPlan{
+		ID:          planID,
+		AccountID:   "6f1c2a3e-8b4d-4e5f-9a6b-7c8d9e0f1a2b",
+		Description: "TV",
+		Amount:      *total,
+		Count:       12,
+		FirstDate:   time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC),
+		Installments: []entities.Transaction{
+			{ID: "txn-1", Monetary: *installment, Description: "TV (1/12)", Date: time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC), Status: entities.TransactionStatusCleared, InstallmentPlanID: planID, InstallmentNumber: 1, InstallmentCount: 12},
+		},
+		Paid:           3,
+		RemainingCount: 9,
+		Remaining:      *remaining,
+		NextDate:       time.Date(2025, time.April, 15, 0, 0, 0, 0, time.UTC),
+	}
+
+	var gotDate time.Time
+	var gotRate float64
+	payoff := func(ctx context.Context, id string, date time.Time, monthlyRate float64) (entities.InstallmentPayoff, error) {
+		if id == paidOffID {
+			return entities.InstallmentPayoff{}, fmt.Errorf("installment plan was paid off on 2025-02-01: %w", domain.ErrConflict)
+		}
+		if monthlyRate > 20 {
+			return entities.InstallmentPayoff{}, fmt.Errorf("monthly rate must be between 0 and 20 percent, got %v: %w", monthlyRate, domain.ErrMalformedParameters)
+		}
+		gotDate, gotRate = date, monthlyRate
+		return entities.InstallmentPayoff{Plan: plan, Date: date, MonthlyRate: monthlyRate, Installments: 9, Remaining: *remaining, Discount: *discount, Amount: *amount}, nil
+	}
+	mockUC := &mocks.InstallmentUseCaseMock{
+		GetInstallmentPlansFunc: func(ctx context.Context) ([]entities.InstallmentPlan, error) {
+			return []entities.InstallmentPlan{plan}, nil
+		},
+		GetInstallmentPlanFunc: func(ctx context.Context, id string) (entities.InstallmentPlan, error) {
+			return plan, nil
+		},
+		QuoteInstallmentPayoffFunc: payoff,
+		PayOffInstallmentPlanFunc: func(ctx context.Context, id string, date time.Time, monthlyRate float64) (entities.InstallmentPayoff, error) {
+			result, err := payoff(ctx, id, date, monthlyRate)
+			result.Transaction = &entities.Transaction{ID: "txn-payoff", Monetary: *amount, Description: "TV (4-12/12)", Date: date, Status: entities.TransactionStatusPending}
+			return result, err
+		},
+		DeleteInstallmentPlanFunc: func(ctx context.Context, id string) error {
+			return nil
+		},
+	}
+	r := chi.NewRouter()
+	(&ApiHandlers{InstallmentUseCase: mockUC}).Routes(r)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(method, path, bytes.NewBufferString(body)))
+		return rec
+	}
+
+	t.Run("lists the plans", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/installments", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		var response []InstallmentPlanResponse
+		json.NewDecoder(rec.Body).Decode(&response)
+		if len(response) != 1 || response[0].Paid != 3 || response[0].RemainingInstallments != 9 || response[0].NextDate != "2025-04-15" {
+			t.Fatalf("unexpected response: %+v", response)
+		}
+		if response[0].Installments != nil || response[0].PaidOffOn != "" {
+			t.Errorf("unexpected plan: %+v", response[0])
+		}
+	})
+
+	t.Run("gets a plan with its installments", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/installments/"+planID, "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		var response InstallmentPlanResponse
+		json.NewDecoder(rec.Body).Decode(&response)
+		if len(response.Installments) != 1 || response.Installments[0].InstallmentNumber != 1 || response.Remaining != "[BRL (R$) 900.00]" {
+			t.Errorf("unexpected response: %+v", response)
+		}
+	})
+
+	t.Run("quotes the payoff", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/installments/"+planID+"/payoff?date=2025-03-20&monthly_rate=1", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if !gotDate.Equal(time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC)) || gotRate != 1 {
+			t.Errorf("unexpected quote: %s at %v", gotDate, gotRate)
+		}
+		var response InstallmentPayoffResponse
+		json.NewDecoder(rec.Body).Decode(&response)
+		if response.Amount != "[BRL (R$) 856.60]" || response.Discount != "[BRL (R$) 43.40]" || response.Transaction != nil {
+			t.Errorf("unexpected response: %+v", response)
+		}
+	})
+
+	t.Run("rejects a malformed rate", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/installments/"+planID+"/payoff?monthly_rate=low", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+		if rec := serve(http.MethodGet, "/api/v1/installments/"+planID+"/payoff?monthly_rate=25", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("pays off the plan", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/installments/"+planID+"/payoff", `{"date":"2025-03-20","monthly_rate":1.5}`)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if gotRate != 1.5 {
+			t.Errorf("unexpected rate: %v", gotRate)
+		}
+		var response InstallmentPayoffResponse
+		json.NewDecoder(rec.Body).Decode(&response)
+		if response.Transaction == nil || response.Transaction.Description != "TV (4-12/12)" {
+			t.Errorf("unexpected response: %+v", response)
+		}
+	})
+
+	t.Run("pays off today without a body", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/installments/"+planID+"/payoff", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if !gotDate.IsZero() || gotRate != 0 {
+			t.Errorf("unexpected payoff: %s at %v", gotDate, gotRate)
+		}
+	})
+
+	t.Run("plan already paid off", func(t *testing.T) {
+		if rec := serve(http.MethodPost, "/api/v1/installments/"+paidOffID+"/payoff", ""); rec.Code != http.StatusConflict {
+			t.Errorf("expected status 409, got %d", rec.Code)
+		}
+	})
+
+	t.Run("deletes the plan", func(t *testing.T) {
+		if rec := serve(http.MethodDelete, "/api/v1/installments/"+planID, ""); rec.Code != http.StatusNoContent {
+			t.Errorf("expected status 204, got %d", rec.Code)
+		}
+	})
+}
@@ -0,0 +1,389 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"math/big"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// InvoiceRequest is an invoice issued to a client, paid into account_id. The
+// issue date defaults to today.
+type InvoiceRequest struct {
+	AccountID   string `json:"account_id"`
+	Client      string `json:"client" example:"Acme"`
+	Number      string `json:"number" example:"2025-014"`
+	Description string `json:"description" example:"March development"`
+	Amount      string `json:"amount" example:"4500.00"`
+	IssueDate   string `json:"issue_date" example:"2025-03-31"`
+	DueDate     string `json:"due_date" example:"2025-04-30"`
+}
+
+// MatchInvoiceRequest picks the transaction that paid an invoice, one is
+// looked for when it's empty
+type MatchInvoiceRequest struct {
+	TransactionID string `json:"transaction_id"`
+}
+
+// InvoiceResponse is an invoice issued to a client. Status is outstanding,
+// overdue or paid, and payment the transaction that paid it.
+type InvoiceResponse struct {
+	ID            string                 `json:"id"`
+	AccountID     string                 `json:"account_id"`
+	Client        string                 `json:"client" example:"Acme"`
+	Number        string                 `json:"number,omitempty" example:"2025-014"`
+	Description   string                 `json:"description,omitempty"`
+	Amount        string                 `json:"amount" example:"[BRL (R$) 4500.00]"`
+	IssueDate     string                 `json:"issue_date" example:"2025-03-31"`
+	DueDate       string                 `json:"due_date" example:"2025-04-30"`
+	Status        entities.InvoiceStatus `json:"status" example:"overdue"`
+	DaysOverdue   int                    `json:"days_overdue,omitempty" example:"5"`
+	TransactionID string                 `json:"transaction_id,omitempty"`
+	Payment       *TransactionResponse   `json:"payment,omitempty"`
+	CreatedAt     string                 `json:"created_at"`
+	UpdatedAt     string                 `json:"updated_at"`
+}
+
+// ReceivablesResponse is what clients owe in one asset next to the net
+// balance of the accounts in it, the ledger. Total adds both.
+type ReceivablesResponse struct {
+	Asset       string                      `json:"asset" example:"BRL"`
+	Invoices    int                         `json:"invoices" example:"3"`
+	Outstanding string                      `json:"outstanding" example:"[BRL (R$) 9000.00]"`
+	Overdue     string                      `json:"overdue" example:"[BRL (R$) 4500.00]"`
+	Ledger      string                      `json:"ledger" example:"[BRL (R$) 12000.00]"`
+	Total       string                      `json:"total" example:"[BRL (R$) 21000.00]"`
+	Clients     []ClientReceivablesResponse `json:"clients"`
+}
+
+type ClientReceivablesResponse struct {
+	Client        string `json:"client" example:"Acme"`
+	Invoices      int    `json:"invoices" example:"2"`
+	Outstanding   string `json:"outstanding" example:"[BRL (R$) 9000.00]"`
+	Overdue       string `json:"overdue" example:"[BRL (R$) 4500.00]"`
+	OldestDueDate string `json:"oldest_due_date" example:"2025-04-30"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/invoice_uc.go . InvoiceUseCase
+type InvoiceUseCase interface {
+	CreateInvoice(ctx context.Context, invoice entities.Invoice) (entities.Invoice, error)
+	GetInvoices(ctx context.Context, status entities.InvoiceStatus) ([]entities.Invoice, error)
+	GetInvoice(ctx context.Context, id string) (entities.Invoice, error)
+	UpdateInvoice(ctx context.Context, invoice entities.Invoice) (entities.Invoice, error)
+	DeleteInvoice(ctx context.Context, id string) error
+	MatchInvoice(ctx context.Context, id, transactionID string) (entities.Invoice, error)
+	UnmatchInvoice(ctx context.Context, id string) (entities.Invoice, error)
+	GetReceivables(ctx context.Context) ([]entities.Receivables, error)
+}
+
+// Invoice handlers
+
+// CreateInvoice records an invoice issued to a client
+//
+//	@Summary		Create invoice
+//	@Description	Record an invoice issued to a client, to be paid into an account in its currency
+//	@Tags			invoices
+//	@Accept			json
+//	@Produce		json
+//	@Param			invoice	body		InvoiceRequest		true	"Invoice data"
+//	@Success		201		{object}	InvoiceResponse		"Invoice created successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody	"Account not found"
+//	@Failure		413		{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/invoices [post]
+func (h *ApiHandlers) CreateInvoice(w http.ResponseWriter, r *http.Request) {
+	invoice, ok := decodeInvoice(w, r)
+	if !ok {
+		return
+	}
+
+	created, err := h.InvoiceUseCase.CreateInvoice(r.Context(), invoice)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, invoiceResponse(created))
+}
+
+// GetInvoices lists the invoices
+//
+//	@Summary		List invoices
+//	@Description	List the invoices, the latest due first, with their status and payment
+//	@Tags			invoices
+//	@Accept			json
+//	@Produce		json
+//	@Param			status	query		string				false	"Only invoices in this status"	Enums(outstanding, overdue, paid)
+//	@Success		200		{array}		InvoiceResponse		"Invoices retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Router			/invoices [get]
+func (h *ApiHandlers) GetInvoices(w http.ResponseWriter, r *http.Request) {
+	status := entities.InvoiceStatus(r.URL.Query().Get("status"))
+
+	invoices, err := h.InvoiceUseCase.GetInvoices(r.Context(), status)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	responses := make([]InvoiceResponse, len(invoices))
+	for i, invoice := range invoices {
+		responses[i] = invoiceResponse(invoice)
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetReceivables adds up what clients owe
+//
+//	@Summary		Outstanding receivables
+//	@Description	Add up the unpaid invoices per currency and client, next to the net balance of the accounts in each currency
+//	@Tags			invoices
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		ReceivablesResponse	"Receivables retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/invoices/receivables [get]
+func (h *ApiHandlers) GetReceivables(w http.ResponseWriter, r *http.Request) {
+	receivables, err := h.InvoiceUseCase.GetReceivables(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	responses := make([]ReceivablesResponse, len(receivables))
+	for i, receivable := range receivables {
+		responses[i] = ReceivablesResponse{
+			Asset:       receivable.Asset.Asset,
+			Invoices:    receivable.Invoices,
+			Outstanding: receivable.Outstanding.String(),
+			Overdue:     receivable.Overdue.String(),
+			Ledger:      receivable.Ledger.String(),
+			Total:       receivable.Total.String(),
+			Clients:     make([]ClientReceivablesResponse, len(receivable.Clients)),
+		}
+		for j, client := range receivable.Clients {
+			responses[i].Clients[j] = ClientReceivablesResponse{
+				Client:        client.Client,
+				Invoices:      client.Invoices,
+				Outstanding:   client.Outstanding.String(),
+				Overdue:       client.Overdue.String(),
+				OldestDueDate: client.OldestDueDate.Format("2006-01-02"),
+			}
+		}
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetInvoice retrieves an invoice
+//
+//	@Summary		Get invoice
+//	@Description	Retrieve an invoice with its status and payment
+//	@Tags			invoices
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string				true	"Invoice ID"
+//	@Success		200	{object}	InvoiceResponse		"Invoice retrieved successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Invoice not found"
+//	@Router			/invoices/{id} [get]
+func (h *ApiHandlers) GetInvoice(w http.ResponseWriter, r *http.Request) {
+	invoice, err := h.InvoiceUseCase.GetInvoice(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, invoiceResponse(invoice))
+}
+
+// UpdateInvoice updates an invoice
+//
+//	@Summary		Update invoice
+//	@Description	Change the details of an invoice. A paid invoice can't move to another account
+//	@Tags			invoices
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Invoice ID"
+//	@Param			invoice	body		InvoiceRequest		true	"Invoice data"
+//	@Success		200		{object}	InvoiceResponse		"Invoice updated successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody	"Invoice or account not found"
+//	@Failure		409		{object}	ErrorResponseBody	"Paid invoice moved to another account"
+//	@Failure		413		{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/invoices/{id} [put]
+func (h *ApiHandlers) UpdateInvoice(w http.ResponseWriter, r *http.Request) {
+	invoice, ok := decodeInvoice(w, r)
+	if !ok {
+		return
+	}
+	invoice.ID = chi.URLParam(r, "id")
+
+	updated, err := h.InvoiceUseCase.UpdateInvoice(r.Context(), invoice)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, invoiceResponse(updated))
+}
+
+// DeleteInvoice deletes an invoice
+//
+//	@Summary		Delete invoice
+//	@Description	Delete an invoice, the transaction that paid it is kept
+//	@Tags			invoices
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	string	true	"Invoice ID"
+//	@Success		204	"Invoice deleted successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Invoice not found"
+//	@Router			/invoices/{id} [delete]
+func (h *ApiHandlers) DeleteInvoice(w http.ResponseWriter, r *http.Request) {
+	if err := h.InvoiceUseCase.DeleteInvoice(r.Context(), chi.URLParam(r, "id")); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// MatchInvoice records the transaction that paid an invoice
+//
+//	@Summary		Match invoice payment
+//	@Description	Record the income transaction on the invoice account that paid it. Without a transaction ID, the earliest one for the exact amount since the issue date is picked
+//	@Tags			invoices
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Invoice ID"
+//	@Param			payment	body		MatchInvoiceRequest	false	"Transaction that paid the invoice"
+//	@Success		200		{object}	InvoiceResponse		"Invoice matched successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody	"Invoice or matching transaction not found"
+//	@Failure		409		{object}	ErrorResponseBody	"Invoice already paid or transaction paying another invoice"
+//	@Failure		413		{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/invoices/{id}/match [post]
+func (h *ApiHandlers) MatchInvoice(w http.ResponseWriter, r *http.Request) {
+	// The body is optional, an empty one looks for the payment
+	var req MatchInvoiceRequest
+	if r.ContentLength != 0 {
+		if err := decodeJSON(w, r, &req); err != nil {
+			bodyErrorResponse(w, r, err)
+			return
+		}
+	}
+
+	invoice, err := h.InvoiceUseCase.MatchInvoice(r.Context(), chi.URLParam(r, "id"), req.TransactionID)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, invoiceResponse(invoice))
+}
+
+// UnmatchInvoice forgets the payment of an invoice
+//
+//	@Summary		Unmatch invoice payment
+//	@Description	Forget the transaction that paid an invoice, making it receivable again
+//	@Tags			invoices
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string				true	"Invoice ID"
+//	@Success		200	{object}	InvoiceResponse		"Invoice unmatched successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Invoice not found"
+//	@Failure		409	{object}	ErrorResponseBody	"Invoice not paid"
+//	@Router			/invoices/{id}/match [delete]
+func (h *ApiHandlers) UnmatchInvoice(w http.ResponseWriter, r *http.Request) {
+	invoice, err := h.InvoiceUseCase.UnmatchInvoice(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, invoiceResponse(invoice))
+}
+
+// decodeInvoice reads an InvoiceRequest, answering the request itself when
+// it's invalid
+func decodeInvoice(w http.ResponseWriter, r *http.Request) (entities.Invoice, bool) {
+	var req InvoiceRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return entities.Invoice{}, false
+	}
+
+	if req.DueDate == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("due_date"))
+		return entities.Invoice{}, false
+	}
+	dueDate, err := time.Parse("2006-01-02", req.DueDate)
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("due_date", "must be in format YYYY-MM-DD"))
+		return entities.Invoice{}, false
+	}
+
+	// The use case defaults the issue date to today
+	var issueDate time.Time
+	if req.IssueDate != "" {
+		if issueDate, err = time.Parse("2006-01-02", req.IssueDate); err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("issue_date", "must be in format YYYY-MM-DD"))
+			return entities.Invoice{}, false
+		}
+	}
+
+	// Like transactions, the use case converts the amount to the account asset
+	amountFloat, err := strconv.ParseFloat(req.Amount, 64)
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("amount", "must be a valid decimal number"))
+		return entities.Invoice{}, false
+	}
+	amount, err := monetary.NewMonetary(monetary.USD, big.NewInt(int64(amountFloat*100)))
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("amount", "must be a valid decimal number"))
+		return entities.Invoice{}, false
+	}
+
+	return entities.Invoice{
+		AccountID:   req.AccountID,
+		Client:      req.Client,
+		Number:      req.Number,
+		Description: req.Description,
+		Amount:      *amount,
+		IssueDate:   issueDate,
+		DueDate:     dueDate,
+	}, true
+}
+
+func invoiceResponse(invoice entities.Invoice) InvoiceResponse {
+	response := InvoiceResponse{
+		ID:            invoice.ID,
+		AccountID:     invoice.AccountID,
+		Client:        invoice.Client,
+		Number:        invoice.Number,
+		Description:   invoice.Description,
+		Amount:        invoice.Amount.String(),
+		IssueDate:     invoice.IssueDate.Format("2006-01-02"),
+		DueDate:       invoice.DueDate.Format("2006-01-02"),
+		Status:        invoice.Status,
+		DaysOverdue:   invoice.DaysOverdue,
+		TransactionID: invoice.TransactionID,
+		CreatedAt:     invoice.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     invoice.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if invoice.Payment != nil {
+		payment := transactionEventData(*invoice.Payment)
+		response.Payment = &payment
+	}
+	return response
+}
@@ -0,0 +1,194 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestInvoiceHandlers(t *testing.T) {
+	const invoiceID = "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"
+	const paidID = "8e9f0a1b-2c3d-4e5f-9a6b-7c8d9e0f1a2b"
+	const transactionID = "6f7a8b9c-0d1e-4f2a-8b3c-4d5e6f7a8b9c"
+
+	amount, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(450000))
+	invoice := entities.Invoice{
+		ID:          invoiceID,
+		AccountID:   "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+		Client:      "Acme",
+		Amount:      *amount,
+		IssueDate:   time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC),
+		DueDate:     time.Date(2025, time.April, 10, 0, 0, 0, 0, time.UTC),
+		Status:      entities.InvoiceStatusOverdue,
+		DaysOverdue: 5,
+	}
+	get := func(ctx context.Context, id string) (entities.Invoice, error) {
+		if id != invoiceID && id != paidID {
+			return entities.Invoice{}, fmt.Errorf("invoice %w", domain.ErrNotFound)
+		}
+		return invoice, nil
+	}
+
+	var gotInvoice entities.Invoice
+	var gotStatus entities.InvoiceStatus
+	var gotTransactionID string
+	mockUC := &mocks.InvoiceUseCaseMock{
+		CreateInvoiceFunc: func(ctx context.Context, created entities.Invoice) (entities.Invoice, error) {
+			gotInvoice = created
+			created.ID = invoiceID
+			created.Status = entities.InvoiceStatusOutstanding
+			return created, nil
+		},
+		GetInvoicesFunc: func(ctx context.Context, status entities.InvoiceStatus) ([]entities.Invoice, error) {
+			gotStatus = status
+			return []entities.Invoice{invoice}, nil
+		},
+		GetInvoiceFunc: get,
+		MatchInvoiceFunc: func(ctx context.Context, id, transactionID string) (entities.Invoice, error) {
+			if id == paidID {
+				return entities.Invoice{}, fmt.Errorf("invoice is already paid: %w", domain.ErrConflict)
+			}
+			gotTransactionID = transactionID
+			matched, err := get(ctx, id)
+			matched.Status = entities.InvoiceStatusPaid
+			matched.DaysOverdue = 0
+			matched.TransactionID = transactionID
+			matched.Payment = &entities.Transaction{ID: transactionID, Monetary: *amount, Date: time.Date(2025, time.April, 8, 0, 0, 0, 0, time.UTC)}
+			return matched, err
+		},
+		GetReceivablesFunc: func(ctx context.Context) ([]entities.Receivables, error) {
+			return []entities.Receivables{{
+				Asset:       monetary.BRL,
+				Invoices:    1,
+				Outstanding: *amount,
+				Overdue:     *amount,
+				Ledger:      *amount,
+				Total:       *amount,
+				Clients:     []entities.ClientReceivables{{Client: "Acme", Invoices: 1, Outstanding: *amount, Overdue: *amount, OldestDueDate: invoice.DueDate}},
+			}}, nil
+		},
+	}
+	h := &ApiHandlers{InvoiceUseCase: mockUC}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(method, path, bytes.NewBufferString(body)))
+		return rec
+	}
+
+	t.Run("creates an invoice", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/invoices", `{"account_id":"1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d","client":"Acme","amount":"4500.00","due_date":"2025-04-30"}`)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotInvoice.Client != "Acme" || gotInvoice.Amount.Amount.Int64() != 450000 || !gotInvoice.IssueDate.IsZero() {
+			t.Errorf("unexpected invoice passed to the use case: %+v", gotInvoice)
+		}
+	})
+
+	t.Run("rejects invalid invoices", func(t *testing.T) {
+		for _, body := range []string{
+			`{"client":"Acme","amount":"4500.00"}`,
+			`{"client":"Acme","amount":"4500.00","due_date":"30/04/2025"}`,
+			`{"client":"Acme","amount":"4500.00","issue_date":"yesterday","due_date":"2025-04-30"}`,
+			`{"client":"Acme","amount":"lots","due_date":"2025-04-30"}`,
+		} {
+			if rec := serve(http.MethodPost, "/api/v1/invoices", body); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", body, rec.Code)
+			}
+		}
+	})
+
+	t.Run("lists invoices by status", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/invoices?status=overdue", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotStatus != entities.InvoiceStatusOverdue {
+			t.Errorf("expected status overdue, got %q", gotStatus)
+		}
+
+		var response []InvoiceResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response) != 1 || response[0].Status != entities.InvoiceStatusOverdue || response[0].DaysOverdue != 5 || response[0].DueDate != "2025-04-10" {
+			t.Errorf("unexpected invoices: %+v", response)
+		}
+	})
+
+	t.Run("matches a payment", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/invoices/"+invoiceID+"/match", `{"transaction_id":"`+transactionID+`"}`)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotTransactionID != transactionID {
+			t.Errorf("expected transaction %s, got %q", transactionID, gotTransactionID)
+		}
+
+		var response InvoiceResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Status != entities.InvoiceStatusPaid || response.Payment == nil || response.Payment.ID != transactionID {
+			t.Errorf("unexpected invoice: %+v", response)
+		}
+	})
+
+	t.Run("looks for the payment without a body", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/invoices/"+invoiceID+"/match", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotTransactionID != "" {
+			t.Errorf("expected no transaction, got %q", gotTransactionID)
+		}
+	})
+
+	t.Run("already paid", func(t *testing.T) {
+		if rec := serve(http.MethodPost, "/api/v1/invoices/"+paidID+"/match", ""); rec.Code != http.StatusConflict {
+			t.Errorf("expected status 409, got %d", rec.Code)
+		}
+	})
+
+	t.Run("reports receivables", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/invoices/receivables", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+
+		var response []ReceivablesResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response) != 1 || response[0].Asset != "BRL" || len(response[0].Clients) != 1 || response[0].Clients[0].OldestDueDate != "2025-04-10" {
+			t.Errorf("unexpected receivables: %+v", response)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/invoices/"+transactionID, ""); rec.Code != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", rec.Code)
+		}
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/invoices/not-a-uuid", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
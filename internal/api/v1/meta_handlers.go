@@ -0,0 +1,178 @@
+package v1
+
+import (
+	"finance/domain/entities"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/go-chi/render"
+)
+
+// SchemaResponse describes the data model of this deployment, so importers
+// and other tools can read the entities, enums and currencies instead of
+// hardcoding them. Version is the latest migration shipped with the service
+// and DatabaseVersion the one applied to the database.
+type SchemaResponse struct {
+	Version         int64                  `json:"version" example:"20"`
+	DatabaseVersion int64                  `json:"database_version" example:"20"`
+	Entities        []SchemaEntityResponse `json:"entities"`
+	Enums           []SchemaEnumResponse   `json:"enums"`
+	Assets          []AssetResponse        `json:"assets"`
+}
+
+// SchemaEntityResponse lists the fields of an entity as the API returns it
+type SchemaEntityResponse struct {
+	Name   string                `json:"name" example:"account"`
+	Fields []SchemaFieldResponse `json:"fields"`
+}
+
+// SchemaFieldResponse is a field of an entity. Type is its JSON type and Enum,
+// when set, names the enum listing its values. Optional fields are left out
+// of the response when empty.
+type SchemaFieldResponse struct {
+	Name     string `json:"name" example:"type"`
+	Type     string `json:"type" example:"string"`
+	Enum     string `json:"enum,omitempty" example:"account_type"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+type SchemaEnumResponse struct {
+	Name   string   `json:"name" example:"account_type"`
+	Values []string `json:"values" example:"checking,savings,credit,investment,cash"`
+}
+
+// schemaEntities are the entities described by the schema, by the response
+// type the API returns them as
+var schemaEntities = []struct {
+	name     string
+	response any
+}{
+	{"book", BookResponse{}},
+	{"account", AccountResponse{}},
+	{"balance", BalanceResponse{}},
+	{"category", CategoryResponse{}},
+	{"transaction", TransactionResponse{}},
+	{"installment_plan", InstallmentPlanResponse{}},
+	{"fatura", FaturaResponse{}},
+	{"expense_report", ExpenseReportResponse{}},
+	{"invoice", InvoiceResponse{}},
+	{"project", ProjectResponse{}},
+}
+
+type schemaEnum struct {
+	name   string
+	kind   reflect.Type
+	values []string
+}
+
+// schemaEnums are the enums described by the schema, matched to the entity
+// fields by their type. They're listed on each call, the statement sources
+// include the ones registered by plugins when the service starts.
+func schemaEnums() []schemaEnum {
+	return []schemaEnum{
+		newSchemaEnum("account_type", entities.AccountTypes),
+		newSchemaEnum("account_classification", entities.AccountClassifications),
+		newSchemaEnum("category_type", entities.CategoryTypes),
+		newSchemaEnum("transaction_status", entities.TransactionStatuses),
+		newSchemaEnum("fatura_status", entities.FaturaStatuses),
+		newSchemaEnum("expense_report_status", entities.ExpenseReportStatuses),
+		newSchemaEnum("invoice_status", entities.InvoiceStatuses),
+		newSchemaEnum("statement_source", entities.ListStatementSources()),
+	}
+}
+
+func newSchemaEnum[T ~string](name string, values []T) schemaEnum {
+	enum := schemaEnum{name: name, kind: reflect.TypeFor[T](), values: make([]string, len(values))}
+	for i, value := range values {
+		enum.values[i] = string(value)
+	}
+	return enum
+}
+
+// GetSchema describes the data model of this deployment
+//
+//	@Summary		Get schema
+//	@Description	Describe the entities returned by the API with their fields, the values of the enums like account types and transaction statuses, and the currencies supported by this deployment, custom ones included, so tools can adapt to them instead of hardcoding them. The version is the latest migration shipped with the service.
+//	@Tags			meta
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	SchemaResponse		"Schema retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/meta/schema [get]
+func (h *ApiHandlers) GetSchema(w http.ResponseWriter, r *http.Request) {
+	status, err := h.MigrationUseCase.GetMigrationStatus(r.Context())
+	if err != nil {
+		slog.Error("failed to get migration status", "error", err)
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	assets, err := h.AssetUseCase.GetAssets(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	enums := schemaEnums()
+	response := SchemaResponse{
+		Version:         status.LatestVersion,
+		DatabaseVersion: status.SchemaVersion,
+		Entities:        make([]SchemaEntityResponse, len(schemaEntities)),
+		Enums:           make([]SchemaEnumResponse, len(enums)),
+		Assets:          assetResponses(assets),
+	}
+	for i, entity := range schemaEntities {
+		response.Entities[i] = SchemaEntityResponse{Name: entity.name, Fields: schemaFields(reflect.TypeOf(entity.response), enums)}
+	}
+	for i, enum := range enums {
+		response.Enums[i] = SchemaEnumResponse{Name: enum.name, Values: enum.values}
+	}
+
+	render.JSON(w, r, response)
+}
+
+// schemaFields lists the JSON fields of a response type
+func schemaFields(t reflect.Type, enums []schemaEnum) []SchemaFieldResponse {
+	fields := make([]SchemaFieldResponse, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, options, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		field := SchemaFieldResponse{
+			Name:     name,
+			Type:     schemaType(t.Field(i).Type),
+			Optional: options == "omitempty" || t.Field(i).Type.Kind() == reflect.Pointer,
+		}
+		for _, enum := range enums {
+			if enum.kind == t.Field(i).Type {
+				field.Enum = enum.name
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// schemaType names the JSON type a Go type is encoded as
+func schemaType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return schemaType(t.Elem())
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Struct, reflect.Map:
+		return "object"
+	}
+	return "string"
+}
@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestGetSchema(t *testing.T) {
+	status := entities.MigrationStatus{SchemaVersion: 17, LatestVersion: 18}
+	var statusErr error
+	h := &ApiHandlers{
+		MigrationUseCase: &mocks.MigrationUseCaseMock{
+			GetMigrationStatusFunc: func(ctx context.Context) (entities.MigrationStatus, error) {
+				return status, statusErr
+			},
+		},
+		AssetUseCase: &mocks.AssetUseCaseMock{
+			GetAssetsFunc: func(ctx context.Context) ([]entities.Asset, error) {
+				return []entities.Asset{{Asset: monetary.BRL, Name: "Brazilian Real"}}, nil
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	get := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/meta/schema", nil))
+		return rec
+	}
+
+	t.Run("describes the data model", func(t *testing.T) {
+		rec := get()
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+
+		var response SchemaResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Version != 18 || response.DatabaseVersion != 17 {
+			t.Errorf("unexpected versions %d and %d", response.Version, response.DatabaseVersion)
+		}
+
+		enums := map[string][]string{}
+		for _, enum := range response.Enums {
+			enums[enum.Name] = enum.Values
+		}
+		if !slices.Equal(enums["account_type"], []string{"checking", "savings", "credit", "investment", "cash"}) {
+			t.Errorf("unexpected account types: %v", enums["account_type"])
+		}
+		if !slices.Equal(enums["transaction_status"], []string{"pending", "cleared", "cancelled", "draft"}) {
+			t.Errorf("unexpected transaction statuses: %v", enums["transaction_status"])
+		}
+
+		fields := map[string]SchemaFieldResponse{}
+		for _, entity := range response.Entities {
+			if entity.Name != "account" {
+				continue
+			}
+			for _, field := range entity.Fields {
+				fields[field.Name] = field
+			}
+		}
+		if field := fields["type"]; field.Type != "string" || field.Enum != "account_type" || field.Optional {
+			t.Errorf("unexpected account type field: %+v", field)
+		}
+		if field := fields["statement_closing_day"]; field.Type != "integer" || !field.Optional {
+			t.Errorf("unexpected statement closing day field: %+v", field)
+		}
+		if field := fields["balance"]; field.Type != "object" || !field.Optional {
+			t.Errorf("unexpected balance field: %+v", field)
+		}
+
+		if len(response.Assets) != 1 || response.Assets[0] != (AssetResponse{Code: "BRL", Name: "Brazilian Real", Symbol: "R$", Precision: 2, Class: "currency"}) {
+			t.Errorf("unexpected assets: %+v", response.Assets)
+		}
+	})
+
+	t.Run("migration status failure", func(t *testing.T) {
+		statusErr = errors.New("connection refused")
+		defer func() { statusErr = nil }()
+
+		if rec := get(); rec.Code != http.StatusInternalServerError {
+			t.Errorf("expected status 500, got %d", rec.Code)
+		}
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"finance/domain/entities"
 	"sync"
+	"time"
 )
 
 // AccountUseCaseMock is a mock implementation of v1.AccountUseCase.
@@ -24,8 +25,17 @@ import (
 //			GetAccountByIDFunc: func(ctx context.Context, id string) (entities.Account, error) {
 //				panic("mock out the GetAccountByID method")
 //			},
-//			GetAllAccountsFunc: func(ctx context.Context) ([]entities.Account, error) {
-//				panic("mock out the GetAllAccounts method")
+//			GetAccountPeriodSummaryFunc: func(ctx context.Context, id string, from time.Time, to time.Time) (entities.AccountPeriodSummary, error) {
+//				panic("mock out the GetAccountPeriodSummary method")
+//			},
+//			GetAccountStatementFunc: func(ctx context.Context, id string, from time.Time, to time.Time, filter entities.TransactionFilter) (entities.AccountStatement, error) {
+//				panic("mock out the GetAccountStatement method")
+//			},
+//			GetAccountWithBalanceFunc: func(ctx context.Context, id string) (entities.Account, error) {
+//				panic("mock out the GetAccountWithBalance method")
+//			},
+//			GetAccountsPageFunc: func(ctx context.Context, sort []entities.SortField, limit int, offset int, withBalances bool) (entities.Page[entities.Account], error) {
+//				panic("mock out the GetAccountsPage method")
 //			},
 //			UpdateAccountFunc: func(ctx context.Context, account entities.Account) (entities.Account, error) {
 //				panic("mock out the UpdateAccount method")
@@ -46,8 +56,17 @@ type AccountUseCaseMock struct {
 	// GetAccountByIDFunc mocks the GetAccountByID method.
 	GetAccountByIDFunc func(ctx context.Context, id string) (entities.Account, error)
 
-	// GetAllAccountsFunc mocks the GetAllAccounts method.
-	GetAllAccountsFunc func(ctx context.Context) ([]entities.Account, error)
+	// GetAccountPeriodSummaryFunc mocks the GetAccountPeriodSummary method.
+	GetAccountPeriodSummaryFunc func(ctx context.Context, id string, from time.Time, to time.Time) (entities.AccountPeriodSummary, error)
+
+	// GetAccountStatementFunc mocks the GetAccountStatement method.
+	GetAccountStatementFunc func(ctx context.Context, id string, from time.Time, to time.Time, filter entities.TransactionFilter) (entities.AccountStatement, error)
+
+	// GetAccountWithBalanceFunc mocks the GetAccountWithBalance method.
+	GetAccountWithBalanceFunc func(ctx context.Context, id string) (entities.Account, error)
+
+	// GetAccountsPageFunc mocks the GetAccountsPage method.
+	GetAccountsPageFunc func(ctx context.Context, sort []entities.SortField, limit int, offset int, withBalances bool) (entities.Page[entities.Account], error)
 
 	// UpdateAccountFunc mocks the UpdateAccount method.
 	UpdateAccountFunc func(ctx context.Context, account entities.Account) (entities.Account, error)
@@ -75,10 +94,49 @@ type AccountUseCaseMock struct {
 			// ID is the id argument value.
 			ID string
 		}
-		// GetAllAccounts holds details about calls to the GetAllAccounts method.
-		GetAllAccounts []struct {
+		// GetAccountPeriodSummary holds details about calls to the GetAccountPeriodSummary method.
+		GetAccountPeriodSummary []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+		// GetAccountStatement holds details about calls to the GetAccountStatement method.
+		GetAccountStatement []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+			// Filter is the filter argument value.
+			Filter entities.TransactionFilter
+		}
+		// GetAccountWithBalance holds details about calls to the GetAccountWithBalance method.
+		GetAccountWithBalance []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// GetAccountsPage holds details about calls to the GetAccountsPage method.
+		GetAccountsPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Sort is the sort argument value.
+			Sort []entities.SortField
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+			// WithBalances is the withBalances argument value.
+			WithBalances bool
 		}
 		// UpdateAccount holds details about calls to the UpdateAccount method.
 		UpdateAccount []struct {
@@ -88,11 +146,14 @@ type AccountUseCaseMock struct {
 			Account entities.Account
 		}
 	}
-	lockCreateAccount  sync.RWMutex
-	lockDeleteAccount  sync.RWMutex
-	lockGetAccountByID sync.RWMutex
-	lockGetAllAccounts sync.RWMutex
-	lockUpdateAccount  sync.RWMutex
+	lockCreateAccount           sync.RWMutex
+	lockDeleteAccount           sync.RWMutex
+	lockGetAccountByID          sync.RWMutex
+	lockGetAccountPeriodSummary sync.RWMutex
+	lockGetAccountStatement     sync.RWMutex
+	lockGetAccountWithBalance   sync.RWMutex
+	lockGetAccountsPage         sync.RWMutex
+	lockUpdateAccount           sync.RWMutex
 }
 
 // CreateAccount calls CreateAccountFunc.
@@ -214,39 +275,195 @@ func (mock *AccountUseCaseMock) GetAccountByIDCalls() []struct {
 	return calls
 }
 
-// GetAllAccounts calls GetAllAccountsFunc.
-func (mock *AccountUseCaseMock) GetAllAccounts(ctx context.Context) ([]entities.Account, error) {
+// GetAccountPeriodSummary calls GetAccountPeriodSummaryFunc.
+func (mock *AccountUseCaseMock) GetAccountPeriodSummary(ctx context.Context, id string, from time.Time, to time.Time) (entities.AccountPeriodSummary, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		ID   string
+		From time.Time
+		To   time.Time
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		From: from,
+		To:   to,
+	}
+	mock.lockGetAccountPeriodSummary.Lock()
+	mock.calls.GetAccountPeriodSummary = append(mock.calls.GetAccountPeriodSummary, callInfo)
+	mock.lockGetAccountPeriodSummary.Unlock()
+	if mock.GetAccountPeriodSummaryFunc == nil {
+		var (
+			accountPeriodSummaryOut entities.AccountPeriodSummary
+			errOut                  error
+		)
+		return accountPeriodSummaryOut, errOut
+	}
+	return mock.GetAccountPeriodSummaryFunc(ctx, id, from, to)
+}
+
+// GetAccountPeriodSummaryCalls gets all the calls that were made to GetAccountPeriodSummary.
+// Check the length with:
+//
+//	len(mockedAccountUseCase.GetAccountPeriodSummaryCalls())
+func (mock *AccountUseCaseMock) GetAccountPeriodSummaryCalls() []struct {
+	Ctx  context.Context
+	ID   string
+	From time.Time
+	To   time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   string
+		From time.Time
+		To   time.Time
+	}
+	mock.lockGetAccountPeriodSummary.RLock()
+	calls = mock.calls.GetAccountPeriodSummary
+	mock.lockGetAccountPeriodSummary.RUnlock()
+	return calls
+}
+
+// GetAccountStatement calls GetAccountStatementFunc.
+func (mock *AccountUseCaseMock) GetAccountStatement(ctx context.Context, id string, from time.Time, to time.Time, filter entities.TransactionFilter) (entities.AccountStatement, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		ID     string
+		From   time.Time
+		To     time.Time
+		Filter entities.TransactionFilter
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		From:   from,
+		To:     to,
+		Filter: filter,
+	}
+	mock.lockGetAccountStatement.Lock()
+	mock.calls.GetAccountStatement = append(mock.calls.GetAccountStatement, callInfo)
+	mock.lockGetAccountStatement.Unlock()
+	if mock.GetAccountStatementFunc == nil {
+		var (
+			accountStatementOut entities.AccountStatement
+			errOut              error
+		)
+		return accountStatementOut, errOut
+	}
+	return mock.GetAccountStatementFunc(ctx, id, from, to, filter)
+}
+
+// GetAccountStatementCalls gets all the calls that were made to GetAccountStatement.
+// Check the length with:
+//
+//	len(mockedAccountUseCase.GetAccountStatementCalls())
+func (mock *AccountUseCaseMock) GetAccountStatementCalls() []struct {
+	Ctx    context.Context
+	ID     string
+	From   time.Time
+	To     time.Time
+	Filter entities.TransactionFilter
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     string
+		From   time.Time
+		To     time.Time
+		Filter entities.TransactionFilter
+	}
+	mock.lockGetAccountStatement.RLock()
+	calls = mock.calls.GetAccountStatement
+	mock.lockGetAccountStatement.RUnlock()
+	return calls
+}
+
+// GetAccountWithBalance calls GetAccountWithBalanceFunc.
+func (mock *AccountUseCaseMock) GetAccountWithBalance(ctx context.Context, id string) (entities.Account, error) {
 	callInfo := struct {
 		Ctx context.Context
+		ID  string
 	}{
 		Ctx: ctx,
+		ID:  id,
 	}
-	mock.lockGetAllAccounts.Lock()
-	mock.calls.GetAllAccounts = append(mock.calls.GetAllAccounts, callInfo)
-	mock.lockGetAllAccounts.Unlock()
-	if mock.GetAllAccountsFunc == nil {
+	mock.lockGetAccountWithBalance.Lock()
+	mock.calls.GetAccountWithBalance = append(mock.calls.GetAccountWithBalance, callInfo)
+	mock.lockGetAccountWithBalance.Unlock()
+	if mock.GetAccountWithBalanceFunc == nil {
 		var (
-			accountsOut []entities.Account
-			errOut      error
+			accountOut entities.Account
+			errOut     error
 		)
-		return accountsOut, errOut
+		return accountOut, errOut
 	}
-	return mock.GetAllAccountsFunc(ctx)
+	return mock.GetAccountWithBalanceFunc(ctx, id)
 }
 
-// GetAllAccountsCalls gets all the calls that were made to GetAllAccounts.
+// GetAccountWithBalanceCalls gets all the calls that were made to GetAccountWithBalance.
 // Check the length with:
 //
-//	len(mockedAccountUseCase.GetAllAccountsCalls())
-func (mock *AccountUseCaseMock) GetAllAccountsCalls() []struct {
+//	len(mockedAccountUseCase.GetAccountWithBalanceCalls())
+func (mock *AccountUseCaseMock) GetAccountWithBalanceCalls() []struct {
 	Ctx context.Context
+	ID  string
 } {
 	var calls []struct {
 		Ctx context.Context
+		ID  string
+	}
+	mock.lockGetAccountWithBalance.RLock()
+	calls = mock.calls.GetAccountWithBalance
+	mock.lockGetAccountWithBalance.RUnlock()
+	return calls
+}
+
+// GetAccountsPage calls GetAccountsPageFunc.
+func (mock *AccountUseCaseMock) GetAccountsPage(ctx context.Context, sort []entities.SortField, limit int, offset int, withBalances bool) (entities.Page[entities.Account], error) {
+	callInfo := struct {
+		Ctx          context.Context
+		Sort         []entities.SortField
+		Limit        int
+		Offset       int
+		WithBalances bool
+	}{
+		Ctx:          ctx,
+		Sort:         sort,
+		Limit:        limit,
+		Offset:       offset,
+		WithBalances: withBalances,
+	}
+	mock.lockGetAccountsPage.Lock()
+	mock.calls.GetAccountsPage = append(mock.calls.GetAccountsPage, callInfo)
+	mock.lockGetAccountsPage.Unlock()
+	if mock.GetAccountsPageFunc == nil {
+		var (
+			vOut   entities.Page[entities.Account]
+			errOut error
+		)
+		return vOut, errOut
+	}
+	return mock.GetAccountsPageFunc(ctx, sort, limit, offset, withBalances)
+}
+
+// GetAccountsPageCalls gets all the calls that were made to GetAccountsPage.
+// Check the length with:
+//
+//	len(mockedAccountUseCase.GetAccountsPageCalls())
+func (mock *AccountUseCaseMock) GetAccountsPageCalls() []struct {
+	Ctx          context.Context
+	Sort         []entities.SortField
+	Limit        int
+	Offset       int
+	WithBalances bool
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Sort         []entities.SortField
+		Limit        int
+		Offset       int
+		WithBalances bool
 	}
-	mock.lockGetAllAccounts.RLock()
-	calls = mock.calls.GetAllAccounts
-	mock.lockGetAllAccounts.RUnlock()
+	mock.lockGetAccountsPage.RLock()
+	calls = mock.calls.GetAccountsPage
+	mock.lockGetAccountsPage.RUnlock()
 	return calls
 }
 
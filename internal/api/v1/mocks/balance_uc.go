@@ -7,6 +7,7 @@ import (
 	"context"
 	"finance/domain/entities"
 	"sync"
+	"time"
 )
 
 // BalanceUseCaseMock is a mock implementation of v1.BalanceUseCase.
@@ -21,15 +22,33 @@ import (
 //			GetBalanceByAccountIDFunc: func(ctx context.Context, accountID string) (entities.Balance, error) {
 //				panic("mock out the GetBalanceByAccountID method")
 //			},
+//			GetBalanceHistoryFunc: func(ctx context.Context, accountID string, granularity entities.BalanceGranularity, from time.Time, to time.Time) (entities.BalanceHistory, error) {
+//				panic("mock out the GetBalanceHistory method")
+//			},
+//			GetBalanceRefreshStatusFunc: func(ctx context.Context) (entities.BalanceRefreshStatus, error) {
+//				panic("mock out the GetBalanceRefreshStatus method")
+//			},
 //			GetBalanceSummaryFunc: func(ctx context.Context) (entities.BalanceSummary, error) {
 //				panic("mock out the GetBalanceSummary method")
 //			},
+//			GetBalancesPageFunc: func(ctx context.Context, limit int, offset int) (entities.Page[entities.Balance], error) {
+//				panic("mock out the GetBalancesPage method")
+//			},
+//			GetGroupedBalancesFunc: func(ctx context.Context, by []entities.BalanceGroupField) ([]entities.BalanceGroup, error) {
+//				panic("mock out the GetGroupedBalances method")
+//			},
+//			GetNetWorthHistoryFunc: func(ctx context.Context, granularity entities.BalanceGranularity, from time.Time, to time.Time) (entities.NetWorthHistory, error) {
+//				panic("mock out the GetNetWorthHistory method")
+//			},
 //			RefreshAccountBalanceFunc: func(ctx context.Context, accountID string) error {
 //				panic("mock out the RefreshAccountBalance method")
 //			},
 //			RefreshAllBalancesFunc: func(ctx context.Context) error {
 //				panic("mock out the RefreshAllBalances method")
 //			},
+//			StartBalanceRefreshFunc: func(ctx context.Context) (entities.BalanceRefreshStatus, error) {
+//				panic("mock out the StartBalanceRefresh method")
+//			},
 //		}
 //
 //		// use mockedBalanceUseCase in code that requires v1.BalanceUseCase
@@ -43,15 +62,33 @@ type BalanceUseCaseMock struct {
 	// GetBalanceByAccountIDFunc mocks the GetBalanceByAccountID method.
 	GetBalanceByAccountIDFunc func(ctx context.Context, accountID string) (entities.Balance, error)
 
+	// GetBalanceHistoryFunc mocks the GetBalanceHistory method.
+	GetBalanceHistoryFunc func(ctx context.Context, accountID string, granularity entities.BalanceGranularity, from time.Time, to time.Time) (entities.BalanceHistory, error)
+
+	// GetBalanceRefreshStatusFunc mocks the GetBalanceRefreshStatus method.
+	GetBalanceRefreshStatusFunc func(ctx context.Context) (entities.BalanceRefreshStatus, error)
+
 	// GetBalanceSummaryFunc mocks the GetBalanceSummary method.
 	GetBalanceSummaryFunc func(ctx context.Context) (entities.BalanceSummary, error)
 
+	// GetBalancesPageFunc mocks the GetBalancesPage method.
+	GetBalancesPageFunc func(ctx context.Context, limit int, offset int) (entities.Page[entities.Balance], error)
+
+	// GetGroupedBalancesFunc mocks the GetGroupedBalances method.
+	GetGroupedBalancesFunc func(ctx context.Context, by []entities.BalanceGroupField) ([]entities.BalanceGroup, error)
+
+	// GetNetWorthHistoryFunc mocks the GetNetWorthHistory method.
+	GetNetWorthHistoryFunc func(ctx context.Context, granularity entities.BalanceGranularity, from time.Time, to time.Time) (entities.NetWorthHistory, error)
+
 	// RefreshAccountBalanceFunc mocks the RefreshAccountBalance method.
 	RefreshAccountBalanceFunc func(ctx context.Context, accountID string) error
 
 	// RefreshAllBalancesFunc mocks the RefreshAllBalances method.
 	RefreshAllBalancesFunc func(ctx context.Context) error
 
+	// StartBalanceRefreshFunc mocks the StartBalanceRefresh method.
+	StartBalanceRefreshFunc func(ctx context.Context) (entities.BalanceRefreshStatus, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// GetAllBalances holds details about calls to the GetAllBalances method.
@@ -66,11 +103,56 @@ type BalanceUseCaseMock struct {
 			// AccountID is the accountID argument value.
 			AccountID string
 		}
+		// GetBalanceHistory holds details about calls to the GetBalanceHistory method.
+		GetBalanceHistory []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountID is the accountID argument value.
+			AccountID string
+			// Granularity is the granularity argument value.
+			Granularity entities.BalanceGranularity
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+		// GetBalanceRefreshStatus holds details about calls to the GetBalanceRefreshStatus method.
+		GetBalanceRefreshStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// GetBalanceSummary holds details about calls to the GetBalanceSummary method.
 		GetBalanceSummary []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// GetBalancesPage holds details about calls to the GetBalancesPage method.
+		GetBalancesPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
+		// GetGroupedBalances holds details about calls to the GetGroupedBalances method.
+		GetGroupedBalances []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// By is the by argument value.
+			By []entities.BalanceGroupField
+		}
+		// GetNetWorthHistory holds details about calls to the GetNetWorthHistory method.
+		GetNetWorthHistory []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Granularity is the granularity argument value.
+			Granularity entities.BalanceGranularity
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
 		// RefreshAccountBalance holds details about calls to the RefreshAccountBalance method.
 		RefreshAccountBalance []struct {
 			// Ctx is the ctx argument value.
@@ -83,12 +165,23 @@ type BalanceUseCaseMock struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// StartBalanceRefresh holds details about calls to the StartBalanceRefresh method.
+		StartBalanceRefresh []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 	}
-	lockGetAllBalances        sync.RWMutex
-	lockGetBalanceByAccountID sync.RWMutex
-	lockGetBalanceSummary     sync.RWMutex
-	lockRefreshAccountBalance sync.RWMutex
-	lockRefreshAllBalances    sync.RWMutex
+	lockGetAllBalances          sync.RWMutex
+	lockGetBalanceByAccountID   sync.RWMutex
+	lockGetBalanceHistory       sync.RWMutex
+	lockGetBalanceRefreshStatus sync.RWMutex
+	lockGetBalanceSummary       sync.RWMutex
+	lockGetBalancesPage         sync.RWMutex
+	lockGetGroupedBalances      sync.RWMutex
+	lockGetNetWorthHistory      sync.RWMutex
+	lockRefreshAccountBalance   sync.RWMutex
+	lockRefreshAllBalances      sync.RWMutex
+	lockStartBalanceRefresh     sync.RWMutex
 }
 
 // GetAllBalances calls GetAllBalancesFunc.
@@ -167,6 +260,94 @@ func (mock *BalanceUseCaseMock) GetBalanceByAccountIDCalls() []struct {
 	return calls
 }
 
+// GetBalanceHistory calls GetBalanceHistoryFunc.
+func (mock *BalanceUseCaseMock) GetBalanceHistory(ctx context.Context, accountID string, granularity entities.BalanceGranularity, from time.Time, to time.Time) (entities.BalanceHistory, error) {
+	callInfo := struct {
+		Ctx         context.Context
+		AccountID   string
+		Granularity entities.BalanceGranularity
+		From        time.Time
+		To          time.Time
+	}{
+		Ctx:         ctx,
+		AccountID:   accountID,
+		Granularity: granularity,
+		From:        from,
+		To:          to,
+	}
+	mock.lockGetBalanceHistory.Lock()
+	mock.calls.GetBalanceHistory = append(mock.calls.GetBalanceHistory, callInfo)
+	mock.lockGetBalanceHistory.Unlock()
+	if mock.GetBalanceHistoryFunc == nil {
+		var (
+			balanceHistoryOut entities.BalanceHistory
+			errOut            error
+		)
+		return balanceHistoryOut, errOut
+	}
+	return mock.GetBalanceHistoryFunc(ctx, accountID, granularity, from, to)
+}
+
+// GetBalanceHistoryCalls gets all the calls that were made to GetBalanceHistory.
+// Check the length with:
+//
+//	len(mockedBalanceUseCase.GetBalanceHistoryCalls())
+func (mock *BalanceUseCaseMock) GetBalanceHistoryCalls() []struct {
+	Ctx         context.Context
+	AccountID   string
+	Granularity entities.BalanceGranularity
+	From        time.Time
+	To          time.Time
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountID   string
+		Granularity entities.BalanceGranularity
+		From        time.Time
+		To          time.Time
+	}
+	mock.lockGetBalanceHistory.RLock()
+	calls = mock.calls.GetBalanceHistory
+	mock.lockGetBalanceHistory.RUnlock()
+	return calls
+}
+
+// GetBalanceRefreshStatus calls GetBalanceRefreshStatusFunc.
+func (mock *BalanceUseCaseMock) GetBalanceRefreshStatus(ctx context.Context) (entities.BalanceRefreshStatus, error) {
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetBalanceRefreshStatus.Lock()
+	mock.calls.GetBalanceRefreshStatus = append(mock.calls.GetBalanceRefreshStatus, callInfo)
+	mock.lockGetBalanceRefreshStatus.Unlock()
+	if mock.GetBalanceRefreshStatusFunc == nil {
+		var (
+			balanceRefreshStatusOut entities.BalanceRefreshStatus
+			errOut                  error
+		)
+		return balanceRefreshStatusOut, errOut
+	}
+	return mock.GetBalanceRefreshStatusFunc(ctx)
+}
+
+// GetBalanceRefreshStatusCalls gets all the calls that were made to GetBalanceRefreshStatus.
+// Check the length with:
+//
+//	len(mockedBalanceUseCase.GetBalanceRefreshStatusCalls())
+func (mock *BalanceUseCaseMock) GetBalanceRefreshStatusCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetBalanceRefreshStatus.RLock()
+	calls = mock.calls.GetBalanceRefreshStatus
+	mock.lockGetBalanceRefreshStatus.RUnlock()
+	return calls
+}
+
 // GetBalanceSummary calls GetBalanceSummaryFunc.
 func (mock *BalanceUseCaseMock) GetBalanceSummary(ctx context.Context) (entities.BalanceSummary, error) {
 	callInfo := struct {
@@ -203,6 +384,138 @@ func (mock *BalanceUseCaseMock) GetBalanceSummaryCalls() []struct {
 	return calls
 }
 
+// GetBalancesPage calls GetBalancesPageFunc.
+func (mock *BalanceUseCaseMock) GetBalancesPage(ctx context.Context, limit int, offset int) (entities.Page[entities.Balance], error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockGetBalancesPage.Lock()
+	mock.calls.GetBalancesPage = append(mock.calls.GetBalancesPage, callInfo)
+	mock.lockGetBalancesPage.Unlock()
+	if mock.GetBalancesPageFunc == nil {
+		var (
+			vOut   entities.Page[entities.Balance]
+			errOut error
+		)
+		return vOut, errOut
+	}
+	return mock.GetBalancesPageFunc(ctx, limit, offset)
+}
+
+// GetBalancesPageCalls gets all the calls that were made to GetBalancesPage.
+// Check the length with:
+//
+//	len(mockedBalanceUseCase.GetBalancesPageCalls())
+func (mock *BalanceUseCaseMock) GetBalancesPageCalls() []struct {
+	Ctx    context.Context
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Limit  int
+		Offset int
+	}
+	mock.lockGetBalancesPage.RLock()
+	calls = mock.calls.GetBalancesPage
+	mock.lockGetBalancesPage.RUnlock()
+	return calls
+}
+
+// GetGroupedBalances calls GetGroupedBalancesFunc.
+func (mock *BalanceUseCaseMock) GetGroupedBalances(ctx context.Context, by []entities.BalanceGroupField) ([]entities.BalanceGroup, error) {
+	callInfo := struct {
+		Ctx context.Context
+		By  []entities.BalanceGroupField
+	}{
+		Ctx: ctx,
+		By:  by,
+	}
+	mock.lockGetGroupedBalances.Lock()
+	mock.calls.GetGroupedBalances = append(mock.calls.GetGroupedBalances, callInfo)
+	mock.lockGetGroupedBalances.Unlock()
+	if mock.GetGroupedBalancesFunc == nil {
+		var (
+			balanceGroupsOut []entities.BalanceGroup
+			errOut           error
+		)
+		return balanceGroupsOut, errOut
+	}
+	return mock.GetGroupedBalancesFunc(ctx, by)
+}
+
+// GetGroupedBalancesCalls gets all the calls that were made to GetGroupedBalances.
+// Check the length with:
+//
+//	len(mockedBalanceUseCase.GetGroupedBalancesCalls())
+func (mock *BalanceUseCaseMock) GetGroupedBalancesCalls() []struct {
+	Ctx context.Context
+	By  []entities.BalanceGroupField
+} {
+	var calls []struct {
+		Ctx context.Context
+		By  []entities.BalanceGroupField
+	}
+	mock.lockGetGroupedBalances.RLock()
+	calls = mock.calls.GetGroupedBalances
+	mock.lockGetGroupedBalances.RUnlock()
+	return calls
+}
+
+// GetNetWorthHistory calls GetNetWorthHistoryFunc.
+func (mock *BalanceUseCaseMock) GetNetWorthHistory(ctx context.Context, granularity entities.BalanceGranularity, from time.Time, to time.Time) (entities.NetWorthHistory, error) {
+	callInfo := struct {
+		Ctx         context.Context
+		Granularity entities.BalanceGranularity
+		From        time.Time
+		To          time.Time
+	}{
+		Ctx:         ctx,
+		Granularity: granularity,
+		From:        from,
+		To:          to,
+	}
+	mock.lockGetNetWorthHistory.Lock()
+	mock.calls.GetNetWorthHistory = append(mock.calls.GetNetWorthHistory, callInfo)
+	mock.lockGetNetWorthHistory.Unlock()
+	if mock.GetNetWorthHistoryFunc == nil {
+		var (
+			netWorthHistoryOut entities.NetWorthHistory
+			errOut             error
+		)
+		return netWorthHistoryOut, errOut
+	}
+	return mock.GetNetWorthHistoryFunc(ctx, granularity, from, to)
+}
+
+// GetNetWorthHistoryCalls gets all the calls that were made to GetNetWorthHistory.
+// Check the length with:
+//
+//	len(mockedBalanceUseCase.GetNetWorthHistoryCalls())
+func (mock *BalanceUseCaseMock) GetNetWorthHistoryCalls() []struct {
+	Ctx         context.Context
+	Granularity entities.BalanceGranularity
+	From        time.Time
+	To          time.Time
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Granularity entities.BalanceGranularity
+		From        time.Time
+		To          time.Time
+	}
+	mock.lockGetNetWorthHistory.RLock()
+	calls = mock.calls.GetNetWorthHistory
+	mock.lockGetNetWorthHistory.RUnlock()
+	return calls
+}
+
 // RefreshAccountBalance calls RefreshAccountBalanceFunc.
 func (mock *BalanceUseCaseMock) RefreshAccountBalance(ctx context.Context, accountID string) error {
 	callInfo := struct {
@@ -276,3 +589,39 @@ func (mock *BalanceUseCaseMock) RefreshAllBalancesCalls() []struct {
 	mock.lockRefreshAllBalances.RUnlock()
 	return calls
 }
+
+// StartBalanceRefresh calls StartBalanceRefreshFunc.
+func (mock *BalanceUseCaseMock) StartBalanceRefresh(ctx context.Context) (entities.BalanceRefreshStatus, error) {
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockStartBalanceRefresh.Lock()
+	mock.calls.StartBalanceRefresh = append(mock.calls.StartBalanceRefresh, callInfo)
+	mock.lockStartBalanceRefresh.Unlock()
+	if mock.StartBalanceRefreshFunc == nil {
+		var (
+			balanceRefreshStatusOut entities.BalanceRefreshStatus
+			errOut                  error
+		)
+		return balanceRefreshStatusOut, errOut
+	}
+	return mock.StartBalanceRefreshFunc(ctx)
+}
+
+// StartBalanceRefreshCalls gets all the calls that were made to StartBalanceRefresh.
+// Check the length with:
+//
+//	len(mockedBalanceUseCase.StartBalanceRefreshCalls())
+func (mock *BalanceUseCaseMock) StartBalanceRefreshCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockStartBalanceRefresh.RLock()
+	calls = mock.calls.StartBalanceRefresh
+	mock.lockStartBalanceRefresh.RUnlock()
+	return calls
+}
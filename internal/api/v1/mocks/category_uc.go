@@ -21,8 +21,8 @@ import (
 //			DeleteCategoryFunc: func(ctx context.Context, id string) error {
 //				panic("mock out the DeleteCategory method")
 //			},
-//			GetAllCategoriesFunc: func(ctx context.Context) ([]entities.Category, error) {
-//				panic("mock out the GetAllCategories method")
+//			GetCategoriesPageFunc: func(ctx context.Context, sort []entities.SortField, limit int, offset int) (entities.Page[entities.Category], error) {
+//				panic("mock out the GetCategoriesPage method")
 //			},
 //			GetCategoryByIDFunc: func(ctx context.Context, id string) (entities.Category, error) {
 //				panic("mock out the GetCategoryByID method")
@@ -43,8 +43,8 @@ type CategoryUseCaseMock struct {
 	// DeleteCategoryFunc mocks the DeleteCategory method.
 	DeleteCategoryFunc func(ctx context.Context, id string) error
 
-	// GetAllCategoriesFunc mocks the GetAllCategories method.
-	GetAllCategoriesFunc func(ctx context.Context) ([]entities.Category, error)
+	// GetCategoriesPageFunc mocks the GetCategoriesPage method.
+	GetCategoriesPageFunc func(ctx context.Context, sort []entities.SortField, limit int, offset int) (entities.Page[entities.Category], error)
 
 	// GetCategoryByIDFunc mocks the GetCategoryByID method.
 	GetCategoryByIDFunc func(ctx context.Context, id string) (entities.Category, error)
@@ -68,10 +68,16 @@ type CategoryUseCaseMock struct {
 			// ID is the id argument value.
 			ID string
 		}
-		// GetAllCategories holds details about calls to the GetAllCategories method.
-		GetAllCategories []struct {
+		// GetCategoriesPage holds details about calls to the GetCategoriesPage method.
+		GetCategoriesPage []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// Sort is the sort argument value.
+			Sort []entities.SortField
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
 		}
 		// GetCategoryByID holds details about calls to the GetCategoryByID method.
 		GetCategoryByID []struct {
@@ -88,11 +94,11 @@ type CategoryUseCaseMock struct {
 			Category entities.Category
 		}
 	}
-	lockCreateCategory   sync.RWMutex
-	lockDeleteCategory   sync.RWMutex
-	lockGetAllCategories sync.RWMutex
-	lockGetCategoryByID  sync.RWMutex
-	lockUpdateCategory   sync.RWMutex
+	lockCreateCategory    sync.RWMutex
+	lockDeleteCategory    sync.RWMutex
+	lockGetCategoriesPage sync.RWMutex
+	lockGetCategoryByID   sync.RWMutex
+	lockUpdateCategory    sync.RWMutex
 }
 
 // CreateCategory calls CreateCategoryFunc.
@@ -174,39 +180,51 @@ func (mock *CategoryUseCaseMock) DeleteCategoryCalls() []struct {
 	return calls
 }
 
-// GetAllCategories calls GetAllCategoriesFunc.
-func (mock *CategoryUseCaseMock) GetAllCategories(ctx context.Context) ([]entities.Category, error) {
+// GetCategoriesPage calls GetCategoriesPageFunc.
+func (mock *CategoryUseCaseMock) GetCategoriesPage(ctx context.Context, sort []entities.SortField, limit int, offset int) (entities.Page[entities.Category], error) {
 	callInfo := struct {
-		Ctx context.Context
+		Ctx    context.Context
+		Sort   []entities.SortField
+		Limit  int
+		Offset int
 	}{
-		Ctx: ctx,
+		Ctx:    ctx,
+		Sort:   sort,
+		Limit:  limit,
+		Offset: offset,
 	}
-	mock.lockGetAllCategories.Lock()
-	mock.calls.GetAllCategories = append(mock.calls.GetAllCategories, callInfo)
-	mock.lockGetAllCategories.Unlock()
-	if mock.GetAllCategoriesFunc == nil {
+	mock.lockGetCategoriesPage.Lock()
+	mock.calls.GetCategoriesPage = append(mock.calls.GetCategoriesPage, callInfo)
+	mock.lockGetCategoriesPage.Unlock()
+	if mock.GetCategoriesPageFunc == nil {
 		var (
-			categorysOut []entities.Category
-			errOut       error
+			vOut   entities.Page[entities.Category]
+			errOut error
 		)
-		return categorysOut, errOut
+		return vOut, errOut
 	}
-	return mock.GetAllCategoriesFunc(ctx)
+	return mock.GetCategoriesPageFunc(ctx, sort, limit, offset)
 }
 
-// GetAllCategoriesCalls gets all the calls that were made to GetAllCategories.
+// GetCategoriesPageCalls gets all the calls that were made to GetCategoriesPage.
 // Check the length with:
 //
-//	len(mockedCategoryUseCase.GetAllCategoriesCalls())
-func (mock *CategoryUseCaseMock) GetAllCategoriesCalls() []struct {
-	Ctx context.Context
+//	len(mockedCategoryUseCase.GetCategoriesPageCalls())
+func (mock *CategoryUseCaseMock) GetCategoriesPageCalls() []struct {
+	Ctx    context.Context
+	Sort   []entities.SortField
+	Limit  int
+	Offset int
 } {
 	var calls []struct {
-		Ctx context.Context
+		Ctx    context.Context
+		Sort   []entities.SortField
+		Limit  int
+		Offset int
 	}
-	mock.lockGetAllCategories.RLock()
-	calls = mock.calls.GetAllCategories
-	mock.lockGetAllCategories.RUnlock()
+	mock.lockGetCategoriesPage.RLock()
+	calls = mock.calls.GetCategoriesPage
+	mock.lockGetCategoriesPage.RUnlock()
 	return calls
 }
 
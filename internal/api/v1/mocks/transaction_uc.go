@@ -7,6 +7,7 @@ import (
 	"context"
 	"finance/domain/entities"
 	"sync"
+	"time"
 )
 
 // TransactionUseCaseMock is a mock implementation of v1.TransactionUseCase.
@@ -15,17 +16,41 @@ import (
 //
 //		// make and configure a mocked v1.TransactionUseCase
 //		mockedTransactionUseCase := &TransactionUseCaseMock{
+//			BulkTransactionsFunc: func(ctx context.Context, operations []entities.BulkOperation) ([]entities.BulkResult, error) {
+//				panic("mock out the BulkTransactions method")
+//			},
+//			CreateInstallmentPurchaseFunc: func(ctx context.Context, transaction entities.Transaction, installments int) (entities.InstallmentPlan, error) {
+//				panic("mock out the CreateInstallmentPurchase method")
+//			},
 //			CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
 //				panic("mock out the CreateTransaction method")
 //			},
 //			DeleteTransactionFunc: func(ctx context.Context, id string) error {
 //				panic("mock out the DeleteTransaction method")
 //			},
+//			DiscardDraftFunc: func(ctx context.Context, id string) error {
+//				panic("mock out the DiscardDraft method")
+//			},
+//			DuplicateTransactionFunc: func(ctx context.Context, id string, date time.Time) (entities.Transaction, error) {
+//				panic("mock out the DuplicateTransaction method")
+//			},
+//			FinalizeTransactionFunc: func(ctx context.Context, id string, status entities.TransactionStatus) (entities.Transaction, error) {
+//				panic("mock out the FinalizeTransaction method")
+//			},
 //			GetTransactionWithDetailsFunc: func(ctx context.Context, id string) (entities.Transaction, error) {
 //				panic("mock out the GetTransactionWithDetails method")
 //			},
-//			GetTransactionsWithDetailsFunc: func(ctx context.Context, limit int, offset int) ([]entities.Transaction, error) {
-//				panic("mock out the GetTransactionsWithDetails method")
+//			GetTransactionsPageFunc: func(ctx context.Context, filter entities.TransactionFilter, limit int, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error) {
+//				panic("mock out the GetTransactionsPage method")
+//			},
+//			GetTrashPageFunc: func(ctx context.Context, limit int, offset int) (entities.Page[entities.Transaction], error) {
+//				panic("mock out the GetTrashPage method")
+//			},
+//			RestoreTransactionFunc: func(ctx context.Context, id string) (entities.Transaction, error) {
+//				panic("mock out the RestoreTransaction method")
+//			},
+//			SearchTransactionsPageFunc: func(ctx context.Context, query string, limit int, offset int) (entities.Page[entities.Transaction], error) {
+//				panic("mock out the SearchTransactionsPage method")
 //			},
 //			UpdateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
 //				panic("mock out the UpdateTransaction method")
@@ -37,23 +62,63 @@ import (
 //
 //	}
 type TransactionUseCaseMock struct {
+	// BulkTransactionsFunc mocks the BulkTransactions method.
+	BulkTransactionsFunc func(ctx context.Context, operations []entities.BulkOperation) ([]entities.BulkResult, error)
+
+	// CreateInstallmentPurchaseFunc mocks the CreateInstallmentPurchase method.
+	CreateInstallmentPurchaseFunc func(ctx context.Context, transaction entities.Transaction, installments int) (entities.InstallmentPlan, error)
+
 	// CreateTransactionFunc mocks the CreateTransaction method.
 	CreateTransactionFunc func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error)
 
 	// DeleteTransactionFunc mocks the DeleteTransaction method.
 	DeleteTransactionFunc func(ctx context.Context, id string) error
 
+	// DiscardDraftFunc mocks the DiscardDraft method.
+	DiscardDraftFunc func(ctx context.Context, id string) error
+
+	// DuplicateTransactionFunc mocks the DuplicateTransaction method.
+	DuplicateTransactionFunc func(ctx context.Context, id string, date time.Time) (entities.Transaction, error)
+
+	// FinalizeTransactionFunc mocks the FinalizeTransaction method.
+	FinalizeTransactionFunc func(ctx context.Context, id string, status entities.TransactionStatus) (entities.Transaction, error)
+
 	// GetTransactionWithDetailsFunc mocks the GetTransactionWithDetails method.
 	GetTransactionWithDetailsFunc func(ctx context.Context, id string) (entities.Transaction, error)
 
-	// GetTransactionsWithDetailsFunc mocks the GetTransactionsWithDetails method.
-	GetTransactionsWithDetailsFunc func(ctx context.Context, limit int, offset int) ([]entities.Transaction, error)
+	// GetTransactionsPageFunc mocks the GetTransactionsPage method.
+	GetTransactionsPageFunc func(ctx context.Context, filter entities.TransactionFilter, limit int, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error)
+
+	// GetTrashPageFunc mocks the GetTrashPage method.
+	GetTrashPageFunc func(ctx context.Context, limit int, offset int) (entities.Page[entities.Transaction], error)
+
+	// RestoreTransactionFunc mocks the RestoreTransaction method.
+	RestoreTransactionFunc func(ctx context.Context, id string) (entities.Transaction, error)
+
+	// SearchTransactionsPageFunc mocks the SearchTransactionsPage method.
+	SearchTransactionsPageFunc func(ctx context.Context, query string, limit int, offset int) (entities.Page[entities.Transaction], error)
 
 	// UpdateTransactionFunc mocks the UpdateTransaction method.
 	UpdateTransactionFunc func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// BulkTransactions holds details about calls to the BulkTransactions method.
+		BulkTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Operations is the operations argument value.
+			Operations []entities.BulkOperation
+		}
+		// CreateInstallmentPurchase holds details about calls to the CreateInstallmentPurchase method.
+		CreateInstallmentPurchase []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Transaction is the transaction argument value.
+			Transaction entities.Transaction
+			// Installments is the installments argument value.
+			Installments int
+		}
 		// CreateTransaction holds details about calls to the CreateTransaction method.
 		CreateTransaction []struct {
 			// Ctx is the ctx argument value.
@@ -68,6 +133,31 @@ type TransactionUseCaseMock struct {
 			// ID is the id argument value.
 			ID string
 		}
+		// DiscardDraft holds details about calls to the DiscardDraft method.
+		DiscardDraft []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// DuplicateTransaction holds details about calls to the DuplicateTransaction method.
+		DuplicateTransaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// Date is the date argument value.
+			Date time.Time
+		}
+		// FinalizeTransaction holds details about calls to the FinalizeTransaction method.
+		FinalizeTransaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+			// Status is the status argument value.
+			Status entities.TransactionStatus
+		}
 		// GetTransactionWithDetails holds details about calls to the GetTransactionWithDetails method.
 		GetTransactionWithDetails []struct {
 			// Ctx is the ctx argument value.
@@ -75,10 +165,41 @@ type TransactionUseCaseMock struct {
 			// ID is the id argument value.
 			ID string
 		}
-		// GetTransactionsWithDetails holds details about calls to the GetTransactionsWithDetails method.
-		GetTransactionsWithDetails []struct {
+		// GetTransactionsPage holds details about calls to the GetTransactionsPage method.
+		GetTransactionsPage []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter entities.TransactionFilter
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+			// Sort is the sort argument value.
+			Sort []entities.SortField
+		}
+		// GetTrashPage holds details about calls to the GetTrashPage method.
+		GetTrashPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+			// Offset is the offset argument value.
+			Offset int
+		}
+		// RestoreTransaction holds details about calls to the RestoreTransaction method.
+		RestoreTransaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID string
+		}
+		// SearchTransactionsPage holds details about calls to the SearchTransactionsPage method.
+		SearchTransactionsPage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
 			// Limit is the limit argument value.
 			Limit int
 			// Offset is the offset argument value.
@@ -92,11 +213,103 @@ type TransactionUseCaseMock struct {
 			Transaction entities.Transaction
 		}
 	}
-	lockCreateTransaction          sync.RWMutex
-	lockDeleteTransaction          sync.RWMutex
-	lockGetTransactionWithDetails  sync.RWMutex
-	lockGetTransactionsWithDetails sync.RWMutex
-	lockUpdateTransaction          sync.RWMutex
+	lockBulkTransactions          sync.RWMutex
+	lockCreateInstallmentPurchase sync.RWMutex
+	lockCreateTransaction         sync.RWMutex
+	lockDeleteTransaction         sync.RWMutex
+	lockDiscardDraft              sync.RWMutex
+	lockDuplicateTransaction      sync.RWMutex
+	lockFinalizeTransaction       sync.RWMutex
+	lockGetTransactionWithDetails sync.RWMutex
+	lockGetTransactionsPage       sync.RWMutex
+	lockGetTrashPage              sync.RWMutex
+	lockRestoreTransaction        sync.RWMutex
+	lockSearchTransactionsPage    sync.RWMutex
+	lockUpdateTransaction         sync.RWMutex
+}
+
+// BulkTransactions calls BulkTransactionsFunc.
+func (mock *TransactionUseCaseMock) BulkTransactions(ctx context.Context, operations []entities.BulkOperation) ([]entities.BulkResult, error) {
+	callInfo := struct {
+		Ctx        context.Context
+		Operations []entities.BulkOperation
+	}{
+		Ctx:        ctx,
+		Operations: operations,
+	}
+	mock.lockBulkTransactions.Lock()
+	mock.calls.BulkTransactions = append(mock.calls.BulkTransactions, callInfo)
+	mock.lockBulkTransactions.Unlock()
+	if mock.BulkTransactionsFunc == nil {
+		var (
+			bulkResultsOut []entities.BulkResult
+			errOut         error
+		)
+		return bulkResultsOut, errOut
+	}
+	return mock.BulkTransactionsFunc(ctx, operations)
+}
+
+// BulkTransactionsCalls gets all the calls that were made to BulkTransactions.
+// Check the length with:
+//
+//	len(mockedTransactionUseCase.BulkTransactionsCalls())
+func (mock *TransactionUseCaseMock) BulkTransactionsCalls() []struct {
+	Ctx        context.Context
+	Operations []entities.BulkOperation
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Operations []entities.BulkOperation
+	}
+	mock.lockBulkTransactions.RLock()
+	calls = mock.calls.BulkTransactions
+	mock.lockBulkTransactions.RUnlock()
+	return calls
+}
+
+// CreateInstallmentPurchase calls CreateInstallmentPurchaseFunc.
+func (mock *TransactionUseCaseMock) CreateInstallmentPurchase(ctx context.Context, transaction entities.Transaction, installments int) (entities.InstallmentPlan, error) {
+	callInfo := struct {
+		Ctx          context.Context
+		Transaction  entities.Transaction
+		Installments int
+	}{
+		Ctx:          ctx,
+		Transaction:  transaction,
+		Installments: installments,
+	}
+	mock.lockCreateInstallmentPurchase.Lock()
+	mock.calls.CreateInstallmentPurchase = append(mock.calls.CreateInstallmentPurchase, callInfo)
+	mock.lockCreateInstallmentPurchase.Unlock()
+	if mock.CreateInstallmentPurchaseFunc == nil {
+		var (
+			installmentPlanOut entities.InstallmentPlan
+			errOut             error
+		)
+		return installmentPlanOut, errOut
+	}
+	return mock.CreateInstallmentPurchaseFunc(ctx, transaction, installments)
+}
+
+// CreateInstallmentPurchaseCalls gets all the calls that were made to CreateInstallmentPurchase.
+// Check the length with:
+//
+//	len(mockedTransactionUseCase.CreateInstallmentPurchaseCalls())
+func (mock *TransactionUseCaseMock) CreateInstallmentPurchaseCalls() []struct {
+	Ctx          context.Context
+	Transaction  entities.Transaction
+	Installments int
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Transaction  entities.Transaction
+		Installments int
+	}
+	mock.lockCreateInstallmentPurchase.RLock()
+	calls = mock.calls.CreateInstallmentPurchase
+	mock.lockCreateInstallmentPurchase.RUnlock()
+	return calls
 }
 
 // CreateTransaction calls CreateTransactionFunc.
@@ -178,6 +391,133 @@ func (mock *TransactionUseCaseMock) DeleteTransactionCalls() []struct {
 	return calls
 }
 
+// DiscardDraft calls DiscardDraftFunc.
+func (mock *TransactionUseCaseMock) DiscardDraft(ctx context.Context, id string) error {
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDiscardDraft.Lock()
+	mock.calls.DiscardDraft = append(mock.calls.DiscardDraft, callInfo)
+	mock.lockDiscardDraft.Unlock()
+	if mock.DiscardDraftFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.DiscardDraftFunc(ctx, id)
+}
+
+// DiscardDraftCalls gets all the calls that were made to DiscardDraft.
+// Check the length with:
+//
+//	len(mockedTransactionUseCase.DiscardDraftCalls())
+func (mock *TransactionUseCaseMock) DiscardDraftCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockDiscardDraft.RLock()
+	calls = mock.calls.DiscardDraft
+	mock.lockDiscardDraft.RUnlock()
+	return calls
+}
+
+// DuplicateTransaction calls DuplicateTransactionFunc.
+func (mock *TransactionUseCaseMock) DuplicateTransaction(ctx context.Context, id string, date time.Time) (entities.Transaction, error) {
+	callInfo := struct {
+		Ctx  context.Context
+		ID   string
+		Date time.Time
+	}{
+		Ctx:  ctx,
+		ID:   id,
+		Date: date,
+	}
+	mock.lockDuplicateTransaction.Lock()
+	mock.calls.DuplicateTransaction = append(mock.calls.DuplicateTransaction, callInfo)
+	mock.lockDuplicateTransaction.Unlock()
+	if mock.DuplicateTransactionFunc == nil {
+		var (
+			transactionOut entities.Transaction
+			errOut         error
+		)
+		return transactionOut, errOut
+	}
+	return mock.DuplicateTransactionFunc(ctx, id, date)
+}
+
+// DuplicateTransactionCalls gets all the calls that were made to DuplicateTransaction.
+// Check the length with:
+//
+//	len(mockedTransactionUseCase.DuplicateTransactionCalls())
+func (mock *TransactionUseCaseMock) DuplicateTransactionCalls() []struct {
+	Ctx  context.Context
+	ID   string
+	Date time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		ID   string
+		Date time.Time
+	}
+	mock.lockDuplicateTransaction.RLock()
+	calls = mock.calls.DuplicateTransaction
+	mock.lockDuplicateTransaction.RUnlock()
+	return calls
+}
+
+// FinalizeTransaction calls FinalizeTransactionFunc.
+func (mock *TransactionUseCaseMock) FinalizeTransaction(ctx context.Context, id string, status entities.TransactionStatus) (entities.Transaction, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		ID     string
+		Status entities.TransactionStatus
+	}{
+		Ctx:    ctx,
+		ID:     id,
+		Status: status,
+	}
+	mock.lockFinalizeTransaction.Lock()
+	mock.calls.FinalizeTransaction = append(mock.calls.FinalizeTransaction, callInfo)
+	mock.lockFinalizeTransaction.Unlock()
+	if mock.FinalizeTransactionFunc == nil {
+		var (
+			transactionOut entities.Transaction
+			errOut         error
+		)
+		return transactionOut, errOut
+	}
+	return mock.FinalizeTransactionFunc(ctx, id, status)
+}
+
+// FinalizeTransactionCalls gets all the calls that were made to FinalizeTransaction.
+// Check the length with:
+//
+//	len(mockedTransactionUseCase.FinalizeTransactionCalls())
+func (mock *TransactionUseCaseMock) FinalizeTransactionCalls() []struct {
+	Ctx    context.Context
+	ID     string
+	Status entities.TransactionStatus
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ID     string
+		Status entities.TransactionStatus
+	}
+	mock.lockFinalizeTransaction.RLock()
+	calls = mock.calls.FinalizeTransaction
+	mock.lockFinalizeTransaction.RUnlock()
+	return calls
+}
+
 // GetTransactionWithDetails calls GetTransactionWithDetailsFunc.
 func (mock *TransactionUseCaseMock) GetTransactionWithDetails(ctx context.Context, id string) (entities.Transaction, error) {
 	callInfo := struct {
@@ -218,8 +558,60 @@ func (mock *TransactionUseCaseMock) GetTransactionWithDetailsCalls() []struct {
 	return calls
 }
 
-// GetTransactionsWithDetails calls GetTransactionsWithDetailsFunc.
-func (mock *TransactionUseCaseMock) GetTransactionsWithDetails(ctx context.Context, limit int, offset int) ([]entities.Transaction, error) {
+// GetTransactionsPage calls GetTransactionsPageFunc.
+func (mock *TransactionUseCaseMock) GetTransactionsPage(ctx context.Context, filter entities.TransactionFilter, limit int, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Filter entities.TransactionFilter
+		Limit  int
+		Offset int
+		Sort   []entities.SortField
+	}{
+		Ctx:    ctx,
+		Filter: filter,
+		Limit:  limit,
+		Offset: offset,
+		Sort:   sort,
+	}
+	mock.lockGetTransactionsPage.Lock()
+	mock.calls.GetTransactionsPage = append(mock.calls.GetTransactionsPage, callInfo)
+	mock.lockGetTransactionsPage.Unlock()
+	if mock.GetTransactionsPageFunc == nil {
+		var (
+			vOut   entities.Page[entities.Transaction]
+			errOut error
+		)
+		return vOut, errOut
+	}
+	return mock.GetTransactionsPageFunc(ctx, filter, limit, offset, sort)
+}
+
+// GetTransactionsPageCalls gets all the calls that were made to GetTransactionsPage.
+// Check the length with:
+//
+//	len(mockedTransactionUseCase.GetTransactionsPageCalls())
+func (mock *TransactionUseCaseMock) GetTransactionsPageCalls() []struct {
+	Ctx    context.Context
+	Filter entities.TransactionFilter
+	Limit  int
+	Offset int
+	Sort   []entities.SortField
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Filter entities.TransactionFilter
+		Limit  int
+		Offset int
+		Sort   []entities.SortField
+	}
+	mock.lockGetTransactionsPage.RLock()
+	calls = mock.calls.GetTransactionsPage
+	mock.lockGetTransactionsPage.RUnlock()
+	return calls
+}
+
+// GetTrashPage calls GetTrashPageFunc.
+func (mock *TransactionUseCaseMock) GetTrashPage(ctx context.Context, limit int, offset int) (entities.Page[entities.Transaction], error) {
 	callInfo := struct {
 		Ctx    context.Context
 		Limit  int
@@ -229,36 +621,124 @@ func (mock *TransactionUseCaseMock) GetTransactionsWithDetails(ctx context.Conte
 		Limit:  limit,
 		Offset: offset,
 	}
-	mock.lockGetTransactionsWithDetails.Lock()
-	mock.calls.GetTransactionsWithDetails = append(mock.calls.GetTransactionsWithDetails, callInfo)
-	mock.lockGetTransactionsWithDetails.Unlock()
-	if mock.GetTransactionsWithDetailsFunc == nil {
+	mock.lockGetTrashPage.Lock()
+	mock.calls.GetTrashPage = append(mock.calls.GetTrashPage, callInfo)
+	mock.lockGetTrashPage.Unlock()
+	if mock.GetTrashPageFunc == nil {
+		var (
+			vOut   entities.Page[entities.Transaction]
+			errOut error
+		)
+		return vOut, errOut
+	}
+	return mock.GetTrashPageFunc(ctx, limit, offset)
+}
+
+// GetTrashPageCalls gets all the calls that were made to GetTrashPage.
+// Check the length with:
+//
+//	len(mockedTransactionUseCase.GetTrashPageCalls())
+func (mock *TransactionUseCaseMock) GetTrashPageCalls() []struct {
+	Ctx    context.Context
+	Limit  int
+	Offset int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Limit  int
+		Offset int
+	}
+	mock.lockGetTrashPage.RLock()
+	calls = mock.calls.GetTrashPage
+	mock.lockGetTrashPage.RUnlock()
+	return calls
+}
+
+// RestoreTransaction calls RestoreTransactionFunc.
+func (mock *TransactionUseCaseMock) RestoreTransaction(ctx context.Context, id string) (entities.Transaction, error) {
+	callInfo := struct {
+		Ctx context.Context
+		ID  string
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockRestoreTransaction.Lock()
+	mock.calls.RestoreTransaction = append(mock.calls.RestoreTransaction, callInfo)
+	mock.lockRestoreTransaction.Unlock()
+	if mock.RestoreTransactionFunc == nil {
 		var (
-			transactionsOut []entities.Transaction
-			errOut          error
+			transactionOut entities.Transaction
+			errOut         error
+		)
+		return transactionOut, errOut
+	}
+	return mock.RestoreTransactionFunc(ctx, id)
+}
+
+// RestoreTransactionCalls gets all the calls that were made to RestoreTransaction.
+// Check the length with:
+//
+//	len(mockedTransactionUseCase.RestoreTransactionCalls())
+func (mock *TransactionUseCaseMock) RestoreTransactionCalls() []struct {
+	Ctx context.Context
+	ID  string
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  string
+	}
+	mock.lockRestoreTransaction.RLock()
+	calls = mock.calls.RestoreTransaction
+	mock.lockRestoreTransaction.RUnlock()
+	return calls
+}
+
+// SearchTransactionsPage calls SearchTransactionsPageFunc.
+func (mock *TransactionUseCaseMock) SearchTransactionsPage(ctx context.Context, query string, limit int, offset int) (entities.Page[entities.Transaction], error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Query  string
+		Limit  int
+		Offset int
+	}{
+		Ctx:    ctx,
+		Query:  query,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockSearchTransactionsPage.Lock()
+	mock.calls.SearchTransactionsPage = append(mock.calls.SearchTransactionsPage, callInfo)
+	mock.lockSearchTransactionsPage.Unlock()
+	if mock.SearchTransactionsPageFunc == nil {
+		var (
+			vOut   entities.Page[entities.Transaction]
+			errOut error
 		)
-		return transactionsOut, errOut
+		return vOut, errOut
 	}
-	return mock.GetTransactionsWithDetailsFunc(ctx, limit, offset)
+	return mock.SearchTransactionsPageFunc(ctx, query, limit, offset)
 }
 
-// GetTransactionsWithDetailsCalls gets all the calls that were made to GetTransactionsWithDetails.
+// SearchTransactionsPageCalls gets all the calls that were made to SearchTransactionsPage.
 // Check the length with:
 //
-//	len(mockedTransactionUseCase.GetTransactionsWithDetailsCalls())
-func (mock *TransactionUseCaseMock) GetTransactionsWithDetailsCalls() []struct {
+//	len(mockedTransactionUseCase.SearchTransactionsPageCalls())
+func (mock *TransactionUseCaseMock) SearchTransactionsPageCalls() []struct {
 	Ctx    context.Context
+	Query  string
 	Limit  int
 	Offset int
 } {
 	var calls []struct {
 		Ctx    context.Context
+		Query  string
 		Limit  int
 		Offset int
 	}
-	mock.lockGetTransactionsWithDetails.RLock()
-	calls = mock.calls.GetTransactionsWithDetails
-	mock.lockGetTransactionsWithDetails.RUnlock()
+	mock.lockSearchTransactionsPage.RLock()
+	calls = mock.calls.SearchTransactionsPage
+	mock.lockSearchTransactionsPage.RUnlock()
 	return calls
 }
 
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"net/http"
+
+	"github.com/go-chi/render"
+)
+
+// Onboarding response types
+type OnboardingStepResponse struct {
+	Name string `json:"name"`
+	Done bool   `json:"done"`
+}
+
+type OnboardingStatusResponse struct {
+	Completed bool                     `json:"completed"`
+	NextStep  string                   `json:"next_step,omitempty"`
+	Steps     []OnboardingStepResponse `json:"steps"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/onboarding_uc.go . OnboardingUseCase
+type OnboardingUseCase interface {
+	GetOnboardingStatus(ctx context.Context) (entities.OnboardingStatus, error)
+}
+
+// Onboarding handlers
+
+// GetOnboardingStatus reports the progress of the first-run setup
+//
+//	@Summary		Get onboarding status
+//	@Description	Report which first-run steps are done (base_currency, categories, account). Onboarding is completed once the first account exists.
+//	@Tags			onboarding
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	OnboardingStatusResponse	"Onboarding status retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody			"Internal server error"
+//	@Router			/onboarding/status [get]
+func (h *ApiHandlers) GetOnboardingStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := h.OnboardingUseCase.GetOnboardingStatus(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := OnboardingStatusResponse{
+		Completed: status.Completed,
+		NextStep:  string(status.NextStep),
+		Steps:     make([]OnboardingStepResponse, 0, len(status.Steps)),
+	}
+	for _, step := range status.Steps {
+		response.Steps = append(response.Steps, OnboardingStepResponse{Name: string(step.Step), Done: step.Done})
+	}
+
+	render.JSON(w, r, response)
+}
@@ -0,0 +1,310 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// ProjectRequest is a project transactions can be filed under, optionally
+// done for a client
+type ProjectRequest struct {
+	Name        string `json:"name" example:"Website redesign"`
+	Client      string `json:"client" example:"Acme"`
+	Description string `json:"description"`
+}
+
+type ProjectResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name" example:"Website redesign"`
+	Client      string `json:"client,omitempty" example:"Acme"`
+	Description string `json:"description,omitempty"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// ProjectProfitResponse is what a project earned and cost between from and
+// to, empty when the period is open, with one total per currency
+type ProjectProfitResponse struct {
+	Project          ProjectResponse        `json:"project"`
+	From             string                 `json:"from,omitempty" example:"2025-01-01"`
+	To               string                 `json:"to,omitempty" example:"2025-03-31"`
+	TransactionCount int                    `json:"transaction_count" example:"12"`
+	Totals           []ProjectTotalResponse `json:"totals"`
+}
+
+type ProjectTotalResponse struct {
+	Asset    string `json:"asset" example:"BRL"`
+	Income   string `json:"income" example:"[BRL (R$) 9000.00]"`
+	Expenses string `json:"expenses" example:"[BRL (R$) 1250.00]"`
+	Profit   string `json:"profit" example:"[BRL (R$) 7750.00]"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/project_uc.go . ProjectUseCase
+type ProjectUseCase interface {
+	CreateProject(ctx context.Context, project entities.Project) (entities.Project, error)
+	GetProjects(ctx context.Context) ([]entities.Project, error)
+	GetProject(ctx context.Context, id string) (entities.Project, error)
+	UpdateProject(ctx context.Context, project entities.Project) (entities.Project, error)
+	DeleteProject(ctx context.Context, id string) error
+	GetProjectProfit(ctx context.Context, id string, from, to time.Time) (entities.ProjectProfit, error)
+	GetProjectsProfit(ctx context.Context, from, to time.Time) ([]entities.ProjectProfit, error)
+}
+
+// Project handlers
+
+// CreateProject creates a project
+//
+//	@Summary		Create project
+//	@Description	Create a project, optionally done for a client, to file transactions under
+//	@Tags			projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			project	body		ProjectRequest		true	"Project data"
+//	@Success		201		{object}	ProjectResponse		"Project created successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		409		{object}	ErrorResponseBody	"Project name already taken"
+//	@Failure		413		{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/projects [post]
+func (h *ApiHandlers) CreateProject(w http.ResponseWriter, r *http.Request) {
+	var req ProjectRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	project, err := h.ProjectUseCase.CreateProject(r.Context(), entities.Project{
+		Name:        req.Name,
+		Client:      req.Client,
+		Description: req.Description,
+	})
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, projectResponse(project))
+}
+
+// GetProjects lists the projects
+//
+//	@Summary		List projects
+//	@Description	List the projects ordered by name
+//	@Tags			projects
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		ProjectResponse		"Projects retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/projects [get]
+func (h *ApiHandlers) GetProjects(w http.ResponseWriter, r *http.Request) {
+	projects, err := h.ProjectUseCase.GetProjects(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	responses := make([]ProjectResponse, len(projects))
+	for i, project := range projects {
+		responses[i] = projectResponse(project)
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetProjectsProfit reports the profit of every project
+//
+//	@Summary		Profit per project
+//	@Description	Add up the income and expenses of the pending and cleared transactions of every project, per currency, between from and to. Without dates the whole history is added up
+//	@Tags			projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			from	query		string					false	"First day of the period (YYYY-MM-DD)"
+//	@Param			to		query		string					false	"Last day of the period (YYYY-MM-DD)"
+//	@Success		200		{array}		ProjectProfitResponse	"Profit retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody		"Bad request"
+//	@Failure		500		{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/projects/profit [get]
+func (h *ApiHandlers) GetProjectsProfit(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := parsePeriod(w, r)
+	if !ok {
+		return
+	}
+
+	profits, err := h.ProjectUseCase.GetProjectsProfit(r.Context(), from, to)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	responses := make([]ProjectProfitResponse, len(profits))
+	for i, profit := range profits {
+		responses[i] = projectProfitResponse(profit)
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetProject retrieves a project
+//
+//	@Summary		Get project
+//	@Description	Retrieve a project by its ID
+//	@Tags			projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string				true	"Project ID"
+//	@Success		200	{object}	ProjectResponse		"Project retrieved successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Project not found"
+//	@Router			/projects/{id} [get]
+func (h *ApiHandlers) GetProject(w http.ResponseWriter, r *http.Request) {
+	project, err := h.ProjectUseCase.GetProject(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, projectResponse(project))
+}
+
+// UpdateProject updates a project
+//
+//	@Summary		Update project
+//	@Description	Change the name, client and description of a project
+//	@Tags			projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Project ID"
+//	@Param			project	body		ProjectRequest		true	"Project data"
+//	@Success		200		{object}	ProjectResponse		"Project updated successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody	"Project not found"
+//	@Failure		409		{object}	ErrorResponseBody	"Project name already taken"
+//	@Failure		413		{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/projects/{id} [put]
+func (h *ApiHandlers) UpdateProject(w http.ResponseWriter, r *http.Request) {
+	var req ProjectRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	project, err := h.ProjectUseCase.UpdateProject(r.Context(), entities.Project{
+		ID:          chi.URLParam(r, "id"),
+		Name:        req.Name,
+		Client:      req.Client,
+		Description: req.Description,
+	})
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, projectResponse(project))
+}
+
+// DeleteProject deletes a project
+//
+//	@Summary		Delete project
+//	@Description	Delete a project, its transactions are kept outside of any project
+//	@Tags			projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	string	true	"Project ID"
+//	@Success		204	"Project deleted successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Project not found"
+//	@Router			/projects/{id} [delete]
+func (h *ApiHandlers) DeleteProject(w http.ResponseWriter, r *http.Request) {
+	if err := h.ProjectUseCase.DeleteProject(r.Context(), chi.URLParam(r, "id")); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetProjectProfit reports the profit of a project
+//
+//	@Summary		Project profit
+//	@Description	Add up the income and expenses of the pending and cleared transactions of a project, per currency, between from and to. Without dates the whole history is added up
+//	@Tags			projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"Project ID"
+//	@Param			from	query		string					false	"First day of the period (YYYY-MM-DD)"
+//	@Param			to		query		string					false	"Last day of the period (YYYY-MM-DD)"
+//	@Success		200		{object}	ProjectProfitResponse	"Profit retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody		"Project not found"
+//	@Router			/projects/{id}/profit [get]
+func (h *ApiHandlers) GetProjectProfit(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := parsePeriod(w, r)
+	if !ok {
+		return
+	}
+
+	profit, err := h.ProjectUseCase.GetProjectProfit(r.Context(), chi.URLParam(r, "id"), from, to)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, projectProfitResponse(profit))
+}
+
+// parsePeriod reads the optional from and to query parameters,
+// answering the request itself when they're invalid
+func parsePeriod(w http.ResponseWriter, r *http.Request) (time.Time, time.Time, bool) {
+	from, err := parseDateParam(r, "from")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return time.Time{}, time.Time{}, false
+	}
+
+	to, err := parseDateParam(r, "to")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return time.Time{}, time.Time{}, false
+	}
+
+	return from, to, true
+}
+
+func projectResponse(project entities.Project) ProjectResponse {
+	return ProjectResponse{
+		ID:          project.ID,
+		Name:        project.Name,
+		Client:      project.Client,
+		Description: project.Description,
+		CreatedAt:   project.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   project.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func projectProfitResponse(profit entities.ProjectProfit) ProjectProfitResponse {
+	response := ProjectProfitResponse{
+		Project:          projectResponse(profit.Project),
+		TransactionCount: profit.TransactionCount,
+		Totals:           make([]ProjectTotalResponse, len(profit.Totals)),
+	}
+	if !profit.From.IsZero() {
+		response.From = profit.From.Format("2006-01-02")
+	}
+	if !profit.To.IsZero() {
+		response.To = profit.To.Format("2006-01-02")
+	}
+	for i, total := range profit.Totals {
+		response.Totals[i] = ProjectTotalResponse{
+			Asset:    total.Asset.Asset,
+			Income:   total.Income.String(),
+			Expenses: total.Expenses.String(),
+			Profit:   total.Profit.String(),
+		}
+	}
+	return response
+}
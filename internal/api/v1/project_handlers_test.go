@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestProjectHandlers(t *testing.T) {
+	const projectID = "5d6e7f8a-9b0c-4d1e-8f2a-3b4c5d6e7f8a"
+	const missingID = "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+
+	project := entities.Project{ID: projectID, Name: "Website", Client: "Acme"}
+	income, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(900000))
+	expenses, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(125000))
+	profit, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(775000))
+
+	var gotProject entities.Project
+	var gotFrom, gotTo time.Time
+	mockUC := &mocks.ProjectUseCaseMock{
+		CreateProjectFunc: func(ctx context.Context, created entities.Project) (entities.Project, error) {
+			if created.Name == "" {
+				return entities.Project{}, fmt.Errorf("project name cannot be empty: %w", domain.ErrMalformedParameters)
+			}
+			gotProject = created
+			created.ID = projectID
+			return created, nil
+		},
+		GetProjectFunc: func(ctx context.Context, id string) (entities.Project, error) {
+			if id != projectID {
+				return entities.Project{}, fmt.Errorf("project %w", domain.ErrNotFound)
+			}
+			return project, nil
+		},
+		GetProjectProfitFunc: func(ctx context.Context, id string, from, to time.Time) (entities.ProjectProfit, error) {
+			gotFrom, gotTo = from, to
+			return entities.ProjectProfit{
+				Project:          project,
+				From:             from,
+				To:               to,
+				TransactionCount: 3,
+				Totals:           []entities.ProjectTotal{{Asset: monetary.BRL, Income: *income, Expenses: *expenses, Profit: *profit}},
+			}, nil
+		},
+		GetProjectsProfitFunc: func(ctx context.Context, from, to time.Time) ([]entities.ProjectProfit, error) {
+			return []entities.ProjectProfit{{Project: project, Totals: []entities.ProjectTotal{}}}, nil
+		},
+	}
+	h := &ApiHandlers{ProjectUseCase: mockUC}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(method, path, bytes.NewBufferString(body)))
+		return rec
+	}
+
+	t.Run("creates a project", func(t *testing.T) {
+		rec := serve(http.MethodPost, "/api/v1/projects", `{"name":"Website","client":"Acme"}`)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotProject.Name != "Website" || gotProject.Client != "Acme" {
+			t.Errorf("unexpected project passed to the use case: %+v", gotProject)
+		}
+	})
+
+	t.Run("rejects a project without a name", func(t *testing.T) {
+		if rec := serve(http.MethodPost, "/api/v1/projects", `{"client":"Acme"}`); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("reports the profit of a period", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/projects/"+projectID+"/profit?from=2025-03-01&to=2025-03-31", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotFrom.Format("2006-01-02") != "2025-03-01" || gotTo.Format("2006-01-02") != "2025-03-31" {
+			t.Errorf("unexpected period passed to the use case: %s to %s", gotFrom, gotTo)
+		}
+
+		var response ProjectProfitResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Project.Client != "Acme" || response.TransactionCount != 3 || len(response.Totals) != 1 || response.Totals[0].Asset != "BRL" {
+			t.Errorf("unexpected profit: %+v", response)
+		}
+	})
+
+	t.Run("reports the profit of every project", func(t *testing.T) {
+		rec := serve(http.MethodGet, "/api/v1/projects/profit", "")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+
+		var response []ProjectProfitResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response) != 1 || response[0].From != "" || response[0].Totals == nil {
+			t.Errorf("unexpected profit: %+v", response)
+		}
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/projects/profit?from=March", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/projects/"+missingID, ""); rec.Code != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", rec.Code)
+		}
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		if rec := serve(http.MethodGet, "/api/v1/projects/not-a-uuid/profit", ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"net/http"
+
+	"github.com/go-chi/render"
+)
+
+// Query request/response types
+type QueryRequest struct {
+	Question string `json:"question" example:"how much did I spend on food in March"`
+}
+
+type QueryResponse struct {
+	Question string               `json:"question"`
+	Intent   entities.QueryIntent `json:"intent" example:"spending"`
+	// Period of the spending and income intents, both inclusive
+	From       string `json:"from,omitempty" example:"2025-03-01"`
+	To         string `json:"to,omitempty" example:"2025-03-31"`
+	CategoryID string `json:"category_id,omitempty"`
+	AccountID  string `json:"account_id,omitempty"`
+	// One total per asset, empty when nothing matched
+	Totals           []string `json:"totals" example:"[USD ($) 412.30]"`
+	TransactionCount int      `json:"transaction_count"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/query_uc.go . QueryUseCase
+type QueryUseCase interface {
+	AnswerQuestion(ctx context.Context, question string) (entities.QueryResult, error)
+}
+
+// Query handlers
+
+// Query answers a question about the finances
+//
+//	@Summary		Ask a question
+//	@Description	Answer a natural language question like "how much did I spend on food in March", "income last month", "what's my net worth" or "Checking balance" by translating it into a report. The question is matched against known words: spend, earn, net worth or balance, a period (today, this week, last month, March 2025, past 30 days, 2024, the current month by default) and category and account names
+//	@Tags			reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			query	body		QueryRequest		true	"Question"
+//	@Success		200		{object}	QueryResponse		"Answer"
+//	@Failure		400		{object}	ErrorResponseBody	"Question not understood"
+//	@Failure		413		{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/query [post]
+func (h *ApiHandlers) Query(w http.ResponseWriter, r *http.Request) {
+	var req QueryRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	result, err := h.QueryUseCase.AnswerQuestion(r.Context(), req.Question)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := QueryResponse{
+		Question:         req.Question,
+		Intent:           result.Query.Intent,
+		CategoryID:       result.Query.CategoryID,
+		AccountID:        result.Query.AccountID,
+		Totals:           make([]string, len(result.Totals)),
+		TransactionCount: result.TransactionCount,
+	}
+	if !result.Query.From.IsZero() {
+		response.From = result.Query.From.Format("2006-01-02")
+		response.To = result.Query.To.Format("2006-01-02")
+	}
+	for i, total := range result.Totals {
+		response.Totals[i] = total.String()
+	}
+
+	render.JSON(w, r, response)
+}
@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/render"
+)
+
+// Quick capture request/response types
+type QuickCaptureRequest struct {
+	Text string `json:"text" example:"coffee 12.50 food"`
+	// Account to capture to, instead of the one named in the text or suggested
+	AccountID string `json:"account_id,omitempty"`
+	// Date of the transaction, YYYY-MM-DD. The user's default date when empty
+	Date string `json:"date,omitempty"`
+	// Parse the text without creating the transaction
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
+type QuickCaptureResponse struct {
+	Transaction TransactionResponse `json:"transaction"`
+	// How the category and account were picked: request, text, history or
+	// default
+	CategorySource entities.QuickCaptureSource `json:"category_source" example:"text"`
+	AccountSource  entities.QuickCaptureSource `json:"account_source" example:"default"`
+	Created        bool                        `json:"created"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/quick_capture_uc.go . QuickCaptureUseCase
+type QuickCaptureUseCase interface {
+	ParseQuickCapture(ctx context.Context, text, accountID string) (entities.QuickCapture, error)
+}
+
+// Quick capture handlers
+
+// QuickCapture creates a transaction from a free-text note
+//
+//	@Summary		Quick capture a transaction
+//	@Description	Parse a free-text note like "coffee 12.50 food" into the amount, description, category and account, then create the transaction. The amount is the last number in the text, and the category and account are the ones named in it or suggested by the latest transaction with the same description. Meant for iOS Shortcuts and voice assistants
+//	@Tags			transactions
+//	@Accept			json
+//	@Produce		json
+//	@Param			capture	body		QuickCaptureRequest		true	"Note to capture"
+//	@Success		201		{object}	QuickCaptureResponse	"Transaction created"
+//	@Success		200		{object}	QuickCaptureResponse	"Parsed without creating, on dry runs"
+//	@Failure		400		{object}	ErrorResponseBody		"Text without an amount or a category"
+//	@Failure		402		{object}	ErrorResponseBody		"Quota of the free tier reached"
+//	@Failure		404		{object}	ErrorResponseBody		"Account not found"
+//	@Failure		413		{object}	ErrorResponseBody		"Request body too large"
+//	@Failure		429		{object}	ErrorResponseBody		"Monthly quota reached, until the Retry-After seconds passed"
+//	@Router			/quick [post]
+func (h *ApiHandlers) QuickCapture(w http.ResponseWriter, r *http.Request) {
+	var req QuickCaptureRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	capture, err := h.QuickCaptureUseCase.ParseQuickCapture(r.Context(), req.Text, req.AccountID)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	transaction := capture.Transaction
+	if req.Date != "" {
+		transaction.Date, err = time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("date", "must be in format YYYY-MM-DD"))
+			return
+		}
+	}
+
+	status := http.StatusOK
+	if !req.DryRun {
+		created, err := h.TransactionUseCase.CreateTransaction(r.Context(), transaction)
+		if err != nil {
+			slog.Error("failed to create quick capture", "error", err, "account_id", transaction.AccountID, "category_id", transaction.CategoryID)
+			errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+			return
+		}
+		transaction, status = created, http.StatusCreated
+	}
+
+	response := QuickCaptureResponse{
+		Transaction: TransactionResponse{
+			ID:          transaction.ID,
+			AccountID:   transaction.AccountID,
+			CategoryID:  transaction.CategoryID,
+			Amount:      transaction.Monetary.String(),
+			Description: transaction.Description,
+			Payee:       transaction.Payee,
+			Status:      transaction.Status,
+		},
+		CategorySource: capture.CategorySource,
+		AccountSource:  capture.AccountSource,
+		Created:        !req.DryRun,
+	}
+	if !transaction.Date.IsZero() {
+		response.Transaction.Date = transaction.Date.Format("2006-01-02")
+	}
+	if !req.DryRun {
+		response.Transaction.CreatedAt = transaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+		response.Transaction.UpdatedAt = transaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if account := transaction.Account; account != nil {
+		response.Transaction.Account = &AccountResponse{
+			ID:          account.ID,
+			Name:        account.Name,
+			Type:        account.Type,
+			Asset:       account.Asset.Asset,
+			Description: account.Description,
+		}
+	}
+	if category := transaction.Category; category != nil {
+		response.Transaction.Category = &CategoryResponse{
+			ID:          category.ID,
+			Name:        category.Name,
+			Type:        category.Type,
+			Description: category.Description,
+			Color:       category.Color,
+		}
+	}
+
+	render.Status(r, status)
+	render.JSON(w, r, response)
+}
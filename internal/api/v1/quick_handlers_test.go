@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestQuickCapture(t *testing.T) {
+	amount, _ := monetary.NewMonetary(monetary.USD, big.NewInt(1250))
+	capture := entities.QuickCapture{
+		Transaction: entities.Transaction{
+			AccountID:   "acc-1",
+			CategoryID:  "cat-1",
+			Monetary:    *amount,
+			Description: "coffee",
+			Account:     &entities.Account{ID: "acc-1", Name: "Checking"},
+			Category:    &entities.Category{ID: "cat-1", Name: "Food", Type: entities.CategoryTypeExpense},
+		},
+		CategorySource: entities.QuickCaptureSourceText,
+		AccountSource:  entities.QuickCaptureSourceDefault,
+	}
+
+	var created []entities.Transaction
+	h := &ApiHandlers{
+		QuickCaptureUseCase: &mocks.QuickCaptureUseCaseMock{
+			ParseQuickCaptureFunc: func(ctx context.Context, text, accountID string) (entities.QuickCapture, error) {
+				if text != "coffee 12.50 food" {
+					return entities.QuickCapture{}, fmt.Errorf("no amount found in %q: %w", text, domain.ErrMalformedParameters)
+				}
+				return capture, nil
+			},
+		},
+		TransactionUseCase: &mocks.TransactionUseCaseMock{
+			CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+				created = append(created, transaction)
+				transaction.ID = "txn-1"
+				transaction.Status = entities.TransactionStatusCleared
+				if transaction.Date.IsZero() {
+					transaction.Date = time.Date(2025, time.May, 10, 0, 0, 0, 0, time.UTC)
+				}
+				return transaction, nil
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	post := func(body string) (*httptest.ResponseRecorder, QuickCaptureResponse) {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/quick", strings.NewReader(body)))
+		var response QuickCaptureResponse
+		json.NewDecoder(rec.Body).Decode(&response)
+		return rec, response
+	}
+
+	t.Run("creates the transaction", func(t *testing.T) {
+		rec, response := post(`{"text": "coffee 12.50 food", "date": "2025-05-09"}`)
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d", rec.Code)
+		}
+		if !response.Created || response.Transaction.ID != "txn-1" || response.Transaction.Date != "2025-05-09" {
+			t.Errorf("unexpected transaction: %+v", response)
+		}
+		if response.Transaction.Category == nil || response.Transaction.Category.Name != "Food" {
+			t.Errorf("expected the category to be embedded, got %+v", response.Transaction.Category)
+		}
+		if response.CategorySource != entities.QuickCaptureSourceText || response.AccountSource != entities.QuickCaptureSourceDefault {
+			t.Errorf("unexpected sources: %s, %s", response.CategorySource, response.AccountSource)
+		}
+		if len(created) != 1 {
+			t.Fatalf("expected one transaction to be created, got %d", len(created))
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		created = nil
+		rec, response := post(`{"text": "coffee 12.50 food", "dry_run": true}`)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if response.Created || response.Transaction.Description != "coffee" {
+			t.Errorf("unexpected response: %+v", response)
+		}
+		if len(created) != 0 {
+			t.Error("expected nothing to be created")
+		}
+	})
+
+	t.Run("unparsable text", func(t *testing.T) {
+		rec, _ := post(`{"text": "coffee"}`)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		rec, _ := post(`{"text": "coffee 12.50 food", "date": "09/05/2025"}`)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
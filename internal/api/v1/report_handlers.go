@@ -0,0 +1,538 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"math/big"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// defaultCommitmentMonths is how many months the commitments report covers
+// when not asked for
+const defaultCommitmentMonths = 6
+
+// Report response types
+
+// CommitmentReportResponse is the calendar of the outflows committed in one
+// asset, month by month starting with the current one
+type CommitmentReportResponse struct {
+	Asset  string                    `json:"asset" example:"BRL"`
+	Months []CommitmentMonthResponse `json:"months"`
+}
+
+// CommitmentMonthResponse sets the commitments due in a month against the
+// income expected in it, the average monthly income of the three months
+// before the current one. Share is the percentage of the income committed.
+type CommitmentMonthResponse struct {
+	Month        string               `json:"month" example:"2025-04"`
+	Faturas      string               `json:"faturas" example:"[BRL (R$) 300.00]"`
+	Installments string               `json:"installments" example:"[BRL (R$) 200.00]"`
+	Bills        string               `json:"bills" example:"[BRL (R$) 1500.00]"`
+	Committed    string               `json:"committed" example:"[BRL (R$) 2000.00]"`
+	Income       string               `json:"income" example:"[BRL (R$) 5000.00]"`
+	Available    string               `json:"available" example:"[BRL (R$) 3000.00]"`
+	Share        float64              `json:"share" example:"40"`
+	Commitments  []CommitmentResponse `json:"commitments"`
+}
+
+type CommitmentResponse struct {
+	Kind          entities.CommitmentKind `json:"kind" example:"fatura"`
+	AccountID     string                  `json:"account_id"`
+	TransactionID string                  `json:"transaction_id,omitempty"`
+	Description   string                  `json:"description" example:"Nubank fatura 2025-04"`
+	Date          string                  `json:"date" example:"2025-04-10"`
+	Amount        string                  `json:"amount" example:"[BRL (R$) 300.00]"`
+}
+
+// ConsolidatedReportResponse merges the net worth and cash flow of every book
+// into the reporting currency, with the share of each book
+type ConsolidatedReportResponse struct {
+	Asset  string                      `json:"asset" example:"USD"`
+	From   string                      `json:"from" example:"2025-03-01"`
+	To     string                      `json:"to" example:"2025-03-31"`
+	Totals ConsolidatedTotalsResponse  `json:"totals"`
+	Books  []BookConsolidationResponse `json:"books"`
+}
+
+// BookConsolidationResponse is the share of a book, in its base currency and
+// converted to the reporting one
+type BookConsolidationResponse struct {
+	Book      BookResponse               `json:"book"`
+	Base      ConsolidatedTotalsResponse `json:"base"`
+	Reporting ConsolidatedTotalsResponse `json:"reporting"`
+}
+
+type ConsolidatedTotalsResponse struct {
+	Assets      string `json:"assets" example:"[USD ($) 810.00]"`
+	Liabilities string `json:"liabilities" example:"[USD ($) 40.00]"`
+	NetWorth    string `json:"net_worth" example:"[USD ($) 770.00]"`
+	Income      string `json:"income" example:"[USD ($) 1300.00]"`
+	Expenses    string `json:"expenses" example:"[USD ($) 200.00]"`
+	NetCashFlow string `json:"net_cash_flow" example:"[USD ($) 1100.00]"`
+}
+
+// MonthlyReportResponse is the report of a calendar month: the totals of the
+// categories and the net worth at the end of the month, per currency. Closed
+// months are served from their snapshot, taken at snapshot_at.
+type MonthlyReportResponse struct {
+	Month      string                  `json:"month" example:"2025-03"`
+	Snapshot   bool                    `json:"snapshot"`
+	SnapshotAt string                  `json:"snapshot_at,omitempty"`
+	Categories []CategoryTotalResponse `json:"categories"`
+	NetWorth   []string                `json:"net_worth" example:"[BRL (R$) 12345.67]"`
+}
+
+// CategoryTotalResponse adds up the pending and cleared transactions of a
+// category in one currency, with the name the category had at the time
+type CategoryTotalResponse struct {
+	CategoryID string                `json:"category_id"`
+	Name       string                `json:"name" example:"Groceries"`
+	Type       entities.CategoryType `json:"type" example:"expense"`
+	Total      string                `json:"total" example:"[BRL (R$) 850.00]"`
+}
+
+// SpendingReportResponse is the expenses of a period grouped by category,
+// month or both. Totals hold the whole spending per currency.
+type SpendingReportResponse struct {
+	StartDate string                        `json:"start_date" example:"2025-04-01"`
+	EndDate   string                        `json:"end_date" example:"2025-04-30"`
+	GroupBy   []entities.SpendingGroupField `json:"group_by" example:"category"`
+	Totals    []string                      `json:"totals" example:"[BRL (R$) 3250.00]"`
+	Groups    []SpendingGroupResponse       `json:"groups"`
+}
+
+// SpendingGroupResponse is what was spent in one currency on a category, in
+// a month, or both, only the fields grouped by being set. Value is the total
+// as a number, for charting, and share its percent of the spending in the
+// currency.
+type SpendingGroupResponse struct {
+	CategoryID    string  `json:"category_id,omitempty"`
+	CategoryName  string  `json:"category_name,omitempty" example:"Groceries"`
+	CategoryColor string  `json:"category_color,omitempty" example:"#4caf50"`
+	Month         string  `json:"month,omitempty" example:"2025-04"`
+	Asset         string  `json:"asset" example:"BRL"`
+	Count         int     `json:"count" example:"12"`
+	Total         string  `json:"total" example:"[BRL (R$) 850.00]"`
+	Value         float64 `json:"value" example:"850"`
+	Share         float64 `json:"share" example:"26.2"`
+}
+
+// CashFlowReportResponse is the income and expenses of a period per month,
+// one series per currency
+type CashFlowReportResponse struct {
+	StartDate string                   `json:"start_date" example:"2024-05-01"`
+	EndDate   string                   `json:"end_date" example:"2025-04-30"`
+	AccountID string                   `json:"account_id,omitempty"`
+	Series    []CashFlowSeriesResponse `json:"series"`
+}
+
+// CashFlowSeriesResponse is the cash flow of every month of the period in
+// one currency, with the totals of the period
+type CashFlowSeriesResponse struct {
+	Asset   string                  `json:"asset" example:"BRL"`
+	Income  string                  `json:"income" example:"[BRL (R$) 60000.00]"`
+	Expense string                  `json:"expense" example:"[BRL (R$) 42000.00]"`
+	Net     string                  `json:"net" example:"[BRL (R$) 18000.00]"`
+	Months  []CashFlowMonthResponse `json:"months"`
+}
+
+type CashFlowMonthResponse struct {
+	Month   string `json:"month" example:"2025-04"`
+	Income  string `json:"income" example:"[BRL (R$) 5000.00]"`
+	Expense string `json:"expense" example:"[BRL (R$) 3500.00]"`
+	Net     string `json:"net" example:"[BRL (R$) 1500.00]"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/report_uc.go . ReportUseCase
+type ReportUseCase interface {
+	GetCommitments(ctx context.Context, months int) ([]entities.CommitmentReport, error)
+	GetConsolidatedReport(ctx context.Context, asset monetary.Asset, rates entities.ExchangeRates, from, to time.Time) (entities.ConsolidatedReport, error)
+	GetMonthlyReport(ctx context.Context, month time.Time) (entities.MonthlyReport, error)
+	CloseMonth(ctx context.Context, month time.Time) (entities.MonthlyReport, error)
+	RecalculateMonthlyReport(ctx context.Context, month time.Time) (entities.MonthlyReport, error)
+	GetSpending(ctx context.Context, by []entities.SpendingGroupField, from, to time.Time) (entities.SpendingReport, error)
+	GetCashFlow(ctx context.Context, accountID string, from, to time.Time) (entities.CashFlowReport, error)
+}
+
+// Report handlers
+
+// GetCommitments returns the outflows committed in the next months
+//
+//	@Summary		Cash-flow calendar of commitments
+//	@Description	Get the outflows already committed in each of the next months, starting with the current one, per currency: the credit card faturas due from today on, the installments and the pending expenses of the other accounts. Each month is set against the average monthly income of the three months before the current one, to show how much of it is already spoken for
+//	@Tags			reports
+//	@Produce		json
+//	@Param			months	query		int							false	"Number of months, 1 to 24 (default 6)"
+//	@Success		200		{array}		CommitmentReportResponse	"Commitments retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody			"Bad request"
+//	@Failure		500		{object}	ErrorResponseBody			"Internal server error"
+//	@Router			/reports/commitments [get]
+func (h *ApiHandlers) GetCommitments(w http.ResponseWriter, r *http.Request) {
+	months := defaultCommitmentMonths
+	if value := r.URL.Query().Get("months"); value != "" {
+		var err error
+		if months, err = strconv.Atoi(value); err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("months", value))
+			return
+		}
+	}
+
+	reports, err := h.ReportUseCase.GetCommitments(r.Context(), months)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	responses := make([]CommitmentReportResponse, len(reports))
+	for i, report := range reports {
+		responses[i] = CommitmentReportResponse{
+			Asset:  report.Asset.Asset,
+			Months: make([]CommitmentMonthResponse, len(report.Months)),
+		}
+		for j, month := range report.Months {
+			response := CommitmentMonthResponse{
+				Month:        month.Month,
+				Faturas:      month.Faturas.String(),
+				Installments: month.Installments.String(),
+				Bills:        month.Bills.String(),
+				Committed:    month.Committed.String(),
+				Income:       month.Income.String(),
+				Available:    month.Available.String(),
+				Share:        month.Share,
+				Commitments:  make([]CommitmentResponse, len(month.Commitments)),
+			}
+			for k, commitment := range month.Commitments {
+				response.Commitments[k] = CommitmentResponse{
+					Kind:          commitment.Kind,
+					AccountID:     commitment.AccountID,
+					TransactionID: commitment.TransactionID,
+					Description:   commitment.Description,
+					Date:          commitment.Date.Format("2006-01-02"),
+					Amount:        commitment.Amount.String(),
+				}
+			}
+			responses[i].Months[j] = response
+		}
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetConsolidatedReport merges the books into a single report
+//
+//	@Summary		Consolidated net worth and cash flow
+//	@Description	Merge the net worth and the cash flow of the pending and cleared transactions of every book into the reporting currency, with the share of each book in its base currency and converted. The books are added up in their base currency first. Rates give how much of the reporting currency one unit of each other currency held is worth
+//	@Tags			reports
+//	@Produce		json
+//	@Param			currency	query		string						true	"Reporting currency (e.g. USD)"
+//	@Param			rates		query		string						false	"Comma separated exchange rates to the reporting currency (e.g. BRL:0.18,GBP:1.25)"
+//	@Param			from		query		string						false	"First day of the cash flow period (YYYY-MM-DD), the current month by default"
+//	@Param			to			query		string						false	"Last day of the cash flow period (YYYY-MM-DD)"
+//	@Success		200			{object}	ConsolidatedReportResponse	"Report retrieved successfully"
+//	@Failure		400			{object}	ErrorResponseBody			"Bad request, or a missing exchange rate"
+//	@Failure		500			{object}	ErrorResponseBody			"Internal server error"
+//	@Router			/reports/consolidated [get]
+func (h *ApiHandlers) GetConsolidatedReport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	code := strings.ToUpper(query.Get("currency"))
+	if code == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("currency"))
+		return
+	}
+	asset, ok := entities.FindSupportedAsset(code)
+	if !ok {
+		errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("currency", code))
+		return
+	}
+
+	rates := entities.ExchangeRates{}
+	for _, pair := range strings.Split(query.Get("rates"), ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		currency, value, ok := strings.Cut(pair, ":")
+		rate, valid := new(big.Rat).SetString(strings.TrimSpace(value))
+		if !ok || strings.TrimSpace(currency) == "" || !valid || rate.Sign() <= 0 {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("rates", pair))
+			return
+		}
+		rates[strings.ToUpper(strings.TrimSpace(currency))] = rate
+	}
+
+	from, to, ok := parsePeriod(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := h.ReportUseCase.GetConsolidatedReport(r.Context(), asset, rates, from, to)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	defaultBookID, err := h.defaultBookID(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := ConsolidatedReportResponse{
+		Asset:  report.Asset.Asset,
+		From:   report.From.Format("2006-01-02"),
+		To:     report.To.Format("2006-01-02"),
+		Totals: consolidatedTotalsResponse(report.Totals),
+		Books:  make([]BookConsolidationResponse, len(report.Books)),
+	}
+	for i, book := range report.Books {
+		response.Books[i] = BookConsolidationResponse{
+			Book:      bookResponse(book.Book, defaultBookID),
+			Base:      consolidatedTotalsResponse(book.Base),
+			Reporting: consolidatedTotalsResponse(book.Reporting),
+		}
+	}
+
+	render.JSON(w, r, response)
+}
+
+func consolidatedTotalsResponse(totals entities.ConsolidatedTotals) ConsolidatedTotalsResponse {
+	return ConsolidatedTotalsResponse{
+		Assets:      totals.Assets.String(),
+		Liabilities: totals.Liabilities.String(),
+		NetWorth:    totals.NetWorth.String(),
+		Income:      totals.Income.String(),
+		Expenses:    totals.Expenses.String(),
+		NetCashFlow: totals.NetCashFlow.String(),
+	}
+}
+
+// GetSpending adds up the expenses by category or month
+//
+//	@Summary		Spending report
+//	@Description	Add up the pending and cleared expenses of a period by category, by month or by both, per currency, for pie and bar charts. The totals are computed by the database. The period defaults to the current month to date
+//	@Tags			reports
+//	@Produce		json
+//	@Param			start_date	query		string					false	"First day (YYYY-MM-DD), the first of the month of end_date by default"
+//	@Param			end_date	query		string					false	"Last day (YYYY-MM-DD), today by default"
+//	@Param			group_by	query		string					false	"Comma separated fields to group by (category, month)"	default(category)
+//	@Success		200			{object}	SpendingReportResponse	"Spending retrieved successfully"
+//	@Failure		400			{object}	ErrorResponseBody		"Bad request"
+//	@Failure		500			{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/reports/spending [get]
+func (h *ApiHandlers) GetSpending(w http.ResponseWriter, r *http.Request) {
+	from, err := parseDateParam(r, "start_date")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+	to, err := parseDateParam(r, "end_date")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	var by []entities.SpendingGroupField
+	for _, field := range strings.Split(r.URL.Query().Get("group_by"), ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if !slices.Contains(entities.SpendingGroupFields, entities.SpendingGroupField(field)) {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("group_by", field))
+			return
+		}
+		by = append(by, entities.SpendingGroupField(field))
+	}
+
+	report, err := h.ReportUseCase.GetSpending(r.Context(), by, from, to)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := SpendingReportResponse{
+		StartDate: report.From.Format("2006-01-02"),
+		EndDate:   report.To.Format("2006-01-02"),
+		GroupBy:   report.GroupBy,
+		Totals:    make([]string, len(report.Totals)),
+		Groups:    make([]SpendingGroupResponse, len(report.Groups)),
+	}
+	for i, total := range report.Totals {
+		response.Totals[i] = total.String()
+	}
+	for i, group := range report.Groups {
+		response.Groups[i] = SpendingGroupResponse{
+			CategoryID:    group.CategoryID,
+			CategoryName:  group.Category.Name,
+			CategoryColor: group.Category.Color,
+			Month:         group.Month,
+			Asset:         group.Total.Asset.Asset,
+			Count:         group.Count,
+			Total:         group.Total.String(),
+			Value:         monetaryValue(group.Total),
+			Share:         group.Share,
+		}
+	}
+
+	render.JSON(w, r, response)
+}
+
+// GetCashFlow returns the income and expenses per month
+//
+//	@Summary		Cash flow report
+//	@Description	Add up the pending and cleared income and expenses of every month of a period, and the net of each, per currency. Months without transactions are at zero. The totals are computed by the database. The period defaults to the last 12 months, the current one included. Custom assets are left out unless the report is of an account kept in one
+//	@Tags			reports
+//	@Produce		json
+//	@Param			start_date	query		string					false	"First day (YYYY-MM-DD), the first of the month 11 months before end_date by default"
+//	@Param			end_date	query		string					false	"Last day (YYYY-MM-DD), today by default"
+//	@Param			account_id	query		string					false	"Only the transactions of this account"
+//	@Success		200			{object}	CashFlowReportResponse	"Cash flow retrieved successfully"
+//	@Failure		400			{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404			{object}	ErrorResponseBody		"Account not found"
+//	@Failure		500			{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/reports/cashflow [get]
+func (h *ApiHandlers) GetCashFlow(w http.ResponseWriter, r *http.Request) {
+	from, err := parseDateParam(r, "start_date")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+	to, err := parseDateParam(r, "end_date")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+	accountID, err := parseUUIDParam(r, "account_id")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	report, err := h.ReportUseCase.GetCashFlow(r.Context(), accountID, from, to)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := CashFlowReportResponse{
+		StartDate: report.From.Format("2006-01-02"),
+		EndDate:   report.To.Format("2006-01-02"),
+		AccountID: report.AccountID,
+		Series:    make([]CashFlowSeriesResponse, len(report.Series)),
+	}
+	for i, series := range report.Series {
+		months := make([]CashFlowMonthResponse, len(series.Months))
+		for j, month := range series.Months {
+			months[j] = CashFlowMonthResponse{
+				Month:   month.Month,
+				Income:  month.Income.String(),
+				Expense: month.Expense.String(),
+				Net:     month.Net.String(),
+			}
+		}
+		response.Series[i] = CashFlowSeriesResponse{
+			Asset:   series.Asset.Asset,
+			Income:  series.Income.String(),
+			Expense: series.Expense.String(),
+			Net:     series.Net.String(),
+			Months:  months,
+		}
+	}
+
+	render.JSON(w, r, response)
+}
+
+// GetMonthlyReport returns the report of a month
+//
+//	@Summary		Monthly report
+//	@Description	Get the totals of each category, adding up the pending and cleared transactions of the month per currency, and the net worth at the end of the month, adding up the cleared transactions of the accounts holding money. Closed months are served from the snapshot taken when they were closed, so later edits and deletes don't change them until recalculated. The current month, and closed months without a snapshot, are calculated from the current transactions
+//	@Tags			reports
+//	@Produce		json
+//	@Param			month	path		string					true	"Month (YYYY-MM)"
+//	@Success		200		{object}	MonthlyReportResponse	"Report retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody		"Invalid month, or a month that hasn't started"
+//	@Failure		500		{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/reports/monthly/{month} [get]
+func (h *ApiHandlers) GetMonthlyReport(w http.ResponseWriter, r *http.Request) {
+	h.monthlyReport(w, r, h.ReportUseCase.GetMonthlyReport)
+}
+
+// CloseMonth snapshots the report of a closed month
+//
+//	@Summary		Close month
+//	@Description	Keep the report of a closed month as a snapshot, like the monthly close does at the start of every month. A month already closed keeps its snapshot, which is returned as is. Useful to close the months before the service was running
+//	@Tags			reports
+//	@Produce		json
+//	@Param			month	path		string					true	"Month (YYYY-MM)"
+//	@Success		200		{object}	MonthlyReportResponse	"Month closed"
+//	@Failure		400		{object}	ErrorResponseBody		"Invalid month"
+//	@Failure		409		{object}	ErrorResponseBody		"Month not over yet"
+//	@Failure		500		{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/reports/monthly/{month}/close [post]
+func (h *ApiHandlers) CloseMonth(w http.ResponseWriter, r *http.Request) {
+	h.monthlyReport(w, r, h.ReportUseCase.CloseMonth)
+}
+
+// RecalculateMonthlyReport replaces the snapshot of a closed month
+//
+//	@Summary		Recalculate monthly report
+//	@Description	Replace the snapshot of a closed month with its report calculated from the current transactions, taking in the edits and deletes made since it was closed
+//	@Tags			reports
+//	@Produce		json
+//	@Param			month	path		string					true	"Month (YYYY-MM)"
+//	@Success		200		{object}	MonthlyReportResponse	"Report recalculated"
+//	@Failure		400		{object}	ErrorResponseBody		"Invalid month"
+//	@Failure		409		{object}	ErrorResponseBody		"Month not over yet"
+//	@Failure		500		{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/reports/monthly/{month}/recalculate [post]
+func (h *ApiHandlers) RecalculateMonthlyReport(w http.ResponseWriter, r *http.Request) {
+	h.monthlyReport(w, r, h.ReportUseCase.RecalculateMonthlyReport)
+}
+
+// monthlyReport parses the month of the path, gets its report with get and
+// renders it
+func (h *ApiHandlers) monthlyReport(w http.ResponseWriter, r *http.Request, get func(ctx context.Context, month time.Time) (entities.MonthlyReport, error)) {
+	value := chi.URLParam(r, "month")
+	month, err := time.Parse("2006-01", value)
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("month", value))
+		return
+	}
+
+	report, err := get(r.Context(), month)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := MonthlyReportResponse{
+		Month:      report.Month.Format("2006-01"),
+		Snapshot:   report.Snapshot(),
+		Categories: make([]CategoryTotalResponse, len(report.Categories)),
+		NetWorth:   make([]string, len(report.NetWorth)),
+	}
+	if report.Snapshot() {
+		response.SnapshotAt = report.SnapshotAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	for i, category := range report.Categories {
+		response.Categories[i] = CategoryTotalResponse{
+			CategoryID: category.CategoryID,
+			Name:       category.Name,
+			Type:       category.Type,
+			Total:      category.Total.String(),
+		}
+	}
+	for i, amount := range report.NetWorth {
+		response.NetWorth[i] = amount.String()
+	}
+
+	render.JSON(w, r, response)
+}
@@ -0,0 +1,456 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestGetCommitments(t *testing.T) {
+	money := func(amount int64) monetary.Monetary {
+		value := &monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(amount)}
+		return *value
+	}
+	month := entities.CommitmentMonth{
+		Month:        "2025-04",
+		Faturas:      money(30000),
+		Installments: money(0),
+		Bills:        money(150000),
+		Committed:    money(180000),
+		Income:       money(500000),
+		Available:    money(320000),
+		Share:        36,
+		Commitments: []entities.Commitment{
+			{Kind: entities.CommitmentKindBill, AccountID: "acc-1", TransactionID: "txn-rent", Description: "Rent", Date: time.Date(2025, time.April, 5, 0, 0, 0, 0, time.UTC), Amount: money(150000)},
+			{Kind: entities.CommitmentKindFatura, AccountID: "acc-card", Description: "Nubank fatura 2025-04", Date: time.Date(2025, time.April, 10, 0, 0, 0, 0, time.UTC), Amount: money(30000)},
+		},
+	}
+
+	var gotMonths int
+	h := &ApiHandlers{
+		ReportUseCase: &mocks.ReportUseCaseMock{
+			GetCommitmentsFunc: func(ctx context.Context, months int) ([]entities.CommitmentReport, error) {
+				gotMonths = months
+				if months > entities.MaxCommitmentMonths {
+					return nil, fmt.Errorf("months must be between 1 and 24, got %d: %w", months, domain.ErrMalformedParameters)
+				}
+				return []entities.CommitmentReport{{Asset: monetary.BRL, Months: []entities.CommitmentMonth{month}}}, nil
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	t.Run("returns the commitments", func(t *testing.T) {
+		rec := get("/api/v1/reports/commitments")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if gotMonths != defaultCommitmentMonths {
+			t.Errorf("expected %d months, got %d", defaultCommitmentMonths, gotMonths)
+		}
+		var response []CommitmentReportResponse
+		json.NewDecoder(rec.Body).Decode(&response)
+		if len(response) != 1 || response[0].Asset != "BRL" || len(response[0].Months) != 1 {
+			t.Fatalf("unexpected response: %+v", response)
+		}
+		got := response[0].Months[0]
+		if got.Committed != "[BRL (R$) 1800.00]" || got.Available != "[BRL (R$) 3200.00]" || got.Share != 36 {
+			t.Errorf("unexpected month: %+v", got)
+		}
+		if len(got.Commitments) != 2 || got.Commitments[0].TransactionID != "txn-rent" || got.Commitments[1].Kind != entities.CommitmentKindFatura || got.Commitments[1].Date != "2025-04-10" {
+			t.Errorf("unexpected commitments: %+v", got.Commitments)
+		}
+	})
+
+	t.Run("takes the number of months", func(t *testing.T) {
+		if rec := get("/api/v1/reports/commitments?months=12"); rec.Code != http.StatusOK || gotMonths != 12 {
+			t.Errorf("expected status 200 for 12 months, got %d for %d", rec.Code, gotMonths)
+		}
+	})
+
+	t.Run("invalid months", func(t *testing.T) {
+		for _, months := range []string{"next", "36"} {
+			if rec := get("/api/v1/reports/commitments?months=" + months); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %q, got %d", months, rec.Code)
+			}
+		}
+	})
+}
+
+func TestGetConsolidatedReport(t *testing.T) {
+	usd := func(amount int64) monetary.Monetary {
+		value := &monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(amount)}
+		return *value
+	}
+	totals := entities.ConsolidatedTotals{
+		Assets:      usd(81000),
+		Liabilities: usd(4000),
+		NetWorth:    usd(77000),
+		Income:      usd(130000),
+		Expenses:    usd(20000),
+		NetCashFlow: usd(110000),
+	}
+
+	var gotAsset monetary.Asset
+	var gotRates entities.ExchangeRates
+	h := &ApiHandlers{
+		ReportUseCase: &mocks.ReportUseCaseMock{
+			GetConsolidatedReportFunc: func(ctx context.Context, asset monetary.Asset, rates entities.ExchangeRates, from, to time.Time) (entities.ConsolidatedReport, error) {
+				gotAsset, gotRates = asset, rates
+				if _, ok := rates["BRL"]; !ok {
+					return entities.ConsolidatedReport{}, fmt.Errorf("no exchange rate from BRL to USD: %w", domain.ErrMalformedParameters)
+				}
+				return entities.ConsolidatedReport{
+					Asset:  asset,
+					From:   from,
+					To:     to,
+					Totals: totals,
+					Books:  []entities.BookConsolidation{{Book: entities.Book{ID: domain.DefaultBookID, Name: "Personal", Asset: monetary.BRL}, Base: totals, Reporting: totals}},
+				}, nil
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	t.Run("returns the report", func(t *testing.T) {
+		rec := get("/api/v1/reports/consolidated?currency=usd&rates=BRL:0.18,gbp:1.08&from=2025-03-01&to=2025-03-31")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotAsset.Asset != "USD" || gotRates["BRL"].Cmp(big.NewRat(18, 100)) != 0 || gotRates["GBP"].Cmp(big.NewRat(108, 100)) != 0 {
+			t.Errorf("unexpected currency %q and rates %v passed to the use case", gotAsset.Asset, gotRates)
+		}
+
+		var response ConsolidatedReportResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.From != "2025-03-01" || response.Totals.NetWorth != "[USD ($) 770.00]" || len(response.Books) != 1 || response.Books[0].Book.Asset != "BRL" {
+			t.Errorf("unexpected report: %+v", response)
+		}
+	})
+
+	t.Run("rejects invalid parameters", func(t *testing.T) {
+		for _, path := range []string{
+			"/api/v1/reports/consolidated",
+			"/api/v1/reports/consolidated?currency=DOGE",
+			"/api/v1/reports/consolidated?currency=USD&rates=BRL",
+			"/api/v1/reports/consolidated?currency=USD&rates=BRL:-1",
+			"/api/v1/reports/consolidated?currency=USD&rates=BRL:0.18&from=March",
+		} {
+			if rec := get(path); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", path, rec.Code)
+			}
+		}
+	})
+
+	t.Run("missing exchange rate", func(t *testing.T) {
+		if rec := get("/api/v1/reports/consolidated?currency=USD"); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
+
+func TestMonthlyReport(t *testing.T) {
+	brl := func(amount int64) monetary.Monetary {
+		value := &monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(amount)}
+		return *value
+	}
+	current := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	report := func(month time.Time) entities.MonthlyReport {
+		return entities.MonthlyReport{
+			Month:      month,
+			Categories: []entities.CategoryTotal{{CategoryID: "cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense, Total: brl(85000)}},
+			NetWorth:   []monetary.Monetary{brl(1234567)},
+		}
+	}
+	snapshot := func(month time.Time) (entities.MonthlyReport, error) {
+		if !month.Before(current) {
+			return entities.MonthlyReport{}, fmt.Errorf("month %s isn't closed yet: %w", month.Format("2006-01"), domain.ErrConflict)
+		}
+		closed := report(month)
+		closed.SnapshotAt = time.Date(2025, time.March, 1, 2, 0, 0, 0, time.UTC)
+		return closed, nil
+	}
+
+	var recalculated time.Time
+	h := &ApiHandlers{
+		ReportUseCase: &mocks.ReportUseCaseMock{
+			GetMonthlyReportFunc: func(ctx context.Context, month time.Time) (entities.MonthlyReport, error) {
+				if month.Before(current) {
+					return snapshot(month)
+				}
+				return report(month), nil
+			},
+			CloseMonthFunc: func(ctx context.Context, month time.Time) (entities.MonthlyReport, error) {
+				return snapshot(month)
+			},
+			RecalculateMonthlyReportFunc: func(ctx context.Context, month time.Time) (entities.MonthlyReport, error) {
+				recalculated = month
+				return snapshot(month)
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	serve := func(method, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec
+	}
+	decode := func(t *testing.T, rec *httptest.ResponseRecorder) MonthlyReportResponse {
+		t.Helper()
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		var response MonthlyReportResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		return response
+	}
+
+	t.Run("closed month from its snapshot", func(t *testing.T) {
+		response := decode(t, serve(http.MethodGet, "/api/v1/reports/monthly/2025-02"))
+		if response.Month != "2025-02" || !response.Snapshot || response.SnapshotAt != "2025-03-01T02:00:00Z" {
+			t.Errorf("unexpected snapshot: %+v", response)
+		}
+		if len(response.Categories) != 1 || response.Categories[0] != (CategoryTotalResponse{CategoryID: "cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense, Total: "[BRL (R$) 850.00]"}) {
+			t.Errorf("unexpected categories: %+v", response.Categories)
+		}
+		if len(response.NetWorth) != 1 || response.NetWorth[0] != "[BRL (R$) 12345.67]" {
+			t.Errorf("unexpected net worth: %v", response.NetWorth)
+		}
+	})
+
+	t.Run("current month is calculated", func(t *testing.T) {
+		response := decode(t, serve(http.MethodGet, "/api/v1/reports/monthly/2025-03"))
+		if response.Snapshot || response.SnapshotAt != "" {
+			t.Errorf("expected a calculated report, got %+v", response)
+		}
+	})
+
+	t.Run("close and recalculate", func(t *testing.T) {
+		decode(t, serve(http.MethodPost, "/api/v1/reports/monthly/2025-01/close"))
+		decode(t, serve(http.MethodPost, "/api/v1/reports/monthly/2025-01/recalculate"))
+		if !recalculated.Equal(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("unexpected month recalculated: %v", recalculated)
+		}
+
+		if rec := serve(http.MethodPost, "/api/v1/reports/monthly/2025-03/close"); rec.Code != http.StatusConflict {
+			t.Errorf("expected status 409, got %d", rec.Code)
+		}
+	})
+
+	t.Run("invalid month", func(t *testing.T) {
+		for _, path := range []string{"/api/v1/reports/monthly/2025-13", "/api/v1/reports/monthly/March"} {
+			if rec := serve(http.MethodGet, path); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", path, rec.Code)
+			}
+		}
+	})
+}
+
+func TestGetSpending(t *testing.T) {
+	var gotBy []entities.SpendingGroupField
+	var gotFrom, gotTo time.Time
+	h := &ApiHandlers{
+		ReportUseCase: &mocks.ReportUseCaseMock{
+			GetSpendingFunc: func(ctx context.Context, by []entities.SpendingGroupField, from, to time.Time) (entities.SpendingReport, error) {
+				gotBy, gotFrom, gotTo = by, from, to
+				total := monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(85000)}
+				return entities.SpendingReport{
+					From:    time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC),
+					To:      time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC),
+					GroupBy: by,
+					Totals:  []monetary.Monetary{total},
+					Groups: []entities.SpendingGroup{{
+						SpendingTotal: entities.SpendingTotal{CategoryID: "cat-groceries", Month: "2025-04", Count: 12, Total: total},
+						Category:      entities.Category{ID: "cat-groceries", Name: "Groceries", Color: "#4caf50"},
+						Share:         100,
+					}},
+				}, nil
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	t.Run("returns the spending", func(t *testing.T) {
+		rec := get("/api/v1/reports/spending?start_date=2025-04-01&end_date=2025-04-30&group_by=category,month")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if len(gotBy) != 2 || gotBy[0] != entities.SpendingGroupFieldCategory || gotBy[1] != entities.SpendingGroupFieldMonth {
+			t.Errorf("unexpected group fields passed to the use case: %v", gotBy)
+		}
+		if !gotFrom.Equal(time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)) || !gotTo.Equal(time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("unexpected period passed to the use case: %v %v", gotFrom, gotTo)
+		}
+
+		var response SpendingReportResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.StartDate != "2025-04-01" || response.EndDate != "2025-04-30" || len(response.Totals) != 1 || response.Totals[0] != "[BRL (R$) 850.00]" {
+			t.Errorf("unexpected response: %+v", response)
+		}
+		want := SpendingGroupResponse{
+			CategoryID:    "cat-groceries",
+			CategoryName:  "Groceries",
+			CategoryColor: "#4caf50",
+			Month:         "2025-04",
+			Asset:         "BRL",
+			Count:         12,
+			Total:         "[BRL (R$) 850.00]",
+			Value:         850,
+			Share:         100,
+		}
+		if len(response.Groups) != 1 || response.Groups[0] != want {
+			t.Errorf("unexpected groups: %+v", response.Groups)
+		}
+	})
+
+	t.Run("groups by category by default", func(t *testing.T) {
+		if rec := get("/api/v1/reports/spending"); rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if gotBy != nil || !gotFrom.IsZero() || !gotTo.IsZero() {
+			t.Errorf("expected the defaults to be left to the use case, got %v %v %v", gotBy, gotFrom, gotTo)
+		}
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		for _, path := range []string{
+			"/api/v1/reports/spending?group_by=payee",
+			"/api/v1/reports/spending?start_date=bad",
+			"/api/v1/reports/spending?end_date=2025-02-30",
+		} {
+			if rec := get(path); rec.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", path, rec.Code)
+			}
+		}
+	})
+}
+
+func TestGetCashFlow(t *testing.T) {
+	const accountID = "3f2b1c4e-8a6d-4e1f-9b7a-2c5d8e0f1a3b"
+	brl := func(cents int64) monetary.Monetary {
+		return monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(cents)}
+	}
+
+	var gotAccountID string
+	var gotFrom, gotTo time.Time
+	h := &ApiHandlers{
+		ReportUseCase: &mocks.ReportUseCaseMock{
+			GetCashFlowFunc: func(ctx context.Context, id string, from, to time.Time) (entities.CashFlowReport, error) {
+				gotAccountID, gotFrom, gotTo = id, from, to
+				if id != "" && id != accountID {
+					return entities.CashFlowReport{}, fmt.Errorf("account %w", domain.ErrNotFound)
+				}
+				return entities.CashFlowReport{
+					From:      time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+					To:        time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC),
+					AccountID: id,
+					Series: []entities.CashFlowSeries{{
+						Asset: monetary.BRL,
+						Months: []entities.CashFlowMonth{
+							{Month: "2025-03", Income: brl(500000), Expense: brl(350000), Net: brl(150000)},
+							{Month: "2025-04", Income: brl(0), Expense: brl(120000), Net: brl(-120000)},
+						},
+						Income:  brl(500000),
+						Expense: brl(470000),
+						Net:     brl(30000),
+					}},
+				}, nil
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	t.Run("returns the cash flow", func(t *testing.T) {
+		rec := get("/api/v1/reports/cashflow?start_date=2025-03-01&end_date=2025-04-30&account_id=" + accountID)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		if gotAccountID != accountID || !gotFrom.Equal(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)) || !gotTo.Equal(time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("unexpected parameters passed to the use case: %q %v %v", gotAccountID, gotFrom, gotTo)
+		}
+
+		var response CashFlowReportResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.StartDate != "2025-03-01" || response.EndDate != "2025-04-30" || response.AccountID != accountID || len(response.Series) != 1 {
+			t.Fatalf("unexpected response: %+v", response)
+		}
+		series := response.Series[0]
+		if series.Asset != "BRL" || series.Net != "[BRL (R$) 300.00]" || len(series.Months) != 2 {
+			t.Fatalf("unexpected series: %+v", series)
+		}
+		want := CashFlowMonthResponse{Month: "2025-04", Income: "[BRL (R$) 0.00]", Expense: "[BRL (R$) 1200.00]", Net: "[BRL (R$) -1200.00]"}
+		if series.Months[1] != want {
+			t.Errorf("expected month %+v, got %+v", want, series.Months[1])
+		}
+	})
+
+	t.Run("defaults are left to the use case", func(t *testing.T) {
+		if rec := get("/api/v1/reports/cashflow"); rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if gotAccountID != "" || !gotFrom.IsZero() || !gotTo.IsZero() {
+			t.Errorf("unexpected parameters passed to the use case: %q %v %v", gotAccountID, gotFrom, gotTo)
+		}
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		for path, want := range map[string]int{
+			"/api/v1/reports/cashflow?start_date=March":                                http.StatusBadRequest,
+			"/api/v1/reports/cashflow?account_id=not-a-uuid":                           http.StatusBadRequest,
+			"/api/v1/reports/cashflow?account_id=7a8b9c0d-1e2f-4a3b-8c4d-5e6f7a8b9c0d": http.StatusNotFound,
+		} {
+			if rec := get(path); rec.Code != want {
+				t.Errorf("expected status %d for %s, got %d", want, path, rec.Code)
+			}
+		}
+	})
+}
@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// RestorePointResponse is the snapshot of the rows a bulk operation changed,
+// newest point first. RolledBackAt is set once the operation was rolled back.
+type RestorePointResponse struct {
+	ID           string                         `json:"id"`
+	Operation    entities.RestorePointOperation `json:"operation" example:"statement_import"`
+	Description  string                         `json:"description" example:"Wise statement import"`
+	Changes      []RestorePointChangeResponse   `json:"changes"`
+	CreatedAt    string                         `json:"created_at"`
+	RolledBackAt string                         `json:"rolled_back_at,omitempty"`
+}
+
+// RestorePointChangeResponse is a row changed by the operation. Before is the
+// transaction as it was, for updated transactions.
+type RestorePointChangeResponse struct {
+	Entity    entities.RestorePointEntity `json:"entity" example:"transaction"`
+	Action    entities.RestorePointAction `json:"action" example:"created"`
+	ID        string                      `json:"id"`
+	AccountID string                      `json:"account_id,omitempty"`
+	Before    *TransactionResponse        `json:"before,omitempty"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/restore_point_uc.go . RestorePointUseCase
+type RestorePointUseCase interface {
+	GetRestorePoints(ctx context.Context) ([]entities.RestorePoint, error)
+	GetRestorePoint(ctx context.Context, id string) (entities.RestorePoint, error)
+	Rollback(ctx context.Context, id string) (entities.RestorePoint, error)
+}
+
+// Restore point handlers
+
+// GetRestorePoints lists the restore points
+//
+//	@Summary		List restore points
+//	@Description	List the restore points of the book, newest first. One is taken before each bulk operation that changes rows, like statement imports, recording the rows it created or updated
+//	@Tags			restore-points
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		RestorePointResponse	"Restore points retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/restore-points [get]
+func (h *ApiHandlers) GetRestorePoints(w http.ResponseWriter, r *http.Request) {
+	points, err := h.RestorePointUseCase.GetRestorePoints(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	responses := make([]RestorePointResponse, len(points))
+	for i, point := range points {
+		responses[i] = restorePointResponse(point)
+	}
+	render.JSON(w, r, responses)
+}
+
+// GetRestorePoint retrieves a restore point
+//
+//	@Summary		Get restore point
+//	@Description	Retrieve a restore point by its ID, with the rows its operation changed
+//	@Tags			restore-points
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string					true	"Restore point ID"
+//	@Success		200	{object}	RestorePointResponse	"Restore point retrieved successfully"
+//	@Failure		400	{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody		"Restore point not found"
+//	@Router			/restore-points/{id} [get]
+func (h *ApiHandlers) GetRestorePoint(w http.ResponseWriter, r *http.Request) {
+	point, err := h.RestorePointUseCase.GetRestorePoint(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, restorePointResponse(point))
+}
+
+// RollbackRestorePoint reverts the operation of a restore point
+//
+//	@Summary		Roll back restore point
+//	@Description	Revert the operation of a restore point: the transactions it created are deleted, the ones it updated get their previous state back and the accounts it created are deleted unless they hold other transactions by now. Balances are refreshed. A restore point can only be rolled back once
+//	@Tags			restore-points
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string					true	"Restore point ID"
+//	@Success		200	{object}	RestorePointResponse	"Operation rolled back"
+//	@Failure		400	{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody		"Restore point not found"
+//	@Failure		409	{object}	ErrorResponseBody		"Restore point already rolled back"
+//	@Router			/restore-points/{id}/rollback [post]
+func (h *ApiHandlers) RollbackRestorePoint(w http.ResponseWriter, r *http.Request) {
+	point, err := h.RestorePointUseCase.Rollback(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, restorePointResponse(point))
+}
+
+func restorePointResponse(point entities.RestorePoint) RestorePointResponse {
+	response := RestorePointResponse{
+		ID:          point.ID,
+		Operation:   point.Operation,
+		Description: point.Description,
+		Changes:     make([]RestorePointChangeResponse, len(point.Changes)),
+		CreatedAt:   point.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if point.RolledBackAt != nil {
+		response.RolledBackAt = point.RolledBackAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	for i, change := range point.Changes {
+		response.Changes[i] = RestorePointChangeResponse{
+			Entity:    change.Entity,
+			Action:    change.Action,
+			ID:        change.ID,
+			AccountID: change.AccountID,
+		}
+		if before := change.Before; before != nil {
+			response.Changes[i].Before = &TransactionResponse{
+				ID:          before.ID,
+				AccountID:   before.AccountID,
+				CategoryID:  before.CategoryID,
+				Amount:      before.Monetary.String(),
+				Description: before.Description,
+				Payee:       before.Payee,
+				Date:        before.Date.Format("2006-01-02"),
+				Status:      before.Status,
+				ProjectID:   before.ProjectID,
+			}
+		}
+	}
+	return response
+}
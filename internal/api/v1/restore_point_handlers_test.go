@@ -0,0 +1,108 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestRollbackRestorePoint(t *testing.T) {
+	const pointID = "7d1c8a52-5f3e-4d2b-9a61-0c4e2b8f9a10"
+	rent := entities.Transaction{
+		ID:          "txn-rent",
+		AccountID:   "acc-1",
+		CategoryID:  "cat-1",
+		Monetary:    monetary.Monetary{Asset: monetary.GBP, Amount: big.NewInt(100000)},
+		Description: "Rent from John",
+		Date:        time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+		Status:      entities.TransactionStatusPending,
+	}
+	point := entities.RestorePoint{
+		ID:          pointID,
+		Operation:   entities.RestorePointOperationStatementImport,
+		Description: "Wise statement import",
+		CreatedAt:   time.Date(2025, time.March, 15, 9, 0, 0, 0, time.UTC),
+	}
+	point.Created(entities.RestorePointEntityTransaction, "txn-bakery", "acc-1")
+	point.Updated(rent)
+
+	rolledBack := false
+	h := &ApiHandlers{
+		RestorePointUseCase: &mocks.RestorePointUseCaseMock{
+			RollbackFunc: func(ctx context.Context, id string) (entities.RestorePoint, error) {
+				if id != pointID {
+					return entities.RestorePoint{}, fmt.Errorf("restore point %w", domain.ErrNotFound)
+				}
+				if rolledBack {
+					return entities.RestorePoint{}, fmt.Errorf("restore point %s was already rolled back: %w", id, domain.ErrConflict)
+				}
+				rolledBack = true
+				now := time.Date(2025, time.March, 15, 10, 0, 0, 0, time.UTC)
+				point.RolledBackAt = &now
+				return point, nil
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	rollback := func(id string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/restore-points/"+id+"/rollback", nil))
+		return rec
+	}
+
+	t.Run("rolls back", func(t *testing.T) {
+		rec := rollback(pointID)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+
+		var response RestorePointResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.RolledBackAt != "2025-03-15T10:00:00Z" {
+			t.Errorf("unexpected rolled back at %q", response.RolledBackAt)
+		}
+		if len(response.Changes) != 2 {
+			t.Fatalf("expected 2 changes, got %d", len(response.Changes))
+		}
+		if change := response.Changes[0]; change.Action != entities.RestorePointActionCreated || change.ID != "txn-bakery" || change.Before != nil {
+			t.Errorf("unexpected created change: %+v", change)
+		}
+		before := response.Changes[1].Before
+		if before == nil || before.Status != entities.TransactionStatusPending || before.Amount != "[GBP (£) 1000.00]" {
+			t.Errorf("unexpected updated change: %+v", before)
+		}
+	})
+
+	t.Run("already rolled back", func(t *testing.T) {
+		if rec := rollback(pointID); rec.Code != http.StatusConflict {
+			t.Errorf("expected status 409, got %d", rec.Code)
+		}
+	})
+
+	t.Run("unknown restore point", func(t *testing.T) {
+		if rec := rollback("0b6c2d0e-8f0a-4c55-b1a4-3e2f5d7c9e11"); rec.Code != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", rec.Code)
+		}
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		if rec := rollback("not-a-uuid"); rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"net/http"
+
+	"github.com/go-chi/render"
+)
+
+// Settings request/response types
+type UpdateSettingsRequest struct {
+	Currency             string            `json:"currency"`
+	Locale               string            `json:"locale"`
+	FiscalMonthStartDay  int               `json:"fiscal_month_start_day"`
+	NotificationsEnabled bool              `json:"notifications_enabled"`
+	NotificationEmail    string            `json:"notification_email"`
+	APIKeys              map[string]string `json:"api_keys"`
+}
+
+type SettingsResponse struct {
+	Currency             string            `json:"currency"`
+	Locale               string            `json:"locale"`
+	FiscalMonthStartDay  int               `json:"fiscal_month_start_day"`
+	NotificationsEnabled bool              `json:"notifications_enabled"`
+	NotificationEmail    string            `json:"notification_email"`
+	APIKeys              map[string]string `json:"api_keys"`
+	UpdatedAt            string            `json:"updated_at"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/settings_uc.go . SettingsUseCase
+type SettingsUseCase interface {
+	GetSettings(ctx context.Context) (entities.Settings, error)
+	UpdateSettings(ctx context.Context, settings entities.Settings) (entities.Settings, error)
+}
+
+// Settings handlers
+
+// GetSettings retrieves the application settings
+//
+//	@Summary		Get settings
+//	@Description	Retrieve the application settings. API keys are masked, showing only their last four characters
+//	@Tags			settings
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	SettingsResponse	"Settings retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/settings [get]
+func (h *ApiHandlers) GetSettings(w http.ResponseWriter, r *http.Request) {
+	settings, err := h.SettingsUseCase.GetSettings(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	render.JSON(w, r, toSettingsResponse(settings))
+}
+
+// UpdateSettings updates the application settings
+//
+//	@Summary		Update settings
+//	@Description	Update the application settings. Masked API keys keep their stored value and empty ones are removed
+//	@Tags			settings
+//	@Accept			json
+//	@Produce		json
+//	@Param			settings	body		UpdateSettingsRequest	true	"Updated settings"
+//	@Success		200			{object}	SettingsResponse		"Settings updated successfully"
+//	@Failure		400			{object}	ErrorResponseBody		"Bad request"
+//	@Failure		403			{object}	ErrorResponseBody		"Not an operator of the deployment"
+//	@Failure		413			{object}	ErrorResponseBody		"Request body too large"
+//	@Router			/settings [put]
+func (h *ApiHandlers) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	var req UpdateSettingsRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	currency, ok := entities.FindSupportedAsset(req.Currency)
+	if !ok {
+		errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("currency", req.Currency))
+		return
+	}
+
+	settings := entities.Settings{
+		Currency:             currency,
+		Locale:               req.Locale,
+		FiscalMonthStartDay:  req.FiscalMonthStartDay,
+		NotificationsEnabled: req.NotificationsEnabled,
+		NotificationEmail:    req.NotificationEmail,
+		APIKeys:              req.APIKeys,
+	}
+
+	updatedSettings, err := h.SettingsUseCase.UpdateSettings(r.Context(), settings)
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	render.JSON(w, r, toSettingsResponse(updatedSettings))
+}
+
+func toSettingsResponse(settings entities.Settings) SettingsResponse {
+	apiKeys := make(map[string]string, len(settings.APIKeys))
+	for provider, key := range settings.APIKeys {
+		apiKeys[provider] = maskAPIKey(key)
+	}
+
+	return SettingsResponse{
+		Currency:             settings.Currency.Asset,
+		Locale:               settings.Locale,
+		FiscalMonthStartDay:  settings.FiscalMonthStartDay,
+		NotificationsEnabled: settings.NotificationsEnabled,
+		NotificationEmail:    settings.NotificationEmail,
+		APIKeys:              apiKeys,
+		UpdatedAt:            settings.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// maskAPIKey hides all but the last four characters of an API key. The
+// "****" prefix tells the settings use case to keep the stored key on update.
+func maskAPIKey(key string) string {
+	if len(key) <= 4 {
+		return "****"
+	}
+	return "****" + key[len(key)-4:]
+}
@@ -0,0 +1,168 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/render"
+)
+
+// Simulation request/response types
+
+// SimulationRequest compares scenarios of hypothetical changes over the next
+// months, 12 by default
+type SimulationRequest struct {
+	Months    int                         `json:"months" example:"12"`
+	Scenarios []SimulationScenarioRequest `json:"scenarios"`
+}
+
+type SimulationScenarioRequest struct {
+	Name    string                    `json:"name" example:"Car loan"`
+	Changes []SimulationChangeRequest `json:"changes"`
+}
+
+// SimulationChangeRequest scales a category by a percent, or adds a monthly
+// income or expense. The amount is in the base currency of the book without
+// an asset, and lasts every month without months.
+type SimulationChangeRequest struct {
+	Type        entities.SimulationChangeType `json:"type" example:"expense"`
+	CategoryID  string                        `json:"category_id,omitempty"`
+	Percent     float64                       `json:"percent,omitempty" example:"-30"`
+	Description string                        `json:"description,omitempty" example:"Car loan"`
+	Amount      string                        `json:"amount,omitempty" example:"800.00"`
+	Asset       string                        `json:"asset,omitempty" example:"BRL"`
+	Months      int                           `json:"months,omitempty" example:"24"`
+}
+
+// SimulationResponse projects the net worth in one asset from its current
+// value, the baseline first and then each scenario
+type SimulationResponse struct {
+	Asset       string                         `json:"asset" example:"BRL"`
+	NetWorth    string                         `json:"net_worth" example:"[BRL (R$) 8000.00]"`
+	Projections []SimulationProjectionResponse `json:"projections"`
+}
+
+// SimulationProjectionResponse is a scenario month by month. Difference is
+// how much its final net worth is above the baseline's.
+type SimulationProjectionResponse struct {
+	Name        string                    `json:"name" example:"baseline"`
+	EndNetWorth string                    `json:"end_net_worth" example:"[BRL (R$) 39200.00]"`
+	Difference  string                    `json:"difference" example:"[BRL (R$) -9600.00]"`
+	Months      []SimulationMonthResponse `json:"months"`
+}
+
+type SimulationMonthResponse struct {
+	Month       string `json:"month" example:"2025-04"`
+	Income      string `json:"income" example:"[BRL (R$) 5000.00]"`
+	Expenses    string `json:"expenses" example:"[BRL (R$) 2400.00]"`
+	NetCashFlow string `json:"net_cash_flow" example:"[BRL (R$) 2600.00]"`
+	NetWorth    string `json:"net_worth" example:"[BRL (R$) 10600.00]"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/simulation_uc.go . SimulationUseCase
+type SimulationUseCase interface {
+	Simulate(ctx context.Context, months int, scenarios []entities.SimulationScenario) ([]entities.Simulation, error)
+}
+
+// Simulation handlers
+
+// Simulate projects what-if scenarios
+//
+//	@Summary		What-if scenario simulator
+//	@Description	Project the net worth of each currency month by month over the next 1 to 24 months, starting with the next one, without any change and with the changes of each of up to 5 scenarios: a category cut or raised by a percent, its subcategories included, or an income or expense added every month, for some months or all of them. The projections carry on the average monthly amount of each category over the six months before the current one from the current net worth, drafts, cancelled transactions and custom assets left out
+//	@Tags			reports
+//	@Accept			json
+//	@Produce		json
+//	@Param			simulation	body		SimulationRequest		true	"Scenarios to compare"
+//	@Success		200			{array}		SimulationResponse		"Projections"
+//	@Failure		400			{object}	ErrorResponseBody		"Bad request"
+//	@Failure		404			{object}	ErrorResponseBody		"Category not found"
+//	@Failure		413			{object}	ErrorResponseBody		"Request body too large"
+//	@Failure		500			{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/simulations [post]
+func (h *ApiHandlers) Simulate(w http.ResponseWriter, r *http.Request) {
+	var req SimulationRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	months := req.Months
+	if months == 0 {
+		months = entities.DefaultSimulationMonths
+	}
+
+	scenarios := make([]entities.SimulationScenario, len(req.Scenarios))
+	for i, scenario := range req.Scenarios {
+		scenarios[i] = entities.SimulationScenario{Name: scenario.Name, Changes: make([]entities.SimulationChange, len(scenario.Changes))}
+		for j, change := range scenario.Changes {
+			prefix := fmt.Sprintf("scenarios[%d].changes[%d].", i, j)
+			simulated := entities.SimulationChange{
+				Type:        change.Type,
+				CategoryID:  change.CategoryID,
+				Percent:     change.Percent,
+				Description: change.Description,
+				Months:      change.Months,
+			}
+			if change.Asset != "" {
+				var ok bool
+				if simulated.Amount.Asset, ok = entities.FindSupportedAsset(change.Asset); !ok {
+					errorResponse(w, r, http.StatusBadRequest, errInvalidParameter(prefix+"asset", "unsupported asset"))
+					return
+				}
+			}
+			if change.Amount != "" {
+				amountFloat, err := strconv.ParseFloat(change.Amount, 64)
+				if err != nil {
+					errorResponse(w, r, http.StatusBadRequest, errInvalidParameter(prefix+"amount", "must be a valid decimal number"))
+					return
+				}
+				simulated.Amount.Amount = big.NewInt(int64(amountFloat * 100))
+			}
+			scenarios[i].Changes[j] = simulated
+		}
+	}
+
+	simulations, err := h.SimulationUseCase.Simulate(r.Context(), months, scenarios)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	responses := make([]SimulationResponse, len(simulations))
+	for i, simulation := range simulations {
+		responses[i] = SimulationResponse{
+			Asset:       simulation.Asset.Asset,
+			NetWorth:    simulation.NetWorth.String(),
+			Projections: make([]SimulationProjectionResponse, len(simulation.Projections)),
+		}
+		for j, projection := range simulation.Projections {
+			responses[i].Projections[j] = simulationProjectionResponse(projection)
+		}
+	}
+
+	render.JSON(w, r, responses)
+}
+
+func simulationProjectionResponse(projection entities.SimulationProjection) SimulationProjectionResponse {
+	response := SimulationProjectionResponse{
+		Name:        projection.Name,
+		EndNetWorth: projection.EndNetWorth.String(),
+		Difference:  projection.Difference.String(),
+		Months:      make([]SimulationMonthResponse, len(projection.Months)),
+	}
+	for i, month := range projection.Months {
+		response.Months[i] = SimulationMonthResponse{
+			Month:       month.Month,
+			Income:      month.Income.String(),
+			Expenses:    month.Expenses.String(),
+			NetCashFlow: month.NetCashFlow.String(),
+			NetWorth:    month.NetWorth.String(),
+		}
+	}
+	return response
+}
@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestSimulate(t *testing.T) {
+	brl := func(amount int64) monetary.Monetary {
+		return monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(amount)}
+	}
+	mockUC := &mocks.SimulationUseCaseMock{
+		SimulateFunc: func(ctx context.Context, months int, scenarios []entities.SimulationScenario) ([]entities.Simulation, error) {
+			if len(scenarios) == 0 {
+				return nil, fmt.Errorf("a simulation compares 1 to 5 scenarios, got 0: %w", domain.ErrMalformedParameters)
+			}
+			month := entities.SimulationMonth{Month: "2025-04", Income: brl(500000), Expenses: brl(240000), NetCashFlow: brl(260000), NetWorth: brl(1060000)}
+			return []entities.Simulation{{
+				Asset:    monetary.BRL,
+				NetWorth: brl(800000),
+				Projections: []entities.SimulationProjection{
+					{Name: entities.BaselineScenario, Months: []entities.SimulationMonth{month}, EndNetWorth: brl(1060000), Difference: brl(0)},
+					{Name: scenarios[0].Name, Months: []entities.SimulationMonth{month}, EndNetWorth: brl(980000), Difference: brl(-80000)},
+				},
+			}}, nil
+		},
+	}
+	r := chi.NewRouter()
+	(&ApiHandlers{SimulationUseCase: mockUC}).Routes(r)
+
+	post := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/simulations", strings.NewReader(body)))
+		return w
+	}
+
+	t.Run("projects the scenarios", func(t *testing.T) {
+		w := post(`{"scenarios": [{"name": "Car loan", "changes": [
+			{"type": "expense", "description": "Car loan", "amount": "800.00", "months": 24},
+			{"type": "category", "category_id": "cat-dining", "percent": -30}
+		]}]}`)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		call := mockUC.SimulateCalls()[0]
+		if call.Months != entities.DefaultSimulationMonths {
+			t.Errorf("expected the default months, got %d", call.Months)
+		}
+		changes := call.Scenarios[0].Changes
+		if len(changes) != 2 || changes[0].Type != entities.SimulationChangeExpense || changes[0].Amount.Amount.Int64() != 80000 || changes[0].Amount.Asset.Asset != "" || changes[0].Months != 24 ||
+			changes[1].CategoryID != "cat-dining" || changes[1].Percent != -30 {
+			t.Errorf("unexpected changes passed to the use case: %+v", changes)
+		}
+
+		var responses []SimulationResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &responses); err != nil {
+			t.Fatal(err)
+		}
+		if len(responses) != 1 || responses[0].Asset != "BRL" || len(responses[0].Projections) != 2 {
+			t.Fatalf("unexpected simulations: %+v", responses)
+		}
+		if projection := responses[0].Projections[1]; projection.Name != "Car loan" || projection.Difference != brl(-80000).String() || len(projection.Months) != 1 || projection.Months[0].Month != "2025-04" {
+			t.Errorf("unexpected projection: %+v", projection)
+		}
+	})
+
+	t.Run("takes the asset and months asked for", func(t *testing.T) {
+		w := post(`{"months": 24, "scenarios": [{"name": "Freelance", "changes": [{"type": "income", "amount": "1000", "asset": "USD"}]}]}`)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		call := mockUC.SimulateCalls()[1]
+		if call.Months != 24 || call.Scenarios[0].Changes[0].Amount.Asset.Asset != "USD" {
+			t.Errorf("unexpected simulation passed to the use case: %+v", call)
+		}
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for _, body := range []string{
+			`{"scenarios": `,
+			`{"scenarios": []}`,
+			`{"scenarios": [{"name": "Loan", "changes": [{"type": "expense", "amount": "lots"}]}]}`,
+			`{"scenarios": [{"name": "Loan", "changes": [{"type": "expense", "amount": "800", "asset": "XYZ"}]}]}`,
+		} {
+			if w := post(body); w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d for %s, got %d: %s", http.StatusBadRequest, body, w.Code, w.Body.String())
+			}
+		}
+	})
+}
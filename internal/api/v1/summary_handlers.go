@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"net/http"
+)
+
+// Summary response types
+type MinimalSummaryResponse struct {
+	Asset          string                `json:"asset" example:"USD"`
+	NetWorth       string                `json:"net_worth" example:"[USD ($) 12345.67]"`
+	TodaySpend     string                `json:"today_spend" example:"[USD ($) 42.10]"`
+	UpcomingBill   *UpcomingBillResponse `json:"upcoming_bill"`
+	LastCalculated string                `json:"last_calculated"`
+}
+
+type UpcomingBillResponse struct {
+	TransactionID string `json:"transaction_id"`
+	AccountID     string `json:"account_id"`
+	Description   string `json:"description" example:"Credit card bill"`
+	Amount        string `json:"amount" example:"[USD ($) -750.00]"`
+	Date          string `json:"date" example:"2025-05-12"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/summary_uc.go . SummaryUseCase
+type SummaryUseCase interface {
+	GetMinimalSummary(ctx context.Context) (entities.MinimalSummary, error)
+}
+
+// Summary handlers
+
+// GetMinimalSummary returns the net worth, today's spend and the next bill
+//
+//	@Summary		Get the minimal summary
+//	@Description	Get the net worth, the expenses dated today and the earliest pending expense of the next 30 days, for tiny polling clients like menubar widgets. The response carries an ETag, send it back in If-None-Match to get 304 Not Modified while nothing changed
+//	@Tags			summary
+//	@Produce		json
+//	@Param			If-None-Match	header		string					false	"ETag of the last response"
+//	@Success		200				{object}	MinimalSummaryResponse	"Minimal summary"
+//	@Success		304				"Not modified"
+//	@Failure		500				{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/summary/minimal [get]
+func (h *ApiHandlers) GetMinimalSummary(w http.ResponseWriter, r *http.Request) {
+	summary, err := h.SummaryUseCase.GetMinimalSummary(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := MinimalSummaryResponse{
+		Asset:          summary.NetWorth.Asset.Asset,
+		NetWorth:       summary.NetWorth.String(),
+		TodaySpend:     summary.TodaySpend.String(),
+		LastCalculated: summary.LastCalculated.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if bill := summary.UpcomingBill; bill != nil {
+		response.UpcomingBill = &UpcomingBillResponse{
+			TransactionID: bill.ID,
+			AccountID:     bill.AccountID,
+			Description:   bill.Description,
+			Amount:        bill.Monetary.String(),
+			Date:          bill.Date.Format("2006-01-02"),
+		}
+	}
+
+	renderWithETag(w, r, response)
+}
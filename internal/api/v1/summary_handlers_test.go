@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestGetMinimalSummary(t *testing.T) {
+	netWorth, _ := monetary.NewMonetary(monetary.USD, big.NewInt(1234567))
+	spend, _ := monetary.NewMonetary(monetary.USD, big.NewInt(2450))
+	bill := &monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(-5000)}
+
+	summary := entities.MinimalSummary{
+		NetWorth:   *netWorth,
+		TodaySpend: *spend,
+		UpcomingBill: &entities.Transaction{
+			ID:          "txn-1",
+			AccountID:   "acc-1",
+			Description: "Phone",
+			Monetary:    *bill,
+			Date:        time.Date(2025, time.May, 13, 0, 0, 0, 0, time.UTC),
+		},
+		LastCalculated: time.Date(2025, time.May, 10, 18, 30, 0, 0, time.UTC),
+	}
+	h := &ApiHandlers{
+		SummaryUseCase: &mocks.SummaryUseCaseMock{
+			GetMinimalSummaryFunc: func(ctx context.Context) (entities.MinimalSummary, error) {
+				return summary, nil
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/summary/minimal", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get("")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	var response MinimalSummaryResponse
+	if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if response.NetWorth != "[USD ($) 12345.67]" || response.TodaySpend != "[USD ($) 24.50]" || response.Asset != "USD" {
+		t.Errorf("unexpected totals: %+v", response)
+	}
+	if response.UpcomingBill == nil || response.UpcomingBill.Date != "2025-05-13" || response.UpcomingBill.Amount != "[USD ($) -50.00]" {
+		t.Errorf("unexpected upcoming bill: %+v", response.UpcomingBill)
+	}
+
+	etag := rec.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("expected an ETag")
+	}
+
+	for _, ifNoneMatch := range []string{etag, "W/" + etag, `"other", ` + etag} {
+		if rec := get(ifNoneMatch); rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+			t.Errorf("If-None-Match %s: expected an empty 304, got %d", ifNoneMatch, rec.Code)
+		}
+	}
+
+	// A change makes for a new ETag
+	summary.TodaySpend = *netWorth
+	rec = get(etag)
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status 200 after a change, got %d", rec.Code)
+	}
+	if rec.Header().Get("ETag") == etag {
+		t.Error("expected a new ETag after a change")
+	}
+}
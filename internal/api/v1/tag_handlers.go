@@ -0,0 +1,173 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+type TagRequest struct {
+	Name string `json:"name" example:"vacation2024"`
+}
+
+type TagResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name" example:"vacation2024"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/tag_uc.go . TagUseCase
+type TagUseCase interface {
+	CreateTag(ctx context.Context, tag entities.Tag) (entities.Tag, error)
+	GetTags(ctx context.Context) ([]entities.Tag, error)
+	GetTag(ctx context.Context, id string) (entities.Tag, error)
+	UpdateTag(ctx context.Context, tag entities.Tag) (entities.Tag, error)
+	DeleteTag(ctx context.Context, id string) error
+}
+
+// Tag handlers
+
+// CreateTag creates a tag
+//
+//	@Summary		Create tag
+//	@Description	Add a tag to the book. Names are lowercased, and tags given to transactions are added on their own, so creating them first is optional
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Param			tag	body		TagRequest			true	"Tag"
+//	@Success		201	{object}	TagResponse			"Tag created successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Invalid tag"
+//	@Failure		409	{object}	ErrorResponseBody	"Tag already exists"
+//	@Failure		413	{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/tags [post]
+func (h *ApiHandlers) CreateTag(w http.ResponseWriter, r *http.Request) {
+	var req TagRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	tag, err := h.TagUseCase.CreateTag(r.Context(), entities.Tag{Name: req.Name})
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, tagResponse(tag))
+}
+
+// GetTags lists the tags
+//
+//	@Summary		List tags
+//	@Description	List the tags of the book ordered by name
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		TagResponse			"Tags retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/tags [get]
+func (h *ApiHandlers) GetTags(w http.ResponseWriter, r *http.Request) {
+	tags, err := h.TagUseCase.GetTags(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	responses := make([]TagResponse, len(tags))
+	for i, tag := range tags {
+		responses[i] = tagResponse(tag)
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetTag retrieves a tag
+//
+//	@Summary		Get tag
+//	@Description	Retrieve a tag by its ID
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string				true	"Tag ID"
+//	@Success		200	{object}	TagResponse			"Tag retrieved successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Tag not found"
+//	@Router			/tags/{id} [get]
+func (h *ApiHandlers) GetTag(w http.ResponseWriter, r *http.Request) {
+	tag, err := h.TagUseCase.GetTag(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, tagResponse(tag))
+}
+
+// UpdateTag renames a tag
+//
+//	@Summary		Rename tag
+//	@Description	Rename a tag, on all its transactions at once
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string				true	"Tag ID"
+//	@Param			tag	body		TagRequest			true	"Tag"
+//	@Success		200	{object}	TagResponse			"Tag renamed successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Invalid tag"
+//	@Failure		404	{object}	ErrorResponseBody	"Tag not found"
+//	@Failure		409	{object}	ErrorResponseBody	"Tag already exists"
+//	@Failure		413	{object}	ErrorResponseBody	"Request body too large"
+//	@Router			/tags/{id} [put]
+func (h *ApiHandlers) UpdateTag(w http.ResponseWriter, r *http.Request) {
+	var req TagRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	tag, err := h.TagUseCase.UpdateTag(r.Context(), entities.Tag{
+		ID:   chi.URLParam(r, "id"),
+		Name: req.Name,
+	})
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, tagResponse(tag))
+}
+
+// DeleteTag deletes a tag
+//
+//	@Summary		Delete tag
+//	@Description	Delete a tag, taking it off its transactions
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	string	true	"Tag ID"
+//	@Success		204	"Tag deleted successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Tag not found"
+//	@Router			/tags/{id} [delete]
+func (h *ApiHandlers) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	if err := h.TagUseCase.DeleteTag(r.Context(), chi.URLParam(r, "id")); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func tagResponse(tag entities.Tag) TagResponse {
+	return TagResponse{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		CreatedAt: tag.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: tag.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"finance/domain"
+	"finance/domain/entities"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/render"
+)
+
+// BulkTransactionsRequest lists the operations to apply, in order
+type BulkTransactionsRequest struct {
+	Operations []BulkOperationRequest `json:"operations"`
+}
+
+// BulkOperationRequest creates Transaction, changes the status of the
+// transaction ID to Status, or deletes the transaction ID
+type BulkOperationRequest struct {
+	Action      entities.BulkAction        `json:"action" example:"update_status"`
+	ID          string                     `json:"id,omitempty"`
+	Status      entities.TransactionStatus `json:"status,omitempty" example:"cleared"`
+	Transaction *CreateTransactionRequest  `json:"transaction,omitempty"`
+}
+
+// BulkTransactionsResponse has the result of each operation, in the order
+// they were given
+type BulkTransactionsResponse struct {
+	Results []BulkResultResponse `json:"results"`
+}
+
+// BulkResultResponse is the transaction an operation created or updated, or
+// the one it deleted as it was
+type BulkResultResponse struct {
+	Action      entities.BulkAction `json:"action" example:"create"`
+	Transaction TransactionResponse `json:"transaction"`
+}
+
+// BulkFailureResponse is why the operation at Index, counting from 0, failed
+type BulkFailureResponse struct {
+	Index int    `json:"index" example:"3"`
+	Error string `json:"error"`
+}
+
+// BulkTransactions applies many transaction operations at once
+//
+//	@Summary		Bulk transaction operations
+//	@Description	Create transactions, change their status or delete them, up to 500 operations in a request. The operations are applied in order and all of them or none: when any would fail nothing is applied, and the error lists every operation that failed by its index. Statuses can't go back to draft, and deleted transactions go to the trash
+//	@Tags			transactions
+//	@Accept			json
+//	@Produce		json
+//	@Param			operations	body		BulkTransactionsRequest		true	"Operations to apply"
+//	@Param			include		query		string						false	"Related resources to embed (account, category)"
+//	@Success		200			{object}	BulkTransactionsResponse	"Operations applied"
+//	@Failure		400			{object}	ErrorResponseBody			"Bad request, with the operations that failed"
+//	@Failure		402			{object}	ErrorResponseBody			"Quota of the free tier reached"
+//	@Failure		413			{object}	ErrorResponseBody			"Request body too large"
+//	@Failure		429			{object}	ErrorResponseBody			"Monthly quota reached, until the Retry-After seconds passed"
+//	@Failure		500			{object}	ErrorResponseBody			"Internal server error"
+//	@Router			/transactions/bulk [post]
+func (h *ApiHandlers) BulkTransactions(w http.ResponseWriter, r *http.Request) {
+	include, err := parseInclude(r, "account", "category")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	var req BulkTransactionsRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		slog.Error("failed to decode bulk request", "error", err)
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	// The transactions to create that can't be parsed fail like the ones the
+	// use case refuses
+	operations := make([]entities.BulkOperation, len(req.Operations))
+	var failures []domain.BulkFailure
+	for i, operation := range req.Operations {
+		operations[i] = entities.BulkOperation{Action: operation.Action, ID: operation.ID, Status: operation.Status}
+		if operation.Action != entities.BulkActionCreate {
+			continue
+		}
+		if operation.Transaction == nil {
+			failures = append(failures, domain.BulkFailure{Index: i, Err: errMissingParameter("transaction")})
+			continue
+		}
+		transaction, err := operation.Transaction.transaction()
+		if err != nil {
+			failures = append(failures, domain.BulkFailure{Index: i, Err: err})
+			continue
+		}
+		operations[i].Transaction = transaction
+	}
+	if len(failures) > 0 {
+		errorResponse(w, r, http.StatusBadRequest, &domain.BulkError{Failures: failures})
+		return
+	}
+
+	results, err := h.TransactionUseCase.BulkTransactions(r.Context(), operations)
+	if err != nil {
+		slog.Error("failed to apply bulk operations", "error", err, "operations", len(operations))
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	response := BulkTransactionsResponse{Results: make([]BulkResultResponse, len(results))}
+	for i, result := range results {
+		transaction := transactionResponse(result.Transaction)
+		if include["account"] && result.Transaction.Account != nil {
+			transaction.Account = &AccountResponse{
+				ID:          result.Transaction.Account.ID,
+				Name:        result.Transaction.Account.Name,
+				Type:        result.Transaction.Account.Type,
+				Asset:       result.Transaction.Account.Asset.Asset,
+				Description: result.Transaction.Account.Description,
+			}
+		}
+		if include["category"] && result.Transaction.Category != nil {
+			transaction.Category = &CategoryResponse{
+				ID:          result.Transaction.Category.ID,
+				Name:        result.Transaction.Category.Name,
+				Type:        result.Transaction.Category.Type,
+				Description: result.Transaction.Category.Description,
+				Color:       result.Transaction.Category.Color,
+			}
+		}
+		response.Results[i] = BulkResultResponse{Action: result.Action, Transaction: transaction}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response)
+}
@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestBulkTransactions(t *testing.T) {
+	amount, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(4590))
+	mockUC := &mocks.TransactionUseCaseMock{
+		BulkTransactionsFunc: func(ctx context.Context, operations []entities.BulkOperation) ([]entities.BulkResult, error) {
+			results := make([]entities.BulkResult, len(operations))
+			for i, operation := range operations {
+				transaction := operation.Transaction
+				if operation.Action != entities.BulkActionCreate {
+					transaction = entities.Transaction{ID: operation.ID, AccountID: "acc-1", Status: operation.Status}
+				} else {
+					transaction.ID = fmt.Sprintf("tx-%d", i)
+				}
+				transaction.Monetary = *amount
+				transaction.Account = &entities.Account{ID: "acc-1", Name: "Checking", Asset: monetary.BRL}
+				results[i] = entities.BulkResult{Action: operation.Action, Transaction: transaction}
+			}
+			return results, nil
+		},
+	}
+	r := chi.NewRouter()
+	(&ApiHandlers{TransactionUseCase: mockUC}).Routes(r)
+
+	post := func(path, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+		return w
+	}
+
+	t.Run("applies the operations", func(t *testing.T) {
+		w := post("/api/v1/transactions/bulk?include=account", `{"operations": [
+			{"action": "create", "transaction": {"account_id": "acc-1", "category_id": "cat-1", "amount": "45.90", "description": "Market", "date": "2025-03-10"}},
+			{"action": "update_status", "id": "tx-cleared", "status": "cancelled"},
+			{"action": "delete", "id": "tx-old"}
+		]}`)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		operations := mockUC.BulkTransactionsCalls()[0].Operations
+		if len(operations) != 3 || operations[0].Transaction.Description != "Market" || operations[0].Transaction.Date.Format("2006-01-02") != "2025-03-10" ||
+			operations[1].ID != "tx-cleared" || operations[1].Status != entities.TransactionStatusCancelled || operations[2].Action != entities.BulkActionDelete {
+			t.Errorf("unexpected operations passed to the use case: %+v", operations)
+		}
+
+		var response BulkTransactionsResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatal(err)
+		}
+		if len(response.Results) != 3 {
+			t.Fatalf("expected 3 results, got %+v", response.Results)
+		}
+		if result := response.Results[0]; result.Action != entities.BulkActionCreate || result.Transaction.ID != "tx-0" || result.Transaction.Account == nil {
+			t.Errorf("unexpected result: %+v", result)
+		}
+		if result := response.Results[2]; result.Action != entities.BulkActionDelete || result.Transaction.ID != "tx-old" {
+			t.Errorf("unexpected result: %+v", result)
+		}
+	})
+
+	t.Run("transactions that can't be parsed", func(t *testing.T) {
+		calls := len(mockUC.BulkTransactionsCalls())
+		w := post("/api/v1/transactions/bulk", `{"operations": [
+			{"action": "create", "transaction": {"account_id": "acc-1", "category_id": "cat-1", "amount": "45.90"}},
+			{"action": "create", "transaction": {"account_id": "acc-1", "category_id": "cat-1", "amount": "lots"}},
+			{"action": "create"}
+		]}`)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusBadRequest, w.Code, w.Body.String())
+		}
+		var body ErrorResponseBody
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatal(err)
+		}
+		if len(body.Operations) != 2 || body.Operations[0].Index != 1 || body.Operations[1].Index != 2 || body.Operations[1].Error != "missing required parameter: transaction" {
+			t.Errorf("unexpected failures: %+v", body.Operations)
+		}
+		if len(mockUC.BulkTransactionsCalls()) != calls {
+			t.Error("expected the use case not to be called")
+		}
+	})
+
+	t.Run("operations the use case refuses", func(t *testing.T) {
+		mockUC.BulkTransactionsFunc = func(ctx context.Context, operations []entities.BulkOperation) ([]entities.BulkResult, error) {
+			return nil, &domain.BulkError{Failures: []domain.BulkFailure{
+				{Index: 0, Err: fmt.Errorf("failed to get transaction: transaction %w", domain.ErrNotFound)},
+				{Index: 1, Err: fmt.Errorf("cannot turn a cleared transaction back into a draft: %w", domain.ErrConflict)},
+			}}
+		}
+		w := post("/api/v1/transactions/bulk", `{"operations": [{"action": "delete", "id": "missing"}, {"action": "update_status", "id": "tx-cleared", "status": "draft"}]}`)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusBadRequest, w.Code, w.Body.String())
+		}
+		var body ErrorResponseBody
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(body.Error, "2 operations failed") || len(body.Operations) != 2 || body.Operations[1].Error != "cannot turn a cleared transaction back into a draft: data conflict" {
+			t.Errorf("unexpected error: %+v", body)
+		}
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for _, body := range []string{`{"operations": `, `[]`} {
+			if w := post("/api/v1/transactions/bulk", body); w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d for %s, got %d", http.StatusBadRequest, body, w.Code)
+			}
+		}
+		if w := post("/api/v1/transactions/bulk?include=project", `{"operations": []}`); w.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+}
@@ -2,8 +2,10 @@ package v1
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"finance/domain/entities"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -22,40 +24,108 @@ type CreateTransactionRequest struct {
 	CategoryID  string                     `json:"category_id"`
 	Amount      string                     `json:"amount"`
 	Description string                     `json:"description"`
+	Payee       string                     `json:"payee"`
 	Date        string                     `json:"date"`
 	Status      entities.TransactionStatus `json:"status"`
+	ProjectID   string                     `json:"project_id"`
+	Tags        []string                   `json:"tags" example:"vacation2024,reimbursable"`
 }
 
+// UpdateTransactionRequest replaces a transaction. Tags left out keep the
+// transaction's tags, an empty list removes them.
 type UpdateTransactionRequest struct {
 	AccountID   string                     `json:"account_id"`
 	CategoryID  string                     `json:"category_id"`
 	Amount      string                     `json:"amount"`
 	Description string                     `json:"description"`
+	Payee       string                     `json:"payee"`
 	Date        string                     `json:"date"`
 	Status      entities.TransactionStatus `json:"status"`
+	ProjectID   string                     `json:"project_id"`
+	Tags        []string                   `json:"tags" example:"vacation2024,reimbursable"`
 }
 
+// CreateInstallmentPurchaseRequest is a purchase paid in monthly installments.
+// Amount is the total of the purchase and Date the day of the first
+// installment.
+type CreateInstallmentPurchaseRequest struct {
+	AccountID    string                     `json:"account_id"`
+	CategoryID   string                     `json:"category_id"`
+	Amount       string                     `json:"amount"`
+	Description  string                     `json:"description"`
+	Payee        string                     `json:"payee"`
+	Date         string                     `json:"date"`
+	Status       entities.TransactionStatus `json:"status"`
+	ProjectID    string                     `json:"project_id"`
+	Tags         []string                   `json:"tags" example:"vacation2024,reimbursable"`
+	Installments int                        `json:"installments" example:"12"`
+}
+
+// DuplicateTransactionRequest optionally moves the copy to another date. The body
+// is optional, without a date the copy gets the user's default date.
+type DuplicateTransactionRequest struct {
+	Date string `json:"date"`
+}
+
+// FinalizeTransactionRequest picks the status a draft is finalized as. The body
+// is optional, an empty status falls back to the user preferences.
+type FinalizeTransactionRequest struct {
+	Status entities.TransactionStatus `json:"status"`
+}
+
+// TransactionResponse is a transaction. Installments of an installment plan
+// carry the plan ID and their position, like 3 of 12. ProjectID is the project
+// the transaction is filed under, and Tags the names of its tags.
 type TransactionResponse struct {
-	ID          string                     `json:"id"`
-	AccountID   string                     `json:"account_id"`
-	CategoryID  string                     `json:"category_id"`
-	Amount      string                     `json:"amount"`
-	Description string                     `json:"description"`
-	Date        string                     `json:"date"`
-	Status      entities.TransactionStatus `json:"status"`
-	CreatedAt   string                     `json:"created_at"`
-	UpdatedAt   string                     `json:"updated_at"`
-	Account     *AccountResponse           `json:"account,omitempty"`
-	Category    *CategoryResponse          `json:"category,omitempty"`
+	ID                string                     `json:"id"`
+	AccountID         string                     `json:"account_id"`
+	CategoryID        string                     `json:"category_id"`
+	Amount            string                     `json:"amount"`
+	Description       string                     `json:"description"`
+	Payee             string                     `json:"payee,omitempty"`
+	Date              string                     `json:"date"`
+	Status            entities.TransactionStatus `json:"status"`
+	InstallmentPlanID string                     `json:"installment_plan_id,omitempty"`
+	InstallmentNumber int                        `json:"installment_number,omitempty" example:"3"`
+	InstallmentCount  int                        `json:"installment_count,omitempty" example:"12"`
+	ProjectID         string                     `json:"project_id,omitempty"`
+	Tags              []string                   `json:"tags,omitempty" example:"vacation2024,reimbursable"`
+	Attachments       []AttachmentResponse       `json:"attachments,omitempty"`
+	CreatedAt         string                     `json:"created_at"`
+	UpdatedAt         string                     `json:"updated_at"`
+	DeletedAt         string                     `json:"deleted_at,omitempty" example:"2025-03-14T10:30:00Z"`
+	Account           *AccountResponse           `json:"account,omitempty"`
+	Category          *CategoryResponse          `json:"category,omitempty"`
+	// BudgetWarnings are the budgets the transaction just created left over
+	// their limit in its month. They don't stop the transaction.
+	BudgetWarnings []BudgetWarningResponse `json:"budget_warnings,omitempty"`
 }
 
 //go:generate moq -skip-ensure -stub -pkg mocks -out mocks/transaction_uc.go . TransactionUseCase
 type TransactionUseCase interface {
 	CreateTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error)
 	GetTransactionWithDetails(ctx context.Context, id string) (entities.Transaction, error)
-	GetTransactionsWithDetails(ctx context.Context, limit int, offset int) ([]entities.Transaction, error)
+	GetTransactionsPage(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error)
 	UpdateTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error)
 	DeleteTransaction(ctx context.Context, id string) error
+	DuplicateTransaction(ctx context.Context, id string, date time.Time) (entities.Transaction, error)
+	CreateInstallmentPurchase(ctx context.Context, transaction entities.Transaction, installments int) (entities.InstallmentPlan, error)
+	FinalizeTransaction(ctx context.Context, id string, status entities.TransactionStatus) (entities.Transaction, error)
+	DiscardDraft(ctx context.Context, id string) error
+	GetTrashPage(ctx context.Context, limit, offset int) (entities.Page[entities.Transaction], error)
+	SearchTransactionsPage(ctx context.Context, query string, limit, offset int) (entities.Page[entities.Transaction], error)
+	RestoreTransaction(ctx context.Context, id string) (entities.Transaction, error)
+	BulkTransactions(ctx context.Context, operations []entities.BulkOperation) ([]entities.BulkResult, error)
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/transaction_export_uc.go . TransactionExportUseCase
+type TransactionExportUseCase interface {
+	ExportTransactions(ctx context.Context, format entities.TransactionExportFormat, filter entities.TransactionFilter, sort []entities.SortField) (entities.TransactionExport, error)
+	CreateExport(ctx context.Context, format entities.TransactionExportFormat, filter entities.TransactionFilter, sort []entities.SortField) (entities.Export, error)
+	GetExports(ctx context.Context) ([]entities.Export, error)
+	GetExport(ctx context.Context, id string) (entities.Export, error)
+	OpenExport(ctx context.Context, id string) (entities.Export, io.ReadCloser, error)
+	DeleteExport(ctx context.Context, id string) error
 }
 
 // Transaction handlers
@@ -63,81 +133,182 @@ type TransactionUseCase interface {
 // CreateTransaction creates a new transaction
 //
 //	@Summary		Create a new transaction
-//	@Description	Create a new financial transaction with the provided details
+//	@Description	Create a new financial transaction with the provided details. When it leaves its category, or the group of it, over the monthly budget, budget_warnings tells which budgets and by how much; the transaction is created all the same
 //	@Tags			transactions
 //	@Accept			json
 //	@Produce		json
 //	@Param			transaction	body		CreateTransactionRequest	true	"Transaction data"
+//	@Param			include		query		string						false	"Related resources to embed (account, category)"
 //	@Success		201			{object}	TransactionResponse			"Transaction created successfully"
 //	@Failure		400			{object}	ErrorResponseBody			"Bad request"
+//	@Failure		402			{object}	ErrorResponseBody			"Quota of the free tier reached"
+//	@Failure		413			{object}	ErrorResponseBody			"Request body too large"
+//	@Failure		429			{object}	ErrorResponseBody			"Monthly quota reached, until the Retry-After seconds passed"
 //	@Router			/transactions [post]
 func (h *ApiHandlers) CreateTransaction(w http.ResponseWriter, r *http.Request) {
+	include, err := parseInclude(r, "account", "category")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
 	var req CreateTransactionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	if err := decodeJSON(w, r, &req); err != nil {
 		slog.Error("failed to decode transaction request", "error", err)
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	transaction, err := req.transaction()
+	if err != nil {
+		slog.Error("failed to parse transaction request", "error", err, "date", req.Date, "amount", req.Amount)
 		errorResponse(w, r, http.StatusBadRequest, err)
 		return
 	}
 
-	// Parse date - default to current date if empty
-	var transactionDate time.Time
+	createdTransaction, err := h.TransactionUseCase.CreateTransaction(r.Context(), transaction)
+	if err != nil {
+		slog.Error("failed to create transaction", "error", err, "account_id", req.AccountID, "category_id", req.CategoryID, "amount", req.Amount)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	response := TransactionResponse{
+		ID:                createdTransaction.ID,
+		AccountID:         createdTransaction.AccountID,
+		CategoryID:        createdTransaction.CategoryID,
+		Amount:            createdTransaction.Monetary.String(),
+		Description:       createdTransaction.Description,
+		Payee:             createdTransaction.Payee,
+		Date:              createdTransaction.Date.Format("2006-01-02"),
+		Status:            createdTransaction.Status,
+		InstallmentPlanID: createdTransaction.InstallmentPlanID,
+		InstallmentNumber: createdTransaction.InstallmentNumber,
+		InstallmentCount:  createdTransaction.InstallmentCount,
+		ProjectID:         createdTransaction.ProjectID,
+		Tags:              createdTransaction.Tags,
+		CreatedAt:         createdTransaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:         createdTransaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	// Add related entities if requested
+	if include["account"] && createdTransaction.Account != nil {
+		response.Account = &AccountResponse{
+			ID:          createdTransaction.Account.ID,
+			Name:        createdTransaction.Account.Name,
+			Type:        createdTransaction.Account.Type,
+			Asset:       createdTransaction.Account.Asset.Asset,
+			Description: createdTransaction.Account.Description,
+		}
+	}
+
+	if include["category"] && createdTransaction.Category != nil {
+		response.Category = &CategoryResponse{
+			ID:          createdTransaction.Category.ID,
+			Name:        createdTransaction.Category.Name,
+			Type:        createdTransaction.Category.Type,
+			Description: createdTransaction.Category.Description,
+			Color:       createdTransaction.Category.Color,
+		}
+	}
+
+	response.BudgetWarnings = h.budgetWarnings(r.Context(), createdTransaction)
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, response)
+}
+
+// CreateInstallmentPurchase creates a purchase paid in installments
+//
+//	@Summary		Create an installment purchase
+//	@Description	Spread a purchase paid in installments (parcelamento) over one transaction a month starting on its date, so each installment lands on the next credit card fatura. The total is split evenly with the leftover cents on the first installments, descriptions are numbered like "TV (3/12)", and installments after the first are pending until they are charged. The installments are linked to the installment plan returned
+//	@Tags			transactions
+//	@Accept			json
+//	@Produce		json
+//	@Param			purchase	body		CreateInstallmentPurchaseRequest	true	"Purchase data"
+//	@Param			include		query		string								false	"Related resources to embed (account, category)"
+//	@Success		201			{object}	InstallmentPlanResponse				"Installment plan created successfully"
+//	@Failure		400			{object}	ErrorResponseBody					"Bad request"
+//	@Failure		402			{object}	ErrorResponseBody					"Quota of the free tier reached"
+//	@Failure		404			{object}	ErrorResponseBody					"Account or category not found"
+//	@Failure		413			{object}	ErrorResponseBody					"Request body too large"
+//	@Failure		429			{object}	ErrorResponseBody					"Monthly quota reached, until the Retry-After seconds passed"
+//	@Router			/transactions/installments [post]
+func (h *ApiHandlers) CreateInstallmentPurchase(w http.ResponseWriter, r *http.Request) {
+	include, err := parseInclude(r, "account", "category")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	var req CreateInstallmentPurchaseRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	// Parse date - the use case applies the user's default when it's empty
+	var purchaseDate time.Time
 	if req.Date != "" {
-		var err error
-		transactionDate, err = time.Parse("2006-01-02", req.Date)
+		purchaseDate, err = time.Parse("2006-01-02", req.Date)
 		if err != nil {
-			slog.Error("failed to parse date request", "error", err, "date", req.Date)
 			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("date", "must be in format YYYY-MM-DD"))
 			return
 		}
-	} else {
-		// Default to current date if no date provided
-		transactionDate = time.Now()
 	}
 
-	// Parse amount as float and create temporary monetary value with USD
-	// The use case will handle the proper asset conversion based on the account
+	// Like a single transaction, the use case converts the amount to the
+	// account asset
 	amountFloat, err := strconv.ParseFloat(req.Amount, 64)
 	if err != nil {
-		slog.Error("failed to parse amount", "error", err, "amount", req.Amount)
 		errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("amount", "must be a valid decimal number"))
 		return
 	}
 
-	// Create temporary monetary value with USD - use case will convert to correct asset
 	tempMonetary, err := monetary.NewMonetary(monetary.USD, big.NewInt(int64(amountFloat*100)))
 	if err != nil {
-		slog.Error("failed to create monetary value", "error", err, "amount", req.Amount)
 		errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("amount", "must be a valid decimal number"))
 		return
 	}
 
-	// Create transaction entity
-	transaction := entities.Transaction{
+	plan, err := h.TransactionUseCase.CreateInstallmentPurchase(r.Context(), entities.Transaction{
 		AccountID:   req.AccountID,
 		CategoryID:  req.CategoryID,
 		Monetary:    *tempMonetary,
 		Description: req.Description,
-		Date:        transactionDate,
+		Payee:       req.Payee,
+		Date:        purchaseDate,
 		Status:      req.Status,
-	}
-
-	createdTransaction, err := h.TransactionUseCase.CreateTransaction(r.Context(), transaction)
+		ProjectID:   req.ProjectID,
+		Tags:        req.Tags,
+	}, req.Installments)
 	if err != nil {
-		slog.Error("failed to create transaction", "error", err, "account_id", req.AccountID, "category_id", req.CategoryID, "amount", req.Amount)
-		errorResponse(w, r, http.StatusBadRequest, err)
+		slog.Error("failed to create installment purchase", "error", err, "account_id", req.AccountID, "installments", req.Installments)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
 		return
 	}
 
-	response := TransactionResponse{
-		ID:          createdTransaction.ID,
-		AccountID:   createdTransaction.AccountID,
-		CategoryID:  createdTransaction.CategoryID,
-		Amount:      createdTransaction.Monetary.String(),
-		Description: createdTransaction.Description,
-		Date:        createdTransaction.Date.Format("2006-01-02"),
-		Status:      createdTransaction.Status,
-		CreatedAt:   createdTransaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   createdTransaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	response := installmentPlanResponse(plan)
+	response.Installments = installmentResponses(plan.Installments)
+	for i, installment := range plan.Installments {
+		if include["account"] && installment.Account != nil {
+			response.Installments[i].Account = &AccountResponse{
+				ID:          installment.Account.ID,
+				Name:        installment.Account.Name,
+				Type:        installment.Account.Type,
+				Asset:       installment.Account.Asset.Asset,
+				Description: installment.Account.Description,
+			}
+		}
+		if include["category"] && installment.Category != nil {
+			response.Installments[i].Category = &CategoryResponse{
+				ID:          installment.Category.ID,
+				Name:        installment.Category.Name,
+				Type:        installment.Category.Type,
+				Description: installment.Category.Description,
+				Color:       installment.Category.Color,
+			}
+		}
 	}
 
 	render.Status(r, http.StatusCreated)
@@ -151,12 +322,20 @@ func (h *ApiHandlers) CreateTransaction(w http.ResponseWriter, r *http.Request)
 //	@Tags			transactions
 //	@Accept			json
 //	@Produce		json
-//	@Param			id	path		string				true	"Transaction ID"
-//	@Success		200	{object}	TransactionResponse	"Transaction retrieved successfully"
-//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
-//	@Failure		404	{object}	ErrorResponseBody	"Transaction not found"
+//	@Param			id		path		string				true	"Transaction ID"
+//	@Param			include	query		string				false	"Related resources to embed (account, category)"
+//	@Success		200		{object}	TransactionResponse	"Transaction retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404		{object}	ErrorResponseBody	"Transaction not found"
+//	@Failure		500		{object}	ErrorResponseBody	"Internal server error"
 //	@Router			/transactions/{id} [get]
 func (h *ApiHandlers) GetTransactionByID(w http.ResponseWriter, r *http.Request) {
+	include, err := parseInclude(r, "account", "category")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
 	id := chi.URLParam(r, "id")
 	if id == "" {
 		slog.Error("missing transaction ID parameter")
@@ -167,30 +346,30 @@ func (h *ApiHandlers) GetTransactionByID(w http.ResponseWriter, r *http.Request)
 	transaction, err := h.TransactionUseCase.GetTransactionWithDetails(r.Context(), id)
 	if err != nil {
 		slog.Error("failed to get transaction", "error", err, "transaction_id", id)
-		errorResponse(w, r, http.StatusNotFound, err)
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
 		return
 	}
 
-	if transaction.ID == "" {
-		slog.Error("transaction not found", "transaction_id", id)
-		errorResponse(w, r, http.StatusNotFound, errNotFound("transaction"))
-		return
+	response := TransactionResponse{
+		ID:                transaction.ID,
+		AccountID:         transaction.AccountID,
+		CategoryID:        transaction.CategoryID,
+		Amount:            transaction.Monetary.String(),
+		Description:       transaction.Description,
+		Payee:             transaction.Payee,
+		Date:              transaction.Date.Format("2006-01-02"),
+		Status:            transaction.Status,
+		InstallmentPlanID: transaction.InstallmentPlanID,
+		InstallmentNumber: transaction.InstallmentNumber,
+		InstallmentCount:  transaction.InstallmentCount,
+		ProjectID:         transaction.ProjectID,
+		Tags:              transaction.Tags,
+		CreatedAt:         transaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:         transaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 
-	response := TransactionResponse{
-		ID:          transaction.ID,
-		AccountID:   transaction.AccountID,
-		CategoryID:  transaction.CategoryID,
-		Amount:      transaction.Monetary.String(),
-		Description: transaction.Description,
-		Date:        transaction.Date.Format("2006-01-02"),
-		Status:      transaction.Status,
-		CreatedAt:   transaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   transaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}
-
-	// Add related entities if available
-	if transaction.Account != nil {
+	// Add related entities if requested
+	if include["account"] && transaction.Account != nil {
 		response.Account = &AccountResponse{
 			ID:          transaction.Account.ID,
 			Name:        transaction.Account.Name,
@@ -200,7 +379,7 @@ func (h *ApiHandlers) GetTransactionByID(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	if transaction.Category != nil {
+	if include["category"] && transaction.Category != nil {
 		response.Category = &CategoryResponse{
 			ID:          transaction.Category.ID,
 			Name:        transaction.Category.Name,
@@ -213,40 +392,125 @@ func (h *ApiHandlers) GetTransactionByID(w http.ResponseWriter, r *http.Request)
 	render.JSON(w, r, response)
 }
 
+// ExportTransactions downloads the transactions as a file
+//
+//	@Summary		Export transactions
+//	@Description	Download every transaction matching the filters of the transaction list as a CSV or Excel file, with their account, category, amount, currency, status and tags
+//	@Tags			transactions
+//	@Produce		text/csv
+//	@Produce		application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Param			format		query		string				false	"Export format, csv or xlsx"	default(csv)
+//	@Param			sort		query		string				false	"Comma separated sort fields (date, amount, description, status, created_at), prefix with - for descending"
+//	@Param			project_id	query		string				false	"Only transactions filed under this project"
+//	@Param			tag			query		string				false	"Only transactions with this tag"
+//	@Success		200			{file}		file				"Transactions file"
+//	@Failure		400			{object}	ErrorResponseBody	"Bad request"
+//	@Failure		500			{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/transactions/export [get]
+func (h *ApiHandlers) ExportTransactions(w http.ResponseWriter, r *http.Request) {
+	format := entities.TransactionExportFormat(r.URL.Query().Get("format"))
+	if format == "" {
+		format = entities.TransactionExportFormatCSV
+	}
+
+	projectID, err := parseUUIDParam(r, "project_id")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	sort := entities.ParseSort(r.URL.Query().Get("sort"))
+
+	export, err := h.TransactionExportUseCase.ExportTransactions(r.Context(), format, entities.TransactionFilter{ProjectID: projectID, Tag: r.URL.Query().Get("tag")}, sort)
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	w.Header().Set("Content-Type", export.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.Filename))
+	// The status is sent with the first bytes, so a failure past them can
+	// only cut the file short
+	if err := export.Write(w); err != nil {
+		slog.Error("failed to export transactions", "error", err)
+	}
+}
+
 // GetAllTransactions retrieves all transactions
 //
 //	@Summary		Get all transactions
-//	@Description	Retrieve a list of all financial transactions with pagination (limit: 50, offset: 0)
+//	@Description	Retrieve a page of the financial transactions with the total number of matching transactions and links to the next and previous pages
 //	@Tags			transactions
 //	@Accept			json
 //	@Produce		json
-//	@Success		200	{array}		TransactionResponse	"Transactions retrieved successfully"
-//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Param			include		query		string				false	"Related resources to embed (account, category)"
+//	@Param			fields		query		string				false	"Comma separated fields to return for each transaction"
+//	@Param			sort		query		string				false	"Comma separated sort fields (date, amount, description, status, created_at), prefix with - for descending"
+//	@Param			project_id	query		string				false	"Only transactions filed under this project"
+//	@Param			tag			query		string				false	"Only transactions with this tag"
+//	@Param			limit		query		int					false	"Page size, 50 by default and at most 500"
+//	@Param			offset		query		int					false	"Number of transactions to skip"
+//	@Success		200			{object}	PageResponse[TransactionResponse]	"Transactions retrieved successfully"
+//	@Failure		400			{object}	ErrorResponseBody	"Bad request"
+//	@Failure		500			{object}	ErrorResponseBody	"Internal server error"
 //	@Router			/transactions [get]
 func (h *ApiHandlers) GetAllTransactions(w http.ResponseWriter, r *http.Request) {
-	transactions, err := h.TransactionUseCase.GetTransactionsWithDetails(r.Context(), 50, 0)
+	include, err := parseInclude(r, "account", "category")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	fields, err := parseFields(r, TransactionResponse{})
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	projectID, err := parseUUIDParam(r, "project_id")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	limit, offset, err := parsePage(r)
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	sort := entities.ParseSort(r.URL.Query().Get("sort"))
+
+	page, err := h.TransactionUseCase.GetTransactionsPage(r.Context(), entities.TransactionFilter{ProjectID: projectID, Tag: r.URL.Query().Get("tag")}, limit, offset, sort)
 	if err != nil {
 		slog.Error("failed to get transactions", "error", err)
-		errorResponse(w, r, http.StatusInternalServerError, err)
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
 		return
 	}
 
-	responses := make([]TransactionResponse, len(transactions))
-	for i, transaction := range transactions {
+	responses := make([]TransactionResponse, len(page.Items))
+	for i, transaction := range page.Items {
 		responses[i] = TransactionResponse{
-			ID:          transaction.ID,
-			AccountID:   transaction.AccountID,
-			CategoryID:  transaction.CategoryID,
-			Amount:      transaction.Monetary.String(),
-			Description: transaction.Description,
-			Date:        transaction.Date.Format("2006-01-02"),
-			Status:      transaction.Status,
-			CreatedAt:   transaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt:   transaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			ID:                transaction.ID,
+			AccountID:         transaction.AccountID,
+			CategoryID:        transaction.CategoryID,
+			Amount:            transaction.Monetary.String(),
+			Description:       transaction.Description,
+			Payee:             transaction.Payee,
+			Date:              transaction.Date.Format("2006-01-02"),
+			Status:            transaction.Status,
+			InstallmentPlanID: transaction.InstallmentPlanID,
+			InstallmentNumber: transaction.InstallmentNumber,
+			InstallmentCount:  transaction.InstallmentCount,
+			ProjectID:         transaction.ProjectID,
+			Tags:              transaction.Tags,
+			Attachments:       attachmentResponses(transaction.Attachments),
+			CreatedAt:         transaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			UpdatedAt:         transaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
 
-		// Add related entities if available
-		if transaction.Account != nil {
+		// Add related entities if requested
+		if include["account"] && transaction.Account != nil {
 			responses[i].Account = &AccountResponse{
 				ID:          transaction.Account.ID,
 				Name:        transaction.Account.Name,
@@ -256,7 +520,81 @@ func (h *ApiHandlers) GetAllTransactions(w http.ResponseWriter, r *http.Request)
 			}
 		}
 
-		if transaction.Category != nil {
+		if include["category"] && transaction.Category != nil {
+			responses[i].Category = &CategoryResponse{
+				ID:          transaction.Category.ID,
+				Name:        transaction.Category.Name,
+				Type:        transaction.Category.Type,
+				Description: transaction.Category.Description,
+				Color:       transaction.Category.Color,
+			}
+		}
+	}
+
+	renderPage(w, r, page, responses, fields)
+}
+
+// SearchTransactions finds transactions by their description and payee
+//
+//	@Summary		Search transactions
+//	@Description	Retrieve a page of the transactions whose description or payee has every word searched for, matched as the start of a word and regardless of case, the best matches first: the ones matching in the description rank above the ones matching in the payee. Deleted transactions are left out
+//	@Tags			transactions
+//	@Accept			json
+//	@Produce		json
+//	@Param			q			query		string								true	"Words to search for"
+//	@Param			include		query		string								false	"Related resources to embed (account, category)"
+//	@Param			fields		query		string								false	"Comma separated fields to return for each transaction"
+//	@Param			limit		query		int									false	"Page size, 50 by default and at most 500"
+//	@Param			offset		query		int									false	"Number of transactions to skip"
+//	@Success		200			{object}	PageResponse[TransactionResponse]	"Transactions found"
+//	@Failure		400			{object}	ErrorResponseBody					"Bad request"
+//	@Failure		500			{object}	ErrorResponseBody					"Internal server error"
+//	@Router			/transactions/search [get]
+func (h *ApiHandlers) SearchTransactions(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("q"))
+		return
+	}
+
+	include, err := parseInclude(r, "account", "category")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	fields, err := parseFields(r, TransactionResponse{})
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	limit, offset, err := parsePage(r)
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	page, err := h.TransactionUseCase.SearchTransactionsPage(r.Context(), query, limit, offset)
+	if err != nil {
+		slog.Error("failed to search transactions", "error", err)
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	responses := make([]TransactionResponse, len(page.Items))
+	for i, transaction := range page.Items {
+		responses[i] = transactionResponse(transaction)
+		if include["account"] && transaction.Account != nil {
+			responses[i].Account = &AccountResponse{
+				ID:          transaction.Account.ID,
+				Name:        transaction.Account.Name,
+				Type:        transaction.Account.Type,
+				Asset:       transaction.Account.Asset.Asset,
+				Description: transaction.Account.Description,
+			}
+		}
+		if include["category"] && transaction.Category != nil {
 			responses[i].Category = &CategoryResponse{
 				ID:          transaction.Category.ID,
 				Name:        transaction.Category.Name,
@@ -267,7 +605,7 @@ func (h *ApiHandlers) GetAllTransactions(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
-	render.JSON(w, r, responses)
+	renderPage(w, r, page, responses, fields)
 }
 
 // UpdateTransaction updates an existing transaction
@@ -279,11 +617,19 @@ func (h *ApiHandlers) GetAllTransactions(w http.ResponseWriter, r *http.Request)
 //	@Produce		json
 //	@Param			id			path		string						true	"Transaction ID"
 //	@Param			transaction	body		UpdateTransactionRequest	true	"Updated transaction data"
+//	@Param			include		query		string						false	"Related resources to embed (account, category)"
 //	@Success		200			{object}	TransactionResponse			"Transaction updated successfully"
 //	@Failure		400			{object}	ErrorResponseBody			"Bad request"
+//	@Failure		413			{object}	ErrorResponseBody			"Request body too large"
 //	@Failure		404			{object}	ErrorResponseBody			"Transaction not found"
 //	@Router			/transactions/{id} [put]
 func (h *ApiHandlers) UpdateTransaction(w http.ResponseWriter, r *http.Request) {
+	include, err := parseInclude(r, "account", "category")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
 	id := chi.URLParam(r, "id")
 	if id == "" {
 		slog.Error("missing transaction ID parameter")
@@ -292,9 +638,9 @@ func (h *ApiHandlers) UpdateTransaction(w http.ResponseWriter, r *http.Request)
 	}
 
 	var req UpdateTransactionRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	if err := decodeJSON(w, r, &req); err != nil {
 		slog.Error("failed to decode update transaction request", "error", err, "transaction_id", id)
-		errorResponse(w, r, http.StatusBadRequest, err)
+		bodyErrorResponse(w, r, err)
 		return
 	}
 
@@ -332,36 +678,66 @@ func (h *ApiHandlers) UpdateTransaction(w http.ResponseWriter, r *http.Request)
 		CategoryID:  req.CategoryID,
 		Monetary:    *tempMonetary,
 		Description: req.Description,
+		Payee:       req.Payee,
 		Date:        transactionDate,
 		Status:      req.Status,
+		ProjectID:   req.ProjectID,
+		Tags:        req.Tags,
 	}
 
 	updatedTransaction, err := h.TransactionUseCase.UpdateTransaction(r.Context(), transaction)
 	if err != nil {
 		slog.Error("failed to update transaction", "error", err, "transaction_id", id, "account_id", req.AccountID, "category_id", req.CategoryID)
-		errorResponse(w, r, http.StatusBadRequest, err)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
 		return
 	}
 
 	response := TransactionResponse{
-		ID:          updatedTransaction.ID,
-		AccountID:   updatedTransaction.AccountID,
-		CategoryID:  updatedTransaction.CategoryID,
-		Amount:      updatedTransaction.Monetary.String(),
-		Description: updatedTransaction.Description,
-		Date:        updatedTransaction.Date.Format("2006-01-02"),
-		Status:      updatedTransaction.Status,
-		CreatedAt:   updatedTransaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   updatedTransaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:                updatedTransaction.ID,
+		AccountID:         updatedTransaction.AccountID,
+		CategoryID:        updatedTransaction.CategoryID,
+		Amount:            updatedTransaction.Monetary.String(),
+		Description:       updatedTransaction.Description,
+		Payee:             updatedTransaction.Payee,
+		Date:              updatedTransaction.Date.Format("2006-01-02"),
+		Status:            updatedTransaction.Status,
+		InstallmentPlanID: updatedTransaction.InstallmentPlanID,
+		InstallmentNumber: updatedTransaction.InstallmentNumber,
+		InstallmentCount:  updatedTransaction.InstallmentCount,
+		ProjectID:         updatedTransaction.ProjectID,
+		Tags:              updatedTransaction.Tags,
+		CreatedAt:         updatedTransaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:         updatedTransaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	// Add related entities if requested
+	if include["account"] && updatedTransaction.Account != nil {
+		response.Account = &AccountResponse{
+			ID:          updatedTransaction.Account.ID,
+			Name:        updatedTransaction.Account.Name,
+			Type:        updatedTransaction.Account.Type,
+			Asset:       updatedTransaction.Account.Asset.Asset,
+			Description: updatedTransaction.Account.Description,
+		}
+	}
+
+	if include["category"] && updatedTransaction.Category != nil {
+		response.Category = &CategoryResponse{
+			ID:          updatedTransaction.Category.ID,
+			Name:        updatedTransaction.Category.Name,
+			Type:        updatedTransaction.Category.Type,
+			Description: updatedTransaction.Category.Description,
+			Color:       updatedTransaction.Category.Color,
+		}
 	}
 
 	render.JSON(w, r, response)
 }
 
-// DeleteTransaction deletes a transaction
+// DeleteTransaction moves a transaction to the trash
 //
 //	@Summary		Delete transaction
-//	@Description	Delete a transaction by its ID
+//	@Description	Move a transaction to the trash, leaving it out of the balances and lists until it's restored
 //	@Tags			transactions
 //	@Accept			json
 //	@Produce		json
@@ -381,9 +757,342 @@ func (h *ApiHandlers) DeleteTransaction(w http.ResponseWriter, r *http.Request)
 	err := h.TransactionUseCase.DeleteTransaction(r.Context(), id)
 	if err != nil {
 		slog.Error("failed to delete transaction", "error", err, "transaction_id", id)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetTrash lists the deleted transactions
+//
+//	@Summary		Get the trash
+//	@Description	Retrieve a page of the deleted transactions, the latest deleted first, with the total number of transactions in the trash
+//	@Tags			transactions
+//	@Accept			json
+//	@Produce		json
+//	@Param			limit	query		int									false	"Page size, 50 by default and at most 500"
+//	@Param			offset	query		int									false	"Number of transactions to skip"
+//	@Success		200		{object}	PageResponse[TransactionResponse]	"Deleted transactions retrieved successfully"
+//	@Failure		400		{object}	ErrorResponseBody					"Bad request"
+//	@Failure		500		{object}	ErrorResponseBody					"Internal server error"
+//	@Router			/transactions/trash [get]
+func (h *ApiHandlers) GetTrash(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := parsePage(r)
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	page, err := h.TransactionUseCase.GetTrashPage(r.Context(), limit, offset)
+	if err != nil {
+		slog.Error("failed to get deleted transactions", "error", err)
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	responses := make([]TransactionResponse, len(page.Items))
+	for i, transaction := range page.Items {
+		responses[i] = transactionResponse(transaction)
+	}
+
+	renderPage(w, r, page, responses, nil)
+}
+
+// RestoreTransaction takes a transaction out of the trash
+//
+//	@Summary		Restore transaction
+//	@Description	Take a deleted transaction out of the trash, putting it back in the balance of its account
+//	@Tags			transactions
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string				true	"Transaction ID"
+//	@Success		200	{object}	TransactionResponse	"Transaction restored successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Transaction not in the trash"
+//	@Router			/transactions/{id}/restore [post]
+func (h *ApiHandlers) RestoreTransaction(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	restored, err := h.TransactionUseCase.RestoreTransaction(r.Context(), id)
+	if err != nil {
+		slog.Error("failed to restore transaction", "error", err, "transaction_id", id)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, transactionResponse(restored))
+}
+
+// transactionResponse renders a transaction without its account and category
+// transaction parses the request into the transaction to create. The amount
+// is held in USD for now, the use case converts it to the account's asset.
+func (req CreateTransactionRequest) transaction() (entities.Transaction, error) {
+	// The use case applies the user's default date when it's empty
+	var date time.Time
+	if req.Date != "" {
+		var err error
+		date, err = time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			return entities.Transaction{}, errInvalidParameter("date", "must be in format YYYY-MM-DD")
+		}
+	}
+
+	amountFloat, err := strconv.ParseFloat(req.Amount, 64)
+	if err != nil {
+		return entities.Transaction{}, errInvalidParameter("amount", "must be a valid decimal number")
+	}
+	tempMonetary, err := monetary.NewMonetary(monetary.USD, big.NewInt(int64(amountFloat*100)))
+	if err != nil {
+		return entities.Transaction{}, errInvalidParameter("amount", "must be a valid decimal number")
+	}
+
+	return entities.Transaction{
+		AccountID:   req.AccountID,
+		CategoryID:  req.CategoryID,
+		Monetary:    *tempMonetary,
+		Description: req.Description,
+		Payee:       req.Payee,
+		Date:        date,
+		Status:      req.Status,
+		ProjectID:   req.ProjectID,
+		Tags:        req.Tags,
+	}, nil
+}
+
+func transactionResponse(transaction entities.Transaction) TransactionResponse {
+	response := TransactionResponse{
+		ID:                transaction.ID,
+		AccountID:         transaction.AccountID,
+		CategoryID:        transaction.CategoryID,
+		Amount:            transaction.Monetary.String(),
+		Description:       transaction.Description,
+		Payee:             transaction.Payee,
+		Date:              transaction.Date.Format("2006-01-02"),
+		Status:            transaction.Status,
+		InstallmentPlanID: transaction.InstallmentPlanID,
+		InstallmentNumber: transaction.InstallmentNumber,
+		InstallmentCount:  transaction.InstallmentCount,
+		ProjectID:         transaction.ProjectID,
+		Tags:              transaction.Tags,
+		Attachments:       attachmentResponses(transaction.Attachments),
+		CreatedAt:         transaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:         transaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if transaction.DeletedAt != nil {
+		response.DeletedAt = transaction.DeletedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return response
+}
+
+// DuplicateTransaction creates a copy of a transaction
+//
+//	@Summary		Duplicate transaction
+//	@Description	Create a new transaction with the account, category, amount and description of an existing one. Without a date the copy gets the user's default date, and its status is always the user's default.
+//	@Tags			transactions
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string						true	"Transaction ID"
+//	@Param			transaction	body		DuplicateTransactionRequest	false	"Date of the copy"
+//	@Param			include		query		string						false	"Related resources to embed (account, category)"
+//	@Success		201			{object}	TransactionResponse			"Transaction duplicated successfully"
+//	@Failure		400			{object}	ErrorResponseBody			"Bad request"
+//	@Failure		413			{object}	ErrorResponseBody			"Request body too large"
+//	@Failure		404			{object}	ErrorResponseBody			"Transaction not found"
+//	@Router			/transactions/{id}/duplicate [post]
+func (h *ApiHandlers) DuplicateTransaction(w http.ResponseWriter, r *http.Request) {
+	include, err := parseInclude(r, "account", "category")
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		slog.Error("missing transaction ID parameter")
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("id"))
+		return
+	}
+
+	var req DuplicateTransactionRequest
+	if err := decodeJSON(w, r, &req); err != nil && !errors.Is(err, io.EOF) {
+		slog.Error("failed to decode duplicate transaction request", "error", err, "transaction_id", id)
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	var date time.Time
+	if req.Date != "" {
+		date, err = time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			slog.Error("failed to parse date request", "error", err, "date", req.Date, "transaction_id", id)
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter("date", "must be in format YYYY-MM-DD"))
+			return
+		}
+	}
+
+	transaction, err := h.TransactionUseCase.DuplicateTransaction(r.Context(), id, date)
+	if err != nil {
+		slog.Error("failed to duplicate transaction", "error", err, "transaction_id", id)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	response := TransactionResponse{
+		ID:                transaction.ID,
+		AccountID:         transaction.AccountID,
+		CategoryID:        transaction.CategoryID,
+		Amount:            transaction.Monetary.String(),
+		Description:       transaction.Description,
+		Payee:             transaction.Payee,
+		Date:              transaction.Date.Format("2006-01-02"),
+		Status:            transaction.Status,
+		InstallmentPlanID: transaction.InstallmentPlanID,
+		InstallmentNumber: transaction.InstallmentNumber,
+		InstallmentCount:  transaction.InstallmentCount,
+		ProjectID:         transaction.ProjectID,
+		Tags:              transaction.Tags,
+		CreatedAt:         transaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:         transaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	// Add related entities if requested
+	if include["account"] && transaction.Account != nil {
+		response.Account = &AccountResponse{
+			ID:          transaction.Account.ID,
+			Name:        transaction.Account.Name,
+			Type:        transaction.Account.Type,
+			Asset:       transaction.Account.Asset.Asset,
+			Description: transaction.Account.Description,
+		}
+	}
+
+	if include["category"] && transaction.Category != nil {
+		response.Category = &CategoryResponse{
+			ID:          transaction.Category.ID,
+			Name:        transaction.Category.Name,
+			Type:        transaction.Category.Type,
+			Description: transaction.Category.Description,
+			Color:       transaction.Category.Color,
+		}
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, response)
+}
+
+// FinalizeTransaction turns a draft into a regular transaction
+//
+//	@Summary		Finalize draft transaction
+//	@Description	Move a draft to pending or cleared so it counts in balances and reports. Without a status the user default applies.
+//	@Tags			transactions
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string						true	"Transaction ID"
+//	@Param			transaction	body		FinalizeTransactionRequest	false	"Status to finalize the draft as"
+//	@Param			include		query		string						false	"Related resources to embed (account, category)"
+//	@Success		200			{object}	TransactionResponse			"Draft finalized successfully"
+//	@Failure		400			{object}	ErrorResponseBody			"Bad request"
+//	@Failure		413			{object}	ErrorResponseBody			"Request body too large"
+//	@Failure		404			{object}	ErrorResponseBody			"Transaction not found"
+//	@Failure		409			{object}	ErrorResponseBody			"Transaction is not a draft"
+//	@Router			/transactions/{id}/finalize [post]
+func (h *ApiHandlers) FinalizeTransaction(w http.ResponseWriter, r *http.Request) {
+	include, err := parseInclude(r, "account", "category")
+	if err != nil {
 		errorResponse(w, r, http.StatusBadRequest, err)
 		return
 	}
 
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		slog.Error("missing transaction ID parameter")
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("id"))
+		return
+	}
+
+	var req FinalizeTransactionRequest
+	if err := decodeJSON(w, r, &req); err != nil && !errors.Is(err, io.EOF) {
+		slog.Error("failed to decode finalize transaction request", "error", err, "transaction_id", id)
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	transaction, err := h.TransactionUseCase.FinalizeTransaction(r.Context(), id, req.Status)
+	if err != nil {
+		slog.Error("failed to finalize transaction", "error", err, "transaction_id", id)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	response := TransactionResponse{
+		ID:                transaction.ID,
+		AccountID:         transaction.AccountID,
+		CategoryID:        transaction.CategoryID,
+		Amount:            transaction.Monetary.String(),
+		Description:       transaction.Description,
+		Payee:             transaction.Payee,
+		Date:              transaction.Date.Format("2006-01-02"),
+		Status:            transaction.Status,
+		InstallmentPlanID: transaction.InstallmentPlanID,
+		InstallmentNumber: transaction.InstallmentNumber,
+		InstallmentCount:  transaction.InstallmentCount,
+		ProjectID:         transaction.ProjectID,
+		Tags:              transaction.Tags,
+		CreatedAt:         transaction.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:         transaction.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	// Add related entities if requested
+	if include["account"] && transaction.Account != nil {
+		response.Account = &AccountResponse{
+			ID:          transaction.Account.ID,
+			Name:        transaction.Account.Name,
+			Type:        transaction.Account.Type,
+			Asset:       transaction.Account.Asset.Asset,
+			Description: transaction.Account.Description,
+		}
+	}
+
+	if include["category"] && transaction.Category != nil {
+		response.Category = &CategoryResponse{
+			ID:          transaction.Category.ID,
+			Name:        transaction.Category.Name,
+			Type:        transaction.Category.Type,
+			Description: transaction.Category.Description,
+			Color:       transaction.Category.Color,
+		}
+	}
+
+	render.JSON(w, r, response)
+}
+
+// DiscardDraft deletes a draft transaction
+//
+//	@Summary		Discard draft transaction
+//	@Description	Delete a draft. Finalized transactions are refused, use the delete endpoint for those.
+//	@Tags			transactions
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	string	true	"Transaction ID"
+//	@Success		204	"Draft discarded successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Transaction not found"
+//	@Failure		409	{object}	ErrorResponseBody	"Transaction is not a draft"
+//	@Router			/transactions/{id}/discard [post]
+func (h *ApiHandlers) DiscardDraft(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		slog.Error("missing transaction ID parameter")
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("id"))
+		return
+	}
+
+	if err := h.TransactionUseCase.DiscardDraft(r.Context(), id); err != nil {
+		slog.Error("failed to discard draft", "error", err, "transaction_id", id)
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -5,11 +5,16 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"finance/domain"
 	"finance/domain/entities"
 	"finance/internal/api/v1/mocks"
+	"fmt"
+	"io"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -81,7 +86,7 @@ func TestCreateTransaction(t *testing.T) {
 		}
 	})
 
-	t.Run("successful creation with empty date (defaults to current date)", func(t *testing.T) {
+	t.Run("successful creation with empty date (left to the use case default)", func(t *testing.T) {
 		mockUC := &mocks.TransactionUseCaseMock{
 			CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
 				monetaryValue, _ := monetary.NewMonetary(monetary.USD, big.NewInt(10050)) // $100.50
@@ -123,17 +128,13 @@ func TestCreateTransaction(t *testing.T) {
 			t.Logf("Response body: %s", w.Body.String())
 		}
 
-		// Check if the usecase was called with today's date
+		// The use case applies the user's default date, the handler passes none
 		calls := mockUC.CreateTransactionCalls()
 		if len(calls) != 1 {
-			t.Errorf("expected 1 call to CreateTransaction, got %d", len(calls))
+			t.Fatalf("expected 1 call to CreateTransaction, got %d", len(calls))
 		}
-
-		// Should be roughly today (within a minute)
-		now := time.Now()
-		dateDiff := now.Sub(calls[0].Transaction.Date)
-		if dateDiff > time.Minute || dateDiff < -time.Minute {
-			t.Errorf("expected date to be close to now, got %v (diff: %v)", calls[0].Transaction.Date, dateDiff)
+		if !calls[0].Transaction.Date.IsZero() {
+			t.Errorf("expected no date, got %v", calls[0].Transaction.Date)
 		}
 	})
 
@@ -310,6 +311,115 @@ func TestCreateTransaction(t *testing.T) {
 			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
 		}
 	})
+
+	t.Run("quota reached", func(t *testing.T) {
+		resetsAt := time.Now().Add(time.Hour).Truncate(time.Second)
+		h := &ApiHandlers{
+			TransactionUseCase: &mocks.TransactionUseCaseMock{
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					return entities.Transaction{}, &domain.QuotaError{Quota: "monthly_transactions", Limit: 500, ResetsAt: resetsAt}
+				},
+			},
+		}
+
+		body := `{"account_id": "acc-1", "category_id": "cat-1", "amount": "100.50", "description": "Test transaction"}`
+		req := httptest.NewRequest(http.MethodPost, "/transactions", bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+
+		h.CreateTransaction(w, req)
+
+		if w.Code != http.StatusTooManyRequests {
+			t.Errorf("expected status %d, got %d", http.StatusTooManyRequests, w.Code)
+		}
+		if retryAfter := w.Header().Get("Retry-After"); retryAfter != "3600" {
+			t.Errorf("expected Retry-After 3600, got %q", retryAfter)
+		}
+		var response ErrorResponseBody
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Quota == nil || response.Quota.Name != "monthly_transactions" || response.Quota.Limit != 500 || response.Quota.ResetsAt == nil || !response.Quota.ResetsAt.Equal(resetsAt) {
+			t.Errorf("unexpected quota %+v", response.Quota)
+		}
+	})
+
+	t.Run("warns of the budgets left over", func(t *testing.T) {
+		limit := monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(80000)}
+		spent := monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(90000)}
+		remaining := monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(-10000)}
+		budgets := &mocks.BudgetUseCaseMock{
+			OverBudgetFunc: func(ctx context.Context, transaction entities.Transaction) ([]entities.BudgetProgress, error) {
+				return []entities.BudgetProgress{{
+					Budget:    entities.Budget{ID: "bud-1", CategoryID: transaction.CategoryID, Limit: limit},
+					Category:  entities.Category{ID: transaction.CategoryID, Name: "Groceries"},
+					Month:     "2025-04",
+					Spent:     spent,
+					Remaining: remaining,
+					Percent:   112.5,
+					Over:      true,
+				}}, nil
+			},
+		}
+		h := &ApiHandlers{
+			TransactionUseCase: &mocks.TransactionUseCaseMock{
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					transaction.ID = "test-123"
+					return transaction, nil
+				},
+			},
+			BudgetUseCase: budgets,
+		}
+
+		body := `{"account_id": "acc-1", "category_id": "cat-1", "amount": "150.00", "description": "Market", "date": "2025-04-20", "status": "cleared"}`
+		req := httptest.NewRequest(http.MethodPost, "/transactions", bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+
+		h.CreateTransaction(w, req)
+
+		if w.Code != http.StatusCreated {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body)
+		}
+		var response TransactionResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response.BudgetWarnings) != 1 {
+			t.Fatalf("expected a budget warning, got %+v", response.BudgetWarnings)
+		}
+		warning := response.BudgetWarnings[0]
+		if warning.CategoryName != "Groceries" || warning.Month != "2025-04" || warning.Overspent != "[BRL (R$) 100.00]" {
+			t.Errorf("unexpected budget warning %+v", warning)
+		}
+	})
+
+	t.Run("creates the transaction when the budgets can't be checked", func(t *testing.T) {
+		h := &ApiHandlers{
+			TransactionUseCase: &mocks.TransactionUseCaseMock{
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					transaction.ID = "test-123"
+					return transaction, nil
+				},
+			},
+			BudgetUseCase: &mocks.BudgetUseCaseMock{
+				OverBudgetFunc: func(ctx context.Context, transaction entities.Transaction) ([]entities.BudgetProgress, error) {
+					return nil, errors.New("connection reset")
+				},
+			},
+		}
+
+		body := `{"account_id": "acc-1", "category_id": "cat-1", "amount": "150.00", "description": "Market", "date": "2025-04-20", "status": "cleared"}`
+		req := httptest.NewRequest(http.MethodPost, "/transactions", bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+
+		h.CreateTransaction(w, req)
+
+		if w.Code != http.StatusCreated {
+			t.Errorf("expected status %d, got %d", http.StatusCreated, w.Code)
+		}
+		if strings.Contains(w.Body.String(), "budget_warnings") {
+			t.Errorf("expected no budget warnings, got %s", w.Body)
+		}
+	})
 }
 
 func TestGetTransactionByID(t *testing.T) {
@@ -396,7 +506,7 @@ func TestGetTransactionByID(t *testing.T) {
 			TransactionUseCase: mockUC,
 		}
 
-		req := httptest.NewRequest(http.MethodGet, "/transactions/test-123", nil)
+		req := httptest.NewRequest(http.MethodGet, "/transactions/test-123?include=account,category", nil)
 		w := httptest.NewRecorder()
 
 		// Setup chi router context
@@ -447,6 +557,26 @@ func TestGetTransactionByID(t *testing.T) {
 		}
 	})
 
+	t.Run("unknown include", func(t *testing.T) {
+		h := &ApiHandlers{
+			TransactionUseCase: &mocks.TransactionUseCaseMock{},
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/transactions/test-123?include=balance", nil)
+		w := httptest.NewRecorder()
+
+		// Setup chi router context
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", "test-123")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+		h.GetTransactionByID(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+
 	t.Run("usecase error", func(t *testing.T) {
 		mockUC := &mocks.TransactionUseCaseMock{
 			GetTransactionWithDetailsFunc: func(ctx context.Context, id string) (entities.Transaction, error) {
@@ -468,15 +598,15 @@ func TestGetTransactionByID(t *testing.T) {
 
 		h.GetTransactionByID(w, req)
 
-		if w.Code != http.StatusNotFound {
-			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("expected status %d, got %d", http.StatusInternalServerError, w.Code)
 		}
 	})
 
-	t.Run("transaction not found (empty ID)", func(t *testing.T) {
+	t.Run("transaction not found", func(t *testing.T) {
 		mockUC := &mocks.TransactionUseCaseMock{
 			GetTransactionWithDetailsFunc: func(ctx context.Context, id string) (entities.Transaction, error) {
-				return entities.Transaction{}, nil // Empty transaction
+				return entities.Transaction{}, fmt.Errorf("failed to get transaction with details: transaction %w", domain.ErrNotFound)
 			},
 		}
 
@@ -514,8 +644,8 @@ func TestGetAllTransactions(t *testing.T) {
 		}
 
 		mockUC := &mocks.TransactionUseCaseMock{
-			GetTransactionsWithDetailsFunc: func(ctx context.Context, limit, offset int) ([]entities.Transaction, error) {
-				return []entities.Transaction{
+			GetTransactionsPageFunc: func(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error) {
+				return entities.Page[entities.Transaction]{Items: []entities.Transaction{
 					{
 						ID:          "test-123",
 						AccountID:   "acc-1",
@@ -538,7 +668,7 @@ func TestGetAllTransactions(t *testing.T) {
 						CreatedAt:   time.Now(),
 						UpdatedAt:   time.Now(),
 					},
-				}, nil
+				}}, nil
 			},
 		}
 
@@ -555,8 +685,9 @@ func TestGetAllTransactions(t *testing.T) {
 			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
 		}
 
-		var response []TransactionResponse
-		json.Unmarshal(w.Body.Bytes(), &response)
+		var page PageResponse[TransactionResponse]
+		json.Unmarshal(w.Body.Bytes(), &page)
+		response := page.Items
 
 		if len(response) != 2 {
 			t.Errorf("expected 2 transactions, got %d", len(response))
@@ -585,9 +716,9 @@ func TestGetAllTransactions(t *testing.T) {
 		}
 
 		// Check that usecase was called with correct parameters
-		calls := mockUC.GetTransactionsWithDetailsCalls()
+		calls := mockUC.GetTransactionsPageCalls()
 		if len(calls) != 1 {
-			t.Errorf("expected 1 call to GetTransactionsWithDetails, got %d", len(calls))
+			t.Errorf("expected 1 call to GetTransactionsPage, got %d", len(calls))
 		}
 		if calls[0].Limit != 50 {
 			t.Errorf("expected limit 50, got %d", calls[0].Limit)
@@ -602,8 +733,8 @@ func TestGetAllTransactions(t *testing.T) {
 		monetaryValue, _ := monetary.NewMonetary(monetary.USD, big.NewInt(10050)) // $100.50
 
 		mockUC := &mocks.TransactionUseCaseMock{
-			GetTransactionsWithDetailsFunc: func(ctx context.Context, limit, offset int) ([]entities.Transaction, error) {
-				return []entities.Transaction{
+			GetTransactionsPageFunc: func(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error) {
+				return entities.Page[entities.Transaction]{Items: []entities.Transaction{
 					{
 						ID:          "test-123",
 						AccountID:   "acc-1",
@@ -628,7 +759,7 @@ func TestGetAllTransactions(t *testing.T) {
 							Color:       "#FF0000",
 						},
 					},
-				}, nil
+				}}, nil
 			},
 		}
 
@@ -636,7 +767,7 @@ func TestGetAllTransactions(t *testing.T) {
 			TransactionUseCase: mockUC,
 		}
 
-		req := httptest.NewRequest(http.MethodGet, "/transactions", nil)
+		req := httptest.NewRequest(http.MethodGet, "/transactions?include=account,category", nil)
 		w := httptest.NewRecorder()
 
 		h.GetAllTransactions(w, req)
@@ -645,8 +776,9 @@ func TestGetAllTransactions(t *testing.T) {
 			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
 		}
 
-		var response []TransactionResponse
-		json.Unmarshal(w.Body.Bytes(), &response)
+		var page PageResponse[TransactionResponse]
+		json.Unmarshal(w.Body.Bytes(), &page)
+		response := page.Items
 
 		if len(response) != 1 {
 			t.Errorf("expected 1 transaction, got %d", len(response))
@@ -665,10 +797,262 @@ func TestGetAllTransactions(t *testing.T) {
 		}
 	})
 
+	t.Run("pagination", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{
+			GetTransactionsPageFunc: func(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error) {
+				return entities.Page[entities.Transaction]{Items: make([]entities.Transaction, limit), Total: 120, Limit: limit, Offset: offset}, nil
+			},
+		}
+
+		h := &ApiHandlers{
+			TransactionUseCase: mockUC,
+		}
+
+		get := func(target string) PageResponse[TransactionResponse] {
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			w := httptest.NewRecorder()
+
+			h.GetAllTransactions(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body)
+			}
+
+			var page PageResponse[TransactionResponse]
+			if err := json.Unmarshal(w.Body.Bytes(), &page); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			return page
+		}
+
+		page := get("/transactions?sort=-date&limit=40&offset=40")
+		calls := mockUC.GetTransactionsPageCalls()
+		if calls[0].Limit != 40 || calls[0].Offset != 40 {
+			t.Errorf("expected limit 40 and offset 40, got %d and %d", calls[0].Limit, calls[0].Offset)
+		}
+		if page.Total != 120 || page.Limit != 40 || page.Offset != 40 || len(page.Items) != 40 {
+			t.Errorf("unexpected page: total %d, limit %d, offset %d, %d items", page.Total, page.Limit, page.Offset, len(page.Items))
+		}
+		if page.Next != "/transactions?limit=40&offset=80&sort=-date" {
+			t.Errorf("unexpected next link %q", page.Next)
+		}
+		if page.Prev != "/transactions?limit=40&offset=0&sort=-date" {
+			t.Errorf("unexpected prev link %q", page.Prev)
+		}
+
+		// The last page has no next link and the first no prev link
+		if page := get("/transactions?limit=40&offset=80"); page.Next != "" || page.Prev != "/transactions?limit=40&offset=40" {
+			t.Errorf("unexpected links of the last page %q and %q", page.Next, page.Prev)
+		}
+		if page := get("/transactions"); page.Prev != "" || page.Next != "/transactions?limit=50&offset=50" {
+			t.Errorf("unexpected links of the first page %q and %q", page.Next, page.Prev)
+		}
+
+		for _, query := range []string{"limit=0", "limit=501", "limit=ten", "offset=-1", "offset=ten"} {
+			req := httptest.NewRequest(http.MethodGet, "/transactions?"+query, nil)
+			w := httptest.NewRecorder()
+
+			h.GetAllTransactions(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d for %s, got %d", http.StatusBadRequest, query, w.Code)
+			}
+		}
+	})
+
+	t.Run("sort parameter", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{
+			GetTransactionsPageFunc: func(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error) {
+				return entities.Page[entities.Transaction]{}, nil
+			},
+		}
+
+		h := &ApiHandlers{
+			TransactionUseCase: mockUC,
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/transactions?sort=-date,amount", nil)
+		w := httptest.NewRecorder()
+
+		h.GetAllTransactions(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+
+		calls := mockUC.GetTransactionsPageCalls()
+		if len(calls) != 1 {
+			t.Fatalf("expected 1 call to GetTransactionsPage, got %d", len(calls))
+		}
+		expected := []entities.SortField{{Field: "date", Desc: true}, {Field: "amount"}}
+		if !reflect.DeepEqual(calls[0].Sort, expected) {
+			t.Errorf("expected sort %v, got %v", expected, calls[0].Sort)
+		}
+	})
+
+	t.Run("unknown sort field", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{
+			GetTransactionsPageFunc: func(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error) {
+				return entities.Page[entities.Transaction]{}, fmt.Errorf("failed to get transactions with details: %w", domain.ErrMalformedParameters)
+			},
+		}
+
+		h := &ApiHandlers{
+			TransactionUseCase: mockUC,
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/transactions?sort=secret", nil)
+		w := httptest.NewRecorder()
+
+		h.GetAllTransactions(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+
+	t.Run("project filter", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{}
+
+		h := &ApiHandlers{
+			TransactionUseCase: mockUC,
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/transactions?project_id=5d6e7f8a-9b0c-4d1e-8f2a-3b4c5d6e7f8a", nil)
+		w := httptest.NewRecorder()
+
+		h.GetAllTransactions(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+
+		calls := mockUC.GetTransactionsPageCalls()
+		if len(calls) != 1 {
+			t.Fatalf("expected 1 call to GetTransactionsPage, got %d", len(calls))
+		}
+		if calls[0].Filter.ProjectID != "5d6e7f8a-9b0c-4d1e-8f2a-3b4c5d6e7f8a" {
+			t.Errorf("expected project filter, got %+v", calls[0].Filter)
+		}
+
+		req = httptest.NewRequest(http.MethodGet, "/transactions?project_id=website", nil)
+		w = httptest.NewRecorder()
+
+		h.GetAllTransactions(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+
+	t.Run("tag filter", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{
+			GetTransactionsPageFunc: func(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error) {
+				return entities.Page[entities.Transaction]{Items: []entities.Transaction{
+					{ID: "test-123", Tags: []string{"reimbursable", "vacation2024"}},
+				}, Total: 1}, nil
+			},
+		}
+
+		h := &ApiHandlers{
+			TransactionUseCase: mockUC,
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/transactions?tag=vacation2024", nil)
+		w := httptest.NewRecorder()
+
+		h.GetAllTransactions(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+
+		calls := mockUC.GetTransactionsPageCalls()
+		if len(calls) != 1 || calls[0].Filter.Tag != "vacation2024" {
+			t.Fatalf("expected a tag filter, got %+v", calls)
+		}
+
+		var response PageResponse[TransactionResponse]
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(response.Items) != 1 || !reflect.DeepEqual(response.Items[0].Tags, []string{"reimbursable", "vacation2024"}) {
+			t.Errorf("expected the tags of the transaction, got %+v", response.Items)
+		}
+	})
+
+	t.Run("sparse fieldset", func(t *testing.T) {
+		monetaryValue, _ := monetary.NewMonetary(monetary.USD, big.NewInt(10050)) // $100.50
+
+		mockUC := &mocks.TransactionUseCaseMock{
+			GetTransactionsPageFunc: func(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error) {
+				return entities.Page[entities.Transaction]{Items: []entities.Transaction{
+					{
+						ID:          "test-123",
+						AccountID:   "acc-1",
+						CategoryID:  "cat-1",
+						Monetary:    *monetaryValue,
+						Description: "Test transaction",
+						Date:        time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+						Status:      entities.TransactionStatusCleared,
+					},
+				}}, nil
+			},
+		}
+
+		h := &ApiHandlers{
+			TransactionUseCase: mockUC,
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/transactions?fields=id,amount,date", nil)
+		w := httptest.NewRecorder()
+
+		h.GetAllTransactions(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+
+		var page PageResponse[map[string]any]
+		json.Unmarshal(w.Body.Bytes(), &page)
+		response := page.Items
+
+		if len(response) != 1 {
+			t.Fatalf("expected 1 transaction, got %d", len(response))
+		}
+		if len(response[0]) != 3 {
+			t.Errorf("expected 3 fields, got %v", response[0])
+		}
+		if response[0]["id"] != "test-123" {
+			t.Errorf("expected ID 'test-123', got '%v'", response[0]["id"])
+		}
+		if response[0]["date"] != "2024-01-15" {
+			t.Errorf("expected date '2024-01-15', got '%v'", response[0]["date"])
+		}
+		if _, ok := response[0]["amount"]; !ok {
+			t.Error("expected amount to be present")
+		}
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		h := &ApiHandlers{
+			TransactionUseCase: &mocks.TransactionUseCaseMock{},
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/transactions?fields=id,secret", nil)
+		w := httptest.NewRecorder()
+
+		h.GetAllTransactions(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+
 	t.Run("empty result", func(t *testing.T) {
 		mockUC := &mocks.TransactionUseCaseMock{
-			GetTransactionsWithDetailsFunc: func(ctx context.Context, limit, offset int) ([]entities.Transaction, error) {
-				return []entities.Transaction{}, nil
+			GetTransactionsPageFunc: func(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error) {
+				return entities.Page[entities.Transaction]{}, nil
 			},
 		}
 
@@ -685,8 +1069,9 @@ func TestGetAllTransactions(t *testing.T) {
 			t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
 		}
 
-		var response []TransactionResponse
-		json.Unmarshal(w.Body.Bytes(), &response)
+		var page PageResponse[TransactionResponse]
+		json.Unmarshal(w.Body.Bytes(), &page)
+		response := page.Items
 
 		if len(response) != 0 {
 			t.Errorf("expected 0 transactions, got %d", len(response))
@@ -695,8 +1080,8 @@ func TestGetAllTransactions(t *testing.T) {
 
 	t.Run("usecase error", func(t *testing.T) {
 		mockUC := &mocks.TransactionUseCaseMock{
-			GetTransactionsWithDetailsFunc: func(ctx context.Context, limit, offset int) ([]entities.Transaction, error) {
-				return nil, errors.New("database error")
+			GetTransactionsPageFunc: func(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) (entities.Page[entities.Transaction], error) {
+				return entities.Page[entities.Transaction]{}, errors.New("database error")
 			},
 		}
 
@@ -993,6 +1378,46 @@ func TestUpdateTransaction(t *testing.T) {
 			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
 		}
 	})
+	t.Run("embeds the account and category", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{
+			UpdateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+				transaction.Monetary = monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(15075)}
+				transaction.Account = &entities.Account{ID: transaction.AccountID, Name: "Checking", Asset: monetary.BRL}
+				transaction.Category = &entities.Category{ID: transaction.CategoryID, Name: "Groceries"}
+				return transaction, nil
+			},
+		}
+		h := &ApiHandlers{
+			TransactionUseCase: mockUC,
+		}
+
+		bodyJSON, _ := json.Marshal(UpdateTransactionRequest{
+			AccountID:  "acc-1",
+			CategoryID: "cat-1",
+			Amount:     "150.75",
+			Date:       "2024-01-15",
+			Status:     entities.TransactionStatusCleared,
+		})
+		req := httptest.NewRequest(http.MethodPut, "/transactions/test-123?include=account,category", bytes.NewBuffer(bodyJSON))
+		w := httptest.NewRecorder()
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", "test-123")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+		h.UpdateTransaction(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body)
+		}
+		var response TransactionResponse
+		if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Account == nil || response.Account.Name != "Checking" || response.Category == nil || response.Category.Name != "Groceries" {
+			t.Errorf("expected the account and category to be embedded, got %+v %+v", response.Account, response.Category)
+		}
+	})
 }
 
 func TestDeleteTransaction(t *testing.T) {
@@ -1076,4 +1501,535 @@ func TestDeleteTransaction(t *testing.T) {
 			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
 		}
 	})
+
+	t.Run("transaction not found", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{
+			DeleteTransactionFunc: func(ctx context.Context, id string) error {
+				return fmt.Errorf("failed to get transaction: transaction %w", domain.ErrNotFound)
+			},
+		}
+
+		h := &ApiHandlers{
+			TransactionUseCase: mockUC,
+		}
+
+		req := httptest.NewRequest(http.MethodDelete, "/transactions/nonexistent", nil)
+		w := httptest.NewRecorder()
+
+		// Setup chi router context
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", "nonexistent")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+		h.DeleteTransaction(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
+
+func TestDuplicateTransaction(t *testing.T) {
+	duplicate := func(h *ApiHandlers, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/transactions/test-123/duplicate", bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", "test-123")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+		h.DuplicateTransaction(w, req)
+		return w
+	}
+
+	monetaryValue := &monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(-4200)}
+	duplicated := func(ctx context.Context, id string, date time.Time) (entities.Transaction, error) {
+		return entities.Transaction{
+			ID:          "copy-456",
+			Monetary:    *monetaryValue,
+			Description: "Haircut",
+			Date:        date,
+			Status:      entities.TransactionStatusCleared,
+		}, nil
+	}
+
+	t.Run("successful duplicate with date", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{DuplicateTransactionFunc: duplicated}
+
+		w := duplicate(&ApiHandlers{TransactionUseCase: mockUC}, `{"date":"2025-04-02"}`)
+
+		if w.Code != http.StatusCreated {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+		}
+
+		calls := mockUC.DuplicateTransactionCalls()
+		if len(calls) != 1 || calls[0].ID != "test-123" {
+			t.Fatalf("unexpected DuplicateTransaction calls: %+v", calls)
+		}
+		if want := time.Date(2025, time.April, 2, 0, 0, 0, 0, time.UTC); !calls[0].Date.Equal(want) {
+			t.Errorf("expected date %v, got %v", want, calls[0].Date)
+		}
+
+		var response TransactionResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.ID != "copy-456" || response.Date != "2025-04-02" {
+			t.Errorf("unexpected response: %+v", response)
+		}
+	})
+
+	t.Run("successful duplicate without body", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{DuplicateTransactionFunc: duplicated}
+
+		w := duplicate(&ApiHandlers{TransactionUseCase: mockUC}, "")
+
+		if w.Code != http.StatusCreated {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+		}
+		if calls := mockUC.DuplicateTransactionCalls(); len(calls) != 1 || !calls[0].Date.IsZero() {
+			t.Errorf("expected one call with a zero date, got %+v", calls)
+		}
+	})
+
+	t.Run("invalid date format", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{}
+
+		w := duplicate(&ApiHandlers{TransactionUseCase: mockUC}, `{"date":"02/04/2025"}`)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+		}
+		if len(mockUC.DuplicateTransactionCalls()) != 0 {
+			t.Error("expected DuplicateTransaction not to be called")
+		}
+	})
+
+	t.Run("transaction not found", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{
+			DuplicateTransactionFunc: func(ctx context.Context, id string, date time.Time) (entities.Transaction, error) {
+				return entities.Transaction{}, fmt.Errorf("failed to get transaction: transaction %w", domain.ErrNotFound)
+			},
+		}
+
+		w := duplicate(&ApiHandlers{TransactionUseCase: mockUC}, "")
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
+
+func TestCreateInstallmentPurchase(t *testing.T) {
+	create := func(h *ApiHandlers, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/transactions/installments", bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+		h.CreateInstallmentPurchase(w, req)
+		return w
+	}
+
+	monetaryValue, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(50000))
+	mockUC := &mocks.TransactionUseCaseMock{
+		CreateInstallmentPurchaseFunc: func(ctx context.Context, transaction entities.Transaction, installments int) (entities.InstallmentPlan, error) {
+			if installments < 2 {
+				return entities.InstallmentPlan{}, fmt.Errorf("installments must be between 2 and 48, got %d: %w", installments, domain.ErrMalformedParameters)
+			}
+			created := make([]entities.Transaction, installments)
+			for i := range created {
+				created[i] = entities.Transaction{
+					ID:          fmt.Sprintf("tx-%d", i+1),
+					Monetary:    *monetaryValue,
+					Description: fmt.Sprintf("%s (%d/%d)", transaction.Description, i+1, installments),
+					Date:        transaction.Date.AddDate(0, i, 0),
+					Status:      entities.TransactionStatusPending,
+
+					InstallmentPlanID: "plan-1",
+					InstallmentNumber: i + 1,
+					InstallmentCount:  installments,
+				}
+			}
+			return entities.InstallmentPlan{
+				ID:           "plan-1",
+				Description:  transaction.Description,
+				Amount:       transaction.Monetary,
+				Count:        installments,
+				FirstDate:    transaction.Date,
+				Installments: created,
+				Remaining:    transaction.Monetary,
+			}, nil
+		},
+	}
+	h := &ApiHandlers{TransactionUseCase: mockUC}
+
+	t.Run("creates the installments", func(t *testing.T) {
+		w := create(h, `{"account_id":"acc-1","category_id":"cat-1","amount":"1000.00","description":"TV","date":"2025-03-20","installments":2}`)
+		if w.Code != http.StatusCreated {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+		}
+
+		calls := mockUC.CreateInstallmentPurchaseCalls()
+		if len(calls) != 1 || calls[0].Installments != 2 || calls[0].Transaction.Monetary.Amount.Int64() != 100000 {
+			t.Fatalf("unexpected CreateInstallmentPurchase calls: %+v", calls)
+		}
+
+		var response InstallmentPlanResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.ID != "plan-1" || response.InstallmentCount != 2 || response.Amount != "[USD ($) 1000.00]" {
+			t.Errorf("unexpected plan: %+v", response)
+		}
+		installments := response.Installments
+		if len(installments) != 2 || installments[1].Description != "TV (2/2)" || installments[1].Date != "2025-04-20" || installments[1].InstallmentNumber != 2 {
+			t.Errorf("unexpected installments: %+v", installments)
+		}
+	})
+
+	t.Run("invalid installments", func(t *testing.T) {
+		w := create(h, `{"account_id":"acc-1","category_id":"cat-1","amount":"1000.00","description":"TV","installments":1}`)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+
+	t.Run("invalid amount", func(t *testing.T) {
+		w := create(h, `{"account_id":"acc-1","category_id":"cat-1","amount":"a lot","description":"TV","installments":12}`)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+}
+
+func TestFinalizeTransaction(t *testing.T) {
+	finalize := func(h *ApiHandlers, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/transactions/test-123/finalize", bytes.NewBufferString(body))
+		w := httptest.NewRecorder()
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", "test-123")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+		h.FinalizeTransaction(w, req)
+		return w
+	}
+
+	t.Run("successful finalize with status", func(t *testing.T) {
+		monetaryValue := &monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(-1500)}
+		mockUC := &mocks.TransactionUseCaseMock{
+			FinalizeTransactionFunc: func(ctx context.Context, id string, status entities.TransactionStatus) (entities.Transaction, error) {
+				return entities.Transaction{
+					ID:       id,
+					Monetary: *monetaryValue,
+					Date:     time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+					Status:   status,
+				}, nil
+			},
+		}
+
+		w := finalize(&ApiHandlers{TransactionUseCase: mockUC}, `{"status":"pending"}`)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		calls := mockUC.FinalizeTransactionCalls()
+		if len(calls) != 1 || calls[0].ID != "test-123" || calls[0].Status != entities.TransactionStatusPending {
+			t.Errorf("unexpected FinalizeTransaction calls: %+v", calls)
+		}
+
+		var response TransactionResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Status != entities.TransactionStatusPending {
+			t.Errorf("expected status pending, got %s", response.Status)
+		}
+	})
+
+	t.Run("successful finalize without body", func(t *testing.T) {
+		monetaryValue, _ := monetary.NewMonetary(monetary.USD, big.NewInt(100))
+		mockUC := &mocks.TransactionUseCaseMock{
+			FinalizeTransactionFunc: func(ctx context.Context, id string, status entities.TransactionStatus) (entities.Transaction, error) {
+				return entities.Transaction{ID: id, Monetary: *monetaryValue, Status: entities.TransactionStatusCleared}, nil
+			},
+		}
+
+		w := finalize(&ApiHandlers{TransactionUseCase: mockUC}, "")
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		if calls := mockUC.FinalizeTransactionCalls(); len(calls) != 1 || calls[0].Status != "" {
+			t.Errorf("expected one call with an empty status, got %+v", calls)
+		}
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		w := finalize(&ApiHandlers{TransactionUseCase: &mocks.TransactionUseCaseMock{}}, "{")
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+		}
+	})
+
+	t.Run("not a draft", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{
+			FinalizeTransactionFunc: func(ctx context.Context, id string, status entities.TransactionStatus) (entities.Transaction, error) {
+				return entities.Transaction{}, fmt.Errorf("transaction is cleared, only drafts can be finalized: %w", domain.ErrConflict)
+			},
+		}
+
+		w := finalize(&ApiHandlers{TransactionUseCase: mockUC}, "")
+
+		if w.Code != http.StatusConflict {
+			t.Errorf("expected status %d, got %d", http.StatusConflict, w.Code)
+		}
+	})
+}
+
+func TestDiscardDraft(t *testing.T) {
+	discard := func(h *ApiHandlers) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/transactions/test-123/discard", nil)
+		w := httptest.NewRecorder()
+
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", "test-123")
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+
+		h.DiscardDraft(w, req)
+		return w
+	}
+
+	t.Run("successful discard", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{
+			DiscardDraftFunc: func(ctx context.Context, id string) error {
+				return nil
+			},
+		}
+
+		w := discard(&ApiHandlers{TransactionUseCase: mockUC})
+
+		if w.Code != http.StatusNoContent {
+			t.Errorf("expected status %d, got %d", http.StatusNoContent, w.Code)
+		}
+		if calls := mockUC.DiscardDraftCalls(); len(calls) != 1 || calls[0].ID != "test-123" {
+			t.Errorf("unexpected DiscardDraft calls: %+v", calls)
+		}
+	})
+
+	t.Run("not a draft", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{
+			DiscardDraftFunc: func(ctx context.Context, id string) error {
+				return fmt.Errorf("transaction is cleared, only drafts can be discarded: %w", domain.ErrConflict)
+			},
+		}
+
+		w := discard(&ApiHandlers{TransactionUseCase: mockUC})
+
+		if w.Code != http.StatusConflict {
+			t.Errorf("expected status %d, got %d", http.StatusConflict, w.Code)
+		}
+	})
+
+	t.Run("transaction not found", func(t *testing.T) {
+		mockUC := &mocks.TransactionUseCaseMock{
+			DiscardDraftFunc: func(ctx context.Context, id string) error {
+				return fmt.Errorf("failed to get transaction: transaction %w", domain.ErrNotFound)
+			},
+		}
+
+		w := discard(&ApiHandlers{TransactionUseCase: mockUC})
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
+
+func TestTransactionTrash(t *testing.T) {
+	id := "3f2a7d4e-8b1c-4e5f-9a6d-2c7b8e9f0a1b"
+	deletedAt := time.Date(2025, time.March, 14, 10, 30, 0, 0, time.UTC)
+	amount, _ := monetary.NewMonetary(monetary.USD, big.NewInt(1250))
+	mockUC := &mocks.TransactionUseCaseMock{
+		GetTrashPageFunc: func(ctx context.Context, limit, offset int) (entities.Page[entities.Transaction], error) {
+			return entities.Page[entities.Transaction]{
+				Items:  []entities.Transaction{{ID: id, Monetary: *amount, Description: "Market", DeletedAt: &deletedAt}},
+				Total:  3,
+				Limit:  limit,
+				Offset: offset,
+			}, nil
+		},
+		RestoreTransactionFunc: func(ctx context.Context, transactionID string) (entities.Transaction, error) {
+			if transactionID != id {
+				return entities.Transaction{}, fmt.Errorf("failed to restore transaction: deleted transaction %w", domain.ErrNotFound)
+			}
+			return entities.Transaction{ID: id, Monetary: *amount, Description: "Market"}, nil
+		},
+	}
+	r := chi.NewRouter()
+	(&ApiHandlers{TransactionUseCase: mockUC}).Routes(r)
+
+	t.Run("lists the trash", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/transactions/trash?limit=1", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		var page PageResponse[TransactionResponse]
+		if err := json.Unmarshal(w.Body.Bytes(), &page); err != nil {
+			t.Fatal(err)
+		}
+		if page.Total != 3 || len(page.Items) != 1 || page.Next == "" {
+			t.Errorf("unexpected page: %+v", page)
+		}
+		if page.Items[0].DeletedAt != "2025-03-14T10:30:00Z" || page.Items[0].Amount != amount.String() {
+			t.Errorf("unexpected transaction: %+v", page.Items[0])
+		}
+	})
+
+	t.Run("restores a transaction", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/transactions/"+id+"/restore", nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		var response TransactionResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+			t.Fatal(err)
+		}
+		if response.ID != id || response.DeletedAt != "" {
+			t.Errorf("unexpected transaction: %+v", response)
+		}
+	})
+
+	t.Run("not in the trash", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/transactions/7c9e6679-7425-40de-944b-e07fc1f90ae7/restore", nil))
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+	})
+}
+
+func TestSearchTransactions(t *testing.T) {
+	amount, _ := monetary.NewMonetary(monetary.BRL, big.NewInt(4590))
+	var gotQuery string
+	var gotLimit, gotOffset int
+	mockUC := &mocks.TransactionUseCaseMock{
+		SearchTransactionsPageFunc: func(ctx context.Context, query string, limit, offset int) (entities.Page[entities.Transaction], error) {
+			gotQuery, gotLimit, gotOffset = query, limit, offset
+			return entities.Page[entities.Transaction]{
+				Items: []entities.Transaction{{
+					ID:          "3f2a7d4e-8b1c-4e5f-9a6d-2c7b8e9f0a1b",
+					Monetary:    *amount,
+					Description: "Supermarket",
+					Account:     &entities.Account{ID: "acc-1", Name: "Checking", Asset: monetary.BRL},
+					Category:    &entities.Category{ID: "cat-1", Name: "Groceries"},
+				}},
+				Total:  2,
+				Limit:  limit,
+				Offset: offset,
+			}, nil
+		},
+	}
+	r := chi.NewRouter()
+	(&ApiHandlers{TransactionUseCase: mockUC}).Routes(r)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	t.Run("finds transactions", func(t *testing.T) {
+		w := get("/api/v1/transactions/search?q=super+market&include=category&limit=1&offset=1")
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		if gotQuery != "super market" || gotLimit != 1 || gotOffset != 1 {
+			t.Errorf("unexpected search passed to the use case: %q %d %d", gotQuery, gotLimit, gotOffset)
+		}
+
+		var page PageResponse[TransactionResponse]
+		if err := json.Unmarshal(w.Body.Bytes(), &page); err != nil {
+			t.Fatal(err)
+		}
+		if page.Total != 2 || len(page.Items) != 1 || page.Prev == "" {
+			t.Fatalf("unexpected page: %+v", page)
+		}
+		if item := page.Items[0]; item.Description != "Supermarket" || item.Category == nil || item.Category.Name != "Groceries" || item.Account != nil {
+			t.Errorf("unexpected transaction: %+v", item)
+		}
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		for _, path := range []string{
+			"/api/v1/transactions/search",
+			"/api/v1/transactions/search?q=market&include=project",
+			"/api/v1/transactions/search?q=market&limit=0",
+		} {
+			if w := get(path); w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d for %s, got %d", http.StatusBadRequest, path, w.Code)
+			}
+		}
+	})
+}
+
+func TestExportTransactions(t *testing.T) {
+	projectID := "3f2a7d4e-8b1c-4e5f-9a6d-2c7b8e9f0a1b"
+	mockUC := &mocks.TransactionExportUseCaseMock{
+		ExportTransactionsFunc: func(ctx context.Context, format entities.TransactionExportFormat, filter entities.TransactionFilter, sort []entities.SortField) (entities.TransactionExport, error) {
+			if format != entities.TransactionExportFormatCSV && format != entities.TransactionExportFormatXLSX {
+				return entities.TransactionExport{}, fmt.Errorf("unknown export format %q: %w", format, domain.ErrMalformedParameters)
+			}
+			return entities.TransactionExport{
+				Filename:    "transactions-2025-03-17." + string(format),
+				ContentType: "text/csv",
+				Write: func(w io.Writer) error {
+					_, err := io.WriteString(w, "Date\n")
+					return err
+				},
+			}, nil
+		},
+	}
+	r := chi.NewRouter()
+	(&ApiHandlers{TransactionExportUseCase: mockUC}).Routes(r)
+
+	serve := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	t.Run("downloads the filtered transactions", func(t *testing.T) {
+		w := serve("/api/v1/transactions/export?project_id=" + projectID + "&sort=-date")
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body)
+		}
+		if got := w.Header().Get("Content-Disposition"); got != `attachment; filename="transactions-2025-03-17.csv"` {
+			t.Errorf("unexpected Content-Disposition: %s", got)
+		}
+		if w.Body.String() != "Date\n" {
+			t.Errorf("unexpected body: %q", w.Body)
+		}
+
+		call := mockUC.ExportTransactionsCalls()[0]
+		if call.Format != entities.TransactionExportFormatCSV || call.Filter.ProjectID != projectID {
+			t.Errorf("unexpected export: %+v", call)
+		}
+		if !reflect.DeepEqual(call.Sort, []entities.SortField{{Field: "date", Desc: true}}) {
+			t.Errorf("unexpected sort: %+v", call.Sort)
+		}
+	})
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		for _, query := range []string{"format=ods", "project_id=not-a-uuid"} {
+			if w := serve("/api/v1/transactions/export?" + query); w.Code != http.StatusBadRequest {
+				t.Errorf("expected status 400 for %s, got %d", query, w.Code)
+			}
+		}
+	})
 }
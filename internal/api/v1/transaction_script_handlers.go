@@ -0,0 +1,190 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+)
+
+// TransactionScriptRequest is a Starlark script run on the transactions created
+// and imported into the book. It defines process(tx), which can change
+// tx["category_id"], tx["description"], tx["payee"], tx["status"] and the list
+// of tx["tags"], or call reject(reason). Scripts are enabled unless enabled is
+// false.
+type TransactionScriptRequest struct {
+	Name    string `json:"name" example:"rideshare"`
+	Source  string `json:"source"`
+	Enabled *bool  `json:"enabled,omitempty"`
+}
+
+type TransactionScriptResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name" example:"rideshare"`
+	Source    string `json:"source"`
+	Enabled   bool   `json:"enabled" example:"true"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/transaction_script_uc.go . TransactionScriptUseCase
+type TransactionScriptUseCase interface {
+	CreateTransactionScript(ctx context.Context, script entities.TransactionScript) (entities.TransactionScript, error)
+	GetTransactionScripts(ctx context.Context) ([]entities.TransactionScript, error)
+	GetTransactionScript(ctx context.Context, id string) (entities.TransactionScript, error)
+	UpdateTransactionScript(ctx context.Context, script entities.TransactionScript) (entities.TransactionScript, error)
+	DeleteTransactionScript(ctx context.Context, id string) error
+}
+
+// Transaction script handlers
+
+// CreateTransactionScript creates a transaction script
+//
+//	@Summary		Create transaction script
+//	@Description	Add a Starlark script run on every transaction created or imported into the book, before it's stored. The script defines process(tx), tx being a dict of account_id, category_id, amount, date, description, payee, status and tags; it can change category_id, description, payee, status and tags, or call reject(reason) to refuse the transaction. The enabled scripts run in the order of their names, each cut short past its time and memory limits
+//	@Tags			scripts
+//	@Accept			json
+//	@Produce		json
+//	@Param			script	body		TransactionScriptRequest	true	"Script"
+//	@Success		201		{object}	TransactionScriptResponse	"Script created successfully"
+//	@Failure		400		{object}	ErrorResponseBody			"Invalid script"
+//	@Failure		409		{object}	ErrorResponseBody			"Script name already taken"
+//	@Failure		413		{object}	ErrorResponseBody			"Request body too large"
+//	@Router			/scripts [post]
+func (h *ApiHandlers) CreateTransactionScript(w http.ResponseWriter, r *http.Request) {
+	var req TransactionScriptRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	script, err := h.TransactionScriptUseCase.CreateTransactionScript(r.Context(), entities.TransactionScript{
+		Name:    req.Name,
+		Source:  req.Source,
+		Enabled: req.Enabled == nil || *req.Enabled,
+	})
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, transactionScriptResponse(script))
+}
+
+// GetTransactionScripts lists the transaction scripts
+//
+//	@Summary		List transaction scripts
+//	@Description	List the transaction scripts of the book in the order they run
+//	@Tags			scripts
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{array}		TransactionScriptResponse	"Scripts retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody			"Internal server error"
+//	@Router			/scripts [get]
+func (h *ApiHandlers) GetTransactionScripts(w http.ResponseWriter, r *http.Request) {
+	scripts, err := h.TransactionScriptUseCase.GetTransactionScripts(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	responses := make([]TransactionScriptResponse, len(scripts))
+	for i, script := range scripts {
+		responses[i] = transactionScriptResponse(script)
+	}
+
+	render.JSON(w, r, responses)
+}
+
+// GetTransactionScript retrieves a transaction script
+//
+//	@Summary		Get transaction script
+//	@Description	Retrieve a transaction script by its ID
+//	@Tags			scripts
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string						true	"Script ID"
+//	@Success		200	{object}	TransactionScriptResponse	"Script retrieved successfully"
+//	@Failure		400	{object}	ErrorResponseBody			"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody			"Script not found"
+//	@Router			/scripts/{id} [get]
+func (h *ApiHandlers) GetTransactionScript(w http.ResponseWriter, r *http.Request) {
+	script, err := h.TransactionScriptUseCase.GetTransactionScript(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	render.JSON(w, r, transactionScriptResponse(script))
+}
+
+// UpdateTransactionScript updates a transaction script
+//
+//	@Summary		Update transaction script
+//	@Description	Change the name and source of a transaction script, or turn it off with enabled false
+//	@Tags			scripts
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Script ID"
+//	@Param			script	body		TransactionScriptRequest	true	"Script"
+//	@Success		200		{object}	TransactionScriptResponse	"Script updated successfully"
+//	@Failure		400		{object}	ErrorResponseBody			"Invalid script"
+//	@Failure		404		{object}	ErrorResponseBody			"Script not found"
+//	@Failure		409		{object}	ErrorResponseBody			"Script name already taken"
+//	@Failure		413		{object}	ErrorResponseBody			"Request body too large"
+//	@Router			/scripts/{id} [put]
+func (h *ApiHandlers) UpdateTransactionScript(w http.ResponseWriter, r *http.Request) {
+	var req TransactionScriptRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	script, err := h.TransactionScriptUseCase.UpdateTransactionScript(r.Context(), entities.TransactionScript{
+		ID:      chi.URLParam(r, "id"),
+		Name:    req.Name,
+		Source:  req.Source,
+		Enabled: req.Enabled == nil || *req.Enabled,
+	})
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	render.JSON(w, r, transactionScriptResponse(script))
+}
+
+// DeleteTransactionScript deletes a transaction script
+//
+//	@Summary		Delete transaction script
+//	@Description	Delete a transaction script, the transactions it ran on are kept as they are
+//	@Tags			scripts
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path	string	true	"Script ID"
+//	@Success		204	"Script deleted successfully"
+//	@Failure		400	{object}	ErrorResponseBody	"Bad request"
+//	@Failure		404	{object}	ErrorResponseBody	"Script not found"
+//	@Router			/scripts/{id} [delete]
+func (h *ApiHandlers) DeleteTransactionScript(w http.ResponseWriter, r *http.Request) {
+	if err := h.TransactionScriptUseCase.DeleteTransactionScript(r.Context(), chi.URLParam(r, "id")); err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusBadRequest), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func transactionScriptResponse(script entities.TransactionScript) TransactionScriptResponse {
+	return TransactionScriptResponse{
+		ID:        script.ID,
+		Name:      script.Name,
+		Source:    script.Source,
+		Enabled:   script.Enabled,
+		CreatedAt: script.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: script.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/render"
+)
+
+// usagePath is the usage endpoint, which isn't metered so users over their
+// quota can still see it
+const usagePath = "/api/v1/usage"
+
+// UsageResponse is what the user created and how much they called the API in
+// the month from PeriodStart to ResetsAt, when the monthly counts start over
+type UsageResponse struct {
+	Tier                entities.UserTier   `json:"tier" example:"free"`
+	PeriodStart         string              `json:"period_start" example:"2025-03-01"`
+	ResetsAt            string              `json:"resets_at" example:"2025-04-01T00:00:00Z"`
+	Books               int                 `json:"books" example:"1"`
+	Accounts            int                 `json:"accounts" example:"4"`
+	Categories          int                 `json:"categories" example:"18"`
+	Budgets             int                 `json:"budgets" example:"3"`
+	Transactions        int                 `json:"transactions" example:"1250"`
+	MonthlyTransactions int                 `json:"monthly_transactions" example:"87"`
+	MonthlyAPICalls     int                 `json:"monthly_api_calls" example:"2140"`
+	AttachmentBytes     int64               `json:"attachment_bytes" example:"52428800"`
+	Limits              UsageLimitsResponse `json:"limits"`
+}
+
+// UsageLimitsResponse are the quotas of the user, left out when unlimited
+type UsageLimitsResponse struct {
+	Accounts            int   `json:"accounts,omitempty" example:"5"`
+	MonthlyTransactions int   `json:"monthly_transactions,omitempty" example:"500"`
+	MonthlyAPICalls     int   `json:"monthly_api_calls,omitempty" example:"10000"`
+	AttachmentBytes     int64 `json:"attachment_bytes,omitempty" example:"1073741824"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/usage_uc.go . UsageUseCase
+type UsageUseCase interface {
+	RecordAPICall(ctx context.Context) error
+	GetUsage(ctx context.Context) (entities.UsageReport, error)
+}
+
+// Usage handlers
+
+// GetUsage returns the usage of the signed in user
+//
+//	@Summary		Get usage
+//	@Description	Count what the signed in user created, the bytes their attachments take and the API calls they made this month, with the quotas of their tier. Calls to this endpoint aren't counted
+//	@Tags			usage
+//	@Produce		json
+//	@Success		200	{object}	UsageResponse		"Usage"
+//	@Failure		401	{object}	ErrorResponseBody	"Not signed in"
+//	@Failure		500	{object}	ErrorResponseBody	"Internal server error"
+//	@Router			/usage [get]
+func (h *ApiHandlers) GetUsage(w http.ResponseWriter, r *http.Request) {
+	report, err := h.UsageUseCase.GetUsage(r.Context())
+	if err != nil {
+		errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+		return
+	}
+
+	usage := report.Usage
+	render.JSON(w, r, UsageResponse{
+		Tier:                usage.Tier,
+		PeriodStart:         report.PeriodStart.Format("2006-01-02"),
+		ResetsAt:            report.ResetsAt.Format("2006-01-02T15:04:05Z07:00"),
+		Books:               usage.Books,
+		Accounts:            usage.Accounts,
+		Categories:          usage.Categories,
+		Budgets:             usage.Budgets,
+		Transactions:        usage.Transactions,
+		MonthlyTransactions: usage.MonthlyTransactions,
+		MonthlyAPICalls:     usage.MonthlyAPICalls,
+		AttachmentBytes:     usage.AttachmentBytes,
+		Limits: UsageLimitsResponse{
+			Accounts:            report.Limits.Accounts,
+			MonthlyTransactions: report.Limits.MonthlyTransactions,
+			MonthlyAPICalls:     report.Limits.MonthlyAPICalls,
+			AttachmentBytes:     report.Limits.AttachmentBytes,
+		},
+	})
+}
+
+// meterUsage counts the API calls of the signed in user, refusing them once
+// they're over their monthly quota. Calls that fail to be counted go through,
+// the metering shouldn't take the API down with it.
+func (h *ApiHandlers) meterUsage(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if h.UsageUseCase == nil || r.URL.Path == usagePath {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if err := h.UsageUseCase.RecordAPICall(r.Context()); err != nil {
+			var quota *domain.QuotaError
+			if errors.As(err, &quota) {
+				errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+				return
+			}
+			slog.Error("failed to meter API call", "error", err)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestUsage(t *testing.T) {
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	var recordErr error
+	mockUC := &mocks.UsageUseCaseMock{
+		RecordAPICallFunc: func(ctx context.Context) error {
+			return recordErr
+		},
+		GetUsageFunc: func(ctx context.Context) (entities.UsageReport, error) {
+			return entities.UsageReport{
+				Usage:       entities.UserUsage{Tier: entities.UserTierFree, Books: 1, Accounts: 4, MonthlyAPICalls: 2140, AttachmentBytes: 4096},
+				Limits:      entities.UsageLimits{Accounts: 5, MonthlyAPICalls: 10000, AttachmentBytes: 1 << 20},
+				PeriodStart: march,
+				ResetsAt:    march.AddDate(0, 1, 0),
+			}, nil
+		},
+	}
+	h := &ApiHandlers{
+		UsageUseCase: mockUC,
+		SummaryUseCase: &mocks.SummaryUseCaseMock{
+			GetMinimalSummaryFunc: func(ctx context.Context) (entities.MinimalSummary, error) {
+				return entities.MinimalSummary{}, nil
+			},
+		},
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	t.Run("reports the usage without metering it", func(t *testing.T) {
+		rec := get("/api/v1/usage")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body)
+		}
+		var response UsageResponse
+		if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if response.Accounts != 4 || response.Limits.Accounts != 5 || response.MonthlyAPICalls != 2140 || response.AttachmentBytes != 4096 || response.Limits.AttachmentBytes != 1<<20 || response.ResetsAt != "2025-04-01T00:00:00Z" {
+			t.Errorf("unexpected usage: %+v", response)
+		}
+		if calls := mockUC.RecordAPICallCalls(); len(calls) != 0 {
+			t.Errorf("expected the usage call not to be metered, got %d calls", len(calls))
+		}
+	})
+
+	t.Run("meters the other calls", func(t *testing.T) {
+		if rec := get("/api/v1/summary/minimal"); rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if calls := mockUC.RecordAPICallCalls(); len(calls) != 1 {
+			t.Errorf("expected 1 metered call, got %d", len(calls))
+		}
+
+		// Failing to meter a call doesn't fail it
+		recordErr = errors.New("connection reset")
+		if rec := get("/api/v1/summary/minimal"); rec.Code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", rec.Code)
+		}
+	})
+
+	t.Run("refuses the calls over the quota", func(t *testing.T) {
+		recordErr = &domain.QuotaError{Quota: "monthly_api_calls", Limit: 10000, ResetsAt: time.Now().Add(time.Hour)}
+		rec := get("/api/v1/summary/minimal")
+		if rec.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected status 429, got %d", rec.Code)
+		}
+		if rec.Header().Get("Retry-After") == "" {
+			t.Error("expected a Retry-After header")
+		}
+		if rec := get("/api/v1/usage"); rec.Code != http.StatusOK {
+			t.Errorf("expected the usage to stay reachable, got %d", rec.Code)
+		}
+	})
+}
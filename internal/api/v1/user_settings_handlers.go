@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+	"finance/domain/entities"
+	"net/http"
+
+	"github.com/go-chi/render"
+)
+
+// User settings request/response types
+type UpdateUserSettingsRequest struct {
+	Settings map[string]string `json:"settings"`
+}
+
+type UserSettingsResponse struct {
+	Settings map[string]string `json:"settings"`
+}
+
+//go:generate moq -skip-ensure -stub -pkg mocks -out mocks/user_settings_uc.go . UserSettingsUseCase
+type UserSettingsUseCase interface {
+	GetUserSettings(ctx context.Context) (map[entities.UserSettingKey]string, error)
+	UpdateUserSettings(ctx context.Context, settings map[entities.UserSettingKey]string) (map[entities.UserSettingKey]string, error)
+}
+
+// User settings handlers
+
+// GetUserSettings retrieves the user preferences
+//
+//	@Summary		Get user settings
+//	@Description	Retrieve the user preferences. Known keys (base_currency, locale, timezone, first_day_of_week, dashboard_layout) are always present, falling back to their defaults
+//	@Tags			user-settings
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	UserSettingsResponse	"User settings retrieved successfully"
+//	@Failure		500	{object}	ErrorResponseBody		"Internal server error"
+//	@Router			/user-settings [get]
+func (h *ApiHandlers) GetUserSettings(w http.ResponseWriter, r *http.Request) {
+	settings, err := h.UserSettingsUseCase.GetUserSettings(r.Context())
+	if err != nil {
+		errorResponse(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	render.JSON(w, r, toUserSettingsResponse(settings))
+}
+
+// UpdateUserSettings updates the user preferences
+//
+//	@Summary		Update user settings
+//	@Description	Update the given user preferences, leaving the others untouched. An empty value removes the preference, resetting known keys to their default
+//	@Tags			user-settings
+//	@Accept			json
+//	@Produce		json
+//	@Param			settings	body		UpdateUserSettingsRequest	true	"Preferences to update"
+//	@Success		200			{object}	UserSettingsResponse		"User settings updated successfully"
+//	@Failure		400			{object}	ErrorResponseBody			"Bad request"
+//	@Failure		413			{object}	ErrorResponseBody			"Request body too large"
+//	@Router			/user-settings [put]
+func (h *ApiHandlers) UpdateUserSettings(w http.ResponseWriter, r *http.Request) {
+	var req UpdateUserSettingsRequest
+	if err := decodeJSON(w, r, &req); err != nil {
+		bodyErrorResponse(w, r, err)
+		return
+	}
+
+	if len(req.Settings) == 0 {
+		errorResponse(w, r, http.StatusBadRequest, errMissingParameter("settings"))
+		return
+	}
+
+	settings := make(map[entities.UserSettingKey]string, len(req.Settings))
+	for key, value := range req.Settings {
+		settings[entities.UserSettingKey(key)] = value
+	}
+
+	updatedSettings, err := h.UserSettingsUseCase.UpdateUserSettings(r.Context(), settings)
+	if err != nil {
+		errorResponse(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	render.JSON(w, r, toUserSettingsResponse(updatedSettings))
+}
+
+func toUserSettingsResponse(settings map[entities.UserSettingKey]string) UserSettingsResponse {
+	response := UserSettingsResponse{
+		Settings: make(map[string]string, len(settings)),
+	}
+	for key, value := range settings {
+		response.Settings[string(key)] = value
+	}
+
+	return response
+}
@@ -0,0 +1,473 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"finance/domain"
+	"finance/internal/realtime"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// wsAuthTimeout is how long a client connected without the Authorization
+	// header has to send its auth message
+	wsAuthTimeout = 10 * time.Second
+	// wsPingInterval is how often the server pings, clients that don't pong
+	// within wsPongTimeout are disconnected
+	wsPingInterval = 30 * time.Second
+	wsPongTimeout  = 2 * wsPingInterval
+	wsWriteTimeout = 10 * time.Second
+	// wsReadLimit caps the size of the client messages, far above what the
+	// subscription messages need
+	wsReadLimit = 4096
+	// wsEventBuffer is how many events a client may fall behind before it's
+	// disconnected
+	wsEventBuffer = 256
+)
+
+// Client message types
+const (
+	WSMessageAuth        = "auth"
+	WSMessageSubscribe   = "subscribe"
+	WSMessageUnsubscribe = "unsubscribe"
+	WSMessagePing        = "ping"
+)
+
+// Server message types
+const (
+	WSMessageAuthenticated = "authenticated"
+	WSMessageSubscribed    = "subscribed"
+	WSMessageUnsubscribed  = "unsubscribed"
+	WSMessageEvent         = "event"
+	WSMessagePong          = "pong"
+	WSMessageError         = "error"
+)
+
+var (
+	errWebSocketDisabled      = errors.New("the WebSocket API is disabled, set SERVICE_WS_ENABLED to enable it")
+	errInvalidWebSocketToken  = errors.New("invalid token")
+	errWebSocketUnauthorized  = errors.New("authenticate first")
+	errWebSocketAuthTimeout   = errors.New("no auth message received in time")
+	errWebSocketMissingTopics = errors.New("missing topics")
+	errWebSocketTooSlow       = errors.New("events were dropped because the client fell behind, reconnect and subscribe again")
+)
+
+// WSClientMessage is a message sent by the WebSocket clients
+type WSClientMessage struct {
+	// auth, subscribe, unsubscribe or ping
+	Type string `json:"type" example:"subscribe"`
+	// Token of the auth message, the user's bearer token
+	Token string `json:"token,omitempty"`
+	// Topics of the subscribe and unsubscribe messages: accounts,
+	// transactions, balances or budget_alerts
+	Topics []string `json:"topics,omitempty" example:"balances"`
+}
+
+// WSServerMessage is a message sent to the WebSocket clients
+type WSServerMessage struct {
+	// authenticated, subscribed, unsubscribed, event, pong or error
+	Type string `json:"type" example:"event"`
+	// Topics subscribed to, after a subscribe or unsubscribe message
+	Topics []string `json:"topics,omitempty"`
+	// Topic and action of the event: created, updated, deleted, or snapshot
+	// for the balances sent on subscribing
+	Topic  string `json:"topic,omitempty" example:"balances"`
+	Action string `json:"action,omitempty" example:"updated"`
+	// Data is the resource as the REST endpoints render it, {"id": ...} when
+	// deleted, or the list of balances of a snapshot
+	Data  any    `json:"data,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// wsUpgrader accepts any origin: clients authenticate with their bearer token,
+// not with cookies a foreign page could ride on
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// WebSocket streams the changes of the subscribed topics
+//
+//	@Summary		Stream changes over a WebSocket
+//	@Description	Upgrades to a WebSocket streaming the accounts, transactions and balances changed through the API, and the budget alerts fired. Clients authenticate with their bearer token, in the Authorization: Bearer header, the token query parameter or an {"type": "auth", "token": "..."} first message, and receive the changes of their book, the one in the X-Book-Id header or book_id query parameter or their default book. They then send {"type": "subscribe", "topics": ["balances"]}. Subscribing to balances sends a snapshot of all of them first. Every message is a JSON WSClientMessage or WSServerMessage.
+//	@Tags			realtime
+//	@Param			Authorization	header	string	false	"Bearer token, when not sent in an auth message"
+//	@Param			token			query	string	false	"Bearer token, for clients that can't set headers"
+//	@Param			X-Book-Id		header	string	false	"Book to stream the changes of, the user's default book when not sent"
+//	@Param			book_id			query	string	false	"Book to stream the changes of, for clients that can't set headers"
+//	@Success		101				"Switching protocols"
+//	@Failure		400				{object}	ErrorResponseBody	"Invalid book"
+//	@Failure		401				{object}	ErrorResponseBody	"Invalid token"
+//	@Failure		404				{object}	ErrorResponseBody	"Book not found"
+//	@Failure		503				{object}	ErrorResponseBody	"WebSocket API disabled"
+//	@Router			/ws [get]
+func (h *ApiHandlers) WebSocket(w http.ResponseWriter, r *http.Request) {
+	if !h.WebSocketEnabled || h.Events == nil {
+		errorResponse(w, r, http.StatusServiceUnavailable, errWebSocketDisabled)
+		return
+	}
+
+	token := r.URL.Query().Get("token")
+	if header := r.Header.Get("Authorization"); header != "" {
+		var ok bool
+		if token, ok = strings.CutPrefix(header, "Bearer "); !ok {
+			unauthorizedResponse(w, r, errInvalidWebSocketToken)
+			return
+		}
+	}
+
+	book := r.Header.Get(BookHeader)
+	if book == "" {
+		book = r.URL.Query().Get("book_id")
+	}
+	if book != "" {
+		if _, err := uuid.FromString(book); err != nil {
+			errorResponse(w, r, http.StatusBadRequest, errInvalidParameter(BookHeader, "must be a valid UUID"))
+			return
+		}
+	}
+
+	var userID, bookID string
+	authenticated := false
+	if token != "" {
+		var err error
+		if userID, err = h.webSocketUser(r.Context(), token); err != nil {
+			if errors.Is(err, domain.ErrUnauthorized) {
+				unauthorizedResponse(w, r, errInvalidWebSocketToken)
+				return
+			}
+			errorResponse(w, r, http.StatusInternalServerError, err)
+			return
+		}
+		if bookID, err = h.webSocketBook(r.Context(), userID, book); err != nil {
+			errorResponse(w, r, errorStatus(err, http.StatusInternalServerError), err)
+			return
+		}
+		authenticated = true
+	}
+
+	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader already replied
+		return
+	}
+	defer conn.Close()
+
+	session := &wsSession{conn: conn, handlers: h, book: book, topics: map[string]bool{}}
+	if authenticated {
+		session.authenticate(userID, bookID)
+	}
+	defer session.close()
+	session.run(r.Context())
+}
+
+// webSocketUser returns the user of a bearer token, the same way authenticate
+// does. Without a UserUseCase there are no users, and any token goes.
+func (h *ApiHandlers) webSocketUser(ctx context.Context, token string) (string, error) {
+	if h.UserUseCase == nil {
+		return "", nil
+	}
+	if token == "" {
+		return "", domain.ErrUnauthorized
+	}
+
+	session, err := h.UserUseCase.Authenticate(ctx, token)
+	if err != nil {
+		return "", err
+	}
+	return session.UserID, nil
+}
+
+// webSocketBook returns the book a client of userID streams the changes of,
+// book when it's one of the user's or userID's default book when it's empty,
+// the same way bookScope picks it
+func (h *ApiHandlers) webSocketBook(ctx context.Context, userID, book string) (string, error) {
+	if userID == "" {
+		if book == "" {
+			return domain.DefaultBookID, nil
+		}
+		return book, nil
+	}
+
+	ctx = domain.WithUser(ctx, userID)
+	if book == "" {
+		return h.defaultBookID(ctx)
+	}
+	if _, err := h.BookUseCase.GetBook(ctx, book); err != nil {
+		return "", err
+	}
+	return book, nil
+}
+
+// wsSession is a WebSocket connection. Only run writes to the connection, the
+// messages read are handed to it by readMessages.
+type wsSession struct {
+	conn     *websocket.Conn
+	handlers *ApiHandlers
+	// book is the book the client asked for, empty for the default book
+	book string
+	// subscription is nil until the client authenticates as userID, then
+	// receives the events of userID and bookID
+	subscription *realtime.Subscription
+	userID       string
+	bookID       string
+	topics       map[string]bool
+}
+
+// wsRead is a message read from the client, or the error that ended reading
+type wsRead struct {
+	message WSClientMessage
+	err     error
+	// malformed messages are reported to the client without disconnecting it
+	malformed bool
+}
+
+func (s *wsSession) authenticate(userID, bookID string) {
+	if s.subscription == nil {
+		s.userID, s.bookID = userID, bookID
+		s.subscription = s.handlers.Events.Subscribe(wsEventBuffer)
+	}
+}
+
+// scope returns ctx scoped to the user and book of the client, the way the
+// REST requests are
+func (s *wsSession) scope(ctx context.Context) context.Context {
+	ctx = domain.WithBook(ctx, s.bookID)
+	if s.userID != "" {
+		ctx = domain.WithUser(ctx, s.userID)
+	}
+	return ctx
+}
+
+func (s *wsSession) close() {
+	if s.subscription != nil {
+		s.subscription.Close()
+	}
+}
+
+func (s *wsSession) run(ctx context.Context) {
+	reads := make(chan wsRead)
+	done := make(chan struct{})
+	defer close(done)
+	go s.readMessages(reads, done)
+
+	ping := time.NewTicker(wsPingInterval)
+	defer ping.Stop()
+
+	authTimeout := time.NewTimer(wsAuthTimeout)
+	defer authTimeout.Stop()
+
+	for {
+		// Receiving from a nil channel blocks, so there are no events
+		// before the client authenticates
+		var events <-chan realtime.Event
+		if s.subscription != nil {
+			events = s.subscription.Events()
+		}
+
+		select {
+		case <-ctx.Done():
+			s.closeWith(websocket.CloseGoingAway, "server shutting down")
+			return
+
+		case <-authTimeout.C:
+			if s.subscription == nil {
+				s.write(WSServerMessage{Type: WSMessageError, Error: errWebSocketAuthTimeout.Error()})
+				s.closeWith(websocket.ClosePolicyViolation, errWebSocketAuthTimeout.Error())
+				return
+			}
+
+		case <-ping.C:
+			if err := s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout)); err != nil {
+				return
+			}
+
+		case read := <-reads:
+			if read.err != nil && !read.malformed {
+				return
+			}
+			if !s.handle(ctx, read) {
+				return
+			}
+
+		case event, ok := <-events:
+			if !ok {
+				s.write(WSServerMessage{Type: WSMessageError, Error: errWebSocketTooSlow.Error()})
+				s.closeWith(websocket.CloseTryAgainLater, "too slow")
+				return
+			}
+			// Only the changes of the client's user and book are sent
+			if !s.topics[event.Topic] || event.UserID != s.userID || event.BookID != s.bookID {
+				continue
+			}
+			err := s.write(WSServerMessage{Type: WSMessageEvent, Topic: event.Topic, Action: event.Action, Data: event.Data})
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// readMessages reads the client messages until the connection fails
+func (s *wsSession) readMessages(reads chan<- wsRead, done <-chan struct{}) {
+	s.conn.SetReadLimit(wsReadLimit)
+	s.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	})
+
+	for {
+		_, data, err := s.conn.ReadMessage()
+		read := wsRead{err: err}
+		if err == nil {
+			s.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+			if err := json.Unmarshal(data, &read.message); err != nil {
+				read.err, read.malformed = errors.New("message must be a JSON object with a type"), true
+			}
+		}
+
+		select {
+		case reads <- read:
+		case <-done:
+			return
+		}
+		if read.err != nil && !read.malformed {
+			return
+		}
+	}
+}
+
+// handle answers a client message, returning false when the connection must
+// be closed
+func (s *wsSession) handle(ctx context.Context, read wsRead) bool {
+	if read.malformed {
+		return s.writeError(read.err)
+	}
+
+	message := read.message
+	switch message.Type {
+	case WSMessageAuth:
+		if s.subscription != nil {
+			return s.write(WSServerMessage{Type: WSMessageAuthenticated}) == nil
+		}
+		userID, err := s.handlers.webSocketUser(ctx, message.Token)
+		if err != nil {
+			if !errors.Is(err, domain.ErrUnauthorized) {
+				slog.Error("failed to authenticate a WebSocket client", "error", err)
+			}
+			s.writeError(errInvalidWebSocketToken)
+			s.closeWith(websocket.ClosePolicyViolation, errInvalidWebSocketToken.Error())
+			return false
+		}
+		bookID, err := s.handlers.webSocketBook(ctx, userID, s.book)
+		if err != nil {
+			if !errors.Is(err, domain.ErrNotFound) {
+				slog.Error("failed to get the book of a WebSocket client", "error", err)
+				err = errors.New("failed to get the book")
+			}
+			s.writeError(err)
+			s.closeWith(websocket.ClosePolicyViolation, err.Error())
+			return false
+		}
+		s.authenticate(userID, bookID)
+		return s.write(WSServerMessage{Type: WSMessageAuthenticated}) == nil
+
+	case WSMessagePing:
+		return s.write(WSServerMessage{Type: WSMessagePong}) == nil
+
+	case WSMessageSubscribe, WSMessageUnsubscribe:
+		if s.subscription == nil {
+			return s.writeError(errWebSocketUnauthorized)
+		}
+		if len(message.Topics) == 0 {
+			return s.writeError(errWebSocketMissingTopics)
+		}
+		for _, topic := range message.Topics {
+			if !realtime.ValidTopic(topic) {
+				return s.writeError(errInvalidParameter("topics", topic))
+			}
+		}
+
+		if message.Type == WSMessageUnsubscribe {
+			for _, topic := range message.Topics {
+				delete(s.topics, topic)
+			}
+			return s.write(WSServerMessage{Type: WSMessageUnsubscribed, Topics: s.subscribedTopics()}) == nil
+		}
+
+		snapshot := !s.topics[realtime.TopicBalances] && slices.Contains(message.Topics, realtime.TopicBalances)
+		for _, topic := range message.Topics {
+			s.topics[topic] = true
+		}
+		if err := s.write(WSServerMessage{Type: WSMessageSubscribed, Topics: s.subscribedTopics()}); err != nil {
+			return false
+		}
+		if snapshot {
+			return s.writeBalancesSnapshot(ctx)
+		}
+		return true
+
+	default:
+		return s.writeError(errInvalidParameter("type", message.Type))
+	}
+}
+
+// writeBalancesSnapshot sends all the balances, so clients don't have to
+// fetch them before the first change
+func (s *wsSession) writeBalancesSnapshot(ctx context.Context) bool {
+	balances, err := s.handlers.BalanceUseCase.GetAllBalances(s.scope(ctx))
+	if err != nil {
+		slog.Error("failed to get the balances snapshot", "error", err)
+		return s.writeError(errors.New("failed to get the balances"))
+	}
+
+	response := make([]BalanceResponse, len(balances))
+	for i, balance := range balances {
+		response[i] = balanceEventData(balance)
+	}
+	return s.write(WSServerMessage{
+		Type:   WSMessageEvent,
+		Topic:  realtime.TopicBalances,
+		Action: realtime.ActionSnapshot,
+		Data:   response,
+	}) == nil
+}
+
+// subscribedTopics lists the topics in the order of realtime.Topics
+func (s *wsSession) subscribedTopics() []string {
+	var topics []string
+	for _, topic := range realtime.Topics {
+		if s.topics[topic] {
+			topics = append(topics, topic)
+		}
+	}
+	return topics
+}
+
+func (s *wsSession) write(message WSServerMessage) error {
+	s.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	if err := s.conn.WriteJSON(message); err != nil {
+		return fmt.Errorf("writing the %s message: %w", message.Type, err)
+	}
+	return nil
+}
+
+// writeError reports an error to the client, returning whether the
+// connection is still usable
+func (s *wsSession) writeError(err error) bool {
+	return s.write(WSServerMessage{Type: WSMessageError, Error: err.Error()}) == nil
+}
+
+func (s *wsSession) closeWith(code int, reason string) {
+	s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(wsWriteTimeout))
+}
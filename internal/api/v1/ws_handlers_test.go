@@ -0,0 +1,281 @@
+package v1
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/api/v1/mocks"
+	"finance/internal/realtime"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+	"github.com/guilhermebr/gox/monetary"
+)
+
+func TestWebSocket(t *testing.T) {
+	amount, _ := monetary.NewMonetary(monetary.USD, big.NewInt(10050)) // $100.50
+	balance := func(accountID string) entities.Balance {
+		return entities.Balance{
+			AccountID:        accountID,
+			CurrentBalance:   *amount,
+			PendingBalance:   *amount,
+			AvailableBalance: *amount,
+		}
+	}
+
+	events := realtime.NewHub()
+	balances := &mocks.BalanceUseCaseMock{
+		GetAllBalancesFunc: func(ctx context.Context) ([]entities.Balance, error) {
+			// The snapshot is the one of the client's user and book
+			if userID, _ := domain.UserFromContext(ctx); userID != "user-alice" || domain.BookFromContext(ctx) != "book-alice" {
+				return nil, nil
+			}
+			return []entities.Balance{balance("acc-1"), balance("acc-2")}, nil
+		},
+		GetBalanceByAccountIDFunc: func(ctx context.Context, accountID string) (entities.Balance, error) {
+			return balance(accountID), nil
+		},
+	}
+	// The next change of the transactions fires these budget alerts
+	var fire []entities.BudgetAlert
+	budgets := &mocks.BudgetUseCaseMock{
+		EvaluateBudgetAlertsFunc: func(ctx context.Context) ([]entities.BudgetAlert, error) {
+			fired := fire
+			fire = nil
+			return fired, nil
+		},
+	}
+	transactions := NewPublishingTransactionUseCase(&mocks.TransactionUseCaseMock{
+		CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+			transaction.ID = "txn-1"
+			transaction.Monetary = *amount
+			return transaction, nil
+		},
+	}, balances, budgets, events)
+
+	users := &mocks.UserUseCaseMock{
+		AuthenticateFunc: func(ctx context.Context, token string) (entities.Session, error) {
+			if token != "token-alice" {
+				return entities.Session{}, fmt.Errorf("invalid token: %w", domain.ErrUnauthorized)
+			}
+			return entities.Session{ID: "session-1", UserID: "user-alice"}, nil
+		},
+	}
+	books := &mocks.BookUseCaseMock{
+		DefaultBookFunc: func(ctx context.Context) (entities.Book, error) {
+			return entities.Book{ID: "book-alice"}, nil
+		},
+		GetBookFunc: func(ctx context.Context, id string) (entities.Book, error) {
+			if id != "00000000-0000-0000-0000-00000000a11c" {
+				return entities.Book{}, fmt.Errorf("book %w", domain.ErrNotFound)
+			}
+			return entities.Book{ID: id}, nil
+		},
+	}
+	alice := domain.WithBook(domain.WithUser(context.Background(), "user-alice"), "book-alice")
+
+	h := &ApiHandlers{
+		BalanceUseCase:   balances,
+		BookUseCase:      books,
+		UserUseCase:      users,
+		Events:           events,
+		WebSocketEnabled: true,
+	}
+	r := chi.NewRouter()
+	h.Routes(r)
+	server := httptest.NewServer(r)
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/v1/ws"
+
+	read := func(t *testing.T, conn *websocket.Conn) WSServerMessage {
+		t.Helper()
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		var message WSServerMessage
+		if err := conn.ReadJSON(&message); err != nil {
+			t.Fatalf("reading message: %v", err)
+		}
+		return message
+	}
+
+	t.Run("streams the subscribed topics", func(t *testing.T) {
+		header := http.Header{"Authorization": {"Bearer token-alice"}}
+		conn, _, err := websocket.DefaultDialer.Dial(url, header)
+		if err != nil {
+			t.Fatalf("dialing: %v", err)
+		}
+		defer conn.Close()
+
+		conn.WriteJSON(WSClientMessage{Type: WSMessageSubscribe, Topics: []string{realtime.TopicBalances}})
+		if message := read(t, conn); message.Type != WSMessageSubscribed || len(message.Topics) != 1 {
+			t.Fatalf("expected subscribed to balances, got %+v", message)
+		}
+
+		snapshot := read(t, conn)
+		if snapshot.Action != realtime.ActionSnapshot || len(snapshot.Data.([]any)) != 2 {
+			t.Fatalf("expected a snapshot of 2 balances, got %+v", snapshot)
+		}
+
+		// The changes of other users and books are skipped
+		bob := domain.WithBook(domain.WithUser(context.Background(), "user-bob"), "book-bob")
+		aliceSideBook := domain.WithBook(alice, "book-alice-side")
+		for _, ctx := range []context.Context{bob, aliceSideBook, context.Background()} {
+			if _, err := transactions.CreateTransaction(ctx, entities.Transaction{AccountID: "acc-9"}); err != nil {
+				t.Fatalf("creating transaction: %v", err)
+			}
+		}
+
+		_, err = transactions.CreateTransaction(alice, entities.Transaction{AccountID: "acc-2"})
+		if err != nil {
+			t.Fatalf("creating transaction: %v", err)
+		}
+
+		// The transaction event is skipped, the client only wants balances
+		event := read(t, conn)
+		if event.Type != WSMessageEvent || event.Topic != realtime.TopicBalances || event.Action != realtime.ActionUpdated {
+			t.Fatalf("expected a balance update, got %+v", event)
+		}
+		if accountID := event.Data.(map[string]any)["account_id"]; accountID != "acc-2" {
+			t.Errorf("expected the balance of acc-2, got %v", accountID)
+		}
+		if got := event.Data.(map[string]any)["current_balance"]; got != amount.String() {
+			t.Errorf("expected current balance %s, got %v", amount.String(), got)
+		}
+	})
+
+	t.Run("streams the budget alerts fired", func(t *testing.T) {
+		header := http.Header{"Authorization": {"Bearer token-alice"}}
+		conn, _, err := websocket.DefaultDialer.Dial(url, header)
+		if err != nil {
+			t.Fatalf("dialing: %v", err)
+		}
+		defer conn.Close()
+
+		conn.WriteJSON(WSClientMessage{Type: WSMessageSubscribe, Topics: []string{realtime.TopicBudgetAlerts}})
+		if message := read(t, conn); message.Type != WSMessageSubscribed {
+			t.Fatalf("expected subscribed to budget alerts, got %+v", message)
+		}
+
+		fire = []entities.BudgetAlert{{ID: "alert-1", BudgetID: "bud-1", Month: "2025-04", Threshold: 80, Spent: *amount, Limit: *amount}}
+		if _, err := transactions.CreateTransaction(alice, entities.Transaction{AccountID: "acc-1"}); err != nil {
+			t.Fatalf("creating transaction: %v", err)
+		}
+
+		event := read(t, conn)
+		if event.Topic != realtime.TopicBudgetAlerts || event.Action != realtime.ActionCreated {
+			t.Fatalf("expected a budget alert, got %+v", event)
+		}
+		if threshold := event.Data.(map[string]any)["threshold"]; threshold != 80.0 {
+			t.Errorf("expected the 80%% threshold, got %v", threshold)
+		}
+	})
+
+	t.Run("authenticates with a message", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		if err != nil {
+			t.Fatalf("dialing: %v", err)
+		}
+		defer conn.Close()
+
+		conn.WriteJSON(WSClientMessage{Type: WSMessageSubscribe, Topics: []string{realtime.TopicAccounts}})
+		if message := read(t, conn); message.Error != errWebSocketUnauthorized.Error() {
+			t.Fatalf("expected to be asked to authenticate, got %+v", message)
+		}
+
+		conn.WriteJSON(WSClientMessage{Type: WSMessageAuth, Token: "token-alice"})
+		if message := read(t, conn); message.Type != WSMessageAuthenticated {
+			t.Fatalf("expected authenticated, got %+v", message)
+		}
+
+		conn.WriteJSON(WSClientMessage{Type: WSMessageSubscribe, Topics: []string{"categories"}})
+		if message := read(t, conn); message.Type != WSMessageError {
+			t.Fatalf("expected an unknown topic error, got %+v", message)
+		}
+
+		conn.WriteJSON(WSClientMessage{Type: WSMessagePing})
+		if message := read(t, conn); message.Type != WSMessagePong {
+			t.Fatalf("expected pong, got %+v", message)
+		}
+	})
+
+	t.Run("closes on an invalid token message", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		if err != nil {
+			t.Fatalf("dialing: %v", err)
+		}
+		defer conn.Close()
+
+		conn.WriteJSON(WSClientMessage{Type: WSMessageAuth, Token: "wrong"})
+		if message := read(t, conn); message.Error != errInvalidWebSocketToken.Error() {
+			t.Fatalf("expected an invalid token error, got %+v", message)
+		}
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		if _, _, err := conn.ReadMessage(); !websocket.IsCloseError(err, websocket.ClosePolicyViolation) {
+			t.Errorf("expected a policy violation close, got %v", err)
+		}
+	})
+
+	t.Run("rejects an invalid token header", func(t *testing.T) {
+		header := http.Header{"Authorization": {"Bearer wrong"}}
+		_, resp, err := websocket.DefaultDialer.Dial(url, header)
+		if err == nil || resp.StatusCode != http.StatusUnauthorized {
+			t.Fatalf("expected 401, got %v", err)
+		}
+	})
+
+	t.Run("authenticates with the token query parameter", func(t *testing.T) {
+		_, resp, err := websocket.DefaultDialer.Dial(url+"?token=wrong", nil)
+		if err == nil || resp.StatusCode != http.StatusUnauthorized {
+			t.Fatalf("expected 401, got %v", err)
+		}
+
+		// Only the user's own books can be streamed
+		_, resp, err = websocket.DefaultDialer.Dial(url+"?token=token-alice&book_id=00000000-0000-0000-0000-0000000000b0", nil)
+		if err == nil || resp.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected 404, got %v", err)
+		}
+		_, resp, err = websocket.DefaultDialer.Dial(url+"?token=token-alice&book_id=side", nil)
+		if err == nil || resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected 400, got %v", err)
+		}
+
+		conn, _, err := websocket.DefaultDialer.Dial(url+"?token=token-alice", nil)
+		if err != nil {
+			t.Fatalf("dialing: %v", err)
+		}
+		defer conn.Close()
+
+		conn.WriteJSON(WSClientMessage{Type: WSMessageSubscribe, Topics: []string{realtime.TopicAccounts}})
+		if message := read(t, conn); message.Type != WSMessageSubscribed {
+			t.Fatalf("expected subscribed, got %+v", message)
+		}
+	})
+
+	t.Run("closes on an empty token message", func(t *testing.T) {
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		if err != nil {
+			t.Fatalf("dialing: %v", err)
+		}
+		defer conn.Close()
+
+		conn.WriteJSON(WSClientMessage{Type: WSMessageAuth})
+		if message := read(t, conn); message.Error != errInvalidWebSocketToken.Error() {
+			t.Fatalf("expected an invalid token error, got %+v", message)
+		}
+	})
+
+	t.Run("is disabled by default", func(t *testing.T) {
+		disabled := &ApiHandlers{Events: events}
+		rec := httptest.NewRecorder()
+		disabled.WebSocket(rec, httptest.NewRequest(http.MethodGet, "/api/v1/ws", nil))
+		if rec.Code != http.StatusServiceUnavailable {
+			t.Errorf("expected 503, got %d", rec.Code)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SiteVerify checks CAPTCHA answers with the siteverify API shared by
+// reCAPTCHA, hCaptcha and Turnstile: the secret, the answer and the client
+// address are posted as a form, and the answer is good when it replies
+// {"success": true}.
+type SiteVerify struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+func NewSiteVerify(verifyURL, secret string) *SiteVerify {
+	return &SiteVerify{
+		url:    verifyURL,
+		secret: secret,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// VerifyCaptcha returns domain.ErrUnauthorized when the answer is wrong
+func (s *SiteVerify) VerifyCaptcha(ctx context.Context, answer, ipAddress string) error {
+	form := url.Values{"secret": {s.secret}, "response": {answer}}
+	if ipAddress != "" {
+		form.Set("remoteip", ipAddress)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call captcha verifier: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("captcha verifier answered %s", resp.Status)
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode captcha verifier response: %w", err)
+	}
+	if !result.Success {
+		return fmt.Errorf("wrong captcha answer: %w", domain.ErrUnauthorized)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"context"
+	"finance/domain"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSiteVerify(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "secret", r.PostForm.Get("secret"))
+		assert.Equal(t, "192.0.2.1", r.PostForm.Get("remoteip"))
+		switch r.PostForm.Get("response") {
+		case "solved":
+			io.WriteString(w, `{"success": true}`)
+		case "broken":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			io.WriteString(w, `{"success": false, "error-codes": ["invalid-input-response"]}`)
+		}
+	}))
+	defer server.Close()
+
+	verifier := NewSiteVerify(server.URL, "secret")
+	ctx := context.Background()
+
+	assert.NoError(t, verifier.VerifyCaptcha(ctx, "solved", "192.0.2.1"))
+	assert.ErrorIs(t, verifier.VerifyCaptcha(ctx, "guessed", "192.0.2.1"), domain.ErrUnauthorized)
+
+	err := verifier.VerifyCaptcha(ctx, "broken", "192.0.2.1")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, domain.ErrUnauthorized)
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"encoding/base64"
+	"finance/domain"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWT(t *testing.T) {
+	const secret = "0123456789abcdef0123456789abcdef"
+	now := time.Date(2025, time.March, 15, 9, 0, 0, 0, time.UTC)
+	jwt := NewJWT(secret)
+	jwt.now = func() time.Time { return now }
+
+	token, err := jwt.IssueToken("user-1", "session-1", now.Add(time.Hour))
+	require.NoError(t, err)
+
+	t.Run("verifies", func(t *testing.T) {
+		userID, sessionID, err := jwt.VerifyToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", userID)
+		assert.Equal(t, "session-1", sessionID)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		later := NewJWT(secret)
+		later.now = func() time.Time { return now.Add(time.Hour) }
+		_, _, err := later.VerifyToken(token)
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("signed with another secret", func(t *testing.T) {
+		other := NewJWT("fedcba9876543210fedcba9876543210")
+		other.now = jwt.now
+		_, _, err := other.VerifyToken(token)
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("tampered subject", func(t *testing.T) {
+		parts := strings.Split(token, ".")
+		parts[1] = base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"user-2","sid":"session-1","iat":0,"exp":9999999999}`))
+		_, _, err := jwt.VerifyToken(strings.Join(parts, "."))
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		parts := strings.Split(token, ".")
+		parts[0] = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+		_, _, err := jwt.VerifyToken(parts[0] + "." + parts[1] + ".")
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, _, err := jwt.VerifyToken("not-a-token")
+		assert.ErrorIs(t, err, domain.ErrUnauthorized)
+	})
+
+	t.Run("without a session", func(t *testing.T) {
+		_, err := jwt.IssueToken("user-1", "", now.Add(time.Hour))
+		assert.Error(t, err)
+	})
+}
@@ -3,25 +3,159 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/netip"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/ardanlabs/conf/v3"
 	_ "github.com/joho/godotenv/autoload"
 )
 
 type Config struct {
+	// Environment picks the profile file loaded from CONFIG_DIR, one of Profiles
 	Environment    string `conf:"env:ENVIRONMENT,default:development"`
 	DatabaseEngine string `conf:"env:DATABASE_ENGINE,default:postgres"`
-	//AuthSecretKey  string `conf:"env:AUTH_SECRET_KEY,required"`
+	// Set each database connection to the user of the request it serves, so
+	// the row level security policies hide the rows of other users on top of
+	// the WHERE clauses of the queries
+	DatabaseRowLevelSecurity bool `conf:"env:DATABASE_ROW_LEVEL_SECURITY,default:false"`
+	// Key the user tokens are signed with, required by the service
+	AuthSecretKey string `conf:"env:AUTH_SECRET_KEY,mask"`
+	// How long the user sessions, and their tokens, last after signing in
+	AuthTokenTTL time.Duration `conf:"env:AUTH_TOKEN_TTL,default:24h"`
+	// Failed sign ins in a row of an email before it's locked out for
+	// AuthLockoutBackoff, doubled with each failure after up to
+	// AuthLockoutMaxBackoff
+	AuthLockoutThreshold  int           `conf:"env:AUTH_LOCKOUT_THRESHOLD,default:5"`
+	AuthLockoutBackoff    time.Duration `conf:"env:AUTH_LOCKOUT_BACKOFF,default:1m"`
+	AuthLockoutMaxBackoff time.Duration `conf:"env:AUTH_LOCKOUT_MAX_BACKOFF,default:1h"`
+	// siteverify endpoint and secret of the CAPTCHA asked for after repeated
+	// failed sign ins, none is asked for while the endpoint is empty
+	AuthCaptchaVerifyURL string `conf:"env:AUTH_CAPTCHA_VERIFY_URL"`
+	AuthCaptchaSecret    string `conf:"env:AUTH_CAPTCHA_SECRET,mask"`
+	// Comma separated emails of the operators of the deployment, the only
+	// users allowed to change what every user shares, like the settings and
+	// the assets, and to use the admin routes
+	AuthAdminEmails string `conf:"env:AUTH_ADMIN_EMAILS"`
+	// Comma separated addresses or CIDR ranges of the proxies in front of the
+	// service, such as the web app, whose X-Forwarded-For is believed. The
+	// loopback addresses are always trusted.
+	AuthTrustedProxies string `conf:"env:AUTH_TRUSTED_PROXIES"`
+	// Keys the account numbers are encrypted with, as id:base64 pairs with the
+	// current key first (see encryption.ParseKeys). They're stored in
+	// plaintext while it's empty.
+	EncryptionKeys string `conf:"env:ENCRYPTION_KEYS,mask"`
+	// Quotas of the users of the free tier, zero leaves them unlimited
+	QuotaMaxAccounts            int `conf:"env:QUOTA_MAX_ACCOUNTS,default:0"`
+	QuotaMaxMonthlyTransactions int `conf:"env:QUOTA_MAX_MONTHLY_TRANSACTIONS,default:0"`
+	QuotaMaxMonthlyAPICalls     int `conf:"env:QUOTA_MAX_MONTHLY_API_CALLS,default:0"`
+	// Megabytes the files attached to the transactions of a user may take
+	QuotaMaxAttachmentMB int `conf:"env:QUOTA_MAX_ATTACHMENT_MB,default:0"`
+
+	// Limits of each run of the transaction scripts of the users. The memory
+	// the scripts allocate is measured on the whole process, see
+	// scripting.Limits.
+	Scripts struct {
+		Timeout       time.Duration `conf:"env:SCRIPTS_TIMEOUT,default:100ms"`
+		MaxSteps      uint64        `conf:"env:SCRIPTS_MAX_STEPS,default:100000"`
+		MaxAllocBytes uint64        `conf:"env:SCRIPTS_MAX_ALLOC_BYTES,default:33554432"`
+	}
+
 	Service struct {
 		Address string `conf:"env:SERVICE_ADDRESS,default:0.0.0.0:3000"`
+		// Log the API request and response bodies, can be toggled at runtime
+		// through the admin API
+		DebugLogging bool `conf:"env:SERVICE_DEBUG_LOGGING,default:false"`
+		// Redact the descriptions from the logged bodies, on top of tokens,
+		// secrets and passwords
+		DebugLoggingRedactDescriptions bool `conf:"env:SERVICE_DEBUG_LOGGING_REDACT_DESCRIPTIONS,default:false"`
+		// Refuse writes with 503 while the database schema is behind the
+		// migrations shipped with the service
+		RequireCurrentSchema bool `conf:"env:SERVICE_REQUIRE_CURRENT_SCHEMA,default:true"`
+		// Serve the web frontend from the service too, calling the API in
+		// process instead of running cmd/web
+		Standalone bool `conf:"env:SERVICE_STANDALONE,default:false"`
+		// Serve the WebSocket API, its clients authenticate with their
+		// bearer token like the REST clients
+		WebSocketEnabled bool `conf:"env:SERVICE_WS_ENABLED,default:false"`
 	}
 	Web struct {
 		Address    string `conf:"env:WEB_ADDRESS,default:0.0.0.0:8080"`
 		ApiBaseURL string `conf:"env:API_BASE_URL,default:http://127.0.0.1:3000"`
+		// CAPTCHA widget shown on the login page when the API asks for one,
+		// one of CaptchaProviders, matching AUTH_CAPTCHA_VERIFY_URL
+		CaptchaProvider string `conf:"env:WEB_CAPTCHA_PROVIDER"`
+		CaptchaSiteKey  string `conf:"env:WEB_CAPTCHA_SITE_KEY"`
+	}
+	Worker struct {
+		BalanceRefreshEnabled bool `conf:"env:WORKER_BALANCE_REFRESH_ENABLED,default:true"`
+		// Cron expression of the full balance refresh, off-peak by default
+		BalanceRefreshSchedule string `conf:"env:WORKER_BALANCE_REFRESH_SCHEDULE,default:0 4 * * *"`
+
+		BackupEnabled bool `conf:"env:WORKER_BACKUP_ENABLED,default:false"`
+		// Cron expression of the full backup, before the balance refresh
+		BackupSchedule string `conf:"env:WORKER_BACKUP_SCHEDULE,default:30 3 * * *"`
+
+		ReportSnapshotEnabled bool `conf:"env:WORKER_REPORT_SNAPSHOT_ENABLED,default:true"`
+		// Cron expression of the monthly close, snapshotting the month that
+		// just ended, early on the first day of the month
+		ReportSnapshotSchedule string `conf:"env:WORKER_REPORT_SNAPSHOT_SCHEDULE,default:0 2 1 * *"`
+
+		BudgetAlertsEnabled bool `conf:"env:WORKER_BUDGET_ALERTS_ENABLED,default:true"`
+		// Cron expression of the budget alert evaluation, catching the
+		// changes made outside the API, hourly by default
+		BudgetAlertsSchedule string `conf:"env:WORKER_BUDGET_ALERTS_SCHEDULE,default:0 * * * *"`
 	}
+	Backup struct {
+		// Where the JSON backups are written to, one of BackupStores: Dir on
+		// the local disk or the bucket of the ATTACHMENTS_S3_* variables
+		Store string `conf:"env:BACKUP_STORE,default:local"`
+		Dir   string `conf:"env:BACKUP_DIR,default:backups"`
+		// Keep the newest backup of each of the last KeepDaily days and of
+		// each of the last KeepWeekly weeks
+		KeepDaily  int `conf:"env:BACKUP_KEEP_DAILY,default:7"`
+		KeepWeekly int `conf:"env:BACKUP_KEEP_WEEKLY,default:4"`
+	}
+	// Where the files attached to the transactions are kept, one of
+	// AttachmentStores: a directory of the local disk or a bucket of S3 or of
+	// a service speaking its API, like MinIO or Cloudflare R2
+	Attachments struct {
+		Store string `conf:"env:ATTACHMENTS_STORE,default:local"`
+		Dir   string `conf:"env:ATTACHMENTS_DIR,default:attachments"`
+		// Base URL of the service, the bucket goes in the path
+		S3Endpoint        string `conf:"env:ATTACHMENTS_S3_ENDPOINT"`
+		S3Bucket          string `conf:"env:ATTACHMENTS_S3_BUCKET"`
+		S3Region          string `conf:"env:ATTACHMENTS_S3_REGION,default:us-east-1"`
+		S3AccessKeyID     string `conf:"env:ATTACHMENTS_S3_ACCESS_KEY_ID"`
+		S3SecretAccessKey string `conf:"env:ATTACHMENTS_S3_SECRET_ACCESS_KEY,mask"`
+	}
+
+	// Where the values came from, for the validation report
+	profileFile string
+	secretFiles []string
 }
 
+// Load reads the configuration from the environment, after filling in the
+// variables that aren't set from the profile file of the environment and the
+// secrets from their _FILE variables. The process environment wins over .env,
+// which wins over the profile file, which wins over the defaults.
 func (c *Config) Load(prefix string) error {
+	environment := os.Getenv("ENVIRONMENT")
+	if environment == "" {
+		environment = DefaultProfile
+	}
+
+	profileFile, err := loadProfile(profileDir(), environment)
+	if err != nil {
+		return err
+	}
+
+	secretFiles, err := loadSecretFiles(SecretVariables)
+	if err != nil {
+		return err
+	}
+
 	if help, err := conf.Parse(prefix, c); err != nil {
 		if errors.Is(err, conf.ErrHelpWanted) {
 			fmt.Println(help)
@@ -29,5 +163,30 @@ func (c *Config) Load(prefix string) error {
 		}
 		return err
 	}
+
+	c.profileFile = profileFile
+	c.secretFiles = secretFiles
 	return nil
 }
+
+// ParseTrustedProxies parses the comma separated addresses and CIDR ranges of
+// AUTH_TRUSTED_PROXIES
+func ParseTrustedProxies(value string) ([]netip.Prefix, error) {
+	var proxies []netip.Prefix
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if addr, err := netip.ParseAddr(entry); err == nil {
+			proxies = append(proxies, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address or CIDR range %q", entry)
+		}
+		proxies = append(proxies, prefix.Masked())
+	}
+	return proxies, nil
+}
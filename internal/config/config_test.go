@@ -0,0 +1,208 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unsetEnv unsets the variables for the test, restoring them afterwards
+func unsetEnv(t *testing.T, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		t.Setenv(name, "")
+		require.NoError(t, os.Unsetenv(name))
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestLoadProfile(t *testing.T) {
+	t.Run("fills in the unset variables", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "staging.env"), "CONFIG_TEST_A=profile\nCONFIG_TEST_B=profile\n")
+		unsetEnv(t, "CONFIG_TEST_A")
+		t.Setenv("CONFIG_TEST_B", "environment")
+
+		path, err := loadProfile(dir, "staging")
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, "staging.env"), path)
+		assert.Equal(t, "profile", os.Getenv("CONFIG_TEST_A"))
+		assert.Equal(t, "environment", os.Getenv("CONFIG_TEST_B"), "the environment wins over the profile")
+	})
+
+	t.Run("missing profile file", func(t *testing.T) {
+		path, err := loadProfile(t.TempDir(), "production")
+		require.NoError(t, err)
+		assert.Empty(t, path)
+	})
+
+	t.Run("unknown environment", func(t *testing.T) {
+		path, err := loadProfile(t.TempDir(), "qa")
+		require.NoError(t, err)
+		assert.Empty(t, path)
+	})
+
+	t.Run("refuses secrets", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "production.env"), "DATABASE_PASSWORD=hunter2\n")
+
+		_, err := loadProfile(dir, "production")
+		assert.ErrorContains(t, err, "sets the secret DATABASE_PASSWORD")
+	})
+}
+
+func TestLoadSecretFiles(t *testing.T) {
+	t.Run("reads the file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "password")
+		writeFile(t, path, "s3cret\n")
+		unsetEnv(t, "CONFIG_TEST_SECRET")
+		t.Setenv("CONFIG_TEST_SECRET_FILE", path)
+
+		loaded, err := loadSecretFiles([]string{"CONFIG_TEST_SECRET", "CONFIG_TEST_OTHER"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"CONFIG_TEST_SECRET"}, loaded)
+		assert.Equal(t, "s3cret", os.Getenv("CONFIG_TEST_SECRET"))
+	})
+
+	t.Run("refuses both the variable and the file", func(t *testing.T) {
+		t.Setenv("CONFIG_TEST_SECRET", "s3cret")
+		t.Setenv("CONFIG_TEST_SECRET_FILE", "/run/secrets/secret")
+
+		_, err := loadSecretFiles([]string{"CONFIG_TEST_SECRET"})
+		assert.ErrorContains(t, err, "both CONFIG_TEST_SECRET and CONFIG_TEST_SECRET_FILE")
+	})
+
+	t.Run("refuses empty files", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "password")
+		writeFile(t, path, "\n")
+		unsetEnv(t, "CONFIG_TEST_SECRET")
+		t.Setenv("CONFIG_TEST_SECRET_FILE", path)
+
+		_, err := loadSecretFiles([]string{"CONFIG_TEST_SECRET"})
+		assert.ErrorContains(t, err, "is empty")
+	})
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() Config {
+		var cfg Config
+		cfg.Environment = "development"
+		cfg.DatabaseEngine = "postgres"
+		cfg.AuthTokenTTL = 24 * time.Hour
+		cfg.AuthLockoutThreshold = 5
+		cfg.AuthLockoutBackoff = time.Minute
+		cfg.AuthLockoutMaxBackoff = time.Hour
+		cfg.Service.Address = "0.0.0.0:3000"
+		cfg.Service.RequireCurrentSchema = true
+		cfg.Web.Address = "0.0.0.0:8080"
+		cfg.Web.ApiBaseURL = "http://127.0.0.1:3000"
+		cfg.Worker.BalanceRefreshEnabled = true
+		cfg.Worker.BalanceRefreshSchedule = "0 4 * * *"
+		cfg.Backup.Store = "local"
+		cfg.Backup.Dir = "backups"
+		cfg.Backup.KeepDaily = 7
+		cfg.Backup.KeepWeekly = 4
+		cfg.Attachments.Store = "local"
+		cfg.Attachments.Dir = "attachments"
+		cfg.Scripts.Timeout = 100 * time.Millisecond
+		cfg.Scripts.MaxSteps = 100000
+		cfg.Scripts.MaxAllocBytes = 32 << 20
+		return cfg
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		cfg := valid()
+		report := cfg.Validate()
+		assert.Empty(t, report.Problems)
+		assert.NoError(t, report.Err())
+	})
+
+	t.Run("missing and invalid values", func(t *testing.T) {
+		unsetEnv(t, "CONFIG_TEST_REQUIRED")
+		cfg := valid()
+		cfg.Environment = "qa"
+		cfg.Service.Address = "3000"
+		cfg.Web.ApiBaseURL = "127.0.0.1:3000"
+		cfg.Backup.KeepDaily = 0
+		cfg.AuthSecretKey = "app2025"
+		cfg.AuthLockoutMaxBackoff = time.Second
+		cfg.AuthCaptchaVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+		cfg.AuthTrustedProxies = "10.0.0.0/8, web"
+		cfg.Web.CaptchaSiteKey = "site-key"
+		cfg.Web.CaptchaProvider = "friendly"
+		cfg.EncryptionKeys = "k1:c2hvcnQ="
+		cfg.QuotaMaxMonthlyTransactions = -1
+		cfg.QuotaMaxMonthlyAPICalls = -1
+		cfg.QuotaMaxAttachmentMB = -1
+		cfg.Scripts.Timeout = 0
+
+		report := cfg.Validate("CONFIG_TEST_REQUIRED")
+		var variables []string
+		for _, problem := range report.Problems {
+			assert.Equal(t, SeverityError, problem.Severity)
+			variables = append(variables, problem.Variable)
+		}
+		assert.Equal(t, []string{"CONFIG_TEST_REQUIRED", "ENVIRONMENT", "SERVICE_ADDRESS", "API_BASE_URL", "AUTH_SECRET_KEY", "AUTH_LOCKOUT_MAX_BACKOFF", "AUTH_CAPTCHA_SECRET", "AUTH_TRUSTED_PROXIES", "ENCRYPTION_KEYS", "QUOTA_MAX_MONTHLY_TRANSACTIONS", "QUOTA_MAX_MONTHLY_API_CALLS", "QUOTA_MAX_ATTACHMENT_MB", "WEB_CAPTCHA_PROVIDER", "SCRIPTS_TIMEOUT", "BACKUP_KEEP_DAILY"}, variables)
+		assert.ErrorContains(t, report.Err(), "CONFIG_TEST_REQUIRED: missing")
+	})
+
+	t.Run("attachments in s3", func(t *testing.T) {
+		cfg := valid()
+		cfg.Attachments.Store = "s3"
+		cfg.Attachments.S3Endpoint = "https://s3.us-east-1.amazonaws.com"
+		cfg.Attachments.S3Bucket = "receipts"
+		cfg.Attachments.S3Region = "us-east-1"
+		cfg.Attachments.S3AccessKeyID = "key-id"
+
+		var variables []string
+		for _, problem := range cfg.Validate().Problems {
+			variables = append(variables, problem.Variable)
+		}
+		assert.Equal(t, []string{"ATTACHMENTS_S3_SECRET_ACCESS_KEY"}, variables)
+
+		cfg.Attachments.Store = "ftp"
+		assert.ErrorContains(t, cfg.Validate().Err(), "ATTACHMENTS_STORE: unknown store \"ftp\"")
+	})
+
+	t.Run("backups in s3", func(t *testing.T) {
+		cfg := valid()
+		cfg.Backup.Store = "s3"
+		assert.ErrorContains(t, cfg.Validate().Err(), "BACKUP_STORE: s3 uses the bucket of the attachments, while ATTACHMENTS_STORE is \"local\"")
+
+		cfg.Attachments.Store = "s3"
+		cfg.Attachments.S3Endpoint = "https://s3.us-east-1.amazonaws.com"
+		cfg.Attachments.S3Bucket = "receipts"
+		cfg.Attachments.S3Region = "us-east-1"
+		cfg.Attachments.S3AccessKeyID = "key-id"
+		cfg.Attachments.S3SecretAccessKey = "secret"
+		cfg.Backup.Dir = ""
+		assert.NoError(t, cfg.Validate().Err())
+
+		cfg.Backup.Store = "tape"
+		assert.ErrorContains(t, cfg.Validate().Err(), "BACKUP_STORE: unknown store \"tape\"")
+	})
+
+	t.Run("production warnings", func(t *testing.T) {
+		t.Setenv("DATABASE_SSLMODE", "disable")
+		cfg := valid()
+		cfg.Environment = "production"
+		cfg.Service.DebugLogging = true
+
+		report := cfg.Validate()
+		var variables []string
+		for _, problem := range report.Problems {
+			assert.Equal(t, SeverityWarning, problem.Severity)
+			variables = append(variables, problem.Variable)
+		}
+		assert.Equal(t, []string{"SERVICE_DEBUG_LOGGING", "WORKER_BACKUP_ENABLED", "DATABASE_SSLMODE", "ENCRYPTION_KEYS"}, variables)
+		assert.NoError(t, report.Err(), "warnings don't keep the binaries from starting")
+	})
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/joho/godotenv"
+)
+
+// Profiles are the environments with a profile file in CONFIG_DIR
+var Profiles = []string{"development", "staging", "production"}
+
+const DefaultProfile = "development"
+
+// SecretVariables can't be set by the profile files. Each can be read from the
+// file named by its _FILE variable instead of the environment (e.g.
+// DATABASE_PASSWORD_FILE=/run/secrets/database_password).
+var SecretVariables = []string{"DATABASE_PASSWORD", "AUTH_SECRET_KEY", "AUTH_CAPTCHA_SECRET", "ENCRYPTION_KEYS", "ATTACHMENTS_S3_SECRET_ACCESS_KEY"}
+
+// DatabaseVariables must be set for the binaries that connect to postgres
+var DatabaseVariables = []string{"DATABASE_NAME", "DATABASE_USER", "DATABASE_PASSWORD"}
+
+// AuthVariables must be set for the binaries serving the API
+var AuthVariables = []string{"AUTH_SECRET_KEY"}
+
+func profileDir() string {
+	if dir := os.Getenv("CONFIG_DIR"); dir != "" {
+		return dir
+	}
+	return "configs"
+}
+
+// loadProfile sets the variables of the environment's profile file that
+// aren't set yet, returning the file or empty when there is none
+func loadProfile(dir, environment string) (string, error) {
+	if !slices.Contains(Profiles, environment) {
+		// Reported by Validate, there is no file to load
+		return "", nil
+	}
+
+	path := filepath.Join(dir, environment+".env")
+	values, err := godotenv.Read(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("reading profile %s: %w", path, err)
+	}
+
+	for name := range values {
+		if slices.Contains(SecretVariables, name) {
+			return "", fmt.Errorf("profile %s sets the secret %s, set it in the environment or through %s_FILE instead", path, name, name)
+		}
+	}
+
+	for name, value := range values {
+		if _, ok := os.LookupEnv(name); ok {
+			continue
+		}
+		if err := os.Setenv(name, value); err != nil {
+			return "", err
+		}
+	}
+	return path, nil
+}
+
+// loadSecretFiles sets each variable with a _FILE variable to the contents of
+// that file, returning the variables read from files
+func loadSecretFiles(names []string) ([]string, error) {
+	var loaded []string
+	for _, name := range names {
+		path := os.Getenv(name + "_FILE")
+		if path == "" {
+			continue
+		}
+		if _, ok := os.LookupEnv(name); ok {
+			return nil, fmt.Errorf("both %s and %s_FILE are set, set only one", name, name)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s_FILE: %w", name, err)
+		}
+		// Files written by editors and secret stores usually end with a newline
+		value := strings.TrimRight(string(data), "\r\n")
+		if value == "" {
+			return nil, fmt.Errorf("%s_FILE %s is empty", name, path)
+		}
+
+		if err := os.Setenv(name, value); err != nil {
+			return nil, err
+		}
+		loaded = append(loaded, name)
+	}
+	return loaded, nil
+}
@@ -0,0 +1,271 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"finance/internal/encryption"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type Severity string
+
+const (
+	// SeverityError values keep the binaries from starting
+	SeverityError Severity = "error"
+	// SeverityWarning values work but are likely a mistake for the environment
+	SeverityWarning Severity = "warning"
+)
+
+// CaptchaProviders are the CAPTCHA widgets the web frontend can show
+var CaptchaProviders = []string{"turnstile", "hcaptcha", "recaptcha"}
+
+// AttachmentStores are where the files attached to the transactions can be
+// kept
+var AttachmentStores = []string{"local", "s3"}
+
+// BackupStores are where the backups can be kept
+var BackupStores = []string{"local", "s3"}
+
+// minAuthSecretKeyLength keeps the user tokens from being forged by guessing
+// the key they're signed with
+const minAuthSecretKeyLength = 32
+
+// Problem is a missing or invalid configuration value
+type Problem struct {
+	Variable string
+	Severity Severity
+	Message  string
+}
+
+func (p Problem) String() string {
+	return p.Variable + ": " + p.Message
+}
+
+// Report lists where the configuration came from and its problems
+type Report struct {
+	Environment string
+	// ProfileFile is empty when the environment has no profile file
+	ProfileFile string
+	// SecretFiles are the variables read from their _FILE variable
+	SecretFiles []string
+	Problems    []Problem
+}
+
+// Err joins the problems that keep the binaries from starting
+func (r Report) Err() error {
+	var errs []error
+	for _, problem := range r.Problems {
+		if problem.Severity == SeverityError {
+			errs = append(errs, errors.New(problem.String()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Log writes the report, one entry per problem
+func (r Report) Log(log *slog.Logger) {
+	log.Info("configuration loaded",
+		slog.String("environment", r.Environment),
+		slog.String("profile_file", r.ProfileFile),
+		slog.String("secret_files", strings.Join(r.SecretFiles, ",")),
+		slog.Int("problems", len(r.Problems)),
+	)
+
+	for _, problem := range r.Problems {
+		level := slog.LevelWarn
+		if problem.Severity == SeverityError {
+			level = slog.LevelError
+		}
+		log.Log(context.Background(), level, "configuration problem",
+			slog.String("variable", problem.Variable),
+			slog.String("error", problem.Message),
+		)
+	}
+}
+
+// Validate checks the loaded values and that the required variables, which
+// are read by other packages, are set
+func (c *Config) Validate(required ...string) Report {
+	report := Report{
+		Environment: c.Environment,
+		ProfileFile: c.profileFile,
+		SecretFiles: c.secretFiles,
+	}
+	problem := func(variable string, severity Severity, format string, args ...any) {
+		report.Problems = append(report.Problems, Problem{
+			Variable: variable,
+			Severity: severity,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	for _, name := range required {
+		if os.Getenv(name) == "" {
+			problem(name, SeverityError, "missing")
+		}
+	}
+
+	if !slices.Contains(Profiles, c.Environment) {
+		problem("ENVIRONMENT", SeverityError, "unknown environment %q, expected one of %s", c.Environment, strings.Join(Profiles, ", "))
+	}
+	if c.DatabaseEngine != "postgres" {
+		problem("DATABASE_ENGINE", SeverityError, "unsupported engine %q, expected postgres", c.DatabaseEngine)
+	}
+
+	if err := validateAddress(c.Service.Address); err != nil {
+		problem("SERVICE_ADDRESS", SeverityError, "%v", err)
+	}
+	if err := validateAddress(c.Web.Address); err != nil {
+		problem("WEB_ADDRESS", SeverityError, "%v", err)
+	}
+	if u, err := url.Parse(c.Web.ApiBaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		problem("API_BASE_URL", SeverityError, "invalid URL %q, expected an absolute http or https URL", c.Web.ApiBaseURL)
+	}
+
+	if c.AuthSecretKey != "" && len(c.AuthSecretKey) < minAuthSecretKeyLength {
+		problem("AUTH_SECRET_KEY", SeverityError, "must be at least %d characters long", minAuthSecretKeyLength)
+	}
+	if c.AuthTokenTTL <= 0 {
+		problem("AUTH_TOKEN_TTL", SeverityError, "must be positive, got %s", c.AuthTokenTTL)
+	}
+	if c.AuthLockoutThreshold < 1 {
+		problem("AUTH_LOCKOUT_THRESHOLD", SeverityError, "must be at least 1, got %d", c.AuthLockoutThreshold)
+	}
+	if c.AuthLockoutBackoff <= 0 {
+		problem("AUTH_LOCKOUT_BACKOFF", SeverityError, "must be positive, got %s", c.AuthLockoutBackoff)
+	}
+	if c.AuthLockoutMaxBackoff < c.AuthLockoutBackoff {
+		problem("AUTH_LOCKOUT_MAX_BACKOFF", SeverityError, "must be at least AUTH_LOCKOUT_BACKOFF (%s), got %s", c.AuthLockoutBackoff, c.AuthLockoutMaxBackoff)
+	}
+	if c.AuthCaptchaVerifyURL != "" {
+		if u, err := url.Parse(c.AuthCaptchaVerifyURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			problem("AUTH_CAPTCHA_VERIFY_URL", SeverityError, "invalid URL %q, expected an absolute http or https URL", c.AuthCaptchaVerifyURL)
+		}
+		if c.AuthCaptchaSecret == "" {
+			problem("AUTH_CAPTCHA_SECRET", SeverityError, "missing while AUTH_CAPTCHA_VERIFY_URL is set")
+		}
+		if c.Web.CaptchaSiteKey == "" {
+			problem("WEB_CAPTCHA_SITE_KEY", SeverityWarning, "missing while AUTH_CAPTCHA_VERIFY_URL is set, the web frontend can't show the CAPTCHA")
+		}
+	}
+	if _, err := ParseTrustedProxies(c.AuthTrustedProxies); err != nil {
+		problem("AUTH_TRUSTED_PROXIES", SeverityError, "%v", err)
+	}
+	if c.EncryptionKeys != "" {
+		if _, err := encryption.ParseKeys(c.EncryptionKeys); err != nil {
+			problem("ENCRYPTION_KEYS", SeverityError, "%v", err)
+		}
+	}
+	if c.QuotaMaxAccounts < 0 {
+		problem("QUOTA_MAX_ACCOUNTS", SeverityError, "can't be negative, got %d", c.QuotaMaxAccounts)
+	}
+	if c.QuotaMaxMonthlyTransactions < 0 {
+		problem("QUOTA_MAX_MONTHLY_TRANSACTIONS", SeverityError, "can't be negative, got %d", c.QuotaMaxMonthlyTransactions)
+	}
+	if c.QuotaMaxMonthlyAPICalls < 0 {
+		problem("QUOTA_MAX_MONTHLY_API_CALLS", SeverityError, "can't be negative, got %d", c.QuotaMaxMonthlyAPICalls)
+	}
+	if c.QuotaMaxAttachmentMB < 0 {
+		problem("QUOTA_MAX_ATTACHMENT_MB", SeverityError, "can't be negative, got %d", c.QuotaMaxAttachmentMB)
+	}
+	if c.Web.CaptchaSiteKey != "" && !slices.Contains(CaptchaProviders, c.Web.CaptchaProvider) {
+		problem("WEB_CAPTCHA_PROVIDER", SeverityError, "unknown provider %q, expected one of %s", c.Web.CaptchaProvider, strings.Join(CaptchaProviders, ", "))
+	}
+	if c.Scripts.Timeout <= 0 {
+		problem("SCRIPTS_TIMEOUT", SeverityError, "must be positive, got %s", c.Scripts.Timeout)
+	}
+	if c.Scripts.MaxSteps == 0 {
+		problem("SCRIPTS_MAX_STEPS", SeverityError, "must be positive")
+	}
+
+	if c.Worker.BalanceRefreshEnabled && strings.TrimSpace(c.Worker.BalanceRefreshSchedule) == "" {
+		problem("WORKER_BALANCE_REFRESH_SCHEDULE", SeverityError, "missing while the balance refresh is enabled")
+	}
+	if c.Worker.BackupEnabled && strings.TrimSpace(c.Worker.BackupSchedule) == "" {
+		problem("WORKER_BACKUP_SCHEDULE", SeverityError, "missing while backups are enabled")
+	}
+	if c.Worker.ReportSnapshotEnabled && strings.TrimSpace(c.Worker.ReportSnapshotSchedule) == "" {
+		problem("WORKER_REPORT_SNAPSHOT_SCHEDULE", SeverityError, "missing while report snapshots are enabled")
+	}
+	if c.Worker.BudgetAlertsEnabled && strings.TrimSpace(c.Worker.BudgetAlertsSchedule) == "" {
+		problem("WORKER_BUDGET_ALERTS_SCHEDULE", SeverityError, "missing while budget alerts are enabled")
+	}
+	switch c.Backup.Store {
+	case "local":
+		if c.Backup.Dir == "" {
+			problem("BACKUP_DIR", SeverityError, "missing while BACKUP_STORE is local")
+		}
+	case "s3":
+		if c.Attachments.Store != "s3" {
+			problem("BACKUP_STORE", SeverityError, "s3 uses the bucket of the attachments, while ATTACHMENTS_STORE is %q", c.Attachments.Store)
+		}
+	default:
+		problem("BACKUP_STORE", SeverityError, "unknown store %q, expected one of %s", c.Backup.Store, strings.Join(BackupStores, ", "))
+	}
+	if c.Backup.KeepDaily < 1 {
+		problem("BACKUP_KEEP_DAILY", SeverityError, "must keep at least one daily backup, got %d", c.Backup.KeepDaily)
+	}
+	if c.Backup.KeepWeekly < 0 {
+		problem("BACKUP_KEEP_WEEKLY", SeverityError, "can't be negative, got %d", c.Backup.KeepWeekly)
+	}
+	switch c.Attachments.Store {
+	case "local":
+		if c.Attachments.Dir == "" {
+			problem("ATTACHMENTS_DIR", SeverityError, "missing while ATTACHMENTS_STORE is local")
+		}
+	case "s3":
+		if u, err := url.Parse(c.Attachments.S3Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			problem("ATTACHMENTS_S3_ENDPOINT", SeverityError, "invalid URL %q, expected an absolute http or https URL", c.Attachments.S3Endpoint)
+		}
+		for _, required := range []struct{ variable, value string }{
+			{"ATTACHMENTS_S3_BUCKET", c.Attachments.S3Bucket},
+			{"ATTACHMENTS_S3_REGION", c.Attachments.S3Region},
+			{"ATTACHMENTS_S3_ACCESS_KEY_ID", c.Attachments.S3AccessKeyID},
+			{"ATTACHMENTS_S3_SECRET_ACCESS_KEY", c.Attachments.S3SecretAccessKey},
+		} {
+			if required.value == "" {
+				problem(required.variable, SeverityError, "missing while ATTACHMENTS_STORE is s3")
+			}
+		}
+	default:
+		problem("ATTACHMENTS_STORE", SeverityError, "unknown store %q, expected one of %s", c.Attachments.Store, strings.Join(AttachmentStores, ", "))
+	}
+
+	if c.Environment == "production" {
+		if c.Service.DebugLogging {
+			problem("SERVICE_DEBUG_LOGGING", SeverityWarning, "request and response bodies are logged in production")
+		}
+		if !c.Service.RequireCurrentSchema {
+			problem("SERVICE_REQUIRE_CURRENT_SCHEMA", SeverityWarning, "writes are accepted while the schema is behind in production")
+		}
+		if !c.Worker.BackupEnabled {
+			problem("WORKER_BACKUP_ENABLED", SeverityWarning, "no backups are taken in production")
+		}
+		if os.Getenv("DATABASE_SSLMODE") == "disable" {
+			problem("DATABASE_SSLMODE", SeverityWarning, "the database connection isn't encrypted in production")
+		}
+		if c.EncryptionKeys == "" {
+			problem("ENCRYPTION_KEYS", SeverityWarning, "the account numbers are stored in plaintext in production")
+		}
+	}
+
+	return report
+}
+
+func validateAddress(address string) error {
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid address %q, expected host:port", address)
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 0 || n > 65535 {
+		return fmt.Errorf("invalid port in address %q", address)
+	}
+	return nil
+}
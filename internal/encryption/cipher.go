@@ -0,0 +1,124 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// prefix marks the encrypted values, followed by the ID of their key and the
+// nonce and ciphertext in base64 (e.g. "enc:v1:2025b:3q2+7w..."). Values
+// without it were stored before encryption was turned on.
+const prefix = "enc:v1:"
+
+// Cipher encrypts values with AES-GCM under the current key of its provider.
+// Without a provider it stores the values as they are, and refuses to read
+// the encrypted ones.
+type Cipher struct {
+	keys KeyProvider
+}
+
+func NewCipher(keys KeyProvider) *Cipher {
+	return &Cipher{
+		keys: keys,
+	}
+}
+
+// Encrypt encrypts a value with the current key. Empty values are kept empty,
+// there's nothing to hide in them.
+func (c *Cipher) Encrypt(ctx context.Context, plaintext string) (string, error) {
+	if plaintext == "" || c.keys == nil {
+		return plaintext, nil
+	}
+
+	key, err := c.keys.CurrentKey(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get encryption key: %w", err)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(key.ID))
+	return prefix + key.ID + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value with the key it was encrypted with. Values stored
+// before encryption was turned on are returned as they are.
+func (c *Cipher) Decrypt(ctx context.Context, value string) (string, error) {
+	keyID, encoded, ok := parse(value)
+	if !ok {
+		return value, nil
+	}
+	if c.keys == nil {
+		return "", errors.New("value is encrypted but no encryption keys are configured")
+	}
+
+	key, err := c.keys.Key(ctx, keyID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get encryption key: %w", err)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", errors.New("malformed encrypted value")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(key.ID))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value with key %s: %w", key.ID, err)
+	}
+	return string(plaintext), nil
+}
+
+// Current tells whether a value is stored as Encrypt would store it now: empty,
+// or encrypted with the current key. The others are due for rotation.
+func (c *Cipher) Current(ctx context.Context, value string) (bool, error) {
+	if value == "" {
+		return true, nil
+	}
+	keyID, _, encrypted := parse(value)
+	if c.keys == nil {
+		return !encrypted, nil
+	}
+	if !encrypted {
+		return false, nil
+	}
+
+	key, err := c.keys.CurrentKey(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get encryption key: %w", err)
+	}
+	return keyID == key.ID, nil
+}
+
+// parse splits an encrypted value into its key ID and sealed data
+func parse(value string) (keyID, encoded string, ok bool) {
+	rest, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		return "", "", false
+	}
+	return strings.Cut(rest, ":")
+}
+
+func newAEAD(key Key) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key %s: %w", key.ID, err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,131 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testKeys parses the keys with the given IDs, each derived from its ID so
+// the same ID always gets the same key
+func testKeys(t *testing.T, ids ...string) *StaticKeys {
+	t.Helper()
+	var entries []string
+	for _, id := range ids {
+		secret := strings.Repeat(id, keySize)[:keySize]
+		entries = append(entries, id+":"+base64.StdEncoding.EncodeToString([]byte(secret)))
+	}
+	keys, err := ParseKeys(strings.Join(entries, ","))
+	require.NoError(t, err)
+	return keys
+}
+
+func TestCipher(t *testing.T) {
+	ctx := context.Background()
+	cipher := NewCipher(testKeys(t, "k2", "k1"))
+
+	t.Run("round trip", func(t *testing.T) {
+		encrypted, err := cipher.Encrypt(ctx, "1234")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(encrypted, "enc:v1:k2:"))
+		assert.NotContains(t, encrypted, "1234")
+
+		again, err := cipher.Encrypt(ctx, "1234")
+		require.NoError(t, err)
+		assert.NotEqual(t, encrypted, again, "each value gets its own nonce")
+
+		decrypted, err := cipher.Decrypt(ctx, encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, "1234", decrypted)
+	})
+
+	t.Run("keeps empty values", func(t *testing.T) {
+		encrypted, err := cipher.Encrypt(ctx, "")
+		require.NoError(t, err)
+		assert.Empty(t, encrypted)
+	})
+
+	t.Run("reads plaintext stored before encryption", func(t *testing.T) {
+		decrypted, err := cipher.Decrypt(ctx, "5678")
+		require.NoError(t, err)
+		assert.Equal(t, "5678", decrypted)
+	})
+
+	t.Run("reads values of retired keys", func(t *testing.T) {
+		old, err := NewCipher(testKeys(t, "k1")).Encrypt(ctx, "4321")
+		require.NoError(t, err)
+
+		decrypted, err := cipher.Decrypt(ctx, old)
+		require.NoError(t, err)
+		assert.Equal(t, "4321", decrypted)
+	})
+
+	t.Run("tells the values due for rotation", func(t *testing.T) {
+		current, err := cipher.Encrypt(ctx, "1234")
+		require.NoError(t, err)
+		old, err := NewCipher(testKeys(t, "k1")).Encrypt(ctx, "1234")
+		require.NoError(t, err)
+
+		for value, want := range map[string]bool{"": true, current: true, old: false, "1234": false} {
+			got, err := cipher.Current(ctx, value)
+			require.NoError(t, err)
+			assert.Equal(t, want, got, value)
+		}
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		encrypted, err := NewCipher(testKeys(t, "k3")).Encrypt(ctx, "1234")
+		require.NoError(t, err)
+
+		_, err = cipher.Decrypt(ctx, encrypted)
+		assert.ErrorIs(t, err, ErrUnknownKey)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		// Same ID, different secret
+		encrypted, err := NewCipher(testKeys(t, "x", "k2")).Encrypt(ctx, "1234")
+		require.NoError(t, err)
+		encrypted = strings.Replace(encrypted, "enc:v1:x:", "enc:v1:k2:", 1)
+
+		_, err = cipher.Decrypt(ctx, encrypted)
+		assert.Error(t, err)
+	})
+
+	t.Run("without keys", func(t *testing.T) {
+		plain := NewCipher(nil)
+		stored, err := plain.Encrypt(ctx, "1234")
+		require.NoError(t, err)
+		assert.Equal(t, "1234", stored)
+
+		encrypted, err := cipher.Encrypt(ctx, "1234")
+		require.NoError(t, err)
+		_, err = plain.Decrypt(ctx, encrypted)
+		assert.Error(t, err)
+	})
+}
+
+func TestParseKeys(t *testing.T) {
+	valid := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("a", keySize)))
+
+	keys, err := ParseKeys(" k2:" + valid + " , k1:" + valid)
+	require.NoError(t, err)
+	current, err := keys.CurrentKey(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "k2", current.ID)
+
+	for _, spec := range []string{
+		"",
+		valid,
+		":" + valid,
+		"k1:not base64",
+		"k1:" + base64.StdEncoding.EncodeToString([]byte("short")),
+		"k1:" + valid + ",k1:" + valid,
+	} {
+		_, err := ParseKeys(spec)
+		assert.Error(t, err, spec)
+	}
+}
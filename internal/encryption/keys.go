@@ -0,0 +1,88 @@
+// Package encryption encrypts the sensitive columns, like the account numbers,
+// before they're stored, so a leaked database or backup of it doesn't expose
+// them
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// keySize is the size of the AES-256 keys
+const keySize = 32
+
+// ErrUnknownKey is returned for a key ID the provider doesn't have, like the
+// one of a key retired before the values encrypted with it were rotated
+var ErrUnknownKey = errors.New("unknown encryption key")
+
+// Key is a data key, named by its ID so each value records the key it was
+// encrypted with
+type Key struct {
+	ID     string
+	Secret []byte
+}
+
+// KeyProvider hands out the data keys, from the configuration or a key
+// management service
+type KeyProvider interface {
+	// CurrentKey returns the key new values are encrypted with
+	CurrentKey(ctx context.Context) (Key, error)
+	// Key returns a key by its ID, current or retired, or ErrUnknownKey
+	Key(ctx context.Context, id string) (Key, error)
+}
+
+// StaticKeys are keys read from the configuration. The first is current, the
+// others are retired and only kept to decrypt the values not rotated yet.
+type StaticKeys struct {
+	keys []Key
+}
+
+// ParseKeys reads a comma separated list of id:key pairs, the keys 32 bytes
+// encoded in base64 (e.g. "2025b:q83v...,2025a:Zm9v..."), the current one
+// first
+func ParseKeys(spec string) (*StaticKeys, error) {
+	var keys []Key
+	seen := map[string]bool{}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, encoded, ok := strings.Cut(entry, ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid key %q, expected id:base64", entry)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("repeated key ID %q", id)
+		}
+		secret, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key %s isn't valid base64: %w", id, err)
+		}
+		if len(secret) != keySize {
+			return nil, fmt.Errorf("key %s is %d bytes long, expected %d", id, len(secret), keySize)
+		}
+		seen[id] = true
+		keys = append(keys, Key{ID: id, Secret: secret})
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no keys")
+	}
+	return &StaticKeys{keys: keys}, nil
+}
+
+func (s *StaticKeys) CurrentKey(ctx context.Context) (Key, error) {
+	return s.keys[0], nil
+}
+
+func (s *StaticKeys) Key(ctx context.Context, id string) (Key, error) {
+	for _, key := range s.keys {
+		if key.ID == id {
+			return key, nil
+		}
+	}
+	return Key{}, fmt.Errorf("%w %s", ErrUnknownKey, id)
+}
@@ -0,0 +1,127 @@
+// Package exporters renders the expense reports to the files they are handed
+// over as, CSV for spreadsheets and PDF to attach to a reimbursement request,
+// and the transactions to CSV and Excel files to work on in a spreadsheet.
+package exporters
+
+import (
+	"bytes"
+	"encoding/csv"
+	"finance/domain/entities"
+	"fmt"
+	"io"
+	"iter"
+	"math/big"
+	"strings"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// ExpenseReportCSV exports an expense report as a CSV file, a row per
+// transaction followed by a total row per currency
+type ExpenseReportCSV struct{}
+
+func NewExpenseReportCSV() ExpenseReportCSV {
+	return ExpenseReportCSV{}
+}
+
+func (ExpenseReportCSV) ExportExpenseReport(report entities.ExpenseReport) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	rows := [][]string{{"Date", "Description", "Payee", "Category", "Account", "Amount", "Currency", "Status"}}
+	for _, transaction := range report.Transactions {
+		rows = append(rows, []string{
+			transaction.Date.Format("2006-01-02"),
+			transaction.Description,
+			transaction.Payee,
+			categoryName(transaction),
+			accountName(transaction),
+			decimal(size(transaction.Monetary)),
+			transaction.Monetary.Asset.Asset,
+			string(transaction.Status),
+		})
+	}
+	for _, total := range report.Totals {
+		rows = append(rows, []string{"", "Total", "", "", "", decimal(total), total.Asset.Asset, ""})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// transactionColumns are the columns of the transaction exports. The amount is
+// signed, negative for what left the account, and the tags are comma separated.
+var transactionColumns = []string{"Date", "Description", "Payee", "Account", "Category", "Amount", "Currency", "Status", "Tags"}
+
+// TransactionsCSV exports transactions as a CSV file, a row per transaction
+type TransactionsCSV struct{}
+
+func NewTransactionsCSV() TransactionsCSV {
+	return TransactionsCSV{}
+}
+
+func (TransactionsCSV) ExportTransactions(w io.Writer, transactions iter.Seq2[entities.Transaction, error]) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(transactionColumns); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	for transaction, err := range transactions {
+		if err != nil {
+			return err
+		}
+		if err := writer.Write([]string{
+			transaction.Date.Format("2006-01-02"),
+			transaction.Description,
+			transaction.Payee,
+			accountName(transaction),
+			categoryName(transaction),
+			decimal(transaction.Monetary),
+			transaction.Monetary.Asset.Asset,
+			string(transaction.Status),
+			strings.Join(transaction.Tags, ", "),
+		}); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+func categoryName(transaction entities.Transaction) string {
+	if transaction.Category != nil {
+		return transaction.Category.Name
+	}
+	return ""
+}
+
+func accountName(transaction entities.Transaction) string {
+	if transaction.Account != nil {
+		return transaction.Account.Name
+	}
+	return ""
+}
+
+// size drops the sign amounts carry for their effect on the account, an
+// expense is reimbursed the same on a checking account or a credit card
+func size(amount monetary.Monetary) monetary.Monetary {
+	return entities.MoneyOf(amount).Abs().Monetary()
+}
+
+// decimal writes an amount with the decimals of its asset, like 1234.50
+func decimal(amount monetary.Monetary) string {
+	value := amount.Amount
+	if value == nil {
+		value = new(big.Int)
+	}
+	exponent := amount.Asset.Precision
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+	return new(big.Rat).SetFrac(value, scale).FloatString(exponent)
+}
@@ -0,0 +1,103 @@
+package exporters
+
+import (
+	"bytes"
+	"errors"
+	"finance/domain/entities"
+	"iter"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// transactions yields the transactions, then err when it isn't nil
+func transactions(err error, list ...entities.Transaction) iter.Seq2[entities.Transaction, error] {
+	return func(yield func(entities.Transaction, error) bool) {
+		for _, transaction := range list {
+			if !yield(transaction, nil) {
+				return
+			}
+		}
+		if err != nil {
+			yield(entities.Transaction{}, err)
+		}
+	}
+}
+
+// testReport is a submitted trip with a hotel stay charged to a card and a
+// taxi paid in cash
+func testReport(t *testing.T) entities.ExpenseReport {
+	t.Helper()
+
+	money := func(amount int64) monetary.Monetary {
+		return monetary.Monetary{Asset: monetary.GBP, Amount: big.NewInt(amount)}
+	}
+	submittedAt := time.Date(2025, time.March, 17, 9, 30, 0, 0, time.UTC)
+
+	return entities.ExpenseReport{
+		Name:        "Lisbon trip",
+		StartDate:   time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC),
+		Notes:       "Client visit",
+		Status:      entities.ExpenseReportStatusSubmitted,
+		SubmittedAt: &submittedAt,
+		Transactions: []entities.Transaction{
+			{
+				Monetary:    money(-48000),
+				Description: "Hotel, 4 nights",
+				Payee:       "Hotel Avenida",
+				Date:        time.Date(2025, time.March, 11, 0, 0, 0, 0, time.UTC),
+				Status:      entities.TransactionStatusCleared,
+				Account:     &entities.Account{Name: "Card"},
+				Category:    &entities.Category{Name: "Travel"},
+			},
+			{
+				Monetary:    money(-3240),
+				Description: "Taxi (airport)",
+				Date:        time.Date(2025, time.March, 12, 0, 0, 0, 0, time.UTC),
+				Status:      entities.TransactionStatusPending,
+				Account:     &entities.Account{Name: "Wallet"},
+				Category:    &entities.Category{Name: "Travel"},
+			},
+		},
+		Totals: []monetary.Monetary{money(51240)},
+	}
+}
+
+func TestExpenseReportCSV(t *testing.T) {
+	data, err := NewExpenseReportCSV().ExportExpenseReport(testReport(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, `Date,Description,Payee,Category,Account,Amount,Currency,Status
+2025-03-11,"Hotel, 4 nights",Hotel Avenida,Travel,Card,480.00,GBP,cleared
+2025-03-12,Taxi (airport),,Travel,Wallet,32.40,GBP,pending
+,Total,,,,512.40,GBP,
+`, string(data))
+}
+
+func TestTransactionsCSV(t *testing.T) {
+	report := testReport(t)
+	salary := entities.Transaction{
+		Monetary:    monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(850050)},
+		Description: "Salary",
+		Date:        time.Date(2025, time.March, 5, 0, 0, 0, 0, time.UTC),
+		Status:      entities.TransactionStatusCleared,
+		Tags:        []string{"payroll", "work"},
+		Account:     &entities.Account{Name: "Checking"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, NewTransactionsCSV().ExportTransactions(&buf, transactions(nil, append(report.Transactions, salary)...)))
+	assert.Equal(t, `Date,Description,Payee,Account,Category,Amount,Currency,Status,Tags
+2025-03-11,"Hotel, 4 nights",Hotel Avenida,Card,Travel,-480.00,GBP,cleared,
+2025-03-12,Taxi (airport),,Wallet,Travel,-32.40,GBP,pending,
+2025-03-05,Salary,,Checking,,8500.50,BRL,cleared,"payroll, work"
+`, buf.String())
+
+	failure := errors.New("connection lost")
+	assert.ErrorIs(t, NewTransactionsCSV().ExportTransactions(&bytes.Buffer{}, transactions(failure, report.Transactions...)), failure)
+}
@@ -0,0 +1,172 @@
+package exporters
+
+import (
+	"bytes"
+	"finance/domain/entities"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// The PDF is an A4 portrait page of monospaced text, so the columns line up
+// without measuring glyphs
+const (
+	pdfPageWidth   = 595
+	pdfPageHeight  = 842
+	pdfMargin      = 40
+	pdfFontSize    = 8
+	pdfLineHeight  = 11
+	pdfLinesOnPage = (pdfPageHeight - 2*pdfMargin) / pdfLineHeight
+)
+
+// pdfColumns are the widths, in characters, of the transaction table
+var pdfColumns = []int{10, 38, 18, 16, 14, 5}
+
+// ExpenseReportPDF exports an expense report as a PDF document listing its
+// transactions and totals
+type ExpenseReportPDF struct{}
+
+func NewExpenseReportPDF() ExpenseReportPDF {
+	return ExpenseReportPDF{}
+}
+
+func (ExpenseReportPDF) ExportExpenseReport(report entities.ExpenseReport) ([]byte, error) {
+	lines := []string{
+		report.Name,
+		"",
+		fmt.Sprintf("Period: %s to %s", report.StartDate.Format("2006-01-02"), report.EndDate.Format("2006-01-02")),
+		fmt.Sprintf("Status: %s", report.Status),
+	}
+	if report.SubmittedAt != nil {
+		lines = append(lines, fmt.Sprintf("Submitted: %s", report.SubmittedAt.Format("2006-01-02 15:04")))
+	}
+	if report.ReviewedAt != nil {
+		lines = append(lines, fmt.Sprintf("Reviewed: %s", report.ReviewedAt.Format("2006-01-02 15:04")))
+	}
+	for _, line := range strings.Split(report.Notes, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	header := pdfRow("Date", "Description", "Category", "Account", "Amount", "")
+	lines = append(lines, "", header, strings.Repeat("-", utf8.RuneCountInString(header)))
+	for _, transaction := range report.Transactions {
+		description := transaction.Description
+		if transaction.Payee != "" {
+			description = fmt.Sprintf("%s - %s", description, transaction.Payee)
+		}
+		lines = append(lines, pdfRow(
+			transaction.Date.Format("2006-01-02"),
+			description,
+			categoryName(transaction),
+			accountName(transaction),
+			decimal(size(transaction.Monetary)),
+			transaction.Monetary.Asset.Asset,
+		))
+	}
+	lines = append(lines, "")
+	for _, total := range report.Totals {
+		lines = append(lines, pdfRow("", "Total", "", "", decimal(total), total.Asset.Asset))
+	}
+
+	return writePDF(lines), nil
+}
+
+// pdfRow lays the cells out in the table columns, cutting what doesn't fit
+// and aligning the amount to the right
+func pdfRow(cells ...string) string {
+	var row strings.Builder
+	for i, cell := range cells {
+		width := pdfColumns[i]
+		runes := []rune(cell)
+		if len(runes) > width {
+			runes = runes[:width]
+		}
+		padding := strings.Repeat(" ", width-len(runes))
+		if i == 4 {
+			row.WriteString(padding + string(runes))
+		} else {
+			row.WriteString(string(runes) + padding)
+		}
+		if i < len(cells)-1 {
+			row.WriteString(" ")
+		}
+	}
+	return strings.TrimRight(row.String(), " ")
+}
+
+// writePDF writes the lines as a PDF document, as many pages as they take
+func writePDF(lines []string) []byte {
+	var pages [][]string
+	for len(lines) > pdfLinesOnPage {
+		pages = append(pages, lines[:pdfLinesOnPage])
+		lines = lines[pdfLinesOnPage:]
+	}
+	pages = append(pages, lines)
+
+	// Objects 1 to 3 are the catalog, the page tree and the font, then each
+	// page is followed by its content stream
+	var objects []string
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 4+2*i)
+	}
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+	)
+	for i, page := range pages {
+		var content bytes.Buffer
+		fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLineHeight, pdfMargin, pdfPageHeight-pdfMargin)
+		for _, line := range page {
+			fmt.Fprintf(&content, "(%s) '\n", pdfString(line))
+		}
+		content.WriteString("ET")
+
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", pdfPageWidth, pdfPageHeight, 5+2*i),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+		)
+	}
+
+	var doc bytes.Buffer
+	doc.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = doc.Len()
+		fmt.Fprintf(&doc, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := doc.Len()
+	fmt.Fprintf(&doc, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&doc, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&doc, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return doc.Bytes()
+}
+
+// pdfString escapes text for a PDF string in WinAnsiEncoding, which covers
+// Latin-1 and the euro sign. Other characters are replaced by a question mark.
+func pdfString(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '€':
+			b.WriteString(`\200`)
+		case r >= ' ' && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, `\%03o`, r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
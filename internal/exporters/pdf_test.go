@@ -0,0 +1,55 @@
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpenseReportPDF(t *testing.T) {
+	data, err := NewExpenseReportPDF().ExportExpenseReport(testReport(t))
+	require.NoError(t, err)
+
+	assert.True(t, bytes.HasPrefix(data, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(data, []byte("%%EOF\n")))
+	assert.Contains(t, string(data), "(Lisbon trip) '")
+	assert.Contains(t, string(data), "(Period: 2025-03-10 to 2025-03-14) '")
+	assert.Contains(t, string(data), `Taxi \(airport\)`)
+	assert.Contains(t, string(data), "512.40 GBP) '")
+
+	t.Run("the cross-reference table points at the objects", func(t *testing.T) {
+		startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(data)
+		require.NotNil(t, startxref)
+		xref, err := strconv.Atoi(string(startxref[1]))
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(data[xref:], []byte("xref\n")))
+
+		entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(data[xref:], -1)
+		require.Len(t, entries, 5)
+		for i, entry := range entries {
+			offset, _ := strconv.Atoi(string(entry[1]))
+			assert.True(t, bytes.HasPrefix(data[offset:], fmt.Appendf(nil, "%d 0 obj\n", i+1)), "object %d", i+1)
+		}
+	})
+
+	t.Run("long reports take several pages", func(t *testing.T) {
+		report := testReport(t)
+		for range 100 {
+			report.Transactions = append(report.Transactions, report.Transactions[0])
+		}
+
+		data, err := NewExpenseReportPDF().ExportExpenseReport(report)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "/Count 2 >>")
+	})
+}
+
+func TestPDFString(t *testing.T) {
+	assert.Equal(t, `Caf\351 \(5 \200\) a\\b ?`, pdfString("Café (5 €) a\\b ✈"))
+	assert.Equal(t, "Date       Description", pdfRow("Date", "Description", "", "", "", ""))
+}
@@ -0,0 +1,177 @@
+package exporters
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"finance/domain/entities"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The parts of the workbook besides its only sheet, which is written as the
+// transactions are read. Style 1 shows a date serial as a date and style 2
+// makes the header bold.
+var xlsxParts = []struct{ name, content string }{
+	{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Transactions" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`},
+	{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`},
+	{"xl/styles.xml", xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+		`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+		`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+		`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+		`<cellXfs count="3"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+		`<xf numFmtId="14" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+		`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+		`</styleSheet>`},
+}
+
+// xlsxEpoch is the day spreadsheets count dates from
+var xlsxEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// TransactionsXLSX exports transactions as an Excel workbook with a sheet
+// holding a row per transaction. Dates and amounts are written as numbers, so
+// they can be sorted and added up.
+type TransactionsXLSX struct{}
+
+func NewTransactionsXLSX() TransactionsXLSX {
+	return TransactionsXLSX{}
+}
+
+func (TransactionsXLSX) ExportTransactions(w io.Writer, transactions iter.Seq2[entities.Transaction, error]) error {
+	archive := zip.NewWriter(w)
+	for _, part := range xlsxParts {
+		file, err := archive.Create(part.name)
+		if err != nil {
+			return fmt.Errorf("failed to write XLSX: %w", err)
+		}
+		if _, err := io.WriteString(file, part.content); err != nil {
+			return fmt.Errorf("failed to write XLSX: %w", err)
+		}
+	}
+
+	file, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return fmt.Errorf("failed to write XLSX: %w", err)
+	}
+	sheet := &xlsxSheet{w: bufio.NewWriter(file)}
+	sheet.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	sheet.startRow()
+	for _, column := range transactionColumns {
+		sheet.text(column, 2)
+	}
+	sheet.endRow()
+
+	for transaction, err := range transactions {
+		if err != nil {
+			return err
+		}
+		sheet.startRow()
+		sheet.date(transaction.Date)
+		sheet.text(transaction.Description, 0)
+		sheet.text(transaction.Payee, 0)
+		sheet.text(accountName(transaction), 0)
+		sheet.text(categoryName(transaction), 0)
+		sheet.number(decimal(transaction.Monetary))
+		sheet.text(transaction.Monetary.Asset.Asset, 0)
+		sheet.text(string(transaction.Status), 0)
+		sheet.text(strings.Join(transaction.Tags, ", "), 0)
+		sheet.endRow()
+	}
+
+	sheet.WriteString(`</sheetData></worksheet>`)
+	if err := sheet.w.Flush(); err != nil {
+		return fmt.Errorf("failed to write XLSX: %w", err)
+	}
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to write XLSX: %w", err)
+	}
+	return nil
+}
+
+// xlsxSheet writes the rows of a worksheet, numbering the rows and cells.
+// Write errors are kept by the bufio.Writer and returned by its Flush.
+type xlsxSheet struct {
+	w      *bufio.Writer
+	row    int
+	column int
+}
+
+func (s *xlsxSheet) WriteString(text string) {
+	_, _ = s.w.WriteString(text)
+}
+
+func (s *xlsxSheet) startRow() {
+	s.row++
+	s.column = 0
+	s.WriteString(`<row r="` + strconv.Itoa(s.row) + `">`)
+}
+
+func (s *xlsxSheet) endRow() {
+	s.WriteString(`</row>`)
+}
+
+// cell starts the next cell of the row, like <c r="B2"
+func (s *xlsxSheet) cell() {
+	s.column++
+	s.WriteString(`<c r="` + xlsxColumn(s.column) + strconv.Itoa(s.row) + `"`)
+}
+
+// text writes a string cell, inline so there's no shared string table to
+// keep in memory. Empty strings leave the cell empty.
+func (s *xlsxSheet) text(text string, style int) {
+	s.cell()
+	if style != 0 {
+		s.WriteString(` s="` + strconv.Itoa(style) + `"`)
+	}
+	if text == "" {
+		s.WriteString(`/>`)
+		return
+	}
+	s.WriteString(` t="inlineStr"><is><t xml:space="preserve">`)
+	_ = xml.EscapeText(s.w, []byte(text))
+	s.WriteString(`</t></is></c>`)
+}
+
+func (s *xlsxSheet) number(value string) {
+	s.cell()
+	s.WriteString(`><v>` + value + `</v></c>`)
+}
+
+// date writes the day as the number of days since the epoch, shown as a date
+func (s *xlsxSheet) date(date time.Time) {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	s.cell()
+	s.WriteString(` s="1"><v>` + strconv.Itoa(int(day.Sub(xlsxEpoch).Hours()/24)) + `</v></c>`)
+}
+
+// xlsxColumn names a column from 1, as A to Z and then AA, AB and so on
+func xlsxColumn(n int) string {
+	var name []byte
+	for n > 0 {
+		n--
+		name = append([]byte{byte('A' + n%26)}, name...)
+		n /= 26
+	}
+	return string(name)
+}
@@ -0,0 +1,53 @@
+package exporters
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionsXLSX(t *testing.T) {
+	report := testReport(t)
+	report.Transactions[1].Description = "Taxi <airport> & tip"
+
+	var buf bytes.Buffer
+	require.NoError(t, NewTransactionsXLSX().ExportTransactions(&buf, transactions(nil, report.Transactions...)))
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	parts := map[string]string{}
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		parts[file.Name] = string(content)
+	}
+	assert.Contains(t, parts, "[Content_Types].xml")
+	assert.Contains(t, parts["xl/workbook.xml"], `<sheet name="Transactions"`)
+
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<row r="1"><c r="A1" s="2" t="inlineStr"><is><t xml:space="preserve">Date</t></is></c>`)
+	// 2025-03-11 is day 45727, and the amount is a signed number
+	assert.Contains(t, sheet, `<row r="2"><c r="A2" s="1"><v>45727</v></c>`)
+	assert.Contains(t, sheet, `<c r="F2"><v>-480.00</v></c>`)
+	// The taxi has no payee, and text is escaped
+	assert.Contains(t, sheet, `<c r="B3" t="inlineStr"><is><t xml:space="preserve">Taxi &lt;airport&gt; &amp; tip</t></is></c><c r="C3"/>`)
+	assert.True(t, strings.HasSuffix(sheet, `</row></sheetData></worksheet>`))
+
+	failure := errors.New("connection lost")
+	assert.ErrorIs(t, NewTransactionsXLSX().ExportTransactions(&bytes.Buffer{}, transactions(failure, report.Transactions...)), failure)
+}
+
+func TestXLSXColumn(t *testing.T) {
+	assert.Equal(t, "A", xlsxColumn(1))
+	assert.Equal(t, "Z", xlsxColumn(26))
+	assert.Equal(t, "AA", xlsxColumn(27))
+	assert.Equal(t, "BA", xlsxColumn(53))
+}
@@ -0,0 +1,112 @@
+// Package importers holds the parsers of the statement exports of banks and
+// payment providers, turning their files into the lines the import use case
+// creates transactions from.
+package importers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"finance/domain"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// csvRecord is a row of a CSV export, read by column name
+type csvRecord struct {
+	line    int
+	fields  []string
+	columns map[string]int
+}
+
+func (r csvRecord) get(column string) string {
+	if i, ok := r.columns[column]; ok && i < len(r.fields) {
+		return strings.TrimSpace(r.fields[i])
+	}
+	return ""
+}
+
+// errorf reports a problem with the row, pointing at its line in the file
+func (r csvRecord) errorf(format string, args ...any) error {
+	return fmt.Errorf("line %d: %s: %w", r.line, fmt.Sprintf(format, args...), domain.ErrMalformedParameters)
+}
+
+// readCSV reads an export whose first row names the columns, making sure the
+// required ones are there
+func readCSV(r io.Reader, required ...string) ([]csvRecord, error) {
+	// Spreadsheet apps save CSV files with a byte order mark
+	buffered := bufio.NewReader(r)
+	if bom, _ := buffered.Peek(3); bytes.Equal(bom, []byte("\ufeff")) {
+		_, _ = buffered.Discard(3)
+	}
+
+	reader := csv.NewReader(buffered)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("statement is empty: %w", domain.ErrMalformedParameters)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read statement: %v: %w", err, domain.ErrMalformedParameters)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range required {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("statement has no %q column: %w", name, domain.ErrMalformedParameters)
+		}
+	}
+
+	var records []csvRecord
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read statement: %v: %w", err, domain.ErrMalformedParameters)
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, csvRecord{line: line, fields: fields, columns: columns})
+	}
+}
+
+// parseAmount parses a decimal amount like "-1234.50" into the asset's
+// smallest unit. An empty value is zero.
+func parseAmount(value string, asset monetary.Asset) (monetary.Monetary, error) {
+	if value == "" {
+		value = "0"
+	}
+	amount, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return monetary.Monetary{}, fmt.Errorf("invalid amount %q", value)
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(asset.Precision)), nil)
+	amount.Mul(amount, new(big.Rat).SetInt(scale))
+	if !amount.IsInt() {
+		return monetary.Monetary{}, fmt.Errorf("amount %q has more decimals than %s", value, asset.Asset)
+	}
+	return monetary.Monetary{Asset: asset, Amount: new(big.Int).Set(amount.Num())}, nil
+}
+
+// parseDate parses the date of a row in any of layouts, dropping the time of
+// day
+func parseDate(value string, layouts ...string) (time.Time, bool) {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), true
+		}
+	}
+	return time.Time{}, false
+}
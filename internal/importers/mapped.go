@@ -0,0 +1,87 @@
+package importers
+
+import (
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// mappedDateLayouts are tried on the dates of exports whose mapping doesn't
+// name a layout
+var mappedDateLayouts = []string{"2006-01-02", "02/01/2006", "02-01-2006", "02.01.2006", "2006/01/02"}
+
+// MappedParser reads the CSV exports of any bank, finding the fields of the
+// transactions through a column mapping instead of knowing the layout of the
+// export. All the rows are of a single account, in its asset.
+type MappedParser struct{}
+
+func NewMappedParser() *MappedParser {
+	return &MappedParser{}
+}
+
+// ParseCSV reads the transactions of an export, skipping the blank rows some
+// banks end their exports with
+func (p *MappedParser) ParseCSV(r io.Reader, mapping entities.CSVMapping, asset monetary.Asset) ([]entities.ImportedTransaction, error) {
+	if mapping.Date == "" || mapping.Amount == "" || mapping.Description == "" {
+		return nil, fmt.Errorf("date, amount and description columns are required: %w", domain.ErrMalformedParameters)
+	}
+	layouts := mappedDateLayouts
+	if mapping.DateLayout != "" {
+		layouts = []string{mapping.DateLayout}
+	}
+
+	required := []string{mapping.Date, mapping.Amount, mapping.Description}
+	if mapping.Payee != "" {
+		required = append(required, mapping.Payee)
+	}
+	records, err := readCSV(r, required...)
+	if err != nil {
+		return nil, err
+	}
+
+	var transactions []entities.ImportedTransaction
+	for _, record := range records {
+		if record.get(mapping.Date) == "" && record.get(mapping.Amount) == "" && record.get(mapping.Description) == "" {
+			continue
+		}
+
+		date, ok := parseDate(record.get(mapping.Date), layouts...)
+		if !ok {
+			return nil, record.errorf("invalid date %q", record.get(mapping.Date))
+		}
+		value := record.get(mapping.Amount)
+		if value == "" {
+			return nil, record.errorf("missing amount")
+		}
+		amount, err := parseAmount(normalizeAmount(value, mapping.DecimalComma), asset)
+		if err != nil {
+			return nil, record.errorf("%v", err)
+		}
+
+		transaction := entities.ImportedTransaction{
+			Date:        date,
+			Amount:      amount,
+			Description: record.get(mapping.Description),
+		}
+		if mapping.Payee != "" {
+			transaction.Payee = record.get(mapping.Payee)
+		}
+		transactions = append(transactions, transaction)
+	}
+	return transactions, nil
+}
+
+// normalizeAmount turns an amount as banks write it, like "1,234.56" or
+// "-1.234,56" with a decimal comma, into "-1234.56"
+func normalizeAmount(value string, decimalComma bool) string {
+	value = strings.ReplaceAll(value, " ", "")
+	if decimalComma {
+		value = strings.ReplaceAll(value, ".", "")
+		return strings.Replace(value, ",", ".", 1)
+	}
+	return strings.ReplaceAll(value, ",", "")
+}
@@ -0,0 +1,57 @@
+package importers
+
+import (
+	"finance/domain"
+	"finance/domain/entities"
+	"strings"
+	"testing"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMappedParser(t *testing.T) {
+	mapping := entities.CSVMapping{Date: "Data", Amount: "Valor", Description: "Histórico", DecimalComma: true}
+
+	t.Run("reads the mapped columns", func(t *testing.T) {
+		export := "\ufeff" + `Data,Histórico,Documento,Valor
+14/03/2025,PIX TRANSF JOAO SILVA 14/03,123,"-1.250,00"
+15/03/2025,SALARIO ACME,,"8.500,5"
+
+`
+		transactions, err := NewMappedParser().ParseCSV(strings.NewReader(export), mapping, monetary.BRL)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"2025-03-14 [BRL (R$) -1250.00] PIX TRANSF JOAO SILVA 14/03",
+			"2025-03-15 [BRL (R$) 8500.50] SALARIO ACME",
+		}, lines(entities.ImportedStatement{Transactions: transactions}))
+	})
+
+	t.Run("date layout and payee", func(t *testing.T) {
+		export := `Posted,Amount,Memo,Merchant
+03/14/2025,"-1,025.80",Card purchase,Bakery
+`
+		mapping := entities.CSVMapping{Date: "Posted", Amount: "Amount", Description: "Memo", Payee: "Merchant", DateLayout: "01/02/2006"}
+		transactions, err := NewMappedParser().ParseCSV(strings.NewReader(export), mapping, monetary.USD)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"2025-03-14 [USD ($) -1025.80] Card purchase"}, lines(entities.ImportedStatement{Transactions: transactions}))
+		assert.Equal(t, "Bakery", transactions[0].Payee)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, export := range map[string]string{
+			"missing column": "Data,Valor\n14/03/2025,10\n",
+			"invalid date":   "Data,Histórico,Valor\n2025-14-03,Market,10\n",
+			"invalid amount": "Data,Histórico,Valor\n14/03/2025,Market,ten\n",
+			"missing amount": "Data,Histórico,Valor\n14/03/2025,Market,\n",
+			"too precise":    "Data,Histórico,Valor\n14/03/2025,Market,\"10,005\"\n",
+		} {
+			_, err := NewMappedParser().ParseCSV(strings.NewReader(export), mapping, monetary.BRL)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters, name)
+		}
+
+		_, err := NewMappedParser().ParseCSV(strings.NewReader("Data,Valor\n"), entities.CSVMapping{Date: "Data", Amount: "Valor"}, monetary.BRL)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters, "the description column is required")
+	})
+}
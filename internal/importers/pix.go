@@ -0,0 +1,115 @@
+package importers
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// BrazilDescriptionParser reads who the money went to or came from out of the
+// descriptions Brazilian banks write for PIX, TED and DOC transfers, like
+// "PIX TRANSF JOAO SILVA 12/03" or "Transferência enviada pelo Pix - MARIA DE
+// SOUZA - •••.123.456-••". Descriptions of anything else, like card purchases,
+// are left alone.
+type BrazilDescriptionParser struct{}
+
+func NewBrazilDescriptionParser() *BrazilDescriptionParser {
+	return &BrazilDescriptionParser{}
+}
+
+var (
+	// transferPrefix matches the way banks open a transfer description, the
+	// longest phrasings first
+	transferPrefix = regexp.MustCompile(`(?i)^\s*(?:` +
+		`transfer[êe]ncia (?:enviada|recebida) pelo pix` +
+		`|(?:transfer[êe]ncia|transf\.?|pagamento|pagto\.?|pgto\.?) (?:via )?(?:pix|ted|doc)(?: (?:enviad[oa]|recebid[oa]|agendad[oa]))?` +
+		`|(?:pix|ted|doc)(?: (?:transf\.?|enviad[oa]|recebid[oa]|agendad[oa]|qr ?code|qrs))?` +
+		`)(?:$|[^\p{L}])`)
+	// transferConnector matches the words between the prefix and the
+	// counterparty, like "- para:" or "REM:"
+	transferConnector = regexp.MustCompile(`(?i)^(?:[\s:\-–]+|(?:rem|des|de|para|p/|favorecido|pagador|chave)(?:$|[\s:\-–]+))`)
+
+	// PIX keys: e-mails, CPF and CNPJ numbers, phone numbers and random keys
+	pixKey = regexp.MustCompile(`(?i)[\w.+-]+@[\w-]+\.[\w.-]+` +
+		`|\d{2}\.\d{3}\.\d{3}/\d{4}-\d{2}` +
+		`|\d{3}\.\d{3}\.\d{3}-\d{2}` +
+		`|\+55[\s(]*\d{2}\)?\s*9?\d{4}-?\d{4}` +
+		`|[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+
+	// Bank codes and branch numbers some banks put before the name, like "TED
+	// 001.0001.JOSE PEREIRA"
+	leadingNumbers = regexp.MustCompile(`^[\d.\s/-]+`)
+	// The name ends at the next field: a " - " separator, a date, masked or
+	// plain numbers
+	nameEnd = regexp.MustCompile(`\s+[-–]\s|\d|[•*]`)
+)
+
+// companySuffixes keep their capitals when names are title cased
+var companySuffixes = map[string]bool{"LTDA": true, "ME": true, "MEI": true, "EIRELI": true, "SA": true, "S/A": true, "EPP": true}
+
+// nameParticles stay lowercase when names are title cased
+var nameParticles = map[string]bool{"de": true, "da": true, "do": true, "das": true, "dos": true, "e": true}
+
+// ParsePayee returns the counterparty named in a transfer description, or the
+// PIX key the money was sent to when there is no name
+func (p *BrazilDescriptionParser) ParsePayee(description string) (string, bool) {
+	prefix := transferPrefix.FindString(description)
+	if prefix == "" {
+		return "", false
+	}
+	rest := description[len(strings.TrimRightFunc(prefix, func(r rune) bool { return !unicode.IsLetter(r) })):]
+	for {
+		connector := transferConnector.FindString(rest)
+		if connector == "" {
+			break
+		}
+		rest = rest[len(connector):]
+	}
+
+	key := pixKey.FindString(rest)
+	if loc := pixKey.FindStringIndex(rest); loc != nil && loc[0] == 0 {
+		// "PIX CHAVE ana@example.com - Ana Lima" names the key first
+		rest = strings.TrimLeft(rest[loc[1]:], " -–:")
+	}
+	rest = leadingNumbers.ReplaceAllString(rest, "")
+	if loc := nameEnd.FindStringIndex(rest); loc != nil {
+		rest = rest[:loc[0]]
+	}
+	name := strings.Join(strings.Fields(strings.Trim(rest, " -–:.,")), " ")
+
+	letters := 0
+	for _, r := range name {
+		if unicode.IsLetter(r) {
+			letters++
+		}
+	}
+	switch {
+	case letters >= 2:
+		return titleCase(name), true
+	case key != "":
+		return key, true
+	}
+	return "", false
+}
+
+// titleCase turns the all caps names most banks write into "Maria de Souza",
+// names already in mixed case are kept as written
+func titleCase(name string) string {
+	if name != strings.ToUpper(name) {
+		return name
+	}
+
+	words := strings.Fields(strings.ToLower(name))
+	for i, word := range words {
+		switch {
+		case companySuffixes[strings.ToUpper(word)]:
+			words[i] = strings.ToUpper(word)
+		case i > 0 && nameParticles[word]:
+		default:
+			runes := []rune(word)
+			runes[0] = unicode.ToUpper(runes[0])
+			words[i] = string(runes)
+		}
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,42 @@
+package importers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBrazilDescriptionParser(t *testing.T) {
+	tests := []struct {
+		description string
+		want        string
+	}{
+		{"PIX TRANSF JOAO SILVA 12/03", "Joao Silva"},
+		{"Transferência enviada pelo Pix - MARIA DE SOUZA - •••.123.456-•• - NU PAGAMENTOS - IP (0260) Agência: 1 Conta: 1234-5", "Maria de Souza"},
+		{"Transferência recebida pelo Pix - Padaria Pão Quente LTDA - 12.345.678/0001-90 - BCO DO BRASIL S.A.", "Padaria Pão Quente LTDA"},
+		{"TRANSFERENCIA PIX REM: ANA LIMA 05/03", "Ana Lima"},
+		{"PIX RECEBIDO DE CARLOS ANDRADE", "Carlos Andrade"},
+		{"TED 001.0001.JOSE PEREIRA", "Jose Pereira"},
+		{"DOC ENVIADO P/ EMPRESA ABC LTDA", "Empresa Abc LTDA"},
+		{"PAGAMENTO PIX CHAVE ana@example.com", "ana@example.com"},
+		{"PIX ENVIADO +55 11 98765-4321", "+55 11 98765-4321"},
+		{"Pix enviado 123.456.789-09", "123.456.789-09"},
+		{"PIX QRS IFOOD*RESTAURANTE 01/02", "Ifood"},
+	}
+
+	parser := NewBrazilDescriptionParser()
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			payee, ok := parser.ParsePayee(tt.description)
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, payee)
+		})
+	}
+
+	for _, description := range []string{"Compra no débito - Mercado X", "Pixel Store", "PIX", "TED 12/03"} {
+		t.Run(description, func(t *testing.T) {
+			_, ok := parser.ParsePayee(description)
+			assert.False(t, ok)
+		})
+	}
+}
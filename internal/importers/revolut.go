@@ -0,0 +1,112 @@
+package importers
+
+import (
+	"finance/domain/entities"
+	"io"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// Revolut transaction states, rows in any other state never moved money
+const (
+	revolutStateCompleted = "COMPLETED"
+	revolutStatePending   = "PENDING"
+)
+
+// revolutDateLayout is the layout of the Started and Completed Date columns
+const revolutDateLayout = "2006-01-02 15:04:05"
+
+// RevolutParser reads the CSV account statements of Revolut. Rows come oldest
+// first in any currency, with the amount signed, the fee apart and the balance
+// of the currency after the row.
+type RevolutParser struct{}
+
+func NewRevolutParser() *RevolutParser {
+	return &RevolutParser{}
+}
+
+// ParseStatement turns the completed and pending rows into lines, dated when
+// they were started, with a fee line after each row charging one. Rows of
+// savings vaults are left out, their money isn't part of the account balance.
+func (p *RevolutParser) ParseStatement(r io.Reader) (entities.ImportedStatement, error) {
+	records, err := readCSV(r, "Started Date", "Description", "Amount", "Currency", "State")
+	if err != nil {
+		return entities.ImportedStatement{}, err
+	}
+
+	statement := entities.ImportedStatement{Source: entities.StatementSourceRevolut}
+	latest := map[string]time.Time{}
+	balances := map[string]monetary.Monetary{}
+	var currencies []string
+
+	for _, record := range records {
+		state := record.get("State")
+		if state != revolutStateCompleted && state != revolutStatePending {
+			continue
+		}
+		if product := record.get("Product"); product != "" && product != "Current" {
+			continue
+		}
+
+		asset, ok := entities.FindSupportedAsset(record.get("Currency"))
+		if !ok {
+			return entities.ImportedStatement{}, record.errorf("unknown currency %q", record.get("Currency"))
+		}
+		date, ok := parseDate(record.get("Started Date"), revolutDateLayout, "2006-01-02")
+		if !ok {
+			return entities.ImportedStatement{}, record.errorf("invalid date %q", record.get("Started Date"))
+		}
+		amount, err := parseAmount(record.get("Amount"), asset)
+		if err != nil {
+			return entities.ImportedStatement{}, record.errorf("%v", err)
+		}
+		fee, err := parseAmount(record.get("Fee"), asset)
+		if err != nil {
+			return entities.ImportedStatement{}, record.errorf("%v", err)
+		}
+
+		pending := state == revolutStatePending
+		description := record.get("Description")
+		statement.Transactions = append(statement.Transactions, entities.ImportedTransaction{
+			Date:        date,
+			Amount:      amount,
+			Description: description,
+			Pending:     pending,
+		})
+		if !entities.MoneyOf(fee).IsZero() {
+			statement.Transactions = append(statement.Transactions, entities.ImportedTransaction{
+				Date:        date,
+				Amount:      entities.MoneyOf(fee).Abs().Neg().Monetary(),
+				Description: "Revolut fee: " + description,
+				Fee:         true,
+				Pending:     pending,
+			})
+		}
+
+		// The last completed row holds the closing balance
+		if pending || record.get("Balance") == "" {
+			continue
+		}
+		completed, err := time.Parse(revolutDateLayout, record.get("Completed Date"))
+		if err != nil {
+			completed = date
+		}
+		if seen, ok := latest[asset.Asset]; ok && completed.Before(seen) {
+			continue
+		}
+		balance, err := parseAmount(record.get("Balance"), asset)
+		if err != nil {
+			return entities.ImportedStatement{}, record.errorf("%v", err)
+		}
+		if _, ok := latest[asset.Asset]; !ok {
+			currencies = append(currencies, asset.Asset)
+		}
+		latest[asset.Asset], balances[asset.Asset] = completed, balance
+	}
+
+	for _, currency := range currencies {
+		statement.Balances = append(statement.Balances, balances[currency])
+	}
+	return statement, nil
+}
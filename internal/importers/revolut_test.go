@@ -0,0 +1,38 @@
+package importers
+
+import (
+	"finance/domain/entities"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevolutParser(t *testing.T) {
+	export := `Type,Product,Started Date,Completed Date,Description,Amount,Fee,Currency,State,Balance
+TOPUP,Current,2025-03-01 09:12:44,2025-03-01 09:12:45,Top-Up by *1234,500.00,0.00,GBP,COMPLETED,500.00
+CARD_PAYMENT,Current,2025-03-02 13:05:10,2025-03-03 08:00:01,Cafe Central,-4.50,0.00,GBP,COMPLETED,495.50
+EXCHANGE,Current,2025-03-04 10:00:00,2025-03-04 10:00:00,Exchanged to USD,-100.00,0.50,GBP,COMPLETED,395.00
+EXCHANGE,Current,2025-03-04 10:00:00,2025-03-04 10:00:00,Exchanged from GBP,108.20,0.00,USD,COMPLETED,108.20
+TRANSFER,Savings,2025-03-05 08:00:00,2025-03-05 08:00:00,To GBP Savings,50.00,0.00,GBP,COMPLETED,50.00
+CARD_PAYMENT,Current,2025-03-06 19:30:00,,Amazon,-20.00,0.00,GBP,DECLINED,
+CARD_PAYMENT,Current,2025-03-07 19:30:00,2025-03-07 19:30:00,Refunded order,-15.00,0.00,GBP,REVERTED,
+CARD_PAYMENT,Current,2025-03-08 12:00:00,,Grocery Store,-32.10,0.00,USD,PENDING,
+`
+
+	statement, err := NewRevolutParser().ParseStatement(strings.NewReader(export))
+	require.NoError(t, err)
+
+	assert.Equal(t, entities.StatementSourceRevolut, statement.Source)
+	assert.Equal(t, []string{
+		"2025-03-01 [GBP (£) 500.00] Top-Up by *1234",
+		"2025-03-02 [GBP (£) -4.50] Cafe Central",
+		"2025-03-04 [GBP (£) -100.00] Exchanged to USD",
+		"2025-03-04 [GBP (£) -0.50] Revolut fee: Exchanged to USD (fee)",
+		"2025-03-04 [USD ($) 108.20] Exchanged from GBP",
+		"2025-03-08 [USD ($) -32.10] Grocery Store (pending)",
+	}, lines(statement))
+	// The savings vault and pending rows don't count for the balances
+	assert.Equal(t, []string{"[GBP (£) 395.00]", "[USD ($) 108.20]"}, balances(statement))
+}
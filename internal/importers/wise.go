@@ -0,0 +1,97 @@
+package importers
+
+import (
+	"cmp"
+	"finance/domain/entities"
+	"io"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// WiseParser reads the CSV statements of Wise multi-currency accounts, either
+// of a single currency or of all of them. Rows come newest first, with the
+// amount signed and including the fees Wise took, the balance of the currency
+// after the row and who the money went to or came from.
+type WiseParser struct{}
+
+func NewWiseParser() *WiseParser {
+	return &WiseParser{}
+}
+
+// ParseStatement splits the fees out of the rows, so paying 25.00 with a 0.80
+// fee is a -25.00 line and a -0.80 fee line
+func (p *WiseParser) ParseStatement(r io.Reader) (entities.ImportedStatement, error) {
+	records, err := readCSV(r, "Date", "Amount", "Currency", "Description")
+	if err != nil {
+		return entities.ImportedStatement{}, err
+	}
+
+	statement := entities.ImportedStatement{Source: entities.StatementSourceWise}
+	latest := map[string]time.Time{}
+	balances := map[string]monetary.Monetary{}
+	var currencies []string
+
+	for _, record := range records {
+		asset, ok := entities.FindSupportedAsset(record.get("Currency"))
+		if !ok {
+			return entities.ImportedStatement{}, record.errorf("unknown currency %q", record.get("Currency"))
+		}
+		date, ok := parseDate(record.get("Date"), "02-01-2006", "2006-01-02")
+		if !ok {
+			return entities.ImportedStatement{}, record.errorf("invalid date %q", record.get("Date"))
+		}
+		amount, err := parseAmount(record.get("Amount"), asset)
+		if err != nil {
+			return entities.ImportedStatement{}, record.errorf("%v", err)
+		}
+		fee, err := parseAmount(record.get("Total fees"), asset)
+		if err != nil {
+			return entities.ImportedStatement{}, record.errorf("%v", err)
+		}
+		fee = entities.MoneyOf(fee).Abs().Monetary()
+
+		description := record.get("Description")
+		paid := entities.MoneyOf(amount)
+		paid.Amount.Add(paid.Amount, fee.Amount)
+		payee := record.get("Payer Name")
+		if paid.Sign() < 0 {
+			payee = cmp.Or(record.get("Merchant"), record.get("Payee Name"))
+		}
+		statement.Transactions = append(statement.Transactions, entities.ImportedTransaction{
+			Date:        date,
+			Amount:      paid.Monetary(),
+			Description: description,
+			Payee:       payee,
+		})
+		if !entities.MoneyOf(fee).IsZero() {
+			statement.Transactions = append(statement.Transactions, entities.ImportedTransaction{
+				Date:        date,
+				Amount:      entities.MoneyOf(fee).Neg().Monetary(),
+				Description: "Wise fee: " + description,
+				Fee:         true,
+			})
+		}
+
+		// The first row of the latest day holds the closing balance
+		if record.get("Running Balance") == "" {
+			continue
+		}
+		if seen, ok := latest[asset.Asset]; ok && !date.After(seen) {
+			continue
+		}
+		balance, err := parseAmount(record.get("Running Balance"), asset)
+		if err != nil {
+			return entities.ImportedStatement{}, record.errorf("%v", err)
+		}
+		if _, ok := latest[asset.Asset]; !ok {
+			currencies = append(currencies, asset.Asset)
+		}
+		latest[asset.Asset], balances[asset.Asset] = date, balance
+	}
+
+	for _, currency := range currencies {
+		statement.Balances = append(statement.Balances, balances[currency])
+	}
+	return statement, nil
+}
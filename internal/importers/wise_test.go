@@ -0,0 +1,88 @@
+package importers
+
+import (
+	"finance/domain"
+	"finance/domain/entities"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lines renders the transactions of a statement for comparison
+func lines(statement entities.ImportedStatement) []string {
+	var rendered []string
+	for _, line := range statement.Transactions {
+		text := line.Date.Format("2006-01-02") + " " + line.Amount.String() + " " + line.Description
+		if line.Fee {
+			text += " (fee)"
+		}
+		if line.Pending {
+			text += " (pending)"
+		}
+		rendered = append(rendered, text)
+	}
+	return rendered
+}
+
+func balances(statement entities.ImportedStatement) []string {
+	var rendered []string
+	for _, balance := range statement.Balances {
+		rendered = append(rendered, balance.String())
+	}
+	return rendered
+}
+
+func TestWiseParser(t *testing.T) {
+	export := "\ufeff" + `"TransferWise ID","Date","Amount","Currency","Description","Payment Reference","Running Balance","Exchange From","Exchange To","Exchange Rate","Payer Name","Payee Name","Payee Account Number","Merchant","Card Last Four Digits","Card Holder Full Name","Attachment","Note","Total fees"
+"CARD-3","14-03-2025","-25.80","GBP","Card transaction of 25.80 GBP issued by Bakery","","974.20","","","","","","","Bakery","1234","Jane Doe","","","0.80"
+"TRANSFER-2","14-03-2025","1000.00","GBP","Received money from John","rent","1000.00","","","","John","","","","","","","","0.00"
+"CONVERSION-1","02-03-2025","-200.00","USD","Converted 200.00 USD to 184.10 GBP","","300.00","USD","GBP","0.9205","","","","","","","","","1.20"
+"TRANSFER-0","01-03-2025","500.00","USD","Received money from Acme","","500.00","","","","Acme","","","","","","","",""
+`
+
+	statement, err := NewWiseParser().ParseStatement(strings.NewReader(export))
+	require.NoError(t, err)
+
+	assert.Equal(t, entities.StatementSourceWise, statement.Source)
+	assert.Equal(t, []string{
+		"2025-03-14 [GBP (£) -25.00] Card transaction of 25.80 GBP issued by Bakery",
+		"2025-03-14 [GBP (£) -0.80] Wise fee: Card transaction of 25.80 GBP issued by Bakery (fee)",
+		"2025-03-14 [GBP (£) 1000.00] Received money from John",
+		"2025-03-02 [USD ($) -198.80] Converted 200.00 USD to 184.10 GBP",
+		"2025-03-02 [USD ($) -1.20] Wise fee: Converted 200.00 USD to 184.10 GBP (fee)",
+		"2025-03-01 [USD ($) 500.00] Received money from Acme",
+	}, lines(statement))
+	assert.Equal(t, time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC), statement.Transactions[0].Date)
+	// The merchant or payee of money going out, the payer of money coming in
+	assert.Equal(t, "Bakery", statement.Transactions[0].Payee)
+	assert.Equal(t, "", statement.Transactions[1].Payee)
+	assert.Equal(t, "John", statement.Transactions[2].Payee)
+	assert.Equal(t, "Acme", statement.Transactions[5].Payee)
+	// Newest first, so the first row of each currency has its closing balance
+	assert.Equal(t, []string{"[GBP (£) 974.20]", "[USD ($) 300.00]"}, balances(statement))
+}
+
+func TestWiseParserErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		export string
+	}{
+		{name: "empty", export: ""},
+		{name: "missing column", export: "Date,Amount,Currency\n01-03-2025,1.00,GBP\n"},
+		{name: "unknown currency", export: "Date,Amount,Currency,Description\n01-03-2025,1.00,XYZ,Coffee\n"},
+		{name: "invalid date", export: "Date,Amount,Currency,Description\n2025/03/01,1.00,GBP,Coffee\n"},
+		{name: "invalid amount", export: "Date,Amount,Currency,Description\n01-03-2025,1.005,GBP,Coffee\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWiseParser().ParseStatement(strings.NewReader(tt.export))
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		})
+	}
+
+	_, err := NewWiseParser().ParseStatement(strings.NewReader("Date,Amount,Currency,Description\n01-03-2025,1.00,GBP,Coffee\n01-03-2025,abc,GBP,Tea\n"))
+	assert.ErrorContains(t, err, "line 3")
+}
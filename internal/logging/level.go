@@ -0,0 +1,157 @@
+// Package logging sets up the service logger with a level that can be changed
+// at runtime, e.g. to switch to debug while diagnosing a production issue.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ardanlabs/conf/v3"
+	"github.com/guilhermebr/gox/logger"
+)
+
+// Level is the runtime log level. It starts at the configured level and can be
+// changed for a while, reverting when the time is up, or until changed again.
+type Level struct {
+	configured slog.Level
+	level      slog.LevelVar
+
+	mu     sync.Mutex
+	until  time.Time
+	revert *time.Timer
+}
+
+func NewLevel(configured slog.Level) *Level {
+	l := &Level{configured: configured}
+	l.level.Set(configured)
+	return l
+}
+
+// Level returns the current level, implementing slog.Leveler
+func (l *Level) Level() slog.Level {
+	return l.level.Level()
+}
+
+// Configured returns the level the service started with
+func (l *Level) Configured() slog.Level {
+	return l.configured
+}
+
+// Until returns when the current level reverts to the configured one, zero
+// when it doesn't
+func (l *Level) Until() time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.until
+}
+
+// Set changes the level, reverting to the configured level after duration
+// when it's positive
+func (l *Level) Set(level slog.Level, duration time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.revert != nil {
+		l.revert.Stop()
+		l.revert = nil
+	}
+	l.until = time.Time{}
+	l.level.Set(level)
+
+	if duration <= 0 {
+		return
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(duration, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		// A later Set replaced this timer
+		if l.revert != timer {
+			return
+		}
+		l.level.Set(l.configured)
+		l.until = time.Time{}
+		l.revert = nil
+	})
+	l.revert = timer
+	l.until = time.Now().Add(duration)
+}
+
+// ParseLevel reads a level name: debug, info, warn (or warning) and error,
+// case insensitive
+func ParseLevel(name string) (slog.Level, error) {
+	if strings.EqualFold(name, "warning") {
+		return slog.LevelWarn, nil
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(name)); err != nil {
+		return 0, fmt.Errorf("unknown log level %q, expected debug, info, warn or error", name)
+	}
+	return level, nil
+}
+
+// NewLogger creates the logger from the LOGGING_* variables like
+// logger.NewLogger does, with its level controlled by the returned Level. It's
+// also set as the default logger.
+func NewLogger(prefix string) (*slog.Logger, *Level, error) {
+	var cfg logger.Config
+	if _, err := conf.Parse(prefix, &cfg); err != nil {
+		return nil, nil, fmt.Errorf("parsing logger config from prefix [%s]: %w", prefix, err)
+	}
+
+	configured := slog.LevelInfo
+	if cfg.Environment == "development" {
+		configured = slog.LevelDebug
+	}
+	if cfg.Level != "" {
+		var err error
+		if configured, err = ParseLevel(cfg.Level); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// The handler lets everything through, the level is checked by the wrapper
+	cfg.Level = "DEBUG"
+	base, err := logger.NewLoggerConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	level := NewLevel(configured)
+	log := slog.New(NewLevelHandler(base.Handler(), level))
+	slog.SetDefault(log)
+	return log, level, nil
+}
+
+// LevelHandler drops the records below a level that may change at runtime,
+// handing the others to the wrapped handler
+type LevelHandler struct {
+	handler slog.Handler
+	level   slog.Leveler
+}
+
+func NewLevelHandler(handler slog.Handler, level slog.Leveler) *LevelHandler {
+	return &LevelHandler{handler: handler, level: level}
+}
+
+func (h *LevelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level.Level() && h.handler.Enabled(ctx, level)
+}
+
+func (h *LevelHandler) Handle(ctx context.Context, record slog.Record) error {
+	return h.handler.Handle(ctx, record)
+}
+
+func (h *LevelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return NewLevelHandler(h.handler.WithAttrs(attrs), h.level)
+}
+
+func (h *LevelHandler) WithGroup(name string) slog.Handler {
+	return NewLevelHandler(h.handler.WithGroup(name), h.level)
+}
@@ -0,0 +1,66 @@
+package logging
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevelHandler(t *testing.T) {
+	var out bytes.Buffer
+	level := NewLevel(slog.LevelInfo)
+	log := slog.New(NewLevelHandler(slog.NewTextHandler(&out, &slog.HandlerOptions{Level: slog.LevelDebug}), level)).
+		With(slog.String("component", "import"))
+
+	log.Debug("hidden")
+	log.Info("shown")
+	assert.NotContains(t, out.String(), "hidden")
+	assert.Contains(t, out.String(), "shown")
+
+	level.Set(slog.LevelDebug, 0)
+	log.Debug("now shown")
+	assert.Contains(t, out.String(), "now shown")
+	assert.Contains(t, out.String(), "component=import", "loggers derived before the change follow it")
+	assert.True(t, level.Until().IsZero())
+}
+
+func TestLevelSetReverts(t *testing.T) {
+	level := NewLevel(slog.LevelWarn)
+
+	level.Set(slog.LevelDebug, 20*time.Millisecond)
+	assert.Equal(t, slog.LevelDebug, level.Level())
+	assert.False(t, level.Until().IsZero())
+
+	require.Eventually(t, func() bool {
+		return level.Level() == slog.LevelWarn
+	}, time.Second, 5*time.Millisecond)
+	assert.True(t, level.Until().IsZero())
+
+	// A later change cancels the pending revert
+	level.Set(slog.LevelDebug, 20*time.Millisecond)
+	level.Set(slog.LevelError, 0)
+	time.Sleep(40 * time.Millisecond)
+	assert.Equal(t, slog.LevelError, level.Level())
+	assert.Equal(t, slog.LevelWarn, level.Configured())
+}
+
+func TestParseLevel(t *testing.T) {
+	for name, want := range map[string]slog.Level{
+		"debug":   slog.LevelDebug,
+		"INFO":    slog.LevelInfo,
+		"warn":    slog.LevelWarn,
+		"Warning": slog.LevelWarn,
+		"error":   slog.LevelError,
+	} {
+		got, err := ParseLevel(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, got, name)
+	}
+
+	_, err := ParseLevel("verbose")
+	assert.Error(t, err)
+}
@@ -0,0 +1,188 @@
+package metrics
+
+import (
+	"cmp"
+	"math"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	// slotDuration is the resolution of the route stats
+	slotDuration = time.Minute
+	// slotCount is how many slots are kept, bounding the longest window
+	slotCount = 60
+)
+
+// latencyBounds are the upper bounds of the latency histogram buckets, the
+// percentiles are estimated from them
+var latencyBounds = [...]time.Duration{
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// RouteSummary sums up the requests served by a route over a window
+type RouteSummary struct {
+	Method       string
+	Route        string
+	Requests     int64
+	ClientErrors int64
+	ServerErrors int64
+	P95          time.Duration
+	Max          time.Duration
+}
+
+// ErrorRate is the share of the requests that failed on the server side
+func (s RouteSummary) ErrorRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return float64(s.ServerErrors) / float64(s.Requests)
+}
+
+// RouteStats keeps per route request counts, errors and latencies over the
+// last hour in one minute slots, so the service reports them without an
+// external metrics system
+type RouteStats struct {
+	mu     sync.Mutex
+	routes map[routeKey]*[slotCount]slot
+	now    func() time.Time
+}
+
+type routeKey struct {
+	method string
+	route  string
+}
+
+type slot struct {
+	minute       int64
+	requests     int64
+	clientErrors int64
+	serverErrors int64
+	max          time.Duration
+	latencies    [len(latencyBounds) + 1]int64
+}
+
+func NewRouteStats() *RouteStats {
+	return &RouteStats{
+		routes: map[routeKey]*[slotCount]slot{},
+		now:    time.Now,
+	}
+}
+
+// Middleware records every request under its route pattern. Requests that
+// don't match a route aren't recorded, keeping the number of routes bounded
+func (s *RouteStats) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		start := time.Now()
+		next.ServeHTTP(ww, r)
+
+		route := chi.RouteContext(r.Context()).RoutePattern()
+		if route == "" {
+			return
+		}
+		s.Record(r.Method, route, ww.Status(), time.Since(start))
+	})
+}
+
+// Record adds a request to the current slot of the route
+func (s *RouteStats) Record(method, route string, status int, duration time.Duration) {
+	minute := s.now().Unix() / int64(slotDuration/time.Second)
+	bucket, _ := slices.BinarySearch(latencyBounds[:], duration)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := routeKey{method: method, route: route}
+	slots, ok := s.routes[key]
+	if !ok {
+		slots = &[slotCount]slot{}
+		s.routes[key] = slots
+	}
+
+	current := &slots[minute%slotCount]
+	if current.minute != minute {
+		*current = slot{minute: minute}
+	}
+
+	current.requests++
+	switch {
+	case status >= http.StatusInternalServerError:
+		current.serverErrors++
+	case status >= http.StatusBadRequest:
+		current.clientErrors++
+	}
+	current.max = max(current.max, duration)
+	current.latencies[bucket]++
+}
+
+// Summary sums up every route with requests in the window ending now, busiest
+// first. Windows are rounded up to whole minutes and capped at an hour
+func (s *RouteStats) Summary(window time.Duration) []RouteSummary {
+	now := s.now().Unix() / int64(slotDuration/time.Second)
+	minutes := min(int64(math.Ceil(float64(window)/float64(slotDuration))), slotCount)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	summaries := []RouteSummary{}
+	for key, slots := range s.routes {
+		summary := RouteSummary{Method: key.method, Route: key.route}
+		var latencies [len(latencyBounds) + 1]int64
+		for _, slot := range slots {
+			if slot.requests == 0 || slot.minute <= now-minutes || slot.minute > now {
+				continue
+			}
+			summary.Requests += slot.requests
+			summary.ClientErrors += slot.clientErrors
+			summary.ServerErrors += slot.serverErrors
+			summary.Max = max(summary.Max, slot.max)
+			for i, count := range slot.latencies {
+				latencies[i] += count
+			}
+		}
+		if summary.Requests == 0 {
+			continue
+		}
+
+		summary.P95 = percentile(latencies, summary.Requests, 0.95, summary.Max)
+		summaries = append(summaries, summary)
+	}
+
+	slices.SortFunc(summaries, func(a, b RouteSummary) int {
+		if c := cmp.Compare(b.Requests, a.Requests); c != 0 {
+			return c
+		}
+		return cmp.Or(cmp.Compare(a.Route, b.Route), cmp.Compare(a.Method, b.Method))
+	})
+	return summaries
+}
+
+// percentile estimates the p-th percentile as the upper bound of the bucket
+// holding it, never above the slowest request
+func percentile(latencies [len(latencyBounds) + 1]int64, total int64, p float64, slowest time.Duration) time.Duration {
+	rank := int64(math.Ceil(float64(total) * p))
+	var seen int64
+	for i, count := range latencies {
+		seen += count
+		if seen >= rank && i < len(latencyBounds) {
+			return min(latencyBounds[i], slowest)
+		}
+	}
+	return slowest
+}
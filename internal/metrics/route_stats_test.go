@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestRouteStatsSummary(t *testing.T) {
+	now := time.Date(2025, 4, 1, 12, 0, 30, 0, time.UTC)
+	stats := NewRouteStats()
+	stats.now = func() time.Time { return now }
+
+	// 100 requests on the accounts list, one of them slow and one failing
+	for i := 0; i < 98; i++ {
+		stats.Record(http.MethodGet, "/api/v1/accounts", http.StatusOK, 8*time.Millisecond)
+	}
+	stats.Record(http.MethodGet, "/api/v1/accounts", http.StatusInternalServerError, 40*time.Millisecond)
+	stats.Record(http.MethodGet, "/api/v1/accounts", http.StatusOK, 3*time.Second)
+
+	// Half an hour ago, out of the five minute window
+	now = now.Add(-30 * time.Minute)
+	stats.Record(http.MethodPost, "/api/v1/transactions", http.StatusBadRequest, 20*time.Millisecond)
+	now = now.Add(30 * time.Minute)
+
+	recent := stats.Summary(5 * time.Minute)
+	if len(recent) != 1 {
+		t.Fatalf("expected 1 route in the last 5 minutes, got %d", len(recent))
+	}
+
+	accounts := recent[0]
+	if accounts.Method != http.MethodGet || accounts.Route != "/api/v1/accounts" {
+		t.Errorf("unexpected route %s %s", accounts.Method, accounts.Route)
+	}
+	if accounts.Requests != 100 || accounts.ServerErrors != 1 || accounts.ClientErrors != 0 {
+		t.Errorf("unexpected counts %+v", accounts)
+	}
+	if accounts.ErrorRate() != 0.01 {
+		t.Errorf("expected error rate 0.01, got %v", accounts.ErrorRate())
+	}
+	if accounts.P95 != 10*time.Millisecond {
+		t.Errorf("expected p95 10ms, got %s", accounts.P95)
+	}
+	if accounts.Max != 3*time.Second {
+		t.Errorf("expected max 3s, got %s", accounts.Max)
+	}
+
+	hour := stats.Summary(time.Hour)
+	if len(hour) != 2 {
+		t.Fatalf("expected 2 routes in the last hour, got %d", len(hour))
+	}
+	if hour[1].Route != "/api/v1/transactions" || hour[1].ClientErrors != 1 || hour[1].P95 != 20*time.Millisecond {
+		t.Errorf("unexpected transactions summary %+v", hour[1])
+	}
+
+	// An hour later every slot is stale
+	now = now.Add(time.Hour)
+	if got := stats.Summary(time.Hour); len(got) != 0 {
+		t.Errorf("expected no routes after an hour, got %+v", got)
+	}
+}
+
+func TestRouteStatsMiddleware(t *testing.T) {
+	stats := NewRouteStats()
+
+	r := chi.NewRouter()
+	r.Use(stats.Middleware)
+	r.Route("/accounts", func(r chi.Router) {
+		r.Get("/{id}", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+	})
+
+	for _, path := range []string{"/accounts/1", "/accounts/2", "/unknown"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	summaries := stats.Summary(time.Minute)
+	if len(summaries) != 1 {
+		t.Fatalf("expected only the matched route, got %+v", summaries)
+	}
+	if summaries[0].Route != "/accounts/{id}" || summaries[0].Requests != 2 || summaries[0].ClientErrors != 2 {
+		t.Errorf("unexpected summary %+v", summaries[0])
+	}
+}
@@ -0,0 +1,243 @@
+// Package query holds the parsers translating natural language questions into
+// the report queries of the finance use cases.
+package query
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// PatternParser understands questions built from known words: an intent
+// ("spend", "earn", "net worth", "balance"), a period ("in March", "last
+// month", "past 30 days", "2024") and category or account names. Words it
+// doesn't know are skipped, so "how much did I spend on food in March" and
+// "food spending march" ask the same.
+type PatternParser struct{}
+
+func NewPatternParser() *PatternParser {
+	return &PatternParser{}
+}
+
+// intentWords maps the words asking for each intent, the first one found in
+// the question wins
+var intentWords = map[string]entities.QueryIntent{
+	"spend":    entities.QueryIntentSpending,
+	"spent":    entities.QueryIntentSpending,
+	"spending": entities.QueryIntentSpending,
+	"expense":  entities.QueryIntentSpending,
+	"expenses": entities.QueryIntentSpending,
+	"paid":     entities.QueryIntentSpending,
+	"pay":      entities.QueryIntentSpending,
+	"cost":     entities.QueryIntentSpending,
+	"earn":     entities.QueryIntentIncome,
+	"earned":   entities.QueryIntentIncome,
+	"earnings": entities.QueryIntentIncome,
+	"income":   entities.QueryIntentIncome,
+	"make":     entities.QueryIntentIncome,
+	"made":     entities.QueryIntentIncome,
+	"receive":  entities.QueryIntentIncome,
+	"received": entities.QueryIntentIncome,
+	"balance":  entities.QueryIntentBalance,
+	"worth":    entities.QueryIntentNetWorth,
+}
+
+var months = map[string]time.Month{
+	"january": time.January, "jan": time.January,
+	"february": time.February, "feb": time.February,
+	"march": time.March, "mar": time.March,
+	"april": time.April, "apr": time.April,
+	"may":  time.May,
+	"june": time.June, "jun": time.June,
+	"july": time.July, "jul": time.July,
+	"august": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September, "sept": time.September,
+	"october": time.October, "oct": time.October,
+	"november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December,
+}
+
+// ParseQuery translates the question. Without a period, spending and income
+// cover the current month to date. A balance question naming no account asks
+// for the net worth.
+func (p *PatternParser) ParseQuery(ctx context.Context, question string, vocabulary entities.QueryVocabulary) (entities.Query, error) {
+	words := tokenize(question)
+	var query entities.Query
+
+	for i, word := range words {
+		if word == "net" && i+1 < len(words) && words[i+1] == "worth" {
+			query.Intent = entities.QueryIntentNetWorth
+			break
+		}
+		if intent, ok := intentWords[word]; ok {
+			query.Intent = intent
+			break
+		}
+	}
+	if query.Intent == "" {
+		return entities.Query{}, fmt.Errorf("couldn't tell what %q asks for, ask about spending, income, net worth or a balance: %w", question, domain.ErrMalformedParameters)
+	}
+
+	// The names are taken out of the words before looking for the period, so
+	// an account like "March Savings" isn't read as a month
+	categoryNames := make([]string, len(vocabulary.Categories))
+	for i, category := range vocabulary.Categories {
+		categoryNames[i] = category.Name
+	}
+	if i, rest, ok := matchName(words, categoryNames); ok {
+		query.CategoryID, words = vocabulary.Categories[i].ID, rest
+	}
+	accountNames := make([]string, len(vocabulary.Accounts))
+	for i, account := range vocabulary.Accounts {
+		accountNames[i] = account.Name
+	}
+	if i, rest, ok := matchName(words, accountNames); ok {
+		query.AccountID, words = vocabulary.Accounts[i].ID, rest
+	}
+
+	// "What's my balance" and "what is Checking worth"
+	switch {
+	case query.Intent == entities.QueryIntentBalance && query.AccountID == "":
+		query.Intent = entities.QueryIntentNetWorth
+	case query.Intent == entities.QueryIntentNetWorth && query.AccountID != "":
+		query.Intent = entities.QueryIntentBalance
+	}
+
+	if query.Intent == entities.QueryIntentSpending || query.Intent == entities.QueryIntentIncome {
+		query.From, query.To = parsePeriod(words, vocabulary.Now)
+	}
+	return query, nil
+}
+
+// tokenize lowercases the question and splits it into words, dropping the
+// punctuation
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// parsePeriod finds the period in words, the current month to date when there
+// is none. Weeks start on Monday.
+func parsePeriod(words []string, now time.Time) (time.Time, time.Time) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	next := func(i int) string {
+		if i+1 < len(words) {
+			return words[i+1]
+		}
+		return ""
+	}
+
+	for i, word := range words {
+		switch word {
+		case "today":
+			return today, today
+		case "yesterday":
+			yesterday := today.AddDate(0, 0, -1)
+			return yesterday, yesterday
+		case "this", "last", "past", "previous":
+			// "last 30 days" and "past 2 weeks"
+			if n, err := strconv.Atoi(next(i)); err == nil && n > 0 && i+2 < len(words) {
+				switch strings.TrimSuffix(words[i+2], "s") {
+				case "day":
+					return today.AddDate(0, 0, -(n - 1)), today
+				case "week":
+					return today.AddDate(0, 0, -(7*n - 1)), today
+				case "month":
+					return today.AddDate(0, -n, 1), today
+				}
+			}
+
+			offset := 0
+			if word != "this" {
+				offset = -1
+			}
+			switch next(i) {
+			case "week":
+				monday := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+				from := monday.AddDate(0, 0, 7*offset)
+				return from, minDate(from.AddDate(0, 0, 6), today)
+			case "month":
+				from := time.Date(today.Year(), today.Month()+time.Month(offset), 1, 0, 0, 0, 0, today.Location())
+				return from, minDate(from.AddDate(0, 1, -1), today)
+			case "year":
+				from := time.Date(today.Year()+offset, time.January, 1, 0, 0, 0, 0, today.Location())
+				return from, minDate(from.AddDate(1, 0, -1), today)
+			}
+		}
+
+		if month, ok := months[word]; ok {
+			year := today.Year()
+			if y, ok := parseYear(next(i)); ok {
+				year = y
+			} else if month > today.Month() {
+				// The latest one that already started
+				year--
+			}
+			from := time.Date(year, month, 1, 0, 0, 0, 0, today.Location())
+			return from, minDate(from.AddDate(0, 1, -1), today)
+		}
+
+		if year, ok := parseYear(word); ok {
+			from := time.Date(year, time.January, 1, 0, 0, 0, 0, today.Location())
+			return from, minDate(from.AddDate(1, 0, -1), today)
+		}
+	}
+
+	return time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location()), today
+}
+
+func parseYear(word string) (int, bool) {
+	if len(word) != 4 {
+		return 0, false
+	}
+	year, err := strconv.Atoi(word)
+	return year, err == nil && year >= 1900
+}
+
+// minDate keeps periods from reaching past today
+func minDate(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// matchName finds the longest of names in words, returning its index and the
+// words without it
+func matchName(words []string, names []string) (int, []string, bool) {
+	best, bestAt, bestLen := -1, 0, 0
+	for i, name := range names {
+		nameWords := tokenize(name)
+		if len(nameWords) <= bestLen {
+			continue
+		}
+
+		for at := 0; at+len(nameWords) <= len(words); at++ {
+			if equalWords(words[at:at+len(nameWords)], nameWords) {
+				best, bestAt, bestLen = i, at, len(nameWords)
+				break
+			}
+		}
+	}
+	if best < 0 {
+		return 0, words, false
+	}
+
+	rest := append(words[:bestAt:bestAt], words[bestAt+bestLen:]...)
+	return best, rest, true
+}
+
+func equalWords(a, b []string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package query
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatternParser(t *testing.T) {
+	// A Saturday
+	now := time.Date(2025, time.May, 10, 15, 0, 0, 0, time.UTC)
+	date := func(month time.Month, day int, year ...int) time.Time {
+		y := 2025
+		if len(year) > 0 {
+			y = year[0]
+		}
+		return time.Date(y, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	vocabulary := entities.QueryVocabulary{
+		Categories: []entities.Category{
+			{ID: "cat-food", Name: "Food"},
+			{ID: "cat-eating-out", Name: "Food Delivery"},
+			{ID: "cat-salary", Name: "Salary"},
+		},
+		Accounts: []entities.Account{
+			{ID: "acc-checking", Name: "Checking"},
+			{ID: "acc-march", Name: "March Savings"},
+		},
+		Now: now,
+	}
+
+	tests := []struct {
+		question string
+		want     entities.Query
+	}{
+		{
+			question: "How much did I spend on food in March?",
+			want:     entities.Query{Intent: entities.QueryIntentSpending, CategoryID: "cat-food", From: date(time.March, 1), To: date(time.March, 31)},
+		},
+		{
+			question: "food delivery spending last month",
+			want:     entities.Query{Intent: entities.QueryIntentSpending, CategoryID: "cat-eating-out", From: date(time.April, 1), To: date(time.April, 30)},
+		},
+		{
+			question: "what did I earn in december",
+			want:     entities.Query{Intent: entities.QueryIntentIncome, From: date(time.December, 1, 2024), To: date(time.December, 31, 2024)},
+		},
+		{
+			question: "expenses on checking this week",
+			want:     entities.Query{Intent: entities.QueryIntentSpending, AccountID: "acc-checking", From: date(time.May, 5), To: date(time.May, 10)},
+		},
+		{
+			question: "spent in the past 30 days",
+			want:     entities.Query{Intent: entities.QueryIntentSpending, From: date(time.April, 11), To: date(time.May, 10)},
+		},
+		{
+			question: "salary received in 2024",
+			want:     entities.Query{Intent: entities.QueryIntentIncome, CategoryID: "cat-salary", From: date(time.January, 1, 2024), To: date(time.December, 31, 2024)},
+		},
+		{
+			question: "how much have I spent",
+			want:     entities.Query{Intent: entities.QueryIntentSpending, From: date(time.May, 1), To: date(time.May, 10)},
+		},
+		{
+			question: "spending on march savings yesterday",
+			want:     entities.Query{Intent: entities.QueryIntentSpending, AccountID: "acc-march", From: date(time.May, 9), To: date(time.May, 9)},
+		},
+		{
+			question: "What's my net worth?",
+			want:     entities.Query{Intent: entities.QueryIntentNetWorth},
+		},
+		{
+			question: "checking balance",
+			want:     entities.Query{Intent: entities.QueryIntentBalance, AccountID: "acc-checking"},
+		},
+		{
+			question: "what's my balance",
+			want:     entities.Query{Intent: entities.QueryIntentNetWorth},
+		},
+	}
+
+	parser := NewPatternParser()
+	for _, tt := range tests {
+		t.Run(tt.question, func(t *testing.T) {
+			query, err := parser.ParseQuery(context.Background(), tt.question, vocabulary)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, query)
+		})
+	}
+
+	t.Run("unknown intent", func(t *testing.T) {
+		_, err := parser.ParseQuery(context.Background(), "tell me a joke", vocabulary)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+}
@@ -0,0 +1,113 @@
+// Package realtime fans out the changes made through the API to the clients
+// listening for them, such as the WebSocket API.
+package realtime
+
+import (
+	"slices"
+	"sync"
+)
+
+// Topics clients can subscribe to
+const (
+	TopicAccounts     = "accounts"
+	TopicTransactions = "transactions"
+	TopicBalances     = "balances"
+	TopicBudgetAlerts = "budget_alerts"
+)
+
+var Topics = []string{TopicAccounts, TopicTransactions, TopicBalances, TopicBudgetAlerts}
+
+// ValidTopic reports whether topic is one of Topics
+func ValidTopic(topic string) bool {
+	return slices.Contains(Topics, topic)
+}
+
+// Event actions
+const (
+	ActionCreated  = "created"
+	ActionUpdated  = "updated"
+	ActionDeleted  = "deleted"
+	ActionSnapshot = "snapshot"
+)
+
+// Event is a change of a resource of a topic. Data holds the resource as the
+// API renders it, or its ID when deleted. UserID and BookID are the user and
+// book the change was made for, UserID is empty while authentication is off.
+type Event struct {
+	Topic  string
+	Action string
+	Data   any
+	UserID string
+	BookID string
+}
+
+// Hub delivers every published event to every subscription. Publishing never
+// blocks: a subscription whose buffer is full is dropped and its channel
+// closed, so a slow client can't hold up the requests making the changes.
+type Hub struct {
+	mu            sync.Mutex
+	subscriptions map[*Subscription]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		subscriptions: map[*Subscription]struct{}{},
+	}
+}
+
+// Subscription receives the events published after it was created
+type Subscription struct {
+	hub    *Hub
+	events chan Event
+}
+
+// Subscribe creates a subscription buffering up to buffer events
+func (h *Hub) Subscribe(buffer int) *Subscription {
+	s := &Subscription{hub: h, events: make(chan Event, buffer)}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subscriptions[s] = struct{}{}
+	return s
+}
+
+// Active reports whether anyone is subscribed, so publishers can skip the
+// work of building events nobody receives
+func (h *Hub) Active() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subscriptions) > 0
+}
+
+// Publish delivers the event to the subscriptions, dropping the ones that
+// fell behind
+func (h *Hub) Publish(event Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for s := range h.subscriptions {
+		select {
+		case s.events <- event:
+		default:
+			delete(h.subscriptions, s)
+			close(s.events)
+		}
+	}
+}
+
+// Events returns the channel of events, closed when the subscription is
+// closed or dropped for falling behind
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Close stops the subscription, it's safe to call more than once
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	if _, ok := s.hub.subscriptions[s]; ok {
+		delete(s.hub.subscriptions, s)
+		close(s.events)
+	}
+}
@@ -0,0 +1,42 @@
+package realtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHub(t *testing.T) {
+	hub := NewHub()
+	assert.False(t, hub.Active())
+
+	fast := hub.Subscribe(4)
+	slow := hub.Subscribe(1)
+	assert.True(t, hub.Active())
+
+	hub.Publish(Event{Topic: TopicAccounts, Action: ActionCreated, Data: "acc-1"})
+	hub.Publish(Event{Topic: TopicBalances, Action: ActionUpdated, Data: "acc-1"})
+
+	assert.Equal(t, Event{Topic: TopicAccounts, Action: ActionCreated, Data: "acc-1"}, <-fast.Events())
+	assert.Equal(t, Event{Topic: TopicBalances, Action: ActionUpdated, Data: "acc-1"}, <-fast.Events())
+
+	// The slow subscription kept the first event and was dropped on the second
+	assert.Equal(t, Event{Topic: TopicAccounts, Action: ActionCreated, Data: "acc-1"}, <-slow.Events())
+	_, ok := <-slow.Events()
+	assert.False(t, ok, "dropped subscriptions are closed")
+	slow.Close()
+
+	fast.Close()
+	fast.Close()
+	_, ok = <-fast.Events()
+	assert.False(t, ok)
+	assert.False(t, hub.Active())
+
+	// Publishing without subscribers is a no-op
+	hub.Publish(Event{Topic: TopicTransactions, Action: ActionDeleted})
+}
+
+func TestValidTopic(t *testing.T) {
+	assert.True(t, ValidTopic(TopicBalances))
+	assert.False(t, ValidTopic("categories"))
+}
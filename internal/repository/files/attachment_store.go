@@ -0,0 +1,90 @@
+package files
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// AttachmentStore keeps the files attached to the transactions in a
+// directory, each key a path under it
+type AttachmentStore struct {
+	dir string
+}
+
+func NewAttachmentStore(dir string) *AttachmentStore {
+	return &AttachmentStore{
+		dir: dir,
+	}
+}
+
+// SaveFile writes a file, going through a temporary file so a crash never
+// leaves a truncated one behind
+func (s *AttachmentStore) SaveFile(ctx context.Context, key, contentType string, content []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".attachment-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *AttachmentStore) OpenFile(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("attachment file %w", domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// DeleteFile removes a file, files already gone included
+func (s *AttachmentStore) DeleteFile(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path only accepts relative keys without .. in them, keeping callers inside
+// the directory
+func (s *AttachmentStore) path(key string) (string, error) {
+	if key == "" || !filepath.IsLocal(key) || strings.Contains(key, `\`) {
+		return "", fmt.Errorf("%w: invalid attachment key %s", domain.ErrMalformedParameters, key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
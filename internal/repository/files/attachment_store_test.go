@@ -0,0 +1,47 @@
+package files
+
+import (
+	"context"
+	"finance/domain"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "attachments")
+	store := NewAttachmentStore(dir)
+	ctx := context.Background()
+
+	const key = "5f0c6a3e-8f1b-4c1d-9a2e-3b4c5d6e7f80/0123456789abcdef"
+	require.NoError(t, store.SaveFile(ctx, key, "image/png", []byte("receipt")))
+	assert.FileExists(t, filepath.Join(dir, "5f0c6a3e-8f1b-4c1d-9a2e-3b4c5d6e7f80", "0123456789abcdef"))
+
+	t.Run("open", func(t *testing.T) {
+		file, err := store.OpenFile(ctx, key)
+		require.NoError(t, err)
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.Equal(t, "receipt", string(content))
+	})
+
+	t.Run("keys outside the directory are rejected", func(t *testing.T) {
+		for _, key := range []string{"../secret", "/etc/passwd", "", `..\secret`} {
+			_, err := store.OpenFile(ctx, key)
+			assert.ErrorIs(t, err, domain.ErrMalformedParameters, key)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, store.DeleteFile(ctx, key))
+
+		_, err := store.OpenFile(ctx, key)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.NoError(t, store.DeleteFile(ctx, key), "files already gone are deleted")
+	})
+}
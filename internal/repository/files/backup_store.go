@@ -0,0 +1,367 @@
+package files
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+)
+
+// backupFormatVersion is bumped when the backup document changes shape, so
+// older services refuse newer backups instead of restoring them partially
+const backupFormatVersion = 1
+
+const (
+	backupPrefix     = "finance-"
+	backupSuffix     = ".json"
+	backupTimeLayout = "20060102T150405Z"
+)
+
+// BackupStore keeps the backups as JSON documents in a directory, named after
+// the time they were taken (e.g. finance-20250401T033000Z.json)
+type BackupStore struct {
+	dir string
+}
+
+func NewBackupStore(dir string) *BackupStore {
+	return &BackupStore{
+		dir: dir,
+	}
+}
+
+// The backup document spells the amounts out in minor units and the assets by
+// code, so it doesn't depend on how the entities marshal
+type backupDocument struct {
+	Version      int                   `json:"version"`
+	CreatedAt    time.Time             `json:"created_at"`
+	Settings     settingsDocument      `json:"settings"`
+	UserSettings map[string]string     `json:"user_settings"`
+	Categories   []entities.Category   `json:"categories"`
+	Accounts     []accountDocument     `json:"accounts"`
+	Transactions []transactionDocument `json:"transactions"`
+}
+
+type settingsDocument struct {
+	Currency             string            `json:"currency"`
+	Locale               string            `json:"locale"`
+	FiscalMonthStartDay  int               `json:"fiscal_month_start_day"`
+	NotificationsEnabled bool              `json:"notifications_enabled"`
+	NotificationEmail    string            `json:"notification_email"`
+	APIKeys              map[string]string `json:"api_keys"`
+}
+
+type accountDocument struct {
+	ID                  string                         `json:"id"`
+	Name                string                         `json:"name"`
+	Type                entities.AccountType           `json:"type"`
+	Asset               string                         `json:"asset"`
+	Description         string                         `json:"description"`
+	Classification      entities.AccountClassification `json:"classification,omitempty"`
+	Institution         string                         `json:"institution,omitempty"`
+	AccountNumberLast4  string                         `json:"account_number_last4,omitempty"`
+	Color               string                         `json:"color,omitempty"`
+	Icon                string                         `json:"icon,omitempty"`
+	StatementClosingDay int                            `json:"statement_closing_day,omitempty"`
+	PaymentDueDay       int                            `json:"payment_due_day,omitempty"`
+	CreatedAt           time.Time                      `json:"created_at"`
+}
+
+type transactionDocument struct {
+	ID          string                     `json:"id"`
+	AccountID   string                     `json:"account_id"`
+	CategoryID  string                     `json:"category_id,omitempty"`
+	Asset       string                     `json:"asset"`
+	Amount      string                     `json:"amount"`
+	Description string                     `json:"description"`
+	Date        time.Time                  `json:"date"`
+	Status      entities.TransactionStatus `json:"status"`
+	CreatedAt   time.Time                  `json:"created_at"`
+}
+
+// SaveBackup writes the backup next to the others, going through a temporary
+// file so a crash never leaves a truncated backup behind
+func (s *BackupStore) SaveBackup(ctx context.Context, backup entities.Backup) (entities.BackupFile, error) {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return entities.BackupFile{}, err
+	}
+
+	data, err := EncodeBackup(backup)
+	if err != nil {
+		return entities.BackupFile{}, err
+	}
+
+	name := BackupName(backup.CreatedAt)
+	tmp, err := os.CreateTemp(s.dir, ".backup-*")
+	if err != nil {
+		return entities.BackupFile{}, err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return entities.BackupFile{}, err
+	}
+	if err := tmp.Close(); err != nil {
+		return entities.BackupFile{}, err
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(s.dir, name)); err != nil {
+		return entities.BackupFile{}, err
+	}
+
+	return entities.BackupFile{
+		Name:      name,
+		CreatedAt: backup.CreatedAt.UTC().Truncate(time.Second),
+		Size:      int64(len(data)),
+	}, nil
+}
+
+// ListBackups returns the backups in the directory, which may not exist yet
+func (s *BackupStore) ListBackups(ctx context.Context) ([]entities.BackupFile, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []entities.BackupFile{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	files := []entities.BackupFile{}
+	for _, entry := range entries {
+		created, ok := ParseBackupName(entry.Name())
+		if !ok || entry.IsDir() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, entities.BackupFile{
+			Name:      entry.Name(),
+			CreatedAt: created,
+			Size:      info.Size(),
+		})
+	}
+	return files, nil
+}
+
+// LoadBackup reads a backup by name
+func (s *BackupStore) LoadBackup(ctx context.Context, name string) (entities.Backup, error) {
+	path, err := s.path(name)
+	if err != nil {
+		return entities.Backup{}, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return entities.Backup{}, fmt.Errorf("backup %w", domain.ErrNotFound)
+	}
+	if err != nil {
+		return entities.Backup{}, err
+	}
+	return DecodeBackup(name, data)
+}
+
+// DeleteBackup removes a backup by name
+func (s *BackupStore) DeleteBackup(ctx context.Context, name string) error {
+	path, err := s.path(name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("backup %w", domain.ErrNotFound)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// path only accepts backup names, keeping callers inside the directory
+func (s *BackupStore) path(name string) (string, error) {
+	if _, ok := ParseBackupName(name); !ok {
+		return "", fmt.Errorf("%w: invalid backup name %s", domain.ErrMalformedParameters, name)
+	}
+	return filepath.Join(s.dir, name), nil
+}
+
+// BackupName is the name of a backup taken at createdAt, which sorts like the
+// times do
+func BackupName(createdAt time.Time) string {
+	return backupPrefix + createdAt.UTC().Format(backupTimeLayout) + backupSuffix
+}
+
+// ParseBackupName returns the time a backup was taken from its name, false
+// for the names of other files
+func ParseBackupName(name string) (time.Time, bool) {
+	if !strings.HasPrefix(name, backupPrefix) || !strings.HasSuffix(name, backupSuffix) {
+		return time.Time{}, false
+	}
+
+	created, err := time.Parse(backupTimeLayout, strings.TrimSuffix(strings.TrimPrefix(name, backupPrefix), backupSuffix))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return created, true
+}
+
+// EncodeBackup writes a backup as the JSON document the stores keep
+func EncodeBackup(backup entities.Backup) ([]byte, error) {
+	return json.MarshalIndent(newBackupDocument(backup), "", "  ")
+}
+
+// DecodeBackup reads the JSON document of the backup name, refusing the ones
+// of other format versions
+func DecodeBackup(name string, data []byte) (entities.Backup, error) {
+	var document backupDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return entities.Backup{}, fmt.Errorf("%w: backup %s is not valid JSON: %v", domain.ErrMalformedParameters, name, err)
+	}
+	if document.Version != backupFormatVersion {
+		return entities.Backup{}, fmt.Errorf("%w: backup %s has format version %d, expected %d", domain.ErrMalformedParameters, name, document.Version, backupFormatVersion)
+	}
+	return document.backup()
+}
+
+func newBackupDocument(backup entities.Backup) backupDocument {
+	document := backupDocument{
+		Version:   backupFormatVersion,
+		CreatedAt: backup.CreatedAt.UTC(),
+		Settings: settingsDocument{
+			Currency:             backup.Settings.Currency.Asset,
+			Locale:               backup.Settings.Locale,
+			FiscalMonthStartDay:  backup.Settings.FiscalMonthStartDay,
+			NotificationsEnabled: backup.Settings.NotificationsEnabled,
+			NotificationEmail:    backup.Settings.NotificationEmail,
+			APIKeys:              backup.Settings.APIKeys,
+		},
+		UserSettings: make(map[string]string, len(backup.UserSettings)),
+		Categories:   backup.Categories,
+		Accounts:     make([]accountDocument, 0, len(backup.Accounts)),
+		Transactions: make([]transactionDocument, 0, len(backup.Transactions)),
+	}
+
+	for _, setting := range backup.UserSettings {
+		document.UserSettings[string(setting.Key)] = setting.Value
+	}
+
+	for _, account := range backup.Accounts {
+		document.Accounts = append(document.Accounts, accountDocument{
+			ID:                  account.ID,
+			Name:                account.Name,
+			Type:                account.Type,
+			Asset:               account.Asset.Asset,
+			Description:         account.Description,
+			Classification:      account.Classification,
+			Institution:         account.Institution,
+			AccountNumberLast4:  account.AccountNumberLast4,
+			Color:               account.Color,
+			Icon:                account.Icon,
+			StatementClosingDay: account.StatementClosingDay,
+			PaymentDueDay:       account.PaymentDueDay,
+			CreatedAt:           account.CreatedAt,
+		})
+	}
+
+	for _, transaction := range backup.Transactions {
+		amount := "0"
+		if transaction.Monetary.Amount != nil {
+			amount = transaction.Monetary.Amount.String()
+		}
+		document.Transactions = append(document.Transactions, transactionDocument{
+			ID:          transaction.ID,
+			AccountID:   transaction.AccountID,
+			CategoryID:  transaction.CategoryID,
+			Asset:       transaction.Monetary.Asset.Asset,
+			Amount:      amount,
+			Description: transaction.Description,
+			Date:        transaction.Date,
+			Status:      transaction.Status,
+			CreatedAt:   transaction.CreatedAt,
+		})
+	}
+
+	return document
+}
+
+func (d backupDocument) backup() (entities.Backup, error) {
+	currency, ok := entities.FindSupportedAsset(d.Settings.Currency)
+	if !ok {
+		return entities.Backup{}, fmt.Errorf("%w: unknown settings currency %s", domain.ErrMalformedParameters, d.Settings.Currency)
+	}
+
+	backup := entities.Backup{
+		CreatedAt: d.CreatedAt,
+		Settings: entities.Settings{
+			Currency:             currency,
+			Locale:               d.Settings.Locale,
+			FiscalMonthStartDay:  d.Settings.FiscalMonthStartDay,
+			NotificationsEnabled: d.Settings.NotificationsEnabled,
+			NotificationEmail:    d.Settings.NotificationEmail,
+			APIKeys:              d.Settings.APIKeys,
+		},
+		UserSettings: make([]entities.UserSetting, 0, len(d.UserSettings)),
+		Categories:   d.Categories,
+		Accounts:     make([]entities.Account, 0, len(d.Accounts)),
+		Transactions: make([]entities.Transaction, 0, len(d.Transactions)),
+	}
+
+	for key, value := range d.UserSettings {
+		backup.UserSettings = append(backup.UserSettings, entities.UserSetting{Key: entities.UserSettingKey(key), Value: value})
+	}
+
+	for _, account := range d.Accounts {
+		asset, ok := entities.FindSupportedAsset(account.Asset)
+		if !ok {
+			return entities.Backup{}, fmt.Errorf("%w: unknown asset %s of account %s", domain.ErrMalformedParameters, account.Asset, account.ID)
+		}
+		backup.Accounts = append(backup.Accounts, entities.Account{
+			ID:                  account.ID,
+			Name:                account.Name,
+			Type:                account.Type,
+			Asset:               asset,
+			Description:         account.Description,
+			Classification:      account.Classification,
+			Institution:         account.Institution,
+			AccountNumberLast4:  account.AccountNumberLast4,
+			Color:               account.Color,
+			Icon:                account.Icon,
+			StatementClosingDay: account.StatementClosingDay,
+			PaymentDueDay:       account.PaymentDueDay,
+			CreatedAt:           account.CreatedAt,
+		})
+	}
+
+	for _, transaction := range d.Transactions {
+		asset, ok := entities.FindSupportedAsset(transaction.Asset)
+		if !ok {
+			return entities.Backup{}, fmt.Errorf("%w: unknown asset %s of transaction %s", domain.ErrMalformedParameters, transaction.Asset, transaction.ID)
+		}
+		amount, ok := new(big.Int).SetString(transaction.Amount, 10)
+		if !ok {
+			return entities.Backup{}, fmt.Errorf("%w: invalid amount %s of transaction %s", domain.ErrMalformedParameters, transaction.Amount, transaction.ID)
+		}
+		backup.Transactions = append(backup.Transactions, entities.Transaction{
+			ID:          transaction.ID,
+			AccountID:   transaction.AccountID,
+			CategoryID:  transaction.CategoryID,
+			Monetary:    monetary.Monetary{Asset: asset, Amount: amount},
+			Description: transaction.Description,
+			Date:        transaction.Date,
+			Status:      transaction.Status,
+			CreatedAt:   transaction.CreatedAt,
+		})
+	}
+
+	return backup, nil
+}
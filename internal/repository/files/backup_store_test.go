@@ -0,0 +1,97 @@
+package files
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "backups")
+	store := NewBackupStore(dir)
+	ctx := context.Background()
+
+	t.Run("list before the first backup", func(t *testing.T) {
+		files, err := store.ListBackups(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+
+	created := time.Date(2025, time.April, 1, 3, 30, 0, 0, time.UTC)
+	backup := entities.Backup{
+		CreatedAt:    created,
+		Settings:     entities.Settings{Currency: monetary.BRL, Locale: "pt-BR", FiscalMonthStartDay: 5, APIKeys: map[string]string{}},
+		UserSettings: []entities.UserSetting{{Key: entities.UserSettingTimezone, Value: "America/Sao_Paulo"}},
+		Categories:   []entities.Category{{ID: "cat-1", Name: "Groceries", Type: entities.CategoryTypeExpense, Color: "#10B981"}},
+		Accounts: []entities.Account{
+			{ID: "acc-1", Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL, Institution: "Nubank", AccountNumberLast4: "1234"},
+		},
+		Transactions: []entities.Transaction{
+			{
+				ID:         "tx-1",
+				AccountID:  "acc-1",
+				CategoryID: "cat-1",
+				Monetary:   monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(-123456789)},
+				Date:       created.AddDate(0, 0, -1),
+				Status:     entities.TransactionStatusCleared,
+			},
+		},
+	}
+
+	file, err := store.SaveBackup(ctx, backup)
+	require.NoError(t, err)
+	assert.Equal(t, "finance-20250401T033000Z.json", file.Name)
+	assert.Positive(t, file.Size)
+
+	t.Run("list", func(t *testing.T) {
+		// Files that aren't backups are ignored
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("hi"), 0o600))
+
+		files, err := store.ListBackups(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []entities.BackupFile{file}, files)
+	})
+
+	t.Run("load", func(t *testing.T) {
+		loaded, err := store.LoadBackup(ctx, file.Name)
+		require.NoError(t, err)
+		assert.Equal(t, backup.Settings.Currency, loaded.Settings.Currency)
+		assert.Equal(t, backup.UserSettings, loaded.UserSettings)
+		assert.Equal(t, backup.Categories, loaded.Categories)
+		assert.Equal(t, backup.Accounts, loaded.Accounts)
+		require.Len(t, loaded.Transactions, 1)
+		assert.Equal(t, "-123456789", loaded.Transactions[0].Monetary.Amount.String())
+		assert.Equal(t, monetary.BRL, loaded.Transactions[0].Monetary.Asset)
+		assert.True(t, backup.Transactions[0].Date.Equal(loaded.Transactions[0].Date))
+	})
+
+	t.Run("names outside the directory are rejected", func(t *testing.T) {
+		_, err := store.LoadBackup(ctx, "../finance-20250401T033000Z.json")
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+
+	t.Run("newer format versions are rejected", func(t *testing.T) {
+		name := "finance-20250402T033000Z.json"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(`{"version": 2}`), 0o600))
+
+		_, err := store.LoadBackup(ctx, name)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, store.DeleteBackup(ctx, file.Name))
+
+		_, err := store.LoadBackup(ctx, file.Name)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.ErrorIs(t, store.DeleteBackup(ctx, file.Name), domain.ErrNotFound)
+	})
+}
@@ -2,46 +2,121 @@ package pg
 
 import (
 	"context"
-	"database/sql"
 	"finance/domain/entities"
+	"finance/internal/encryption"
 	"finance/internal/repository/pg/gen"
+	"fmt"
+	"math/big"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/guilhermebr/gox/monetary"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// The list queries are built here instead of sqlc since the ORDER BY depends on the request
+const (
+	listAccountsQuery = `SELECT
+    a.id, a.name, a.type, a.description, a.asset, a.created_at, a.updated_at, a.classification, a.institution, a.account_number_last4, a.color, a.icon, a.statement_closing_day, a.payment_due_day, a.book_id, a.user_id,
+    activity.transaction_count, activity.last_transaction_date
+FROM accounts a
+` + accountActivityJoin + `
+WHERE a.book_id = $1 AND ($2::uuid IS NULL OR a.user_id = $2)
+ORDER BY %s`
+
+	listAccountsWithBalancesQuery = `SELECT
+    a.id, a.name, a.type, a.description, a.asset, a.created_at, a.updated_at, a.classification, a.institution, a.account_number_last4, a.color, a.icon, a.statement_closing_day, a.payment_due_day, a.book_id, a.user_id,
+    COALESCE(b.current_balance, 0) as current_balance,
+    COALESCE(b.pending_balance, 0) as pending_balance,
+    COALESCE(b.available_balance, 0) as available_balance,
+    b.last_calculated,
+    activity.transaction_count, activity.last_transaction_date
+FROM accounts a
+LEFT JOIN balances b ON a.id = b.account_id
+` + accountActivityJoin + `
+WHERE a.book_id = $1 AND ($2::uuid IS NULL OR a.user_id = $2)
+ORDER BY %s`
+
+	// Counted per account through idx_transactions_account_id_date, which also
+	// holds the latest date first
+	accountActivityJoin = `CROSS JOIN LATERAL (
+    SELECT COUNT(*) AS transaction_count, MAX(t.date)::date AS last_transaction_date
+    FROM transactions t
+    WHERE t.account_id = a.id AND t.deleted_at IS NULL
+) activity`
+)
+
+// accountBookConstraint is the foreign key of the account's book
+const accountBookConstraint = "accounts_book_id_fkey"
+
+// FieldCipher encrypts the sensitive columns before they're stored
+type FieldCipher interface {
+	Encrypt(ctx context.Context, plaintext string) (string, error)
+	Decrypt(ctx context.Context, value string) (string, error)
+	// Current tells whether a stored value is encrypted with the current key
+	Current(ctx context.Context, value string) (bool, error)
+}
+
 type AccountRepository struct {
 	queries *gen.Queries
 	db      *pgxpool.Pool
+	cipher  FieldCipher
 }
 
 func NewAccountRepository(db *pgxpool.Pool) *AccountRepository {
 	return &AccountRepository{
 		queries: gen.New(db),
 		db:      db,
+		cipher:  encryption.NewCipher(nil),
 	}
 }
 
+// UseCipher encrypts the account numbers with the cipher, they're stored in
+// plaintext otherwise
+func (r *AccountRepository) UseCipher(cipher FieldCipher) {
+	r.cipher = cipher
+}
+
 func (r *AccountRepository) CreateAccount(ctx context.Context, account entities.Account) (entities.Account, error) {
-	result, err := r.queries.CreateAccount(ctx, account.Name, string(account.Type), account.Description, account.Asset.Asset)
+	bookID, err := contextBook(ctx)
 	if err != nil {
 		return entities.Account{}, err
 	}
 
-	asset, ok := monetary.FindAssetByName(result.Asset)
+	accountNumber, err := r.cipher.Encrypt(ctx, account.AccountNumberLast4)
+	if err != nil {
+		return entities.Account{}, fmt.Errorf("failed to encrypt account number: %w", err)
+	}
+
+	result, err := r.queries.CreateAccount(ctx, account.Name, string(account.Type), account.Description, account.Asset.Asset, nullableClassification(account.Classification), account.Institution, accountNumber, account.Color, account.Icon, int32(account.StatementClosingDay), int32(account.PaymentDueDay), bookID)
+	if err != nil {
+		return entities.Account{}, missingReference(err, accountBookConstraint, "book")
+	}
+
+	asset, ok := entities.FindSupportedAsset(result.Asset)
 	if !ok {
 		asset = monetary.BRL // default fallback
 	}
 
 	return entities.Account{
-		ID:          result.ID.String(),
-		Name:        result.Name,
-		Type:        entities.AccountType(result.Type),
-		Asset:       asset,
-		Description: result.Description,
-		CreatedAt:   result.CreatedAt,
-		UpdatedAt:   result.UpdatedAt,
+		ID:                  result.ID.String(),
+		Name:                result.Name,
+		Type:                entities.AccountType(result.Type),
+		Asset:               asset,
+		Description:         result.Description,
+		Classification:      classificationOf(result.Classification),
+		BookID:              result.BookID.String(),
+		OwnerID:             uuidString(result.UserID),
+		Institution:         result.Institution,
+		AccountNumberLast4:  account.AccountNumberLast4,
+		Color:               result.Color,
+		Icon:                result.Icon,
+		StatementClosingDay: int(result.StatementClosingDay),
+		PaymentDueDay:       int(result.PaymentDueDay),
+		CreatedAt:           result.CreatedAt,
+		UpdatedAt:           result.UpdatedAt,
 	}, nil
 }
 
@@ -53,79 +128,121 @@ func (r *AccountRepository) GetAccountByID(ctx context.Context, id string) (enti
 
 	result, err := r.queries.GetAccountByID(ctx, uuid)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return entities.Account{}, nil
-		}
+		return entities.Account{}, notFound(err, "account")
+	}
+
+	if err := inBook(ctx, result.BookID, "account"); err != nil {
+		return entities.Account{}, err
+	}
+	if err := ofUser(ctx, result.UserID, "account"); err != nil {
 		return entities.Account{}, err
 	}
 
-	asset, ok := monetary.FindAssetByName(result.Asset)
-	if !ok {
-		asset = monetary.BRL // default fallback
+	return r.convertAccount(ctx, result)
+}
+
+func (r *AccountRepository) GetAllAccounts(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+	order, err := orderBy(sort, accountSortColumns, "a.name")
+	if err != nil {
+		return nil, err
 	}
 
-	return entities.Account{
-		ID:          result.ID.String(),
-		Name:        result.Name,
-		Type:        entities.AccountType(result.Type),
-		Asset:       asset,
-		Description: result.Description,
-		CreatedAt:   result.CreatedAt,
-		UpdatedAt:   result.UpdatedAt,
-	}, nil
+	return r.listAccounts(ctx, fmt.Sprintf(listAccountsQuery, order))
 }
 
-func (r *AccountRepository) GetAllAccounts(ctx context.Context) ([]entities.Account, error) {
-	results, err := r.queries.GetAllAccounts(ctx)
+// GetAccountsPage lists limit accounts after the first offset ones, ties in
+// the sort broken by ID so the pages don't overlap
+func (r *AccountRepository) GetAccountsPage(ctx context.Context, sort []entities.SortField, limit, offset int) ([]entities.Account, error) {
+	order, err := orderBy(sort, accountSortColumns, "a.name")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.listAccounts(ctx, fmt.Sprintf(listAccountsQuery, order+", a.id")+pageClause, int32(limit), int32(offset))
+}
+
+func (r *AccountRepository) listAccounts(ctx context.Context, query string, args ...any) ([]entities.Account, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, append([]any{bookID, userID}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[gen.GetAccountByIDRow])
 	if err != nil {
 		return nil, err
 	}
 
 	accounts := make([]entities.Account, len(results))
 	for i, result := range results {
-		asset, ok := monetary.FindAssetByName(result.Asset)
-		if !ok {
-			asset = monetary.BRL // default fallback
-		}
-
-		accounts[i] = entities.Account{
-			ID:          result.ID.String(),
-			Name:        result.Name,
-			Type:        entities.AccountType(result.Type),
-			Asset:       asset,
-			Description: result.Description,
-			CreatedAt:   result.CreatedAt,
-			UpdatedAt:   result.UpdatedAt,
+		accounts[i], err = r.convertAccount(ctx, result)
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	return accounts, nil
 }
 
+func (r *AccountRepository) CountAccounts(ctx context.Context) (int64, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return 0, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.queries.CountAccounts(ctx, bookID, userID)
+}
+
 func (r *AccountRepository) UpdateAccount(ctx context.Context, account entities.Account) (entities.Account, error) {
 	uuid, err := uuid.FromString(account.ID)
 	if err != nil {
 		return entities.Account{}, err
 	}
 
-	result, err := r.queries.UpdateAccount(ctx, uuid, account.Name, string(account.Type), account.Description, account.Asset.Asset)
+	accountNumber, err := r.cipher.Encrypt(ctx, account.AccountNumberLast4)
 	if err != nil {
-		return entities.Account{}, err
+		return entities.Account{}, fmt.Errorf("failed to encrypt account number: %w", err)
 	}
 
-	asset, ok := monetary.FindAssetByName(result.Asset)
+	result, err := r.queries.UpdateAccount(ctx, uuid, account.Name, string(account.Type), account.Description, account.Asset.Asset, nullableClassification(account.Classification), account.Institution, accountNumber, account.Color, account.Icon, int32(account.StatementClosingDay), int32(account.PaymentDueDay))
+	if err != nil {
+		return entities.Account{}, notFound(err, "account")
+	}
+
+	asset, ok := entities.FindSupportedAsset(result.Asset)
 	if !ok {
 		asset = monetary.BRL // default fallback
 	}
 
 	return entities.Account{
-		ID:          result.ID.String(),
-		Name:        result.Name,
-		Type:        entities.AccountType(result.Type),
-		Asset:       asset,
-		Description: result.Description,
-		CreatedAt:   result.CreatedAt,
-		UpdatedAt:   result.UpdatedAt,
+		ID:                  result.ID.String(),
+		Name:                result.Name,
+		Type:                entities.AccountType(result.Type),
+		Asset:               asset,
+		Description:         result.Description,
+		Classification:      classificationOf(result.Classification),
+		BookID:              result.BookID.String(),
+		OwnerID:             uuidString(result.UserID),
+		Institution:         result.Institution,
+		AccountNumberLast4:  account.AccountNumberLast4,
+		Color:               result.Color,
+		Icon:                result.Icon,
+		StatementClosingDay: int(result.StatementClosingDay),
+		PaymentDueDay:       int(result.PaymentDueDay),
+		CreatedAt:           result.CreatedAt,
+		UpdatedAt:           result.UpdatedAt,
 	}, nil
 }
 
@@ -138,29 +255,333 @@ func (r *AccountRepository) DeleteAccount(ctx context.Context, id string) error
 	return r.queries.DeleteAccount(ctx, uuid)
 }
 
-func (r *AccountRepository) GetAccountsWithBalances(ctx context.Context) ([]entities.Account, error) {
-	results, err := r.queries.GetAccountsWithBalances(ctx)
+func (r *AccountRepository) CountAccountTransactions(ctx context.Context, id string) (int64, error) {
+	uuid, err := uuid.FromString(id)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.queries.CountAccountTransactions(ctx, uuid)
+}
+
+func (r *AccountRepository) GetAccountStatement(ctx context.Context, account entities.Account, from, to time.Time) (entities.AccountStatement, error) {
+	uuid, err := uuid.FromString(account.ID)
+	if err != nil {
+		return entities.AccountStatement{}, err
+	}
+
+	opening, err := r.queries.GetAccountBalanceBefore(ctx, uuid, pgtype.Date{Time: from, Valid: true})
+	if err != nil {
+		return entities.AccountStatement{}, err
+	}
+
+	results, err := r.queries.GetAccountStatement(ctx, uuid, pgtype.Date{Time: to, Valid: true}, pgtype.Date{Time: from, Valid: true})
+	if err != nil {
+		return entities.AccountStatement{}, err
+	}
+
+	openingBalance, err := monetary.NewMonetary(account.Asset, big.NewInt(opening))
+	if err != nil {
+		return entities.AccountStatement{}, err
+	}
+
+	statement := entities.AccountStatement{
+		Account:        account,
+		From:           from,
+		To:             to,
+		OpeningBalance: *openingBalance,
+		ClosingBalance: *openingBalance,
+		Lines:          make([]entities.StatementLine, len(results)),
+	}
+
+	for i, result := range results {
+		amount, err := monetary.NewMonetary(account.Asset, big.NewInt(result.Amount))
+		if err != nil {
+			return entities.AccountStatement{}, err
+		}
+
+		runningBalance, err := monetary.NewMonetary(account.Asset, big.NewInt(result.RunningBalance))
+		if err != nil {
+			return entities.AccountStatement{}, err
+		}
+
+		statement.Lines[i] = entities.StatementLine{
+			Transaction: entities.Transaction{
+				ID:          result.ID.String(),
+				AccountID:   result.AccountID.String(),
+				CategoryID:  result.CategoryID.String(),
+				Monetary:    *amount,
+				Description: result.Description,
+				Payee:       result.Payee,
+				ProjectID:   uuidString(result.ProjectID),
+				Date:        result.Date.Time,
+				Status:      entities.TransactionStatus(result.Status),
+				CreatedAt:   result.CreatedAt,
+				UpdatedAt:   result.UpdatedAt,
+			},
+			RunningBalance: *runningBalance,
+		}
+		statement.ClosingBalance = *runningBalance
+	}
+
+	return statement, nil
+}
+
+func (r *AccountRepository) GetAccountPeriodSummary(ctx context.Context, account entities.Account, from, to time.Time) (entities.AccountPeriodSummary, error) {
+	uuid, err := uuid.FromString(account.ID)
+	if err != nil {
+		return entities.AccountPeriodSummary{}, err
+	}
+
+	result, err := r.queries.GetAccountPeriodSummary(ctx, pgtype.Date{Time: from, Valid: true}, uuid, pgtype.Date{Time: to, Valid: true})
+	if err != nil {
+		return entities.AccountPeriodSummary{}, err
+	}
+
+	amounts := []int64{result.OpeningBalance, result.TotalIn, result.TotalOut, result.OpeningBalance + result.TotalIn - result.TotalOut}
+	values := make([]monetary.Monetary, len(amounts))
+	for i, amount := range amounts {
+		value, err := monetary.NewMonetary(account.Asset, big.NewInt(amount))
+		if err != nil {
+			return entities.AccountPeriodSummary{}, err
+		}
+		values[i] = *value
+	}
+
+	return entities.AccountPeriodSummary{
+		Account:        account,
+		From:           from,
+		To:             to,
+		OpeningBalance: values[0],
+		TotalIn:        values[1],
+		TotalOut:       values[2],
+		ClosingBalance: values[3],
+	}, nil
+}
+
+func (r *AccountRepository) GetAccountWithBalance(ctx context.Context, id string) (entities.Account, error) {
+	uuid, err := uuid.FromString(id)
+	if err != nil {
+		return entities.Account{}, err
+	}
+
+	result, err := r.queries.GetAccountWithBalance(ctx, uuid)
+	if err != nil {
+		return entities.Account{}, notFound(err, "account")
+	}
+
+	if err := inBook(ctx, result.BookID, "account"); err != nil {
+		return entities.Account{}, err
+	}
+	if err := ofUser(ctx, result.UserID, "account"); err != nil {
+		return entities.Account{}, err
+	}
+
+	return r.convertAccountWithBalance(ctx, result)
+}
+
+func (r *AccountRepository) GetAccountsWithBalances(ctx context.Context, sort []entities.SortField) ([]entities.Account, error) {
+	order, err := orderBy(sort, accountSortColumns, "a.name")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.listAccountsWithBalances(ctx, fmt.Sprintf(listAccountsWithBalancesQuery, order))
+}
+
+// GetAccountsWithBalancesPage is GetAccountsPage with the balances embedded
+func (r *AccountRepository) GetAccountsWithBalancesPage(ctx context.Context, sort []entities.SortField, limit, offset int) ([]entities.Account, error) {
+	order, err := orderBy(sort, accountSortColumns, "a.name")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.listAccountsWithBalances(ctx, fmt.Sprintf(listAccountsWithBalancesQuery, order+", a.id")+pageClause, int32(limit), int32(offset))
+}
+
+func (r *AccountRepository) listAccountsWithBalances(ctx context.Context, query string, args ...any) ([]entities.Account, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, append([]any{bookID, userID}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[gen.GetAccountWithBalanceRow])
 	if err != nil {
 		return nil, err
 	}
 
 	accounts := make([]entities.Account, len(results))
 	for i, result := range results {
-		asset, ok := monetary.FindAssetByName(result.Asset)
-		if !ok {
-			asset = monetary.BRL // default fallback
+		accounts[i], err = r.convertAccountWithBalance(ctx, result)
+		if err != nil {
+			return nil, err
 		}
+	}
+
+	return accounts, nil
+}
+
+func (r *AccountRepository) convertAccount(ctx context.Context, result gen.GetAccountByIDRow) (entities.Account, error) {
+	asset, ok := entities.FindSupportedAsset(result.Asset)
+	if !ok {
+		asset = monetary.BRL // default fallback
+	}
 
-		accounts[i] = entities.Account{
-			ID:          result.ID.String(),
-			Name:        result.Name,
-			Type:        entities.AccountType(result.Type),
-			Asset:       asset,
-			Description: result.Description,
-			CreatedAt:   result.CreatedAt,
-			UpdatedAt:   result.UpdatedAt,
+	accountNumber, err := r.cipher.Decrypt(ctx, result.AccountNumberLast4)
+	if err != nil {
+		return entities.Account{}, fmt.Errorf("failed to decrypt account number: %w", err)
+	}
+
+	return entities.Account{
+		ID:                  result.ID.String(),
+		Name:                result.Name,
+		Type:                entities.AccountType(result.Type),
+		Asset:               asset,
+		Description:         result.Description,
+		Classification:      classificationOf(result.Classification),
+		BookID:              result.BookID.String(),
+		OwnerID:             uuidString(result.UserID),
+		Institution:         result.Institution,
+		AccountNumberLast4:  accountNumber,
+		Color:               result.Color,
+		Icon:                result.Icon,
+		StatementClosingDay: int(result.StatementClosingDay),
+		PaymentDueDay:       int(result.PaymentDueDay),
+		CreatedAt:           result.CreatedAt,
+		UpdatedAt:           result.UpdatedAt,
+		TransactionCount:    result.TransactionCount,
+		LastTransactionDate: dateOf(result.LastTransactionDate),
+	}, nil
+}
+
+func (r *AccountRepository) convertAccountWithBalance(ctx context.Context, result gen.GetAccountWithBalanceRow) (entities.Account, error) {
+	asset, ok := entities.FindSupportedAsset(result.Asset)
+	if !ok {
+		asset = monetary.BRL // default fallback
+	}
+
+	accountNumber, err := r.cipher.Decrypt(ctx, result.AccountNumberLast4)
+	if err != nil {
+		return entities.Account{}, fmt.Errorf("failed to decrypt account number: %w", err)
+	}
+
+	currentBalance, err := monetary.NewMonetary(asset, big.NewInt(result.CurrentBalance))
+	if err != nil {
+		return entities.Account{}, err
+	}
+
+	pendingBalance, err := monetary.NewMonetary(asset, big.NewInt(result.PendingBalance))
+	if err != nil {
+		return entities.Account{}, err
+	}
+
+	availableBalance, err := monetary.NewMonetary(asset, big.NewInt(result.AvailableBalance))
+	if err != nil {
+		return entities.Account{}, err
+	}
+
+	// Accounts without transactions may not have a balance row yet
+	lastCalculated := result.UpdatedAt
+	if result.LastCalculated != nil {
+		lastCalculated = *result.LastCalculated
+	}
+
+	return entities.Account{
+		ID:                  result.ID.String(),
+		Name:                result.Name,
+		Type:                entities.AccountType(result.Type),
+		Asset:               asset,
+		Description:         result.Description,
+		Classification:      classificationOf(result.Classification),
+		BookID:              result.BookID.String(),
+		OwnerID:             uuidString(result.UserID),
+		Institution:         result.Institution,
+		AccountNumberLast4:  accountNumber,
+		Color:               result.Color,
+		Icon:                result.Icon,
+		StatementClosingDay: int(result.StatementClosingDay),
+		PaymentDueDay:       int(result.PaymentDueDay),
+		CreatedAt:           result.CreatedAt,
+		UpdatedAt:           result.UpdatedAt,
+		TransactionCount:    result.TransactionCount,
+		LastTransactionDate: dateOf(result.LastTransactionDate),
+		Balance: &entities.Balance{
+			AccountID:        result.ID.String(),
+			CurrentBalance:   *currentBalance,
+			PendingBalance:   *pendingBalance,
+			AvailableBalance: *availableBalance,
+			LastCalculated:   lastCalculated,
+		},
+	}, nil
+}
+
+// ReencryptAccountNumbers encrypts again with the current key the account
+// numbers of every book stored with a retired key, or in plaintext, returning
+// how many were rewritten. Once it's done the retired keys can be dropped.
+func (r *AccountRepository) ReencryptAccountNumbers(ctx context.Context) (int, error) {
+	results, err := r.queries.GetAccountNumbers(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, result := range results {
+		current, err := r.cipher.Current(ctx, result.AccountNumberLast4)
+		if err != nil {
+			return count, err
+		}
+		if current {
+			continue
+		}
+
+		accountNumber, err := r.cipher.Decrypt(ctx, result.AccountNumberLast4)
+		if err != nil {
+			return count, fmt.Errorf("failed to decrypt account number of account %s: %w", result.ID, err)
 		}
+		encrypted, err := r.cipher.Encrypt(ctx, accountNumber)
+		if err != nil {
+			return count, fmt.Errorf("failed to encrypt account number of account %s: %w", result.ID, err)
+		}
+		if err := r.queries.SetAccountNumber(ctx, result.ID, encrypted); err != nil {
+			return count, err
+		}
+		count++
 	}
 
-	return accounts, nil
+	return count, nil
+}
+
+// nullableClassification stores an unset classification as NULL, so the account
+// follows the default of its type
+func nullableClassification(classification entities.AccountClassification) *string {
+	if classification == "" {
+		return nil
+	}
+	value := string(classification)
+	return &value
+}
+
+func classificationOf(classification *string) entities.AccountClassification {
+	if classification == nil {
+		return ""
+	}
+	return entities.AccountClassification(*classification)
+}
+
+// dateOf returns nil for a NULL date, like the last transaction date of an account
+// without transactions
+func dateOf(date pgtype.Date) *time.Time {
+	if !date.Valid {
+		return nil
+	}
+	return &date.Time
 }
@@ -0,0 +1,369 @@
+package pg
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/encryption"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewAccountRepository(db)
+	ctx := context.Background()
+
+	checking := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+	savings := createTestAccount(t, db, "Savings", entities.AccountTypeSavings)
+	credit := createTestAccount(t, db, "Credit Card", entities.AccountTypeCredit)
+
+	t.Run("create", func(t *testing.T) {
+		account, err := repo.CreateAccount(ctx, entities.Account{
+			Name:        "Wallet",
+			Type:        entities.AccountTypeCash,
+			Asset:       monetary.GBP,
+			Description: "Pocket money",
+		})
+		require.NoError(t, err)
+
+		assert.NotEmpty(t, account.ID)
+		assert.Equal(t, "Wallet", account.Name)
+		assert.Equal(t, entities.AccountTypeCash, account.Type)
+		assert.Equal(t, "GBP", account.Asset.Asset)
+		assert.Equal(t, "Pocket money", account.Description)
+		assert.False(t, account.CreatedAt.IsZero())
+
+		require.NoError(t, repo.DeleteAccount(ctx, account.ID))
+	})
+
+	t.Run("create with classification", func(t *testing.T) {
+		account, err := repo.CreateAccount(ctx, entities.Account{
+			Name:           "Mortgage Escrow",
+			Type:           entities.AccountTypeInvestment,
+			Asset:          monetary.USD,
+			Classification: entities.AccountClassificationLiability,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, entities.AccountClassificationLiability, account.Classification)
+
+		found, err := repo.GetAccountByID(ctx, account.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entities.AccountClassificationLiability, found.Classification)
+		assert.True(t, found.IsLiability())
+
+		require.NoError(t, repo.DeleteAccount(ctx, account.ID))
+	})
+
+	t.Run("create rejects unknown classification", func(t *testing.T) {
+		_, err := repo.CreateAccount(ctx, entities.Account{Name: "Bad", Type: entities.AccountTypeCash, Asset: monetary.USD, Classification: "equity"})
+		assert.Error(t, err)
+	})
+
+	t.Run("create with institution details", func(t *testing.T) {
+		account, err := repo.CreateAccount(ctx, entities.Account{
+			Name:               "Nubank Checking",
+			Type:               entities.AccountTypeChecking,
+			Asset:              monetary.BRL,
+			Institution:        "Nubank",
+			AccountNumberLast4: "1234",
+			Color:              "#8A05BE",
+			Icon:               "🏦",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Nubank", account.Institution)
+		assert.Equal(t, "1234", account.AccountNumberLast4)
+
+		account.Institution = "Nu Pagamentos"
+		account.AccountNumberLast4 = ""
+		_, err = repo.UpdateAccount(ctx, account)
+		require.NoError(t, err)
+
+		found, err := repo.GetAccountWithBalance(ctx, account.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Nu Pagamentos", found.Institution)
+		assert.Empty(t, found.AccountNumberLast4)
+		assert.Equal(t, "#8A05BE", found.Color)
+		assert.Equal(t, "🏦", found.Icon)
+
+		require.NoError(t, repo.DeleteAccount(ctx, account.ID))
+	})
+
+	t.Run("create rejects malformed institution details", func(t *testing.T) {
+		// The account numbers are validated by the use case, they may be
+		// stored encrypted
+		_, err := repo.CreateAccount(ctx, entities.Account{Name: "Bad", Type: entities.AccountTypeCash, Asset: monetary.USD, Color: "purple"})
+		assert.Error(t, err)
+	})
+
+	t.Run("encrypted account numbers", func(t *testing.T) {
+		oldKeys, err := encryption.ParseKeys("k1:" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+		require.NoError(t, err)
+		newKeys, err := encryption.ParseKeys("k2:" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32)) + ",k1:" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+		require.NoError(t, err)
+
+		encrypted := NewAccountRepository(db)
+		encrypted.UseCipher(encryption.NewCipher(oldKeys))
+		account, err := encrypted.CreateAccount(ctx, entities.Account{Name: "Vault", Type: entities.AccountTypeSavings, Asset: monetary.BRL, AccountNumberLast4: "4321"})
+		require.NoError(t, err)
+		assert.Equal(t, "4321", account.AccountNumberLast4)
+
+		var stored string
+		require.NoError(t, db.QueryRow(ctx, "SELECT account_number_last4 FROM accounts WHERE id = $1", account.ID).Scan(&stored))
+		assert.True(t, strings.HasPrefix(stored, "enc:v1:k1:"), stored)
+
+		found, err := encrypted.GetAccountByID(ctx, account.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "4321", found.AccountNumberLast4)
+
+		_, err = repo.GetAccountByID(ctx, account.ID)
+		assert.Error(t, err, "the numbers can't be read without the keys")
+
+		rotated := NewAccountRepository(db)
+		rotated.UseCipher(encryption.NewCipher(newKeys))
+		count, err := rotated.ReencryptAccountNumbers(ctx)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, count, 1)
+
+		require.NoError(t, db.QueryRow(ctx, "SELECT account_number_last4 FROM accounts WHERE id = $1", account.ID).Scan(&stored))
+		assert.True(t, strings.HasPrefix(stored, "enc:v1:k2:"), stored)
+		found, err = rotated.GetAccountWithBalance(ctx, account.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "4321", found.AccountNumberLast4)
+
+		count, err = rotated.ReencryptAccountNumbers(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+
+		require.NoError(t, repo.DeleteAccount(ctx, account.ID))
+	})
+
+	t.Run("create with billing cycle", func(t *testing.T) {
+		account, err := repo.CreateAccount(ctx, entities.Account{
+			Name:                "Nubank Card",
+			Type:                entities.AccountTypeCredit,
+			Asset:               monetary.BRL,
+			StatementClosingDay: 3,
+			PaymentDueDay:       10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, account.StatementClosingDay)
+		assert.Equal(t, 10, account.PaymentDueDay)
+
+		account.StatementClosingDay = 28
+		_, err = repo.UpdateAccount(ctx, account)
+		require.NoError(t, err)
+
+		found, err := repo.GetAccountByID(ctx, account.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 28, found.StatementClosingDay)
+		assert.Equal(t, 10, found.PaymentDueDay)
+
+		require.NoError(t, repo.DeleteAccount(ctx, account.ID))
+
+		_, err = repo.CreateAccount(ctx, entities.Account{Name: "Bad", Type: entities.AccountTypeCredit, Asset: monetary.BRL, PaymentDueDay: 32})
+		assert.Error(t, err)
+	})
+
+	t.Run("create rejects unknown type", func(t *testing.T) {
+		_, err := repo.CreateAccount(ctx, entities.Account{Name: "Bad", Type: "loan", Asset: monetary.USD})
+		assert.Error(t, err)
+	})
+
+	t.Run("get by id", func(t *testing.T) {
+		account, err := repo.GetAccountByID(ctx, checking.ID)
+		require.NoError(t, err)
+		assert.Equal(t, checking.ID, account.ID)
+		assert.Equal(t, "Checking", account.Name)
+		assert.Equal(t, "USD", account.Asset.Asset)
+		assert.Empty(t, account.Classification)
+	})
+
+	t.Run("get by id not found", func(t *testing.T) {
+		_, err := repo.GetAccountByID(ctx, uuid.Must(uuid.NewV4()).String())
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("get by id invalid uuid", func(t *testing.T) {
+		_, err := repo.GetAccountByID(ctx, "not-a-uuid")
+		assert.Error(t, err)
+	})
+
+	t.Run("get all sorted by name by default", func(t *testing.T) {
+		accounts, err := repo.GetAllAccounts(ctx, nil)
+		require.NoError(t, err)
+		require.Len(t, accounts, 3)
+		assert.Equal(t, []string{"Checking", "Credit Card", "Savings"}, accountNames(accounts))
+	})
+
+	t.Run("get all with sort", func(t *testing.T) {
+		accounts, err := repo.GetAllAccounts(ctx, []entities.SortField{{Field: "name", Desc: true}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Savings", "Credit Card", "Checking"}, accountNames(accounts))
+
+		accounts, err = repo.GetAllAccounts(ctx, []entities.SortField{{Field: "type"}, {Field: "name"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Checking", "Credit Card", "Savings"}, accountNames(accounts))
+	})
+
+	t.Run("get all with unknown sort field", func(t *testing.T) {
+		_, err := repo.GetAllAccounts(ctx, []entities.SortField{{Field: "asset; DROP TABLE accounts"}})
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+
+	t.Run("page and count", func(t *testing.T) {
+		accounts, err := repo.GetAccountsPage(ctx, nil, 2, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Checking", "Credit Card"}, accountNames(accounts))
+
+		accounts, err = repo.GetAccountsPage(ctx, nil, 2, 2)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Savings"}, accountNames(accounts))
+
+		accounts, err = repo.GetAccountsWithBalancesPage(ctx, []entities.SortField{{Field: "name", Desc: true}}, 1, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Credit Card"}, accountNames(accounts))
+		assert.NotNil(t, accounts[0].Balance)
+
+		count, err := repo.CountAccounts(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+
+	t.Run("get with balance before any transaction", func(t *testing.T) {
+		account, err := repo.GetAccountWithBalance(ctx, savings.ID)
+		require.NoError(t, err)
+		require.NotNil(t, account.Balance)
+		assert.Equal(t, savings.ID, account.Balance.AccountID)
+		assert.Zero(t, account.Balance.CurrentBalance.Amount.Int64())
+		assert.Equal(t, account.UpdatedAt, account.Balance.LastCalculated)
+	})
+
+	t.Run("get all with balances", func(t *testing.T) {
+		category := createTestCategory(t, db, "Account Test", entities.CategoryTypeExpense)
+		createTestTransaction(t, db, credit, category, 2500, time.Now(), entities.TransactionStatusCleared)
+
+		accounts, err := repo.GetAccountsWithBalances(ctx, []entities.SortField{{Field: "created_at", Desc: true}})
+		require.NoError(t, err)
+		require.Len(t, accounts, 3)
+		assert.Equal(t, credit.ID, accounts[0].ID)
+		require.NotNil(t, accounts[0].Balance)
+		assert.Equal(t, int64(2500), accounts[0].Balance.CurrentBalance.Amount.Int64())
+		assert.Equal(t, "USD", accounts[0].Balance.CurrentBalance.Asset.Asset)
+	})
+
+	t.Run("count transactions", func(t *testing.T) {
+		count, err := repo.CountAccountTransactions(ctx, credit.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		count, err = repo.CountAccountTransactions(ctx, checking.ID)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("activity", func(t *testing.T) {
+		category := createTestCategory(t, db, "Activity Test", entities.CategoryTypeExpense)
+		lastWeek := time.Now().AddDate(0, 0, -7).Truncate(24 * time.Hour)
+		createTestTransaction(t, db, credit, category, 1000, lastWeek, entities.TransactionStatusCleared)
+
+		account, err := repo.GetAccountByID(ctx, credit.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), account.TransactionCount)
+		require.NotNil(t, account.LastTransactionDate)
+		assert.Equal(t, time.Now().Format("2006-01-02"), account.LastTransactionDate.Format("2006-01-02"))
+
+		account, err = repo.GetAccountWithBalance(ctx, checking.ID)
+		require.NoError(t, err)
+		assert.Zero(t, account.TransactionCount)
+		assert.Nil(t, account.LastTransactionDate)
+
+		accounts, err := repo.GetAllAccounts(ctx, []entities.SortField{{Field: "transaction_count", Desc: true}, {Field: "name"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Credit Card", "Checking", "Savings"}, accountNames(accounts))
+		assert.Equal(t, int64(2), accounts[0].TransactionCount)
+	})
+
+	t.Run("statement and period summary", func(t *testing.T) {
+		wallet := createTestAccount(t, db, "Statement Wallet", entities.AccountTypeCash)
+		income := createTestCategory(t, db, "Statement Income", entities.CategoryTypeIncome)
+		day := func(d int) time.Time { return time.Date(2025, time.March, d, 0, 0, 0, 0, time.UTC) }
+
+		createTestTransaction(t, db, wallet, income, 10000, day(1), entities.TransactionStatusCleared)
+		first := createTestTransaction(t, db, wallet, income, -2500, day(10), entities.TransactionStatusCleared)
+		createTestTransaction(t, db, wallet, income, -999, day(12), entities.TransactionStatusPending)
+		second := createTestTransaction(t, db, wallet, income, 500, day(12), entities.TransactionStatusCleared)
+		createTestTransaction(t, db, wallet, income, -100, day(25), entities.TransactionStatusCleared)
+
+		statement, err := repo.GetAccountStatement(ctx, wallet, day(5), day(20))
+		require.NoError(t, err)
+		assert.Equal(t, int64(10000), statement.OpeningBalance.Amount.Int64())
+		assert.Equal(t, int64(8000), statement.ClosingBalance.Amount.Int64())
+		require.Len(t, statement.Lines, 2)
+		assert.Equal(t, first.ID, statement.Lines[0].Transaction.ID)
+		assert.Equal(t, int64(7500), statement.Lines[0].RunningBalance.Amount.Int64())
+		assert.Equal(t, second.ID, statement.Lines[1].Transaction.ID)
+		assert.Equal(t, int64(8000), statement.Lines[1].RunningBalance.Amount.Int64())
+		assert.Equal(t, "USD", statement.Lines[1].RunningBalance.Asset.Asset)
+
+		statement, err = repo.GetAccountStatement(ctx, wallet, day(13), day(20))
+		require.NoError(t, err)
+		assert.Empty(t, statement.Lines)
+		assert.Equal(t, int64(8000), statement.OpeningBalance.Amount.Int64())
+		assert.Equal(t, int64(8000), statement.ClosingBalance.Amount.Int64())
+
+		summary, err := repo.GetAccountPeriodSummary(ctx, wallet, day(5), day(20))
+		require.NoError(t, err)
+		assert.Equal(t, int64(10000), summary.OpeningBalance.Amount.Int64())
+		assert.Equal(t, int64(500), summary.TotalIn.Amount.Int64())
+		assert.Equal(t, int64(2500), summary.TotalOut.Amount.Int64())
+		assert.Equal(t, int64(8000), summary.ClosingBalance.Amount.Int64())
+
+		summary, err = repo.GetAccountPeriodSummary(ctx, wallet, day(1), day(31))
+		require.NoError(t, err)
+		assert.Zero(t, summary.OpeningBalance.Amount.Int64())
+		assert.Equal(t, int64(10500), summary.TotalIn.Amount.Int64())
+		assert.Equal(t, int64(2600), summary.TotalOut.Amount.Int64())
+		assert.Equal(t, int64(7900), summary.ClosingBalance.Amount.Int64())
+
+		require.NoError(t, repo.DeleteAccount(ctx, wallet.ID))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		updated, err := repo.UpdateAccount(ctx, entities.Account{
+			ID:          checking.ID,
+			Name:        "Main Checking",
+			Type:        entities.AccountTypeChecking,
+			Asset:       monetary.BRL,
+			Description: "Salary account",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Main Checking", updated.Name)
+		assert.Equal(t, "BRL", updated.Asset.Asset)
+		assert.Equal(t, "Salary account", updated.Description)
+		assert.True(t, updated.UpdatedAt.After(checking.UpdatedAt))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.DeleteAccount(ctx, savings.ID))
+
+		_, err := repo.GetAccountByID(ctx, savings.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func accountNames(accounts []entities.Account) []string {
+	names := make([]string, len(accounts))
+	for i, account := range accounts {
+		names[i] = account.Name
+	}
+	return names
+}
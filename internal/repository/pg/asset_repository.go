@@ -0,0 +1,64 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AssetRepository struct {
+	queries *gen.Queries
+}
+
+func NewAssetRepository(db *pgxpool.Pool) *AssetRepository {
+	return &AssetRepository{
+		queries: gen.New(db),
+	}
+}
+
+func (r *AssetRepository) CreateCustomAsset(ctx context.Context, asset entities.Asset) (entities.Asset, error) {
+	result, err := r.queries.CreateCustomAsset(ctx,
+		asset.Asset.Asset,
+		asset.Name,
+		asset.Symbol,
+		int32(asset.Precision),
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return entities.Asset{}, fmt.Errorf("asset %s already exists: %w", asset.Asset.Asset, domain.ErrConflict)
+		}
+		return entities.Asset{}, err
+	}
+
+	return convertCustomAsset(result), nil
+}
+
+func (r *AssetRepository) GetAllCustomAssets(ctx context.Context) ([]entities.Asset, error) {
+	results, err := r.queries.GetAllCustomAssets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]entities.Asset, len(results))
+	for i, result := range results {
+		assets[i] = convertCustomAsset(result)
+	}
+
+	return assets, nil
+}
+
+func convertCustomAsset(result gen.CustomAsset) entities.Asset {
+	return entities.Asset{
+		Asset:     monetary.NewAsset(result.Code, int(result.Precision), result.Symbol, entities.AssetClassCustom),
+		Name:      result.Name,
+		CreatedAt: result.CreatedAt,
+	}
+}
@@ -0,0 +1,62 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewAssetRepository(db)
+	accounts := NewAccountRepository(db)
+	ctx := context.Background()
+
+	miles := entities.Asset{Asset: monetary.NewAsset("MILES", 0, "mi", entities.AssetClassCustom), Name: "Airline miles"}
+	created, err := repo.CreateCustomAsset(ctx, miles)
+	require.NoError(t, err)
+	assert.Equal(t, miles.Asset, created.Asset)
+	assert.False(t, created.CreatedAt.IsZero())
+
+	t.Run("create rejects duplicate code", func(t *testing.T) {
+		_, err := repo.CreateCustomAsset(ctx, miles)
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("lists by code", func(t *testing.T) {
+		_, err := repo.CreateCustomAsset(ctx, entities.Asset{Asset: monetary.NewAsset("AAPTS", 2, "pts", entities.AssetClassCustom), Name: "Store points"})
+		require.NoError(t, err)
+
+		assets, err := repo.GetAllCustomAssets(ctx)
+		require.NoError(t, err)
+		require.Len(t, assets, 2)
+		assert.Equal(t, "AAPTS", assets[0].Asset.Asset)
+		assert.Equal(t, "MILES", assets[1].Asset.Asset)
+	})
+
+	t.Run("accounts can be kept in custom assets", func(t *testing.T) {
+		entities.RegisterCustomAssets(created)
+
+		account, err := accounts.CreateAccount(ctx, entities.Account{Name: "Frequent flyer", Type: entities.AccountTypeInvestment, Asset: created.Asset})
+		require.NoError(t, err)
+
+		found, err := accounts.GetAccountByID(ctx, account.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created.Asset, found.Asset)
+
+		checking := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+		rewards := createTestCategory(t, db, "Test Rewards", entities.CategoryTypeIncome)
+		createTestTransaction(t, db, checking, rewards, 10000, time.Now(), entities.TransactionStatusCleared)
+		createTestTransaction(t, db, found, rewards, 42000, time.Now(), entities.TransactionStatusCleared)
+
+		summary, err := NewBalanceRepository(db).GetBalanceSummary(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10000), summary.NetWorth.Amount.Int64(), "miles are left out of the net worth")
+	})
+}
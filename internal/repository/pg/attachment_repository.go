@@ -0,0 +1,148 @@
+package pg
+
+import (
+	"context"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AttachmentRepository struct {
+	queries      *gen.Queries
+	transactions *TransactionRepository
+}
+
+func NewAttachmentRepository(db *pgxpool.Pool) *AttachmentRepository {
+	return &AttachmentRepository{
+		queries:      gen.New(db),
+		transactions: NewTransactionRepository(db),
+	}
+}
+
+func (r *AttachmentRepository) CreateAttachment(ctx context.Context, attachment entities.Attachment) (entities.Attachment, error) {
+	transactionID, err := uuid.FromString(attachment.TransactionID)
+	if err != nil {
+		return entities.Attachment{}, err
+	}
+	// Attachments are in the book of their transaction
+	if _, err := r.transactions.GetTransactionByID(ctx, attachment.TransactionID); err != nil {
+		return entities.Attachment{}, err
+	}
+
+	result, err := r.queries.CreateAttachment(ctx,
+		transactionID,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.Size,
+		attachment.StorageKey,
+		nullString(attachment.ThumbnailKey),
+	)
+	if err != nil {
+		return entities.Attachment{}, err
+	}
+
+	return convertAttachment(result), nil
+}
+
+func (r *AttachmentRepository) GetAttachmentByID(ctx context.Context, id string) (entities.Attachment, error) {
+	attachmentID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.Attachment{}, err
+	}
+
+	result, err := r.queries.GetAttachmentByID(ctx, attachmentID)
+	if err != nil {
+		return entities.Attachment{}, notFound(err, "attachment")
+	}
+	if _, err := r.transactions.GetTransactionByID(ctx, result.TransactionID.String()); err != nil {
+		return entities.Attachment{}, err
+	}
+
+	return convertAttachment(result), nil
+}
+
+// GetAttachmentsByTransaction returns the attachments of a transaction in the
+// order they were added
+func (r *AttachmentRepository) GetAttachmentsByTransaction(ctx context.Context, transactionID string) ([]entities.Attachment, error) {
+	id, err := uuid.FromString(transactionID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.transactions.GetTransactionByID(ctx, transactionID); err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetTransactionAttachments(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := make([]entities.Attachment, len(results))
+	for i, result := range results {
+		attachments[i] = convertAttachment(result)
+	}
+
+	return attachments, nil
+}
+
+// GetAttachmentsByDateRange returns the attachments of the transactions from
+// startDate to endDate, outside the trash, by the date of their transaction
+func (r *AttachmentRepository) GetAttachmentsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]entities.Attachment, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAttachmentsByDateRange(ctx,
+		pgtype.Date{Time: startDate, Valid: true},
+		pgtype.Date{Time: endDate, Valid: true},
+		bookID,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := make([]entities.Attachment, len(results))
+	for i, result := range results {
+		attachments[i] = convertAttachment(result)
+	}
+
+	return attachments, nil
+}
+
+func (r *AttachmentRepository) DeleteAttachment(ctx context.Context, id string) error {
+	attachmentID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+	if _, err := r.GetAttachmentByID(ctx, id); err != nil {
+		return err
+	}
+
+	return r.queries.DeleteAttachment(ctx, attachmentID)
+}
+
+func convertAttachment(result gen.Attachment) entities.Attachment {
+	attachment := entities.Attachment{
+		ID:            result.ID.String(),
+		TransactionID: result.TransactionID.String(),
+		FileName:      result.FileName,
+		ContentType:   result.ContentType,
+		Size:          result.Size,
+		StorageKey:    result.StorageKey,
+		CreatedAt:     result.CreatedAt,
+	}
+	if result.ThumbnailKey != nil {
+		attachment.ThumbnailKey = *result.ThumbnailKey
+	}
+	return attachment
+}
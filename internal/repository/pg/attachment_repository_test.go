@@ -0,0 +1,100 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewAttachmentRepository(db)
+	transactions := NewTransactionRepository(db)
+	books := NewBookRepository(db)
+	ctx := context.Background()
+
+	account := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+	category := createTestCategory(t, db, "Test Groceries", entities.CategoryTypeExpense)
+	transaction := createTestTransaction(t, db, account, category, -4590, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), entities.TransactionStatusCleared)
+
+	var receipt entities.Attachment
+	t.Run("create and get", func(t *testing.T) {
+		var err error
+		receipt, err = repo.CreateAttachment(ctx, entities.Attachment{
+			TransactionID: transaction.ID,
+			FileName:      "market.jpg",
+			ContentType:   "image/jpeg",
+			Size:          2048,
+			StorageKey:    transaction.ID + "/receipt",
+			ThumbnailKey:  transaction.ID + "/receipt-thumbnail.png",
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, receipt.ID)
+		assert.True(t, receipt.HasThumbnail())
+
+		got, err := repo.GetAttachmentByID(ctx, receipt.ID)
+		require.NoError(t, err)
+		assert.Equal(t, receipt, got)
+	})
+
+	t.Run("list with the transaction", func(t *testing.T) {
+		invoice, err := repo.CreateAttachment(ctx, entities.Attachment{
+			TransactionID: transaction.ID,
+			FileName:      "invoice.pdf",
+			ContentType:   "application/pdf",
+			Size:          4096,
+			StorageKey:    transaction.ID + "/invoice",
+		})
+		require.NoError(t, err)
+		assert.False(t, invoice.HasThumbnail())
+
+		attachments, err := repo.GetAttachmentsByTransaction(ctx, transaction.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []entities.Attachment{receipt, invoice}, attachments)
+
+		detailed, err := transactions.GetTransactionWithDetails(ctx, transaction.ID)
+		require.NoError(t, err)
+		assert.Equal(t, attachments, detailed.Attachments)
+
+		listed, err := transactions.GetTransactionsWithDetails(ctx, entities.TransactionFilter{}, 10, 0, nil)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Equal(t, attachments, listed[0].Attachments)
+	})
+
+	t.Run("list by the date of the transaction", func(t *testing.T) {
+		attachments, err := repo.GetAttachmentsByDateRange(ctx, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Len(t, attachments, 2)
+
+		attachments, err = repo.GetAttachmentsByDateRange(ctx, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Empty(t, attachments)
+	})
+
+	t.Run("attachments are kept per book", func(t *testing.T) {
+		side, err := books.CreateBook(ctx, entities.Book{Name: "Side business", Asset: monetary.USD})
+		require.NoError(t, err)
+		sideCtx := domain.WithBook(ctx, side.ID)
+
+		attachments, err := repo.GetAttachmentsByDateRange(sideCtx, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.Empty(t, attachments)
+
+		_, err = repo.GetAttachmentByID(sideCtx, receipt.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.ErrorIs(t, repo.DeleteAttachment(sideCtx, receipt.ID), domain.ErrNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.DeleteAttachment(ctx, receipt.ID))
+		_, err := repo.GetAttachmentByID(ctx, receipt.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
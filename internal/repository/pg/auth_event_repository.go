@@ -0,0 +1,65 @@
+package pg
+
+import (
+	"context"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AuthEventRepository struct {
+	queries *gen.Queries
+}
+
+func NewAuthEventRepository(db *pgxpool.Pool) *AuthEventRepository {
+	return &AuthEventRepository{
+		queries: gen.New(db),
+	}
+}
+
+func (r *AuthEventRepository) CreateAuthEvent(ctx context.Context, event entities.AuthEvent) (entities.AuthEvent, error) {
+	userID, err := nullUUID(event.UserID)
+	if err != nil {
+		return entities.AuthEvent{}, err
+	}
+
+	result, err := r.queries.CreateAuthEvent(ctx, userID, string(event.Type), event.Email, event.IPAddress, event.UserAgent)
+	if err != nil {
+		return entities.AuthEvent{}, err
+	}
+
+	return convertAuthEvent(result), nil
+}
+
+func (r *AuthEventRepository) GetAuthEventsByUser(ctx context.Context, userID string, limit int) ([]entities.AuthEvent, error) {
+	id, err := uuid.FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAuthEventsByUser(ctx, &id, int32(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]entities.AuthEvent, len(results))
+	for i, result := range results {
+		events[i] = convertAuthEvent(result)
+	}
+
+	return events, nil
+}
+
+func convertAuthEvent(result gen.AuthEvent) entities.AuthEvent {
+	return entities.AuthEvent{
+		ID:        result.ID.String(),
+		UserID:    uuidString(result.UserID),
+		Type:      entities.AuthEventType(result.Type),
+		Email:     result.Email,
+		IPAddress: result.IpAddress,
+		UserAgent: result.UserAgent,
+		CreatedAt: result.CreatedAt,
+	}
+}
@@ -0,0 +1,41 @@
+package pg
+
+import (
+	"context"
+	"finance/domain/entities"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthEventRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewAuthEventRepository(db)
+	users := NewUserRepository(db)
+	ctx := context.Background()
+
+	alice, err := users.CreateUser(ctx, entities.User{Email: "alice@example.com", PasswordHash: "hash"})
+	require.NoError(t, err)
+
+	for _, event := range []entities.AuthEvent{
+		{UserID: alice.ID, Type: entities.AuthEventLoginFailed, Email: alice.Email, IPAddress: "192.0.2.1", UserAgent: "curl"},
+		{UserID: alice.ID, Type: entities.AuthEventAccountLocked, Email: alice.Email, IPAddress: "192.0.2.1", UserAgent: "curl"},
+		// Unregistered emails have no user
+		{Type: entities.AuthEventLoginFailed, Email: "mallory@example.com", IPAddress: "192.0.2.1"},
+	} {
+		created, err := repo.CreateAuthEvent(ctx, event)
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+		assert.Equal(t, event.UserID, created.UserID)
+	}
+
+	events, err := repo.GetAuthEventsByUser(ctx, alice.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "curl", events[0].UserAgent)
+
+	events, err = repo.GetAuthEventsByUser(ctx, alice.ID, 1)
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+}
@@ -2,7 +2,6 @@ package pg
 
 import (
 	"context"
-	"database/sql"
 	"finance/domain/entities"
 	"finance/internal/repository/pg/gen"
 	"math/big"
@@ -10,6 +9,7 @@ import (
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/guilhermebr/gox/monetary"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -33,10 +33,7 @@ func (r *BalanceRepository) GetBalanceByAccountID(ctx context.Context, accountID
 
 	result, err := r.queries.GetBalanceByAccountID(ctx, uuid)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return entities.Balance{}, nil
-		}
-		return entities.Balance{}, err
+		return entities.Balance{}, notFound(err, "balance")
 	}
 
 	// Get the account to retrieve the asset information
@@ -45,7 +42,11 @@ func (r *BalanceRepository) GetBalanceByAccountID(ctx context.Context, accountID
 		return entities.Balance{}, err
 	}
 
-	asset, ok := monetary.FindAssetByName(account.Asset)
+	if err := inBook(ctx, account.BookID, "balance"); err != nil {
+		return entities.Balance{}, err
+	}
+
+	asset, ok := entities.FindSupportedAsset(account.Asset)
 	if !ok {
 		asset = monetary.USD // default fallback
 	}
@@ -75,11 +76,44 @@ func (r *BalanceRepository) GetBalanceByAccountID(ctx context.Context, accountID
 }
 
 func (r *BalanceRepository) GetAllBalances(ctx context.Context) ([]entities.Balance, error) {
-	results, err := r.queries.GetAllBalances(ctx)
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllBalances(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.convertBalances(ctx, results)
+}
+
+// GetBalancesPage lists limit balances after the first offset ones, by account
+func (r *BalanceRepository) GetBalancesPage(ctx context.Context, limit, offset int) ([]entities.Balance, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetBalancesPage(ctx, bookID, int32(limit), int32(offset))
 	if err != nil {
 		return nil, err
 	}
 
+	return r.convertBalances(ctx, results)
+}
+
+func (r *BalanceRepository) CountBalances(ctx context.Context) (int64, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.queries.CountBalances(ctx, bookID)
+}
+
+func (r *BalanceRepository) convertBalances(ctx context.Context, results []gen.Balance) ([]entities.Balance, error) {
 	balances := make([]entities.Balance, len(results))
 	for i, result := range results {
 		// Get the account to retrieve the asset information
@@ -88,7 +122,7 @@ func (r *BalanceRepository) GetAllBalances(ctx context.Context) ([]entities.Bala
 			return nil, err
 		}
 
-		asset, ok := monetary.FindAssetByName(account.Asset)
+		asset, ok := entities.FindSupportedAsset(account.Asset)
 		if !ok {
 			asset = monetary.USD // default fallback
 		}
@@ -130,32 +164,33 @@ func (r *BalanceRepository) RefreshAccountBalance(ctx context.Context, accountID
 }
 
 func (r *BalanceRepository) GetBalanceSummary(ctx context.Context) (entities.BalanceSummary, error) {
-	result, err := r.queries.GetBalanceSummary(ctx)
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.BalanceSummary{}, err
+	}
+
+	result, err := r.queries.GetBalanceSummary(ctx, bookID)
 	if err != nil {
 		return entities.BalanceSummary{}, err
 	}
 
-	// Convert interface{} values to proper types
-	totalAssets, _ := result.TotalAssets.(int64)
-	totalLiabilities, _ := result.TotalLiabilities.(int64)
-	netWorth, _ := result.NetWorth.(int64)
 	lastCalculated, _ := result.LastCalculated.(time.Time)
 
 	// For balance summary, we'll use USD as the default asset
 	// In a real implementation, you might want to have a configurable base currency
 	usd := monetary.USD
 
-	totalAssetsMonetary, err := monetary.NewMonetary(usd, big.NewInt(totalAssets))
+	totalAssetsMonetary, err := monetary.NewMonetary(usd, big.NewInt(result.TotalAssets))
 	if err != nil {
 		return entities.BalanceSummary{}, err
 	}
 
-	totalLiabilitiesMonetary, err := monetary.NewMonetary(usd, big.NewInt(totalLiabilities))
+	totalLiabilitiesMonetary, err := monetary.NewMonetary(usd, big.NewInt(result.TotalLiabilities))
 	if err != nil {
 		return entities.BalanceSummary{}, err
 	}
 
-	netWorthMonetary, err := monetary.NewMonetary(usd, big.NewInt(netWorth))
+	netWorthMonetary, err := monetary.NewMonetary(usd, big.NewInt(result.NetWorth))
 	if err != nil {
 		return entities.BalanceSummary{}, err
 	}
@@ -167,3 +202,79 @@ func (r *BalanceRepository) GetBalanceSummary(ctx context.Context) (entities.Bal
 		LastCalculated:   lastCalculated,
 	}, nil
 }
+
+// GetBalanceSnapshotsAt returns, for each account, the latest snapshot taken on or before date
+func (r *BalanceRepository) GetBalanceSnapshotsAt(ctx context.Context, date time.Time) ([]entities.BalanceSnapshot, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetBalanceSnapshotsAt(ctx, pgtype.Date{Time: date, Valid: true}, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]entities.BalanceSnapshot, len(results))
+	for i, result := range results {
+		if snapshots[i], err = convertBalanceSnapshot(result.AccountID, result.SnapshotDate, result.CurrentBalance, result.Asset); err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshots, nil
+}
+
+// GetBalanceSnapshotsBetween returns the snapshots of the account, of every
+// account of the book when accountID is empty, taken from from to to ordered
+// by account and date. Each account starts with its last snapshot on or
+// before from, the balance it had then.
+func (r *BalanceRepository) GetBalanceSnapshotsBetween(ctx context.Context, accountID string, from, to time.Time) ([]entities.BalanceSnapshot, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var account *uuid.UUID
+	if accountID != "" {
+		id, err := uuid.FromString(accountID)
+		if err != nil {
+			return nil, err
+		}
+		account = &id
+	}
+
+	results, err := r.queries.GetBalanceSnapshotsBetween(ctx, bookID, account,
+		pgtype.Date{Time: from, Valid: true},
+		pgtype.Date{Time: to, Valid: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]entities.BalanceSnapshot, len(results))
+	for i, result := range results {
+		if snapshots[i], err = convertBalanceSnapshot(result.AccountID, result.SnapshotDate, result.CurrentBalance, result.Asset); err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshots, nil
+}
+
+func convertBalanceSnapshot(accountID uuid.UUID, date pgtype.Date, balance int64, code string) (entities.BalanceSnapshot, error) {
+	asset, ok := entities.FindSupportedAsset(code)
+	if !ok {
+		asset = monetary.USD // default fallback
+	}
+
+	currentBalance, err := monetary.NewMonetary(asset, big.NewInt(balance))
+	if err != nil {
+		return entities.BalanceSnapshot{}, err
+	}
+
+	return entities.BalanceSnapshot{
+		AccountID:      accountID.String(),
+		Date:           date.Time,
+		CurrentBalance: *currentBalance,
+	}, nil
+}
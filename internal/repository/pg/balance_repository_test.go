@@ -0,0 +1,146 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewBalanceRepository(db)
+	ctx := context.Background()
+
+	checking := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+	credit := createTestAccount(t, db, "Credit Card", entities.AccountTypeCredit)
+	empty := createTestAccount(t, db, "Empty", entities.AccountTypeSavings)
+	salary := createTestCategory(t, db, "Test Salary", entities.CategoryTypeIncome)
+	shopping := createTestCategory(t, db, "Test Shopping", entities.CategoryTypeExpense)
+
+	today := time.Now()
+	createTestTransaction(t, db, checking, salary, 500000, today, entities.TransactionStatusCleared)
+	createTestTransaction(t, db, checking, shopping, -12550, today, entities.TransactionStatusPending)
+	createTestTransaction(t, db, checking, shopping, -1000, today, entities.TransactionStatusCancelled)
+	createTestTransaction(t, db, checking, shopping, -700, today, entities.TransactionStatusDraft)
+	createTestTransaction(t, db, credit, shopping, 2500, today, entities.TransactionStatusCleared)
+
+	t.Run("kept up to date by the transactions trigger", func(t *testing.T) {
+		balance, err := repo.GetBalanceByAccountID(ctx, checking.ID)
+		require.NoError(t, err)
+		assert.Equal(t, checking.ID, balance.AccountID)
+		assert.Equal(t, int64(500000), balance.CurrentBalance.Amount.Int64())
+		assert.Equal(t, int64(-12550), balance.PendingBalance.Amount.Int64())
+		assert.Equal(t, int64(487450), balance.AvailableBalance.Amount.Int64())
+		assert.Equal(t, "USD", balance.CurrentBalance.Asset.Asset)
+	})
+
+	t.Run("get by account without transactions", func(t *testing.T) {
+		_, err := repo.GetBalanceByAccountID(ctx, empty.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		_, err = repo.GetBalanceByAccountID(ctx, uuid.Must(uuid.NewV4()).String())
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("get all", func(t *testing.T) {
+		balances, err := repo.GetAllBalances(ctx)
+		require.NoError(t, err)
+		require.Len(t, balances, 2)
+
+		byAccount := map[string]entities.Balance{}
+		for _, balance := range balances {
+			byAccount[balance.AccountID] = balance
+		}
+		assert.Equal(t, int64(500000), byAccount[checking.ID].CurrentBalance.Amount.Int64())
+		assert.Equal(t, int64(2500), byAccount[credit.ID].CurrentBalance.Amount.Int64())
+	})
+
+	t.Run("page and count", func(t *testing.T) {
+		all, err := repo.GetAllBalances(ctx)
+		require.NoError(t, err)
+
+		balances, err := repo.GetBalancesPage(ctx, 1, 1)
+		require.NoError(t, err)
+		require.Len(t, balances, 1)
+		assert.Equal(t, all[1].AccountID, balances[0].AccountID)
+
+		count, err := repo.CountBalances(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		_, err := db.Exec(ctx, "UPDATE balances SET current_balance = 0 WHERE account_id = $1", checking.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, repo.RefreshAccountBalance(ctx, checking.ID))
+
+		balance, err := repo.GetBalanceByAccountID(ctx, checking.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(500000), balance.CurrentBalance.Amount.Int64())
+	})
+
+	t.Run("summary", func(t *testing.T) {
+		summary, err := repo.GetBalanceSummary(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(500000), summary.TotalAssets.Amount.Int64())
+		assert.Equal(t, int64(2500), summary.TotalLiabilities.Amount.Int64())
+		assert.Equal(t, int64(497500), summary.NetWorth.Amount.Int64())
+		assert.False(t, summary.LastCalculated.IsZero())
+	})
+
+	t.Run("summary follows classification overrides", func(t *testing.T) {
+		_, err := db.Exec(ctx, "UPDATE accounts SET classification = 'asset' WHERE id = $1", credit.ID)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_, err := db.Exec(ctx, "UPDATE accounts SET classification = NULL WHERE id = $1", credit.ID)
+			require.NoError(t, err)
+		})
+
+		summary, err := repo.GetBalanceSummary(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(502500), summary.TotalAssets.Amount.Int64())
+		assert.Zero(t, summary.TotalLiabilities.Amount.Int64())
+		assert.Equal(t, int64(502500), summary.NetWorth.Amount.Int64())
+	})
+
+	t.Run("snapshots", func(t *testing.T) {
+		snapshots, err := repo.GetBalanceSnapshotsAt(ctx, today)
+		require.NoError(t, err)
+		require.Len(t, snapshots, 2)
+
+		byAccount := map[string]entities.BalanceSnapshot{}
+		for _, snapshot := range snapshots {
+			byAccount[snapshot.AccountID] = snapshot
+		}
+		assert.Equal(t, int64(500000), byAccount[checking.ID].CurrentBalance.Amount.Int64())
+		assert.Equal(t, int64(2500), byAccount[credit.ID].CurrentBalance.Amount.Int64())
+
+		// Nothing was recorded before the accounts existed
+		snapshots, err = repo.GetBalanceSnapshotsAt(ctx, today.AddDate(0, 0, -1))
+		require.NoError(t, err)
+		assert.Empty(t, snapshots)
+	})
+
+	t.Run("snapshots between", func(t *testing.T) {
+		snapshots, err := repo.GetBalanceSnapshotsBetween(ctx, checking.ID, today.AddDate(0, 0, -7), today)
+		require.NoError(t, err)
+		require.Len(t, snapshots, 1)
+		assert.Equal(t, checking.ID, snapshots[0].AccountID)
+		assert.Equal(t, int64(500000), snapshots[0].CurrentBalance.Amount.Int64())
+
+		snapshots, err = repo.GetBalanceSnapshotsBetween(ctx, "", today.AddDate(0, 0, -7), today)
+		require.NoError(t, err)
+		assert.Len(t, snapshots, 2)
+
+		snapshots, err = repo.GetBalanceSnapshotsBetween(ctx, "", today.AddDate(0, 0, -7), today.AddDate(0, 0, -1))
+		require.NoError(t, err)
+		assert.Empty(t, snapshots)
+	})
+}
@@ -0,0 +1,206 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BookRepository struct {
+	queries *gen.Queries
+}
+
+func NewBookRepository(db *pgxpool.Pool) *BookRepository {
+	return &BookRepository{
+		queries: gen.New(db),
+	}
+}
+
+func (r *BookRepository) CreateBook(ctx context.Context, book entities.Book) (entities.Book, error) {
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return entities.Book{}, err
+	}
+
+	result, err := r.queries.CreateBook(ctx, book.Name, book.Description, book.Asset.Asset, userID)
+	if err != nil {
+		return entities.Book{}, duplicateBook(err, book.Name)
+	}
+
+	return convertBook(result), nil
+}
+
+func (r *BookRepository) GetBookByID(ctx context.Context, id string) (entities.Book, error) {
+	bookID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.Book{}, err
+	}
+
+	result, err := r.queries.GetBookByID(ctx, bookID)
+	if err != nil {
+		return entities.Book{}, notFound(err, "book")
+	}
+	if err := ofUser(ctx, result.UserID, "book"); err != nil {
+		return entities.Book{}, err
+	}
+
+	return convertBook(result), nil
+}
+
+func (r *BookRepository) GetAllBooks(ctx context.Context) ([]entities.Book, error) {
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllBooks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	books := make([]entities.Book, len(results))
+	for i, result := range results {
+		books[i] = convertBook(result)
+	}
+
+	return books, nil
+}
+
+// GetFirstBook returns the oldest book of the context's user
+func (r *BookRepository) GetFirstBook(ctx context.Context) (entities.Book, error) {
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return entities.Book{}, err
+	}
+
+	result, err := r.queries.GetFirstBook(ctx, userID)
+	if err != nil {
+		return entities.Book{}, notFound(err, "book")
+	}
+
+	return convertBook(result), nil
+}
+
+func (r *BookRepository) UpdateBook(ctx context.Context, book entities.Book) (entities.Book, error) {
+	bookID, err := uuid.FromString(book.ID)
+	if err != nil {
+		return entities.Book{}, err
+	}
+	if _, err := r.GetBookByID(ctx, book.ID); err != nil {
+		return entities.Book{}, err
+	}
+
+	result, err := r.queries.UpdateBook(ctx, bookID, book.Name, book.Description, book.Asset.Asset)
+	if err != nil {
+		return entities.Book{}, notFound(duplicateBook(err, book.Name), "book")
+	}
+
+	return convertBook(result), nil
+}
+
+// DeleteBook deletes an empty book, domain.ErrConflict is returned while
+// accounts or categories are still in it
+func (r *BookRepository) DeleteBook(ctx context.Context, id string) error {
+	bookID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+	if _, err := r.GetBookByID(ctx, id); err != nil {
+		return err
+	}
+
+	var pgErr *pgconn.PgError
+	if err := r.queries.DeleteBook(ctx, bookID); errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		return fmt.Errorf("book still has accounts or categories: %w", domain.ErrConflict)
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ClaimBooks gives the books, with their projects and expense reports, and the
+// user settings recorded before users existed to the user, returning how many
+// books there were
+func (r *BookRepository) ClaimBooks(ctx context.Context, userID string) (int64, error) {
+	id, err := uuid.FromString(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.queries.ClaimBooks(ctx, id)
+}
+
+// contextUser returns the user the context is authenticated as, nil when the
+// API runs without authentication
+func contextUser(ctx context.Context) (*uuid.UUID, error) {
+	id, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	userID, err := uuid.FromString(id)
+	if err != nil {
+		return nil, err
+	}
+	return &userID, nil
+}
+
+// ofUser hides the rows of other users than the context's, as if they didn't
+// exist. Without authentication every row is visible.
+func ofUser(ctx context.Context, owner *uuid.UUID, resource string) error {
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return err
+	}
+	if userID != nil && (owner == nil || *owner != *userID) {
+		return fmt.Errorf("%s %w", resource, domain.ErrNotFound)
+	}
+	return nil
+}
+
+// contextBook returns the book the context is scoped to
+func contextBook(ctx context.Context) (uuid.UUID, error) {
+	return uuid.FromString(domain.BookFromContext(ctx))
+}
+
+// inBook hides the rows of other books than the context's, as if they didn't
+// exist, so they can't be read or changed through their ID
+func inBook(ctx context.Context, bookID uuid.UUID, resource string) error {
+	if book, err := contextBook(ctx); err != nil || book != bookID {
+		return fmt.Errorf("%s %w", resource, domain.ErrNotFound)
+	}
+	return nil
+}
+
+// duplicateBook translates a book name already taken into domain.ErrConflict
+func duplicateBook(err error, name string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("book %q already exists: %w", name, domain.ErrConflict)
+	}
+	return err
+}
+
+func convertBook(result gen.Book) entities.Book {
+	asset, ok := entities.FindSupportedAsset(result.Asset)
+	if !ok {
+		asset = monetary.BRL // default fallback
+	}
+
+	return entities.Book{
+		ID:          result.ID.String(),
+		Name:        result.Name,
+		Description: result.Description,
+		Asset:       asset,
+		CreatedAt:   result.CreatedAt,
+		UpdatedAt:   result.UpdatedAt,
+	}
+}
@@ -0,0 +1,76 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewBookRepository(db)
+	accounts := NewAccountRepository(db)
+	categories := NewCategoryRepository(db)
+	ctx := context.Background()
+
+	personal := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+
+	side, err := repo.CreateBook(ctx, entities.Book{Name: "Side business"})
+	require.NoError(t, err)
+	sideCtx := domain.WithBook(ctx, side.ID)
+
+	t.Run("the default book is seeded", func(t *testing.T) {
+		book, err := repo.GetBookByID(ctx, domain.DefaultBookID)
+		require.NoError(t, err)
+		assert.Equal(t, "Personal", book.Name)
+	})
+
+	t.Run("create rejects duplicate name", func(t *testing.T) {
+		_, err := repo.CreateBook(ctx, entities.Book{Name: "Side business"})
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("accounts are kept in their book", func(t *testing.T) {
+		account, err := accounts.CreateAccount(sideCtx, entities.Account{Name: "Business", Type: entities.AccountTypeChecking, Asset: monetary.USD})
+		require.NoError(t, err)
+		assert.Equal(t, side.ID, account.BookID)
+
+		listed, err := accounts.GetAllAccounts(sideCtx, nil)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Equal(t, account.ID, listed[0].ID)
+
+		_, err = accounts.GetAccountByID(sideCtx, personal.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = accounts.GetAccountByID(ctx, account.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		assert.ErrorIs(t, repo.DeleteBook(ctx, side.ID), domain.ErrConflict)
+		require.NoError(t, accounts.DeleteAccount(sideCtx, account.ID))
+	})
+
+	t.Run("categories names are unique per book", func(t *testing.T) {
+		category, err := categories.CreateCategory(sideCtx, entities.Category{Name: "Server rental", Type: entities.CategoryTypeExpense})
+		require.NoError(t, err)
+		_, err = categories.CreateCategory(ctx, entities.Category{Name: "Server rental", Type: entities.CategoryTypeExpense})
+		require.NoError(t, err)
+
+		listed, err := categories.GetAllCategories(sideCtx, nil)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Equal(t, category.ID, listed[0].ID)
+
+		require.NoError(t, categories.DeleteCategory(sideCtx, category.ID))
+	})
+
+	t.Run("delete empty book", func(t *testing.T) {
+		require.NoError(t, repo.DeleteBook(ctx, side.ID))
+		_, err := repo.GetBookByID(ctx, side.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
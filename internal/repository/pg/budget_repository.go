@@ -0,0 +1,320 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// budgetCategoryConstraint is the foreign key of the budget's category
+const budgetCategoryConstraint = "budgets_category_id_fkey"
+
+type BudgetRepository struct {
+	queries *gen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewBudgetRepository(db *pgxpool.Pool) *BudgetRepository {
+	return &BudgetRepository{
+		queries: gen.New(db),
+		db:      db,
+	}
+}
+
+func (r *BudgetRepository) CreateBudget(ctx context.Context, budget entities.Budget) (entities.Budget, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.Budget{}, err
+	}
+
+	return createBudget(ctx, r.queries, bookID, budget)
+}
+
+// CreateBudgets creates the budgets in a single database transaction, none of
+// them when one fails
+func (r *BudgetRepository) CreateBudgets(ctx context.Context, budgets []entities.Budget) ([]entities.Budget, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rolling back after the commit does nothing
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	queries := r.queries.WithTx(tx)
+	created := make([]entities.Budget, len(budgets))
+	for i, budget := range budgets {
+		if created[i], err = createBudget(ctx, queries, bookID, budget); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func createBudget(ctx context.Context, queries *gen.Queries, bookID uuid.UUID, budget entities.Budget) (entities.Budget, error) {
+	categoryID, err := uuid.FromString(budget.CategoryID)
+	if err != nil {
+		return entities.Budget{}, err
+	}
+
+	result, err := queries.CreateBudget(ctx,
+		bookID,
+		categoryID,
+		budget.Limit.Asset.Asset,
+		budget.Limit.Amount.Int64(),
+		pgtype.Date{Time: budget.StartMonth, Valid: true},
+		budgetEndMonth(budget),
+		budgetThresholds(budget),
+	)
+	if err != nil {
+		return entities.Budget{}, budgetError(err)
+	}
+
+	return convertBudget(result)
+}
+
+func (r *BudgetRepository) GetBudgetByID(ctx context.Context, id string) (entities.Budget, error) {
+	budgetID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.Budget{}, err
+	}
+
+	result, err := r.queries.GetBudgetByID(ctx, budgetID)
+	if err != nil {
+		return entities.Budget{}, notFound(err, "budget")
+	}
+
+	if err := inBook(ctx, result.BookID, "budget"); err != nil {
+		return entities.Budget{}, err
+	}
+	if err := ofUser(ctx, result.UserID, "budget"); err != nil {
+		return entities.Budget{}, err
+	}
+
+	return convertBudget(result)
+}
+
+func (r *BudgetRepository) GetAllBudgets(ctx context.Context) ([]entities.Budget, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllBudgets(ctx, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets := make([]entities.Budget, len(results))
+	for i, result := range results {
+		if budgets[i], err = convertBudget(result); err != nil {
+			return nil, err
+		}
+	}
+
+	return budgets, nil
+}
+
+func (r *BudgetRepository) UpdateBudget(ctx context.Context, budget entities.Budget) (entities.Budget, error) {
+	budgetID, err := uuid.FromString(budget.ID)
+	if err != nil {
+		return entities.Budget{}, err
+	}
+	categoryID, err := uuid.FromString(budget.CategoryID)
+	if err != nil {
+		return entities.Budget{}, err
+	}
+
+	result, err := r.queries.UpdateBudget(ctx,
+		budgetID,
+		categoryID,
+		budget.Limit.Asset.Asset,
+		budget.Limit.Amount.Int64(),
+		pgtype.Date{Time: budget.StartMonth, Valid: true},
+		budgetEndMonth(budget),
+		budgetThresholds(budget),
+	)
+	if err != nil {
+		return entities.Budget{}, notFound(budgetError(err), "budget")
+	}
+
+	return convertBudget(result)
+}
+
+func (r *BudgetRepository) DeleteBudget(ctx context.Context, id string) error {
+	budgetID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.DeleteBudget(ctx, budgetID)
+}
+
+// RecordBudgetAlert records the budget reaching a threshold in the month of
+// the alert, or reports false when it did already and the alert was recorded
+// before
+func (r *BudgetRepository) RecordBudgetAlert(ctx context.Context, alert entities.BudgetAlert) (entities.BudgetAlert, bool, error) {
+	budgetID, err := uuid.FromString(alert.BudgetID)
+	if err != nil {
+		return entities.BudgetAlert{}, false, err
+	}
+	month, err := time.Parse("2006-01", alert.Month)
+	if err != nil {
+		return entities.BudgetAlert{}, false, err
+	}
+
+	result, err := r.queries.RecordBudgetAlert(ctx,
+		budgetID,
+		pgtype.Date{Time: month, Valid: true},
+		int32(alert.Threshold),
+		alert.Percent,
+		alert.Spent.Amount.Int64(),
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entities.BudgetAlert{}, false, nil
+	}
+	if err != nil {
+		return entities.BudgetAlert{}, false, err
+	}
+
+	recorded, err := convertBudgetAlert(result)
+	return recorded, err == nil, err
+}
+
+// GetAllBudgetAlerts returns the alerts fired in the book, the latest first
+func (r *BudgetRepository) GetAllBudgetAlerts(ctx context.Context) ([]entities.BudgetAlert, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllBudgetAlerts(ctx, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make([]entities.BudgetAlert, len(results))
+	for i, result := range results {
+		if alerts[i], err = convertBudgetAlert(result); err != nil {
+			return nil, err
+		}
+	}
+
+	return alerts, nil
+}
+
+// budgetEndMonth is the last month of the budget, NULL when open ended
+func budgetEndMonth(budget entities.Budget) pgtype.Date {
+	if budget.EndMonth == nil {
+		return pgtype.Date{}
+	}
+	return pgtype.Date{Time: *budget.EndMonth, Valid: true}
+}
+
+// budgetThresholds are the percents the budget alerts on
+func budgetThresholds(budget entities.Budget) []int32 {
+	thresholds := budget.AlertThresholds()
+	converted := make([]int32, len(thresholds))
+	for i, threshold := range thresholds {
+		converted[i] = int32(threshold)
+	}
+	return converted
+}
+
+// budgetError translates a category already budgeted in one of the months
+// into domain.ErrConflict and a missing one into domain.ErrNotFound
+func budgetError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == exclusionViolation {
+		return fmt.Errorf("category already has a budget in those months: %w", domain.ErrConflict)
+	}
+	return missingReference(err, budgetCategoryConstraint, "category")
+}
+
+func convertBudget(result gen.Budget) (entities.Budget, error) {
+	asset, ok := entities.FindSupportedAsset(result.Asset)
+	if !ok {
+		asset = monetary.BRL // default fallback
+	}
+	limit, err := monetary.NewMonetary(asset, big.NewInt(result.Amount))
+	if err != nil {
+		return entities.Budget{}, err
+	}
+
+	budget := entities.Budget{
+		ID:         result.ID.String(),
+		CategoryID: result.CategoryID.String(),
+		Limit:      *limit,
+		StartMonth: result.StartMonth.Time,
+		Thresholds: make([]int, len(result.Thresholds)),
+		BookID:     result.BookID.String(),
+		OwnerID:    uuidString(result.UserID),
+		CreatedAt:  result.CreatedAt,
+		UpdatedAt:  result.UpdatedAt,
+	}
+	if result.EndMonth.Valid {
+		budget.EndMonth = &result.EndMonth.Time
+	}
+	for i, threshold := range result.Thresholds {
+		budget.Thresholds[i] = int(threshold)
+	}
+
+	return budget, nil
+}
+
+func convertBudgetAlert(result gen.BudgetAlert) (entities.BudgetAlert, error) {
+	asset, ok := entities.FindSupportedAsset(result.Asset)
+	if !ok {
+		asset = monetary.BRL // default fallback
+	}
+	spent, err := monetary.NewMonetary(asset, big.NewInt(result.Spent))
+	if err != nil {
+		return entities.BudgetAlert{}, err
+	}
+	limit, err := monetary.NewMonetary(asset, big.NewInt(result.LimitAmount))
+	if err != nil {
+		return entities.BudgetAlert{}, err
+	}
+
+	return entities.BudgetAlert{
+		ID:         result.ID.String(),
+		BudgetID:   result.BudgetID.String(),
+		CategoryID: result.CategoryID.String(),
+		Month:      result.Month.Time.Format("2006-01"),
+		Threshold:  int(result.Threshold),
+		Percent:    result.Percent,
+		Spent:      *spent,
+		Limit:      *limit,
+		BookID:     result.BookID.String(),
+		OwnerID:    uuidString(result.UserID),
+		CreatedAt:  result.CreatedAt,
+	}, nil
+}
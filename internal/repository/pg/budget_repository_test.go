@@ -0,0 +1,151 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewBudgetRepository(db)
+	books := NewBookRepository(db)
+	ctx := context.Background()
+
+	groceries := createTestCategory(t, db, "Groceries", entities.CategoryTypeExpense)
+	march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	var created entities.Budget
+	t.Run("create and get", func(t *testing.T) {
+		var err error
+		created, err = repo.CreateBudget(ctx, entities.Budget{
+			CategoryID: groceries.ID,
+			Limit:      monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(80000)},
+			StartMonth: march,
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+		assert.Nil(t, created.EndMonth)
+
+		got, err := repo.GetBudgetByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, groceries.ID, got.CategoryID)
+		assert.Equal(t, monetary.BRL, got.Limit.Asset)
+		assert.Equal(t, int64(80000), got.Limit.Amount.Int64())
+		assert.True(t, march.Equal(got.StartMonth))
+		assert.Equal(t, []int{50, 80, 100}, got.Thresholds)
+
+		all, err := repo.GetAllBudgets(ctx)
+		require.NoError(t, err)
+		assert.Len(t, all, 1)
+	})
+
+	t.Run("budgets of a category can't overlap", func(t *testing.T) {
+		_, err := repo.CreateBudget(ctx, entities.Budget{
+			CategoryID: groceries.ID,
+			Limit:      monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(50000)},
+			StartMonth: march,
+		})
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		june := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+		created.Limit = monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(20000)}
+		created.EndMonth = &june
+		created.Thresholds = []int{75, 120}
+
+		updated, err := repo.UpdateBudget(ctx, created)
+		require.NoError(t, err)
+		assert.Equal(t, monetary.USD, updated.Limit.Asset)
+		assert.Equal(t, []int{75, 120}, updated.Thresholds)
+		require.NotNil(t, updated.EndMonth)
+		assert.True(t, june.Equal(*updated.EndMonth))
+	})
+
+	t.Run("create several", func(t *testing.T) {
+		dining := createTestCategory(t, db, "Dining", entities.CategoryTypeExpense)
+		july := time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+		limit := monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(30000)}
+
+		budgets, err := repo.CreateBudgets(ctx, []entities.Budget{
+			{CategoryID: groceries.ID, Limit: limit, StartMonth: july, EndMonth: &july},
+			{CategoryID: dining.ID, Limit: limit, StartMonth: july, EndMonth: &july},
+		})
+		require.NoError(t, err)
+		require.Len(t, budgets, 2)
+		assert.NotEmpty(t, budgets[1].ID)
+
+		// The groceries budget of June overlaps, none is created
+		june := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+		_, err = repo.CreateBudgets(ctx, []entities.Budget{
+			{CategoryID: dining.ID, Limit: limit, StartMonth: june, EndMonth: &june},
+			{CategoryID: groceries.ID, Limit: limit, StartMonth: june, EndMonth: &june},
+		})
+		assert.ErrorIs(t, err, domain.ErrConflict)
+
+		all, err := repo.GetAllBudgets(ctx)
+		require.NoError(t, err)
+		assert.Len(t, all, 3)
+
+		for _, budget := range budgets {
+			require.NoError(t, repo.DeleteBudget(ctx, budget.ID))
+		}
+	})
+
+	t.Run("alerts fire once per threshold and month", func(t *testing.T) {
+		alert := entities.BudgetAlert{
+			BudgetID:  created.ID,
+			Month:     "2025-04",
+			Threshold: 75,
+			Percent:   81.5,
+			Spent:     monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(16300)},
+		}
+		recorded, ok, err := repo.RecordBudgetAlert(ctx, alert)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, groceries.ID, recorded.CategoryID)
+		assert.Equal(t, int64(20000), recorded.Limit.Amount.Int64())
+
+		_, ok, err = repo.RecordBudgetAlert(ctx, alert)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		alert.Month = "2025-05"
+		_, ok, err = repo.RecordBudgetAlert(ctx, alert)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		alerts, err := repo.GetAllBudgetAlerts(ctx)
+		require.NoError(t, err)
+		require.Len(t, alerts, 2)
+		assert.Equal(t, "2025-05", alerts[0].Month)
+	})
+
+	t.Run("budgets are kept per book", func(t *testing.T) {
+		side, err := books.CreateBook(ctx, entities.Book{Name: "Side business", Asset: monetary.USD})
+		require.NoError(t, err)
+		sideCtx := domain.WithBook(ctx, side.ID)
+
+		_, err = repo.GetBudgetByID(sideCtx, created.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		all, err := repo.GetAllBudgets(sideCtx)
+		require.NoError(t, err)
+		assert.Empty(t, all)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.DeleteBudget(ctx, created.ID))
+
+		_, err := repo.GetBudgetByID(ctx, created.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
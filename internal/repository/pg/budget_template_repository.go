@@ -0,0 +1,185 @@
+package pg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+	"math/big"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type BudgetTemplateRepository struct {
+	queries *gen.Queries
+}
+
+func NewBudgetTemplateRepository(db *pgxpool.Pool) *BudgetTemplateRepository {
+	return &BudgetTemplateRepository{
+		queries: gen.New(db),
+	}
+}
+
+// budgetTemplateItem is a limit of a template as kept in the items column,
+// amount in the smallest unit of asset
+type budgetTemplateItem struct {
+	CategoryID string `json:"category_id"`
+	Asset      string `json:"asset"`
+	Amount     int64  `json:"amount"`
+}
+
+func (r *BudgetTemplateRepository) CreateBudgetTemplate(ctx context.Context, template entities.BudgetTemplate) (entities.BudgetTemplate, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.BudgetTemplate{}, err
+	}
+	items, err := marshalBudgetTemplateItems(template.Items)
+	if err != nil {
+		return entities.BudgetTemplate{}, err
+	}
+
+	result, err := r.queries.CreateBudgetTemplate(ctx, bookID, template.Name, items)
+	if err != nil {
+		return entities.BudgetTemplate{}, duplicateBudgetTemplate(err, template.Name)
+	}
+
+	return convertBudgetTemplate(result)
+}
+
+func (r *BudgetTemplateRepository) GetBudgetTemplateByID(ctx context.Context, id string) (entities.BudgetTemplate, error) {
+	templateID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.BudgetTemplate{}, err
+	}
+
+	result, err := r.queries.GetBudgetTemplateByID(ctx, templateID)
+	if err != nil {
+		return entities.BudgetTemplate{}, notFound(err, "budget template")
+	}
+	if err := inBook(ctx, result.BookID, "budget template"); err != nil {
+		return entities.BudgetTemplate{}, err
+	}
+	if err := ofUser(ctx, result.UserID, "budget template"); err != nil {
+		return entities.BudgetTemplate{}, err
+	}
+
+	return convertBudgetTemplate(result)
+}
+
+// GetAllBudgetTemplates returns the budget templates of the book ordered by
+// name
+func (r *BudgetTemplateRepository) GetAllBudgetTemplates(ctx context.Context) ([]entities.BudgetTemplate, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllBudgetTemplates(ctx, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]entities.BudgetTemplate, len(results))
+	for i, result := range results {
+		if templates[i], err = convertBudgetTemplate(result); err != nil {
+			return nil, err
+		}
+	}
+
+	return templates, nil
+}
+
+func (r *BudgetTemplateRepository) UpdateBudgetTemplate(ctx context.Context, template entities.BudgetTemplate) (entities.BudgetTemplate, error) {
+	templateID, err := uuid.FromString(template.ID)
+	if err != nil {
+		return entities.BudgetTemplate{}, err
+	}
+	if _, err := r.GetBudgetTemplateByID(ctx, template.ID); err != nil {
+		return entities.BudgetTemplate{}, err
+	}
+	items, err := marshalBudgetTemplateItems(template.Items)
+	if err != nil {
+		return entities.BudgetTemplate{}, err
+	}
+
+	result, err := r.queries.UpdateBudgetTemplate(ctx, templateID, template.Name, items)
+	if err != nil {
+		return entities.BudgetTemplate{}, notFound(duplicateBudgetTemplate(err, template.Name), "budget template")
+	}
+
+	return convertBudgetTemplate(result)
+}
+
+func (r *BudgetTemplateRepository) DeleteBudgetTemplate(ctx context.Context, id string) error {
+	templateID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+	if _, err := r.GetBudgetTemplateByID(ctx, id); err != nil {
+		return err
+	}
+
+	return r.queries.DeleteBudgetTemplate(ctx, templateID)
+}
+
+// duplicateBudgetTemplate translates a template name already taken in the
+// book into domain.ErrConflict
+func duplicateBudgetTemplate(err error, name string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("budget template %q already exists: %w", name, domain.ErrConflict)
+	}
+	return err
+}
+
+func marshalBudgetTemplateItems(items []entities.BudgetTemplateItem) ([]byte, error) {
+	stored := make([]budgetTemplateItem, len(items))
+	for i, item := range items {
+		stored[i] = budgetTemplateItem{
+			CategoryID: item.CategoryID,
+			Asset:      item.Limit.Asset.Asset,
+			Amount:     item.Limit.Amount.Int64(),
+		}
+	}
+	return json.Marshal(stored)
+}
+
+func convertBudgetTemplate(result gen.BudgetTemplate) (entities.BudgetTemplate, error) {
+	var stored []budgetTemplateItem
+	if err := json.Unmarshal(result.Items, &stored); err != nil {
+		return entities.BudgetTemplate{}, err
+	}
+
+	template := entities.BudgetTemplate{
+		ID:        result.ID.String(),
+		Name:      result.Name,
+		Items:     make([]entities.BudgetTemplateItem, len(stored)),
+		BookID:    result.BookID.String(),
+		OwnerID:   uuidString(result.UserID),
+		CreatedAt: result.CreatedAt,
+		UpdatedAt: result.UpdatedAt,
+	}
+	for i, item := range stored {
+		asset, ok := entities.FindSupportedAsset(item.Asset)
+		if !ok {
+			asset = monetary.BRL // default fallback
+		}
+		limit, err := monetary.NewMonetary(asset, big.NewInt(item.Amount))
+		if err != nil {
+			return entities.BudgetTemplate{}, err
+		}
+		template.Items[i] = entities.BudgetTemplateItem{CategoryID: item.CategoryID, Limit: *limit}
+	}
+
+	return template, nil
+}
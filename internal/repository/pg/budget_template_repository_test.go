@@ -0,0 +1,79 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"math/big"
+	"testing"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetTemplateRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewBudgetTemplateRepository(db)
+	books := NewBookRepository(db)
+	ctx := context.Background()
+
+	groceries := createTestCategory(t, db, "Groceries", entities.CategoryTypeExpense)
+	dining := createTestCategory(t, db, "Dining", entities.CategoryTypeExpense)
+
+	var created entities.BudgetTemplate
+	t.Run("create and get", func(t *testing.T) {
+		var err error
+		created, err = repo.CreateBudgetTemplate(ctx, entities.BudgetTemplate{
+			Name: "Regular month",
+			Items: []entities.BudgetTemplateItem{
+				{CategoryID: groceries.ID, Limit: monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(80000)}},
+				{CategoryID: dining.ID, Limit: monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(5000)}},
+			},
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+		require.Len(t, created.Items, 2)
+		assert.Equal(t, monetary.USD, created.Items[1].Limit.Asset)
+		assert.Equal(t, int64(5000), created.Items[1].Limit.Amount.Int64())
+
+		got, err := repo.GetBudgetTemplateByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created, got)
+
+		_, err = repo.CreateBudgetTemplate(ctx, entities.BudgetTemplate{Name: "Regular month"})
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("update lists by name", func(t *testing.T) {
+		_, err := repo.CreateBudgetTemplate(ctx, entities.BudgetTemplate{Name: "Holidays"})
+		require.NoError(t, err)
+
+		created.Items = created.Items[:1]
+		updated, err := repo.UpdateBudgetTemplate(ctx, created)
+		require.NoError(t, err)
+		assert.Len(t, updated.Items, 1)
+
+		all, err := repo.GetAllBudgetTemplates(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		assert.Equal(t, "Holidays", all[0].Name)
+		assert.Equal(t, "Regular month", all[1].Name)
+	})
+
+	t.Run("templates are kept per book", func(t *testing.T) {
+		side, err := books.CreateBook(ctx, entities.Book{Name: "Side business", Asset: monetary.USD})
+		require.NoError(t, err)
+		sideCtx := domain.WithBook(ctx, side.ID)
+
+		_, err = repo.GetBudgetTemplateByID(sideCtx, created.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.ErrorIs(t, repo.DeleteBudgetTemplate(sideCtx, created.ID), domain.ErrNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.DeleteBudgetTemplate(ctx, created.ID))
+		_, err := repo.GetBudgetTemplateByID(ctx, created.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -2,14 +2,24 @@ package pg
 
 import (
 	"context"
-	"database/sql"
 	"finance/domain/entities"
 	"finance/internal/repository/pg/gen"
+	"fmt"
 
 	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// listCategoriesQuery is built here instead of sqlc since the ORDER BY depends on the request
+const listCategoriesQuery = `SELECT c.id, c.name, c.type, c.description, c.color, c.created_at, c.updated_at, c.book_id, c.user_id, c.parent_id
+FROM categories c
+WHERE c.book_id = $1 AND ($2::uuid IS NULL OR c.user_id = $2)
+ORDER BY %s`
+
+// categoryBookConstraint is the foreign key of the category's book
+const categoryBookConstraint = "categories_book_id_fkey"
+
 type CategoryRepository struct {
 	queries *gen.Queries
 	db      *pgxpool.Pool
@@ -23,20 +33,22 @@ func NewCategoryRepository(db *pgxpool.Pool) *CategoryRepository {
 }
 
 func (r *CategoryRepository) CreateCategory(ctx context.Context, category entities.Category) (entities.Category, error) {
-	result, err := r.queries.CreateCategory(ctx, category.Name, string(category.Type), category.Description, category.Color)
+	bookID, err := contextBook(ctx)
 	if err != nil {
 		return entities.Category{}, err
 	}
 
-	return entities.Category{
-		ID:          result.ID.String(),
-		Name:        result.Name,
-		Type:        entities.CategoryType(result.Type),
-		Description: result.Description,
-		Color:       result.Color,
-		CreatedAt:   result.CreatedAt,
-		UpdatedAt:   result.UpdatedAt,
-	}, nil
+	parentID, err := nullUUID(category.ParentID)
+	if err != nil {
+		return entities.Category{}, err
+	}
+
+	result, err := r.queries.CreateCategory(ctx, category.Name, string(category.Type), category.Description, category.Color, bookID, parentID)
+	if err != nil {
+		return entities.Category{}, missingReference(err, categoryBookConstraint, "book")
+	}
+
+	return convertCategory(result), nil
 }
 
 func (r *CategoryRepository) GetCategoryByID(ctx context.Context, id string) (entities.Category, error) {
@@ -47,62 +59,98 @@ func (r *CategoryRepository) GetCategoryByID(ctx context.Context, id string) (en
 
 	result, err := r.queries.GetCategoryByID(ctx, uuid)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return entities.Category{}, nil
-		}
+		return entities.Category{}, notFound(err, "category")
+	}
+
+	if err := inBook(ctx, result.BookID, "category"); err != nil {
+		return entities.Category{}, err
+	}
+	if err := ofUser(ctx, result.UserID, "category"); err != nil {
 		return entities.Category{}, err
 	}
 
-	return entities.Category{
-		ID:          result.ID.String(),
-		Name:        result.Name,
-		Type:        entities.CategoryType(result.Type),
-		Description: result.Description,
-		Color:       result.Color,
-		CreatedAt:   result.CreatedAt,
-		UpdatedAt:   result.UpdatedAt,
-	}, nil
+	return convertCategory(result), nil
+}
+
+func (r *CategoryRepository) GetAllCategories(ctx context.Context, sort []entities.SortField) ([]entities.Category, error) {
+	order, err := orderBy(sort, categorySortColumns, "c.type, c.name")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.listCategories(ctx, fmt.Sprintf(listCategoriesQuery, order))
 }
 
-func (r *CategoryRepository) GetAllCategories(ctx context.Context) ([]entities.Category, error) {
-	results, err := r.queries.GetAllCategories(ctx)
+// GetCategoriesPage lists limit categories after the first offset ones, ties
+// in the sort broken by ID so the pages don't overlap
+func (r *CategoryRepository) GetCategoriesPage(ctx context.Context, sort []entities.SortField, limit, offset int) ([]entities.Category, error) {
+	order, err := orderBy(sort, categorySortColumns, "c.type, c.name")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.listCategories(ctx, fmt.Sprintf(listCategoriesQuery, order+", c.id")+pageClause, int32(limit), int32(offset))
+}
+
+func (r *CategoryRepository) listCategories(ctx context.Context, query string, args ...any) ([]entities.Category, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, append([]any{bookID, userID}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[gen.Category])
 	if err != nil {
 		return nil, err
 	}
 
 	categories := make([]entities.Category, len(results))
 	for i, result := range results {
-		categories[i] = entities.Category{
-			ID:          result.ID.String(),
-			Name:        result.Name,
-			Type:        entities.CategoryType(result.Type),
-			Description: result.Description,
-			Color:       result.Color,
-			CreatedAt:   result.CreatedAt,
-			UpdatedAt:   result.UpdatedAt,
-		}
+		categories[i] = convertCategory(result)
 	}
 
 	return categories, nil
 }
 
+func (r *CategoryRepository) CountCategories(ctx context.Context) (int64, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return 0, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.queries.CountCategories(ctx, bookID, userID)
+}
+
 func (r *CategoryRepository) GetCategoriesByType(ctx context.Context, categoryType entities.CategoryType) ([]entities.Category, error) {
-	results, err := r.queries.GetCategoriesByType(ctx, string(categoryType))
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetCategoriesByType(ctx, string(categoryType), bookID, userID)
 	if err != nil {
 		return nil, err
 	}
 
 	categories := make([]entities.Category, len(results))
 	for i, result := range results {
-		categories[i] = entities.Category{
-			ID:          result.ID.String(),
-			Name:        result.Name,
-			Type:        entities.CategoryType(result.Type),
-			Description: result.Description,
-			Color:       result.Color,
-			CreatedAt:   result.CreatedAt,
-			UpdatedAt:   result.UpdatedAt,
-		}
+		categories[i] = convertCategory(result)
 	}
 
 	return categories, nil
@@ -114,20 +162,17 @@ func (r *CategoryRepository) UpdateCategory(ctx context.Context, category entiti
 		return entities.Category{}, err
 	}
 
-	result, err := r.queries.UpdateCategory(ctx, uuid, category.Name, string(category.Type), category.Description, category.Color)
+	parentID, err := nullUUID(category.ParentID)
 	if err != nil {
 		return entities.Category{}, err
 	}
 
-	return entities.Category{
-		ID:          result.ID.String(),
-		Name:        result.Name,
-		Type:        entities.CategoryType(result.Type),
-		Description: result.Description,
-		Color:       result.Color,
-		CreatedAt:   result.CreatedAt,
-		UpdatedAt:   result.UpdatedAt,
-	}, nil
+	result, err := r.queries.UpdateCategory(ctx, uuid, category.Name, string(category.Type), category.Description, category.Color, parentID)
+	if err != nil {
+		return entities.Category{}, notFound(err, "category")
+	}
+
+	return convertCategory(result), nil
 }
 
 func (r *CategoryRepository) DeleteCategory(ctx context.Context, id string) error {
@@ -138,3 +183,18 @@ func (r *CategoryRepository) DeleteCategory(ctx context.Context, id string) erro
 
 	return r.queries.DeleteCategory(ctx, uuid)
 }
+
+func convertCategory(result gen.Category) entities.Category {
+	return entities.Category{
+		ID:          result.ID.String(),
+		Name:        result.Name,
+		Type:        entities.CategoryType(result.Type),
+		Description: result.Description,
+		Color:       result.Color,
+		ParentID:    uuidString(result.ParentID),
+		BookID:      result.BookID.String(),
+		OwnerID:     uuidString(result.UserID),
+		CreatedAt:   result.CreatedAt,
+		UpdatedAt:   result.UpdatedAt,
+	}
+}
@@ -0,0 +1,155 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCategoryRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewCategoryRepository(db)
+	ctx := context.Background()
+
+	// The schema migration seeds the default categories
+	defaults, err := repo.GetAllCategories(ctx, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, defaults)
+
+	t.Run("create", func(t *testing.T) {
+		category, err := repo.CreateCategory(ctx, entities.Category{
+			Name:        "Pets",
+			Type:        entities.CategoryTypeExpense,
+			Description: "Food and vet",
+			Color:       "#123456",
+		})
+		require.NoError(t, err)
+
+		assert.NotEmpty(t, category.ID)
+		assert.Equal(t, "Pets", category.Name)
+		assert.Equal(t, entities.CategoryTypeExpense, category.Type)
+		assert.Equal(t, "Food and vet", category.Description)
+		assert.Equal(t, "#123456", category.Color)
+	})
+
+	t.Run("create rejects duplicate name within type", func(t *testing.T) {
+		_, err := repo.CreateCategory(ctx, entities.Category{Name: "Pets", Type: entities.CategoryTypeExpense})
+		assert.Error(t, err)
+
+		_, err = repo.CreateCategory(ctx, entities.Category{Name: "Pets", Type: entities.CategoryTypeIncome})
+		assert.NoError(t, err)
+	})
+
+	t.Run("get by id", func(t *testing.T) {
+		created := createTestCategory(t, db, "Books", entities.CategoryTypeExpense)
+
+		category, err := repo.GetCategoryByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created, category)
+	})
+
+	t.Run("get by id not found", func(t *testing.T) {
+		_, err := repo.GetCategoryByID(ctx, uuid.Must(uuid.NewV4()).String())
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("get all sorted by type and name by default", func(t *testing.T) {
+		categories, err := repo.GetAllCategories(ctx, nil)
+		require.NoError(t, err)
+		assert.Len(t, categories, len(defaults)+3)
+
+		for i := 1; i < len(categories); i++ {
+			prev, cur := categories[i-1], categories[i]
+			assert.True(t, prev.Type < cur.Type || (prev.Type == cur.Type && prev.Name <= cur.Name),
+				"%s/%s sorted before %s/%s", prev.Type, prev.Name, cur.Type, cur.Name)
+		}
+	})
+
+	t.Run("get all with sort", func(t *testing.T) {
+		categories, err := repo.GetAllCategories(ctx, []entities.SortField{{Field: "created_at", Desc: true}})
+		require.NoError(t, err)
+		assert.Equal(t, "Books", categories[0].Name)
+
+		_, err = repo.GetAllCategories(ctx, []entities.SortField{{Field: "color"}})
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+
+	t.Run("page and count", func(t *testing.T) {
+		all, err := repo.GetAllCategories(ctx, nil)
+		require.NoError(t, err)
+
+		page, err := repo.GetCategoriesPage(ctx, nil, 2, 1)
+		require.NoError(t, err)
+		require.Len(t, page, 2)
+		assert.Equal(t, all[1].ID, page[0].ID)
+		assert.Equal(t, all[2].ID, page[1].ID)
+
+		count, err := repo.CountCategories(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(all)), count)
+	})
+
+	t.Run("get by type", func(t *testing.T) {
+		categories, err := repo.GetCategoriesByType(ctx, entities.CategoryTypeIncome)
+		require.NoError(t, err)
+		require.NotEmpty(t, categories)
+
+		for _, category := range categories {
+			assert.Equal(t, entities.CategoryTypeIncome, category.Type)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		created := createTestCategory(t, db, "Gym", entities.CategoryTypeExpense)
+
+		updated, err := repo.UpdateCategory(ctx, entities.Category{
+			ID:          created.ID,
+			Name:        "Fitness",
+			Type:        entities.CategoryTypeExpense,
+			Description: "Gym and sports",
+			Color:       "#00FF00",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Fitness", updated.Name)
+		assert.Equal(t, "Gym and sports", updated.Description)
+		assert.Equal(t, "#00FF00", updated.Color)
+	})
+
+	t.Run("groups", func(t *testing.T) {
+		food := createTestCategory(t, db, "Food Group", entities.CategoryTypeExpense)
+		restaurants, err := repo.CreateCategory(ctx, entities.Category{Name: "Restaurants", Type: entities.CategoryTypeExpense, ParentID: food.ID})
+		require.NoError(t, err)
+		assert.Equal(t, food.ID, restaurants.ParentID)
+
+		got, err := repo.GetCategoryByID(ctx, restaurants.ID)
+		require.NoError(t, err)
+		assert.Equal(t, food.ID, got.ParentID)
+
+		// Deleting the group leaves its categories ungrouped
+		require.NoError(t, repo.DeleteCategory(ctx, food.ID))
+		got, err = repo.GetCategoryByID(ctx, restaurants.ID)
+		require.NoError(t, err)
+		assert.Empty(t, got.ParentID)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		created := createTestCategory(t, db, "Temporary", entities.CategoryTypeExpense)
+		require.NoError(t, repo.DeleteCategory(ctx, created.ID))
+
+		_, err := repo.GetCategoryByID(ctx, created.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("delete in use is restricted", func(t *testing.T) {
+		account := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+		category := createTestCategory(t, db, "In Use", entities.CategoryTypeExpense)
+		createTestTransaction(t, db, account, category, -100, account.CreatedAt, entities.TransactionStatusCleared)
+
+		assert.Error(t, repo.DeleteCategory(ctx, category.ID))
+	})
+}
@@ -0,0 +1,37 @@
+package pg
+
+import (
+	"database/sql"
+	"errors"
+	"finance/domain"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Postgres error codes for a duplicate key, a reference to a missing row and
+// a row overlapping another one
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+	exclusionViolation  = "23P01"
+)
+
+// notFound translates a missing row into domain.ErrNotFound, naming the resource so
+// the message reads like "account not found"
+func notFound(err error, resource string) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%s %w", resource, domain.ErrNotFound)
+	}
+	return err
+}
+
+// missingReference translates a violation of the foreign key constraint, a
+// reference to a row that doesn't exist, into domain.ErrNotFound
+func missingReference(err error, constraint, resource string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation && pgErr.ConstraintName == constraint {
+		return fmt.Errorf("%s %w", resource, domain.ErrNotFound)
+	}
+	return err
+}
@@ -0,0 +1,252 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+	"math/big"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ExpenseReportRepository struct {
+	queries *gen.Queries
+}
+
+func NewExpenseReportRepository(db *pgxpool.Pool) *ExpenseReportRepository {
+	return &ExpenseReportRepository{
+		queries: gen.New(db),
+	}
+}
+
+func (r *ExpenseReportRepository) CreateExpenseReport(ctx context.Context, report entities.ExpenseReport) (entities.ExpenseReport, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.ExpenseReport{}, err
+	}
+
+	result, err := r.queries.CreateExpenseReport(ctx,
+		bookID,
+		report.Name,
+		pgtype.Date{Time: report.StartDate, Valid: true},
+		pgtype.Date{Time: report.EndDate, Valid: true},
+		report.Notes,
+	)
+	if err != nil {
+		return entities.ExpenseReport{}, err
+	}
+
+	return convertExpenseReport(result), nil
+}
+
+func (r *ExpenseReportRepository) GetExpenseReportByID(ctx context.Context, id string) (entities.ExpenseReport, error) {
+	reportID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.ExpenseReport{}, err
+	}
+
+	result, err := r.queries.GetExpenseReportByID(ctx, reportID)
+	if err != nil {
+		return entities.ExpenseReport{}, notFound(err, "expense report")
+	}
+
+	if err := inBook(ctx, result.BookID, "expense report"); err != nil {
+		return entities.ExpenseReport{}, err
+	}
+	if err := ofUser(ctx, result.UserID, "expense report"); err != nil {
+		return entities.ExpenseReport{}, err
+	}
+
+	return convertExpenseReport(result), nil
+}
+
+func (r *ExpenseReportRepository) GetAllExpenseReports(ctx context.Context) ([]entities.ExpenseReport, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllExpenseReports(ctx, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]entities.ExpenseReport, len(results))
+	for i, result := range results {
+		reports[i] = convertExpenseReport(result)
+	}
+
+	return reports, nil
+}
+
+func (r *ExpenseReportRepository) UpdateExpenseReport(ctx context.Context, report entities.ExpenseReport) (entities.ExpenseReport, error) {
+	reportID, err := uuid.FromString(report.ID)
+	if err != nil {
+		return entities.ExpenseReport{}, err
+	}
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.ExpenseReport{}, err
+	}
+
+	result, err := r.queries.UpdateExpenseReport(ctx,
+		reportID,
+		bookID,
+		report.Name,
+		pgtype.Date{Time: report.StartDate, Valid: true},
+		pgtype.Date{Time: report.EndDate, Valid: true},
+		report.Notes,
+		string(report.Status),
+		report.SubmittedAt,
+		report.ReviewedAt,
+	)
+	if err != nil {
+		return entities.ExpenseReport{}, notFound(err, "expense report")
+	}
+
+	return convertExpenseReport(result), nil
+}
+
+func (r *ExpenseReportRepository) DeleteExpenseReport(ctx context.Context, id string) error {
+	reportID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.DeleteExpenseReport(ctx, reportID, bookID)
+}
+
+// AddExpenseReportTransaction links a transaction to a report, failing with
+// domain.ErrConflict when it's already on a report
+func (r *ExpenseReportRepository) AddExpenseReportTransaction(ctx context.Context, reportID, transactionID string) error {
+	report, err := uuid.FromString(reportID)
+	if err != nil {
+		return err
+	}
+	transaction, err := uuid.FromString(transactionID)
+	if err != nil {
+		return err
+	}
+
+	err = r.queries.AddExpenseReportTransaction(ctx, report, transaction)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("transaction %s is already on an expense report: %w", transactionID, domain.ErrConflict)
+	}
+	return err
+}
+
+func (r *ExpenseReportRepository) RemoveExpenseReportTransaction(ctx context.Context, reportID, transactionID string) error {
+	report, err := uuid.FromString(reportID)
+	if err != nil {
+		return err
+	}
+	transaction, err := uuid.FromString(transactionID)
+	if err != nil {
+		return err
+	}
+
+	removed, err := r.queries.RemoveExpenseReportTransaction(ctx, report, transaction)
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return fmt.Errorf("expense report transaction %w", domain.ErrNotFound)
+	}
+
+	return nil
+}
+
+// GetExpenseReportTransactions returns the transactions on a report, oldest
+// first, with their account and category
+func (r *ExpenseReportRepository) GetExpenseReportTransactions(ctx context.Context, reportID string) ([]entities.Transaction, error) {
+	id, err := uuid.FromString(reportID)
+	if err != nil {
+		return nil, err
+	}
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetExpenseReportTransactions(ctx, id, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]entities.Transaction, len(results))
+	for i, result := range results {
+		asset, ok := entities.FindSupportedAsset(result.AccountAsset)
+		if !ok {
+			asset = monetary.BRL // default fallback
+		}
+
+		monetaryAmount, err := monetary.NewMonetary(asset, big.NewInt(result.Amount))
+		if err != nil {
+			return nil, err
+		}
+
+		transactions[i] = entities.Transaction{
+			ID:                result.ID.String(),
+			AccountID:         result.AccountID.String(),
+			CategoryID:        result.CategoryID.String(),
+			Monetary:          *monetaryAmount,
+			Description:       result.Description,
+			Payee:             result.Payee,
+			InstallmentPlanID: uuidString(result.InstallmentPlanID),
+			InstallmentNumber: int(result.InstallmentNumber),
+			InstallmentCount:  int(result.InstallmentCount),
+			ProjectID:         uuidString(result.ProjectID),
+			Date:              result.Date.Time,
+			Status:            entities.TransactionStatus(result.Status),
+			CreatedAt:         result.CreatedAt,
+			UpdatedAt:         result.UpdatedAt,
+			Account: &entities.Account{
+				ID:   result.AccountID.String(),
+				Name: result.AccountName,
+				Type: entities.AccountType(result.AccountType),
+			},
+			Category: &entities.Category{
+				ID:    result.CategoryID.String(),
+				Name:  result.CategoryName,
+				Type:  entities.CategoryType(result.CategoryType),
+				Color: result.CategoryColor,
+			},
+		}
+	}
+
+	return transactions, nil
+}
+
+func convertExpenseReport(result gen.ExpenseReport) entities.ExpenseReport {
+	return entities.ExpenseReport{
+		ID:          result.ID.String(),
+		Name:        result.Name,
+		StartDate:   result.StartDate.Time,
+		EndDate:     result.EndDate.Time,
+		Notes:       result.Notes,
+		Status:      entities.ExpenseReportStatus(result.Status),
+		SubmittedAt: result.SubmittedAt,
+		ReviewedAt:  result.ReviewedAt,
+		BookID:      result.BookID.String(),
+		OwnerID:     uuidString(result.UserID),
+		CreatedAt:   result.CreatedAt,
+		UpdatedAt:   result.UpdatedAt,
+	}
+}
@@ -0,0 +1,69 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpenseReportRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewExpenseReportRepository(db)
+	books := NewBookRepository(db)
+	ctx := context.Background()
+
+	card := createTestAccount(t, db, "Card", entities.AccountTypeCredit)
+	travel := createTestCategory(t, db, "Travel", entities.CategoryTypeExpense)
+	march := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+	hotel := createTestTransaction(t, db, card, travel, 42000, march, entities.TransactionStatusCleared)
+
+	report, err := repo.CreateExpenseReport(ctx, entities.ExpenseReport{
+		Name:      "Lisbon trip",
+		StartDate: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	require.NoError(t, repo.AddExpenseReportTransaction(ctx, report.ID, hotel.ID))
+
+	t.Run("create and get", func(t *testing.T) {
+		got, err := repo.GetExpenseReportByID(ctx, report.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Lisbon trip", got.Name)
+		assert.Equal(t, entities.ExpenseReportStatusDraft, got.Status)
+
+		transactions, err := repo.GetExpenseReportTransactions(ctx, report.ID)
+		require.NoError(t, err)
+		require.Len(t, transactions, 1)
+		assert.Equal(t, hotel.ID, transactions[0].ID)
+	})
+
+	t.Run("expense reports are kept per book", func(t *testing.T) {
+		side, err := books.CreateBook(ctx, entities.Book{Name: "Side business", Asset: monetary.USD})
+		require.NoError(t, err)
+		sideCtx := domain.WithBook(ctx, side.ID)
+
+		_, err = repo.GetExpenseReportByID(sideCtx, report.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		all, err := repo.GetAllExpenseReports(sideCtx)
+		require.NoError(t, err)
+		assert.Empty(t, all)
+
+		transactions, err := repo.GetExpenseReportTransactions(sideCtx, report.ID)
+		require.NoError(t, err)
+		assert.Empty(t, transactions)
+
+		_, err = repo.UpdateExpenseReport(sideCtx, report)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		require.NoError(t, repo.DeleteExpenseReport(sideCtx, report.ID))
+		_, err = repo.GetExpenseReportByID(ctx, report.ID)
+		require.NoError(t, err)
+	})
+}
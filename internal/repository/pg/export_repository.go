@@ -0,0 +1,143 @@
+package pg
+
+import (
+	"context"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ExportRepository struct {
+	queries *gen.Queries
+}
+
+func NewExportRepository(db *pgxpool.Pool) *ExportRepository {
+	return &ExportRepository{
+		queries: gen.New(db),
+	}
+}
+
+// CreateExport records an export written to the file store, owned by the
+// user of the context
+func (r *ExportRepository) CreateExport(ctx context.Context, export entities.Export) (entities.Export, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.Export{}, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return entities.Export{}, err
+	}
+
+	result, err := r.queries.CreateExport(ctx,
+		bookID,
+		userID,
+		string(export.Format),
+		export.FileName,
+		export.ContentType,
+		export.Size,
+		export.StorageKey,
+		export.ExpiresAt,
+	)
+	if err != nil {
+		return entities.Export{}, err
+	}
+
+	return convertExport(result), nil
+}
+
+func (r *ExportRepository) GetExportByID(ctx context.Context, id string) (entities.Export, error) {
+	exportID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.Export{}, err
+	}
+
+	result, err := r.queries.GetExportByID(ctx, exportID)
+	if err != nil {
+		return entities.Export{}, notFound(err, "export")
+	}
+
+	if err := inBook(ctx, result.BookID, "export"); err != nil {
+		return entities.Export{}, err
+	}
+	if err := ofUser(ctx, result.UserID, "export"); err != nil {
+		return entities.Export{}, err
+	}
+
+	return convertExport(result), nil
+}
+
+// GetExports returns the exports of the book not expired by now, the latest
+// first
+func (r *ExportRepository) GetExports(ctx context.Context, now time.Time) ([]entities.Export, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetExports(ctx, bookID, userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertExports(results), nil
+}
+
+// DeleteExpiredExports forgets the exports of the book expired by now,
+// returning them so their files can be deleted
+func (r *ExportRepository) DeleteExpiredExports(ctx context.Context, now time.Time) ([]entities.Export, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.DeleteExpiredExports(ctx, bookID, userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertExports(results), nil
+}
+
+func (r *ExportRepository) DeleteExport(ctx context.Context, id string) error {
+	exportID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.DeleteExport(ctx, exportID)
+}
+
+func convertExports(results []gen.Export) []entities.Export {
+	exports := make([]entities.Export, len(results))
+	for i, result := range results {
+		exports[i] = convertExport(result)
+	}
+	return exports
+}
+
+func convertExport(result gen.Export) entities.Export {
+	return entities.Export{
+		ID:          result.ID.String(),
+		Format:      entities.TransactionExportFormat(result.Format),
+		FileName:    result.FileName,
+		ContentType: result.ContentType,
+		Size:        result.Size,
+		StorageKey:  result.StorageKey,
+		BookID:      result.BookID.String(),
+		OwnerID:     uuidString(result.UserID),
+		CreatedAt:   result.CreatedAt,
+		ExpiresAt:   result.ExpiresAt,
+	}
+}
@@ -0,0 +1,82 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewExportRepository(db)
+	books := NewBookRepository(db)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	var export entities.Export
+	t.Run("create and get", func(t *testing.T) {
+		var err error
+		export, err = repo.CreateExport(ctx, entities.Export{
+			Format:      entities.TransactionExportFormatCSV,
+			FileName:    "transactions-2025-04-01.csv",
+			ContentType: "text/csv",
+			Size:        1024,
+			StorageKey:  "exports/current.csv",
+			ExpiresAt:   now.Add(entities.ExportTTL),
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, export.ID)
+		assert.Equal(t, entities.TransactionExportFormatCSV, export.Format)
+
+		got, err := repo.GetExportByID(ctx, export.ID)
+		require.NoError(t, err)
+		assert.Equal(t, export, got)
+	})
+
+	t.Run("list and delete the expired ones", func(t *testing.T) {
+		expired, err := repo.CreateExport(ctx, entities.Export{
+			Format:      entities.TransactionExportFormatXLSX,
+			FileName:    "transactions-2025-03-01.xlsx",
+			ContentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+			Size:        2048,
+			StorageKey:  "exports/old.xlsx",
+			ExpiresAt:   now.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+
+		exports, err := repo.GetExports(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, []entities.Export{export}, exports)
+
+		deleted, err := repo.DeleteExpiredExports(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, []entities.Export{expired}, deleted)
+		_, err = repo.GetExportByID(ctx, expired.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("exports are kept per book", func(t *testing.T) {
+		side, err := books.CreateBook(ctx, entities.Book{Name: "Side business", Asset: monetary.USD})
+		require.NoError(t, err)
+		sideCtx := domain.WithBook(ctx, side.ID)
+
+		exports, err := repo.GetExports(sideCtx, now)
+		require.NoError(t, err)
+		assert.Empty(t, exports)
+
+		_, err = repo.GetExportByID(sideCtx, export.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.DeleteExport(ctx, export.ID))
+		_, err := repo.GetExportByID(ctx, export.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -3,120 +3,711 @@
 -- =============================================================================
 
 -- name: CreateAccount :one
-INSERT INTO accounts (name, type, description, asset)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, type, description, asset, created_at, updated_at;
+INSERT INTO accounts (name, type, description, asset, classification, institution, account_number_last4, color, icon, statement_closing_day, payment_due_day, book_id, user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, (SELECT user_id FROM books WHERE id = $12))
+RETURNING id, name, type, description, asset, created_at, updated_at, classification, institution, account_number_last4, color, icon, statement_closing_day, payment_due_day, book_id, user_id;
 
 -- name: GetAccountByID :one
-SELECT id, name, type, description, asset, created_at, updated_at
-FROM accounts
-WHERE id = $1;
-
--- name: GetAllAccounts :many
-SELECT id, name, type, description, asset, created_at, updated_at
-FROM accounts
-ORDER BY name;
+SELECT
+    a.id, a.name, a.type, a.description, a.asset, a.created_at, a.updated_at, a.classification, a.institution, a.account_number_last4, a.color, a.icon, a.statement_closing_day, a.payment_due_day, a.book_id, a.user_id,
+    activity.transaction_count, activity.last_transaction_date
+FROM accounts a
+CROSS JOIN LATERAL (
+    SELECT COUNT(*) AS transaction_count, MAX(t.date)::date AS last_transaction_date
+    FROM transactions t
+    WHERE t.account_id = a.id AND t.deleted_at IS NULL
+) activity
+WHERE a.id = $1;
 
 -- name: UpdateAccount :one
 UPDATE accounts
-SET name = $2, type = $3, description = $4, asset = $5, updated_at = NOW()
+SET name = $2, type = $3, description = $4, asset = $5, classification = $6, institution = $7, account_number_last4 = $8, color = $9, icon = $10, statement_closing_day = $11, payment_due_day = $12, updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, type, description, asset, created_at, updated_at;
+RETURNING id, name, type, description, asset, created_at, updated_at, classification, institution, account_number_last4, color, icon, statement_closing_day, payment_due_day, book_id, user_id;
 
 -- name: DeleteAccount :exec
 DELETE FROM accounts WHERE id = $1;
 
+-- name: CountAccounts :one
+SELECT COUNT(*) FROM accounts WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2);
+
+-- name: CountAccountTransactions :one
+SELECT COUNT(*) FROM transactions WHERE account_id = $1;
+
+-- name: GetAccountNumbers :many
+SELECT id, account_number_last4
+FROM accounts
+WHERE account_number_last4 <> ''
+ORDER BY id;
+
+-- name: SetAccountNumber :exec
+UPDATE accounts
+SET account_number_last4 = $2
+WHERE id = $1;
+
+-- name: GetAccountBalanceBefore :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS balance
+FROM transactions
+WHERE account_id = $1 AND status = 'cleared' AND date < $2 AND deleted_at IS NULL;
+
+-- name: GetAccountPeriodSummary :one
+SELECT
+    COALESCE(SUM(amount) FILTER (WHERE date < sqlc.arg(from_date)), 0)::bigint AS opening_balance,
+    COALESCE(SUM(amount) FILTER (WHERE date >= sqlc.arg(from_date) AND amount > 0), 0)::bigint AS total_in,
+    COALESCE(-SUM(amount) FILTER (WHERE date >= sqlc.arg(from_date) AND amount < 0), 0)::bigint AS total_out
+FROM transactions
+WHERE account_id = sqlc.arg(account_id) AND status = 'cleared' AND date <= sqlc.arg(to_date) AND deleted_at IS NULL;
+
+-- name: GetAccountStatement :many
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, project_id, running_balance
+FROM (
+    SELECT
+        t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee, t.project_id,
+        SUM(t.amount) OVER (ORDER BY t.date, t.created_at, t.id)::bigint AS running_balance
+    FROM transactions t
+    WHERE t.account_id = sqlc.arg(account_id) AND t.status = 'cleared' AND t.date <= sqlc.arg(to_date) AND t.deleted_at IS NULL
+) statement
+WHERE statement.date >= sqlc.arg(from_date)
+ORDER BY statement.date, statement.created_at, statement.id;
+
 -- =============================================================================
 -- CATEGORIES
 -- =============================================================================
 
 -- name: CreateCategory :one
-INSERT INTO categories (name, type, description, color)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, type, description, color, created_at, updated_at;
+INSERT INTO categories (name, type, description, color, book_id, user_id, parent_id)
+VALUES ($1, $2, $3, $4, $5, (SELECT user_id FROM books WHERE id = $5), $6)
+RETURNING id, name, type, description, color, created_at, updated_at, book_id, user_id, parent_id;
 
 -- name: GetCategoryByID :one
-SELECT id, name, type, description, color, created_at, updated_at
+SELECT id, name, type, description, color, created_at, updated_at, book_id, user_id, parent_id
 FROM categories
 WHERE id = $1;
 
--- name: GetAllCategories :many
-SELECT id, name, type, description, color, created_at, updated_at
-FROM categories
-ORDER BY type, name;
-
 -- name: GetCategoriesByType :many
-SELECT id, name, type, description, color, created_at, updated_at
+SELECT id, name, type, description, color, created_at, updated_at, book_id, user_id, parent_id
 FROM categories
-WHERE type = $1
+WHERE type = $1 AND book_id = $2 AND ($3::uuid IS NULL OR user_id = $3)
 ORDER BY name;
 
 -- name: UpdateCategory :one
 UPDATE categories
-SET name = $2, type = $3, description = $4, color = $5, updated_at = NOW()
+SET name = $2, type = $3, description = $4, color = $5, parent_id = $6, updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, type, description, color, created_at, updated_at;
+RETURNING id, name, type, description, color, created_at, updated_at, book_id, user_id, parent_id;
 
 -- name: DeleteCategory :exec
 DELETE FROM categories WHERE id = $1;
 
+-- name: CountCategories :one
+SELECT COUNT(*) FROM categories WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2);
+
 -- =============================================================================
 -- TRANSACTIONS
 -- =============================================================================
 
 -- name: CreateTransaction :one
-INSERT INTO transactions (account_id, category_id, amount, description, date, status)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, account_id, category_id, amount, description, date, status, created_at, updated_at;
+INSERT INTO transactions (account_id, category_id, amount, description, date, status, payee, installment_plan_id, installment_number, installment_count, project_id, user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, (SELECT user_id FROM accounts WHERE id = $1))
+RETURNING id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at;
 
 -- name: GetTransactionByID :one
-SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
 FROM transactions
-WHERE id = $1;
+WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: GetAllTransactions :many
-SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at
-FROM transactions
-ORDER BY date DESC, created_at DESC;
+SELECT t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee, t.installment_plan_id, t.installment_number, t.installment_count, t.project_id, t.user_id
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE a.book_id = $1 AND t.deleted_at IS NULL AND ($2::uuid IS NULL OR t.user_id = $2)
+ORDER BY t.date DESC, t.created_at DESC;
+
+-- name: CountTransactions :one
+SELECT COUNT(*)
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE a.book_id = $1 AND t.deleted_at IS NULL AND ($2::uuid IS NULL OR t.project_id = $2) AND ($3::uuid IS NULL OR t.user_id = $3)
+    AND ($4::text IS NULL OR EXISTS (SELECT 1 FROM transaction_tags tt JOIN tags g ON g.id = tt.tag_id WHERE tt.transaction_id = t.id AND g.name = lower($4)));
 
 -- name: GetTransactionsByAccount :many
-SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
 FROM transactions
-WHERE account_id = $1
+WHERE account_id = $1 AND deleted_at IS NULL AND ($2::uuid IS NULL OR user_id = $2)
 ORDER BY date DESC, created_at DESC;
 
 -- name: GetTransactionsByCategory :many
-SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
 FROM transactions
-WHERE category_id = $1
+WHERE category_id = $1 AND deleted_at IS NULL AND ($2::uuid IS NULL OR user_id = $2)
 ORDER BY date DESC, created_at DESC;
 
 -- name: GetTransactionsByDateRange :many
-SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at
-FROM transactions
-WHERE date >= $1 AND date <= $2
-ORDER BY date DESC, created_at DESC;
+SELECT t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee, t.installment_plan_id, t.installment_number, t.installment_count, t.project_id, t.user_id
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE t.deleted_at IS NULL AND t.date >= $1 AND t.date <= $2 AND a.book_id = $3 AND ($4::uuid IS NULL OR t.user_id = $4)
+ORDER BY t.date DESC, t.created_at DESC;
+
+-- name: GetTransactionCube :many
+-- Pivots the pending and cleared transactions by two dimensions with grouping
+-- sets: grouping_id is 0 for the cells, 1 for the row totals, 2 for the column
+-- totals and 3 for the grand total, each per asset.
+SELECT
+    COALESCE(row_key, '')::text AS row_key,
+    COALESCE(col_key, '')::text AS col_key,
+    asset,
+    GROUPING(row_key, col_key)::int AS grouping_id,
+    COUNT(*)::bigint AS count,
+    SUM(amount)::bigint AS sum
+FROM (
+    SELECT
+        CASE $1::text
+            WHEN 'category' THEN t.category_id::text
+            WHEN 'account' THEN t.account_id::text
+            WHEN 'payee' THEN t.payee
+            WHEN 'month' THEN to_char(t.date, 'YYYY-MM')
+        END AS row_key,
+        CASE $2::text
+            WHEN 'category' THEN t.category_id::text
+            WHEN 'account' THEN t.account_id::text
+            WHEN 'payee' THEN t.payee
+            WHEN 'month' THEN to_char(t.date, 'YYYY-MM')
+        END AS col_key,
+        a.asset,
+        CASE WHEN c.type = 'expense' THEN -ABS(t.amount) ELSE ABS(t.amount) END AS amount
+    FROM transactions t
+    JOIN accounts a ON t.account_id = a.id
+    JOIN categories c ON t.category_id = c.id
+    WHERE t.deleted_at IS NULL AND t.status IN ('pending', 'cleared') AND t.date >= $3 AND t.date <= $4 AND a.book_id = $5 AND ($6::uuid IS NULL OR t.user_id = $6)
+) cells
+GROUP BY GROUPING SETS ((row_key, col_key, asset), (row_key, asset), (col_key, asset), (asset))
+ORDER BY asset, grouping_id, row_key, col_key;
+
+-- name: GetCashFlow :many
+-- Adds up the size of the pending and cleared income and expenses per asset
+-- and month, of one account when given.
+SELECT
+    to_char(t.date, 'YYYY-MM') AS month,
+    a.asset,
+    COALESCE(SUM(ABS(t.amount)) FILTER (WHERE c.type = 'income'), 0)::bigint AS income,
+    COALESCE(SUM(ABS(t.amount)) FILTER (WHERE c.type = 'expense'), 0)::bigint AS expense
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+JOIN categories c ON t.category_id = c.id
+WHERE t.deleted_at IS NULL AND t.status IN ('pending', 'cleared')
+    AND t.date >= sqlc.arg(start_date) AND t.date <= sqlc.arg(end_date)
+    AND (sqlc.narg(account_id)::uuid IS NULL OR t.account_id = sqlc.narg(account_id))
+    AND a.book_id = sqlc.arg(book_id) AND (sqlc.narg(user_id)::uuid IS NULL OR t.user_id = sqlc.narg(user_id))
+GROUP BY a.asset, month
+ORDER BY a.asset, month;
+
+-- name: GetSpending :many
+-- Adds up the size of the pending and cleared expenses per asset, by
+-- category and by month when asked, the keys not grouped by left empty.
+SELECT
+    category_key,
+    month_key,
+    asset,
+    COUNT(*)::bigint AS count,
+    SUM(amount)::bigint AS total
+FROM (
+    SELECT
+        CASE WHEN sqlc.arg(by_category)::bool THEN t.category_id::text ELSE '' END AS category_key,
+        CASE WHEN sqlc.arg(by_month)::bool THEN to_char(t.date, 'YYYY-MM') ELSE '' END AS month_key,
+        a.asset,
+        ABS(t.amount) AS amount
+    FROM transactions t
+    JOIN accounts a ON t.account_id = a.id
+    JOIN categories c ON t.category_id = c.id
+    WHERE c.type = 'expense' AND t.deleted_at IS NULL AND t.status IN ('pending', 'cleared')
+        AND t.date >= sqlc.arg(start_date) AND t.date <= sqlc.arg(end_date)
+        AND a.book_id = sqlc.arg(book_id) AND (sqlc.narg(user_id)::uuid IS NULL OR t.user_id = sqlc.narg(user_id))
+) spending
+GROUP BY category_key, month_key, asset
+ORDER BY asset, month_key, total DESC, category_key;
 
 -- name: GetTransactionsByAccountAndDateRange :many
-SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
 FROM transactions
-WHERE account_id = $1 AND date >= $2 AND date <= $3
+WHERE account_id = $1 AND deleted_at IS NULL AND date >= $2 AND date <= $3 AND ($4::uuid IS NULL OR user_id = $4)
 ORDER BY date DESC, created_at DESC;
 
 -- name: UpdateTransaction :one
 UPDATE transactions
-SET account_id = $2, category_id = $3, amount = $4, description = $5, date = $6, status = $7, updated_at = NOW()
-WHERE id = $1
-RETURNING id, account_id, category_id, amount, description, date, status, created_at, updated_at;
+SET account_id = $2, category_id = $3, amount = $4, description = $5, date = $6, status = $7, payee = $8, project_id = $9, user_id = (SELECT user_id FROM accounts WHERE id = $2), updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at;
 
 -- name: UpdateTransactionStatus :one
 UPDATE transactions
 SET status = $2, updated_at = NOW()
-WHERE id = $1
-RETURNING id, account_id, category_id, amount, description, date, status, created_at, updated_at;
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at;
 
 -- name: DeleteTransaction :exec
-DELETE FROM transactions WHERE id = $1;
+UPDATE transactions SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetDeletedTransactions :many
+SELECT t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee, t.installment_plan_id, t.installment_number, t.installment_count, t.project_id, t.user_id, t.deleted_at
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE a.book_id = $3 AND t.deleted_at IS NOT NULL AND ($4::uuid IS NULL OR t.user_id = $4)
+ORDER BY t.deleted_at DESC, t.id
+LIMIT $1 OFFSET $2;
+
+-- name: CountDeletedTransactions :one
+SELECT COUNT(*)
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE a.book_id = $1 AND t.deleted_at IS NOT NULL AND ($2::uuid IS NULL OR t.user_id = $2);
+
+-- name: SearchTransactions :many
+-- Matches the description and payee against a tsquery with the expression of
+-- idx_transactions_search, the best ranked first.
+SELECT t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee, t.installment_plan_id, t.installment_number, t.installment_count, t.project_id, t.user_id, t.deleted_at
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE a.book_id = sqlc.arg(book_id) AND t.deleted_at IS NULL AND (sqlc.narg(user_id)::uuid IS NULL OR t.user_id = sqlc.narg(user_id))
+    AND (setweight(to_tsvector('simple', t.description), 'A') || setweight(to_tsvector('simple', t.payee), 'B')) @@ to_tsquery('simple', sqlc.arg(query))
+ORDER BY ts_rank(setweight(to_tsvector('simple', t.description), 'A') || setweight(to_tsvector('simple', t.payee), 'B'), to_tsquery('simple', sqlc.arg(query))) DESC, t.date DESC, t.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountSearchTransactions :one
+SELECT COUNT(*)
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE a.book_id = sqlc.arg(book_id) AND t.deleted_at IS NULL AND (sqlc.narg(user_id)::uuid IS NULL OR t.user_id = sqlc.narg(user_id))
+    AND (setweight(to_tsvector('simple', t.description), 'A') || setweight(to_tsvector('simple', t.payee), 'B')) @@ to_tsquery('simple', sqlc.arg(query));
+
+-- name: RestoreTransaction :one
+UPDATE transactions t
+SET deleted_at = NULL, updated_at = NOW()
+FROM accounts a
+WHERE t.id = $1 AND t.deleted_at IS NOT NULL AND t.account_id = a.id AND a.book_id = $2 AND ($3::uuid IS NULL OR t.user_id = $3)
+RETURNING t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee, t.installment_plan_id, t.installment_number, t.installment_count, t.project_id, t.user_id, t.deleted_at;
+
+-- name: GetTransactionsByInstallmentPlan :many
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
+FROM transactions
+WHERE installment_plan_id = $1 AND deleted_at IS NULL AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY installment_number, date;
+
+-- name: GetTransactionsByProject :many
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
+FROM transactions
+WHERE project_id = $1 AND deleted_at IS NULL AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY date DESC, created_at DESC;
+
+-- =============================================================================
+-- INSTALLMENT PLANS
+-- =============================================================================
+
+-- name: CreateInstallmentPlan :one
+INSERT INTO installment_plans (account_id, description, amount, installment_count, first_date)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, account_id, description, amount, installment_count, first_date, paid_off_on, created_at, updated_at;
+
+-- name: GetInstallmentPlanByID :one
+SELECT id, account_id, description, amount, installment_count, first_date, paid_off_on, created_at, updated_at
+FROM installment_plans
+WHERE id = $1;
+
+-- name: GetAllInstallmentPlans :many
+SELECT p.id, p.account_id, p.description, p.amount, p.installment_count, p.first_date, p.paid_off_on, p.created_at, p.updated_at
+FROM installment_plans p
+JOIN accounts a ON p.account_id = a.id
+WHERE a.book_id = $1
+ORDER BY p.first_date DESC, p.created_at DESC;
+
+-- name: MarkInstallmentPlanPaidOff :one
+UPDATE installment_plans
+SET paid_off_on = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, account_id, description, amount, installment_count, first_date, paid_off_on, created_at, updated_at;
+
+-- name: DeleteInstallmentPlanTransactions :exec
+UPDATE transactions SET deleted_at = NOW() WHERE installment_plan_id = $1 AND deleted_at IS NULL;
+
+-- name: DeleteInstallmentPlan :exec
+DELETE FROM installment_plans WHERE id = $1;
+
+-- =============================================================================
+-- EXPENSE REPORTS
+-- =============================================================================
+
+-- name: CreateExpenseReport :one
+INSERT INTO expense_reports (book_id, user_id, name, start_date, end_date, notes)
+VALUES ($1, (SELECT user_id FROM books WHERE id = $1), $2, $3, $4, $5)
+RETURNING id, name, start_date, end_date, notes, status, submitted_at, reviewed_at, created_at, updated_at, book_id, user_id;
+
+-- name: GetExpenseReportByID :one
+SELECT id, name, start_date, end_date, notes, status, submitted_at, reviewed_at, created_at, updated_at, book_id, user_id
+FROM expense_reports
+WHERE id = $1;
+
+-- name: GetAllExpenseReports :many
+SELECT id, name, start_date, end_date, notes, status, submitted_at, reviewed_at, created_at, updated_at, book_id, user_id
+FROM expense_reports
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY start_date DESC, created_at DESC;
+
+-- name: UpdateExpenseReport :one
+UPDATE expense_reports
+SET name = $3, start_date = $4, end_date = $5, notes = $6, status = $7, submitted_at = $8, reviewed_at = $9, updated_at = NOW()
+WHERE id = $1 AND book_id = $2
+RETURNING id, name, start_date, end_date, notes, status, submitted_at, reviewed_at, created_at, updated_at, book_id, user_id;
+
+-- name: DeleteExpenseReport :exec
+DELETE FROM expense_reports WHERE id = $1 AND book_id = $2;
+
+-- name: AddExpenseReportTransaction :exec
+INSERT INTO expense_report_transactions (expense_report_id, transaction_id)
+VALUES ($1, $2);
+
+-- name: RemoveExpenseReportTransaction :execrows
+DELETE FROM expense_report_transactions
+WHERE expense_report_id = $1 AND transaction_id = $2;
+
+-- name: GetExpenseReportTransactions :many
+SELECT
+    t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee,
+    t.installment_plan_id, t.installment_number, t.installment_count, t.project_id,
+    a.name as account_name, a.type as account_type, a.asset as account_asset,
+    c.name as category_name, c.type as category_type, c.color as category_color
+FROM expense_report_transactions r
+JOIN transactions t ON r.transaction_id = t.id
+JOIN accounts a ON t.account_id = a.id
+JOIN categories c ON t.category_id = c.id
+JOIN expense_reports er ON r.expense_report_id = er.id
+WHERE r.expense_report_id = $1 AND er.book_id = $2 AND t.deleted_at IS NULL
+ORDER BY t.date, t.created_at;
+
+-- =============================================================================
+-- INVOICES
+-- =============================================================================
+
+-- name: CreateInvoice :one
+INSERT INTO invoices (account_id, client, number, description, amount, issue_date, due_date)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, account_id, client, number, description, amount, issue_date, due_date, transaction_id, created_at, updated_at;
+
+-- name: GetInvoiceByID :one
+SELECT id, account_id, client, number, description, amount, issue_date, due_date, transaction_id, created_at, updated_at
+FROM invoices
+WHERE id = $1;
+
+-- name: GetAllInvoices :many
+SELECT i.id, i.account_id, i.client, i.number, i.description, i.amount, i.issue_date, i.due_date, i.transaction_id, i.created_at, i.updated_at
+FROM invoices i
+JOIN accounts a ON i.account_id = a.id
+WHERE a.book_id = $1
+ORDER BY i.due_date DESC, i.created_at DESC;
+
+-- name: UpdateInvoice :one
+UPDATE invoices
+SET account_id = $2, client = $3, number = $4, description = $5, amount = $6, issue_date = $7, due_date = $8, updated_at = NOW()
+WHERE id = $1
+RETURNING id, account_id, client, number, description, amount, issue_date, due_date, transaction_id, created_at, updated_at;
+
+-- name: SetInvoiceTransaction :one
+UPDATE invoices
+SET transaction_id = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, account_id, client, number, description, amount, issue_date, due_date, transaction_id, created_at, updated_at;
+
+-- name: DeleteInvoice :exec
+DELETE FROM invoices WHERE id = $1;
+
+-- =============================================================================
+-- PROJECTS
+-- =============================================================================
+
+-- name: CreateProject :one
+INSERT INTO projects (book_id, user_id, name, client, description)
+VALUES ($1, (SELECT user_id FROM books WHERE id = $1), $2, $3, $4)
+RETURNING id, name, client, description, created_at, updated_at, book_id, user_id;
+
+-- name: GetProjectByID :one
+SELECT id, name, client, description, created_at, updated_at, book_id, user_id
+FROM projects
+WHERE id = $1;
+
+-- name: GetAllProjects :many
+SELECT id, name, client, description, created_at, updated_at, book_id, user_id
+FROM projects
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY name;
+
+-- name: UpdateProject :one
+UPDATE projects
+SET name = $3, client = $4, description = $5, updated_at = NOW()
+WHERE id = $1 AND book_id = $2
+RETURNING id, name, client, description, created_at, updated_at, book_id, user_id;
+
+-- name: DeleteProject :exec
+DELETE FROM projects WHERE id = $1 AND book_id = $2;
+
+-- =============================================================================
+-- BUDGETS
+-- =============================================================================
+
+-- name: CreateBudget :one
+INSERT INTO budgets (book_id, user_id, category_id, asset, amount, start_month, end_month, thresholds)
+VALUES ($1, (SELECT user_id FROM books WHERE id = $1), $2, $3, $4, $5, $6, $7)
+RETURNING id, book_id, user_id, category_id, asset, amount, start_month, end_month, created_at, updated_at, thresholds;
+
+-- name: GetBudgetByID :one
+SELECT id, book_id, user_id, category_id, asset, amount, start_month, end_month, created_at, updated_at, thresholds
+FROM budgets
+WHERE id = $1;
+
+-- name: GetAllBudgets :many
+SELECT b.id, b.book_id, b.user_id, b.category_id, b.asset, b.amount, b.start_month, b.end_month, b.created_at, b.updated_at, b.thresholds
+FROM budgets b
+JOIN categories c ON b.category_id = c.id
+WHERE b.book_id = $1 AND ($2::uuid IS NULL OR b.user_id = $2)
+ORDER BY c.type, c.name, b.start_month;
+
+-- name: UpdateBudget :one
+UPDATE budgets
+SET category_id = $2, asset = $3, amount = $4, start_month = $5, end_month = $6, thresholds = $7, updated_at = NOW()
+WHERE id = $1
+RETURNING id, book_id, user_id, category_id, asset, amount, start_month, end_month, created_at, updated_at, thresholds;
+
+-- name: DeleteBudget :exec
+DELETE FROM budgets WHERE id = $1;
+
+-- =============================================================================
+-- BUDGET ALERTS
+-- =============================================================================
+
+-- name: RecordBudgetAlert :one
+INSERT INTO budget_alerts (book_id, user_id, budget_id, category_id, month, threshold, percent, asset, spent, limit_amount)
+SELECT book_id, user_id, id, category_id, $2, $3, $4, asset, $5, amount
+FROM budgets
+WHERE id = $1
+ON CONFLICT (budget_id, month, threshold) DO NOTHING
+RETURNING id, book_id, user_id, budget_id, category_id, month, threshold, percent, asset, spent, limit_amount, created_at;
+
+-- name: GetAllBudgetAlerts :many
+SELECT id, book_id, user_id, budget_id, category_id, month, threshold, percent, asset, spent, limit_amount, created_at
+FROM budget_alerts
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY created_at DESC, threshold DESC;
+
+-- =============================================================================
+-- BUDGET TEMPLATES
+-- =============================================================================
+
+-- name: CreateBudgetTemplate :one
+INSERT INTO budget_templates (book_id, user_id, name, items)
+VALUES ($1, (SELECT user_id FROM books WHERE id = $1), $2, $3)
+RETURNING id, book_id, user_id, name, items, created_at, updated_at;
+
+-- name: GetBudgetTemplateByID :one
+SELECT id, book_id, user_id, name, items, created_at, updated_at
+FROM budget_templates
+WHERE id = $1;
+
+-- name: GetAllBudgetTemplates :many
+SELECT id, book_id, user_id, name, items, created_at, updated_at
+FROM budget_templates
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY name;
+
+-- name: UpdateBudgetTemplate :one
+UPDATE budget_templates
+SET name = $2, items = $3, updated_at = NOW()
+WHERE id = $1
+RETURNING id, book_id, user_id, name, items, created_at, updated_at;
+
+-- name: DeleteBudgetTemplate :exec
+DELETE FROM budget_templates
+WHERE id = $1;
+
+-- =============================================================================
+-- USERS
+-- =============================================================================
+
+-- name: CreateUser :one
+INSERT INTO users (email, name, password_hash)
+VALUES ($1, $2, $3)
+RETURNING id, email, name, password_hash, created_at, updated_at, tier;
+
+-- name: GetUserByID :one
+SELECT id, email, name, password_hash, created_at, updated_at, tier
+FROM users
+WHERE id = $1;
+
+-- name: GetUserByEmail :one
+SELECT id, email, name, password_hash, created_at, updated_at, tier
+FROM users
+WHERE email = $1;
+
+-- name: GetUserUsage :one
+SELECT
+    tier,
+    (SELECT COUNT(*) FROM books WHERE books.user_id = users.id) AS books,
+    (SELECT COUNT(*) FROM accounts WHERE accounts.user_id = users.id) AS accounts,
+    (SELECT COUNT(*) FROM categories WHERE categories.user_id = users.id) AS categories,
+    (SELECT COUNT(*) FROM budgets WHERE budgets.user_id = users.id) AS budgets,
+    (SELECT COUNT(*) FROM transactions WHERE transactions.user_id = users.id AND transactions.deleted_at IS NULL) AS transactions,
+    (SELECT COUNT(*) FROM transactions WHERE transactions.user_id = users.id AND transactions.created_at >= $2) AS monthly_transactions,
+    (SELECT COALESCE(SUM(calls), 0)::BIGINT FROM api_usage WHERE api_usage.user_id = users.id AND api_usage.month >= $2::DATE) AS monthly_api_calls,
+    (SELECT COALESCE(SUM(attachments.size), 0)::BIGINT FROM attachments JOIN transactions ON attachments.transaction_id = transactions.id WHERE transactions.user_id = users.id) AS attachment_bytes
+FROM users
+WHERE id = $1;
+
+-- name: SetUserTier :execrows
+UPDATE users SET tier = $2, updated_at = NOW()
+WHERE email = $1;
+
+-- name: AddAPICalls :exec
+INSERT INTO api_usage (user_id, month, calls)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, month) DO UPDATE SET calls = api_usage.calls + EXCLUDED.calls;
+
+-- =============================================================================
+-- SESSIONS
+-- =============================================================================
+
+-- name: CreateSession :one
+INSERT INTO sessions (user_id, user_agent, ip_address, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, user_agent, ip_address, created_at, last_seen_at, expires_at, revoked_at;
+
+-- name: GetSessionByID :one
+SELECT id, user_id, user_agent, ip_address, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE id = $1;
+
+-- name: GetActiveSessions :many
+SELECT id, user_id, user_agent, ip_address, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+ORDER BY last_seen_at DESC, created_at DESC;
+
+-- name: TouchSession :exec
+UPDATE sessions
+SET last_seen_at = NOW()
+WHERE id = $1 AND last_seen_at < NOW() - INTERVAL '1 minute';
+
+-- name: RevokeSession :execrows
+UPDATE sessions
+SET revoked_at = NOW()
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > NOW();
+
+-- name: RevokeOtherSessions :execrows
+UPDATE sessions
+SET revoked_at = NOW()
+WHERE user_id = $1 AND id <> $2 AND revoked_at IS NULL AND expires_at > NOW();
+
+-- =============================================================================
+-- LOGIN LOCKOUT
+-- =============================================================================
+
+-- name: GetLoginFailures :one
+SELECT scope, key, failures, last_failed_at, locked_until
+FROM login_failures
+WHERE scope = $1 AND key = $2;
+
+-- name: RecordLoginFailure :one
+INSERT INTO login_failures (scope, key, failures, last_failed_at)
+VALUES ($1, $2, 1, NOW())
+ON CONFLICT (scope, key) DO UPDATE
+SET failures = CASE WHEN login_failures.last_failed_at < $3 THEN 1 ELSE login_failures.failures + 1 END,
+    last_failed_at = NOW()
+RETURNING scope, key, failures, last_failed_at, locked_until;
+
+-- name: LockLogin :exec
+UPDATE login_failures
+SET locked_until = $3
+WHERE scope = $1 AND key = $2;
+
+-- name: ClearLoginFailures :exec
+DELETE FROM login_failures
+WHERE scope = $1 AND key = $2;
+
+-- name: CreateAuthEvent :one
+INSERT INTO auth_events (user_id, type, email, ip_address, user_agent)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, user_id, type, email, ip_address, user_agent, created_at;
+
+-- name: GetAuthEventsByUser :many
+SELECT id, user_id, type, email, ip_address, user_agent, created_at
+FROM auth_events
+WHERE user_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- =============================================================================
+-- BOOKS
+-- =============================================================================
+
+-- name: CreateBook :one
+INSERT INTO books (name, description, asset, user_id)
+VALUES ($1, $2, $3, $4)
+RETURNING id, name, description, created_at, updated_at, asset, user_id;
+
+-- name: GetBookByID :one
+SELECT id, name, description, created_at, updated_at, asset, user_id
+FROM books
+WHERE id = $1;
+
+-- name: GetAllBooks :many
+SELECT id, name, description, created_at, updated_at, asset, user_id
+FROM books
+WHERE $1::uuid IS NULL OR user_id = $1
+ORDER BY name;
+
+-- name: GetFirstBook :one
+SELECT id, name, description, created_at, updated_at, asset, user_id
+FROM books
+WHERE $1::uuid IS NULL OR user_id = $1
+ORDER BY created_at, id
+LIMIT 1;
+
+-- name: UpdateBook :one
+UPDATE books
+SET name = $2, description = $3, asset = $4, updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, description, created_at, updated_at, asset, user_id;
+
+-- name: ClaimBooks :execrows
+WITH claimed_accounts AS (
+    UPDATE accounts SET user_id = $1 WHERE user_id IS NULL
+), claimed_categories AS (
+    UPDATE categories SET user_id = $1 WHERE user_id IS NULL
+), claimed_transactions AS (
+    UPDATE transactions SET user_id = $1 WHERE user_id IS NULL
+), claimed_user_settings AS (
+    UPDATE user_settings SET user_id = $1 WHERE user_id IS NULL
+), claimed_projects AS (
+    UPDATE projects SET user_id = $1 WHERE user_id IS NULL
+), claimed_expense_reports AS (
+    UPDATE expense_reports SET user_id = $1 WHERE user_id IS NULL
+)
+UPDATE books
+SET user_id = $1, updated_at = NOW()
+WHERE user_id IS NULL;
+
+-- name: DeleteBook :exec
+DELETE FROM books WHERE id = $1;
+
+-- =============================================================================
+-- CUSTOM ASSETS
+-- =============================================================================
+
+-- name: CreateCustomAsset :one
+INSERT INTO custom_assets (code, name, symbol, "precision")
+VALUES ($1, $2, $3, $4)
+RETURNING code, name, symbol, "precision", created_at;
+
+-- name: GetAllCustomAssets :many
+SELECT code, name, symbol, "precision", created_at
+FROM custom_assets
+ORDER BY code;
 
 -- =============================================================================
 -- BALANCES
@@ -128,21 +719,68 @@ FROM balances
 WHERE account_id = $1;
 
 -- name: GetAllBalances :many
-SELECT account_id, current_balance, pending_balance, available_balance, last_calculated
-FROM balances
-ORDER BY account_id;
+SELECT b.account_id, b.current_balance, b.pending_balance, b.available_balance, b.last_calculated
+FROM balances b
+JOIN accounts a ON b.account_id = a.id
+WHERE a.book_id = $1
+ORDER BY b.account_id;
+
+-- name: GetBalancesPage :many
+SELECT b.account_id, b.current_balance, b.pending_balance, b.available_balance, b.last_calculated
+FROM balances b
+JOIN accounts a ON b.account_id = a.id
+WHERE a.book_id = $1
+ORDER BY b.account_id
+LIMIT $2 OFFSET $3;
+
+-- name: CountBalances :one
+SELECT COUNT(*)
+FROM balances b
+JOIN accounts a ON b.account_id = a.id
+WHERE a.book_id = $1;
 
 -- name: RefreshAccountBalance :exec
 SELECT update_account_balance($1);
 
 -- name: GetBalanceSummary :one
+WITH classified AS (
+    SELECT
+        b.current_balance,
+        COALESCE(a.classification, CASE WHEN a.type = 'credit' THEN 'liability' ELSE 'asset' END) as classification
+    FROM balances b
+    JOIN accounts a ON b.account_id = a.id
+    WHERE a.book_id = $1
+      AND a.asset NOT IN (SELECT code FROM custom_assets)
+)
 SELECT 
-    COALESCE(SUM(CASE WHEN a.type IN ('checking', 'savings', 'investment', 'cash') THEN b.current_balance ELSE 0 END), 0) as total_assets,
-    COALESCE(SUM(CASE WHEN a.type = 'credit' THEN ABS(b.current_balance) ELSE 0 END), 0) as total_liabilities,
-    COALESCE(SUM(CASE WHEN a.type IN ('checking', 'savings', 'investment', 'cash') THEN b.current_balance ELSE -ABS(b.current_balance) END), 0) as net_worth,
+    COALESCE(SUM(CASE WHEN classification = 'asset' THEN current_balance ELSE 0 END), 0)::BIGINT as total_assets,
+    COALESCE(SUM(CASE WHEN classification = 'liability' THEN current_balance ELSE 0 END), 0)::BIGINT as total_liabilities,
+    COALESCE(SUM(CASE WHEN classification = 'asset' THEN current_balance ELSE -current_balance END), 0)::BIGINT as net_worth,
     NOW() as last_calculated
-FROM balances b
-JOIN accounts a ON b.account_id = a.id;
+FROM classified;
+
+-- name: GetBalanceSnapshotsAt :many
+SELECT DISTINCT ON (s.account_id) s.account_id, s.snapshot_date, s.current_balance, a.asset
+FROM balance_snapshots s
+JOIN accounts a ON s.account_id = a.id
+WHERE s.snapshot_date <= $1 AND a.book_id = $2
+ORDER BY s.account_id, s.snapshot_date DESC;
+
+-- name: GetBalanceSnapshotsBetween :many
+-- The snapshots of each account taken between the dates, starting with the
+-- last one on or before the first date, the balance the account had then
+SELECT s.account_id, s.snapshot_date, s.current_balance, a.asset
+FROM balance_snapshots s
+JOIN accounts a ON s.account_id = a.id
+WHERE a.book_id = sqlc.arg(book_id)
+  AND (sqlc.narg(account_id)::uuid IS NULL OR s.account_id = sqlc.narg(account_id))
+  AND s.snapshot_date >= COALESCE((
+      SELECT MAX(p.snapshot_date)
+      FROM balance_snapshots p
+      WHERE p.account_id = s.account_id AND p.snapshot_date <= sqlc.arg(from_date)
+  ), sqlc.arg(from_date))
+  AND s.snapshot_date <= sqlc.arg(to_date)
+ORDER BY s.account_id, s.snapshot_date;
 
 -- =============================================================================
 -- JOINED QUERIES FOR DETAILED VIEWS
@@ -150,41 +788,304 @@ JOIN accounts a ON b.account_id = a.id;
 
 -- name: GetTransactionWithDetails :one
 SELECT 
-    t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at,
-    a.name as account_name, a.type as account_type, a.asset as account_asset,
-    c.name as category_name, c.type as category_type, c.color as category_color
-FROM transactions t
-JOIN accounts a ON t.account_id = a.id
-JOIN categories c ON t.category_id = c.id
-WHERE t.id = $1;
-
--- name: GetTransactionsWithDetails :many
-SELECT 
-    t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at,
+    t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee,
+    t.installment_plan_id, t.installment_number, t.installment_count, t.project_id, t.user_id,
     a.name as account_name, a.type as account_type, a.asset as account_asset,
     c.name as category_name, c.type as category_type, c.color as category_color
 FROM transactions t
 JOIN accounts a ON t.account_id = a.id
 JOIN categories c ON t.category_id = c.id
-ORDER BY t.date DESC, t.created_at DESC
-LIMIT $1 OFFSET $2;
+WHERE t.id = $1 AND t.deleted_at IS NULL AND a.book_id = $2;
 
 -- name: GetAccountWithBalance :one
 SELECT 
-    a.id, a.name, a.type, a.description, a.asset, a.created_at, a.updated_at,
+    a.id, a.name, a.type, a.description, a.asset, a.created_at, a.updated_at, a.classification, a.institution, a.account_number_last4, a.color, a.icon, a.statement_closing_day, a.payment_due_day, a.book_id, a.user_id,
     COALESCE(b.current_balance, 0) as current_balance,
     COALESCE(b.pending_balance, 0) as pending_balance,
-    COALESCE(b.available_balance, 0) as available_balance
+    COALESCE(b.available_balance, 0) as available_balance,
+    b.last_calculated,
+    activity.transaction_count, activity.last_transaction_date
 FROM accounts a
 LEFT JOIN balances b ON a.id = b.account_id
-WHERE a.id = $1;
+CROSS JOIN LATERAL (
+    SELECT COUNT(*) AS transaction_count, MAX(t.date)::date AS last_transaction_date
+    FROM transactions t
+    WHERE t.account_id = a.id AND t.deleted_at IS NULL
+) activity
+WHERE a.id = $1; 
+-- =============================================================================
+-- REPORT SNAPSHOTS
+-- =============================================================================
 
--- name: GetAccountsWithBalances :many
-SELECT 
-    a.id, a.name, a.type, a.description, a.asset, a.created_at, a.updated_at,
-    COALESCE(b.current_balance, 0) as current_balance,
-    COALESCE(b.pending_balance, 0) as pending_balance,
-    COALESCE(b.available_balance, 0) as available_balance
-FROM accounts a
-LEFT JOIN balances b ON a.id = b.account_id
-ORDER BY a.name; 
\ No newline at end of file
+-- name: GetReportSnapshot :one
+SELECT book_id, month, report, created_at
+FROM report_snapshots
+WHERE book_id = $1 AND month = $2;
+
+-- name: UpsertReportSnapshot :one
+INSERT INTO report_snapshots (book_id, month, report)
+VALUES ($1, $2, $3)
+ON CONFLICT (book_id, month) DO UPDATE SET report = EXCLUDED.report, created_at = NOW()
+RETURNING book_id, month, report, created_at;
+
+-- =============================================================================
+-- RESTORE POINTS
+-- =============================================================================
+
+-- name: CreateRestorePoint :one
+INSERT INTO restore_points (book_id, operation, description, changes)
+VALUES ($1, $2, $3, $4)
+RETURNING id, book_id, operation, description, changes, created_at, rolled_back_at;
+
+-- name: GetRestorePointByID :one
+SELECT id, book_id, operation, description, changes, created_at, rolled_back_at
+FROM restore_points
+WHERE id = $1;
+
+-- name: GetAllRestorePoints :many
+SELECT id, book_id, operation, description, changes, created_at, rolled_back_at
+FROM restore_points
+WHERE book_id = $1
+ORDER BY created_at DESC;
+
+-- name: MarkRestorePointRolledBack :one
+UPDATE restore_points
+SET rolled_back_at = NOW()
+WHERE id = $1
+RETURNING id, book_id, operation, description, changes, created_at, rolled_back_at;
+
+-- =============================================================================
+-- TRANSACTION SCRIPTS
+-- =============================================================================
+
+-- name: CreateTransactionScript :one
+INSERT INTO transaction_scripts (book_id, name, source, enabled)
+VALUES ($1, $2, $3, $4)
+RETURNING id, book_id, name, source, enabled, created_at, updated_at;
+
+-- name: GetTransactionScriptByID :one
+SELECT id, book_id, name, source, enabled, created_at, updated_at
+FROM transaction_scripts
+WHERE id = $1;
+
+-- name: GetAllTransactionScripts :many
+SELECT id, book_id, name, source, enabled, created_at, updated_at
+FROM transaction_scripts
+WHERE book_id = $1
+ORDER BY name;
+
+-- name: UpdateTransactionScript :one
+UPDATE transaction_scripts
+SET name = $2, source = $3, enabled = $4, updated_at = NOW()
+WHERE id = $1
+RETURNING id, book_id, name, source, enabled, created_at, updated_at;
+
+-- name: DeleteTransactionScript :exec
+DELETE FROM transaction_scripts
+WHERE id = $1;
+
+-- =============================================================================
+-- TAGS
+-- =============================================================================
+
+-- name: CreateTag :one
+INSERT INTO tags (book_id, name)
+VALUES ($1, $2)
+RETURNING id, book_id, name, created_at, updated_at;
+
+-- name: GetTagByID :one
+SELECT id, book_id, name, created_at, updated_at
+FROM tags
+WHERE id = $1;
+
+-- name: GetAllTags :many
+SELECT id, book_id, name, created_at, updated_at
+FROM tags
+WHERE book_id = $1
+ORDER BY name;
+
+-- name: UpdateTag :one
+UPDATE tags
+SET name = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, book_id, name, created_at, updated_at;
+
+-- name: DeleteTag :exec
+DELETE FROM tags
+WHERE id = $1;
+
+-- name: CreateTagsByName :exec
+INSERT INTO tags (book_id, name)
+SELECT $1, unnest($2::text[])
+ON CONFLICT (book_id, name) DO NOTHING;
+
+-- name: DeleteTransactionTags :exec
+DELETE FROM transaction_tags
+WHERE transaction_id = $1;
+
+-- name: AddTransactionTags :exec
+INSERT INTO transaction_tags (transaction_id, tag_id)
+SELECT $1, id
+FROM tags
+WHERE book_id = $2 AND name = ANY($3::text[])
+ON CONFLICT DO NOTHING;
+
+-- name: GetTransactionTags :many
+SELECT tt.transaction_id, g.name
+FROM transaction_tags tt
+JOIN tags g ON g.id = tt.tag_id
+WHERE tt.transaction_id = ANY($1::uuid[])
+ORDER BY g.name;
+
+-- =============================================================================
+-- REPORT DEFINITIONS
+-- =============================================================================
+
+-- name: CreateReportDefinition :one
+INSERT INTO report_definitions (book_id, user_id, name, definition)
+VALUES ($1, (SELECT user_id FROM books WHERE id = $1), $2, $3)
+RETURNING id, book_id, user_id, name, definition, created_at, updated_at;
+
+-- name: GetReportDefinitionByID :one
+SELECT id, book_id, user_id, name, definition, created_at, updated_at
+FROM report_definitions
+WHERE id = $1;
+
+-- name: GetAllReportDefinitions :many
+SELECT id, book_id, user_id, name, definition, created_at, updated_at
+FROM report_definitions
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY name;
+
+-- name: UpdateReportDefinition :one
+UPDATE report_definitions
+SET name = $2, definition = $3, updated_at = NOW()
+WHERE id = $1
+RETURNING id, book_id, user_id, name, definition, created_at, updated_at;
+
+-- name: DeleteReportDefinition :exec
+DELETE FROM report_definitions
+WHERE id = $1;
+
+-- =============================================================================
+-- ATTACHMENTS
+-- =============================================================================
+
+-- name: CreateAttachment :one
+INSERT INTO attachments (transaction_id, file_name, content_type, size, storage_key, thumbnail_key)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, transaction_id, file_name, content_type, size, storage_key, thumbnail_key, created_at;
+
+-- name: GetAttachmentByID :one
+SELECT id, transaction_id, file_name, content_type, size, storage_key, thumbnail_key, created_at
+FROM attachments
+WHERE id = $1;
+
+-- name: GetTransactionAttachments :many
+SELECT id, transaction_id, file_name, content_type, size, storage_key, thumbnail_key, created_at
+FROM attachments
+WHERE transaction_id = ANY($1::uuid[])
+ORDER BY created_at, id;
+
+-- name: GetAttachmentsByDateRange :many
+SELECT at.id, at.transaction_id, at.file_name, at.content_type, at.size, at.storage_key, at.thumbnail_key, at.created_at
+FROM attachments at
+JOIN transactions t ON at.transaction_id = t.id
+JOIN accounts a ON t.account_id = a.id
+WHERE t.deleted_at IS NULL AND t.date >= $1 AND t.date <= $2 AND a.book_id = $3 AND ($4::uuid IS NULL OR t.user_id = $4)
+ORDER BY t.date, at.created_at, at.id;
+
+-- name: DeleteAttachment :exec
+DELETE FROM attachments
+WHERE id = $1;
+
+-- =============================================================================
+-- EXPORTS
+-- =============================================================================
+
+-- name: CreateExport :one
+INSERT INTO exports (book_id, user_id, format, file_name, content_type, size, storage_key, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, book_id, user_id, format, file_name, content_type, size, storage_key, created_at, expires_at;
+
+-- name: GetExportByID :one
+SELECT id, book_id, user_id, format, file_name, content_type, size, storage_key, created_at, expires_at
+FROM exports
+WHERE id = $1;
+
+-- name: GetExports :many
+SELECT id, book_id, user_id, format, file_name, content_type, size, storage_key, created_at, expires_at
+FROM exports
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2) AND expires_at > $3
+ORDER BY created_at DESC, id;
+
+-- name: DeleteExpiredExports :many
+DELETE FROM exports
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2) AND expires_at <= $3
+RETURNING id, book_id, user_id, format, file_name, content_type, size, storage_key, created_at, expires_at;
+
+-- name: DeleteExport :exec
+DELETE FROM exports
+WHERE id = $1;
+
+-- =============================================================================
+-- IDEMPOTENCY KEYS
+-- =============================================================================
+
+-- name: ClaimIdempotencyKey :one
+INSERT INTO idempotency_keys (user_id, key, fingerprint)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, key) DO NOTHING
+RETURNING user_id, key, fingerprint, status_code, content_type, body, created_at;
+
+-- name: GetIdempotencyKey :one
+SELECT user_id, key, fingerprint, status_code, content_type, body, created_at
+FROM idempotency_keys
+WHERE user_id IS NOT DISTINCT FROM $1 AND key = $2;
+
+-- name: SaveIdempotentResponse :exec
+UPDATE idempotency_keys
+SET status_code = $3, content_type = $4, body = $5
+WHERE user_id IS NOT DISTINCT FROM $1 AND key = $2;
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE user_id IS NOT DISTINCT FROM $1 AND key = $2;
+
+-- name: DeleteIdempotencyKeysBefore :execrows
+DELETE FROM idempotency_keys
+WHERE created_at < $1;
+
+-- =============================================================================
+-- SETTINGS
+-- =============================================================================
+
+-- name: GetSettings :one
+SELECT id, currency, locale, fiscal_month_start_day, notifications_enabled, notification_email, api_keys, updated_at
+FROM settings
+WHERE id = TRUE;
+
+-- name: UpdateSettings :one
+UPDATE settings
+SET currency = $1, locale = $2, fiscal_month_start_day = $3, notifications_enabled = $4, notification_email = $5, api_keys = $6, updated_at = NOW()
+WHERE id = TRUE
+RETURNING id, currency, locale, fiscal_month_start_day, notifications_enabled, notification_email, api_keys, updated_at;
+
+-- =============================================================================
+-- USER SETTINGS
+-- =============================================================================
+
+-- name: GetAllUserSettings :many
+SELECT key, value, updated_at, user_id
+FROM user_settings
+WHERE user_id IS NOT DISTINCT FROM $1
+ORDER BY key;
+
+-- name: UpsertUserSetting :one
+INSERT INTO user_settings (user_id, key, value)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+RETURNING key, value, updated_at, user_id;
+
+-- name: DeleteUserSetting :exec
+DELETE FROM user_settings WHERE user_id IS NOT DISTINCT FROM $1 AND key = $2;
@@ -13,85 +13,3043 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addAPICalls = `-- name: AddAPICalls :exec
+INSERT INTO api_usage (user_id, month, calls)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, month) DO UPDATE SET calls = api_usage.calls + EXCLUDED.calls
+`
+
+func (q *Queries) AddAPICalls(ctx context.Context, userID uuid.UUID, month pgtype.Date, calls int64) error {
+	_, err := q.db.Exec(ctx, addAPICalls, userID, month, calls)
+	return err
+}
+
+const addExpenseReportTransaction = `-- name: AddExpenseReportTransaction :exec
+INSERT INTO expense_report_transactions (expense_report_id, transaction_id)
+VALUES ($1, $2)
+`
+
+func (q *Queries) AddExpenseReportTransaction(ctx context.Context, expenseReportID uuid.UUID, transactionID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, addExpenseReportTransaction, expenseReportID, transactionID)
+	return err
+}
+
+const addTransactionTags = `-- name: AddTransactionTags :exec
+INSERT INTO transaction_tags (transaction_id, tag_id)
+SELECT $1, id
+FROM tags
+WHERE book_id = $2 AND name = ANY($3::text[])
+ON CONFLICT DO NOTHING
+`
+
+func (q *Queries) AddTransactionTags(ctx context.Context, transactionID uuid.UUID, bookID uuid.UUID, names []string) error {
+	_, err := q.db.Exec(ctx, addTransactionTags, transactionID, bookID, names)
+	return err
+}
+
+const claimBooks = `-- name: ClaimBooks :execrows
+WITH claimed_accounts AS (
+    UPDATE accounts SET user_id = $1 WHERE user_id IS NULL
+), claimed_categories AS (
+    UPDATE categories SET user_id = $1 WHERE user_id IS NULL
+), claimed_transactions AS (
+    UPDATE transactions SET user_id = $1 WHERE user_id IS NULL
+), claimed_user_settings AS (
+    UPDATE user_settings SET user_id = $1 WHERE user_id IS NULL
+), claimed_projects AS (
+    UPDATE projects SET user_id = $1 WHERE user_id IS NULL
+), claimed_expense_reports AS (
+    UPDATE expense_reports SET user_id = $1 WHERE user_id IS NULL
+)
+UPDATE books
+SET user_id = $1, updated_at = NOW()
+WHERE user_id IS NULL
+`
+
+func (q *Queries) ClaimBooks(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, claimBooks, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimIdempotencyKey = `-- name: ClaimIdempotencyKey :one
+INSERT INTO idempotency_keys (user_id, key, fingerprint)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, key) DO NOTHING
+RETURNING user_id, key, fingerprint, status_code, content_type, body, created_at
+`
+
+func (q *Queries) ClaimIdempotencyKey(ctx context.Context, userID *uuid.UUID, key string, fingerprint string) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, claimIdempotencyKey, userID, key, fingerprint)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.UserID,
+		&i.Key,
+		&i.Fingerprint,
+		&i.StatusCode,
+		&i.ContentType,
+		&i.Body,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const clearLoginFailures = `-- name: ClearLoginFailures :exec
+DELETE FROM login_failures
+WHERE scope = $1 AND key = $2
+`
+
+func (q *Queries) ClearLoginFailures(ctx context.Context, scope string, key string) error {
+	_, err := q.db.Exec(ctx, clearLoginFailures, scope, key)
+	return err
+}
+
+const countAccountTransactions = `-- name: CountAccountTransactions :one
+SELECT COUNT(*) FROM transactions WHERE account_id = $1
+`
+
+func (q *Queries) CountAccountTransactions(ctx context.Context, accountID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countAccountTransactions, accountID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countAccounts = `-- name: CountAccounts :one
+SELECT COUNT(*) FROM accounts WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+`
+
+func (q *Queries) CountAccounts(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countAccounts, bookID, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countBalances = `-- name: CountBalances :one
+SELECT COUNT(*)
+FROM balances b
+JOIN accounts a ON b.account_id = a.id
+WHERE a.book_id = $1
+`
+
+func (q *Queries) CountBalances(ctx context.Context, bookID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countBalances, bookID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countCategories = `-- name: CountCategories :one
+SELECT COUNT(*) FROM categories WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+`
+
+func (q *Queries) CountCategories(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countCategories, bookID, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countDeletedTransactions = `-- name: CountDeletedTransactions :one
+SELECT COUNT(*)
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE a.book_id = $1 AND t.deleted_at IS NOT NULL AND ($2::uuid IS NULL OR t.user_id = $2)
+`
+
+func (q *Queries) CountDeletedTransactions(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countDeletedTransactions, bookID, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSearchTransactions = `-- name: CountSearchTransactions :one
+SELECT COUNT(*)
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE a.book_id = $1 AND t.deleted_at IS NULL AND ($2::uuid IS NULL OR t.user_id = $2)
+    AND (setweight(to_tsvector('simple', t.description), 'A') || setweight(to_tsvector('simple', t.payee), 'B')) @@ to_tsquery('simple', $3)
+`
+
+func (q *Queries) CountSearchTransactions(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID, query string) (int64, error) {
+	row := q.db.QueryRow(ctx, countSearchTransactions, bookID, userID, query)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTransactions = `-- name: CountTransactions :one
+SELECT COUNT(*)
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE a.book_id = $1 AND t.deleted_at IS NULL AND ($2::uuid IS NULL OR t.project_id = $2) AND ($3::uuid IS NULL OR t.user_id = $3)
+    AND ($4::text IS NULL OR EXISTS (SELECT 1 FROM transaction_tags tt JOIN tags g ON g.id = tt.tag_id WHERE tt.transaction_id = t.id AND g.name = lower($4)))
+`
+
+func (q *Queries) CountTransactions(ctx context.Context, bookID uuid.UUID, projectID *uuid.UUID, userID *uuid.UUID, tag *string) (int64, error) {
+	row := q.db.QueryRow(ctx, countTransactions,
+		bookID,
+		projectID,
+		userID,
+		tag,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createAccount = `-- name: CreateAccount :one
 
-INSERT INTO accounts (name, type, description, asset)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, type, description, asset, created_at, updated_at
+INSERT INTO accounts (name, type, description, asset, classification, institution, account_number_last4, color, icon, statement_closing_day, payment_due_day, book_id, user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, (SELECT user_id FROM books WHERE id = $12))
+RETURNING id, name, type, description, asset, created_at, updated_at, classification, institution, account_number_last4, color, icon, statement_closing_day, payment_due_day, book_id, user_id
+`
+
+// =============================================================================
+// ACCOUNTS
+// =============================================================================
+func (q *Queries) CreateAccount(ctx context.Context, name string, type_ string, description string, asset string, classification *string, institution string, accountNumberLast4 string, color string, icon string, statementClosingDay int32, paymentDueDay int32, bookID uuid.UUID) (Account, error) {
+	row := q.db.QueryRow(ctx, createAccount,
+		name,
+		type_,
+		description,
+		asset,
+		classification,
+		institution,
+		accountNumberLast4,
+		color,
+		icon,
+		statementClosingDay,
+		paymentDueDay,
+		bookID,
+	)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Type,
+		&i.Description,
+		&i.Asset,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Classification,
+		&i.Institution,
+		&i.AccountNumberLast4,
+		&i.Color,
+		&i.Icon,
+		&i.StatementClosingDay,
+		&i.PaymentDueDay,
+		&i.BookID,
+		&i.UserID,
+	)
+	return i, err
+}
+
+const createAttachment = `-- name: CreateAttachment :one
+
+INSERT INTO attachments (transaction_id, file_name, content_type, size, storage_key, thumbnail_key)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, transaction_id, file_name, content_type, size, storage_key, thumbnail_key, created_at
+`
+
+// =============================================================================
+// ATTACHMENTS
+// =============================================================================
+func (q *Queries) CreateAttachment(ctx context.Context, transactionID uuid.UUID, fileName string, contentType string, size int64, storageKey string, thumbnailKey *string) (Attachment, error) {
+	row := q.db.QueryRow(ctx, createAttachment,
+		transactionID,
+		fileName,
+		contentType,
+		size,
+		storageKey,
+		thumbnailKey,
+	)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.TransactionID,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.StorageKey,
+		&i.ThumbnailKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createAuthEvent = `-- name: CreateAuthEvent :one
+INSERT INTO auth_events (user_id, type, email, ip_address, user_agent)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, user_id, type, email, ip_address, user_agent, created_at
+`
+
+func (q *Queries) CreateAuthEvent(ctx context.Context, userID *uuid.UUID, type_ string, email string, ipAddress string, userAgent string) (AuthEvent, error) {
+	row := q.db.QueryRow(ctx, createAuthEvent,
+		userID,
+		type_,
+		email,
+		ipAddress,
+		userAgent,
+	)
+	var i AuthEvent
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Type,
+		&i.Email,
+		&i.IpAddress,
+		&i.UserAgent,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createBook = `-- name: CreateBook :one
+
+INSERT INTO books (name, description, asset, user_id)
+VALUES ($1, $2, $3, $4)
+RETURNING id, name, description, created_at, updated_at, asset, user_id
+`
+
+// =============================================================================
+// BOOKS
+// =============================================================================
+func (q *Queries) CreateBook(ctx context.Context, name string, description string, asset string, userID *uuid.UUID) (Book, error) {
+	row := q.db.QueryRow(ctx, createBook,
+		name,
+		description,
+		asset,
+		userID,
+	)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Asset,
+		&i.UserID,
+	)
+	return i, err
+}
+
+const createBudget = `-- name: CreateBudget :one
+
+INSERT INTO budgets (book_id, user_id, category_id, asset, amount, start_month, end_month, thresholds)
+VALUES ($1, (SELECT user_id FROM books WHERE id = $1), $2, $3, $4, $5, $6, $7)
+RETURNING id, book_id, user_id, category_id, asset, amount, start_month, end_month, created_at, updated_at, thresholds
+`
+
+// =============================================================================
+// BUDGETS
+// =============================================================================
+func (q *Queries) CreateBudget(ctx context.Context, bookID uuid.UUID, categoryID uuid.UUID, asset string, amount int64, startMonth pgtype.Date, endMonth pgtype.Date, thresholds []int32) (Budget, error) {
+	row := q.db.QueryRow(ctx, createBudget,
+		bookID,
+		categoryID,
+		asset,
+		amount,
+		startMonth,
+		endMonth,
+		thresholds,
+	)
+	var i Budget
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.UserID,
+		&i.CategoryID,
+		&i.Asset,
+		&i.Amount,
+		&i.StartMonth,
+		&i.EndMonth,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Thresholds,
+	)
+	return i, err
+}
+
+const createBudgetTemplate = `-- name: CreateBudgetTemplate :one
+
+INSERT INTO budget_templates (book_id, user_id, name, items)
+VALUES ($1, (SELECT user_id FROM books WHERE id = $1), $2, $3)
+RETURNING id, book_id, user_id, name, items, created_at, updated_at
+`
+
+// =============================================================================
+// BUDGET TEMPLATES
+// =============================================================================
+func (q *Queries) CreateBudgetTemplate(ctx context.Context, bookID uuid.UUID, name string, items []byte) (BudgetTemplate, error) {
+	row := q.db.QueryRow(ctx, createBudgetTemplate, bookID, name, items)
+	var i BudgetTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.UserID,
+		&i.Name,
+		&i.Items,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createCategory = `-- name: CreateCategory :one
+
+INSERT INTO categories (name, type, description, color, book_id, user_id, parent_id)
+VALUES ($1, $2, $3, $4, $5, (SELECT user_id FROM books WHERE id = $5), $6)
+RETURNING id, name, type, description, color, created_at, updated_at, book_id, user_id, parent_id
+`
+
+// =============================================================================
+// CATEGORIES
+// =============================================================================
+func (q *Queries) CreateCategory(ctx context.Context, name string, type_ string, description string, color string, bookID uuid.UUID, parentID *uuid.UUID) (Category, error) {
+	row := q.db.QueryRow(ctx, createCategory,
+		name,
+		type_,
+		description,
+		color,
+		bookID,
+		parentID,
+	)
+	var i Category
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Type,
+		&i.Description,
+		&i.Color,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BookID,
+		&i.UserID,
+		&i.ParentID,
+	)
+	return i, err
+}
+
+const createCustomAsset = `-- name: CreateCustomAsset :one
+
+INSERT INTO custom_assets (code, name, symbol, "precision")
+VALUES ($1, $2, $3, $4)
+RETURNING code, name, symbol, "precision", created_at
+`
+
+// =============================================================================
+// CUSTOM ASSETS
+// =============================================================================
+func (q *Queries) CreateCustomAsset(ctx context.Context, code string, name string, symbol string, precision int32) (CustomAsset, error) {
+	row := q.db.QueryRow(ctx, createCustomAsset,
+		code,
+		name,
+		symbol,
+		precision,
+	)
+	var i CustomAsset
+	err := row.Scan(
+		&i.Code,
+		&i.Name,
+		&i.Symbol,
+		&i.Precision,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createExpenseReport = `-- name: CreateExpenseReport :one
+
+INSERT INTO expense_reports (book_id, user_id, name, start_date, end_date, notes)
+VALUES ($1, (SELECT user_id FROM books WHERE id = $1), $2, $3, $4, $5)
+RETURNING id, name, start_date, end_date, notes, status, submitted_at, reviewed_at, created_at, updated_at, book_id, user_id
+`
+
+// =============================================================================
+// EXPENSE REPORTS
+// =============================================================================
+func (q *Queries) CreateExpenseReport(ctx context.Context, bookID uuid.UUID, name string, startDate pgtype.Date, endDate pgtype.Date, notes string) (ExpenseReport, error) {
+	row := q.db.QueryRow(ctx, createExpenseReport,
+		bookID,
+		name,
+		startDate,
+		endDate,
+		notes,
+	)
+	var i ExpenseReport
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Notes,
+		&i.Status,
+		&i.SubmittedAt,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BookID,
+		&i.UserID,
+	)
+	return i, err
+}
+
+const createExport = `-- name: CreateExport :one
+
+INSERT INTO exports (book_id, user_id, format, file_name, content_type, size, storage_key, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, book_id, user_id, format, file_name, content_type, size, storage_key, created_at, expires_at
+`
+
+// =============================================================================
+// EXPORTS
+// =============================================================================
+func (q *Queries) CreateExport(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID, format string, fileName string, contentType string, size int64, storageKey string, expiresAt time.Time) (Export, error) {
+	row := q.db.QueryRow(ctx, createExport,
+		bookID,
+		userID,
+		format,
+		fileName,
+		contentType,
+		size,
+		storageKey,
+		expiresAt,
+	)
+	var i Export
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.UserID,
+		&i.Format,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.StorageKey,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const createInstallmentPlan = `-- name: CreateInstallmentPlan :one
+
+INSERT INTO installment_plans (account_id, description, amount, installment_count, first_date)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, account_id, description, amount, installment_count, first_date, paid_off_on, created_at, updated_at
+`
+
+// =============================================================================
+// INSTALLMENT PLANS
+// =============================================================================
+func (q *Queries) CreateInstallmentPlan(ctx context.Context, accountID uuid.UUID, description string, amount int64, installmentCount int32, firstDate pgtype.Date) (InstallmentPlan, error) {
+	row := q.db.QueryRow(ctx, createInstallmentPlan,
+		accountID,
+		description,
+		amount,
+		installmentCount,
+		firstDate,
+	)
+	var i InstallmentPlan
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Description,
+		&i.Amount,
+		&i.InstallmentCount,
+		&i.FirstDate,
+		&i.PaidOffOn,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createInvoice = `-- name: CreateInvoice :one
+
+INSERT INTO invoices (account_id, client, number, description, amount, issue_date, due_date)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, account_id, client, number, description, amount, issue_date, due_date, transaction_id, created_at, updated_at
+`
+
+// =============================================================================
+// INVOICES
+// =============================================================================
+func (q *Queries) CreateInvoice(ctx context.Context, accountID uuid.UUID, client string, number string, description string, amount int64, issueDate pgtype.Date, dueDate pgtype.Date) (Invoice, error) {
+	row := q.db.QueryRow(ctx, createInvoice,
+		accountID,
+		client,
+		number,
+		description,
+		amount,
+		issueDate,
+		dueDate,
+	)
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Client,
+		&i.Number,
+		&i.Description,
+		&i.Amount,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.TransactionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createProject = `-- name: CreateProject :one
+
+INSERT INTO projects (book_id, user_id, name, client, description)
+VALUES ($1, (SELECT user_id FROM books WHERE id = $1), $2, $3, $4)
+RETURNING id, name, client, description, created_at, updated_at, book_id, user_id
+`
+
+// =============================================================================
+// PROJECTS
+// =============================================================================
+func (q *Queries) CreateProject(ctx context.Context, bookID uuid.UUID, name string, client string, description string) (Project, error) {
+	row := q.db.QueryRow(ctx, createProject,
+		bookID,
+		name,
+		client,
+		description,
+	)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Client,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BookID,
+		&i.UserID,
+	)
+	return i, err
+}
+
+const createReportDefinition = `-- name: CreateReportDefinition :one
+
+INSERT INTO report_definitions (book_id, user_id, name, definition)
+VALUES ($1, (SELECT user_id FROM books WHERE id = $1), $2, $3)
+RETURNING id, book_id, user_id, name, definition, created_at, updated_at
+`
+
+// =============================================================================
+// REPORT DEFINITIONS
+// =============================================================================
+func (q *Queries) CreateReportDefinition(ctx context.Context, bookID uuid.UUID, name string, definition []byte) (ReportDefinition, error) {
+	row := q.db.QueryRow(ctx, createReportDefinition, bookID, name, definition)
+	var i ReportDefinition
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.UserID,
+		&i.Name,
+		&i.Definition,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createRestorePoint = `-- name: CreateRestorePoint :one
+
+INSERT INTO restore_points (book_id, operation, description, changes)
+VALUES ($1, $2, $3, $4)
+RETURNING id, book_id, operation, description, changes, created_at, rolled_back_at
+`
+
+// =============================================================================
+// RESTORE POINTS
+// =============================================================================
+func (q *Queries) CreateRestorePoint(ctx context.Context, bookID uuid.UUID, operation string, description string, changes []byte) (RestorePoint, error) {
+	row := q.db.QueryRow(ctx, createRestorePoint,
+		bookID,
+		operation,
+		description,
+		changes,
+	)
+	var i RestorePoint
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Operation,
+		&i.Description,
+		&i.Changes,
+		&i.CreatedAt,
+		&i.RolledBackAt,
+	)
+	return i, err
+}
+
+const createSession = `-- name: CreateSession :one
+
+INSERT INTO sessions (user_id, user_agent, ip_address, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, user_agent, ip_address, created_at, last_seen_at, expires_at, revoked_at
+`
+
+// =============================================================================
+// SESSIONS
+// =============================================================================
+func (q *Queries) CreateSession(ctx context.Context, userID uuid.UUID, userAgent string, ipAddress string, expiresAt time.Time) (Session, error) {
+	row := q.db.QueryRow(ctx, createSession,
+		userID,
+		userAgent,
+		ipAddress,
+		expiresAt,
+	)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.CreatedAt,
+		&i.LastSeenAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const createTag = `-- name: CreateTag :one
+
+INSERT INTO tags (book_id, name)
+VALUES ($1, $2)
+RETURNING id, book_id, name, created_at, updated_at
+`
+
+// =============================================================================
+// TAGS
+// =============================================================================
+func (q *Queries) CreateTag(ctx context.Context, bookID uuid.UUID, name string) (Tag, error) {
+	row := q.db.QueryRow(ctx, createTag, bookID, name)
+	var i Tag
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createTagsByName = `-- name: CreateTagsByName :exec
+INSERT INTO tags (book_id, name)
+SELECT $1, unnest($2::text[])
+ON CONFLICT (book_id, name) DO NOTHING
+`
+
+func (q *Queries) CreateTagsByName(ctx context.Context, bookID uuid.UUID, names []string) error {
+	_, err := q.db.Exec(ctx, createTagsByName, bookID, names)
+	return err
+}
+
+const createTransaction = `-- name: CreateTransaction :one
+
+INSERT INTO transactions (account_id, category_id, amount, description, date, status, payee, installment_plan_id, installment_number, installment_count, project_id, user_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, (SELECT user_id FROM accounts WHERE id = $1))
+RETURNING id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
+`
+
+// =============================================================================
+// TRANSACTIONS
+// =============================================================================
+func (q *Queries) CreateTransaction(ctx context.Context, accountID uuid.UUID, categoryID uuid.UUID, amount int64, description string, date pgtype.Date, status string, payee string, installmentPlanID *uuid.UUID, installmentNumber int32, installmentCount int32, projectID *uuid.UUID) (Transaction, error) {
+	row := q.db.QueryRow(ctx, createTransaction,
+		accountID,
+		categoryID,
+		amount,
+		description,
+		date,
+		status,
+		payee,
+		installmentPlanID,
+		installmentNumber,
+		installmentCount,
+		projectID,
+	)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.CategoryID,
+		&i.Amount,
+		&i.Description,
+		&i.Date,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Payee,
+		&i.InstallmentPlanID,
+		&i.InstallmentNumber,
+		&i.InstallmentCount,
+		&i.ProjectID,
+		&i.UserID,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const createTransactionScript = `-- name: CreateTransactionScript :one
+
+INSERT INTO transaction_scripts (book_id, name, source, enabled)
+VALUES ($1, $2, $3, $4)
+RETURNING id, book_id, name, source, enabled, created_at, updated_at
+`
+
+// =============================================================================
+// TRANSACTION SCRIPTS
+// =============================================================================
+func (q *Queries) CreateTransactionScript(ctx context.Context, bookID uuid.UUID, name string, source string, enabled bool) (TransactionScript, error) {
+	row := q.db.QueryRow(ctx, createTransactionScript,
+		bookID,
+		name,
+		source,
+		enabled,
+	)
+	var i TransactionScript
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Name,
+		&i.Source,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createUser = `-- name: CreateUser :one
+
+INSERT INTO users (email, name, password_hash)
+VALUES ($1, $2, $3)
+RETURNING id, email, name, password_hash, created_at, updated_at, tier
+`
+
+// =============================================================================
+// USERS
+// =============================================================================
+func (q *Queries) CreateUser(ctx context.Context, email string, name string, passwordHash string) (User, error) {
+	row := q.db.QueryRow(ctx, createUser, email, name, passwordHash)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Tier,
+	)
+	return i, err
+}
+
+const deleteAccount = `-- name: DeleteAccount :exec
+DELETE FROM accounts WHERE id = $1
+`
+
+func (q *Queries) DeleteAccount(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAccount, id)
+	return err
+}
+
+const deleteAttachment = `-- name: DeleteAttachment :exec
+DELETE FROM attachments
+WHERE id = $1
+`
+
+func (q *Queries) DeleteAttachment(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteAttachment, id)
+	return err
+}
+
+const deleteBook = `-- name: DeleteBook :exec
+DELETE FROM books WHERE id = $1
+`
+
+func (q *Queries) DeleteBook(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteBook, id)
+	return err
+}
+
+const deleteBudget = `-- name: DeleteBudget :exec
+DELETE FROM budgets WHERE id = $1
+`
+
+func (q *Queries) DeleteBudget(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteBudget, id)
+	return err
+}
+
+const deleteBudgetTemplate = `-- name: DeleteBudgetTemplate :exec
+DELETE FROM budget_templates
+WHERE id = $1
+`
+
+func (q *Queries) DeleteBudgetTemplate(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteBudgetTemplate, id)
+	return err
+}
+
+const deleteCategory = `-- name: DeleteCategory :exec
+DELETE FROM categories WHERE id = $1
+`
+
+func (q *Queries) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCategory, id)
+	return err
+}
+
+const deleteExpenseReport = `-- name: DeleteExpenseReport :exec
+DELETE FROM expense_reports WHERE id = $1 AND book_id = $2
+`
+
+func (q *Queries) DeleteExpenseReport(ctx context.Context, id uuid.UUID, bookID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteExpenseReport, id, bookID)
+	return err
+}
+
+const deleteExpiredExports = `-- name: DeleteExpiredExports :many
+DELETE FROM exports
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2) AND expires_at <= $3
+RETURNING id, book_id, user_id, format, file_name, content_type, size, storage_key, created_at, expires_at
+`
+
+func (q *Queries) DeleteExpiredExports(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID, now time.Time) ([]Export, error) {
+	rows, err := q.db.Query(ctx, deleteExpiredExports, bookID, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Export
+	for rows.Next() {
+		var i Export
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.UserID,
+			&i.Format,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.StorageKey,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteExport = `-- name: DeleteExport :exec
+DELETE FROM exports
+WHERE id = $1
+`
+
+func (q *Queries) DeleteExport(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteExport, id)
+	return err
+}
+
+const deleteIdempotencyKey = `-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE user_id IS NOT DISTINCT FROM $1 AND key = $2
+`
+
+func (q *Queries) DeleteIdempotencyKey(ctx context.Context, userID *uuid.UUID, key string) error {
+	_, err := q.db.Exec(ctx, deleteIdempotencyKey, userID, key)
+	return err
+}
+
+const deleteIdempotencyKeysBefore = `-- name: DeleteIdempotencyKeysBefore :execrows
+DELETE FROM idempotency_keys
+WHERE created_at < $1
+`
+
+func (q *Queries) DeleteIdempotencyKeysBefore(ctx context.Context, createdAt time.Time) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteIdempotencyKeysBefore, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteInstallmentPlan = `-- name: DeleteInstallmentPlan :exec
+DELETE FROM installment_plans WHERE id = $1
+`
+
+func (q *Queries) DeleteInstallmentPlan(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteInstallmentPlan, id)
+	return err
+}
+
+const deleteInstallmentPlanTransactions = `-- name: DeleteInstallmentPlanTransactions :exec
+UPDATE transactions SET deleted_at = NOW() WHERE installment_plan_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) DeleteInstallmentPlanTransactions(ctx context.Context, installmentPlanID *uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteInstallmentPlanTransactions, installmentPlanID)
+	return err
+}
+
+const deleteInvoice = `-- name: DeleteInvoice :exec
+DELETE FROM invoices WHERE id = $1
+`
+
+func (q *Queries) DeleteInvoice(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteInvoice, id)
+	return err
+}
+
+const deleteProject = `-- name: DeleteProject :exec
+DELETE FROM projects WHERE id = $1 AND book_id = $2
+`
+
+func (q *Queries) DeleteProject(ctx context.Context, id uuid.UUID, bookID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteProject, id, bookID)
+	return err
+}
+
+const deleteReportDefinition = `-- name: DeleteReportDefinition :exec
+DELETE FROM report_definitions
+WHERE id = $1
+`
+
+func (q *Queries) DeleteReportDefinition(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteReportDefinition, id)
+	return err
+}
+
+const deleteTag = `-- name: DeleteTag :exec
+DELETE FROM tags
+WHERE id = $1
+`
+
+func (q *Queries) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTag, id)
+	return err
+}
+
+const deleteTransaction = `-- name: DeleteTransaction :exec
+UPDATE transactions SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) DeleteTransaction(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTransaction, id)
+	return err
+}
+
+const deleteTransactionScript = `-- name: DeleteTransactionScript :exec
+DELETE FROM transaction_scripts
+WHERE id = $1
+`
+
+func (q *Queries) DeleteTransactionScript(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTransactionScript, id)
+	return err
+}
+
+const deleteTransactionTags = `-- name: DeleteTransactionTags :exec
+DELETE FROM transaction_tags
+WHERE transaction_id = $1
+`
+
+func (q *Queries) DeleteTransactionTags(ctx context.Context, transactionID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTransactionTags, transactionID)
+	return err
+}
+
+const deleteUserSetting = `-- name: DeleteUserSetting :exec
+DELETE FROM user_settings WHERE user_id IS NOT DISTINCT FROM $1 AND key = $2
+`
+
+func (q *Queries) DeleteUserSetting(ctx context.Context, userID *uuid.UUID, key string) error {
+	_, err := q.db.Exec(ctx, deleteUserSetting, userID, key)
+	return err
+}
+
+const getAccountBalanceBefore = `-- name: GetAccountBalanceBefore :one
+SELECT COALESCE(SUM(amount), 0)::bigint AS balance
+FROM transactions
+WHERE account_id = $1 AND status = 'cleared' AND date < $2 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetAccountBalanceBefore(ctx context.Context, accountID uuid.UUID, date pgtype.Date) (int64, error) {
+	row := q.db.QueryRow(ctx, getAccountBalanceBefore, accountID, date)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
+
+const getAccountByID = `-- name: GetAccountByID :one
+SELECT
+    a.id, a.name, a.type, a.description, a.asset, a.created_at, a.updated_at, a.classification, a.institution, a.account_number_last4, a.color, a.icon, a.statement_closing_day, a.payment_due_day, a.book_id, a.user_id,
+    activity.transaction_count, activity.last_transaction_date
+FROM accounts a
+CROSS JOIN LATERAL (
+    SELECT COUNT(*) AS transaction_count, MAX(t.date)::date AS last_transaction_date
+    FROM transactions t
+    WHERE t.account_id = a.id AND t.deleted_at IS NULL
+) activity
+WHERE a.id = $1
+`
+
+type GetAccountByIDRow struct {
+	ID                  uuid.UUID   `json:"id"`
+	Name                string      `json:"name"`
+	Type                string      `json:"type"`
+	Description         string      `json:"description"`
+	Asset               string      `json:"asset"`
+	CreatedAt           time.Time   `json:"createdAt"`
+	UpdatedAt           time.Time   `json:"updatedAt"`
+	Classification      *string     `json:"classification"`
+	Institution         string      `json:"institution"`
+	AccountNumberLast4  string      `json:"accountNumberLast4"`
+	Color               string      `json:"color"`
+	Icon                string      `json:"icon"`
+	StatementClosingDay int32       `json:"statementClosingDay"`
+	PaymentDueDay       int32       `json:"paymentDueDay"`
+	BookID              uuid.UUID   `json:"bookId"`
+	UserID              *uuid.UUID  `json:"userId"`
+	TransactionCount    int64       `json:"transactionCount"`
+	LastTransactionDate pgtype.Date `json:"lastTransactionDate"`
+}
+
+func (q *Queries) GetAccountByID(ctx context.Context, id uuid.UUID) (GetAccountByIDRow, error) {
+	row := q.db.QueryRow(ctx, getAccountByID, id)
+	var i GetAccountByIDRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Type,
+		&i.Description,
+		&i.Asset,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Classification,
+		&i.Institution,
+		&i.AccountNumberLast4,
+		&i.Color,
+		&i.Icon,
+		&i.StatementClosingDay,
+		&i.PaymentDueDay,
+		&i.BookID,
+		&i.UserID,
+		&i.TransactionCount,
+		&i.LastTransactionDate,
+	)
+	return i, err
+}
+
+const getAccountNumbers = `-- name: GetAccountNumbers :many
+SELECT id, account_number_last4
+FROM accounts
+WHERE account_number_last4 <> ''
+ORDER BY id
+`
+
+type GetAccountNumbersRow struct {
+	ID                 uuid.UUID `json:"id"`
+	AccountNumberLast4 string    `json:"account_number_last4"`
+}
+
+func (q *Queries) GetAccountNumbers(ctx context.Context) ([]GetAccountNumbersRow, error) {
+	rows, err := q.db.Query(ctx, getAccountNumbers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAccountNumbersRow
+	for rows.Next() {
+		var i GetAccountNumbersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountNumberLast4,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccountPeriodSummary = `-- name: GetAccountPeriodSummary :one
+SELECT
+    COALESCE(SUM(amount) FILTER (WHERE date < $1), 0)::bigint AS opening_balance,
+    COALESCE(SUM(amount) FILTER (WHERE date >= $1 AND amount > 0), 0)::bigint AS total_in,
+    COALESCE(-SUM(amount) FILTER (WHERE date >= $1 AND amount < 0), 0)::bigint AS total_out
+FROM transactions
+WHERE account_id = $2 AND status = 'cleared' AND date <= $3 AND deleted_at IS NULL
+`
+
+type GetAccountPeriodSummaryRow struct {
+	OpeningBalance int64 `json:"openingBalance"`
+	TotalIn        int64 `json:"totalIn"`
+	TotalOut       int64 `json:"totalOut"`
+}
+
+func (q *Queries) GetAccountPeriodSummary(ctx context.Context, fromDate pgtype.Date, accountID uuid.UUID, toDate pgtype.Date) (GetAccountPeriodSummaryRow, error) {
+	row := q.db.QueryRow(ctx, getAccountPeriodSummary, fromDate, accountID, toDate)
+	var i GetAccountPeriodSummaryRow
+	err := row.Scan(&i.OpeningBalance, &i.TotalIn, &i.TotalOut)
+	return i, err
+}
+
+const getAccountStatement = `-- name: GetAccountStatement :many
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, project_id, running_balance
+FROM (
+    SELECT
+        t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee, t.project_id,
+        SUM(t.amount) OVER (ORDER BY t.date, t.created_at, t.id)::bigint AS running_balance
+    FROM transactions t
+    WHERE t.account_id = $1 AND t.status = 'cleared' AND t.date <= $2 AND t.deleted_at IS NULL
+) statement
+WHERE statement.date >= $3
+ORDER BY statement.date, statement.created_at, statement.id
+`
+
+type GetAccountStatementRow struct {
+	ID             uuid.UUID   `json:"id"`
+	AccountID      uuid.UUID   `json:"accountId"`
+	CategoryID     uuid.UUID   `json:"categoryId"`
+	Amount         int64       `json:"amount"`
+	Description    string      `json:"description"`
+	Date           pgtype.Date `json:"date"`
+	Status         string      `json:"status"`
+	CreatedAt      time.Time   `json:"createdAt"`
+	UpdatedAt      time.Time   `json:"updatedAt"`
+	Payee          string      `json:"payee"`
+	ProjectID      *uuid.UUID  `json:"projectId"`
+	RunningBalance int64       `json:"runningBalance"`
+}
+
+func (q *Queries) GetAccountStatement(ctx context.Context, accountID uuid.UUID, toDate pgtype.Date, fromDate pgtype.Date) ([]GetAccountStatementRow, error) {
+	rows, err := q.db.Query(ctx, getAccountStatement, accountID, toDate, fromDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAccountStatementRow
+	for rows.Next() {
+		var i GetAccountStatementRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.CategoryID,
+			&i.Amount,
+			&i.Description,
+			&i.Date,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Payee,
+			&i.ProjectID,
+			&i.RunningBalance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccountWithBalance = `-- name: GetAccountWithBalance :one
+SELECT 
+    a.id, a.name, a.type, a.description, a.asset, a.created_at, a.updated_at, a.classification, a.institution, a.account_number_last4, a.color, a.icon, a.statement_closing_day, a.payment_due_day, a.book_id, a.user_id,
+    COALESCE(b.current_balance, 0) as current_balance,
+    COALESCE(b.pending_balance, 0) as pending_balance,
+    COALESCE(b.available_balance, 0) as available_balance,
+    b.last_calculated,
+    activity.transaction_count, activity.last_transaction_date
+FROM accounts a
+LEFT JOIN balances b ON a.id = b.account_id
+CROSS JOIN LATERAL (
+    SELECT COUNT(*) AS transaction_count, MAX(t.date)::date AS last_transaction_date
+    FROM transactions t
+    WHERE t.account_id = a.id AND t.deleted_at IS NULL
+) activity
+WHERE a.id = $1
+`
+
+type GetAccountWithBalanceRow struct {
+	ID                  uuid.UUID   `json:"id"`
+	Name                string      `json:"name"`
+	Type                string      `json:"type"`
+	Description         string      `json:"description"`
+	Asset               string      `json:"asset"`
+	CreatedAt           time.Time   `json:"createdAt"`
+	UpdatedAt           time.Time   `json:"updatedAt"`
+	Classification      *string     `json:"classification"`
+	Institution         string      `json:"institution"`
+	AccountNumberLast4  string      `json:"accountNumberLast4"`
+	Color               string      `json:"color"`
+	Icon                string      `json:"icon"`
+	StatementClosingDay int32       `json:"statementClosingDay"`
+	PaymentDueDay       int32       `json:"paymentDueDay"`
+	BookID              uuid.UUID   `json:"bookId"`
+	UserID              *uuid.UUID  `json:"userId"`
+	CurrentBalance      int64       `json:"currentBalance"`
+	PendingBalance      int64       `json:"pendingBalance"`
+	AvailableBalance    int64       `json:"availableBalance"`
+	LastCalculated      *time.Time  `json:"lastCalculated"`
+	TransactionCount    int64       `json:"transactionCount"`
+	LastTransactionDate pgtype.Date `json:"lastTransactionDate"`
+}
+
+func (q *Queries) GetAccountWithBalance(ctx context.Context, id uuid.UUID) (GetAccountWithBalanceRow, error) {
+	row := q.db.QueryRow(ctx, getAccountWithBalance, id)
+	var i GetAccountWithBalanceRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Type,
+		&i.Description,
+		&i.Asset,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Classification,
+		&i.Institution,
+		&i.AccountNumberLast4,
+		&i.Color,
+		&i.Icon,
+		&i.StatementClosingDay,
+		&i.PaymentDueDay,
+		&i.BookID,
+		&i.UserID,
+		&i.CurrentBalance,
+		&i.PendingBalance,
+		&i.AvailableBalance,
+		&i.LastCalculated,
+		&i.TransactionCount,
+		&i.LastTransactionDate,
+	)
+	return i, err
+}
+
+const getActiveSessions = `-- name: GetActiveSessions :many
+SELECT id, user_id, user_agent, ip_address, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+ORDER BY last_seen_at DESC, created_at DESC
+`
+
+func (q *Queries) GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]Session, error) {
+	rows, err := q.db.Query(ctx, getActiveSessions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.UserAgent,
+			&i.IpAddress,
+			&i.CreatedAt,
+			&i.LastSeenAt,
+			&i.ExpiresAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllBalances = `-- name: GetAllBalances :many
+SELECT b.account_id, b.current_balance, b.pending_balance, b.available_balance, b.last_calculated
+FROM balances b
+JOIN accounts a ON b.account_id = a.id
+WHERE a.book_id = $1
+ORDER BY b.account_id
+`
+
+func (q *Queries) GetAllBalances(ctx context.Context, bookID uuid.UUID) ([]Balance, error) {
+	rows, err := q.db.Query(ctx, getAllBalances, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Balance
+	for rows.Next() {
+		var i Balance
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.CurrentBalance,
+			&i.PendingBalance,
+			&i.AvailableBalance,
+			&i.LastCalculated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllBooks = `-- name: GetAllBooks :many
+SELECT id, name, description, created_at, updated_at, asset, user_id
+FROM books
+WHERE $1::uuid IS NULL OR user_id = $1
+ORDER BY name
+`
+
+func (q *Queries) GetAllBooks(ctx context.Context, userID *uuid.UUID) ([]Book, error) {
+	rows, err := q.db.Query(ctx, getAllBooks, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Asset,
+			&i.UserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllBudgetAlerts = `-- name: GetAllBudgetAlerts :many
+SELECT id, book_id, user_id, budget_id, category_id, month, threshold, percent, asset, spent, limit_amount, created_at
+FROM budget_alerts
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY created_at DESC, threshold DESC
+`
+
+func (q *Queries) GetAllBudgetAlerts(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]BudgetAlert, error) {
+	rows, err := q.db.Query(ctx, getAllBudgetAlerts, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BudgetAlert
+	for rows.Next() {
+		var i BudgetAlert
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.UserID,
+			&i.BudgetID,
+			&i.CategoryID,
+			&i.Month,
+			&i.Threshold,
+			&i.Percent,
+			&i.Asset,
+			&i.Spent,
+			&i.LimitAmount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllBudgetTemplates = `-- name: GetAllBudgetTemplates :many
+SELECT id, book_id, user_id, name, items, created_at, updated_at
+FROM budget_templates
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY name
+`
+
+func (q *Queries) GetAllBudgetTemplates(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]BudgetTemplate, error) {
+	rows, err := q.db.Query(ctx, getAllBudgetTemplates, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BudgetTemplate
+	for rows.Next() {
+		var i BudgetTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.UserID,
+			&i.Name,
+			&i.Items,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllBudgets = `-- name: GetAllBudgets :many
+SELECT b.id, b.book_id, b.user_id, b.category_id, b.asset, b.amount, b.start_month, b.end_month, b.created_at, b.updated_at, b.thresholds
+FROM budgets b
+JOIN categories c ON b.category_id = c.id
+WHERE b.book_id = $1 AND ($2::uuid IS NULL OR b.user_id = $2)
+ORDER BY c.type, c.name, b.start_month
+`
+
+func (q *Queries) GetAllBudgets(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]Budget, error) {
+	rows, err := q.db.Query(ctx, getAllBudgets, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Budget
+	for rows.Next() {
+		var i Budget
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.UserID,
+			&i.CategoryID,
+			&i.Asset,
+			&i.Amount,
+			&i.StartMonth,
+			&i.EndMonth,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Thresholds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCustomAssets = `-- name: GetAllCustomAssets :many
+SELECT code, name, symbol, "precision", created_at
+FROM custom_assets
+ORDER BY code
+`
+
+func (q *Queries) GetAllCustomAssets(ctx context.Context) ([]CustomAsset, error) {
+	rows, err := q.db.Query(ctx, getAllCustomAssets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CustomAsset
+	for rows.Next() {
+		var i CustomAsset
+		if err := rows.Scan(
+			&i.Code,
+			&i.Name,
+			&i.Symbol,
+			&i.Precision,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllExpenseReports = `-- name: GetAllExpenseReports :many
+SELECT id, name, start_date, end_date, notes, status, submitted_at, reviewed_at, created_at, updated_at, book_id, user_id
+FROM expense_reports
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY start_date DESC, created_at DESC
+`
+
+func (q *Queries) GetAllExpenseReports(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]ExpenseReport, error) {
+	rows, err := q.db.Query(ctx, getAllExpenseReports, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExpenseReport
+	for rows.Next() {
+		var i ExpenseReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.StartDate,
+			&i.EndDate,
+			&i.Notes,
+			&i.Status,
+			&i.SubmittedAt,
+			&i.ReviewedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.BookID,
+			&i.UserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllInstallmentPlans = `-- name: GetAllInstallmentPlans :many
+SELECT p.id, p.account_id, p.description, p.amount, p.installment_count, p.first_date, p.paid_off_on, p.created_at, p.updated_at
+FROM installment_plans p
+JOIN accounts a ON p.account_id = a.id
+WHERE a.book_id = $1
+ORDER BY p.first_date DESC, p.created_at DESC
+`
+
+func (q *Queries) GetAllInstallmentPlans(ctx context.Context, bookID uuid.UUID) ([]InstallmentPlan, error) {
+	rows, err := q.db.Query(ctx, getAllInstallmentPlans, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InstallmentPlan
+	for rows.Next() {
+		var i InstallmentPlan
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Description,
+			&i.Amount,
+			&i.InstallmentCount,
+			&i.FirstDate,
+			&i.PaidOffOn,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllInvoices = `-- name: GetAllInvoices :many
+SELECT i.id, i.account_id, i.client, i.number, i.description, i.amount, i.issue_date, i.due_date, i.transaction_id, i.created_at, i.updated_at
+FROM invoices i
+JOIN accounts a ON i.account_id = a.id
+WHERE a.book_id = $1
+ORDER BY i.due_date DESC, i.created_at DESC
+`
+
+func (q *Queries) GetAllInvoices(ctx context.Context, bookID uuid.UUID) ([]Invoice, error) {
+	rows, err := q.db.Query(ctx, getAllInvoices, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Invoice
+	for rows.Next() {
+		var i Invoice
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Client,
+			&i.Number,
+			&i.Description,
+			&i.Amount,
+			&i.IssueDate,
+			&i.DueDate,
+			&i.TransactionID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllProjects = `-- name: GetAllProjects :many
+SELECT id, name, client, description, created_at, updated_at, book_id, user_id
+FROM projects
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY name
+`
+
+func (q *Queries) GetAllProjects(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]Project, error) {
+	rows, err := q.db.Query(ctx, getAllProjects, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Project
+	for rows.Next() {
+		var i Project
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Client,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.BookID,
+			&i.UserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllReportDefinitions = `-- name: GetAllReportDefinitions :many
+SELECT id, book_id, user_id, name, definition, created_at, updated_at
+FROM report_definitions
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY name
+`
+
+func (q *Queries) GetAllReportDefinitions(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]ReportDefinition, error) {
+	rows, err := q.db.Query(ctx, getAllReportDefinitions, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReportDefinition
+	for rows.Next() {
+		var i ReportDefinition
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.UserID,
+			&i.Name,
+			&i.Definition,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllRestorePoints = `-- name: GetAllRestorePoints :many
+SELECT id, book_id, operation, description, changes, created_at, rolled_back_at
+FROM restore_points
+WHERE book_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetAllRestorePoints(ctx context.Context, bookID uuid.UUID) ([]RestorePoint, error) {
+	rows, err := q.db.Query(ctx, getAllRestorePoints, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RestorePoint
+	for rows.Next() {
+		var i RestorePoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Operation,
+			&i.Description,
+			&i.Changes,
+			&i.CreatedAt,
+			&i.RolledBackAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllTags = `-- name: GetAllTags :many
+SELECT id, book_id, name, created_at, updated_at
+FROM tags
+WHERE book_id = $1
+ORDER BY name
+`
+
+func (q *Queries) GetAllTags(ctx context.Context, bookID uuid.UUID) ([]Tag, error) {
+	rows, err := q.db.Query(ctx, getAllTags, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Tag
+	for rows.Next() {
+		var i Tag
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Name,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllTransactionScripts = `-- name: GetAllTransactionScripts :many
+SELECT id, book_id, name, source, enabled, created_at, updated_at
+FROM transaction_scripts
+WHERE book_id = $1
+ORDER BY name
+`
+
+func (q *Queries) GetAllTransactionScripts(ctx context.Context, bookID uuid.UUID) ([]TransactionScript, error) {
+	rows, err := q.db.Query(ctx, getAllTransactionScripts, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TransactionScript
+	for rows.Next() {
+		var i TransactionScript
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Name,
+			&i.Source,
+			&i.Enabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllTransactions = `-- name: GetAllTransactions :many
+SELECT t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee, t.installment_plan_id, t.installment_number, t.installment_count, t.project_id, t.user_id
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE a.book_id = $1 AND t.deleted_at IS NULL AND ($2::uuid IS NULL OR t.user_id = $2)
+ORDER BY t.date DESC, t.created_at DESC
+`
+
+func (q *Queries) GetAllTransactions(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]Transaction, error) {
+	rows, err := q.db.Query(ctx, getAllTransactions, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Transaction
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.CategoryID,
+			&i.Amount,
+			&i.Description,
+			&i.Date,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Payee,
+			&i.InstallmentPlanID,
+			&i.InstallmentNumber,
+			&i.InstallmentCount,
+			&i.ProjectID,
+			&i.UserID,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllUserSettings = `-- name: GetAllUserSettings :many
+
+SELECT key, value, updated_at, user_id
+FROM user_settings
+WHERE user_id IS NOT DISTINCT FROM $1
+ORDER BY key
+`
+
+// =============================================================================
+// USER SETTINGS
+// =============================================================================
+func (q *Queries) GetAllUserSettings(ctx context.Context, userID *uuid.UUID) ([]UserSetting, error) {
+	rows, err := q.db.Query(ctx, getAllUserSettings, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserSetting
+	for rows.Next() {
+		var i UserSetting
+		if err := rows.Scan(
+			&i.Key,
+			&i.Value,
+			&i.UpdatedAt,
+			&i.UserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAttachmentByID = `-- name: GetAttachmentByID :one
+SELECT id, transaction_id, file_name, content_type, size, storage_key, thumbnail_key, created_at
+FROM attachments
+WHERE id = $1
+`
+
+func (q *Queries) GetAttachmentByID(ctx context.Context, id uuid.UUID) (Attachment, error) {
+	row := q.db.QueryRow(ctx, getAttachmentByID, id)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.TransactionID,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.StorageKey,
+		&i.ThumbnailKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAttachmentsByDateRange = `-- name: GetAttachmentsByDateRange :many
+SELECT at.id, at.transaction_id, at.file_name, at.content_type, at.size, at.storage_key, at.thumbnail_key, at.created_at
+FROM attachments at
+JOIN transactions t ON at.transaction_id = t.id
+JOIN accounts a ON t.account_id = a.id
+WHERE t.deleted_at IS NULL AND t.date >= $1 AND t.date <= $2 AND a.book_id = $3 AND ($4::uuid IS NULL OR t.user_id = $4)
+ORDER BY t.date, at.created_at, at.id
+`
+
+func (q *Queries) GetAttachmentsByDateRange(ctx context.Context, date pgtype.Date, date_2 pgtype.Date, bookID uuid.UUID, userID *uuid.UUID) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, getAttachmentsByDateRange,
+		date,
+		date_2,
+		bookID,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Attachment
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TransactionID,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.StorageKey,
+			&i.ThumbnailKey,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuthEventsByUser = `-- name: GetAuthEventsByUser :many
+SELECT id, user_id, type, email, ip_address, user_agent, created_at
+FROM auth_events
+WHERE user_id = $1
+ORDER BY created_at DESC
+LIMIT $2
+`
+
+func (q *Queries) GetAuthEventsByUser(ctx context.Context, userID *uuid.UUID, limit int32) ([]AuthEvent, error) {
+	rows, err := q.db.Query(ctx, getAuthEventsByUser, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuthEvent
+	for rows.Next() {
+		var i AuthEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Type,
+			&i.Email,
+			&i.IpAddress,
+			&i.UserAgent,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBalanceByAccountID = `-- name: GetBalanceByAccountID :one
+
+SELECT account_id, current_balance, pending_balance, available_balance, last_calculated
+FROM balances
+WHERE account_id = $1
+`
+
+// =============================================================================
+// BALANCES
+// =============================================================================
+func (q *Queries) GetBalanceByAccountID(ctx context.Context, accountID uuid.UUID) (Balance, error) {
+	row := q.db.QueryRow(ctx, getBalanceByAccountID, accountID)
+	var i Balance
+	err := row.Scan(
+		&i.AccountID,
+		&i.CurrentBalance,
+		&i.PendingBalance,
+		&i.AvailableBalance,
+		&i.LastCalculated,
+	)
+	return i, err
+}
+
+const getBalanceSnapshotsAt = `-- name: GetBalanceSnapshotsAt :many
+SELECT DISTINCT ON (s.account_id) s.account_id, s.snapshot_date, s.current_balance, a.asset
+FROM balance_snapshots s
+JOIN accounts a ON s.account_id = a.id
+WHERE s.snapshot_date <= $1 AND a.book_id = $2
+ORDER BY s.account_id, s.snapshot_date DESC
+`
+
+type GetBalanceSnapshotsAtRow struct {
+	AccountID      uuid.UUID   `json:"accountId"`
+	SnapshotDate   pgtype.Date `json:"snapshotDate"`
+	CurrentBalance int64       `json:"currentBalance"`
+	Asset          string      `json:"asset"`
+}
+
+func (q *Queries) GetBalanceSnapshotsAt(ctx context.Context, snapshotDate pgtype.Date, bookID uuid.UUID) ([]GetBalanceSnapshotsAtRow, error) {
+	rows, err := q.db.Query(ctx, getBalanceSnapshotsAt, snapshotDate, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetBalanceSnapshotsAtRow
+	for rows.Next() {
+		var i GetBalanceSnapshotsAtRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.SnapshotDate,
+			&i.CurrentBalance,
+			&i.Asset,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBalanceSnapshotsBetween = `-- name: GetBalanceSnapshotsBetween :many
+SELECT s.account_id, s.snapshot_date, s.current_balance, a.asset
+FROM balance_snapshots s
+JOIN accounts a ON s.account_id = a.id
+WHERE a.book_id = $1
+  AND ($2::uuid IS NULL OR s.account_id = $2)
+  AND s.snapshot_date >= COALESCE((
+      SELECT MAX(p.snapshot_date)
+      FROM balance_snapshots p
+      WHERE p.account_id = s.account_id AND p.snapshot_date <= $3
+  ), $3)
+  AND s.snapshot_date <= $4
+ORDER BY s.account_id, s.snapshot_date
+`
+
+type GetBalanceSnapshotsBetweenRow struct {
+	AccountID      uuid.UUID   `json:"accountId"`
+	SnapshotDate   pgtype.Date `json:"snapshotDate"`
+	CurrentBalance int64       `json:"currentBalance"`
+	Asset          string      `json:"asset"`
+}
+
+// The snapshots of each account taken between the dates, starting with the
+// last one on or before the first date, the balance the account had then
+func (q *Queries) GetBalanceSnapshotsBetween(ctx context.Context, bookID uuid.UUID, accountID *uuid.UUID, fromDate pgtype.Date, toDate pgtype.Date) ([]GetBalanceSnapshotsBetweenRow, error) {
+	rows, err := q.db.Query(ctx, getBalanceSnapshotsBetween,
+		bookID,
+		accountID,
+		fromDate,
+		toDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetBalanceSnapshotsBetweenRow
+	for rows.Next() {
+		var i GetBalanceSnapshotsBetweenRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.SnapshotDate,
+			&i.CurrentBalance,
+			&i.Asset,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBalanceSummary = `-- name: GetBalanceSummary :one
+WITH classified AS (
+    SELECT
+        b.current_balance,
+        COALESCE(a.classification, CASE WHEN a.type = 'credit' THEN 'liability' ELSE 'asset' END) as classification
+    FROM balances b
+    JOIN accounts a ON b.account_id = a.id
+    WHERE a.book_id = $1
+      AND a.asset NOT IN (SELECT code FROM custom_assets)
+)
+SELECT 
+    COALESCE(SUM(CASE WHEN classification = 'asset' THEN current_balance ELSE 0 END), 0)::BIGINT as total_assets,
+    COALESCE(SUM(CASE WHEN classification = 'liability' THEN current_balance ELSE 0 END), 0)::BIGINT as total_liabilities,
+    COALESCE(SUM(CASE WHEN classification = 'asset' THEN current_balance ELSE -current_balance END), 0)::BIGINT as net_worth,
+    NOW() as last_calculated
+FROM classified
+`
+
+type GetBalanceSummaryRow struct {
+	TotalAssets      int64       `json:"totalAssets"`
+	TotalLiabilities int64       `json:"totalLiabilities"`
+	NetWorth         int64       `json:"netWorth"`
+	LastCalculated   interface{} `json:"lastCalculated"`
+}
+
+func (q *Queries) GetBalanceSummary(ctx context.Context, bookID uuid.UUID) (GetBalanceSummaryRow, error) {
+	row := q.db.QueryRow(ctx, getBalanceSummary, bookID)
+	var i GetBalanceSummaryRow
+	err := row.Scan(
+		&i.TotalAssets,
+		&i.TotalLiabilities,
+		&i.NetWorth,
+		&i.LastCalculated,
+	)
+	return i, err
+}
+
+const getBalancesPage = `-- name: GetBalancesPage :many
+SELECT b.account_id, b.current_balance, b.pending_balance, b.available_balance, b.last_calculated
+FROM balances b
+JOIN accounts a ON b.account_id = a.id
+WHERE a.book_id = $1
+ORDER BY b.account_id
+LIMIT $2 OFFSET $3
+`
+
+func (q *Queries) GetBalancesPage(ctx context.Context, bookID uuid.UUID, limit int32, offset int32) ([]Balance, error) {
+	rows, err := q.db.Query(ctx, getBalancesPage, bookID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Balance
+	for rows.Next() {
+		var i Balance
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.CurrentBalance,
+			&i.PendingBalance,
+			&i.AvailableBalance,
+			&i.LastCalculated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBookByID = `-- name: GetBookByID :one
+SELECT id, name, description, created_at, updated_at, asset, user_id
+FROM books
+WHERE id = $1
+`
+
+func (q *Queries) GetBookByID(ctx context.Context, id uuid.UUID) (Book, error) {
+	row := q.db.QueryRow(ctx, getBookByID, id)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Asset,
+		&i.UserID,
+	)
+	return i, err
+}
+
+const getBudgetByID = `-- name: GetBudgetByID :one
+SELECT id, book_id, user_id, category_id, asset, amount, start_month, end_month, created_at, updated_at, thresholds
+FROM budgets
+WHERE id = $1
+`
+
+func (q *Queries) GetBudgetByID(ctx context.Context, id uuid.UUID) (Budget, error) {
+	row := q.db.QueryRow(ctx, getBudgetByID, id)
+	var i Budget
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.UserID,
+		&i.CategoryID,
+		&i.Asset,
+		&i.Amount,
+		&i.StartMonth,
+		&i.EndMonth,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Thresholds,
+	)
+	return i, err
+}
+
+const getBudgetTemplateByID = `-- name: GetBudgetTemplateByID :one
+SELECT id, book_id, user_id, name, items, created_at, updated_at
+FROM budget_templates
+WHERE id = $1
+`
+
+func (q *Queries) GetBudgetTemplateByID(ctx context.Context, id uuid.UUID) (BudgetTemplate, error) {
+	row := q.db.QueryRow(ctx, getBudgetTemplateByID, id)
+	var i BudgetTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.UserID,
+		&i.Name,
+		&i.Items,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getCashFlow = `-- name: GetCashFlow :many
+SELECT
+    to_char(t.date, 'YYYY-MM') AS month,
+    a.asset,
+    COALESCE(SUM(ABS(t.amount)) FILTER (WHERE c.type = 'income'), 0)::bigint AS income,
+    COALESCE(SUM(ABS(t.amount)) FILTER (WHERE c.type = 'expense'), 0)::bigint AS expense
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+JOIN categories c ON t.category_id = c.id
+WHERE t.deleted_at IS NULL AND t.status IN ('pending', 'cleared')
+    AND t.date >= $1 AND t.date <= $2
+    AND ($3::uuid IS NULL OR t.account_id = $3)
+    AND a.book_id = $4 AND ($5::uuid IS NULL OR t.user_id = $5)
+GROUP BY a.asset, month
+ORDER BY a.asset, month
+`
+
+type GetCashFlowRow struct {
+	Month   string `json:"month"`
+	Asset   string `json:"asset"`
+	Income  int64  `json:"income"`
+	Expense int64  `json:"expense"`
+}
+
+// Adds up the size of the pending and cleared income and expenses per asset
+// and month, of one account when given.
+func (q *Queries) GetCashFlow(ctx context.Context, startDate pgtype.Date, endDate pgtype.Date, accountID *uuid.UUID, bookID uuid.UUID, userID *uuid.UUID) ([]GetCashFlowRow, error) {
+	rows, err := q.db.Query(ctx, getCashFlow,
+		startDate,
+		endDate,
+		accountID,
+		bookID,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCashFlowRow
+	for rows.Next() {
+		var i GetCashFlowRow
+		if err := rows.Scan(
+			&i.Month,
+			&i.Asset,
+			&i.Income,
+			&i.Expense,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCategoriesByType = `-- name: GetCategoriesByType :many
+SELECT id, name, type, description, color, created_at, updated_at, book_id, user_id, parent_id
+FROM categories
+WHERE type = $1 AND book_id = $2 AND ($3::uuid IS NULL OR user_id = $3)
+ORDER BY name
+`
+
+func (q *Queries) GetCategoriesByType(ctx context.Context, type_ string, bookID uuid.UUID, userID *uuid.UUID) ([]Category, error) {
+	rows, err := q.db.Query(ctx, getCategoriesByType, type_, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Category
+	for rows.Next() {
+		var i Category
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Type,
+			&i.Description,
+			&i.Color,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.BookID,
+			&i.UserID,
+			&i.ParentID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCategoryByID = `-- name: GetCategoryByID :one
+SELECT id, name, type, description, color, created_at, updated_at, book_id, user_id, parent_id
+FROM categories
+WHERE id = $1
+`
+
+func (q *Queries) GetCategoryByID(ctx context.Context, id uuid.UUID) (Category, error) {
+	row := q.db.QueryRow(ctx, getCategoryByID, id)
+	var i Category
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Type,
+		&i.Description,
+		&i.Color,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BookID,
+		&i.UserID,
+		&i.ParentID,
+	)
+	return i, err
+}
+
+const getDeletedTransactions = `-- name: GetDeletedTransactions :many
+SELECT t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee, t.installment_plan_id, t.installment_number, t.installment_count, t.project_id, t.user_id, t.deleted_at
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE a.book_id = $3 AND t.deleted_at IS NOT NULL AND ($4::uuid IS NULL OR t.user_id = $4)
+ORDER BY t.deleted_at DESC, t.id
+LIMIT $1 OFFSET $2
+`
+
+func (q *Queries) GetDeletedTransactions(ctx context.Context, limit int32, offset int32, bookID uuid.UUID, userID *uuid.UUID) ([]Transaction, error) {
+	rows, err := q.db.Query(ctx, getDeletedTransactions,
+		limit,
+		offset,
+		bookID,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Transaction
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.CategoryID,
+			&i.Amount,
+			&i.Description,
+			&i.Date,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Payee,
+			&i.InstallmentPlanID,
+			&i.InstallmentNumber,
+			&i.InstallmentCount,
+			&i.ProjectID,
+			&i.UserID,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExpenseReportByID = `-- name: GetExpenseReportByID :one
+SELECT id, name, start_date, end_date, notes, status, submitted_at, reviewed_at, created_at, updated_at, book_id, user_id
+FROM expense_reports
+WHERE id = $1
+`
+
+func (q *Queries) GetExpenseReportByID(ctx context.Context, id uuid.UUID) (ExpenseReport, error) {
+	row := q.db.QueryRow(ctx, getExpenseReportByID, id)
+	var i ExpenseReport
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Notes,
+		&i.Status,
+		&i.SubmittedAt,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BookID,
+		&i.UserID,
+	)
+	return i, err
+}
+
+const getExpenseReportTransactions = `-- name: GetExpenseReportTransactions :many
+SELECT
+    t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee,
+    t.installment_plan_id, t.installment_number, t.installment_count, t.project_id,
+    a.name as account_name, a.type as account_type, a.asset as account_asset,
+    c.name as category_name, c.type as category_type, c.color as category_color
+FROM expense_report_transactions r
+JOIN transactions t ON r.transaction_id = t.id
+JOIN accounts a ON t.account_id = a.id
+JOIN categories c ON t.category_id = c.id
+JOIN expense_reports er ON r.expense_report_id = er.id
+WHERE r.expense_report_id = $1 AND er.book_id = $2 AND t.deleted_at IS NULL
+ORDER BY t.date, t.created_at
+`
+
+type GetExpenseReportTransactionsRow struct {
+	ID                uuid.UUID   `json:"id"`
+	AccountID         uuid.UUID   `json:"accountId"`
+	CategoryID        uuid.UUID   `json:"categoryId"`
+	Amount            int64       `json:"amount"`
+	Description       string      `json:"description"`
+	Date              pgtype.Date `json:"date"`
+	Status            string      `json:"status"`
+	CreatedAt         time.Time   `json:"createdAt"`
+	UpdatedAt         time.Time   `json:"updatedAt"`
+	Payee             string      `json:"payee"`
+	InstallmentPlanID *uuid.UUID  `json:"installmentPlanId"`
+	InstallmentNumber int32       `json:"installmentNumber"`
+	InstallmentCount  int32       `json:"installmentCount"`
+	ProjectID         *uuid.UUID  `json:"projectId"`
+	AccountName       string      `json:"accountName"`
+	AccountType       string      `json:"accountType"`
+	AccountAsset      string      `json:"accountAsset"`
+	CategoryName      string      `json:"categoryName"`
+	CategoryType      string      `json:"categoryType"`
+	CategoryColor     string      `json:"categoryColor"`
+}
+
+func (q *Queries) GetExpenseReportTransactions(ctx context.Context, expenseReportID uuid.UUID, bookID uuid.UUID) ([]GetExpenseReportTransactionsRow, error) {
+	rows, err := q.db.Query(ctx, getExpenseReportTransactions, expenseReportID, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetExpenseReportTransactionsRow
+	for rows.Next() {
+		var i GetExpenseReportTransactionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.CategoryID,
+			&i.Amount,
+			&i.Description,
+			&i.Date,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Payee,
+			&i.InstallmentPlanID,
+			&i.InstallmentNumber,
+			&i.InstallmentCount,
+			&i.ProjectID,
+			&i.AccountName,
+			&i.AccountType,
+			&i.AccountAsset,
+			&i.CategoryName,
+			&i.CategoryType,
+			&i.CategoryColor,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExportByID = `-- name: GetExportByID :one
+SELECT id, book_id, user_id, format, file_name, content_type, size, storage_key, created_at, expires_at
+FROM exports
+WHERE id = $1
+`
+
+func (q *Queries) GetExportByID(ctx context.Context, id uuid.UUID) (Export, error) {
+	row := q.db.QueryRow(ctx, getExportByID, id)
+	var i Export
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.UserID,
+		&i.Format,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.StorageKey,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getExports = `-- name: GetExports :many
+SELECT id, book_id, user_id, format, file_name, content_type, size, storage_key, created_at, expires_at
+FROM exports
+WHERE book_id = $1 AND ($2::uuid IS NULL OR user_id = $2) AND expires_at > $3
+ORDER BY created_at DESC, id
+`
+
+func (q *Queries) GetExports(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID, now time.Time) ([]Export, error) {
+	rows, err := q.db.Query(ctx, getExports, bookID, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Export
+	for rows.Next() {
+		var i Export
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.UserID,
+			&i.Format,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.StorageKey,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getFirstBook = `-- name: GetFirstBook :one
+SELECT id, name, description, created_at, updated_at, asset, user_id
+FROM books
+WHERE $1::uuid IS NULL OR user_id = $1
+ORDER BY created_at, id
+LIMIT 1
+`
+
+func (q *Queries) GetFirstBook(ctx context.Context, userID *uuid.UUID) (Book, error) {
+	row := q.db.QueryRow(ctx, getFirstBook, userID)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Asset,
+		&i.UserID,
+	)
+	return i, err
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT user_id, key, fingerprint, status_code, content_type, body, created_at
+FROM idempotency_keys
+WHERE user_id IS NOT DISTINCT FROM $1 AND key = $2
+`
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, userID *uuid.UUID, key string) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, userID, key)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.UserID,
+		&i.Key,
+		&i.Fingerprint,
+		&i.StatusCode,
+		&i.ContentType,
+		&i.Body,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getInstallmentPlanByID = `-- name: GetInstallmentPlanByID :one
+SELECT id, account_id, description, amount, installment_count, first_date, paid_off_on, created_at, updated_at
+FROM installment_plans
+WHERE id = $1
+`
+
+func (q *Queries) GetInstallmentPlanByID(ctx context.Context, id uuid.UUID) (InstallmentPlan, error) {
+	row := q.db.QueryRow(ctx, getInstallmentPlanByID, id)
+	var i InstallmentPlan
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Description,
+		&i.Amount,
+		&i.InstallmentCount,
+		&i.FirstDate,
+		&i.PaidOffOn,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getInvoiceByID = `-- name: GetInvoiceByID :one
+SELECT id, account_id, client, number, description, amount, issue_date, due_date, transaction_id, created_at, updated_at
+FROM invoices
+WHERE id = $1
+`
+
+func (q *Queries) GetInvoiceByID(ctx context.Context, id uuid.UUID) (Invoice, error) {
+	row := q.db.QueryRow(ctx, getInvoiceByID, id)
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Client,
+		&i.Number,
+		&i.Description,
+		&i.Amount,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.TransactionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getLoginFailures = `-- name: GetLoginFailures :one
+
+SELECT scope, key, failures, last_failed_at, locked_until
+FROM login_failures
+WHERE scope = $1 AND key = $2
+`
+
+// =============================================================================
+// LOGIN LOCKOUT
+// =============================================================================
+func (q *Queries) GetLoginFailures(ctx context.Context, scope string, key string) (LoginFailure, error) {
+	row := q.db.QueryRow(ctx, getLoginFailures, scope, key)
+	var i LoginFailure
+	err := row.Scan(
+		&i.Scope,
+		&i.Key,
+		&i.Failures,
+		&i.LastFailedAt,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const getProjectByID = `-- name: GetProjectByID :one
+SELECT id, name, client, description, created_at, updated_at, book_id, user_id
+FROM projects
+WHERE id = $1
+`
+
+func (q *Queries) GetProjectByID(ctx context.Context, id uuid.UUID) (Project, error) {
+	row := q.db.QueryRow(ctx, getProjectByID, id)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Client,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BookID,
+		&i.UserID,
+	)
+	return i, err
+}
+
+const getReportDefinitionByID = `-- name: GetReportDefinitionByID :one
+SELECT id, book_id, user_id, name, definition, created_at, updated_at
+FROM report_definitions
+WHERE id = $1
+`
+
+func (q *Queries) GetReportDefinitionByID(ctx context.Context, id uuid.UUID) (ReportDefinition, error) {
+	row := q.db.QueryRow(ctx, getReportDefinitionByID, id)
+	var i ReportDefinition
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.UserID,
+		&i.Name,
+		&i.Definition,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getReportSnapshot = `-- name: GetReportSnapshot :one
+
+SELECT book_id, month, report, created_at
+FROM report_snapshots
+WHERE book_id = $1 AND month = $2
+`
+
+// =============================================================================
+// REPORT SNAPSHOTS
+// =============================================================================
+func (q *Queries) GetReportSnapshot(ctx context.Context, bookID uuid.UUID, month pgtype.Date) (ReportSnapshot, error) {
+	row := q.db.QueryRow(ctx, getReportSnapshot, bookID, month)
+	var i ReportSnapshot
+	err := row.Scan(
+		&i.BookID,
+		&i.Month,
+		&i.Report,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getRestorePointByID = `-- name: GetRestorePointByID :one
+SELECT id, book_id, operation, description, changes, created_at, rolled_back_at
+FROM restore_points
+WHERE id = $1
+`
+
+func (q *Queries) GetRestorePointByID(ctx context.Context, id uuid.UUID) (RestorePoint, error) {
+	row := q.db.QueryRow(ctx, getRestorePointByID, id)
+	var i RestorePoint
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Operation,
+		&i.Description,
+		&i.Changes,
+		&i.CreatedAt,
+		&i.RolledBackAt,
+	)
+	return i, err
+}
+
+const getSessionByID = `-- name: GetSessionByID :one
+SELECT id, user_id, user_agent, ip_address, created_at, last_seen_at, expires_at, revoked_at
+FROM sessions
+WHERE id = $1
+`
+
+func (q *Queries) GetSessionByID(ctx context.Context, id uuid.UUID) (Session, error) {
+	row := q.db.QueryRow(ctx, getSessionByID, id)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.CreatedAt,
+		&i.LastSeenAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const getSettings = `-- name: GetSettings :one
+
+SELECT id, currency, locale, fiscal_month_start_day, notifications_enabled, notification_email, api_keys, updated_at
+FROM settings
+WHERE id = TRUE
+`
+
+// =============================================================================
+// SETTINGS
+// =============================================================================
+func (q *Queries) GetSettings(ctx context.Context) (Setting, error) {
+	row := q.db.QueryRow(ctx, getSettings)
+	var i Setting
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.Locale,
+		&i.FiscalMonthStartDay,
+		&i.NotificationsEnabled,
+		&i.NotificationEmail,
+		&i.ApiKeys,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getSpending = `-- name: GetSpending :many
+SELECT
+    category_key,
+    month_key,
+    asset,
+    COUNT(*)::bigint AS count,
+    SUM(amount)::bigint AS total
+FROM (
+    SELECT
+        CASE WHEN $1::bool THEN t.category_id::text ELSE '' END AS category_key,
+        CASE WHEN $2::bool THEN to_char(t.date, 'YYYY-MM') ELSE '' END AS month_key,
+        a.asset,
+        ABS(t.amount) AS amount
+    FROM transactions t
+    JOIN accounts a ON t.account_id = a.id
+    JOIN categories c ON t.category_id = c.id
+    WHERE c.type = 'expense' AND t.deleted_at IS NULL AND t.status IN ('pending', 'cleared')
+        AND t.date >= $3 AND t.date <= $4
+        AND a.book_id = $5 AND ($6::uuid IS NULL OR t.user_id = $6)
+) spending
+GROUP BY category_key, month_key, asset
+ORDER BY asset, month_key, total DESC, category_key
+`
+
+type GetSpendingRow struct {
+	CategoryKey string `json:"categoryKey"`
+	MonthKey    string `json:"monthKey"`
+	Asset       string `json:"asset"`
+	Count       int64  `json:"count"`
+	Total       int64  `json:"total"`
+}
+
+// Adds up the size of the pending and cleared expenses per asset, by
+// category and by month when asked, the keys not grouped by left empty.
+func (q *Queries) GetSpending(ctx context.Context, byCategory bool, byMonth bool, startDate pgtype.Date, endDate pgtype.Date, bookID uuid.UUID, userID *uuid.UUID) ([]GetSpendingRow, error) {
+	rows, err := q.db.Query(ctx, getSpending,
+		byCategory,
+		byMonth,
+		startDate,
+		endDate,
+		bookID,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSpendingRow
+	for rows.Next() {
+		var i GetSpendingRow
+		if err := rows.Scan(
+			&i.CategoryKey,
+			&i.MonthKey,
+			&i.Asset,
+			&i.Count,
+			&i.Total,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTagByID = `-- name: GetTagByID :one
+SELECT id, book_id, name, created_at, updated_at
+FROM tags
+WHERE id = $1
 `
 
-// =============================================================================
-// ACCOUNTS
-// =============================================================================
-func (q *Queries) CreateAccount(ctx context.Context, name string, type_ string, description string, asset string) (Account, error) {
-	row := q.db.QueryRow(ctx, createAccount,
-		name,
-		type_,
-		description,
-		asset,
-	)
-	var i Account
+func (q *Queries) GetTagByID(ctx context.Context, id uuid.UUID) (Tag, error) {
+	row := q.db.QueryRow(ctx, getTagByID, id)
+	var i Tag
 	err := row.Scan(
 		&i.ID,
+		&i.BookID,
 		&i.Name,
-		&i.Type,
-		&i.Description,
-		&i.Asset,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const createCategory = `-- name: CreateCategory :one
-
-INSERT INTO categories (name, type, description, color)
-VALUES ($1, $2, $3, $4)
-RETURNING id, name, type, description, color, created_at, updated_at
+const getTransactionAttachments = `-- name: GetTransactionAttachments :many
+SELECT id, transaction_id, file_name, content_type, size, storage_key, thumbnail_key, created_at
+FROM attachments
+WHERE transaction_id = ANY($1::uuid[])
+ORDER BY created_at, id
 `
 
-// =============================================================================
-// CATEGORIES
-// =============================================================================
-func (q *Queries) CreateCategory(ctx context.Context, name string, type_ string, description string, color string) (Category, error) {
-	row := q.db.QueryRow(ctx, createCategory,
-		name,
-		type_,
-		description,
-		color,
-	)
-	var i Category
-	err := row.Scan(
-		&i.ID,
-		&i.Name,
-		&i.Type,
-		&i.Description,
-		&i.Color,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-	)
-	return i, err
+func (q *Queries) GetTransactionAttachments(ctx context.Context, transactionIds []uuid.UUID) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, getTransactionAttachments, transactionIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Attachment
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TransactionID,
+			&i.FileName,
+			&i.ContentType,
+			&i.Size,
+			&i.StorageKey,
+			&i.ThumbnailKey,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const createTransaction = `-- name: CreateTransaction :one
-
-INSERT INTO transactions (account_id, category_id, amount, description, date, status)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, account_id, category_id, amount, description, date, status, created_at, updated_at
+const getTransactionByID = `-- name: GetTransactionByID :one
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
+FROM transactions
+WHERE id = $1 AND deleted_at IS NULL
 `
 
-// =============================================================================
-// TRANSACTIONS
-// =============================================================================
-func (q *Queries) CreateTransaction(ctx context.Context, accountID uuid.UUID, categoryID uuid.UUID, amount int64, description string, date pgtype.Date, status string) (Transaction, error) {
-	row := q.db.QueryRow(ctx, createTransaction,
-		accountID,
-		categoryID,
-		amount,
-		description,
-		date,
-		status,
-	)
+func (q *Queries) GetTransactionByID(ctx context.Context, id uuid.UUID) (Transaction, error) {
+	row := q.db.QueryRow(ctx, getTransactionByID, id)
 	var i Transaction
 	err := row.Scan(
 		&i.ID,
@@ -103,144 +3061,304 @@ func (q *Queries) CreateTransaction(ctx context.Context, accountID uuid.UUID, ca
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Payee,
+		&i.InstallmentPlanID,
+		&i.InstallmentNumber,
+		&i.InstallmentCount,
+		&i.ProjectID,
+		&i.UserID,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const deleteAccount = `-- name: DeleteAccount :exec
-DELETE FROM accounts WHERE id = $1
-`
-
-func (q *Queries) DeleteAccount(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteAccount, id)
-	return err
-}
-
-const deleteCategory = `-- name: DeleteCategory :exec
-DELETE FROM categories WHERE id = $1
+const getTransactionCube = `-- name: GetTransactionCube :many
+SELECT
+    COALESCE(row_key, '')::text AS row_key,
+    COALESCE(col_key, '')::text AS col_key,
+    asset,
+    GROUPING(row_key, col_key)::int AS grouping_id,
+    COUNT(*)::bigint AS count,
+    SUM(amount)::bigint AS sum
+FROM (
+    SELECT
+        CASE $1::text
+            WHEN 'category' THEN t.category_id::text
+            WHEN 'account' THEN t.account_id::text
+            WHEN 'payee' THEN t.payee
+            WHEN 'month' THEN to_char(t.date, 'YYYY-MM')
+        END AS row_key,
+        CASE $2::text
+            WHEN 'category' THEN t.category_id::text
+            WHEN 'account' THEN t.account_id::text
+            WHEN 'payee' THEN t.payee
+            WHEN 'month' THEN to_char(t.date, 'YYYY-MM')
+        END AS col_key,
+        a.asset,
+        CASE WHEN c.type = 'expense' THEN -ABS(t.amount) ELSE ABS(t.amount) END AS amount
+    FROM transactions t
+    JOIN accounts a ON t.account_id = a.id
+    JOIN categories c ON t.category_id = c.id
+    WHERE t.deleted_at IS NULL AND t.status IN ('pending', 'cleared') AND t.date >= $3 AND t.date <= $4 AND a.book_id = $5 AND ($6::uuid IS NULL OR t.user_id = $6)
+) cells
+GROUP BY GROUPING SETS ((row_key, col_key, asset), (row_key, asset), (col_key, asset), (asset))
+ORDER BY asset, grouping_id, row_key, col_key
 `
 
-func (q *Queries) DeleteCategory(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteCategory, id)
-	return err
+type GetTransactionCubeRow struct {
+	RowKey     string `json:"rowKey"`
+	ColKey     string `json:"colKey"`
+	Asset      string `json:"asset"`
+	GroupingID int32  `json:"groupingId"`
+	Count      int64  `json:"count"`
+	Sum        int64  `json:"sum"`
 }
 
-const deleteTransaction = `-- name: DeleteTransaction :exec
-DELETE FROM transactions WHERE id = $1
-`
-
-func (q *Queries) DeleteTransaction(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteTransaction, id)
-	return err
+// Pivots the pending and cleared transactions by two dimensions with grouping
+// sets: grouping_id is 0 for the cells, 1 for the row totals, 2 for the column
+// totals and 3 for the grand total, each per asset.
+func (q *Queries) GetTransactionCube(ctx context.Context, rowDimension string, colDimension string, fromDate pgtype.Date, toDate pgtype.Date, bookID uuid.UUID, userID *uuid.UUID) ([]GetTransactionCubeRow, error) {
+	rows, err := q.db.Query(ctx, getTransactionCube,
+		rowDimension,
+		colDimension,
+		fromDate,
+		toDate,
+		bookID,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTransactionCubeRow
+	for rows.Next() {
+		var i GetTransactionCubeRow
+		if err := rows.Scan(
+			&i.RowKey,
+			&i.ColKey,
+			&i.Asset,
+			&i.GroupingID,
+			&i.Count,
+			&i.Sum,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getAccountByID = `-- name: GetAccountByID :one
-SELECT id, name, type, description, asset, created_at, updated_at
-FROM accounts
+const getTransactionScriptByID = `-- name: GetTransactionScriptByID :one
+SELECT id, book_id, name, source, enabled, created_at, updated_at
+FROM transaction_scripts
 WHERE id = $1
 `
 
-func (q *Queries) GetAccountByID(ctx context.Context, id uuid.UUID) (Account, error) {
-	row := q.db.QueryRow(ctx, getAccountByID, id)
-	var i Account
+func (q *Queries) GetTransactionScriptByID(ctx context.Context, id uuid.UUID) (TransactionScript, error) {
+	row := q.db.QueryRow(ctx, getTransactionScriptByID, id)
+	var i TransactionScript
 	err := row.Scan(
 		&i.ID,
+		&i.BookID,
 		&i.Name,
-		&i.Type,
-		&i.Description,
-		&i.Asset,
+		&i.Source,
+		&i.Enabled,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const getAccountWithBalance = `-- name: GetAccountWithBalance :one
+const getTransactionTags = `-- name: GetTransactionTags :many
+SELECT tt.transaction_id, g.name
+FROM transaction_tags tt
+JOIN tags g ON g.id = tt.tag_id
+WHERE tt.transaction_id = ANY($1::uuid[])
+ORDER BY g.name
+`
+
+type GetTransactionTagsRow struct {
+	TransactionID uuid.UUID `json:"transaction_id"`
+	Name          string    `json:"name"`
+}
+
+func (q *Queries) GetTransactionTags(ctx context.Context, transactionIds []uuid.UUID) ([]GetTransactionTagsRow, error) {
+	rows, err := q.db.Query(ctx, getTransactionTags, transactionIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTransactionTagsRow
+	for rows.Next() {
+		var i GetTransactionTagsRow
+		if err := rows.Scan(
+			&i.TransactionID,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTransactionWithDetails = `-- name: GetTransactionWithDetails :one
+
 SELECT 
-    a.id, a.name, a.type, a.description, a.asset, a.created_at, a.updated_at,
-    COALESCE(b.current_balance, 0) as current_balance,
-    COALESCE(b.pending_balance, 0) as pending_balance,
-    COALESCE(b.available_balance, 0) as available_balance
-FROM accounts a
-LEFT JOIN balances b ON a.id = b.account_id
-WHERE a.id = $1
+    t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee,
+    t.installment_plan_id, t.installment_number, t.installment_count, t.project_id, t.user_id,
+    a.name as account_name, a.type as account_type, a.asset as account_asset,
+    c.name as category_name, c.type as category_type, c.color as category_color
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+JOIN categories c ON t.category_id = c.id
+WHERE t.id = $1 AND t.deleted_at IS NULL AND a.book_id = $2
 `
 
-type GetAccountWithBalanceRow struct {
-	ID               uuid.UUID `json:"id"`
-	Name             string    `json:"name"`
-	Type             string    `json:"type"`
-	Description      string    `json:"description"`
-	Asset            string    `json:"asset"`
-	CreatedAt        time.Time `json:"createdAt"`
-	UpdatedAt        time.Time `json:"updatedAt"`
-	CurrentBalance   int64     `json:"currentBalance"`
-	PendingBalance   int64     `json:"pendingBalance"`
-	AvailableBalance int64     `json:"availableBalance"`
+type GetTransactionWithDetailsRow struct {
+	ID                uuid.UUID   `json:"id"`
+	AccountID         uuid.UUID   `json:"accountId"`
+	CategoryID        uuid.UUID   `json:"categoryId"`
+	Amount            int64       `json:"amount"`
+	Description       string      `json:"description"`
+	Date              pgtype.Date `json:"date"`
+	Status            string      `json:"status"`
+	CreatedAt         time.Time   `json:"createdAt"`
+	UpdatedAt         time.Time   `json:"updatedAt"`
+	Payee             string      `json:"payee"`
+	InstallmentPlanID *uuid.UUID  `json:"installmentPlanId"`
+	InstallmentNumber int32       `json:"installmentNumber"`
+	InstallmentCount  int32       `json:"installmentCount"`
+	ProjectID         *uuid.UUID  `json:"projectId"`
+	UserID            *uuid.UUID  `json:"userId"`
+	AccountName       string      `json:"accountName"`
+	AccountType       string      `json:"accountType"`
+	AccountAsset      string      `json:"accountAsset"`
+	CategoryName      string      `json:"categoryName"`
+	CategoryType      string      `json:"categoryType"`
+	CategoryColor     string      `json:"categoryColor"`
 }
 
-func (q *Queries) GetAccountWithBalance(ctx context.Context, id uuid.UUID) (GetAccountWithBalanceRow, error) {
-	row := q.db.QueryRow(ctx, getAccountWithBalance, id)
-	var i GetAccountWithBalanceRow
+// =============================================================================
+// JOINED QUERIES FOR DETAILED VIEWS
+// =============================================================================
+func (q *Queries) GetTransactionWithDetails(ctx context.Context, id uuid.UUID, bookID uuid.UUID) (GetTransactionWithDetailsRow, error) {
+	row := q.db.QueryRow(ctx, getTransactionWithDetails, id, bookID)
+	var i GetTransactionWithDetailsRow
 	err := row.Scan(
 		&i.ID,
-		&i.Name,
-		&i.Type,
+		&i.AccountID,
+		&i.CategoryID,
+		&i.Amount,
 		&i.Description,
-		&i.Asset,
+		&i.Date,
+		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
-		&i.CurrentBalance,
-		&i.PendingBalance,
-		&i.AvailableBalance,
+		&i.Payee,
+		&i.InstallmentPlanID,
+		&i.InstallmentNumber,
+		&i.InstallmentCount,
+		&i.ProjectID,
+		&i.UserID,
+		&i.AccountName,
+		&i.AccountType,
+		&i.AccountAsset,
+		&i.CategoryName,
+		&i.CategoryType,
+		&i.CategoryColor,
 	)
 	return i, err
 }
 
-const getAccountsWithBalances = `-- name: GetAccountsWithBalances :many
-SELECT 
-    a.id, a.name, a.type, a.description, a.asset, a.created_at, a.updated_at,
-    COALESCE(b.current_balance, 0) as current_balance,
-    COALESCE(b.pending_balance, 0) as pending_balance,
-    COALESCE(b.available_balance, 0) as available_balance
-FROM accounts a
-LEFT JOIN balances b ON a.id = b.account_id
-ORDER BY a.name
+const getTransactionsByAccount = `-- name: GetTransactionsByAccount :many
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
+FROM transactions
+WHERE account_id = $1 AND deleted_at IS NULL AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY date DESC, created_at DESC
 `
 
-type GetAccountsWithBalancesRow struct {
-	ID               uuid.UUID `json:"id"`
-	Name             string    `json:"name"`
-	Type             string    `json:"type"`
-	Description      string    `json:"description"`
-	Asset            string    `json:"asset"`
-	CreatedAt        time.Time `json:"createdAt"`
-	UpdatedAt        time.Time `json:"updatedAt"`
-	CurrentBalance   int64     `json:"currentBalance"`
-	PendingBalance   int64     `json:"pendingBalance"`
-	AvailableBalance int64     `json:"availableBalance"`
+func (q *Queries) GetTransactionsByAccount(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID) ([]Transaction, error) {
+	rows, err := q.db.Query(ctx, getTransactionsByAccount, accountID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Transaction
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.CategoryID,
+			&i.Amount,
+			&i.Description,
+			&i.Date,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Payee,
+			&i.InstallmentPlanID,
+			&i.InstallmentNumber,
+			&i.InstallmentCount,
+			&i.ProjectID,
+			&i.UserID,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) GetAccountsWithBalances(ctx context.Context) ([]GetAccountsWithBalancesRow, error) {
-	rows, err := q.db.Query(ctx, getAccountsWithBalances)
+const getTransactionsByAccountAndDateRange = `-- name: GetTransactionsByAccountAndDateRange :many
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
+FROM transactions
+WHERE account_id = $1 AND deleted_at IS NULL AND date >= $2 AND date <= $3 AND ($4::uuid IS NULL OR user_id = $4)
+ORDER BY date DESC, created_at DESC
+`
+
+func (q *Queries) GetTransactionsByAccountAndDateRange(ctx context.Context, accountID uuid.UUID, date pgtype.Date, date_2 pgtype.Date, userID *uuid.UUID) ([]Transaction, error) {
+	rows, err := q.db.Query(ctx, getTransactionsByAccountAndDateRange,
+		accountID,
+		date,
+		date_2,
+		userID,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetAccountsWithBalancesRow
+	var items []Transaction
 	for rows.Next() {
-		var i GetAccountsWithBalancesRow
+		var i Transaction
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.Type,
+			&i.AccountID,
+			&i.CategoryID,
+			&i.Amount,
 			&i.Description,
-			&i.Asset,
+			&i.Date,
+			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
-			&i.CurrentBalance,
-			&i.PendingBalance,
-			&i.AvailableBalance,
+			&i.Payee,
+			&i.InstallmentPlanID,
+			&i.InstallmentNumber,
+			&i.InstallmentCount,
+			&i.ProjectID,
+			&i.UserID,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -252,29 +3370,39 @@ func (q *Queries) GetAccountsWithBalances(ctx context.Context) ([]GetAccountsWit
 	return items, nil
 }
 
-const getAllAccounts = `-- name: GetAllAccounts :many
-SELECT id, name, type, description, asset, created_at, updated_at
-FROM accounts
-ORDER BY name
+const getTransactionsByCategory = `-- name: GetTransactionsByCategory :many
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
+FROM transactions
+WHERE category_id = $1 AND deleted_at IS NULL AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY date DESC, created_at DESC
 `
 
-func (q *Queries) GetAllAccounts(ctx context.Context) ([]Account, error) {
-	rows, err := q.db.Query(ctx, getAllAccounts)
+func (q *Queries) GetTransactionsByCategory(ctx context.Context, categoryID uuid.UUID, userID *uuid.UUID) ([]Transaction, error) {
+	rows, err := q.db.Query(ctx, getTransactionsByCategory, categoryID, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Account
+	var items []Transaction
 	for rows.Next() {
-		var i Account
+		var i Transaction
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.Type,
+			&i.AccountID,
+			&i.CategoryID,
+			&i.Amount,
 			&i.Description,
-			&i.Asset,
+			&i.Date,
+			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Payee,
+			&i.InstallmentPlanID,
+			&i.InstallmentNumber,
+			&i.InstallmentCount,
+			&i.ProjectID,
+			&i.UserID,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -286,27 +3414,45 @@ func (q *Queries) GetAllAccounts(ctx context.Context) ([]Account, error) {
 	return items, nil
 }
 
-const getAllBalances = `-- name: GetAllBalances :many
-SELECT account_id, current_balance, pending_balance, available_balance, last_calculated
-FROM balances
-ORDER BY account_id
+const getTransactionsByDateRange = `-- name: GetTransactionsByDateRange :many
+SELECT t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee, t.installment_plan_id, t.installment_number, t.installment_count, t.project_id, t.user_id
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE t.deleted_at IS NULL AND t.date >= $1 AND t.date <= $2 AND a.book_id = $3 AND ($4::uuid IS NULL OR t.user_id = $4)
+ORDER BY t.date DESC, t.created_at DESC
 `
 
-func (q *Queries) GetAllBalances(ctx context.Context) ([]Balance, error) {
-	rows, err := q.db.Query(ctx, getAllBalances)
+func (q *Queries) GetTransactionsByDateRange(ctx context.Context, date pgtype.Date, date_2 pgtype.Date, bookID uuid.UUID, userID *uuid.UUID) ([]Transaction, error) {
+	rows, err := q.db.Query(ctx, getTransactionsByDateRange,
+		date,
+		date_2,
+		bookID,
+		userID,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Balance
+	var items []Transaction
 	for rows.Next() {
-		var i Balance
+		var i Transaction
 		if err := rows.Scan(
+			&i.ID,
 			&i.AccountID,
-			&i.CurrentBalance,
-			&i.PendingBalance,
-			&i.AvailableBalance,
-			&i.LastCalculated,
+			&i.CategoryID,
+			&i.Amount,
+			&i.Description,
+			&i.Date,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Payee,
+			&i.InstallmentPlanID,
+			&i.InstallmentNumber,
+			&i.InstallmentCount,
+			&i.ProjectID,
+			&i.UserID,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -318,29 +3464,39 @@ func (q *Queries) GetAllBalances(ctx context.Context) ([]Balance, error) {
 	return items, nil
 }
 
-const getAllCategories = `-- name: GetAllCategories :many
-SELECT id, name, type, description, color, created_at, updated_at
-FROM categories
-ORDER BY type, name
+const getTransactionsByInstallmentPlan = `-- name: GetTransactionsByInstallmentPlan :many
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
+FROM transactions
+WHERE installment_plan_id = $1 AND deleted_at IS NULL AND ($2::uuid IS NULL OR user_id = $2)
+ORDER BY installment_number, date
 `
 
-func (q *Queries) GetAllCategories(ctx context.Context) ([]Category, error) {
-	rows, err := q.db.Query(ctx, getAllCategories)
+func (q *Queries) GetTransactionsByInstallmentPlan(ctx context.Context, installmentPlanID *uuid.UUID, userID *uuid.UUID) ([]Transaction, error) {
+	rows, err := q.db.Query(ctx, getTransactionsByInstallmentPlan, installmentPlanID, userID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Category
+	var items []Transaction
 	for rows.Next() {
-		var i Category
+		var i Transaction
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.Type,
+			&i.AccountID,
+			&i.CategoryID,
+			&i.Amount,
 			&i.Description,
-			&i.Color,
+			&i.Date,
+			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Payee,
+			&i.InstallmentPlanID,
+			&i.InstallmentNumber,
+			&i.InstallmentCount,
+			&i.ProjectID,
+			&i.UserID,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -352,14 +3508,15 @@ func (q *Queries) GetAllCategories(ctx context.Context) ([]Category, error) {
 	return items, nil
 }
 
-const getAllTransactions = `-- name: GetAllTransactions :many
-SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at
+const getTransactionsByProject = `-- name: GetTransactionsByProject :many
+SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
 FROM transactions
+WHERE project_id = $1 AND deleted_at IS NULL AND ($2::uuid IS NULL OR user_id = $2)
 ORDER BY date DESC, created_at DESC
 `
 
-func (q *Queries) GetAllTransactions(ctx context.Context) ([]Transaction, error) {
-	rows, err := q.db.Query(ctx, getAllTransactions)
+func (q *Queries) GetTransactionsByProject(ctx context.Context, projectID *uuid.UUID, userID *uuid.UUID) ([]Transaction, error) {
+	rows, err := q.db.Query(ctx, getTransactionsByProject, projectID, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -377,6 +3534,13 @@ func (q *Queries) GetAllTransactions(ctx context.Context) ([]Transaction, error)
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Payee,
+			&i.InstallmentPlanID,
+			&i.InstallmentNumber,
+			&i.InstallmentCount,
+			&i.ProjectID,
+			&i.UserID,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -388,313 +3552,329 @@ func (q *Queries) GetAllTransactions(ctx context.Context) ([]Transaction, error)
 	return items, nil
 }
 
-const getBalanceByAccountID = `-- name: GetBalanceByAccountID :one
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT id, email, name, password_hash, created_at, updated_at, tier
+FROM users
+WHERE email = $1
+`
 
-SELECT account_id, current_balance, pending_balance, available_balance, last_calculated
-FROM balances
-WHERE account_id = $1
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, email)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Tier,
+	)
+	return i, err
+}
+
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, email, name, password_hash, created_at, updated_at, tier
+FROM users
+WHERE id = $1
 `
 
-// =============================================================================
-// BALANCES
-// =============================================================================
-func (q *Queries) GetBalanceByAccountID(ctx context.Context, accountID uuid.UUID) (Balance, error) {
-	row := q.db.QueryRow(ctx, getBalanceByAccountID, accountID)
-	var i Balance
+func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByID, id)
+	var i User
 	err := row.Scan(
-		&i.AccountID,
-		&i.CurrentBalance,
-		&i.PendingBalance,
-		&i.AvailableBalance,
-		&i.LastCalculated,
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Tier,
 	)
 	return i, err
 }
 
-const getBalanceSummary = `-- name: GetBalanceSummary :one
-SELECT 
-    COALESCE(SUM(CASE WHEN a.type IN ('checking', 'savings', 'investment', 'cash') THEN b.current_balance ELSE 0 END), 0) as total_assets,
-    COALESCE(SUM(CASE WHEN a.type = 'credit' THEN ABS(b.current_balance) ELSE 0 END), 0) as total_liabilities,
-    COALESCE(SUM(CASE WHEN a.type IN ('checking', 'savings', 'investment', 'cash') THEN b.current_balance ELSE -ABS(b.current_balance) END), 0) as net_worth,
-    NOW() as last_calculated
-FROM balances b
-JOIN accounts a ON b.account_id = a.id
+const getUserUsage = `-- name: GetUserUsage :one
+SELECT
+    tier,
+    (SELECT COUNT(*) FROM books WHERE books.user_id = users.id) AS books,
+    (SELECT COUNT(*) FROM accounts WHERE accounts.user_id = users.id) AS accounts,
+    (SELECT COUNT(*) FROM categories WHERE categories.user_id = users.id) AS categories,
+    (SELECT COUNT(*) FROM budgets WHERE budgets.user_id = users.id) AS budgets,
+    (SELECT COUNT(*) FROM transactions WHERE transactions.user_id = users.id AND transactions.deleted_at IS NULL) AS transactions,
+    (SELECT COUNT(*) FROM transactions WHERE transactions.user_id = users.id AND transactions.created_at >= $2) AS monthly_transactions,
+    (SELECT COALESCE(SUM(calls), 0)::BIGINT FROM api_usage WHERE api_usage.user_id = users.id AND api_usage.month >= $2::DATE) AS monthly_api_calls,
+    (SELECT COALESCE(SUM(attachments.size), 0)::BIGINT FROM attachments JOIN transactions ON attachments.transaction_id = transactions.id WHERE transactions.user_id = users.id) AS attachment_bytes
+FROM users
+WHERE id = $1
 `
 
-type GetBalanceSummaryRow struct {
-	TotalAssets      interface{} `json:"totalAssets"`
-	TotalLiabilities interface{} `json:"totalLiabilities"`
-	NetWorth         interface{} `json:"netWorth"`
-	LastCalculated   interface{} `json:"lastCalculated"`
+type GetUserUsageRow struct {
+	Tier                string `json:"tier"`
+	Books               int64  `json:"books"`
+	Accounts            int64  `json:"accounts"`
+	Categories          int64  `json:"categories"`
+	Budgets             int64  `json:"budgets"`
+	Transactions        int64  `json:"transactions"`
+	MonthlyTransactions int64  `json:"monthly_transactions"`
+	MonthlyApiCalls     int64  `json:"monthly_api_calls"`
+	AttachmentBytes     int64  `json:"attachment_bytes"`
 }
 
-func (q *Queries) GetBalanceSummary(ctx context.Context) (GetBalanceSummaryRow, error) {
-	row := q.db.QueryRow(ctx, getBalanceSummary)
-	var i GetBalanceSummaryRow
+func (q *Queries) GetUserUsage(ctx context.Context, id uuid.UUID, createdAt time.Time) (GetUserUsageRow, error) {
+	row := q.db.QueryRow(ctx, getUserUsage, id, createdAt)
+	var i GetUserUsageRow
 	err := row.Scan(
-		&i.TotalAssets,
-		&i.TotalLiabilities,
-		&i.NetWorth,
-		&i.LastCalculated,
+		&i.Tier,
+		&i.Books,
+		&i.Accounts,
+		&i.Categories,
+		&i.Budgets,
+		&i.Transactions,
+		&i.MonthlyTransactions,
+		&i.MonthlyApiCalls,
+		&i.AttachmentBytes,
 	)
 	return i, err
 }
 
-const getCategoriesByType = `-- name: GetCategoriesByType :many
-SELECT id, name, type, description, color, created_at, updated_at
-FROM categories
-WHERE type = $1
-ORDER BY name
+const lockLogin = `-- name: LockLogin :exec
+UPDATE login_failures
+SET locked_until = $3
+WHERE scope = $1 AND key = $2
 `
 
-func (q *Queries) GetCategoriesByType(ctx context.Context, type_ string) ([]Category, error) {
-	rows, err := q.db.Query(ctx, getCategoriesByType, type_)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Category
-	for rows.Next() {
-		var i Category
-		if err := rows.Scan(
-			&i.ID,
-			&i.Name,
-			&i.Type,
-			&i.Description,
-			&i.Color,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+func (q *Queries) LockLogin(ctx context.Context, scope string, key string, lockedUntil *time.Time) error {
+	_, err := q.db.Exec(ctx, lockLogin, scope, key, lockedUntil)
+	return err
 }
 
-const getCategoryByID = `-- name: GetCategoryByID :one
-SELECT id, name, type, description, color, created_at, updated_at
-FROM categories
+const markInstallmentPlanPaidOff = `-- name: MarkInstallmentPlanPaidOff :one
+UPDATE installment_plans
+SET paid_off_on = $2, updated_at = NOW()
 WHERE id = $1
+RETURNING id, account_id, description, amount, installment_count, first_date, paid_off_on, created_at, updated_at
 `
 
-func (q *Queries) GetCategoryByID(ctx context.Context, id uuid.UUID) (Category, error) {
-	row := q.db.QueryRow(ctx, getCategoryByID, id)
-	var i Category
+func (q *Queries) MarkInstallmentPlanPaidOff(ctx context.Context, iD uuid.UUID, paidOffOn pgtype.Date) (InstallmentPlan, error) {
+	row := q.db.QueryRow(ctx, markInstallmentPlanPaidOff, iD, paidOffOn)
+	var i InstallmentPlan
 	err := row.Scan(
 		&i.ID,
-		&i.Name,
-		&i.Type,
+		&i.AccountID,
 		&i.Description,
-		&i.Color,
+		&i.Amount,
+		&i.InstallmentCount,
+		&i.FirstDate,
+		&i.PaidOffOn,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const getTransactionByID = `-- name: GetTransactionByID :one
-SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at
-FROM transactions
+const markRestorePointRolledBack = `-- name: MarkRestorePointRolledBack :one
+UPDATE restore_points
+SET rolled_back_at = NOW()
 WHERE id = $1
+RETURNING id, book_id, operation, description, changes, created_at, rolled_back_at
 `
 
-func (q *Queries) GetTransactionByID(ctx context.Context, id uuid.UUID) (Transaction, error) {
-	row := q.db.QueryRow(ctx, getTransactionByID, id)
-	var i Transaction
+func (q *Queries) MarkRestorePointRolledBack(ctx context.Context, id uuid.UUID) (RestorePoint, error) {
+	row := q.db.QueryRow(ctx, markRestorePointRolledBack, id)
+	var i RestorePoint
 	err := row.Scan(
 		&i.ID,
-		&i.AccountID,
-		&i.CategoryID,
-		&i.Amount,
+		&i.BookID,
+		&i.Operation,
 		&i.Description,
-		&i.Date,
-		&i.Status,
+		&i.Changes,
 		&i.CreatedAt,
-		&i.UpdatedAt,
+		&i.RolledBackAt,
 	)
 	return i, err
 }
 
-const getTransactionWithDetails = `-- name: GetTransactionWithDetails :one
+const recordBudgetAlert = `-- name: RecordBudgetAlert :one
 
-SELECT 
-    t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at,
-    a.name as account_name, a.type as account_type, a.asset as account_asset,
-    c.name as category_name, c.type as category_type, c.color as category_color
-FROM transactions t
-JOIN accounts a ON t.account_id = a.id
-JOIN categories c ON t.category_id = c.id
-WHERE t.id = $1
+INSERT INTO budget_alerts (book_id, user_id, budget_id, category_id, month, threshold, percent, asset, spent, limit_amount)
+SELECT book_id, user_id, id, category_id, $2, $3, $4, asset, $5, amount
+FROM budgets
+WHERE id = $1
+ON CONFLICT (budget_id, month, threshold) DO NOTHING
+RETURNING id, book_id, user_id, budget_id, category_id, month, threshold, percent, asset, spent, limit_amount, created_at
 `
 
-type GetTransactionWithDetailsRow struct {
-	ID            uuid.UUID   `json:"id"`
-	AccountID     uuid.UUID   `json:"accountId"`
-	CategoryID    uuid.UUID   `json:"categoryId"`
-	Amount        int64       `json:"amount"`
-	Description   string      `json:"description"`
-	Date          pgtype.Date `json:"date"`
-	Status        string      `json:"status"`
-	CreatedAt     time.Time   `json:"createdAt"`
-	UpdatedAt     time.Time   `json:"updatedAt"`
-	AccountName   string      `json:"accountName"`
-	AccountType   string      `json:"accountType"`
-	AccountAsset  string      `json:"accountAsset"`
-	CategoryName  string      `json:"categoryName"`
-	CategoryType  string      `json:"categoryType"`
-	CategoryColor string      `json:"categoryColor"`
-}
-
 // =============================================================================
-// JOINED QUERIES FOR DETAILED VIEWS
+// BUDGET ALERTS
 // =============================================================================
-func (q *Queries) GetTransactionWithDetails(ctx context.Context, id uuid.UUID) (GetTransactionWithDetailsRow, error) {
-	row := q.db.QueryRow(ctx, getTransactionWithDetails, id)
-	var i GetTransactionWithDetailsRow
+func (q *Queries) RecordBudgetAlert(ctx context.Context, budgetID uuid.UUID, month pgtype.Date, threshold int32, percent float64, spent int64) (BudgetAlert, error) {
+	row := q.db.QueryRow(ctx, recordBudgetAlert,
+		budgetID,
+		month,
+		threshold,
+		percent,
+		spent,
+	)
+	var i BudgetAlert
 	err := row.Scan(
 		&i.ID,
-		&i.AccountID,
+		&i.BookID,
+		&i.UserID,
+		&i.BudgetID,
 		&i.CategoryID,
-		&i.Amount,
-		&i.Description,
-		&i.Date,
-		&i.Status,
+		&i.Month,
+		&i.Threshold,
+		&i.Percent,
+		&i.Asset,
+		&i.Spent,
+		&i.LimitAmount,
 		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.AccountName,
-		&i.AccountType,
-		&i.AccountAsset,
-		&i.CategoryName,
-		&i.CategoryType,
-		&i.CategoryColor,
 	)
 	return i, err
 }
 
-const getTransactionsByAccount = `-- name: GetTransactionsByAccount :many
-SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at
-FROM transactions
-WHERE account_id = $1
-ORDER BY date DESC, created_at DESC
+const recordLoginFailure = `-- name: RecordLoginFailure :one
+INSERT INTO login_failures (scope, key, failures, last_failed_at)
+VALUES ($1, $2, 1, NOW())
+ON CONFLICT (scope, key) DO UPDATE
+SET failures = CASE WHEN login_failures.last_failed_at < $3 THEN 1 ELSE login_failures.failures + 1 END,
+    last_failed_at = NOW()
+RETURNING scope, key, failures, last_failed_at, locked_until
 `
 
-func (q *Queries) GetTransactionsByAccount(ctx context.Context, accountID uuid.UUID) ([]Transaction, error) {
-	rows, err := q.db.Query(ctx, getTransactionsByAccount, accountID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Transaction
-	for rows.Next() {
-		var i Transaction
-		if err := rows.Scan(
-			&i.ID,
-			&i.AccountID,
-			&i.CategoryID,
-			&i.Amount,
-			&i.Description,
-			&i.Date,
-			&i.Status,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+func (q *Queries) RecordLoginFailure(ctx context.Context, scope string, key string, since time.Time) (LoginFailure, error) {
+	row := q.db.QueryRow(ctx, recordLoginFailure, scope, key, since)
+	var i LoginFailure
+	err := row.Scan(
+		&i.Scope,
+		&i.Key,
+		&i.Failures,
+		&i.LastFailedAt,
+		&i.LockedUntil,
+	)
+	return i, err
 }
 
-const getTransactionsByAccountAndDateRange = `-- name: GetTransactionsByAccountAndDateRange :many
-SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at
-FROM transactions
-WHERE account_id = $1 AND date >= $2 AND date <= $3
-ORDER BY date DESC, created_at DESC
+const refreshAccountBalance = `-- name: RefreshAccountBalance :exec
+SELECT update_account_balance($1)
 `
 
-func (q *Queries) GetTransactionsByAccountAndDateRange(ctx context.Context, accountID uuid.UUID, date pgtype.Date, date_2 pgtype.Date) ([]Transaction, error) {
-	rows, err := q.db.Query(ctx, getTransactionsByAccountAndDateRange, accountID, date, date_2)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Transaction
-	for rows.Next() {
-		var i Transaction
-		if err := rows.Scan(
-			&i.ID,
-			&i.AccountID,
-			&i.CategoryID,
-			&i.Amount,
-			&i.Description,
-			&i.Date,
-			&i.Status,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+func (q *Queries) RefreshAccountBalance(ctx context.Context, accountUuid uuid.UUID) error {
+	_, err := q.db.Exec(ctx, refreshAccountBalance, accountUuid)
+	return err
 }
 
-const getTransactionsByCategory = `-- name: GetTransactionsByCategory :many
-SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at
-FROM transactions
-WHERE category_id = $1
-ORDER BY date DESC, created_at DESC
+const removeExpenseReportTransaction = `-- name: RemoveExpenseReportTransaction :execrows
+DELETE FROM expense_report_transactions
+WHERE expense_report_id = $1 AND transaction_id = $2
 `
 
-func (q *Queries) GetTransactionsByCategory(ctx context.Context, categoryID uuid.UUID) ([]Transaction, error) {
-	rows, err := q.db.Query(ctx, getTransactionsByCategory, categoryID)
+func (q *Queries) RemoveExpenseReportTransaction(ctx context.Context, expenseReportID uuid.UUID, transactionID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, removeExpenseReportTransaction, expenseReportID, transactionID)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	defer rows.Close()
-	var items []Transaction
-	for rows.Next() {
-		var i Transaction
-		if err := rows.Scan(
-			&i.ID,
-			&i.AccountID,
-			&i.CategoryID,
-			&i.Amount,
-			&i.Description,
-			&i.Date,
-			&i.Status,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
+	return result.RowsAffected(), nil
+}
+
+const restoreTransaction = `-- name: RestoreTransaction :one
+UPDATE transactions t
+SET deleted_at = NULL, updated_at = NOW()
+FROM accounts a
+WHERE t.id = $1 AND t.deleted_at IS NOT NULL AND t.account_id = a.id AND a.book_id = $2 AND ($3::uuid IS NULL OR t.user_id = $3)
+RETURNING t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee, t.installment_plan_id, t.installment_number, t.installment_count, t.project_id, t.user_id, t.deleted_at
+`
+
+func (q *Queries) RestoreTransaction(ctx context.Context, id uuid.UUID, bookID uuid.UUID, userID *uuid.UUID) (Transaction, error) {
+	row := q.db.QueryRow(ctx, restoreTransaction, id, bookID, userID)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.CategoryID,
+		&i.Amount,
+		&i.Description,
+		&i.Date,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Payee,
+		&i.InstallmentPlanID,
+		&i.InstallmentNumber,
+		&i.InstallmentCount,
+		&i.ProjectID,
+		&i.UserID,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const revokeOtherSessions = `-- name: RevokeOtherSessions :execrows
+UPDATE sessions
+SET revoked_at = NOW()
+WHERE user_id = $1 AND id <> $2 AND revoked_at IS NULL AND expires_at > NOW()
+`
+
+func (q *Queries) RevokeOtherSessions(ctx context.Context, userID uuid.UUID, iD uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeOtherSessions, userID, iD)
+	if err != nil {
+		return 0, err
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+	return result.RowsAffected(), nil
+}
+
+const revokeSession = `-- name: RevokeSession :execrows
+UPDATE sessions
+SET revoked_at = NOW()
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > NOW()
+`
+
+func (q *Queries) RevokeSession(ctx context.Context, iD uuid.UUID, userID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeSession, iD, userID)
+	if err != nil {
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const getTransactionsByDateRange = `-- name: GetTransactionsByDateRange :many
-SELECT id, account_id, category_id, amount, description, date, status, created_at, updated_at
-FROM transactions
-WHERE date >= $1 AND date <= $2
-ORDER BY date DESC, created_at DESC
+const saveIdempotentResponse = `-- name: SaveIdempotentResponse :exec
+UPDATE idempotency_keys
+SET status_code = $3, content_type = $4, body = $5
+WHERE user_id IS NOT DISTINCT FROM $1 AND key = $2
+`
+
+func (q *Queries) SaveIdempotentResponse(ctx context.Context, userID *uuid.UUID, key string, statusCode int32, contentType string, body []byte) error {
+	_, err := q.db.Exec(ctx, saveIdempotentResponse,
+		userID,
+		key,
+		statusCode,
+		contentType,
+		body,
+	)
+	return err
+}
+
+const searchTransactions = `-- name: SearchTransactions :many
+SELECT t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee, t.installment_plan_id, t.installment_number, t.installment_count, t.project_id, t.user_id, t.deleted_at
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+WHERE a.book_id = $1 AND t.deleted_at IS NULL AND ($2::uuid IS NULL OR t.user_id = $2)
+    AND (setweight(to_tsvector('simple', t.description), 'A') || setweight(to_tsvector('simple', t.payee), 'B')) @@ to_tsquery('simple', $3)
+ORDER BY ts_rank(setweight(to_tsvector('simple', t.description), 'A') || setweight(to_tsvector('simple', t.payee), 'B'), to_tsquery('simple', $3)) DESC, t.date DESC, t.id
+LIMIT $4 OFFSET $5
 `
 
-func (q *Queries) GetTransactionsByDateRange(ctx context.Context, date pgtype.Date, date_2 pgtype.Date) ([]Transaction, error) {
-	rows, err := q.db.Query(ctx, getTransactionsByDateRange, date, date_2)
+// Matches the description and payee against a tsquery with the expression of
+// idx_transactions_search, the best ranked first.
+func (q *Queries) SearchTransactions(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID, query string, limit int32, offset int32) ([]Transaction, error) {
+	rows, err := q.db.Query(ctx, searchTransactions,
+		bookID,
+		userID,
+		query,
+		limit,
+		offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -712,6 +3892,13 @@ func (q *Queries) GetTransactionsByDateRange(ctx context.Context, date pgtype.Da
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Payee,
+			&i.InstallmentPlanID,
+			&i.InstallmentNumber,
+			&i.InstallmentCount,
+			&i.ProjectID,
+			&i.UserID,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -723,95 +3910,88 @@ func (q *Queries) GetTransactionsByDateRange(ctx context.Context, date pgtype.Da
 	return items, nil
 }
 
-const getTransactionsWithDetails = `-- name: GetTransactionsWithDetails :many
-SELECT 
-    t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at,
-    a.name as account_name, a.type as account_type, a.asset as account_asset,
-    c.name as category_name, c.type as category_type, c.color as category_color
-FROM transactions t
-JOIN accounts a ON t.account_id = a.id
-JOIN categories c ON t.category_id = c.id
-ORDER BY t.date DESC, t.created_at DESC
-LIMIT $1 OFFSET $2
+const setAccountNumber = `-- name: SetAccountNumber :exec
+UPDATE accounts
+SET account_number_last4 = $2
+WHERE id = $1
+`
+
+func (q *Queries) SetAccountNumber(ctx context.Context, iD uuid.UUID, accountNumberLast4 string) error {
+	_, err := q.db.Exec(ctx, setAccountNumber, iD, accountNumberLast4)
+	return err
+}
+
+const setInvoiceTransaction = `-- name: SetInvoiceTransaction :one
+UPDATE invoices
+SET transaction_id = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, account_id, client, number, description, amount, issue_date, due_date, transaction_id, created_at, updated_at
 `
 
-type GetTransactionsWithDetailsRow struct {
-	ID            uuid.UUID   `json:"id"`
-	AccountID     uuid.UUID   `json:"accountId"`
-	CategoryID    uuid.UUID   `json:"categoryId"`
-	Amount        int64       `json:"amount"`
-	Description   string      `json:"description"`
-	Date          pgtype.Date `json:"date"`
-	Status        string      `json:"status"`
-	CreatedAt     time.Time   `json:"createdAt"`
-	UpdatedAt     time.Time   `json:"updatedAt"`
-	AccountName   string      `json:"accountName"`
-	AccountType   string      `json:"accountType"`
-	AccountAsset  string      `json:"accountAsset"`
-	CategoryName  string      `json:"categoryName"`
-	CategoryType  string      `json:"categoryType"`
-	CategoryColor string      `json:"categoryColor"`
+func (q *Queries) SetInvoiceTransaction(ctx context.Context, iD uuid.UUID, transactionID *uuid.UUID) (Invoice, error) {
+	row := q.db.QueryRow(ctx, setInvoiceTransaction, iD, transactionID)
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Client,
+		&i.Number,
+		&i.Description,
+		&i.Amount,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.TransactionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
 }
 
-func (q *Queries) GetTransactionsWithDetails(ctx context.Context, limit int32, offset int32) ([]GetTransactionsWithDetailsRow, error) {
-	rows, err := q.db.Query(ctx, getTransactionsWithDetails, limit, offset)
+const setUserTier = `-- name: SetUserTier :execrows
+UPDATE users SET tier = $2, updated_at = NOW()
+WHERE email = $1
+`
+
+func (q *Queries) SetUserTier(ctx context.Context, email string, tier string) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserTier, email, tier)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetTransactionsWithDetailsRow
-	for rows.Next() {
-		var i GetTransactionsWithDetailsRow
-		if err := rows.Scan(
-			&i.ID,
-			&i.AccountID,
-			&i.CategoryID,
-			&i.Amount,
-			&i.Description,
-			&i.Date,
-			&i.Status,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.AccountName,
-			&i.AccountType,
-			&i.AccountAsset,
-			&i.CategoryName,
-			&i.CategoryType,
-			&i.CategoryColor,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const refreshAccountBalance = `-- name: RefreshAccountBalance :exec
-SELECT update_account_balance($1)
+const touchSession = `-- name: TouchSession :exec
+UPDATE sessions
+SET last_seen_at = NOW()
+WHERE id = $1 AND last_seen_at < NOW() - INTERVAL '1 minute'
 `
 
-func (q *Queries) RefreshAccountBalance(ctx context.Context, accountUuid uuid.UUID) error {
-	_, err := q.db.Exec(ctx, refreshAccountBalance, accountUuid)
+func (q *Queries) TouchSession(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, touchSession, id)
 	return err
 }
 
 const updateAccount = `-- name: UpdateAccount :one
 UPDATE accounts
-SET name = $2, type = $3, description = $4, asset = $5, updated_at = NOW()
+SET name = $2, type = $3, description = $4, asset = $5, classification = $6, institution = $7, account_number_last4 = $8, color = $9, icon = $10, statement_closing_day = $11, payment_due_day = $12, updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, type, description, asset, created_at, updated_at
+RETURNING id, name, type, description, asset, created_at, updated_at, classification, institution, account_number_last4, color, icon, statement_closing_day, payment_due_day, book_id, user_id
 `
 
-func (q *Queries) UpdateAccount(ctx context.Context, iD uuid.UUID, name string, type_ string, description string, asset string) (Account, error) {
+func (q *Queries) UpdateAccount(ctx context.Context, iD uuid.UUID, name string, type_ string, description string, asset string, classification *string, institution string, accountNumberLast4 string, color string, icon string, statementClosingDay int32, paymentDueDay int32) (Account, error) {
 	row := q.db.QueryRow(ctx, updateAccount,
 		iD,
 		name,
 		type_,
 		description,
 		asset,
+		classification,
+		institution,
+		accountNumberLast4,
+		color,
+		icon,
+		statementClosingDay,
+		paymentDueDay,
 	)
 	var i Account
 	err := row.Scan(
@@ -822,24 +4002,117 @@ func (q *Queries) UpdateAccount(ctx context.Context, iD uuid.UUID, name string,
 		&i.Asset,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Classification,
+		&i.Institution,
+		&i.AccountNumberLast4,
+		&i.Color,
+		&i.Icon,
+		&i.StatementClosingDay,
+		&i.PaymentDueDay,
+		&i.BookID,
+		&i.UserID,
+	)
+	return i, err
+}
+
+const updateBook = `-- name: UpdateBook :one
+UPDATE books
+SET name = $2, description = $3, asset = $4, updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, description, created_at, updated_at, asset, user_id
+`
+
+func (q *Queries) UpdateBook(ctx context.Context, iD uuid.UUID, name string, description string, asset string) (Book, error) {
+	row := q.db.QueryRow(ctx, updateBook,
+		iD,
+		name,
+		description,
+		asset,
+	)
+	var i Book
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Asset,
+		&i.UserID,
+	)
+	return i, err
+}
+
+const updateBudget = `-- name: UpdateBudget :one
+UPDATE budgets
+SET category_id = $2, asset = $3, amount = $4, start_month = $5, end_month = $6, thresholds = $7, updated_at = NOW()
+WHERE id = $1
+RETURNING id, book_id, user_id, category_id, asset, amount, start_month, end_month, created_at, updated_at, thresholds
+`
+
+func (q *Queries) UpdateBudget(ctx context.Context, iD uuid.UUID, categoryID uuid.UUID, asset string, amount int64, startMonth pgtype.Date, endMonth pgtype.Date, thresholds []int32) (Budget, error) {
+	row := q.db.QueryRow(ctx, updateBudget,
+		iD,
+		categoryID,
+		asset,
+		amount,
+		startMonth,
+		endMonth,
+		thresholds,
+	)
+	var i Budget
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.UserID,
+		&i.CategoryID,
+		&i.Asset,
+		&i.Amount,
+		&i.StartMonth,
+		&i.EndMonth,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Thresholds,
+	)
+	return i, err
+}
+
+const updateBudgetTemplate = `-- name: UpdateBudgetTemplate :one
+UPDATE budget_templates
+SET name = $2, items = $3, updated_at = NOW()
+WHERE id = $1
+RETURNING id, book_id, user_id, name, items, created_at, updated_at
+`
+
+func (q *Queries) UpdateBudgetTemplate(ctx context.Context, id uuid.UUID, name string, items []byte) (BudgetTemplate, error) {
+	row := q.db.QueryRow(ctx, updateBudgetTemplate, id, name, items)
+	var i BudgetTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.UserID,
+		&i.Name,
+		&i.Items,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
 
 const updateCategory = `-- name: UpdateCategory :one
 UPDATE categories
-SET name = $2, type = $3, description = $4, color = $5, updated_at = NOW()
+SET name = $2, type = $3, description = $4, color = $5, parent_id = $6, updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, type, description, color, created_at, updated_at
+RETURNING id, name, type, description, color, created_at, updated_at, book_id, user_id, parent_id
 `
 
-func (q *Queries) UpdateCategory(ctx context.Context, iD uuid.UUID, name string, type_ string, description string, color string) (Category, error) {
+func (q *Queries) UpdateCategory(ctx context.Context, iD uuid.UUID, name string, type_ string, description string, color string, parentID *uuid.UUID) (Category, error) {
 	row := q.db.QueryRow(ctx, updateCategory,
 		iD,
 		name,
 		type_,
 		description,
 		color,
+		parentID,
 	)
 	var i Category
 	err := row.Scan(
@@ -850,18 +4123,194 @@ func (q *Queries) UpdateCategory(ctx context.Context, iD uuid.UUID, name string,
 		&i.Color,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.BookID,
+		&i.UserID,
+		&i.ParentID,
+	)
+	return i, err
+}
+
+const updateExpenseReport = `-- name: UpdateExpenseReport :one
+UPDATE expense_reports
+SET name = $3, start_date = $4, end_date = $5, notes = $6, status = $7, submitted_at = $8, reviewed_at = $9, updated_at = NOW()
+WHERE id = $1 AND book_id = $2
+RETURNING id, name, start_date, end_date, notes, status, submitted_at, reviewed_at, created_at, updated_at, book_id, user_id
+`
+
+func (q *Queries) UpdateExpenseReport(ctx context.Context, iD uuid.UUID, bookID uuid.UUID, name string, startDate pgtype.Date, endDate pgtype.Date, notes string, status string, submittedAt *time.Time, reviewedAt *time.Time) (ExpenseReport, error) {
+	row := q.db.QueryRow(ctx, updateExpenseReport,
+		iD,
+		bookID,
+		name,
+		startDate,
+		endDate,
+		notes,
+		status,
+		submittedAt,
+		reviewedAt,
+	)
+	var i ExpenseReport
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.StartDate,
+		&i.EndDate,
+		&i.Notes,
+		&i.Status,
+		&i.SubmittedAt,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BookID,
+		&i.UserID,
+	)
+	return i, err
+}
+
+const updateInvoice = `-- name: UpdateInvoice :one
+UPDATE invoices
+SET account_id = $2, client = $3, number = $4, description = $5, amount = $6, issue_date = $7, due_date = $8, updated_at = NOW()
+WHERE id = $1
+RETURNING id, account_id, client, number, description, amount, issue_date, due_date, transaction_id, created_at, updated_at
+`
+
+func (q *Queries) UpdateInvoice(ctx context.Context, iD uuid.UUID, accountID uuid.UUID, client string, number string, description string, amount int64, issueDate pgtype.Date, dueDate pgtype.Date) (Invoice, error) {
+	row := q.db.QueryRow(ctx, updateInvoice,
+		iD,
+		accountID,
+		client,
+		number,
+		description,
+		amount,
+		issueDate,
+		dueDate,
+	)
+	var i Invoice
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.Client,
+		&i.Number,
+		&i.Description,
+		&i.Amount,
+		&i.IssueDate,
+		&i.DueDate,
+		&i.TransactionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateProject = `-- name: UpdateProject :one
+UPDATE projects
+SET name = $3, client = $4, description = $5, updated_at = NOW()
+WHERE id = $1 AND book_id = $2
+RETURNING id, name, client, description, created_at, updated_at, book_id, user_id
+`
+
+func (q *Queries) UpdateProject(ctx context.Context, iD uuid.UUID, bookID uuid.UUID, name string, client string, description string) (Project, error) {
+	row := q.db.QueryRow(ctx, updateProject,
+		iD,
+		bookID,
+		name,
+		client,
+		description,
+	)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Client,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.BookID,
+		&i.UserID,
+	)
+	return i, err
+}
+
+const updateReportDefinition = `-- name: UpdateReportDefinition :one
+UPDATE report_definitions
+SET name = $2, definition = $3, updated_at = NOW()
+WHERE id = $1
+RETURNING id, book_id, user_id, name, definition, created_at, updated_at
+`
+
+func (q *Queries) UpdateReportDefinition(ctx context.Context, id uuid.UUID, name string, definition []byte) (ReportDefinition, error) {
+	row := q.db.QueryRow(ctx, updateReportDefinition, id, name, definition)
+	var i ReportDefinition
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.UserID,
+		&i.Name,
+		&i.Definition,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateSettings = `-- name: UpdateSettings :one
+UPDATE settings
+SET currency = $1, locale = $2, fiscal_month_start_day = $3, notifications_enabled = $4, notification_email = $5, api_keys = $6, updated_at = NOW()
+WHERE id = TRUE
+RETURNING id, currency, locale, fiscal_month_start_day, notifications_enabled, notification_email, api_keys, updated_at
+`
+
+func (q *Queries) UpdateSettings(ctx context.Context, currency string, locale string, fiscalMonthStartDay int32, notificationsEnabled bool, notificationEmail string, apiKeys []byte) (Setting, error) {
+	row := q.db.QueryRow(ctx, updateSettings,
+		currency,
+		locale,
+		fiscalMonthStartDay,
+		notificationsEnabled,
+		notificationEmail,
+		apiKeys,
+	)
+	var i Setting
+	err := row.Scan(
+		&i.ID,
+		&i.Currency,
+		&i.Locale,
+		&i.FiscalMonthStartDay,
+		&i.NotificationsEnabled,
+		&i.NotificationEmail,
+		&i.ApiKeys,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateTag = `-- name: UpdateTag :one
+UPDATE tags
+SET name = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, book_id, name, created_at, updated_at
+`
+
+func (q *Queries) UpdateTag(ctx context.Context, id uuid.UUID, name string) (Tag, error) {
+	row := q.db.QueryRow(ctx, updateTag, id, name)
+	var i Tag
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Name,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
 
 const updateTransaction = `-- name: UpdateTransaction :one
 UPDATE transactions
-SET account_id = $2, category_id = $3, amount = $4, description = $5, date = $6, status = $7, updated_at = NOW()
-WHERE id = $1
-RETURNING id, account_id, category_id, amount, description, date, status, created_at, updated_at
+SET account_id = $2, category_id = $3, amount = $4, description = $5, date = $6, status = $7, payee = $8, project_id = $9, user_id = (SELECT user_id FROM accounts WHERE id = $2), updated_at = NOW()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
 `
 
-func (q *Queries) UpdateTransaction(ctx context.Context, iD uuid.UUID, accountID uuid.UUID, categoryID uuid.UUID, amount int64, description string, date pgtype.Date, status string) (Transaction, error) {
+func (q *Queries) UpdateTransaction(ctx context.Context, iD uuid.UUID, accountID uuid.UUID, categoryID uuid.UUID, amount int64, description string, date pgtype.Date, status string, payee string, projectID *uuid.UUID) (Transaction, error) {
 	row := q.db.QueryRow(ctx, updateTransaction,
 		iD,
 		accountID,
@@ -870,6 +4319,8 @@ func (q *Queries) UpdateTransaction(ctx context.Context, iD uuid.UUID, accountID
 		description,
 		date,
 		status,
+		payee,
+		projectID,
 	)
 	var i Transaction
 	err := row.Scan(
@@ -882,6 +4333,40 @@ func (q *Queries) UpdateTransaction(ctx context.Context, iD uuid.UUID, accountID
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Payee,
+		&i.InstallmentPlanID,
+		&i.InstallmentNumber,
+		&i.InstallmentCount,
+		&i.ProjectID,
+		&i.UserID,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const updateTransactionScript = `-- name: UpdateTransactionScript :one
+UPDATE transaction_scripts
+SET name = $2, source = $3, enabled = $4, updated_at = NOW()
+WHERE id = $1
+RETURNING id, book_id, name, source, enabled, created_at, updated_at
+`
+
+func (q *Queries) UpdateTransactionScript(ctx context.Context, id uuid.UUID, name string, source string, enabled bool) (TransactionScript, error) {
+	row := q.db.QueryRow(ctx, updateTransactionScript,
+		id,
+		name,
+		source,
+		enabled,
+	)
+	var i TransactionScript
+	err := row.Scan(
+		&i.ID,
+		&i.BookID,
+		&i.Name,
+		&i.Source,
+		&i.Enabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
@@ -889,8 +4374,8 @@ func (q *Queries) UpdateTransaction(ctx context.Context, iD uuid.UUID, accountID
 const updateTransactionStatus = `-- name: UpdateTransactionStatus :one
 UPDATE transactions
 SET status = $2, updated_at = NOW()
-WHERE id = $1
-RETURNING id, account_id, category_id, amount, description, date, status, created_at, updated_at
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, account_id, category_id, amount, description, date, status, created_at, updated_at, payee, installment_plan_id, installment_number, installment_count, project_id, user_id, deleted_at
 `
 
 func (q *Queries) UpdateTransactionStatus(ctx context.Context, iD uuid.UUID, status string) (Transaction, error) {
@@ -906,6 +4391,51 @@ func (q *Queries) UpdateTransactionStatus(ctx context.Context, iD uuid.UUID, sta
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Payee,
+		&i.InstallmentPlanID,
+		&i.InstallmentNumber,
+		&i.InstallmentCount,
+		&i.ProjectID,
+		&i.UserID,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const upsertReportSnapshot = `-- name: UpsertReportSnapshot :one
+INSERT INTO report_snapshots (book_id, month, report)
+VALUES ($1, $2, $3)
+ON CONFLICT (book_id, month) DO UPDATE SET report = EXCLUDED.report, created_at = NOW()
+RETURNING book_id, month, report, created_at
+`
+
+func (q *Queries) UpsertReportSnapshot(ctx context.Context, bookID uuid.UUID, month pgtype.Date, report []byte) (ReportSnapshot, error) {
+	row := q.db.QueryRow(ctx, upsertReportSnapshot, bookID, month, report)
+	var i ReportSnapshot
+	err := row.Scan(
+		&i.BookID,
+		&i.Month,
+		&i.Report,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertUserSetting = `-- name: UpsertUserSetting :one
+INSERT INTO user_settings (user_id, key, value)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+RETURNING key, value, updated_at, user_id
+`
+
+func (q *Queries) UpsertUserSetting(ctx context.Context, userID *uuid.UUID, key string, value string) (UserSetting, error) {
+	row := q.db.QueryRow(ctx, upsertUserSetting, userID, key, value)
+	var i UserSetting
+	err := row.Scan(
+		&i.Key,
+		&i.Value,
+		&i.UpdatedAt,
+		&i.UserID,
 	)
 	return i, err
 }
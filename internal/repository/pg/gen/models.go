@@ -12,13 +12,49 @@ import (
 )
 
 type Account struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	Type        string    `json:"type"`
-	Description string    `json:"description"`
-	Asset       string    `json:"asset"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+	ID                  uuid.UUID  `json:"id"`
+	Name                string     `json:"name"`
+	Type                string     `json:"type"`
+	Description         string     `json:"description"`
+	Asset               string     `json:"asset"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	UpdatedAt           time.Time  `json:"updatedAt"`
+	Classification      *string    `json:"classification"`
+	Institution         string     `json:"institution"`
+	AccountNumberLast4  string     `json:"accountNumberLast4"`
+	Color               string     `json:"color"`
+	Icon                string     `json:"icon"`
+	StatementClosingDay int32      `json:"statementClosingDay"`
+	PaymentDueDay       int32      `json:"paymentDueDay"`
+	BookID              uuid.UUID  `json:"bookId"`
+	UserID              *uuid.UUID `json:"userId"`
+}
+
+type ApiUsage struct {
+	UserID uuid.UUID   `json:"userId"`
+	Month  pgtype.Date `json:"month"`
+	Calls  int64       `json:"calls"`
+}
+
+type Attachment struct {
+	ID            uuid.UUID `json:"id"`
+	TransactionID uuid.UUID `json:"transactionId"`
+	FileName      string    `json:"fileName"`
+	ContentType   string    `json:"contentType"`
+	Size          int64     `json:"size"`
+	StorageKey    string    `json:"storageKey"`
+	ThumbnailKey  *string   `json:"thumbnailKey"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type AuthEvent struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    *uuid.UUID `json:"userId"`
+	Type      string     `json:"type"`
+	Email     string     `json:"email"`
+	IpAddress string     `json:"ipAddress"`
+	UserAgent string     `json:"userAgent"`
+	CreatedAt time.Time  `json:"createdAt"`
 }
 
 type Balance struct {
@@ -29,24 +65,275 @@ type Balance struct {
 	LastCalculated   time.Time `json:"lastCalculated"`
 }
 
-type Category struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	Type        string    `json:"type"`
-	Description string    `json:"description"`
-	Color       string    `json:"color"`
-	CreatedAt   time.Time `json:"createdAt"`
-	UpdatedAt   time.Time `json:"updatedAt"`
+type BalanceSnapshot struct {
+	AccountID      uuid.UUID   `json:"accountId"`
+	SnapshotDate   pgtype.Date `json:"snapshotDate"`
+	CurrentBalance int64       `json:"currentBalance"`
 }
 
-type Transaction struct {
+type Book struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	Asset       string     `json:"asset"`
+	UserID      *uuid.UUID `json:"userId"`
+}
+
+type Budget struct {
+	ID         uuid.UUID   `json:"id"`
+	BookID     uuid.UUID   `json:"bookId"`
+	UserID     *uuid.UUID  `json:"userId"`
+	CategoryID uuid.UUID   `json:"categoryId"`
+	Asset      string      `json:"asset"`
+	Amount     int64       `json:"amount"`
+	StartMonth pgtype.Date `json:"startMonth"`
+	EndMonth   pgtype.Date `json:"endMonth"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	UpdatedAt  time.Time   `json:"updatedAt"`
+	Thresholds []int32     `json:"thresholds"`
+}
+
+type BudgetAlert struct {
 	ID          uuid.UUID   `json:"id"`
-	AccountID   uuid.UUID   `json:"accountId"`
+	BookID      uuid.UUID   `json:"bookId"`
+	UserID      *uuid.UUID  `json:"userId"`
+	BudgetID    uuid.UUID   `json:"budgetId"`
 	CategoryID  uuid.UUID   `json:"categoryId"`
-	Amount      int64       `json:"amount"`
-	Description string      `json:"description"`
-	Date        pgtype.Date `json:"date"`
+	Month       pgtype.Date `json:"month"`
+	Threshold   int32       `json:"threshold"`
+	Percent     float64     `json:"percent"`
+	Asset       string      `json:"asset"`
+	Spent       int64       `json:"spent"`
+	LimitAmount int64       `json:"limitAmount"`
+	CreatedAt   time.Time   `json:"createdAt"`
+}
+
+type BudgetTemplate struct {
+	ID        uuid.UUID  `json:"id"`
+	BookID    uuid.UUID  `json:"bookId"`
+	UserID    *uuid.UUID `json:"userId"`
+	Name      string     `json:"name"`
+	Items     []byte     `json:"items"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+type Category struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Type        string     `json:"type"`
+	Description string     `json:"description"`
+	Color       string     `json:"color"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	BookID      uuid.UUID  `json:"bookId"`
+	UserID      *uuid.UUID `json:"userId"`
+	ParentID    *uuid.UUID `json:"parentId"`
+}
+
+type CustomAsset struct {
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Symbol    string    `json:"symbol"`
+	Precision int32     `json:"precision"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type ExpenseReport struct {
+	ID          uuid.UUID   `json:"id"`
+	Name        string      `json:"name"`
+	StartDate   pgtype.Date `json:"startDate"`
+	EndDate     pgtype.Date `json:"endDate"`
+	Notes       string      `json:"notes"`
 	Status      string      `json:"status"`
+	SubmittedAt *time.Time  `json:"submittedAt"`
+	ReviewedAt  *time.Time  `json:"reviewedAt"`
 	CreatedAt   time.Time   `json:"createdAt"`
 	UpdatedAt   time.Time   `json:"updatedAt"`
+	BookID      uuid.UUID   `json:"bookId"`
+	UserID      *uuid.UUID  `json:"userId"`
+}
+
+type ExpenseReportTransaction struct {
+	ExpenseReportID uuid.UUID `json:"expenseReportId"`
+	TransactionID   uuid.UUID `json:"transactionId"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+type Export struct {
+	ID          uuid.UUID  `json:"id"`
+	BookID      uuid.UUID  `json:"bookId"`
+	UserID      *uuid.UUID `json:"userId"`
+	Format      string     `json:"format"`
+	FileName    string     `json:"fileName"`
+	ContentType string     `json:"contentType"`
+	Size        int64      `json:"size"`
+	StorageKey  string     `json:"storageKey"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	ExpiresAt   time.Time  `json:"expiresAt"`
+}
+
+type IdempotencyKey struct {
+	UserID      *uuid.UUID `json:"userId"`
+	Key         string     `json:"key"`
+	Fingerprint string     `json:"fingerprint"`
+	StatusCode  int32      `json:"statusCode"`
+	ContentType string     `json:"contentType"`
+	Body        []byte     `json:"body"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+type InstallmentPlan struct {
+	ID               uuid.UUID   `json:"id"`
+	AccountID        uuid.UUID   `json:"accountId"`
+	Description      string      `json:"description"`
+	Amount           int64       `json:"amount"`
+	InstallmentCount int32       `json:"installmentCount"`
+	FirstDate        pgtype.Date `json:"firstDate"`
+	PaidOffOn        pgtype.Date `json:"paidOffOn"`
+	CreatedAt        time.Time   `json:"createdAt"`
+	UpdatedAt        time.Time   `json:"updatedAt"`
+}
+
+type Invoice struct {
+	ID            uuid.UUID   `json:"id"`
+	AccountID     uuid.UUID   `json:"accountId"`
+	Client        string      `json:"client"`
+	Number        string      `json:"number"`
+	Description   string      `json:"description"`
+	Amount        int64       `json:"amount"`
+	IssueDate     pgtype.Date `json:"issueDate"`
+	DueDate       pgtype.Date `json:"dueDate"`
+	TransactionID *uuid.UUID  `json:"transactionId"`
+	CreatedAt     time.Time   `json:"createdAt"`
+	UpdatedAt     time.Time   `json:"updatedAt"`
+}
+
+type LoginFailure struct {
+	Scope        string     `json:"scope"`
+	Key          string     `json:"key"`
+	Failures     int32      `json:"failures"`
+	LastFailedAt time.Time  `json:"lastFailedAt"`
+	LockedUntil  *time.Time `json:"lockedUntil"`
+}
+
+type Project struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	Client      string     `json:"client"`
+	Description string     `json:"description"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	BookID      uuid.UUID  `json:"bookId"`
+	UserID      *uuid.UUID `json:"userId"`
+}
+
+type ReportDefinition struct {
+	ID         uuid.UUID  `json:"id"`
+	BookID     uuid.UUID  `json:"bookId"`
+	UserID     *uuid.UUID `json:"userId"`
+	Name       string     `json:"name"`
+	Definition []byte     `json:"definition"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+type ReportSnapshot struct {
+	BookID    uuid.UUID   `json:"bookId"`
+	Month     pgtype.Date `json:"month"`
+	Report    []byte      `json:"report"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
+
+type RestorePoint struct {
+	ID           uuid.UUID  `json:"id"`
+	BookID       uuid.UUID  `json:"bookId"`
+	Operation    string     `json:"operation"`
+	Description  string     `json:"description"`
+	Changes      []byte     `json:"changes"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	RolledBackAt *time.Time `json:"rolledBackAt"`
+}
+
+type Session struct {
+	ID         uuid.UUID  `json:"id"`
+	UserID     uuid.UUID  `json:"userId"`
+	UserAgent  string     `json:"userAgent"`
+	IpAddress  string     `json:"ipAddress"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastSeenAt time.Time  `json:"lastSeenAt"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+}
+
+type Setting struct {
+	ID                   bool      `json:"id"`
+	Currency             string    `json:"currency"`
+	Locale               string    `json:"locale"`
+	FiscalMonthStartDay  int32     `json:"fiscalMonthStartDay"`
+	NotificationsEnabled bool      `json:"notificationsEnabled"`
+	NotificationEmail    string    `json:"notificationEmail"`
+	ApiKeys              []byte    `json:"apiKeys"`
+	UpdatedAt            time.Time `json:"updatedAt"`
+}
+
+type Tag struct {
+	ID        uuid.UUID `json:"id"`
+	BookID    uuid.UUID `json:"bookId"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type Transaction struct {
+	ID                uuid.UUID   `json:"id"`
+	AccountID         uuid.UUID   `json:"accountId"`
+	CategoryID        uuid.UUID   `json:"categoryId"`
+	Amount            int64       `json:"amount"`
+	Description       string      `json:"description"`
+	Date              pgtype.Date `json:"date"`
+	Status            string      `json:"status"`
+	CreatedAt         time.Time   `json:"createdAt"`
+	UpdatedAt         time.Time   `json:"updatedAt"`
+	Payee             string      `json:"payee"`
+	InstallmentPlanID *uuid.UUID  `json:"installmentPlanId"`
+	InstallmentNumber int32       `json:"installmentNumber"`
+	InstallmentCount  int32       `json:"installmentCount"`
+	ProjectID         *uuid.UUID  `json:"projectId"`
+	UserID            *uuid.UUID  `json:"userId"`
+	DeletedAt         *time.Time  `json:"deletedAt"`
+}
+
+type TransactionScript struct {
+	ID        uuid.UUID `json:"id"`
+	BookID    uuid.UUID `json:"bookId"`
+	Name      string    `json:"name"`
+	Source    string    `json:"source"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type TransactionTag struct {
+	TransactionID uuid.UUID `json:"transactionId"`
+	TagID         uuid.UUID `json:"tagId"`
+}
+
+type User struct {
+	ID           uuid.UUID `json:"id"`
+	Email        string    `json:"email"`
+	Name         string    `json:"name"`
+	PasswordHash string    `json:"passwordHash"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+	Tier         string    `json:"tier"`
+}
+
+type UserSetting struct {
+	Key       string     `json:"key"`
+	Value     string     `json:"value"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	UserID    *uuid.UUID `json:"userId"`
 }
@@ -6,56 +6,259 @@ package gen
 
 import (
 	"context"
+	"time"
 
 	uuid "github.com/gofrs/uuid/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	AddAPICalls(ctx context.Context, userID uuid.UUID, month pgtype.Date, calls int64) error
+	AddExpenseReportTransaction(ctx context.Context, expenseReportID uuid.UUID, transactionID uuid.UUID) error
+	AddTransactionTags(ctx context.Context, transactionID uuid.UUID, bookID uuid.UUID, names []string) error
+	ClaimBooks(ctx context.Context, userID uuid.UUID) (int64, error)
+	ClaimIdempotencyKey(ctx context.Context, userID *uuid.UUID, key string, fingerprint string) (IdempotencyKey, error)
+	ClearLoginFailures(ctx context.Context, scope string, key string) error
+	CountAccountTransactions(ctx context.Context, accountID uuid.UUID) (int64, error)
+	CountAccounts(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) (int64, error)
+	CountBalances(ctx context.Context, bookID uuid.UUID) (int64, error)
+	CountCategories(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) (int64, error)
+	CountDeletedTransactions(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) (int64, error)
+	CountSearchTransactions(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID, query string) (int64, error)
+	CountTransactions(ctx context.Context, bookID uuid.UUID, projectID *uuid.UUID, userID *uuid.UUID, tag *string) (int64, error)
 	// =============================================================================
 	// ACCOUNTS
 	// =============================================================================
-	CreateAccount(ctx context.Context, name string, type_ string, description string, asset string) (Account, error)
+	CreateAccount(ctx context.Context, name string, type_ string, description string, asset string, classification *string, institution string, accountNumberLast4 string, color string, icon string, statementClosingDay int32, paymentDueDay int32, bookID uuid.UUID) (Account, error)
+	// =============================================================================
+	// ATTACHMENTS
+	// =============================================================================
+	CreateAttachment(ctx context.Context, transactionID uuid.UUID, fileName string, contentType string, size int64, storageKey string, thumbnailKey *string) (Attachment, error)
+	CreateAuthEvent(ctx context.Context, userID *uuid.UUID, type_ string, email string, ipAddress string, userAgent string) (AuthEvent, error)
+	// =============================================================================
+	// BOOKS
+	// =============================================================================
+	CreateBook(ctx context.Context, name string, description string, asset string, userID *uuid.UUID) (Book, error)
+	// =============================================================================
+	// BUDGETS
+	// =============================================================================
+	CreateBudget(ctx context.Context, bookID uuid.UUID, categoryID uuid.UUID, asset string, amount int64, startMonth pgtype.Date, endMonth pgtype.Date, thresholds []int32) (Budget, error)
+	// =============================================================================
+	// BUDGET TEMPLATES
+	// =============================================================================
+	CreateBudgetTemplate(ctx context.Context, bookID uuid.UUID, name string, items []byte) (BudgetTemplate, error)
 	// =============================================================================
 	// CATEGORIES
 	// =============================================================================
-	CreateCategory(ctx context.Context, name string, type_ string, description string, color string) (Category, error)
+	CreateCategory(ctx context.Context, name string, type_ string, description string, color string, bookID uuid.UUID, parentID *uuid.UUID) (Category, error)
+	// =============================================================================
+	// CUSTOM ASSETS
+	// =============================================================================
+	CreateCustomAsset(ctx context.Context, code string, name string, symbol string, precision int32) (CustomAsset, error)
+	// =============================================================================
+	// EXPENSE REPORTS
+	// =============================================================================
+	CreateExpenseReport(ctx context.Context, bookID uuid.UUID, name string, startDate pgtype.Date, endDate pgtype.Date, notes string) (ExpenseReport, error)
+	// =============================================================================
+	// EXPORTS
+	// =============================================================================
+	CreateExport(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID, format string, fileName string, contentType string, size int64, storageKey string, expiresAt time.Time) (Export, error)
+	// =============================================================================
+	// INSTALLMENT PLANS
+	// =============================================================================
+	CreateInstallmentPlan(ctx context.Context, accountID uuid.UUID, description string, amount int64, installmentCount int32, firstDate pgtype.Date) (InstallmentPlan, error)
+	// =============================================================================
+	// INVOICES
+	// =============================================================================
+	CreateInvoice(ctx context.Context, accountID uuid.UUID, client string, number string, description string, amount int64, issueDate pgtype.Date, dueDate pgtype.Date) (Invoice, error)
+	// =============================================================================
+	// PROJECTS
+	// =============================================================================
+	CreateProject(ctx context.Context, bookID uuid.UUID, name string, client string, description string) (Project, error)
+	// =============================================================================
+	// REPORT DEFINITIONS
+	// =============================================================================
+	CreateReportDefinition(ctx context.Context, bookID uuid.UUID, name string, definition []byte) (ReportDefinition, error)
+	// =============================================================================
+	// RESTORE POINTS
+	// =============================================================================
+	CreateRestorePoint(ctx context.Context, bookID uuid.UUID, operation string, description string, changes []byte) (RestorePoint, error)
+	// =============================================================================
+	// SESSIONS
+	// =============================================================================
+	CreateSession(ctx context.Context, userID uuid.UUID, userAgent string, ipAddress string, expiresAt time.Time) (Session, error)
+	// =============================================================================
+	// TAGS
+	// =============================================================================
+	CreateTag(ctx context.Context, bookID uuid.UUID, name string) (Tag, error)
+	CreateTagsByName(ctx context.Context, bookID uuid.UUID, names []string) error
 	// =============================================================================
 	// TRANSACTIONS
 	// =============================================================================
-	CreateTransaction(ctx context.Context, accountID uuid.UUID, categoryID uuid.UUID, amount int64, description string, date pgtype.Date, status string) (Transaction, error)
+	CreateTransaction(ctx context.Context, accountID uuid.UUID, categoryID uuid.UUID, amount int64, description string, date pgtype.Date, status string, payee string, installmentPlanID *uuid.UUID, installmentNumber int32, installmentCount int32, projectID *uuid.UUID) (Transaction, error)
+	// =============================================================================
+	// TRANSACTION SCRIPTS
+	// =============================================================================
+	CreateTransactionScript(ctx context.Context, bookID uuid.UUID, name string, source string, enabled bool) (TransactionScript, error)
+	// =============================================================================
+	// USERS
+	// =============================================================================
+	CreateUser(ctx context.Context, email string, name string, passwordHash string) (User, error)
 	DeleteAccount(ctx context.Context, id uuid.UUID) error
+	DeleteAttachment(ctx context.Context, id uuid.UUID) error
+	DeleteBook(ctx context.Context, id uuid.UUID) error
+	DeleteBudget(ctx context.Context, id uuid.UUID) error
+	DeleteBudgetTemplate(ctx context.Context, id uuid.UUID) error
 	DeleteCategory(ctx context.Context, id uuid.UUID) error
+	DeleteExpenseReport(ctx context.Context, id uuid.UUID, bookID uuid.UUID) error
+	DeleteExpiredExports(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID, now time.Time) ([]Export, error)
+	DeleteExport(ctx context.Context, id uuid.UUID) error
+	DeleteIdempotencyKey(ctx context.Context, userID *uuid.UUID, key string) error
+	DeleteIdempotencyKeysBefore(ctx context.Context, createdAt time.Time) (int64, error)
+	DeleteInstallmentPlan(ctx context.Context, id uuid.UUID) error
+	DeleteInstallmentPlanTransactions(ctx context.Context, installmentPlanID *uuid.UUID) error
+	DeleteInvoice(ctx context.Context, id uuid.UUID) error
+	DeleteProject(ctx context.Context, id uuid.UUID, bookID uuid.UUID) error
+	DeleteReportDefinition(ctx context.Context, id uuid.UUID) error
+	DeleteTag(ctx context.Context, id uuid.UUID) error
 	DeleteTransaction(ctx context.Context, id uuid.UUID) error
-	GetAccountByID(ctx context.Context, id uuid.UUID) (Account, error)
+	DeleteTransactionScript(ctx context.Context, id uuid.UUID) error
+	DeleteTransactionTags(ctx context.Context, transactionID uuid.UUID) error
+	DeleteUserSetting(ctx context.Context, userID *uuid.UUID, key string) error
+	GetAccountBalanceBefore(ctx context.Context, accountID uuid.UUID, date pgtype.Date) (int64, error)
+	GetAccountByID(ctx context.Context, id uuid.UUID) (GetAccountByIDRow, error)
+	GetAccountNumbers(ctx context.Context) ([]GetAccountNumbersRow, error)
+	GetAccountPeriodSummary(ctx context.Context, fromDate pgtype.Date, accountID uuid.UUID, toDate pgtype.Date) (GetAccountPeriodSummaryRow, error)
+	GetAccountStatement(ctx context.Context, accountID uuid.UUID, toDate pgtype.Date, fromDate pgtype.Date) ([]GetAccountStatementRow, error)
 	GetAccountWithBalance(ctx context.Context, id uuid.UUID) (GetAccountWithBalanceRow, error)
-	GetAccountsWithBalances(ctx context.Context) ([]GetAccountsWithBalancesRow, error)
-	GetAllAccounts(ctx context.Context) ([]Account, error)
-	GetAllBalances(ctx context.Context) ([]Balance, error)
-	GetAllCategories(ctx context.Context) ([]Category, error)
-	GetAllTransactions(ctx context.Context) ([]Transaction, error)
+	GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]Session, error)
+	GetAllBalances(ctx context.Context, bookID uuid.UUID) ([]Balance, error)
+	GetAllBooks(ctx context.Context, userID *uuid.UUID) ([]Book, error)
+	GetAllBudgetAlerts(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]BudgetAlert, error)
+	GetAllBudgetTemplates(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]BudgetTemplate, error)
+	GetAllBudgets(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]Budget, error)
+	GetAllCustomAssets(ctx context.Context) ([]CustomAsset, error)
+	GetAllExpenseReports(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]ExpenseReport, error)
+	GetAllInstallmentPlans(ctx context.Context, bookID uuid.UUID) ([]InstallmentPlan, error)
+	GetAllInvoices(ctx context.Context, bookID uuid.UUID) ([]Invoice, error)
+	GetAllProjects(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]Project, error)
+	GetAllReportDefinitions(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]ReportDefinition, error)
+	GetAllRestorePoints(ctx context.Context, bookID uuid.UUID) ([]RestorePoint, error)
+	GetAllTags(ctx context.Context, bookID uuid.UUID) ([]Tag, error)
+	GetAllTransactionScripts(ctx context.Context, bookID uuid.UUID) ([]TransactionScript, error)
+	GetAllTransactions(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID) ([]Transaction, error)
+	// =============================================================================
+	// USER SETTINGS
+	// =============================================================================
+	GetAllUserSettings(ctx context.Context, userID *uuid.UUID) ([]UserSetting, error)
+	GetAttachmentByID(ctx context.Context, id uuid.UUID) (Attachment, error)
+	GetAttachmentsByDateRange(ctx context.Context, date pgtype.Date, date_2 pgtype.Date, bookID uuid.UUID, userID *uuid.UUID) ([]Attachment, error)
+	GetAuthEventsByUser(ctx context.Context, userID *uuid.UUID, limit int32) ([]AuthEvent, error)
 	// =============================================================================
 	// BALANCES
 	// =============================================================================
 	GetBalanceByAccountID(ctx context.Context, accountID uuid.UUID) (Balance, error)
-	GetBalanceSummary(ctx context.Context) (GetBalanceSummaryRow, error)
-	GetCategoriesByType(ctx context.Context, type_ string) ([]Category, error)
+	GetBalanceSnapshotsAt(ctx context.Context, snapshotDate pgtype.Date, bookID uuid.UUID) ([]GetBalanceSnapshotsAtRow, error)
+	// The snapshots of each account taken between the dates, starting with the
+	// last one on or before the first date, the balance the account had then
+	GetBalanceSnapshotsBetween(ctx context.Context, bookID uuid.UUID, accountID *uuid.UUID, fromDate pgtype.Date, toDate pgtype.Date) ([]GetBalanceSnapshotsBetweenRow, error)
+	GetBalanceSummary(ctx context.Context, bookID uuid.UUID) (GetBalanceSummaryRow, error)
+	GetBalancesPage(ctx context.Context, bookID uuid.UUID, limit int32, offset int32) ([]Balance, error)
+	GetBookByID(ctx context.Context, id uuid.UUID) (Book, error)
+	GetBudgetByID(ctx context.Context, id uuid.UUID) (Budget, error)
+	GetBudgetTemplateByID(ctx context.Context, id uuid.UUID) (BudgetTemplate, error)
+	// Adds up the size of the pending and cleared income and expenses per asset
+	// and month, of one account when given.
+	GetCashFlow(ctx context.Context, startDate pgtype.Date, endDate pgtype.Date, accountID *uuid.UUID, bookID uuid.UUID, userID *uuid.UUID) ([]GetCashFlowRow, error)
+	GetCategoriesByType(ctx context.Context, type_ string, bookID uuid.UUID, userID *uuid.UUID) ([]Category, error)
 	GetCategoryByID(ctx context.Context, id uuid.UUID) (Category, error)
+	GetDeletedTransactions(ctx context.Context, limit int32, offset int32, bookID uuid.UUID, userID *uuid.UUID) ([]Transaction, error)
+	GetExpenseReportByID(ctx context.Context, id uuid.UUID) (ExpenseReport, error)
+	GetExpenseReportTransactions(ctx context.Context, expenseReportID uuid.UUID, bookID uuid.UUID) ([]GetExpenseReportTransactionsRow, error)
+	GetExportByID(ctx context.Context, id uuid.UUID) (Export, error)
+	GetExports(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID, now time.Time) ([]Export, error)
+	GetFirstBook(ctx context.Context, userID *uuid.UUID) (Book, error)
+	GetIdempotencyKey(ctx context.Context, userID *uuid.UUID, key string) (IdempotencyKey, error)
+	GetInstallmentPlanByID(ctx context.Context, id uuid.UUID) (InstallmentPlan, error)
+	GetInvoiceByID(ctx context.Context, id uuid.UUID) (Invoice, error)
+	// =============================================================================
+	// LOGIN LOCKOUT
+	// =============================================================================
+	GetLoginFailures(ctx context.Context, scope string, key string) (LoginFailure, error)
+	GetProjectByID(ctx context.Context, id uuid.UUID) (Project, error)
+	GetReportDefinitionByID(ctx context.Context, id uuid.UUID) (ReportDefinition, error)
+	// =============================================================================
+	// REPORT SNAPSHOTS
+	// =============================================================================
+	GetReportSnapshot(ctx context.Context, bookID uuid.UUID, month pgtype.Date) (ReportSnapshot, error)
+	GetRestorePointByID(ctx context.Context, id uuid.UUID) (RestorePoint, error)
+	GetSessionByID(ctx context.Context, id uuid.UUID) (Session, error)
+	// =============================================================================
+	// SETTINGS
+	// =============================================================================
+	GetSettings(ctx context.Context) (Setting, error)
+	// Adds up the size of the pending and cleared expenses per asset, by
+	// category and by month when asked, the keys not grouped by left empty.
+	GetSpending(ctx context.Context, byCategory bool, byMonth bool, startDate pgtype.Date, endDate pgtype.Date, bookID uuid.UUID, userID *uuid.UUID) ([]GetSpendingRow, error)
+	GetTagByID(ctx context.Context, id uuid.UUID) (Tag, error)
+	GetTransactionAttachments(ctx context.Context, transactionIds []uuid.UUID) ([]Attachment, error)
 	GetTransactionByID(ctx context.Context, id uuid.UUID) (Transaction, error)
+	// Pivots the pending and cleared transactions by two dimensions with grouping
+	// sets: grouping_id is 0 for the cells, 1 for the row totals, 2 for the column
+	// totals and 3 for the grand total, each per asset.
+	GetTransactionCube(ctx context.Context, rowDimension string, colDimension string, fromDate pgtype.Date, toDate pgtype.Date, bookID uuid.UUID, userID *uuid.UUID) ([]GetTransactionCubeRow, error)
+	GetTransactionScriptByID(ctx context.Context, id uuid.UUID) (TransactionScript, error)
+	GetTransactionTags(ctx context.Context, transactionIds []uuid.UUID) ([]GetTransactionTagsRow, error)
 	// =============================================================================
 	// JOINED QUERIES FOR DETAILED VIEWS
 	// =============================================================================
-	GetTransactionWithDetails(ctx context.Context, id uuid.UUID) (GetTransactionWithDetailsRow, error)
-	GetTransactionsByAccount(ctx context.Context, accountID uuid.UUID) ([]Transaction, error)
-	GetTransactionsByAccountAndDateRange(ctx context.Context, accountID uuid.UUID, date pgtype.Date, date_2 pgtype.Date) ([]Transaction, error)
-	GetTransactionsByCategory(ctx context.Context, categoryID uuid.UUID) ([]Transaction, error)
-	GetTransactionsByDateRange(ctx context.Context, date pgtype.Date, date_2 pgtype.Date) ([]Transaction, error)
-	GetTransactionsWithDetails(ctx context.Context, limit int32, offset int32) ([]GetTransactionsWithDetailsRow, error)
+	GetTransactionWithDetails(ctx context.Context, id uuid.UUID, bookID uuid.UUID) (GetTransactionWithDetailsRow, error)
+	GetTransactionsByAccount(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID) ([]Transaction, error)
+	GetTransactionsByAccountAndDateRange(ctx context.Context, accountID uuid.UUID, date pgtype.Date, date_2 pgtype.Date, userID *uuid.UUID) ([]Transaction, error)
+	GetTransactionsByCategory(ctx context.Context, categoryID uuid.UUID, userID *uuid.UUID) ([]Transaction, error)
+	GetTransactionsByDateRange(ctx context.Context, date pgtype.Date, date_2 pgtype.Date, bookID uuid.UUID, userID *uuid.UUID) ([]Transaction, error)
+	GetTransactionsByInstallmentPlan(ctx context.Context, installmentPlanID *uuid.UUID, userID *uuid.UUID) ([]Transaction, error)
+	GetTransactionsByProject(ctx context.Context, projectID *uuid.UUID, userID *uuid.UUID) ([]Transaction, error)
+	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
+	GetUserUsage(ctx context.Context, id uuid.UUID, createdAt time.Time) (GetUserUsageRow, error)
+	LockLogin(ctx context.Context, scope string, key string, lockedUntil *time.Time) error
+	MarkInstallmentPlanPaidOff(ctx context.Context, iD uuid.UUID, paidOffOn pgtype.Date) (InstallmentPlan, error)
+	MarkRestorePointRolledBack(ctx context.Context, id uuid.UUID) (RestorePoint, error)
+	// =============================================================================
+	// BUDGET ALERTS
+	// =============================================================================
+	RecordBudgetAlert(ctx context.Context, budgetID uuid.UUID, month pgtype.Date, threshold int32, percent float64, spent int64) (BudgetAlert, error)
+	RecordLoginFailure(ctx context.Context, scope string, key string, since time.Time) (LoginFailure, error)
 	RefreshAccountBalance(ctx context.Context, accountUuid uuid.UUID) error
-	UpdateAccount(ctx context.Context, iD uuid.UUID, name string, type_ string, description string, asset string) (Account, error)
-	UpdateCategory(ctx context.Context, iD uuid.UUID, name string, type_ string, description string, color string) (Category, error)
-	UpdateTransaction(ctx context.Context, iD uuid.UUID, accountID uuid.UUID, categoryID uuid.UUID, amount int64, description string, date pgtype.Date, status string) (Transaction, error)
+	RemoveExpenseReportTransaction(ctx context.Context, expenseReportID uuid.UUID, transactionID uuid.UUID) (int64, error)
+	RestoreTransaction(ctx context.Context, id uuid.UUID, bookID uuid.UUID, userID *uuid.UUID) (Transaction, error)
+	RevokeOtherSessions(ctx context.Context, userID uuid.UUID, iD uuid.UUID) (int64, error)
+	RevokeSession(ctx context.Context, iD uuid.UUID, userID uuid.UUID) (int64, error)
+	SaveIdempotentResponse(ctx context.Context, userID *uuid.UUID, key string, statusCode int32, contentType string, body []byte) error
+	// Matches the description and payee against a tsquery with the expression of
+	// idx_transactions_search, the best ranked first.
+	SearchTransactions(ctx context.Context, bookID uuid.UUID, userID *uuid.UUID, query string, limit int32, offset int32) ([]Transaction, error)
+	SetAccountNumber(ctx context.Context, iD uuid.UUID, accountNumberLast4 string) error
+	SetInvoiceTransaction(ctx context.Context, iD uuid.UUID, transactionID *uuid.UUID) (Invoice, error)
+	SetUserTier(ctx context.Context, email string, tier string) (int64, error)
+	TouchSession(ctx context.Context, id uuid.UUID) error
+	UpdateAccount(ctx context.Context, iD uuid.UUID, name string, type_ string, description string, asset string, classification *string, institution string, accountNumberLast4 string, color string, icon string, statementClosingDay int32, paymentDueDay int32) (Account, error)
+	UpdateBook(ctx context.Context, iD uuid.UUID, name string, description string, asset string) (Book, error)
+	UpdateBudget(ctx context.Context, iD uuid.UUID, categoryID uuid.UUID, asset string, amount int64, startMonth pgtype.Date, endMonth pgtype.Date, thresholds []int32) (Budget, error)
+	UpdateBudgetTemplate(ctx context.Context, id uuid.UUID, name string, items []byte) (BudgetTemplate, error)
+	UpdateCategory(ctx context.Context, iD uuid.UUID, name string, type_ string, description string, color string, parentID *uuid.UUID) (Category, error)
+	UpdateExpenseReport(ctx context.Context, iD uuid.UUID, bookID uuid.UUID, name string, startDate pgtype.Date, endDate pgtype.Date, notes string, status string, submittedAt *time.Time, reviewedAt *time.Time) (ExpenseReport, error)
+	UpdateInvoice(ctx context.Context, iD uuid.UUID, accountID uuid.UUID, client string, number string, description string, amount int64, issueDate pgtype.Date, dueDate pgtype.Date) (Invoice, error)
+	UpdateProject(ctx context.Context, iD uuid.UUID, bookID uuid.UUID, name string, client string, description string) (Project, error)
+	UpdateReportDefinition(ctx context.Context, id uuid.UUID, name string, definition []byte) (ReportDefinition, error)
+	UpdateSettings(ctx context.Context, currency string, locale string, fiscalMonthStartDay int32, notificationsEnabled bool, notificationEmail string, apiKeys []byte) (Setting, error)
+	UpdateTag(ctx context.Context, id uuid.UUID, name string) (Tag, error)
+	UpdateTransaction(ctx context.Context, iD uuid.UUID, accountID uuid.UUID, categoryID uuid.UUID, amount int64, description string, date pgtype.Date, status string, payee string, projectID *uuid.UUID) (Transaction, error)
+	UpdateTransactionScript(ctx context.Context, id uuid.UUID, name string, source string, enabled bool) (TransactionScript, error)
 	UpdateTransactionStatus(ctx context.Context, iD uuid.UUID, status string) (Transaction, error)
+	UpsertReportSnapshot(ctx context.Context, bookID uuid.UUID, month pgtype.Date, report []byte) (ReportSnapshot, error)
+	UpsertUserSetting(ctx context.Context, userID *uuid.UUID, key string, value string) (UserSetting, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,85 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type IdempotencyRepository struct {
+	queries *gen.Queries
+}
+
+func NewIdempotencyRepository(db *pgxpool.Pool) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		queries: gen.New(db),
+	}
+}
+
+// ClaimIdempotencyKey records the request for the user of the context, or
+// returns the one already recorded with its key
+func (r *IdempotencyRepository) ClaimIdempotencyKey(ctx context.Context, request entities.IdempotentRequest) (entities.IdempotentRequest, bool, error) {
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return entities.IdempotentRequest{}, false, err
+	}
+
+	result, err := r.queries.ClaimIdempotencyKey(ctx, userID, request.Key, request.Fingerprint)
+	if err == nil {
+		return convertIdempotentRequest(result), true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return entities.IdempotentRequest{}, false, err
+	}
+
+	// The key was already used
+	result, err = r.queries.GetIdempotencyKey(ctx, userID, request.Key)
+	if err != nil {
+		return entities.IdempotentRequest{}, false, notFound(err, "idempotency key")
+	}
+	return convertIdempotentRequest(result), false, nil
+}
+
+func (r *IdempotencyRepository) SaveIdempotentResponse(ctx context.Context, key string, response entities.IdempotentResponse) error {
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.SaveIdempotentResponse(ctx, userID, key, int32(response.StatusCode), response.ContentType, response.Body)
+}
+
+func (r *IdempotencyRepository) DeleteIdempotencyKey(ctx context.Context, key string) error {
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.DeleteIdempotencyKey(ctx, userID, key)
+}
+
+func (r *IdempotencyRepository) DeleteIdempotencyKeysBefore(ctx context.Context, before time.Time) (int64, error) {
+	return r.queries.DeleteIdempotencyKeysBefore(ctx, before)
+}
+
+func convertIdempotentRequest(result gen.IdempotencyKey) entities.IdempotentRequest {
+	request := entities.IdempotentRequest{
+		Key:         result.Key,
+		Fingerprint: result.Fingerprint,
+		CreatedAt:   result.CreatedAt,
+	}
+	// The status code is 0 until the response is saved
+	if result.StatusCode != 0 {
+		request.Response = &entities.IdempotentResponse{
+			StatusCode:  int(result.StatusCode),
+			ContentType: result.ContentType,
+			Body:        result.Body,
+		}
+	}
+	return request
+}
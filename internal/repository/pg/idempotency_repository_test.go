@@ -0,0 +1,87 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewIdempotencyRepository(db)
+	users := NewUserRepository(db)
+	ctx := context.Background()
+
+	alice, err := users.CreateUser(ctx, entities.User{Email: "alice@example.com", PasswordHash: "hash"})
+	require.NoError(t, err)
+	bob, err := users.CreateUser(ctx, entities.User{Email: "bob@example.com", PasswordHash: "hash"})
+	require.NoError(t, err)
+	aliceCtx := domain.WithUser(ctx, alice.ID)
+	bobCtx := domain.WithUser(ctx, bob.ID)
+
+	request := entities.IdempotentRequest{Key: "key-1", Fingerprint: "create"}
+
+	t.Run("claim", func(t *testing.T) {
+		claimed, ok, err := repo.ClaimIdempotencyKey(aliceCtx, request)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "create", claimed.Fingerprint)
+		assert.Nil(t, claimed.Response)
+
+		again, ok, err := repo.ClaimIdempotencyKey(aliceCtx, entities.IdempotentRequest{Key: "key-1", Fingerprint: "update"})
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, claimed, again)
+	})
+
+	t.Run("keys are kept per user", func(t *testing.T) {
+		_, ok, err := repo.ClaimIdempotencyKey(bobCtx, request)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		// And under no user without authentication
+		_, ok, err = repo.ClaimIdempotencyKey(ctx, request)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		_, ok, err = repo.ClaimIdempotencyKey(ctx, request)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("save the response", func(t *testing.T) {
+		response := entities.IdempotentResponse{StatusCode: 201, ContentType: "application/json", Body: []byte(`{"id":"tx-1"}`)}
+		require.NoError(t, repo.SaveIdempotentResponse(aliceCtx, "key-1", response))
+
+		stored, ok, err := repo.ClaimIdempotencyKey(aliceCtx, request)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, &response, stored.Response)
+
+		// Bob's request is still being handled
+		stored, _, err = repo.ClaimIdempotencyKey(bobCtx, request)
+		require.NoError(t, err)
+		assert.Nil(t, stored.Response)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.DeleteIdempotencyKey(bobCtx, "key-1"))
+		_, ok, err := repo.ClaimIdempotencyKey(bobCtx, request)
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("delete the expired keys", func(t *testing.T) {
+		deleted, err := repo.DeleteIdempotencyKeysBefore(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
+
+		deleted, err = repo.DeleteIdempotencyKeysBefore(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), deleted)
+	})
+}
@@ -0,0 +1,160 @@
+package pg
+
+import (
+	"context"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type InstallmentRepository struct {
+	queries *gen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewInstallmentRepository(db *pgxpool.Pool) *InstallmentRepository {
+	return &InstallmentRepository{
+		queries: gen.New(db),
+		db:      db,
+	}
+}
+
+func (r *InstallmentRepository) CreateInstallmentPlan(ctx context.Context, plan entities.InstallmentPlan) (entities.InstallmentPlan, error) {
+	accountID, err := uuid.FromString(plan.AccountID)
+	if err != nil {
+		return entities.InstallmentPlan{}, err
+	}
+
+	firstDate := pgtype.Date{Time: plan.FirstDate, Valid: true}
+
+	result, err := r.queries.CreateInstallmentPlan(ctx, accountID, plan.Description, plan.Amount.Amount.Int64(), int32(plan.Count), firstDate)
+	if err != nil {
+		return entities.InstallmentPlan{}, err
+	}
+
+	return r.convertInstallmentPlan(ctx, result, map[uuid.UUID]monetary.Asset{})
+}
+
+func (r *InstallmentRepository) GetInstallmentPlanByID(ctx context.Context, id string) (entities.InstallmentPlan, error) {
+	planID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.InstallmentPlan{}, err
+	}
+
+	result, err := r.queries.GetInstallmentPlanByID(ctx, planID)
+	if err != nil {
+		return entities.InstallmentPlan{}, notFound(err, "installment plan")
+	}
+
+	return r.convertInstallmentPlan(ctx, result, map[uuid.UUID]monetary.Asset{})
+}
+
+func (r *InstallmentRepository) GetAllInstallmentPlans(ctx context.Context) ([]entities.InstallmentPlan, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllInstallmentPlans(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := map[uuid.UUID]monetary.Asset{}
+	plans := make([]entities.InstallmentPlan, len(results))
+	for i, result := range results {
+		if plans[i], err = r.convertInstallmentPlan(ctx, result, assets); err != nil {
+			return nil, err
+		}
+	}
+
+	return plans, nil
+}
+
+func (r *InstallmentRepository) MarkInstallmentPlanPaidOff(ctx context.Context, id string, date time.Time) (entities.InstallmentPlan, error) {
+	planID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.InstallmentPlan{}, err
+	}
+
+	result, err := r.queries.MarkInstallmentPlanPaidOff(ctx, planID, pgtype.Date{Time: date, Valid: true})
+	if err != nil {
+		return entities.InstallmentPlan{}, notFound(err, "installment plan")
+	}
+
+	return r.convertInstallmentPlan(ctx, result, map[uuid.UUID]monetary.Asset{})
+}
+
+// DeleteInstallmentPlan moves the installments of the plan to the trash and
+// deletes the plan, leaving the installments restorable on their own
+func (r *InstallmentRepository) DeleteInstallmentPlan(ctx context.Context, id string) error {
+	planID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	// Rolling back after the commit does nothing
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	queries := r.queries.WithTx(tx)
+	if err := queries.DeleteInstallmentPlanTransactions(ctx, &planID); err != nil {
+		return err
+	}
+	if err := queries.DeleteInstallmentPlan(ctx, planID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// convertInstallmentPlan looks up the asset of the plan's account, once per
+// account across calls sharing assets, hiding the ones of other books
+func (r *InstallmentRepository) convertInstallmentPlan(ctx context.Context, result gen.InstallmentPlan, assets map[uuid.UUID]monetary.Asset) (entities.InstallmentPlan, error) {
+	asset, ok := assets[result.AccountID]
+	if !ok {
+		account, err := r.queries.GetAccountByID(ctx, result.AccountID)
+		if err != nil {
+			return entities.InstallmentPlan{}, fmt.Errorf("failed to get account %s: %w", result.AccountID, err)
+		}
+		if err := inBook(ctx, account.BookID, "installment plan"); err != nil {
+			return entities.InstallmentPlan{}, err
+		}
+
+		asset, ok = entities.FindSupportedAsset(account.Asset)
+		if !ok {
+			asset = monetary.BRL // default fallback
+		}
+		assets[result.AccountID] = asset
+	}
+
+	amount, err := monetary.NewMonetary(asset, big.NewInt(result.Amount))
+	if err != nil {
+		return entities.InstallmentPlan{}, err
+	}
+
+	plan := entities.InstallmentPlan{
+		ID:          result.ID.String(),
+		AccountID:   result.AccountID.String(),
+		Description: result.Description,
+		Amount:      *amount,
+		Count:       int(result.InstallmentCount),
+		FirstDate:   result.FirstDate.Time,
+		CreatedAt:   result.CreatedAt,
+		UpdatedAt:   result.UpdatedAt,
+	}
+	if result.PaidOffOn.Valid {
+		plan.PaidOffOn = result.PaidOffOn.Time
+	}
+
+	return plan, nil
+}
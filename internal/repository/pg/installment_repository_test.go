@@ -0,0 +1,67 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstallmentRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewInstallmentRepository(db)
+	transactions := NewTransactionRepository(db)
+	ctx := context.Background()
+
+	card := createTestAccount(t, db, "Card", entities.AccountTypeCredit)
+	electronics := createTestCategory(t, db, "Electronics", entities.CategoryTypeExpense)
+	march := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	plan, err := repo.CreateInstallmentPlan(ctx, entities.InstallmentPlan{
+		AccountID:   card.ID,
+		Description: "Laptop",
+		Amount:      monetary.Monetary{Asset: card.Asset, Amount: big.NewInt(-300000)},
+		Count:       3,
+		FirstDate:   march,
+	})
+	require.NoError(t, err)
+
+	installments := make([]entities.Transaction, plan.Count)
+	for i := range installments {
+		installments[i], err = transactions.CreateTransaction(ctx, entities.Transaction{
+			AccountID:         card.ID,
+			CategoryID:        electronics.ID,
+			Monetary:          monetary.Monetary{Asset: card.Asset, Amount: big.NewInt(-100000)},
+			Description:       "Laptop",
+			Date:              march.AddDate(0, i, 0),
+			Status:            entities.TransactionStatusPending,
+			InstallmentPlanID: plan.ID,
+			InstallmentNumber: i + 1,
+			InstallmentCount:  plan.Count,
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, transactions.DeleteTransaction(ctx, installments[2].ID))
+
+	t.Run("deleting a plan moves its installments to the trash", func(t *testing.T) {
+		require.NoError(t, repo.DeleteInstallmentPlan(ctx, plan.ID))
+
+		_, err := repo.GetInstallmentPlanByID(ctx, plan.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		count, err := transactions.CountDeletedTransactions(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+
+		restored, err := transactions.RestoreTransaction(ctx, installments[0].ID)
+		require.NoError(t, err)
+		assert.Empty(t, restored.InstallmentPlanID)
+		assert.Equal(t, int64(-100000), restored.Monetary.Amount.Int64())
+	})
+}
@@ -0,0 +1,187 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+	"math/big"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type InvoiceRepository struct {
+	queries *gen.Queries
+}
+
+func NewInvoiceRepository(db *pgxpool.Pool) *InvoiceRepository {
+	return &InvoiceRepository{
+		queries: gen.New(db),
+	}
+}
+
+func (r *InvoiceRepository) CreateInvoice(ctx context.Context, invoice entities.Invoice) (entities.Invoice, error) {
+	accountID, err := uuid.FromString(invoice.AccountID)
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+
+	result, err := r.queries.CreateInvoice(ctx,
+		accountID,
+		invoice.Client,
+		invoice.Number,
+		invoice.Description,
+		invoice.Amount.Amount.Int64(),
+		pgtype.Date{Time: invoice.IssueDate, Valid: true},
+		pgtype.Date{Time: invoice.DueDate, Valid: true},
+	)
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+
+	return r.convertInvoice(ctx, result, map[uuid.UUID]monetary.Asset{})
+}
+
+func (r *InvoiceRepository) GetInvoiceByID(ctx context.Context, id string) (entities.Invoice, error) {
+	invoiceID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+
+	result, err := r.queries.GetInvoiceByID(ctx, invoiceID)
+	if err != nil {
+		return entities.Invoice{}, notFound(err, "invoice")
+	}
+
+	return r.convertInvoice(ctx, result, map[uuid.UUID]monetary.Asset{})
+}
+
+func (r *InvoiceRepository) GetAllInvoices(ctx context.Context) ([]entities.Invoice, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllInvoices(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := map[uuid.UUID]monetary.Asset{}
+	invoices := make([]entities.Invoice, len(results))
+	for i, result := range results {
+		if invoices[i], err = r.convertInvoice(ctx, result, assets); err != nil {
+			return nil, err
+		}
+	}
+
+	return invoices, nil
+}
+
+func (r *InvoiceRepository) UpdateInvoice(ctx context.Context, invoice entities.Invoice) (entities.Invoice, error) {
+	invoiceID, err := uuid.FromString(invoice.ID)
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+	accountID, err := uuid.FromString(invoice.AccountID)
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+
+	result, err := r.queries.UpdateInvoice(ctx,
+		invoiceID,
+		accountID,
+		invoice.Client,
+		invoice.Number,
+		invoice.Description,
+		invoice.Amount.Amount.Int64(),
+		pgtype.Date{Time: invoice.IssueDate, Valid: true},
+		pgtype.Date{Time: invoice.DueDate, Valid: true},
+	)
+	if err != nil {
+		return entities.Invoice{}, notFound(err, "invoice")
+	}
+
+	return r.convertInvoice(ctx, result, map[uuid.UUID]monetary.Asset{})
+}
+
+// SetInvoiceTransaction records the transaction that paid an invoice, empty
+// to clear it. It fails with domain.ErrConflict when the transaction already
+// pays another invoice.
+func (r *InvoiceRepository) SetInvoiceTransaction(ctx context.Context, id, transactionID string) (entities.Invoice, error) {
+	invoiceID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+	transaction, err := nullUUID(transactionID)
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+
+	result, err := r.queries.SetInvoiceTransaction(ctx, invoiceID, transaction)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return entities.Invoice{}, fmt.Errorf("transaction %s already pays another invoice: %w", transactionID, domain.ErrConflict)
+	}
+	if err != nil {
+		return entities.Invoice{}, notFound(err, "invoice")
+	}
+
+	return r.convertInvoice(ctx, result, map[uuid.UUID]monetary.Asset{})
+}
+
+func (r *InvoiceRepository) DeleteInvoice(ctx context.Context, id string) error {
+	invoiceID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.DeleteInvoice(ctx, invoiceID)
+}
+
+// convertInvoice looks up the asset of the invoice's account, once per
+// account across calls sharing assets, hiding the ones of other books
+func (r *InvoiceRepository) convertInvoice(ctx context.Context, result gen.Invoice, assets map[uuid.UUID]monetary.Asset) (entities.Invoice, error) {
+	asset, ok := assets[result.AccountID]
+	if !ok {
+		account, err := r.queries.GetAccountByID(ctx, result.AccountID)
+		if err != nil {
+			return entities.Invoice{}, fmt.Errorf("failed to get account %s: %w", result.AccountID, err)
+		}
+		if err := inBook(ctx, account.BookID, "invoice"); err != nil {
+			return entities.Invoice{}, err
+		}
+
+		asset, ok = entities.FindSupportedAsset(account.Asset)
+		if !ok {
+			asset = monetary.BRL // default fallback
+		}
+		assets[result.AccountID] = asset
+	}
+
+	amount, err := monetary.NewMonetary(asset, big.NewInt(result.Amount))
+	if err != nil {
+		return entities.Invoice{}, err
+	}
+
+	return entities.Invoice{
+		ID:            result.ID.String(),
+		AccountID:     result.AccountID.String(),
+		Client:        result.Client,
+		Number:        result.Number,
+		Description:   result.Description,
+		Amount:        *amount,
+		IssueDate:     result.IssueDate.Time,
+		DueDate:       result.DueDate.Time,
+		TransactionID: uuidString(result.TransactionID),
+		CreatedAt:     result.CreatedAt,
+		UpdatedAt:     result.UpdatedAt,
+	}, nil
+}
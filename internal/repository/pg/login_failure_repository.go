@@ -0,0 +1,61 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type LoginFailureRepository struct {
+	queries *gen.Queries
+}
+
+func NewLoginFailureRepository(db *pgxpool.Pool) *LoginFailureRepository {
+	return &LoginFailureRepository{
+		queries: gen.New(db),
+	}
+}
+
+func (r *LoginFailureRepository) GetLoginFailures(ctx context.Context, scope entities.LoginScope, key string) (entities.LoginFailures, error) {
+	result, err := r.queries.GetLoginFailures(ctx, string(scope), key)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entities.LoginFailures{Scope: scope, Key: key}, nil
+	}
+	if err != nil {
+		return entities.LoginFailures{}, err
+	}
+
+	return convertLoginFailures(result), nil
+}
+
+func (r *LoginFailureRepository) RecordLoginFailure(ctx context.Context, scope entities.LoginScope, key string, since time.Time) (entities.LoginFailures, error) {
+	result, err := r.queries.RecordLoginFailure(ctx, string(scope), key, since)
+	if err != nil {
+		return entities.LoginFailures{}, err
+	}
+
+	return convertLoginFailures(result), nil
+}
+
+func (r *LoginFailureRepository) LockLogin(ctx context.Context, scope entities.LoginScope, key string, until time.Time) error {
+	return r.queries.LockLogin(ctx, string(scope), key, &until)
+}
+
+func (r *LoginFailureRepository) ClearLoginFailures(ctx context.Context, scope entities.LoginScope, key string) error {
+	return r.queries.ClearLoginFailures(ctx, string(scope), key)
+}
+
+func convertLoginFailures(result gen.LoginFailure) entities.LoginFailures {
+	return entities.LoginFailures{
+		Scope:        entities.LoginScope(result.Scope),
+		Key:          result.Key,
+		Failures:     int(result.Failures),
+		LastFailedAt: result.LastFailedAt,
+		LockedUntil:  result.LockedUntil,
+	}
+}
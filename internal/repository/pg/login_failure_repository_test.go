@@ -0,0 +1,59 @@
+package pg
+
+import (
+	"context"
+	"finance/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginFailureRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewLoginFailureRepository(db)
+	ctx := context.Background()
+	const email = "alice@example.com"
+
+	t.Run("none recorded", func(t *testing.T) {
+		failures, err := repo.GetLoginFailures(ctx, entities.LoginScopeEmail, email)
+		require.NoError(t, err)
+		assert.Zero(t, failures.Failures)
+		assert.Nil(t, failures.LockedUntil)
+	})
+
+	t.Run("record and lock", func(t *testing.T) {
+		since := time.Now().Add(-time.Hour)
+		for i := 1; i <= 3; i++ {
+			failures, err := repo.RecordLoginFailure(ctx, entities.LoginScopeEmail, email, since)
+			require.NoError(t, err)
+			assert.Equal(t, i, failures.Failures)
+		}
+		// Addresses are counted apart
+		failures, err := repo.RecordLoginFailure(ctx, entities.LoginScopeIP, "192.0.2.1", since)
+		require.NoError(t, err)
+		assert.Equal(t, 1, failures.Failures)
+
+		until := time.Now().Add(time.Minute).Truncate(time.Second)
+		require.NoError(t, repo.LockLogin(ctx, entities.LoginScopeEmail, email, until))
+		failures, err = repo.GetLoginFailures(ctx, entities.LoginScopeEmail, email)
+		require.NoError(t, err)
+		assert.Equal(t, 3, failures.Failures)
+		require.NotNil(t, failures.LockedUntil)
+		assert.True(t, until.Equal(*failures.LockedUntil))
+	})
+
+	t.Run("failures before since start over", func(t *testing.T) {
+		failures, err := repo.RecordLoginFailure(ctx, entities.LoginScopeEmail, email, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, failures.Failures)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		require.NoError(t, repo.ClearLoginFailures(ctx, entities.LoginScopeEmail, email))
+		failures, err := repo.GetLoginFailures(ctx, entities.LoginScopeEmail, email)
+		require.NoError(t, err)
+		assert.Zero(t, failures.Failures)
+	})
+}
@@ -0,0 +1,81 @@
+package pg
+
+import (
+	"cmp"
+	"context"
+	"embed"
+	"errors"
+	"finance/domain/entities"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// migrationFiles ships the migrations with the service, so it can tell which
+// of them the database is missing
+//
+//go:embed migrations/*.up.sql
+var migrationFiles embed.FS
+
+// undefinedTable is the Postgres error code for a missing table
+const undefinedTable = "42P01"
+
+type MigrationRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewMigrationRepository(db *pgxpool.Pool) *MigrationRepository {
+	return &MigrationRepository{
+		db: db,
+	}
+}
+
+// ListMigrations returns the shipped migrations ordered by version, parsed
+// from their file names (e.g. 000010_account_institution.up.sql)
+func (r *MigrationRepository) ListMigrations() ([]entities.Migration, error) {
+	files, err := fs.Glob(migrationFiles, "migrations/*.up.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]entities.Migration, 0, len(files))
+	for _, file := range files {
+		version, name, _ := strings.Cut(strings.TrimSuffix(path.Base(file), ".up.sql"), "_")
+		number, err := strconv.ParseInt(version, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name %s: %w", path.Base(file), err)
+		}
+		migrations = append(migrations, entities.Migration{Version: number, Name: name})
+	}
+
+	slices.SortFunc(migrations, func(a, b entities.Migration) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+	return migrations, nil
+}
+
+// GetSchemaVersion reads the version recorded by golang-migrate, reporting 0
+// for a database it never migrated
+func (r *MigrationRepository) GetSchemaVersion(ctx context.Context) (int64, bool, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+	err := r.db.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, pgx.ErrNoRows), errors.As(err, &pgErr) && pgErr.Code == undefinedTable:
+		return 0, false, nil
+	case err != nil:
+		return 0, false, err
+	}
+	return version, dirty, nil
+}
@@ -0,0 +1,50 @@
+package pg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationRepository(t *testing.T) {
+	t.Run("list shipped migrations", func(t *testing.T) {
+		migrations, err := NewMigrationRepository(nil).ListMigrations()
+		require.NoError(t, err)
+		require.NotEmpty(t, migrations)
+		assert.Equal(t, int64(1), migrations[0].Version)
+		assert.Equal(t, "consolidated_schema", migrations[0].Name)
+		for i := 1; i < len(migrations); i++ {
+			assert.Equal(t, migrations[i-1].Version+1, migrations[i].Version, "migrations should be numbered in sequence")
+		}
+	})
+
+	db := newTestDB(t)
+	repo := NewMigrationRepository(db)
+	ctx := context.Background()
+
+	t.Run("never migrated", func(t *testing.T) {
+		version, dirty, err := repo.GetSchemaVersion(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, version)
+		assert.False(t, dirty)
+	})
+
+	t.Run("version recorded by migrate", func(t *testing.T) {
+		_, err := db.Exec(ctx, "CREATE TABLE schema_migrations (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)")
+		require.NoError(t, err)
+
+		version, _, err := repo.GetSchemaVersion(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, version)
+
+		_, err = db.Exec(ctx, "INSERT INTO schema_migrations (version, dirty) VALUES (7, true)")
+		require.NoError(t, err)
+
+		version, dirty, err := repo.GetSchemaVersion(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), version)
+		assert.True(t, dirty)
+	})
+}
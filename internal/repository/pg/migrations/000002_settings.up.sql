@@ -0,0 +1,21 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- SETTINGS
+-- =============================================================================
+
+-- Application wide settings managed from the admin page (single row)
+CREATE TABLE IF NOT EXISTS settings (
+    "id" BOOLEAN NOT NULL PRIMARY KEY DEFAULT TRUE CHECK (id),
+    "currency" TEXT NOT NULL DEFAULT 'BRL' CHECK (currency IN ('BRL', 'USD', 'EUR', 'GBP', 'JPY', 'CAD', 'AUD', 'BTC', 'ETH')),
+    "locale" TEXT NOT NULL DEFAULT 'pt-BR',
+    "fiscal_month_start_day" INTEGER NOT NULL DEFAULT 1 CHECK (fiscal_month_start_day BETWEEN 1 AND 28),
+    "notifications_enabled" BOOLEAN NOT NULL DEFAULT FALSE,
+    "notification_email" TEXT NOT NULL DEFAULT '',
+    "api_keys" JSONB NOT NULL DEFAULT '{}', -- Third-party API keys indexed by provider
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO settings (id) VALUES (TRUE) ON CONFLICT (id) DO NOTHING;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- USER SETTINGS
+-- =============================================================================
+
+-- User preferences stored as key/value pairs. Known keys are validated by the
+-- application, missing keys fall back to their defaults.
+CREATE TABLE IF NOT EXISTS user_settings (
+    "key" TEXT NOT NULL PRIMARY KEY CHECK (key ~ '^[a-z][a-z0-9_]*$'),
+    "value" TEXT NOT NULL,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMIT;
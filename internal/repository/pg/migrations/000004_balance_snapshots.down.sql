@@ -0,0 +1,27 @@
+BEGIN TRANSACTION;
+
+-- Restore the balance function without snapshots
+CREATE OR REPLACE FUNCTION update_account_balance(account_uuid UUID)
+RETURNS VOID AS $$
+BEGIN
+    INSERT INTO balances (account_id, current_balance, pending_balance, available_balance, last_calculated)
+    SELECT 
+        account_uuid,
+        COALESCE(SUM(CASE WHEN status = 'cleared' THEN amount ELSE 0 END), 0) as current_balance,
+        COALESCE(SUM(CASE WHEN status = 'pending' THEN amount ELSE 0 END), 0) as pending_balance,
+        COALESCE(SUM(CASE WHEN status IN ('cleared', 'pending') THEN amount ELSE 0 END), 0) as available_balance,
+        NOW()
+    FROM transactions 
+    WHERE account_id = account_uuid
+    ON CONFLICT (account_id) 
+    DO UPDATE SET 
+        current_balance = EXCLUDED.current_balance,
+        pending_balance = EXCLUDED.pending_balance,
+        available_balance = EXCLUDED.available_balance,
+        last_calculated = EXCLUDED.last_calculated;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS balance_snapshots;
+
+COMMIT;
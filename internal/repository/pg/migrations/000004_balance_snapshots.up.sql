@@ -0,0 +1,58 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- BALANCE SNAPSHOTS
+-- =============================================================================
+
+-- Daily snapshot of each account current balance, used to compute balance deltas
+CREATE TABLE IF NOT EXISTS balance_snapshots (
+    "account_id" UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    "snapshot_date" DATE NOT NULL,
+    "current_balance" BIGINT NOT NULL DEFAULT 0, -- Stored as smallest currency unit
+    PRIMARY KEY (account_id, snapshot_date)
+);
+
+-- Backfill the last 30 days from the cleared transactions of each account
+INSERT INTO balance_snapshots (account_id, snapshot_date, current_balance)
+SELECT
+    a.id,
+    d.day::DATE,
+    COALESCE((
+        SELECT SUM(t.amount)
+        FROM transactions t
+        WHERE t.account_id = a.id AND t.status = 'cleared' AND t.date <= d.day::DATE
+    ), 0)
+FROM accounts a
+CROSS JOIN generate_series(CURRENT_DATE - 30, CURRENT_DATE, INTERVAL '1 day') AS d(day)
+ON CONFLICT (account_id, snapshot_date) DO NOTHING;
+
+-- Keep today's snapshot in sync whenever a balance is recalculated
+CREATE OR REPLACE FUNCTION update_account_balance(account_uuid UUID)
+RETURNS VOID AS $$
+BEGIN
+    INSERT INTO balances (account_id, current_balance, pending_balance, available_balance, last_calculated)
+    SELECT 
+        account_uuid,
+        COALESCE(SUM(CASE WHEN status = 'cleared' THEN amount ELSE 0 END), 0) as current_balance,
+        COALESCE(SUM(CASE WHEN status = 'pending' THEN amount ELSE 0 END), 0) as pending_balance,
+        COALESCE(SUM(CASE WHEN status IN ('cleared', 'pending') THEN amount ELSE 0 END), 0) as available_balance,
+        NOW()
+    FROM transactions 
+    WHERE account_id = account_uuid
+    ON CONFLICT (account_id) 
+    DO UPDATE SET 
+        current_balance = EXCLUDED.current_balance,
+        pending_balance = EXCLUDED.pending_balance,
+        available_balance = EXCLUDED.available_balance,
+        last_calculated = EXCLUDED.last_calculated;
+
+    INSERT INTO balance_snapshots (account_id, snapshot_date, current_balance)
+    SELECT account_id, CURRENT_DATE, current_balance
+    FROM balances
+    WHERE account_id = account_uuid
+    ON CONFLICT (account_id, snapshot_date)
+    DO UPDATE SET current_balance = EXCLUDED.current_balance;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN TRANSACTION;
+
+DROP INDEX IF EXISTS idx_categories_type_name;
+DROP INDEX IF EXISTS idx_accounts_name;
+DROP INDEX IF EXISTS idx_transactions_amount;
+DROP INDEX IF EXISTS idx_transactions_date_created_at;
+
+COMMIT;
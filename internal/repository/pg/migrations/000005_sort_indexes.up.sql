@@ -0,0 +1,16 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- INDEXES FOR SORTED LISTS
+-- =============================================================================
+
+-- Default transaction list order, also serves ?sort=-date
+CREATE INDEX IF NOT EXISTS idx_transactions_date_created_at ON transactions(date DESC, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_transactions_amount ON transactions(amount);
+
+CREATE INDEX IF NOT EXISTS idx_accounts_name ON accounts(name);
+
+-- Default category list order
+CREATE INDEX IF NOT EXISTS idx_categories_type_name ON categories(type, name);
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN TRANSACTION;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_account_id ON transactions(account_id);
+CREATE INDEX IF NOT EXISTS idx_transactions_category_id ON transactions(category_id);
+CREATE INDEX IF NOT EXISTS idx_transactions_date ON transactions(date);
+CREATE INDEX IF NOT EXISTS idx_transactions_status ON transactions(status);
+
+DROP INDEX IF EXISTS idx_transactions_status_date;
+DROP INDEX IF EXISTS idx_transactions_category_id_date;
+DROP INDEX IF EXISTS idx_transactions_account_id_date;
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- INDEXES FOR TRANSACTION FILTERS
+-- =============================================================================
+
+-- The filtered lists order by date DESC, created_at DESC, so the composite
+-- indexes serve both the filter and the ordering and replace the single
+-- column ones
+CREATE INDEX IF NOT EXISTS idx_transactions_account_id_date ON transactions(account_id, date DESC, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_transactions_category_id_date ON transactions(category_id, date DESC, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_transactions_status_date ON transactions(status, date DESC);
+
+DROP INDEX IF EXISTS idx_transactions_account_id;
+DROP INDEX IF EXISTS idx_transactions_category_id;
+DROP INDEX IF EXISTS idx_transactions_status;
+
+-- Covered by idx_transactions_date_created_at
+DROP INDEX IF EXISTS idx_transactions_date;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN TRANSACTION;
+
+UPDATE balance_snapshots
+SET current_balance = -current_balance
+WHERE account_id IN (SELECT id FROM accounts WHERE type = 'credit');
+
+UPDATE transactions
+SET amount = -amount
+WHERE account_id IN (SELECT id FROM accounts WHERE type = 'credit');
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- LIABILITY ACCOUNT SIGNS
+-- =============================================================================
+
+-- Transactions on liability (credit) accounts are now signed by their effect on
+-- the balance owed: expenses are positive and payments negative, so the balance
+-- of a credit account is what's owed on it.
+
+-- Snapshots are flipped first, the transactions trigger below recalculates
+-- today's snapshot from the flipped amounts
+UPDATE balance_snapshots
+SET current_balance = -current_balance
+WHERE account_id IN (SELECT id FROM accounts WHERE type = 'credit');
+
+UPDATE transactions
+SET amount = -amount
+WHERE account_id IN (SELECT id FROM accounts WHERE type = 'credit');
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN TRANSACTION;
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS classification;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- ACCOUNT CLASSIFICATION
+-- =============================================================================
+
+-- Overrides whether an account aggregates as an asset or a liability. When NULL
+-- the classification follows the account type: credit accounts are liabilities
+-- and everything else is an asset.
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS "classification" TEXT CHECK (classification IN ('asset', 'liability'));
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN TRANSACTION;
+
+DELETE FROM transactions WHERE status = 'draft';
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_status_check;
+ALTER TABLE transactions
+    ADD CONSTRAINT transactions_status_check CHECK (status IN ('pending', 'cleared', 'cancelled'));
+
+COMMIT;
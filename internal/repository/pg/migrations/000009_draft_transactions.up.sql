@@ -0,0 +1,14 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- DRAFT TRANSACTIONS
+-- =============================================================================
+
+-- Drafts are transactions saved halfway through a multi-step entry. Balances,
+-- snapshots, statements and summaries only count cleared and pending amounts, so
+-- drafts stay out of them until they are finalized.
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_status_check;
+ALTER TABLE transactions
+    ADD CONSTRAINT transactions_status_check CHECK (status IN ('draft', 'pending', 'cleared', 'cancelled'));
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN TRANSACTION;
+
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS icon,
+    DROP COLUMN IF EXISTS color,
+    DROP COLUMN IF EXISTS account_number_last4,
+    DROP COLUMN IF EXISTS institution;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- ACCOUNT INSTITUTION
+-- =============================================================================
+
+-- Optional details telling apart accounts at the same bank. Empty when not set.
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS "institution" TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS "account_number_last4" TEXT NOT NULL DEFAULT '' CHECK (account_number_last4 ~ '^([0-9]{4})?$'),
+    ADD COLUMN IF NOT EXISTS "color" TEXT NOT NULL DEFAULT '' CHECK (color ~ '^(#[0-9A-Fa-f]{6})?$'),
+    ADD COLUMN IF NOT EXISTS "icon" TEXT NOT NULL DEFAULT '';
+
+COMMIT;
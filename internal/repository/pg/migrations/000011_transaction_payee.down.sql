@@ -0,0 +1,8 @@
+BEGIN TRANSACTION;
+
+DROP INDEX IF EXISTS idx_transactions_payee;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS payee;
+
+COMMIT;
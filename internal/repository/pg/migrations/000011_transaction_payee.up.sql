@@ -0,0 +1,13 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- TRANSACTION PAYEE
+-- =============================================================================
+
+-- Who the money went to or came from, as told by the bank. Empty when unknown.
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS "payee" TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_transactions_payee ON transactions (LOWER(payee)) WHERE payee <> '';
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN TRANSACTION;
+
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS payment_due_day,
+    DROP COLUMN IF EXISTS statement_closing_day;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- CARD BILLING CYCLE
+-- =============================================================================
+
+-- The days of the month a credit card closes its statement (fatura) and the
+-- payment is due. Zero when not set; months shorter than the day close and
+-- fall due on their last day.
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS "statement_closing_day" INTEGER NOT NULL DEFAULT 0 CHECK (statement_closing_day BETWEEN 0 AND 31),
+    ADD COLUMN IF NOT EXISTS "payment_due_day" INTEGER NOT NULL DEFAULT 0 CHECK (payment_due_day BETWEEN 0 AND 31);
+
+COMMIT;
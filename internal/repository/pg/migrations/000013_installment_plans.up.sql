@@ -0,0 +1,33 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- INSTALLMENT PLANS
+-- =============================================================================
+
+-- A purchase paid in installments (parcelamento): the total amount, split into
+-- one transaction a month from the first date. paid_off_on is set once the
+-- remaining installments are paid in advance.
+CREATE TABLE IF NOT EXISTS installment_plans (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "account_id" UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    "description" TEXT NOT NULL DEFAULT '',
+    "amount" BIGINT NOT NULL, -- Stored as smallest currency unit
+    "installment_count" INTEGER NOT NULL CHECK (installment_count BETWEEN 2 AND 48),
+    "first_date" DATE NOT NULL,
+    "paid_off_on" DATE,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_installment_plans_account_id ON installment_plans (account_id);
+
+-- The installments link back to their plan with their position, like 3 of 12.
+-- Deleting the plan deletes its installments.
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS "installment_plan_id" UUID REFERENCES installment_plans(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS "installment_number" INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "installment_count" INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_installment_plan_id ON transactions (installment_plan_id) WHERE installment_plan_id IS NOT NULL;
+
+COMMIT;
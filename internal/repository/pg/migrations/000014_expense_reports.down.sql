@@ -0,0 +1,6 @@
+BEGIN TRANSACTION;
+
+DROP TABLE IF EXISTS expense_report_transactions;
+DROP TABLE IF EXISTS expense_reports;
+
+COMMIT;
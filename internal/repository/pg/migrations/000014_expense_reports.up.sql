@@ -0,0 +1,33 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- EXPENSE REPORTS
+-- =============================================================================
+
+-- A group of work expenses to be reimbursed, like the ones of a trip. Reports
+-- move from draft to submitted, then approved or rejected. submitted_at and
+-- reviewed_at record when they were last submitted and approved or rejected.
+CREATE TABLE IF NOT EXISTS expense_reports (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "name" VARCHAR(255) NOT NULL,
+    "start_date" DATE NOT NULL,
+    "end_date" DATE NOT NULL,
+    "notes" TEXT NOT NULL DEFAULT '',
+    "status" VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'submitted', 'approved', 'rejected')),
+    "submitted_at" TIMESTAMPTZ,
+    "reviewed_at" TIMESTAMPTZ,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (end_date >= start_date)
+);
+
+-- The transactions of a report. A transaction is reimbursed once, so it's on
+-- one report at most.
+CREATE TABLE IF NOT EXISTS expense_report_transactions (
+    "expense_report_id" UUID NOT NULL REFERENCES expense_reports(id) ON DELETE CASCADE,
+    "transaction_id" UUID NOT NULL UNIQUE REFERENCES transactions(id) ON DELETE CASCADE,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (expense_report_id, transaction_id)
+);
+
+COMMIT;
@@ -0,0 +1,28 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- INVOICES
+-- =============================================================================
+
+-- Invoices issued to clients, to be paid into an account in its asset.
+-- transaction_id is the incoming transaction that paid the invoice, a
+-- transaction pays one invoice at most. Invoices without one are receivable.
+CREATE TABLE IF NOT EXISTS invoices (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "account_id" UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    "client" VARCHAR(255) NOT NULL,
+    "number" VARCHAR(100) NOT NULL DEFAULT '',
+    "description" TEXT NOT NULL DEFAULT '',
+    "amount" BIGINT NOT NULL CHECK (amount > 0),
+    "issue_date" DATE NOT NULL,
+    "due_date" DATE NOT NULL,
+    "transaction_id" UUID UNIQUE REFERENCES transactions(id) ON DELETE SET NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (due_date >= issue_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoices_account_id ON invoices(account_id);
+CREATE INDEX IF NOT EXISTS idx_invoices_due_date ON invoices(due_date);
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN TRANSACTION;
+
+DROP INDEX IF EXISTS idx_transactions_project_id;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS project_id;
+
+DROP TABLE IF EXISTS projects;
+
+COMMIT;
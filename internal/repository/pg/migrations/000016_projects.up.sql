@@ -0,0 +1,24 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- PROJECTS
+-- =============================================================================
+
+-- Projects, optionally done for a client, that transactions can be filed
+-- under to report income and expenses per project
+CREATE TABLE IF NOT EXISTS projects (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "name" VARCHAR(255) NOT NULL UNIQUE,
+    "client" VARCHAR(255) NOT NULL DEFAULT '',
+    "description" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Deleting a project keeps its transactions, outside of any project
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS "project_id" UUID REFERENCES projects(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_project_id ON transactions (project_id);
+
+COMMIT;
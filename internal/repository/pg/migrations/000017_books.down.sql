@@ -0,0 +1,16 @@
+BEGIN TRANSACTION;
+
+DROP INDEX IF EXISTS idx_categories_book_id_name_type;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_name_type ON categories (name, type);
+
+DROP INDEX IF EXISTS idx_accounts_book_id;
+
+ALTER TABLE categories
+    DROP COLUMN IF EXISTS book_id;
+
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS book_id;
+
+DROP TABLE IF EXISTS books;
+
+COMMIT;
@@ -0,0 +1,36 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- BOOKS
+-- =============================================================================
+
+-- Books are separate ledgers, like "Personal" and "Side business", each with
+-- its own accounts and categories
+CREATE TABLE IF NOT EXISTS books (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "name" VARCHAR(255) NOT NULL UNIQUE,
+    "description" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- The default book holds everything recorded before books existed, and what
+-- is recorded without picking a book
+INSERT INTO books (id, name)
+VALUES ('00000000-0000-0000-0000-000000000001', 'Personal')
+ON CONFLICT (id) DO NOTHING;
+
+-- Books can only be deleted once they're empty
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS "book_id" UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES books(id);
+
+ALTER TABLE categories
+    ADD COLUMN IF NOT EXISTS "book_id" UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES books(id);
+
+CREATE INDEX IF NOT EXISTS idx_accounts_book_id ON accounts (book_id);
+
+-- Category names are unique within their type in each book
+DROP INDEX IF EXISTS idx_categories_name_type;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_book_id_name_type ON categories (book_id, name, type);
+
+COMMIT;
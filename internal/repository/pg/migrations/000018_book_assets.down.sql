@@ -0,0 +1,6 @@
+BEGIN TRANSACTION;
+
+ALTER TABLE books
+    DROP COLUMN IF EXISTS asset;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN TRANSACTION;
+
+-- Each book reports in its own base currency, the books existing so far in
+-- the currency of the settings
+ALTER TABLE books
+    ADD COLUMN IF NOT EXISTS "asset" TEXT NOT NULL DEFAULT 'BRL' CHECK (asset IN ('BRL', 'USD', 'EUR', 'GBP', 'JPY', 'CAD', 'AUD', 'BTC', 'ETH'));
+
+UPDATE books SET asset = settings.currency FROM settings;
+
+COMMIT;
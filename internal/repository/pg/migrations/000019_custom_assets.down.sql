@@ -0,0 +1,13 @@
+BEGIN TRANSACTION;
+
+-- NOT VALID keeps the rows already in custom assets, new ones are checked
+ALTER TABLE settings
+    ADD CONSTRAINT settings_currency_check CHECK (currency IN ('BRL', 'USD', 'EUR', 'GBP', 'JPY', 'CAD', 'AUD', 'BTC', 'ETH')) NOT VALID;
+ALTER TABLE books
+    ADD CONSTRAINT books_asset_check CHECK (asset IN ('BRL', 'USD', 'EUR', 'GBP', 'JPY', 'CAD', 'AUD', 'BTC', 'ETH')) NOT VALID;
+ALTER TABLE accounts
+    ADD CONSTRAINT accounts_asset_check CHECK (asset IN ('BRL', 'USD', 'EUR', 'GBP', 'JPY', 'CAD', 'AUD', 'BTC', 'ETH')) NOT VALID;
+
+DROP TABLE IF EXISTS custom_assets;
+
+COMMIT;
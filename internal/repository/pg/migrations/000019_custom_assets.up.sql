@@ -0,0 +1,22 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- CUSTOM ASSETS
+-- =============================================================================
+
+-- Custom assets are enabled per deployment next to the built-in currencies,
+-- like loyalty points or airline miles
+CREATE TABLE IF NOT EXISTS custom_assets (
+    "code" TEXT NOT NULL PRIMARY KEY CHECK (code ~ '^[A-Z][A-Z0-9]{1,9}$'),
+    "name" VARCHAR(100) NOT NULL,
+    "symbol" VARCHAR(10) NOT NULL,
+    "precision" INTEGER NOT NULL CHECK (precision BETWEEN 0 AND 18),
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- The supported assets are checked by the service, which knows the custom ones
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_asset_check;
+ALTER TABLE books DROP CONSTRAINT IF EXISTS books_asset_check;
+ALTER TABLE settings DROP CONSTRAINT IF EXISTS settings_currency_check;
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- REPORT SNAPSHOTS
+-- =============================================================================
+
+-- Monthly reports are frozen once their month is closed, so later edits of the
+-- transactions don't rewrite past months. The report is kept as it was
+-- calculated, category names included, and only replaced when recalculated.
+CREATE TABLE IF NOT EXISTS report_snapshots (
+    "book_id" UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    "month" DATE NOT NULL CHECK (EXTRACT(DAY FROM month) = 1),
+    "report" JSONB NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (book_id, month)
+);
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- RESTORE POINTS
+-- =============================================================================
+
+-- Bulk operations like statement imports record the rows they change in a
+-- restore point, so they can be reverted when they went wrong. changes lists
+-- the rows created and the state of the rows updated before the operation.
+-- rolled_back_at is set once the operation is reverted.
+CREATE TABLE IF NOT EXISTS restore_points (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "book_id" UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    "operation" VARCHAR(50) NOT NULL,
+    "description" TEXT NOT NULL DEFAULT '',
+    "changes" JSONB NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "rolled_back_at" TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_restore_points_book_id_created_at ON restore_points(book_id, created_at DESC);
+
+COMMIT;
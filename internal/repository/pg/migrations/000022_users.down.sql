@@ -0,0 +1,13 @@
+BEGIN TRANSACTION;
+
+DROP INDEX IF EXISTS idx_books_user_id_name;
+DROP INDEX IF EXISTS idx_books_user_id;
+
+ALTER TABLE books
+    DROP COLUMN IF EXISTS user_id;
+
+ALTER TABLE books ADD CONSTRAINT books_name_key UNIQUE (name);
+
+DROP TABLE IF EXISTS users;
+
+COMMIT;
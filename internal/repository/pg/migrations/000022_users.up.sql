@@ -0,0 +1,30 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- USERS
+-- =============================================================================
+
+-- Users sign in with their email and password. Emails are kept lowercased.
+CREATE TABLE IF NOT EXISTS users (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "email" VARCHAR(254) NOT NULL UNIQUE,
+    "name" VARCHAR(100) NOT NULL DEFAULT '',
+    "password_hash" TEXT NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Books belong to the user who created them, the accounts, categories and
+-- transactions in them with it. Books recorded before users existed have no
+-- owner until the first user registers and takes them over.
+ALTER TABLE books
+    ADD COLUMN IF NOT EXISTS "user_id" UUID REFERENCES users(id);
+
+CREATE INDEX IF NOT EXISTS idx_books_user_id ON books (user_id);
+
+-- Book names are unique for each user instead of across the deployment
+ALTER TABLE books DROP CONSTRAINT IF EXISTS books_name_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_books_user_id_name
+    ON books (COALESCE(user_id, '00000000-0000-0000-0000-000000000000'), name);
+
+COMMIT;
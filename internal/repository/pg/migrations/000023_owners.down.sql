@@ -0,0 +1,11 @@
+BEGIN TRANSACTION;
+
+DROP INDEX IF EXISTS idx_transactions_user_id;
+DROP INDEX IF EXISTS idx_categories_user_id;
+DROP INDEX IF EXISTS idx_accounts_user_id;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS user_id;
+ALTER TABLE categories DROP COLUMN IF EXISTS user_id;
+ALTER TABLE accounts DROP COLUMN IF EXISTS user_id;
+
+COMMIT;
@@ -0,0 +1,39 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- OWNERS
+-- =============================================================================
+
+-- Accounts, categories and transactions carry the user owning them, the owner
+-- of their book, so each query can be filtered by it without joining back to
+-- the book. Rows recorded before users existed have no owner until the first
+-- user registers and takes the books over.
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS "user_id" UUID REFERENCES users(id);
+
+ALTER TABLE categories
+    ADD COLUMN IF NOT EXISTS "user_id" UUID REFERENCES users(id);
+
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS "user_id" UUID REFERENCES users(id);
+
+UPDATE accounts a
+SET user_id = b.user_id
+FROM books b
+WHERE b.id = a.book_id AND b.user_id IS NOT NULL;
+
+UPDATE categories c
+SET user_id = b.user_id
+FROM books b
+WHERE b.id = c.book_id AND b.user_id IS NOT NULL;
+
+UPDATE transactions t
+SET user_id = a.user_id
+FROM accounts a
+WHERE a.id = t.account_id AND a.user_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_accounts_user_id ON accounts (user_id);
+CREATE INDEX IF NOT EXISTS idx_categories_user_id ON categories (user_id);
+CREATE INDEX IF NOT EXISTS idx_transactions_user_id ON transactions (user_id);
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- SESSIONS
+-- =============================================================================
+
+-- Each sign in starts a session, named in the token it issues, so a token can
+-- be revoked before it expires. The device is told apart by the user agent and
+-- address it signed in from.
+CREATE TABLE IF NOT EXISTS sessions (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "user_id" UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    "user_agent" VARCHAR(500) NOT NULL DEFAULT '',
+    "ip_address" VARCHAR(45) NOT NULL DEFAULT '',
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "last_seen_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "expires_at" TIMESTAMPTZ NOT NULL,
+    "revoked_at" TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id, last_seen_at DESC);
+
+COMMIT;
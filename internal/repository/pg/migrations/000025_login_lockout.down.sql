@@ -0,0 +1,7 @@
+BEGIN TRANSACTION;
+
+DROP INDEX IF EXISTS idx_auth_events_user_id;
+DROP TABLE IF EXISTS auth_events;
+DROP TABLE IF EXISTS login_failures;
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- LOGIN LOCKOUT
+-- =============================================================================
+
+-- Failed sign ins in a row of an email, or from an address. Past a threshold
+-- the sign ins are refused until locked_until, for longer with each failure.
+CREATE TABLE IF NOT EXISTS login_failures (
+    "scope" VARCHAR(10) NOT NULL,
+    "key" VARCHAR(254) NOT NULL,
+    "failures" INTEGER NOT NULL DEFAULT 0,
+    "last_failed_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "locked_until" TIMESTAMPTZ,
+    PRIMARY KEY (scope, key)
+);
+
+-- Audit log of the suspicious sign in activity. The user is known when the
+-- email is registered.
+CREATE TABLE IF NOT EXISTS auth_events (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "user_id" UUID REFERENCES users(id) ON DELETE CASCADE,
+    "type" VARCHAR(30) NOT NULL,
+    "email" VARCHAR(254) NOT NULL DEFAULT '',
+    "ip_address" VARCHAR(45) NOT NULL DEFAULT '',
+    "user_agent" VARCHAR(500) NOT NULL DEFAULT '',
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_events_user_id ON auth_events (user_id, created_at DESC);
+
+COMMIT;
@@ -0,0 +1,27 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- BUDGETS
+-- =============================================================================
+
+-- Monthly spending limits, one per category at most. amount is the limit in
+-- the smallest unit of asset, only transactions of accounts in that asset are
+-- counted against it. The budget applies from start_month on, through
+-- end_month when set.
+CREATE TABLE IF NOT EXISTS budgets (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "book_id" UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    "user_id" UUID REFERENCES users(id),
+    "category_id" UUID NOT NULL UNIQUE REFERENCES categories(id) ON DELETE CASCADE,
+    "asset" TEXT NOT NULL,
+    "amount" BIGINT NOT NULL CHECK (amount > 0),
+    "start_month" DATE NOT NULL,
+    "end_month" DATE,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (end_month IS NULL OR end_month >= start_month)
+);
+
+CREATE INDEX IF NOT EXISTS idx_budgets_book_id ON budgets(book_id);
+
+COMMIT;
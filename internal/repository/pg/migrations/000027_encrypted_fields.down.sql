@@ -0,0 +1,8 @@
+BEGIN TRANSACTION;
+
+-- NOT VALID keeps the encrypted numbers already stored, decrypt them first to
+-- validate the constraint
+ALTER TABLE accounts
+    ADD CONSTRAINT accounts_account_number_last4_check CHECK (account_number_last4 ~ '^([0-9]{4})?$') NOT VALID;
+
+COMMIT;
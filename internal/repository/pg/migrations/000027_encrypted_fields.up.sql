@@ -0,0 +1,12 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- ENCRYPTED FIELDS
+-- =============================================================================
+
+-- The account numbers are stored encrypted when ENCRYPTION_KEYS is set, so
+-- the column no longer holds just the 4 digits. They're validated before
+-- being encrypted.
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_account_number_last4_check;
+
+COMMIT;
@@ -0,0 +1,33 @@
+BEGIN TRANSACTION;
+
+DROP POLICY IF EXISTS owner_isolation ON restore_points;
+ALTER TABLE restore_points NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE restore_points DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS owner_isolation ON report_snapshots;
+ALTER TABLE report_snapshots NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE report_snapshots DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS owner_isolation ON budgets;
+ALTER TABLE budgets NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE budgets DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS owner_isolation ON transactions;
+ALTER TABLE transactions NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE transactions DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS owner_isolation ON categories;
+ALTER TABLE categories NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE categories DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS owner_isolation ON accounts;
+ALTER TABLE accounts NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE accounts DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS owner_isolation ON books;
+ALTER TABLE books NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE books DISABLE ROW LEVEL SECURITY;
+
+DROP FUNCTION IF EXISTS app_user_id();
+
+COMMIT;
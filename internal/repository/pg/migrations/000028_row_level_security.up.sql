@@ -0,0 +1,55 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- ROW LEVEL SECURITY
+-- =============================================================================
+
+-- The user the connection acts for, set on each connection the service takes
+-- from its pool when DATABASE_ROW_LEVEL_SECURITY is on. NULL while unset or
+-- empty, for the background jobs, sign ins and single user deployments.
+CREATE OR REPLACE FUNCTION app_user_id() RETURNS UUID
+LANGUAGE SQL STABLE
+AS $$
+    SELECT NULLIF(current_setting('app.user_id', true), '')::uuid
+$$;
+
+-- Rows of other users than the connection's are hidden, and can't be written,
+-- even by a query missing its WHERE clause. FORCE applies the policies to the
+-- owner of the tables too, superusers still bypass them.
+ALTER TABLE books ENABLE ROW LEVEL SECURITY;
+ALTER TABLE books FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON books
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+ALTER TABLE accounts ENABLE ROW LEVEL SECURITY;
+ALTER TABLE accounts FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON accounts
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+ALTER TABLE categories ENABLE ROW LEVEL SECURITY;
+ALTER TABLE categories FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON categories
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+ALTER TABLE transactions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE transactions FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON transactions
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+ALTER TABLE budgets ENABLE ROW LEVEL SECURITY;
+ALTER TABLE budgets FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON budgets
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+-- Tables without an owner column follow the books they're in
+ALTER TABLE report_snapshots ENABLE ROW LEVEL SECURITY;
+ALTER TABLE report_snapshots FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON report_snapshots
+    USING (app_user_id() IS NULL OR book_id IN (SELECT id FROM books));
+
+ALTER TABLE restore_points ENABLE ROW LEVEL SECURITY;
+ALTER TABLE restore_points FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON restore_points
+    USING (app_user_id() IS NULL OR book_id IN (SELECT id FROM books));
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN TRANSACTION;
+
+DROP INDEX IF EXISTS idx_transactions_user_id_created_at;
+
+ALTER TABLE users DROP COLUMN IF EXISTS tier;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- USER TIERS
+-- =============================================================================
+
+-- The quotas of QUOTA_* apply to the users of the free tier, the unlimited
+-- tier isn't limited
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS tier TEXT NOT NULL DEFAULT 'free' CHECK (tier IN ('free', 'unlimited'));
+
+-- The transactions a user created this month are counted against their quota
+CREATE INDEX IF NOT EXISTS idx_transactions_user_id_created_at ON transactions (user_id, created_at);
+
+COMMIT;
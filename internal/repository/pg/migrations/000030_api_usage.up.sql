@@ -0,0 +1,21 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- API USAGE
+-- =============================================================================
+
+-- The API calls of each user a month, counted by the service in memory and
+-- added up here every minute
+CREATE TABLE IF NOT EXISTS api_usage (
+    "user_id" UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    "month" DATE NOT NULL,
+    "calls" BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, month)
+);
+
+ALTER TABLE api_usage ENABLE ROW LEVEL SECURITY;
+ALTER TABLE api_usage FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON api_usage
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+COMMIT;
@@ -0,0 +1,44 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- TRANSACTION TRASH
+-- =============================================================================
+
+-- Deleted transactions are kept in the trash, so they can be restored, until
+-- the account they belong to is deleted
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS "deleted_at" TIMESTAMPTZ;
+
+-- The trash lists the latest deleted first
+CREATE INDEX IF NOT EXISTS idx_transactions_deleted_at ON transactions(deleted_at DESC) WHERE deleted_at IS NOT NULL;
+
+-- Leave the transactions in the trash out of the balances. Deleting and
+-- restoring update the row, so the trigger recalculates them.
+CREATE OR REPLACE FUNCTION update_account_balance(account_uuid UUID)
+RETURNS VOID AS $$
+BEGIN
+    INSERT INTO balances (account_id, current_balance, pending_balance, available_balance, last_calculated)
+    SELECT 
+        account_uuid,
+        COALESCE(SUM(CASE WHEN status = 'cleared' THEN amount ELSE 0 END), 0) as current_balance,
+        COALESCE(SUM(CASE WHEN status = 'pending' THEN amount ELSE 0 END), 0) as pending_balance,
+        COALESCE(SUM(CASE WHEN status IN ('cleared', 'pending') THEN amount ELSE 0 END), 0) as available_balance,
+        NOW()
+    FROM transactions 
+    WHERE account_id = account_uuid AND deleted_at IS NULL
+    ON CONFLICT (account_id) 
+    DO UPDATE SET 
+        current_balance = EXCLUDED.current_balance,
+        pending_balance = EXCLUDED.pending_balance,
+        available_balance = EXCLUDED.available_balance,
+        last_calculated = EXCLUDED.last_calculated;
+
+    INSERT INTO balance_snapshots (account_id, snapshot_date, current_balance)
+    SELECT account_id, CURRENT_DATE, current_balance
+    FROM balances
+    WHERE account_id = account_uuid
+    ON CONFLICT (account_id, snapshot_date)
+    DO UPDATE SET current_balance = EXCLUDED.current_balance;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMIT;
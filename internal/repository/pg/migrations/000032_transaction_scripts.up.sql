@@ -0,0 +1,26 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- TRANSACTION SCRIPTS
+-- =============================================================================
+
+-- Scripts the users run on the transactions created and imported into a book,
+-- in the order of their names, to file them under a category or reject them.
+-- source is the Starlark code, defining process(tx).
+CREATE TABLE IF NOT EXISTS transaction_scripts (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "book_id" UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    "name" VARCHAR(255) NOT NULL,
+    "source" TEXT NOT NULL,
+    "enabled" BOOLEAN NOT NULL DEFAULT TRUE,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (book_id, name)
+);
+
+ALTER TABLE transaction_scripts ENABLE ROW LEVEL SECURITY;
+ALTER TABLE transaction_scripts FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON transaction_scripts
+    USING (app_user_id() IS NULL OR book_id IN (SELECT id FROM books));
+
+COMMIT;
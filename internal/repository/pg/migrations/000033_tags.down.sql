@@ -0,0 +1,6 @@
+BEGIN TRANSACTION;
+
+DROP TABLE IF EXISTS transaction_tags;
+DROP TABLE IF EXISTS tags;
+
+COMMIT;
@@ -0,0 +1,37 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- TAGS
+-- =============================================================================
+
+-- Free-form labels of a book, like vacation2024 or reimbursable. Unlike the
+-- category, a transaction can have any number of them.
+CREATE TABLE IF NOT EXISTS tags (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "book_id" UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    "name" VARCHAR(50) NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (book_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS transaction_tags (
+    "transaction_id" UUID NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    "tag_id" UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (transaction_id, tag_id)
+);
+
+-- Listing the transactions of a tag
+CREATE INDEX IF NOT EXISTS idx_transaction_tags_tag_id ON transaction_tags(tag_id);
+
+ALTER TABLE tags ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tags FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON tags
+    USING (app_user_id() IS NULL OR book_id IN (SELECT id FROM books));
+
+ALTER TABLE transaction_tags ENABLE ROW LEVEL SECURITY;
+ALTER TABLE transaction_tags FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON transaction_tags
+    USING (app_user_id() IS NULL OR tag_id IN (SELECT id FROM tags));
+
+COMMIT;
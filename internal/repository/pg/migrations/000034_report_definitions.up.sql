@@ -0,0 +1,27 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- REPORT DEFINITIONS
+-- =============================================================================
+
+-- Reports the users build themselves, run over the transactions of the book
+-- whenever asked for. definition holds the dimensions the transactions are
+-- grouped by, the measures added up and the filters, as the application
+-- writes them.
+CREATE TABLE IF NOT EXISTS report_definitions (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "book_id" UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    "user_id" UUID REFERENCES users(id),
+    "name" VARCHAR(255) NOT NULL,
+    "definition" JSONB NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (book_id, name)
+);
+
+ALTER TABLE report_definitions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE report_definitions FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON report_definitions
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+COMMIT;
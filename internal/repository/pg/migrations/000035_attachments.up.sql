@@ -0,0 +1,29 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- ATTACHMENTS
+-- =============================================================================
+
+-- Receipts and other files attached to a transaction. The files themselves
+-- live in the attachment store, under storage_key; thumbnail_key is the key
+-- of their thumbnail, NULL when there is none, like for PDFs.
+CREATE TABLE IF NOT EXISTS attachments (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "transaction_id" UUID NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    "file_name" VARCHAR(255) NOT NULL,
+    "content_type" VARCHAR(100) NOT NULL,
+    "size" BIGINT NOT NULL,
+    "storage_key" VARCHAR(255) NOT NULL UNIQUE,
+    "thumbnail_key" VARCHAR(255),
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Listing the attachments of transactions
+CREATE INDEX IF NOT EXISTS idx_attachments_transaction_id ON attachments(transaction_id);
+
+ALTER TABLE attachments ENABLE ROW LEVEL SECURITY;
+ALTER TABLE attachments FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON attachments
+    USING (app_user_id() IS NULL OR transaction_id IN (SELECT id FROM transactions));
+
+COMMIT;
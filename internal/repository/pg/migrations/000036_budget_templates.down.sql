@@ -0,0 +1,8 @@
+BEGIN TRANSACTION;
+
+DROP TABLE IF EXISTS budget_templates;
+
+ALTER TABLE budgets DROP CONSTRAINT IF EXISTS budgets_category_months_excl;
+ALTER TABLE budgets ADD CONSTRAINT budgets_category_id_key UNIQUE (category_id);
+
+COMMIT;
@@ -0,0 +1,33 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- BUDGET TEMPLATES
+-- =============================================================================
+
+-- A category may now have one budget per period, as long as the periods don't
+-- overlap, so a month can be set up with budgets of its own
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE budgets DROP CONSTRAINT IF EXISTS budgets_category_id_key;
+ALTER TABLE budgets ADD CONSTRAINT budgets_category_months_excl
+    EXCLUDE USING gist (category_id WITH =, daterange(start_month, end_month, '[]') WITH &&);
+
+-- Named sets of category limits applied to a month at once. items holds the
+-- category, asset and amount of each limit, as the application writes them.
+CREATE TABLE IF NOT EXISTS budget_templates (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "book_id" UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    "user_id" UUID REFERENCES users(id),
+    "name" VARCHAR(255) NOT NULL,
+    "items" JSONB NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (book_id, name)
+);
+
+ALTER TABLE budget_templates ENABLE ROW LEVEL SECURITY;
+ALTER TABLE budget_templates FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON budget_templates
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+COMMIT;
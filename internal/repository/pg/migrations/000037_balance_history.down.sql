@@ -0,0 +1,6 @@
+BEGIN TRANSACTION;
+
+-- The backfilled snapshots can't be told apart from the ones taken since,
+-- they're all kept
+
+COMMIT;
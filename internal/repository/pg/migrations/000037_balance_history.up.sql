@@ -0,0 +1,28 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- BALANCE HISTORY
+-- =============================================================================
+
+-- The daily balance snapshots were only backfilled 30 days back. Backfill the
+-- end of every day an account had cleared transactions before its first
+-- snapshot too, so the balance history goes back to the first transaction.
+-- The days without a snapshot have the balance of the last one before them.
+INSERT INTO balance_snapshots (account_id, snapshot_date, current_balance)
+SELECT days.account_id, days.date, days.current_balance
+FROM (
+    SELECT
+        t.account_id,
+        t.date,
+        SUM(SUM(t.amount)) OVER (PARTITION BY t.account_id ORDER BY t.date) AS current_balance
+    FROM transactions t
+    WHERE t.status = 'cleared' AND t.deleted_at IS NULL
+    GROUP BY t.account_id, t.date
+) days
+WHERE days.date < COALESCE(
+    (SELECT MIN(s.snapshot_date) FROM balance_snapshots s WHERE s.account_id = days.account_id),
+    'infinity'::DATE
+)
+ON CONFLICT (account_id, snapshot_date) DO NOTHING;
+
+COMMIT;
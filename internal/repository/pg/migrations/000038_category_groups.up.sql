@@ -0,0 +1,15 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- CATEGORY GROUPS
+-- =============================================================================
+
+-- The category grouping this one, e.g. Food over Restaurants and Groceries.
+-- Groups are one level deep, a category with a parent has no children.
+-- Deleting a group leaves its categories ungrouped.
+ALTER TABLE categories
+    ADD COLUMN IF NOT EXISTS "parent_id" UUID REFERENCES categories(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id ON categories (parent_id) WHERE parent_id IS NOT NULL;
+
+COMMIT;
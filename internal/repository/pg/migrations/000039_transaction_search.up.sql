@@ -0,0 +1,15 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- TRANSACTION SEARCH
+-- =============================================================================
+
+-- Full-text search over the description and payee of the transactions, the
+-- description weighing more in the ranking. The 'simple' configuration
+-- doesn't stem, since descriptions mix languages and names. Searches have to
+-- use this same expression for the index to be used.
+CREATE INDEX IF NOT EXISTS idx_transactions_search ON transactions USING GIN (
+    (setweight(to_tsvector('simple', description), 'A') || setweight(to_tsvector('simple', payee), 'B'))
+);
+
+COMMIT;
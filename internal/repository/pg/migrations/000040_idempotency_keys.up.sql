@@ -0,0 +1,30 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- IDEMPOTENCY KEYS
+-- =============================================================================
+
+-- The writes made with an Idempotency-Key header, with the response replayed
+-- to their retries. The fingerprint tells the request apart from another one
+-- made with the same key, and the status code is 0 while the request is still
+-- being handled. Keys are kept for a day.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    "user_id" UUID REFERENCES users(id) ON DELETE CASCADE,
+    "key" VARCHAR(255) NOT NULL,
+    "fingerprint" VARCHAR(64) NOT NULL,
+    "status_code" INTEGER NOT NULL DEFAULT 0,
+    "content_type" VARCHAR(255) NOT NULL DEFAULT '',
+    "body" BYTEA NOT NULL DEFAULT '',
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- Without authentication the keys are shared, under no user
+    UNIQUE NULLS NOT DISTINCT (user_id, key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_created_at ON idempotency_keys (created_at);
+
+ALTER TABLE idempotency_keys ENABLE ROW LEVEL SECURITY;
+ALTER TABLE idempotency_keys FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON idempotency_keys
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN TRANSACTION;
+
+-- Only the settings of the first user are kept, the key is unique again
+DELETE FROM user_settings
+WHERE user_id IS DISTINCT FROM (SELECT id FROM users ORDER BY created_at, id LIMIT 1);
+
+ALTER TABLE user_settings DROP CONSTRAINT IF EXISTS user_settings_user_id_key;
+ALTER TABLE user_settings DROP COLUMN IF EXISTS user_id;
+ALTER TABLE user_settings ADD PRIMARY KEY (key);
+
+COMMIT;
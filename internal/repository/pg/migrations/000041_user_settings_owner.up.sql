@@ -0,0 +1,22 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- USER SETTINGS OWNER
+-- =============================================================================
+
+-- The settings of each user, keyed by user and key. The settings recorded
+-- before users existed go to the first user, who took the books over too, or
+-- stay under no user until one registers.
+ALTER TABLE user_settings
+    ADD COLUMN IF NOT EXISTS "user_id" UUID REFERENCES users(id) ON DELETE CASCADE;
+
+UPDATE user_settings
+SET user_id = (SELECT id FROM users ORDER BY created_at, id LIMIT 1)
+WHERE user_id IS NULL;
+
+ALTER TABLE user_settings DROP CONSTRAINT IF EXISTS user_settings_pkey;
+-- Without authentication the settings are shared, under no user
+ALTER TABLE user_settings
+    ADD CONSTRAINT user_settings_user_id_key UNIQUE NULLS NOT DISTINCT (user_id, key);
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN TRANSACTION;
+
+DROP INDEX IF EXISTS idx_expense_reports_book_id;
+DROP INDEX IF EXISTS idx_projects_book_id_name;
+ALTER TABLE projects ADD CONSTRAINT projects_name_key UNIQUE (name);
+
+ALTER TABLE expense_reports
+    DROP COLUMN IF EXISTS user_id,
+    DROP COLUMN IF EXISTS book_id;
+
+ALTER TABLE projects
+    DROP COLUMN IF EXISTS user_id,
+    DROP COLUMN IF EXISTS book_id;
+
+COMMIT;
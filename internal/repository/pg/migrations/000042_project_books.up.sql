@@ -0,0 +1,106 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- PROJECT AND EXPENSE REPORT BOOKS
+-- =============================================================================
+
+-- Projects and expense reports belong to a book, and to the user owning it,
+-- like the budgets. The ones recorded before go to the book holding most of
+-- their transactions, or to the oldest book without transactions.
+ALTER TABLE projects
+    ADD COLUMN IF NOT EXISTS "book_id" UUID REFERENCES books(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS "user_id" UUID REFERENCES users(id);
+
+ALTER TABLE expense_reports
+    ADD COLUMN IF NOT EXISTS "book_id" UUID REFERENCES books(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS "user_id" UUID REFERENCES users(id);
+
+UPDATE projects p
+SET book_id = COALESCE(
+    (SELECT a.book_id
+     FROM transactions t
+     JOIN accounts a ON t.account_id = a.id
+     WHERE t.project_id = p.id
+     GROUP BY a.book_id
+     ORDER BY COUNT(*) DESC, a.book_id
+     LIMIT 1),
+    (SELECT id FROM books ORDER BY created_at, id LIMIT 1)
+);
+
+UPDATE expense_reports er
+SET book_id = COALESCE(
+    (SELECT a.book_id
+     FROM expense_report_transactions r
+     JOIN transactions t ON r.transaction_id = t.id
+     JOIN accounts a ON t.account_id = a.id
+     WHERE r.expense_report_id = er.id
+     GROUP BY a.book_id
+     ORDER BY COUNT(*) DESC, a.book_id
+     LIMIT 1),
+    (SELECT id FROM books ORDER BY created_at, id LIMIT 1)
+);
+
+-- A project or report with transactions in other books is copied to each of
+-- them, and their transactions moved to the copy
+CREATE TEMPORARY TABLE project_copies ON COMMIT DROP AS
+SELECT project_id, book_id, gen_random_uuid() AS copy_id
+FROM (
+    SELECT DISTINCT p.id AS project_id, a.book_id
+    FROM transactions t
+    JOIN accounts a ON t.account_id = a.id
+    JOIN projects p ON t.project_id = p.id
+    WHERE a.book_id <> p.book_id
+) moved;
+
+INSERT INTO projects (id, name, client, description, created_at, updated_at, book_id)
+SELECT c.copy_id, p.name, p.client, p.description, p.created_at, p.updated_at, c.book_id
+FROM project_copies c
+JOIN projects p ON c.project_id = p.id;
+
+UPDATE transactions t
+SET project_id = c.copy_id
+FROM accounts a, project_copies c
+WHERE a.id = t.account_id AND c.project_id = t.project_id AND c.book_id = a.book_id;
+
+CREATE TEMPORARY TABLE expense_report_copies ON COMMIT DROP AS
+SELECT expense_report_id, book_id, gen_random_uuid() AS copy_id
+FROM (
+    SELECT DISTINCT er.id AS expense_report_id, a.book_id
+    FROM expense_report_transactions r
+    JOIN transactions t ON r.transaction_id = t.id
+    JOIN accounts a ON t.account_id = a.id
+    JOIN expense_reports er ON r.expense_report_id = er.id
+    WHERE a.book_id <> er.book_id
+) moved;
+
+INSERT INTO expense_reports (id, name, start_date, end_date, notes, status, submitted_at, reviewed_at, created_at, updated_at, book_id)
+SELECT c.copy_id, er.name, er.start_date, er.end_date, er.notes, er.status, er.submitted_at, er.reviewed_at, er.created_at, er.updated_at, c.book_id
+FROM expense_report_copies c
+JOIN expense_reports er ON c.expense_report_id = er.id;
+
+UPDATE expense_report_transactions r
+SET expense_report_id = c.copy_id
+FROM transactions t, accounts a, expense_report_copies c
+WHERE t.id = r.transaction_id AND a.id = t.account_id
+    AND c.expense_report_id = r.expense_report_id AND c.book_id = a.book_id;
+
+UPDATE projects p
+SET user_id = b.user_id
+FROM books b
+WHERE b.id = p.book_id;
+
+UPDATE expense_reports er
+SET user_id = b.user_id
+FROM books b
+WHERE b.id = er.book_id;
+
+ALTER TABLE projects ALTER COLUMN book_id SET NOT NULL;
+ALTER TABLE expense_reports ALTER COLUMN book_id SET NOT NULL;
+
+-- Project names are unique in each book
+ALTER TABLE projects DROP CONSTRAINT IF EXISTS projects_name_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_projects_book_id_name ON projects (book_id, name);
+
+CREATE INDEX IF NOT EXISTS idx_expense_reports_book_id ON expense_reports (book_id);
+
+COMMIT;
@@ -0,0 +1,27 @@
+BEGIN TRANSACTION;
+
+DROP POLICY IF EXISTS owner_isolation ON installment_plans;
+ALTER TABLE installment_plans NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE installment_plans DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS owner_isolation ON invoices;
+ALTER TABLE invoices NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE invoices DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS owner_isolation ON expense_report_transactions;
+ALTER TABLE expense_report_transactions NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE expense_report_transactions DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS owner_isolation ON user_settings;
+ALTER TABLE user_settings NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE user_settings DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS owner_isolation ON expense_reports;
+ALTER TABLE expense_reports NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE expense_reports DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS owner_isolation ON projects;
+ALTER TABLE projects NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE projects DISABLE ROW LEVEL SECURITY;
+
+COMMIT;
@@ -0,0 +1,40 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- ROW LEVEL SECURITY COVERAGE
+-- =============================================================================
+
+-- The tables that gained an owner or came after the row level security
+-- policies, hidden from other users like the rest of their books
+ALTER TABLE projects ENABLE ROW LEVEL SECURITY;
+ALTER TABLE projects FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON projects
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+ALTER TABLE expense_reports ENABLE ROW LEVEL SECURITY;
+ALTER TABLE expense_reports FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON expense_reports
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+ALTER TABLE user_settings ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_settings FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON user_settings
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+-- Tables without an owner column follow their report or account
+ALTER TABLE expense_report_transactions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE expense_report_transactions FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON expense_report_transactions
+    USING (app_user_id() IS NULL OR expense_report_id IN (SELECT id FROM expense_reports));
+
+ALTER TABLE invoices ENABLE ROW LEVEL SECURITY;
+ALTER TABLE invoices FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON invoices
+    USING (app_user_id() IS NULL OR account_id IN (SELECT id FROM accounts));
+
+ALTER TABLE installment_plans ENABLE ROW LEVEL SECURITY;
+ALTER TABLE installment_plans FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON installment_plans
+    USING (app_user_id() IS NULL OR account_id IN (SELECT id FROM accounts));
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN TRANSACTION;
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_installment_plan_id_fkey;
+ALTER TABLE transactions
+    ADD CONSTRAINT transactions_installment_plan_id_fkey
+    FOREIGN KEY (installment_plan_id) REFERENCES installment_plans(id) ON DELETE CASCADE;
+
+COMMIT;
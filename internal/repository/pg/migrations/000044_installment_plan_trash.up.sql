@@ -0,0 +1,14 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- INSTALLMENT PLAN TRASH
+-- =============================================================================
+
+-- Deleting a plan moves its installments to the trash instead of deleting
+-- them, so they're kept without it and can be restored on their own
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_installment_plan_id_fkey;
+ALTER TABLE transactions
+    ADD CONSTRAINT transactions_installment_plan_id_fkey
+    FOREIGN KEY (installment_plan_id) REFERENCES installment_plans(id) ON DELETE SET NULL;
+
+COMMIT;
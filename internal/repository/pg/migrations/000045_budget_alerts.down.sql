@@ -0,0 +1,7 @@
+BEGIN TRANSACTION;
+
+DROP TABLE IF EXISTS budget_alerts;
+
+ALTER TABLE budgets DROP COLUMN IF EXISTS thresholds;
+
+COMMIT;
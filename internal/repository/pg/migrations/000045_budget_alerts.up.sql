@@ -0,0 +1,36 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- BUDGET ALERTS
+-- =============================================================================
+
+-- The percents of its limit a budget alerts on once its category reaches them
+ALTER TABLE budgets ADD COLUMN IF NOT EXISTS "thresholds" INTEGER[] NOT NULL DEFAULT '{50,80,100}';
+
+-- The alerts fired, one per threshold of a budget in each month at most.
+-- spent is what the category took when the threshold was reached and
+-- limit_amount the limit then, both in the smallest unit of asset.
+CREATE TABLE IF NOT EXISTS budget_alerts (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "book_id" UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    "user_id" UUID REFERENCES users(id),
+    "budget_id" UUID NOT NULL REFERENCES budgets(id) ON DELETE CASCADE,
+    "category_id" UUID NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    "month" DATE NOT NULL,
+    "threshold" INTEGER NOT NULL,
+    "percent" DOUBLE PRECISION NOT NULL,
+    "asset" TEXT NOT NULL,
+    "spent" BIGINT NOT NULL,
+    "limit_amount" BIGINT NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (budget_id, month, threshold)
+);
+
+CREATE INDEX IF NOT EXISTS idx_budget_alerts_book_id ON budget_alerts(book_id);
+
+ALTER TABLE budget_alerts ENABLE ROW LEVEL SECURITY;
+ALTER TABLE budget_alerts FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON budget_alerts
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+COMMIT;
@@ -0,0 +1,29 @@
+BEGIN TRANSACTION;
+
+-- =============================================================================
+-- EXPORTS
+-- =============================================================================
+
+-- The transaction exports written to the file store under storage_key, which
+-- are downloaded from it until expires_at and then deleted
+CREATE TABLE IF NOT EXISTS exports (
+    "id" UUID NOT NULL PRIMARY KEY DEFAULT gen_random_uuid(),
+    "book_id" UUID NOT NULL REFERENCES books(id) ON DELETE CASCADE,
+    "user_id" UUID REFERENCES users(id),
+    "format" TEXT NOT NULL,
+    "file_name" TEXT NOT NULL,
+    "content_type" TEXT NOT NULL,
+    "size" BIGINT NOT NULL,
+    "storage_key" TEXT NOT NULL UNIQUE,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    "expires_at" TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_exports_book_id ON exports(book_id, created_at);
+
+ALTER TABLE exports ENABLE ROW LEVEL SECURITY;
+ALTER TABLE exports FORCE ROW LEVEL SECURITY;
+CREATE POLICY owner_isolation ON exports
+    USING (app_user_id() IS NULL OR user_id = app_user_id());
+
+COMMIT;
@@ -0,0 +1,136 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ProjectRepository struct {
+	queries *gen.Queries
+}
+
+func NewProjectRepository(db *pgxpool.Pool) *ProjectRepository {
+	return &ProjectRepository{
+		queries: gen.New(db),
+	}
+}
+
+func (r *ProjectRepository) CreateProject(ctx context.Context, project entities.Project) (entities.Project, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.Project{}, err
+	}
+
+	result, err := r.queries.CreateProject(ctx, bookID, project.Name, project.Client, project.Description)
+	if err != nil {
+		return entities.Project{}, duplicateProject(err, project.Name)
+	}
+
+	return convertProject(result), nil
+}
+
+func (r *ProjectRepository) GetProjectByID(ctx context.Context, id string) (entities.Project, error) {
+	projectID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.Project{}, err
+	}
+
+	result, err := r.queries.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return entities.Project{}, notFound(err, "project")
+	}
+
+	if err := inBook(ctx, result.BookID, "project"); err != nil {
+		return entities.Project{}, err
+	}
+	if err := ofUser(ctx, result.UserID, "project"); err != nil {
+		return entities.Project{}, err
+	}
+
+	return convertProject(result), nil
+}
+
+func (r *ProjectRepository) GetAllProjects(ctx context.Context) ([]entities.Project, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllProjects(ctx, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	projects := make([]entities.Project, len(results))
+	for i, result := range results {
+		projects[i] = convertProject(result)
+	}
+
+	return projects, nil
+}
+
+func (r *ProjectRepository) UpdateProject(ctx context.Context, project entities.Project) (entities.Project, error) {
+	projectID, err := uuid.FromString(project.ID)
+	if err != nil {
+		return entities.Project{}, err
+	}
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.Project{}, err
+	}
+
+	result, err := r.queries.UpdateProject(ctx, projectID, bookID, project.Name, project.Client, project.Description)
+	if err != nil {
+		return entities.Project{}, notFound(duplicateProject(err, project.Name), "project")
+	}
+
+	return convertProject(result), nil
+}
+
+func (r *ProjectRepository) DeleteProject(ctx context.Context, id string) error {
+	projectID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.DeleteProject(ctx, projectID, bookID)
+}
+
+// duplicateProject translates a project name already taken into
+// domain.ErrConflict
+func duplicateProject(err error, name string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("project %q already exists: %w", name, domain.ErrConflict)
+	}
+	return err
+}
+
+func convertProject(result gen.Project) entities.Project {
+	return entities.Project{
+		ID:          result.ID.String(),
+		Name:        result.Name,
+		Client:      result.Client,
+		Description: result.Description,
+		BookID:      result.BookID.String(),
+		OwnerID:     uuidString(result.UserID),
+		CreatedAt:   result.CreatedAt,
+		UpdatedAt:   result.UpdatedAt,
+	}
+}
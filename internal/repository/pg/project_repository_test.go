@@ -0,0 +1,87 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewProjectRepository(db)
+	transactions := NewTransactionRepository(db)
+	books := NewBookRepository(db)
+	ctx := context.Background()
+
+	checking := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+	fees := createTestCategory(t, db, "Fees", entities.CategoryTypeIncome)
+
+	website, err := repo.CreateProject(ctx, entities.Project{Name: "Website", Client: "Acme"})
+	require.NoError(t, err)
+
+	side, err := books.CreateBook(ctx, entities.Book{Name: "Side business", Asset: monetary.USD})
+	require.NoError(t, err)
+	sideCtx := domain.WithBook(ctx, side.ID)
+
+	t.Run("projects are kept per book", func(t *testing.T) {
+		_, err := repo.GetProjectByID(sideCtx, website.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		all, err := repo.GetAllProjects(sideCtx)
+		require.NoError(t, err)
+		assert.Empty(t, all)
+
+		website.Name = "Taken"
+		_, err = repo.UpdateProject(sideCtx, website)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		website.Name = "Website"
+
+		require.NoError(t, repo.DeleteProject(sideCtx, website.ID))
+		_, err = repo.GetProjectByID(ctx, website.ID)
+		require.NoError(t, err)
+
+		// The name is only taken in the book of the project
+		_, err = repo.CreateProject(sideCtx, entities.Project{Name: "Website"})
+		require.NoError(t, err)
+		_, err = repo.CreateProject(ctx, entities.Project{Name: "Website"})
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("transactions are filed under the projects of their book", func(t *testing.T) {
+		sideProject, err := repo.CreateProject(sideCtx, entities.Project{Name: "Consulting"})
+		require.NoError(t, err)
+
+		transaction := entities.Transaction{
+			AccountID:   checking.ID,
+			CategoryID:  fees.ID,
+			Monetary:    monetary.Monetary{Asset: checking.Asset, Amount: big.NewInt(50000)},
+			Description: "Invoice 12",
+			Date:        time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+			Status:      entities.TransactionStatusCleared,
+			ProjectID:   sideProject.ID,
+		}
+		_, err = transactions.CreateTransaction(ctx, transaction)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		transaction.ProjectID = website.ID
+		created, err := transactions.CreateTransaction(ctx, transaction)
+		require.NoError(t, err)
+
+		created.ProjectID = sideProject.ID
+		_, err = transactions.UpdateTransaction(ctx, created)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		// Deleting the project keeps its transactions
+		require.NoError(t, repo.DeleteProject(ctx, website.ID))
+		got, err := transactions.GetTransactionByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Empty(t, got.ProjectID)
+	})
+}
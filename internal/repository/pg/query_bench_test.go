@@ -0,0 +1,301 @@
+package pg
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Benchmarks for the transaction list queries against a seeded database. They run
+// against the migrated, disposable database in BENCH_DATABASE_URL and are skipped
+// when it isn't set:
+//
+//	BENCH_DATABASE_URL=postgres://... go test -run='^$' -bench=. ./internal/repository/pg
+//
+// The first run seeds benchTransactions rows, which takes a while. Besides timing the
+// queries, each benchmark runs EXPLAIN ANALYZE on the query and fails when the
+// execution goes over its target or falls back to a sequential scan on transactions.
+
+const (
+	benchTransactions = 1_000_000
+	benchAccounts     = 200
+	benchCategories   = 50
+)
+
+// seedBenchSteps fill the database with benchAccounts accounts and benchCategories categories
+// sharing benchTransactions transactions spread over the last five years
+var seedBenchSteps = []struct {
+	sql  string
+	args []any
+}{
+	{
+		sql: `INSERT INTO accounts (name, type, asset)
+SELECT 'Bench Account ' || i, 'checking', 'USD' FROM generate_series(1, $1::int) i`,
+		args: []any{benchAccounts},
+	},
+	{
+		sql: `INSERT INTO categories (name, type)
+SELECT 'Bench Category ' || i, 'expense' FROM generate_series(1, $1::int) i
+ON CONFLICT (name, type) DO NOTHING`,
+		args: []any{benchCategories},
+	},
+	// Recomputing the balance for every inserted row would take hours
+	{sql: `ALTER TABLE transactions DISABLE TRIGGER transaction_balance_update`},
+	{
+		sql: `INSERT INTO transactions (account_id, category_id, amount, description, date, status)
+SELECT
+    a.ids[1 + floor(random() * array_length(a.ids, 1))::int],
+    c.ids[1 + floor(random() * array_length(c.ids, 1))::int],
+    -floor(random() * 100000)::bigint,
+    'Bench transaction ' || i,
+    CURRENT_DATE - floor(random() * 1825)::int,
+    (ARRAY['cleared', 'cleared', 'cleared', 'pending', 'cancelled'])[1 + i % 5]
+FROM generate_series(1, $1::int) i,
+    (SELECT array_agg(id) AS ids FROM accounts WHERE name LIKE 'Bench Account %') a,
+    (SELECT array_agg(id) AS ids FROM categories WHERE name LIKE 'Bench Category %') c`,
+		args: []any{benchTransactions},
+	},
+	{sql: `ALTER TABLE transactions ENABLE TRIGGER transaction_balance_update`},
+	{sql: `SELECT update_account_balance(id) FROM accounts WHERE name LIKE 'Bench Account %'`},
+	{sql: `ANALYZE transactions`},
+}
+
+type benchSeed struct {
+	db         *pgxpool.Pool
+	tracer     *lastQueryTracer
+	accountID  uuid.UUID
+	categoryID uuid.UUID
+}
+
+var (
+	benchOnce  sync.Once
+	benchState benchSeed
+	benchErr   error
+)
+
+// benchDB connects to the benchmark database, seeding it on first use
+func benchDB(b *testing.B) benchSeed {
+	b.Helper()
+
+	url := os.Getenv("BENCH_DATABASE_URL")
+	if url == "" {
+		b.Skip("BENCH_DATABASE_URL not set")
+	}
+
+	benchOnce.Do(func() {
+		benchState, benchErr = seedBenchDB(context.Background(), url)
+	})
+	if benchErr != nil {
+		b.Fatalf("failed to setup benchmark database: %v", benchErr)
+	}
+
+	return benchState
+}
+
+func seedBenchDB(ctx context.Context, url string) (benchSeed, error) {
+	cfg, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		return benchSeed{}, err
+	}
+
+	tracer := &lastQueryTracer{}
+	cfg.ConnConfig.Tracer = tracer
+
+	db, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		return benchSeed{}, err
+	}
+
+	var seeded bool
+	err = db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM accounts WHERE name LIKE 'Bench Account %')`).Scan(&seeded)
+	if err != nil {
+		return benchSeed{}, err
+	}
+
+	if !seeded {
+		err = pgx.BeginFunc(ctx, db, func(tx pgx.Tx) error {
+			for _, step := range seedBenchSteps {
+				if _, err := tx.Exec(ctx, step.sql, step.args...); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return benchSeed{}, err
+		}
+	}
+
+	seed := benchSeed{db: db, tracer: tracer}
+	err = db.QueryRow(ctx, `SELECT id FROM accounts WHERE name = 'Bench Account 1'`).Scan(&seed.accountID)
+	if err != nil {
+		return benchSeed{}, err
+	}
+	err = db.QueryRow(ctx, `SELECT id FROM categories WHERE name = 'Bench Category 1'`).Scan(&seed.categoryID)
+	if err != nil {
+		return benchSeed{}, err
+	}
+
+	return seed, nil
+}
+
+// lastQueryTracer remembers the last query sent, so the benchmarks can explain the
+// exact SQL the repositories run
+type lastQueryTracer struct {
+	mu   sync.Mutex
+	sql  string
+	args []any
+}
+
+func (t *lastQueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sql, t.args = data.SQL, data.Args
+	return ctx
+}
+
+func (t *lastQueryTracer) TraceQueryEnd(context.Context, *pgx.Conn, pgx.TraceQueryEndData) {}
+
+func (t *lastQueryTracer) last() (string, []any) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.sql, t.args
+}
+
+type explainPlan struct {
+	NodeType     string        `json:"Node Type"`
+	RelationName string        `json:"Relation Name"`
+	Plans        []explainPlan `json:"Plans"`
+}
+
+// benchQuery runs the query once to capture its SQL, checks the EXPLAIN ANALYZE output
+// against the target and then times it
+func benchQuery(b *testing.B, seed benchSeed, target time.Duration, run func(ctx context.Context) error) {
+	b.Helper()
+	ctx := context.Background()
+
+	if err := run(ctx); err != nil {
+		b.Fatalf("query failed: %v", err)
+	}
+	sql, args := seed.tracer.last()
+
+	var output []byte
+	if err := seed.db.QueryRow(ctx, "EXPLAIN (ANALYZE, FORMAT JSON) "+sql, args...).Scan(&output); err != nil {
+		b.Fatalf("failed to explain query: %v", err)
+	}
+
+	var explain []struct {
+		Plan          explainPlan `json:"Plan"`
+		ExecutionTime float64     `json:"Execution Time"`
+	}
+	if err := json.Unmarshal(output, &explain); err != nil || len(explain) == 0 {
+		b.Fatalf("failed to parse query plan: %v", err)
+	}
+
+	execution := time.Duration(explain[0].ExecutionTime * float64(time.Millisecond))
+	b.ReportMetric(explain[0].ExecutionTime, "exec-ms")
+	if execution > target {
+		b.Errorf("execution took %s, over the %s target\n%s", execution, target, output)
+	}
+	if scan, ok := findSeqScan(explain[0].Plan, "transactions"); ok {
+		b.Errorf("query plan has a %s on transactions\n%s", scan.NodeType, output)
+	}
+
+	b.ResetTimer()
+	for b.Loop() {
+		if err := run(ctx); err != nil {
+			b.Fatalf("query failed: %v", err)
+		}
+	}
+}
+
+func findSeqScan(plan explainPlan, relation string) (explainPlan, bool) {
+	if plan.NodeType == "Seq Scan" && plan.RelationName == relation {
+		return plan, true
+	}
+	for _, child := range plan.Plans {
+		if scan, ok := findSeqScan(child, relation); ok {
+			return scan, true
+		}
+	}
+	return explainPlan{}, false
+}
+
+func benchMonth() (pgtype.Date, pgtype.Date) {
+	end := time.Now().AddDate(0, -6, 0)
+	return pgtype.Date{Time: end.AddDate(0, -1, 0), Valid: true}, pgtype.Date{Time: end, Valid: true}
+}
+
+func BenchmarkListTransactionsWithDetails(b *testing.B) {
+	seed := benchDB(b)
+	repo := NewTransactionRepository(seed.db)
+
+	benchQuery(b, seed, 25*time.Millisecond, func(ctx context.Context) error {
+		_, err := repo.GetTransactionsWithDetails(ctx, entities.TransactionFilter{}, 50, 0, nil)
+		return err
+	})
+}
+
+func BenchmarkListTransactionsWithDetailsSortedByAmount(b *testing.B) {
+	seed := benchDB(b)
+	repo := NewTransactionRepository(seed.db)
+	sort := []entities.SortField{{Field: "amount", Desc: true}}
+
+	benchQuery(b, seed, 25*time.Millisecond, func(ctx context.Context) error {
+		_, err := repo.GetTransactionsWithDetails(ctx, entities.TransactionFilter{}, 50, 0, sort)
+		return err
+	})
+}
+
+func BenchmarkGetTransactionsByAccount(b *testing.B) {
+	seed := benchDB(b)
+	queries := gen.New(seed.db)
+
+	benchQuery(b, seed, 100*time.Millisecond, func(ctx context.Context) error {
+		_, err := queries.GetTransactionsByAccount(ctx, seed.accountID, nil)
+		return err
+	})
+}
+
+func BenchmarkGetTransactionsByCategory(b *testing.B) {
+	seed := benchDB(b)
+	queries := gen.New(seed.db)
+
+	benchQuery(b, seed, 250*time.Millisecond, func(ctx context.Context) error {
+		_, err := queries.GetTransactionsByCategory(ctx, seed.categoryID, nil)
+		return err
+	})
+}
+
+func BenchmarkGetTransactionsByDateRange(b *testing.B) {
+	seed := benchDB(b)
+	queries := gen.New(seed.db)
+	start, end := benchMonth()
+
+	benchQuery(b, seed, 250*time.Millisecond, func(ctx context.Context) error {
+		_, err := queries.GetTransactionsByDateRange(ctx, start, end, uuid.FromStringOrNil(domain.DefaultBookID), nil)
+		return err
+	})
+}
+
+func BenchmarkGetTransactionsByAccountAndDateRange(b *testing.B) {
+	seed := benchDB(b)
+	queries := gen.New(seed.db)
+	start, end := benchMonth()
+
+	benchQuery(b, seed, 10*time.Millisecond, func(ctx context.Context) error {
+		_, err := queries.GetTransactionsByAccountAndDateRange(ctx, seed.accountID, start, end, nil)
+		return err
+	})
+}
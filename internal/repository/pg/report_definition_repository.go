@@ -0,0 +1,219 @@
+package pg
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReportDefinitionRepository struct {
+	queries *gen.Queries
+}
+
+func NewReportDefinitionRepository(db *pgxpool.Pool) *ReportDefinitionRepository {
+	return &ReportDefinitionRepository{
+		queries: gen.New(db),
+	}
+}
+
+// reportDefinition is a report definition as kept in the definition column,
+// dates as YYYY-MM-DD and empty when not set
+type reportDefinition struct {
+	Dimensions []string               `json:"dimensions"`
+	Measures   []string               `json:"measures"`
+	Filter     reportDefinitionFilter `json:"filter"`
+}
+
+type reportDefinitionFilter struct {
+	From         string   `json:"from,omitempty"`
+	To           string   `json:"to,omitempty"`
+	AccountIDs   []string `json:"account_ids,omitempty"`
+	CategoryIDs  []string `json:"category_ids,omitempty"`
+	CategoryType string   `json:"category_type,omitempty"`
+	Payees       []string `json:"payees,omitempty"`
+}
+
+func (r *ReportDefinitionRepository) CreateReportDefinition(ctx context.Context, definition entities.ReportDefinition) (entities.ReportDefinition, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.ReportDefinition{}, err
+	}
+	data, err := marshalReportDefinition(definition)
+	if err != nil {
+		return entities.ReportDefinition{}, err
+	}
+
+	result, err := r.queries.CreateReportDefinition(ctx, bookID, definition.Name, data)
+	if err != nil {
+		return entities.ReportDefinition{}, duplicateReportDefinition(err, definition.Name)
+	}
+
+	return convertReportDefinition(result)
+}
+
+func (r *ReportDefinitionRepository) GetReportDefinitionByID(ctx context.Context, id string) (entities.ReportDefinition, error) {
+	definitionID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.ReportDefinition{}, err
+	}
+
+	result, err := r.queries.GetReportDefinitionByID(ctx, definitionID)
+	if err != nil {
+		return entities.ReportDefinition{}, notFound(err, "report definition")
+	}
+	if err := inBook(ctx, result.BookID, "report definition"); err != nil {
+		return entities.ReportDefinition{}, err
+	}
+	if err := ofUser(ctx, result.UserID, "report definition"); err != nil {
+		return entities.ReportDefinition{}, err
+	}
+
+	return convertReportDefinition(result)
+}
+
+// GetAllReportDefinitions returns the report definitions of the book ordered
+// by name
+func (r *ReportDefinitionRepository) GetAllReportDefinitions(ctx context.Context) ([]entities.ReportDefinition, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllReportDefinitions(ctx, bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := make([]entities.ReportDefinition, len(results))
+	for i, result := range results {
+		if definitions[i], err = convertReportDefinition(result); err != nil {
+			return nil, err
+		}
+	}
+
+	return definitions, nil
+}
+
+func (r *ReportDefinitionRepository) UpdateReportDefinition(ctx context.Context, definition entities.ReportDefinition) (entities.ReportDefinition, error) {
+	definitionID, err := uuid.FromString(definition.ID)
+	if err != nil {
+		return entities.ReportDefinition{}, err
+	}
+	if _, err := r.GetReportDefinitionByID(ctx, definition.ID); err != nil {
+		return entities.ReportDefinition{}, err
+	}
+	data, err := marshalReportDefinition(definition)
+	if err != nil {
+		return entities.ReportDefinition{}, err
+	}
+
+	result, err := r.queries.UpdateReportDefinition(ctx, definitionID, definition.Name, data)
+	if err != nil {
+		return entities.ReportDefinition{}, notFound(duplicateReportDefinition(err, definition.Name), "report definition")
+	}
+
+	return convertReportDefinition(result)
+}
+
+func (r *ReportDefinitionRepository) DeleteReportDefinition(ctx context.Context, id string) error {
+	definitionID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+	if _, err := r.GetReportDefinitionByID(ctx, id); err != nil {
+		return err
+	}
+
+	return r.queries.DeleteReportDefinition(ctx, definitionID)
+}
+
+// duplicateReportDefinition translates a report name already taken in the
+// book into domain.ErrConflict
+func duplicateReportDefinition(err error, name string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("report %q already exists: %w", name, domain.ErrConflict)
+	}
+	return err
+}
+
+func marshalReportDefinition(definition entities.ReportDefinition) ([]byte, error) {
+	stored := reportDefinition{
+		Dimensions: make([]string, len(definition.Dimensions)),
+		Measures:   make([]string, len(definition.Measures)),
+		Filter: reportDefinitionFilter{
+			AccountIDs:   definition.Filter.AccountIDs,
+			CategoryIDs:  definition.Filter.CategoryIDs,
+			CategoryType: string(definition.Filter.CategoryType),
+			Payees:       definition.Filter.Payees,
+		},
+	}
+	for i, dimension := range definition.Dimensions {
+		stored.Dimensions[i] = string(dimension)
+	}
+	for i, measure := range definition.Measures {
+		stored.Measures[i] = string(measure)
+	}
+	if !definition.Filter.From.IsZero() {
+		stored.Filter.From = definition.Filter.From.Format(time.DateOnly)
+	}
+	if !definition.Filter.To.IsZero() {
+		stored.Filter.To = definition.Filter.To.Format(time.DateOnly)
+	}
+	return json.Marshal(stored)
+}
+
+func convertReportDefinition(result gen.ReportDefinition) (entities.ReportDefinition, error) {
+	var stored reportDefinition
+	if err := json.Unmarshal(result.Definition, &stored); err != nil {
+		return entities.ReportDefinition{}, err
+	}
+
+	definition := entities.ReportDefinition{
+		ID:         result.ID.String(),
+		Name:       result.Name,
+		Dimensions: make([]entities.ReportDimension, len(stored.Dimensions)),
+		Measures:   make([]entities.ReportMeasure, len(stored.Measures)),
+		Filter: entities.ReportFilter{
+			AccountIDs:   stored.Filter.AccountIDs,
+			CategoryIDs:  stored.Filter.CategoryIDs,
+			CategoryType: entities.CategoryType(stored.Filter.CategoryType),
+			Payees:       stored.Filter.Payees,
+		},
+		CreatedAt: result.CreatedAt,
+		UpdatedAt: result.UpdatedAt,
+	}
+	for i, dimension := range stored.Dimensions {
+		definition.Dimensions[i] = entities.ReportDimension(dimension)
+	}
+	for i, measure := range stored.Measures {
+		definition.Measures[i] = entities.ReportMeasure(measure)
+	}
+
+	var err error
+	if stored.Filter.From != "" {
+		if definition.Filter.From, err = time.Parse(time.DateOnly, stored.Filter.From); err != nil {
+			return entities.ReportDefinition{}, err
+		}
+	}
+	if stored.Filter.To != "" {
+		if definition.Filter.To, err = time.Parse(time.DateOnly, stored.Filter.To); err != nil {
+			return entities.ReportDefinition{}, err
+		}
+	}
+
+	return definition, nil
+}
@@ -0,0 +1,84 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportDefinitionRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewReportDefinitionRepository(db)
+	books := NewBookRepository(db)
+	ctx := context.Background()
+
+	var created entities.ReportDefinition
+	t.Run("create and get", func(t *testing.T) {
+		var err error
+		created, err = repo.CreateReportDefinition(ctx, entities.ReportDefinition{
+			Name:       "Groceries by payee",
+			Dimensions: []entities.ReportDimension{entities.ReportDimensionPayee, entities.ReportDimensionMonth},
+			Measures:   []entities.ReportMeasure{entities.ReportMeasureSum, entities.ReportMeasureAvg},
+			Filter: entities.ReportFilter{
+				From:         time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+				CategoryType: entities.CategoryTypeExpense,
+				Payees:       []string{"Market"},
+			},
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+		assert.Equal(t, []entities.ReportDimension{entities.ReportDimensionPayee, entities.ReportDimensionMonth}, created.Dimensions)
+		assert.True(t, created.Filter.To.IsZero())
+
+		got, err := repo.GetReportDefinitionByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created, got)
+
+		_, err = repo.CreateReportDefinition(ctx, entities.ReportDefinition{Name: "Groceries by payee", Measures: []entities.ReportMeasure{entities.ReportMeasureCount}})
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("update lists by name", func(t *testing.T) {
+		_, err := repo.CreateReportDefinition(ctx, entities.ReportDefinition{Name: "Cash flow", Measures: []entities.ReportMeasure{entities.ReportMeasureSum}})
+		require.NoError(t, err)
+
+		created.Measures = []entities.ReportMeasure{entities.ReportMeasureCount}
+		created.Filter.Payees = nil
+		updated, err := repo.UpdateReportDefinition(ctx, created)
+		require.NoError(t, err)
+		assert.Equal(t, []entities.ReportMeasure{entities.ReportMeasureCount}, updated.Measures)
+		assert.Empty(t, updated.Filter.Payees)
+
+		all, err := repo.GetAllReportDefinitions(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		assert.Equal(t, "Cash flow", all[0].Name)
+		assert.Equal(t, "Groceries by payee", all[1].Name)
+	})
+
+	t.Run("definitions are kept per book", func(t *testing.T) {
+		side, err := books.CreateBook(ctx, entities.Book{Name: "Side business", Asset: monetary.USD})
+		require.NoError(t, err)
+		sideCtx := domain.WithBook(ctx, side.ID)
+
+		_, err = repo.GetReportDefinitionByID(sideCtx, created.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.ErrorIs(t, repo.DeleteReportDefinition(sideCtx, created.ID), domain.ErrNotFound)
+
+		all, err := repo.GetAllReportDefinitions(sideCtx)
+		require.NoError(t, err)
+		assert.Empty(t, all)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.DeleteReportDefinition(ctx, created.ID))
+		_, err := repo.GetReportDefinitionByID(ctx, created.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,140 @@
+package pg
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReportSnapshotRepository struct {
+	queries *gen.Queries
+}
+
+func NewReportSnapshotRepository(db *pgxpool.Pool) *ReportSnapshotRepository {
+	return &ReportSnapshotRepository{
+		queries: gen.New(db),
+	}
+}
+
+// reportSnapshot is a monthly report as kept in the report column, amounts in
+// the minor units of their asset
+type reportSnapshot struct {
+	Categories []reportSnapshotCategory `json:"categories"`
+	NetWorth   []reportSnapshotAmount   `json:"net_worth"`
+}
+
+type reportSnapshotCategory struct {
+	CategoryID string               `json:"category_id"`
+	Name       string               `json:"name"`
+	Type       string               `json:"type"`
+	Total      reportSnapshotAmount `json:"total"`
+}
+
+type reportSnapshotAmount struct {
+	Asset  string   `json:"asset"`
+	Amount *big.Int `json:"amount"`
+}
+
+func (r *ReportSnapshotRepository) GetReportSnapshot(ctx context.Context, month time.Time) (entities.MonthlyReport, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.MonthlyReport{}, err
+	}
+
+	result, err := r.queries.GetReportSnapshot(ctx, bookID, pgtype.Date{Time: month, Valid: true})
+	if err != nil {
+		return entities.MonthlyReport{}, notFound(err, "report snapshot")
+	}
+
+	return convertReportSnapshot(result)
+}
+
+// SaveReportSnapshot keeps report as the snapshot of its month, replacing the
+// one taken before
+func (r *ReportSnapshotRepository) SaveReportSnapshot(ctx context.Context, report entities.MonthlyReport) (entities.MonthlyReport, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.MonthlyReport{}, err
+	}
+
+	snapshot := reportSnapshot{
+		Categories: make([]reportSnapshotCategory, len(report.Categories)),
+		NetWorth:   make([]reportSnapshotAmount, len(report.NetWorth)),
+	}
+	for i, category := range report.Categories {
+		snapshot.Categories[i] = reportSnapshotCategory{
+			CategoryID: category.CategoryID,
+			Name:       category.Name,
+			Type:       string(category.Type),
+			Total:      reportSnapshotAmount{Asset: category.Total.Asset.Asset, Amount: category.Total.Amount},
+		}
+	}
+	for i, amount := range report.NetWorth {
+		snapshot.NetWorth[i] = reportSnapshotAmount{Asset: amount.Asset.Asset, Amount: amount.Amount}
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return entities.MonthlyReport{}, err
+	}
+
+	result, err := r.queries.UpsertReportSnapshot(ctx, bookID, pgtype.Date{Time: report.Month, Valid: true}, data)
+	if err != nil {
+		return entities.MonthlyReport{}, err
+	}
+
+	return convertReportSnapshot(result)
+}
+
+func convertReportSnapshot(result gen.ReportSnapshot) (entities.MonthlyReport, error) {
+	var snapshot reportSnapshot
+	if err := json.Unmarshal(result.Report, &snapshot); err != nil {
+		return entities.MonthlyReport{}, err
+	}
+
+	report := entities.MonthlyReport{
+		Month:      result.Month.Time,
+		Categories: make([]entities.CategoryTotal, len(snapshot.Categories)),
+		NetWorth:   make([]monetary.Monetary, len(snapshot.NetWorth)),
+		SnapshotAt: result.CreatedAt,
+	}
+	for i, category := range snapshot.Categories {
+		total, err := category.Total.monetary()
+		if err != nil {
+			return entities.MonthlyReport{}, err
+		}
+		report.Categories[i] = entities.CategoryTotal{
+			CategoryID: category.CategoryID,
+			Name:       category.Name,
+			Type:       entities.CategoryType(category.Type),
+			Total:      total,
+		}
+	}
+	for i, amount := range snapshot.NetWorth {
+		var err error
+		if report.NetWorth[i], err = amount.monetary(); err != nil {
+			return entities.MonthlyReport{}, err
+		}
+	}
+
+	return report, nil
+}
+
+func (a reportSnapshotAmount) monetary() (monetary.Monetary, error) {
+	asset, ok := entities.FindSupportedAsset(a.Asset)
+	if !ok {
+		return monetary.Monetary{}, fmt.Errorf("report snapshot in unsupported asset %s", a.Asset)
+	}
+	if a.Amount == nil {
+		a.Amount = new(big.Int)
+	}
+	// Amounts can be negative, like the net worth of an account in debt
+	return monetary.Monetary{Asset: asset, Amount: a.Amount}, nil
+}
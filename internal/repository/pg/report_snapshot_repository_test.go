@@ -0,0 +1,73 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportSnapshotRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewReportSnapshotRepository(db)
+	books := NewBookRepository(db)
+	ctx := context.Background()
+
+	february := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	report := entities.MonthlyReport{
+		Month: february,
+		Categories: []entities.CategoryTotal{
+			{CategoryID: "cat-groceries", Name: "Groceries", Type: entities.CategoryTypeExpense, Total: monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(85000)}},
+		},
+		NetWorth: []monetary.Monetary{
+			{Asset: monetary.BRL, Amount: big.NewInt(1234567)},
+			{Asset: monetary.USD, Amount: big.NewInt(-1500)},
+		},
+	}
+
+	t.Run("missing snapshot", func(t *testing.T) {
+		_, err := repo.GetReportSnapshot(ctx, february)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("save and get", func(t *testing.T) {
+		saved, err := repo.SaveReportSnapshot(ctx, report)
+		require.NoError(t, err)
+		assert.True(t, saved.Snapshot())
+
+		snapshot, err := repo.GetReportSnapshot(ctx, february)
+		require.NoError(t, err)
+		assert.True(t, snapshot.Month.Equal(february))
+		require.Len(t, snapshot.Categories, 1)
+		assert.Equal(t, "Groceries", snapshot.Categories[0].Name)
+		assert.Equal(t, entities.CategoryTypeExpense, snapshot.Categories[0].Type)
+		assert.Equal(t, int64(85000), snapshot.Categories[0].Total.Amount.Int64())
+		require.Len(t, snapshot.NetWorth, 2)
+		assert.Equal(t, "USD", snapshot.NetWorth[1].Asset.Asset)
+		assert.Equal(t, int64(-1500), snapshot.NetWorth[1].Amount.Int64())
+	})
+
+	t.Run("save replaces the snapshot", func(t *testing.T) {
+		report.NetWorth = report.NetWorth[:1]
+		_, err := repo.SaveReportSnapshot(ctx, report)
+		require.NoError(t, err)
+
+		snapshot, err := repo.GetReportSnapshot(ctx, february)
+		require.NoError(t, err)
+		assert.Len(t, snapshot.NetWorth, 1)
+	})
+
+	t.Run("snapshots are kept per book", func(t *testing.T) {
+		side, err := books.CreateBook(ctx, entities.Book{Name: "Side business", Asset: monetary.USD})
+		require.NoError(t, err)
+
+		_, err = repo.GetReportSnapshot(domain.WithBook(ctx, side.ID), february)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
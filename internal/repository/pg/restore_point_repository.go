@@ -0,0 +1,206 @@
+package pg
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RestorePointRepository struct {
+	queries *gen.Queries
+}
+
+func NewRestorePointRepository(db *pgxpool.Pool) *RestorePointRepository {
+	return &RestorePointRepository{
+		queries: gen.New(db),
+	}
+}
+
+// restorePointChange is a change of a restore point as kept in the changes
+// column
+type restorePointChange struct {
+	Entity    string                   `json:"entity"`
+	Action    string                   `json:"action"`
+	ID        string                   `json:"id"`
+	AccountID string                   `json:"account_id,omitempty"`
+	Before    *restorePointTransaction `json:"before,omitempty"`
+}
+
+// restorePointTransaction is a transaction as it was before the operation, the
+// amount in the minor units of its asset
+type restorePointTransaction struct {
+	AccountID   string   `json:"account_id"`
+	CategoryID  string   `json:"category_id"`
+	Asset       string   `json:"asset"`
+	Amount      *big.Int `json:"amount"`
+	Description string   `json:"description"`
+	Payee       string   `json:"payee,omitempty"`
+	Date        string   `json:"date"`
+	Status      string   `json:"status"`
+	ProjectID   string   `json:"project_id,omitempty"`
+}
+
+func (r *RestorePointRepository) CreateRestorePoint(ctx context.Context, point entities.RestorePoint) (entities.RestorePoint, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.RestorePoint{}, err
+	}
+
+	changes := make([]restorePointChange, len(point.Changes))
+	for i, change := range point.Changes {
+		changes[i] = restorePointChange{
+			Entity:    string(change.Entity),
+			Action:    string(change.Action),
+			ID:        change.ID,
+			AccountID: change.AccountID,
+		}
+		if before := change.Before; before != nil {
+			changes[i].Before = &restorePointTransaction{
+				AccountID:   before.AccountID,
+				CategoryID:  before.CategoryID,
+				Asset:       before.Monetary.Asset.Asset,
+				Amount:      before.Monetary.Amount,
+				Description: before.Description,
+				Payee:       before.Payee,
+				Date:        before.Date.Format(time.DateOnly),
+				Status:      string(before.Status),
+				ProjectID:   before.ProjectID,
+			}
+		}
+	}
+	data, err := json.Marshal(changes)
+	if err != nil {
+		return entities.RestorePoint{}, err
+	}
+
+	result, err := r.queries.CreateRestorePoint(ctx, bookID, string(point.Operation), point.Description, data)
+	if err != nil {
+		return entities.RestorePoint{}, err
+	}
+
+	return convertRestorePoint(result)
+}
+
+func (r *RestorePointRepository) GetRestorePointByID(ctx context.Context, id string) (entities.RestorePoint, error) {
+	pointID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.RestorePoint{}, err
+	}
+
+	result, err := r.queries.GetRestorePointByID(ctx, pointID)
+	if err != nil {
+		return entities.RestorePoint{}, notFound(err, "restore point")
+	}
+	if err := inBook(ctx, result.BookID, "restore point"); err != nil {
+		return entities.RestorePoint{}, err
+	}
+
+	return convertRestorePoint(result)
+}
+
+// GetAllRestorePoints returns the restore points of the book, newest first
+func (r *RestorePointRepository) GetAllRestorePoints(ctx context.Context) ([]entities.RestorePoint, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllRestorePoints(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]entities.RestorePoint, len(results))
+	for i, result := range results {
+		if points[i], err = convertRestorePoint(result); err != nil {
+			return nil, err
+		}
+	}
+
+	return points, nil
+}
+
+func (r *RestorePointRepository) MarkRestorePointRolledBack(ctx context.Context, id string) (entities.RestorePoint, error) {
+	pointID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.RestorePoint{}, err
+	}
+	if _, err := r.GetRestorePointByID(ctx, id); err != nil {
+		return entities.RestorePoint{}, err
+	}
+
+	result, err := r.queries.MarkRestorePointRolledBack(ctx, pointID)
+	if err != nil {
+		return entities.RestorePoint{}, notFound(err, "restore point")
+	}
+
+	return convertRestorePoint(result)
+}
+
+func convertRestorePoint(result gen.RestorePoint) (entities.RestorePoint, error) {
+	var changes []restorePointChange
+	if err := json.Unmarshal(result.Changes, &changes); err != nil {
+		return entities.RestorePoint{}, err
+	}
+
+	point := entities.RestorePoint{
+		ID:           result.ID.String(),
+		Operation:    entities.RestorePointOperation(result.Operation),
+		Description:  result.Description,
+		Changes:      make([]entities.RestorePointChange, len(changes)),
+		CreatedAt:    result.CreatedAt,
+		RolledBackAt: result.RolledBackAt,
+	}
+	for i, change := range changes {
+		point.Changes[i] = entities.RestorePointChange{
+			Entity:    entities.RestorePointEntity(change.Entity),
+			Action:    entities.RestorePointAction(change.Action),
+			ID:        change.ID,
+			AccountID: change.AccountID,
+		}
+		if change.Before != nil {
+			before, err := change.Before.transaction(change.ID)
+			if err != nil {
+				return entities.RestorePoint{}, err
+			}
+			point.Changes[i].Before = &before
+		}
+	}
+
+	return point, nil
+}
+
+func (t restorePointTransaction) transaction(id string) (entities.Transaction, error) {
+	asset, ok := entities.FindSupportedAsset(t.Asset)
+	if !ok {
+		return entities.Transaction{}, fmt.Errorf("restore point in unsupported asset %s", t.Asset)
+	}
+	if t.Amount == nil {
+		t.Amount = new(big.Int)
+	}
+	date, err := time.Parse(time.DateOnly, t.Date)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+
+	return entities.Transaction{
+		ID:         id,
+		AccountID:  t.AccountID,
+		CategoryID: t.CategoryID,
+		// Amounts are negative for money going out
+		Monetary:    monetary.Monetary{Asset: asset, Amount: t.Amount},
+		Description: t.Description,
+		Payee:       t.Payee,
+		Date:        date,
+		Status:      entities.TransactionStatus(t.Status),
+		ProjectID:   t.ProjectID,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestorePointRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewRestorePointRepository(db)
+	books := NewBookRepository(db)
+	ctx := context.Background()
+
+	cleared := entities.Transaction{
+		ID:          uuid.Must(uuid.NewV4()).String(),
+		AccountID:   uuid.Must(uuid.NewV4()).String(),
+		CategoryID:  uuid.Must(uuid.NewV4()).String(),
+		Monetary:    monetary.Monetary{Asset: monetary.GBP, Amount: big.NewInt(-2500)},
+		Description: "Bakery",
+		Payee:       "Bakery",
+		Date:        time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC),
+		Status:      entities.TransactionStatusPending,
+	}
+	point := entities.RestorePoint{Operation: entities.RestorePointOperationStatementImport, Description: "Wise statement import"}
+	point.Created(entities.RestorePointEntityAccount, uuid.Must(uuid.NewV4()).String(), "")
+	point.Updated(cleared)
+
+	var created entities.RestorePoint
+	t.Run("create and get", func(t *testing.T) {
+		var err error
+		created, err = repo.CreateRestorePoint(ctx, point)
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+		assert.Nil(t, created.RolledBackAt)
+
+		got, err := repo.GetRestorePointByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Wise statement import", got.Description)
+		require.Len(t, got.Changes, 2)
+		assert.Equal(t, point.Changes[0], got.Changes[0])
+		require.NotNil(t, got.Changes[1].Before)
+		assert.Equal(t, int64(-2500), got.Changes[1].Before.Monetary.Amount.Int64())
+		assert.Equal(t, cleared.ID, got.Changes[1].Before.ID)
+		assert.True(t, cleared.Date.Equal(got.Changes[1].Before.Date))
+		assert.Equal(t, entities.TransactionStatusPending, got.Changes[1].Before.Status)
+
+		all, err := repo.GetAllRestorePoints(ctx)
+		require.NoError(t, err)
+		assert.Len(t, all, 1)
+	})
+
+	t.Run("mark rolled back", func(t *testing.T) {
+		rolledBack, err := repo.MarkRestorePointRolledBack(ctx, created.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, rolledBack.RolledBackAt)
+	})
+
+	t.Run("restore points are kept per book", func(t *testing.T) {
+		side, err := books.CreateBook(ctx, entities.Book{Name: "Side business", Asset: monetary.USD})
+		require.NoError(t, err)
+		sideCtx := domain.WithBook(ctx, side.ID)
+
+		_, err = repo.GetRestorePointByID(sideCtx, created.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = repo.MarkRestorePointRolledBack(sideCtx, created.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		all, err := repo.GetAllRestorePoints(sideCtx)
+		require.NoError(t, err)
+		assert.Empty(t, all)
+	})
+
+	t.Run("missing restore point", func(t *testing.T) {
+		_, err := repo.GetRestorePointByID(ctx, uuid.Must(uuid.NewV4()).String())
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,48 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"fmt"
+
+	"github.com/ardanlabs/conf/v3"
+	"github.com/guilhermebr/gox/postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// setRowUserQuery sets the user the row level security policies let through,
+// see app_user_id in the migrations. An empty user lets every row through.
+const setRowUserQuery = "SELECT set_config('app.user_id', $1, false)"
+
+// NewRowSecurityPool connects to postgres like postgres.New, setting each
+// connection taken from the pool to the user of the context it's taken for.
+// The row level security policies then hide the rows of other users even
+// from a query missing its WHERE clause.
+func NewRowSecurityPool(ctx context.Context) (*pgxpool.Pool, error) {
+	var cfg postgres.Config
+	if _, err := conf.Parse("", &cfg); err != nil {
+		return nil, fmt.Errorf("parsing postgres config: %w", err)
+	}
+
+	poolConfig, err := pgxpool.ParseConfig(cfg.ConnectionString())
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup postgres: %w", err)
+	}
+	poolConfig.BeforeAcquire = setRowUser
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup postgres: %w", err)
+	}
+	return pool, nil
+}
+
+// setRowUser sets the connection to the user of the context, or to none
+// without authentication. Connections that fail to take it are dropped
+// instead of being used for the previous user.
+func setRowUser(ctx context.Context, conn *pgx.Conn) bool {
+	userID, _ := domain.UserFromContext(ctx)
+	_, err := conn.Exec(ctx, setRowUserQuery, userID)
+	return err == nil
+}
@@ -0,0 +1,86 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowSecurity(t *testing.T) {
+	db := newTestDB(t)
+	ctx := context.Background()
+
+	// Two users with a book, an account, a project, an invoice and a setting each
+	users := make([]uuid.UUID, 2)
+	for i := range users {
+		users[i] = uuid.Must(uuid.NewV4())
+		_, err := db.Exec(ctx, "INSERT INTO users (id, email, password_hash) VALUES ($1, $2, 'hash')", users[i], fmt.Sprintf("user%d@example.com", i))
+		require.NoError(t, err)
+		var bookID uuid.UUID
+		require.NoError(t, db.QueryRow(ctx, "INSERT INTO books (name, asset, user_id) VALUES ('Personal', 'BRL', $1) RETURNING id", users[i]).Scan(&bookID))
+		var accountID uuid.UUID
+		require.NoError(t, db.QueryRow(ctx, "INSERT INTO accounts (name, type, asset, book_id, user_id) VALUES ('Checking', 'checking', 'BRL', $1, $2) RETURNING id", bookID, users[i]).Scan(&accountID))
+		_, err = db.Exec(ctx, "INSERT INTO projects (name, book_id, user_id) VALUES ('Website', $1, $2)", bookID, users[i])
+		require.NoError(t, err)
+		_, err = db.Exec(ctx, "INSERT INTO invoices (account_id, client, amount, issue_date, due_date) VALUES ($1, 'Acme', 50000, '2025-03-01', '2025-03-31')", accountID)
+		require.NoError(t, err)
+		_, err = db.Exec(ctx, "INSERT INTO user_settings (user_id, key, value) VALUES ($1, 'theme', 'dark')", users[i])
+		require.NoError(t, err)
+	}
+
+	// Superusers bypass the policies, so the test runs as a plain role
+	role := fmt.Sprintf("finance_rls_%d", time.Now().UnixNano())
+	_, err := db.Exec(ctx, "CREATE ROLE "+pgx.Identifier{role}.Sanitize()+" NOLOGIN")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = testServer.Exec(ctx, "DROP ROLE IF EXISTS "+pgx.Identifier{role}.Sanitize())
+	})
+	_, err = db.Exec(ctx, "GRANT SELECT, UPDATE ON ALL TABLES IN SCHEMA public TO "+pgx.Identifier{role}.Sanitize())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = db.Exec(ctx, "REVOKE ALL ON ALL TABLES IN SCHEMA public FROM "+pgx.Identifier{role}.Sanitize())
+	})
+
+	conn, err := db.Acquire(ctx)
+	require.NoError(t, err)
+	defer conn.Release()
+	_, err = conn.Exec(ctx, "SET ROLE "+pgx.Identifier{role}.Sanitize())
+	require.NoError(t, err)
+	defer func() { _, _ = conn.Exec(ctx, "RESET ROLE") }()
+
+	count := func(table string) int {
+		var n int
+		require.NoError(t, conn.QueryRow(ctx, "SELECT COUNT(*) FROM "+table).Scan(&n))
+		return n
+	}
+
+	t.Run("the user's rows only", func(t *testing.T) {
+		require.True(t, setRowUser(domain.WithUser(ctx, users[0].String()), conn.Conn()))
+		assert.Equal(t, 1, count("books"))
+		assert.Equal(t, 1, count("accounts"))
+		assert.Equal(t, 1, count("projects"))
+		assert.Equal(t, 1, count("invoices"))
+		assert.Equal(t, 1, count("user_settings"))
+
+		// Other users' rows can't be changed either
+		tag, err := conn.Exec(ctx, "UPDATE accounts SET name = 'Taken' WHERE user_id = $1", users[1])
+		require.NoError(t, err)
+		assert.Zero(t, tag.RowsAffected())
+	})
+
+	t.Run("every row without a user", func(t *testing.T) {
+		require.True(t, setRowUser(ctx, conn.Conn()))
+		// The default book has no owner
+		assert.Equal(t, 3, count("books"))
+		assert.Equal(t, 2, count("accounts"))
+		assert.Equal(t, 2, count("projects"))
+		assert.Equal(t, 2, count("invoices"))
+	})
+}
@@ -0,0 +1,127 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SessionRepository struct {
+	queries *gen.Queries
+}
+
+func NewSessionRepository(db *pgxpool.Pool) *SessionRepository {
+	return &SessionRepository{
+		queries: gen.New(db),
+	}
+}
+
+func (r *SessionRepository) CreateSession(ctx context.Context, session entities.Session) (entities.Session, error) {
+	userID, err := uuid.FromString(session.UserID)
+	if err != nil {
+		return entities.Session{}, err
+	}
+
+	result, err := r.queries.CreateSession(ctx, userID, session.UserAgent, session.IPAddress, session.ExpiresAt)
+	if err != nil {
+		return entities.Session{}, err
+	}
+
+	return convertSession(result), nil
+}
+
+func (r *SessionRepository) GetSessionByID(ctx context.Context, id string) (entities.Session, error) {
+	sessionID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.Session{}, err
+	}
+
+	result, err := r.queries.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		return entities.Session{}, notFound(err, "session")
+	}
+
+	return convertSession(result), nil
+}
+
+func (r *SessionRepository) GetActiveSessions(ctx context.Context, userID string) ([]entities.Session, error) {
+	id, err := uuid.FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetActiveSessions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]entities.Session, len(results))
+	for i, result := range results {
+		sessions[i] = convertSession(result)
+	}
+
+	return sessions, nil
+}
+
+// TouchSession marks the session as seen now. It's only written once a minute,
+// so a page firing many requests doesn't update it on every one.
+func (r *SessionRepository) TouchSession(ctx context.Context, id string) error {
+	sessionID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.TouchSession(ctx, sessionID)
+}
+
+func (r *SessionRepository) RevokeSession(ctx context.Context, id, userID string) error {
+	sessionID, err := uuid.FromString(id)
+	if err != nil {
+		return fmt.Errorf("session %w", domain.ErrNotFound)
+	}
+	owner, err := uuid.FromString(userID)
+	if err != nil {
+		return err
+	}
+
+	revoked, err := r.queries.RevokeSession(ctx, sessionID, owner)
+	if err != nil {
+		return err
+	}
+	if revoked == 0 {
+		return fmt.Errorf("session %w", domain.ErrNotFound)
+	}
+
+	return nil
+}
+
+func (r *SessionRepository) RevokeOtherSessions(ctx context.Context, userID, keepID string) (int64, error) {
+	owner, err := uuid.FromString(userID)
+	if err != nil {
+		return 0, err
+	}
+	keep, err := uuid.FromString(keepID)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.queries.RevokeOtherSessions(ctx, owner, keep)
+}
+
+func convertSession(result gen.Session) entities.Session {
+	return entities.Session{
+		ID:         result.ID.String(),
+		UserID:     result.UserID.String(),
+		UserAgent:  result.UserAgent,
+		IPAddress:  result.IpAddress,
+		CreatedAt:  result.CreatedAt,
+		LastSeenAt: result.LastSeenAt,
+		ExpiresAt:  result.ExpiresAt,
+		RevokedAt:  result.RevokedAt,
+	}
+}
@@ -0,0 +1,90 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewSessionRepository(db)
+	users := NewUserRepository(db)
+	ctx := context.Background()
+
+	alice, err := users.CreateUser(ctx, entities.User{Email: "alice@example.com", PasswordHash: "hash"})
+	require.NoError(t, err)
+	bob, err := users.CreateUser(ctx, entities.User{Email: "bob@example.com", PasswordHash: "hash"})
+	require.NoError(t, err)
+
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	create := func(userID, userAgent string) entities.Session {
+		session, err := repo.CreateSession(ctx, entities.Session{UserID: userID, UserAgent: userAgent, IPAddress: "192.0.2.1", ExpiresAt: expiresAt})
+		require.NoError(t, err)
+		return session
+	}
+
+	laptop := create(alice.ID, "Firefox")
+	phone := create(alice.ID, "Safari")
+	other := create(bob.ID, "Chrome")
+
+	t.Run("create and get", func(t *testing.T) {
+		assert.NotEmpty(t, laptop.ID)
+		assert.Nil(t, laptop.RevokedAt)
+
+		got, err := repo.GetSessionByID(ctx, laptop.ID)
+		require.NoError(t, err)
+		assert.Equal(t, alice.ID, got.UserID)
+		assert.Equal(t, "Firefox", got.UserAgent)
+		assert.Equal(t, "192.0.2.1", got.IPAddress)
+		assert.True(t, expiresAt.Equal(got.ExpiresAt))
+
+		_, err = repo.GetSessionByID(ctx, uuid.Must(uuid.NewV4()).String())
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("touch", func(t *testing.T) {
+		require.NoError(t, repo.TouchSession(ctx, laptop.ID))
+	})
+
+	t.Run("active sessions of the user", func(t *testing.T) {
+		sessions, err := repo.GetActiveSessions(ctx, alice.ID)
+		require.NoError(t, err)
+		assert.Len(t, sessions, 2)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		err := repo.RevokeSession(ctx, other.ID, alice.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		require.NoError(t, repo.RevokeSession(ctx, phone.ID, alice.ID))
+		got, err := repo.GetSessionByID(ctx, phone.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, got.RevokedAt)
+
+		err = repo.RevokeSession(ctx, phone.ID, alice.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("revoke the others", func(t *testing.T) {
+		create(alice.ID, "Edge")
+		revoked, err := repo.RevokeOtherSessions(ctx, alice.ID, laptop.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), revoked)
+
+		sessions, err := repo.GetActiveSessions(ctx, alice.ID)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, laptop.ID, sessions[0].ID)
+
+		sessions, err = repo.GetActiveSessions(ctx, bob.ID)
+		require.NoError(t, err)
+		assert.Len(t, sessions, 1)
+	})
+}
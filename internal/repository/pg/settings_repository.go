@@ -0,0 +1,77 @@
+package pg
+
+import (
+	"context"
+	"encoding/json"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SettingsRepository struct {
+	queries *gen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewSettingsRepository(db *pgxpool.Pool) *SettingsRepository {
+	return &SettingsRepository{
+		queries: gen.New(db),
+		db:      db,
+	}
+}
+
+func (r *SettingsRepository) GetSettings(ctx context.Context) (entities.Settings, error) {
+	result, err := r.queries.GetSettings(ctx)
+	if err != nil {
+		return entities.Settings{}, err
+	}
+
+	return r.convertSettings(result)
+}
+
+func (r *SettingsRepository) UpdateSettings(ctx context.Context, settings entities.Settings) (entities.Settings, error) {
+	apiKeys, err := json.Marshal(settings.APIKeys)
+	if err != nil {
+		return entities.Settings{}, err
+	}
+
+	result, err := r.queries.UpdateSettings(ctx,
+		settings.Currency.Asset,
+		settings.Locale,
+		int32(settings.FiscalMonthStartDay),
+		settings.NotificationsEnabled,
+		settings.NotificationEmail,
+		apiKeys,
+	)
+	if err != nil {
+		return entities.Settings{}, err
+	}
+
+	return r.convertSettings(result)
+}
+
+func (r *SettingsRepository) convertSettings(result gen.Setting) (entities.Settings, error) {
+	apiKeys := map[string]string{}
+	if len(result.ApiKeys) > 0 {
+		if err := json.Unmarshal(result.ApiKeys, &apiKeys); err != nil {
+			return entities.Settings{}, err
+		}
+	}
+
+	currency, ok := entities.FindSupportedAsset(result.Currency)
+	if !ok {
+		currency = monetary.BRL // default fallback
+	}
+
+	return entities.Settings{
+		Currency:             currency,
+		Locale:               result.Locale,
+		FiscalMonthStartDay:  int(result.FiscalMonthStartDay),
+		NotificationsEnabled: result.NotificationsEnabled,
+		NotificationEmail:    result.NotificationEmail,
+		APIKeys:              apiKeys,
+		UpdatedAt:            result.UpdatedAt,
+	}, nil
+}
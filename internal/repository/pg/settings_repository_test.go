@@ -0,0 +1,54 @@
+package pg
+
+import (
+	"context"
+	"finance/domain/entities"
+	"testing"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettingsRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewSettingsRepository(db)
+	ctx := context.Background()
+
+	t.Run("defaults", func(t *testing.T) {
+		settings, err := repo.GetSettings(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "BRL", settings.Currency.Asset)
+		assert.Equal(t, "pt-BR", settings.Locale)
+		assert.Equal(t, 1, settings.FiscalMonthStartDay)
+		assert.False(t, settings.NotificationsEnabled)
+		assert.Empty(t, settings.APIKeys)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		updated, err := repo.UpdateSettings(ctx, entities.Settings{
+			Currency:             monetary.USD,
+			Locale:               "en-US",
+			FiscalMonthStartDay:  5,
+			NotificationsEnabled: true,
+			NotificationEmail:    "me@example.com",
+			APIKeys:              map[string]string{"openexchangerates": "secret"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "USD", updated.Currency.Asset)
+
+		settings, err := repo.GetSettings(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, updated, settings)
+		assert.Equal(t, "secret", settings.APIKeys["openexchangerates"])
+	})
+
+	t.Run("update rejects out of range fiscal day", func(t *testing.T) {
+		_, err := repo.UpdateSettings(ctx, entities.Settings{
+			Currency:            monetary.USD,
+			Locale:              "en-US",
+			FiscalMonthStartDay: 31,
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,60 @@
+package pg
+
+import (
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"strings"
+)
+
+// Columns each list query can be sorted by, keyed by the field name clients use
+var (
+	accountSortColumns = map[string]string{
+		"name":                  "a.name",
+		"type":                  "a.type",
+		"institution":           "a.institution",
+		"created_at":            "a.created_at",
+		"transaction_count":     "activity.transaction_count",
+		"last_transaction_date": "activity.last_transaction_date",
+	}
+
+	categorySortColumns = map[string]string{
+		"name":       "c.name",
+		"type":       "c.type",
+		"created_at": "c.created_at",
+	}
+
+	transactionSortColumns = map[string]string{
+		"date":        "t.date",
+		"amount":      "t.amount",
+		"description": "t.description",
+		"status":      "t.status",
+		"created_at":  "t.created_at",
+	}
+)
+
+// pageClause limits a list query taking the book and its owner as its only
+// arguments to a page, the limit and offset following them
+const pageClause = "\nLIMIT $3 OFFSET $4"
+
+// orderBy builds the ORDER BY list for the requested sort, only accepting
+// whitelisted fields so nothing from the request ends up in the SQL as is
+func orderBy(sort []entities.SortField, columns map[string]string, fallback string) (string, error) {
+	if len(sort) == 0 {
+		return fallback, nil
+	}
+
+	terms := make([]string, len(sort))
+	for i, field := range sort {
+		column, ok := columns[field.Field]
+		if !ok {
+			return "", fmt.Errorf("%w: unknown sort field %s", domain.ErrMalformedParameters, field.Field)
+		}
+		if field.Desc {
+			column += " DESC"
+		}
+		terms[i] = column
+	}
+
+	return strings.Join(terms, ", "), nil
+}
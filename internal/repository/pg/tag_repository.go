@@ -0,0 +1,123 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TagRepository struct {
+	queries *gen.Queries
+}
+
+func NewTagRepository(db *pgxpool.Pool) *TagRepository {
+	return &TagRepository{
+		queries: gen.New(db),
+	}
+}
+
+func (r *TagRepository) CreateTag(ctx context.Context, tag entities.Tag) (entities.Tag, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.Tag{}, err
+	}
+
+	result, err := r.queries.CreateTag(ctx, bookID, tag.Name)
+	if err != nil {
+		return entities.Tag{}, duplicateTag(err, tag.Name)
+	}
+
+	return convertTag(result), nil
+}
+
+func (r *TagRepository) GetTagByID(ctx context.Context, id string) (entities.Tag, error) {
+	tagID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.Tag{}, err
+	}
+
+	result, err := r.queries.GetTagByID(ctx, tagID)
+	if err != nil {
+		return entities.Tag{}, notFound(err, "tag")
+	}
+	if err := inBook(ctx, result.BookID, "tag"); err != nil {
+		return entities.Tag{}, err
+	}
+
+	return convertTag(result), nil
+}
+
+// GetAllTags returns the tags of the book ordered by name
+func (r *TagRepository) GetAllTags(ctx context.Context) ([]entities.Tag, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllTags(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]entities.Tag, len(results))
+	for i, result := range results {
+		tags[i] = convertTag(result)
+	}
+
+	return tags, nil
+}
+
+func (r *TagRepository) UpdateTag(ctx context.Context, tag entities.Tag) (entities.Tag, error) {
+	tagID, err := uuid.FromString(tag.ID)
+	if err != nil {
+		return entities.Tag{}, err
+	}
+	if _, err := r.GetTagByID(ctx, tag.ID); err != nil {
+		return entities.Tag{}, err
+	}
+
+	result, err := r.queries.UpdateTag(ctx, tagID, tag.Name)
+	if err != nil {
+		return entities.Tag{}, notFound(duplicateTag(err, tag.Name), "tag")
+	}
+
+	return convertTag(result), nil
+}
+
+func (r *TagRepository) DeleteTag(ctx context.Context, id string) error {
+	tagID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+	if _, err := r.GetTagByID(ctx, id); err != nil {
+		return err
+	}
+
+	return r.queries.DeleteTag(ctx, tagID)
+}
+
+// duplicateTag translates a tag name already taken in the book into
+// domain.ErrConflict
+func duplicateTag(err error, name string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("tag %q already exists: %w", name, domain.ErrConflict)
+	}
+	return err
+}
+
+func convertTag(result gen.Tag) entities.Tag {
+	return entities.Tag{
+		ID:        result.ID.String(),
+		Name:      result.Name,
+		CreatedAt: result.CreatedAt,
+		UpdatedAt: result.UpdatedAt,
+	}
+}
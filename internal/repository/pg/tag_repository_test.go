@@ -0,0 +1,90 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewTagRepository(db)
+	transactions := NewTransactionRepository(db)
+	books := NewBookRepository(db)
+	ctx := context.Background()
+
+	var created entities.Tag
+	t.Run("create and get", func(t *testing.T) {
+		var err error
+		created, err = repo.CreateTag(ctx, entities.Tag{Name: "reimbursable"})
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+
+		got, err := repo.GetTagByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created, got)
+
+		_, err = repo.CreateTag(ctx, entities.Tag{Name: "reimbursable"})
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("transactions add the missing tags", func(t *testing.T) {
+		account := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+		category := createTestCategory(t, db, "Test Travel", entities.CategoryTypeExpense)
+		transaction, err := transactions.CreateTransaction(ctx, entities.Transaction{
+			AccountID:   account.ID,
+			CategoryID:  category.ID,
+			Monetary:    monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(-25000)},
+			Description: "Hotel",
+			Date:        time.Date(2024, 7, 2, 0, 0, 0, 0, time.UTC),
+			Status:      entities.TransactionStatusCleared,
+			Tags:        []string{"reimbursable", "vacation2024"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"reimbursable", "vacation2024"}, transaction.Tags)
+
+		all, err := repo.GetAllTags(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		assert.Equal(t, created.ID, all[0].ID)
+		assert.Equal(t, "vacation2024", all[1].Name)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		created.Name = "work"
+		updated, err := repo.UpdateTag(ctx, created)
+		require.NoError(t, err)
+		assert.Equal(t, "work", updated.Name)
+
+		created.Name = "vacation2024"
+		_, err = repo.UpdateTag(ctx, created)
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("tags are kept per book", func(t *testing.T) {
+		side, err := books.CreateBook(ctx, entities.Book{Name: "Side business", Asset: monetary.USD})
+		require.NoError(t, err)
+		sideCtx := domain.WithBook(ctx, side.ID)
+
+		_, err = repo.GetTagByID(sideCtx, created.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.ErrorIs(t, repo.DeleteTag(sideCtx, created.ID), domain.ErrNotFound)
+
+		all, err := repo.GetAllTags(sideCtx)
+		require.NoError(t, err)
+		assert.Empty(t, all)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.DeleteTag(ctx, created.ID))
+		_, err := repo.GetTagByID(ctx, created.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -2,18 +2,39 @@ package pg
 
 import (
 	"context"
-	"database/sql"
+	"finance/domain"
 	"finance/domain/entities"
 	"finance/internal/repository/pg/gen"
+	"fmt"
 	"math/big"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/guilhermebr/gox/monetary"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// listTransactionsWithDetailsQuery is built here instead of sqlc since the ORDER BY depends on the request
+const listTransactionsWithDetailsQuery = `SELECT
+    t.id, t.account_id, t.category_id, t.amount, t.description, t.date, t.status, t.created_at, t.updated_at, t.payee,
+    t.installment_plan_id, t.installment_number, t.installment_count, t.project_id, t.user_id,
+    a.name as account_name, a.type as account_type, a.asset as account_asset,
+    c.name as category_name, c.type as category_type, c.color as category_color
+FROM transactions t
+JOIN accounts a ON t.account_id = a.id
+JOIN categories c ON t.category_id = c.id
+WHERE a.book_id = $4 AND t.deleted_at IS NULL AND ($3::uuid IS NULL OR t.project_id = $3) AND ($5::uuid IS NULL OR t.user_id = $5)
+    AND ($6::text IS NULL OR EXISTS (SELECT 1 FROM transaction_tags tt JOIN tags g ON g.id = tt.tag_id WHERE tt.transaction_id = t.id AND g.name = lower($6)))
+ORDER BY %s
+LIMIT $1 OFFSET $2`
+
+// transactionProjectConstraint keeps transactions filed under existing projects
+const transactionProjectConstraint = "transactions_project_id_fkey"
+
 type TransactionRepository struct {
 	queries *gen.Queries
 	db      *pgxpool.Pool
@@ -26,7 +47,107 @@ func NewTransactionRepository(db *pgxpool.Pool) *TransactionRepository {
 	}
 }
 
+// CreateTransaction creates the transaction along with its tags, adding the
+// tags the book doesn't have yet
 func (r *TransactionRepository) CreateTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+	var created entities.Transaction
+	err := r.inTx(ctx, func(queries *gen.Queries) error {
+		var err error
+		created, err = createTransaction(ctx, queries, transaction)
+		return err
+	})
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+	return created, nil
+}
+
+// CreateTransactions creates the transactions in a single database
+// transaction, so either all of them are created or none is
+func (r *TransactionRepository) CreateTransactions(ctx context.Context, transactions []entities.Transaction) ([]entities.Transaction, error) {
+	created := make([]entities.Transaction, len(transactions))
+	err := r.inTx(ctx, func(queries *gen.Queries) error {
+		for i, transaction := range transactions {
+			var err error
+			if created[i], err = createTransaction(ctx, queries, transaction); err != nil {
+				return fmt.Errorf("transaction %d: %w", i+1, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// ApplyBulkOperations applies the operations in order in a single database
+// transaction, so either all of them are applied or none is. The operation
+// that failed is named in a *domain.BulkError.
+func (r *TransactionRepository) ApplyBulkOperations(ctx context.Context, operations []entities.BulkOperation) ([]entities.Transaction, error) {
+	transactions := make([]entities.Transaction, len(operations))
+	// The updated transactions are converted once committed
+	updated := map[int]gen.Transaction{}
+	err := r.inTx(ctx, func(queries *gen.Queries) error {
+		for i, operation := range operations {
+			var err error
+			switch operation.Action {
+			case entities.BulkActionCreate:
+				transactions[i], err = createTransaction(ctx, queries, operation.Transaction)
+			case entities.BulkActionUpdateStatus:
+				var id uuid.UUID
+				if id, err = uuid.FromString(operation.ID); err == nil {
+					var result gen.Transaction
+					if result, err = queries.UpdateTransactionStatus(ctx, id, string(operation.Status)); err != nil {
+						err = notFound(err, "transaction")
+					}
+					updated[i] = result
+				}
+			case entities.BulkActionDelete:
+				var id uuid.UUID
+				if id, err = uuid.FromString(operation.ID); err == nil {
+					err = queries.DeleteTransaction(ctx, id)
+					transactions[i] = entities.Transaction{ID: operation.ID}
+				}
+			default:
+				err = fmt.Errorf("unknown bulk action %s", operation.Action)
+			}
+			if err != nil {
+				return &domain.BulkError{Failures: []domain.BulkFailure{{Index: i, Err: err}}}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, result := range updated {
+		converted, err := r.convertTransactions(ctx, []gen.Transaction{result})
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = converted[0]
+	}
+	return transactions, nil
+}
+
+// inTx runs fn in a database transaction, committed when fn succeeds
+func (r *TransactionRepository) inTx(ctx context.Context, fn func(queries *gen.Queries) error) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	// Rolling back after the commit does nothing
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := fn(r.queries.WithTx(tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func createTransaction(ctx context.Context, queries *gen.Queries, transaction entities.Transaction) (entities.Transaction, error) {
 	accountID, err := uuid.FromString(transaction.AccountID)
 	if err != nil {
 		return entities.Transaction{}, err
@@ -42,21 +163,41 @@ func (r *TransactionRepository) CreateTransaction(ctx context.Context, transacti
 		Valid: true,
 	}
 
+	planID, err := nullUUID(transaction.InstallmentPlanID)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+
+	projectID, err := nullUUID(transaction.ProjectID)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+
+	if err := projectInBook(ctx, queries, projectID, accountID); err != nil {
+		return entities.Transaction{}, err
+	}
+
 	// Convert monetary to int64 for storage
 	amount := transaction.Monetary.Amount.Int64()
 
-	result, err := r.queries.CreateTransaction(ctx, accountID, categoryID, amount, transaction.Description, date, string(transaction.Status))
+	result, err := queries.CreateTransaction(ctx, accountID, categoryID, amount, transaction.Description, date, string(transaction.Status), transaction.Payee, planID, int32(transaction.InstallmentNumber), int32(transaction.InstallmentCount), projectID)
 	if err != nil {
-		return entities.Transaction{}, err
+		return entities.Transaction{}, missingReference(err, transactionProjectConstraint, "project")
 	}
 
 	// Get the account to retrieve the asset information
-	account, err := r.queries.GetAccountByID(ctx, result.AccountID)
+	account, err := queries.GetAccountByID(ctx, result.AccountID)
 	if err != nil {
 		return entities.Transaction{}, err
 	}
 
-	asset, ok := monetary.FindAssetByName(account.Asset)
+	if len(transaction.Tags) > 0 {
+		if err := setTransactionTags(ctx, queries, account.BookID, result.ID, transaction.Tags); err != nil {
+			return entities.Transaction{}, err
+		}
+	}
+
+	asset, ok := entities.FindSupportedAsset(account.Asset)
 	if !ok {
 		asset = monetary.BRL // default fallback
 	}
@@ -68,15 +209,22 @@ func (r *TransactionRepository) CreateTransaction(ctx context.Context, transacti
 	}
 
 	return entities.Transaction{
-		ID:          result.ID.String(),
-		AccountID:   result.AccountID.String(),
-		CategoryID:  result.CategoryID.String(),
-		Monetary:    *monetaryAmount,
-		Description: result.Description,
-		Date:        result.Date.Time,
-		Status:      entities.TransactionStatus(result.Status),
-		CreatedAt:   result.CreatedAt,
-		UpdatedAt:   result.UpdatedAt,
+		ID:                result.ID.String(),
+		AccountID:         result.AccountID.String(),
+		CategoryID:        result.CategoryID.String(),
+		Monetary:          *monetaryAmount,
+		Description:       result.Description,
+		Payee:             result.Payee,
+		InstallmentPlanID: uuidString(result.InstallmentPlanID),
+		InstallmentNumber: int(result.InstallmentNumber),
+		InstallmentCount:  int(result.InstallmentCount),
+		ProjectID:         uuidString(result.ProjectID),
+		Tags:              transaction.Tags,
+		OwnerID:           uuidString(result.UserID),
+		Date:              result.Date.Time,
+		Status:            entities.TransactionStatus(result.Status),
+		CreatedAt:         result.CreatedAt,
+		UpdatedAt:         result.UpdatedAt,
 	}, nil
 }
 
@@ -88,10 +236,7 @@ func (r *TransactionRepository) GetTransactionByID(ctx context.Context, id strin
 
 	result, err := r.queries.GetTransactionByID(ctx, uuid)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return entities.Transaction{}, nil
-		}
-		return entities.Transaction{}, err
+		return entities.Transaction{}, notFound(err, "transaction")
 	}
 
 	// Get the account to retrieve the asset information
@@ -100,7 +245,14 @@ func (r *TransactionRepository) GetTransactionByID(ctx context.Context, id strin
 		return entities.Transaction{}, err
 	}
 
-	asset, ok := monetary.FindAssetByName(account.Asset)
+	if err := inBook(ctx, account.BookID, "transaction"); err != nil {
+		return entities.Transaction{}, err
+	}
+	if err := ofUser(ctx, result.UserID, "transaction"); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	asset, ok := entities.FindSupportedAsset(account.Asset)
 	if !ok {
 		asset = monetary.BRL // default fallback
 	}
@@ -111,26 +263,50 @@ func (r *TransactionRepository) GetTransactionByID(ctx context.Context, id strin
 		return entities.Transaction{}, err
 	}
 
-	return entities.Transaction{
-		ID:          result.ID.String(),
-		AccountID:   result.AccountID.String(),
-		CategoryID:  result.CategoryID.String(),
-		Monetary:    *monetaryAmount,
-		Description: result.Description,
-		Date:        result.Date.Time,
-		Status:      entities.TransactionStatus(result.Status),
-		CreatedAt:   result.CreatedAt,
-		UpdatedAt:   result.UpdatedAt,
-	}, nil
+	transaction := entities.Transaction{
+		ID:                result.ID.String(),
+		AccountID:         result.AccountID.String(),
+		CategoryID:        result.CategoryID.String(),
+		Monetary:          *monetaryAmount,
+		Description:       result.Description,
+		Payee:             result.Payee,
+		InstallmentPlanID: uuidString(result.InstallmentPlanID),
+		InstallmentNumber: int(result.InstallmentNumber),
+		InstallmentCount:  int(result.InstallmentCount),
+		ProjectID:         uuidString(result.ProjectID),
+		OwnerID:           uuidString(result.UserID),
+		Date:              result.Date.Time,
+		Status:            entities.TransactionStatus(result.Status),
+		CreatedAt:         result.CreatedAt,
+		UpdatedAt:         result.UpdatedAt,
+	}
+	if transaction.Tags, err = getTags(ctx, r.queries, result.ID); err != nil {
+		return entities.Transaction{}, err
+	}
+	transactions := []entities.Transaction{transaction}
+	if err := r.loadAttachments(ctx, transactions); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	return transactions[0], nil
 }
 
 func (r *TransactionRepository) GetAllTransactions(ctx context.Context) ([]entities.Transaction, error) {
-	results, err := r.queries.GetAllTransactions(ctx)
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllTransactions(ctx, bookID, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.convertTransactions(results), nil
+	return r.convertTransactions(ctx, results)
 }
 
 func (r *TransactionRepository) GetTransactionsByAccount(ctx context.Context, accountID string) ([]entities.Transaction, error) {
@@ -138,13 +314,17 @@ func (r *TransactionRepository) GetTransactionsByAccount(ctx context.Context, ac
 	if err != nil {
 		return nil, err
 	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	results, err := r.queries.GetTransactionsByAccount(ctx, uuid)
+	results, err := r.queries.GetTransactionsByAccount(ctx, uuid, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.convertTransactions(results), nil
+	return r.convertTransactions(ctx, results)
 }
 
 func (r *TransactionRepository) GetTransactionsByCategory(ctx context.Context, categoryID string) ([]entities.Transaction, error) {
@@ -152,25 +332,38 @@ func (r *TransactionRepository) GetTransactionsByCategory(ctx context.Context, c
 	if err != nil {
 		return nil, err
 	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	results, err := r.queries.GetTransactionsByCategory(ctx, uuid)
+	results, err := r.queries.GetTransactionsByCategory(ctx, uuid, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.convertTransactions(results), nil
+	return r.convertTransactions(ctx, results)
 }
 
 func (r *TransactionRepository) GetTransactionsByDateRange(ctx context.Context, startDate, endDate time.Time) ([]entities.Transaction, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	startPgDate := pgtype.Date{Time: startDate, Valid: true}
 	endPgDate := pgtype.Date{Time: endDate, Valid: true}
 
-	results, err := r.queries.GetTransactionsByDateRange(ctx, startPgDate, endPgDate)
+	results, err := r.queries.GetTransactionsByDateRange(ctx, startPgDate, endPgDate, bookID, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.convertTransactions(results), nil
+	return r.convertTransactions(ctx, results)
 }
 
 func (r *TransactionRepository) GetTransactionsByAccountAndDateRange(ctx context.Context, accountID string, startDate, endDate time.Time) ([]entities.Transaction, error) {
@@ -179,15 +372,56 @@ func (r *TransactionRepository) GetTransactionsByAccountAndDateRange(ctx context
 		return nil, err
 	}
 
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	startPgDate := pgtype.Date{Time: startDate, Valid: true}
 	endPgDate := pgtype.Date{Time: endDate, Valid: true}
 
-	results, err := r.queries.GetTransactionsByAccountAndDateRange(ctx, uuid, startPgDate, endPgDate)
+	results, err := r.queries.GetTransactionsByAccountAndDateRange(ctx, uuid, startPgDate, endPgDate, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.convertTransactions(ctx, results)
+}
+
+func (r *TransactionRepository) GetTransactionsByInstallmentPlan(ctx context.Context, planID string) ([]entities.Transaction, error) {
+	uuid, err := uuid.FromString(planID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetTransactionsByInstallmentPlan(ctx, &uuid, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.convertTransactions(ctx, results)
+}
+
+func (r *TransactionRepository) GetTransactionsByProject(ctx context.Context, projectID string) ([]entities.Transaction, error) {
+	uuid, err := uuid.FromString(projectID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetTransactionsByProject(ctx, &uuid, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.convertTransactions(results), nil
+	return r.convertTransactions(ctx, results)
 }
 
 func (r *TransactionRepository) UpdateTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
@@ -211,21 +445,48 @@ func (r *TransactionRepository) UpdateTransaction(ctx context.Context, transacti
 		Valid: true,
 	}
 
-	// Convert monetary to int64 for storage
-	amount := transaction.Monetary.Amount.Int64()
-
-	result, err := r.queries.UpdateTransaction(ctx, id, accountID, categoryID, amount, transaction.Description, date, string(transaction.Status))
+	projectID, err := nullUUID(transaction.ProjectID)
 	if err != nil {
 		return entities.Transaction{}, err
 	}
 
-	// Get the account to retrieve the asset information
-	account, err := r.queries.GetAccountByID(ctx, result.AccountID)
+	// Convert monetary to int64 for storage
+	amount := transaction.Monetary.Amount.Int64()
+
+	var (
+		result  gen.Transaction
+		account gen.GetAccountByIDRow
+		tags    = transaction.Tags
+	)
+	err = r.inTx(ctx, func(queries *gen.Queries) error {
+		if err := projectInBook(ctx, queries, projectID, accountID); err != nil {
+			return err
+		}
+
+		var err error
+		result, err = queries.UpdateTransaction(ctx, id, accountID, categoryID, amount, transaction.Description, date, string(transaction.Status), transaction.Payee, projectID)
+		if err != nil {
+			return notFound(missingReference(err, transactionProjectConstraint, "project"), "transaction")
+		}
+
+		// Get the account to retrieve the asset information
+		account, err = queries.GetAccountByID(ctx, result.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// nil tags are left as they are
+		if tags == nil {
+			tags, err = getTags(ctx, queries, result.ID)
+			return err
+		}
+		return setTransactionTags(ctx, queries, account.BookID, result.ID, tags)
+	})
 	if err != nil {
 		return entities.Transaction{}, err
 	}
 
-	asset, ok := monetary.FindAssetByName(account.Asset)
+	asset, ok := entities.FindSupportedAsset(account.Asset)
 	if !ok {
 		asset = monetary.BRL // default fallback
 	}
@@ -237,15 +498,22 @@ func (r *TransactionRepository) UpdateTransaction(ctx context.Context, transacti
 	}
 
 	return entities.Transaction{
-		ID:          result.ID.String(),
-		AccountID:   result.AccountID.String(),
-		CategoryID:  result.CategoryID.String(),
-		Monetary:    *monetaryAmount,
-		Description: result.Description,
-		Date:        result.Date.Time,
-		Status:      entities.TransactionStatus(result.Status),
-		CreatedAt:   result.CreatedAt,
-		UpdatedAt:   result.UpdatedAt,
+		ID:                result.ID.String(),
+		AccountID:         result.AccountID.String(),
+		CategoryID:        result.CategoryID.String(),
+		Monetary:          *monetaryAmount,
+		Description:       result.Description,
+		Payee:             result.Payee,
+		InstallmentPlanID: uuidString(result.InstallmentPlanID),
+		InstallmentNumber: int(result.InstallmentNumber),
+		InstallmentCount:  int(result.InstallmentCount),
+		ProjectID:         uuidString(result.ProjectID),
+		Tags:              tags,
+		OwnerID:           uuidString(result.UserID),
+		Date:              result.Date.Time,
+		Status:            entities.TransactionStatus(result.Status),
+		CreatedAt:         result.CreatedAt,
+		UpdatedAt:         result.UpdatedAt,
 	}, nil
 }
 
@@ -257,7 +525,7 @@ func (r *TransactionRepository) UpdateTransactionStatus(ctx context.Context, id
 
 	result, err := r.queries.UpdateTransactionStatus(ctx, uuid, string(status))
 	if err != nil {
-		return entities.Transaction{}, err
+		return entities.Transaction{}, notFound(err, "transaction")
 	}
 
 	// Get the account to retrieve the asset information
@@ -266,7 +534,7 @@ func (r *TransactionRepository) UpdateTransactionStatus(ctx context.Context, id
 		return entities.Transaction{}, err
 	}
 
-	asset, ok := monetary.FindAssetByName(account.Asset)
+	asset, ok := entities.FindSupportedAsset(account.Asset)
 	if !ok {
 		asset = monetary.BRL // default fallback
 	}
@@ -278,15 +546,21 @@ func (r *TransactionRepository) UpdateTransactionStatus(ctx context.Context, id
 	}
 
 	return entities.Transaction{
-		ID:          result.ID.String(),
-		AccountID:   result.AccountID.String(),
-		CategoryID:  result.CategoryID.String(),
-		Monetary:    *monetaryAmount,
-		Description: result.Description,
-		Date:        result.Date.Time,
-		Status:      entities.TransactionStatus(result.Status),
-		CreatedAt:   result.CreatedAt,
-		UpdatedAt:   result.UpdatedAt,
+		ID:                result.ID.String(),
+		AccountID:         result.AccountID.String(),
+		CategoryID:        result.CategoryID.String(),
+		Monetary:          *monetaryAmount,
+		Description:       result.Description,
+		Payee:             result.Payee,
+		InstallmentPlanID: uuidString(result.InstallmentPlanID),
+		InstallmentNumber: int(result.InstallmentNumber),
+		InstallmentCount:  int(result.InstallmentCount),
+		ProjectID:         uuidString(result.ProjectID),
+		OwnerID:           uuidString(result.UserID),
+		Date:              result.Date.Time,
+		Status:            entities.TransactionStatus(result.Status),
+		CreatedAt:         result.CreatedAt,
+		UpdatedAt:         result.UpdatedAt,
 	}, nil
 }
 
@@ -299,18 +573,138 @@ func (r *TransactionRepository) DeleteTransaction(ctx context.Context, id string
 	return r.queries.DeleteTransaction(ctx, uuid)
 }
 
+// GetDeletedTransactions lists the transactions in the trash of the book, the
+// latest deleted first
+func (r *TransactionRepository) GetDeletedTransactions(ctx context.Context, limit, offset int) ([]entities.Transaction, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetDeletedTransactions(ctx, int32(limit), int32(offset), bookID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.convertTransactions(ctx, results)
+}
+
+// CountDeletedTransactions counts the transactions in the trash of the book
+func (r *TransactionRepository) CountDeletedTransactions(ctx context.Context) (int64, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return 0, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.queries.CountDeletedTransactions(ctx, bookID, userID)
+}
+
+// SearchTransactions lists the transactions of the book whose description or
+// payee has every word of query, the words taken as prefixes, the best
+// matches first
+func (r *TransactionRepository) SearchTransactions(ctx context.Context, query string, limit, offset int) ([]entities.Transaction, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.SearchTransactions(ctx, bookID, userID, searchQuery(query), int32(limit), int32(offset))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.convertTransactions(ctx, results)
+}
+
+// CountSearchTransactions counts the transactions of the book matching query
+// like SearchTransactions
+func (r *TransactionRepository) CountSearchTransactions(ctx context.Context, query string) (int64, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return 0, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.queries.CountSearchTransactions(ctx, bookID, userID, searchQuery(query))
+}
+
+// searchQuery turns what was typed in a search into a tsquery matching every
+// word as a prefix, e.g. "super mark" into "super:* & mark:*". Only letters
+// and digits are kept, so the tsquery syntax can't be given.
+func searchQuery(query string) string {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+// RestoreTransaction takes a transaction of the book out of the trash
+func (r *TransactionRepository) RestoreTransaction(ctx context.Context, id string) (entities.Transaction, error) {
+	uuid, err := uuid.FromString(id)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+
+	result, err := r.queries.RestoreTransaction(ctx, uuid, bookID, userID)
+	if err != nil {
+		return entities.Transaction{}, notFound(err, "deleted transaction")
+	}
+
+	restored, err := r.convertTransactions(ctx, []gen.Transaction{result})
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+	return restored[0], nil
+}
+
 func (r *TransactionRepository) GetTransactionWithDetails(ctx context.Context, id string) (entities.Transaction, error) {
 	uuid, err := uuid.FromString(id)
 	if err != nil {
 		return entities.Transaction{}, err
 	}
 
-	result, err := r.queries.GetTransactionWithDetails(ctx, uuid)
+	bookID, err := contextBook(ctx)
 	if err != nil {
 		return entities.Transaction{}, err
 	}
 
-	asset, ok := monetary.FindAssetByName(result.AccountAsset)
+	result, err := r.queries.GetTransactionWithDetails(ctx, uuid, bookID)
+	if err != nil {
+		return entities.Transaction{}, notFound(err, "transaction")
+	}
+
+	if err := ofUser(ctx, result.UserID, "transaction"); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	asset, ok := entities.FindSupportedAsset(result.AccountAsset)
 	if !ok {
 		asset = monetary.BRL // default fallback
 	}
@@ -321,16 +715,22 @@ func (r *TransactionRepository) GetTransactionWithDetails(ctx context.Context, i
 		return entities.Transaction{}, err
 	}
 
-	return entities.Transaction{
-		ID:          result.ID.String(),
-		AccountID:   result.AccountID.String(),
-		CategoryID:  result.CategoryID.String(),
-		Monetary:    *monetaryAmount,
-		Description: result.Description,
-		Date:        result.Date.Time,
-		Status:      entities.TransactionStatus(result.Status),
-		CreatedAt:   result.CreatedAt,
-		UpdatedAt:   result.UpdatedAt,
+	transaction := entities.Transaction{
+		ID:                result.ID.String(),
+		AccountID:         result.AccountID.String(),
+		CategoryID:        result.CategoryID.String(),
+		Monetary:          *monetaryAmount,
+		Description:       result.Description,
+		Payee:             result.Payee,
+		InstallmentPlanID: uuidString(result.InstallmentPlanID),
+		InstallmentNumber: int(result.InstallmentNumber),
+		InstallmentCount:  int(result.InstallmentCount),
+		ProjectID:         uuidString(result.ProjectID),
+		OwnerID:           uuidString(result.UserID),
+		Date:              result.Date.Time,
+		Status:            entities.TransactionStatus(result.Status),
+		CreatedAt:         result.CreatedAt,
+		UpdatedAt:         result.UpdatedAt,
 		Account: &entities.Account{
 			ID:   result.AccountID.String(),
 			Name: result.AccountName,
@@ -342,18 +742,51 @@ func (r *TransactionRepository) GetTransactionWithDetails(ctx context.Context, i
 			Type:  entities.CategoryType(result.CategoryType),
 			Color: result.CategoryColor,
 		},
-	}, nil
+	}
+	if transaction.Tags, err = getTags(ctx, r.queries, result.ID); err != nil {
+		return entities.Transaction{}, err
+	}
+	transactions := []entities.Transaction{transaction}
+	if err := r.loadAttachments(ctx, transactions); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	return transactions[0], nil
 }
 
-func (r *TransactionRepository) GetTransactionsWithDetails(ctx context.Context, limit, offset int) ([]entities.Transaction, error) {
-	results, err := r.queries.GetTransactionsWithDetails(ctx, int32(limit), int32(offset))
+func (r *TransactionRepository) GetTransactionsWithDetails(ctx context.Context, filter entities.TransactionFilter, limit, offset int, sort []entities.SortField) ([]entities.Transaction, error) {
+	order, err := orderBy(sort, transactionSortColumns, "t.date DESC, t.created_at DESC")
+	if err != nil {
+		return nil, err
+	}
+
+	projectID, err := nullUUID(filter.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, fmt.Sprintf(listTransactionsWithDetailsQuery, order+", t.id"), int32(limit), int32(offset), projectID, bookID, userID, nullString(filter.Tag))
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByPos[gen.GetTransactionWithDetailsRow])
 	if err != nil {
 		return nil, err
 	}
 
 	transactions := make([]entities.Transaction, len(results))
 	for i, result := range results {
-		asset, ok := monetary.FindAssetByName(result.AccountAsset)
+		asset, ok := entities.FindSupportedAsset(result.AccountAsset)
 		if !ok {
 			asset = monetary.BRL // default fallback
 		}
@@ -365,15 +798,21 @@ func (r *TransactionRepository) GetTransactionsWithDetails(ctx context.Context,
 		}
 
 		transactions[i] = entities.Transaction{
-			ID:          result.ID.String(),
-			AccountID:   result.AccountID.String(),
-			CategoryID:  result.CategoryID.String(),
-			Monetary:    *monetaryAmount,
-			Description: result.Description,
-			Date:        result.Date.Time,
-			Status:      entities.TransactionStatus(result.Status),
-			CreatedAt:   result.CreatedAt,
-			UpdatedAt:   result.UpdatedAt,
+			ID:                result.ID.String(),
+			AccountID:         result.AccountID.String(),
+			CategoryID:        result.CategoryID.String(),
+			Monetary:          *monetaryAmount,
+			Description:       result.Description,
+			Payee:             result.Payee,
+			InstallmentPlanID: uuidString(result.InstallmentPlanID),
+			InstallmentNumber: int(result.InstallmentNumber),
+			InstallmentCount:  int(result.InstallmentCount),
+			ProjectID:         uuidString(result.ProjectID),
+			OwnerID:           uuidString(result.UserID),
+			Date:              result.Date.Time,
+			Status:            entities.TransactionStatus(result.Status),
+			CreatedAt:         result.CreatedAt,
+			UpdatedAt:         result.UpdatedAt,
 			Account: &entities.Account{
 				ID:   result.AccountID.String(),
 				Name: result.AccountName,
@@ -388,42 +827,365 @@ func (r *TransactionRepository) GetTransactionsWithDetails(ctx context.Context,
 		}
 	}
 
+	if err := r.loadTags(ctx, transactions); err != nil {
+		return nil, err
+	}
+	if err := r.loadAttachments(ctx, transactions); err != nil {
+		return nil, err
+	}
+
 	return transactions, nil
 }
 
-func (r *TransactionRepository) convertTransactions(results []gen.Transaction) []entities.Transaction {
-	transactions := make([]entities.Transaction, len(results))
+// convertTransactions looks up the asset of each transaction's account, once per
+// account, using the caller's context so cancelled requests stop querying
+// CountTransactions counts the transactions matching filter
+func (r *TransactionRepository) CountTransactions(ctx context.Context, filter entities.TransactionFilter) (int64, error) {
+	projectID, err := nullUUID(filter.ProjectID)
+	if err != nil {
+		return 0, err
+	}
+
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return 0, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.queries.CountTransactions(ctx, bookID, projectID, userID, nullString(filter.Tag))
+}
+
+// GetTransactionCube adds up the pending and cleared transactions of the
+// book from startDate to endDate with grouping sets, so the cells and their
+// totals come from a single query
+func (r *TransactionRepository) GetTransactionCube(ctx context.Context, rows, cols entities.ReportDimension, startDate, endDate time.Time) ([]entities.CubeGroup, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetTransactionCube(ctx,
+		string(rows),
+		string(cols),
+		pgtype.Date{Time: startDate, Valid: true},
+		pgtype.Date{Time: endDate, Valid: true},
+		bookID,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]entities.CubeGroup, len(results))
 	for i, result := range results {
-		// For convertTransactions, we need to fetch the account to get the asset
-		// This is not ideal but necessary for the current implementation
-		account, err := r.queries.GetAccountByID(context.Background(), result.AccountID)
+		asset, ok := entities.FindSupportedAsset(result.Asset)
+		if !ok {
+			asset = monetary.BRL // default fallback
+		}
+		sum, err := monetary.NewMonetary(asset, big.NewInt(result.Sum))
 		if err != nil {
-			continue // skip this transaction if we can't get the account
+			return nil, err
 		}
 
-		asset, ok := monetary.FindAssetByName(account.Asset)
+		// GROUPING sets a bit for each key added up, the row's being the
+		// higher one
+		groups[i] = entities.CubeGroup{
+			Row:     result.RowKey,
+			Col:     result.ColKey,
+			AllRows: result.GroupingID&2 != 0,
+			AllCols: result.GroupingID&1 != 0,
+			Count:   int(result.Count),
+			Sum:     *sum,
+		}
+	}
+
+	return groups, nil
+}
+
+// GetSpending adds up the pending and cleared expenses of the book from
+// startDate to endDate per asset, by category and by month when asked
+func (r *TransactionRepository) GetSpending(ctx context.Context, byCategory, byMonth bool, startDate, endDate time.Time) ([]entities.SpendingTotal, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetSpending(ctx,
+		byCategory,
+		byMonth,
+		pgtype.Date{Time: startDate, Valid: true},
+		pgtype.Date{Time: endDate, Valid: true},
+		bookID,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make([]entities.SpendingTotal, len(results))
+	for i, result := range results {
+		asset, ok := entities.FindSupportedAsset(result.Asset)
 		if !ok {
 			asset = monetary.BRL // default fallback
 		}
+		total, err := monetary.NewMonetary(asset, big.NewInt(result.Total))
+		if err != nil {
+			return nil, err
+		}
+
+		totals[i] = entities.SpendingTotal{
+			CategoryID: result.CategoryKey,
+			Month:      result.MonthKey,
+			Count:      int(result.Count),
+			Total:      *total,
+		}
+	}
+
+	return totals, nil
+}
+
+// GetCashFlow adds up the pending and cleared income and expenses of the book
+// from startDate to endDate per asset and month, of one account when given
+func (r *TransactionRepository) GetCashFlow(ctx context.Context, accountID string, startDate, endDate time.Time) ([]entities.CashFlowTotal, error) {
+	account, err := nullUUID(accountID)
+	if err != nil {
+		return nil, err
+	}
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetCashFlow(ctx,
+		pgtype.Date{Time: startDate, Valid: true},
+		pgtype.Date{Time: endDate, Valid: true},
+		account,
+		bookID,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make([]entities.CashFlowTotal, len(results))
+	for i, result := range results {
+		asset, ok := entities.FindSupportedAsset(result.Asset)
+		if !ok {
+			asset = monetary.BRL // default fallback
+		}
+		income, err := monetary.NewMonetary(asset, big.NewInt(result.Income))
+		if err != nil {
+			return nil, err
+		}
+		expense, err := monetary.NewMonetary(asset, big.NewInt(result.Expense))
+		if err != nil {
+			return nil, err
+		}
+
+		totals[i] = entities.CashFlowTotal{
+			Month:   result.Month,
+			Income:  *income,
+			Expense: *expense,
+		}
+	}
+
+	return totals, nil
+}
+
+func (r *TransactionRepository) convertTransactions(ctx context.Context, results []gen.Transaction) ([]entities.Transaction, error) {
+	assets := make(map[uuid.UUID]monetary.Asset)
+
+	transactions := make([]entities.Transaction, len(results))
+	for i, result := range results {
+		asset, ok := assets[result.AccountID]
+		if !ok {
+			account, err := r.queries.GetAccountByID(ctx, result.AccountID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get account %s: %w", result.AccountID, err)
+			}
+
+			asset, ok = entities.FindSupportedAsset(account.Asset)
+			if !ok {
+				asset = monetary.BRL // default fallback
+			}
+			assets[result.AccountID] = asset
+		}
 
 		// Convert back to monetary
 		monetaryAmount, err := monetary.NewMonetary(asset, big.NewInt(result.Amount))
 		if err != nil {
-			continue // skip this transaction if we can't get the account
+			return nil, err
 		}
 
 		transactions[i] = entities.Transaction{
-			ID:          result.ID.String(),
-			AccountID:   result.AccountID.String(),
-			CategoryID:  result.CategoryID.String(),
-			Monetary:    *monetaryAmount,
-			Description: result.Description,
-			Date:        result.Date.Time,
-			Status:      entities.TransactionStatus(result.Status),
-			CreatedAt:   result.CreatedAt,
-			UpdatedAt:   result.UpdatedAt,
+			ID:                result.ID.String(),
+			AccountID:         result.AccountID.String(),
+			CategoryID:        result.CategoryID.String(),
+			Monetary:          *monetaryAmount,
+			Description:       result.Description,
+			Payee:             result.Payee,
+			InstallmentPlanID: uuidString(result.InstallmentPlanID),
+			InstallmentNumber: int(result.InstallmentNumber),
+			InstallmentCount:  int(result.InstallmentCount),
+			ProjectID:         uuidString(result.ProjectID),
+			OwnerID:           uuidString(result.UserID),
+			Date:              result.Date.Time,
+			Status:            entities.TransactionStatus(result.Status),
+			CreatedAt:         result.CreatedAt,
+			UpdatedAt:         result.UpdatedAt,
+			DeletedAt:         result.DeletedAt,
 		}
 	}
 
-	return transactions
+	return transactions, nil
+}
+
+// nullUUID parses an optional ID, empty for NULL
+func nullUUID(id string) (*uuid.UUID, error) {
+	if id == "" {
+		return nil, nil
+	}
+	parsed, err := uuid.FromString(id)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// projectInBook rejects a project of another book than the account's as not
+// found, so transactions are only filed under the projects of their book
+func projectInBook(ctx context.Context, queries *gen.Queries, projectID *uuid.UUID, accountID uuid.UUID) error {
+	if projectID == nil {
+		return nil
+	}
+
+	project, err := queries.GetProjectByID(ctx, *projectID)
+	if err != nil {
+		return notFound(err, "project")
+	}
+	account, err := queries.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return notFound(err, "account")
+	}
+	if project.BookID != account.BookID {
+		return fmt.Errorf("project %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// uuidString formats an optional ID, empty for NULL
+func uuidString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+// nullString is an optional text, empty for NULL
+func nullString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// setTransactionTags replaces the tags of a transaction, adding the tags the
+// book doesn't have yet
+func setTransactionTags(ctx context.Context, queries *gen.Queries, bookID, transactionID uuid.UUID, tags []string) error {
+	if err := queries.DeleteTransactionTags(ctx, transactionID); err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	if err := queries.CreateTagsByName(ctx, bookID, tags); err != nil {
+		return err
+	}
+	return queries.AddTransactionTags(ctx, transactionID, bookID, tags)
+}
+
+// getTags returns the tag names of a transaction in alphabetical order
+func getTags(ctx context.Context, queries *gen.Queries, transactionID uuid.UUID) ([]string, error) {
+	rows, err := queries.GetTransactionTags(ctx, []uuid.UUID{transactionID})
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []string
+	for _, row := range rows {
+		tags = append(tags, row.Name)
+	}
+	return tags, nil
+}
+
+// loadTags sets the tags of the transactions with a single query
+func (r *TransactionRepository) loadTags(ctx context.Context, transactions []entities.Transaction) error {
+	ids := make([]uuid.UUID, 0, len(transactions))
+	positions := make(map[uuid.UUID]int, len(transactions))
+	for i, transaction := range transactions {
+		// Transactions left out of a list are empty
+		id, err := uuid.FromString(transaction.ID)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+		positions[id] = i
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	rows, err := r.queries.GetTransactionTags(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		i := positions[row.TransactionID]
+		transactions[i].Tags = append(transactions[i].Tags, row.Name)
+	}
+	return nil
+}
+
+// loadAttachments sets the attachments of the transactions with a single
+// query
+func (r *TransactionRepository) loadAttachments(ctx context.Context, transactions []entities.Transaction) error {
+	ids := make([]uuid.UUID, 0, len(transactions))
+	positions := make(map[uuid.UUID]int, len(transactions))
+	for i, transaction := range transactions {
+		// Transactions left out of a list are empty
+		id, err := uuid.FromString(transaction.ID)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+		positions[id] = i
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	results, err := r.queries.GetTransactionAttachments(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		i := positions[result.TransactionID]
+		transactions[i].Attachments = append(transactions[i].Attachments, convertAttachment(result))
+	}
+	return nil
 }
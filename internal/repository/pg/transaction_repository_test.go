@@ -0,0 +1,566 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewTransactionRepository(db)
+	ctx := context.Background()
+
+	checking := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+	savings := createTestAccount(t, db, "Savings", entities.AccountTypeSavings)
+	groceries := createTestCategory(t, db, "Test Groceries", entities.CategoryTypeExpense)
+	salary := createTestCategory(t, db, "Test Salary", entities.CategoryTypeIncome)
+
+	jan10 := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	jan20 := time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)
+	feb05 := time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)
+
+	paycheck := createTestTransaction(t, db, checking, salary, 500000, jan10, entities.TransactionStatusCleared)
+	market := createTestTransaction(t, db, checking, groceries, -12550, jan20, entities.TransactionStatusPending)
+	deposit := createTestTransaction(t, db, savings, salary, 100000, feb05, entities.TransactionStatusCleared)
+
+	t.Run("create", func(t *testing.T) {
+		assert.NotEmpty(t, paycheck.ID)
+		assert.Equal(t, checking.ID, paycheck.AccountID)
+		assert.Equal(t, salary.ID, paycheck.CategoryID)
+		assert.Equal(t, int64(500000), paycheck.Monetary.Amount.Int64())
+		assert.Equal(t, "USD", paycheck.Monetary.Asset.Asset)
+		assert.True(t, jan10.Equal(paycheck.Date))
+		assert.Equal(t, entities.TransactionStatusCleared, paycheck.Status)
+	})
+
+	t.Run("create rejects unknown account", func(t *testing.T) {
+		value, _ := monetary.NewMonetary(monetary.USD, big.NewInt(100))
+		_, err := repo.CreateTransaction(ctx, entities.Transaction{
+			AccountID:  uuid.Must(uuid.NewV4()).String(),
+			CategoryID: groceries.ID,
+			Monetary:   *value,
+			Date:       jan10,
+			Status:     entities.TransactionStatusCleared,
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("create many", func(t *testing.T) {
+		value, _ := monetary.NewMonetary(monetary.USD, big.NewInt(100))
+		transaction := entities.Transaction{AccountID: savings.ID, CategoryID: groceries.ID, Monetary: *value, Date: feb05, Status: entities.TransactionStatusCleared}
+
+		created, err := repo.CreateTransactions(ctx, []entities.Transaction{transaction, transaction})
+		require.NoError(t, err)
+		require.Len(t, created, 2)
+		assert.NotEqual(t, created[0].ID, created[1].ID)
+		for _, transaction := range created {
+			require.NoError(t, repo.DeleteTransaction(ctx, transaction.ID))
+		}
+
+		// The second fails, so the first isn't kept either
+		unknown := transaction
+		unknown.AccountID = uuid.Must(uuid.NewV4()).String()
+		_, err = repo.CreateTransactions(ctx, []entities.Transaction{transaction, unknown})
+		assert.Error(t, err)
+
+		transactions, err := repo.GetTransactionsByAccount(ctx, savings.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{deposit.ID}, transactionIDs(transactions))
+	})
+
+	t.Run("get by id", func(t *testing.T) {
+		transaction, err := repo.GetTransactionByID(ctx, market.ID)
+		require.NoError(t, err)
+		assert.Equal(t, market.ID, transaction.ID)
+		assert.Equal(t, int64(-12550), transaction.Monetary.Amount.Int64())
+		assert.Equal(t, entities.TransactionStatusPending, transaction.Status)
+	})
+
+	t.Run("get by id not found", func(t *testing.T) {
+		_, err := repo.GetTransactionByID(ctx, uuid.Must(uuid.NewV4()).String())
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("get all newest first", func(t *testing.T) {
+		transactions, err := repo.GetAllTransactions(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{deposit.ID, market.ID, paycheck.ID}, transactionIDs(transactions))
+	})
+
+	t.Run("get by account", func(t *testing.T) {
+		transactions, err := repo.GetTransactionsByAccount(ctx, checking.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{market.ID, paycheck.ID}, transactionIDs(transactions))
+	})
+
+	t.Run("get by category", func(t *testing.T) {
+		transactions, err := repo.GetTransactionsByCategory(ctx, salary.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{deposit.ID, paycheck.ID}, transactionIDs(transactions))
+	})
+
+	t.Run("get by date range includes both ends", func(t *testing.T) {
+		transactions, err := repo.GetTransactionsByDateRange(ctx, jan10, jan20)
+		require.NoError(t, err)
+		assert.Equal(t, []string{market.ID, paycheck.ID}, transactionIDs(transactions))
+	})
+
+	t.Run("get by account and date range", func(t *testing.T) {
+		transactions, err := repo.GetTransactionsByAccountAndDateRange(ctx, checking.ID, jan20, feb05)
+		require.NoError(t, err)
+		assert.Equal(t, []string{market.ID}, transactionIDs(transactions))
+	})
+
+	t.Run("get with details", func(t *testing.T) {
+		transaction, err := repo.GetTransactionWithDetails(ctx, market.ID)
+		require.NoError(t, err)
+		require.NotNil(t, transaction.Account)
+		require.NotNil(t, transaction.Category)
+		assert.Equal(t, "Checking", transaction.Account.Name)
+		assert.Equal(t, "Test Groceries", transaction.Category.Name)
+		assert.Equal(t, "#FF0000", transaction.Category.Color)
+	})
+
+	t.Run("list with details", func(t *testing.T) {
+		transactions, err := repo.GetTransactionsWithDetails(ctx, entities.TransactionFilter{}, 2, 0, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{deposit.ID, market.ID}, transactionIDs(transactions))
+		require.NotNil(t, transactions[0].Account)
+		assert.Equal(t, "Savings", transactions[0].Account.Name)
+
+		transactions, err = repo.GetTransactionsWithDetails(ctx, entities.TransactionFilter{}, 2, 2, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{paycheck.ID}, transactionIDs(transactions))
+	})
+
+	t.Run("count", func(t *testing.T) {
+		count, err := repo.CountTransactions(ctx, entities.TransactionFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+
+	t.Run("list with details sorted", func(t *testing.T) {
+		transactions, err := repo.GetTransactionsWithDetails(ctx, entities.TransactionFilter{}, 10, 0, []entities.SortField{{Field: "amount"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{market.ID, deposit.ID, paycheck.ID}, transactionIDs(transactions))
+
+		transactions, err = repo.GetTransactionsWithDetails(ctx, entities.TransactionFilter{}, 10, 0, []entities.SortField{{Field: "status", Desc: true}, {Field: "date"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{market.ID, paycheck.ID, deposit.ID}, transactionIDs(transactions))
+	})
+
+	t.Run("list with details unknown sort field", func(t *testing.T) {
+		_, err := repo.GetTransactionsWithDetails(ctx, entities.TransactionFilter{}, 10, 0, []entities.SortField{{Field: "account_id"}})
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		value := &monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(-9900)}
+		updated, err := repo.UpdateTransaction(ctx, entities.Transaction{
+			ID:          market.ID,
+			AccountID:   savings.ID,
+			CategoryID:  groceries.ID,
+			Monetary:    *value,
+			Description: "Farmers market",
+			Payee:       "Green Farm",
+			Date:        jan20,
+			Status:      entities.TransactionStatusCleared,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, savings.ID, updated.AccountID)
+		assert.Equal(t, int64(-9900), updated.Monetary.Amount.Int64())
+		assert.Equal(t, "Farmers market", updated.Description)
+		assert.Equal(t, "Green Farm", updated.Payee)
+		assert.Equal(t, entities.TransactionStatusCleared, updated.Status)
+
+		stored, err := repo.GetTransactionWithDetails(ctx, market.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Green Farm", stored.Payee)
+	})
+
+	t.Run("tags", func(t *testing.T) {
+		value := &monetary.Monetary{Asset: monetary.USD, Amount: big.NewInt(-9900)}
+		transaction := entities.Transaction{
+			ID:          market.ID,
+			AccountID:   savings.ID,
+			CategoryID:  groceries.ID,
+			Monetary:    *value,
+			Description: "Farmers market",
+			Date:        jan20,
+			Status:      entities.TransactionStatusCleared,
+			Tags:        []string{"reimbursable", "vacation2024"},
+		}
+		updated, err := repo.UpdateTransaction(ctx, transaction)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"reimbursable", "vacation2024"}, updated.Tags)
+
+		filter := entities.TransactionFilter{Tag: "Vacation2024"}
+		transactions, err := repo.GetTransactionsWithDetails(ctx, filter, 10, 0, nil)
+		require.NoError(t, err)
+		require.Equal(t, []string{market.ID}, transactionIDs(transactions))
+		assert.Equal(t, []string{"reimbursable", "vacation2024"}, transactions[0].Tags)
+		count, err := repo.CountTransactions(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		// nil tags are kept, empty ones cleared
+		transaction.Tags = nil
+		updated, err = repo.UpdateTransaction(ctx, transaction)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"reimbursable", "vacation2024"}, updated.Tags)
+
+		transaction.Tags = []string{}
+		_, err = repo.UpdateTransaction(ctx, transaction)
+		require.NoError(t, err)
+		stored, err := repo.GetTransactionByID(ctx, market.ID)
+		require.NoError(t, err)
+		assert.Empty(t, stored.Tags)
+	})
+
+	t.Run("update status", func(t *testing.T) {
+		updated, err := repo.UpdateTransactionStatus(ctx, deposit.ID, entities.TransactionStatusCancelled)
+		require.NoError(t, err)
+		assert.Equal(t, entities.TransactionStatusCancelled, updated.Status)
+		assert.Equal(t, int64(100000), updated.Monetary.Amount.Int64())
+
+		_, err = repo.UpdateTransactionStatus(ctx, deposit.ID, "refunded")
+		assert.Error(t, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.DeleteTransaction(ctx, paycheck.ID))
+
+		_, err := repo.GetTransactionByID(ctx, paycheck.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		transactions, err := repo.GetTransactionsByAccount(ctx, checking.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{market.ID}, transactionIDs(transactions))
+	})
+
+	t.Run("trash", func(t *testing.T) {
+		// The two created many were deleted before
+		count, err := repo.CountDeletedTransactions(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+
+		transactions, err := repo.GetDeletedTransactions(ctx, 1, 0)
+		require.NoError(t, err)
+		require.Equal(t, []string{paycheck.ID}, transactionIDs(transactions))
+		assert.NotNil(t, transactions[0].DeletedAt)
+		assert.Equal(t, "USD", transactions[0].Monetary.Asset.Asset)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		restored, err := repo.RestoreTransaction(ctx, paycheck.ID)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+		assert.Equal(t, int64(500000), restored.Monetary.Amount.Int64())
+
+		_, err = repo.GetTransactionByID(ctx, paycheck.ID)
+		assert.NoError(t, err)
+
+		_, err = repo.RestoreTransaction(ctx, paycheck.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound, "only transactions in the trash can be restored")
+	})
+}
+
+func TestTransactionCube(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewTransactionRepository(db)
+	ctx := context.Background()
+
+	checking := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+	groceries := createTestCategory(t, db, "Test Groceries", entities.CategoryTypeExpense)
+	salary := createTestCategory(t, db, "Test Salary", entities.CategoryTypeIncome)
+
+	jan10 := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	jan20 := time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)
+	feb05 := time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)
+
+	createTestTransaction(t, db, checking, salary, 500000, jan10, entities.TransactionStatusCleared)
+	createTestTransaction(t, db, checking, groceries, -12550, jan20, entities.TransactionStatusPending)
+	createTestTransaction(t, db, checking, groceries, -7450, feb05, entities.TransactionStatusCleared)
+	// Drafts, cancelled transactions and those out of the dates are left out
+	createTestTransaction(t, db, checking, groceries, -99900, feb05, entities.TransactionStatusDraft)
+	createTestTransaction(t, db, checking, groceries, -99900, feb05, entities.TransactionStatusCancelled)
+	createTestTransaction(t, db, checking, groceries, -99900, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), entities.TransactionStatusCleared)
+
+	groups, err := repo.GetTransactionCube(ctx, entities.ReportDimensionCategory, entities.ReportDimensionMonth, jan10, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	type group struct {
+		row, col         string
+		allRows, allCols bool
+		count            int
+		sum              int64
+	}
+	got := make([]group, len(groups))
+	for i, g := range groups {
+		assert.Equal(t, "USD", g.Sum.Asset.Asset)
+		got[i] = group{g.Row, g.Col, g.AllRows, g.AllCols, g.Count, g.Sum.Amount.Int64()}
+	}
+	cells := []group{
+		{groceries.ID, "2024-01", false, false, 1, -12550},
+		{groceries.ID, "2024-02", false, false, 1, -7450},
+		{salary.ID, "2024-01", false, false, 1, 500000},
+	}
+	rowTotals := []group{
+		{groceries.ID, "", false, true, 2, -20000},
+		{salary.ID, "", false, true, 1, 500000},
+	}
+	if salary.ID < groceries.ID {
+		cells = []group{cells[2], cells[0], cells[1]}
+		rowTotals = []group{rowTotals[1], rowTotals[0]}
+	}
+	want := append(append(cells, rowTotals...),
+		group{"", "2024-01", true, false, 2, 487450},
+		group{"", "2024-02", true, false, 1, -7450},
+		group{"", "", true, true, 3, 480000},
+	)
+	assert.Equal(t, want, got)
+}
+
+func TestTransactionSpending(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewTransactionRepository(db)
+	ctx := context.Background()
+
+	checking := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+	groceries := createTestCategory(t, db, "Test Groceries", entities.CategoryTypeExpense)
+	rent := createTestCategory(t, db, "Test Rent", entities.CategoryTypeExpense)
+	salary := createTestCategory(t, db, "Test Salary", entities.CategoryTypeIncome)
+
+	jan10 := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	feb05 := time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)
+
+	createTestTransaction(t, db, checking, rent, -150000, jan10, entities.TransactionStatusCleared)
+	createTestTransaction(t, db, checking, groceries, -12550, jan10, entities.TransactionStatusPending)
+	createTestTransaction(t, db, checking, groceries, -7450, feb05, entities.TransactionStatusCleared)
+	// Income, drafts and those out of the dates are left out
+	createTestTransaction(t, db, checking, salary, 500000, jan10, entities.TransactionStatusCleared)
+	createTestTransaction(t, db, checking, groceries, -99900, feb05, entities.TransactionStatusDraft)
+	createTestTransaction(t, db, checking, groceries, -99900, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), entities.TransactionStatusCleared)
+
+	type total struct {
+		category, month string
+		count           int
+		amount          int64
+	}
+	spending := func(byCategory, byMonth bool) []total {
+		totals, err := repo.GetSpending(ctx, byCategory, byMonth, jan10, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		got := make([]total, len(totals))
+		for i, spent := range totals {
+			assert.Equal(t, "USD", spent.Total.Asset.Asset)
+			got[i] = total{spent.CategoryID, spent.Month, spent.Count, spent.Total.Amount.Int64()}
+		}
+		return got
+	}
+
+	t.Run("by category", func(t *testing.T) {
+		assert.Equal(t, []total{
+			{rent.ID, "", 1, 150000},
+			{groceries.ID, "", 2, 20000},
+		}, spending(true, false))
+	})
+
+	t.Run("by month", func(t *testing.T) {
+		assert.Equal(t, []total{
+			{"", "2024-01", 2, 162550},
+			{"", "2024-02", 1, 7450},
+		}, spending(false, true))
+	})
+
+	t.Run("by category and month", func(t *testing.T) {
+		assert.Equal(t, []total{
+			{rent.ID, "2024-01", 1, 150000},
+			{groceries.ID, "2024-01", 1, 12550},
+			{groceries.ID, "2024-02", 1, 7450},
+		}, spending(true, true))
+	})
+}
+
+func TestTransactionCashFlow(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewTransactionRepository(db)
+	ctx := context.Background()
+
+	checking := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+	savings := createTestAccount(t, db, "Savings", entities.AccountTypeSavings)
+	groceries := createTestCategory(t, db, "Test Groceries", entities.CategoryTypeExpense)
+	salary := createTestCategory(t, db, "Test Salary", entities.CategoryTypeIncome)
+
+	jan10 := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	feb05 := time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)
+
+	createTestTransaction(t, db, checking, salary, 500000, jan10, entities.TransactionStatusCleared)
+	createTestTransaction(t, db, checking, groceries, -12550, jan10, entities.TransactionStatusPending)
+	createTestTransaction(t, db, checking, groceries, -7450, feb05, entities.TransactionStatusCleared)
+	createTestTransaction(t, db, savings, salary, 1000, feb05, entities.TransactionStatusCleared)
+	// Drafts and those out of the dates are left out
+	createTestTransaction(t, db, checking, groceries, -99900, feb05, entities.TransactionStatusDraft)
+	createTestTransaction(t, db, checking, salary, 99900, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), entities.TransactionStatusCleared)
+
+	type total struct {
+		month           string
+		income, expense int64
+	}
+	cashFlow := func(accountID string) []total {
+		totals, err := repo.GetCashFlow(ctx, accountID, jan10, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		got := make([]total, len(totals))
+		for i, flow := range totals {
+			assert.Equal(t, "USD", flow.Income.Asset.Asset)
+			got[i] = total{flow.Month, flow.Income.Amount.Int64(), flow.Expense.Amount.Int64()}
+		}
+		return got
+	}
+
+	t.Run("of the book", func(t *testing.T) {
+		assert.Equal(t, []total{
+			{"2024-01", 500000, 12550},
+			{"2024-02", 1000, 7450},
+		}, cashFlow(""))
+	})
+
+	t.Run("of an account", func(t *testing.T) {
+		assert.Equal(t, []total{{"2024-02", 1000, 0}}, cashFlow(savings.ID))
+	})
+}
+
+func TestTransactionSearch(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewTransactionRepository(db)
+	ctx := context.Background()
+
+	account := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+	groceries := createTestCategory(t, db, "Test Groceries", entities.CategoryTypeExpense)
+	date := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	create := func(description, payee string) entities.Transaction {
+		transaction := createTestTransaction(t, db, account, groceries, -4590, date, entities.TransactionStatusCleared)
+		transaction.Description = description
+		transaction.Payee = payee
+		transaction, err := repo.UpdateTransaction(ctx, transaction)
+		require.NoError(t, err)
+		return transaction
+	}
+	byPayee := create("Weekly groceries", "Supermarket Central")
+	byDescription := create("Supermarket run", "")
+	create("Pharmacy", "Drugstore")
+
+	t.Run("description matches rank first", func(t *testing.T) {
+		found, err := repo.SearchTransactions(ctx, "super", 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{byDescription.ID, byPayee.ID}, transactionIDs(found))
+
+		total, err := repo.CountSearchTransactions(ctx, "super")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		found, err := repo.SearchTransactions(ctx, "super", 1, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{byPayee.ID}, transactionIDs(found))
+	})
+
+	t.Run("every word must match", func(t *testing.T) {
+		found, err := repo.SearchTransactions(ctx, "weekly super!", 10, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{byPayee.ID}, transactionIDs(found))
+	})
+
+	t.Run("nothing to search", func(t *testing.T) {
+		found, err := repo.SearchTransactions(ctx, "?!", 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, found)
+	})
+}
+
+func TestTransactionBulk(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewTransactionRepository(db)
+	ctx := context.Background()
+
+	account := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+	groceries := createTestCategory(t, db, "Test Groceries", entities.CategoryTypeExpense)
+	date := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	pending := createTestTransaction(t, db, account, groceries, -4590, date, entities.TransactionStatusPending)
+	old := createTestTransaction(t, db, account, groceries, -1200, date, entities.TransactionStatusCleared)
+	create := entities.Transaction{
+		AccountID:   account.ID,
+		CategoryID:  groceries.ID,
+		Monetary:    monetary.Monetary{Asset: account.Asset, Amount: big.NewInt(-3000)},
+		Description: "Market",
+		Date:        date,
+		Status:      entities.TransactionStatusCleared,
+	}
+
+	t.Run("a failing operation applies none", func(t *testing.T) {
+		_, err := repo.ApplyBulkOperations(ctx, []entities.BulkOperation{
+			{Action: entities.BulkActionCreate, Transaction: create},
+			{Action: entities.BulkActionDelete, ID: old.ID},
+			{Action: entities.BulkActionUpdateStatus, ID: "00000000-0000-0000-0000-000000000000", Status: entities.TransactionStatusCleared},
+		})
+		var bulk *domain.BulkError
+		require.ErrorAs(t, err, &bulk)
+		assert.Equal(t, 2, bulk.Failures[0].Index)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+		assert.ErrorIs(t, bulk.Failures[0].Err, domain.ErrNotFound)
+
+		_, err = repo.GetTransactionByID(ctx, old.ID)
+		require.NoError(t, err)
+		found, err := repo.SearchTransactions(ctx, "market", 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, found)
+	})
+
+	t.Run("applies the operations", func(t *testing.T) {
+		applied, err := repo.ApplyBulkOperations(ctx, []entities.BulkOperation{
+			{Action: entities.BulkActionCreate, Transaction: create},
+			{Action: entities.BulkActionUpdateStatus, ID: pending.ID, Status: entities.TransactionStatusCleared},
+			{Action: entities.BulkActionDelete, ID: old.ID},
+		})
+		require.NoError(t, err)
+		require.Len(t, applied, 3)
+
+		assert.NotEmpty(t, applied[0].ID)
+		assert.Equal(t, "Market", applied[0].Description)
+		assert.Equal(t, entities.TransactionStatusCleared, applied[1].Status)
+		assert.Equal(t, int64(-4590), applied[1].Monetary.Amount.Int64())
+		assert.Equal(t, old.ID, applied[2].ID)
+
+		_, err = repo.GetTransactionByID(ctx, old.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
+
+func TestSearchQuery(t *testing.T) {
+	for query, want := range map[string]string{
+		"Super-Mercado  pão!": "super:* & mercado:* & pão:*",
+		"uber":                "uber:*",
+		"'); drop":            "drop:*",
+		"  ":                  "",
+	} {
+		assert.Equal(t, want, searchQuery(query), query)
+	}
+}
+
+func transactionIDs(transactions []entities.Transaction) []string {
+	ids := make([]string, len(transactions))
+	for i, transaction := range transactions {
+		ids[i] = transaction.ID
+	}
+	return ids
+}
@@ -0,0 +1,125 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TransactionScriptRepository struct {
+	queries *gen.Queries
+}
+
+func NewTransactionScriptRepository(db *pgxpool.Pool) *TransactionScriptRepository {
+	return &TransactionScriptRepository{
+		queries: gen.New(db),
+	}
+}
+
+func (r *TransactionScriptRepository) CreateTransactionScript(ctx context.Context, script entities.TransactionScript) (entities.TransactionScript, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return entities.TransactionScript{}, err
+	}
+
+	result, err := r.queries.CreateTransactionScript(ctx, bookID, script.Name, script.Source, script.Enabled)
+	if err != nil {
+		return entities.TransactionScript{}, duplicateTransactionScript(err, script.Name)
+	}
+
+	return convertTransactionScript(result), nil
+}
+
+func (r *TransactionScriptRepository) GetTransactionScriptByID(ctx context.Context, id string) (entities.TransactionScript, error) {
+	scriptID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.TransactionScript{}, err
+	}
+
+	result, err := r.queries.GetTransactionScriptByID(ctx, scriptID)
+	if err != nil {
+		return entities.TransactionScript{}, notFound(err, "script")
+	}
+	if err := inBook(ctx, result.BookID, "script"); err != nil {
+		return entities.TransactionScript{}, err
+	}
+
+	return convertTransactionScript(result), nil
+}
+
+// GetAllTransactionScripts returns the scripts of the book ordered by name
+func (r *TransactionScriptRepository) GetAllTransactionScripts(ctx context.Context) ([]entities.TransactionScript, error) {
+	bookID, err := contextBook(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllTransactionScripts(ctx, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	scripts := make([]entities.TransactionScript, len(results))
+	for i, result := range results {
+		scripts[i] = convertTransactionScript(result)
+	}
+
+	return scripts, nil
+}
+
+func (r *TransactionScriptRepository) UpdateTransactionScript(ctx context.Context, script entities.TransactionScript) (entities.TransactionScript, error) {
+	scriptID, err := uuid.FromString(script.ID)
+	if err != nil {
+		return entities.TransactionScript{}, err
+	}
+	if _, err := r.GetTransactionScriptByID(ctx, script.ID); err != nil {
+		return entities.TransactionScript{}, err
+	}
+
+	result, err := r.queries.UpdateTransactionScript(ctx, scriptID, script.Name, script.Source, script.Enabled)
+	if err != nil {
+		return entities.TransactionScript{}, notFound(duplicateTransactionScript(err, script.Name), "script")
+	}
+
+	return convertTransactionScript(result), nil
+}
+
+func (r *TransactionScriptRepository) DeleteTransactionScript(ctx context.Context, id string) error {
+	scriptID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+	if _, err := r.GetTransactionScriptByID(ctx, id); err != nil {
+		return err
+	}
+
+	return r.queries.DeleteTransactionScript(ctx, scriptID)
+}
+
+// duplicateTransactionScript translates a script name already taken in the
+// book into domain.ErrConflict
+func duplicateTransactionScript(err error, name string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("script %q already exists: %w", name, domain.ErrConflict)
+	}
+	return err
+}
+
+func convertTransactionScript(result gen.TransactionScript) entities.TransactionScript {
+	return entities.TransactionScript{
+		ID:        result.ID.String(),
+		Name:      result.Name,
+		Source:    result.Source,
+		Enabled:   result.Enabled,
+		CreatedAt: result.CreatedAt,
+		UpdatedAt: result.UpdatedAt,
+	}
+}
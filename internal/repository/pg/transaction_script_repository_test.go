@@ -0,0 +1,74 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionScriptRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewTransactionScriptRepository(db)
+	books := NewBookRepository(db)
+	ctx := context.Background()
+
+	var created entities.TransactionScript
+	t.Run("create and get", func(t *testing.T) {
+		var err error
+		created, err = repo.CreateTransactionScript(ctx, entities.TransactionScript{
+			Name:    "uber",
+			Source:  "def process(tx):\n    pass\n",
+			Enabled: true,
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+
+		got, err := repo.GetTransactionScriptByID(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created, got)
+
+		_, err = repo.CreateTransactionScript(ctx, entities.TransactionScript{Name: "uber", Source: "def process(tx):\n    pass\n"})
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("update lists by name", func(t *testing.T) {
+		_, err := repo.CreateTransactionScript(ctx, entities.TransactionScript{Name: "amazon", Source: "def process(tx):\n    pass\n"})
+		require.NoError(t, err)
+
+		created.Enabled = false
+		updated, err := repo.UpdateTransactionScript(ctx, created)
+		require.NoError(t, err)
+		assert.False(t, updated.Enabled)
+
+		all, err := repo.GetAllTransactionScripts(ctx)
+		require.NoError(t, err)
+		require.Len(t, all, 2)
+		assert.Equal(t, "amazon", all[0].Name)
+		assert.Equal(t, "uber", all[1].Name)
+	})
+
+	t.Run("scripts are kept per book", func(t *testing.T) {
+		side, err := books.CreateBook(ctx, entities.Book{Name: "Side business", Asset: monetary.USD})
+		require.NoError(t, err)
+		sideCtx := domain.WithBook(ctx, side.ID)
+
+		_, err = repo.GetTransactionScriptByID(sideCtx, created.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.ErrorIs(t, repo.DeleteTransactionScript(sideCtx, created.ID), domain.ErrNotFound)
+
+		all, err := repo.GetAllTransactionScripts(sideCtx)
+		require.NoError(t, err)
+		assert.Empty(t, all)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.DeleteTransactionScript(ctx, created.ID))
+		_, err := repo.GetTransactionScriptByID(ctx, created.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
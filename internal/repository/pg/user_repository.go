@@ -0,0 +1,125 @@
+package pg
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UserRepository struct {
+	queries *gen.Queries
+}
+
+func NewUserRepository(db *pgxpool.Pool) *UserRepository {
+	return &UserRepository{
+		queries: gen.New(db),
+	}
+}
+
+// CreateUser stores a user, domain.ErrConflict is returned when the email is
+// already registered
+func (r *UserRepository) CreateUser(ctx context.Context, user entities.User) (entities.User, error) {
+	email := strings.ToLower(user.Email)
+	result, err := r.queries.CreateUser(ctx, email, user.Name, user.PasswordHash)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return entities.User{}, fmt.Errorf("email %q is already registered: %w", email, domain.ErrConflict)
+	} else if err != nil {
+		return entities.User{}, err
+	}
+
+	return convertUser(result), nil
+}
+
+func (r *UserRepository) GetUserByID(ctx context.Context, id string) (entities.User, error) {
+	userID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.User{}, err
+	}
+
+	result, err := r.queries.GetUserByID(ctx, userID)
+	if err != nil {
+		return entities.User{}, notFound(err, "user")
+	}
+
+	return convertUser(result), nil
+}
+
+func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (entities.User, error) {
+	result, err := r.queries.GetUserByEmail(ctx, strings.ToLower(email))
+	if err != nil {
+		return entities.User{}, notFound(err, "user")
+	}
+
+	return convertUser(result), nil
+}
+
+// GetUserUsage counts what the user created, the transactions and API calls
+// since the given time
+func (r *UserRepository) GetUserUsage(ctx context.Context, id string, since time.Time) (entities.UserUsage, error) {
+	userID, err := uuid.FromString(id)
+	if err != nil {
+		return entities.UserUsage{}, err
+	}
+
+	result, err := r.queries.GetUserUsage(ctx, userID, since)
+	if err != nil {
+		return entities.UserUsage{}, notFound(err, "user")
+	}
+
+	return entities.UserUsage{
+		Tier:                entities.UserTier(result.Tier),
+		Books:               int(result.Books),
+		Accounts:            int(result.Accounts),
+		Categories:          int(result.Categories),
+		Budgets:             int(result.Budgets),
+		Transactions:        int(result.Transactions),
+		MonthlyTransactions: int(result.MonthlyTransactions),
+		MonthlyAPICalls:     int(result.MonthlyApiCalls),
+		AttachmentBytes:     result.AttachmentBytes,
+	}, nil
+}
+
+// SetUserTier moves the user with the email to another tier
+func (r *UserRepository) SetUserTier(ctx context.Context, email string, tier entities.UserTier) error {
+	updated, err := r.queries.SetUserTier(ctx, strings.ToLower(email), string(tier))
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return fmt.Errorf("user %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// AddAPICalls adds calls to the API calls the user made in the month
+func (r *UserRepository) AddAPICalls(ctx context.Context, id string, month time.Time, calls int) error {
+	userID, err := uuid.FromString(id)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.AddAPICalls(ctx, userID, pgtype.Date{Time: month, Valid: true}, int64(calls))
+}
+
+func convertUser(result gen.User) entities.User {
+	return entities.User{
+		ID:           result.ID.String(),
+		Email:        result.Email,
+		Name:         result.Name,
+		PasswordHash: result.PasswordHash,
+		Tier:         entities.UserTier(result.Tier),
+		CreatedAt:    result.CreatedAt,
+		UpdatedAt:    result.UpdatedAt,
+	}
+}
@@ -0,0 +1,211 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewUserRepository(db)
+	books := NewBookRepository(db)
+	ctx := context.Background()
+
+	var alice entities.User
+	t.Run("create and get", func(t *testing.T) {
+		var err error
+		alice, err = repo.CreateUser(ctx, entities.User{Email: "Alice@Example.com", Name: "Alice", PasswordHash: "hash"})
+		require.NoError(t, err)
+		assert.NotEmpty(t, alice.ID)
+		assert.Equal(t, "alice@example.com", alice.Email)
+
+		got, err := repo.GetUserByEmail(ctx, "ALICE@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, alice.ID, got.ID)
+		assert.Equal(t, "hash", got.PasswordHash)
+
+		got, err = repo.GetUserByID(ctx, alice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Alice", got.Name)
+	})
+
+	t.Run("create rejects duplicate email", func(t *testing.T) {
+		_, err := repo.CreateUser(ctx, entities.User{Email: "alice@example.com", PasswordHash: "hash"})
+		assert.ErrorIs(t, err, domain.ErrConflict)
+	})
+
+	t.Run("missing user", func(t *testing.T) {
+		_, err := repo.GetUserByEmail(ctx, "bob@example.com")
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+
+	t.Run("books are kept per user", func(t *testing.T) {
+		userSettings := NewUserSettingsRepository(db)
+		_, err := userSettings.UpsertUserSetting(ctx, entities.UserSetting{Key: entities.UserSettingLocale, Value: "pt-BR"})
+		require.NoError(t, err)
+
+		claimed, err := books.ClaimBooks(ctx, alice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), claimed)
+
+		// The settings go along with the books
+		settings, err := userSettings.GetAllUserSettings(domain.WithUser(ctx, alice.ID))
+		require.NoError(t, err)
+		require.Len(t, settings, 1)
+		assert.Equal(t, "pt-BR", settings[0].Value)
+
+		bob, err := repo.CreateUser(ctx, entities.User{Email: "bob@example.com", PasswordHash: "hash"})
+		require.NoError(t, err)
+		claimed, err = books.ClaimBooks(ctx, bob.ID)
+		require.NoError(t, err)
+		assert.Zero(t, claimed)
+
+		aliceCtx := domain.WithUser(ctx, alice.ID)
+		bobCtx := domain.WithUser(ctx, bob.ID)
+		bobBook, err := books.CreateBook(bobCtx, entities.Book{Name: "Personal", Asset: monetary.USD})
+		require.NoError(t, err)
+
+		first, err := books.GetFirstBook(aliceCtx)
+		require.NoError(t, err)
+		assert.Equal(t, domain.DefaultBookID, first.ID)
+		first, err = books.GetFirstBook(bobCtx)
+		require.NoError(t, err)
+		assert.Equal(t, bobBook.ID, first.ID)
+
+		listed, err := books.GetAllBooks(bobCtx)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		assert.Equal(t, bobBook.ID, listed[0].ID)
+
+		_, err = books.GetBookByID(bobCtx, domain.DefaultBookID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = books.UpdateBook(aliceCtx, entities.Book{ID: bobBook.ID, Name: "Taken", Asset: monetary.USD})
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		assert.ErrorIs(t, books.DeleteBook(aliceCtx, bobBook.ID), domain.ErrNotFound)
+	})
+}
+
+func TestOwnerScoping(t *testing.T) {
+	db := newTestDB(t)
+	users := NewUserRepository(db)
+	books := NewBookRepository(db)
+	accounts := NewAccountRepository(db)
+	categories := NewCategoryRepository(db)
+	transactions := NewTransactionRepository(db)
+	ctx := context.Background()
+
+	// Recorded before users existed
+	checking := createTestAccount(t, db, "Checking", entities.AccountTypeChecking)
+	groceries := createTestCategory(t, db, "Groceries", entities.CategoryTypeExpense)
+	market := createTestTransaction(t, db, checking, groceries, -1500, time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC), entities.TransactionStatusCleared)
+	assert.Empty(t, checking.OwnerID)
+
+	alice, err := users.CreateUser(ctx, entities.User{Email: "alice@example.com", PasswordHash: "hash"})
+	require.NoError(t, err)
+	bob, err := users.CreateUser(ctx, entities.User{Email: "bob@example.com", PasswordHash: "hash"})
+	require.NoError(t, err)
+	_, err = books.ClaimBooks(ctx, alice.ID)
+	require.NoError(t, err)
+
+	aliceCtx := domain.WithUser(ctx, alice.ID)
+	bobCtx := domain.WithUser(ctx, bob.ID)
+
+	t.Run("claimed rows follow their book", func(t *testing.T) {
+		account, err := accounts.GetAccountByID(aliceCtx, checking.ID)
+		require.NoError(t, err)
+		assert.Equal(t, alice.ID, account.OwnerID)
+
+		category, err := categories.GetCategoryByID(aliceCtx, groceries.ID)
+		require.NoError(t, err)
+		assert.Equal(t, alice.ID, category.OwnerID)
+
+		transaction, err := transactions.GetTransactionByID(aliceCtx, market.ID)
+		require.NoError(t, err)
+		assert.Equal(t, alice.ID, transaction.OwnerID)
+	})
+
+	t.Run("new rows take the owner of their book", func(t *testing.T) {
+		bobBook, err := books.CreateBook(bobCtx, entities.Book{Name: "Personal", Asset: monetary.USD})
+		require.NoError(t, err)
+
+		savings, err := accounts.CreateAccount(domain.WithBook(bobCtx, bobBook.ID), entities.Account{Name: "Savings", Type: entities.AccountTypeSavings, Asset: monetary.USD})
+		require.NoError(t, err)
+		assert.Equal(t, bob.ID, savings.OwnerID)
+	})
+
+	t.Run("rows of another user are hidden", func(t *testing.T) {
+		_, err := accounts.GetAccountByID(bobCtx, checking.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = accounts.GetAccountWithBalance(bobCtx, checking.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = categories.GetCategoryByID(bobCtx, groceries.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = transactions.GetTransactionByID(bobCtx, market.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+		_, err = transactions.GetTransactionWithDetails(bobCtx, market.ID)
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+
+		listedAccounts, err := accounts.GetAllAccounts(bobCtx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, listedAccounts)
+		count, err := categories.CountCategories(bobCtx)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+		listedTransactions, err := transactions.GetTransactionsByAccount(bobCtx, checking.ID)
+		require.NoError(t, err)
+		assert.Empty(t, listedTransactions)
+		count, err = transactions.CountTransactions(bobCtx, entities.TransactionFilter{})
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("usage and tiers", func(t *testing.T) {
+		usage, err := users.GetUserUsage(ctx, alice.ID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, entities.UserTierFree, usage.Tier)
+		assert.Equal(t, 1, usage.Books)
+		assert.Equal(t, 1, usage.Accounts)
+		assert.Equal(t, 1, usage.Transactions)
+		assert.Equal(t, 1, usage.MonthlyTransactions)
+		assert.Positive(t, usage.Categories)
+		usage, err = users.GetUserUsage(ctx, alice.ID, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Zero(t, usage.MonthlyTransactions)
+		assert.Equal(t, 1, usage.Transactions)
+
+		// Attachments add up their size
+		_, err = NewAttachmentRepository(db).CreateAttachment(aliceCtx, entities.Attachment{
+			TransactionID: market.ID,
+			FileName:      "market.jpg",
+			ContentType:   "image/jpeg",
+			Size:          2048,
+			StorageKey:    market.ID + "/receipt",
+		})
+		require.NoError(t, err)
+		usage, err = users.GetUserUsage(ctx, alice.ID, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, int64(2048), usage.AttachmentBytes)
+
+		// API calls add up per month
+		march := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+		require.NoError(t, users.AddAPICalls(ctx, alice.ID, march, 40))
+		require.NoError(t, users.AddAPICalls(ctx, alice.ID, march, 2))
+		require.NoError(t, users.AddAPICalls(ctx, alice.ID, march.AddDate(0, -1, 0), 7))
+		usage, err = users.GetUserUsage(ctx, alice.ID, march)
+		require.NoError(t, err)
+		assert.Equal(t, 42, usage.MonthlyAPICalls)
+
+		require.NoError(t, users.SetUserTier(ctx, "ALICE@example.com", entities.UserTierUnlimited))
+		got, err := users.GetUserByID(ctx, alice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, entities.UserTierUnlimited, got.Tier)
+		assert.ErrorIs(t, users.SetUserTier(ctx, "carol@example.com", entities.UserTierFree), domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,73 @@
+package pg
+
+import (
+	"context"
+	"finance/domain/entities"
+	"finance/internal/repository/pg/gen"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UserSettingsRepository struct {
+	queries *gen.Queries
+	db      *pgxpool.Pool
+}
+
+func NewUserSettingsRepository(db *pgxpool.Pool) *UserSettingsRepository {
+	return &UserSettingsRepository{
+		queries: gen.New(db),
+		db:      db,
+	}
+}
+
+// GetAllUserSettings returns the settings of the user of the context, those
+// under no user without authentication
+func (r *UserSettingsRepository) GetAllUserSettings(ctx context.Context) ([]entities.UserSetting, error) {
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := r.queries.GetAllUserSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make([]entities.UserSetting, len(results))
+	for i, result := range results {
+		settings[i] = r.convertUserSetting(result)
+	}
+
+	return settings, nil
+}
+
+func (r *UserSettingsRepository) UpsertUserSetting(ctx context.Context, setting entities.UserSetting) (entities.UserSetting, error) {
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return entities.UserSetting{}, err
+	}
+
+	result, err := r.queries.UpsertUserSetting(ctx, userID, string(setting.Key), setting.Value)
+	if err != nil {
+		return entities.UserSetting{}, err
+	}
+
+	return r.convertUserSetting(result), nil
+}
+
+func (r *UserSettingsRepository) DeleteUserSetting(ctx context.Context, key entities.UserSettingKey) error {
+	userID, err := contextUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.DeleteUserSetting(ctx, userID, string(key))
+}
+
+func (r *UserSettingsRepository) convertUserSetting(result gen.UserSetting) entities.UserSetting {
+	return entities.UserSetting{
+		Key:       entities.UserSettingKey(result.Key),
+		Value:     result.Value,
+		UpdatedAt: result.UpdatedAt,
+	}
+}
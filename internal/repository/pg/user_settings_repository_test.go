@@ -0,0 +1,91 @@
+package pg
+
+import (
+	"context"
+	"finance/domain"
+	"finance/domain/entities"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserSettingsRepository(t *testing.T) {
+	db := newTestDB(t)
+	repo := NewUserSettingsRepository(db)
+	ctx := context.Background()
+
+	t.Run("empty", func(t *testing.T) {
+		settings, err := repo.GetAllUserSettings(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, settings)
+	})
+
+	t.Run("upsert", func(t *testing.T) {
+		setting, err := repo.UpsertUserSetting(ctx, entities.UserSetting{Key: entities.UserSettingTimezone, Value: "UTC"})
+		require.NoError(t, err)
+		assert.Equal(t, "UTC", setting.Value)
+
+		setting, err = repo.UpsertUserSetting(ctx, entities.UserSetting{Key: entities.UserSettingTimezone, Value: "America/Sao_Paulo"})
+		require.NoError(t, err)
+		assert.Equal(t, "America/Sao_Paulo", setting.Value)
+
+		_, err = repo.UpsertUserSetting(ctx, entities.UserSetting{Key: entities.UserSettingLocale, Value: "en-US"})
+		require.NoError(t, err)
+
+		settings, err := repo.GetAllUserSettings(ctx)
+		require.NoError(t, err)
+		require.Len(t, settings, 2)
+		assert.Equal(t, entities.UserSettingLocale, settings[0].Key)
+		assert.Equal(t, entities.UserSettingTimezone, settings[1].Key)
+		assert.Equal(t, "America/Sao_Paulo", settings[1].Value)
+	})
+
+	t.Run("upsert rejects invalid key", func(t *testing.T) {
+		_, err := repo.UpsertUserSetting(ctx, entities.UserSetting{Key: "Bad Key", Value: "x"})
+		assert.Error(t, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.DeleteUserSetting(ctx, entities.UserSettingLocale))
+
+		settings, err := repo.GetAllUserSettings(ctx)
+		require.NoError(t, err)
+		require.Len(t, settings, 1)
+		assert.Equal(t, entities.UserSettingTimezone, settings[0].Key)
+	})
+	t.Run("settings are kept per user", func(t *testing.T) {
+		users := NewUserRepository(db)
+		alice, err := users.CreateUser(ctx, entities.User{Email: "alice@example.com", PasswordHash: "hash"})
+		require.NoError(t, err)
+		bob, err := users.CreateUser(ctx, entities.User{Email: "bob@example.com", PasswordHash: "hash"})
+		require.NoError(t, err)
+		aliceCtx := domain.WithUser(ctx, alice.ID)
+		bobCtx := domain.WithUser(ctx, bob.ID)
+
+		settings, err := repo.GetAllUserSettings(aliceCtx)
+		require.NoError(t, err)
+		assert.Empty(t, settings)
+
+		_, err = repo.UpsertUserSetting(aliceCtx, entities.UserSetting{Key: entities.UserSettingTimezone, Value: "Europe/London"})
+		require.NoError(t, err)
+		_, err = repo.UpsertUserSetting(bobCtx, entities.UserSetting{Key: entities.UserSettingTimezone, Value: "Asia/Tokyo"})
+		require.NoError(t, err)
+		require.NoError(t, repo.DeleteUserSetting(bobCtx, entities.UserSettingTimezone))
+
+		settings, err = repo.GetAllUserSettings(aliceCtx)
+		require.NoError(t, err)
+		require.Len(t, settings, 1)
+		assert.Equal(t, "Europe/London", settings[0].Value)
+
+		settings, err = repo.GetAllUserSettings(bobCtx)
+		require.NoError(t, err)
+		assert.Empty(t, settings)
+
+		// The ones under no user are left alone
+		settings, err = repo.GetAllUserSettings(ctx)
+		require.NoError(t, err)
+		require.Len(t, settings, 1)
+		assert.Equal(t, "America/Sao_Paulo", settings[0].Value)
+	})
+}
@@ -0,0 +1,337 @@
+// Package s3 keeps files in a bucket of Amazon S3 or of a service speaking its
+// API, like MinIO or Cloudflare R2, signing the requests with AWS Signature
+// Version 4
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"finance/domain"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	amzDateLayout    = "20060102T150405Z"
+	// unsignedPayload stands for the body in the presigned URLs, which sign
+	// no content
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	// MaxURLTTL is the longest S3 accepts a presigned URL for
+	MaxURLTTL = 7 * 24 * time.Hour
+	// maxErrorBody is how much of an error response is kept in the error
+	maxErrorBody = 1 << 10
+)
+
+// Config is where the bucket is and the credentials to reach it. Endpoint is
+// the base URL of the service, like https://s3.us-east-1.amazonaws.com, the
+// bucket going in the path.
+type Config struct {
+	Endpoint        string
+	Bucket          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// AttachmentStore keeps the files attached to the transactions as objects of
+// a bucket, named after their keys
+type AttachmentStore struct {
+	cfg    Config
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewAttachmentStore(cfg Config) *AttachmentStore {
+	cfg.Endpoint = strings.TrimSuffix(cfg.Endpoint, "/")
+	return &AttachmentStore{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 30 * time.Second},
+		now:    time.Now,
+	}
+}
+
+func (s *AttachmentStore) SaveFile(ctx context.Context, key, contentType string, content []byte) error {
+	req, err := s.request(ctx, http.MethodPut, key, content)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := s.do(req, content)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// OpenFile returns the object of key, domain.ErrNotFound when the bucket
+// doesn't have it
+func (s *AttachmentStore) OpenFile(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s.request(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// DeleteFile removes the object of key, which S3 accepts for objects already
+// gone too
+func (s *AttachmentStore) DeleteFile(ctx context.Context, key string) error {
+	req, err := s.request(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// object is an entry of a bucket listing
+type object struct {
+	Key          string    `xml:"Key"`
+	Size         int64     `xml:"Size"`
+	LastModified time.Time `xml:"LastModified"`
+}
+
+// listObjects returns the objects whose keys start with prefix, following
+// the continuation tokens of ListObjectsV2 through every page
+func (s *AttachmentStore) listObjects(ctx context.Context, prefix string) ([]object, error) {
+	objects := []object{}
+	query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.cfg.Endpoint+"/"+uriEncode(s.cfg.Bucket, false)+"?"+query.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := s.do(req, nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Contents              []object `xml:"Contents"`
+			IsTruncated           bool     `xml:"IsTruncated"`
+			NextContinuationToken string   `xml:"NextContinuationToken"`
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("s3 listing of %s: %w", prefix, err)
+		}
+
+		objects = append(objects, page.Contents...)
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			return objects, nil
+		}
+		query.Set("continuation-token", page.NextContinuationToken)
+	}
+}
+
+// SignURL presigns a GET of the object of key, so anyone holding the URL
+// downloads it straight from the bucket, as fileName, until ttl passes
+func (s *AttachmentStore) SignURL(key, fileName string, ttl time.Duration) (string, error) {
+	if ttl < time.Second || ttl > MaxURLTTL {
+		return "", fmt.Errorf("%w: signed URLs last between a second and %s, not %s", domain.ErrMalformedParameters, MaxURLTTL, ttl)
+	}
+	objectURL, err := s.objectURL(key)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(objectURL)
+	if err != nil {
+		return "", err
+	}
+	if fileName != "" {
+		u.RawQuery = url.Values{
+			"response-content-disposition": {mime.FormatMediaType("attachment", map[string]string{"filename": fileName})},
+		}.Encode()
+	}
+	presign(u, s.cfg, s.now(), ttl)
+	return u.String(), nil
+}
+
+func (s *AttachmentStore) request(ctx context.Context, method, key string, content []byte) (*http.Request, error) {
+	objectURL, err := s.objectURL(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var body io.Reader
+	if content != nil {
+		body = bytes.NewReader(content)
+	}
+	return http.NewRequestWithContext(ctx, method, objectURL, body)
+}
+
+func (s *AttachmentStore) objectURL(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return "", fmt.Errorf("%w: invalid attachment key %s", domain.ErrMalformedParameters, key)
+	}
+	return s.cfg.Endpoint + "/" + uriEncode(s.cfg.Bucket, false) + "/" + uriEncode(key, false), nil
+}
+
+// do signs and sends a request, turning the error responses into errors
+func (s *AttachmentStore) do(req *http.Request, content []byte) (*http.Response, error) {
+	sign(req, hashHex(content), s.cfg, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("attachment file %w", domain.ErrNotFound)
+	}
+	message, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	return nil, fmt.Errorf("s3 %s %s returned %s: %s", req.Method, req.URL.Path, resp.Status, bytes.TrimSpace(message))
+}
+
+// sign adds the AWS Signature Version 4 of a request to its Authorization
+// header, signing the host and every header it has
+func sign(req *http.Request, payloadHash string, cfg Config, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(amzDateLayout)
+	scope := credentialScope(cfg, now)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, cfg.AccessKeyID, scope, signedHeaders, signature(cfg, now, canonicalRequest)))
+}
+
+// presign adds the AWS Signature Version 4 of a GET of u to its query, the
+// signature covering only the host so any client can send it
+func presign(u *url.URL, cfg Config, now time.Time, ttl time.Duration) {
+	now = now.UTC()
+
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", signingAlgorithm)
+	query.Set("X-Amz-Credential", cfg.AccessKeyID+"/"+credentialScope(cfg, now))
+	query.Set("X-Amz-Date", now.Format(amzDateLayout))
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(ttl/time.Second), 10))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		canonicalQuery(query),
+		"host:" + u.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+
+	u.RawQuery = canonicalQuery(query) + "&X-Amz-Signature=" + signature(cfg, now, canonicalRequest)
+}
+
+func credentialScope(cfg Config, now time.Time) string {
+	return strings.Join([]string{now.Format("20060102"), cfg.Region, "s3", "aws4_request"}, "/")
+}
+
+// signature signs a canonical request with the key derived from the secret
+// for the day, region and service
+func signature(cfg Config, now time.Time, canonicalRequest string) string {
+	stringToSign := strings.Join([]string{signingAlgorithm, now.Format(amzDateLayout), credentialScope(cfg, now), hashHex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+cfg.SecretAccessKey), now.Format("20060102"))
+	for _, part := range []string{cfg.Region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// canonicalQuery is the query string sorted by name, encoded the way the
+// signature expects
+func canonicalQuery(query url.Values) string {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var params []string
+	for _, name := range names {
+		values := slices.Clone(query[name])
+		slices.Sort(values)
+		for _, value := range values {
+			params = append(params, uriEncode(name, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// uriEncode percent-encodes everything but the unreserved characters and,
+// in paths, the slashes separating the parts of the keys
+func uriEncode(value string, encodeSlash bool) string {
+	var encoded strings.Builder
+	for _, b := range []byte(value) {
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9', b == '-', b == '.', b == '_', b == '~', b == '/' && !encodeSlash:
+			encoded.WriteByte(b)
+		default:
+			fmt.Fprintf(&encoded, "%%%02X", b)
+		}
+	}
+	return encoded.String()
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
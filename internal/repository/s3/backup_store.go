@@ -0,0 +1,123 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"finance/internal/repository/files"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// backupPrefix keeps the backups apart from the attachments sharing the
+// bucket
+const backupPrefix = "backups/"
+
+// BackupStore keeps the backups as objects under backups/ in the bucket, in
+// the JSON documents and names of the files backup store
+type BackupStore struct {
+	objects *AttachmentStore
+}
+
+func NewBackupStore(cfg Config) *BackupStore {
+	return &BackupStore{
+		objects: NewAttachmentStore(cfg),
+	}
+}
+
+// SaveBackup uploads the backup, which S3 only shows once it's whole
+func (s *BackupStore) SaveBackup(ctx context.Context, backup entities.Backup) (entities.BackupFile, error) {
+	data, err := files.EncodeBackup(backup)
+	if err != nil {
+		return entities.BackupFile{}, err
+	}
+
+	name := files.BackupName(backup.CreatedAt)
+	if err := s.objects.SaveFile(ctx, backupPrefix+name, "application/json", data); err != nil {
+		return entities.BackupFile{}, err
+	}
+
+	return entities.BackupFile{
+		Name:      name,
+		CreatedAt: backup.CreatedAt.UTC().Truncate(time.Second),
+		Size:      int64(len(data)),
+	}, nil
+}
+
+// ListBackups returns the backups in the bucket, skipping other objects
+// under the prefix
+func (s *BackupStore) ListBackups(ctx context.Context) ([]entities.BackupFile, error) {
+	objects, err := s.objects.listObjects(ctx, backupPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	backups := []entities.BackupFile{}
+	for _, object := range objects {
+		name := strings.TrimPrefix(object.Key, backupPrefix)
+		created, ok := files.ParseBackupName(name)
+		if !ok {
+			continue
+		}
+		backups = append(backups, entities.BackupFile{
+			Name:      name,
+			CreatedAt: created,
+			Size:      object.Size,
+		})
+	}
+	return backups, nil
+}
+
+// LoadBackup downloads a backup by name
+func (s *BackupStore) LoadBackup(ctx context.Context, name string) (entities.Backup, error) {
+	key, err := backupKey(name)
+	if err != nil {
+		return entities.Backup{}, err
+	}
+
+	file, err := s.objects.OpenFile(ctx, key)
+	if errors.Is(err, domain.ErrNotFound) {
+		return entities.Backup{}, fmt.Errorf("backup %w", domain.ErrNotFound)
+	}
+	if err != nil {
+		return entities.Backup{}, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return entities.Backup{}, err
+	}
+	return files.DecodeBackup(name, data)
+}
+
+// DeleteBackup removes a backup by name. S3 accepts the names of backups
+// already gone.
+func (s *BackupStore) DeleteBackup(ctx context.Context, name string) error {
+	key, err := backupKey(name)
+	if err != nil {
+		return err
+	}
+	return s.objects.DeleteFile(ctx, key)
+}
+
+// SignURL presigns a download of the backup name for ttl, so it's fetched
+// from the bucket instead of through the service
+func (s *BackupStore) SignURL(name string, ttl time.Duration) (string, error) {
+	key, err := backupKey(name)
+	if err != nil {
+		return "", err
+	}
+	return s.objects.SignURL(key, name, ttl)
+}
+
+// backupKey only accepts backup names, keeping callers under the prefix
+func backupKey(name string) (string, error) {
+	if _, ok := files.ParseBackupName(name); !ok {
+		return "", fmt.Errorf("%w: invalid backup name %s", domain.ErrMalformedParameters, name)
+	}
+	return backupPrefix + name, nil
+}
@@ -0,0 +1,116 @@
+package s3
+
+import (
+	"context"
+	"encoding/xml"
+	"finance/domain"
+	"finance/domain/entities"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupStore(t *testing.T) {
+	var mu sync.Mutex
+	objects := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		key := strings.TrimPrefix(r.URL.Path, "/backups-bucket/")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/backups-bucket":
+			// One object per page, to follow the continuation tokens
+			var keys []string
+			for key := range objects {
+				if strings.HasPrefix(key, r.URL.Query().Get("prefix")) && key > r.URL.Query().Get("continuation-token") {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+			page := struct {
+				XMLName               xml.Name `xml:"ListBucketResult"`
+				Contents              []object `xml:"Contents"`
+				IsTruncated           bool     `xml:"IsTruncated"`
+				NextContinuationToken string   `xml:"NextContinuationToken,omitempty"`
+			}{}
+			if len(keys) > 0 {
+				page.Contents = []object{{Key: keys[0], Size: int64(len(objects[keys[0]])), LastModified: time.Now()}}
+				page.IsTruncated = len(keys) > 1
+				page.NextContinuationToken = keys[0]
+			}
+			xml.NewEncoder(w).Encode(page)
+		case r.Method == http.MethodPut:
+			objects[key], _ = io.ReadAll(r.Body)
+		case r.Method == http.MethodGet:
+			content, ok := objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(content)
+		case r.Method == http.MethodDelete:
+			delete(objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	store := NewBackupStore(Config{Endpoint: server.URL, Bucket: "backups-bucket", Region: "auto", AccessKeyID: "key-id", SecretAccessKey: "secret"})
+	ctx := context.Background()
+
+	created := time.Date(2025, time.April, 1, 3, 30, 0, 0, time.UTC)
+	backup := entities.Backup{
+		CreatedAt: created,
+		Settings:  entities.Settings{Currency: monetary.BRL, Locale: "pt-BR", APIKeys: map[string]string{}},
+		Accounts:  []entities.Account{{ID: "acc-1", Name: "Checking", Type: entities.AccountTypeChecking, Asset: monetary.BRL}},
+		Transactions: []entities.Transaction{
+			{ID: "tx-1", AccountID: "acc-1", Monetary: monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(-1500)}, Date: created, Status: entities.TransactionStatusCleared},
+		},
+	}
+
+	file, err := store.SaveBackup(ctx, backup)
+	require.NoError(t, err)
+	assert.Equal(t, "finance-20250401T033000Z.json", file.Name)
+	assert.Contains(t, objects, "backups/finance-20250401T033000Z.json")
+
+	_, err = store.SaveBackup(ctx, entities.Backup{CreatedAt: created.AddDate(0, 0, 1), Settings: backup.Settings})
+	require.NoError(t, err)
+	// Objects that aren't backups are skipped
+	objects["backups/notes.txt"] = []byte("hi")
+	objects["attachments/receipt.pdf"] = []byte("%PDF")
+
+	list, err := store.ListBackups(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "finance-20250401T033000Z.json", list[0].Name)
+	assert.Equal(t, created, list[0].CreatedAt)
+	assert.Equal(t, file.Size, list[0].Size)
+	assert.Equal(t, "finance-20250402T033000Z.json", list[1].Name)
+
+	loaded, err := store.LoadBackup(ctx, file.Name)
+	require.NoError(t, err)
+	require.Len(t, loaded.Transactions, 1)
+	assert.Equal(t, "-1500", loaded.Transactions[0].Monetary.Amount.String())
+
+	link, err := store.SignURL(file.Name, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(link, server.URL+"/backups-bucket/backups/finance-20250401T033000Z.json?"))
+
+	require.NoError(t, store.DeleteBackup(ctx, file.Name))
+	_, err = store.LoadBackup(ctx, file.Name)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	_, err = store.LoadBackup(ctx, "../attachments/receipt.pdf")
+	assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+}
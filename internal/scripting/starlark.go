@@ -0,0 +1,283 @@
+// Package scripting runs the transaction scripts of the users, written in
+// Starlark, in a sandbox: scripts can't load modules or reach the files and
+// network, there are no while loops nor recursion, and every run is cut short
+// past its Limits.
+package scripting
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"fmt"
+	"math/big"
+	"runtime/metrics"
+	"slices"
+	"time"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+// Limits bound each run of a script
+type Limits struct {
+	// Timeout is how long a run may take
+	Timeout time.Duration
+	// MaxSteps caps the Starlark computation steps of a run
+	MaxSteps uint64
+	// MaxAllocBytes caps the memory allocated while a script runs. Starlark
+	// can't account for it per script, so it's measured on the whole process
+	// every millisecond: a run is stopped at most a step after going over,
+	// and may be stopped earlier by the allocations of concurrent requests.
+	MaxAllocBytes uint64
+}
+
+// processFunction is the function the scripts define, called with the
+// transaction
+const processFunction = "process"
+
+// editableFields are the string fields of tx the scripts can change, along
+// with its tags. The others are there to be read.
+var editableFields = []string{"category_id", "description", "payee", "status"}
+
+// tagsField is the list of the tag names of tx
+const tagsField = "tags"
+
+// fileOptions leave out while loops and recursion, so the scripts only loop
+// over what they're given
+var fileOptions = &syntax.FileOptions{}
+
+// rejection is the error reject(reason) stops the script with
+type rejection struct {
+	reason string
+}
+
+func (r *rejection) Error() string {
+	return "rejected: " + r.reason
+}
+
+var predeclared = starlark.StringDict{
+	"reject": starlark.NewBuiltin("reject", func(thread *starlark.Thread, fn *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var reason string
+		if err := starlark.UnpackPositionalArgs(fn.Name(), args, kwargs, 1, &reason); err != nil {
+			return nil, err
+		}
+		return nil, &rejection{reason: reason}
+	}),
+}
+
+// Runner runs the scripts with Starlark, satisfying finance.ScriptRunner
+type Runner struct {
+	limits Limits
+}
+
+func NewRunner(limits Limits) *Runner {
+	return &Runner{
+		limits: limits,
+	}
+}
+
+// Check compiles a script and runs its top level, which has to define
+// process(tx)
+func (r *Runner) Check(script entities.TransactionScript) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.limits.Timeout)
+	defer cancel()
+
+	thread := r.thread(script)
+	defer r.watch(ctx, thread)()
+
+	_, err := r.load(thread, script)
+	return err
+}
+
+// Run calls process with the transaction as a dict, then reads back the fields
+// the script can change
+func (r *Runner) Run(ctx context.Context, script entities.TransactionScript, transaction entities.Transaction) (entities.Transaction, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.limits.Timeout)
+	defer cancel()
+
+	thread := r.thread(script)
+	defer r.watch(ctx, thread)()
+
+	process, err := r.load(thread, script)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+
+	tx := transactionDict(transaction)
+	if _, err := starlark.Call(thread, process, starlark.Tuple{tx}, nil); err != nil {
+		var rejected *rejection
+		if errors.As(err, &rejected) {
+			return entities.Transaction{}, &domain.RejectedError{Script: script.Name, Reason: rejected.reason}
+		}
+		return entities.Transaction{}, err
+	}
+
+	return readTransactionDict(tx, transaction)
+}
+
+func (r *Runner) thread(script entities.TransactionScript) *starlark.Thread {
+	thread := &starlark.Thread{
+		Name:  script.Name,
+		Print: func(*starlark.Thread, string) {},
+	}
+	thread.SetMaxExecutionSteps(r.limits.MaxSteps)
+	return thread
+}
+
+// load runs the top level of a script, returning its process function
+func (r *Runner) load(thread *starlark.Thread, script entities.TransactionScript) (*starlark.Function, error) {
+	globals, err := starlark.ExecFileOptions(fileOptions, thread, script.Name, script.Source, predeclared)
+	if err != nil {
+		return nil, err
+	}
+
+	process, ok := globals[processFunction].(*starlark.Function)
+	if !ok || process.NumParams() != 1 {
+		return nil, fmt.Errorf("the script must define %s(tx)", processFunction)
+	}
+	return process, nil
+}
+
+// watch cancels the thread once ctx is done or it allocated too much, until
+// the returned function is called
+func (r *Runner) watch(ctx context.Context, thread *starlark.Thread) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(time.Millisecond)
+		defer ticker.Stop()
+
+		start := allocatedBytes()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					thread.Cancel(fmt.Sprintf("time limit of %s exceeded", r.limits.Timeout))
+				} else {
+					thread.Cancel(ctx.Err().Error())
+				}
+				return
+			case <-ticker.C:
+				if allocatedBytes()-start > r.limits.MaxAllocBytes {
+					thread.Cancel(fmt.Sprintf("memory limit of %d bytes exceeded", r.limits.MaxAllocBytes))
+					return
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// allocatedBytes is the memory the process allocated since it started
+func allocatedBytes() uint64 {
+	sample := []metrics.Sample{{Name: "/gc/heap/allocs:bytes"}}
+	metrics.Read(sample)
+	return sample[0].Value.Uint64()
+}
+
+// transactionDict is the transaction as the scripts see it. The amount is a
+// float in units of the account's currency, which isn't told: transactions
+// created through the API are run before their amount is converted to it.
+func transactionDict(transaction entities.Transaction) *starlark.Dict {
+	amount := new(big.Int)
+	if transaction.Monetary.Amount != nil {
+		amount = transaction.Monetary.Amount
+	}
+	units, _ := new(big.Rat).SetFrac(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(transaction.Monetary.Asset.Precision)), nil)).Float64()
+
+	fields := []struct {
+		key   string
+		value starlark.Value
+	}{
+		{"account_id", starlark.String(transaction.AccountID)},
+		{"category_id", starlark.String(transaction.CategoryID)},
+		{"amount", starlark.Float(units)},
+		{"date", starlark.String(transaction.Date.Format(time.DateOnly))},
+		{"description", starlark.String(transaction.Description)},
+		{"payee", starlark.String(transaction.Payee)},
+		{"status", starlark.String(transaction.Status)},
+		{tagsField, tagList(transaction.Tags)},
+	}
+	tx := starlark.NewDict(len(fields))
+	for _, field := range fields {
+		_ = tx.SetKey(starlark.String(field.key), field.value)
+	}
+	return tx
+}
+
+// readTransactionDict sets the editable fields of the transaction from tx
+func readTransactionDict(tx *starlark.Dict, transaction entities.Transaction) (entities.Transaction, error) {
+	values := map[string]string{}
+	for _, key := range editableFields {
+		value, found, err := tx.Get(starlark.String(key))
+		if err != nil {
+			return entities.Transaction{}, err
+		}
+		if !found {
+			return entities.Transaction{}, fmt.Errorf("tx[%q] was removed", key)
+		}
+		text, ok := starlark.AsString(value)
+		if !ok {
+			return entities.Transaction{}, fmt.Errorf("tx[%q] must be a string, not %s", key, value.Type())
+		}
+		values[key] = text
+	}
+
+	status := entities.TransactionStatus(values["status"])
+	if status != "" && !slices.Contains(entities.TransactionStatuses, status) {
+		return entities.Transaction{}, fmt.Errorf("invalid transaction status: %s", status)
+	}
+
+	tags, err := readTags(tx)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+
+	transaction.CategoryID = values["category_id"]
+	transaction.Description = values["description"]
+	transaction.Payee = values["payee"]
+	transaction.Status = status
+	transaction.Tags = tags
+	return transaction, nil
+}
+
+func tagList(tags []string) *starlark.List {
+	values := make([]starlark.Value, len(tags))
+	for i, tag := range tags {
+		values[i] = starlark.String(tag)
+	}
+	return starlark.NewList(values)
+}
+
+// readTags reads the tags of tx, a list or tuple of strings, nil when empty
+func readTags(tx *starlark.Dict) ([]string, error) {
+	value, found, err := tx.Get(starlark.String(tagsField))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("tx[%q] was removed", tagsField)
+	}
+
+	var elems starlark.Indexable
+	switch value := value.(type) {
+	case *starlark.List:
+		elems = value
+	case starlark.Tuple:
+		elems = value
+	default:
+		return nil, fmt.Errorf("tx[%q] must be a list, not %s", tagsField, value.Type())
+	}
+
+	var tags []string
+	for i := range elems.Len() {
+		tag, ok := starlark.AsString(elems.Index(i))
+		if !ok {
+			return nil, fmt.Errorf("tx[%q] must hold strings, not %s", tagsField, elems.Index(i).Type())
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
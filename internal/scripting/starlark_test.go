@@ -0,0 +1,140 @@
+package scripting
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"finance/domain/entities"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/guilhermebr/gox/monetary"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testLimits = Limits{
+	Timeout:       time.Second,
+	MaxSteps:      100_000,
+	MaxAllocBytes: 64 << 20,
+}
+
+func TestRunner(t *testing.T) {
+	transaction := entities.Transaction{
+		AccountID:   "acc-1",
+		CategoryID:  "cat-expense",
+		Monetary:    monetary.Monetary{Asset: monetary.BRL, Amount: big.NewInt(-4590)},
+		Description: "UBER *TRIP",
+		Date:        time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC),
+		Status:      entities.TransactionStatusCleared,
+		Tags:        []string{"work"},
+	}
+	run := func(t *testing.T, limits Limits, source string) (entities.Transaction, error) {
+		t.Helper()
+		return NewRunner(limits).Run(context.Background(), entities.TransactionScript{Name: "test", Source: source}, transaction)
+	}
+
+	t.Run("changes the editable fields", func(t *testing.T) {
+		got, err := run(t, testLimits, `
+RIDES = ["UBER", "99APP"]
+
+def process(tx):
+    if tx["amount"] < -40 and tx["date"].startswith("2025-03"):
+        for ride in RIDES:
+            if ride in tx["description"]:
+                tx["category_id"] = "cat-transport"
+                tx["payee"] = ride.title()
+                tx["status"] = "pending"
+                tx["tags"].append("rides")
+    tx["account_id"] = "acc-other"
+`)
+		require.NoError(t, err)
+		assert.Equal(t, "cat-transport", got.CategoryID)
+		assert.Equal(t, "Uber", got.Payee)
+		assert.Equal(t, entities.TransactionStatusPending, got.Status)
+		assert.Equal(t, []string{"work", "rides"}, got.Tags)
+		assert.Equal(t, "acc-1", got.AccountID, "the account can't be changed")
+		assert.Equal(t, transaction.Monetary, got.Monetary)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		_, err := run(t, testLimits, "def process(tx):\n    reject(\"no rides\")\n")
+		var rejected *domain.RejectedError
+		require.ErrorAs(t, err, &rejected)
+		assert.Equal(t, &domain.RejectedError{Script: "test", Reason: "no rides"}, rejected)
+		assert.ErrorIs(t, err, domain.ErrMalformedParameters)
+	})
+
+	t.Run("invalid fields", func(t *testing.T) {
+		_, err := run(t, testLimits, "def process(tx):\n    tx[\"category_id\"] = 3\n")
+		assert.EqualError(t, err, `tx["category_id"] must be a string, not int`)
+
+		_, err = run(t, testLimits, "def process(tx):\n    tx[\"status\"] = \"paid\"\n")
+		assert.EqualError(t, err, "invalid transaction status: paid")
+
+		_, err = run(t, testLimits, "def process(tx):\n    tx.pop(\"payee\")\n")
+		assert.EqualError(t, err, `tx["payee"] was removed`)
+
+		_, err = run(t, testLimits, "def process(tx):\n    tx[\"tags\"] = \"rides\"\n")
+		assert.EqualError(t, err, `tx["tags"] must be a list, not string`)
+
+		_, err = run(t, testLimits, "def process(tx):\n    tx[\"tags\"] = [\"rides\", None]\n")
+		assert.EqualError(t, err, `tx["tags"] must hold strings, not NoneType`)
+	})
+
+	t.Run("runtime error", func(t *testing.T) {
+		_, err := run(t, testLimits, "def process(tx):\n    tx[\"missing\"]\n")
+		assert.ErrorContains(t, err, `key "missing" not in dict`)
+	})
+
+	t.Run("step limit", func(t *testing.T) {
+		limits := testLimits
+		limits.MaxSteps = 1000
+		_, err := run(t, limits, "def process(tx):\n    for i in range(100000):\n        pass\n")
+		assert.ErrorContains(t, err, "too many steps")
+	})
+
+	t.Run("time limit", func(t *testing.T) {
+		limits := testLimits
+		limits.Timeout = 10 * time.Millisecond
+		limits.MaxSteps = 0
+		_, err := run(t, limits, "def process(tx):\n    for i in range(1000000000):\n        pass\n")
+		assert.ErrorContains(t, err, "time limit of 10ms exceeded")
+	})
+
+	t.Run("memory limit", func(t *testing.T) {
+		limits := testLimits
+		limits.MaxAllocBytes = 1 << 20
+		limits.MaxSteps = 0
+		_, err := run(t, limits, "def process(tx):\n    rows = []\n    for i in range(100000000):\n        rows.append(tx[\"description\"] * 100)\n")
+		assert.ErrorContains(t, err, "memory limit of 1048576 bytes exceeded")
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		limits := testLimits
+		limits.MaxSteps = 0
+		_, err := NewRunner(limits).Run(ctx, entities.TransactionScript{Name: "test", Source: "def process(tx):\n    for i in range(1000000000):\n        pass\n"}, transaction)
+		assert.ErrorContains(t, err, context.Canceled.Error())
+	})
+}
+
+func TestRunnerCheck(t *testing.T) {
+	runner := NewRunner(testLimits)
+	check := func(source string) error {
+		return runner.Check(entities.TransactionScript{Name: "test", Source: source})
+	}
+
+	assert.NoError(t, check("def process(tx):\n    pass\n"))
+	assert.EqualError(t, check("def categorize(tx):\n    pass\n"), "the script must define process(tx)")
+	assert.EqualError(t, check("def process(tx, category):\n    pass\n"), "the script must define process(tx)")
+	assert.ErrorContains(t, check("def process(tx):\n    while True:\n        pass\n"), "does not support while loops")
+	assert.ErrorContains(t, check("load(\"os.star\", \"system\")\n"), "load")
+	assert.Error(t, check("def process(tx)\n"))
+
+	err := check("def process(tx):\n    pass\nfail(\"at load\")\n")
+	assert.ErrorContains(t, err, "at load")
+	assert.False(t, errors.Is(err, domain.ErrMalformedParameters), "the use case tells the script is invalid")
+}
@@ -0,0 +1,102 @@
+// Package thumbnails shrinks JPEG, PNG and GIF pictures down to PNG
+// thumbnails
+package thumbnails
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+)
+
+// DefaultSize is the longest side of the thumbnails, in pixels
+const DefaultSize = 160
+
+// maxPixels keeps pictures that would take too much memory to decode, like
+// the tiny files claiming to be huge, from being decoded
+const maxPixels = 50_000_000
+
+type Thumbnailer struct {
+	size int
+}
+
+// New returns a thumbnailer fitting the pictures in a size by size square
+func New(size int) *Thumbnailer {
+	return &Thumbnailer{
+		size: size,
+	}
+}
+
+// Thumbnail returns a PNG of the picture in content, scaled down to fit the
+// square and keeping its proportions. Pictures already smaller are kept at
+// their size.
+func (t *Thumbnailer) Thumbnail(content []byte) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read picture: %w", err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxPixels {
+		return nil, fmt.Errorf("picture of %dx%d pixels is too large", config.Width, config.Height)
+	}
+
+	picture, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode picture: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, t.scale(picture)); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// scale shrinks a picture averaging the pixels each thumbnail pixel covers
+func (t *Thumbnailer) scale(picture image.Image) image.Image {
+	bounds := picture.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	scaledWidth, scaledHeight := width, height
+	if width > t.size || height > t.size {
+		if width >= height {
+			scaledWidth, scaledHeight = t.size, max(1, height*t.size/width)
+		} else {
+			scaledWidth, scaledHeight = max(1, width*t.size/height), t.size
+		}
+	}
+
+	source := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(source, source.Bounds(), picture, bounds.Min, draw.Src)
+	if scaledWidth == width && scaledHeight == height {
+		return source
+	}
+
+	scaled := image.NewNRGBA(image.Rect(0, 0, scaledWidth, scaledHeight))
+	for y := range scaledHeight {
+		top, bottom := y*height/scaledHeight, max((y+1)*height/scaledHeight, y*height/scaledHeight+1)
+		for x := range scaledWidth {
+			left, right := x*width/scaledWidth, max((x+1)*width/scaledWidth, x*width/scaledWidth+1)
+
+			var sum [4]int
+			for sy := top; sy < bottom; sy++ {
+				row := source.Pix[sy*source.Stride+left*4 : sy*source.Stride+right*4]
+				for i := 0; i < len(row); i += 4 {
+					sum[0] += int(row[i])
+					sum[1] += int(row[i+1])
+					sum[2] += int(row[i+2])
+					sum[3] += int(row[i+3])
+				}
+			}
+
+			count := (bottom - top) * (right - left)
+			offset := y*scaled.Stride + x*4
+			for i := range sum {
+				scaled.Pix[offset+i] = uint8(sum[i] / count)
+			}
+		}
+	}
+	return scaled
+}
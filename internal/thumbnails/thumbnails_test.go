@@ -0,0 +1,77 @@
+package thumbnails
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThumbnail(t *testing.T) {
+	encode := func(width, height int, jpg bool) []byte {
+		picture := image.NewRGBA(image.Rect(0, 0, width, height))
+		for y := range height {
+			for x := range width {
+				// Left half red, right half blue
+				c := color.RGBA{R: 255, A: 255}
+				if x >= width/2 {
+					c = color.RGBA{B: 255, A: 255}
+				}
+				picture.Set(x, y, c)
+			}
+		}
+
+		var buf bytes.Buffer
+		if jpg {
+			require.NoError(t, jpeg.Encode(&buf, picture, nil))
+		} else {
+			require.NoError(t, png.Encode(&buf, picture))
+		}
+		return buf.Bytes()
+	}
+	thumbnailer := New(DefaultSize)
+
+	tests := []struct {
+		name          string
+		content       []byte
+		width, height int
+	}{
+		{"landscape", encode(800, 400, false), 160, 80},
+		{"portrait jpeg", encode(300, 600, true), 80, 160},
+		{"smaller pictures keep their size", encode(40, 20, false), 40, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			thumbnail, err := thumbnailer.Thumbnail(tt.content)
+			require.NoError(t, err)
+
+			decoded, err := png.Decode(bytes.NewReader(thumbnail))
+			require.NoError(t, err)
+			assert.Equal(t, image.Rect(0, 0, tt.width, tt.height), decoded.Bounds())
+
+			r, _, b, _ := decoded.At(tt.width/4, tt.height/2).RGBA()
+			assert.Greater(t, r, b, "the left side stays red")
+		})
+	}
+
+	t.Run("not a picture", func(t *testing.T) {
+		_, err := thumbnailer.Thumbnail([]byte("%PDF-1.4"))
+		assert.Error(t, err)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		// A PNG header claiming 100000x100000 pixels
+		header := encode(1, 1, false)[:33]
+		copy(header[16:], []byte{0, 1, 0x86, 0xa0, 0, 1, 0x86, 0xa0})
+		binary.BigEndian.PutUint32(header[29:], crc32.ChecksumIEEE(header[12:29]))
+		_, err := thumbnailer.Thumbnail(header)
+		assert.ErrorContains(t, err, "too large")
+	})
+}
@@ -0,0 +1,160 @@
+// Package tracing propagates a request ID and a W3C trace context
+// (https://www.w3.org/TR/trace-context/) from the web frontend to the API, so
+// the API requests made to render a page can be found by the page's IDs.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	TraceparentHeader = "traceparent"
+	RequestIDHeader   = "X-Request-Id"
+	TraceIDHeader     = "X-Trace-Id"
+)
+
+// maxRequestIDLength caps the request IDs accepted from callers, longer ones
+// are replaced
+const maxRequestIDLength = 128
+
+// traceparent is version 00: version-trace_id-parent_id-flags, lowercase hex
+var traceparentPattern = regexp.MustCompile(`^00-([0-9a-f]{32})-([0-9a-f]{16})-([0-9a-f]{2})$`)
+
+// Trace identifies a request and where it sits in its trace
+type Trace struct {
+	// RequestID is shared by every request of the trace
+	RequestID string
+	TraceID   string
+	// SpanID identifies this request within the trace
+	SpanID string
+	// ParentSpanID is the span of the caller, empty when the trace started here
+	ParentSpanID string
+}
+
+// Attrs returns the trace as log attributes
+func (t Trace) Attrs() []any {
+	attrs := []any{
+		slog.String("request_id", t.RequestID),
+		slog.String("trace_id", t.TraceID),
+		slog.String("span_id", t.SpanID),
+	}
+	if t.ParentSpanID != "" {
+		attrs = append(attrs, slog.String("parent_span_id", t.ParentSpanID))
+	}
+	return attrs
+}
+
+type contextKey struct{}
+
+// FromContext returns the trace of the request, false outside of one
+func FromContext(ctx context.Context) (Trace, bool) {
+	trace, ok := ctx.Value(contextKey{}).(Trace)
+	return trace, ok
+}
+
+// NewContext returns a context carrying the trace
+func NewContext(ctx context.Context, trace Trace) context.Context {
+	return context.WithValue(ctx, contextKey{}, trace)
+}
+
+// FromRequest continues the trace of the caller from the request headers, or
+// starts a new one when there is none or it's malformed
+func FromRequest(r *http.Request) Trace {
+	trace := Trace{SpanID: randomHex(8)}
+
+	if match := traceparentPattern.FindStringSubmatch(strings.TrimSpace(r.Header.Get(TraceparentHeader))); match != nil &&
+		strings.Trim(match[1], "0") != "" && strings.Trim(match[2], "0") != "" {
+		trace.TraceID = match[1]
+		trace.ParentSpanID = match[2]
+	} else {
+		trace.TraceID = randomHex(16)
+	}
+
+	trace.RequestID = r.Header.Get(RequestIDHeader)
+	if trace.RequestID == "" || len(trace.RequestID) > maxRequestIDLength || strings.ContainsFunc(trace.RequestID, isControl) {
+		trace.RequestID = trace.TraceID
+	}
+	return trace
+}
+
+// Middleware puts the request's trace in its context, where chi's request ID
+// is set too so middleware.Logger prints it, and echoes the IDs in the
+// response headers
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		trace := FromRequest(r)
+
+		w.Header().Set(RequestIDHeader, trace.RequestID)
+		w.Header().Set(TraceIDHeader, trace.TraceID)
+
+		ctx := NewContext(r.Context(), trace)
+		ctx = context.WithValue(ctx, middleware.RequestIDKey, trace.RequestID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Transport propagates the trace of the request context to the outgoing
+// requests, each as a child span, and logs them at debug level with their
+// duration
+type Transport struct {
+	Base http.RoundTripper
+	Log  *slog.Logger
+}
+
+func NewTransport(base http.RoundTripper, log *slog.Logger) *Transport {
+	return &Transport{Base: base, Log: log}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	trace, ok := FromContext(req.Context())
+	if !ok {
+		return t.Base.RoundTrip(req)
+	}
+
+	child := Trace{
+		RequestID:    trace.RequestID,
+		TraceID:      trace.TraceID,
+		SpanID:       randomHex(8),
+		ParentSpanID: trace.SpanID,
+	}
+
+	// RoundTrippers must not modify the request they're given
+	req = req.Clone(req.Context())
+	req.Header.Set(TraceparentHeader, "00-"+child.TraceID+"-"+child.SpanID+"-01")
+	req.Header.Set(RequestIDHeader, child.RequestID)
+
+	start := time.Now()
+	resp, err := t.Base.RoundTrip(req)
+
+	attrs := append(child.Attrs(),
+		slog.String("method", req.Method),
+		slog.String("path", req.URL.Path),
+		slog.Duration("duration", time.Since(start)),
+	)
+	if err != nil {
+		t.Log.Debug("api call failed", append(attrs, slog.String("error", err.Error()))...)
+		return resp, err
+	}
+	t.Log.Debug("api call", append(attrs, slog.Int("status", resp.StatusCode))...)
+	return resp, nil
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	// crypto/rand.Read never fails
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func isControl(r rune) bool {
+	return r < 0x20 || r == 0x7f
+}
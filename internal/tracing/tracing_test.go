@@ -0,0 +1,91 @@
+package tracing
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromRequest(t *testing.T) {
+	t.Run("continues the caller's trace", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set(TraceparentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+		r.Header.Set(RequestIDHeader, "dashboard-42")
+
+		trace := FromRequest(r)
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", trace.TraceID)
+		assert.Equal(t, "00f067aa0ba902b7", trace.ParentSpanID)
+		assert.Len(t, trace.SpanID, 16)
+		assert.NotEqual(t, trace.ParentSpanID, trace.SpanID)
+		assert.Equal(t, "dashboard-42", trace.RequestID)
+	})
+
+	t.Run("starts a new trace", func(t *testing.T) {
+		for _, traceparent := range []string{
+			"",
+			"01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+			"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		} {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.Header.Set(TraceparentHeader, traceparent)
+
+			trace := FromRequest(r)
+			assert.Len(t, trace.TraceID, 32, traceparent)
+			assert.NotEqual(t, "4bf92f3577b34da6a3ce929d0e0e4736", trace.TraceID, traceparent)
+			assert.Empty(t, trace.ParentSpanID, traceparent)
+			assert.Equal(t, trace.TraceID, trace.RequestID, "the request ID defaults to the trace ID")
+		}
+	})
+
+	t.Run("replaces invalid request ids", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set(RequestIDHeader, "id\nforged log line")
+
+		trace := FromRequest(r)
+		assert.Equal(t, trace.TraceID, trace.RequestID)
+	})
+}
+
+func TestPropagation(t *testing.T) {
+	// The API echoes the headers it received
+	var received http.Header
+	api := httptest.NewServer(Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		trace, ok := FromContext(r.Context())
+		require.True(t, ok)
+		assert.Equal(t, trace.RequestID, middleware.GetReqID(r.Context()))
+		w.Header().Set("X-Parent-Span", trace.ParentSpanID)
+	})))
+	defer api.Close()
+
+	client := &http.Client{Transport: NewTransport(http.DefaultTransport, slog.New(slog.NewTextHandler(io.Discard, nil)))}
+
+	// The web page calls the API while handling its own request
+	var page Trace
+	web := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ = FromContext(r.Context())
+		req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, api.URL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, page.TraceID, resp.Header.Get(TraceIDHeader), "the API joins the page's trace")
+		assert.Equal(t, page.RequestID, resp.Header.Get(RequestIDHeader))
+		assert.Equal(t, received.Get(TraceparentHeader)[36:52], resp.Header.Get("X-Parent-Span"))
+	}))
+
+	rec := httptest.NewRecorder()
+	web.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, page.RequestID, rec.Header().Get(RequestIDHeader))
+	assert.Regexp(t, "^00-"+page.TraceID+"-[0-9a-f]{16}-01$", received.Get(TraceparentHeader))
+	assert.NotContains(t, received.Get(TraceparentHeader), page.SpanID, "each API call is a child span")
+}
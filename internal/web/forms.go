@@ -0,0 +1,222 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// formData carries the submitted values and inline errors used to render a form partial
+type formData struct {
+	Values     url.Values
+	Errors     map[string]string
+	Accounts   []AccountResponse
+	Categories []CategoryResponse
+}
+
+// settingsForm carries the settings being edited and inline errors used to render the settings form
+type settingsForm struct {
+	Settings SettingsResponse
+	Errors   map[string]string
+}
+
+// transactionDetail carries a transaction and its edit form for the detail page.
+// SwapOOB re-renders the summary out of band after an edit.
+type transactionDetail struct {
+	Transaction TransactionResponse
+	Form        formData
+	SwapOOB     bool
+	Title       string
+	CurrentPage string
+}
+
+// onboardingForm carries the first-run choices and inline errors used to render the
+// onboarding wizard. Selected holds the IDs of the categories to keep.
+type onboardingForm struct {
+	Values     url.Values
+	Errors     map[string]string
+	Categories []CategoryResponse
+	Selected   map[string]bool
+}
+
+// preferencesForm carries the user preferences being edited and inline errors used to render the preferences form
+type preferencesForm struct {
+	Settings map[string]string
+	Errors   map[string]string
+}
+
+// formField maps a keyword found in API validation messages to a form field.
+// Fields are matched in order, so more specific keywords must come first.
+type formField struct {
+	keyword string
+	name    string
+}
+
+var accountFormFields = []formField{
+	{keyword: "classification", name: "classification"},
+	{keyword: "institution", name: "institution"},
+	{keyword: "last4", name: "account_number_last4"},
+	{keyword: "color", name: "color"},
+	{keyword: "icon", name: "icon"},
+	{keyword: "closing day", name: "statement_closing_day"},
+	{keyword: "billing cycle", name: "statement_closing_day"},
+	{keyword: "due day", name: "payment_due_day"},
+	{keyword: "name", name: "name"},
+	{keyword: "type", name: "type"},
+	{keyword: "asset", name: "asset"},
+	{keyword: "description", name: "description"},
+}
+
+var categoryFormFields = []formField{
+	{keyword: "name", name: "name"},
+	{keyword: "type", name: "type"},
+	{keyword: "color", name: "color"},
+	{keyword: "description", name: "description"},
+}
+
+var transactionFormFields = []formField{
+	{keyword: "amount", name: "amount"},
+	{keyword: "date", name: "transaction_date"},
+	{keyword: "description", name: "description"},
+	{keyword: "payee", name: "payee"},
+	{keyword: "installments", name: "installments"},
+	{keyword: "status", name: "status"},
+	{keyword: "category", name: "category_id"},
+	{keyword: "account", name: "account_id"},
+}
+
+var onboardingFormFields = []formField{
+	{keyword: "currency", name: "base_currency"},
+	{keyword: "asset", name: "base_currency"},
+	{keyword: "categor", name: "categories"},
+	{keyword: "name", name: "name"},
+	{keyword: "type", name: "type"},
+	{keyword: "description", name: "description"},
+}
+
+var settingsFormFields = []formField{
+	{keyword: "currency", name: "currency"},
+	{keyword: "locale", name: "locale"},
+	{keyword: "fiscal", name: "fiscal_month_start_day"},
+	{keyword: "email", name: "notification_email"},
+	{keyword: "api key", name: "api_keys"},
+}
+
+var preferencesFormFields = []formField{
+	{keyword: "currency", name: "base_currency"},
+	{keyword: "timezone", name: "timezone"},
+	{keyword: "day of week", name: "first_day_of_week"},
+	{keyword: "dashboard", name: "dashboard_layout"},
+	{keyword: "locale", name: "locale"},
+}
+
+// formErrors maps an API validation error to the form field it refers to.
+// Errors that can't be attributed to a field are reported on the whole form.
+func formErrors(err error, fields []formField) map[string]string {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		return map[string]string{"form": err.Error()}
+	}
+
+	if apiErr.StatusCode == http.StatusBadRequest {
+		message := strings.ToLower(apiErr.Message)
+		for _, field := range fields {
+			if strings.Contains(message, field.keyword) {
+				return map[string]string{field.name: apiErr.Message}
+			}
+		}
+	}
+
+	return map[string]string{"form": apiErr.Message}
+}
+
+// renderForm re-renders a form partial in place of the submitted form.
+// HTMX only swaps successful responses, so the status stays 200 and the
+// swap target is redirected to the form itself.
+func (h *Handlers) renderForm(w http.ResponseWriter, form string, data any) {
+	w.Header().Set("HX-Retarget", "#"+form)
+	w.Header().Set("HX-Reswap", "outerHTML")
+	notify(w, form+"-invalid", toastError, "Please check the highlighted fields")
+
+	if err := h.templates.ExecuteTemplate(w, form, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// transactionDetailValues fills the detail form with the stored transaction
+func transactionDetailValues(transaction TransactionResponse) url.Values {
+	amount := transaction.Amount
+	if _, value, ok := parseMoney(transaction.Amount); ok {
+		amount = value
+	}
+
+	return url.Values{
+		"account_id":       {transaction.AccountID},
+		"category_id":      {transaction.CategoryID},
+		"amount":           {amount},
+		"description":      {transaction.Description},
+		"payee":            {transaction.Payee},
+		"transaction_date": {transaction.Date},
+		"status":           {string(transaction.Status)},
+		"project_id":       {transaction.ProjectID},
+	}
+}
+
+// transactionFormData loads the account and category options needed to re-render the transaction form
+func (h *Handlers) transactionFormData(r *http.Request, errs map[string]string) formData {
+	data := formData{
+		Values: r.PostForm,
+		Errors: errs,
+	}
+
+	// Options are best effort, the inline errors are still shown without them
+	_ = h.apiGetAll(r.Context(), "/api/v1/accounts", &data.Accounts)
+	_ = h.apiGetAll(r.Context(), "/api/v1/categories", &data.Categories)
+
+	return data
+}
+
+// billingCycleFromForm reads the statement closing and payment due days of the
+// account form, blank days are left unset
+func billingCycleFromForm(r *http.Request) (int, int, map[string]string) {
+	days := make([]int, 2)
+	for i, name := range []string{"statement_closing_day", "payment_due_day"} {
+		value := strings.TrimSpace(r.FormValue(name))
+		if value == "" {
+			continue
+		}
+		day, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, 0, map[string]string{name: "Invalid day of the month"}
+		}
+		days[i] = day
+	}
+	return days[0], days[1], nil
+}
+
+// settingsFromForm reads the submitted settings form. API keys are posted as
+// parallel provider/value lists, rows without a provider are ignored.
+func settingsFromForm(values url.Values) SettingsResponse {
+	settings := SettingsResponse{
+		Currency:             values.Get("currency"),
+		Locale:               values.Get("locale"),
+		NotificationsEnabled: values.Get("notifications_enabled") == "on",
+		NotificationEmail:    values.Get("notification_email"),
+		APIKeys:              map[string]string{},
+	}
+
+	providers := values["api_key_provider"]
+	keys := values["api_key_value"]
+	for i, provider := range providers {
+		provider = strings.TrimSpace(provider)
+		if provider == "" || i >= len(keys) {
+			continue
+		}
+		settings.APIKeys[provider] = strings.TrimSpace(keys[i])
+	}
+
+	return settings
+}
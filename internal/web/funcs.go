@@ -0,0 +1,172 @@
+package web
+
+import (
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// templateFuncs returns the helpers shared by all web templates
+func templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"formatMoney":    formatMoney,
+		"isNegative":     isNegative,
+		"formatDelta":    formatDelta,
+		"formatDate":     formatDate,
+		"formatDateTime": formatDateTime,
+		"humanize":       humanize,
+		"percentage":     percentage,
+		"barWidth":       barWidth,
+		"colorContrast":  colorContrast,
+		"accountLabel":   accountLabel,
+	}
+}
+
+// parseMoney splits an API amount like "[USD ($) -1234.50]" into its symbol and value
+func parseMoney(amount string) (symbol string, value string, ok bool) {
+	fields := strings.Fields(strings.Trim(amount, "[]"))
+	if len(fields) != 3 {
+		return "", "", false
+	}
+	return strings.Trim(fields[1], "()"), fields[2], true
+}
+
+// formatMoney renders an API amount as "$1,234.50", keeping the sign in front of the symbol
+func formatMoney(amount string) string {
+	symbol, value, ok := parseMoney(amount)
+	if !ok {
+		return amount
+	}
+
+	sign := ""
+	if strings.HasPrefix(value, "-") {
+		sign = "-"
+		value = value[1:]
+	}
+
+	integer, fraction, _ := strings.Cut(value, ".")
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	if fraction != "" {
+		grouped.WriteString("." + fraction)
+	}
+
+	return sign + symbol + grouped.String()
+}
+
+// isNegative reports whether an API amount is below zero
+func isNegative(amount string) bool {
+	if _, value, ok := parseMoney(amount); ok {
+		return strings.HasPrefix(value, "-")
+	}
+	return strings.HasPrefix(amount, "-")
+}
+
+// formatDelta renders a balance change as "▲ $1,240.00" or "▼ $1,240.00"
+func formatDelta(amount string) string {
+	formatted := formatMoney(amount)
+	if isNegative(amount) {
+		return "▼ " + strings.TrimPrefix(formatted, "-")
+	}
+	return "▲ " + formatted
+}
+
+// formatDate renders API dates (YYYY-MM-DD or RFC 3339) as "Jan 2, 2006"
+func formatDate(date string) string {
+	for _, layout := range []string{"2006-01-02", time.RFC3339} {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t.Format("Jan 2, 2006")
+		}
+	}
+	return date
+}
+
+// formatDateTime renders API timestamps (RFC 3339) as "Jan 2, 2006 at 15:04"
+func formatDateTime(timestamp string) string {
+	if t, err := time.Parse(time.RFC3339, timestamp); err == nil {
+		return t.Format("Jan 2, 2006 at 15:04")
+	}
+	return timestamp
+}
+
+// humanize turns identifiers like "credit_card" into "Credit Card"
+func humanize(value any) string {
+	words := strings.FieldsFunc(fmt.Sprint(value), func(r rune) bool {
+		return r == '_' || r == '-'
+	})
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// percentage renders part as a share of total, e.g. "12.5%"
+func percentage(part, total any) string {
+	p, t := toFloat(part), toFloat(total)
+	if t == 0 {
+		return "0%"
+	}
+	return strconv.FormatFloat(p/t*100, 'f', 1, 64) + "%"
+}
+
+// barWidth renders a percentage as the width of a progress bar, which stops
+// at full width once over 100
+func barWidth(percent float64) string {
+	return strconv.FormatFloat(min(max(percent, 0), 100), 'f', 1, 64)
+}
+
+func toFloat(value any) float64 {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	case string:
+		f, _ := strconv.ParseFloat(v, 64)
+		return f
+	default:
+		return 0
+	}
+}
+
+// colorContrast returns black or white, whichever reads better on the given hex background
+func colorContrast(hex string) string {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return "#000000"
+	}
+
+	rgb, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return "#000000"
+	}
+	r, g, b := float64(rgb>>16&0xFF), float64(rgb>>8&0xFF), float64(rgb&0xFF)
+
+	// Perceived brightness (ITU-R BT.601)
+	if 0.299*r+0.587*g+0.114*b > 150 {
+		return "#000000"
+	}
+	return "#FFFFFF"
+}
+
+// accountLabel names an account in dropdowns, adding the institution and last 4
+// digits when set so accounts at the same bank can be told apart
+func accountLabel(account AccountResponse) string {
+	bank := strings.TrimSpace(account.Institution)
+	if account.AccountNumberLast4 != "" {
+		bank = strings.TrimSpace(bank + " ••" + account.AccountNumberLast4)
+	}
+	if bank == "" {
+		return fmt.Sprintf("%s (%s)", account.Name, account.Type)
+	}
+	return fmt.Sprintf("%s (%s, %s)", account.Name, bank, account.Type)
+}
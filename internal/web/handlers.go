@@ -2,28 +2,52 @@ package web
 
 import (
 	"bytes"
+	"cmp"
+	"context"
 	"encoding/json"
+	"errors"
+	"finance/domain"
 	"finance/domain/entities"
+	"finance/internal/tracing"
 	"fmt"
 	"html/template"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"path/filepath"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/gofrs/uuid/v5"
 	"github.com/gorilla/mux"
 	"github.com/guilhermebr/gox/monetary"
+	"golang.org/x/sync/errgroup"
 )
 
 // Response DTOs that match the API contracts
 type AccountResponse struct {
-	ID          string               `json:"id"`
-	Name        string               `json:"name"`
-	Type        entities.AccountType `json:"type"`
-	Asset       string               `json:"asset"`
-	Description string               `json:"description"`
-	CreatedAt   string               `json:"created_at"`
-	UpdatedAt   string               `json:"updated_at"`
+	ID                  string                         `json:"id"`
+	Name                string                         `json:"name"`
+	Type                entities.AccountType           `json:"type"`
+	Asset               string                         `json:"asset"`
+	Description         string                         `json:"description"`
+	Classification      entities.AccountClassification `json:"classification,omitempty"`
+	Institution         string                         `json:"institution,omitempty"`
+	AccountNumberLast4  string                         `json:"account_number_last4,omitempty"`
+	Color               string                         `json:"color,omitempty"`
+	Icon                string                         `json:"icon,omitempty"`
+	StatementClosingDay int                            `json:"statement_closing_day,omitempty"`
+	PaymentDueDay       int                            `json:"payment_due_day,omitempty"`
+	CreatedAt           string                         `json:"created_at"`
+	UpdatedAt           string                         `json:"updated_at"`
+	TransactionCount    int64                          `json:"transaction_count"`
+	LastTransactionDate string                         `json:"last_transaction_date,omitempty"`
+	Balance             *BalanceResponse               `json:"balance,omitempty"`
 }
 
 type CategoryResponse struct {
@@ -32,6 +56,7 @@ type CategoryResponse struct {
 	Type        entities.CategoryType `json:"type"`
 	Description string                `json:"description"`
 	Color       string                `json:"color"`
+	ParentID    string                `json:"parent_id"`
 	CreatedAt   string                `json:"created_at"`
 	UpdatedAt   string                `json:"updated_at"`
 }
@@ -42,21 +67,166 @@ type TransactionResponse struct {
 	CategoryID  string                     `json:"category_id"`
 	Amount      string                     `json:"amount"`
 	Description string                     `json:"description"`
+	Payee       string                     `json:"payee,omitempty"`
 	Date        string                     `json:"date"`
 	Status      entities.TransactionStatus `json:"status"`
+	ProjectID   string                     `json:"project_id,omitempty"`
+	Attachments []AttachmentResponse       `json:"attachments,omitempty"`
 	CreatedAt   string                     `json:"created_at"`
 	UpdatedAt   string                     `json:"updated_at"`
 	Account     *AccountResponse           `json:"account,omitempty"`
 	Category    *CategoryResponse          `json:"category,omitempty"`
+	// BudgetWarnings are the budgets the API says the transaction just
+	// created left over their limit
+	BudgetWarnings []BudgetWarningResponse `json:"budget_warnings,omitempty"`
+}
+
+type BudgetWarningResponse struct {
+	CategoryName string `json:"category_name"`
+	Month        string `json:"month"`
+	Limit        string `json:"limit"`
+	Overspent    string `json:"overspent"`
+}
+
+type AttachmentResponse struct {
+	ID           string `json:"id"`
+	FileName     string `json:"file_name"`
+	ContentType  string `json:"content_type"`
+	Size         int64  `json:"size"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+}
+
+type InstallmentPlanResponse struct {
+	ID               string                `json:"id"`
+	AccountID        string                `json:"account_id"`
+	Description      string                `json:"description"`
+	Amount           string                `json:"amount"`
+	InstallmentCount int                   `json:"installment_count"`
+	Installments     []TransactionResponse `json:"installments"`
+}
+
+type FaturaResponse struct {
+	AccountID        string                `json:"account_id"`
+	Month            string                `json:"month"`
+	From             string                `json:"from"`
+	ClosingDate      string                `json:"closing_date"`
+	DueDate          string                `json:"due_date"`
+	Status           entities.FaturaStatus `json:"status"`
+	Charges          string                `json:"charges"`
+	Credits          string                `json:"credits"`
+	Total            string                `json:"total"`
+	TransactionCount int                   `json:"transaction_count"`
+	Transactions     []TransactionResponse `json:"transactions,omitempty"`
+}
+
+// CubeResponse pivots the transactions by two dimensions, with a table per
+// currency
+type CubeResponse struct {
+	Rows    string              `json:"rows"`
+	Cols    string              `json:"cols"`
+	Measure string              `json:"measure"`
+	From    string              `json:"from"`
+	To      string              `json:"to"`
+	Tables  []CubeTableResponse `json:"tables"`
+}
+
+type CubeTableResponse struct {
+	Asset     string                 `json:"asset"`
+	Rows      []ReportKeyResponse    `json:"rows"`
+	Cols      []ReportKeyResponse    `json:"cols"`
+	Values    [][]*CubeValueResponse `json:"values"`
+	RowTotals []CubeValueResponse    `json:"row_totals"`
+	ColTotals []CubeValueResponse    `json:"col_totals"`
+	Total     CubeValueResponse      `json:"total"`
+}
+
+type ReportKeyResponse struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// CubeValueResponse holds the one measure the cube was asked for
+type CubeValueResponse struct {
+	Sum     string `json:"sum"`
+	Count   *int   `json:"count"`
+	Average string `json:"avg"`
+}
+
+// BudgetProgressResponse is how much of a category budget was spent in the
+// month, broken down by category for the budget of a group
+type BudgetProgressResponse struct {
+	Budget struct {
+		Limit string `json:"limit"`
+	} `json:"budget"`
+	CategoryName  string  `json:"category_name"`
+	CategoryColor string  `json:"category_color"`
+	Month         string  `json:"month"`
+	Spent         string  `json:"spent"`
+	Remaining     string  `json:"remaining"`
+	Percent       float64 `json:"percent"`
+	Over          bool    `json:"over"`
+	Breakdown     []struct {
+		CategoryName string `json:"category_name"`
+		Spent        string `json:"spent"`
+	} `json:"breakdown"`
+}
+
+// BudgetHistoryResponse is how each budgeted category kept to its budgets in
+// the last months
+type BudgetHistoryResponse struct {
+	Months     []string `json:"months"`
+	Categories []struct {
+		CategoryName string  `json:"category_name"`
+		Adherence    float64 `json:"adherence"`
+		Months       []struct {
+			Month    string `json:"month"`
+			Budgeted string `json:"budgeted"`
+			Actual   string `json:"actual"`
+			Over     bool   `json:"over"`
+		} `json:"months"`
+	} `json:"categories"`
+}
+
+type DemoStatusResponse struct {
+	Active     bool     `json:"active"`
+	AccountIDs []string `json:"account_ids"`
+}
+
+type BookResponse struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Default bool   `json:"default"`
+}
+
+type OnboardingStatusResponse struct {
+	Completed bool   `json:"completed"`
+	NextStep  string `json:"next_step"`
+	Steps     []struct {
+		Name string `json:"name"`
+		Done bool   `json:"done"`
+	} `json:"steps"`
 }
 
 type BalanceResponse struct {
-	AccountID        string           `json:"account_id"`
-	CurrentBalance   string           `json:"current_balance"`
-	PendingBalance   string           `json:"pending_balance"`
-	AvailableBalance string           `json:"available_balance"`
-	LastCalculated   string           `json:"last_calculated"`
-	Account          *AccountResponse `json:"account,omitempty"`
+	AccountID        string                 `json:"account_id"`
+	CurrentBalance   string                 `json:"current_balance"`
+	PendingBalance   string                 `json:"pending_balance"`
+	AvailableBalance string                 `json:"available_balance"`
+	LastCalculated   string                 `json:"last_calculated"`
+	Deltas           []BalanceDeltaResponse `json:"deltas,omitempty"`
+	Account          *AccountResponse       `json:"account,omitempty"`
+}
+
+type BalanceGroupResponse struct {
+	Institution string            `json:"institution,omitempty"`
+	Type        string            `json:"type,omitempty"`
+	Subtotals   []string          `json:"subtotals"`
+	Accounts    []AccountResponse `json:"accounts"`
+}
+
+type BalanceDeltaResponse struct {
+	Days   int    `json:"days"`
+	Amount string `json:"amount"`
 }
 
 type BalanceSummaryResponse struct {
@@ -66,17 +236,58 @@ type BalanceSummaryResponse struct {
 	LastCalculated   string `json:"last_calculated"`
 }
 
+type SettingsResponse struct {
+	Currency             string            `json:"currency"`
+	Locale               string            `json:"locale"`
+	FiscalMonthStartDay  int               `json:"fiscal_month_start_day"`
+	NotificationsEnabled bool              `json:"notifications_enabled"`
+	NotificationEmail    string            `json:"notification_email"`
+	APIKeys              map[string]string `json:"api_keys"`
+	UpdatedAt            string            `json:"updated_at"`
+}
+
+type UserSettingsResponse struct {
+	Settings map[string]string `json:"settings"`
+}
+
+type UsageResponse struct {
+	Tier                string `json:"tier"`
+	ResetsAt            string `json:"resets_at"`
+	Books               int    `json:"books"`
+	Accounts            int    `json:"accounts"`
+	Categories          int    `json:"categories"`
+	Budgets             int    `json:"budgets"`
+	Transactions        int    `json:"transactions"`
+	MonthlyTransactions int    `json:"monthly_transactions"`
+	MonthlyAPICalls     int    `json:"monthly_api_calls"`
+	AttachmentBytes     int64  `json:"attachment_bytes"`
+	Limits              struct {
+		Accounts            int   `json:"accounts"`
+		MonthlyTransactions int   `json:"monthly_transactions"`
+		MonthlyAPICalls     int   `json:"monthly_api_calls"`
+		AttachmentBytes     int64 `json:"attachment_bytes"`
+	} `json:"limits"`
+}
+
 // Handlers contains all web handlers for the personal finance application
 type Handlers struct {
 	apiBaseURL string
 	httpClient *http.Client
 	templates  *template.Template
+	// captcha is shown on the login page once the API asks for one, none
+	// while nil
+	captcha *captchaWidget
+
+	// lastDashboard is the last dashboard of each session and book that
+	// loaded fully, shown while the API is unavailable
+	mu            sync.Mutex
+	lastDashboard map[string]dashboardData
 }
 
 // NewHandlers creates a new instance of web handlers
 func NewHandlers(apiBaseURL string) *Handlers {
 	// Load templates individually to avoid naming conflicts
-	templates := template.New("")
+	templates := template.New("").Funcs(templateFuncs())
 
 	// Parse each template file individually
 	templateFiles := map[string]string{
@@ -87,22 +298,43 @@ func NewHandlers(apiBaseURL string) *Handlers {
 		"accounts-table.html":     "internal/web/templates/accounts-table.html",
 		"categories-table.html":   "internal/web/templates/categories-table.html",
 		"transactions-table.html": "internal/web/templates/transactions-table.html",
+		"transaction.html":        "internal/web/templates/transaction.html",
+		"faturas.html":            "internal/web/templates/faturas.html",
+		"analysis.html":           "internal/web/templates/analysis.html",
 		"balance-summary.html":    "internal/web/templates/balance-summary.html",
+		"notifications.html":      "internal/web/templates/notifications.html",
+		"settings.html":           "internal/web/templates/settings.html",
+		"onboarding.html":         "internal/web/templates/onboarding.html",
+		"demo-banner.html":        "internal/web/templates/demo-banner.html",
+		"book-switcher.html":      "internal/web/templates/book-switcher.html",
+		"usage-card.html":         "internal/web/templates/usage-card.html",
+		"unavailable.html":        "internal/web/templates/unavailable.html",
+		"login.html":              "internal/web/templates/login.html",
 	}
 
 	for name, file := range templateFiles {
-		tmpl, err := template.ParseFiles(file)
+		tmpl, err := template.New(filepath.Base(file)).Funcs(templateFuncs()).ParseFiles(file)
 		if err != nil {
 			panic(fmt.Sprintf("Failed to parse template %s: %v", file, err))
 		}
-		// Associate each template with its intended name
-		templates, _ = templates.AddParseTree(name, tmpl.Tree)
+		// Associate each template with its intended name, keeping any
+		// {{define}} blocks (e.g. table rows) available for partial renders
+		for _, t := range tmpl.Templates() {
+			if t.Name() == filepath.Base(file) {
+				templates, _ = templates.AddParseTree(name, t.Tree)
+				continue
+			}
+			templates, _ = templates.AddParseTree(t.Name(), t.Tree)
+		}
 	}
 
 	return &Handlers{
 		apiBaseURL: apiBaseURL,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
+			// Retry the reads through a circuit breaker, forwarding the page's
+			// request ID and trace context to the API on each attempt
+			Transport: newRetryTransport(tracing.NewTransport(newBreakerTransport(http.DefaultTransport), slog.Default())),
 		},
 		templates: templates,
 	}
@@ -111,16 +343,33 @@ func NewHandlers(apiBaseURL string) *Handlers {
 // Router returns the HTTP router for the web application
 func (h *Handlers) Router() http.Handler {
 	r := mux.NewRouter()
+	r.Use(tracing.Middleware, middleware.Logger, forwardClient, requireSession, bookScope)
 
 	// Static files
 	r.PathPrefix("/static/").Handler(http.StripPrefix("/static/", http.FileServer(http.Dir("internal/web/static/"))))
 
+	// Session routes
+	r.HandleFunc("/login", h.LoginPage).Methods("GET")
+	r.HandleFunc("/login", h.Login).Methods("POST")
+	r.HandleFunc("/register", h.RegisterPage).Methods("GET")
+	r.HandleFunc("/register", h.Register).Methods("POST")
+	r.HandleFunc("/logout", h.Logout).Methods("POST")
+
 	// Web routes
 	r.HandleFunc("/", h.Dashboard).Methods("GET")
+	r.HandleFunc("/onboarding", h.OnboardingPage).Methods("GET")
+	r.HandleFunc("/onboarding", h.CompleteOnboarding).Methods("POST")
+	r.HandleFunc("/demo", h.StartDemo).Methods("POST")
+	r.HandleFunc("/demo/reset", h.ResetDemo).Methods("POST")
+	r.HandleFunc("/demo", h.StopDemo).Methods("DELETE")
+	r.HandleFunc("/books/switch", h.SwitchBook).Methods("POST")
 	r.HandleFunc("/accounts", h.AccountsPage).Methods("GET")
 	r.HandleFunc("/accounts/create", h.CreateAccount).Methods("POST")
 	r.HandleFunc("/accounts/{id}", h.UpdateAccount).Methods("PUT")
 	r.HandleFunc("/accounts/{id}", h.DeleteAccount).Methods("DELETE")
+	r.HandleFunc("/accounts/{id}/faturas", h.FaturasPage).Methods("GET")
+
+	r.HandleFunc("/analysis", h.AnalysisPage).Methods("GET")
 
 	r.HandleFunc("/categories", h.CategoriesPage).Methods("GET")
 	r.HandleFunc("/categories/create", h.CreateCategory).Methods("POST")
@@ -129,37 +378,130 @@ func (h *Handlers) Router() http.Handler {
 
 	r.HandleFunc("/transactions", h.TransactionsPage).Methods("GET")
 	r.HandleFunc("/transactions/create", h.CreateTransaction).Methods("POST")
+	r.HandleFunc("/transactions/export", h.ExportTransactions).Methods("GET")
+	r.HandleFunc("/transactions/{id}", h.TransactionPage).Methods("GET")
 	r.HandleFunc("/transactions/{id}", h.UpdateTransaction).Methods("PUT")
+	r.HandleFunc("/transactions/{id}/detail", h.UpdateTransactionDetail).Methods("PUT")
 	r.HandleFunc("/transactions/{id}", h.DeleteTransaction).Methods("DELETE")
+	r.HandleFunc("/transactions/{id}/duplicate", h.DuplicateTransaction).Methods("POST")
+	r.HandleFunc("/transactions/{id}/finalize", h.FinalizeTransaction).Methods("POST")
+	r.HandleFunc("/transactions/{id}/discard", h.DiscardDraft).Methods("POST")
+	r.HandleFunc("/transactions/{id}/attachments/{attachmentId}", h.AttachmentFile).Methods("GET")
+	r.HandleFunc("/transactions/{id}/attachments/{attachmentId}/thumbnail", h.AttachmentFile).Methods("GET")
+
+	r.HandleFunc("/settings", h.SettingsPage).Methods("GET")
+	r.HandleFunc("/settings", h.UpdateSettings).Methods("PUT")
+	r.HandleFunc("/settings/preferences", h.UpdatePreferences).Methods("PUT")
 
 	// HTMX partial routes
 	r.HandleFunc("/htmx/accounts", h.AccountsTable).Methods("GET")
 	r.HandleFunc("/htmx/categories", h.CategoriesTable).Methods("GET")
 	r.HandleFunc("/htmx/transactions", h.TransactionsTable).Methods("GET")
 	r.HandleFunc("/htmx/balance-summary", h.BalanceSummary).Methods("GET")
+	r.HandleFunc("/htmx/demo-banner", h.DemoBanner).Methods("GET")
+	r.HandleFunc("/htmx/book-switcher", h.BookSwitcher).Methods("GET")
+	r.HandleFunc("/htmx/usage", h.UsageCard).Methods("GET")
 
 	return r
 }
 
+// apiError is returned when the API answers with an unexpected status.
+// Parameter is the request parameter the API blamed, if any.
+type apiError struct {
+	StatusCode int
+	Message    string
+	Parameter  string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("API returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// newAPIError reads the API error body, preferring the message of a JSON error response
+func newAPIError(resp *http.Response) error {
+	expireSession(resp)
+	body, _ := io.ReadAll(resp.Body)
+
+	message := string(body)
+	var payload struct {
+		Error     string `json:"error"`
+		Parameter string `json:"parameter"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil && payload.Error != "" {
+		message = payload.Error
+	}
+
+	return &apiError{StatusCode: resp.StatusCode, Message: message, Parameter: payload.Parameter}
+}
+
 // Helper method to make GET requests to the API
-func (h *Handlers) apiGet(endpoint string, result interface{}) error {
+func (h *Handlers) apiGet(ctx context.Context, endpoint string, result interface{}) error {
 	url := h.apiBaseURL + endpoint
-	resp, err := h.httpClient.Get(url)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	setAPIHeaders(req)
+
+	resp, err := h.httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to call API: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+		return newAPIError(resp)
 	}
 
 	return json.NewDecoder(resp.Body).Decode(result)
 }
 
+// apiPage is the envelope the API list endpoints answer with
+type apiPage struct {
+	Items []json.RawMessage `json:"items"`
+	Next  string            `json:"next"`
+}
+
+// apiGetItems decodes the items of the first page of a list endpoint into result
+func (h *Handlers) apiGetItems(ctx context.Context, endpoint string, result interface{}) error {
+	var page apiPage
+	if err := h.apiGet(ctx, endpoint, &page); err != nil {
+		return err
+	}
+
+	return decodeItems(page.Items, result)
+}
+
+// apiGetAll decodes the items of every page of a list endpoint into result,
+// following the next links
+func (h *Handlers) apiGetAll(ctx context.Context, endpoint string, result interface{}) error {
+	var items []json.RawMessage
+	for endpoint != "" {
+		var page apiPage
+		if err := h.apiGet(ctx, endpoint, &page); err != nil {
+			return err
+		}
+		items = append(items, page.Items...)
+		endpoint = page.Next
+	}
+
+	return decodeItems(items, result)
+}
+
+func decodeItems(items []json.RawMessage, result interface{}) error {
+	if items == nil {
+		items = []json.RawMessage{}
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		return fmt.Errorf("failed to encode items: %w", err)
+	}
+	return json.Unmarshal(data, result)
+}
+
 // Helper method to make POST requests to the API
-func (h *Handlers) apiPost(endpoint string, payload interface{}, result interface{}) error {
+func (h *Handlers) apiPost(ctx context.Context, endpoint string, payload interface{}, result interface{}) error {
 	url := h.apiBaseURL + endpoint
 
 	jsonData, err := json.Marshal(payload)
@@ -167,25 +509,31 @@ func (h *Handlers) apiPost(endpoint string, payload interface{}, result interfac
 		return fmt.Errorf("failed to marshal payload: %w", err)
 	}
 
-	resp, err := h.httpClient.Post(url, "application/json", bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	setAPIHeaders(req)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to call API: %w", err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return newAPIError(resp)
 	}
 
-	if result != nil {
+	if result != nil && resp.StatusCode != http.StatusNoContent {
 		return json.NewDecoder(resp.Body).Decode(result)
 	}
 	return nil
 }
 
 // Helper method to make PUT requests to the API
-func (h *Handlers) apiPut(endpoint string, payload interface{}, result interface{}) error {
+func (h *Handlers) apiPut(ctx context.Context, endpoint string, payload interface{}, result interface{}) error {
 	url := h.apiBaseURL + endpoint
 
 	jsonData, err := json.Marshal(payload)
@@ -193,10 +541,11 @@ func (h *Handlers) apiPut(endpoint string, payload interface{}, result interface
 		return fmt.Errorf("failed to marshal payload: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
+	setAPIHeaders(req)
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := h.httpClient.Do(req)
@@ -206,8 +555,7 @@ func (h *Handlers) apiPut(endpoint string, payload interface{}, result interface
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+		return newAPIError(resp)
 	}
 
 	if result != nil {
@@ -217,13 +565,14 @@ func (h *Handlers) apiPut(endpoint string, payload interface{}, result interface
 }
 
 // Helper method to make DELETE requests to the API
-func (h *Handlers) apiDelete(endpoint string) error {
+func (h *Handlers) apiDelete(ctx context.Context, endpoint string) error {
 	url := h.apiBaseURL + endpoint
 
-	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
+	setAPIHeaders(req)
 
 	resp, err := h.httpClient.Do(req)
 	if err != nil {
@@ -232,78 +581,204 @@ func (h *Handlers) apiDelete(endpoint string) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+		return newAPIError(resp)
 	}
 
 	return nil
 }
 
+// dashboardKey identifies the dashboard of the signed in user's book, so no
+// user is shown another's
+func dashboardKey(ctx context.Context) string {
+	key := domain.BookFromContext(ctx)
+	if s := sessionFromContext(ctx); s != nil {
+		key = s.token + "/" + key
+	}
+	return key
+}
+
+// dashboardTimeout bounds the API calls made to build the dashboard
+const dashboardTimeout = 10 * time.Second
+
+type dashboardData struct {
+	Accounts          []AccountResponse
+	Categories        []CategoryResponse
+	Transactions      []TransactionResponse
+	Balances          []BalanceResponse
+	Budgets           []BudgetProgressResponse
+	BudgetHistory     BudgetHistoryResponse
+	AccountsError     string
+	TransactionsError string
+	BalancesError     string
+	Title             string
+	CurrentPage       string
+	// LoadedAt is when the data was read from the API, shown when Stale
+	LoadedAt time.Time
+	// Stale is set when the API is unavailable and the data is the last
+	// dashboard that loaded
+	Stale bool
+}
+
 // Dashboard renders the main dashboard page
 func (h *Handlers) Dashboard(w http.ResponseWriter, r *http.Request) {
+	// Send first-run users to the onboarding wizard instead of an empty dashboard.
+	// The check is best effort, the dashboard still renders if it fails.
+	var onboarding OnboardingStatusResponse
+	if err := h.apiGet(r.Context(), "/api/v1/onboarding/status", &onboarding); err == nil && !onboarding.Completed {
+		http.Redirect(w, r, "/onboarding", http.StatusSeeOther)
+		return
+	}
+
 	var accounts []AccountResponse
 	var categories []CategoryResponse
 	var transactions []TransactionResponse
 	var balances []BalanceResponse
+	var budgets []BudgetProgressResponse
+	var budgetHistory BudgetHistoryResponse
+
+	// Each section keeps its own error so a failing call only degrades
+	// its part of the page instead of failing the whole dashboard
+	var accountsErr, transactionsErr, balancesErr error
+
+	ctx, cancel := context.WithTimeout(r.Context(), dashboardTimeout)
+	defer cancel()
+
+	// Get data from API concurrently
+	var g errgroup.Group
+	g.Go(func() error {
+		accountsErr = h.apiGetAll(ctx, "/api/v1/accounts", &accounts)
+		return nil
+	})
+	g.Go(func() error {
+		// Categories are only used for display names, which fall back to
+		// "Unknown Category" when they can't be loaded
+		_ = h.apiGetAll(ctx, "/api/v1/categories", &categories)
+		return nil
+	})
+	g.Go(func() error {
+		transactionsErr = h.apiGetItems(ctx, "/api/v1/transactions?include=account,category", &transactions)
+		return nil
+	})
+	g.Go(func() error {
+		balancesErr = h.apiGetAll(ctx, "/api/v1/balances?include=account", &balances)
+		return nil
+	})
+	g.Go(func() error {
+		// Budgets are optional, the section is hidden when they can't be
+		// loaded
+		_ = h.apiGet(ctx, "/api/v1/budgets/progress", &budgets)
+		return nil
+	})
+	g.Go(func() error {
+		_ = h.apiGet(ctx, "/api/v1/reports/budget-history?months=6", &budgetHistory)
+		return nil
+	})
+	_ = g.Wait()
+
+	// While the API is down, show the last dashboard that loaded instead
+	if isBackendUnavailable(accountsErr) && isBackendUnavailable(transactionsErr) && isBackendUnavailable(balancesErr) {
+		h.mu.Lock()
+		data, ok := h.lastDashboard[dashboardKey(r.Context())]
+		h.mu.Unlock()
+		if !ok {
+			h.renderUnavailable(w, r)
+			return
+		}
 
-	// Get data from API
-	if err := h.apiGet("/api/v1/accounts", &accounts); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get accounts: %v", err), http.StatusInternalServerError)
+		data.Stale = true
+		if err := h.templates.ExecuteTemplate(w, "dashboard.html", data); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
 		return
 	}
 
-	if err := h.apiGet("/api/v1/categories", &categories); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get categories: %v", err), http.StatusInternalServerError)
+	data := dashboardData{
+		Accounts:          accounts,
+		Categories:        categories,
+		Transactions:      transactions,
+		Balances:          balances,
+		Budgets:           budgets,
+		BudgetHistory:     budgetHistory,
+		AccountsError:     sectionError("accounts", accountsErr),
+		TransactionsError: sectionError("transactions", transactionsErr),
+		BalancesError:     sectionError("balances", balancesErr),
+		Title:             "Personal Finance Dashboard",
+		CurrentPage:       "dashboard",
+		LoadedAt:          time.Now(),
+	}
+	if accountsErr == nil && transactionsErr == nil && balancesErr == nil {
+		h.mu.Lock()
+		if h.lastDashboard == nil {
+			h.lastDashboard = map[string]dashboardData{}
+		}
+		h.lastDashboard[dashboardKey(r.Context())] = data
+		h.mu.Unlock()
+	}
+
+	if err := h.templates.ExecuteTemplate(w, "dashboard.html", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+}
 
-	if err := h.apiGet("/api/v1/transactions", &transactions); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get transactions: %v", err), http.StatusInternalServerError)
+// pageError answers a page whose data couldn't be loaded, with the backend
+// unavailable page when the API is down
+func (h *Handlers) pageError(w http.ResponseWriter, r *http.Request, message string, err error) {
+	if isBackendUnavailable(err) {
+		h.renderUnavailable(w, r)
 		return
 	}
+	http.Error(w, fmt.Sprintf("%s: %v", message, err), http.StatusInternalServerError)
+}
 
-	if err := h.apiGet("/api/v1/balances", &balances); err != nil {
-		// Don't fail if balances can't be loaded, just use empty slice
-		balances = []BalanceResponse{}
+// renderUnavailable answers with 503 and a page explaining the backend is
+// unavailable, or just the message for HTMX requests, shown as a toast
+func (h *Handlers) renderUnavailable(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("HX-Request") != "" {
+		http.Error(w, "The backend is unavailable, try again in a moment", http.StatusServiceUnavailable)
+		return
 	}
 
+	w.Header().Set("Retry-After", strconv.Itoa(int(breakerCooldown.Seconds())))
+	w.WriteHeader(http.StatusServiceUnavailable)
 	data := struct {
-		Accounts     []AccountResponse
-		Categories   []CategoryResponse
-		Transactions []TransactionResponse
-		Balances     []BalanceResponse
-		Title        string
-		CurrentPage  string
+		Title       string
+		CurrentPage string
 	}{
-		Accounts:     accounts,
-		Categories:   categories,
-		Transactions: transactions,
-		Balances:     balances,
-		Title:        "Personal Finance Dashboard",
-		CurrentPage:  "dashboard",
+		Title:       "Backend Unavailable",
+		CurrentPage: "",
 	}
+	_ = h.templates.ExecuteTemplate(w, "unavailable.html", data)
+}
 
-	if err := h.templates.ExecuteTemplate(w, "dashboard.html", data); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+// sectionError returns a user facing message for a dashboard section that failed to load
+func sectionError(section string, err error) string {
+	if err == nil {
+		return ""
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Sprintf("Loading %s timed out", section)
 	}
+	return fmt.Sprintf("Failed to load %s", section)
 }
 
 // AccountsPage renders the accounts management page
 func (h *Handlers) AccountsPage(w http.ResponseWriter, r *http.Request) {
 	var accounts []AccountResponse
 
-	if err := h.apiGet("/api/v1/accounts", &accounts); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get accounts: %v", err), http.StatusInternalServerError)
+	if err := h.apiGetAll(r.Context(), "/api/v1/accounts", &accounts); err != nil {
+		h.pageError(w, r, "Failed to get accounts", err)
 		return
 	}
 
 	data := struct {
 		Accounts    []AccountResponse
+		Form        formData
 		Title       string
 		CurrentPage string
 	}{
 		Accounts:    accounts,
+		Form:        formData{},
 		Title:       "Manage Accounts",
 		CurrentPage: "accounts",
 	}
@@ -324,56 +799,62 @@ func (h *Handlers) CreateAccount(w http.ResponseWriter, r *http.Request) {
 
 	asset, ok := monetary.FindAssetByName(assetName)
 	if !ok {
-		http.Error(w, "Invalid asset", http.StatusBadRequest)
-		return
-	}
-
-	// Create request payload that matches API expectations
-	requestPayload := struct {
-		Name        string `json:"name"`
-		Type        string `json:"type"`
-		Asset       string `json:"asset"`
-		Description string `json:"description"`
-	}{
-		Name:        r.FormValue("name"),
-		Type:        r.FormValue("type"),
-		Asset:       asset.Asset,
-		Description: r.FormValue("description"),
-	}
-
-	var createdAccount AccountResponse
-	if err := h.apiPost("/api/v1/accounts", requestPayload, &createdAccount); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to create account: %v", err), http.StatusBadRequest)
+		h.renderForm(w, "account-form", formData{
+			Values: r.PostForm,
+			Errors: map[string]string{"asset": "Invalid asset"},
+		})
 		return
 	}
 
-	// Return updated accounts table for HTMX
-	var accounts []AccountResponse
-	if err := h.apiGet("/api/v1/accounts", &accounts); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get accounts: %v", err), http.StatusInternalServerError)
+	closingDay, dueDay, errs := billingCycleFromForm(r)
+	if errs != nil {
+		h.renderForm(w, "account-form", formData{
+			Values: r.PostForm,
+			Errors: errs,
+		})
 		return
 	}
 
-	var balances []BalanceResponse
-	if err := h.apiGet("/api/v1/balances", &balances); err != nil {
-		// Don't fail if balances can't be loaded, just use empty slice
-		balances = []BalanceResponse{}
-	}
-
-	data := struct {
-		Accounts []AccountResponse
-		Balances []BalanceResponse
+	// Create request payload that matches API expectations
+	requestPayload := struct {
+		Name                string `json:"name"`
+		Type                string `json:"type"`
+		Asset               string `json:"asset"`
+		Description         string `json:"description"`
+		Classification      string `json:"classification,omitempty"`
+		Institution         string `json:"institution,omitempty"`
+		AccountNumberLast4  string `json:"account_number_last4,omitempty"`
+		Color               string `json:"color,omitempty"`
+		Icon                string `json:"icon,omitempty"`
+		StatementClosingDay int    `json:"statement_closing_day,omitempty"`
+		PaymentDueDay       int    `json:"payment_due_day,omitempty"`
 	}{
-		Accounts: accounts,
-		Balances: balances,
+		Name:                r.FormValue("name"),
+		Type:                r.FormValue("type"),
+		Asset:               asset.Asset,
+		Description:         r.FormValue("description"),
+		Classification:      r.FormValue("classification"),
+		Institution:         r.FormValue("institution"),
+		AccountNumberLast4:  r.FormValue("account_number_last4"),
+		Color:               r.FormValue("color"),
+		Icon:                r.FormValue("icon"),
+		StatementClosingDay: closingDay,
+		PaymentDueDay:       dueDay,
 	}
 
-	if err := h.templates.ExecuteTemplate(w, "accounts-table.html", data); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	var createdAccount AccountResponse
+	if err := h.apiPost(r.Context(), "/api/v1/accounts", requestPayload, &createdAccount); err != nil {
+		h.renderForm(w, "account-form", formData{
+			Values: r.PostForm,
+			Errors: formErrors(err, accountFormFields),
+		})
 		return
 	}
 
-	w.Header().Set("HX-Trigger", fmt.Sprintf("account-created-%s", createdAccount.ID))
+	// Re-render the whole table, the new account may start a group and
+	// changes its subtotals
+	notify(w, fmt.Sprintf("account-created-%s", createdAccount.ID), toastSuccess, "Account created")
+	h.renderAccountsTable(w, r)
 }
 
 // UpdateAccount handles account updates
@@ -397,52 +878,56 @@ func (h *Handlers) UpdateAccount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	closingDay, dueDay, errs := billingCycleFromForm(r)
+	if errs != nil {
+		http.Error(w, "Invalid billing cycle", http.StatusBadRequest)
+		return
+	}
+
 	// Create request payload that matches API expectations
 	requestPayload := struct {
-		Name        string `json:"name"`
-		Type        string `json:"type"`
-		Asset       string `json:"asset"`
-		Description string `json:"description"`
+		Name                string `json:"name"`
+		Type                string `json:"type"`
+		Asset               string `json:"asset"`
+		Description         string `json:"description"`
+		Classification      string `json:"classification,omitempty"`
+		Institution         string `json:"institution,omitempty"`
+		AccountNumberLast4  string `json:"account_number_last4,omitempty"`
+		Color               string `json:"color,omitempty"`
+		Icon                string `json:"icon,omitempty"`
+		StatementClosingDay int    `json:"statement_closing_day,omitempty"`
+		PaymentDueDay       int    `json:"payment_due_day,omitempty"`
 	}{
-		Name:        r.FormValue("name"),
-		Type:        r.FormValue("type"),
-		Asset:       asset.Asset,
-		Description: r.FormValue("description"),
+		Name:                r.FormValue("name"),
+		Type:                r.FormValue("type"),
+		Asset:               asset.Asset,
+		Description:         r.FormValue("description"),
+		Classification:      r.FormValue("classification"),
+		Institution:         r.FormValue("institution"),
+		AccountNumberLast4:  r.FormValue("account_number_last4"),
+		Color:               r.FormValue("color"),
+		Icon:                r.FormValue("icon"),
+		StatementClosingDay: closingDay,
+		PaymentDueDay:       dueDay,
 	}
 
 	var updatedAccount AccountResponse
-	if err := h.apiPut("/api/v1/accounts/"+id, requestPayload, &updatedAccount); err != nil {
+	if err := h.apiPut(r.Context(), "/api/v1/accounts/"+id, requestPayload, &updatedAccount); err != nil {
 		http.Error(w, fmt.Sprintf("Failed to update account: %v", err), http.StatusBadRequest)
 		return
 	}
 
-	// Return updated accounts table for HTMX
-	var accounts []AccountResponse
-	if err := h.apiGet("/api/v1/accounts", &accounts); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get accounts: %v", err), http.StatusInternalServerError)
-		return
-	}
-
-	var balances []BalanceResponse
-	if err := h.apiGet("/api/v1/balances", &balances); err != nil {
-		// Don't fail if balances can't be loaded, just use empty slice
-		balances = []BalanceResponse{}
-	}
-
-	data := struct {
-		Accounts []AccountResponse
-		Balances []BalanceResponse
-	}{
-		Accounts: accounts,
-		Balances: balances,
+	// Only the balance of the updated account is needed to re-render its row
+	var balance BalanceResponse
+	if err := h.apiGet(r.Context(), "/api/v1/balances/"+id, &balance); err == nil {
+		updatedAccount.Balance = &balance
 	}
 
-	if err := h.templates.ExecuteTemplate(w, "accounts-table.html", data); err != nil {
+	notify(w, fmt.Sprintf("account-updated-%s", updatedAccount.ID), toastSuccess, "Account updated")
+	if err := h.templates.ExecuteTemplate(w, "account-row", updatedAccount); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-
-	w.Header().Set("HX-Trigger", fmt.Sprintf("account-updated-%s", updatedAccount.ID))
 }
 
 // DeleteAccount handles account deletion
@@ -454,49 +939,98 @@ func (h *Handlers) DeleteAccount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.apiDelete("/api/v1/accounts/" + id); err != nil {
+	if err := h.apiDelete(r.Context(), "/api/v1/accounts/"+id); err != nil {
 		http.Error(w, fmt.Sprintf("Failed to delete account: %v", err), http.StatusBadRequest)
 		return
 	}
 
-	// Return updated accounts table for HTMX
-	var accounts []entities.Account
-	if err := h.apiGet("/api/v1/accounts", &accounts); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get accounts: %v", err), http.StatusInternalServerError)
+	// Re-render the whole table so the group subtotals stay in sync
+	notify(w, fmt.Sprintf("account-deleted-%s", id), toastSuccess, "Account deleted")
+	h.renderAccountsTable(w, r)
+}
+
+// FaturasPage renders the faturas of a credit card, with the transactions of
+// the selected one: the month in the query, or else the open fatura
+func (h *Handlers) FaturasPage(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	var account AccountResponse
+	if err := h.apiGet(r.Context(), "/api/v1/accounts/"+id, &account); err != nil {
+		status := http.StatusInternalServerError
+		var apiErr *apiError
+		if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusBadRequest) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, fmt.Sprintf("Failed to get account: %v", err), status)
+		return
+	}
+
+	var faturas []FaturaResponse
+	if err := h.apiGet(r.Context(), "/api/v1/accounts/"+id+"/faturas", &faturas); err != nil {
+		var apiErr *apiError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict {
+			http.Error(w, "The account has no statement closing and payment due days", http.StatusConflict)
+			return
+		}
+		h.pageError(w, r, "Failed to get faturas", err)
 		return
 	}
 
+	month := r.URL.Query().Get("month")
+	if month == "" {
+		for _, fatura := range faturas {
+			if fatura.Status == entities.FaturaStatusOpen {
+				month = fatura.Month
+				break
+			}
+		}
+	}
+
+	var selected FaturaResponse
+	if month != "" {
+		if err := h.apiGet(r.Context(), "/api/v1/accounts/"+id+"/faturas/"+url.PathEscape(month), &selected); err != nil {
+			h.pageError(w, r, "Failed to get fatura", err)
+			return
+		}
+	}
+
 	data := struct {
-		Accounts []entities.Account
-		Balances []entities.Balance
+		Account     AccountResponse
+		Faturas     []FaturaResponse
+		Selected    FaturaResponse
+		Title       string
+		CurrentPage string
 	}{
-		Accounts: accounts,
-		Balances: []entities.Balance{}, // Empty for now due to API issue
+		Account:     account,
+		Faturas:     faturas,
+		Selected:    selected,
+		Title:       account.Name + " Faturas",
+		CurrentPage: "accounts",
 	}
 
-	if err := h.templates.ExecuteTemplate(w, "accounts-table.html", data); err != nil {
+	if err := h.templates.ExecuteTemplate(w, "faturas.html", data); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-
-	w.Header().Set("HX-Trigger", fmt.Sprintf("account-deleted-%s", id))
 }
 
 // CategoriesPage renders the categories management page
 func (h *Handlers) CategoriesPage(w http.ResponseWriter, r *http.Request) {
 	var categories []CategoryResponse
 
-	if err := h.apiGet("/api/v1/categories", &categories); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get categories: %v", err), http.StatusInternalServerError)
+	if err := h.apiGetAll(r.Context(), "/api/v1/categories", &categories); err != nil {
+		h.pageError(w, r, "Failed to get categories", err)
 		return
 	}
 
 	data := struct {
 		Categories  []CategoryResponse
+		Form        formData
 		Title       string
 		CurrentPage string
 	}{
 		Categories:  categories,
+		Form:        formData{},
 		Title:       "Manage Categories",
 		CurrentPage: "categories",
 	}
@@ -515,38 +1049,29 @@ func (h *Handlers) CreateCategory(w http.ResponseWriter, r *http.Request) {
 		Type        string `json:"type"`
 		Color       string `json:"color"`
 		Description string `json:"description"`
+		ParentID    string `json:"parent_id"`
 	}{
 		Name:        r.FormValue("name"),
 		Type:        r.FormValue("type"),
 		Color:       r.FormValue("color"),
 		Description: r.FormValue("description"),
+		ParentID:    r.FormValue("parent_id"),
 	}
 
 	var createdCategory CategoryResponse
-	if err := h.apiPost("/api/v1/categories", requestPayload, &createdCategory); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to create category: %v", err), http.StatusBadRequest)
-		return
-	}
-
-	// Return updated categories table for HTMX
-	var categories []CategoryResponse
-	if err := h.apiGet("/api/v1/categories", &categories); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get categories: %v", err), http.StatusInternalServerError)
+	if err := h.apiPost(r.Context(), "/api/v1/categories", requestPayload, &createdCategory); err != nil {
+		h.renderForm(w, "category-form", formData{
+			Values: r.PostForm,
+			Errors: formErrors(err, categoryFormFields),
+		})
 		return
 	}
 
-	data := struct {
-		Categories []CategoryResponse
-	}{
-		Categories: categories,
-	}
-
-	if err := h.templates.ExecuteTemplate(w, "categories-table.html", data); err != nil {
+	notify(w, fmt.Sprintf("category-created-%s", createdCategory.ID), toastSuccess, "Category created")
+	if err := h.templates.ExecuteTemplate(w, "category-created", createdCategory); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-
-	w.Header().Set("HX-Trigger", fmt.Sprintf("category-created-%s", createdCategory.ID))
 }
 
 // UpdateCategory handles category updates
@@ -564,38 +1089,26 @@ func (h *Handlers) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 		Type        string `json:"type"`
 		Color       string `json:"color"`
 		Description string `json:"description"`
+		ParentID    string `json:"parent_id"`
 	}{
 		Name:        r.FormValue("name"),
 		Type:        r.FormValue("type"),
 		Color:       r.FormValue("color"),
 		Description: r.FormValue("description"),
+		ParentID:    r.FormValue("parent_id"),
 	}
 
 	var updatedCategory CategoryResponse
-	if err := h.apiPut("/api/v1/categories/"+id, requestPayload, &updatedCategory); err != nil {
+	if err := h.apiPut(r.Context(), "/api/v1/categories/"+id, requestPayload, &updatedCategory); err != nil {
 		http.Error(w, fmt.Sprintf("Failed to update category: %v", err), http.StatusBadRequest)
 		return
 	}
 
-	// Return updated categories table for HTMX
-	var categories []CategoryResponse
-	if err := h.apiGet("/api/v1/categories", &categories); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get categories: %v", err), http.StatusInternalServerError)
-		return
-	}
-
-	data := struct {
-		Categories []CategoryResponse
-	}{
-		Categories: categories,
-	}
-
-	if err := h.templates.ExecuteTemplate(w, "categories-table.html", data); err != nil {
+	notify(w, fmt.Sprintf("category-updated-%s", updatedCategory.ID), toastSuccess, "Category updated")
+	if err := h.templates.ExecuteTemplate(w, "category-row", updatedCategory); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-
-	w.Header().Set("HX-Trigger", fmt.Sprintf("category-updated-%s", updatedCategory.ID))
 }
 
 // DeleteCategory handles category deletion
@@ -607,30 +1120,14 @@ func (h *Handlers) DeleteCategory(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.apiDelete("/api/v1/categories/" + id); err != nil {
+	if err := h.apiDelete(r.Context(), "/api/v1/categories/"+id); err != nil {
 		http.Error(w, fmt.Sprintf("Failed to delete category: %v", err), http.StatusBadRequest)
 		return
 	}
 
-	// Return updated categories table for HTMX
-	var categories []CategoryResponse
-	if err := h.apiGet("/api/v1/categories", &categories); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get categories: %v", err), http.StatusInternalServerError)
-		return
-	}
-
-	data := struct {
-		Categories []CategoryResponse
-	}{
-		Categories: categories,
-	}
-
-	if err := h.templates.ExecuteTemplate(w, "categories-table.html", data); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	w.Header().Set("HX-Trigger", fmt.Sprintf("category-deleted-%s", id))
+	// Respond with an empty body so HTMX removes the row in place
+	notify(w, fmt.Sprintf("category-deleted-%s", id), toastSuccess, "Category deleted")
+	w.WriteHeader(http.StatusOK)
 }
 
 // TransactionsPage renders the transactions management page
@@ -639,18 +1136,18 @@ func (h *Handlers) TransactionsPage(w http.ResponseWriter, r *http.Request) {
 	var accounts []AccountResponse
 	var categories []CategoryResponse
 
-	if err := h.apiGet("/api/v1/transactions", &transactions); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get transactions: %v", err), http.StatusInternalServerError)
+	if err := h.apiGetItems(r.Context(), transactionsPath(r), &transactions); err != nil {
+		h.pageError(w, r, "Failed to get transactions", err)
 		return
 	}
 
-	if err := h.apiGet("/api/v1/accounts", &accounts); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get accounts: %v", err), http.StatusInternalServerError)
+	if err := h.apiGetAll(r.Context(), "/api/v1/accounts", &accounts); err != nil {
+		h.pageError(w, r, "Failed to get accounts", err)
 		return
 	}
 
-	if err := h.apiGet("/api/v1/categories", &categories); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get categories: %v", err), http.StatusInternalServerError)
+	if err := h.apiGetAll(r.Context(), "/api/v1/categories", &categories); err != nil {
+		h.pageError(w, r, "Failed to get categories", err)
 		return
 	}
 
@@ -658,12 +1155,16 @@ func (h *Handlers) TransactionsPage(w http.ResponseWriter, r *http.Request) {
 		Transactions []TransactionResponse
 		Accounts     []AccountResponse
 		Categories   []CategoryResponse
+		Form         formData
+		ProjectID    string
 		Title        string
 		CurrentPage  string
 	}{
 		Transactions: transactions,
 		Accounts:     accounts,
 		Categories:   categories,
+		Form:         formData{Accounts: accounts, Categories: categories},
+		ProjectID:    r.URL.Query().Get("project_id"),
 		Title:        "Manage Transactions",
 		CurrentPage:  "transactions",
 	}
@@ -674,81 +1175,204 @@ func (h *Handlers) TransactionsPage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateTransaction handles transaction creation
-func (h *Handlers) CreateTransaction(w http.ResponseWriter, r *http.Request) {
-	amountStr := r.FormValue("amount")
-	// Validate amount format by trying to parse it as float
-	if _, err := strconv.ParseFloat(amountStr, 64); err != nil {
-		http.Error(w, "Invalid amount", http.StatusBadRequest)
+// ExportTransactions downloads the transactions from the API as a CSV or
+// Excel file, of the project given in the query when there's one
+func (h *Handlers) ExportTransactions(w http.ResponseWriter, r *http.Request) {
+	query := url.Values{}
+	query.Set("format", r.URL.Query().Get("format"))
+	if projectID := r.URL.Query().Get("project_id"); projectID != "" {
+		query.Set("project_id", projectID)
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, h.apiBaseURL+"/api/v1/transactions/export?"+query.Encode(), nil)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	setAPIHeaders(req)
 
-	// Validate date format but send as string to match API expectations
-	dateStr := r.FormValue("transaction_date")
-	if _, err := time.Parse("2006-01-02", dateStr); err != nil {
-		http.Error(w, "Invalid date", http.StatusBadRequest)
+	// An export of every transaction may take longer than the other calls
+	// are given, so it's only bounded by the request
+	client := &http.Client{Transport: h.httpClient.Transport}
+	resp, err := client.Do(req)
+	if err != nil {
+		h.pageError(w, r, "Failed to export transactions", fmt.Errorf("failed to call API: %w", err))
 		return
 	}
+	defer resp.Body.Close()
 
-	// Create request payload that matches API expectations
-	requestPayload := struct {
-		AccountID   string                     `json:"account_id"`
-		CategoryID  string                     `json:"category_id"`
-		Amount      string                     `json:"amount"`
-		Description string                     `json:"description"`
-		Date        string                     `json:"date"`
-		Status      entities.TransactionStatus `json:"status"`
-	}{
-		AccountID:   r.FormValue("account_id"),
-		CategoryID:  r.FormValue("category_id"),
-		Amount:      amountStr,
-		Description: r.FormValue("description"),
-		Date:        dateStr,
-		Status:      entities.TransactionStatus(r.FormValue("status")),
+	if resp.StatusCode != http.StatusOK {
+		h.pageError(w, r, "Failed to export transactions", newAPIError(resp))
+		return
 	}
 
-	var createdTransaction TransactionResponse
-	if err := h.apiPost("/api/v1/transactions", requestPayload, &createdTransaction); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to create transaction: %v", err), http.StatusBadRequest)
+	w.Header().Set("Content-Type", resp.Header.Get("Content-Type"))
+	w.Header().Set("Content-Disposition", resp.Header.Get("Content-Disposition"))
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		slog.Error("failed to export transactions", "error", err)
+	}
+}
+
+// AttachmentFile sends the file of an attachment, or its thumbnail, from the
+// API
+func (h *Handlers) AttachmentFile(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	endpoint := "/api/v1/transactions/" + url.PathEscape(vars["id"]) + "/attachments/" + url.PathEscape(vars["attachmentId"])
+	if strings.HasSuffix(r.URL.Path, "/thumbnail") {
+		endpoint += "/thumbnail"
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, h.apiBaseURL+endpoint, nil)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	setAPIHeaders(req)
 
-	// Return updated transactions table for HTMX
-	var transactions []TransactionResponse
-	var accounts []AccountResponse
-	var categories []CategoryResponse
+	// Files are streamed, so like exports they're only bounded by the request
+	client := &http.Client{Transport: h.httpClient.Transport}
+	resp, err := client.Do(req)
+	if err != nil {
+		h.pageError(w, r, "Failed to get attachment", fmt.Errorf("failed to call API: %w", err))
+		return
+	}
+	defer resp.Body.Close()
 
-	if err := h.apiGet("/api/v1/transactions", &transactions); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get transactions: %v", err), http.StatusInternalServerError)
+	if resp.StatusCode == http.StatusNotFound {
+		http.NotFound(w, r)
+		return
+	}
+	if resp.StatusCode != http.StatusOK {
+		h.pageError(w, r, "Failed to get attachment", newAPIError(resp))
 		return
 	}
 
-	if err := h.apiGet("/api/v1/accounts", &accounts); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get accounts: %v", err), http.StatusInternalServerError)
+	for _, header := range []string{"Content-Type", "Content-Disposition", "Cache-Control", "X-Content-Type-Options"} {
+		if value := resp.Header.Get(header); value != "" {
+			w.Header().Set(header, value)
+		}
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		slog.Error("failed to send attachment", "error", err)
+	}
+}
+
+// TransactionPage renders the detail page of a single transaction
+func (h *Handlers) TransactionPage(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+
+	var transaction TransactionResponse
+	if err := h.apiGet(r.Context(), "/api/v1/transactions/"+id+"?include=account,category", &transaction); err != nil {
+		status := http.StatusInternalServerError
+		var apiErr *apiError
+		if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusBadRequest) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, fmt.Sprintf("Failed to get transaction: %v", err), status)
 		return
 	}
 
-	if err := h.apiGet("/api/v1/categories", &categories); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get categories: %v", err), http.StatusInternalServerError)
+	data := transactionDetail{
+		Transaction: transaction,
+		Form:        formData{Values: transactionDetailValues(transaction)},
+		Title:       transaction.Description,
+		CurrentPage: "transactions",
+	}
+
+	if err := h.apiGetAll(r.Context(), "/api/v1/accounts", &data.Form.Accounts); err != nil {
+		h.pageError(w, r, "Failed to get accounts", err)
 		return
 	}
 
-	data := struct {
-		Transactions []TransactionResponse
-		Accounts     []AccountResponse
-		Categories   []CategoryResponse
-	}{
-		Transactions: transactions,
-		Accounts:     accounts,
-		Categories:   categories,
+	if err := h.apiGetAll(r.Context(), "/api/v1/categories", &data.Form.Categories); err != nil {
+		h.pageError(w, r, "Failed to get categories", err)
+		return
 	}
 
-	if err := h.templates.ExecuteTemplate(w, "transactions-table.html", data); err != nil {
+	if err := h.templates.ExecuteTemplate(w, "transaction.html", data); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+}
+
+// CreateTransaction handles transaction creation
+func (h *Handlers) CreateTransaction(w http.ResponseWriter, r *http.Request) {
+	amountStr := r.FormValue("amount")
+	// Validate amount format by trying to parse it as float
+	if _, err := strconv.ParseFloat(amountStr, 64); err != nil {
+		h.renderForm(w, "transaction-form", h.transactionFormData(r, map[string]string{"amount": "Invalid amount"}))
+		return
+	}
+
+	// Validate date format but send as string to match API expectations
+	dateStr := r.FormValue("transaction_date")
+	if _, err := time.Parse("2006-01-02", dateStr); err != nil {
+		h.renderForm(w, "transaction-form", h.transactionFormData(r, map[string]string{"transaction_date": "Invalid date"}))
+		return
+	}
+
+	// A purchase paid in installments is spread over the next faturas
+	installments := 1
+	if value := r.FormValue("installments"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			h.renderForm(w, "transaction-form", h.transactionFormData(r, map[string]string{"installments": "Invalid number of installments"}))
+			return
+		}
+		installments = n
+	}
+
+	// Create request payload that matches API expectations
+	requestPayload := struct {
+		AccountID    string                     `json:"account_id"`
+		CategoryID   string                     `json:"category_id"`
+		Amount       string                     `json:"amount"`
+		Description  string                     `json:"description"`
+		Payee        string                     `json:"payee"`
+		Date         string                     `json:"date"`
+		Status       entities.TransactionStatus `json:"status"`
+		Installments int                        `json:"installments,omitempty"`
+	}{
+		AccountID:   r.FormValue("account_id"),
+		CategoryID:  r.FormValue("category_id"),
+		Amount:      amountStr,
+		Description: r.FormValue("description"),
+		Payee:       r.FormValue("payee"),
+		Date:        dateStr,
+		Status:      entities.TransactionStatus(r.FormValue("status")),
+	}
+
+	if installments > 1 {
+		requestPayload.Installments = installments
 
-	w.Header().Set("HX-Trigger", fmt.Sprintf("transaction-created-%s", createdTransaction.ID))
+		var plan InstallmentPlanResponse
+		if err := h.apiPost(r.Context(), "/api/v1/transactions/installments?include=account,category", requestPayload, &plan); err != nil {
+			h.renderForm(w, "transaction-form", h.transactionFormData(r, formErrors(err, transactionFormFields)))
+			return
+		}
+
+		notify(w, fmt.Sprintf("installments-created-%s", plan.ID), toastSuccess, fmt.Sprintf("%d installments created", len(plan.Installments)))
+		if err := h.templates.ExecuteTemplate(w, "installments-created", plan.Installments); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	var createdTransaction TransactionResponse
+	if err := h.apiPost(r.Context(), "/api/v1/transactions?include=account,category", requestPayload, &createdTransaction); err != nil {
+		h.renderForm(w, "transaction-form", h.transactionFormData(r, formErrors(err, transactionFormFields)))
+		return
+	}
+
+	if len(createdTransaction.BudgetWarnings) > 0 {
+		notifyBudgetWarnings(w, fmt.Sprintf("transaction-created-%s", createdTransaction.ID), budgetWarningMessages(createdTransaction.BudgetWarnings))
+	} else {
+		notify(w, fmt.Sprintf("transaction-created-%s", createdTransaction.ID), toastSuccess, "Transaction created")
+	}
+	if err := h.templates.ExecuteTemplate(w, "transaction-created", createdTransaction); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 }
 
 // UpdateTransaction handles transaction updates
@@ -780,59 +1404,101 @@ func (h *Handlers) UpdateTransaction(w http.ResponseWriter, r *http.Request) {
 		CategoryID  string                     `json:"category_id"`
 		Amount      string                     `json:"amount"`
 		Description string                     `json:"description"`
+		Payee       string                     `json:"payee"`
 		Date        string                     `json:"date"`
 		Status      entities.TransactionStatus `json:"status"`
+		ProjectID   string                     `json:"project_id"`
 	}{
 		AccountID:   r.FormValue("account_id"),
 		CategoryID:  r.FormValue("category_id"),
 		Amount:      amountStr,
 		Description: r.FormValue("description"),
+		Payee:       r.FormValue("payee"),
 		Date:        dateStr,
 		Status:      entities.TransactionStatus(r.FormValue("status")),
+		ProjectID:   r.FormValue("project_id"),
 	}
 
 	var updatedTransaction TransactionResponse
-	if err := h.apiPut("/api/v1/transactions/"+id, requestPayload, &updatedTransaction); err != nil {
+	if err := h.apiPut(r.Context(), "/api/v1/transactions/"+id+"?include=account,category", requestPayload, &updatedTransaction); err != nil {
 		http.Error(w, fmt.Sprintf("Failed to update transaction: %v", err), http.StatusBadRequest)
 		return
 	}
 
-	// Return updated transactions table for HTMX
-	var transactions []TransactionResponse
-	var accounts []AccountResponse
-	var categories []CategoryResponse
+	notify(w, fmt.Sprintf("transaction-updated-%s", updatedTransaction.ID), toastSuccess, "Transaction updated")
+	if err := h.templates.ExecuteTemplate(w, "transaction-row", updatedTransaction); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
 
-	if err := h.apiGet("/api/v1/transactions", &transactions); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get transactions: %v", err), http.StatusInternalServerError)
+// UpdateTransactionDetail saves the edit form of the detail page and re-renders it
+// along with the summary
+func (h *Handlers) UpdateTransactionDetail(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form data", http.StatusBadRequest)
 		return
 	}
 
-	if err := h.apiGet("/api/v1/accounts", &accounts); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get accounts: %v", err), http.StatusInternalServerError)
+	invalid := func(errs map[string]string) {
+		h.renderForm(w, "transaction-detail-form", transactionDetail{
+			Transaction: TransactionResponse{ID: id},
+			Form:        h.transactionFormData(r, errs),
+		})
+	}
+
+	amountStr := r.FormValue("amount")
+	if _, err := strconv.ParseFloat(amountStr, 64); err != nil {
+		invalid(map[string]string{"amount": "Invalid amount"})
 		return
 	}
 
-	if err := h.apiGet("/api/v1/categories", &categories); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get categories: %v", err), http.StatusInternalServerError)
+	dateStr := r.FormValue("transaction_date")
+	if _, err := time.Parse("2006-01-02", dateStr); err != nil {
+		invalid(map[string]string{"transaction_date": "Invalid date"})
 		return
 	}
 
-	data := struct {
-		Transactions []TransactionResponse
-		Accounts     []AccountResponse
-		Categories   []CategoryResponse
+	requestPayload := struct {
+		AccountID   string                     `json:"account_id"`
+		CategoryID  string                     `json:"category_id"`
+		Amount      string                     `json:"amount"`
+		Description string                     `json:"description"`
+		Payee       string                     `json:"payee"`
+		Date        string                     `json:"date"`
+		Status      entities.TransactionStatus `json:"status"`
+		ProjectID   string                     `json:"project_id"`
 	}{
-		Transactions: transactions,
-		Accounts:     accounts,
-		Categories:   categories,
+		AccountID:   r.FormValue("account_id"),
+		CategoryID:  r.FormValue("category_id"),
+		Amount:      amountStr,
+		Description: r.FormValue("description"),
+		Payee:       r.FormValue("payee"),
+		Date:        dateStr,
+		Status:      entities.TransactionStatus(r.FormValue("status")),
+		ProjectID:   r.FormValue("project_id"),
 	}
 
-	if err := h.templates.ExecuteTemplate(w, "transactions-table.html", data); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	var updatedTransaction TransactionResponse
+	if err := h.apiPut(r.Context(), "/api/v1/transactions/"+id+"?include=account,category", requestPayload, &updatedTransaction); err != nil {
+		invalid(formErrors(err, transactionFormFields))
 		return
 	}
 
-	w.Header().Set("HX-Trigger", fmt.Sprintf("transaction-updated-%s", updatedTransaction.ID))
+	data := h.transactionFormData(r, nil)
+	data.Values = transactionDetailValues(updatedTransaction)
+	detail := transactionDetail{Transaction: updatedTransaction, Form: data, SwapOOB: true}
+
+	notify(w, fmt.Sprintf("transaction-updated-%s", updatedTransaction.ID), toastSuccess, "Transaction updated")
+	if err := h.templates.ExecuteTemplate(w, "transaction-detail-form", detail); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := h.templates.ExecuteTemplate(w, "transaction-summary", detail); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 }
 
 // DeleteTransaction handles transaction deletion
@@ -844,70 +1510,594 @@ func (h *Handlers) DeleteTransaction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.apiDelete("/api/v1/transactions/" + id); err != nil {
+	if err := h.apiDelete(r.Context(), "/api/v1/transactions/"+id); err != nil {
 		http.Error(w, fmt.Sprintf("Failed to delete transaction: %v", err), http.StatusBadRequest)
 		return
 	}
 
-	// Return updated transactions table for HTMX
-	var transactions []TransactionResponse
-	var accounts []AccountResponse
-	var categories []CategoryResponse
+	// Respond with an empty body so HTMX removes the row in place
+	notify(w, fmt.Sprintf("transaction-deleted-%s", id), toastSuccess, "Transaction deleted")
+	w.WriteHeader(http.StatusOK)
+}
 
-	if err := h.apiGet("/api/v1/transactions", &transactions); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get transactions: %v", err), http.StatusInternalServerError)
+// DuplicateTransaction copies a transaction to the user's default date and adds it
+// to the top of the table
+func (h *Handlers) DuplicateTransaction(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+	if id == "" {
+		http.Error(w, "Invalid transaction ID", http.StatusBadRequest)
 		return
 	}
 
-	if err := h.apiGet("/api/v1/accounts", &accounts); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get accounts: %v", err), http.StatusInternalServerError)
+	var transaction TransactionResponse
+	if err := h.apiPost(r.Context(), "/api/v1/transactions/"+id+"/duplicate?include=account,category", struct{}{}, &transaction); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to duplicate transaction: %v", err), http.StatusBadRequest)
 		return
 	}
 
-	if err := h.apiGet("/api/v1/categories", &categories); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get categories: %v", err), http.StatusInternalServerError)
+	notify(w, fmt.Sprintf("transaction-created-%s", transaction.ID), toastSuccess, "Transaction duplicated")
+	if err := h.templates.ExecuteTemplate(w, "transaction-created", transaction); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+}
+
+// FinalizeTransaction turns a draft into a regular transaction with the default status
+func (h *Handlers) FinalizeTransaction(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+	if id == "" {
+		http.Error(w, "Invalid transaction ID", http.StatusBadRequest)
+		return
+	}
+
+	var transaction TransactionResponse
+	if err := h.apiPost(r.Context(), "/api/v1/transactions/"+id+"/finalize?include=account,category", struct{}{}, &transaction); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to finalize transaction: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	notify(w, fmt.Sprintf("transaction-finalized-%s", transaction.ID), toastSuccess, "Draft finalized")
+	if err := h.templates.ExecuteTemplate(w, "transaction-row", transaction); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// DiscardDraft deletes a draft transaction
+func (h *Handlers) DiscardDraft(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	id := vars["id"]
+	if id == "" {
+		http.Error(w, "Invalid transaction ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.apiPost(r.Context(), "/api/v1/transactions/"+id+"/discard", struct{}{}, nil); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to discard draft: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	// Respond with an empty body so HTMX removes the row in place
+	notify(w, fmt.Sprintf("transaction-discarded-%s", id), toastSuccess, "Draft discarded")
+	w.WriteHeader(http.StatusOK)
+}
+
+// AnalysisPage renders the transactions pivoted by two dimensions, like a
+// spreadsheet pivot table. It starts with the categories by month of the
+// current year.
+func (h *Handlers) AnalysisPage(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	params := url.Values{}
+	params.Set("rows", cmp.Or(query.Get("rows"), "category"))
+	params.Set("cols", cmp.Or(query.Get("cols"), "month"))
+	params.Set("measure", cmp.Or(query.Get("measure"), "sum"))
+	params.Set("from", cmp.Or(query.Get("from"), time.Now().Format("2006")+"-01-01"))
+	if to := query.Get("to"); to != "" {
+		params.Set("to", to)
+	}
+
+	// A bad selection, like the same dimension twice, is shown on the page
+	// to be changed
+	var cube CubeResponse
+	var message string
+	if err := h.apiGet(r.Context(), "/api/v1/reports/cube?"+params.Encode(), &cube); err != nil {
+		var apiErr *apiError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+			h.pageError(w, r, "Failed to get analysis", err)
+			return
+		}
+		message = apiErr.Message
+	}
 
 	data := struct {
-		Transactions []TransactionResponse
-		Accounts     []AccountResponse
-		Categories   []CategoryResponse
+		Params      url.Values
+		Dimensions  []entities.ReportDimension
+		Measures    []entities.ReportMeasure
+		Cube        CubeResponse
+		Error       string
+		Title       string
+		CurrentPage string
 	}{
-		Transactions: transactions,
-		Accounts:     accounts,
-		Categories:   categories,
+		Params:      params,
+		Dimensions:  entities.ReportDimensions,
+		Measures:    entities.ReportMeasures,
+		Cube:        cube,
+		Error:       message,
+		Title:       "Analysis",
+		CurrentPage: "analysis",
 	}
 
-	if err := h.templates.ExecuteTemplate(w, "transactions-table.html", data); err != nil {
+	if err := h.templates.ExecuteTemplate(w, "analysis.html", data); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+}
+
+// SettingsPage renders the admin settings page
+func (h *Handlers) SettingsPage(w http.ResponseWriter, r *http.Request) {
+	var settings SettingsResponse
+
+	if err := h.apiGet(r.Context(), "/api/v1/settings", &settings); err != nil {
+		h.pageError(w, r, "Failed to get settings", err)
+		return
+	}
+
+	var preferences UserSettingsResponse
+	if err := h.apiGet(r.Context(), "/api/v1/user-settings", &preferences); err != nil {
+		h.pageError(w, r, "Failed to get user settings", err)
+		return
+	}
+
+	data := struct {
+		Form        settingsForm
+		Preferences preferencesForm
+		Title       string
+		CurrentPage string
+	}{
+		Form:        settingsForm{Settings: settings},
+		Preferences: preferencesForm{Settings: preferences.Settings},
+		Title:       "Settings",
+		CurrentPage: "settings",
+	}
 
-	w.Header().Set("HX-Trigger", fmt.Sprintf("transaction-deleted-%s", id))
+	if err := h.templates.ExecuteTemplate(w, "settings.html", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// UpdateSettings handles settings updates
+func (h *Handlers) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	submitted := settingsFromForm(r.PostForm)
+
+	fiscalMonthStartDay, err := strconv.Atoi(r.FormValue("fiscal_month_start_day"))
+	if err != nil {
+		h.renderForm(w, "settings-form", settingsForm{
+			Settings: submitted,
+			Errors:   map[string]string{"fiscal_month_start_day": "Invalid fiscal month start day"},
+		})
+		return
+	}
+	submitted.FiscalMonthStartDay = fiscalMonthStartDay
+
+	// Create request payload that matches API expectations
+	requestPayload := struct {
+		Currency             string            `json:"currency"`
+		Locale               string            `json:"locale"`
+		FiscalMonthStartDay  int               `json:"fiscal_month_start_day"`
+		NotificationsEnabled bool              `json:"notifications_enabled"`
+		NotificationEmail    string            `json:"notification_email"`
+		APIKeys              map[string]string `json:"api_keys"`
+	}{
+		Currency:             submitted.Currency,
+		Locale:               submitted.Locale,
+		FiscalMonthStartDay:  submitted.FiscalMonthStartDay,
+		NotificationsEnabled: submitted.NotificationsEnabled,
+		NotificationEmail:    submitted.NotificationEmail,
+		APIKeys:              submitted.APIKeys,
+	}
+
+	var updatedSettings SettingsResponse
+	if err := h.apiPut(r.Context(), "/api/v1/settings", requestPayload, &updatedSettings); err != nil {
+		h.renderForm(w, "settings-form", settingsForm{
+			Settings: submitted,
+			Errors:   formErrors(err, settingsFormFields),
+		})
+		return
+	}
+
+	notify(w, "settings-updated", toastSuccess, "Settings saved")
+	if err := h.templates.ExecuteTemplate(w, "settings-form", settingsForm{Settings: updatedSettings}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// UpdatePreferences handles user preference updates
+func (h *Handlers) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	// Only the known preferences are edited from the web, custom keys are left untouched
+	submitted := map[string]string{}
+	for _, key := range []string{"base_currency", "locale", "timezone", "first_day_of_week", "dashboard_layout"} {
+		submitted[key] = strings.TrimSpace(r.PostForm.Get(key))
+	}
+
+	// Create request payload that matches API expectations
+	requestPayload := struct {
+		Settings map[string]string `json:"settings"`
+	}{
+		Settings: submitted,
+	}
+
+	var updatedPreferences UserSettingsResponse
+	if err := h.apiPut(r.Context(), "/api/v1/user-settings", requestPayload, &updatedPreferences); err != nil {
+		h.renderForm(w, "preferences-form", preferencesForm{
+			Settings: submitted,
+			Errors:   formErrors(err, preferencesFormFields),
+		})
+		return
+	}
+
+	notify(w, "preferences-updated", toastSuccess, "Preferences saved")
+	if err := h.templates.ExecuteTemplate(w, "preferences-form", preferencesForm{Settings: updatedPreferences.Settings}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// OnboardingPage renders the first-run wizard: pick the base currency, the
+// categories to keep and create the first account
+func (h *Handlers) OnboardingPage(w http.ResponseWriter, r *http.Request) {
+	var status OnboardingStatusResponse
+	if err := h.apiGet(r.Context(), "/api/v1/onboarding/status", &status); err != nil {
+		h.pageError(w, r, "Failed to get onboarding status", err)
+		return
+	}
+	if status.Completed {
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+		return
+	}
+
+	var preferences UserSettingsResponse
+	if err := h.apiGet(r.Context(), "/api/v1/user-settings", &preferences); err != nil {
+		h.pageError(w, r, "Failed to get user settings", err)
+		return
+	}
+
+	form := onboardingForm{
+		Values: url.Values{
+			"base_currency": {preferences.Settings["base_currency"]},
+			"type":          {string(entities.AccountTypeChecking)},
+		},
+		Selected: map[string]bool{},
+	}
+	if err := h.apiGetAll(r.Context(), "/api/v1/categories", &form.Categories); err != nil {
+		h.pageError(w, r, "Failed to get categories", err)
+		return
+	}
+	// Every category starts selected, unticking one removes it
+	for _, category := range form.Categories {
+		form.Selected[category.ID] = true
+	}
+
+	data := struct {
+		Form        onboardingForm
+		Title       string
+		CurrentPage string
+	}{
+		Form:        form,
+		Title:       "Welcome",
+		CurrentPage: "onboarding",
+	}
+
+	if err := h.templates.ExecuteTemplate(w, "onboarding.html", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// CompleteOnboarding saves the wizard steps in order and sends the user to the
+// dashboard. A failing step re-renders the wizard with the error, the steps
+// already saved are kept.
+func (h *Handlers) CompleteOnboarding(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form := onboardingForm{Values: r.PostForm, Selected: map[string]bool{}}
+	for _, id := range r.PostForm["category_id"] {
+		form.Selected[id] = true
+	}
+	invalid := func(errs map[string]string) {
+		// Categories are best effort, the inline errors are still shown without them
+		_ = h.apiGetAll(r.Context(), "/api/v1/categories", &form.Categories)
+		form.Errors = errs
+		h.renderForm(w, "onboarding-form", form)
+	}
+
+	// Step 1: base currency, also used as the asset of the first account
+	currency := r.PostForm.Get("base_currency")
+	asset, ok := monetary.FindAssetByName(currency)
+	if !ok {
+		invalid(map[string]string{"base_currency": "Invalid currency"})
+		return
+	}
+
+	preferences := struct {
+		Settings map[string]string `json:"settings"`
+	}{
+		Settings: map[string]string{"base_currency": asset.Asset},
+	}
+	if err := h.apiPut(r.Context(), "/api/v1/user-settings", preferences, nil); err != nil {
+		invalid(formErrors(err, onboardingFormFields))
+		return
+	}
+
+	// Step 2: remove the categories that were unticked, keeping at least one of each type
+	var categories []CategoryResponse
+	if err := h.apiGetAll(r.Context(), "/api/v1/categories", &categories); err != nil {
+		invalid(map[string]string{"form": err.Error()})
+		return
+	}
+
+	kept := map[entities.CategoryType]bool{}
+	for _, category := range categories {
+		if form.Selected[category.ID] {
+			kept[category.Type] = true
+		}
+	}
+	if !kept[entities.CategoryTypeIncome] || !kept[entities.CategoryTypeExpense] {
+		invalid(map[string]string{"categories": "Keep at least one income and one expense category"})
+		return
+	}
+
+	for _, category := range categories {
+		if form.Selected[category.ID] {
+			continue
+		}
+		if err := h.apiDelete(r.Context(), "/api/v1/categories/"+category.ID); err != nil {
+			invalid(map[string]string{"categories": fmt.Sprintf("Failed to remove %s: %v", category.Name, err)})
+			return
+		}
+	}
+
+	// Step 3: the first account, which completes the onboarding
+	account := struct {
+		Name        string `json:"name"`
+		Type        string `json:"type"`
+		Asset       string `json:"asset"`
+		Description string `json:"description"`
+	}{
+		Name:        r.PostForm.Get("name"),
+		Type:        r.PostForm.Get("type"),
+		Asset:       asset.Asset,
+		Description: r.PostForm.Get("description"),
+	}
+	if err := h.apiPost(r.Context(), "/api/v1/accounts", account, nil); err != nil {
+		invalid(formErrors(err, onboardingFormFields))
+		return
+	}
+
+	w.Header().Set("HX-Redirect", "/")
+	w.WriteHeader(http.StatusOK)
+}
+
+// StartDemo loads the sample data and sends the user to the dashboard
+func (h *Handlers) StartDemo(w http.ResponseWriter, r *http.Request) {
+	if err := h.apiPost(r.Context(), "/api/v1/demo", struct{}{}, nil); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to load sample data: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("HX-Redirect", "/")
+	w.WriteHeader(http.StatusOK)
+}
+
+// ResetDemo throws away the changes made to the sample data by loading it again
+func (h *Handlers) ResetDemo(w http.ResponseWriter, r *http.Request) {
+	if err := h.apiDelete(r.Context(), "/api/v1/demo"); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to reset sample data: %v", err), http.StatusBadRequest)
+		return
+	}
+	if err := h.apiPost(r.Context(), "/api/v1/demo", struct{}{}, nil); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to reset sample data: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("HX-Redirect", "/")
+	w.WriteHeader(http.StatusOK)
+}
+
+// StopDemo deletes the sample data, the dashboard then sends the user to the
+// onboarding wizard
+func (h *Handlers) StopDemo(w http.ResponseWriter, r *http.Request) {
+	if err := h.apiDelete(r.Context(), "/api/v1/demo"); err != nil {
+		http.Error(w, fmt.Sprintf("Failed to exit demo: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("HX-Redirect", "/")
+	w.WriteHeader(http.StatusOK)
+}
+
+// DemoBanner renders the demo banner partial for HTMX, empty unless the sample
+// data is loaded
+func (h *Handlers) DemoBanner(w http.ResponseWriter, r *http.Request) {
+	var status DemoStatusResponse
+
+	// Don't fail the page if the status can't be loaded, just hide the banner
+	_ = h.apiGet(r.Context(), "/api/v1/demo", &status)
+
+	if err := h.templates.ExecuteTemplate(w, "demo-banner", status); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// usageRow is a count of the usage card, with the quota it's held to when
+// there's one
+type usageRow struct {
+	Label string
+	Used  int
+	Limit int
+}
+
+// Percent is how much of the quota is used
+func (r usageRow) Percent() float64 {
+	if r.Limit == 0 {
+		return 0
+	}
+	return float64(r.Used) / float64(r.Limit) * 100
+}
+
+// megabytes rounds a size up to whole megabytes, so a few bytes don't show as
+// none
+func megabytes(bytes int64) int {
+	return int((bytes + 1<<20 - 1) >> 20)
+}
+
+// UsageCard renders the counts of what the user created and the API calls
+// they made this month, next to their quotas
+func (h *Handlers) UsageCard(w http.ResponseWriter, r *http.Request) {
+	var usage UsageResponse
+	data := struct {
+		Tier     string
+		ResetsAt string
+		Rows     []usageRow
+	}{}
+
+	// Don't fail the page if the usage can't be loaded, as without signing
+	// in, just hide the card
+	if err := h.apiGet(r.Context(), "/api/v1/usage", &usage); err == nil {
+		data.Tier = usage.Tier
+		data.ResetsAt = usage.ResetsAt
+		data.Rows = []usageRow{
+			{Label: "Books", Used: usage.Books},
+			{Label: "Accounts", Used: usage.Accounts, Limit: usage.Limits.Accounts},
+			{Label: "Categories", Used: usage.Categories},
+			{Label: "Budgets", Used: usage.Budgets},
+			{Label: "Transactions", Used: usage.Transactions},
+			{Label: "Transactions This Month", Used: usage.MonthlyTransactions, Limit: usage.Limits.MonthlyTransactions},
+			{Label: "API Calls This Month", Used: usage.MonthlyAPICalls, Limit: usage.Limits.MonthlyAPICalls},
+			{Label: "Attachments (MB)", Used: megabytes(usage.AttachmentBytes), Limit: megabytes(usage.Limits.AttachmentBytes)},
+		}
+	}
+
+	if err := h.templates.ExecuteTemplate(w, "usage-card", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+const (
+	// bookCookie remembers the book picked in the switcher
+	bookCookie = "book_id"
+	// bookHeader picks the book of the API requests
+	bookHeader = "X-Book-Id"
+)
+
+// bookScope scopes the request context to the book picked in the switcher,
+// which the API helpers forward. The default book is used until one is picked.
+func bookScope(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie(bookCookie); err == nil {
+			if id, err := uuid.FromString(cookie.Value); err == nil {
+				r = r.WithContext(domain.WithBook(r.Context(), id.String()))
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// BookSwitcher renders the book switcher partial for HTMX. A picked book that
+// no longer exists is forgotten, so the next page falls back to the user's
+// default book.
+func (h *Handlers) BookSwitcher(w http.ResponseWriter, r *http.Request) {
+	var books []BookResponse
+
+	// Don't fail the page if the books can't be loaded, just hide the switcher
+	_ = h.apiGet(r.Context(), "/api/v1/books", &books)
+
+	current := domain.BookFromContext(r.Context())
+	if len(books) > 0 && !slices.ContainsFunc(books, func(book BookResponse) bool { return book.ID == current }) {
+		clearCookie(w, bookCookie)
+		if i := slices.IndexFunc(books, func(book BookResponse) bool { return book.Default }); i >= 0 {
+			current = books[i].ID
+		}
+	}
+
+	data := struct {
+		Books   []BookResponse
+		Current string
+	}{books, current}
+	if err := h.templates.ExecuteTemplate(w, "book-switcher", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// SwitchBook remembers the book picked in the switcher and reloads the page to
+// show it
+func (h *Handlers) SwitchBook(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(r.FormValue("book_id"))
+	if err != nil {
+		http.Error(w, "Invalid book", http.StatusBadRequest)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     bookCookie,
+		Value:    id.String(),
+		Path:     "/",
+		MaxAge:   365 * 24 * 60 * 60,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	w.Header().Set("HX-Refresh", "true")
+	w.WriteHeader(http.StatusOK)
 }
 
 // AccountsTable renders the accounts table partial for HTMX
 func (h *Handlers) AccountsTable(w http.ResponseWriter, r *http.Request) {
-	var accounts []AccountResponse
-	var balances []BalanceResponse
+	h.renderAccountsTable(w, r)
+}
 
-	if err := h.apiGet("/api/v1/accounts", &accounts); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get accounts: %v", err), http.StatusInternalServerError)
-		return
+// accountGroupings lists the groupings offered on the accounts page, the
+// first one being the default
+var accountGroupings = []string{"institution,type", "institution", "type"}
+
+// renderAccountsTable renders the accounts grouped as selected in the
+// "group" form value, with the subtotal balances of each group
+func (h *Handlers) renderAccountsTable(w http.ResponseWriter, r *http.Request) {
+	group := r.FormValue("group")
+	if !slices.Contains(accountGroupings, group) {
+		group = accountGroupings[0]
 	}
 
-	if err := h.apiGet("/api/v1/balances", &balances); err != nil {
-		// Don't fail if balances can't be loaded, just use empty slice
-		balances = []BalanceResponse{}
+	var groups []BalanceGroupResponse
+	if err := h.apiGet(r.Context(), "/api/v1/balances/grouped?by="+url.QueryEscape(group), &groups); err != nil {
+		h.pageError(w, r, "Failed to get accounts", err)
+		return
 	}
 
 	data := struct {
-		Accounts []AccountResponse
-		Balances []BalanceResponse
+		Groups        []BalanceGroupResponse
+		ByInstitution bool
 	}{
-		Accounts: accounts,
-		Balances: balances,
+		Groups:        groups,
+		ByInstitution: strings.Contains(group, "institution"),
 	}
 
 	if err := h.templates.ExecuteTemplate(w, "accounts-table.html", data); err != nil {
@@ -920,8 +2110,8 @@ func (h *Handlers) AccountsTable(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) CategoriesTable(w http.ResponseWriter, r *http.Request) {
 	var categories []CategoryResponse
 
-	if err := h.apiGet("/api/v1/categories", &categories); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get categories: %v", err), http.StatusInternalServerError)
+	if err := h.apiGetAll(r.Context(), "/api/v1/categories", &categories); err != nil {
+		h.pageError(w, r, "Failed to get categories", err)
 		return
 	}
 
@@ -937,35 +2127,36 @@ func (h *Handlers) CategoriesTable(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// transactionsPath lists the transactions with their account and category,
+// the ones matching the search in q or of the project given in the query
+// when there's one
+func transactionsPath(r *http.Request) string {
+	if q := strings.TrimSpace(r.URL.Query().Get("q")); q != "" {
+		return "/api/v1/transactions/search?include=account,category&q=" + url.QueryEscape(q)
+	}
+	path := "/api/v1/transactions?include=account,category"
+	if projectID := r.URL.Query().Get("project_id"); projectID != "" {
+		path += "&project_id=" + url.QueryEscape(projectID)
+	}
+	return path
+}
+
 // TransactionsTable renders the transactions table partial for HTMX
 func (h *Handlers) TransactionsTable(w http.ResponseWriter, r *http.Request) {
 	var transactions []TransactionResponse
-	var accounts []AccountResponse
-	var categories []CategoryResponse
-
-	if err := h.apiGet("/api/v1/transactions", &transactions); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get transactions: %v", err), http.StatusInternalServerError)
-		return
-	}
-
-	if err := h.apiGet("/api/v1/accounts", &accounts); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get accounts: %v", err), http.StatusInternalServerError)
-		return
-	}
 
-	if err := h.apiGet("/api/v1/categories", &categories); err != nil {
-		http.Error(w, fmt.Sprintf("Failed to get categories: %v", err), http.StatusInternalServerError)
+	// Account and category names are embedded in the API response
+	if err := h.apiGetItems(r.Context(), transactionsPath(r), &transactions); err != nil {
+		h.pageError(w, r, "Failed to get transactions", err)
 		return
 	}
 
 	data := struct {
 		Transactions []TransactionResponse
-		Accounts     []AccountResponse
-		Categories   []CategoryResponse
+		Query        string
 	}{
 		Transactions: transactions,
-		Accounts:     accounts,
-		Categories:   categories,
+		Query:        strings.TrimSpace(r.URL.Query().Get("q")),
 	}
 
 	if err := h.templates.ExecuteTemplate(w, "transactions-table.html", data); err != nil {
@@ -978,7 +2169,7 @@ func (h *Handlers) TransactionsTable(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) BalanceSummary(w http.ResponseWriter, r *http.Request) {
 	var balances []BalanceResponse
 
-	if err := h.apiGet("/api/v1/balances", &balances); err != nil {
+	if err := h.apiGetAll(r.Context(), "/api/v1/balances?include=account", &balances); err != nil {
 		// Don't fail if balances can't be loaded, just use empty slice
 		balances = []BalanceResponse{}
 	}
@@ -0,0 +1,230 @@
+package web
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIGetAllFollowsNextLinks(t *testing.T) {
+	t.Chdir("../..")
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("offset") {
+		case "":
+			io.WriteString(w, `{"items": [{"id": "cat-1"}, {"id": "cat-2"}], "total": 3, "next": "/api/v1/categories?limit=2&offset=2"}`)
+		case "2":
+			io.WriteString(w, `{"items": [{"id": "cat-3"}], "total": 3, "prev": "/api/v1/categories?limit=2&offset=0"}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer api.Close()
+
+	h := NewHandlers(api.URL)
+
+	var categories []CategoryResponse
+	require.NoError(t, h.apiGetAll(context.Background(), "/api/v1/categories", &categories))
+	require.Len(t, categories, 3)
+	assert.Equal(t, "cat-3", categories[2].ID)
+
+	categories = nil
+	require.NoError(t, h.apiGetItems(context.Background(), "/api/v1/categories", &categories))
+	assert.Len(t, categories, 2)
+}
+
+func TestAttachmentFile(t *testing.T) {
+	t.Chdir("../..")
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/transactions/tx-1/attachments/att-1":
+			w.Header().Set("Content-Type", "application/pdf")
+			w.Header().Set("Content-Disposition", `inline; filename="receipt.pdf"`)
+			io.WriteString(w, "%PDF-1.4")
+		case "/api/v1/transactions/tx-1/attachments/att-1/thumbnail":
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"error": "thumbnail not found"}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer api.Close()
+
+	router := NewHandlers(api.URL).Router()
+	get := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.AddCookie(&http.Cookie{Name: sessionCookie, Value: "token-alice"})
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get("/transactions/tx-1/attachments/att-1")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/pdf", rec.Header().Get("Content-Type"))
+	assert.Equal(t, `inline; filename="receipt.pdf"`, rec.Header().Get("Content-Disposition"))
+	assert.Equal(t, "%PDF-1.4", rec.Body.String())
+
+	assert.Equal(t, http.StatusNotFound, get("/transactions/tx-1/attachments/att-1/thumbnail").Code)
+}
+
+func TestAnalysisPage(t *testing.T) {
+	t.Chdir("../..")
+
+	var gotQuery url.Values
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		if gotQuery.Get("rows") == gotQuery.Get("cols") {
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `{"error": "cube rows and columns must be different dimensions: malformed parameters"}`)
+			return
+		}
+		io.WriteString(w, `{"rows": "category", "cols": "month", "measure": "sum", "from": "2025-01-01", "to": "2025-04-30", "tables": [{
+			"asset": "BRL",
+			"rows": [{"value": "cat-1", "label": "Groceries"}],
+			"cols": [{"value": "2025-03", "label": "2025-03"}, {"value": "2025-04", "label": "2025-04"}],
+			"values": [[{"sum": "[BRL (R$) -1234.50]"}, null]],
+			"row_totals": [{"sum": "[BRL (R$) -1234.50]"}],
+			"col_totals": [{"sum": "[BRL (R$) -1234.50]"}, {}],
+			"total": {"sum": "[BRL (R$) -1234.50]"}
+		}]}`)
+	}))
+	defer api.Close()
+
+	router := NewHandlers(api.URL).Router()
+	get := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.AddCookie(&http.Cookie{Name: sessionCookie, Value: "token-alice"})
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get("/analysis?from=2025-01-01")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "category", gotQuery.Get("rows"))
+	assert.Equal(t, "month", gotQuery.Get("cols"))
+	assert.Equal(t, "sum", gotQuery.Get("measure"))
+	assert.Contains(t, rec.Body.String(), "Groceries")
+	assert.Contains(t, rec.Body.String(), "-R$1,234.50")
+
+	rec = get("/analysis?rows=payee&cols=payee")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "cube rows and columns must be different dimensions")
+}
+
+func TestTransactionsSearch(t *testing.T) {
+	t.Chdir("../..")
+
+	var gotPath string
+	var gotQuery url.Values
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotQuery = r.URL.Path, r.URL.Query()
+		io.WriteString(w, `{"items": [], "total": 0, "limit": 50, "offset": 0}`)
+	}))
+	defer api.Close()
+
+	router := NewHandlers(api.URL).Router()
+	get := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.AddCookie(&http.Cookie{Name: sessionCookie, Value: "token-alice"})
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get("/htmx/transactions?q=+super+market+")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "/api/v1/transactions/search", gotPath)
+	assert.Equal(t, "super market", gotQuery.Get("q"))
+	assert.Equal(t, "account,category", gotQuery.Get("include"))
+	assert.Contains(t, rec.Body.String(), "super market")
+
+	rec = get("/htmx/transactions?q=+")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "/api/v1/transactions", gotPath)
+}
+
+func TestCreateTransactionBudgetWarnings(t *testing.T) {
+	t.Chdir("../..")
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"id": "tx-1", "amount": "[BRL (R$) -150.00]", "date": "2025-04-10", "status": "cleared",
+			"account": {"id": "acc-1", "name": "Checking"}, "category": {"id": "cat-1", "name": "Groceries"},
+			"budget_warnings": [{"category_name": "Groceries", "month": "2025-04", "limit": "[BRL (R$) 800.00]", "overspent": "[BRL (R$) 100.00]"}]}`)
+	}))
+	defer api.Close()
+
+	router := NewHandlers(api.URL).Router()
+	form := url.Values{
+		"account_id":       {"acc-1"},
+		"category_id":      {"cat-1"},
+		"amount":           {"-150.00"},
+		"transaction_date": {"2025-04-10"},
+		"status":           {"cleared"},
+	}
+	req := httptest.NewRequest(http.MethodPost, "/transactions/create", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.AddCookie(&http.Cookie{Name: sessionCookie, Value: "token-alice"})
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	trigger := rec.Header().Get("HX-Trigger")
+	assert.Contains(t, trigger, `"level":"warning"`)
+	assert.Contains(t, trigger, `"messages":["Groceries is R$100.00 over its R$800.00 budget for 2025-04"]`)
+	assert.Contains(t, rec.Body.String(), "Groceries")
+}
+
+func TestTransactionRowsEmbedAccountAndCategory(t *testing.T) {
+	t.Chdir("../..")
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include") != "account,category" {
+			io.WriteString(w, `{"id": "tx-1", "amount": "[BRL (R$) -150.00]", "date": "2025-04-10", "status": "cleared"}`)
+			return
+		}
+		io.WriteString(w, `{"id": "tx-1", "amount": "[BRL (R$) -150.00]", "date": "2025-04-10", "status": "cleared",
+			"account": {"id": "acc-1", "name": "Checking"}, "category": {"id": "cat-1", "name": "Groceries"}}`)
+	}))
+	defer api.Close()
+
+	router := NewHandlers(api.URL).Router()
+	form := url.Values{
+		"account_id":       {"acc-1"},
+		"category_id":      {"cat-1"},
+		"amount":           {"-150.00"},
+		"transaction_date": {"2025-04-10"},
+		"status":           {"cleared"},
+	}
+	for _, tc := range []struct{ method, path string }{
+		{http.MethodPost, "/transactions/create"},
+		{http.MethodPut, "/transactions/tx-1"},
+		{http.MethodPost, "/transactions/tx-1/duplicate"},
+		{http.MethodPost, "/transactions/tx-1/finalize"},
+	} {
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.AddCookie(&http.Cookie{Name: sessionCookie, Value: "token-alice"})
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+			body := rec.Body.String()
+			assert.Contains(t, body, "Checking")
+			assert.Contains(t, body, "Groceries")
+			assert.NotContains(t, body, "Unknown Account")
+			assert.NotContains(t, body, "Unknown Category")
+		})
+	}
+}
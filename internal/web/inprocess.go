@@ -0,0 +1,78 @@
+package web
+
+import (
+	"bytes"
+	"finance/internal/tracing"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+)
+
+// inProcessBaseURL is the API base URL of the in-process handlers, only its
+// paths reach the API handler
+const inProcessBaseURL = "http://in-process"
+
+// NewInProcessHandlers creates the web handlers calling the API handler in
+// the same process, for serving both from one binary. The API calls skip the
+// network, so there is nothing to retry or break the circuit on.
+func NewInProcessHandlers(api http.Handler) *Handlers {
+	h := NewHandlers(inProcessBaseURL)
+	h.httpClient.Transport = tracing.NewTransport(handlerTransport{handler: api}, slog.Default())
+	return h
+}
+
+// handlerTransport answers the requests by calling a handler directly
+type handlerTransport struct {
+	handler http.Handler
+}
+
+func (t handlerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Handlers expect server requests, which always have a body
+	r := req.Clone(req.Context())
+	if r.Body == nil {
+		r.Body = http.NoBody
+	}
+	r.RequestURI = r.URL.RequestURI()
+	r.RemoteAddr = "in-process"
+
+	w := &bufferedResponse{header: http.Header{}}
+	t.handler.ServeHTTP(w, r)
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	return &http.Response{
+		Status:        strconv.Itoa(w.status) + " " + http.StatusText(w.status),
+		StatusCode:    w.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        w.header,
+		Body:          io.NopCloser(&w.body),
+		ContentLength: int64(w.body.Len()),
+		Request:       req,
+	}, nil
+}
+
+// bufferedResponse keeps a handler's response in memory
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponse) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedResponse) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *bufferedResponse) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(b)
+}
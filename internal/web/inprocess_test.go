@@ -0,0 +1,40 @@
+package web
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerTransport(t *testing.T) {
+	api := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"method": "`+r.Method+`", "uri": "`+r.RequestURI+`", "body": `+string(body)+`}`)
+	})
+	client := &http.Client{Transport: handlerTransport{handler: api}}
+
+	resp, err := client.Post(inProcessBaseURL+"/api/v1/accounts?include=balance", "application/json", strings.NewReader(`{"name": "Checking"}`))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.JSONEq(t, `{"method": "POST", "uri": "/api/v1/accounts?include=balance", "body": {"name": "Checking"}}`, string(body))
+
+	// Handlers that only write a body answer 200
+	client = &http.Client{Transport: handlerTransport{handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "[]")
+	})}}
+	resp, err = client.Get(inProcessBaseURL + "/api/v1/categories")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
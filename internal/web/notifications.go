@@ -0,0 +1,64 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Toast levels understood by the notifications template
+const (
+	toastSuccess = "success"
+	toastError   = "error"
+	toastWarning = "warning"
+)
+
+// toast is the payload of the showToast event rendered by the notifications template
+type toast struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// notify sets the HX-Trigger header with the request specific event (e.g.
+// "account-created-<id>") and a showToast event describing the outcome
+func notify(w http.ResponseWriter, event, level, message string) {
+	trigger, err := json.Marshal(map[string]any{
+		event:       true,
+		"showToast": toast{Level: level, Message: message},
+	})
+	if err != nil {
+		return
+	}
+	w.Header().Set("HX-Trigger", string(trigger))
+}
+
+// budgetWarnings is the payload of the budgetWarnings event the transaction
+// form shows above its fields
+type budgetWarnings struct {
+	Messages []string `json:"messages"`
+}
+
+// notifyBudgetWarnings is notify for a transaction created over budget: the
+// toast warns of it and the budgetWarnings event tells the form which budgets
+func notifyBudgetWarnings(w http.ResponseWriter, event string, messages []string) {
+	trigger, err := json.Marshal(map[string]any{
+		event:            true,
+		"showToast":      toast{Level: toastWarning, Message: "Transaction created over budget"},
+		"budgetWarnings": budgetWarnings{Messages: messages},
+	})
+	if err != nil {
+		return
+	}
+	w.Header().Set("HX-Trigger", string(trigger))
+}
+
+// budgetWarningMessages describes each budget left over, e.g. "Groceries is
+// R$100.00 over its R$800.00 budget for 2025-04"
+func budgetWarningMessages(warnings []BudgetWarningResponse) []string {
+	messages := make([]string, 0, len(warnings))
+	for _, warning := range warnings {
+		messages = append(messages, fmt.Sprintf("%s is %s over its %s budget for %s",
+			warning.CategoryName, formatMoney(warning.Overspent), formatMoney(warning.Limit), warning.Month))
+	}
+	return messages
+}
@@ -0,0 +1,164 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// errBackendUnavailable is returned without calling the API while the
+// circuit breaker is open
+var errBackendUnavailable = errors.New("backend unavailable")
+
+const (
+	// breakerThreshold consecutive failures open the circuit breaker
+	breakerThreshold = 5
+	// breakerCooldown is how long the breaker stays open before letting a
+	// trial request through
+	breakerCooldown = 30 * time.Second
+
+	// retryAttempts is how many times an idempotent request is tried
+	retryAttempts = 3
+	// retryBackoff is the wait before the first retry, doubled on each one
+	retryBackoff = 100 * time.Millisecond
+)
+
+// unavailableStatus reports whether the API answer means it's down or
+// unreachable rather than refusing the request
+func unavailableStatus(code int) bool {
+	return code == http.StatusBadGateway || code == http.StatusServiceUnavailable || code == http.StatusGatewayTimeout
+}
+
+// isBackendUnavailable reports whether an API call failed because the API is
+// down, as opposed to answering with an error
+func isBackendUnavailable(err error) bool {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return unavailableStatus(apiErr.StatusCode)
+	}
+
+	var netErr net.Error
+	return errors.Is(err, errBackendUnavailable) || errors.As(err, &netErr)
+}
+
+// breakerTransport fails fast with errBackendUnavailable after
+// breakerThreshold consecutive failures, instead of making every page wait on
+// a dead API. After breakerCooldown one trial request is let through, closing
+// the breaker again when it succeeds.
+type breakerTransport struct {
+	base http.RoundTripper
+	now  func() time.Time
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	trial     bool
+}
+
+func newBreakerTransport(base http.RoundTripper) *breakerTransport {
+	return &breakerTransport{base: base, now: time.Now}
+}
+
+func (t *breakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.allow() {
+		return nil, errBackendUnavailable
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	// A cancelled page request says nothing about the API
+	if err != nil && req.Context().Err() != nil {
+		t.release()
+		return resp, err
+	}
+	t.record(err == nil && !unavailableStatus(resp.StatusCode))
+	return resp, err
+}
+
+func (t *breakerTransport) allow() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.failures < breakerThreshold {
+		return true
+	}
+	// Open, until the cooldown lets a single trial request through
+	if t.trial || t.now().Before(t.openUntil) {
+		return false
+	}
+	t.trial = true
+	return true
+}
+
+// release ends a trial request without an outcome
+func (t *breakerTransport) release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.trial = false
+}
+
+func (t *breakerTransport) record(ok bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.trial = false
+	if ok {
+		t.failures = 0
+		return
+	}
+	t.failures++
+	if t.failures >= breakerThreshold {
+		t.openUntil = t.now().Add(breakerCooldown)
+	}
+}
+
+// retryTransport retries the idempotent requests that failed because the API
+// was briefly unreachable, backing off with jitter between attempts
+type retryTransport struct {
+	base  http.RoundTripper
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newRetryTransport(base http.RoundTripper) *retryTransport {
+	return &retryTransport{base: base, sleep: sleepContext}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return t.base.RoundTrip(req)
+	}
+
+	backoff := retryBackoff
+	for attempt := 1; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		// An open breaker won't let the retries through either
+		retry := !errors.Is(err, errBackendUnavailable) &&
+			(err != nil || unavailableStatus(resp.StatusCode))
+		if !retry || attempt == retryAttempts || req.Context().Err() != nil {
+			return resp, err
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+		// Jitter keeps the web instances from retrying in lockstep
+		if err := t.sleep(req.Context(), backoff/2+rand.N(backoff/2+1)); err != nil {
+			return nil, err
+		}
+		backoff *= 2
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,172 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func response(code int) *http.Response {
+	return &http.Response{StatusCode: code, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+var errConnectionRefused = errors.New("connection refused")
+
+func TestBreakerTransport(t *testing.T) {
+	now := time.Date(2025, time.April, 30, 12, 0, 0, 0, time.UTC)
+	calls := 0
+	fail := true
+	breaker := newBreakerTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		if fail {
+			return nil, errConnectionRefused
+		}
+		return response(http.StatusOK), nil
+	}))
+	breaker.now = func() time.Time { return now }
+	req := httptest.NewRequest(http.MethodGet, "http://api/api/v1/accounts", nil)
+
+	for range breakerThreshold {
+		_, err := breaker.RoundTrip(req)
+		assert.ErrorIs(t, err, errConnectionRefused)
+	}
+
+	// Open: fails fast without calling the API
+	_, err := breaker.RoundTrip(req)
+	assert.ErrorIs(t, err, errBackendUnavailable)
+	assert.Equal(t, breakerThreshold, calls)
+
+	// After the cooldown a failing trial keeps it open
+	now = now.Add(breakerCooldown)
+	_, err = breaker.RoundTrip(req)
+	assert.ErrorIs(t, err, errConnectionRefused)
+	_, err = breaker.RoundTrip(req)
+	assert.ErrorIs(t, err, errBackendUnavailable)
+
+	// A successful trial closes it
+	now = now.Add(breakerCooldown)
+	fail = false
+	resp, err := breaker.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	_, err = breaker.RoundTrip(req)
+	assert.NoError(t, err)
+}
+
+func TestRetryTransport(t *testing.T) {
+	newRetry := func(responses ...any) (*retryTransport, *int) {
+		calls := 0
+		retry := newRetryTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			next := responses[min(calls, len(responses)-1)]
+			calls++
+			if err, ok := next.(error); ok {
+				return nil, err
+			}
+			return response(next.(int)), nil
+		}))
+		retry.sleep = func(ctx context.Context, d time.Duration) error { return nil }
+		return retry, &calls
+	}
+
+	t.Run("retries reads until they succeed", func(t *testing.T) {
+		retry, calls := newRetry(errConnectionRefused, http.StatusBadGateway, http.StatusOK)
+
+		resp, err := retry.RoundTrip(httptest.NewRequest(http.MethodGet, "http://api/", nil))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("gives up after the last attempt", func(t *testing.T) {
+		retry, calls := newRetry(errConnectionRefused)
+
+		_, err := retry.RoundTrip(httptest.NewRequest(http.MethodGet, "http://api/", nil))
+		assert.ErrorIs(t, err, errConnectionRefused)
+		assert.Equal(t, retryAttempts, *calls)
+	})
+
+	t.Run("doesn't retry errors of the API", func(t *testing.T) {
+		retry, calls := newRetry(http.StatusInternalServerError)
+
+		resp, err := retry.RoundTrip(httptest.NewRequest(http.MethodGet, "http://api/", nil))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("doesn't retry writes", func(t *testing.T) {
+		retry, calls := newRetry(errConnectionRefused)
+
+		_, err := retry.RoundTrip(httptest.NewRequest(http.MethodPost, "http://api/", nil))
+		assert.Error(t, err)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("stops on an open breaker", func(t *testing.T) {
+		retry, calls := newRetry(errBackendUnavailable)
+
+		_, err := retry.RoundTrip(httptest.NewRequest(http.MethodGet, "http://api/", nil))
+		assert.ErrorIs(t, err, errBackendUnavailable)
+		assert.Equal(t, 1, *calls)
+	})
+}
+
+func TestDashboardFallsBackToTheLastOne(t *testing.T) {
+	t.Chdir("../..")
+
+	up := true
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !up {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/onboarding/status":
+			io.WriteString(w, `{"completed": true}`)
+		case "/api/v1/accounts":
+			io.WriteString(w, `{"items": [{"id": "acc-1", "name": "Checking"}], "total": 1}`)
+		case "/api/v1/categories", "/api/v1/transactions", "/api/v1/balances":
+			io.WriteString(w, `{"items": [], "total": 0}`)
+		default:
+			io.WriteString(w, `[]`)
+		}
+	}))
+	defer api.Close()
+
+	h := NewHandlers(api.URL)
+	retry := h.httpClient.Transport.(*retryTransport)
+	retry.sleep = func(ctx context.Context, d time.Duration) error { return nil }
+
+	rec := httptest.NewRecorder()
+	h.Dashboard(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "The backend is unavailable")
+
+	up = false
+	rec = httptest.NewRecorder()
+	h.Dashboard(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "The backend is unavailable")
+	assert.Contains(t, rec.Body.String(), "Showing the dashboard as of")
+
+	// Without a dashboard to fall back to
+	h.lastDashboard = nil
+	rec = httptest.NewRecorder()
+	h.Dashboard(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Try again")
+}
@@ -0,0 +1,280 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// sessionCookie holds the API token of the signed in user
+	sessionCookie = "session"
+	// authHeader carries the API token
+	authHeader = "Authorization"
+)
+
+// AuthResponse is the token the API signs users in with
+type AuthResponse struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type loginData struct {
+	Title    string
+	Register bool
+	Email    string
+	Name     string
+	Error    string
+	Captcha  *captchaWidget
+}
+
+// captchaWidget is the CAPTCHA of a provider, answering in the form field
+// Field
+type captchaWidget struct {
+	Script  string
+	Class   string
+	Field   string
+	SiteKey string
+}
+
+// captchaProviders are the widgets of the CAPTCHA providers whose siteverify
+// API the service checks the answers with
+var captchaProviders = map[string]captchaWidget{
+	"turnstile": {Script: "https://challenges.cloudflare.com/turnstile/v0/api.js", Class: "cf-turnstile", Field: "cf-turnstile-response"},
+	"hcaptcha":  {Script: "https://js.hcaptcha.com/1/api.js", Class: "h-captcha", Field: "h-captcha-response"},
+	"recaptcha": {Script: "https://www.google.com/recaptcha/api.js", Class: "g-recaptcha", Field: "g-recaptcha-response"},
+}
+
+// UseCaptcha shows the CAPTCHA of the provider on the login page when the API
+// asks for one
+func (h *Handlers) UseCaptcha(provider, siteKey string) error {
+	widget, ok := captchaProviders[provider]
+	if !ok {
+		return fmt.Errorf("unknown captcha provider %q", provider)
+	}
+	widget.SiteKey = siteKey
+	h.captcha = &widget
+	return nil
+}
+
+type (
+	sessionKey struct{}
+	clientKey  struct{}
+)
+
+// client is the browser of a page request, told to the API so the sessions
+// it signs in name the device rather than the web app
+type client struct {
+	userAgent    string
+	forwardedFor string
+}
+
+// session is the signed in user of a page request. Expired is set when the
+// API refuses the token, from any of the calls the page makes.
+type session struct {
+	token   string
+	expired atomic.Bool
+}
+
+func sessionFromContext(ctx context.Context) *session {
+	s, _ := ctx.Value(sessionKey{}).(*session)
+	return s
+}
+
+// requireSession sends the visitors without a session to the login page, and
+// the signed in users to it again once the API refuses their token. The
+// response is buffered until then, so it can still turn into a redirect.
+func requireSession(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if publicPath(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		cookie, err := r.Cookie(sessionCookie)
+		if err != nil || cookie.Value == "" {
+			redirectToLogin(w, r)
+			return
+		}
+
+		s := &session{token: cookie.Value}
+		buffered := &bufferedResponse{header: http.Header{}}
+		next.ServeHTTP(buffered, r.WithContext(context.WithValue(r.Context(), sessionKey{}, s)))
+
+		if s.expired.Load() {
+			clearCookie(w, sessionCookie)
+			redirectToLogin(w, r)
+			return
+		}
+
+		for key, values := range buffered.header {
+			w.Header()[key] = values
+		}
+		if buffered.status != 0 {
+			w.WriteHeader(buffered.status)
+		}
+		w.Write(buffered.body.Bytes())
+	})
+}
+
+// forwardClient keeps the browser of the request for the API calls it makes.
+// The peer address is appended to the X-Forwarded-For the request came with,
+// as proxies do.
+func forwardClient(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwardedFor := r.RemoteAddr
+		if host, _, err := net.SplitHostPort(forwardedFor); err == nil {
+			forwardedFor = host
+		}
+		if prior := r.Header.Get("X-Forwarded-For"); prior != "" {
+			forwardedFor = prior + ", " + forwardedFor
+		}
+		c := client{userAgent: r.UserAgent(), forwardedFor: forwardedFor}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientKey{}, c)))
+	})
+}
+
+func publicPath(path string) bool {
+	return path == "/login" || path == "/register" || strings.HasPrefix(path, "/static/")
+}
+
+// redirectToLogin redirects the page, or the whole page of an HTMX partial,
+// to the login page
+func redirectToLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("HX-Request") == "true" {
+		w.Header().Set("HX-Redirect", "/login")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	http.Redirect(w, r, "/login", http.StatusSeeOther)
+}
+
+func clearCookie(w http.ResponseWriter, name string) {
+	http.SetCookie(w, &http.Cookie{Name: name, Path: "/", MaxAge: -1})
+}
+
+// setAPIHeaders authenticates an API request as the signed in user on their
+// browser and picks the book of the switcher. Without a picked book the API
+// uses the user's default book.
+func setAPIHeaders(req *http.Request) {
+	ctx := req.Context()
+	if s := sessionFromContext(ctx); s != nil {
+		req.Header.Set(authHeader, "Bearer "+s.token)
+	}
+	if c, ok := ctx.Value(clientKey{}).(client); ok {
+		req.Header.Set("User-Agent", c.userAgent)
+		req.Header.Set("X-Forwarded-For", c.forwardedFor)
+	}
+	if book := domain.BookFromContext(ctx); book != domain.DefaultBookID {
+		req.Header.Set(bookHeader, book)
+	}
+}
+
+// expireSession flags the session of the request once the API refused its
+// token
+func expireSession(resp *http.Response) {
+	if resp.StatusCode != http.StatusUnauthorized || resp.Request == nil {
+		return
+	}
+	if s := sessionFromContext(resp.Request.Context()); s != nil {
+		s.expired.Store(true)
+	}
+}
+
+// LoginPage renders the login form
+func (h *Handlers) LoginPage(w http.ResponseWriter, r *http.Request) {
+	h.renderLogin(w, loginData{Title: "Sign in"}, http.StatusOK)
+}
+
+// RegisterPage renders the registration form
+func (h *Handlers) RegisterPage(w http.ResponseWriter, r *http.Request) {
+	h.renderLogin(w, loginData{Title: "Create account", Register: true}, http.StatusOK)
+}
+
+// Login signs the user in and starts their session
+func (h *Handlers) Login(w http.ResponseWriter, r *http.Request) {
+	data := loginData{Title: "Sign in", Email: r.FormValue("email")}
+
+	var auth AuthResponse
+	payload := map[string]string{
+		"email":    r.FormValue("email"),
+		"password": r.FormValue("password"),
+	}
+	if h.captcha != nil {
+		payload["captcha"] = r.FormValue(h.captcha.Field)
+	}
+	err := h.apiPost(r.Context(), "/api/v1/auth/login", payload, &auth)
+	h.startSession(w, r, data, auth, err)
+}
+
+// Register creates the user and starts their session
+func (h *Handlers) Register(w http.ResponseWriter, r *http.Request) {
+	data := loginData{Title: "Create account", Register: true, Email: r.FormValue("email"), Name: r.FormValue("name")}
+
+	var auth AuthResponse
+	err := h.apiPost(r.Context(), "/api/v1/auth/register", map[string]string{
+		"email":    r.FormValue("email"),
+		"name":     r.FormValue("name"),
+		"password": r.FormValue("password"),
+	}, &auth)
+	h.startSession(w, r, data, auth, err)
+}
+
+// Logout ends the session. It's revoked on the API too, so the token stops
+// working even if the cookie was copied; the cookies are cleared regardless.
+func (h *Handlers) Logout(w http.ResponseWriter, r *http.Request) {
+	_ = h.apiPost(r.Context(), "/api/v1/auth/logout", nil, nil)
+	clearCookie(w, sessionCookie)
+	clearCookie(w, bookCookie)
+	redirectToLogin(w, r)
+}
+
+// startSession keeps the token of a successful sign in, or shows the form
+// again with the error, and the CAPTCHA when the API asks for one. The book
+// picked by a previous user is forgotten.
+func (h *Handlers) startSession(w http.ResponseWriter, r *http.Request, data loginData, auth AuthResponse, err error) {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) && apiErr.StatusCode < http.StatusInternalServerError {
+		data.Error = apiErr.Message
+		if apiErr.Parameter == "captcha" {
+			data.Captcha = h.captcha
+		}
+		h.renderLogin(w, data, apiErr.StatusCode)
+		return
+	}
+	if err != nil {
+		data.Error = "The backend is unavailable, please try again in a moment."
+		h.renderLogin(w, data, http.StatusBadGateway)
+		return
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, auth.ExpiresAt)
+	if err != nil {
+		expiresAt = time.Now().Add(24 * time.Hour)
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    auth.Token,
+		Path:     "/",
+		Expires:  expiresAt,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+	clearCookie(w, bookCookie)
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
+
+func (h *Handlers) renderLogin(w http.ResponseWriter, data loginData, status int) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := h.templates.ExecuteTemplate(w, "login.html", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,151 @@
+package web
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSession(t *testing.T) {
+	t.Chdir("../..")
+
+	var gotAuth, gotBook, gotUserAgent, gotForwardedFor, loggedOut string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/auth/login":
+			gotUserAgent, gotForwardedFor = r.UserAgent(), r.Header.Get("X-Forwarded-For")
+			body, _ := io.ReadAll(r.Body)
+			if strings.Contains(string(body), `"email":"bob@example.com"`) && !strings.Contains(string(body), `"captcha":"solved"`) {
+				w.WriteHeader(http.StatusUnauthorized)
+				io.WriteString(w, `{"error": "solve the captcha to sign in: captcha required", "parameter": "captcha"}`)
+				return
+			}
+			if !strings.Contains(string(body), `"password":"correct horse"`) {
+				w.WriteHeader(http.StatusUnauthorized)
+				io.WriteString(w, `{"error": "invalid email or password: unauthorized"}`)
+				return
+			}
+			io.WriteString(w, `{"token": "token-alice", "expires_at": "2099-03-16T09:00:00Z"}`)
+		case "/api/v1/auth/logout":
+			loggedOut = r.Header.Get("Authorization")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			gotAuth, gotBook = r.Header.Get("Authorization"), r.Header.Get(bookHeader)
+			if gotAuth != "Bearer token-alice" {
+				w.WriteHeader(http.StatusUnauthorized)
+				io.WriteString(w, `{"error": "token expired: unauthorized"}`)
+				return
+			}
+			io.WriteString(w, `[{"id": "5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b", "name": "Personal", "default": true}]`)
+		}
+	}))
+	defer api.Close()
+
+	handlers := NewHandlers(api.URL)
+	require.NoError(t, handlers.UseCaptcha("turnstile", "site-key"))
+	router := handlers.Router()
+	serve := func(req *http.Request, token string) *httptest.ResponseRecorder {
+		if token != "" {
+			req.AddCookie(&http.Cookie{Name: sessionCookie, Value: token})
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+	cookie := func(rec *httptest.ResponseRecorder, name string) *http.Cookie {
+		for _, c := range rec.Result().Cookies() {
+			if c.Name == name {
+				return c
+			}
+		}
+		return nil
+	}
+
+	t.Run("pages require a session", func(t *testing.T) {
+		rec := serve(httptest.NewRequest(http.MethodGet, "/htmx/book-switcher", nil), "")
+		assert.Equal(t, http.StatusSeeOther, rec.Code)
+		assert.Equal(t, "/login", rec.Header().Get("Location"))
+
+		req := httptest.NewRequest(http.MethodGet, "/htmx/book-switcher", nil)
+		req.Header.Set("HX-Request", "true")
+		rec = serve(req, "")
+		assert.Equal(t, "/login", rec.Header().Get("HX-Redirect"))
+
+		rec = serve(httptest.NewRequest(http.MethodGet, "/login", nil), "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("signs in", func(t *testing.T) {
+		form := url.Values{"email": {"alice@example.com"}, "password": {"correct horse"}}
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("User-Agent", "Firefox")
+		rec := serve(req, "")
+		assert.Equal(t, http.StatusSeeOther, rec.Code)
+		session := cookie(rec, sessionCookie)
+		require.NotNil(t, session)
+		assert.Equal(t, "token-alice", session.Value)
+		assert.True(t, session.HttpOnly)
+
+		// The session is named after the browser, not the web app
+		assert.Equal(t, "Firefox", gotUserAgent)
+		assert.Equal(t, "192.0.2.1", gotForwardedFor)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		form := url.Values{"email": {"alice@example.com"}, "password": {"wrong"}}
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := serve(req, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), "invalid email or password")
+		assert.Nil(t, cookie(rec, sessionCookie))
+	})
+
+	t.Run("shows the captcha when asked for", func(t *testing.T) {
+		form := url.Values{"email": {"bob@example.com"}, "password": {"correct horse"}}
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := serve(req, "")
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), `data-sitekey="site-key"`)
+
+		form.Set("cf-turnstile-response", "solved")
+		req = httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec = serve(req, "")
+		assert.Equal(t, http.StatusSeeOther, rec.Code)
+	})
+
+	t.Run("calls the API as the user", func(t *testing.T) {
+		rec := serve(httptest.NewRequest(http.MethodGet, "/htmx/book-switcher", nil), "token-alice")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "Bearer token-alice", gotAuth)
+		// No book was picked, so the API picks the user's default book
+		assert.Empty(t, gotBook)
+	})
+
+	t.Run("expired sessions sign in again", func(t *testing.T) {
+		rec := serve(httptest.NewRequest(http.MethodGet, "/htmx/book-switcher", nil), "token-expired")
+		assert.Equal(t, http.StatusSeeOther, rec.Code)
+		assert.Equal(t, "/login", rec.Header().Get("Location"))
+		session := cookie(rec, sessionCookie)
+		require.NotNil(t, session)
+		assert.Negative(t, session.MaxAge)
+	})
+
+	t.Run("signs out on the API", func(t *testing.T) {
+		rec := serve(httptest.NewRequest(http.MethodPost, "/logout", nil), "token-alice")
+		assert.Equal(t, http.StatusSeeOther, rec.Code)
+		assert.Equal(t, "Bearer token-alice", loggedOut)
+		session := cookie(rec, sessionCookie)
+		require.NotNil(t, session)
+		assert.Negative(t, session.MaxAge)
+	})
+}
@@ -0,0 +1,122 @@
+package worker
+
+import (
+	"context"
+	"expvar"
+	"finance/domain/entities"
+	"log/slog"
+	"time"
+)
+
+// Backup metrics, published on /debug/vars
+var (
+	backupMetrics      = expvar.NewMap("backup")
+	backupRuns         = new(expvar.Int)
+	backupFailures     = new(expvar.Int)
+	backupPruned       = new(expvar.Int)
+	backupLastDuration = new(expvar.Float)
+	backupLastRun      = new(expvar.String)
+	backupLastBackup   = new(expvar.String)
+	backupLastError    = new(expvar.String)
+)
+
+func init() {
+	backupMetrics.Set("runs", backupRuns)
+	backupMetrics.Set("failures", backupFailures)
+	backupMetrics.Set("pruned", backupPruned)
+	backupMetrics.Set("last_duration_seconds", backupLastDuration)
+	backupMetrics.Set("last_run", backupLastRun)
+	backupMetrics.Set("last_backup", backupLastBackup)
+	backupMetrics.Set("last_error", backupLastError)
+}
+
+type Backuper interface {
+	CreateBackup(ctx context.Context) (entities.BackupFile, error)
+	PruneBackups(ctx context.Context, retention entities.BackupRetention) ([]entities.BackupFile, error)
+}
+
+// BackupJob takes a full backup on a schedule and prunes the ones the retention
+// policy no longer keeps
+type BackupJob struct {
+	backuper  Backuper
+	schedule  Schedule
+	retention entities.BackupRetention
+	log       *slog.Logger
+	now       func() time.Time
+}
+
+func NewBackupJob(backuper Backuper, schedule Schedule, retention entities.BackupRetention, log *slog.Logger) *BackupJob {
+	return &BackupJob{
+		backuper:  backuper,
+		schedule:  schedule,
+		retention: retention,
+		log:       log,
+		now:       time.Now,
+	}
+}
+
+// Run takes a backup at every scheduled time until ctx is done
+func (j *BackupJob) Run(ctx context.Context) {
+	for {
+		next := j.schedule.Next(j.now())
+		if next.IsZero() {
+			j.log.Error("backup schedule never matches, stopping")
+			return
+		}
+		j.log.Info("next backup scheduled", slog.Time("at", next))
+
+		timer := time.NewTimer(next.Sub(j.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		j.RunOnce(ctx)
+	}
+}
+
+// RunOnce takes a backup, prunes the expired ones and records the run metrics.
+// Nothing is pruned when the backup fails, so a broken job never eats into
+// the backups that are left
+func (j *BackupJob) RunOnce(ctx context.Context) {
+	started := j.now()
+	file, err := j.backuper.CreateBackup(ctx)
+	duration := j.now().Sub(started)
+
+	backupRuns.Add(1)
+	backupLastDuration.Set(duration.Seconds())
+	backupLastRun.Set(started.Format(time.RFC3339))
+
+	if err != nil {
+		backupFailures.Add(1)
+		backupLastError.Set(err.Error())
+		j.log.Error("scheduled backup failed",
+			slog.Duration("duration", duration),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	backupLastBackup.Set(file.Name)
+
+	pruned, err := j.backuper.PruneBackups(ctx, j.retention)
+	backupPruned.Add(int64(len(pruned)))
+	if err != nil {
+		backupFailures.Add(1)
+		backupLastError.Set(err.Error())
+		j.log.Error("pruning backups failed",
+			slog.String("backup", file.Name),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	backupLastError.Set("")
+	j.log.Info("scheduled backup completed",
+		slog.String("backup", file.Name),
+		slog.Int64("size", file.Size),
+		slog.Int("pruned", len(pruned)),
+		slog.Duration("duration", duration),
+	)
+}
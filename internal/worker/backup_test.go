@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"finance/domain/entities"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type backuperFunc struct {
+	create func(ctx context.Context) (entities.BackupFile, error)
+	prune  func(ctx context.Context, retention entities.BackupRetention) ([]entities.BackupFile, error)
+}
+
+func (b backuperFunc) CreateBackup(ctx context.Context) (entities.BackupFile, error) {
+	return b.create(ctx)
+}
+
+func (b backuperFunc) PruneBackups(ctx context.Context, retention entities.BackupRetention) ([]entities.BackupFile, error) {
+	return b.prune(ctx, retention)
+}
+
+func TestBackupJobRunOnce(t *testing.T) {
+	tests := []struct {
+		name         string
+		createErr    error
+		pruneErr     error
+		wantFailures int64
+		wantPruned   int64
+		wantPrune    bool
+		wantError    string
+	}{
+		{
+			name:       "success",
+			wantPruned: 2,
+			wantPrune:  true,
+		},
+		{
+			name:         "backup failure",
+			createErr:    errors.New("failed to get accounts: connection reset"),
+			wantFailures: 1,
+			wantError:    "failed to get accounts: connection reset",
+		},
+		{
+			name:         "prune failure",
+			pruneErr:     errors.New("failed to delete backup: permission denied"),
+			wantFailures: 1,
+			wantPruned:   2,
+			wantPrune:    true,
+			wantError:    "failed to delete backup: permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs, failures, pruned := backupRuns.Value(), backupFailures.Value(), backupPruned.Value()
+			retention := entities.BackupRetention{Daily: 7, Weekly: 4}
+
+			pruneCalled := false
+			job := NewBackupJob(backuperFunc{
+				create: func(ctx context.Context) (entities.BackupFile, error) {
+					return entities.BackupFile{Name: "finance-20250115T033000Z.json"}, tt.createErr
+				},
+				prune: func(ctx context.Context, got entities.BackupRetention) ([]entities.BackupFile, error) {
+					pruneCalled = true
+					assert.Equal(t, retention, got)
+					return []entities.BackupFile{{Name: "a"}, {Name: "b"}}, tt.pruneErr
+				},
+			}, Schedule{}, retention, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+			// Each backup takes a minute on the job clock
+			clock := time.Date(2025, time.January, 15, 3, 30, 0, 0, time.UTC)
+			job.now = func() time.Time {
+				clock = clock.Add(time.Minute)
+				return clock
+			}
+
+			job.RunOnce(context.Background())
+
+			assert.Equal(t, int64(1), backupRuns.Value()-runs)
+			assert.Equal(t, tt.wantFailures, backupFailures.Value()-failures)
+			assert.Equal(t, tt.wantPruned, backupPruned.Value()-pruned)
+			assert.Equal(t, tt.wantPrune, pruneCalled, "nothing is pruned after a failed backup")
+			assert.Equal(t, 60.0, backupLastDuration.Value())
+			assert.Equal(t, tt.wantError, backupLastError.Value())
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"finance/domain"
+	"log/slog"
+	"time"
+)
+
+// Balance refresh metrics, published on /debug/vars
+var (
+	balanceRefreshMetrics      = expvar.NewMap("balance_refresh")
+	balanceRefreshRuns         = new(expvar.Int)
+	balanceRefreshFailures     = new(expvar.Int)
+	balanceRefreshSkipped      = new(expvar.Int)
+	balanceRefreshLastDuration = new(expvar.Float)
+	balanceRefreshLastRun      = new(expvar.String)
+	balanceRefreshLastError    = new(expvar.String)
+)
+
+func init() {
+	balanceRefreshMetrics.Set("runs", balanceRefreshRuns)
+	balanceRefreshMetrics.Set("failures", balanceRefreshFailures)
+	balanceRefreshMetrics.Set("skipped", balanceRefreshSkipped)
+	balanceRefreshMetrics.Set("last_duration_seconds", balanceRefreshLastDuration)
+	balanceRefreshMetrics.Set("last_run", balanceRefreshLastRun)
+	balanceRefreshMetrics.Set("last_error", balanceRefreshLastError)
+}
+
+type BalanceRefresher interface {
+	RefreshAllBalances(ctx context.Context) error
+}
+
+// BalanceRefresherFunc adapts a function into a BalanceRefresher
+type BalanceRefresherFunc func(ctx context.Context) error
+
+func (f BalanceRefresherFunc) RefreshAllBalances(ctx context.Context) error {
+	return f(ctx)
+}
+
+// BalanceRefreshJob recalculates every account balance on a schedule. Balances are
+// kept up to date by the transactions trigger, the job catches any drift left by
+// missed updates.
+type BalanceRefreshJob struct {
+	refresher BalanceRefresher
+	schedule  Schedule
+	log       *slog.Logger
+	now       func() time.Time
+}
+
+func NewBalanceRefreshJob(refresher BalanceRefresher, schedule Schedule, log *slog.Logger) *BalanceRefreshJob {
+	return &BalanceRefreshJob{
+		refresher: refresher,
+		schedule:  schedule,
+		log:       log,
+		now:       time.Now,
+	}
+}
+
+// Run refreshes the balances at every scheduled time until ctx is done
+func (j *BalanceRefreshJob) Run(ctx context.Context) {
+	for {
+		next := j.schedule.Next(j.now())
+		if next.IsZero() {
+			j.log.Error("balance refresh schedule never matches, stopping")
+			return
+		}
+		j.log.Info("next balance refresh scheduled", slog.Time("at", next))
+
+		timer := time.NewTimer(next.Sub(j.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		j.RunOnce(ctx)
+	}
+}
+
+// RunOnce refreshes the balances and records the run metrics
+func (j *BalanceRefreshJob) RunOnce(ctx context.Context) {
+	started := j.now()
+	err := j.refresher.RefreshAllBalances(ctx)
+	duration := j.now().Sub(started)
+
+	// A refresh started through the API is already catching up the balances
+	if errors.Is(err, domain.ErrConflict) {
+		balanceRefreshSkipped.Add(1)
+		j.log.Info("scheduled balance refresh skipped", slog.String("reason", err.Error()))
+		return
+	}
+
+	balanceRefreshRuns.Add(1)
+	balanceRefreshLastDuration.Set(duration.Seconds())
+	balanceRefreshLastRun.Set(started.Format(time.RFC3339))
+
+	if err != nil {
+		balanceRefreshFailures.Add(1)
+		balanceRefreshLastError.Set(err.Error())
+		j.log.Error("scheduled balance refresh failed",
+			slog.Duration("duration", duration),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	balanceRefreshLastError.Set("")
+	j.log.Info("scheduled balance refresh completed", slog.Duration("duration", duration))
+}
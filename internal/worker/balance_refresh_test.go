@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"finance/domain"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type refresherFunc func(ctx context.Context) error
+
+func (f refresherFunc) RefreshAllBalances(ctx context.Context) error {
+	return f(ctx)
+}
+
+func TestBalanceRefreshJobRunOnce(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		wantRuns     int64
+		wantFailures int64
+		wantSkipped  int64
+		wantError    string
+	}{
+		{
+			name:     "success",
+			wantRuns: 1,
+		},
+		{
+			name:         "failure",
+			err:          errors.New("failed to get accounts: connection reset"),
+			wantRuns:     1,
+			wantFailures: 1,
+			wantError:    "failed to get accounts: connection reset",
+		},
+		{
+			name:        "refresh already running",
+			err:         fmt.Errorf("balance refresh already running: %w", domain.ErrConflict),
+			wantSkipped: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs, failures, skipped := balanceRefreshRuns.Value(), balanceRefreshFailures.Value(), balanceRefreshSkipped.Value()
+
+			// Each run takes a minute on the job clock
+			clock := time.Date(2025, time.January, 15, 4, 0, 0, 0, time.UTC)
+			job := NewBalanceRefreshJob(refresherFunc(func(ctx context.Context) error {
+				return tt.err
+			}), Schedule{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+			job.now = func() time.Time {
+				clock = clock.Add(time.Minute)
+				return clock
+			}
+
+			job.RunOnce(context.Background())
+
+			assert.Equal(t, tt.wantRuns, balanceRefreshRuns.Value()-runs)
+			assert.Equal(t, tt.wantFailures, balanceRefreshFailures.Value()-failures)
+			assert.Equal(t, tt.wantSkipped, balanceRefreshSkipped.Value()-skipped)
+			if tt.wantRuns > 0 {
+				assert.Equal(t, 60.0, balanceRefreshLastDuration.Value())
+				assert.Equal(t, tt.wantError, balanceRefreshLastError.Value())
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package worker
+
+import (
+	"context"
+	"expvar"
+	"log/slog"
+	"time"
+)
+
+// Budget alert metrics, published on /debug/vars
+var (
+	budgetAlertMetrics      = expvar.NewMap("budget_alerts")
+	budgetAlertRuns         = new(expvar.Int)
+	budgetAlertFailures     = new(expvar.Int)
+	budgetAlertLastDuration = new(expvar.Float)
+	budgetAlertLastRun      = new(expvar.String)
+	budgetAlertLastError    = new(expvar.String)
+)
+
+func init() {
+	budgetAlertMetrics.Set("runs", budgetAlertRuns)
+	budgetAlertMetrics.Set("failures", budgetAlertFailures)
+	budgetAlertMetrics.Set("last_duration_seconds", budgetAlertLastDuration)
+	budgetAlertMetrics.Set("last_run", budgetAlertLastRun)
+	budgetAlertMetrics.Set("last_error", budgetAlertLastError)
+}
+
+type BudgetAlerter interface {
+	EvaluateBudgetAlerts(ctx context.Context) error
+}
+
+// BudgetAlerterFunc adapts a function into a BudgetAlerter
+type BudgetAlerterFunc func(ctx context.Context) error
+
+func (f BudgetAlerterFunc) EvaluateBudgetAlerts(ctx context.Context) error {
+	return f(ctx)
+}
+
+// BudgetAlertJob evaluates the budget alerts on a schedule, catching the
+// thresholds reached by changes made outside the API, such as imports. Each
+// threshold fires once a month, so running it again is harmless.
+type BudgetAlertJob struct {
+	alerter  BudgetAlerter
+	schedule Schedule
+	log      *slog.Logger
+	now      func() time.Time
+}
+
+func NewBudgetAlertJob(alerter BudgetAlerter, schedule Schedule, log *slog.Logger) *BudgetAlertJob {
+	return &BudgetAlertJob{
+		alerter:  alerter,
+		schedule: schedule,
+		log:      log,
+		now:      time.Now,
+	}
+}
+
+// Run evaluates the budget alerts at every scheduled time until ctx is done
+func (j *BudgetAlertJob) Run(ctx context.Context) {
+	for {
+		next := j.schedule.Next(j.now())
+		if next.IsZero() {
+			j.log.Error("budget alert schedule never matches, stopping")
+			return
+		}
+		j.log.Info("next budget alert evaluation scheduled", slog.Time("at", next))
+
+		timer := time.NewTimer(next.Sub(j.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		j.RunOnce(ctx)
+	}
+}
+
+// RunOnce evaluates the budget alerts and records the run metrics
+func (j *BudgetAlertJob) RunOnce(ctx context.Context) {
+	started := j.now()
+	err := j.alerter.EvaluateBudgetAlerts(ctx)
+	duration := j.now().Sub(started)
+
+	budgetAlertRuns.Add(1)
+	budgetAlertLastDuration.Set(duration.Seconds())
+	budgetAlertLastRun.Set(started.Format(time.RFC3339))
+
+	if err != nil {
+		budgetAlertFailures.Add(1)
+		budgetAlertLastError.Set(err.Error())
+		j.log.Error("scheduled budget alert evaluation failed",
+			slog.Duration("duration", duration),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	budgetAlertLastError.Set("")
+	j.log.Info("scheduled budget alert evaluation completed", slog.Duration("duration", duration))
+}
@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"expvar"
+	"log/slog"
+	"time"
+)
+
+// Idempotency purge metrics, published on /debug/vars
+var (
+	idempotencyPurgeMetrics   = expvar.NewMap("idempotency_purge")
+	idempotencyPurgeRuns      = new(expvar.Int)
+	idempotencyPurgeFailures  = new(expvar.Int)
+	idempotencyPurgeDeleted   = new(expvar.Int)
+	idempotencyPurgeLastError = new(expvar.String)
+)
+
+func init() {
+	idempotencyPurgeMetrics.Set("runs", idempotencyPurgeRuns)
+	idempotencyPurgeMetrics.Set("failures", idempotencyPurgeFailures)
+	idempotencyPurgeMetrics.Set("deleted", idempotencyPurgeDeleted)
+	idempotencyPurgeMetrics.Set("last_error", idempotencyPurgeLastError)
+}
+
+type IdempotencyPurger interface {
+	PurgeExpired(ctx context.Context) (int64, error)
+}
+
+// IdempotencyPurgeJob deletes the expired idempotency keys every interval.
+// Expired keys are free to be used again before they're deleted, the job
+// only keeps the table from growing.
+type IdempotencyPurgeJob struct {
+	purger   IdempotencyPurger
+	interval time.Duration
+	log      *slog.Logger
+}
+
+func NewIdempotencyPurgeJob(purger IdempotencyPurger, interval time.Duration, log *slog.Logger) *IdempotencyPurgeJob {
+	return &IdempotencyPurgeJob{
+		purger:   purger,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Run purges the expired keys every interval until ctx is done
+func (j *IdempotencyPurgeJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		j.RunOnce(ctx)
+	}
+}
+
+// RunOnce purges the expired keys and records the run metrics
+func (j *IdempotencyPurgeJob) RunOnce(ctx context.Context) {
+	idempotencyPurgeRuns.Add(1)
+	deleted, err := j.purger.PurgeExpired(ctx)
+	if err != nil {
+		idempotencyPurgeFailures.Add(1)
+		idempotencyPurgeLastError.Set(err.Error())
+		j.log.Error("idempotency purge failed", slog.String("error", err.Error()))
+		return
+	}
+	idempotencyPurgeDeleted.Add(deleted)
+	idempotencyPurgeLastError.Set("")
+}
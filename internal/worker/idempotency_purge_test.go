@@ -0,0 +1,36 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type purgerFunc func(ctx context.Context) (int64, error)
+
+func (f purgerFunc) PurgeExpired(ctx context.Context) (int64, error) {
+	return f(ctx)
+}
+
+func TestIdempotencyPurgeJobRunOnce(t *testing.T) {
+	runs, failures, deleted := idempotencyPurgeRuns.Value(), idempotencyPurgeFailures.Value(), idempotencyPurgeDeleted.Value()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	NewIdempotencyPurgeJob(purgerFunc(func(ctx context.Context) (int64, error) {
+		return 0, errors.New("failed to delete expired idempotency keys: connection reset")
+	}), 0, log).RunOnce(context.Background())
+	assert.Equal(t, "failed to delete expired idempotency keys: connection reset", idempotencyPurgeLastError.Value())
+
+	NewIdempotencyPurgeJob(purgerFunc(func(ctx context.Context) (int64, error) {
+		return 12, nil
+	}), 0, log).RunOnce(context.Background())
+	assert.Empty(t, idempotencyPurgeLastError.Value())
+
+	assert.Equal(t, runs+2, idempotencyPurgeRuns.Value())
+	assert.Equal(t, failures+1, idempotencyPurgeFailures.Value())
+	assert.Equal(t, deleted+12, idempotencyPurgeDeleted.Value())
+}
@@ -0,0 +1,109 @@
+package worker
+
+import (
+	"context"
+	"expvar"
+	"finance/internal/realtime"
+	"log/slog"
+)
+
+// Notification metrics, published on /debug/vars
+var (
+	notifyMetrics   = expvar.NewMap("notify")
+	notifySent      = new(expvar.Int)
+	notifyFailures  = new(expvar.Int)
+	notifyDropped   = new(expvar.Int)
+	notifyLastError = new(expvar.String)
+)
+
+func init() {
+	notifyMetrics.Set("sent", notifySent)
+	notifyMetrics.Set("failures", notifyFailures)
+	notifyMetrics.Set("dropped", notifyDropped)
+	notifyMetrics.Set("last_error", notifyLastError)
+}
+
+// notifyBuffer is how many events a channel can fall behind before it's
+// dropped and subscribed again
+const notifyBuffer = 256
+
+type EventSubscriber interface {
+	Subscribe(buffer int) *realtime.Subscription
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, event realtime.Event) error
+}
+
+// NotifierFunc adapts a function to a Notifier
+type NotifierFunc func(ctx context.Context, event realtime.Event) error
+
+func (f NotifierFunc) Notify(ctx context.Context, event realtime.Event) error {
+	return f(ctx, event)
+}
+
+// NotifyJob delivers the changes made through the API to a notification
+// channel as they're published. A channel that falls behind misses the events
+// published until it catches up, the same as a slow WebSocket client.
+type NotifyJob struct {
+	events   EventSubscriber
+	channel  string
+	notifier Notifier
+	log      *slog.Logger
+}
+
+func NewNotifyJob(events EventSubscriber, channel string, notifier Notifier, log *slog.Logger) *NotifyJob {
+	return &NotifyJob{
+		events:   events,
+		channel:  channel,
+		notifier: notifier,
+		log:      log,
+	}
+}
+
+// Run delivers the events until ctx is done
+func (j *NotifyJob) Run(ctx context.Context) {
+	for {
+		subscription := j.events.Subscribe(notifyBuffer)
+		if !j.deliver(ctx, subscription) {
+			subscription.Close()
+			return
+		}
+
+		notifyDropped.Add(1)
+		j.log.Warn("notification channel fell behind, events were missed",
+			slog.String("channel", j.channel),
+		)
+	}
+}
+
+// deliver notifies the events of the subscription until ctx is done, returning
+// false, or the subscription is dropped
+func (j *NotifyJob) deliver(ctx context.Context, subscription *realtime.Subscription) bool {
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case event, ok := <-subscription.Events():
+			if !ok {
+				return true
+			}
+			j.NotifyOnce(ctx, event)
+		}
+	}
+}
+
+// NotifyOnce delivers an event and records the metrics. Failed deliveries
+// aren't retried.
+func (j *NotifyJob) NotifyOnce(ctx context.Context, event realtime.Event) {
+	if err := j.notifier.Notify(ctx, event); err != nil {
+		notifyFailures.Add(1)
+		notifyLastError.Set(j.channel + ": " + err.Error())
+		j.log.Error("notification failed",
+			slog.String("channel", j.channel),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	notifySent.Add(1)
+}
@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"finance/internal/realtime"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifyJob(t *testing.T) {
+	sent, failures := notifySent.Value(), notifyFailures.Value()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	hub := realtime.NewHub()
+
+	delivered := make(chan realtime.Event)
+	notifier := NotifierFunc(func(ctx context.Context, event realtime.Event) error {
+		if event.Action == realtime.ActionDeleted {
+			return errors.New("webhook answered 500")
+		}
+		delivered <- event
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		NewNotifyJob(hub, "webhook", notifier, log).Run(ctx)
+		close(done)
+	}()
+	assert.Eventually(t, hub.Active, time.Second, time.Millisecond)
+
+	hub.Publish(realtime.Event{Topic: realtime.TopicAccounts, Action: realtime.ActionDeleted, Data: "acc-1"})
+	hub.Publish(realtime.Event{Topic: realtime.TopicAccounts, Action: realtime.ActionCreated, Data: "acc-2"})
+	assert.Equal(t, realtime.Event{Topic: realtime.TopicAccounts, Action: realtime.ActionCreated, Data: "acc-2"}, <-delivered)
+
+	cancel()
+	<-done
+	assert.False(t, hub.Active(), "the subscription is closed when the job stops")
+
+	assert.Equal(t, sent+1, notifySent.Value())
+	assert.Equal(t, failures+1, notifyFailures.Value())
+	assert.Equal(t, "webhook: webhook answered 500", notifyLastError.Value())
+}
@@ -0,0 +1,102 @@
+package worker
+
+import (
+	"context"
+	"expvar"
+	"log/slog"
+	"time"
+)
+
+// Report snapshot metrics, published on /debug/vars
+var (
+	reportSnapshotMetrics      = expvar.NewMap("report_snapshot")
+	reportSnapshotRuns         = new(expvar.Int)
+	reportSnapshotFailures     = new(expvar.Int)
+	reportSnapshotLastDuration = new(expvar.Float)
+	reportSnapshotLastRun      = new(expvar.String)
+	reportSnapshotLastError    = new(expvar.String)
+)
+
+func init() {
+	reportSnapshotMetrics.Set("runs", reportSnapshotRuns)
+	reportSnapshotMetrics.Set("failures", reportSnapshotFailures)
+	reportSnapshotMetrics.Set("last_duration_seconds", reportSnapshotLastDuration)
+	reportSnapshotMetrics.Set("last_run", reportSnapshotLastRun)
+	reportSnapshotMetrics.Set("last_error", reportSnapshotLastError)
+}
+
+type MonthCloser interface {
+	CloseLastMonth(ctx context.Context) error
+}
+
+// MonthCloserFunc adapts a function into a MonthCloser
+type MonthCloserFunc func(ctx context.Context) error
+
+func (f MonthCloserFunc) CloseLastMonth(ctx context.Context) error {
+	return f(ctx)
+}
+
+// ReportSnapshotJob closes the month that just ended on a schedule, keeping its
+// report as a snapshot so later edits don't rewrite it. Months already closed
+// keep their snapshot, so running it again is harmless.
+type ReportSnapshotJob struct {
+	closer   MonthCloser
+	schedule Schedule
+	log      *slog.Logger
+	now      func() time.Time
+}
+
+func NewReportSnapshotJob(closer MonthCloser, schedule Schedule, log *slog.Logger) *ReportSnapshotJob {
+	return &ReportSnapshotJob{
+		closer:   closer,
+		schedule: schedule,
+		log:      log,
+		now:      time.Now,
+	}
+}
+
+// Run closes the last month at every scheduled time until ctx is done
+func (j *ReportSnapshotJob) Run(ctx context.Context) {
+	for {
+		next := j.schedule.Next(j.now())
+		if next.IsZero() {
+			j.log.Error("report snapshot schedule never matches, stopping")
+			return
+		}
+		j.log.Info("next report snapshot scheduled", slog.Time("at", next))
+
+		timer := time.NewTimer(next.Sub(j.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		j.RunOnce(ctx)
+	}
+}
+
+// RunOnce closes the last month and records the run metrics
+func (j *ReportSnapshotJob) RunOnce(ctx context.Context) {
+	started := j.now()
+	err := j.closer.CloseLastMonth(ctx)
+	duration := j.now().Sub(started)
+
+	reportSnapshotRuns.Add(1)
+	reportSnapshotLastDuration.Set(duration.Seconds())
+	reportSnapshotLastRun.Set(started.Format(time.RFC3339))
+
+	if err != nil {
+		reportSnapshotFailures.Add(1)
+		reportSnapshotLastError.Set(err.Error())
+		j.log.Error("scheduled report snapshot failed",
+			slog.Duration("duration", duration),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	reportSnapshotLastError.Set("")
+	j.log.Info("scheduled report snapshot completed", slog.Duration("duration", duration))
+}
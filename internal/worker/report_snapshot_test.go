@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportSnapshotJobRunOnce(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		wantFailures int64
+		wantError    string
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:         "failure",
+			err:          errors.New("book Side business: failed to get transactions: connection reset"),
+			wantFailures: 1,
+			wantError:    "book Side business: failed to get transactions: connection reset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs, failures := reportSnapshotRuns.Value(), reportSnapshotFailures.Value()
+
+			// Each run takes a minute on the job clock
+			clock := time.Date(2025, time.February, 1, 3, 0, 0, 0, time.UTC)
+			job := NewReportSnapshotJob(MonthCloserFunc(func(ctx context.Context) error {
+				return tt.err
+			}), Schedule{}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+			job.now = func() time.Time {
+				clock = clock.Add(time.Minute)
+				return clock
+			}
+
+			job.RunOnce(context.Background())
+
+			assert.Equal(t, int64(1), reportSnapshotRuns.Value()-runs)
+			assert.Equal(t, tt.wantFailures, reportSnapshotFailures.Value()-failures)
+			assert.Equal(t, 60.0, reportSnapshotLastDuration.Value())
+			assert.Equal(t, tt.wantError, reportSnapshotLastError.Value())
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package worker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression with the five standard fields: minute, hour,
+// day of month, month and day of week. Fields accept *, numbers, ranges (1-5),
+// lists (1,15) and steps (*/15, 0-30/10).
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// Like cron, when both day fields are restricted a time matches either of them
+	domAny, dowAny bool
+}
+
+type scheduleField struct {
+	name     string
+	min, max int
+}
+
+var scheduleFields = []scheduleField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 6},
+}
+
+// ParseSchedule parses a cron expression like "0 4 * * *"
+func ParseSchedule(expr string) (Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(scheduleFields) {
+		return Schedule{}, fmt.Errorf("invalid schedule %q: expected %d fields, got %d", expr, len(scheduleFields), len(parts))
+	}
+
+	var bits [5]uint64
+	for i, field := range scheduleFields {
+		var err error
+		if bits[i], err = parseScheduleField(parts[i], field); err != nil {
+			return Schedule{}, fmt.Errorf("invalid schedule %q: %w", expr, err)
+		}
+	}
+
+	return Schedule{
+		minute: bits[0],
+		hour:   bits[1],
+		dom:    bits[2],
+		month:  bits[3],
+		dow:    bits[4],
+		domAny: parts[2] == "*",
+		dowAny: parts[4] == "*",
+	}, nil
+}
+
+func parseScheduleField(value string, field scheduleField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(value, ",") {
+		rng, step, hasStep := strings.Cut(item, "/")
+
+		every := 1
+		if hasStep {
+			var err error
+			if every, err = strconv.Atoi(step); err != nil || every <= 0 {
+				return 0, fmt.Errorf("invalid %s step: %s", field.name, step)
+			}
+		}
+
+		start, end := field.min, field.max
+		if rng != "*" {
+			from, to, isRange := strings.Cut(rng, "-")
+
+			var err error
+			if start, err = strconv.Atoi(from); err != nil {
+				return 0, fmt.Errorf("invalid %s: %s", field.name, item)
+			}
+			end = start
+			if isRange {
+				if end, err = strconv.Atoi(to); err != nil {
+					return 0, fmt.Errorf("invalid %s: %s", field.name, item)
+				}
+			} else if hasStep {
+				end = field.max
+			}
+		}
+
+		if start < field.min || end > field.max || start > end {
+			return 0, fmt.Errorf("%s out of range: %s", field.name, item)
+		}
+
+		for i := start; i <= end; i += every {
+			bits |= 1 << uint(i)
+		}
+	}
+
+	return bits, nil
+}
+
+// Next returns the first time after t matching the schedule, in t's location
+func (s Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	// Every schedule matches at least once within a few years, the limit only
+	// guards against impossible dates like February 30th
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case !has(s.month, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !has(s.hour, t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !has(s.minute, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (s Schedule) matchesDay(t time.Time) bool {
+	dom := has(s.dom, t.Day())
+	dow := has(s.dow, int(t.Weekday()))
+
+	switch {
+	case s.domAny:
+		return dow
+	case s.dowAny:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+func has(bits uint64, i int) bool {
+	return bits&(1<<uint(i)) != 0
+}
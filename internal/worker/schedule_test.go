@@ -0,0 +1,88 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr string
+	}{
+		{expr: "0 4 * * *"},
+		{expr: "*/15 1-5 1,15 * 0"},
+		{expr: "30 2 * 1-12/3 1-5"},
+		{expr: "0 4 * *", wantErr: `invalid schedule "0 4 * *": expected 5 fields, got 4`},
+		{expr: "60 4 * * *", wantErr: `invalid schedule "60 4 * * *": minute out of range: 60`},
+		{expr: "0 4 0 * *", wantErr: `invalid schedule "0 4 0 * *": day of month out of range: 0`},
+		{expr: "0 5-4 * * *", wantErr: `invalid schedule "0 5-4 * * *": hour out of range: 5-4`},
+		{expr: "*/0 4 * * *", wantErr: `invalid schedule "*/0 4 * * *": invalid minute step: 0`},
+		{expr: "0 four * * *", wantErr: `invalid schedule "0 four * * *": invalid hour: four`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseSchedule(tt.expr)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	// A Wednesday
+	from := time.Date(2025, time.January, 15, 10, 20, 30, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{
+			expr: "0 4 * * *",
+			want: time.Date(2025, time.January, 16, 4, 0, 0, 0, time.UTC),
+		},
+		{
+			expr: "*/15 * * * *",
+			want: time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			expr: "20 10 * * *",
+			want: time.Date(2025, time.January, 16, 10, 20, 0, 0, time.UTC),
+		},
+		{
+			expr: "0 3 * * 0",
+			want: time.Date(2025, time.January, 19, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			expr: "0 0 1 * *",
+			want: time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// Either day field matches when both are set: the 20th or the next Friday
+			expr: "0 0 20 * 5",
+			want: time.Date(2025, time.January, 17, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr: "0 0 29 2 *",
+			want: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr: "0 0 30 2 *",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			schedule, err := ParseSchedule(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.Next(from))
+		})
+	}
+}
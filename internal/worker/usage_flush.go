@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"context"
+	"expvar"
+	"log/slog"
+	"time"
+)
+
+// Usage flush metrics, published on /debug/vars
+var (
+	usageFlushMetrics   = expvar.NewMap("usage_flush")
+	usageFlushRuns      = new(expvar.Int)
+	usageFlushFailures  = new(expvar.Int)
+	usageFlushLastError = new(expvar.String)
+)
+
+func init() {
+	usageFlushMetrics.Set("runs", usageFlushRuns)
+	usageFlushMetrics.Set("failures", usageFlushFailures)
+	usageFlushMetrics.Set("last_error", usageFlushLastError)
+}
+
+type UsageFlusher interface {
+	Flush(ctx context.Context) error
+}
+
+// UsageFlushJob adds the API calls metered in memory to the database every
+// interval. Calls that failed to be added are kept for the next run, the ones
+// counted after the last run are lost when the service stops.
+type UsageFlushJob struct {
+	flusher  UsageFlusher
+	interval time.Duration
+	log      *slog.Logger
+}
+
+func NewUsageFlushJob(flusher UsageFlusher, interval time.Duration, log *slog.Logger) *UsageFlushJob {
+	return &UsageFlushJob{
+		flusher:  flusher,
+		interval: interval,
+		log:      log,
+	}
+}
+
+// Run flushes the usage every interval until ctx is done
+func (j *UsageFlushJob) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		j.RunOnce(ctx)
+	}
+}
+
+// RunOnce flushes the usage and records the run metrics
+func (j *UsageFlushJob) RunOnce(ctx context.Context) {
+	usageFlushRuns.Add(1)
+	if err := j.flusher.Flush(ctx); err != nil {
+		usageFlushFailures.Add(1)
+		usageFlushLastError.Set(err.Error())
+		j.log.Error("usage flush failed", slog.String("error", err.Error()))
+		return
+	}
+	usageFlushLastError.Set("")
+}
@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type flusherFunc func(ctx context.Context) error
+
+func (f flusherFunc) Flush(ctx context.Context) error {
+	return f(ctx)
+}
+
+func TestUsageFlushJobRunOnce(t *testing.T) {
+	runs, failures := usageFlushRuns.Value(), usageFlushFailures.Value()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	NewUsageFlushJob(flusherFunc(func(ctx context.Context) error {
+		return errors.New("failed to add API calls: connection reset")
+	}), 0, log).RunOnce(context.Background())
+	assert.Equal(t, "failed to add API calls: connection reset", usageFlushLastError.Value())
+
+	NewUsageFlushJob(flusherFunc(func(ctx context.Context) error {
+		return nil
+	}), 0, log).RunOnce(context.Background())
+	assert.Empty(t, usageFlushLastError.Value())
+
+	assert.Equal(t, runs+2, usageFlushRuns.Value())
+	assert.Equal(t, failures+1, usageFlushFailures.Value())
+}
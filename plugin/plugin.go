@@ -0,0 +1,171 @@
+// Package plugin lets deployments extend the service with their own statement
+// importers, notification channels and rule actions, without changing the
+// domain packages.
+//
+// A plugin is a package registering its extensions from its init function,
+// like database/sql drivers:
+//
+//	func init() {
+//		plugin.RegisterImporter(plugin.Importer{
+//			Source:      "nubank",
+//			Institution: "Nubank",
+//			Parser:      NubankParser{},
+//		})
+//	}
+//
+// and linked into the service with a blank import in cmd/service/plugins.go.
+// The service resolves the registered extensions when it starts.
+package plugin
+
+import (
+	"context"
+	"finance/domain/entities"
+	"finance/domain/finance"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// Importer reads the statement exports of a provider, imported through
+// /imports/{source} like the built-in ones. The accounts of the statements
+// are kept under Institution.
+type Importer struct {
+	Source      entities.StatementSource
+	Institution string
+	Parser      finance.StatementParser
+}
+
+// Notification is a change made through the API, as sent to the WebSocket
+// clients. Data holds the resource as the API renders it, or its ID when
+// deleted.
+type Notification struct {
+	Topic  string
+	Action string
+	Data   any
+}
+
+// Notifier is a notification channel, like a chat or a webhook, notified of
+// the changes made through the API as they're made. Failed notifications
+// aren't retried.
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// RuleAction changes the transactions created through the API before they're
+// validated and stored, see finance.RuleAction
+type RuleAction = finance.RuleAction
+
+var registry struct {
+	sync.Mutex
+	importers   map[entities.StatementSource]Importer
+	notifiers   map[string]Notifier
+	ruleActions map[string]RuleAction
+}
+
+// RegisterImporter makes the statements of a source importable. It panics
+// when the source is built in or already registered, or the parser is nil.
+func RegisterImporter(importer Importer) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	if importer.Parser == nil {
+		panic(fmt.Sprintf("plugin: importer %q has no parser", importer.Source))
+	}
+	if importer.Source == "" {
+		panic("plugin: importer without a source")
+	}
+	if slices.Contains(entities.StatementSources, importer.Source) {
+		panic(fmt.Sprintf("plugin: statement source %q is built in", importer.Source))
+	}
+	if _, ok := registry.importers[importer.Source]; ok {
+		panic(fmt.Sprintf("plugin: importer %q registered twice", importer.Source))
+	}
+	if importer.Institution == "" {
+		importer.Institution = string(importer.Source)
+	}
+	if registry.importers == nil {
+		registry.importers = map[entities.StatementSource]Importer{}
+	}
+	registry.importers[importer.Source] = importer
+}
+
+// RegisterNotifier adds a notification channel. It panics when the name is
+// already registered or the notifier is nil.
+func RegisterNotifier(name string, notifier Notifier) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	if notifier == nil {
+		panic(fmt.Sprintf("plugin: notifier %q is nil", name))
+	}
+	if _, ok := registry.notifiers[name]; ok {
+		panic(fmt.Sprintf("plugin: notifier %q registered twice", name))
+	}
+	if registry.notifiers == nil {
+		registry.notifiers = map[string]Notifier{}
+	}
+	registry.notifiers[name] = notifier
+}
+
+// RegisterRuleAction adds an action applied to every transaction created
+// through the API. Actions are applied in the order of their names. It panics
+// when the name is already registered or the action is nil.
+func RegisterRuleAction(name string, action RuleAction) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	if action == nil {
+		panic(fmt.Sprintf("plugin: rule action %q is nil", name))
+	}
+	if _, ok := registry.ruleActions[name]; ok {
+		panic(fmt.Sprintf("plugin: rule action %q registered twice", name))
+	}
+	if registry.ruleActions == nil {
+		registry.ruleActions = map[string]RuleAction{}
+	}
+	registry.ruleActions[name] = action
+}
+
+// Importers lists the registered importers by source
+func Importers() []Importer {
+	registry.Lock()
+	defer registry.Unlock()
+
+	importers := make([]Importer, 0, len(registry.importers))
+	for _, source := range slices.Sorted(maps.Keys(registry.importers)) {
+		importers = append(importers, registry.importers[source])
+	}
+	return importers
+}
+
+// Notifiers returns the registered notification channels by name
+func Notifiers() map[string]Notifier {
+	registry.Lock()
+	defer registry.Unlock()
+	return maps.Clone(registry.notifiers)
+}
+
+// RuleActions lists the registered rule actions in the order they're applied
+func RuleActions() []RuleAction {
+	registry.Lock()
+	defer registry.Unlock()
+
+	actions := make([]RuleAction, 0, len(registry.ruleActions))
+	for _, name := range slices.Sorted(maps.Keys(registry.ruleActions)) {
+		actions = append(actions, registry.ruleActions[name])
+	}
+	return actions
+}
+
+// Names lists the names of the registered extensions, for the startup log
+func Names() (importers []string, notifiers []string, ruleActions []string) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	for source := range registry.importers {
+		importers = append(importers, string(source))
+	}
+	slices.Sort(importers)
+	return importers, slices.Sorted(maps.Keys(registry.notifiers)), slices.Sorted(maps.Keys(registry.ruleActions))
+}
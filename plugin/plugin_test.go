@@ -0,0 +1,70 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"finance/domain/entities"
+	"finance/domain/finance/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type notifierFunc func(ctx context.Context, notification Notification) error
+
+func (f notifierFunc) Notify(ctx context.Context, notification Notification) error {
+	return f(ctx, notification)
+}
+
+func resetRegistry(t *testing.T) {
+	t.Cleanup(func() {
+		registry.Lock()
+		defer registry.Unlock()
+		registry.importers, registry.notifiers, registry.ruleActions = nil, nil, nil
+	})
+}
+
+func TestRegisterImporter(t *testing.T) {
+	resetRegistry(t)
+	parser := &mocks.StatementParserMock{}
+
+	RegisterImporter(Importer{Source: "nubank", Institution: "Nubank", Parser: parser})
+	RegisterImporter(Importer{Source: "inter", Parser: parser})
+	assert.Equal(t, []Importer{
+		{Source: "inter", Institution: "inter", Parser: parser},
+		{Source: "nubank", Institution: "Nubank", Parser: parser},
+	}, Importers())
+
+	assert.PanicsWithValue(t, `plugin: importer "nubank" registered twice`, func() {
+		RegisterImporter(Importer{Source: "nubank", Parser: parser})
+	})
+	assert.PanicsWithValue(t, `plugin: statement source "wise" is built in`, func() {
+		RegisterImporter(Importer{Source: entities.StatementSourceWise, Parser: parser})
+	})
+	assert.PanicsWithValue(t, `plugin: importer "c6" has no parser`, func() {
+		RegisterImporter(Importer{Source: "c6"})
+	})
+}
+
+func TestRegisterNotifierAndRuleAction(t *testing.T) {
+	resetRegistry(t)
+	notifier := notifierFunc(func(ctx context.Context, notification Notification) error { return nil })
+	first := &mocks.RuleActionMock{}
+	second := &mocks.RuleActionMock{}
+
+	RegisterNotifier("slack", notifier)
+	RegisterRuleAction("2-tag", second)
+	RegisterRuleAction("1-categorize", first)
+
+	assert.Len(t, Notifiers(), 1)
+	assert.Contains(t, Notifiers(), "slack")
+	assert.Equal(t, []RuleAction{first, second}, RuleActions(), "applied in the order of their names")
+
+	importers, notifiers, ruleActions := Names()
+	assert.Empty(t, importers)
+	assert.Equal(t, []string{"slack"}, notifiers)
+	assert.Equal(t, []string{"1-categorize", "2-tag"}, ruleActions)
+
+	assert.PanicsWithValue(t, `plugin: notifier "slack" registered twice`, func() { RegisterNotifier("slack", notifier) })
+	assert.PanicsWithValue(t, `plugin: rule action "audit" is nil`, func() { RegisterRuleAction("audit", nil) })
+}